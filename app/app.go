@@ -28,17 +28,24 @@ import (
 	axelarParams "github.com/axelarnetwork/axelar-core/app/params"
 	"github.com/axelarnetwork/axelar-core/x/ante"
 	"github.com/axelarnetwork/axelar-core/x/axelarnet"
+	axelarnetClient "github.com/axelarnetwork/axelar-core/x/axelarnet/client"
 	axelarnetKeeper "github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
 	axelarnetTypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin"
 	btcKeeper "github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
 	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 	"github.com/axelarnetwork/axelar-core/x/evm"
+	evmClient "github.com/axelarnetwork/axelar-core/x/evm/client"
 	evmKeeper "github.com/axelarnetwork/axelar-core/x/evm/keeper"
 	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	"github.com/axelarnetwork/axelar-core/x/nexus"
+	nexusClient "github.com/axelarnetwork/axelar-core/x/nexus/client"
 	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
 	nexusTypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/axelar-core/x/permission"
+	permissionClient "github.com/axelarnetwork/axelar-core/x/permission/client"
+	permissionKeeper "github.com/axelarnetwork/axelar-core/x/permission/keeper"
+	permissionTypes "github.com/axelarnetwork/axelar-core/x/permission/types"
 	"github.com/axelarnetwork/axelar-core/x/reward"
 	rewardKeeper "github.com/axelarnetwork/axelar-core/x/reward/keeper"
 	rewardTypes "github.com/axelarnetwork/axelar-core/x/reward/types"
@@ -46,6 +53,7 @@ import (
 	snapKeeper "github.com/axelarnetwork/axelar-core/x/snapshot/keeper"
 	snapTypes "github.com/axelarnetwork/axelar-core/x/snapshot/types"
 	"github.com/axelarnetwork/axelar-core/x/tss"
+	tssProposal "github.com/axelarnetwork/axelar-core/x/tss/client/proposal"
 	tssKeeper "github.com/axelarnetwork/axelar-core/x/tss/keeper"
 	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
 	"github.com/axelarnetwork/axelar-core/x/vote"
@@ -135,6 +143,9 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			permissionClient.GrantRoleProposalHandler, permissionClient.RevokeRoleProposalHandler,
+			evmClient.AddEVMChainProposalHandler, axelarnetClient.AddCosmosChainProposalHandler, nexusClient.RegisterAssetProposalHandler,
+			tssProposal.RotateKeyProposalHandler, tssProposal.UpdateKeyRequirementProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -154,6 +165,7 @@ var (
 		nexus.AppModuleBasic{},
 		axelarnet.AppModuleBasic{},
 		reward.AppModuleBasic{},
+		permission.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -202,6 +214,7 @@ type AxelarApp struct {
 	evidenceKeeper   evidencekeeper.Keeper
 	transferKeeper   ibctransferkeeper.Keeper
 	capabilityKeeper *capabilitykeeper.Keeper
+	upgradeKeeper    upgradekeeper.Keeper
 
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
@@ -209,6 +222,7 @@ type AxelarApp struct {
 	memKeys map[string]*sdk.MemoryStoreKey
 
 	mm           *module.Manager
+	configurator module.Configurator
 	paramsKeeper paramskeeper.Keeper
 }
 
@@ -250,6 +264,7 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		nexusTypes.StoreKey,
 		axelarnetTypes.StoreKey,
 		rewardTypes.StoreKey,
+		permissionTypes.StoreKey,
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -300,6 +315,7 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	)
 	app.crisisKeeper = crisisK
 	upgradeK := upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
+	app.upgradeKeeper = upgradeK
 
 	evidenceK := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &stakingK, slashingK,
@@ -308,17 +324,7 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 
 	feegrantK := feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], accountK)
 
-	// register the proposal types
-	govRouter := govtypes.NewRouter()
-	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(paramsK)).
-		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(distrK)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(upgradeK))
-
-	govK := govkeeper.NewKeeper(
-		appCodec, keys[govtypes.StoreKey], app.getSubspace(govtypes.ModuleName), accountK, bankK,
-		&stakingK, govRouter,
-	)
+	permissionK := permissionKeeper.NewKeeper(appCodec, keys[permissionTypes.StoreKey])
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -373,7 +379,7 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	nexusK := nexusKeeper.NewKeeper(
 		appCodec, keys[nexusTypes.StoreKey], app.getSubspace(nexusTypes.ModuleName), axelarnetK,
 	)
-	axelarnetModule := axelarnet.NewAppModule(axelarnetK, nexusK, bankK, app.transferKeeper, app.ibcKeeper.ChannelKeeper, accountK, bApp.MsgServiceRouter(), bApp.Router(), transferModule, logger)
+	axelarnetModule := axelarnet.NewAppModule(axelarnetK, nexusK, bankK, app.transferKeeper, app.ibcKeeper.ChannelKeeper, accountK, distrK, bApp.MsgServiceRouter(), bApp.Router(), transferModule, logger)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -388,6 +394,28 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		AddRoute(btcTypes.ModuleName, btcKeeper.NewTssHandler(btcK, tssK))
 	tssK.SetRouter(tssRouter)
 
+	keyRotationRouter := tssTypes.NewKeyRotationRouter()
+	keyRotationRouter.AddRoute(evmTypes.ModuleName, evmKeeper.NewKeyRotationHandler(evmK, tssK, nexusK, tssK, votingK, snapK)).
+		AddRoute(btcTypes.ModuleName, btcKeeper.NewKeyRotationHandler(btcK, tssK, nexusK, votingK, snapK))
+	tssK.SetKeyRotationRouter(keyRotationRouter)
+
+	// register the proposal types
+	govRouter := govtypes.NewRouter()
+	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(paramsK)).
+		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(distrK)).
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(upgradeK)).
+		AddRoute(permissionTypes.RouterKey, permission.NewProposalHandler(permissionK)).
+		AddRoute(evmTypes.RouterKey, evm.NewProposalHandler(evmK, tssK, votingK, tssK, nexusK, snapK)).
+		AddRoute(axelarnetTypes.RouterKey, axelarnet.NewProposalHandler(axelarnetK, nexusK, bankK, app.transferKeeper, app.ibcKeeper.ChannelKeeper, accountK, bApp.MsgServiceRouter(), bApp.Router())).
+		AddRoute(nexusTypes.RouterKey, nexus.NewProposalHandler(nexusK, axelarnetK)).
+		AddRoute(tssTypes.RouterKey, tss.NewProposalHandler(tssK, snapK, nexusK, votingK, stakingK, rewardK))
+
+	govK := govkeeper.NewKeeper(
+		appCodec, keys[govtypes.StoreKey], app.getSubspace(govtypes.ModuleName), accountK, bankK,
+		&stakingK, govRouter,
+	)
+
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -418,11 +446,12 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		snapshot.NewAppModule(snapK),
 		tss.NewAppModule(tssK, snapK, votingK, nexusK, stakingK, rewardK),
 		vote.NewAppModule(votingK),
-		nexus.NewAppModule(nexusK, snapK, stakingK),
+		nexus.NewAppModule(nexusK, snapK, stakingK, btcDepositAddressResolver(btcK), evmDepositAddressResolver(evmK)),
 		evm.NewAppModule(evmK, tssK, votingK, tssK, nexusK, snapK, logger),
 		bitcoin.NewAppModule(btcK, votingK, tssK, nexusK, snapK),
 		axelarnetModule,
 		reward.NewAppModule(rewardK, nexusK, mintK, stakingK, tssK, snapK),
+		permission.NewAppModule(permissionK),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -477,14 +506,16 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		voteTypes.ModuleName,
 		axelarnetTypes.ModuleName,
 		rewardTypes.ModuleName,
+		permissionTypes.ModuleName,
 	)
 
-	app.mm.RegisterInvariants(&crisisK)
 	app.mm.RegisterInvariants(&crisisK)
 
 	// register all module routes and module queriers
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), legacyAmino)
-	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
+	app.setUpgradeBehaviour()
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -517,6 +548,7 @@ func NewAxelarApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		ante.NewValidateValidatorDeregisteredTssDecorator(tssK, nexusK, snapK),
 		ante.NewCheckRefundFeeDecorator(app.interfaceRegistry, accountK, stakingK, snapK, axelarnetK),
 		ante.NewCheckProxy(snapK),
+		ante.NewRestrictedMsgDecorator(permissionK),
 	)
 	app.SetAnteHandler(anteHandler)
 
@@ -565,6 +597,8 @@ func (app *AxelarApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) ab
 	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.upgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	btcKeeper "github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexusTypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	permissionTypes "github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// upgradeName is the name of the software upgrade plan that migrates the store to the current consensus versions
+const upgradeName = "v0.10"
+
+// setUpgradeBehaviour registers the handler that runs the in-place store migrations of all modules
+// and adds the stores of modules introduced by the upgrade
+func (app *AxelarApp) setUpgradeBehaviour() {
+	app.upgradeKeeper.SetUpgradeHandler(upgradeName, func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// chains started before module versions were recorded have no version map yet
+		if len(fromVM) == 0 {
+			fromVM = preUpgradeVersionMap(app.mm)
+		}
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %v", err))
+	}
+
+	if upgradeInfo.Name == upgradeName && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := storetypes.StoreUpgrades{Added: []string{permissionTypes.StoreKey}}
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
+}
+
+// preUpgradeVersionMap returns the module versions before the upgrade.
+// The permission module is new and gets initialized with its default genesis state
+func preUpgradeVersionMap(mm *module.Manager) module.VersionMap {
+	fromVM := mm.GetVersionMap()
+	delete(fromVM, permissionTypes.ModuleName)
+	fromVM[nexusTypes.ModuleName] = 1
+
+	return fromVM
+}
+
+// btcDepositAddressResolver recovers the original case of bitcoin deposit addresses from their address info
+func btcDepositAddressResolver(k btcKeeper.Keeper) nexusTypes.DepositAddressResolver {
+	return func(ctx sdk.Context, address nexus.CrossChainAddress) (string, bool) {
+		if !strings.EqualFold(address.Chain.Name, btc.Bitcoin.Name) {
+			return "", false
+		}
+
+		info, ok := k.GetAddress(ctx, address.Address)
+		return info.Address, ok
+	}
+}
+
+// evmDepositAddressResolver recovers the checksummed form of evm burner addresses
+func evmDepositAddressResolver(k evmTypes.BaseKeeper) nexusTypes.DepositAddressResolver {
+	return func(ctx sdk.Context, address nexus.CrossChainAddress) (string, bool) {
+		if !common.IsHexAddress(address.Address) {
+			return "", false
+		}
+
+		burnerAddress := common.HexToAddress(address.Address)
+		if k.ForChain(address.Chain.Name).GetBurnerInfo(ctx, burnerAddress) == nil {
+			return "", false
+		}
+
+		return burnerAddress.Hex(), true
+	}
+}
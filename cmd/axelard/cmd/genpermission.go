@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/axelarnetwork/axelar-core/x/permission"
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+	permissionTypes "github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// AddGenesisRoleCmd returns add-genesis-role cobra Command.
+func AddGenesisRoleCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-role [role] [address]",
+		Short: "Grant a role of the permission module to the given account in genesis",
+		Long: "Grant a role of the permission module to the given account in genesis. " +
+			"Restricted messages are rejected unless their sender holds the required role, " +
+			"so a chain started without any roles can only grant them by governance proposals.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			depCdc := clientCtx.Codec
+			cdc := depCdc.(codec.Codec)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			role, err := exported.RoleFromSimpleStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+			genesisPermission := permission.GetGenesisStateFromAppState(cdc, appState)
+
+			genesisPermission.RoleAssignments = append(genesisPermission.RoleAssignments, permissionTypes.NewRoleAssignment(role, address))
+			if err := genesisPermission.Validate(); err != nil {
+				return err
+			}
+
+			genesisPermissionBz, err := cdc.MarshalJSON(&genesisPermission)
+			if err != nil {
+				return fmt.Errorf("failed to marshal permission genesis state: %w", err)
+			}
+			appState[permissionTypes.ModuleName] = genesisPermissionBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+			genDoc.AppState = appStateJSON
+
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "node's home directory")
+
+	return cmd
+}
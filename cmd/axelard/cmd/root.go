@@ -160,6 +160,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		SetGenesisGovCmd(app.DefaultNodeHome),
 		AddGenesisEVMChainCmd(app.DefaultNodeHome),
 		SetGenesisMintCmd(app.DefaultNodeHome),
+		AddGenesisRoleCmd(app.DefaultNodeHome),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, export(encodingConfig), crisis.AddModuleInitFlags)
@@ -189,6 +190,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 	// add vald after the overwrite so it can set its own defaults
 	rootCmd.AddCommand(vald.GetValdCommand())
+	rootCmd.AddCommand(vald.GetValdReplayCommand())
+	rootCmd.AddCommand(vald.GetValdSignerCommand())
 
 	// add health check command
 	rootCmd.AddCommand(vald.GetHealthCheckCommand())
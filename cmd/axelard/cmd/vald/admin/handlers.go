@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+)
+
+// Handlers keeps track of the event handlers of vald and lets operators pause and resume them at runtime
+type Handlers struct {
+	lock   sync.RWMutex
+	paused map[string]chan struct{}
+	names  map[string]bool
+}
+
+// NewHandlers returns a new Handlers instance
+func NewHandlers() *Handlers {
+	return &Handlers{
+		paused: make(map[string]chan struct{}),
+		names:  make(map[string]bool),
+	}
+}
+
+// Wrap registers the given handler under the given name. While the handler is paused,
+// incoming events are held back and processed as soon as the handler is resumed
+func (h *Handlers) Wrap(name string, f func(e tmEvents.Event) error) func(e tmEvents.Event) error {
+	h.lock.Lock()
+	h.names[name] = true
+	h.lock.Unlock()
+
+	return func(e tmEvents.Event) error {
+		h.waitWhilePaused(name)
+		return f(e)
+	}
+}
+
+// Pause holds back all events of the handler with the given name until it is resumed
+func (h *Handlers) Pause(name string) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if !h.names[name] {
+		return fmt.Errorf("unknown handler %s", name)
+	}
+
+	if _, ok := h.paused[name]; !ok {
+		h.paused[name] = make(chan struct{})
+	}
+
+	return nil
+}
+
+// Resume processes all events the handler with the given name was held back from and all future ones
+func (h *Handlers) Resume(name string) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if !h.names[name] {
+		return fmt.Errorf("unknown handler %s", name)
+	}
+
+	if resumed, ok := h.paused[name]; ok {
+		close(resumed)
+		delete(h.paused, name)
+	}
+
+	return nil
+}
+
+// HandlerStatus describes whether an event handler is paused
+type HandlerStatus struct {
+	Name   string `json:"name"`
+	Paused bool   `json:"paused"`
+}
+
+// Status returns the status of all registered handlers sorted by name
+func (h *Handlers) Status() []HandlerStatus {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	var statuses []HandlerStatus
+	for name := range h.names {
+		_, paused := h.paused[name]
+		statuses = append(statuses, HandlerStatus{Name: name, Paused: paused})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+func (h *Handlers) waitWhilePaused(name string) {
+	h.lock.RLock()
+	resumed, paused := h.paused[name]
+	h.lock.RUnlock()
+
+	if paused {
+		<-resumed
+	}
+}
@@ -0,0 +1,195 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss"
+)
+
+// SessionManager gives access to the running tss sessions
+type SessionManager interface {
+	ActiveSessions() []tss.SessionInfo
+	AbortSession(id string) error
+}
+
+// BroadcasterStatus describes the broadcast calls that have not completed yet
+type BroadcasterStatus struct {
+	// calls waiting to be bundled into a transaction
+	Backlog int `json:"backlog"`
+	// transactions waiting to be broadcast or being retried
+	Pipeline int `json:"pipeline"`
+}
+
+// EndpointStatus describes the health of a single rpc endpoint
+type EndpointStatus struct {
+	Index   int  `json:"index"`
+	Healthy bool `json:"healthy"`
+}
+
+// ChainStatus describes the rpc endpoints vald uses for an external chain
+type ChainStatus struct {
+	Name      string           `json:"name"`
+	Endpoints []EndpointStatus `json:"endpoints"`
+}
+
+// Status is the overall state of a running vald process
+type Status struct {
+	BlockHeight int64             `json:"block_height"`
+	Broadcaster BroadcasterStatus `json:"broadcaster"`
+	Sessions    []tss.SessionInfo `json:"sessions"`
+	Chains      []ChainStatus     `json:"chains"`
+	Handlers    []HandlerStatus   `json:"handlers"`
+}
+
+// Backend provides the state the admin API exposes
+type Backend struct {
+	Sessions    SessionManager
+	Handlers    *Handlers
+	Broadcaster func() BroadcasterStatus
+	BlockHeight func() int64
+	Chains      func() []ChainStatus
+}
+
+type server struct {
+	backend Backend
+	logger  log.Logger
+}
+
+// NewServer returns a server for the admin API of vald at the given address. It exposes
+//
+// GET  /status                  overall state of vald
+// GET  /sessions                running tss sessions
+// POST /sessions/{id}/abort     aborts a tss session
+// GET  /chains                  connected chains and the health of their rpc endpoints
+// GET  /handlers                event handlers and whether they are paused
+// POST /handlers/{name}/pause   holds back the events of a handler
+// POST /handlers/{name}/resume  resumes a paused handler
+func NewServer(addr string, backend Backend, logger log.Logger) *http.Server {
+	s := server{backend: backend, logger: logger}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", get(s.status))
+	mux.HandleFunc("/sessions", get(s.sessions))
+	mux.HandleFunc("/sessions/", post(s.abortSession))
+	mux.HandleFunc("/chains", get(s.chains))
+	mux.HandleFunc("/handlers", get(s.handlers))
+	mux.HandleFunc("/handlers/", post(s.pauseOrResume))
+
+	return &http.Server{Addr: addr, Handler: mux}
+}
+
+func (s server) status(*http.Request) (interface{}, error) {
+	return Status{
+		BlockHeight: s.backend.BlockHeight(),
+		Broadcaster: s.backend.Broadcaster(),
+		Sessions:    s.backend.Sessions.ActiveSessions(),
+		Chains:      s.backend.Chains(),
+		Handlers:    s.backend.Handlers.Status(),
+	}, nil
+}
+
+func (s server) sessions(*http.Request) (interface{}, error) {
+	return s.backend.Sessions.ActiveSessions(), nil
+}
+
+func (s server) chains(*http.Request) (interface{}, error) {
+	return s.backend.Chains(), nil
+}
+
+func (s server) handlers(*http.Request) (interface{}, error) {
+	return s.backend.Handlers.Status(), nil
+}
+
+func (s server) abortSession(r *http.Request) (interface{}, error) {
+	id, action, err := parsePath(r.URL.Path, "/sessions/")
+	if err != nil {
+		return nil, err
+	}
+
+	if action != "abort" {
+		return nil, errNotFound
+	}
+
+	s.logger.Info(fmt.Sprintf("operator requested to abort session %s", id))
+	return nil, s.backend.Sessions.AbortSession(id)
+}
+
+func (s server) pauseOrResume(r *http.Request) (interface{}, error) {
+	name, action, err := parsePath(r.URL.Path, "/handlers/")
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case "pause":
+		err = s.backend.Handlers.Pause(name)
+	case "resume":
+		err = s.backend.Handlers.Resume(name)
+	default:
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(fmt.Sprintf("operator requested to %s handler %s", action, name))
+	return nil, nil
+}
+
+var errNotFound = fmt.Errorf("not found")
+
+// parsePath splits paths of the form {prefix}{target}/{action}
+func parsePath(path string, prefix string) (target string, action string, err error) {
+	parts := strings.Split(strings.TrimPrefix(path, prefix), "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", errNotFound
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func get(f func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return handle(http.MethodGet, f)
+}
+
+func post(f func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return handle(http.MethodPost, f)
+}
+
+func handle(method string, f func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+			return
+		}
+
+		result, err := f(r)
+		switch {
+		case err == errNotFound:
+			writeJSON(w, http.StatusNotFound, errorResponse{Error: err.Error()})
+		case err != nil:
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		case result == nil:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeJSON(w, http.StatusOK, result)
+		}
+	}
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	// the status is already sent, so there is nothing left to do if encoding fails
+	_ = json.NewEncoder(w).Encode(v)
+}
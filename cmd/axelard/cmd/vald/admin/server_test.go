@@ -0,0 +1,180 @@
+package admin_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/admin"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+)
+
+type sessionManager struct {
+	sessions []tss.SessionInfo
+	aborted  []string
+}
+
+func (m *sessionManager) ActiveSessions() []tss.SessionInfo { return m.sessions }
+
+func (m *sessionManager) AbortSession(id string) error {
+	for _, session := range m.sessions {
+		if session.ID == id {
+			m.aborted = append(m.aborted, id)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown session")
+}
+
+func TestHandlers(t *testing.T) {
+	t.Run("should hold back events while paused", testutils.Func(func(t *testing.T) {
+		handlers := admin.NewHandlers()
+		name := rand.StrBetween(5, 20)
+
+		mu := sync.Mutex{}
+		processed := 0
+		handle := handlers.Wrap(name, func(tmEvents.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			processed++
+			return nil
+		})
+
+		assert.NoError(t, handlers.Pause(name))
+		assert.Equal(t, []admin.HandlerStatus{{Name: name, Paused: true}}, handlers.Status())
+
+		count := int(rand.I64Between(1, 20))
+		wg := &sync.WaitGroup{}
+		for i := 0; i < count; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, handle(tmEvents.Event{}))
+			}()
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		assert.Equal(t, 0, processed)
+		mu.Unlock()
+
+		assert.NoError(t, handlers.Resume(name))
+		wg.Wait()
+		assert.Equal(t, count, processed)
+		assert.Equal(t, []admin.HandlerStatus{{Name: name, Paused: false}}, handlers.Status())
+	}).Repeat(20))
+
+	t.Run("should reject unknown handlers", testutils.Func(func(t *testing.T) {
+		handlers := admin.NewHandlers()
+
+		assert.Error(t, handlers.Pause(rand.Str(10)))
+		assert.Error(t, handlers.Resume(rand.Str(10)))
+	}).Repeat(20))
+}
+
+func TestServer(t *testing.T) {
+	var (
+		server   *httptest.Server
+		sessions *sessionManager
+		handlers *admin.Handlers
+		height   int64
+	)
+
+	setup := func() {
+		sessions = &sessionManager{}
+		for i := 0; i < int(rand.I64Between(0, 10)); i++ {
+			sessions.sessions = append(sessions.sessions, tss.SessionInfo{ID: rand.Str(20), Type: tss.SessionSign, TimeoutAt: rand.PosI64()})
+		}
+
+		handlers = admin.NewHandlers()
+		handlers.Wrap("ProcessSignStart", func(tmEvents.Event) error { return nil })
+
+		height = rand.PosI64()
+		backend := admin.Backend{
+			Sessions:    sessions,
+			Handlers:    handlers,
+			Broadcaster: func() admin.BroadcasterStatus { return admin.BroadcasterStatus{Backlog: 1, Pipeline: 2} },
+			BlockHeight: func() int64 { return height },
+			Chains: func() []admin.ChainStatus {
+				return []admin.ChainStatus{{Name: "ethereum", Endpoints: []admin.EndpointStatus{{Index: 0, Healthy: true}}}}
+			},
+		}
+
+		server = httptest.NewServer(admin.NewServer("", backend, log.TestingLogger()).Handler)
+	}
+
+	t.Run("should return the status", testutils.Func(func(t *testing.T) {
+		setup()
+		defer server.Close()
+
+		res, err := http.Get(server.URL + "/status")
+		assert.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		var status admin.Status
+		assert.NoError(t, json.NewDecoder(res.Body).Decode(&status))
+		assert.Equal(t, height, status.BlockHeight)
+		assert.Equal(t, admin.BroadcasterStatus{Backlog: 1, Pipeline: 2}, status.Broadcaster)
+		assert.Equal(t, len(sessions.sessions), len(status.Sessions))
+		assert.Len(t, status.Chains, 1)
+		assert.Equal(t, handlers.Status(), status.Handlers)
+	}).Repeat(20))
+
+	t.Run("should abort sessions", testutils.Func(func(t *testing.T) {
+		setup()
+		defer server.Close()
+
+		sessions.sessions = append(sessions.sessions, tss.SessionInfo{ID: rand.Str(20), Type: tss.SessionKeygen})
+		id := sessions.sessions[len(sessions.sessions)-1].ID
+
+		res, err := http.Post(fmt.Sprintf("%s/sessions/%s/abort", server.URL, id), "", nil)
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+		assert.Equal(t, []string{id}, sessions.aborted)
+
+		res, err = http.Post(fmt.Sprintf("%s/sessions/%s/abort", server.URL, rand.Str(21)), "", nil)
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		res, err = http.Get(fmt.Sprintf("%s/sessions/%s/abort", server.URL, id))
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+	}).Repeat(20))
+
+	t.Run("should pause and resume handlers", testutils.Func(func(t *testing.T) {
+		setup()
+		defer server.Close()
+
+		res, err := http.Post(server.URL+"/handlers/ProcessSignStart/pause", "", nil)
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+		assert.True(t, handlers.Status()[0].Paused)
+
+		res, err = http.Post(server.URL+"/handlers/ProcessSignStart/resume", "", nil)
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+		assert.False(t, handlers.Status()[0].Paused)
+
+		res, err = http.Post(server.URL+"/handlers/ProcessSignStart/stop", "", nil)
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	}).Repeat(20))
+}
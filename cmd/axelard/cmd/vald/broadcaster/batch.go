@@ -0,0 +1,249 @@
+package broadcaster
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+)
+
+type broadcastTask struct {
+	ctx        sdkClient.Context
+	msgs       []sdk.Msg
+	size       int
+	refundable bool
+	callback   chan<- broadcastResult
+}
+
+type broadcastResult struct {
+	response *sdk.TxResponse
+	err      error
+}
+
+// BatchedBroadcaster coalesces the messages of concurrent broadcast calls into multi-message transactions
+type BatchedBroadcaster struct {
+	broadcaster   types.Broadcaster
+	limitsLock    sync.RWMutex
+	maxMsgCount   int
+	maxBatchBytes int
+	backlog       chan broadcastTask
+	logger        log.Logger
+}
+
+// NewBatchedBroadcaster returns a broadcaster that bundles queued messages into a single transaction as long as
+// the bundle stays within the given message count and byte size. A batch that fails is broadcast again call by call,
+// so a single rejected message does not prevent the others from being included. Each call receives a response that only
+// contains the data and logs of its own messages. Refundable and non-refundable calls never share a transaction.
+// A message count of 1 disables batching
+func NewBatchedBroadcaster(broadcaster types.Broadcaster, maxMsgCount int, maxBatchBytes int, logger log.Logger) *BatchedBroadcaster {
+	b := &BatchedBroadcaster{
+		broadcaster:   broadcaster,
+		maxMsgCount:   maxMsgCount,
+		maxBatchBytes: maxBatchBytes,
+		backlog:       make(chan broadcastTask, 10000),
+		logger:        logger,
+	}
+
+	go b.process()
+
+	return b
+}
+
+// Broadcast queues the passed messages to be sent to the network and waits for the result. This function in thread-safe.
+func (b *BatchedBroadcaster) Broadcast(ctx sdkClient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("call broadcast with at least one message")
+	}
+
+	size := 0
+	refundable := true
+	for _, msg := range msgs {
+		size += proto.Size(msg)
+		if _, ok := msg.(*axelarnet.RefundMsgRequest); !ok {
+			refundable = false
+		}
+	}
+
+	callback := make(chan broadcastResult, 1)
+	b.backlog <- broadcastTask{ctx: ctx, msgs: msgs, size: size, refundable: refundable, callback: callback}
+
+	result := <-callback
+	return result.response, result.err
+}
+
+// Len returns the number of broadcast calls waiting to be batched
+func (b *BatchedBroadcaster) Len() int {
+	return len(b.backlog)
+}
+
+// SetLimits changes the maximum message count and byte size of future batches
+func (b *BatchedBroadcaster) SetLimits(maxMsgCount int, maxBatchBytes int) {
+	b.limitsLock.Lock()
+	defer b.limitsLock.Unlock()
+
+	b.maxMsgCount = maxMsgCount
+	b.maxBatchBytes = maxBatchBytes
+}
+
+// Close stops processing the queued messages once the backlog is drained
+func (b *BatchedBroadcaster) Close() {
+	close(b.backlog)
+}
+
+func (b *BatchedBroadcaster) process() {
+	var next *broadcastTask
+	for {
+		if next == nil {
+			task, ok := <-b.backlog
+			if !ok {
+				return
+			}
+			next = &task
+		}
+
+		batch := []broadcastTask{*next}
+		next = nil
+		msgCount := len(batch[0].msgs)
+		size := batch[0].size
+
+		// only take what is already queued, so a lone message is broadcast without delay
+	collect:
+		for {
+			select {
+			case task, ok := <-b.backlog:
+				if !ok {
+					break collect
+				}
+
+				if !b.fitsBatch(batch[0], task, msgCount, size) {
+					next = &task
+					break collect
+				}
+
+				batch = append(batch, task)
+				msgCount += len(task.msgs)
+				size += task.size
+			default:
+				break collect
+			}
+		}
+
+		b.broadcastBatch(batch)
+	}
+}
+
+func (b *BatchedBroadcaster) fitsBatch(first broadcastTask, task broadcastTask, msgCount int, size int) bool {
+	b.limitsLock.RLock()
+	defer b.limitsLock.RUnlock()
+
+	return msgCount+len(task.msgs) <= b.maxMsgCount &&
+		size+task.size <= b.maxBatchBytes &&
+		// the fee of a batch is split between its messages, non-refundable messages would dilute the refunds
+		first.refundable == task.refundable &&
+		broadcastsAlike(first.ctx, task.ctx)
+}
+
+// broadcastsAlike returns true if a transaction broadcast with either context is signed and sent the same way,
+// so the batch can be broadcast with the context of any of its calls
+func broadcastsAlike(ctx1 sdkClient.Context, ctx2 sdkClient.Context) bool {
+	return ctx1.BroadcastMode == ctx2.BroadcastMode &&
+		ctx1.GetFromAddress().Equals(ctx2.GetFromAddress()) &&
+		ctx1.GetFromName() == ctx2.GetFromName() &&
+		ctx1.GetFeeGranterAddress().Equals(ctx2.GetFeeGranterAddress()) &&
+		ctx1.ChainID == ctx2.ChainID &&
+		ctx1.NodeURI == ctx2.NodeURI &&
+		ctx1.Simulate == ctx2.Simulate &&
+		ctx1.GenerateOnly == ctx2.GenerateOnly &&
+		ctx1.Offline == ctx2.Offline
+}
+
+func (b *BatchedBroadcaster) broadcastBatch(batch []broadcastTask) {
+	if len(batch) == 1 {
+		response, err := b.broadcaster.Broadcast(batch[0].ctx, batch[0].msgs...)
+		batch[0].callback <- broadcastResult{response: response, err: err}
+		return
+	}
+
+	var msgs []sdk.Msg
+	for _, task := range batch {
+		msgs = append(msgs, task.msgs...)
+	}
+
+	response, err := b.broadcaster.Broadcast(batch[0].ctx, msgs...)
+	if err == nil {
+		b.logger.Debug(fmt.Sprintf("broadcast batch of %d messages from %d calls", len(msgs), len(batch)))
+
+		msgIndex := 0
+		for _, task := range batch {
+			// every call only gets to see the results of its own messages
+			response, err := splitResponse(response, msgIndex, len(task.msgs))
+			task.callback <- broadcastResult{response: response, err: err}
+			msgIndex += len(task.msgs)
+		}
+		return
+	}
+
+	b.logger.Info(fmt.Sprintf("batch of %d messages failed, broadcasting calls individually: %s", len(msgs), err.Error()))
+	for _, task := range batch {
+		response, err := b.broadcaster.Broadcast(task.ctx, task.msgs...)
+		task.callback <- broadcastResult{response: response, err: err}
+	}
+}
+
+// splitResponse returns a copy of the given batch response that only contains the message data and logs
+// of the msgCount messages starting at msgIndex
+func splitResponse(response *sdk.TxResponse, msgIndex int, msgCount int) (*sdk.TxResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+
+	split := *response
+
+	// responses of transactions that are not included in a block yet carry no message data
+	if response.Data != "" {
+		bz, err := hex.DecodeString(response.Data)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to decode batch response data")
+		}
+
+		var txMsgData sdk.TxMsgData
+		if err := proto.Unmarshal(bz, &txMsgData); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to unmarshal batch response data")
+		}
+
+		if len(txMsgData.Data) < msgIndex+msgCount {
+			return nil, fmt.Errorf("batch response contains data for %d messages, expected at least %d", len(txMsgData.Data), msgIndex+msgCount)
+		}
+
+		bz, err = proto.Marshal(&sdk.TxMsgData{Data: txMsgData.Data[msgIndex : msgIndex+msgCount]})
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to marshal split response data")
+		}
+		split.Data = tmbytes.HexBytes(bz).String()
+	}
+
+	if len(response.Logs) > 0 {
+		var logs sdk.ABCIMessageLogs
+		for _, msgLog := range response.Logs {
+			if int(msgLog.MsgIndex) < msgIndex || int(msgLog.MsgIndex) >= msgIndex+msgCount {
+				continue
+			}
+
+			msgLog.MsgIndex -= uint32(msgIndex)
+			logs = append(logs, msgLog)
+		}
+		split.Logs = logs
+		split.RawLog = logs.String()
+	}
+
+	return &split, nil
+}
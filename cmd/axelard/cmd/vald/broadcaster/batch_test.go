@@ -0,0 +1,243 @@
+package broadcaster
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+
+	mock2 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+)
+
+func TestBatchedBroadcaster(t *testing.T) {
+	var (
+		inner   *mock2.BroadcasterMock
+		release chan struct{}
+		ctx     client.Context
+		newMsg  func(call int) sdk.Msg
+	)
+
+	setupBatched := func(maxMsgCount int, fail func(msgs []sdk.Msg) bool) *BatchedBroadcaster {
+		release = make(chan struct{})
+		ctx = client.Context{BroadcastMode: flags.BroadcastBlock, FromAddress: rand.AccAddr()}
+		newMsg = func(int) sdk.Msg { return createMsgsWithRandomSigner()[0] }
+
+		var once sync.Once
+		inner = &mock2.BroadcasterMock{
+			BroadcastFunc: func(_ client.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+				// block the first broadcast so the following calls queue up
+				once.Do(func() { <-release })
+
+				if fail(msgs) {
+					return nil, fmt.Errorf("some error")
+				}
+				return createResponse(msgs), nil
+			},
+		}
+
+		return NewBatchedBroadcaster(inner, maxMsgCount, 500*1024, log.TestingLogger())
+	}
+
+	// broadcastConcurrently sends one message per call while the first call is blocked and returns the responses and errors of all calls
+	broadcastConcurrently := func(b *BatchedBroadcaster, calls int, ctxs ...client.Context) ([]sdk.Msg, []*sdk.TxResponse, []error) {
+		msgs := make([]sdk.Msg, calls+1)
+		responses := make([]*sdk.TxResponse, calls+1)
+		errs := make([]error, calls+1)
+		wg := &sync.WaitGroup{}
+		wg.Add(calls + 1)
+
+		broadcast := func(i int) {
+			defer wg.Done()
+
+			callCtx := ctx
+			if len(ctxs) > 0 {
+				callCtx = ctxs[i%len(ctxs)]
+			}
+			msgs[i] = newMsg(i)
+			responses[i], errs[i] = b.Broadcast(callCtx, msgs[i])
+		}
+
+		go broadcast(0)
+		for len(inner.BroadcastCalls()) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		for i := 1; i <= calls; i++ {
+			go broadcast(i)
+		}
+		for len(b.backlog) < calls {
+			time.Sleep(time.Millisecond)
+		}
+
+		close(release)
+		wg.Wait()
+
+		return msgs, responses, errs
+	}
+
+	repeats := 20
+
+	t.Run("should bundle queued calls into one transaction", testutils.Func(func(t *testing.T) {
+		maxMsgCount := int(rand.I64Between(2, 50))
+		b := setupBatched(maxMsgCount, func([]sdk.Msg) bool { return false })
+		defer b.Close()
+
+		calls := int(rand.I64Between(1, int64(maxMsgCount)+1))
+		_, _, errs := broadcastConcurrently(b, calls)
+		for _, err := range errs {
+			assert.NoError(t, err)
+		}
+
+		assert.Len(t, inner.BroadcastCalls(), 2)
+		assert.Len(t, inner.BroadcastCalls()[1].Msgs, calls)
+	}).Repeat(repeats))
+
+	t.Run("should respect the max message count", testutils.Func(func(t *testing.T) {
+		maxMsgCount := int(rand.I64Between(2, 10))
+		b := setupBatched(maxMsgCount, func([]sdk.Msg) bool { return false })
+		defer b.Close()
+
+		calls := int(rand.I64Between(int64(maxMsgCount)+1, 100))
+		_, _, errs := broadcastConcurrently(b, calls)
+		for _, err := range errs {
+			assert.NoError(t, err)
+		}
+
+		msgCount := 0
+		for _, call := range inner.BroadcastCalls()[1:] {
+			assert.LessOrEqual(t, len(call.Msgs), maxMsgCount)
+			msgCount += len(call.Msgs)
+		}
+		assert.Equal(t, calls, msgCount)
+		assert.Len(t, inner.BroadcastCalls(), 1+(calls+maxMsgCount-1)/maxMsgCount)
+	}).Repeat(repeats))
+
+	t.Run("should broadcast calls individually when a batch fails", testutils.Func(func(t *testing.T) {
+		maxMsgCount := int(rand.I64Between(2, 50))
+		b := setupBatched(maxMsgCount, func(msgs []sdk.Msg) bool { return len(msgs) > 1 })
+		defer b.Close()
+
+		calls := int(rand.I64Between(2, int64(maxMsgCount)+1))
+		_, _, errs := broadcastConcurrently(b, calls)
+		for _, err := range errs {
+			assert.NoError(t, err)
+		}
+
+		assert.Len(t, inner.BroadcastCalls(), 2+calls)
+	}).Repeat(repeats))
+
+	t.Run("should only return each call the results of its own messages", testutils.Func(func(t *testing.T) {
+		maxMsgCount := int(rand.I64Between(2, 50))
+		b := setupBatched(maxMsgCount, func([]sdk.Msg) bool { return false })
+		defer b.Close()
+
+		calls := int(rand.I64Between(1, int64(maxMsgCount)+1))
+		msgs, responses, errs := broadcastConcurrently(b, calls)
+
+		assert.Len(t, inner.BroadcastCalls(), 2)
+		for i, msg := range msgs {
+			assert.NoError(t, errs[i])
+
+			bz, err := hex.DecodeString(responses[i].Data)
+			assert.NoError(t, err)
+			var txMsgData sdk.TxMsgData
+			assert.NoError(t, proto.Unmarshal(bz, &txMsgData))
+
+			assert.Len(t, txMsgData.Data, 1)
+			assert.Equal(t, msg.GetSigners()[0].Bytes(), txMsgData.Data[0].Data)
+			assert.Len(t, responses[i].Logs, 1)
+			assert.Equal(t, uint32(0), responses[i].Logs[0].MsgIndex)
+			assert.Equal(t, msg.GetSigners()[0].String(), responses[i].Logs[0].Log)
+		}
+	}).Repeat(repeats))
+
+	t.Run("should not batch calls that would be broadcast differently", testutils.Func(func(t *testing.T) {
+		maxMsgCount := int(rand.I64Between(2, 50))
+		b := setupBatched(maxMsgCount, func([]sdk.Msg) bool { return false })
+		defer b.Close()
+
+		otherChainCtx := ctx
+		otherChainCtx.ChainID = rand.StrBetween(5, 10)
+		otherSenderCtx := ctx.WithFromName(rand.StrBetween(5, 10))
+
+		ctxs := []client.Context{ctx, otherChainCtx, otherSenderCtx}
+		calls := int(rand.I64Between(1, int64(maxMsgCount)+1))
+		msgs, _, errs := broadcastConcurrently(b, calls, ctxs...)
+		for _, err := range errs {
+			assert.NoError(t, err)
+		}
+
+		msgCtx := make(map[sdk.Msg]int)
+		for i, msg := range msgs {
+			msgCtx[msg] = i % len(ctxs)
+		}
+		for _, call := range inner.BroadcastCalls() {
+			for _, msg := range call.Msgs {
+				assert.Equal(t, msgCtx[call.Msgs[0]], msgCtx[msg])
+			}
+			assert.True(t, broadcastsAlike(ctxs[msgCtx[call.Msgs[0]]], call.Ctx))
+		}
+	}).Repeat(repeats))
+
+	t.Run("should not batch refundable and non-refundable calls", testutils.Func(func(t *testing.T) {
+		maxMsgCount := int(rand.I64Between(2, 50))
+		b := setupBatched(maxMsgCount, func([]sdk.Msg) bool { return false })
+		defer b.Close()
+
+		// every other call sends its message without a refund request
+		newMsg = func(call int) sdk.Msg {
+			msg := createMsgsWithRandomSigner()[0]
+			if call%2 == 1 {
+				return msg.(*axelarnet.RefundMsgRequest).GetInnerMessage()
+			}
+			return msg
+		}
+
+		calls := int(rand.I64Between(2, int64(maxMsgCount)+1))
+		_, _, errs := broadcastConcurrently(b, calls)
+		for _, err := range errs {
+			assert.NoError(t, err)
+		}
+
+		msgCount := 0
+		for _, call := range inner.BroadcastCalls() {
+			_, refundable := call.Msgs[0].(*axelarnet.RefundMsgRequest)
+			for _, msg := range call.Msgs {
+				_, ok := msg.(*axelarnet.RefundMsgRequest)
+				assert.Equal(t, refundable, ok)
+			}
+			msgCount += len(call.Msgs)
+		}
+		assert.Equal(t, calls+1, msgCount)
+		assert.Greater(t, len(inner.BroadcastCalls()), 2)
+	}).Repeat(repeats))
+}
+
+// createResponse returns a response with one message result and log per message, both identify the message by its signer
+func createResponse(msgs []sdk.Msg) *sdk.TxResponse {
+	var txMsgData sdk.TxMsgData
+	var logs sdk.ABCIMessageLogs
+	for i, msg := range msgs {
+		txMsgData.Data = append(txMsgData.Data, &sdk.MsgData{MsgType: sdk.MsgTypeURL(msg), Data: msg.GetSigners()[0]})
+		logs = append(logs, sdk.NewABCIMessageLog(uint32(i), msg.GetSigners()[0].String(), nil))
+	}
+
+	bz, err := proto.Marshal(&txMsgData)
+	if err != nil {
+		panic(err)
+	}
+
+	return &sdk.TxResponse{Data: tmbytes.HexBytes(bz).String(), Logs: logs, RawLog: logs.String()}
+}
@@ -2,6 +2,8 @@ package broadcaster
 
 import (
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	sdkClient "github.com/cosmos/cosmos-sdk/client"
@@ -12,6 +14,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/metrics"
 	"github.com/axelarnetwork/axelar-core/utils"
 )
 
@@ -146,21 +149,36 @@ func Broadcast(ctx sdkClient.Context, txf tx.Factory, msgs []sdk.Msg) (*sdk.TxRe
 // RetryPipeline manages serialized execution of functions with retry on error
 type RetryPipeline struct {
 	c          chan func()
+	pending    int64
+	lock       sync.RWMutex
 	backOff    utils.BackOff
 	maxRetries int
 	logger     log.Logger
 }
 
 // Push adds the given function to the serialized execution pipeline
-func (p RetryPipeline) Push(f func() error) error {
+func (p *RetryPipeline) Push(f func() error) error {
 	e := make(chan error, 1)
-	p.c <- func() { e <- p.retry(f) }
+	metrics.AddBroadcastQueueDepth(1)
+	atomic.AddInt64(&p.pending, 1)
+	p.c <- func() {
+		metrics.AddBroadcastQueueDepth(-1)
+		e <- p.retry(f)
+		atomic.AddInt64(&p.pending, -1)
+	}
 	return <-e
 }
 
-func (p RetryPipeline) retry(f func() error) error {
+// Len returns the number of functions that are queued or currently executed
+func (p *RetryPipeline) Len() int {
+	return int(atomic.LoadInt64(&p.pending))
+}
+
+func (p *RetryPipeline) retry(f func() error) error {
+	maxRetries, backOff := p.retryPolicy()
+
 	var err error
-	for i := 0; i <= p.maxRetries; i++ {
+	for i := 0; i <= maxRetries; i++ {
 		err = f()
 		if err == nil {
 			if i > 0 {
@@ -169,17 +187,34 @@ func (p RetryPipeline) retry(f func() error) error {
 			return nil
 		}
 
-		if i < p.maxRetries {
-			timeout := p.backOff(i)
+		if i < maxRetries {
+			metrics.IncBroadcastRetries()
+			timeout := backOff(i)
 			p.logger.Info(sdkerrors.Wrapf(err, "backing off (retry in %v )", timeout).Error())
 			time.Sleep(timeout)
 		}
 	}
-	return sdkerrors.Wrap(err, fmt.Sprintf("aborting after %d retries", p.maxRetries))
+	return sdkerrors.Wrap(err, fmt.Sprintf("aborting after %d retries", maxRetries))
+}
+
+// SetRetryPolicy changes the retry behaviour of the pipeline. Functions that are already being retried keep their policy
+func (p *RetryPipeline) SetRetryPolicy(maxRetries int, backOffStrategy utils.BackOff) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.maxRetries = maxRetries
+	p.backOff = backOffStrategy
+}
+
+func (p *RetryPipeline) retryPolicy() (int, utils.BackOff) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.maxRetries, p.backOff
 }
 
 // Close closes the pipeline
-func (p RetryPipeline) Close() {
+func (p *RetryPipeline) Close() {
 	close(p.c)
 }
 
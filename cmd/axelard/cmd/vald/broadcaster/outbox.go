@@ -0,0 +1,318 @@
+package broadcaster
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
+)
+
+const (
+	entryExt = ".json"
+	tmpExt   = ".tmp"
+)
+
+// Reference kinds of outgoing messages
+const (
+	ReferencePoll    = "poll"
+	ReferenceSession = "session"
+	// a report that starts the poll it references
+	ReferenceReport  = "report"
+	ReferenceFeeRate = "fee_rate"
+)
+
+// Reference identifies the poll, tss session or fee rate an outgoing message belongs to. A zero reference means the message is not tied to any
+type Reference struct {
+	Kind   string `json:"kind,omitempty"`
+	Module string `json:"module,omitempty"`
+	ID     string `json:"id,omitempty"`
+}
+
+// OutboxEntry is a persisted broadcast call that has not finished yet
+type OutboxEntry struct {
+	Seq           uint64            `json:"seq"`
+	Reference     Reference         `json:"reference"`
+	BroadcastMode string            `json:"broadcast_mode"`
+	Msgs          []json.RawMessage `json:"msgs"`
+	CreatedAt     time.Time         `json:"created_at"`
+}
+
+// Outbox persists pending outgoing messages in a directory, one file per broadcast call.
+// Files are written to a temporary location first and then renamed, so a crash never leaves a partial entry behind
+type Outbox struct {
+	dir string
+	cdc codec.Codec
+	mu  sync.Mutex
+	// entries with a lower sequence number than firstSeq were left over by a previous run
+	firstSeq uint64
+	nextSeq  uint64
+}
+
+// NewOutbox returns an outbox that stores its entries in the given directory, creating it if necessary
+func NewOutbox(dir string, cdc codec.Codec) (*Outbox, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, sdkerrors.Wrap(err, "could not create outbox directory")
+	}
+
+	// temporary files belong to entries that were never completely written
+	tmpFiles, err := filepath.Glob(filepath.Join(dir, "*"+tmpExt))
+	if err != nil {
+		return nil, err
+	}
+	for _, tmpFile := range tmpFiles {
+		if err := os.Remove(tmpFile); err != nil {
+			return nil, sdkerrors.Wrap(err, "could not clean up outbox directory")
+		}
+	}
+
+	o := &Outbox{dir: dir, cdc: cdc}
+
+	seqs, err := o.seqs()
+	if err != nil {
+		return nil, err
+	}
+	if len(seqs) > 0 {
+		o.nextSeq = seqs[len(seqs)-1] + 1
+	}
+	o.firstSeq = o.nextSeq
+
+	return o, nil
+}
+
+// Add persists the given messages and returns the sequence number of the new entry
+func (o *Outbox) Add(ref Reference, mode string, msgs []sdk.Msg) (uint64, error) {
+	entry := OutboxEntry{Reference: ref, BroadcastMode: mode, CreatedAt: time.Now()}
+	for _, msg := range msgs {
+		bz, err := o.cdc.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return 0, sdkerrors.Wrap(err, "could not encode message")
+		}
+		entry.Msgs = append(entry.Msgs, bz)
+	}
+
+	o.mu.Lock()
+	entry.Seq = o.nextSeq
+	o.nextSeq++
+	o.mu.Unlock()
+
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return 0, err
+	}
+
+	tmpPath := o.path(entry.Seq) + tmpExt
+	if err := writeSynced(tmpPath, bz); err != nil {
+		return 0, sdkerrors.Wrap(err, "could not write outbox entry")
+	}
+
+	if err := os.Rename(tmpPath, o.path(entry.Seq)); err != nil {
+		return 0, sdkerrors.Wrap(err, "could not write outbox entry")
+	}
+
+	return entry.Seq, nil
+}
+
+// Remove deletes the entry with the given sequence number
+func (o *Outbox) Remove(seq uint64) error {
+	if err := os.Remove(o.path(seq)); err != nil && !os.IsNotExist(err) {
+		return sdkerrors.Wrapf(err, "could not remove outbox entry %d", seq)
+	}
+
+	return nil
+}
+
+// Entries returns all persisted entries ordered by sequence number
+func (o *Outbox) Entries() ([]OutboxEntry, error) {
+	seqs, err := o.seqs()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]OutboxEntry, 0, len(seqs))
+	for _, seq := range seqs {
+		bz, err := os.ReadFile(o.path(seq))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "could not read outbox entry %d", seq)
+		}
+
+		var entry OutboxEntry
+		if err := json.Unmarshal(bz, &entry); err != nil {
+			return nil, sdkerrors.Wrapf(err, "outbox entry %d is in unexpected format", seq)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// Msgs decodes the messages of the given entry
+func (o *Outbox) Msgs(entry OutboxEntry) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(entry.Msgs))
+	for _, bz := range entry.Msgs {
+		var msg sdk.Msg
+		if err := o.cdc.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+			return nil, sdkerrors.Wrapf(err, "could not decode message of outbox entry %d", entry.Seq)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+func (o *Outbox) seqs() ([]uint64, error) {
+	files, err := os.ReadDir(o.dir)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not read outbox directory")
+	}
+
+	var seqs []uint64
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, entryExt) {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, entryExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs, nil
+}
+
+func (o *Outbox) path(seq uint64) string {
+	return filepath.Join(o.dir, fmt.Sprintf("%020d%s", seq, entryExt))
+}
+
+func writeSynced(path string, bz []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(bz); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// PersistentBroadcaster records every broadcast call in an outbox until the underlying broadcaster is done with it,
+// so messages that are queued or being retried when vald stops can be replayed on the next start
+type PersistentBroadcaster struct {
+	broadcaster types.Broadcaster
+	outbox      *Outbox
+	reference   func(msgs []sdk.Msg) Reference
+	logger      log.Logger
+}
+
+// NewPersistentBroadcaster returns a broadcaster that persists calls to the given outbox. The reference function
+// determines which poll or session the messages of a call belong to
+func NewPersistentBroadcaster(broadcaster types.Broadcaster, outbox *Outbox, reference func(msgs []sdk.Msg) Reference, logger log.Logger) *PersistentBroadcaster {
+	return &PersistentBroadcaster{
+		broadcaster: broadcaster,
+		outbox:      outbox,
+		reference:   reference,
+		logger:      logger,
+	}
+}
+
+// Broadcast persists the passed messages, sends them to the network and removes them from the outbox once the
+// underlying broadcaster has succeeded or given up. This function in thread-safe.
+func (b *PersistentBroadcaster) Broadcast(ctx sdkClient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	seq, err := b.outbox.Add(b.reference(msgs), ctx.BroadcastMode, msgs)
+	if err != nil {
+		// losing crash safety must not keep the messages from being sent
+		b.logger.Error(sdkerrors.Wrap(err, "failed to persist outgoing messages").Error())
+		return b.broadcaster.Broadcast(ctx, msgs...)
+	}
+
+	return b.broadcast(ctx, seq, msgs)
+}
+
+// Replay broadcasts all entries left over from a previous run, calls made in the meantime are not affected.
+// Entries for which isRelevant returns false are dropped without being sent.
+// The entries are broadcast concurrently, so Replay only returns once all of them are done
+func (b *PersistentBroadcaster) Replay(ctx sdkClient.Context, isRelevant func(entry OutboxEntry) (bool, error)) error {
+	entries, err := b.outbox.Entries()
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	for _, entry := range entries {
+		if entry.Seq >= b.outbox.firstSeq {
+			break
+		}
+
+		relevant, err := isRelevant(entry)
+		if err != nil {
+			b.logger.Error(sdkerrors.Wrapf(err, "could not determine relevance of outbox entry %d, replaying it", entry.Seq).Error())
+			relevant = true
+		}
+
+		if !relevant {
+			b.logger.Debug(fmt.Sprintf("dropping outbox entry %d for %s %s of module %s", entry.Seq, entry.Reference.Kind, entry.Reference.ID, entry.Reference.Module))
+			if err := b.outbox.Remove(entry.Seq); err != nil {
+				return err
+			}
+			continue
+		}
+
+		msgs, err := b.outbox.Msgs(entry)
+		if err != nil {
+			b.logger.Error(sdkerrors.Wrap(err, "dropping outbox entry").Error())
+			if err := b.outbox.Remove(entry.Seq); err != nil {
+				return err
+			}
+			continue
+		}
+
+		b.logger.Info(fmt.Sprintf("replaying outbox entry %d with %d message(s)", entry.Seq, len(msgs)))
+
+		wg.Add(1)
+		go func(entry OutboxEntry) {
+			defer wg.Done()
+
+			if _, err := b.broadcast(ctx.WithBroadcastMode(entry.BroadcastMode), entry.Seq, msgs); err != nil {
+				b.logger.Error(sdkerrors.Wrapf(err, "failed to replay outbox entry %d", entry.Seq).Error())
+			}
+		}(entry)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func (b *PersistentBroadcaster) broadcast(ctx sdkClient.Context, seq uint64, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	response, err := b.broadcaster.Broadcast(ctx, msgs...)
+
+	if removeErr := b.outbox.Remove(seq); removeErr != nil {
+		b.logger.Error(removeErr.Error())
+	}
+
+	return response, err
+}
@@ -0,0 +1,180 @@
+package broadcaster
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	mock2 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+)
+
+func TestOutbox(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	repeats := 20
+
+	t.Run("should restore persisted messages", testutils.Func(func(t *testing.T) {
+		outbox, err := NewOutbox(t.TempDir(), cdc)
+		assert.NoError(t, err)
+
+		msgs := createMsgsWithRandomSigner()
+		ref := Reference{Kind: ReferencePoll, Module: rand.StrBetween(5, 10), ID: rand.StrBetween(5, 20)}
+		seq, err := outbox.Add(ref, flags.BroadcastBlock, msgs)
+		assert.NoError(t, err)
+
+		entries, err := outbox.Entries()
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, seq, entries[0].Seq)
+		assert.Equal(t, ref, entries[0].Reference)
+		assert.Equal(t, flags.BroadcastBlock, entries[0].BroadcastMode)
+
+		actual, err := outbox.Msgs(entries[0])
+		assert.NoError(t, err)
+		assert.Equal(t, msgs, actual)
+
+		assert.NoError(t, outbox.Remove(seq))
+		entries, err = outbox.Entries()
+		assert.NoError(t, err)
+		assert.Len(t, entries, 0)
+	}).Repeat(repeats))
+
+	t.Run("should continue the sequence after restart", testutils.Func(func(t *testing.T) {
+		dir := t.TempDir()
+		outbox, err := NewOutbox(dir, cdc)
+		assert.NoError(t, err)
+
+		count := int(rand.I64Between(1, 20))
+		for i := 0; i < count; i++ {
+			_, err := outbox.Add(Reference{}, flags.BroadcastSync, createMsgsWithRandomSigner())
+			assert.NoError(t, err)
+		}
+
+		// an entry that was not completely written before the crash
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "garbage"+tmpExt), rand.Bytes(10), 0600))
+
+		restarted, err := NewOutbox(dir, cdc)
+		assert.NoError(t, err)
+
+		seq, err := restarted.Add(Reference{}, flags.BroadcastSync, createMsgsWithRandomSigner())
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(count), seq)
+
+		entries, err := restarted.Entries()
+		assert.NoError(t, err)
+		assert.Len(t, entries, count+1)
+		for i, entry := range entries {
+			assert.Equal(t, uint64(i), entry.Seq)
+		}
+
+		tmpFiles, err := filepath.Glob(filepath.Join(dir, "*"+tmpExt))
+		assert.NoError(t, err)
+		assert.Len(t, tmpFiles, 0)
+	}).Repeat(repeats))
+}
+
+func TestPersistentBroadcaster(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	ctx := client.Context{BroadcastMode: flags.BroadcastBlock}
+	noReference := func([]sdk.Msg) Reference { return Reference{} }
+	repeats := 20
+
+	t.Run("should remove messages from the outbox once they are broadcast", testutils.Func(func(t *testing.T) {
+		outbox, err := NewOutbox(t.TempDir(), cdc)
+		assert.NoError(t, err)
+
+		inner := &mock2.BroadcasterMock{
+			BroadcastFunc: func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+				// the messages are persisted while the broadcast is in progress
+				entries, err := outbox.Entries()
+				assert.NoError(t, err)
+				assert.Len(t, entries, 1)
+
+				if rand.Bools(0.5).Next() {
+					return nil, fmt.Errorf("some error")
+				}
+				return &sdk.TxResponse{}, nil
+			},
+		}
+		b := NewPersistentBroadcaster(inner, outbox, noReference, log.TestingLogger())
+
+		_, _ = b.Broadcast(ctx, createMsgsWithRandomSigner()...)
+
+		assert.Len(t, inner.BroadcastCalls(), 1)
+		entries, err := outbox.Entries()
+		assert.NoError(t, err)
+		assert.Len(t, entries, 0)
+	}).Repeat(repeats))
+
+	t.Run("should replay relevant left over messages", testutils.Func(func(t *testing.T) {
+		dir := t.TempDir()
+		previous, err := NewOutbox(dir, cdc)
+		assert.NoError(t, err)
+
+		relevant := make(map[uint64]bool)
+		for i := 0; i < int(rand.I64Between(1, 20)); i++ {
+			seq, err := previous.Add(Reference{}, flags.BroadcastSync, createMsgsWithRandomSigner())
+			assert.NoError(t, err)
+			relevant[seq] = rand.Bools(0.5).Next()
+		}
+
+		outbox, err := NewOutbox(dir, cdc)
+		assert.NoError(t, err)
+
+		inner := &mock2.BroadcasterMock{
+			BroadcastFunc: func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+		b := NewPersistentBroadcaster(inner, outbox, noReference, log.TestingLogger())
+
+		// a call of the current run must not be replayed
+		newSeq, err := outbox.Add(Reference{}, flags.BroadcastSync, createMsgsWithRandomSigner())
+		assert.NoError(t, err)
+
+		err = b.Replay(ctx, func(entry OutboxEntry) (bool, error) {
+			assert.Less(t, entry.Seq, newSeq)
+			return relevant[entry.Seq], nil
+		})
+		assert.NoError(t, err)
+
+		expectedCalls := 0
+		for _, isRelevant := range relevant {
+			if isRelevant {
+				expectedCalls++
+			}
+		}
+		assert.Len(t, inner.BroadcastCalls(), expectedCalls)
+		for _, call := range inner.BroadcastCalls() {
+			assert.Equal(t, flags.BroadcastSync, call.Ctx.BroadcastMode)
+		}
+
+		entries, err := outbox.Entries()
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, newSeq, entries[0].Seq)
+	}).Repeat(repeats))
+
+	t.Run("should broadcast even if the outbox fails", testutils.Func(func(t *testing.T) {
+		dir := t.TempDir()
+		outbox, err := NewOutbox(dir, cdc)
+		assert.NoError(t, err)
+		assert.NoError(t, os.RemoveAll(dir))
+
+		inner := &mock2.BroadcasterMock{
+			BroadcastFunc: func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+		b := NewPersistentBroadcaster(inner, outbox, noReference, log.TestingLogger())
+
+		_, err = b.Broadcast(ctx, createMsgsWithRandomSigner()...)
+		assert.NoError(t, err)
+		assert.Len(t, inner.BroadcastCalls(), 1)
+	}).Repeat(repeats))
+}
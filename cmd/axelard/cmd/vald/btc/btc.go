@@ -3,18 +3,23 @@ package btc
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"sync"
 
 	sdkClient "github.com/cosmos/cosmos-sdk/client"
 	sdkFlags "github.com/cosmos/cosmos-sdk/client/flags"
 
 	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcutil"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
 	rpc3 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/parse"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
 	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
@@ -27,6 +32,7 @@ type Mgr struct {
 	logger      log.Logger
 	broadcaster types.Broadcaster
 	rpc         rpc3.Client
+	rpcLock     *sync.RWMutex
 	cdc         *codec.LegacyAmino
 }
 
@@ -34,6 +40,7 @@ type Mgr struct {
 func NewMgr(rpc rpc3.Client, cliCtx sdkClient.Context, broadcaster types.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *Mgr {
 	return &Mgr{
 		rpc:         rpc,
+		rpcLock:     &sync.RWMutex{},
 		cliCtx:      cliCtx,
 		logger:      logger.With("listener", "btc"),
 		broadcaster: broadcaster,
@@ -41,10 +48,50 @@ func NewMgr(rpc rpc3.Client, cliCtx sdkClient.Context, broadcaster types.Broadca
 	}
 }
 
+// SetRPC replaces the rpc client used to communicate with Bitcoin, a nil client disconnects the manager
+func (mgr *Mgr) SetRPC(rpc rpc3.Client) {
+	mgr.rpcLock.Lock()
+	defer mgr.rpcLock.Unlock()
+
+	mgr.rpc = rpc
+}
+
+// RPC returns the rpc client used to communicate with Bitcoin, nil if none is configured
+func (mgr *Mgr) RPC() rpc3.Client {
+	mgr.rpcLock.RLock()
+	defer mgr.rpcLock.RUnlock()
+
+	return mgr.rpc
+}
+
+// Name returns the name of the chain family the manager connects to
+func (mgr *Mgr) Name() string {
+	return "bitcoin"
+}
+
+// Subscriptions returns the bitcoin module events the manager handles
+func (mgr *Mgr) Subscriptions() []chain.Subscription {
+	return []chain.Subscription{
+		{Name: "ProcessConfirmation", EventType: btc.EventTypeOutpointConfirmation, Module: btc.ModuleName, Action: btc.AttributeValueStart, Handle: mgr.ProcessConfirmation},
+		{Name: "ProcessFeeRateVote", EventType: btc.EventTypeFeeRate, Module: btc.ModuleName, Action: btc.AttributeValueStart, EndBlock: true, Handle: mgr.ProcessFeeRateVote},
+	}
+}
+
+// Chains returns the status of Bitcoin if an rpc client is configured
+func (mgr *Mgr) Chains() []chain.Status {
+	rpc := mgr.RPC()
+	if rpc == nil {
+		return nil
+	}
+
+	return []chain.Status{chain.NewStatus(mgr.Name(), rpc)}
+}
+
 // ProcessConfirmation votes on the correctness of a Bitcoin deposit
 func (mgr *Mgr) ProcessConfirmation(e tmEvents.Event) error {
-	if mgr.rpc == nil {
-		mgr.logger.Error("no bitcoin rpc endpoint was provided during start-up, ignoring confirmation event")
+	rpc := mgr.RPC()
+	if rpc == nil {
+		mgr.logger.Error("no bitcoin rpc endpoint is configured, ignoring confirmation event")
 		return nil
 	}
 
@@ -53,7 +100,7 @@ func (mgr *Mgr) ProcessConfirmation(e tmEvents.Event) error {
 		return sdkerrors.Wrap(err, "Bitcoin transaction confirmation failed")
 	}
 
-	err = confirmTx(mgr.rpc, outPointInfo, confHeight)
+	err = confirmTx(rpc, outPointInfo, confHeight)
 	if err != nil {
 		mgr.logger.Debug(sdkerrors.Wrap(err, "tx outpoint confirmation failed").Error())
 	}
@@ -65,6 +112,90 @@ func (mgr *Mgr) ProcessConfirmation(e tmEvents.Event) error {
 	return err
 }
 
+// ProcessFeeRateVote votes on the fee rates the Bitcoin node estimates for the requested confirmation targets
+func (mgr *Mgr) ProcessFeeRateVote(e tmEvents.Event) error {
+	rpc := mgr.RPC()
+	if rpc == nil {
+		mgr.logger.Error("no bitcoin rpc endpoint is configured, ignoring fee rate vote event")
+		return nil
+	}
+
+	confTargets, err := parseFeeRateVoteParams(e.Attributes)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Bitcoin fee rate vote failed")
+	}
+
+	var msgs []sdk.Msg
+	for _, confTarget := range confTargets {
+		feeRate, err := estimateFeeRate(rpc, confTarget)
+		if err != nil {
+			// abstain instead of voting on a fee rate that cannot be estimated reliably
+			mgr.logger.Debug(sdkerrors.Wrapf(err, "fee rate estimation for confirmation target %d failed", confTarget).Error())
+			continue
+		}
+
+		msg := btc.NewVoteFeeRateRequest(mgr.cliCtx.FromAddress, confTarget, feeRate)
+		msgs = append(msgs, axelarnet.NewRefundMsgRequest(mgr.cliCtx.FromAddress, msg))
+
+		mgr.logger.Debug(fmt.Sprintf("broadcasting fee rate vote %s/kvB for confirmation target %d", feeRate.String(), confTarget))
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	_, err = mgr.broadcaster.Broadcast(mgr.cliCtx.WithBroadcastMode(sdkFlags.BroadcastBlock), msgs...)
+	return err
+}
+
+func parseFeeRateVoteParams(attributes map[string]string) ([]int64, error) {
+	parsers := []*parse.AttributeParser{
+		{Key: btc.AttributeKeyConfTargets, Map: func(s string) (interface{}, error) {
+			var confTargets []int64
+			for _, confTarget := range strings.Split(s, ",") {
+				target, err := strconv.ParseInt(confTarget, 10, 64)
+				if err != nil {
+					return nil, err
+				}
+
+				confTargets = append(confTargets, target)
+			}
+
+			return confTargets, nil
+		}},
+	}
+
+	results, err := parse.Parse(attributes, parsers)
+	if err != nil {
+		return nil, err
+	}
+
+	return results[0].([]int64), nil
+}
+
+func estimateFeeRate(rpc rpc3.Client, confTarget int64) (btcutil.Amount, error) {
+	result, err := rpc.EstimateSmartFee(confTarget, &btcjson.EstimateModeConservative)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "call to Bitcoin rpc failed")
+	}
+
+	if result.FeeRate == nil {
+		return 0, fmt.Errorf("no fee rate estimate available: %s", strings.Join(result.Errors, ", "))
+	}
+
+	// estimatesmartfee returns the fee rate in BTC per kilo virtual byte
+	feeRate, err := btcutil.NewAmount(*result.FeeRate)
+	if err != nil {
+		return 0, err
+	}
+
+	if feeRate <= 0 {
+		return 0, fmt.Errorf("invalid fee rate estimate %s", feeRate.String())
+	}
+
+	return feeRate, nil
+}
+
 func parseConfirmationParams(cdc *codec.LegacyAmino, attributes map[string]string) (outPoint btc.OutPointInfo, confHeight int64, pollKey vote.PollKey, err error) {
 	parsers := []*parse.AttributeParser{
 		{Key: btc.AttributeKeyOutPointInfo, Map: func(s string) (interface{}, error) {
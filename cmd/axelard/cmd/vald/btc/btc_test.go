@@ -3,6 +3,7 @@ package btc
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	tmEvents "github.com/axelarnetwork/tm-events/events"
@@ -18,6 +19,8 @@ import (
 	"github.com/axelarnetwork/axelar-core/app"
 	mock3 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
 	mock2 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain/chaintest"
 	"github.com/axelarnetwork/axelar-core/testutils"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
@@ -146,6 +149,127 @@ func TestMgr_ProcessConfirmation(t *testing.T) {
 	}).Repeat(repetitionCount))
 }
 
+func TestMgr_ProcessFeeRateVote(t *testing.T) {
+	var (
+		mgr         *Mgr
+		rpc         *mock2.ClientMock
+		broadcaster *mock3.BroadcasterMock
+		attributes  map[string]string
+		confTargets []int64
+	)
+
+	setup := func() {
+		cdc := app.MakeEncodingConfig().Amino
+		rpc = &mock2.ClientMock{}
+		broadcaster = &mock3.BroadcasterMock{
+			BroadcastFunc: func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+
+		mgr = NewMgr(rpc, client.Context{}, broadcaster, log.TestingLogger(), cdc)
+
+		confTargets = nil
+		var strs []string
+		for confTarget := rand.I64Between(1, 1000); len(confTargets) < 5; confTarget += rand.I64Between(1, 100) {
+			confTargets = append(confTargets, confTarget)
+			strs = append(strs, strconv.FormatInt(confTarget, 10))
+		}
+		attributes = map[string]string{btc.AttributeKeyConfTargets: strings.Join(strs, ",")}
+	}
+
+	repetitionCount := 20
+	t.Run("missing attributes", testutils.Func(func(t *testing.T) {
+		setup()
+
+		err := mgr.ProcessFeeRateVote(tmEvents.Event{Attributes: map[string]string{}})
+		assert.Error(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	}).Repeat(repetitionCount))
+
+	t.Run("no estimate available", testutils.Func(func(t *testing.T) {
+		setup()
+		rpc.EstimateSmartFeeFunc = func(int64, *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error) {
+			return &btcjson.EstimateSmartFeeResult{Errors: []string{"Insufficient data or no feerate found"}}, nil
+		}
+
+		err := mgr.ProcessFeeRateVote(tmEvents.Event{Attributes: attributes})
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	}).Repeat(repetitionCount))
+
+	t.Run("happy path", testutils.Func(func(t *testing.T) {
+		setup()
+		feeRates := make(map[int64]btcutil.Amount)
+		rpc.EstimateSmartFeeFunc = func(confTarget int64, _ *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error) {
+			feeRate := btcutil.Amount(rand.I64Between(1000, 100000))
+			feeRates[confTarget] = feeRate
+			btcPerKvB := feeRate.ToBTC()
+
+			return &btcjson.EstimateSmartFeeResult{FeeRate: &btcPerKvB, Blocks: confTarget}, nil
+		}
+
+		err := mgr.ProcessFeeRateVote(tmEvents.Event{Attributes: attributes})
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		assert.Len(t, broadcaster.BroadcastCalls()[0].Msgs, len(confTargets))
+
+		for _, msg := range broadcaster.BroadcastCalls()[0].Msgs {
+			vote := unwrapRefundMsg(msg).(*btc.VoteFeeRateRequest)
+			assert.Equal(t, feeRates[vote.ConfTarget], vote.FeeRate)
+		}
+	}).Repeat(repetitionCount))
+}
+
+func TestMgr_Adapter(t *testing.T) {
+	var (
+		h   *chaintest.Harness
+		mgr *Mgr
+		rpc *mock2.ClientMock
+	)
+
+	setup := func() {
+		h = chaintest.NewHarness()
+		rpc = &mock2.ClientMock{}
+		mgr = NewMgr(rpc, h.Ctx, h.Broadcaster, h.Logger, h.Cdc)
+	}
+
+	repetitionCount := 20
+	t.Run("should subscribe to all bitcoin events", testutils.Func(func(t *testing.T) {
+		setup()
+		chaintest.AssertSubscriptions(t, mgr)
+	}).Repeat(repetitionCount))
+
+	t.Run("should vote on outpoint confirmations", testutils.Func(func(t *testing.T) {
+		setup()
+		confHeight := rand.PosI64()
+		info := randomOutpointInfo()
+		rpc.GetTxOutFunc = func(*chainhash.Hash, uint32, bool) (*btcjson.GetTxOutResult, error) {
+			return &btcjson.GetTxOutResult{
+				Confirmations: confHeight,
+				Value:         info.Amount.ToBTC(),
+				ScriptPubKey:  btcjson.ScriptPubKeyResult{Addresses: []string{info.Address}},
+			}, nil
+		}
+
+		e := chaintest.NewEvent(btc.EventTypeOutpointConfirmation, btc.ModuleName, btc.AttributeValueStart, map[string]string{
+			btc.AttributeKeyConfHeight:   strconv.FormatInt(confHeight, 10),
+			btc.AttributeKeyOutPointInfo: string(h.Cdc.MustMarshalJSON(info)),
+			btc.AttributeKeyPoll:         string(h.Cdc.MustMarshalJSON(exported.NewPollKey(btc.ModuleName, rand.StrBetween(1, 100)))),
+		})
+
+		assert.NoError(t, chaintest.Deliver(mgr, e))
+		assert.Len(t, h.Msgs(), 1)
+		assert.True(t, h.Msgs()[0].(*btc.VoteConfirmOutpointRequest).Confirmed)
+	}).Repeat(repetitionCount))
+
+	t.Run("should only report Bitcoin when it is configured", testutils.Func(func(t *testing.T) {
+		setup()
+		assert.Equal(t, []chain.Status{{Name: "bitcoin"}}, mgr.Chains())
+
+		mgr.SetRPC(nil)
+		assert.Empty(t, mgr.Chains())
+	}).Repeat(repetitionCount))
+}
+
 func randomOutpointInfo() btc.OutPointInfo {
 	txHash, err := chainhash.NewHash(rand.Bytes(chainhash.HashSize))
 	if err != nil {
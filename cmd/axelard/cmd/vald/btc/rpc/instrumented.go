@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/metrics"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+)
+
+// InstrumentedClient records latencies and errors of all calls to the underlying client
+type InstrumentedClient struct {
+	client Client
+}
+
+// NewInstrumentedClient returns a client that records metrics for the calls to the given client
+func NewInstrumentedClient(client Client) InstrumentedClient {
+	return InstrumentedClient{client: client}
+}
+
+// GetTxOut returns the transaction output with the given index of the given transaction
+func (c InstrumentedClient) GetTxOut(txHash *chainhash.Hash, voutIdx uint32, mempool bool) (*btcjson.GetTxOutResult, error) {
+	start := time.Now()
+	result, err := c.client.GetTxOut(txHash, voutIdx, mempool)
+	metrics.ObserveRPC(exported.Bitcoin.Name, "GetTxOut", start, err)
+
+	return result, err
+}
+
+// SendRawTransaction submits the given transaction to the network
+func (c InstrumentedClient) SendRawTransaction(tx *wire.MsgTx, allowHighFees bool) (*chainhash.Hash, error) {
+	start := time.Now()
+	hash, err := c.client.SendRawTransaction(tx, allowHighFees)
+	metrics.ObserveRPC(exported.Bitcoin.Name, "SendRawTransaction", start, err)
+
+	return hash, err
+}
+
+// EstimateSmartFee estimates the fee rate needed for a transaction to confirm within the given number of blocks
+func (c InstrumentedClient) EstimateSmartFee(confTarget int64, mode *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error) {
+	start := time.Now()
+	result, err := c.client.EstimateSmartFee(confTarget, mode)
+	metrics.ObserveRPC(exported.Bitcoin.Name, "EstimateSmartFee", start, err)
+
+	return result, err
+}
+
+// GetBlockCount returns the number of blocks in the longest block chain
+func (c InstrumentedClient) GetBlockCount() (int64, error) {
+	start := time.Now()
+	count, err := c.client.GetBlockCount()
+	metrics.ObserveRPC(exported.Bitcoin.Name, "GetBlockCount", start, err)
+
+	return count, err
+}
+
+// Network returns the Bitcoin network the client is connected to
+func (c InstrumentedClient) Network() types.Network {
+	return c.client.Network()
+}
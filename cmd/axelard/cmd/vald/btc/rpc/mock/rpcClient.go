@@ -22,6 +22,12 @@ var _ rpc.Client = &ClientMock{}
 //
 // 		// make and configure a mocked rpc.Client
 // 		mockedClient := &ClientMock{
+// 			EstimateSmartFeeFunc: func(confTarget int64, mode *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error) {
+// 				panic("mock out the EstimateSmartFee method")
+// 			},
+// 			GetBlockCountFunc: func() (int64, error) {
+// 				panic("mock out the GetBlockCount method")
+// 			},
 // 			GetTxOutFunc: func(txHash *chainhash.Hash, voutIdx uint32, mempool bool) (*btcjson.GetTxOutResult, error) {
 // 				panic("mock out the GetTxOut method")
 // 			},
@@ -38,6 +44,12 @@ var _ rpc.Client = &ClientMock{}
 //
 // 	}
 type ClientMock struct {
+	// EstimateSmartFeeFunc mocks the EstimateSmartFee method.
+	EstimateSmartFeeFunc func(confTarget int64, mode *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error)
+
+	// GetBlockCountFunc mocks the GetBlockCount method.
+	GetBlockCountFunc func() (int64, error)
+
 	// GetTxOutFunc mocks the GetTxOut method.
 	GetTxOutFunc func(txHash *chainhash.Hash, voutIdx uint32, mempool bool) (*btcjson.GetTxOutResult, error)
 
@@ -49,6 +61,16 @@ type ClientMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// EstimateSmartFee holds details about calls to the EstimateSmartFee method.
+		EstimateSmartFee []struct {
+			// ConfTarget is the confTarget argument value.
+			ConfTarget int64
+			// Mode is the mode argument value.
+			Mode *btcjson.EstimateSmartFeeMode
+		}
+		// GetBlockCount holds details about calls to the GetBlockCount method.
+		GetBlockCount []struct {
+		}
 		// GetTxOut holds details about calls to the GetTxOut method.
 		GetTxOut []struct {
 			// TxHash is the txHash argument value.
@@ -69,11 +91,74 @@ type ClientMock struct {
 			AllowHighFees bool
 		}
 	}
+	lockEstimateSmartFee   sync.RWMutex
+	lockGetBlockCount      sync.RWMutex
 	lockGetTxOut           sync.RWMutex
 	lockNetwork            sync.RWMutex
 	lockSendRawTransaction sync.RWMutex
 }
 
+// EstimateSmartFee calls EstimateSmartFeeFunc.
+func (mock *ClientMock) EstimateSmartFee(confTarget int64, mode *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error) {
+	if mock.EstimateSmartFeeFunc == nil {
+		panic("ClientMock.EstimateSmartFeeFunc: method is nil but Client.EstimateSmartFee was just called")
+	}
+	callInfo := struct {
+		ConfTarget int64
+		Mode       *btcjson.EstimateSmartFeeMode
+	}{
+		ConfTarget: confTarget,
+		Mode:       mode,
+	}
+	mock.lockEstimateSmartFee.Lock()
+	mock.calls.EstimateSmartFee = append(mock.calls.EstimateSmartFee, callInfo)
+	mock.lockEstimateSmartFee.Unlock()
+	return mock.EstimateSmartFeeFunc(confTarget, mode)
+}
+
+// EstimateSmartFeeCalls gets all the calls that were made to EstimateSmartFee.
+// Check the length with:
+//     len(mockedClient.EstimateSmartFeeCalls())
+func (mock *ClientMock) EstimateSmartFeeCalls() []struct {
+	ConfTarget int64
+	Mode       *btcjson.EstimateSmartFeeMode
+} {
+	var calls []struct {
+		ConfTarget int64
+		Mode       *btcjson.EstimateSmartFeeMode
+	}
+	mock.lockEstimateSmartFee.RLock()
+	calls = mock.calls.EstimateSmartFee
+	mock.lockEstimateSmartFee.RUnlock()
+	return calls
+}
+
+// GetBlockCount calls GetBlockCountFunc.
+func (mock *ClientMock) GetBlockCount() (int64, error) {
+	if mock.GetBlockCountFunc == nil {
+		panic("ClientMock.GetBlockCountFunc: method is nil but Client.GetBlockCount was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetBlockCount.Lock()
+	mock.calls.GetBlockCount = append(mock.calls.GetBlockCount, callInfo)
+	mock.lockGetBlockCount.Unlock()
+	return mock.GetBlockCountFunc()
+}
+
+// GetBlockCountCalls gets all the calls that were made to GetBlockCount.
+// Check the length with:
+//     len(mockedClient.GetBlockCountCalls())
+func (mock *ClientMock) GetBlockCountCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetBlockCount.RLock()
+	calls = mock.calls.GetBlockCount
+	mock.lockGetBlockCount.RUnlock()
+	return calls
+}
+
 // GetTxOut calls GetTxOutFunc.
 func (mock *ClientMock) GetTxOut(txHash *chainhash.Hash, voutIdx uint32, mempool bool) (*btcjson.GetTxOutResult, error) {
 	if mock.GetTxOutFunc == nil {
@@ -0,0 +1,190 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/rpcpool"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+)
+
+const notFoundKey = "not found"
+
+// MultiClient spreads calls across redundant endpoints of the Bitcoin network. With a quorum of one it fails over
+// to the next endpoint when a call fails, otherwise the results of enough endpoints have to agree
+type MultiClient struct {
+	clients []Client
+	pool    *rpcpool.Pool
+}
+
+// NewMultiClient returns a client for the given endpoints, which must all be connected to the same network
+func NewMultiClient(clients []Client, quorum int, maxBlockLag uint64, logger log.Logger) (*MultiClient, error) {
+	pool, err := rpcpool.NewPool(len(clients), quorum, maxBlockLag, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, client := range clients[1:] {
+		if client.Network() != clients[0].Network() {
+			return nil, fmt.Errorf("endpoints are connected to different networks (%s and %s)", clients[0].Network().Name, client.Network().Name)
+		}
+	}
+
+	return &MultiClient{clients: clients, pool: pool}, nil
+}
+
+// EndpointHealth returns the result of the last health check for each endpoint
+func (c *MultiClient) EndpointHealth() []bool {
+	return c.pool.Health()
+}
+
+// CheckHealth updates the health of all endpoints based on their block count
+func (c *MultiClient) CheckHealth() {
+	c.pool.CheckHealth(func(i int) (uint64, error) {
+		count, err := c.clients[i].GetBlockCount()
+		if err != nil {
+			return 0, err
+		}
+		if count < 0 {
+			return 0, fmt.Errorf("negative block count")
+		}
+
+		return uint64(count), nil
+	})
+}
+
+// GetTxOut returns the transaction output with the given index of the given transaction.
+// In quorum mode the endpoints have to agree on the output, and the lowest reported number of confirmations is returned
+func (c *MultiClient) GetTxOut(txHash *chainhash.Hash, voutIdx uint32, mempool bool) (*btcjson.GetTxOutResult, error) {
+	if c.pool.Quorum() == 1 {
+		var result *btcjson.GetTxOutResult
+		notFound := false
+		err := c.pool.Failover(func(i int) error {
+			txOut, err := c.clients[i].GetTxOut(txHash, voutIdx, mempool)
+			if err != nil {
+				return err
+			}
+			// another endpoint might know about the output
+			if txOut == nil {
+				notFound = true
+				return fmt.Errorf("tx out not found")
+			}
+
+			result = txOut
+			return nil
+		})
+		if err != nil && notFound {
+			return nil, nil
+		}
+		return result, err
+	}
+
+	results := make([]*btcjson.GetTxOutResult, len(c.clients))
+	agreed, err := c.pool.Agree(func(i int) (string, error) {
+		var err error
+		results[i], err = c.clients[i].GetTxOut(txHash, voutIdx, mempool)
+		if err != nil {
+			return "", err
+		}
+
+		return txOutKey(results[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := results[agreed[0]]
+	for _, i := range agreed[1:] {
+		if result != nil && results[i].Confirmations < result.Confirmations {
+			result = results[i]
+		}
+	}
+
+	return result, nil
+}
+
+// txOutKey identifies a tx out independently of the state of the endpoint's chain tip
+func txOutKey(txOut *btcjson.GetTxOutResult) (string, error) {
+	if txOut == nil {
+		return notFoundKey, nil
+	}
+
+	bz, err := json.Marshal(struct {
+		Value        float64
+		ScriptPubKey btcjson.ScriptPubKeyResult
+		Coinbase     bool
+	}{txOut.Value, txOut.ScriptPubKey, txOut.Coinbase})
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// SendRawTransaction submits the given transaction to the first endpoint that accepts it
+func (c *MultiClient) SendRawTransaction(tx *wire.MsgTx, allowHighFees bool) (*chainhash.Hash, error) {
+	var hash *chainhash.Hash
+	err := c.pool.Failover(func(i int) error {
+		var err error
+		hash, err = c.clients[i].SendRawTransaction(tx, allowHighFees)
+		return err
+	})
+
+	return hash, err
+}
+
+// EstimateSmartFee estimates the fee rate needed for a transaction to confirm within the given number of blocks
+func (c *MultiClient) EstimateSmartFee(confTarget int64, mode *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error) {
+	var result *btcjson.EstimateSmartFeeResult
+	err := c.pool.Failover(func(i int) error {
+		var err error
+		result, err = c.clients[i].EstimateSmartFee(confTarget, mode)
+		return err
+	})
+
+	return result, err
+}
+
+// GetBlockCount returns the number of blocks in the longest block chain. In quorum mode this is the highest count reached by a quorum of endpoints
+func (c *MultiClient) GetBlockCount() (int64, error) {
+	if c.pool.Quorum() == 1 {
+		var count int64
+		err := c.pool.Failover(func(i int) error {
+			var err error
+			count, err = c.clients[i].GetBlockCount()
+			return err
+		})
+		return count, err
+	}
+
+	mu := sync.Mutex{}
+	var counts []uint64
+	c.pool.Each(func(i int) error {
+		count, err := c.clients[i].GetBlockCount()
+		if err != nil {
+			return err
+		}
+		if count < 0 {
+			return fmt.Errorf("negative block count")
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		counts = append(counts, uint64(count))
+		return nil
+	})
+
+	count, err := rpcpool.HighestAgreed(counts, c.pool.Quorum())
+	return int64(count), err
+}
+
+// Network returns the Bitcoin network the endpoints are connected to
+func (c *MultiClient) Network() types.Network {
+	return c.clients[0].Network()
+}
@@ -0,0 +1,107 @@
+package rpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+)
+
+func TestMultiClient_GetTxOut(t *testing.T) {
+	newClients := func(txOuts []*btcjson.GetTxOutResult, errs []error) []rpc.Client {
+		var clients []rpc.Client
+		for i := range txOuts {
+			txOut, err := txOuts[i], errs[i]
+			clients = append(clients, &mock.ClientMock{
+				NetworkFunc: func() types.Network { return types.Mainnet },
+				GetTxOutFunc: func(*chainhash.Hash, uint32, bool) (*btcjson.GetTxOutResult, error) {
+					return txOut, err
+				},
+			})
+		}
+		return clients
+	}
+
+	randTxOut := func() *btcjson.GetTxOutResult {
+		return &btcjson.GetTxOutResult{
+			Value:         float64(rand.PosI64()),
+			Confirmations: rand.I64Between(1, 100),
+			ScriptPubKey:  btcjson.ScriptPubKeyResult{Hex: rand.HexStr(40), Addresses: []string{rand.StrBetween(20, 40)}},
+		}
+	}
+
+	t.Run("should fail over to the next endpoint", testutils.Func(func(t *testing.T) {
+		size := int(rand.I64Between(2, 10))
+		txOuts := make([]*btcjson.GetTxOutResult, size)
+		errs := make([]error, size)
+		for i := 0; i < size-1; i++ {
+			if rand.Bools(0.5).Next() {
+				errs[i] = fmt.Errorf("some error")
+			}
+		}
+		txOuts[size-1] = randTxOut()
+
+		client, err := rpc.NewMultiClient(newClients(txOuts, errs), 1, 0, log.TestingLogger())
+		assert.NoError(t, err)
+
+		actual, err := client.GetTxOut(&chainhash.Hash{}, 0, false)
+		assert.NoError(t, err)
+		assert.Equal(t, txOuts[size-1], actual)
+	}).Repeat(20))
+
+	t.Run("should return the agreed output with the fewest confirmations", testutils.Func(func(t *testing.T) {
+		size := int(rand.I64Between(3, 10))
+		quorum := int(rand.I64Between(2, int64(size)+1))
+
+		expected := randTxOut()
+		txOuts := make([]*btcjson.GetTxOutResult, size)
+		errs := make([]error, size)
+		minConfirmations := expected.Confirmations
+		for i := range txOuts {
+			if i >= quorum {
+				errs[i] = fmt.Errorf("some error")
+				continue
+			}
+
+			txOut := *expected
+			txOut.Confirmations = rand.I64Between(1, 100)
+			txOut.BestBlock = rand.HexStr(64)
+			txOuts[i] = &txOut
+
+			if i == 0 || txOut.Confirmations < minConfirmations {
+				minConfirmations = txOut.Confirmations
+			}
+		}
+
+		client, err := rpc.NewMultiClient(newClients(txOuts, errs), quorum, 0, log.TestingLogger())
+		assert.NoError(t, err)
+
+		actual, err := client.GetTxOut(&chainhash.Hash{}, 0, false)
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Value, actual.Value)
+		assert.Equal(t, minConfirmations, actual.Confirmations)
+	}).Repeat(20))
+
+	t.Run("should fail if the endpoints disagree", testutils.Func(func(t *testing.T) {
+		size := int(rand.I64Between(2, 10))
+		txOuts := make([]*btcjson.GetTxOutResult, size)
+		for i := range txOuts {
+			txOuts[i] = randTxOut()
+		}
+
+		client, err := rpc.NewMultiClient(newClients(txOuts, make([]error, size)), size, 0, log.TestingLogger())
+		assert.NoError(t, err)
+
+		_, err = client.GetTxOut(&chainhash.Hash{}, 0, false)
+		assert.Error(t, err)
+	}).Repeat(20))
+}
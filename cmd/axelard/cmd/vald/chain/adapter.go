@@ -0,0 +1,55 @@
+package chain
+
+import (
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Adapter connects vald to a family of external chains. It subscribes to the events of its module,
+// verifies the requested confirmations against the chains' rpc endpoints and votes on the result
+type Adapter interface {
+	// Name returns the name of the chain family, it must be unique among all adapters
+	Name() string
+	// Subscriptions returns the module events the adapter handles
+	Subscriptions() []Subscription
+	// Chains returns the status of all external chains the adapter is connected to
+	Chains() []Status
+}
+
+// Subscription is a module event and the function handling it
+type Subscription struct {
+	// Name identifies the handler in metrics and the admin API, it must be unique among all adapters
+	Name      string
+	EventType string
+	Module    string
+	Action    string
+	// EndBlock is set for events that are emitted at the end of a block instead of by a transaction
+	EndBlock bool
+	Handle   func(e tmEvents.Event) error
+}
+
+// Matches returns true if the given event is the one the subscription is for
+func (s Subscription) Matches(e tmEvents.Event) bool {
+	return e.Type == s.EventType && e.Attributes[sdk.AttributeKeyModule] == s.Module && e.Attributes[sdk.AttributeKeyAction] == s.Action
+}
+
+// Status describes the rpc endpoints of an external chain
+type Status struct {
+	Name string
+	// health of the individual rpc endpoints, empty if the chain is reached through a single endpoint
+	Endpoints []bool
+}
+
+// NewStatus returns the status of the chain with the given name that is reached through the given rpc client
+func NewStatus(name string, client interface{}) Status {
+	type healthReporter interface {
+		EndpointHealth() []bool
+	}
+
+	status := Status{Name: name}
+	if reporter, ok := client.(healthReporter); ok {
+		status.Endpoints = reporter.EndpointHealth()
+	}
+
+	return status
+}
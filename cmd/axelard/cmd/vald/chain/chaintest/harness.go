@@ -0,0 +1,93 @@
+package chaintest
+
+import (
+	"fmt"
+	"testing"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+)
+
+// Harness provides the dependencies of a chain adapter under test and records the messages it broadcasts
+type Harness struct {
+	Ctx         sdkClient.Context
+	Broadcaster *mock.BroadcasterMock
+	Logger      log.Logger
+	Cdc         *codec.LegacyAmino
+}
+
+// NewHarness returns a new Harness instance
+func NewHarness() *Harness {
+	return &Harness{
+		Ctx:         sdkClient.Context{},
+		Broadcaster: &mock.BroadcasterMock{},
+		Logger:      log.TestingLogger(),
+		Cdc:         app.MakeEncodingConfig().Amino,
+	}
+}
+
+// Msgs returns all messages broadcast so far, unwrapped from their refund requests
+func (h *Harness) Msgs() []sdk.Msg {
+	var msgs []sdk.Msg
+	for _, call := range h.Broadcaster.BroadcastCalls() {
+		for _, msg := range call.Msgs {
+			if refundMsg, ok := msg.(*axelarnet.RefundMsgRequest); ok {
+				msg = refundMsg.GetInnerMessage()
+			}
+			msgs = append(msgs, msg)
+		}
+	}
+
+	return msgs
+}
+
+// NewEvent returns an event of the given module with the given attributes
+func NewEvent(eventType, module, action string, attributes map[string]string) tmEvents.Event {
+	e := tmEvents.Event{Type: eventType, Attributes: map[string]string{}}
+	for key, value := range attributes {
+		e.Attributes[key] = value
+	}
+	e.Attributes[sdk.AttributeKeyModule] = module
+	e.Attributes[sdk.AttributeKeyAction] = action
+
+	return e
+}
+
+// Deliver passes the given event to the subscription of the adapter that matches it
+func Deliver(adapter chain.Adapter, e tmEvents.Event) error {
+	for _, subscription := range adapter.Subscriptions() {
+		if subscription.Matches(e) {
+			return subscription.Handle(e)
+		}
+	}
+
+	return fmt.Errorf("chain adapter %s has no subscription for event %s", adapter.Name(), e.Type)
+}
+
+// AssertSubscriptions checks that the adapter can be registered and that every module event is handled by exactly one subscription
+func AssertSubscriptions(t *testing.T, adapter chain.Adapter) {
+	assert.NoError(t, chain.NewRegistry().Register(adapter))
+
+	subscriptions := adapter.Subscriptions()
+	assert.NotEmpty(t, subscriptions)
+	for _, subscription := range subscriptions {
+		e := NewEvent(subscription.EventType, subscription.Module, subscription.Action, nil)
+
+		matches := 0
+		for _, other := range subscriptions {
+			if other.Matches(e) {
+				matches++
+			}
+		}
+		assert.Equal(t, 1, matches, "event of handler %s must match exactly one subscription", subscription.Name)
+	}
+}
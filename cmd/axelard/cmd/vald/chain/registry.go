@@ -0,0 +1,90 @@
+package chain
+
+import (
+	"fmt"
+)
+
+// Registry keeps track of all chain adapters vald runs
+type Registry struct {
+	adapters []Adapter
+	names    map[string]bool
+	handlers map[string]bool
+}
+
+// NewRegistry returns a new Registry instance
+func NewRegistry() *Registry {
+	return &Registry{
+		names:    make(map[string]bool),
+		handlers: make(map[string]bool),
+	}
+}
+
+// Register adds the given adapter to the registry. Adapters and their subscriptions must be uniquely named
+func (r *Registry) Register(adapter Adapter) error {
+	if adapter.Name() == "" {
+		return fmt.Errorf("chain adapter name must not be empty")
+	}
+
+	if r.names[adapter.Name()] {
+		return fmt.Errorf("chain adapter %s is already registered", adapter.Name())
+	}
+
+	subscriptions := adapter.Subscriptions()
+	handlers := make(map[string]bool)
+	for _, subscription := range subscriptions {
+		if err := validateSubscription(subscription); err != nil {
+			return fmt.Errorf("invalid subscription of chain adapter %s: %s", adapter.Name(), err.Error())
+		}
+
+		if r.handlers[subscription.Name] || handlers[subscription.Name] {
+			return fmt.Errorf("handler %s of chain adapter %s is already registered", subscription.Name, adapter.Name())
+		}
+		handlers[subscription.Name] = true
+	}
+
+	r.names[adapter.Name()] = true
+	for name := range handlers {
+		r.handlers[name] = true
+	}
+	r.adapters = append(r.adapters, adapter)
+
+	return nil
+}
+
+// Adapters returns all registered adapters in the order they were registered
+func (r *Registry) Adapters() []Adapter {
+	return append([]Adapter{}, r.adapters...)
+}
+
+// Subscriptions returns the subscriptions of all registered adapters
+func (r *Registry) Subscriptions() []Subscription {
+	var subscriptions []Subscription
+	for _, adapter := range r.adapters {
+		subscriptions = append(subscriptions, adapter.Subscriptions()...)
+	}
+
+	return subscriptions
+}
+
+// Chains returns the status of all external chains the registered adapters are connected to
+func (r *Registry) Chains() []Status {
+	var chains []Status
+	for _, adapter := range r.adapters {
+		chains = append(chains, adapter.Chains()...)
+	}
+
+	return chains
+}
+
+func validateSubscription(subscription Subscription) error {
+	switch {
+	case subscription.Name == "":
+		return fmt.Errorf("handler name must not be empty")
+	case subscription.EventType == "" || subscription.Module == "" || subscription.Action == "":
+		return fmt.Errorf("handler %s must specify event type, module and action", subscription.Name)
+	case subscription.Handle == nil:
+		return fmt.Errorf("handler %s must not be nil", subscription.Name)
+	default:
+		return nil
+	}
+}
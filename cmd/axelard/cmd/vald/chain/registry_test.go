@@ -0,0 +1,123 @@
+package chain_test
+
+import (
+	"fmt"
+	"testing"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain/chaintest"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+)
+
+type adapter struct {
+	name          string
+	subscriptions []chain.Subscription
+	chains        []chain.Status
+}
+
+func (a adapter) Name() string                        { return a.name }
+func (a adapter) Subscriptions() []chain.Subscription { return a.subscriptions }
+func (a adapter) Chains() []chain.Status              { return a.chains }
+
+func randomAdapter() adapter {
+	a := adapter{name: rand.StrBetween(5, 20)}
+	for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+		a.subscriptions = append(a.subscriptions, chain.Subscription{
+			Name:      rand.StrBetween(5, 20),
+			EventType: rand.StrBetween(5, 20),
+			Module:    a.name,
+			Action:    rand.StrBetween(5, 20),
+			Handle:    func(tmEvents.Event) error { return nil },
+		})
+	}
+	for i := 0; i < int(rand.I64Between(0, 5)); i++ {
+		a.chains = append(a.chains, chain.Status{Name: rand.StrBetween(5, 20), Endpoints: rand.Bools(0.5).Take(int(rand.I64Between(0, 5)))})
+	}
+
+	return a
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("should return the subscriptions and chains of all adapters", testutils.Func(func(t *testing.T) {
+		registry := chain.NewRegistry()
+
+		var subscriptions []chain.Subscription
+		var chains []chain.Status
+		for i := 0; i < int(rand.I64Between(1, 5)); i++ {
+			a := randomAdapter()
+			assert.NoError(t, registry.Register(a))
+			chaintest.AssertSubscriptions(t, a)
+
+			subscriptions = append(subscriptions, a.subscriptions...)
+			chains = append(chains, a.chains...)
+		}
+
+		assert.Len(t, registry.Subscriptions(), len(subscriptions))
+		for i, subscription := range registry.Subscriptions() {
+			assert.Equal(t, subscriptions[i].Name, subscription.Name)
+		}
+		assert.Equal(t, chains, registry.Chains())
+	}).Repeat(20))
+
+	t.Run("should reject adapters with the same name", testutils.Func(func(t *testing.T) {
+		registry := chain.NewRegistry()
+		a := randomAdapter()
+		assert.NoError(t, registry.Register(a))
+
+		b := randomAdapter()
+		b.name = a.name
+		assert.Error(t, registry.Register(b))
+		assert.Len(t, registry.Adapters(), 1)
+	}).Repeat(20))
+
+	t.Run("should reject handlers that are already registered", testutils.Func(func(t *testing.T) {
+		registry := chain.NewRegistry()
+		a := randomAdapter()
+		assert.NoError(t, registry.Register(a))
+
+		b := randomAdapter()
+		b.subscriptions[0].Name = a.subscriptions[len(a.subscriptions)-1].Name
+		assert.Error(t, registry.Register(b))
+
+		c := randomAdapter()
+		c.subscriptions = append(c.subscriptions, c.subscriptions[0])
+		assert.Error(t, registry.Register(c))
+
+		assert.Len(t, registry.Subscriptions(), len(a.subscriptions))
+	}).Repeat(20))
+
+	t.Run("should reject incomplete subscriptions", testutils.Func(func(t *testing.T) {
+		registry := chain.NewRegistry()
+
+		for i, invalidate := range []func(s *chain.Subscription){
+			func(s *chain.Subscription) { s.Name = "" },
+			func(s *chain.Subscription) { s.EventType = "" },
+			func(s *chain.Subscription) { s.Module = "" },
+			func(s *chain.Subscription) { s.Action = "" },
+			func(s *chain.Subscription) { s.Handle = nil },
+		} {
+			a := randomAdapter()
+			invalidate(&a.subscriptions[0])
+			assert.Error(t, registry.Register(a), fmt.Sprintf("case %d", i))
+		}
+
+		assert.Empty(t, registry.Adapters())
+	}).Repeat(20))
+}
+
+func TestNewStatus(t *testing.T) {
+	t.Run("should report the endpoint health of multi endpoint clients", testutils.Func(func(t *testing.T) {
+		health := rand.Bools(0.5).Take(int(rand.I64Between(1, 5)))
+
+		assert.Equal(t, chain.Status{Name: "bitcoin", Endpoints: health}, chain.NewStatus("bitcoin", healthReporter(health)))
+		assert.Equal(t, chain.Status{Name: "bitcoin"}, chain.NewStatus("bitcoin", struct{}{}))
+	}).Repeat(20))
+}
+
+type healthReporter []bool
+
+func (h healthReporter) EndpointHealth() []bool { return h }
@@ -10,20 +10,36 @@ import (
 
 // ValdConfig contains all necessary vald configurations
 type ValdConfig struct {
-	bitcoin.BtcConfig `mapstructure:"axelar_bridge_btc"`
-	tss.TssConfig     `mapstructure:",squash"`
-	BroadcastConfig   `mapstructure:",squash"`
+	bitcoin.BtcConfig  `mapstructure:"axelar_bridge_btc"`
+	tss.TssConfig      `mapstructure:",squash"`
+	BroadcastConfig    `mapstructure:",squash"`
+	MetricsConfig      `mapstructure:"metrics"`
+	AdminConfig        `mapstructure:"admin"`
+	RemoteSignerConfig `mapstructure:"remote-signer"`
 
 	EVMConfig []evm.EVMConfig `mapstructure:"axelar_bridge_evm"`
+	// interval in which the health of redundant rpc endpoints of external chains is checked, zero disables the checks
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
+	// interval in which the config files are checked for changes to reload them, zero disables polling.
+	// The configuration is always reloaded when vald receives SIGHUP
+	ConfigPollInterval time.Duration `mapstructure:"config-poll-interval"`
+	// interval in which EVM chains with enabled deposit detection are scanned for new deposits
+	DepositScanInterval time.Duration `mapstructure:"deposit-scan-interval"`
 }
 
 // DefaultValdConfig returns a configurations populated with default values
 func DefaultValdConfig() ValdConfig {
 	return ValdConfig{
-		EVMConfig:       evm.DefaultConfig(),
-		BtcConfig:       bitcoin.DefaultConfig(),
-		TssConfig:       tss.DefaultConfig(),
-		BroadcastConfig: DefaultBroadcastConfig(),
+		EVMConfig:          evm.DefaultConfig(),
+		BtcConfig:          bitcoin.DefaultConfig(),
+		TssConfig:          tss.DefaultConfig(),
+		BroadcastConfig:    DefaultBroadcastConfig(),
+		MetricsConfig:      DefaultMetricsConfig(),
+		AdminConfig:        DefaultAdminConfig(),
+		RemoteSignerConfig: DefaultRemoteSignerConfig(),
+
+		RPCHealthCheckInterval: 30 * time.Second,
+		DepositScanInterval:    30 * time.Second,
 	}
 }
 
@@ -31,12 +47,64 @@ func DefaultValdConfig() ValdConfig {
 type BroadcastConfig struct {
 	MaxRetries int           `mapstructure:"max-retries"`
 	MinTimeout time.Duration `mapstructure:"min-timeout"`
+	// messages of concurrent broadcasts are bundled into one transaction up to these limits, a message count of 1 disables batching
+	MaxBatchMsgCount int `mapstructure:"max-batch-msg-count"`
+	MaxBatchBytes    int `mapstructure:"max-batch-bytes"`
+	// outgoing messages left over from a previous run are dropped on startup once they are older than this,
+	// unless they belong to a poll that is still pending
+	MaxPendingAge time.Duration `mapstructure:"max-pending-age"`
 }
 
 // DefaultBroadcastConfig returns a configurations populated with default values
 func DefaultBroadcastConfig() BroadcastConfig {
 	return BroadcastConfig{
-		MaxRetries: 10,
-		MinTimeout: 5 * time.Second,
+		MaxRetries:       10,
+		MinTimeout:       5 * time.Second,
+		MaxBatchMsgCount: 50,
+		MaxBatchBytes:    500 * 1024,
+		MaxPendingAge:    1 * time.Hour,
+	}
+}
+
+// MetricsConfig is the configuration of the prometheus metrics endpoint
+type MetricsConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	ListenAddr string `mapstructure:"listen-addr"`
+}
+
+// DefaultMetricsConfig returns a configurations populated with default values
+func DefaultMetricsConfig() MetricsConfig {
+	return MetricsConfig{
+		Enabled:    false,
+		ListenAddr: ":26661",
+	}
+}
+
+// AdminConfig is the configuration of the admin API to inspect and control a running vald process
+type AdminConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	ListenAddr string `mapstructure:"listen-addr"`
+}
+
+// DefaultAdminConfig returns a configurations populated with default values
+func DefaultAdminConfig() AdminConfig {
+	return AdminConfig{
+		Enabled:    false,
+		ListenAddr: "127.0.0.1:26662",
+	}
+}
+
+// RemoteSignerConfig is the configuration of the external signer holding the key of the broadcaster account
+type RemoteSignerConfig struct {
+	// unix socket of the signer of the form unix:///path/to/socket. If empty, the key is read from the local keyring
+	Address string        `mapstructure:"address"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultRemoteSignerConfig returns a configurations populated with default values
+func DefaultRemoteSignerConfig() RemoteSignerConfig {
+	return RemoteSignerConfig{
+		Address: "",
+		Timeout: 10 * time.Second,
 	}
 }
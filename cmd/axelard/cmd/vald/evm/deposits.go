@@ -0,0 +1,266 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sdkFlags "github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+)
+
+const (
+	// number of finalized blocks that are scanned for deposits when vald starts detecting deposits on a chain
+	depositScanLookback = 1000
+	// maximum number of blocks covered by a single log query
+	maxDepositScanRange = 1000
+	// number of burner addresses fetched per query
+	burnerAddressesPageLimit = 1000
+)
+
+type depositScan struct {
+	// next block to scan, zero if no block has been scanned yet
+	next uint64
+}
+
+type detectedDeposit struct {
+	txID       common.Hash
+	burnerAddr common.Address
+	amount     sdk.Uint
+}
+
+// SetDepositDetection enables or disables the detection of deposits to burner addresses on the given chain
+func (mgr Mgr) SetDepositDetection(chain string, enabled bool) {
+	mgr.scanLock.Lock()
+	defer mgr.scanLock.Unlock()
+
+	chain = strings.ToLower(chain)
+	if !enabled {
+		delete(mgr.scans, chain)
+		return
+	}
+
+	if _, ok := mgr.scans[chain]; !ok {
+		mgr.scans[chain] = &depositScan{}
+	}
+}
+
+// DetectDeposits periodically scans all chains with enabled deposit detection until the context is done
+func (mgr Mgr) DetectDeposits(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			mgr.ScanDeposits()
+		}
+	}
+}
+
+// ScanDeposits scans all chains with enabled deposit detection for ERC20 transfers to burner addresses
+// and reports them to axelar, so they get confirmed without anyone having to submit them
+func (mgr Mgr) ScanDeposits() {
+	// the lock only guards the scan states, so network calls do not block enabling or disabling deposit detection
+	mgr.scanLock.Lock()
+	scans := make(map[string]*depositScan, len(mgr.scans))
+	var chains []string
+	for chain, scan := range mgr.scans {
+		scans[chain] = scan
+		chains = append(chains, chain)
+	}
+	mgr.scanLock.Unlock()
+	sort.Strings(chains)
+
+	for _, chain := range chains {
+		if err := mgr.scanDeposits(chain, scans[chain]); err != nil {
+			mgr.logger.Error(sdkerrors.Wrapf(err, "deposit detection on chain %s failed", chain).Error())
+		}
+	}
+}
+
+func (mgr Mgr) scanDeposits(chain string, scan *depositScan) error {
+	rpc, found := mgr.getRPC(chain)
+	if !found {
+		return fmt.Errorf("unable to find an RPC for chain '%s'", chain)
+	}
+
+	burners, err := mgr.queryBurners(chain)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not get burner addresses")
+	}
+
+	latest, err := rpc.BlockNumber(context.Background())
+	if err != nil {
+		return sdkerrors.Wrap(err, "checking block number failed")
+	}
+
+	// only transfers with enough confirmations are reported, otherwise validators would vote against them
+	if latest+1 < burners.ConfirmationHeight {
+		return nil
+	}
+	finalized := latest + 1 - burners.ConfirmationHeight
+
+	next := mgr.getNextScanBlock(scan)
+	if next == 0 {
+		next = 1
+		if finalized > depositScanLookback {
+			next = finalized - depositScanLookback
+		}
+		mgr.setNextScanBlock(scan, next)
+	}
+
+	if len(burners.Burners) == 0 {
+		mgr.setNextScanBlock(scan, finalized+1)
+		return nil
+	}
+
+	tokens := make(map[common.Address]common.Address, len(burners.Burners))
+	for _, burner := range burners.Burners {
+		tokens[common.HexToAddress(burner.Address)] = common.HexToAddress(burner.TokenAddress)
+	}
+
+	for next <= finalized {
+		to := next + maxDepositScanRange - 1
+		if to > finalized {
+			to = finalized
+		}
+
+		deposits, err := findDeposits(rpc, next, to, tokens)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "could not scan blocks %d to %d", next, to)
+		}
+
+		for _, deposit := range deposits {
+			if err := mgr.reportDeposit(chain, deposit); err != nil {
+				return err
+			}
+		}
+
+		next = to + 1
+		mgr.setNextScanBlock(scan, next)
+	}
+
+	return nil
+}
+
+func (mgr Mgr) getNextScanBlock(scan *depositScan) uint64 {
+	mgr.scanLock.Lock()
+	defer mgr.scanLock.Unlock()
+
+	return scan.next
+}
+
+// setNextScanBlock records the progress of a scan. If deposit detection was disabled in the meantime,
+// the scan is not tracked by the manager anymore and its progress gets discarded
+func (mgr Mgr) setNextScanBlock(scan *depositScan, next uint64) {
+	mgr.scanLock.Lock()
+	defer mgr.scanLock.Unlock()
+
+	scan.next = next
+}
+
+func (mgr Mgr) reportDeposit(chain string, deposit detectedDeposit) error {
+	msg := evmTypes.NewReportDepositRequest(mgr.cliCtx.FromAddress, chain, deposit.txID, deposit.amount, deposit.burnerAddr)
+	mgr.logger.Info(fmt.Sprintf("reporting deposit %s of %s to burner address %s on chain %s", deposit.txID.Hex(), deposit.amount.String(), deposit.burnerAddr.Hex(), chain))
+	_, err := mgr.reportBroadcaster.Broadcast(mgr.cliCtx.WithBroadcastMode(sdkFlags.BroadcastBlock), msg)
+	return err
+}
+
+// findDeposits returns all deposits of the expected tokens to the given burner addresses in the given block range.
+// Transfers to the same burner address within a transaction are summed up, the same way they are when the deposit is confirmed
+func findDeposits(rpc rpc.Client, from, to uint64, tokens map[common.Address]common.Address) ([]detectedDeposit, error) {
+	var tokenAddrs []common.Address
+	var burnerTopics []common.Hash
+	seen := make(map[common.Address]bool)
+	for burnerAddr, tokenAddr := range tokens {
+		burnerTopics = append(burnerTopics, common.BytesToHash(burnerAddr.Bytes()))
+		if !seen[tokenAddr] {
+			seen[tokenAddr] = true
+			tokenAddrs = append(tokenAddrs, tokenAddr)
+		}
+	}
+
+	logs, err := rpc.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: sdk.NewUint(from).BigInt(),
+		ToBlock:   sdk.NewUint(to).BigInt(),
+		Addresses: tokenAddrs,
+		Topics:    [][]common.Hash{{ERC20TransferSig}, nil, burnerTopics},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deposits []detectedDeposit
+	index := make(map[string]int)
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+
+		burnerAddr, amount, err := decodeERC20TransferEvent(&log)
+		if err != nil {
+			continue
+		}
+
+		if tokenAddr, ok := tokens[burnerAddr]; !ok || tokenAddr != log.Address {
+			continue
+		}
+
+		key := depositKey(log, burnerAddr)
+		if i, ok := index[key]; ok {
+			deposits[i].amount = deposits[i].amount.Add(amount)
+			continue
+		}
+
+		index[key] = len(deposits)
+		deposits = append(deposits, detectedDeposit{txID: log.TxHash, burnerAddr: burnerAddr, amount: amount})
+	}
+
+	return deposits, nil
+}
+
+func depositKey(log geth.Log, burnerAddr common.Address) string {
+	return log.TxHash.Hex() + "_" + burnerAddr.Hex()
+}
+
+// queryBurnerAddresses returns all burner addresses of the given chain, fetching them page by page
+func (mgr Mgr) queryBurnerAddresses(chain string) (evmTypes.QueryBurnerAddressesResponse, error) {
+	client := evmTypes.NewQueryServiceClient(mgr.cliCtx)
+
+	var res evmTypes.QueryBurnerAddressesResponse
+	var nextKey []byte
+	for {
+		page, err := client.BurnerAddresses(context.Background(), &evmTypes.QueryBurnerAddressesRequest{
+			Chain:      chain,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: burnerAddressesPageLimit},
+		})
+		if err != nil {
+			return evmTypes.QueryBurnerAddressesResponse{}, err
+		}
+
+		res.ConfirmationHeight = page.ConfirmationHeight
+		res.Burners = append(res.Burners, page.Burners...)
+
+		if page.Pagination == nil || len(page.Pagination.NextKey) == 0 {
+			return res, nil
+		}
+		nextKey = page.Pagination.NextKey
+	}
+}
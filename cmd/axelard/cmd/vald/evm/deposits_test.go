@@ -0,0 +1,210 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	mock2 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	evmRpc "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+)
+
+func TestMgr_ScanDeposits(t *testing.T) {
+	var (
+		mgr         *Mgr
+		rpc         *mock.ClientMock
+		broadcaster *mock2.BroadcasterMock
+		burners     evmTypes.QueryBurnerAddressesResponse
+		logs        []geth.Log
+		latest      uint64
+	)
+
+	transferLog := func(block uint64, txID common.Hash, token, to common.Address, amount int64) geth.Log {
+		return geth.Log{
+			Address: token,
+			Topics: []common.Hash{
+				ERC20TransferSig,
+				common.BytesToHash(common.LeftPadBytes(rand.Bytes(common.AddressLength), common.HashLength)),
+				common.BytesToHash(common.LeftPadBytes(to.Bytes(), common.HashLength)),
+			},
+			Data:        common.LeftPadBytes(big.NewInt(amount).Bytes(), common.HashLength),
+			BlockNumber: block,
+			TxHash:      txID,
+		}
+	}
+
+	reported := func() []*evmTypes.ReportDepositRequest {
+		var msgs []*evmTypes.ReportDepositRequest
+		for _, call := range broadcaster.BroadcastCalls() {
+			for _, msg := range call.Msgs {
+				msgs = append(msgs, msg.(*evmTypes.ReportDepositRequest))
+			}
+		}
+		return msgs
+	}
+
+	setup := func() {
+		latest = uint64(rand.I64Between(5000, 100000))
+		logs = nil
+		burners = evmTypes.QueryBurnerAddressesResponse{ConfirmationHeight: uint64(rand.I64Between(1, 100))}
+		for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+			burners.Burners = append(burners.Burners, evmTypes.QueryBurnerAddressesResponse_Burner{
+				Address:      common.BytesToAddress(rand.Bytes(common.AddressLength)).Hex(),
+				TokenAddress: common.BytesToAddress(rand.Bytes(common.AddressLength)).Hex(),
+			})
+		}
+
+		rpc = &mock.ClientMock{
+			BlockNumberFunc: func(context.Context) (uint64, error) { return latest, nil },
+			FilterLogsFunc: func(_ context.Context, q ethereum.FilterQuery) ([]geth.Log, error) {
+				var result []geth.Log
+				for _, log := range logs {
+					if log.BlockNumber >= q.FromBlock.Uint64() && log.BlockNumber <= q.ToBlock.Uint64() {
+						result = append(result, log)
+					}
+				}
+				return result, nil
+			},
+		}
+		broadcaster = &mock2.BroadcasterMock{
+			BroadcastFunc: func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+
+		mgr = NewMgr(map[string]evmRpc.Client{"ethereum": rpc}, client.Context{}, broadcaster, log.TestingLogger(), app.MakeEncodingConfig().Amino)
+		mgr.queryBurners = func(string) (evmTypes.QueryBurnerAddressesResponse, error) { return burners, nil }
+		mgr.SetDepositDetection("Ethereum", true)
+	}
+
+	finalized := func() uint64 { return latest + 1 - burners.ConfirmationHeight }
+
+	repeats := 20
+	t.Run("should report deposits to burner addresses once they are finalized", testutils.Func(func(t *testing.T) {
+		setup()
+
+		burner := burners.Burners[0]
+		burnerAddr, tokenAddr := common.HexToAddress(burner.Address), common.HexToAddress(burner.TokenAddress)
+		txID := common.BytesToHash(rand.Bytes(common.HashLength))
+		logs = append(logs,
+			transferLog(finalized(), txID, tokenAddr, burnerAddr, 10),
+			transferLog(finalized(), txID, tokenAddr, burnerAddr, 5),
+			// transfer of a different token
+			transferLog(finalized(), txID, common.BytesToAddress(rand.Bytes(common.AddressLength)), burnerAddr, 7),
+			// not finalized yet
+			transferLog(finalized()+1, common.BytesToHash(rand.Bytes(common.HashLength)), tokenAddr, burnerAddr, 3),
+		)
+
+		mgr.ScanDeposits()
+
+		msgs := reported()
+		assert.Len(t, msgs, 1)
+		assert.Equal(t, "ethereum", msgs[0].Chain)
+		assert.Equal(t, evmTypes.Hash(txID), msgs[0].TxID)
+		assert.Equal(t, evmTypes.Address(burnerAddr), msgs[0].BurnerAddress)
+		assert.Equal(t, sdk.NewUint(15), msgs[0].Amount)
+
+		latest++
+		mgr.ScanDeposits()
+
+		msgs = reported()
+		assert.Len(t, msgs, 2)
+		assert.Equal(t, sdk.NewUint(3), msgs[1].Amount)
+	}).Repeat(repeats))
+
+	t.Run("should scan blocks only once", testutils.Func(func(t *testing.T) {
+		setup()
+
+		for i := 0; i < int(rand.I64Between(1, 20)); i++ {
+			burner := burners.Burners[int(rand.I64Between(0, int64(len(burners.Burners))))]
+			block := finalized() - uint64(rand.I64Between(0, depositScanLookback))
+			logs = append(logs, transferLog(block, common.BytesToHash(rand.Bytes(common.HashLength)), common.HexToAddress(burner.TokenAddress), common.HexToAddress(burner.Address), rand.PosI64()))
+		}
+
+		mgr.ScanDeposits()
+		assert.Len(t, reported(), len(logs))
+
+		mgr.ScanDeposits()
+		assert.Len(t, reported(), len(logs))
+	}).Repeat(repeats))
+
+	t.Run("should rescan blocks when reporting fails", testutils.Func(func(t *testing.T) {
+		setup()
+
+		burner := burners.Burners[0]
+		logs = append(logs, transferLog(finalized(), common.BytesToHash(rand.Bytes(common.HashLength)), common.HexToAddress(burner.TokenAddress), common.HexToAddress(burner.Address), rand.PosI64()))
+		broadcaster.BroadcastFunc = func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return nil, fmt.Errorf("failed") }
+
+		mgr.ScanDeposits()
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+
+		broadcaster.BroadcastFunc = func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }
+		mgr.ScanDeposits()
+		assert.Len(t, broadcaster.BroadcastCalls(), 2)
+	}).Repeat(repeats))
+
+	t.Run("should send reports with the report broadcaster", testutils.Func(func(t *testing.T) {
+		setup()
+		reportBroadcaster := &mock2.BroadcasterMock{
+			BroadcastFunc: func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+		mgr.SetReportBroadcaster(reportBroadcaster)
+
+		burner := burners.Burners[0]
+		logs = append(logs, transferLog(finalized(), common.BytesToHash(rand.Bytes(common.HashLength)), common.HexToAddress(burner.TokenAddress), common.HexToAddress(burner.Address), rand.PosI64()))
+
+		mgr.ScanDeposits()
+
+		assert.Empty(t, broadcaster.BroadcastCalls())
+		assert.Len(t, reportBroadcaster.BroadcastCalls(), 1)
+		assert.IsType(t, &evmTypes.ReportDepositRequest{}, reportBroadcaster.BroadcastCalls()[0].Msgs[0])
+	}).Repeat(repeats))
+
+	t.Run("should not scan chains without deposit detection", testutils.Func(func(t *testing.T) {
+		setup()
+		mgr.SetDepositDetection("ethereum", false)
+
+		burner := burners.Burners[0]
+		logs = append(logs, transferLog(finalized(), common.BytesToHash(rand.Bytes(common.HashLength)), common.HexToAddress(burner.TokenAddress), common.HexToAddress(burner.Address), rand.PosI64()))
+
+		mgr.ScanDeposits()
+
+		assert.Empty(t, rpc.FilterLogsCalls())
+		assert.Empty(t, broadcaster.BroadcastCalls())
+	}).Repeat(repeats))
+
+	t.Run("should allow disabling deposit detection while reporting", testutils.Func(func(t *testing.T) {
+		setup()
+
+		burner := burners.Burners[0]
+		logs = append(logs, transferLog(finalized(), common.BytesToHash(rand.Bytes(common.HashLength)), common.HexToAddress(burner.TokenAddress), common.HexToAddress(burner.Address), rand.PosI64()))
+		broadcaster.BroadcastFunc = func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+			mgr.SetDepositDetection("ethereum", false)
+			return &sdk.TxResponse{}, nil
+		}
+
+		mgr.ScanDeposits()
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+
+		mgr.ScanDeposits()
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+
+		// the progress of the disabled scan is discarded, so a new scan starts over
+		broadcaster.BroadcastFunc = func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }
+		mgr.SetDepositDetection("ethereum", true)
+		mgr.ScanDeposits()
+		assert.Len(t, broadcaster.BroadcastCalls(), 2)
+	}).Repeat(repeats))
+}
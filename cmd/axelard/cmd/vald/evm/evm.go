@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	sdkClient "github.com/cosmos/cosmos-sdk/client"
 	sdkFlags "github.com/cosmos/cosmos-sdk/client/flags"
@@ -23,6 +24,7 @@ import (
 	tmLog "github.com/tendermint/tendermint/libs/log"
 
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/parse"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
@@ -46,29 +48,125 @@ type Mgr struct {
 	cliCtx      sdkClient.Context
 	logger      tmLog.Logger
 	rpcs        map[string]rpc.Client
+	rpcLock     *sync.RWMutex
 	broadcaster types.Broadcaster
 	cdc         *codec.LegacyAmino
+	// deposit reports are not refundable, so they are sent without batching them with refundable votes
+	reportBroadcaster types.Broadcaster
+	// chains with enabled deposit detection by their lower case name
+	scans        map[string]*depositScan
+	scanLock     *sync.Mutex
+	queryBurners func(chain string) (evmTypes.QueryBurnerAddressesResponse, error)
 }
 
 // NewMgr returns a new Mgr instance
 func NewMgr(rpcs map[string]rpc.Client, cliCtx sdkClient.Context, broadcaster types.Broadcaster, logger tmLog.Logger, cdc *codec.LegacyAmino) *Mgr {
-	return &Mgr{
+	mgr := &Mgr{
 		rpcs:        rpcs,
+		rpcLock:     &sync.RWMutex{},
 		cliCtx:      cliCtx,
 		broadcaster: broadcaster,
 		logger:      logger.With("listener", "evm"),
 		cdc:         cdc,
+		scans:       make(map[string]*depositScan),
+		scanLock:    &sync.Mutex{},
 	}
+	mgr.queryBurners = mgr.queryBurnerAddresses
+	mgr.reportBroadcaster = broadcaster
+
+	return mgr
+}
+
+// SetReportBroadcaster sets the broadcaster for deposit reports, it should bypass the batching of refundable messages.
+// Reports are sent with the manager's broadcaster by default
+func (mgr *Mgr) SetReportBroadcaster(broadcaster types.Broadcaster) {
+	mgr.reportBroadcaster = broadcaster
+}
+
+// SetRPC connects the manager to the given chain with the given rpc client, replacing any previous client of that chain
+func (mgr Mgr) SetRPC(chain string, client rpc.Client) {
+	mgr.rpcLock.Lock()
+	defer mgr.rpcLock.Unlock()
+
+	mgr.rpcs[strings.ToLower(chain)] = client
+}
+
+// RemoveRPC disconnects the manager from the given chain
+func (mgr Mgr) RemoveRPC(chain string) {
+	mgr.rpcLock.Lock()
+	defer mgr.rpcLock.Unlock()
+
+	delete(mgr.rpcs, strings.ToLower(chain))
+}
+
+// RPCs returns the rpc clients of all connected chains by their lower case name
+func (mgr Mgr) RPCs() map[string]rpc.Client {
+	mgr.rpcLock.RLock()
+	defer mgr.rpcLock.RUnlock()
+
+	rpcs := make(map[string]rpc.Client, len(mgr.rpcs))
+	for chain, client := range mgr.rpcs {
+		rpcs[chain] = client
+	}
+
+	return rpcs
 }
 
-// ProcessNewChain notifies the operator that vald needs to be restarted/udpated for a new chain
+func (mgr Mgr) getRPC(chain string) (rpc.Client, bool) {
+	mgr.rpcLock.RLock()
+	defer mgr.rpcLock.RUnlock()
+
+	client, found := mgr.rpcs[strings.ToLower(chain)]
+	return client, found
+}
+
+// Name returns the name of the chain family the manager connects to
+func (mgr Mgr) Name() string {
+	return "evm"
+}
+
+// Subscriptions returns the evm module events the manager handles
+func (mgr Mgr) Subscriptions() []chain.Subscription {
+	return []chain.Subscription{
+		{Name: "ProcessNewChain", EventType: evmTypes.EventTypeNewChain, Module: evmTypes.ModuleName, Action: evmTypes.AttributeValueUpdate, Handle: mgr.ProcessNewChain},
+		{Name: "ProcessChainConfirmation", EventType: evmTypes.EventTypeChainConfirmation, Module: evmTypes.ModuleName, Action: evmTypes.AttributeValueStart, Handle: mgr.ProcessChainConfirmation},
+		{Name: "ProcessGatewayDeploymentConfirmation", EventType: evmTypes.EventTypeGatewayDeploymentConfirmation, Module: evmTypes.ModuleName, Action: evmTypes.AttributeValueStart, Handle: mgr.ProcessGatewayDeploymentConfirmation},
+		{Name: "ProcessDepositConfirmation", EventType: evmTypes.EventTypeDepositConfirmation, Module: evmTypes.ModuleName, Action: evmTypes.AttributeValueStart, Handle: mgr.ProcessDepositConfirmation},
+		{Name: "ProcessTokenConfirmation", EventType: evmTypes.EventTypeTokenConfirmation, Module: evmTypes.ModuleName, Action: evmTypes.AttributeValueStart, Handle: mgr.ProcessTokenConfirmation},
+		{Name: "ProcessTransferKeyConfirmation", EventType: evmTypes.EventTypeTransferKeyConfirmation, Module: evmTypes.ModuleName, Action: evmTypes.AttributeValueStart, Handle: mgr.ProcessTransferKeyConfirmation},
+	}
+}
+
+// Chains returns the status of all connected EVM chains sorted by name
+func (mgr Mgr) Chains() []chain.Status {
+	rpcs := mgr.RPCs()
+	var names []string
+	for name := range rpcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var chains []chain.Status
+	for _, name := range names {
+		chains = append(chains, chain.NewStatus(name, rpcs[name]))
+	}
+
+	return chains
+}
+
+// ProcessNewChain notifies the operator if vald needs to be configured for a new chain
 func (mgr Mgr) ProcessNewChain(e tmEvents.Event) (err error) {
 	chain, nativeAsset, err := parseNewChainParams(e.Attributes)
 	if err != nil {
 		return sdkerrors.Wrap(err, "Invalid update event")
 	}
 
-	mgr.logger.Info(fmt.Sprintf("VALD needs to be updated and restarted for new chain %s with native asset %s", chain, nativeAsset))
+	if _, found := mgr.getRPC(chain); found {
+		mgr.logger.Info(fmt.Sprintf("new chain %s with native asset %s is already connected", chain, nativeAsset))
+		return nil
+	}
+
+	mgr.logger.Info(fmt.Sprintf("new chain %s with native asset %s has no rpc configured, add it to the vald configuration to connect without a restart", chain, nativeAsset))
 	return nil
 }
 
@@ -79,7 +177,7 @@ func (mgr Mgr) ProcessChainConfirmation(e tmEvents.Event) (err error) {
 		return sdkerrors.Wrap(err, "EVM chain confirmation failed")
 	}
 
-	_, confirmed := mgr.rpcs[strings.ToLower(chain)]
+	_, confirmed := mgr.getRPC(chain)
 
 	msg := evmTypes.NewVoteConfirmChainRequest(mgr.cliCtx.FromAddress, chain, pollKey, confirmed)
 	refundableMsg := axelarnet.NewRefundMsgRequest(mgr.cliCtx.FromAddress, msg)
@@ -95,7 +193,7 @@ func (mgr Mgr) ProcessGatewayDeploymentConfirmation(e tmEvents.Event) error {
 		return sdkerrors.Wrap(err, "EVM gateway deployment confirmation failed")
 	}
 
-	rpc, found := mgr.rpcs[strings.ToLower(chain)]
+	rpc, found := mgr.getRPC(chain)
 	if !found {
 		return sdkerrors.Wrap(err, fmt.Sprintf("Unable to find an RPC for chain '%s'", chain))
 	}
@@ -128,7 +226,7 @@ func (mgr Mgr) ProcessDepositConfirmation(e tmEvents.Event) (err error) {
 		return sdkerrors.Wrap(err, "EVM deposit confirmation failed")
 	}
 
-	rpc, found := mgr.rpcs[strings.ToLower(chain)]
+	rpc, found := mgr.getRPC(chain)
 	if !found {
 		return sdkerrors.Wrap(err, fmt.Sprintf("Unable to find an RPC for chain '%s'", chain))
 	}
@@ -156,7 +254,7 @@ func (mgr Mgr) ProcessTokenConfirmation(e tmEvents.Event) error {
 		return sdkerrors.Wrap(err, "EVM token deployment confirmation failed")
 	}
 
-	rpc, found := mgr.rpcs[strings.ToLower(chain)]
+	rpc, found := mgr.getRPC(chain)
 	if !found {
 		return sdkerrors.Wrap(err, fmt.Sprintf("Unable to find an RPC for chain '%s'", chain))
 	}
@@ -184,7 +282,7 @@ func (mgr Mgr) ProcessTransferKeyConfirmation(e tmEvents.Event) (err error) {
 		return sdkerrors.Wrap(err, "EVM key transfer confirmation failed")
 	}
 
-	rpc, found := mgr.rpcs[strings.ToLower(chain)]
+	rpc, found := mgr.getRPC(chain)
 	if !found {
 		return sdkerrors.Wrap(err, fmt.Sprintf("Unable to find an RPC for chain '%s'", chain))
 	}
@@ -638,7 +736,7 @@ func isTxSuccessful(txReceipt *geth.Receipt) bool {
 
 func decodeERC20TransferEvent(log *geth.Log) (common.Address, sdk.Uint, error) {
 
-	if len(log.Topics) != 3 || log.Topics[0] != ERC20TransferSig {
+	if len(log.Topics) != 3 || log.Topics[0] != ERC20TransferSig || len(log.Data) < 32 {
 		return common.Address{}, sdk.Uint{}, fmt.Errorf("log is not an ERC20 transfer")
 	}
 
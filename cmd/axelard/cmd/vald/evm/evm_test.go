@@ -6,6 +6,7 @@ import (
 	"math/big"
 	mathRand "math/rand"
 	"strconv"
+	"strings"
 	"testing"
 
 	tmEvents "github.com/axelarnetwork/tm-events/events"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/app"
 	mock2 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain/chaintest"
 	evmRpc "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc/mock"
 	"github.com/axelarnetwork/axelar-core/testutils"
@@ -710,6 +712,64 @@ func createTokenLogs(denom string, gateway, tokenAddr common.Address, deploySig
 	return logs
 }
 
+func TestMgr_Adapter(t *testing.T) {
+	var (
+		h      *chaintest.Harness
+		mgr    *Mgr
+		chains []string
+	)
+
+	setup := func() {
+		h = chaintest.NewHarness()
+
+		chains = nil
+		rpcs := make(map[string]evmRpc.Client)
+		for i := 0; i < int(rand.I64Between(1, 5)); i++ {
+			chain := rand.StrBetween(5, 20)
+			chains = append(chains, chain)
+			rpcs[strings.ToLower(chain)] = &mock.ClientMock{}
+		}
+		mgr = NewMgr(rpcs, h.Ctx, h.Broadcaster, h.Logger, h.Cdc)
+	}
+
+	repeats := 20
+	t.Run("should subscribe to all evm events", testutils.Func(func(t *testing.T) {
+		setup()
+		chaintest.AssertSubscriptions(t, mgr)
+	}).Repeat(repeats))
+
+	t.Run("should vote on chain confirmations", testutils.Func(func(t *testing.T) {
+		setup()
+		connected := rand.Bools(0.5).Next()
+		chain := rand.StrBetween(21, 30)
+		if connected {
+			chain = chains[rand.I64Between(0, int64(len(chains)))]
+		}
+
+		e := chaintest.NewEvent(evmTypes.EventTypeChainConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart, map[string]string{
+			evmTypes.AttributeKeyChain: chain,
+			evmTypes.AttributeKeyPoll:  string(h.Cdc.MustMarshalJSON(exported.NewPollKey(evmTypes.ModuleName, rand.StrBetween(5, 20)))),
+		})
+
+		assert.NoError(t, chaintest.Deliver(mgr, e))
+		assert.Len(t, h.Msgs(), 1)
+		assert.Equal(t, connected, h.Msgs()[0].(*evmTypes.VoteConfirmChainRequest).Confirmed)
+	}).Repeat(repeats))
+
+	t.Run("should report all connected chains", testutils.Func(func(t *testing.T) {
+		setup()
+
+		statuses := mgr.Chains()
+		assert.Len(t, statuses, len(chains))
+		for i := 1; i < len(statuses); i++ {
+			assert.Less(t, statuses[i-1].Name, statuses[i].Name)
+		}
+
+		mgr.RemoveRPC(chains[0])
+		assert.Len(t, mgr.Chains(), len(chains)-1)
+	}).Repeat(repeats))
+}
+
 func unwrapRefundMsg(msg sdk.Msg) sdk.Msg {
 	return msg.(*axelarnetTypes.RefundMsgRequest).GetInnerMessage()
 }
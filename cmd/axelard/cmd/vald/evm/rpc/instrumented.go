@@ -0,0 +1,59 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/metrics"
+)
+
+// InstrumentedClient records latencies and errors of all calls to the underlying client
+type InstrumentedClient struct {
+	chain  string
+	client Client
+}
+
+// NewInstrumentedClient returns a client that records metrics for the calls to the given client of the given chain
+func NewInstrumentedClient(chain string, client Client) InstrumentedClient {
+	return InstrumentedClient{chain: chain, client: client}
+}
+
+// BlockNumber returns the most recent block number
+func (c InstrumentedClient) BlockNumber(ctx context.Context) (uint64, error) {
+	start := time.Now()
+	number, err := c.client.BlockNumber(ctx)
+	metrics.ObserveRPC(c.chain, "BlockNumber", start, err)
+
+	return number, err
+}
+
+// TransactionByHash returns the transaction with the given hash
+func (c InstrumentedClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	start := time.Now()
+	tx, isPending, err := c.client.TransactionByHash(ctx, hash)
+	metrics.ObserveRPC(c.chain, "TransactionByHash", start, err)
+
+	return tx, isPending, err
+}
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash
+func (c InstrumentedClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	start := time.Now()
+	receipt, err := c.client.TransactionReceipt(ctx, txHash)
+	metrics.ObserveRPC(c.chain, "TransactionReceipt", start, err)
+
+	return receipt, err
+}
+
+// FilterLogs returns the logs matching the given query
+func (c InstrumentedClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	start := time.Now()
+	logs, err := c.client.FilterLogs(ctx, q)
+	metrics.ObserveRPC(c.chain, "FilterLogs", start, err)
+
+	return logs, err
+}
@@ -6,6 +6,7 @@ package mock
 import (
 	"context"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"sync"
@@ -24,6 +25,9 @@ var _ rpc.Client = &ClientMock{}
 // 			BlockNumberFunc: func(ctx context.Context) (uint64, error) {
 // 				panic("mock out the BlockNumber method")
 // 			},
+// 			FilterLogsFunc: func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+// 				panic("mock out the FilterLogs method")
+// 			},
 // 			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
 // 				panic("mock out the TransactionByHash method")
 // 			},
@@ -40,6 +44,9 @@ type ClientMock struct {
 	// BlockNumberFunc mocks the BlockNumber method.
 	BlockNumberFunc func(ctx context.Context) (uint64, error)
 
+	// FilterLogsFunc mocks the FilterLogs method.
+	FilterLogsFunc func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+
 	// TransactionByHashFunc mocks the TransactionByHash method.
 	TransactionByHashFunc func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
 
@@ -53,6 +60,13 @@ type ClientMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// FilterLogs holds details about calls to the FilterLogs method.
+		FilterLogs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Q is the q argument value.
+			Q ethereum.FilterQuery
+		}
 		// TransactionByHash holds details about calls to the TransactionByHash method.
 		TransactionByHash []struct {
 			// Ctx is the ctx argument value.
@@ -69,6 +83,7 @@ type ClientMock struct {
 		}
 	}
 	lockBlockNumber        sync.RWMutex
+	lockFilterLogs         sync.RWMutex
 	lockTransactionByHash  sync.RWMutex
 	lockTransactionReceipt sync.RWMutex
 }
@@ -104,6 +119,41 @@ func (mock *ClientMock) BlockNumberCalls() []struct {
 	return calls
 }
 
+// FilterLogs calls FilterLogsFunc.
+func (mock *ClientMock) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if mock.FilterLogsFunc == nil {
+		panic("ClientMock.FilterLogsFunc: method is nil but Client.FilterLogs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Q   ethereum.FilterQuery
+	}{
+		Ctx: ctx,
+		Q:   q,
+	}
+	mock.lockFilterLogs.Lock()
+	mock.calls.FilterLogs = append(mock.calls.FilterLogs, callInfo)
+	mock.lockFilterLogs.Unlock()
+	return mock.FilterLogsFunc(ctx, q)
+}
+
+// FilterLogsCalls gets all the calls that were made to FilterLogs.
+// Check the length with:
+//     len(mockedClient.FilterLogsCalls())
+func (mock *ClientMock) FilterLogsCalls() []struct {
+	Ctx context.Context
+	Q   ethereum.FilterQuery
+} {
+	var calls []struct {
+		Ctx context.Context
+		Q   ethereum.FilterQuery
+	}
+	mock.lockFilterLogs.RLock()
+	calls = mock.calls.FilterLogs
+	mock.lockFilterLogs.RUnlock()
+	return calls
+}
+
 // TransactionByHash calls TransactionByHashFunc.
 func (mock *ClientMock) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
 	if mock.TransactionByHashFunc == nil {
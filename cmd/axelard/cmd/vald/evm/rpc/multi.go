@@ -0,0 +1,188 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/rpcpool"
+)
+
+const notFoundKey = "not found"
+
+// MultiClient spreads calls across redundant endpoints of the same EVM chain. With a quorum of one it fails over
+// to the next endpoint when a call fails, otherwise the results of enough endpoints have to agree
+type MultiClient struct {
+	clients []Client
+	pool    *rpcpool.Pool
+}
+
+// NewMultiClient returns a client for the given endpoints
+func NewMultiClient(clients []Client, quorum int, maxBlockLag uint64, logger log.Logger) (*MultiClient, error) {
+	pool, err := rpcpool.NewPool(len(clients), quorum, maxBlockLag, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiClient{clients: clients, pool: pool}, nil
+}
+
+// EndpointHealth returns the result of the last health check for each endpoint
+func (c *MultiClient) EndpointHealth() []bool {
+	return c.pool.Health()
+}
+
+// CheckHealth updates the health of all endpoints based on their latest block number
+func (c *MultiClient) CheckHealth(ctx context.Context) {
+	c.pool.CheckHealth(func(i int) (uint64, error) { return c.clients[i].BlockNumber(ctx) })
+}
+
+// BlockNumber returns the most recent block number. In quorum mode this is the highest block number reached by a quorum of endpoints
+func (c *MultiClient) BlockNumber(ctx context.Context) (uint64, error) {
+	if c.pool.Quorum() == 1 {
+		var number uint64
+		err := c.pool.Failover(func(i int) error {
+			var err error
+			number, err = c.clients[i].BlockNumber(ctx)
+			return err
+		})
+		return number, err
+	}
+
+	mu := sync.Mutex{}
+	var numbers []uint64
+	c.pool.Each(func(i int) error {
+		number, err := c.clients[i].BlockNumber(ctx)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		numbers = append(numbers, number)
+		return nil
+	})
+
+	return rpcpool.HighestAgreed(numbers, c.pool.Quorum())
+}
+
+// TransactionByHash returns the transaction with the given hash
+func (c *MultiClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	txs := make([]*types.Transaction, len(c.clients))
+	isPending := make([]bool, len(c.clients))
+	errs := make([]error, len(c.clients))
+
+	call := func(i int) (string, error) {
+		txs[i], isPending[i], errs[i] = c.clients[i].TransactionByHash(ctx, hash)
+		if errors.Is(errs[i], ethereum.NotFound) {
+			return notFoundKey, nil
+		}
+		if errs[i] != nil {
+			return "", errs[i]
+		}
+
+		bz, err := txs[i].MarshalJSON()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s %t", bz, isPending[i]), nil
+	}
+
+	i, err := c.call(call)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return txs[i], isPending[i], errs[i]
+}
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash
+func (c *MultiClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipts := make([]*types.Receipt, len(c.clients))
+	errs := make([]error, len(c.clients))
+
+	call := func(i int) (string, error) {
+		receipts[i], errs[i] = c.clients[i].TransactionReceipt(ctx, txHash)
+		if errors.Is(errs[i], ethereum.NotFound) {
+			return notFoundKey, nil
+		}
+		if errs[i] != nil {
+			return "", errs[i]
+		}
+
+		bz, err := json.Marshal(receipts[i])
+		if err != nil {
+			return "", err
+		}
+		return string(bz), nil
+	}
+
+	i, err := c.call(call)
+	if err != nil {
+		return nil, err
+	}
+
+	return receipts[i], errs[i]
+}
+
+// FilterLogs returns the logs matching the given query
+func (c *MultiClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	logs := make([][]types.Log, len(c.clients))
+
+	call := func(i int) (string, error) {
+		var err error
+		logs[i], err = c.clients[i].FilterLogs(ctx, q)
+		if err != nil {
+			return "", err
+		}
+
+		bz, err := json.Marshal(logs[i])
+		if err != nil {
+			return "", err
+		}
+		return string(bz), nil
+	}
+
+	i, err := c.call(call)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs[i], nil
+}
+
+// call executes the given call either with failover or on all endpoints, depending on the quorum,
+// and returns the index of an endpoint whose result can be used
+func (c *MultiClient) call(call func(i int) (string, error)) (int, error) {
+	if c.pool.Quorum() == 1 {
+		var idx int
+		err := c.pool.Failover(func(i int) error {
+			key, err := call(i)
+			if err != nil {
+				return err
+			}
+			// another endpoint might know about the transaction
+			if key == notFoundKey {
+				return ethereum.NotFound
+			}
+
+			idx = i
+			return nil
+		})
+		return idx, err
+	}
+
+	agreed, err := c.pool.Agree(call)
+	if err != nil {
+		return 0, err
+	}
+
+	return agreed[0], nil
+}
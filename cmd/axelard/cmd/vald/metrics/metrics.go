@@ -0,0 +1,220 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+)
+
+const namespace = "vald"
+
+// Outcomes of observed operations
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+var (
+	eventsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "received_total",
+		Help:      "Number of events received per handler",
+	}, []string{"handler"})
+
+	eventErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "errors_total",
+		Help:      "Number of events per handler that could not be processed",
+	}, []string{"handler"})
+
+	eventDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "processing_duration_seconds",
+		Help:      "Time it takes a handler to process an event",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 8),
+	}, []string{"handler"})
+
+	msgsBroadcast = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "broadcaster",
+		Name:      "msgs_total",
+		Help:      "Number of broadcast messages per message type and outcome",
+	}, []string{"type", "outcome"})
+
+	broadcastRetries = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "broadcaster",
+		Name:      "retries_total",
+		Help:      "Number of retried broadcasts",
+	})
+
+	broadcastQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "broadcaster",
+		Name:      "queue_depth",
+		Help:      "Number of broadcasts waiting in the pipeline",
+	})
+
+	tofndDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "tofnd",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of unary tofnd gRPC calls per method and status code",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	tofndStreams = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tofnd",
+		Name:      "streams_total",
+		Help:      "Number of tofnd gRPC streams opened per method and status code",
+	}, []string{"method", "code"})
+
+	tssSessions = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "tss",
+		Name:      "active_sessions",
+		Help:      "Number of keygen and sign sessions that have not timed out yet",
+	})
+
+	rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of external chain rpc calls per chain and method",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"chain", "method"})
+
+	rpcErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "errors_total",
+		Help:      "Number of failed external chain rpc calls per chain and method",
+	}, []string{"chain", "method"})
+
+	blockHeight = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "last_processed_block_height",
+		Help:      "Height of the last block for which all events have been processed",
+	})
+)
+
+// NewServer returns an http server exposing all metrics at /metrics
+func NewServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return &http.Server{Addr: addr, Handler: mux}
+}
+
+// InstrumentEventHandler counts the events passed to the given handler and measures how long they take to process
+func InstrumentEventHandler(handler string, f func(e tmEvents.Event) error) func(e tmEvents.Event) error {
+	return func(e tmEvents.Event) error {
+		eventsReceived.WithLabelValues(handler).Inc()
+
+		start := time.Now()
+		err := f(e)
+		eventDuration.WithLabelValues(handler).Observe(time.Since(start).Seconds())
+
+		if err != nil {
+			eventErrors.WithLabelValues(handler).Inc()
+		}
+		return err
+	}
+}
+
+// Broadcaster counts the broadcast messages by type and outcome
+type Broadcaster struct {
+	broadcaster types.Broadcaster
+}
+
+// InstrumentBroadcaster returns a broadcaster that records the outcome of all messages sent through the given broadcaster
+func InstrumentBroadcaster(broadcaster types.Broadcaster) Broadcaster {
+	return Broadcaster{broadcaster: broadcaster}
+}
+
+// Broadcast sends the passed messages to the network. This function in thread-safe.
+func (b Broadcaster) Broadcast(ctx sdkClient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	response, err := b.broadcaster.Broadcast(ctx, msgs...)
+
+	outcome := OutcomeSuccess
+	if err != nil {
+		outcome = OutcomeFailure
+	}
+	for _, msg := range msgs {
+		msgsBroadcast.WithLabelValues(msgType(msg), outcome).Inc()
+	}
+
+	return response, err
+}
+
+// msgType returns the type URL of the given message, or of the inner message if it is wrapped for a refund
+func msgType(msg sdk.Msg) string {
+	if refundMsg, ok := msg.(*axelarnet.RefundMsgRequest); ok {
+		if inner := refundMsg.GetInnerMessage(); inner != nil {
+			return sdk.MsgTypeURL(inner)
+		}
+	}
+
+	return sdk.MsgTypeURL(msg)
+}
+
+// IncBroadcastRetries counts a retried broadcast
+func IncBroadcastRetries() {
+	broadcastRetries.Inc()
+}
+
+// AddBroadcastQueueDepth changes the number of queued broadcasts by the given delta
+func AddBroadcastQueueDepth(delta float64) {
+	broadcastQueueDepth.Add(delta)
+}
+
+// AddTSSSessions changes the number of active tss sessions by the given delta
+func AddTSSSessions(delta float64) {
+	tssSessions.Add(delta)
+}
+
+// SetLastProcessedBlockHeight records the height of the last completely processed block
+func SetLastProcessedBlockHeight(height int64) {
+	blockHeight.Set(float64(height))
+}
+
+// ObserveRPC records the latency and the error of an external chain rpc call that was started at the given time
+func ObserveRPC(chain string, method string, start time.Time, err error) {
+	rpcDuration.WithLabelValues(chain, method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		rpcErrors.WithLabelValues(chain, method).Inc()
+	}
+}
+
+// TofndUnaryInterceptor measures the latency of unary tofnd gRPC calls
+func TofndUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	tofndDuration.WithLabelValues(method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+
+	return err
+}
+
+// TofndStreamInterceptor counts the tofnd gRPC streams that are opened
+func TofndStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	tofndStreams.WithLabelValues(method, status.Code(err).String()).Inc()
+
+	return stream, err
+}
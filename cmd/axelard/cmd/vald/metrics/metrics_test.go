@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
+)
+
+func TestInstrumentEventHandler(t *testing.T) {
+	t.Run("should count received events and errors", testutils.Func(func(t *testing.T) {
+		handler := rand.StrBetween(5, 20)
+		fail := rand.Bools(0.5).Next()
+
+		f := InstrumentEventHandler(handler, func(tmEvents.Event) error {
+			if fail {
+				return fmt.Errorf("some error")
+			}
+			return nil
+		})
+
+		count := int(rand.I64Between(1, 20))
+		for i := 0; i < count; i++ {
+			err := f(tmEvents.Event{})
+			assert.Equal(t, fail, err != nil)
+		}
+
+		assert.Equal(t, float64(count), testutil.ToFloat64(eventsReceived.WithLabelValues(handler)))
+		if fail {
+			assert.Equal(t, float64(count), testutil.ToFloat64(eventErrors.WithLabelValues(handler)))
+		} else {
+			assert.Equal(t, float64(0), testutil.ToFloat64(eventErrors.WithLabelValues(handler)))
+		}
+	}).Repeat(20))
+}
+
+func TestBroadcaster(t *testing.T) {
+	t.Run("should count messages by inner type and outcome", testutils.Func(func(t *testing.T) {
+		var msgs []sdk.Msg
+		count := int(rand.I64Between(1, 20))
+		for i := 0; i < count; i++ {
+			msgs = append(msgs, axelarnet.NewRefundMsgRequest(rand.AccAddr(), &evm.VoteConfirmDepositRequest{}))
+		}
+
+		fail := rand.Bools(0.5).Next()
+		b := InstrumentBroadcaster(&mock.BroadcasterMock{
+			BroadcastFunc: func(client.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+				if fail {
+					return nil, fmt.Errorf("some error")
+				}
+				return &sdk.TxResponse{}, nil
+			},
+		})
+
+		outcome := OutcomeSuccess
+		if fail {
+			outcome = OutcomeFailure
+		}
+		counter := msgsBroadcast.WithLabelValues(sdk.MsgTypeURL(&evm.VoteConfirmDepositRequest{}), outcome)
+		before := testutil.ToFloat64(counter)
+
+		_, err := b.Broadcast(client.Context{}, msgs...)
+		assert.Equal(t, fail, err != nil)
+		assert.Equal(t, before+float64(count), testutil.ToFloat64(counter))
+	}).Repeat(20))
+}
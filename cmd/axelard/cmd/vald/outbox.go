@@ -0,0 +1,91 @@
+package vald
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster"
+	axelarnetTypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+)
+
+// msgReference returns the poll or tss session the first message with such an association belongs to
+func msgReference(msgs []sdk.Msg) broadcaster.Reference {
+	for _, msg := range msgs {
+		if refundMsg, ok := msg.(*axelarnetTypes.RefundMsgRequest); ok {
+			msg = refundMsg.GetInnerMessage()
+		}
+
+		switch msg := msg.(type) {
+		case *tssTypes.ProcessKeygenTrafficRequest:
+			return broadcaster.Reference{Kind: broadcaster.ReferenceSession, Module: tssTypes.ModuleName, ID: msg.SessionID}
+		case *tssTypes.ProcessSignTrafficRequest:
+			return broadcaster.Reference{Kind: broadcaster.ReferenceSession, Module: tssTypes.ModuleName, ID: msg.SessionID}
+		case *tssTypes.VotePubKeyRequest:
+			return pollReference(msg.PollKey)
+		case *tssTypes.VoteSigRequest:
+			return pollReference(msg.PollKey)
+		case *btcTypes.VoteConfirmOutpointRequest:
+			return pollReference(msg.PollKey)
+		case *btcTypes.VoteFeeRateRequest:
+			return broadcaster.Reference{Kind: broadcaster.ReferenceFeeRate, Module: btcTypes.ModuleName, ID: strconv.FormatInt(msg.ConfTarget, 10)}
+		case *evmTypes.VoteConfirmChainRequest:
+			return pollReference(msg.PollKey)
+		case *evmTypes.VoteConfirmDepositRequest:
+			return pollReference(msg.PollKey)
+		case *evmTypes.VoteConfirmTokenRequest:
+			return pollReference(msg.PollKey)
+		case *evmTypes.VoteConfirmTransferKeyRequest:
+			return pollReference(msg.PollKey)
+		case *evmTypes.VoteConfirmGatewayDeploymentRequest:
+			return pollReference(msg.PollKey)
+		case *evmTypes.ReportDepositRequest:
+			pollKey := evmTypes.GetDepositPollKey(msg.TxID, msg.BurnerAddress, msg.Amount)
+			return broadcaster.Reference{Kind: broadcaster.ReferenceReport, Module: pollKey.Module, ID: pollKey.ID}
+		}
+	}
+
+	return broadcaster.Reference{}
+}
+
+func pollReference(pollKey vote.PollKey) broadcaster.Reference {
+	return broadcaster.Reference{Kind: broadcaster.ReferencePoll, Module: pollKey.Module, ID: pollKey.ID}
+}
+
+// isOutboxEntryRelevant returns a function that decides if a left over outbox entry should still be broadcast.
+// Votes are only relevant while their poll is pending and reports until the poll they start exists.
+// Fee rate votes are cast again every vote interval, so left over ones are outdated. Everything else is relevant until it is older than maxAge
+func isOutboxEntryRelevant(ctx sdkClient.Context, maxAge time.Duration) func(entry broadcaster.OutboxEntry) (bool, error) {
+	queryClient := voteTypes.NewQueryServiceClient(ctx)
+
+	return func(entry broadcaster.OutboxEntry) (bool, error) {
+		switch entry.Reference.Kind {
+		case broadcaster.ReferencePoll:
+			res, err := queryClient.Poll(context.Background(), &voteTypes.QueryPollRequest{Module: entry.Reference.Module, ID: entry.Reference.ID})
+			if err != nil {
+				return false, err
+			}
+
+			return res.Poll.Metadata.State&vote.Pending == vote.Pending, nil
+		case broadcaster.ReferenceReport:
+			// the poll cannot be found until the report is processed
+			if _, err := queryClient.Poll(context.Background(), &voteTypes.QueryPollRequest{Module: entry.Reference.Module, ID: entry.Reference.ID}); err == nil {
+				return false, nil
+			}
+
+			return time.Since(entry.CreatedAt) < maxAge, nil
+		case broadcaster.ReferenceFeeRate:
+			return false, nil
+		default:
+			return time.Since(entry.CreatedAt) < maxAge, nil
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package vald
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	axelarnetTypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+func TestMsgReference(t *testing.T) {
+	repeats := 20
+
+	t.Run("should reference the poll of a wrapped vote", testutils.Func(func(t *testing.T) {
+		sender := rand.AccAddr()
+		pollKey := vote.NewPollKey(evmTypes.ModuleName, rand.StrBetween(5, 20))
+		msg := evmTypes.NewVoteConfirmDepositRequest(sender, rand.StrBetween(5, 10), pollKey, common.BytesToHash(rand.Bytes(common.HashLength)), evmTypes.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))), true)
+
+		ref := msgReference([]sdk.Msg{axelarnetTypes.NewRefundMsgRequest(sender, msg)})
+		assert.Equal(t, broadcaster.Reference{Kind: broadcaster.ReferencePoll, Module: pollKey.Module, ID: pollKey.ID}, ref)
+	}).Repeat(repeats))
+
+	t.Run("should reference the session of tss traffic", testutils.Func(func(t *testing.T) {
+		sessionID := rand.StrBetween(5, 20)
+		msg := &tssTypes.ProcessSignTrafficRequest{Sender: rand.AccAddr(), SessionID: sessionID}
+
+		ref := msgReference([]sdk.Msg{msg})
+		assert.Equal(t, broadcaster.Reference{Kind: broadcaster.ReferenceSession, Module: tssTypes.ModuleName, ID: sessionID}, ref)
+	}).Repeat(repeats))
+
+	t.Run("should reference the confirmation target of a fee rate vote", testutils.Func(func(t *testing.T) {
+		sender := rand.AccAddr()
+		confTarget := rand.I64Between(1, 1000)
+		msg := btcTypes.NewVoteFeeRateRequest(sender, confTarget, btcutil.Amount(rand.PosI64()))
+
+		ref := msgReference([]sdk.Msg{axelarnetTypes.NewRefundMsgRequest(sender, msg)})
+		assert.Equal(t, broadcaster.Reference{Kind: broadcaster.ReferenceFeeRate, Module: btcTypes.ModuleName, ID: strconv.FormatInt(confTarget, 10)}, ref)
+	}).Repeat(repeats))
+
+	t.Run("should reference the poll a deposit report starts", testutils.Func(func(t *testing.T) {
+		sender := rand.AccAddr()
+		txID := common.BytesToHash(rand.Bytes(common.HashLength))
+		burnerAddr := common.BytesToAddress(rand.Bytes(common.AddressLength))
+		amount := sdk.NewUint(uint64(rand.PosI64()))
+		msg := evmTypes.NewReportDepositRequest(sender, rand.StrBetween(5, 10), txID, amount, burnerAddr)
+
+		pollKey := evmTypes.GetDepositPollKey(evmTypes.Hash(txID), evmTypes.Address(burnerAddr), amount)
+		ref := msgReference([]sdk.Msg{msg})
+		assert.Equal(t, broadcaster.Reference{Kind: broadcaster.ReferenceReport, Module: pollKey.Module, ID: pollKey.ID}, ref)
+	}).Repeat(repeats))
+
+	t.Run("should not reference anything for other messages", func(t *testing.T) {
+		msg := tssTypes.NewHeartBeatRequest(rand.AccAddr(), nil)
+
+		assert.Equal(t, broadcaster.Reference{}, msgReference([]sdk.Msg{msg}))
+	})
+}
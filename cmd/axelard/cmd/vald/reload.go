@@ -0,0 +1,339 @@
+package vald
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc"
+	btcRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/config"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm"
+	evmRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss"
+	tssRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss/rpc"
+	utils2 "github.com/axelarnetwork/axelar-core/utils"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+// configFiles returns the config files vald reads its configuration from
+func configFiles(home string) []string {
+	return []string{
+		filepath.Join(home, "config", "config.toml"),
+		filepath.Join(home, "config", "app.toml"),
+	}
+}
+
+// loadValdConfig reads the config files of the given home directory again into v and returns the resulting vald configuration.
+// Flags bound to v keep taking precedence over the files
+func loadValdConfig(v *viper.Viper, home string) (config.ValdConfig, error) {
+	files := configFiles(home)
+
+	v.SetConfigFile(files[0])
+	if err := v.ReadInConfig(); err != nil {
+		return config.ValdConfig{}, sdkerrors.Wrapf(err, "failed to read in %s", files[0])
+	}
+
+	for _, file := range files[1:] {
+		v.SetConfigFile(file)
+		if err := v.MergeInConfig(); err != nil {
+			return config.ValdConfig{}, sdkerrors.Wrapf(err, "failed to merge %s", file)
+		}
+	}
+
+	valdConf := config.DefaultValdConfig()
+	if err := v.Unmarshal(&valdConf); err != nil {
+		return config.ValdConfig{}, err
+	}
+
+	return valdConf, nil
+}
+
+// replaced rpc clients stay open for this long so calls that are still in flight can finish
+const rpcCloseDelay = time.Minute
+
+type tssConnector func(cfg tssTypes.TssConfig) (tssRPC.Client, tssRPC.MultiSigClient, func(), error)
+type btcConnector func(cfg btcTypes.BtcConfig, healthCheckInterval time.Duration) (btcRPC.Client, func(), error)
+type evmConnector func(cfg evmTypes.EVMConfig, healthCheckInterval time.Duration) (evmRPC.Client, func(), error)
+
+// configReloader applies changes of the vald configuration to the running process.
+// Connections are only replaced once their successors are established, so a broken configuration leaves vald running as before
+type configReloader struct {
+	lock   sync.Mutex
+	load   func() (config.ValdConfig, error)
+	logger log.Logger
+
+	pipeline     *broadcaster.RetryPipeline
+	batched      *broadcaster.BatchedBroadcaster
+	broadcastCfg config.BroadcastConfig
+
+	tssMgr     *tss.Mgr
+	connectTSS tssConnector
+	tssCfg     tssTypes.TssConfig
+	tssClose   func()
+
+	btcMgr     *btc.Mgr
+	connectBTC btcConnector
+	btcCfg     btcTypes.BtcConfig
+	btcClose   func()
+
+	evmMgr     *evm.Mgr
+	connectEVM evmConnector
+	evmCfgs    map[string]evmTypes.EVMConfig
+	evmClose   map[string]func()
+
+	healthCheckInterval time.Duration
+	otherCfg            config.ValdConfig
+}
+
+// Reload loads the current configuration and applies all changes that can be made without a restart
+func (r *configReloader) Reload() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	cfg, err := r.load()
+	if err != nil {
+		r.logger.Error(sdkerrors.Wrap(err, "failed to reload vald configuration, keeping the previous one").Error())
+		return
+	}
+
+	r.logger.Info("reloading vald configuration")
+
+	reconnectAll := cfg.RPCHealthCheckInterval != r.healthCheckInterval
+	r.healthCheckInterval = cfg.RPCHealthCheckInterval
+
+	r.applyBroadcastConfig(cfg.BroadcastConfig)
+	r.applyTSSConfig(cfg.TssConfig)
+	r.applyBTCConfig(cfg.BtcConfig, reconnectAll)
+	r.applyEVMConfig(cfg.EVMConfig, reconnectAll)
+
+	if !reflect.DeepEqual(withoutReloadable(cfg), withoutReloadable(r.otherCfg)) {
+		r.logger.Info("some changes of the vald configuration only take effect after a restart")
+	}
+	// changes are only reported once
+	r.otherCfg = cfg
+}
+
+// Close shuts down all connections to tofnd and external chains
+func (r *configReloader) Close() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.tssClose != nil {
+		r.tssClose()
+	}
+
+	if r.btcClose != nil {
+		r.btcClose()
+	}
+
+	for _, closer := range r.evmClose {
+		if closer != nil {
+			closer()
+		}
+	}
+}
+
+func (r *configReloader) applyBroadcastConfig(cfg config.BroadcastConfig) {
+	if cfg == r.broadcastCfg {
+		return
+	}
+
+	r.pipeline.SetRetryPolicy(cfg.MaxRetries, utils2.LinearBackOff(cfg.MinTimeout))
+	r.batched.SetLimits(cfg.MaxBatchMsgCount, cfg.MaxBatchBytes)
+	r.broadcastCfg = cfg
+
+	r.logger.Info("updated broadcast configuration")
+}
+
+func (r *configReloader) applyTSSConfig(cfg tssTypes.TssConfig) {
+	if cfg == r.tssCfg {
+		return
+	}
+
+	client, multiSigClient, closer, err := r.connectTSS(cfg)
+	if err != nil {
+		r.logger.Error(sdkerrors.Wrap(err, "failed to connect to tofnd with the new configuration, keeping the previous connection").Error())
+		return
+	}
+
+	r.tssMgr.SetClients(client, multiSigClient)
+
+	// running sessions still stream through the previous connection, so it is only closed once they have timed out
+	previousClose := r.tssClose
+	if previousClose != nil {
+		time.AfterFunc(r.tssMgr.Timeout, previousClose)
+	}
+
+	r.tssCfg = cfg
+	r.tssClose = closer
+
+	r.logger.Info("reconnected to tofnd")
+}
+
+func (r *configReloader) applyBTCConfig(cfg btcTypes.BtcConfig, force bool) {
+	if !force && reflect.DeepEqual(cfg, r.btcCfg) {
+		return
+	}
+
+	client, closer, err := r.connectBTC(cfg, r.healthCheckInterval)
+	if err != nil {
+		r.logger.Error(sdkerrors.Wrap(err, "failed to connect to Bitcoin with the new configuration, keeping the previous connection").Error())
+		return
+	}
+
+	r.btcMgr.SetRPC(client)
+	if r.btcClose != nil {
+		time.AfterFunc(rpcCloseDelay, r.btcClose)
+	}
+
+	r.btcCfg = cfg
+	r.btcClose = closer
+
+	r.logger.Info("updated Bitcoin connection")
+}
+
+func (r *configReloader) applyEVMConfig(cfgs []evmTypes.EVMConfig, force bool) {
+	updated, err := evmBridgeConfigs(cfgs)
+	if err != nil {
+		r.logger.Error(sdkerrors.Wrap(err, "keeping the previous EVM connections").Error())
+		return
+	}
+
+	for chain, cfg := range r.evmCfgs {
+		if _, found := updated[chain]; found {
+			continue
+		}
+
+		r.evmMgr.RemoveRPC(chain)
+		r.evmMgr.SetDepositDetection(chain, false)
+		if closer := r.evmClose[chain]; closer != nil {
+			time.AfterFunc(rpcCloseDelay, closer)
+		}
+
+		delete(r.evmCfgs, chain)
+		delete(r.evmClose, chain)
+
+		r.logger.Info(fmt.Sprintf("disconnected from EVM chain %s", cfg.Name))
+	}
+
+	for chain, cfg := range updated {
+		previous, found := r.evmCfgs[chain]
+		if found && !force && reflect.DeepEqual(cfg, previous) {
+			continue
+		}
+
+		client, closer, err := r.connectEVM(cfg, r.healthCheckInterval)
+		if err != nil {
+			r.logger.Error(sdkerrors.Wrapf(err, "failed to connect to EVM chain %s with the new configuration", cfg.Name).Error())
+			continue
+		}
+
+		r.evmMgr.SetRPC(chain, client)
+		r.evmMgr.SetDepositDetection(chain, cfg.DetectDeposits)
+		if closer := r.evmClose[chain]; found && closer != nil {
+			time.AfterFunc(rpcCloseDelay, closer)
+		}
+
+		r.evmCfgs[chain] = cfg
+		r.evmClose[chain] = closer
+
+		r.logger.Info(fmt.Sprintf("connected to EVM chain %s", cfg.Name))
+	}
+}
+
+// evmBridgeConfigs returns the configurations of all EVM chains vald should connect to by their lower case name
+func evmBridgeConfigs(cfgs []evmTypes.EVMConfig) (map[string]evmTypes.EVMConfig, error) {
+	bridges := make(map[string]evmTypes.EVMConfig)
+	for _, cfg := range cfgs {
+		if !cfg.WithBridge {
+			continue
+		}
+
+		chain := strings.ToLower(cfg.Name)
+		if _, found := bridges[chain]; found {
+			return nil, fmt.Errorf("duplicate bridge configuration found for EVM chain %s", cfg.Name)
+		}
+		bridges[chain] = cfg
+	}
+
+	return bridges, nil
+}
+
+// withoutReloadable clears all fields of the given configuration that can be changed without a restart
+func withoutReloadable(cfg config.ValdConfig) config.ValdConfig {
+	cfg.BroadcastConfig = config.BroadcastConfig{MaxPendingAge: cfg.MaxPendingAge}
+	cfg.TssConfig = tssTypes.TssConfig{}
+	cfg.BtcConfig = btcTypes.BtcConfig{}
+	cfg.EVMConfig = nil
+	cfg.RPCHealthCheckInterval = 0
+
+	return cfg
+}
+
+// startConfigReloads reloads the configuration whenever the process receives SIGHUP and,
+// if the poll interval is positive, whenever one of the config files changes
+func startConfigReloads(reloader *configReloader, files []string, pollInterval time.Duration, logger log.Logger) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	done := make(chan struct{})
+	cleanupCommands = append(cleanupCommands, func() {
+		signal.Stop(sighup)
+		close(done)
+	})
+
+	var poll <-chan time.Time
+	if pollInterval > 0 {
+		ticker := time.NewTicker(pollInterval)
+		cleanupCommands = append(cleanupCommands, ticker.Stop)
+		poll = ticker.C
+	}
+
+	go func() {
+		modTimes := readModTimes(files)
+		for {
+			select {
+			case <-done:
+				return
+			case <-sighup:
+				logger.Info("captured signal \"hangup\"")
+				modTimes = readModTimes(files)
+				reloader.Reload()
+			case <-poll:
+				latest := readModTimes(files)
+				if reflect.DeepEqual(latest, modTimes) {
+					continue
+				}
+
+				logger.Info("detected change of the vald configuration files")
+				modTimes = latest
+				reloader.Reload()
+			}
+		}
+	}()
+}
+
+func readModTimes(files []string) []time.Time {
+	modTimes := make([]time.Time, len(files))
+	for i, file := range files {
+		if info, err := os.Stat(file); err == nil {
+			modTimes[i] = info.ModTime()
+		}
+	}
+
+	return modTimes
+}
@@ -0,0 +1,158 @@
+package vald
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/config"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm"
+	evmRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+)
+
+func TestConfigReloader_Reload(t *testing.T) {
+	var (
+		reloader  *configReloader
+		cfg       config.ValdConfig
+		connected map[string]int
+		failing   map[string]bool
+	)
+
+	setup := func() {
+		cfg = config.DefaultValdConfig()
+		cfg.EVMConfig = nil
+		for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+			cfg.EVMConfig = append(cfg.EVMConfig, evmTypes.EVMConfig{Name: fmt.Sprintf("chain%d", i), RPCAddr: rand.Str(10), WithBridge: true})
+		}
+
+		connected = make(map[string]int)
+		failing = make(map[string]bool)
+
+		evmCfgs, err := evmBridgeConfigs(cfg.EVMConfig)
+		assert.NoError(t, err)
+
+		evmClose := make(map[string]func())
+		for chain := range evmCfgs {
+			evmClose[chain] = func() {}
+		}
+
+		reloader = &configReloader{
+			load:         func() (config.ValdConfig, error) { return cfg, nil },
+			logger:       log.TestingLogger(),
+			broadcastCfg: cfg.BroadcastConfig,
+			tssCfg:       cfg.TssConfig,
+			btcCfg:       cfg.BtcConfig,
+			evmMgr:       evm.NewMgr(make(map[string]evmRPC.Client), sdkClient.Context{}, nil, log.TestingLogger(), nil),
+			connectEVM: func(cfg evmTypes.EVMConfig, _ time.Duration) (evmRPC.Client, func(), error) {
+				if failing[strings.ToLower(cfg.Name)] {
+					return nil, nil, fmt.Errorf("some error")
+				}
+
+				connected[strings.ToLower(cfg.Name)]++
+				return &mock.ClientMock{}, func() {}, nil
+			},
+			evmCfgs:             evmCfgs,
+			evmClose:            evmClose,
+			healthCheckInterval: cfg.RPCHealthCheckInterval,
+			otherCfg:            cfg,
+		}
+	}
+
+	t.Run("should only reconnect to changed and added chains", testutils.Func(func(t *testing.T) {
+		setup()
+
+		cfg.EVMConfig = append([]evmTypes.EVMConfig{}, cfg.EVMConfig...)
+		changed := int(rand.I64Between(0, int64(len(cfg.EVMConfig))))
+		cfg.EVMConfig[changed].RPCAddr = rand.Str(11)
+		cfg.EVMConfig = append(cfg.EVMConfig, evmTypes.EVMConfig{Name: "new", RPCAddr: rand.Str(10), WithBridge: true})
+
+		reloader.Reload()
+
+		assert.Equal(t, map[string]int{strings.ToLower(cfg.EVMConfig[changed].Name): 1, "new": 1}, connected)
+		assert.Len(t, reloader.evmCfgs, len(cfg.EVMConfig))
+		assert.Len(t, reloader.evmClose, len(cfg.EVMConfig))
+	}).Repeat(20))
+
+	t.Run("should disconnect from removed chains", testutils.Func(func(t *testing.T) {
+		setup()
+
+		removed := cfg.EVMConfig[0]
+		if rand.Bools(0.5).Next() {
+			cfg.EVMConfig = cfg.EVMConfig[1:]
+		} else {
+			cfg.EVMConfig = append([]evmTypes.EVMConfig{}, cfg.EVMConfig...)
+			cfg.EVMConfig[0].WithBridge = false
+		}
+
+		reloader.Reload()
+
+		assert.Empty(t, connected)
+		assert.NotContains(t, reloader.evmCfgs, strings.ToLower(removed.Name))
+		assert.NotContains(t, reloader.evmClose, strings.ToLower(removed.Name))
+	}).Repeat(20))
+
+	t.Run("should keep the previous connection if the new one fails and retry on the next reload", testutils.Func(func(t *testing.T) {
+		setup()
+
+		cfg.EVMConfig = append([]evmTypes.EVMConfig{}, cfg.EVMConfig...)
+		cfg.EVMConfig[0].RPCAddr = rand.Str(11)
+		chain := strings.ToLower(cfg.EVMConfig[0].Name)
+		previous := reloader.evmCfgs[chain]
+
+		failing[chain] = true
+		reloader.Reload()
+
+		assert.Empty(t, connected)
+		assert.Equal(t, previous, reloader.evmCfgs[chain])
+
+		failing[chain] = false
+		reloader.Reload()
+
+		assert.Equal(t, map[string]int{chain: 1}, connected)
+		assert.Equal(t, cfg.EVMConfig[0], reloader.evmCfgs[chain])
+	}).Repeat(20))
+
+	t.Run("should keep all connections on duplicate chain configurations", testutils.Func(func(t *testing.T) {
+		setup()
+
+		previous := reloader.evmCfgs
+		duplicate := cfg.EVMConfig[0]
+		duplicate.RPCAddr = rand.Str(11)
+		cfg.EVMConfig = append(cfg.EVMConfig, duplicate)
+
+		reloader.Reload()
+
+		assert.Empty(t, connected)
+		assert.Equal(t, previous, reloader.evmCfgs)
+	}).Repeat(20))
+
+	t.Run("should remember changes that require a restart", testutils.Func(func(t *testing.T) {
+		setup()
+
+		cfg.ConfigPollInterval = cfg.ConfigPollInterval + time.Duration(rand.I64Between(1, 1000))*time.Second
+		reloader.Reload()
+
+		assert.Equal(t, cfg, reloader.otherCfg)
+	}).Repeat(20))
+
+	t.Run("should keep the configuration if it cannot be loaded", testutils.Func(func(t *testing.T) {
+		setup()
+
+		previous := reloader.evmCfgs
+		reloader.load = func() (config.ValdConfig, error) { return config.ValdConfig{}, fmt.Errorf("some error") }
+
+		reloader.Reload()
+
+		assert.Empty(t, connected)
+		assert.Equal(t, previous, reloader.evmCfgs)
+	}).Repeat(20))
+}
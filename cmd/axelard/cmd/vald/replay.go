@@ -0,0 +1,450 @@
+package vald
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/config"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm"
+	evmRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss"
+	axelarnetTypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+const (
+	flagReplayFrom       = "from"
+	flagReplayTo         = "to"
+	flagBroadcasterAddr  = "broadcaster-addr"
+	flagVoteWindow       = "vote-window"
+	txSearchResultsLimit = 100
+)
+
+// GetValdReplayCommand returns the command to replay vald's event handlers over a historical block range
+func GetValdReplayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-replay",
+		Short: "Replay vald's event handlers over a historical block range without broadcasting and compare the result with what was sent on-chain",
+		Long: "Replay vald's event handlers over a historical block range without broadcasting and compare the result with what was sent on-chain. " +
+			"Events are processed one after another in block order. Chain confirmations are checked against the current state of the external chains. " +
+			"Threshold keygen and sign sessions are interactive protocols between all validators and cannot be replayed, " +
+			"so their messages are left out of the comparison.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			logger := serverCtx.Logger.With("module", "vald-replay")
+
+			cliCtx, err := sdkClient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := cmd.Flags().GetInt64(flagReplayFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagReplayTo)
+			if err != nil {
+				return err
+			}
+			if from <= 0 || to < from {
+				return fmt.Errorf("invalid block range [%d, %d]", from, to)
+			}
+
+			voteWindow, err := cmd.Flags().GetInt64(flagVoteWindow)
+			if err != nil {
+				return err
+			}
+
+			skipTofnd, err := cmd.Flags().GetBool(flagSkipTofnd)
+			if err != nil {
+				return err
+			}
+
+			addrStr, err := cmd.Flags().GetString(flagBroadcasterAddr)
+			if err != nil {
+				return err
+			}
+			broadcasterAddr, err := sdk.AccAddressFromBech32(addrStr)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid broadcaster address")
+			}
+			cliCtx = cliCtx.WithFromAddress(broadcasterAddr)
+
+			valdConf := config.DefaultValdConfig()
+			if err := serverCtx.Viper.Unmarshal(&valdConf); err != nil {
+				return err
+			}
+
+			tmClient, err := cliCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			recorder := &recordingBroadcaster{}
+			handlers, withTSS := createReplayHandlers(cliCtx, valdConf, recorder, skipTofnd, serverCtx.Viper.GetString("validator-addr"), logger)
+
+			replayed, errs, err := replayBlocks(cmd.Context(), tmClient, from, to, handlers, recorder, logger)
+			if err != nil {
+				return err
+			}
+
+			actual, err := querySentMsgs(cmd.Context(), cliCtx, tmClient, broadcasterAddr, from, to+voteWindow)
+			if err != nil {
+				return err
+			}
+
+			report, err := diffMsgs(cliCtx.Codec, replayed, filterMsgs(actual, replayableMsgTypes(withTSS)))
+			if err != nil {
+				return err
+			}
+			report.Errors = errs
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().Int64(flagReplayFrom, 0, "first block height of the replayed range")
+	cmd.Flags().Int64(flagReplayTo, 0, "last block height of the replayed range")
+	cmd.Flags().String(flagBroadcasterAddr, "", "address of the validator's broadcaster account whose messages are compared with the replay")
+	cmd.Flags().Int64(flagVoteWindow, 20, "number of blocks after the replayed range in which sent messages are still taken into account")
+	cmd.Flags().Bool(flagSkipTofnd, false, "do not connect to tofnd and leave heartbeats out of the replay")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().String(flags.FlagChainID, app.Name, "The network chain ID")
+	cmd.Flags().String("validator-addr", "", "the address of the validator operator")
+	for _, flag := range []string{flagReplayFrom, flagReplayTo, flagBroadcasterAddr} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
+
+// recordingBroadcaster keeps the messages it is asked to broadcast instead of sending them
+type recordingBroadcaster struct {
+	height   int64
+	handler  string
+	recorded []recordedMsg
+}
+
+type recordedMsg struct {
+	Height  int64
+	Handler string
+	Msg     sdk.Msg
+}
+
+// Broadcast implements the Broadcaster interface
+func (r *recordingBroadcaster) Broadcast(_ sdkClient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	for _, msg := range msgs {
+		r.recorded = append(r.recorded, recordedMsg{Height: r.height, Handler: r.handler, Msg: msg})
+	}
+
+	return &sdk.TxResponse{Height: r.height}, nil
+}
+
+// createReplayHandlers returns the handlers of all events that cause vald to send messages other than tss session traffic.
+// Also returns whether tss heartbeats are part of the replay
+func createReplayHandlers(cliCtx sdkClient.Context, valdConf config.ValdConfig, b *recordingBroadcaster, skipTofnd bool, valAddr string, logger log.Logger) ([]chain.Subscription, bool) {
+	cdc := app.MakeEncodingConfig().Amino
+	var handlers []chain.Subscription
+
+	withTSS := false
+	if !skipTofnd {
+		client, multiSigClient, _, err := connectTofnd(valdConf.TssConfig, logger)
+		if err != nil {
+			logger.Error(sdkerrors.Wrap(err, "could not connect to tofnd, leaving heartbeats out of the replay").Error())
+		} else {
+			tssMgr := tss.NewMgr(client, multiSigClient, cliCtx, timeout, valAddr, b, logger, cdc)
+			handlers = append(handlers, chain.Subscription{
+				Name:      "ProcessHeartBeatEvent",
+				EventType: tssTypes.EventTypeHeartBeat,
+				Module:    tssTypes.ModuleName,
+				Action:    tssTypes.AttributeValueSend,
+				EndBlock:  true,
+				Handle:    tssMgr.ProcessHeartBeatEvent,
+			})
+			withTSS = true
+		}
+	}
+
+	btcRPC, _, err := connectBTC(valdConf.BtcConfig, 0, logger)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	btcMgr := btc.NewMgr(btcRPC, cliCtx, b, logger, cdc)
+
+	rpcs := make(map[string]evmRPC.Client)
+	evmCfgs, err := evmBridgeConfigs(valdConf.EVMConfig)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	for name, evmCfg := range evmCfgs {
+		rpc, _, err := connectEVMChain(evmCfg, 0, logger)
+		if err != nil {
+			logger.Error(err.Error())
+			continue
+		}
+		rpcs[name] = rpc
+	}
+	evmMgr := evm.NewMgr(rpcs, cliCtx, b, logger, cdc)
+
+	handlers = append(handlers, newChainRegistry(btcMgr, evmMgr).Subscriptions()...)
+
+	return handlers, withTSS
+}
+
+// replayError is an error a handler returned during the replay
+type replayError struct {
+	Height  int64  `json:"height"`
+	Handler string `json:"handler"`
+	Error   string `json:"error"`
+}
+
+// replayBlocks passes the events of all blocks in the given range to the matching handlers, one at a time and in block order
+func replayBlocks(ctx context.Context, client rpcclient.Client, from, to int64, handlers []chain.Subscription, recorder *recordingBroadcaster, logger log.Logger) ([]recordedMsg, []replayError, error) {
+	var errs []replayError
+	for height := from; height <= to; height++ {
+		h := height
+		block, err := client.BlockResults(ctx, &h)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "failed to query results of block %d", height)
+		}
+
+		abciEvents := append(block.BeginBlockEvents, block.EndBlockEvents...)
+		for _, txRes := range block.TxsResults {
+			abciEvents = append(abciEvents, txRes.Events...)
+		}
+
+		for _, event := range parseEvents(abciEvents, height) {
+			for _, handler := range handlers {
+				if !handler.Matches(event) {
+					continue
+				}
+
+				recorder.height, recorder.handler = height, handler.Name
+				if err := handler.Handle(event); err != nil {
+					logger.Debug(fmt.Sprintf("handler %s failed at height %d: %s", handler.Name, height, err.Error()))
+					errs = append(errs, replayError{Height: height, Handler: handler.Name, Error: err.Error()})
+				}
+			}
+		}
+
+		logger.Debug(fmt.Sprintf("replayed block %d", height))
+	}
+
+	return recorder.recorded, errs, nil
+}
+
+func parseEvents(abciEvents []abci.Event, height int64) []tmEvents.Event {
+	var events []tmEvents.Event
+	for _, abciEvent := range abciEvents {
+		event, err := tmEvents.Parse(abciEvent)
+		if err != nil {
+			continue
+		}
+		event.Height = height
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// querySentMsgs returns all messages of successful transactions the given sender submitted within the given block range
+func querySentMsgs(ctx context.Context, cliCtx sdkClient.Context, client rpcclient.Client, sender sdk.AccAddress, from, to int64) ([]recordedMsg, error) {
+	query := fmt.Sprintf("%s.%s='%s' AND tx.height>=%d AND tx.height<=%d", sdk.EventTypeMessage, sdk.AttributeKeySender, sender.String(), from, to)
+
+	var msgs []recordedMsg
+	for page := 1; ; page++ {
+		p, limit := page, txSearchResultsLimit
+		res, err := client.TxSearch(ctx, query, false, &p, &limit, "asc")
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to search for sent transactions")
+		}
+
+		for _, txRes := range res.Txs {
+			if txRes.TxResult.Code != abci.CodeTypeOK {
+				continue
+			}
+
+			tx, err := cliCtx.TxConfig.TxDecoder()(txRes.Tx)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to decode transaction at height %d", txRes.Height)
+			}
+
+			for _, msg := range tx.GetMsgs() {
+				msgs = append(msgs, recordedMsg{Height: txRes.Height, Msg: msg})
+			}
+		}
+
+		if page*limit >= res.TotalCount {
+			return msgs, nil
+		}
+	}
+}
+
+// replayableMsgTypes returns the type URLs of all messages the replay can reproduce
+func replayableMsgTypes(withTSS bool) map[string]bool {
+	msgs := []sdk.Msg{
+		&btcTypes.VoteConfirmOutpointRequest{},
+		&btcTypes.VoteFeeRateRequest{},
+		&evmTypes.VoteConfirmChainRequest{},
+		&evmTypes.VoteConfirmGatewayDeploymentRequest{},
+		&evmTypes.VoteConfirmDepositRequest{},
+		&evmTypes.VoteConfirmTokenRequest{},
+		&evmTypes.VoteConfirmTransferKeyRequest{},
+	}
+	if withTSS {
+		msgs = append(msgs, &tssTypes.HeartBeatRequest{})
+	}
+
+	types := make(map[string]bool)
+	for _, msg := range msgs {
+		types[sdk.MsgTypeURL(msg)] = true
+	}
+
+	return types
+}
+
+// filterMsgs unwraps refundable messages and keeps those of the given types
+func filterMsgs(msgs []recordedMsg, types map[string]bool) []recordedMsg {
+	var filtered []recordedMsg
+	for _, msg := range msgs {
+		msg.Msg = unwrapRefundMsg(msg.Msg)
+		if types[sdk.MsgTypeURL(msg.Msg)] {
+			filtered = append(filtered, msg)
+		}
+	}
+
+	return filtered
+}
+
+func unwrapRefundMsg(msg sdk.Msg) sdk.Msg {
+	if refundMsg, ok := msg.(*axelarnetTypes.RefundMsgRequest); ok {
+		return refundMsg.GetInnerMessage()
+	}
+
+	return msg
+}
+
+// replayedMsg is a message in the replay report
+type replayedMsg struct {
+	Height  int64           `json:"height"`
+	Handler string          `json:"handler,omitempty"`
+	Type    string          `json:"type"`
+	Msg     json.RawMessage `json:"msg"`
+}
+
+// msgMismatch is a message that was sent on-chain with a different content than in the replay
+type msgMismatch struct {
+	Replayed replayedMsg `json:"replayed"`
+	Sent     replayedMsg `json:"sent"`
+}
+
+// replayReport is the result of a replay
+type replayReport struct {
+	Replayed []replayedMsg `json:"replayed"`
+	// messages that would have been sent but are missing on-chain
+	Missing []replayedMsg `json:"missing"`
+	// messages that were sent on-chain but did not come up in the replay
+	Unexpected []replayedMsg `json:"unexpected"`
+	Mismatched []msgMismatch `json:"mismatched"`
+	Errors     []replayError `json:"errors"`
+}
+
+// diffMsgs compares the replayed with the actually sent messages. Messages are matched by type and the poll they vote on,
+// messages that do not belong to a poll are matched by type in the order they were sent
+func diffMsgs(cdc codec.Codec, replayed []recordedMsg, sent []recordedMsg) (replayReport, error) {
+	replayedByKey, replayedKeys, err := keyMsgs(cdc, replayed)
+	if err != nil {
+		return replayReport{}, err
+	}
+
+	sentByKey, _, err := keyMsgs(cdc, sent)
+	if err != nil {
+		return replayReport{}, err
+	}
+
+	report := replayReport{}
+	for _, key := range replayedKeys {
+		r := replayedByKey[key]
+		report.Replayed = append(report.Replayed, r)
+
+		s, ok := sentByKey[key]
+		switch {
+		case !ok:
+			report.Missing = append(report.Missing, r)
+		case string(s.Msg) != string(r.Msg):
+			report.Mismatched = append(report.Mismatched, msgMismatch{Replayed: r, Sent: s})
+		}
+	}
+
+	var unexpectedKeys []string
+	for key := range sentByKey {
+		if _, ok := replayedByKey[key]; !ok {
+			unexpectedKeys = append(unexpectedKeys, key)
+		}
+	}
+	sort.Slice(unexpectedKeys, func(i, j int) bool {
+		return sentByKey[unexpectedKeys[i]].Height < sentByKey[unexpectedKeys[j]].Height ||
+			sentByKey[unexpectedKeys[i]].Height == sentByKey[unexpectedKeys[j]].Height && unexpectedKeys[i] < unexpectedKeys[j]
+	})
+	for _, key := range unexpectedKeys {
+		report.Unexpected = append(report.Unexpected, sentByKey[key])
+	}
+
+	return report, nil
+}
+
+// keyMsgs returns the given messages by their matching key, as well as the keys in the order of the messages
+func keyMsgs(cdc codec.Codec, msgs []recordedMsg) (map[string]replayedMsg, []string, error) {
+	byKey := make(map[string]replayedMsg)
+	var keys []string
+	occurrences := make(map[string]int)
+
+	for _, msg := range msgs {
+		inner := unwrapRefundMsg(msg.Msg)
+		typeURL := sdk.MsgTypeURL(inner)
+
+		key := typeURL
+		if ref := msgReference([]sdk.Msg{inner}); ref.ID != "" {
+			key = fmt.Sprintf("%s/%s/%s", typeURL, ref.Module, ref.ID)
+		}
+		occurrences[key]++
+		key = fmt.Sprintf("%s#%d", key, occurrences[key]-1)
+
+		bz, err := cdc.MarshalInterfaceJSON(inner)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		byKey[key] = replayedMsg{Height: msg.Height, Handler: msg.Handler, Type: typeURL, Msg: bz}
+		keys = append(keys, key)
+	}
+
+	return byKey, keys, nil
+}
@@ -0,0 +1,165 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	axelarnetTypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+type blockResultsClient struct {
+	rpcclient.Client
+	blocks map[int64]*coretypes.ResultBlockResults
+}
+
+func (c blockResultsClient) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	block, ok := c.blocks[*height]
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", *height)
+	}
+
+	return block, nil
+}
+
+func TestReplayBlocks(t *testing.T) {
+	t.Run("should pass matching events to handlers in block order", testutils.Func(func(t *testing.T) {
+		from := rand.I64Between(1, 1000)
+		to := from + rand.I64Between(0, 20)
+
+		rpc := blockResultsClient{blocks: make(map[int64]*coretypes.ResultBlockResults)}
+		var expected []int64
+		for height := from; height <= to; height++ {
+			block := &coretypes.ResultBlockResults{Height: height}
+			if rand.Bools(0.5).Next() {
+				block.TxsResults = []*abci.ResponseDeliverTx{{Events: []abci.Event{
+					newEvent(evmTypes.EventTypeDepositConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart),
+					newEvent(evmTypes.EventTypeDepositConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueVote),
+				}}}
+				expected = append(expected, height)
+			}
+			rpc.blocks[height] = block
+		}
+
+		recorder := &recordingBroadcaster{}
+		sender := rand.AccAddr()
+		handlers := []chain.Subscription{{
+			Name:      "ProcessDepositConfirmation",
+			EventType: evmTypes.EventTypeDepositConfirmation,
+			Module:    evmTypes.ModuleName,
+			Action:    evmTypes.AttributeValueStart,
+			Handle: func(e tmEvents.Event) error {
+				_, err := recorder.Broadcast(client.Context{}, btcTypes.NewVoteFeeRateRequest(sender, e.Height, 1000))
+				return err
+			},
+		}}
+
+		recorded, errs, err := replayBlocks(context.Background(), rpc, from, to, handlers, recorder, log.TestingLogger())
+		assert.NoError(t, err)
+		assert.Empty(t, errs)
+		assert.Len(t, recorded, len(expected))
+		for i, msg := range recorded {
+			assert.Equal(t, expected[i], msg.Height)
+			assert.Equal(t, "ProcessDepositConfirmation", msg.Handler)
+		}
+	}).Repeat(20))
+}
+
+func newEvent(eventType, module, action string) abci.Event {
+	return abci.Event{
+		Type: eventType,
+		Attributes: []abci.EventAttribute{
+			{Key: []byte(sdk.AttributeKeyModule), Value: []byte(module)},
+			{Key: []byte(sdk.AttributeKeyAction), Value: []byte(action)},
+		},
+	}
+}
+
+func TestDiffMsgs(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	newVote := func(sender sdk.AccAddress, pollID string, confirmed bool) sdk.Msg {
+		return evmTypes.NewVoteConfirmChainRequest(sender, "ethereum", vote.NewPollKey(evmTypes.ModuleName, pollID), confirmed)
+	}
+
+	t.Run("should match votes by poll", testutils.Func(func(t *testing.T) {
+		sender := rand.AccAddr()
+
+		var replayed, sent []recordedMsg
+		var missing, mismatched, unexpected int
+		for i := 0; i < int(rand.I64Between(1, 20)); i++ {
+			pollID := rand.Str(10)
+			replayedVote := newVote(sender, pollID, true)
+			replayed = append(replayed, recordedMsg{Height: int64(i), Msg: replayedVote})
+
+			switch rand.I64Between(0, 3) {
+			case 0:
+				missing++
+			case 1:
+				mismatched++
+				sent = append(sent, recordedMsg{Height: int64(i + 1), Msg: axelarnetTypes.NewRefundMsgRequest(sender, newVote(sender, pollID, false))})
+			default:
+				sent = append(sent, recordedMsg{Height: int64(i + 1), Msg: axelarnetTypes.NewRefundMsgRequest(sender, replayedVote)})
+			}
+
+			if rand.Bools(0.2).Next() {
+				unexpected++
+				sent = append(sent, recordedMsg{Height: int64(i + 1), Msg: newVote(sender, rand.Str(11), true)})
+			}
+		}
+
+		report, err := diffMsgs(cdc, replayed, filterMsgs(sent, replayableMsgTypes(false)))
+		assert.NoError(t, err)
+		assert.Len(t, report.Replayed, len(replayed))
+		assert.Len(t, report.Missing, missing)
+		assert.Len(t, report.Mismatched, mismatched)
+		assert.Len(t, report.Unexpected, unexpected)
+	}).Repeat(20))
+
+	t.Run("should match messages without poll in order", testutils.Func(func(t *testing.T) {
+		sender := rand.AccAddr()
+		confTargets := []int64{1, 3, 6}
+
+		var replayed, sent []recordedMsg
+		for _, confTarget := range confTargets {
+			replayed = append(replayed, recordedMsg{Msg: btcTypes.NewVoteFeeRateRequest(sender, confTarget, 1000)})
+			sent = append(sent, recordedMsg{Msg: btcTypes.NewVoteFeeRateRequest(sender, confTarget, 1000)})
+		}
+
+		report, err := diffMsgs(cdc, replayed, sent)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Missing)
+		assert.Empty(t, report.Mismatched)
+		assert.Empty(t, report.Unexpected)
+
+		report, err = diffMsgs(cdc, replayed, sent[:len(sent)-1])
+		assert.NoError(t, err)
+		assert.Len(t, report.Missing, 1)
+	}).Repeat(20))
+
+	t.Run("should ignore messages that cannot be replayed", testutils.Func(func(t *testing.T) {
+		sender := rand.AccAddr()
+		sent := []recordedMsg{{Msg: axelarnetTypes.NewRefundMsgRequest(sender, &tssTypes.HeartBeatRequest{Sender: sender})}}
+
+		report, err := diffMsgs(cdc, nil, filterMsgs(sent, replayableMsgTypes(false)))
+		assert.NoError(t, err)
+		assert.Empty(t, report.Unexpected)
+	}).Repeat(20))
+}
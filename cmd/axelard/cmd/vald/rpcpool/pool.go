@@ -0,0 +1,237 @@
+package rpcpool
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Pool keeps track of the health of redundant rpc endpoints of the same chain and distributes calls across them.
+// Endpoints are identified by their index
+type Pool struct {
+	mu      sync.RWMutex
+	healthy []bool
+	quorum  int
+	maxLag  uint64
+	logger  log.Logger
+}
+
+// NewPool returns a pool of the given number of endpoints which are all considered healthy initially.
+// A quorum of more than one endpoint means results must agree across that many endpoints,
+// endpoints lagging more than maxLag blocks behind the highest one are considered unhealthy
+func NewPool(size int, quorum int, maxLag uint64, logger log.Logger) (*Pool, error) {
+	if size == 0 {
+		return nil, fmt.Errorf("pool needs at least one endpoint")
+	}
+
+	if quorum < 1 {
+		quorum = 1
+	}
+
+	if quorum > size {
+		return nil, fmt.Errorf("quorum %d cannot be reached with %d endpoint(s)", quorum, size)
+	}
+
+	healthy := make([]bool, size)
+	for i := range healthy {
+		healthy[i] = true
+	}
+
+	return &Pool{healthy: healthy, quorum: quorum, maxLag: maxLag, logger: logger}, nil
+}
+
+// Quorum returns the number of endpoints that need to agree on a result
+func (p *Pool) Quorum() int {
+	return p.quorum
+}
+
+// IsHealthy returns true if the endpoint with the given index passed the last health check
+func (p *Pool) IsHealthy(i int) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.healthy[i]
+}
+
+// Health returns the result of the last health check for each endpoint
+func (p *Pool) Health() []bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	health := make([]bool, len(p.healthy))
+	copy(health, p.healthy)
+
+	return health
+}
+
+// Failover calls the endpoints one after another, healthy ones first, until a call succeeds.
+// Returns the error of the last call if all of them fail
+func (p *Pool) Failover(call func(i int) error) error {
+	var err error
+	for _, i := range p.order() {
+		if err = call(i); err == nil {
+			return nil
+		}
+
+		p.logger.Debug(fmt.Sprintf("rpc call to endpoint %d failed, trying next one: %s", i, err.Error()))
+	}
+
+	return err
+}
+
+// Each calls all healthy endpoints concurrently and returns the indices of the successful calls in ascending order
+func (p *Pool) Each(call func(i int) error) []int {
+	var indices []int
+	for i := range p.healthy {
+		if p.IsHealthy(i) {
+			indices = append(indices, i)
+		}
+	}
+
+	mu := sync.Mutex{}
+	var succeeded []int
+	wg := &sync.WaitGroup{}
+	for _, i := range indices {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			if err := call(i); err != nil {
+				p.logger.Debug(fmt.Sprintf("rpc call to endpoint %d failed: %s", i, err.Error()))
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			succeeded = append(succeeded, i)
+		}(i)
+	}
+	wg.Wait()
+
+	sort.Ints(succeeded)
+	return succeeded
+}
+
+// Agree calls all healthy endpoints concurrently. Each call returns a key that identifies its result, failed calls are not counted.
+// Returns the indices of the endpoints that agree on the result, as long as at least a quorum of endpoints agrees and no other result has the same support
+func (p *Pool) Agree(call func(i int) (string, error)) ([]int, error) {
+	mu := sync.Mutex{}
+	keys := make(map[int]string)
+	succeeded := p.Each(func(i int) error {
+		key, err := call(i)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		keys[i] = key
+		return nil
+	})
+
+	groups := make(map[string][]int)
+	for _, i := range succeeded {
+		groups[keys[i]] = append(groups[keys[i]], i)
+	}
+
+	var best []int
+	tie := false
+	for _, group := range groups {
+		switch {
+		case len(group) > len(best):
+			best = group
+			tie = false
+		case len(group) == len(best):
+			tie = true
+		}
+	}
+
+	switch {
+	case len(best) < p.quorum:
+		return nil, fmt.Errorf("no result is supported by a quorum of %d endpoints (%d of them responded)", p.quorum, len(succeeded))
+	case tie:
+		return nil, fmt.Errorf("endpoints returned conflicting results with equal support")
+	default:
+		return best, nil
+	}
+}
+
+// CheckHealth queries the latest block height of all endpoints concurrently and marks those as unhealthy
+// that fail to respond or lag more than the maximum number of blocks behind the highest one
+func (p *Pool) CheckHealth(height func(i int) (uint64, error)) {
+	heights := make([]uint64, len(p.healthy))
+	healthy := make([]bool, len(p.healthy))
+
+	wg := &sync.WaitGroup{}
+	for i := range p.healthy {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			h, err := height(i)
+			if err != nil {
+				p.logger.Debug(fmt.Sprintf("health check of rpc endpoint %d failed: %s", i, err.Error()))
+				return
+			}
+
+			heights[i] = h
+			healthy[i] = true
+		}(i)
+	}
+	wg.Wait()
+
+	var highest uint64
+	for i, h := range heights {
+		if healthy[i] && h > highest {
+			highest = h
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := range p.healthy {
+		isHealthy := healthy[i] && highest-heights[i] <= p.maxLag
+
+		switch {
+		case p.healthy[i] && !isHealthy:
+			p.logger.Info(fmt.Sprintf("rpc endpoint %d is unhealthy (block height %d, highest %d)", i, heights[i], highest))
+		case !p.healthy[i] && isHealthy:
+			p.logger.Info(fmt.Sprintf("rpc endpoint %d is healthy again", i))
+		}
+
+		p.healthy[i] = isHealthy
+	}
+}
+
+// order returns the endpoint indices with the healthy endpoints first
+func (p *Pool) order() []int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var healthy, unhealthy []int
+	for i, isHealthy := range p.healthy {
+		if isHealthy {
+			healthy = append(healthy, i)
+		} else {
+			unhealthy = append(unhealthy, i)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// HighestAgreed returns the highest value that at least quorum of the given values reach
+func HighestAgreed(values []uint64, quorum int) (uint64, error) {
+	if len(values) < quorum || quorum < 1 {
+		return 0, fmt.Errorf("got %d value(s), need at least %d", len(values), quorum)
+	}
+
+	sorted := make([]uint64, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	return sorted[quorum-1], nil
+}
@@ -0,0 +1,157 @@
+package rpcpool
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+)
+
+func TestPool_Failover(t *testing.T) {
+	t.Run("should prefer healthy endpoints and stop at the first success", testutils.Func(func(t *testing.T) {
+		size := int(rand.I64Between(2, 10))
+		pool, err := NewPool(size, 1, 0, log.TestingLogger())
+		assert.NoError(t, err)
+
+		unhealthy := int(rand.I64Between(0, int64(size)))
+		pool.CheckHealth(func(i int) (uint64, error) {
+			if i == unhealthy {
+				return 0, fmt.Errorf("some error")
+			}
+			return 100, nil
+		})
+
+		succeeding := int(rand.I64Between(0, int64(size)))
+		var called []int
+		err = pool.Failover(func(i int) error {
+			called = append(called, i)
+			if i == succeeding {
+				return nil
+			}
+			return fmt.Errorf("some error")
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, succeeding, called[len(called)-1])
+		if succeeding != unhealthy {
+			assert.NotContains(t, called, unhealthy)
+		} else {
+			assert.Len(t, called, size)
+		}
+	}).Repeat(20))
+
+	t.Run("should return an error if all endpoints fail", testutils.Func(func(t *testing.T) {
+		size := int(rand.I64Between(1, 10))
+		pool, err := NewPool(size, 1, 0, log.TestingLogger())
+		assert.NoError(t, err)
+
+		calls := 0
+		err = pool.Failover(func(int) error {
+			calls++
+			return fmt.Errorf("some error")
+		})
+		assert.Error(t, err)
+		assert.Equal(t, size, calls)
+	}).Repeat(20))
+}
+
+func TestPool_Agree(t *testing.T) {
+	t.Run("should return the endpoints that agree on the result", testutils.Func(func(t *testing.T) {
+		size := int(rand.I64Between(3, 10))
+		quorum := int(rand.I64Between(int64(size/2+1), int64(size)+1))
+		pool, err := NewPool(size, quorum, 0, log.TestingLogger())
+		assert.NoError(t, err)
+
+		agreeing := rand.I64Between(int64(quorum), int64(size)+1)
+		agreed, err := pool.Agree(func(i int) (string, error) {
+			if int64(i) < agreeing {
+				return "result", nil
+			}
+			return rand.Str(10), nil
+		})
+		assert.NoError(t, err)
+		assert.Len(t, agreed, int(agreeing))
+	}).Repeat(20))
+
+	t.Run("should fail without quorum", testutils.Func(func(t *testing.T) {
+		size := int(rand.I64Between(3, 10))
+		quorum := int(rand.I64Between(2, int64(size)+1))
+		pool, err := NewPool(size, quorum, 0, log.TestingLogger())
+		assert.NoError(t, err)
+
+		_, err = pool.Agree(func(i int) (string, error) {
+			if i < quorum-1 {
+				return "result", nil
+			}
+			if rand.Bools(0.5).Next() {
+				return "", fmt.Errorf("some error")
+			}
+			return rand.Str(10), nil
+		})
+		assert.Error(t, err)
+	}).Repeat(20))
+
+	t.Run("should fail on conflicting results with equal support", testutils.Func(func(t *testing.T) {
+		quorum := int(rand.I64Between(1, 5))
+		pool, err := NewPool(2*quorum, quorum, 0, log.TestingLogger())
+		assert.NoError(t, err)
+
+		_, err = pool.Agree(func(i int) (string, error) { return fmt.Sprint(i % 2), nil })
+		assert.Error(t, err)
+	}).Repeat(20))
+}
+
+func TestPool_CheckHealth(t *testing.T) {
+	t.Run("should mark lagging and failing endpoints as unhealthy", testutils.Func(func(t *testing.T) {
+		size := int(rand.I64Between(3, 20))
+		maxLag := uint64(rand.I64Between(0, 10))
+		pool, err := NewPool(size, 1, maxLag, log.TestingLogger())
+		assert.NoError(t, err)
+
+		highest := uint64(rand.I64Between(100, 1000))
+		heights := make([]uint64, size)
+		failing := make([]bool, size)
+		for i := range heights {
+			heights[i] = highest - uint64(rand.I64Between(0, 2*int64(maxLag)+1))
+			failing[i] = rand.Bools(0.2).Next()
+		}
+		heights[0] = highest
+		failing[0] = false
+
+		pool.CheckHealth(func(i int) (uint64, error) {
+			if failing[i] {
+				return 0, fmt.Errorf("some error")
+			}
+			return heights[i], nil
+		})
+
+		for i := range heights {
+			assert.Equal(t, !failing[i] && highest-heights[i] <= maxLag, pool.IsHealthy(i))
+		}
+
+		mu := sync.Mutex{}
+		var called []int
+		pool.Each(func(i int) error {
+			mu.Lock()
+			defer mu.Unlock()
+			called = append(called, i)
+			return nil
+		})
+		for _, i := range called {
+			assert.True(t, pool.IsHealthy(i))
+		}
+	}).Repeat(20))
+}
+
+func TestHighestAgreed(t *testing.T) {
+	value, err := HighestAgreed([]uint64{7, 10, 9, 3}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(9), value)
+
+	_, err = HighestAgreed([]uint64{10}, 2)
+	assert.Error(t, err)
+}
@@ -0,0 +1,118 @@
+package vald
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/signer"
+	signerTypes "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/signer/types"
+)
+
+const (
+	flagSignerListenAddr = "listen-addr"
+	flagSignerKey        = "key"
+)
+
+// GetValdSignerCommand returns the command to run a software signer for the vald broadcaster key
+func GetValdSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-signer",
+		Short: "Run a remote signer that signs the transactions of vald's broadcaster account",
+		Long: "Run a remote signer that signs the transactions of vald's broadcaster account, so the key does not need to be stored in vald's keyring. " +
+			"The signer only serves the given key on a unix socket that is accessible by its own user alone, " +
+			"and only signs transactions for the given chain that consist of messages vald broadcasts. " +
+			"Point vald to it by setting the address in the [remote-signer] section of its configuration.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			logger := serverCtx.Logger.With("module", "vald-signer")
+
+			home, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return err
+			}
+
+			listenAddr, err := cmd.Flags().GetString(flagSignerListenAddr)
+			if err != nil {
+				return err
+			}
+			if listenAddr == "" {
+				listenAddr = "unix://" + filepath.Join(home, "vald", "signer.sock")
+			}
+
+			keyName, err := cmd.Flags().GetString(flagSignerKey)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+
+			backend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+			if err != nil {
+				return err
+			}
+
+			keyringDir, err := cmd.Flags().GetString(flags.FlagKeyringDir)
+			if err != nil {
+				return err
+			}
+			if keyringDir == "" {
+				keyringDir = home
+			}
+
+			kr, err := keyring.New(sdk.KeyringServiceName(), backend, keyringDir, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			signerServer, err := signer.NewServer(kr, keyName, chainID, logger)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to load key %s", keyName)
+			}
+
+			listener, err := signer.Listen(listenAddr)
+			if err != nil {
+				return err
+			}
+
+			grpcServer := grpc.NewServer()
+			signerTypes.RegisterSignerServer(grpcServer, signerServer)
+
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				sig := <-sigs
+				logger.Info(fmt.Sprintf("captured signal \"%s\"", sig))
+				grpcServer.GracefulStop()
+			}()
+
+			logger.Info(fmt.Sprintf("serving key %s for chain %s at %s", keyName, chainID, listenAddr))
+			return grpcServer.Serve(listener)
+		},
+	}
+
+	cmd.Flags().String(flagSignerListenAddr, "", "unix socket to serve the signer at, of the form unix:///path/to/socket (default unix://<home>/vald/signer.sock)")
+	cmd.Flags().String(flagSignerKey, "", "name of the broadcaster key in the keyring")
+	cmd.Flags().String(flags.FlagChainID, app.Name, "The network chain ID")
+	cmd.Flags().String(flags.FlagKeyringBackend, keyring.BackendFile, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+	if err := cmd.MarkFlagRequired(flagSignerKey); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,65 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const unixPrefix = "unix://"
+
+// Listen listens on the unix socket at the given address of the form unix:///path/to/socket.
+// The signer does not authenticate its peers, so it is only served on a socket file that is accessible by the current user alone.
+// A stale socket file is removed
+func Listen(addr string) (net.Listener, error) {
+	path, err := socketPath(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to remove stale socket %s: %v", path, err)
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, 0600); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// Dial connects to the signer at the given address, see Listen for the supported address format
+func Dial(addr string, timeout time.Duration) (*grpc.ClientConn, error) {
+	path, err := socketPath(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	dialer := func(ctx context.Context, target string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, "unix", target)
+	}
+
+	return grpc.DialContext(ctx, path, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithContextDialer(dialer))
+}
+
+func socketPath(addr string) (string, error) {
+	if !strings.HasPrefix(addr, unixPrefix) || len(addr) == len(unixPrefix) {
+		return "", fmt.Errorf("invalid signer address %s, the signer is only served on unix sockets of the form unix:///path/to/socket", addr)
+	}
+
+	return strings.TrimPrefix(addr, unixPrefix), nil
+}
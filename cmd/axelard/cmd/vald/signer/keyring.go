@@ -0,0 +1,84 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/signer/types"
+)
+
+var _ keyring.Keyring = remoteKeyring{}
+
+// remoteKeyring only holds the public key of the broadcaster account and delegates signing to a remote signer
+type remoteKeyring struct {
+	keyring.Keyring
+	client  types.SignerClient
+	timeout time.Duration
+}
+
+// NewKeyring returns a keyring for the key with the given name that is held by the remote signer behind the given client
+func NewKeyring(client types.SignerClient, keyName string, timeout time.Duration) (keyring.Keyring, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	res, err := client.PubKey(ctx, &types.PubKeyRequest{KeyName: keyName})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to get public key %s from the remote signer", keyName)
+	}
+
+	if len(res.PubKey) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("remote signer returned a public key of invalid length %d", len(res.PubKey))
+	}
+
+	kr := keyring.NewInMemory()
+	if _, err := kr.SavePubKey(keyName, &secp256k1.PubKey{Key: res.PubKey}, hd.Secp256k1Type); err != nil {
+		return nil, err
+	}
+
+	return remoteKeyring{Keyring: kr, client: client, timeout: timeout}, nil
+}
+
+// Sign signs the given message with the remote key of the given name
+func (k remoteKeyring) Sign(uid string, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	info, err := k.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return k.sign(info, msg)
+}
+
+// SignByAddress signs the given message with the remote key of the given address
+func (k remoteKeyring) SignByAddress(address sdk.Address, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	info, err := k.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return k.sign(info, msg)
+}
+
+func (k remoteKeyring) sign(info keyring.Info, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), k.timeout)
+	defer cancel()
+
+	res, err := k.client.Sign(ctx, &types.SignRequest{KeyName: info.GetName(), SignBytes: msg})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "remote signer failed to sign")
+	}
+
+	// a faulty signature would only surface as a rejected transaction, so catch it early
+	if !info.GetPubKey().VerifySignature(msg, res.Signature) {
+		return nil, nil, fmt.Errorf("remote signer returned an invalid signature for key %s", info.GetName())
+	}
+
+	return res.Signature, info.GetPubKey(), nil
+}
@@ -0,0 +1,151 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/tendermint/tendermint/libs/log"
+
+	signerTypes "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/signer/types"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+var _ signerTypes.SignerServer = Server{}
+
+// valdMsgTypes contains the type URLs of all messages vald broadcasts, the signer refuses to sign any other message
+var valdMsgTypes = msgTypeURLs(
+	&tss.HeartBeatRequest{},
+	&tss.ProcessKeygenTrafficRequest{},
+	&tss.ProcessSignTrafficRequest{},
+	&tss.VotePubKeyRequest{},
+	&tss.VoteSigRequest{},
+	&tss.SubmitMultisigPubKeysRequest{},
+	&tss.SubmitMultisigSignaturesRequest{},
+	&btc.VoteConfirmOutpointRequest{},
+	&btc.VoteFeeRateRequest{},
+	&evm.VoteConfirmChainRequest{},
+	&evm.VoteConfirmDepositRequest{},
+	&evm.VoteConfirmTokenRequest{},
+	&evm.VoteConfirmTransferKeyRequest{},
+	&evm.VoteConfirmGatewayDeploymentRequest{},
+	&evm.ReportDepositRequest{},
+)
+
+// Server is a software signer that serves a single key of a local keyring to vald
+type Server struct {
+	keyring keyring.Keyring
+	keyName string
+	chainID string
+	logger  log.Logger
+}
+
+// NewServer returns a signer for the key with the given name that only signs transactions for the given chain
+func NewServer(kr keyring.Keyring, keyName string, chainID string, logger log.Logger) (Server, error) {
+	info, err := kr.Key(keyName)
+	if err != nil {
+		return Server{}, err
+	}
+
+	if _, ok := info.GetPubKey().(*secp256k1.PubKey); !ok {
+		return Server{}, fmt.Errorf("key %s must be a secp256k1 key", keyName)
+	}
+
+	return Server{
+		keyring: kr,
+		keyName: keyName,
+		chainID: chainID,
+		logger:  logger,
+	}, nil
+}
+
+// PubKey returns the public key of the served key
+func (s Server) PubKey(_ context.Context, req *signerTypes.PubKeyRequest) (*signerTypes.PubKeyResponse, error) {
+	if req.KeyName != s.keyName {
+		return nil, fmt.Errorf("unknown key %s", req.KeyName)
+	}
+
+	info, err := s.keyring.Key(s.keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signerTypes.PubKeyResponse{PubKey: info.GetPubKey().Bytes()}, nil
+}
+
+// Sign signs the given transaction if it is meant for the configured chain and only contains messages vald broadcasts
+func (s Server) Sign(_ context.Context, req *signerTypes.SignRequest) (*signerTypes.SignResponse, error) {
+	if req.KeyName != s.keyName {
+		return nil, fmt.Errorf("unknown key %s", req.KeyName)
+	}
+
+	var signDoc txtypes.SignDoc
+	if err := signDoc.Unmarshal(req.SignBytes); err != nil {
+		return nil, sdkerrors.Wrap(err, "sign bytes must be a serialized SignDoc")
+	}
+
+	if signDoc.ChainId != s.chainID {
+		return nil, fmt.Errorf("refusing to sign transaction for chain %s, expected chain %s", signDoc.ChainId, s.chainID)
+	}
+
+	var body txtypes.TxBody
+	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid transaction body")
+	}
+
+	if len(body.Messages) == 0 {
+		return nil, fmt.Errorf("refusing to sign transaction without messages")
+	}
+
+	for _, msg := range body.Messages {
+		if err := checkValdMsg(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	signature, _, err := s.keyring.Sign(s.keyName, req.SignBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug(fmt.Sprintf("signed transaction with account number %d", signDoc.AccountNumber))
+	return &signerTypes.SignResponse{Signature: signature}, nil
+}
+
+// checkValdMsg returns an error unless the given message is a vald message, either on its own or wrapped in a refund request
+func checkValdMsg(msg *types.Any) error {
+	if msg.TypeUrl == sdk.MsgTypeURL(&axelarnet.RefundMsgRequest{}) {
+		var req axelarnet.RefundMsgRequest
+		if err := req.Unmarshal(msg.Value); err != nil {
+			return sdkerrors.Wrap(err, "invalid refund request")
+		}
+
+		if req.InnerMessage == nil {
+			return fmt.Errorf("refusing to sign refund request without message")
+		}
+		msg = req.InnerMessage
+	}
+
+	if !valdMsgTypes[msg.TypeUrl] {
+		return fmt.Errorf("refusing to sign message of type %s", msg.TypeUrl)
+	}
+
+	return nil
+}
+
+func msgTypeURLs(msgs ...sdk.Msg) map[string]bool {
+	urls := make(map[string]bool)
+	for _, msg := range msgs {
+		urls[sdk.MsgTypeURL(msg)] = true
+	}
+
+	return urls
+}
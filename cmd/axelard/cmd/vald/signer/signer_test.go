@@ -0,0 +1,195 @@
+package signer_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/signer"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/signer/types"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	tssexported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+const timeout = time.Second
+
+// faultySigner returns corrupted signatures
+type faultySigner struct {
+	types.SignerServer
+}
+
+func (s faultySigner) Sign(ctx context.Context, req *types.SignRequest) (*types.SignResponse, error) {
+	res, err := s.SignerServer.Sign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Signature[0] ^= 0xff
+	return res, nil
+}
+
+func TestRemoteSigner(t *testing.T) {
+	var (
+		local   keyring.Keyring
+		keyName string
+		chainID string
+	)
+
+	setup := func(t *testing.T, wrap func(types.SignerServer) types.SignerServer) keyring.Keyring {
+		local = keyring.NewInMemory()
+		keyName = rand.StrBetween(5, 20)
+		chainID = rand.StrBetween(5, 20)
+		_, _, err := local.NewMnemonic(keyName, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		assert.NoError(t, err)
+
+		server, err := signer.NewServer(local, keyName, chainID, log.TestingLogger())
+		assert.NoError(t, err)
+
+		addr := "unix://" + filepath.Join(t.TempDir(), "signer.sock")
+		listener, err := signer.Listen(addr)
+		assert.NoError(t, err)
+
+		grpcServer := grpc.NewServer()
+		types.RegisterSignerServer(grpcServer, wrap(server))
+		go func() { _ = grpcServer.Serve(listener) }()
+		t.Cleanup(grpcServer.Stop)
+
+		conn, err := signer.Dial(addr, timeout)
+		assert.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+
+		remote, err := signer.NewKeyring(types.NewSignerClient(conn), keyName, timeout)
+		assert.NoError(t, err)
+
+		return remote
+	}
+
+	signMsgBytes := func(chainID string, msgs ...sdk.Msg) []byte {
+		anys := make([]*codectypes.Any, len(msgs))
+		for i, msg := range msgs {
+			var err error
+			anys[i], err = codectypes.NewAnyWithValue(msg)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		body := txtypes.TxBody{Messages: anys, Memo: rand.StrBetween(0, 20)}
+		bodyBytes, err := body.Marshal()
+		if err != nil {
+			panic(err)
+		}
+
+		signDoc := txtypes.SignDoc{BodyBytes: bodyBytes, AuthInfoBytes: rand.Bytes(50), ChainId: chainID, AccountNumber: uint64(rand.PosI64())}
+		bz, err := signDoc.Marshal()
+		if err != nil {
+			panic(err)
+		}
+
+		return bz
+	}
+
+	valdMsg := func() sdk.Msg {
+		return tss.NewHeartBeatRequest(rand.AccAddr(), []tssexported.KeyID{tssexported.KeyID(rand.StrBetween(5, 20))})
+	}
+
+	signBytes := func(chainID string) []byte {
+		msg := valdMsg()
+		return signMsgBytes(chainID, msg, axelarnet.NewRefundMsgRequest(msg.GetSigners()[0], valdMsg()))
+	}
+
+	noWrap := func(s types.SignerServer) types.SignerServer { return s }
+
+	t.Run("should sign transactions with the remote key", testutils.Func(func(t *testing.T) {
+		remote := setup(t, noWrap)
+
+		localInfo, err := local.Key(keyName)
+		assert.NoError(t, err)
+		remoteInfo, err := remote.Key(keyName)
+		assert.NoError(t, err)
+		assert.Equal(t, localInfo.GetAddress(), remoteInfo.GetAddress())
+
+		msg := signBytes(chainID)
+		signature, pubKey, err := remote.Sign(keyName, msg)
+		assert.NoError(t, err)
+		assert.True(t, localInfo.GetPubKey().Equals(pubKey))
+		assert.True(t, pubKey.VerifySignature(msg, signature))
+
+		signature, _, err = remote.SignByAddress(localInfo.GetAddress(), msg)
+		assert.NoError(t, err)
+		assert.True(t, pubKey.VerifySignature(msg, signature))
+	}).Repeat(5))
+
+	t.Run("should refuse to sign for other chains", testutils.Func(func(t *testing.T) {
+		remote := setup(t, noWrap)
+
+		_, _, err := remote.Sign(keyName, signBytes(chainID+rand.StrBetween(1, 5)))
+		assert.Error(t, err)
+
+		_, _, err = remote.Sign(keyName, rand.Bytes(int(rand.I64Between(1, 100))))
+		assert.Error(t, err)
+	}).Repeat(5))
+
+	t.Run("should refuse to sign messages vald does not broadcast", testutils.Func(func(t *testing.T) {
+		remote := setup(t, noWrap)
+
+		sender := rand.AccAddr()
+		send := banktypes.NewMsgSend(sender, rand.AccAddr(), sdk.NewCoins(sdk.NewInt64Coin("uaxl", rand.PosI64())))
+
+		_, _, err := remote.Sign(keyName, signMsgBytes(chainID, valdMsg(), send))
+		assert.Error(t, err)
+
+		_, _, err = remote.Sign(keyName, signMsgBytes(chainID, axelarnet.NewRefundMsgRequest(sender, send)))
+		assert.Error(t, err)
+
+		_, _, err = remote.Sign(keyName, signMsgBytes(chainID))
+		assert.Error(t, err)
+	}).Repeat(5))
+
+	t.Run("should only serve on unix sockets", func(t *testing.T) {
+		for _, addr := range []string{"tcp://127.0.0.1:0", "127.0.0.1:0", "unix://"} {
+			_, err := signer.Listen(addr)
+			assert.Error(t, err)
+
+			_, err = signer.Dial(addr, timeout)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("should only serve the configured key", testutils.Func(func(t *testing.T) {
+		remote := setup(t, noWrap)
+
+		_, _, err := remote.Sign(rand.StrBetween(21, 30), signBytes(chainID))
+		assert.Error(t, err)
+
+		_, err = signer.NewServer(local, rand.StrBetween(21, 30), chainID, log.TestingLogger())
+		assert.Error(t, err)
+
+		_, err = local.SavePubKey("ed25519", ed25519.GenPrivKey().PubKey(), hd.Ed25519Type)
+		assert.NoError(t, err)
+		_, err = signer.NewServer(local, "ed25519", chainID, log.TestingLogger())
+		assert.Error(t, err)
+	}).Repeat(5))
+
+	t.Run("should reject invalid signatures", testutils.Func(func(t *testing.T) {
+		remote := setup(t, func(s types.SignerServer) types.SignerServer { return faultySigner{s} })
+
+		_, _, err := remote.Sign(keyName, signBytes(chainID))
+		assert.Error(t, err)
+	}).Repeat(5))
+}
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
-	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -31,13 +31,19 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/app"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/utils"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/admin"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster"
 	broadcasterTypes "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc"
 	btcRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/btc/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/chain"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm"
 	evmRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/metrics"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/signer"
+	signerTypes "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/signer/types"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss"
+	tssRPC "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss/rpc"
 	utils2 "github.com/axelarnetwork/axelar-core/utils"
 	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
@@ -84,7 +90,12 @@ func GetValdCommand() *cobra.Command {
 				once.Do(cleanUp)
 			}()
 
-			cliCtx, err := sdkClient.GetClientTxContext(cmd)
+			valdConf := config.DefaultValdConfig()
+			if err := serverCtx.Viper.Unmarshal(&valdConf); err != nil {
+				panic(err)
+			}
+
+			cliCtx, err := getTxContext(cmd, valdConf.RemoteSignerConfig, logger)
 			if err != nil {
 				return err
 			}
@@ -92,11 +103,6 @@ func GetValdCommand() *cobra.Command {
 			// dynamically adjust gas limit by simulating the tx first
 			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithSimulateAndExecute(true)
 
-			valdConf := config.DefaultValdConfig()
-			if err := serverCtx.Viper.Unmarshal(&valdConf); err != nil {
-				panic(err)
-			}
-
 			valAddr := serverCtx.Viper.GetString("validator-addr")
 			if valAddr == "" {
 				return fmt.Errorf("validator address not set")
@@ -125,9 +131,12 @@ func GetValdCommand() *cobra.Command {
 
 			fPath := filepath.Join(valdHome, "state.json")
 			stateSource := NewRWFile(fPath)
+			outboxDir := filepath.Join(valdHome, "outbox")
+
+			loadConfig := func() (config.ValdConfig, error) { return loadValdConfig(serverCtx.Viper, cliCtx.HomeDir) }
 
 			logger.Info("start listening to events")
-			listen(cliCtx, txf, valdConf, valAddr, recoveryJSON, stateSource, logger)
+			listen(cliCtx, txf, valdConf, loadConfig, valAddr, recoveryJSON, stateSource, outboxDir, logger)
 			logger.Info("shutting down")
 			return nil
 		},
@@ -145,6 +154,47 @@ func GetValdCommand() *cobra.Command {
 	return cmd
 }
 
+// getTxContext returns the client context for transactions of the broadcaster account.
+// If a remote signer is configured, the account's key is not expected in the local keyring and signing is delegated to the signer
+func getTxContext(cmd *cobra.Command, cfg config.RemoteSignerConfig, logger log.Logger) (sdkClient.Context, error) {
+	if cfg.Address == "" {
+		return sdkClient.GetClientTxContext(cmd)
+	}
+
+	keyName, err := cmd.Flags().GetString(flags.FlagFrom)
+	if err != nil {
+		return sdkClient.Context{}, err
+	}
+
+	// the client context would otherwise look up the key in the local keyring
+	if err := cmd.Flags().Set(flags.FlagFrom, ""); err != nil {
+		return sdkClient.Context{}, err
+	}
+
+	cliCtx, err := sdkClient.GetClientTxContext(cmd)
+	if err != nil {
+		return sdkClient.Context{}, err
+	}
+
+	logger.Info(fmt.Sprintf("connecting to remote signer at %s", cfg.Address))
+	conn, err := signer.Dial(cfg.Address, cfg.Timeout)
+	if err != nil {
+		return sdkClient.Context{}, sdkerrors.Wrap(err, "failed to connect to the remote signer")
+	}
+	cleanupCommands = append(cleanupCommands, func() {
+		if err := conn.Close(); err != nil {
+			logger.Error(sdkerrors.Wrap(err, "failed to close remote signer connection").Error())
+		}
+	})
+
+	kr, err := signer.NewKeyring(signerTypes.NewSignerClient(conn), keyName, cfg.Timeout)
+	if err != nil {
+		return sdkClient.Context{}, err
+	}
+
+	return cliCtx.WithKeyring(kr).WithFrom(keyName), nil
+}
+
 func cleanUp() {
 	for _, cmd := range cleanupCommands {
 		cmd()
@@ -160,7 +210,7 @@ func setPersistentFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String(flags.FlagChainID, app.Name, "The network chain ID")
 }
 
-func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, valAddr string, recoveryJSON []byte, stateSource ReadWriter, logger log.Logger) {
+func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, loadConfig func() (config.ValdConfig, error), valAddr string, recoveryJSON []byte, stateSource ReadWriter, outboxDir string, logger log.Logger) {
 	encCfg := app.MakeEncodingConfig()
 	cdc := encCfg.Amino
 	sender, err := ctx.Keyring.Key(ctx.From)
@@ -171,7 +221,18 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 		WithFromAddress(sender.GetAddress()).
 		WithFromName(sender.GetName())
 
-	bc := createBroadcaster(txf, axelarCfg, logger)
+	outbox, err := broadcaster.NewOutbox(outboxDir, encCfg.Marshaler)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "failed to open the outbox of outgoing messages"))
+	}
+	pipeline, unbatchedBroadcaster, batchedBroadcaster := createBroadcaster(txf, axelarCfg, logger)
+	bc := broadcaster.NewPersistentBroadcaster(metrics.InstrumentBroadcaster(batchedBroadcaster), outbox, msgReference, logger)
+	// messages that are not refundable bypass the batching, so they never share a transaction with refundable ones
+	unbatchedBC := broadcaster.NewPersistentBroadcaster(metrics.InstrumentBroadcaster(unbatchedBroadcaster), outbox, msgReference, logger)
+
+	if axelarCfg.MetricsConfig.Enabled {
+		startMetricsServer(axelarCfg.MetricsConfig.ListenAddr, logger)
+	}
 
 	stateStore := NewStateStore(stateSource)
 	startBlock, err := stateStore.GetState()
@@ -192,15 +253,45 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 	}
 	eventBus := createEventBus(tmClient, startBlock, logger)
 
-	tssMgr := createTSSMgr(bc, ctx, axelarCfg, logger, valAddr, cdc)
+	tssMgr, tssClose := createTSSMgr(bc, ctx, axelarCfg, logger, valAddr, cdc)
 	if recoveryJSON != nil && len(recoveryJSON) > 0 {
 		if err = tssMgr.Recover(recoveryJSON); err != nil {
 			panic(fmt.Errorf("unable to perform tss recovery: %v", err))
 		}
 	}
 
-	btcMgr := createBTCMgr(axelarCfg, ctx, bc, logger, cdc)
-	evmMgr := createEVMMgr(axelarCfg, ctx, bc, logger, cdc)
+	btcMgr, btcClose := createBTCMgr(axelarCfg, ctx, bc, logger, cdc)
+	evmMgr, evmCfgs, evmClose := createEVMMgr(axelarCfg, ctx, bc, unbatchedBC, logger, cdc)
+
+	reloader := &configReloader{
+		load:         loadConfig,
+		logger:       logger,
+		pipeline:     pipeline,
+		batched:      batchedBroadcaster,
+		broadcastCfg: axelarCfg.BroadcastConfig,
+		tssMgr:       tssMgr,
+		connectTSS: func(cfg tssTypes.TssConfig) (tssRPC.Client, tssRPC.MultiSigClient, func(), error) {
+			return connectTofnd(cfg, logger)
+		},
+		tssCfg:   axelarCfg.TssConfig,
+		tssClose: tssClose,
+		btcMgr:   btcMgr,
+		connectBTC: func(cfg btcTypes.BtcConfig, interval time.Duration) (btcRPC.Client, func(), error) {
+			return connectBTC(cfg, interval, logger)
+		},
+		btcCfg:   axelarCfg.BtcConfig,
+		btcClose: btcClose,
+		evmMgr:   evmMgr,
+		connectEVM: func(cfg evmTypes.EVMConfig, interval time.Duration) (evmRPC.Client, func(), error) {
+			return connectEVMChain(cfg, interval, logger)
+		},
+		evmCfgs:             evmCfgs,
+		evmClose:            evmClose,
+		healthCheckInterval: axelarCfg.RPCHealthCheckInterval,
+		otherCfg:            axelarCfg,
+	}
+	cleanupCommands = append(cleanupCommands, reloader.Close)
+	startConfigReloads(reloader, configFiles(ctx.HomeDir), axelarCfg.ConfigPollInterval, logger)
 
 	// we have two processes listening to block headers
 	blockHeaderForTSS := tmEvents.MustSubscribeBlockHeader(eventBus)
@@ -228,14 +319,20 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 	keygenMsg := subscribe(tssTypes.EventTypeKeygen, tssTypes.ModuleName, tssTypes.AttributeValueMsg)
 	signMsg := subscribe(tssTypes.EventTypeSign, tssTypes.ModuleName, tssTypes.AttributeValueMsg)
 
-	btcConf := subscribe(btcTypes.EventTypeOutpointConfirmation, btcTypes.ModuleName, btcTypes.AttributeValueStart)
+	chains := newChainRegistry(btcMgr, evmMgr)
 
-	evmNewChain := subscribe(evmTypes.EventTypeNewChain, evmTypes.ModuleName, evmTypes.AttributeValueUpdate)
-	evmChainConf := subscribe(evmTypes.EventTypeChainConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
-	evmGatewayDeploymentConf := subscribe(evmTypes.EventTypeGatewayDeploymentConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
-	evmDepConf := subscribe(evmTypes.EventTypeDepositConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
-	evmTokConf := subscribe(evmTypes.EventTypeTokenConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
-	evmTraConf := subscribe(evmTypes.EventTypeTransferKeyConfirmation, evmTypes.ModuleName, evmTypes.AttributeValueStart)
+	chainSubscriptions := chains.Subscriptions()
+	chainSubscribers := make([]tmEvents.FilteredSubscriber, len(chainSubscriptions))
+	for i, subscription := range chainSubscriptions {
+		chainSubscribers[i] = subscribeToChainEvents(eventBus, subscription)
+	}
+
+	// messages that were still queued when vald last stopped are sent alongside the new ones
+	go func() {
+		if err := bc.Replay(ctx, isOutboxEntryRelevant(ctx, axelarCfg.MaxPendingAge)); err != nil {
+			logger.Error(sdkerrors.Wrap(err, "failed to replay outgoing messages").Error())
+		}
+	}()
 
 	eventCtx, cancelEventCtx := context.WithCancel(context.Background())
 	// stop the jobs if process gets interrupted/terminated
@@ -249,6 +346,27 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 		logger.Info("event listener stopped")
 	})
 
+	handlers := admin.NewHandlers()
+	handle := func(name string, f func(e tmEvents.Event) error) func(e tmEvents.Event) error {
+		return handlers.Wrap(name, metrics.InstrumentEventHandler(name, f))
+	}
+
+	if axelarCfg.AdminConfig.Enabled {
+		startAdminServer(axelarCfg.AdminConfig.ListenAddr, admin.Backend{
+			Sessions: tssMgr,
+			Handlers: handlers,
+			Broadcaster: func() admin.BroadcasterStatus {
+				return admin.BroadcasterStatus{Backlog: batchedBroadcaster.Len(), Pipeline: pipeline.Len()}
+			},
+			BlockHeight: func() int64 {
+				// no block has been completed yet if the state cannot be read
+				height, _ := stateStore.GetState()
+				return height
+			},
+			Chains: func() []admin.ChainStatus { return chainStatuses(chains) },
+		}, logger)
+	}
+
 	fetchEvents := func(errChan chan<- error) { errChan <- <-eventBus.FetchEvents(eventCtx) }
 	js := []jobs.Job{
 		fetchEvents,
@@ -257,20 +375,19 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 			tssMgr.ProcessNewBlockHeader(height)
 			return nil
 		})),
-		tmEvents.Consume(heartbeat, tssMgr.ProcessHeartBeatEvent),
-		tmEvents.Consume(keygenStart, tssMgr.ProcessKeygenStart),
-		tmEvents.Consume(keygenMsg, tssMgr.ProcessKeygenMsg),
-		tmEvents.Consume(signStart, tssMgr.ProcessSignStart),
-		tmEvents.Consume(signMsg, tssMgr.ProcessSignMsg),
-		tmEvents.Consume(btcConf, btcMgr.ProcessConfirmation),
-		tmEvents.Consume(evmNewChain, evmMgr.ProcessNewChain),
-		tmEvents.Consume(evmChainConf, evmMgr.ProcessChainConfirmation),
-		tmEvents.Consume(evmGatewayDeploymentConf, evmMgr.ProcessGatewayDeploymentConfirmation),
-		tmEvents.Consume(evmDepConf, evmMgr.ProcessDepositConfirmation),
-		tmEvents.Consume(evmTokConf, evmMgr.ProcessTokenConfirmation),
-		tmEvents.Consume(evmTraConf, evmMgr.ProcessTransferKeyConfirmation),
+		tmEvents.Consume(heartbeat, handle("ProcessHeartBeatEvent", tssMgr.ProcessHeartBeatEvent)),
+		tmEvents.Consume(keygenStart, handle("ProcessKeygenStart", tssMgr.ProcessKeygenStart)),
+		tmEvents.Consume(keygenMsg, handle("ProcessKeygenMsg", tssMgr.ProcessKeygenMsg)),
+		tmEvents.Consume(signStart, handle("ProcessSignStart", tssMgr.ProcessSignStart)),
+		tmEvents.Consume(signMsg, handle("ProcessSignMsg", tssMgr.ProcessSignMsg)),
 	}
 
+	for i, subscription := range chainSubscriptions {
+		js = append(js, tmEvents.Consume(chainSubscribers[i], handle(subscription.Name, subscription.Handle)))
+	}
+
+	js = append(js, func(chan<- error) { evmMgr.DetectDeposits(eventCtx, axelarCfg.DepositScanInterval) })
+
 	// errGroup runs async processes and cancels their context if ANY of them returns an error.
 	// Here, we don't want to stop on errors, but simply log it and continue, so errGroup doesn't cut it
 	logErr := func(err error) { logger.Error(err.Error()) }
@@ -279,6 +396,82 @@ func listen(ctx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig,
 	mgr.Wait()
 }
 
+func startMetricsServer(addr string, logger log.Logger) {
+	server := metrics.NewServer(addr)
+	go func() {
+		logger.Info(fmt.Sprintf("serving metrics at %s", addr))
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error(sdkerrors.Wrap(err, "metrics server stopped").Error())
+		}
+	}()
+
+	cleanupCommands = append(cleanupCommands, func() {
+		logger.Info("stopping metrics server...")
+		if err := server.Shutdown(context.Background()); err != nil {
+			logger.Error(err.Error())
+		}
+	})
+}
+
+func startAdminServer(addr string, backend admin.Backend, logger log.Logger) {
+	server := admin.NewServer(addr, backend, logger.With("server", "admin"))
+	go func() {
+		logger.Info(fmt.Sprintf("serving admin API at %s", addr))
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error(sdkerrors.Wrap(err, "admin server stopped").Error())
+		}
+	}()
+
+	cleanupCommands = append(cleanupCommands, func() {
+		logger.Info("stopping admin server...")
+		if err := server.Shutdown(context.Background()); err != nil {
+			logger.Error(err.Error())
+		}
+	})
+}
+
+// newChainRegistry returns a registry of the given chain adapters. New chain families are added to vald by passing their adapter here
+func newChainRegistry(adapters ...chain.Adapter) *chain.Registry {
+	registry := chain.NewRegistry()
+	for _, adapter := range adapters {
+		if err := registry.Register(adapter); err != nil {
+			panic(err)
+		}
+	}
+
+	return registry
+}
+
+// chainStatuses returns the rpc endpoint health of all chains the registered adapters are connected to
+func chainStatuses(chains *chain.Registry) []admin.ChainStatus {
+	var statuses []admin.ChainStatus
+	for _, c := range chains.Chains() {
+		status := admin.ChainStatus{Name: c.Name}
+		for i, healthy := range c.Endpoints {
+			status.Endpoints = append(status.Endpoints, admin.EndpointStatus{Index: i, Healthy: healthy})
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// subscribeToChainEvents subscribes to the module events of the given chain adapter subscription
+func subscribeToChainEvents(eventBus *tmEvents.Bus, subscription chain.Subscription) tmEvents.FilteredSubscriber {
+	if !subscription.EndBlock {
+		return tmEvents.MustSubscribeWithAttributes(eventBus, subscription.EventType, subscription.Module,
+			sdk.Attribute{Key: sdk.AttributeKeyAction, Value: subscription.Action})
+	}
+
+	query := createNewBlockEventQuery(subscription.EventType, subscription.Module, subscription.Action)
+	subscriber, err := tmEvents.Subscribe(eventBus, query)
+	if err != nil {
+		panic(fmt.Errorf("unable to subscribe with %s event query: %v", subscription.EventType, err))
+	}
+
+	return subscriber
+}
+
 func createNewBlockEventQuery(eventType, module, action string) tmEvents.Query {
 	return tmEvents.Query{
 		TMQuery: tmEvents.NewBlockHeaderEventQuery(eventType).MatchModule(module).MatchAction(action).Build(),
@@ -293,83 +486,170 @@ func createEventBus(client rpcclient.Client, startBlock int64, logger log.Logger
 	return tmEvents.NewEventBus(tmEvents.NewBlockSource(client, notifier), pubsub.NewBus, logger)
 }
 
-func createBroadcaster(txf tx.Factory, axelarCfg config.ValdConfig, logger log.Logger) broadcasterTypes.Broadcaster {
+// createBroadcaster returns the retry pipeline, the broadcaster on top of it and a batched version of that broadcaster,
+// so they can be tuned at runtime. The batched broadcaster is always created so batching can be switched on and off by a config reload
+func createBroadcaster(txf tx.Factory, axelarCfg config.ValdConfig, logger log.Logger) (*broadcaster.RetryPipeline, broadcasterTypes.Broadcaster, *broadcaster.BatchedBroadcaster) {
 	pipeline := broadcaster.NewPipelineWithRetry(10000, axelarCfg.MaxRetries, utils2.LinearBackOff(axelarCfg.MinTimeout), logger)
-	return broadcaster.NewBroadcaster(txf, pipeline, logger)
-}
-
-func createTSSMgr(broadcaster broadcasterTypes.Broadcaster, cliCtx client.Context, axelarCfg config.ValdConfig, logger log.Logger, valAddr string, cdc *codec.LegacyAmino) *tss.Mgr {
-	create := func() (*tss.Mgr, error) {
-		conn, err := tss.Connect(axelarCfg.TssConfig.Host, axelarCfg.TssConfig.Port, axelarCfg.TssConfig.DialTimeout, logger)
-		if err != nil {
-			return nil, err
-		}
-		logger.Debug("successful connection to tofnd gRPC server")
-
-		// creates clients to communicate with the external tofnd process service
-		gg20client := tofnd.NewGG20Client(conn)
-		multiSigClient := tofnd.NewMultisigClient(conn)
+	b := broadcaster.NewBroadcaster(txf, pipeline, logger)
 
-		tssMgr := tss.NewMgr(gg20client, multiSigClient, cliCtx, 2*time.Hour, valAddr, broadcaster, logger, cdc)
+	return pipeline, b, broadcaster.NewBatchedBroadcaster(b, axelarCfg.MaxBatchMsgCount, axelarCfg.MaxBatchBytes, logger)
+}
 
-		return tssMgr, nil
-	}
-	mgr, err := create()
+func createTSSMgr(broadcaster broadcasterTypes.Broadcaster, cliCtx client.Context, axelarCfg config.ValdConfig, logger log.Logger, valAddr string, cdc *codec.LegacyAmino) (*tss.Mgr, func()) {
+	gg20client, multiSigClient, closeConn, err := connectTofnd(axelarCfg.TssConfig, logger)
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "failed to create tss manager"))
 	}
 
-	return mgr
+	return tss.NewMgr(gg20client, multiSigClient, cliCtx, 2*time.Hour, valAddr, broadcaster, logger, cdc), closeConn
 }
 
-func createBTCMgr(axelarCfg config.ValdConfig, cliCtx client.Context, b broadcasterTypes.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *btc.Mgr {
-	var rpc *btcRPC.ClientImpl
-	var err error
+// connectTofnd creates clients to communicate with the external tofnd process service
+func connectTofnd(cfg tssTypes.TssConfig, logger log.Logger) (tssRPC.Client, tssRPC.MultiSigClient, func(), error) {
+	conn, err := tss.Connect(cfg.Host, cfg.Port, cfg.DialTimeout, logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	logger.Debug("successful connection to tofnd gRPC server")
 
-	if axelarCfg.BtcConfig.RPCAddr != "" {
-		rpc, err = btcRPC.NewRPCClient(axelarCfg.BtcConfig, logger)
-		if err != nil {
-			logger.Error(err.Error())
-			panic(err)
+	closeConn := func() {
+		if err := conn.Close(); err != nil {
+			logger.Error(sdkerrors.Wrap(err, "failed to close tofnd connection").Error())
 		}
+	}
+
+	return tofnd.NewGG20Client(conn), tofnd.NewMultisigClient(conn), closeConn, nil
+}
 
-		// clean up btcRPC connection on process shutdown
-		cleanupCommands = append(cleanupCommands, rpc.Shutdown)
-		logger.Info("Successfully connected to Bitcoin bridge ")
+func createBTCMgr(axelarCfg config.ValdConfig, cliCtx client.Context, b broadcasterTypes.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) (*btc.Mgr, func()) {
+	rpc, closeRPC, err := connectBTC(axelarCfg.BtcConfig, axelarCfg.RPCHealthCheckInterval, logger)
+	if err != nil {
+		logger.Error(err.Error())
+		panic(err)
 	}
 
 	btcMgr := btc.NewMgr(rpc, cliCtx, b, logger, cdc)
-	return btcMgr
+	return btcMgr, closeRPC
 }
 
-func createEVMMgr(axelarCfg config.ValdConfig, cliCtx client.Context, b broadcasterTypes.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *evm.Mgr {
-	rpcs := make(map[string]evmRPC.Client)
+// connectBTC connects to all configured Bitcoin rpc endpoints. Returns a nil client if no endpoint is configured
+func connectBTC(cfg btcTypes.BtcConfig, healthCheckInterval time.Duration, logger log.Logger) (btcRPC.Client, func(), error) {
+	endpoints := cfg.RPCEndpoints()
+	if len(endpoints) == 0 {
+		return nil, func() {}, nil
+	}
+
+	var clients []btcRPC.Client
+	var shutdowns []func()
+	for _, endpoint := range endpoints {
+		endpointCfg := cfg
+		endpointCfg.RPCAddr = endpoint
 
-	for _, evmChainConf := range axelarCfg.EVMConfig {
-		if !evmChainConf.WithBridge {
+		client, err := btcRPC.NewRPCClient(endpointCfg, logger)
+		if err != nil {
+			logger.Error(sdkerrors.Wrapf(err, "could not connect to Bitcoin rpc endpoint %s", endpoint).Error())
 			continue
 		}
 
-		if _, found := rpcs[strings.ToLower(evmChainConf.Name)]; found {
-			msg := fmt.Errorf("duplicate bridge configuration found for EVM chain %s", evmChainConf.Name)
-			logger.Error(msg.Error())
-			panic(msg)
-		}
+		shutdowns = append(shutdowns, client.Shutdown)
+		clients = append(clients, btcRPC.NewInstrumentedClient(client))
+	}
+
+	multiClient, err := btcRPC.NewMultiClient(clients, cfg.RPCQuorum, cfg.RPCMaxBlockLag, logger.With("chain", "Bitcoin"))
+	if err != nil {
+		runAll(shutdowns)
+		return nil, nil, sdkerrors.Wrap(err, "failed to connect to the Bitcoin rpc endpoints")
+	}
+	logger.Info(fmt.Sprintf("Successfully connected to Bitcoin bridge (%d of %d endpoints)", len(clients), len(endpoints)))
+
+	stopHealthChecks := startHealthChecks(healthCheckInterval, multiClient.CheckHealth)
+	return multiClient, func() { stopHealthChecks(); runAll(shutdowns) }, nil
+}
+
+func createEVMMgr(axelarCfg config.ValdConfig, cliCtx client.Context, b broadcasterTypes.Broadcaster, reportBroadcaster broadcasterTypes.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) (*evm.Mgr, map[string]evmTypes.EVMConfig, map[string]func()) {
+	evmCfgs, err := evmBridgeConfigs(axelarCfg.EVMConfig)
+	if err != nil {
+		logger.Error(err.Error())
+		panic(err)
+	}
 
-		rpc, err := evmRPC.NewClient(evmChainConf.RPCAddr)
+	rpcs := make(map[string]evmRPC.Client)
+	closeRPCs := make(map[string]func())
+	for chain, evmChainConf := range evmCfgs {
+		rpc, closeRPC, err := connectEVMChain(evmChainConf, axelarCfg.RPCHealthCheckInterval, logger)
 		if err != nil {
 			logger.Error(err.Error())
 			panic(err)
 		}
-		// clean up evmRPC connection on process shutdown
-		cleanupCommands = append(cleanupCommands, rpc.Close)
 
-		rpcs[strings.ToLower(evmChainConf.Name)] = rpc
-		logger.Info(fmt.Sprintf("Successfully connected to EVM bridge for chain %s", evmChainConf.Name))
+		rpcs[chain] = rpc
+		closeRPCs[chain] = closeRPC
 	}
 
 	evmMgr := evm.NewMgr(rpcs, cliCtx, b, logger, cdc)
-	return evmMgr
+	evmMgr.SetReportBroadcaster(reportBroadcaster)
+	for chain, evmChainConf := range evmCfgs {
+		evmMgr.SetDepositDetection(chain, evmChainConf.DetectDeposits)
+	}
+
+	return evmMgr, evmCfgs, closeRPCs
+}
+
+// connectEVMChain connects to all configured rpc endpoints of the given EVM chain
+func connectEVMChain(cfg evmTypes.EVMConfig, healthCheckInterval time.Duration, logger log.Logger) (evmRPC.Client, func(), error) {
+	endpoints := cfg.RPCEndpoints()
+	var clients []evmRPC.Client
+	var closers []func()
+	for _, endpoint := range endpoints {
+		client, err := evmRPC.NewClient(endpoint)
+		if err != nil {
+			logger.Error(sdkerrors.Wrapf(err, "could not connect to rpc endpoint %s of EVM chain %s", endpoint, cfg.Name).Error())
+			continue
+		}
+
+		closers = append(closers, client.Close)
+		clients = append(clients, evmRPC.NewInstrumentedClient(cfg.Name, client))
+	}
+
+	rpc, err := evmRPC.NewMultiClient(clients, cfg.RPCQuorum, cfg.RPCMaxBlockLag, logger.With("chain", cfg.Name))
+	if err != nil {
+		runAll(closers)
+		return nil, nil, sdkerrors.Wrapf(err, "failed to connect to the rpc endpoints of EVM chain %s", cfg.Name)
+	}
+	logger.Info(fmt.Sprintf("Successfully connected to EVM bridge for chain %s (%d of %d endpoints)", cfg.Name, len(clients), len(endpoints)))
+
+	stopHealthChecks := startHealthChecks(healthCheckInterval, func() { rpc.CheckHealth(context.Background()) })
+	return rpc, func() { stopHealthChecks(); runAll(closers) }, nil
+}
+
+// startHealthChecks periodically runs the given health check until the returned function is called
+func startHealthChecks(interval time.Duration, check func()) func() {
+	if interval <= 0 {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				check()
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+func runAll(fs []func()) {
+	for _, f := range fs {
+		f()
+	}
 }
 
 // RWFile implements the ReadWriter interface for an underlying file
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/metrics"
 )
 
 //go:generate moq -pkg mock -out ./mock/state.go . ReadWriter
@@ -54,5 +56,11 @@ func (s StateStore) SetState(completed int64) error {
 	if err != nil {
 		return err
 	}
-	return s.rw.WriteAll(bz)
+
+	if err := s.rw.WriteAll(bz); err != nil {
+		return err
+	}
+
+	metrics.SetLastProcessedBlockHeight(completed)
+	return nil
 }
@@ -102,7 +102,7 @@ func (mgr *Mgr) multiSigKeygenStart(keyID string, shares uint32) error {
 			PartyUid: mgr.principalAddr,
 		}
 
-		res, err := mgr.multiSigClient.Keygen(grpcCtx, keygenRequest)
+		res, err := mgr.getMultiSigClient().Keygen(grpcCtx, keygenRequest)
 		if err != nil {
 			return sdkerrors.Wrapf(err, "failed to generate multisig key")
 		}
@@ -191,7 +191,7 @@ func (mgr *Mgr) startKeygen(keyID string, threshold uint32, myIndex uint32, part
 	}
 
 	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.Timeout)
-	stream, err := mgr.client.Keygen(grpcCtx)
+	stream, err := mgr.getClient().Keygen(grpcCtx)
 	if err != nil {
 		cancel()
 		return nil, nil, sdkerrors.Wrap(err, "failed tofnd gRPC call Keygen")
@@ -145,7 +145,7 @@ func (mgr *Mgr) startSign(keyID string, sigID string, participants []string, pay
 	}
 
 	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.Timeout)
-	stream, err := mgr.client.Sign(grpcCtx)
+	stream, err := mgr.getClient().Sign(grpcCtx)
 	if err != nil {
 		cancel()
 		return nil, nil, sdkerrors.Wrap(err, "failed tofnd gRPC call Sign")
@@ -257,7 +257,7 @@ func (mgr *Mgr) multiSigSign(keyUID string, msgToSign []byte) ([]byte, error) {
 		MsgToSign: msgToSign,
 		PartyUid:  mgr.principalAddr,
 	}
-	res, err := mgr.multiSigClient.Sign(grpcCtx, signRequest)
+	res, err := mgr.getMultiSigClient().Sign(grpcCtx, signRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc"
 
 	broadcasterTypes "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/metrics"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/parse"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss/rpc"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
@@ -57,6 +58,7 @@ func (q *TimeoutQueue) Enqueue(ID string, timeoutAt int64) *Session {
 
 	session := Session{ID: ID, TimeoutAt: timeoutAt, timeout: make(chan struct{})}
 	q.queue = append(q.queue, &session)
+	metrics.AddTSSSessions(1)
 
 	return &session
 }
@@ -72,6 +74,7 @@ func (q *TimeoutQueue) Dequeue() *Session {
 
 	result := q.queue[0]
 	q.queue = q.queue[1:]
+	metrics.AddTSSSessions(-1)
 
 	return result
 }
@@ -88,6 +91,19 @@ func (q *TimeoutQueue) Top() *Session {
 	return q.queue[0]
 }
 
+// Sessions returns all sessions in the queue
+func (q *TimeoutQueue) Sessions() []Session {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
+	sessions := make([]Session, 0, len(q.queue))
+	for _, session := range q.queue {
+		sessions = append(sessions, *session)
+	}
+
+	return sessions
+}
+
 // NewTimeoutQueue is the constructor for TimeoutQueue
 func NewTimeoutQueue() *TimeoutQueue {
 	return &TimeoutQueue{
@@ -147,6 +163,7 @@ func (l *LockableStream) CloseSend() error {
 type Mgr struct {
 	client         rpc.Client
 	multiSigClient rpc.MultiSigClient
+	clients        *sync.RWMutex
 	cliCtx         sdkClient.Context
 	keygen         *sync.RWMutex
 	sign           *sync.RWMutex
@@ -167,7 +184,8 @@ func Connect(host string, port string, timeout time.Duration, logger log.Logger)
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	return grpc.DialContext(ctx, tofndServerAddress, grpc.WithInsecure(), grpc.WithBlock())
+	return grpc.DialContext(ctx, tofndServerAddress, grpc.WithInsecure(), grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(metrics.TofndUnaryInterceptor), grpc.WithStreamInterceptor(metrics.TofndStreamInterceptor))
 }
 
 // NewMgr returns a new tss manager instance
@@ -175,6 +193,7 @@ func NewMgr(client rpc.Client, multiSigClient rpc.MultiSigClient, cliCtx sdkClie
 	return &Mgr{
 		client:         client,
 		multiSigClient: multiSigClient,
+		clients:        &sync.RWMutex{},
 		cliCtx:         cliCtx,
 		keygen:         &sync.RWMutex{},
 		sign:           &sync.RWMutex{},
@@ -189,6 +208,29 @@ func NewMgr(client rpc.Client, multiSigClient rpc.MultiSigClient, cliCtx sdkClie
 	}
 }
 
+// SetClients replaces the clients used to communicate with tofnd. Sessions that are already running keep using the previous clients
+func (mgr *Mgr) SetClients(client rpc.Client, multiSigClient rpc.MultiSigClient) {
+	mgr.clients.Lock()
+	defer mgr.clients.Unlock()
+
+	mgr.client = client
+	mgr.multiSigClient = multiSigClient
+}
+
+func (mgr *Mgr) getClient() rpc.Client {
+	mgr.clients.RLock()
+	defer mgr.clients.RUnlock()
+
+	return mgr.client
+}
+
+func (mgr *Mgr) getMultiSigClient() rpc.MultiSigClient {
+	mgr.clients.RLock()
+	defer mgr.clients.RUnlock()
+
+	return mgr.multiSigClient
+}
+
 // Recover instructs tofnd to recover the node's shares given the recovery info provided
 func (mgr *Mgr) Recover(recoverJSON []byte) error {
 	var requests []tofnd.RecoverRequest
@@ -207,7 +249,7 @@ func (mgr *Mgr) Recover(recoverJSON []byte) error {
 		grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.Timeout)
 		defer cancel()
 
-		response, err := mgr.client.Recover(grpcCtx, &request)
+		response, err := mgr.getClient().Recover(grpcCtx, &request)
 		if err != nil {
 			return sdkerrors.Wrap(err,
 				fmt.Sprintf("failed tofnd gRPC call Recover for key ID %s", request.KeygenInit.NewKeyUid))
@@ -234,7 +276,7 @@ func (mgr *Mgr) ProcessHeartBeatEvent(e tmEvents.Event) error {
 		KeyUid: "dummyID",
 	}
 
-	response, err := mgr.client.KeyPresence(grpcCtx, request)
+	response, err := mgr.getClient().KeyPresence(grpcCtx, request)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "failed to invoke KeyPresence grpc")
 	}
@@ -262,12 +304,12 @@ func (mgr *Mgr) ProcessHeartBeatEvent(e tmEvents.Event) error {
 			request = &tofnd.KeyPresenceRequest{
 				KeyUid: string(keyInfo.KeyID),
 			}
-			response, err = mgr.client.KeyPresence(grpcCtx, request)
+			response, err = mgr.getClient().KeyPresence(grpcCtx, request)
 		case exported.Multisig:
 			request = &tofnd.KeyPresenceRequest{
 				KeyUid: fmt.Sprintf("%s_%d", string(keyInfo.KeyID), 0),
 			}
-			response, err = mgr.multiSigClient.KeyPresence(grpcCtx, request)
+			response, err = mgr.getMultiSigClient().KeyPresence(grpcCtx, request)
 		default:
 			return sdkerrors.Wrapf(err, fmt.Sprintf("unrecognize key type %s", keyInfo.KeyType.SimpleString()))
 		}
@@ -327,6 +369,65 @@ func (mgr *Mgr) ProcessHeartBeatEvent(e tmEvents.Event) error {
 	return nil
 }
 
+// Session types
+const (
+	SessionKeygen = "keygen"
+	SessionSign   = "sign"
+)
+
+// SessionInfo describes a threshold keygen or sign session
+type SessionInfo struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	TimeoutAt int64  `json:"timeout_at"`
+}
+
+// ActiveSessions returns all threshold keygen and sign sessions that have not timed out yet
+func (mgr *Mgr) ActiveSessions() []SessionInfo {
+	var infos []SessionInfo
+	for _, session := range mgr.timeoutQueue.Sessions() {
+		info := SessionInfo{ID: session.ID, TimeoutAt: session.TimeoutAt}
+
+		switch {
+		case mgr.hasKeygenStream(session.ID):
+			info.Type = SessionKeygen
+		case mgr.hasSignStream(session.ID):
+			info.Type = SessionSign
+		default:
+			// the session is queued but its stream has not been opened yet
+			continue
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// AbortSession instructs tofnd to abort the keygen or sign session with the given ID
+func (mgr *Mgr) AbortSession(id string) error {
+	switch {
+	case mgr.hasKeygenStream(id):
+		mgr.Logger.Info(fmt.Sprintf("aborting keygen protocol %s on operator request", id))
+		return mgr.abortKeygen(id)
+	case mgr.hasSignStream(id):
+		mgr.Logger.Info(fmt.Sprintf("aborting sign protocol %s on operator request", id))
+		return mgr.abortSign(id)
+	default:
+		return fmt.Errorf("no keygen or sign session with ID %s", id)
+	}
+}
+
+func (mgr *Mgr) hasKeygenStream(keyID string) bool {
+	_, ok := mgr.getKeygenStream(keyID)
+	return ok
+}
+
+func (mgr *Mgr) hasSignStream(sigID string) bool {
+	_, ok := mgr.getSignStream(sigID)
+	return ok
+}
+
 func (mgr *Mgr) abortSign(sigID string) (err error) {
 	stream, ok := mgr.getSignStream(sigID)
 	if !ok {
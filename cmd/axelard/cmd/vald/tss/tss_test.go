@@ -6,9 +6,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	mock2 "github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/broadcaster/types/mock"
+	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/vald/tss/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	mock3 "github.com/axelarnetwork/axelar-core/x/tss/types/mock"
 )
 
 func TestGRPCTimeout(t *testing.T) {
@@ -40,3 +50,40 @@ func TestGRPCTimeout(t *testing.T) {
 		assert.Equal(t, context.DeadlineExceeded, err)
 	})
 }
+
+func TestMgr_AbortSession(t *testing.T) {
+	var (
+		mgr    *Mgr
+		stream *mock3.TofndSignClientMock
+	)
+
+	setup := func() {
+		stream = &mock3.TofndSignClientMock{SendFunc: func(*tofnd.MessageIn) error { return nil }}
+		mgr = NewMgr(&mock.ClientMock{}, &mock.MultiSigClientMock{}, client.Context{}, time.Second, rand.Str(20), &mock2.BroadcasterMock{}, log.TestingLogger(), app.MakeEncodingConfig().Amino)
+	}
+
+	t.Run("should list and abort running sessions", testutils.Func(func(t *testing.T) {
+		setup()
+
+		sigID := rand.Str(20)
+		timeoutAt := rand.PosI64()
+		mgr.timeoutQueue.Enqueue(sigID, timeoutAt)
+		mgr.setSignStream(sigID, stream)
+
+		// queued sessions without a stream are not running yet
+		mgr.timeoutQueue.Enqueue(rand.Str(20), timeoutAt)
+
+		assert.Equal(t, []SessionInfo{{ID: sigID, Type: SessionSign, TimeoutAt: timeoutAt}}, mgr.ActiveSessions())
+
+		assert.NoError(t, mgr.AbortSession(sigID))
+		assert.Len(t, stream.SendCalls(), 1)
+		assert.True(t, stream.SendCalls()[0].MessageIn.GetAbort())
+	}).Repeat(20))
+
+	t.Run("should fail for unknown sessions", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.Error(t, mgr.AbortSession(rand.Str(20)))
+		assert.Empty(t, mgr.ActiveSessions())
+	}).Repeat(20))
+}
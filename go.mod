@@ -18,6 +18,7 @@ require (
 	github.com/klauspost/compress v1.11.9 // indirect
 	github.com/matryer/moq v0.2.4
 	github.com/miguelmota/go-ethereum-hdwallet v0.1.1
+	github.com/prometheus/client_golang v1.11.0
 	github.com/rakyll/statik v0.1.7
 	github.com/rcrowley/go-metrics v0.0.0-20201227073835-cf1acfcdf475 // indirect
 	github.com/regen-network/cosmos-proto v0.3.1
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// PaginateSlice returns the bounds of the requested page of a slice with the given length and the corresponding page response.
+// It follows the semantics of query.Paginate for lists that are not read from a store directly,
+// the next key of a page encodes the offset of the following page
+func PaginateSlice(length int, pageRequest *query.PageRequest) (int, int, *query.PageResponse, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	if pageRequest.Offset > 0 && pageRequest.Key != nil {
+		return 0, 0, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if pageRequest.Reverse {
+		return 0, 0, nil, fmt.Errorf("invalid request, reverse pagination is not supported")
+	}
+
+	offset := pageRequest.Offset
+	if len(pageRequest.Key) != 0 {
+		if len(pageRequest.Key) != 8 {
+			return 0, 0, nil, fmt.Errorf("invalid request, unknown key %x", pageRequest.Key)
+		}
+		offset = sdk.BigEndianToUint64(pageRequest.Key)
+	}
+
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	start := uint64(length)
+	if offset < start {
+		start = offset
+	}
+
+	end := uint64(length)
+	if start+limit < end {
+		end = start + limit
+	}
+
+	pageResponse := &query.PageResponse{}
+	if end < uint64(length) {
+		pageResponse.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+
+	if countTotal {
+		pageResponse.Total = uint64(length)
+	}
+
+	return int(start), int(end), pageResponse, nil
+}
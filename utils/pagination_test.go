@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+)
+
+func TestPaginateSlice(t *testing.T) {
+	repeats := 20
+
+	t.Run("following next keys returns every item once", testutils.Func(func(t *testing.T) {
+		length := int(rand.I64Between(0, 100))
+		limit := uint64(rand.I64Between(1, 20))
+
+		var pages [][2]int
+		var key []byte
+		for {
+			start, end, pageResponse, err := PaginateSlice(length, &query.PageRequest{Key: key, Limit: limit})
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, end-start, int(limit))
+
+			pages = append(pages, [2]int{start, end})
+			key = pageResponse.NextKey
+			if key == nil {
+				break
+			}
+		}
+
+		next := 0
+		for _, page := range pages {
+			assert.Equal(t, next, page[0])
+			next = page[1]
+		}
+		assert.Equal(t, length, next)
+	}).Repeat(repeats))
+
+	t.Run("offset beyond the length returns an empty page", testutils.Func(func(t *testing.T) {
+		length := int(rand.I64Between(0, 100))
+
+		start, end, pageResponse, err := PaginateSlice(length, &query.PageRequest{Offset: uint64(length + 1), CountTotal: true})
+		assert.NoError(t, err)
+		assert.Equal(t, start, end)
+		assert.Nil(t, pageResponse.NextKey)
+		assert.Equal(t, uint64(length), pageResponse.Total)
+	}).Repeat(repeats))
+
+	t.Run("no page request returns the default page", testutils.Func(func(t *testing.T) {
+		length := int(rand.I64Between(0, 2*query.DefaultLimit))
+
+		start, end, pageResponse, err := PaginateSlice(length, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, start)
+		assert.LessOrEqual(t, end, query.DefaultLimit)
+		assert.Equal(t, uint64(length), pageResponse.Total)
+	}).Repeat(repeats))
+
+	t.Run("offset and key are mutually exclusive", func(t *testing.T) {
+		_, _, _, err := PaginateSlice(10, &query.PageRequest{Offset: 1, Key: rand.Bytes(8)})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+// AddProposalFlags adds the flags shared by all governance proposal submission commands
+func AddProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+}
+
+// SubmitProposal reads the proposal flags of the given command and broadcasts a governance proposal with the content returned by newContent
+func SubmitProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	cliCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, cliCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+}
@@ -17,6 +17,8 @@ type KVQueue interface {
 	Enqueue(key Key, value codec.ProtoMarshaler)
 	Dequeue(value codec.ProtoMarshaler, filter ...func(value codec.ProtoMarshaler) bool) bool
 	IsEmpty() bool
+	ExportState() QueueState
+	ImportState(state QueueState)
 }
 
 // BlockHeightKVQueue is a queue that orders items with the block height at which the items are enqueued;
@@ -75,6 +77,55 @@ func (q BlockHeightKVQueue) IsEmpty() bool {
 	return !iter.Valid()
 }
 
+// ExportState returns the items of the queue in the order they will be dequeued
+func (q BlockHeightKVQueue) ExportState() QueueState {
+	prefix := append(q.name.AsKey(), []byte(defaultDelimiter)...)
+	iter := sdk.KVStorePrefixIterator(q.store.KVStore, prefix)
+	defer CloseLogError(iter, q.logger)
+
+	state := QueueState{Items: []QueueState_Item{}}
+	for ; iter.Valid(); iter.Next() {
+		blockHeight := iter.Key()[len(prefix):]
+		if len(blockHeight) < 8 {
+			q.logger.Error(fmt.Sprintf("skipping malformed entry %s in queue %s", string(iter.Key()), string(q.name.AsKey())))
+			continue
+		}
+
+		var key gogoprototypes.BytesValue
+		q.store.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &key)
+
+		state.Items = append(state.Items, QueueState_Item{
+			Height: int64(binary.BigEndian.Uint64(blockHeight[:8])),
+			Key:    key.Value,
+		})
+	}
+
+	return state
+}
+
+// ImportState enqueues the given items at their respective block heights. The values referenced by the items
+// must be stored separately
+func (q BlockHeightKVQueue) ImportState(state QueueState) {
+	for _, item := range state.Items {
+		q.store.Set(q.name.Append(q.WithBlockHeight(item.Height).blockHeight).Append(KeyFromBz(item.Key)), &gogoprototypes.BytesValue{Value: item.Key})
+	}
+}
+
+// ValidateBasic returns an error if the queue state is malformed
+func (m QueueState) ValidateBasic() error {
+	for _, item := range m.Items {
+		if item.Height < 0 {
+			return fmt.Errorf("queue item height must not be negative")
+		}
+
+		if len(item.Key) == 0 {
+			return fmt.Errorf("queue item key must not be empty")
+		}
+	}
+
+	return nil
+}
+
 // WithBlockHeight returns a queue with the given block height
 func (q BlockHeightKVQueue) WithBlockHeight(blockHeight int64) BlockHeightKVQueue {
 	bz := make([]byte, 8)
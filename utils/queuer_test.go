@@ -59,6 +59,42 @@ func TestNewBlockHeightKVQueue(t *testing.T) {
 		}
 		assert.Equal(t, items, actualItems)
 	}).Repeat(repeats))
+
+	t.Run("export and import", testutils.Func(func(t *testing.T) {
+		ctx, cdc := setup()
+		store := NewNormalizedStore(ctx.KVStore(sdk.NewKVStoreKey(stringGen.Next())), cdc)
+
+		itemCount := rand.I64Between(10, 100)
+		items := make([]string, itemCount)
+
+		blockHeight := rand.I64Between(1, 10000)
+		kvQueue := NewBlockHeightKVQueue("test-export-import", store, blockHeight, log.TestingLogger())
+		for i := range items {
+			items[i] = rand.Str(10)
+			kvQueue.Enqueue(KeyFromStr(items[i]), &gogoprototypes.StringValue{Value: items[i]})
+			blockHeight += rand.I64Between(0, 1000)
+			kvQueue = kvQueue.WithBlockHeight(blockHeight)
+		}
+
+		state := kvQueue.ExportState()
+		assert.NoError(t, state.ValidateBasic())
+		assert.Len(t, state.Items, len(items))
+
+		newStore := NewNormalizedStore(ctx.KVStore(sdk.NewKVStoreKey(stringGen.Next())), cdc)
+		newQueue := NewBlockHeightKVQueue("test-export-import", newStore, 0, log.TestingLogger())
+		newQueue.ImportState(state)
+		for _, item := range items {
+			newStore.Set(KeyFromStr(item), &gogoprototypes.StringValue{Value: item})
+		}
+		assert.Equal(t, state, newQueue.ExportState())
+
+		var expected, actual gogoprototypes.StringValue
+		for kvQueue.Dequeue(&expected) {
+			assert.True(t, newQueue.Dequeue(&actual))
+			assert.Equal(t, expected, actual)
+		}
+		assert.True(t, newQueue.IsEmpty())
+	}).Repeat(repeats))
 }
 
 func TestNewSequenceKVQueue(t *testing.T) {
@@ -30,11 +30,20 @@ func NewCheckRefundFeeDecorator(registry cdctypes.InterfaceRegistry, ak antetype
 	}
 }
 
-// AnteHandle record qualified refund for the tss and vote transactions
+// AnteHandle records a pending refund for every qualifying message of the tss and vote transactions
 func (d CheckRefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	msgs := tx.GetMsgs()
 
-	if d.qualifyForRefund(ctx, msgs) {
+	refundable := make([]*axelarnetTypes.RefundMsgRequest, len(msgs))
+	hasRefundable := false
+	for i, msg := range msgs {
+		if req, ok := d.qualifyForRefund(ctx, msg); ok {
+			refundable[i] = req
+			hasRefundable = true
+		}
+	}
+
+	if hasRefundable {
 		feeTx, ok := tx.(sdk.FeeTx)
 		if !ok {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
@@ -42,10 +51,25 @@ func (d CheckRefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 		fee := feeTx.GetFee()
 		if len(fee) > 0 {
-			req := msgs[0].(*axelarnetTypes.RefundMsgRequest)
-			err := d.axelarnet.SetPendingRefund(ctx, *req, fee[0])
-			if err != nil {
-				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+			// the fee is split evenly between all messages, the first message receives the remainder.
+			// Only the shares of qualifying messages are refunded, the sender pays for all others
+			share := fee[0].Amount.QuoRaw(int64(len(msgs)))
+			remainder := fee[0].Amount.Sub(share.MulRaw(int64(len(msgs))))
+
+			for i, req := range refundable {
+				if req == nil {
+					continue
+				}
+
+				refund := sdk.NewCoin(fee[0].Denom, share)
+				if i == 0 {
+					refund = refund.AddAmount(remainder)
+				}
+
+				err := d.axelarnet.SetPendingRefund(ctx, *req, refund)
+				if err != nil {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+				}
 			}
 		}
 
@@ -54,30 +78,25 @@ func (d CheckRefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
-func (d CheckRefundFeeDecorator) qualifyForRefund(ctx sdk.Context, msgs []sdk.Msg) bool {
-	if len(msgs) != 1 {
-		return false
+// qualifyForRefund returns the refund request if the message is refundable and sent by the proxy of a bonded validator
+func (d CheckRefundFeeDecorator) qualifyForRefund(ctx sdk.Context, msg sdk.Msg) (*axelarnetTypes.RefundMsgRequest, bool) {
+	req, ok := msg.(*axelarnetTypes.RefundMsgRequest)
+	if !ok || !msgRegistered(d.registry, req.InnerMessage.GetTypeUrl()) {
+		return nil, false
 	}
 
-	switch msg := msgs[0].(type) {
-	case *axelarnetTypes.RefundMsgRequest:
-		if msgRegistered(d.registry, msg.InnerMessage.GetTypeUrl()) {
-			// Validator must be bonded
-			sender := msg.GetSigners()[0]
-			validatorAddr := d.snapshotter.GetOperator(ctx, sender)
-			if validatorAddr == nil {
-				return false
-			}
-			validator := d.staking.Validator(ctx, validatorAddr)
-			if validator == nil || !validator.IsBonded() {
-				return false
-			}
-		}
-	default:
-		return false
+	// Validator must be bonded
+	sender := req.GetSigners()[0]
+	validatorAddr := d.snapshotter.GetOperator(ctx, sender)
+	if validatorAddr == nil {
+		return nil, false
+	}
+	validator := d.staking.Validator(ctx, validatorAddr)
+	if validator == nil || !validator.IsBonded() {
+		return nil, false
 	}
 
-	return true
+	return req, true
 }
 
 func msgRegistered(r cdctypes.InterfaceRegistry, targetURL string) bool {
@@ -0,0 +1,143 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/ante"
+	"github.com/axelarnetwork/axelar-core/x/ante/types/mock"
+	axelarnettypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tsstypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestCheckRefundFeeDecorator(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+
+	var (
+		ctx       sdk.Context
+		axelarnet *mock.AxelarnetMock
+		decorator ante.CheckRefundFeeDecorator
+		bonded    bool
+		unbonded  map[string]bool
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		bonded = true
+		unbonded = make(map[string]bool)
+
+		axelarnet = &mock.AxelarnetMock{
+			SetPendingRefundFunc: func(sdk.Context, axelarnettypes.RefundMsgRequest, sdk.Coin) error { return nil },
+		}
+		snapshotter := &mock.SnapshotterMock{
+			GetOperatorFunc: func(_ sdk.Context, proxy sdk.AccAddress) sdk.ValAddress { return sdk.ValAddress(proxy) },
+		}
+		staking := &mock.StakingMock{
+			ValidatorFunc: func(_ sdk.Context, validator sdk.ValAddress) stakingtypes.ValidatorI {
+				if bonded && !unbonded[validator.String()] {
+					return stakingtypes.Validator{Status: stakingtypes.Bonded}
+				}
+				return stakingtypes.Validator{Status: stakingtypes.Unbonded}
+			},
+		}
+
+		decorator = ante.NewCheckRefundFeeDecorator(encCfg.InterfaceRegistry, nil, staking, snapshotter, axelarnet)
+	}
+
+	newTx := func(fee sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		assert.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBuilder.SetFeeAmount(fee)
+
+		return txBuilder.GetTx()
+	}
+
+	refundableMsgs := func(count int) []sdk.Msg {
+		msgs := make([]sdk.Msg, count)
+		for i := range msgs {
+			sender := rand.AccAddr()
+			msgs[i] = axelarnettypes.NewRefundMsgRequest(sender, tsstypes.NewHeartBeatRequest(sender, []tss.KeyID{tss.KeyID(rand.StrBetween(5, 10))}))
+		}
+		return msgs
+	}
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	repeats := 20
+
+	t.Run("should split the fee between all refundable messages", testutils.Func(func(t *testing.T) {
+		setup()
+
+		msgs := refundableMsgs(int(rand.I64Between(1, 20)))
+		fee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(rand.I64Between(1, 1000000)))
+
+		_, err := decorator.AnteHandle(ctx, newTx(sdk.NewCoins(fee), msgs...), false, next)
+		assert.NoError(t, err)
+
+		calls := axelarnet.SetPendingRefundCalls()
+		assert.Len(t, calls, len(msgs))
+
+		share := fee.Amount.QuoRaw(int64(len(msgs)))
+		total := sdk.NewCoin(fee.Denom, sdk.ZeroInt())
+		for i, call := range calls {
+			assert.Equal(t, *msgs[i].(*axelarnettypes.RefundMsgRequest), call.Req)
+			if i > 0 {
+				assert.Equal(t, share, call.Fee.Amount)
+			}
+			total = total.Add(call.Fee)
+		}
+		assert.Equal(t, fee, total)
+		assert.True(t, calls[0].Fee.Amount.Sub(share).LT(sdk.NewInt(int64(len(msgs)))))
+	}).Repeat(repeats))
+
+	t.Run("should refund only the shares of qualifying messages in a mixed batch", testutils.Func(func(t *testing.T) {
+		setup()
+
+		refundable := refundableMsgs(int(rand.I64Between(1, 10)))
+		// the proxy of an unbonded validator does not qualify for a refund
+		unbondedMsg := refundableMsgs(1)[0]
+		unbonded[sdk.ValAddress(unbondedMsg.GetSigners()[0]).String()] = true
+		sender := rand.AccAddr()
+		heartBeat := tsstypes.NewHeartBeatRequest(sender, []tss.KeyID{tss.KeyID(rand.StrBetween(5, 10))})
+
+		// the first message pays the remainder of the fee split
+		msgs := append(append([]sdk.Msg{heartBeat}, refundable...), unbondedMsg)
+		fee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(rand.I64Between(1, 1000000)))
+
+		_, err := decorator.AnteHandle(ctx, newTx(sdk.NewCoins(fee), msgs...), false, next)
+		assert.NoError(t, err)
+
+		calls := axelarnet.SetPendingRefundCalls()
+		assert.Len(t, calls, len(refundable))
+
+		share := fee.Amount.QuoRaw(int64(len(msgs)))
+		var refunded []sdk.Msg
+		for _, call := range calls {
+			req := call.Req
+			refunded = append(refunded, &req)
+			assert.Equal(t, sdk.NewCoin(fee.Denom, share), call.Fee)
+		}
+		assert.ElementsMatch(t, refundable, refunded)
+	}).Repeat(repeats))
+
+	t.Run("should not refund validators that are not bonded", testutils.Func(func(t *testing.T) {
+		setup()
+		bonded = false
+
+		msgs := refundableMsgs(int(rand.I64Between(1, 20)))
+		fee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(rand.I64Between(1, 1000000)))
+
+		_, err := decorator.AnteHandle(ctx, newTx(sdk.NewCoins(fee), msgs...), false, next)
+		assert.NoError(t, err)
+		assert.Len(t, axelarnet.SetPendingRefundCalls(), 0)
+	}).Repeat(repeats))
+}
@@ -0,0 +1,52 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/axelarnetwork/axelar-core/x/ante/types"
+	axelarnettypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
+)
+
+// RestrictedMsgDecorator rejects privileged messages from accounts that have not been granted the required role
+type RestrictedMsgDecorator struct {
+	permission types.Permission
+}
+
+// NewRestrictedMsgDecorator is the constructor for RestrictedMsgDecorator
+func NewRestrictedMsgDecorator(permission types.Permission) RestrictedMsgDecorator {
+	return RestrictedMsgDecorator{
+		permission: permission,
+	}
+}
+
+// AnteHandle fails the transaction if any of its restricted messages is signed by an account lacking the required role
+func (d RestrictedMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// exempt genesis transactions from this check
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if refundMsg, ok := msg.(*axelarnettypes.RefundMsgRequest); ok {
+			msg = refundMsg.GetInnerMessage()
+		}
+
+		restricted, ok := msg.(permission.RestrictedMsg)
+		if !ok {
+			continue
+		}
+
+		role := restricted.GetRequiredRole()
+		for _, signer := range restricted.GetSigners() {
+			if !d.permission.HasRole(ctx, signer, role) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s requires role %s to send %s",
+					signer.String(), role.SimpleString(), proto.MessageName(restricted))
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
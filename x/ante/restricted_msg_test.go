@@ -0,0 +1,133 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/ante"
+	"github.com/axelarnetwork/axelar-core/x/ante/types/mock"
+	axelarnettypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tsstypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestRestrictedMsgDecorator(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+
+	var (
+		ctx        sdk.Context
+		perm       *mock.PermissionMock
+		decorator  ante.RestrictedMsgDecorator
+		authorized map[string]bool
+		nextCalled bool
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.I64Between(1, 1000000)}, false, log.TestingLogger())
+		authorized = make(map[string]bool)
+		nextCalled = false
+
+		perm = &mock.PermissionMock{
+			HasRoleFunc: func(_ sdk.Context, address sdk.AccAddress, role permission.Role) bool {
+				return role == permission.KeyManagement && authorized[address.String()]
+			},
+		}
+		decorator = ante.NewRestrictedMsgDecorator(perm)
+	}
+
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		assert.NoError(t, txBuilder.SetMsgs(msgs...))
+
+		return txBuilder.GetTx()
+	}
+
+	// StartKeygenRequest requires the key management role
+	restrictedMsg := func(sender sdk.AccAddress) sdk.Msg {
+		return tsstypes.NewStartKeygenRequest(sender, rand.StrBetween(5, 10), tss.MasterKey, tss.Threshold)
+	}
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	repeats := 20
+
+	t.Run("should reject restricted messages from unauthorized signers", testutils.Func(func(t *testing.T) {
+		setup()
+		sender := rand.AccAddr()
+
+		_, err := decorator.AnteHandle(ctx, newTx(restrictedMsg(sender)), false, next)
+		assert.Error(t, err)
+		assert.False(t, nextCalled)
+		assert.Len(t, perm.HasRoleCalls(), 1)
+		assert.Equal(t, sender, perm.HasRoleCalls()[0].Address)
+		assert.Equal(t, permission.KeyManagement, perm.HasRoleCalls()[0].Role)
+	}).Repeat(repeats))
+
+	t.Run("should accept restricted messages from authorized signers", testutils.Func(func(t *testing.T) {
+		setup()
+		sender := rand.AccAddr()
+		authorized[sender.String()] = true
+
+		_, err := decorator.AnteHandle(ctx, newTx(restrictedMsg(sender)), false, next)
+		assert.NoError(t, err)
+		assert.True(t, nextCalled)
+	}).Repeat(repeats))
+
+	t.Run("should check restricted messages wrapped in refund requests", testutils.Func(func(t *testing.T) {
+		setup()
+		sender := rand.AccAddr()
+		wrapped := axelarnettypes.NewRefundMsgRequest(sender, restrictedMsg(sender))
+
+		_, err := decorator.AnteHandle(ctx, newTx(wrapped), false, next)
+		assert.Error(t, err)
+		assert.False(t, nextCalled)
+		assert.Len(t, perm.HasRoleCalls(), 1)
+
+		authorized[sender.String()] = true
+		_, err = decorator.AnteHandle(ctx, newTx(wrapped), false, next)
+		assert.NoError(t, err)
+		assert.True(t, nextCalled)
+	}).Repeat(repeats))
+
+	t.Run("should reject the tx if any restricted message is unauthorized", testutils.Func(func(t *testing.T) {
+		setup()
+		sender := rand.AccAddr()
+		authorized[sender.String()] = true
+
+		_, err := decorator.AnteHandle(ctx, newTx(restrictedMsg(sender), restrictedMsg(rand.AccAddr())), false, next)
+		assert.Error(t, err)
+		assert.False(t, nextCalled)
+	}).Repeat(repeats))
+
+	t.Run("should not check unrestricted messages", testutils.Func(func(t *testing.T) {
+		setup()
+		sender := rand.AccAddr()
+		msg := tsstypes.NewHeartBeatRequest(sender, []tss.KeyID{tss.KeyID(rand.StrBetween(5, 10))})
+
+		_, err := decorator.AnteHandle(ctx, newTx(msg, axelarnettypes.NewRefundMsgRequest(sender, msg)), false, next)
+		assert.NoError(t, err)
+		assert.True(t, nextCalled)
+		assert.Len(t, perm.HasRoleCalls(), 0)
+	}).Repeat(repeats))
+
+	t.Run("should not check genesis transactions", testutils.Func(func(t *testing.T) {
+		setup()
+		ctx = ctx.WithBlockHeight(0)
+
+		_, err := decorator.AnteHandle(ctx, newTx(restrictedMsg(rand.AccAddr())), false, next)
+		assert.NoError(t, err)
+		assert.True(t, nextCalled)
+		assert.Len(t, perm.HasRoleCalls(), 0)
+	}).Repeat(repeats))
+}
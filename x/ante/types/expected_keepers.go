@@ -6,11 +6,14 @@ import (
 
 	axelarnettypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
+//go:generate moq -out ./mock/expected_keepers.go -pkg mock . Tss Nexus Snapshotter Staking Axelarnet Permission
+
 // Tss provides access to the tss functionality
 type Tss interface {
 	GetCurrentKeyID(ctx sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool)
@@ -44,3 +47,8 @@ type Staking interface {
 type Axelarnet interface {
 	SetPendingRefund(ctx sdk.Context, req axelarnettypes.RefundMsgRequest, fee sdk.Coin) error
 }
+
+// Permission provides access to the permission functionality
+type Permission interface {
+	HasRole(ctx sdk.Context, address sdk.AccAddress, role permission.Role) bool
+}
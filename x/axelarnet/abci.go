@@ -1,8 +1,13 @@
 package axelarnet
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
 )
 
 // BeginBlocker check for infraction evidence or downtime of validators
@@ -10,6 +15,63 @@ import (
 func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlocker called every block, process inflation, update validator set.
-func EndBlocker(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k types.BaseKeeper, n types.Nexus, b types.BankKeeper, d types.Distributor) []abci.ValidatorUpdate {
+	distributeFees(ctx, k, n, b, d)
+
 	return nil
 }
+
+// distributeFees pays out the collected bridge fees held by the fee collector to the configured target
+func distributeFees(ctx sdk.Context, k types.BaseKeeper, n types.Nexus, b types.BankKeeper, d types.Distributor) {
+	target := k.GetFeeDistributionTarget(ctx)
+	if target == types.NoDistribution || ctx.BlockHeight()%k.GetFeeDistributionInterval(ctx) != 0 {
+		return
+	}
+
+	feeCollector, ok := k.GetFeeCollector(ctx)
+	if !ok {
+		return
+	}
+
+	// fees only reach the fee collector once their pending transfers are executed
+	fees := sdk.NewCoins()
+	for _, fee := range n.GetUndistributedFees(ctx) {
+		balance := b.GetBalance(ctx, feeCollector, fee.Denom)
+		fees = fees.Add(sdk.NewCoin(fee.Denom, sdk.MinInt(fee.Amount, balance.Amount)))
+	}
+
+	if fees.IsZero() {
+		return
+	}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+
+	var err error
+	switch target {
+	case types.CommunityPool:
+		err = d.FundCommunityPool(cachedCtx, fees, feeCollector)
+	case types.ValidatorRewards:
+		err = b.SendCoinsFromAccountToModule(cachedCtx, feeCollector, authtypes.FeeCollectorName, fees)
+	default:
+		err = fmt.Errorf("unknown fee distribution target %s", target.String())
+	}
+
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to distribute fees %s: %s", fees.String(), err.Error()))
+		return
+	}
+
+	writeCache()
+	n.AddDistributedFees(ctx, fees)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeFeeDistribution,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueDistribute),
+			sdk.NewAttribute(types.AttributeKeyTarget, target.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, fees.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(fmt.Sprintf("distributed fees %s to %s", fees.String(), target.String()))
+}
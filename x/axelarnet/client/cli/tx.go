@@ -7,8 +7,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
+	clientUtils "github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
 )
 
@@ -171,6 +173,26 @@ func GetCmdAddCosmosBasedChain() *cobra.Command {
 	return cmd
 }
 
+// NewCmdSubmitAddCosmosChainProposal returns the cli command to submit a governance proposal adding a new cosmos based chain
+func NewCmdSubmitAddCosmosChainProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-cosmos-chain [name] [native asset]",
+		Short: "Submit a proposal to add a new cosmos based chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			nativeAsset := args[1]
+
+			return clientUtils.SubmitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewAddCosmosChainProposal(title, description, name, nativeAsset)
+			})
+		},
+	}
+
+	clientUtils.AddProposalFlags(cmd)
+	return cmd
+}
+
 // GetCmdRegisterAsset returns the cli command to register an asset to a cosmos based chain
 func GetCmdRegisterAsset() *cobra.Command {
 	cmd := &cobra.Command{
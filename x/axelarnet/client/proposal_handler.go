@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/client/cli"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/client/rest"
+)
+
+// AddCosmosChainProposalHandler is the proposal handler to submit add cosmos chain proposals through the gov module's cli and REST endpoints
+var AddCosmosChainProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitAddCosmosChainProposal, rest.AddCosmosChainProposalRESTHandler)
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
 	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
@@ -91,3 +92,20 @@ func NewHandler(k types.BaseKeeper, n types.Nexus, b types.BankKeeper, t types.I
 		return res, nil
 	}
 }
+
+// NewProposalHandler returns the handler for the axelarnet module's governance proposals
+func NewProposalHandler(k types.BaseKeeper, n types.Nexus, b types.BankKeeper, t types.IBCTransferKeeper, c types.ChannelKeeper, a types.AccountKeeper, m *baseapp.MsgServiceRouter, r sdk.Router) govtypes.Handler {
+	server := keeper.NewMsgServerImpl(k, n, b, t, c, a, m, r)
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddCosmosChainProposal:
+			if _, err := server.AddCosmosBasedChain(sdk.WrapSDKContext(ctx), c.ToRequest()); err != nil {
+				return sdkerrors.Wrap(types.ErrAxelarnet, err.Error())
+			}
+
+			return nil
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+)
+
+var _ types.QueryServiceServer = Querier{}
+
+// Querier implements the grpc querier
+type Querier struct {
+	keeper Keeper
+}
+
+// NewGRPCQuerier creates a new axelarnet Querier
+func NewGRPCQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// Params returns the module parameters
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: q.keeper.GetParams(ctx)}, nil
+}
+
+// IBCPath returns the IBC path registered for the given cosmos chain
+func (q Querier) IBCPath(c context.Context, req *types.QueryIBCPathRequest) (*types.QueryIBCPathResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	path, ok := q.keeper.GetIBCPath(ctx, req.Chain)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrAxelarnet, fmt.Sprintf("no IBC path registered for chain %s", req.Chain))
+	}
+
+	return &types.QueryIBCPathResponse{IBCPath: path}, nil
+}
+
+// CosmosChains returns a page of all registered cosmos chains
+func (q Querier) CosmosChains(c context.Context, req *types.QueryCosmosChainsRequest) (*types.QueryCosmosChainsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chains := make([]string, 0)
+	store := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), cosmosChainPrefix.AppendStr("").AsKey())
+
+	pageResponse, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		chains = append(chains, string(value))
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrAxelarnet, err.Error())
+	}
+
+	return &types.QueryCosmosChainsResponse{Chains: chains, Pagination: pageResponse}, nil
+}
+
+// ChainByAsset returns the cosmos chain the given asset is registered to
+func (q Querier) ChainByAsset(c context.Context, req *types.QueryChainByAssetRequest) (*types.QueryChainByAssetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := q.keeper.GetCosmosChain(ctx, req.Asset)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrAxelarnet, fmt.Sprintf("asset %s is not registered to any cosmos chain", req.Asset))
+	}
+
+	return &types.QueryChainByAssetResponse{Chain: chain}, nil
+}
+
+// FeeCollector returns the address of the fee collector
+func (q Querier) FeeCollector(c context.Context, _ *types.QueryFeeCollectorRequest) (*types.QueryFeeCollectorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, ok := q.keeper.GetFeeCollector(ctx)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrAxelarnet, "fee collector not set")
+	}
+
+	return &types.QueryFeeCollectorResponse{Address: address}, nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	axelarnetKeeper "github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+)
+
+func TestQuerier(t *testing.T) {
+	repeats := 20
+
+	var (
+		ctx     sdk.Context
+		keeper  axelarnetKeeper.Keeper
+		querier axelarnetKeeper.Querier
+	)
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		axelarnetSubspace := params.NewSubspace(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("axelarnetKey"), sdk.NewKVStoreKey("tAxelarnetKey"), "axelarnet")
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		keeper = axelarnetKeeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey("axelarnet"), axelarnetSubspace)
+		querier = axelarnetKeeper.NewGRPCQuerier(keeper)
+	}
+
+	t.Run("should return all cosmos chains page by page", testutils.Func(func(t *testing.T) {
+		setup()
+
+		count := int(rand.I64Between(0, 50))
+		var chains []string
+		for i := 0; i < count; i++ {
+			chain := strings.ToLower(rand.Str(10))
+			chains = append(chains, chain)
+			keeper.RegisterAssetToCosmosChain(ctx, randomDenom(), chain)
+		}
+		sort.Strings(chains)
+
+		limit := uint64(rand.I64Between(1, 10))
+		var actual []string
+		var nextKey []byte
+		for {
+			res, err := querier.CosmosChains(sdk.WrapSDKContext(ctx), &types.QueryCosmosChainsRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: limit}})
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, uint64(len(res.Chains)), limit)
+
+			actual = append(actual, res.Chains...)
+			nextKey = res.Pagination.NextKey
+			if nextKey == nil {
+				break
+			}
+		}
+		assert.Equal(t, chains, actual)
+
+		res, err := querier.CosmosChains(sdk.WrapSDKContext(ctx), &types.QueryCosmosChainsRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, res.Chains, count)
+		assert.Equal(t, uint64(count), res.Pagination.Total)
+	}).Repeat(repeats))
+
+	t.Run("should return the chain an asset is registered to", testutils.Func(func(t *testing.T) {
+		setup()
+
+		asset := randomDenom()
+		chain := strings.ToLower(rand.Str(10))
+
+		_, err := querier.ChainByAsset(sdk.WrapSDKContext(ctx), &types.QueryChainByAssetRequest{Asset: asset})
+		assert.Error(t, err)
+
+		keeper.RegisterAssetToCosmosChain(ctx, asset, chain)
+
+		res, err := querier.ChainByAsset(sdk.WrapSDKContext(ctx), &types.QueryChainByAssetRequest{Asset: asset})
+		assert.NoError(t, err)
+		assert.Equal(t, chain, res.Chain)
+	}).Repeat(repeats))
+
+	t.Run("should return the IBC path of a registered chain", testutils.Func(func(t *testing.T) {
+		setup()
+
+		chain := rand.StrBetween(5, 10)
+		path := randomIBCPath()
+
+		_, err := querier.IBCPath(sdk.WrapSDKContext(ctx), &types.QueryIBCPathRequest{Chain: chain})
+		assert.Error(t, err)
+
+		assert.NoError(t, keeper.RegisterIBCPath(ctx, chain, path))
+
+		res, err := querier.IBCPath(sdk.WrapSDKContext(ctx), &types.QueryIBCPathRequest{Chain: chain})
+		assert.NoError(t, err)
+		assert.Equal(t, path, res.IBCPath)
+	}).Repeat(repeats))
+
+	t.Run("should return the fee collector once it is set", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := querier.FeeCollector(sdk.WrapSDKContext(ctx), &types.QueryFeeCollectorRequest{})
+		assert.Error(t, err)
+
+		address := rand.AccAddr()
+		keeper.SetFeeCollector(ctx, address)
+
+		res, err := querier.FeeCollector(sdk.WrapSDKContext(ctx), &types.QueryFeeCollectorRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, address, res.Address)
+	}).Repeat(repeats))
+}
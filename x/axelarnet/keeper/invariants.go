@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+// RegisterInvariants registers all axelarnet module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper, n types.Nexus, b types.BankKeeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-balances", EscrowBalancesInvariant(k, n, b))
+}
+
+// EscrowBalancesInvariant checks that the escrow account of every locked asset holds enough funds to cover
+// the amount of that asset that is circulating on other chains or pending for transfer, net of the fees collected from those chains
+func EscrowBalancesInvariant(k Keeper, n types.Nexus, b types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		chains := n.GetChains(ctx)
+		pending := make(map[string]sdk.Int)
+		for _, chain := range chains {
+			for _, transfer := range n.GetTransfersForChain(ctx, chain, nexus.Pending) {
+				asset := strings.ToLower(transfer.Asset.Denom)
+				if _, ok := pending[asset]; !ok {
+					pending[asset] = sdk.ZeroInt()
+				}
+				pending[asset] = pending[asset].Add(transfer.Asset.Amount)
+			}
+		}
+
+		for _, escrowed := range k.getEscrowedAssets(ctx) {
+			locked, ok := pending[strings.ToLower(escrowed.asset)]
+			if !ok {
+				locked = sdk.ZeroInt()
+			}
+
+			for _, chain := range chains {
+				// tokens deposited on axelarnet are credited to its total before they are transferred to other chains
+				if chain.Name == exported.Axelarnet.Name {
+					continue
+				}
+				locked = locked.Add(n.GetChainTotal(ctx, chain, escrowed.asset).Amount)
+
+				// fees are collected from transfers without being subtracted from the total of the source chain
+				if chain.NativeAsset == escrowed.asset {
+					continue
+				}
+				for _, entry := range n.GetFeeLedger(ctx, chain.Name, "", escrowed.asset) {
+					locked = locked.Sub(entry.Collected.Amount)
+				}
+			}
+
+			balance := b.GetBalance(ctx, types.GetEscrowAddress(escrowed.denom), escrowed.denom)
+			if balance.Amount.LT(locked) {
+				msg += fmt.Sprintf("\tescrow balance %s does not cover the locked amount of %s%s\n", balance.String(), locked.String(), escrowed.asset)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-balances",
+			fmt.Sprintf("escrow accounts do not cover the locked assets\n%s", msg)), broken
+	}
+}
+
+type escrowedAsset struct {
+	asset string
+	denom string
+}
+
+// getEscrowedAssets returns the assets that are locked in escrow accounts when deposited on axelarnet,
+// together with the denomination they are held in
+func (k Keeper) getEscrowedAssets(ctx sdk.Context) []escrowedAsset {
+	assets := []escrowedAsset{{asset: exported.Axelarnet.NativeAsset, denom: exported.Axelarnet.NativeAsset}}
+
+	prefix := ibcAssetPrefix.AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		asset := strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey()))
+		path, ok := k.GetIBCPath(ctx, string(iter.Value()))
+		if !ok {
+			continue
+		}
+
+		denom := ibctransfertypes.ParseDenomTrace(fmt.Sprintf("%s/%s", path, asset)).IBCDenom()
+		assets = append(assets, escrowedAsset{asset: asset, denom: denom})
+	}
+
+	return assets
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibctypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	axelarnetKeeper "github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types/mock"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+func TestEscrowBalancesInvariant(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		keeper      axelarnetKeeper.Keeper
+		nexusKeeper *mock.NexusMock
+		bankKeeper  *mock.BankKeeperMock
+		ibcDenom    string
+		locked      sdk.Int
+	)
+
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		axelarnetSubspace := params.NewSubspace(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("axelarnetKey"), sdk.NewKVStoreKey("tAxelarnetKey"), "axelarnet")
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		keeper = axelarnetKeeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey("axelarnet"), axelarnetSubspace)
+
+		asset := randomDenom()
+		path := randomIBCPath()
+		keeper.RegisterAssetToCosmosChain(ctx, asset, testChain)
+		assert.NoError(t, keeper.RegisterIBCPath(ctx, testChain, path))
+		ibcDenom = ibctypes.ParseDenomTrace(fmt.Sprintf("%s/%s", path, asset)).IBCDenom()
+
+		evmChain := nexus.Chain{Name: rand.StrBetween(5, 10), NativeAsset: rand.StrBetween(3, 5), SupportsForeignAssets: true}
+		pending := sdk.NewCoin(asset, sdk.NewInt(rand.I64Between(1, 100000)))
+		total := sdk.NewCoin(asset, sdk.NewInt(rand.I64Between(1, 100000)))
+		// collected fees remain part of the total of the chain they are collected from
+		fee := sdk.NewCoin(asset, sdk.NewInt(rand.I64Between(0, total.Amount.Int64())))
+		locked = pending.Amount.Add(total.Amount).Sub(fee.Amount)
+
+		nexusKeeper = &mock.NexusMock{
+			GetChainsFunc: func(sdk.Context) []nexus.Chain { return []nexus.Chain{exported.Axelarnet, evmChain} },
+			GetTransfersForChainFunc: func(_ sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
+				if chain.Name != evmChain.Name || state != nexus.Pending {
+					return nil
+				}
+
+				return []nexus.CrossChainTransfer{{Recipient: nexus.CrossChainAddress{Chain: evmChain, Address: rand.StrBetween(5, 20)}, Asset: pending}}
+			},
+			GetChainTotalFunc: func(_ sdk.Context, chain nexus.Chain, denom string) sdk.Coin {
+				// axelarnet totals must be ignored
+				if chain.Name == exported.Axelarnet.Name {
+					return sdk.NewCoin(denom, sdk.NewInt(rand.PosI64()))
+				}
+
+				if denom == asset {
+					return total
+				}
+
+				return sdk.NewCoin(denom, sdk.ZeroInt())
+			},
+			GetFeeLedgerFunc: func(_ sdk.Context, sourceChain string, destinationChain string, denom string) []nexustypes.FeeLedgerEntry {
+				if sourceChain != evmChain.Name || denom != asset {
+					return nil
+				}
+
+				return []nexustypes.FeeLedgerEntry{{SourceChain: evmChain.Name, DestinationChain: exported.Axelarnet.Name, Collected: fee}}
+			},
+		}
+	}
+
+	t.Run("should hold when escrow accounts cover the locked assets", testutils.Func(func(t *testing.T) {
+		setup()
+		bankKeeper = &mock.BankKeeperMock{
+			GetBalanceFunc: func(_ sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+				if denom == ibcDenom && addr.Equals(types.GetEscrowAddress(ibcDenom)) {
+					return sdk.NewCoin(denom, locked.AddRaw(rand.I64Between(0, 100)))
+				}
+
+				return sdk.NewCoin(denom, sdk.ZeroInt())
+			},
+		}
+
+		_, broken := axelarnetKeeper.EscrowBalancesInvariant(keeper, nexusKeeper, bankKeeper)(ctx)
+		assert.False(t, broken)
+	}).Repeat(20))
+
+	t.Run("should break when an escrow account does not cover the locked assets", testutils.Func(func(t *testing.T) {
+		setup()
+		bankKeeper = &mock.BankKeeperMock{
+			GetBalanceFunc: func(_ sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+				if denom == ibcDenom && addr.Equals(types.GetEscrowAddress(ibcDenom)) {
+					return sdk.NewCoin(denom, locked.SubRaw(rand.I64Between(1, locked.Int64()+1)))
+				}
+
+				return sdk.NewCoin(denom, sdk.ZeroInt())
+			},
+		}
+
+		_, broken := axelarnetKeeper.EscrowBalancesInvariant(keeper, nexusKeeper, bankKeeper)(ctx)
+		assert.True(t, broken)
+	}).Repeat(20))
+}
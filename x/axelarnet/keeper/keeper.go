@@ -66,6 +66,22 @@ func (k Keeper) GetRouteTimeoutWindow(ctx sdk.Context) uint64 {
 	return result
 }
 
+// GetFeeDistributionTarget returns the destination of the collected bridge fees
+func (k Keeper) GetFeeDistributionTarget(ctx sdk.Context) types.FeeDistributionTarget {
+	var result types.FeeDistributionTarget
+	k.params.Get(ctx, types.KeyFeeDistributionTarget, &result)
+
+	return result
+}
+
+// GetFeeDistributionInterval returns the number of blocks between fee distributions
+func (k Keeper) GetFeeDistributionInterval(ctx sdk.Context) int64 {
+	var result int64
+	k.params.Get(ctx, types.KeyFeeDistributionInterval, &result)
+
+	return result
+}
+
 // GetTransactionFeeRate returns the transaction fee rate for axelarnet and cosmos chains
 func (k Keeper) GetTransactionFeeRate(ctx sdk.Context) sdk.Dec {
 	var result sdk.Dec
@@ -14,6 +14,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -155,7 +156,7 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 
 	}
 
-	if err := s.nexus.EnqueueForTransfer(ctx, depositAddr, req.Token, s.GetTransactionFeeRate(ctx)); err != nil {
+	if err := s.nexus.EnqueueForTransfer(ctx, depositAddr, req.Token, s.GetTransactionFeeRate(ctx), hex.EncodeToString(req.TxID)); err != nil {
 		return nil, err
 	}
 
@@ -184,11 +185,18 @@ func (s msgServer) ExecutePendingTransfers(c context.Context, req *types.Execute
 		return &types.ExecutePendingTransfersResponse{}, nil
 	}
 
+	// transfers to axelarnet are executed by the current transaction
+	executionID := getTxHash(ctx)
 	for _, pendingTransfer := range pendingTransfers {
+		// frozen transfers stay pending until the freeze on both the source and the destination chain is lifted
+		if s.nexus.IsTransferFrozen(ctx, chain, pendingTransfer.Asset.Denom) || s.nexus.IsTransferFrozen(ctx, pendingTransfer.Sender.Chain, pendingTransfer.Asset.Denom) {
+			continue
+		}
+
 		recipient, err := sdk.AccAddressFromBech32(pendingTransfer.Recipient.Address)
 		if err != nil {
 			ctx.Logger().Debug(fmt.Sprintf("Discard invalid recipient %s and continue", pendingTransfer.Recipient.Address))
-			s.nexus.ArchivePendingTransfer(ctx, pendingTransfer)
+			s.nexus.ArchivePendingTransfer(ctx, pendingTransfer, executionID)
 			continue
 		}
 
@@ -202,7 +210,7 @@ func (s msgServer) ExecutePendingTransfers(c context.Context, req *types.Execute
 		); err != nil {
 			return nil, err
 		}
-		s.nexus.ArchivePendingTransfer(ctx, pendingTransfer)
+		s.nexus.ArchivePendingTransfer(ctx, pendingTransfer, executionID)
 	}
 
 	return &types.ExecutePendingTransfersResponse{}, nil
@@ -293,6 +301,11 @@ func (s msgServer) RouteIBCTransfers(c context.Context, req *types.RouteIBCTrans
 		}
 		pendingTransfers := s.nexus.GetTransfersForChain(ctx, chain, nexus.Pending)
 		for _, p := range pendingTransfers {
+			// frozen transfers stay pending until the freeze on both the source and the destination chain is lifted
+			if s.nexus.IsTransferFrozen(ctx, chain, p.Asset.Denom) || s.nexus.IsTransferFrozen(ctx, p.Sender.Chain, p.Asset.Denom) {
+				continue
+			}
+
 			token, sender, err := prepareTransfer(ctx, s.BaseKeeper, s.nexus, s.bank, s.account, p)
 			if err != nil {
 				return nil, err
@@ -303,7 +316,7 @@ func (s msgServer) RouteIBCTransfers(c context.Context, req *types.RouteIBCTrans
 				ctx.Logger().Error(fmt.Sprintf("failed to send IBC transfer %s for %s:  %e", token, p.Recipient.Address, err))
 				continue
 			}
-			s.nexus.ArchivePendingTransfer(ctx, p)
+			s.nexus.ArchivePendingTransfer(ctx, p, getTxHash(ctx))
 		}
 	}
 
@@ -452,3 +465,8 @@ func prepareTransfer(ctx sdk.Context, k types.BaseKeeper, n types.Nexus, b types
 
 	return token, sender, nil
 }
+
+// getTxHash returns the hash of the transaction that is currently being executed
+func getTxHash(ctx sdk.Context) string {
+	return strings.ToUpper(hex.EncodeToString(tmhash.Sum(ctx.TxBytes())))
+}
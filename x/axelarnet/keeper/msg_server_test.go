@@ -35,8 +35,9 @@ import (
 )
 
 const (
-	testChain = "cosmoschain-0"
-	testToken = "stake"
+	testChain       = "cosmoschain-0"
+	testSourceChain = "evmchain-0"
+	testToken       = "stake"
 )
 
 func TestHandleMsgLink(t *testing.T) {
@@ -121,7 +122,7 @@ func TestHandleMsgConfirmDeposit(t *testing.T) {
 				}, true
 			},
 			IsAssetRegisteredFunc:  func(sdk.Context, string, string) bool { return true },
-			EnqueueForTransferFunc: func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec) error { return nil },
+			EnqueueForTransferFunc: func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec, string) error { return nil },
 			AddToChainTotalFunc:    func(_ sdk.Context, _ nexus.Chain, _ sdk.Coin) {},
 		}
 		bankKeeper = &mock.BankKeeperMock{
@@ -161,7 +162,7 @@ func TestHandleMsgConfirmDeposit(t *testing.T) {
 	t.Run("should return error when EnqueueForTransfer in nexus keeper failed", testutils.Func(func(t *testing.T) {
 		setup()
 		msg = randomMsgConfirmDeposit()
-		nexusKeeper.EnqueueForTransferFunc = func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec) error {
+		nexusKeeper.EnqueueForTransferFunc = func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec, string) error {
 			return fmt.Errorf("failed")
 		}
 
@@ -308,7 +309,8 @@ func TestHandleMsgExecutePendingTransfers(t *testing.T) {
 				}
 				return transfers
 			},
-			ArchivePendingTransferFunc: func(sdk.Context, nexus.CrossChainTransfer) {},
+			ArchivePendingTransferFunc: func(sdk.Context, nexus.CrossChainTransfer, string) {},
+			IsTransferFrozenFunc:       func(sdk.Context, nexus.Chain, string) bool { return false },
 			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
 				return nexus.Chain{
 					Name:                  chain,
@@ -317,7 +319,7 @@ func TestHandleMsgExecutePendingTransfers(t *testing.T) {
 				}, true
 			},
 			IsAssetRegisteredFunc:  func(sdk.Context, string, string) bool { return true },
-			EnqueueForTransferFunc: func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec) error { return nil },
+			EnqueueForTransferFunc: func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec, string) error { return nil },
 		}
 		bankKeeper = &mock.BankKeeperMock{
 			MintCoinsFunc: func(sdk.Context, string, sdk.Coins) error { return nil },
@@ -343,6 +345,26 @@ func TestHandleMsgExecutePendingTransfers(t *testing.T) {
 		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), len(transfers))
 	}).Repeat(repeatCount))
 
+	t.Run("should keep frozen transfers pending", testutils.Func(func(t *testing.T) {
+		setup()
+		nexusKeeper.IsTransferFrozenFunc = func(sdk.Context, nexus.Chain, string) bool { return true }
+		msg = types.NewExecutePendingTransfersRequest(rand.AccAddr())
+		_, err := server.ExecutePendingTransfers(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, bankKeeper.SendCoinsCalls(), 0)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should keep transfers from a frozen source chain pending", testutils.Func(func(t *testing.T) {
+		setup()
+		nexusKeeper.IsTransferFrozenFunc = func(_ sdk.Context, chain nexus.Chain, _ string) bool { return chain.Name == testSourceChain }
+		msg = types.NewExecutePendingTransfersRequest(rand.AccAddr())
+		_, err := server.ExecutePendingTransfers(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, bankKeeper.SendCoinsCalls(), 0)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
 	t.Run("should return error when MintCoins in bank keeper failed", testutils.Func(func(t *testing.T) {
 		setup()
 		bankKeeper.MintCoinsFunc = func(sdk.Context, string, sdk.Coins) error {
@@ -559,7 +581,8 @@ func TestHandleMsgRouteIBCTransfers(t *testing.T) {
 				}
 				return transfers
 			},
-			ArchivePendingTransferFunc: func(sdk.Context, nexus.CrossChainTransfer) {},
+			ArchivePendingTransferFunc: func(sdk.Context, nexus.CrossChainTransfer, string) {},
+			IsTransferFrozenFunc:       func(sdk.Context, nexus.Chain, string) bool { return false },
 			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
 				return nexus.Chain{
 					Name:                  chain,
@@ -613,6 +636,26 @@ func TestHandleMsgRouteIBCTransfers(t *testing.T) {
 		assert.Len(t, axelarnetKeeper.SetPendingIBCTransferCalls(), len(transfers))
 	}).Repeat(repeatCount))
 
+	t.Run("should keep frozen transfers pending", testutils.Func(func(t *testing.T) {
+		setup()
+		nexusKeeper.IsTransferFrozenFunc = func(_ sdk.Context, chain nexus.Chain, _ string) bool { return chain.Name != testSourceChain }
+		msg = types.NewRouteIBCTransfersRequest(rand.AccAddr())
+		_, err := server.RouteIBCTransfers(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+		assert.Len(t, axelarnetKeeper.SetPendingIBCTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should keep transfers from a frozen source chain pending", testutils.Func(func(t *testing.T) {
+		setup()
+		nexusKeeper.IsTransferFrozenFunc = func(_ sdk.Context, chain nexus.Chain, _ string) bool { return chain.Name == testSourceChain }
+		msg = types.NewRouteIBCTransfersRequest(rand.AccAddr())
+		_, err := server.RouteIBCTransfers(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+		assert.Len(t, axelarnetKeeper.SetPendingIBCTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
 	t.Run("should return error when no path registered for cosmos chain", testutils.Func(func(t *testing.T) {
 		setup()
 		axelarnetKeeper.GetIBCPathFunc = func(sdk.Context, string) (string, bool) { return "", false }
@@ -652,7 +695,10 @@ func randomTransfer(asset string, chain string) nexus.CrossChainTransfer {
 	ranAddr := sdk.AccAddress(hash[:20]).String()
 	c := nexus.Chain{Name: chain, NativeAsset: "cosmos", SupportsForeignAssets: true}
 
+	source := nexus.Chain{Name: testSourceChain, NativeAsset: rand.StrBetween(5, 10), SupportsForeignAssets: true}
+
 	return nexus.CrossChainTransfer{
+		Sender:    nexus.CrossChainAddress{Chain: source, Address: "0x" + rand.HexStr(40)},
 		Recipient: nexus.CrossChainAddress{Chain: c, Address: ranAddr},
 		Asset:     sdk.NewInt64Coin(asset, rand.I64Between(1, 10000000000)),
 		ID:        mathRand.Uint64(),
@@ -1,6 +1,7 @@
 package axelarnet
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -68,7 +69,10 @@ func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Rout
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux) {
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryServiceHandlerClient(context.Background(), mux, types.NewQueryServiceClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns all CLI tx commands for this module
@@ -91,6 +95,7 @@ type AppModule struct {
 	transfer       types.IBCTransferKeeper
 	channel        types.ChannelKeeper
 	account        types.AccountKeeper
+	distributor    types.Distributor
 	msgSvcRouter   *baseapp.MsgServiceRouter
 	router         sdk.Router
 	transferModule transfer.AppModule
@@ -104,6 +109,7 @@ func NewAppModule(
 	transfer types.IBCTransferKeeper,
 	channel types.ChannelKeeper,
 	account types.AccountKeeper,
+	distributor types.Distributor,
 	msgSvcRouter *baseapp.MsgServiceRouter,
 	router sdk.Router,
 	transferModule transfer.AppModule,
@@ -117,6 +123,7 @@ func NewAppModule(
 		transfer:       transfer,
 		channel:        channel,
 		account:        account,
+		distributor:    distributor,
 		msgSvcRouter:   msgSvcRouter,
 		router:         router,
 		transferModule: transferModule,
@@ -124,8 +131,8 @@ func NewAppModule(
 }
 
 // RegisterInvariants registers this module's invariants
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
-	// No invariants yet
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper, am.nexus, am.bank)
 }
 
 // InitGenesis initializes the module's keeper from the given genesis state
@@ -161,7 +168,8 @@ func (am AppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier {
 
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
-func (am AppModule) RegisterServices(module.Configurator) {
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterQueryServiceServer(cfg.QueryServer(), keeper.NewGRPCQuerier(am.keeper))
 }
 
 // BeginBlock executes all state transitions this module requires at the beginning of each new block
@@ -171,7 +179,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock executes all state transitions this module requires at the end of each new block
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return EndBlocker(ctx, req)
+	return EndBlocker(ctx, req, am.keeper, am.nexus, am.bank, am.distributor)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
 )
@@ -20,6 +21,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RefundMsgRequest{}, "axelarnet/RefundMsgRequest", nil)
 	cdc.RegisterConcrete(&RouteIBCTransfersRequest{}, "axelarnet/RouteIBCTransfers", nil)
 	cdc.RegisterConcrete(&RegisterFeeCollectorRequest{}, "axelarnet/RegisterFeeCollector", nil)
+	cdc.RegisterConcrete(&AddCosmosChainProposal{}, "axelarnet/AddCosmosChainProposal", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -35,6 +37,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&RouteIBCTransfersRequest{},
 		&RegisterFeeCollectorRequest{},
 	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddCosmosChainProposal{},
+	)
 	registry.RegisterInterface("axelarnet.v1beta1.Refundable",
 		(*axelarnet.Refundable)(nil))
 }
@@ -4,6 +4,7 @@ package types
 const (
 	EventTypeDepositConfirmation = "depositConfirmation"
 	EventTypeLink                = "link"
+	EventTypeFeeDistribution     = "feeDistribution"
 )
 
 // Event attribute keys
@@ -14,9 +15,11 @@ const (
 	AttributeKeyDepositAddress     = "depositAddress"
 	AttributeKeyDestinationAddress = "destinationAddress"
 	AttributeKeyDestinationChain   = "destinationChain"
+	AttributeKeyTarget             = "target"
 )
 
 // Event attribute values
 const (
-	AttributeValueConfirm = "confirm"
+	AttributeValueConfirm    = "confirm"
+	AttributeValueDistribute = "distribute"
 )
@@ -10,9 +10,10 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
 )
 
-//go:generate moq -out ./mock/expected_keepers.go -pkg mock . BaseKeeper  Nexus  BankKeeper IBCTransferKeeper ChannelKeeper AccountKeeper
+//go:generate moq -out ./mock/expected_keepers.go -pkg mock . BaseKeeper  Nexus  BankKeeper IBCTransferKeeper ChannelKeeper AccountKeeper Distributor
 
 // BaseKeeper is implemented by this module's base keeper
 type BaseKeeper interface {
@@ -20,6 +21,8 @@ type BaseKeeper interface {
 	SetParams(ctx sdk.Context, n Nexus, p Params)
 	GetRouteTimeoutWindow(ctx sdk.Context) uint64
 	GetTransactionFeeRate(ctx sdk.Context) sdk.Dec
+	GetFeeDistributionTarget(ctx sdk.Context) FeeDistributionTarget
+	GetFeeDistributionInterval(ctx sdk.Context) int64
 
 	RegisterIBCPath(ctx sdk.Context, asset, path string) error
 	GetIBCPath(ctx sdk.Context, chain string) (string, bool)
@@ -37,16 +40,22 @@ type BaseKeeper interface {
 
 // Nexus provides functionality to manage cross-chain transfers
 type Nexus interface {
-	EnqueueForTransfer(ctx sdk.Context, sender nexus.CrossChainAddress, amount sdk.Coin, feeRate sdk.Dec) error
+	EnqueueForTransfer(ctx sdk.Context, sender nexus.CrossChainAddress, amount sdk.Coin, feeRate sdk.Dec, depositTxID string) error
 	GetTransfersForChain(ctx sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer
-	ArchivePendingTransfer(ctx sdk.Context, transfer nexus.CrossChainTransfer)
+	ArchivePendingTransfer(ctx sdk.Context, transfer nexus.CrossChainTransfer, executionID string)
+	IsTransferFrozen(ctx sdk.Context, chain nexus.Chain, asset string) bool
 	GetChain(ctx sdk.Context, chain string) (nexus.Chain, bool)
+	GetChains(ctx sdk.Context) []nexus.Chain
 	IsAssetRegistered(ctx sdk.Context, chainName, denom string) bool
 	RegisterAsset(ctx sdk.Context, chainName, denom string)
 	LinkAddresses(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress)
 	GetRecipient(ctx sdk.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool)
 	AddToChainTotal(ctx sdk.Context, chain nexus.Chain, amount sdk.Coin)
+	GetChainTotal(ctx sdk.Context, chain nexus.Chain, denom string) sdk.Coin
 	SetChain(ctx sdk.Context, chain nexus.Chain)
+	GetUndistributedFees(ctx sdk.Context) sdk.Coins
+	GetFeeLedger(ctx sdk.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeLedgerEntry
+	AddDistributedFees(ctx sdk.Context, fees sdk.Coins)
 }
 
 // BankKeeper defines the expected interface contract the vesting module requires
@@ -57,6 +66,7 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
 // IBCTransferKeeper provides functionality to manage IBC transfers
@@ -76,3 +86,8 @@ type ChannelKeeper interface {
 type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// Distributor provides functionality to fund the community pool
+type Distributor interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
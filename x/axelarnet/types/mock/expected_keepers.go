@@ -6,6 +6,7 @@ package mock
 import (
 	axelarnettypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
 	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
 	cosmossdktypes "github.com/cosmos/cosmos-sdk/types"
 	ibctypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
@@ -40,6 +41,12 @@ var _ axelarnettypes.BaseKeeper = &BaseKeeperMock{}
 // 			GetFeeCollectorFunc: func(ctx cosmossdktypes.Context) (cosmossdktypes.AccAddress, bool) {
 // 				panic("mock out the GetFeeCollector method")
 // 			},
+// 			GetFeeDistributionIntervalFunc: func(ctx cosmossdktypes.Context) int64 {
+// 				panic("mock out the GetFeeDistributionInterval method")
+// 			},
+// 			GetFeeDistributionTargetFunc: func(ctx cosmossdktypes.Context) axelarnettypes.FeeDistributionTarget {
+// 				panic("mock out the GetFeeDistributionTarget method")
+// 			},
 // 			GetIBCPathFunc: func(ctx cosmossdktypes.Context, chain string) (string, bool) {
 // 				panic("mock out the GetIBCPath method")
 // 			},
@@ -95,6 +102,12 @@ type BaseKeeperMock struct {
 	// GetFeeCollectorFunc mocks the GetFeeCollector method.
 	GetFeeCollectorFunc func(ctx cosmossdktypes.Context) (cosmossdktypes.AccAddress, bool)
 
+	// GetFeeDistributionIntervalFunc mocks the GetFeeDistributionInterval method.
+	GetFeeDistributionIntervalFunc func(ctx cosmossdktypes.Context) int64
+
+	// GetFeeDistributionTargetFunc mocks the GetFeeDistributionTarget method.
+	GetFeeDistributionTargetFunc func(ctx cosmossdktypes.Context) axelarnettypes.FeeDistributionTarget
+
 	// GetIBCPathFunc mocks the GetIBCPath method.
 	GetIBCPathFunc func(ctx cosmossdktypes.Context, chain string) (string, bool)
 
@@ -165,6 +178,16 @@ type BaseKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx cosmossdktypes.Context
 		}
+		// GetFeeDistributionInterval holds details about calls to the GetFeeDistributionInterval method.
+		GetFeeDistributionInterval []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+		}
+		// GetFeeDistributionTarget holds details about calls to the GetFeeDistributionTarget method.
+		GetFeeDistributionTarget []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+		}
 		// GetIBCPath holds details about calls to the GetIBCPath method.
 		GetIBCPath []struct {
 			// Ctx is the ctx argument value.
@@ -258,6 +281,8 @@ type BaseKeeperMock struct {
 	lockGetCosmosChain             sync.RWMutex
 	lockGetCosmosChains            sync.RWMutex
 	lockGetFeeCollector            sync.RWMutex
+	lockGetFeeDistributionInterval sync.RWMutex
+	lockGetFeeDistributionTarget   sync.RWMutex
 	lockGetIBCPath                 sync.RWMutex
 	lockGetPendingIBCTransfer      sync.RWMutex
 	lockGetPendingRefund           sync.RWMutex
@@ -446,6 +471,68 @@ func (mock *BaseKeeperMock) GetFeeCollectorCalls() []struct {
 	return calls
 }
 
+// GetFeeDistributionInterval calls GetFeeDistributionIntervalFunc.
+func (mock *BaseKeeperMock) GetFeeDistributionInterval(ctx cosmossdktypes.Context) int64 {
+	if mock.GetFeeDistributionIntervalFunc == nil {
+		panic("BaseKeeperMock.GetFeeDistributionIntervalFunc: method is nil but BaseKeeper.GetFeeDistributionInterval was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetFeeDistributionInterval.Lock()
+	mock.calls.GetFeeDistributionInterval = append(mock.calls.GetFeeDistributionInterval, callInfo)
+	mock.lockGetFeeDistributionInterval.Unlock()
+	return mock.GetFeeDistributionIntervalFunc(ctx)
+}
+
+// GetFeeDistributionIntervalCalls gets all the calls that were made to GetFeeDistributionInterval.
+// Check the length with:
+//     len(mockedBaseKeeper.GetFeeDistributionIntervalCalls())
+func (mock *BaseKeeperMock) GetFeeDistributionIntervalCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockGetFeeDistributionInterval.RLock()
+	calls = mock.calls.GetFeeDistributionInterval
+	mock.lockGetFeeDistributionInterval.RUnlock()
+	return calls
+}
+
+// GetFeeDistributionTarget calls GetFeeDistributionTargetFunc.
+func (mock *BaseKeeperMock) GetFeeDistributionTarget(ctx cosmossdktypes.Context) axelarnettypes.FeeDistributionTarget {
+	if mock.GetFeeDistributionTargetFunc == nil {
+		panic("BaseKeeperMock.GetFeeDistributionTargetFunc: method is nil but BaseKeeper.GetFeeDistributionTarget was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetFeeDistributionTarget.Lock()
+	mock.calls.GetFeeDistributionTarget = append(mock.calls.GetFeeDistributionTarget, callInfo)
+	mock.lockGetFeeDistributionTarget.Unlock()
+	return mock.GetFeeDistributionTargetFunc(ctx)
+}
+
+// GetFeeDistributionTargetCalls gets all the calls that were made to GetFeeDistributionTarget.
+// Check the length with:
+//     len(mockedBaseKeeper.GetFeeDistributionTargetCalls())
+func (mock *BaseKeeperMock) GetFeeDistributionTargetCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockGetFeeDistributionTarget.RLock()
+	calls = mock.calls.GetFeeDistributionTarget
+	mock.lockGetFeeDistributionTarget.RUnlock()
+	return calls
+}
+
 // GetIBCPath calls GetIBCPathFunc.
 func (mock *BaseKeeperMock) GetIBCPath(ctx cosmossdktypes.Context, chain string) (string, bool) {
 	if mock.GetIBCPathFunc == nil {
@@ -861,27 +948,45 @@ var _ axelarnettypes.Nexus = &NexusMock{}
 //
 // 		// make and configure a mocked axelarnettypes.Nexus
 // 		mockedNexus := &NexusMock{
+// 			AddDistributedFeesFunc: func(ctx cosmossdktypes.Context, fees cosmossdktypes.Coins)  {
+// 				panic("mock out the AddDistributedFees method")
+// 			},
 // 			AddToChainTotalFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, amount cosmossdktypes.Coin)  {
 // 				panic("mock out the AddToChainTotal method")
 // 			},
-// 			ArchivePendingTransferFunc: func(ctx cosmossdktypes.Context, transfer exported.CrossChainTransfer)  {
+// 			ArchivePendingTransferFunc: func(ctx cosmossdktypes.Context, transfer exported.CrossChainTransfer, executionID string)  {
 // 				panic("mock out the ArchivePendingTransfer method")
 // 			},
-// 			EnqueueForTransferFunc: func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, amount cosmossdktypes.Coin, feeRate cosmossdktypes.Dec) error {
+// 			EnqueueForTransferFunc: func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, amount cosmossdktypes.Coin, feeRate cosmossdktypes.Dec, depositTxID string) error {
 // 				panic("mock out the EnqueueForTransfer method")
 // 			},
 // 			GetChainFunc: func(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool) {
 // 				panic("mock out the GetChain method")
 // 			},
+// 			GetChainTotalFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, denom string) cosmossdktypes.Coin {
+// 				panic("mock out the GetChainTotal method")
+// 			},
+// 			GetChainsFunc: func(ctx cosmossdktypes.Context) []exported.Chain {
+// 				panic("mock out the GetChains method")
+// 			},
+// 			GetFeeLedgerFunc: func(ctx cosmossdktypes.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeLedgerEntry {
+// 				panic("mock out the GetFeeLedger method")
+// 			},
 // 			GetRecipientFunc: func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
 // 				panic("mock out the GetRecipient method")
 // 			},
 // 			GetTransfersForChainFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, state exported.TransferState) []exported.CrossChainTransfer {
 // 				panic("mock out the GetTransfersForChain method")
 // 			},
+// 			GetUndistributedFeesFunc: func(ctx cosmossdktypes.Context) cosmossdktypes.Coins {
+// 				panic("mock out the GetUndistributedFees method")
+// 			},
 // 			IsAssetRegisteredFunc: func(ctx cosmossdktypes.Context, chainName string, denom string) bool {
 // 				panic("mock out the IsAssetRegistered method")
 // 			},
+// 			IsTransferFrozenFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, asset string) bool {
+// 				panic("mock out the IsTransferFrozen method")
+// 			},
 // 			LinkAddressesFunc: func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress)  {
 // 				panic("mock out the LinkAddresses method")
 // 			},
@@ -898,27 +1003,45 @@ var _ axelarnettypes.Nexus = &NexusMock{}
 //
 // 	}
 type NexusMock struct {
+	// AddDistributedFeesFunc mocks the AddDistributedFees method.
+	AddDistributedFeesFunc func(ctx cosmossdktypes.Context, fees cosmossdktypes.Coins)
+
 	// AddToChainTotalFunc mocks the AddToChainTotal method.
 	AddToChainTotalFunc func(ctx cosmossdktypes.Context, chain exported.Chain, amount cosmossdktypes.Coin)
 
 	// ArchivePendingTransferFunc mocks the ArchivePendingTransfer method.
-	ArchivePendingTransferFunc func(ctx cosmossdktypes.Context, transfer exported.CrossChainTransfer)
+	ArchivePendingTransferFunc func(ctx cosmossdktypes.Context, transfer exported.CrossChainTransfer, executionID string)
 
 	// EnqueueForTransferFunc mocks the EnqueueForTransfer method.
-	EnqueueForTransferFunc func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, amount cosmossdktypes.Coin, feeRate cosmossdktypes.Dec) error
+	EnqueueForTransferFunc func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, amount cosmossdktypes.Coin, feeRate cosmossdktypes.Dec, depositTxID string) error
 
 	// GetChainFunc mocks the GetChain method.
 	GetChainFunc func(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool)
 
+	// GetChainTotalFunc mocks the GetChainTotal method.
+	GetChainTotalFunc func(ctx cosmossdktypes.Context, chain exported.Chain, denom string) cosmossdktypes.Coin
+
+	// GetChainsFunc mocks the GetChains method.
+	GetChainsFunc func(ctx cosmossdktypes.Context) []exported.Chain
+
+	// GetFeeLedgerFunc mocks the GetFeeLedger method.
+	GetFeeLedgerFunc func(ctx cosmossdktypes.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeLedgerEntry
+
 	// GetRecipientFunc mocks the GetRecipient method.
 	GetRecipientFunc func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool)
 
 	// GetTransfersForChainFunc mocks the GetTransfersForChain method.
 	GetTransfersForChainFunc func(ctx cosmossdktypes.Context, chain exported.Chain, state exported.TransferState) []exported.CrossChainTransfer
 
+	// GetUndistributedFeesFunc mocks the GetUndistributedFees method.
+	GetUndistributedFeesFunc func(ctx cosmossdktypes.Context) cosmossdktypes.Coins
+
 	// IsAssetRegisteredFunc mocks the IsAssetRegistered method.
 	IsAssetRegisteredFunc func(ctx cosmossdktypes.Context, chainName string, denom string) bool
 
+	// IsTransferFrozenFunc mocks the IsTransferFrozen method.
+	IsTransferFrozenFunc func(ctx cosmossdktypes.Context, chain exported.Chain, asset string) bool
+
 	// LinkAddressesFunc mocks the LinkAddresses method.
 	LinkAddressesFunc func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress)
 
@@ -930,6 +1053,13 @@ type NexusMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddDistributedFees holds details about calls to the AddDistributedFees method.
+		AddDistributedFees []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Fees is the fees argument value.
+			Fees cosmossdktypes.Coins
+		}
 		// AddToChainTotal holds details about calls to the AddToChainTotal method.
 		AddToChainTotal []struct {
 			// Ctx is the ctx argument value.
@@ -945,6 +1075,8 @@ type NexusMock struct {
 			Ctx cosmossdktypes.Context
 			// Transfer is the transfer argument value.
 			Transfer exported.CrossChainTransfer
+			// ExecutionID is the executionID argument value.
+			ExecutionID string
 		}
 		// EnqueueForTransfer holds details about calls to the EnqueueForTransfer method.
 		EnqueueForTransfer []struct {
@@ -956,6 +1088,8 @@ type NexusMock struct {
 			Amount cosmossdktypes.Coin
 			// FeeRate is the feeRate argument value.
 			FeeRate cosmossdktypes.Dec
+			// DepositTxID is the depositTxID argument value.
+			DepositTxID string
 		}
 		// GetChain holds details about calls to the GetChain method.
 		GetChain []struct {
@@ -964,6 +1098,31 @@ type NexusMock struct {
 			// Chain is the chain argument value.
 			Chain string
 		}
+		// GetChainTotal holds details about calls to the GetChainTotal method.
+		GetChainTotal []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Chain is the chain argument value.
+			Chain exported.Chain
+			// Denom is the denom argument value.
+			Denom string
+		}
+		// GetChains holds details about calls to the GetChains method.
+		GetChains []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+		}
+		// GetFeeLedger holds details about calls to the GetFeeLedger method.
+		GetFeeLedger []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// SourceChain is the sourceChain argument value.
+			SourceChain string
+			// DestinationChain is the destinationChain argument value.
+			DestinationChain string
+			// Asset is the asset argument value.
+			Asset string
+		}
 		// GetRecipient holds details about calls to the GetRecipient method.
 		GetRecipient []struct {
 			// Ctx is the ctx argument value.
@@ -980,6 +1139,11 @@ type NexusMock struct {
 			// State is the state argument value.
 			State exported.TransferState
 		}
+		// GetUndistributedFees holds details about calls to the GetUndistributedFees method.
+		GetUndistributedFees []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+		}
 		// IsAssetRegistered holds details about calls to the IsAssetRegistered method.
 		IsAssetRegistered []struct {
 			// Ctx is the ctx argument value.
@@ -989,6 +1153,15 @@ type NexusMock struct {
 			// Denom is the denom argument value.
 			Denom string
 		}
+		// IsTransferFrozen holds details about calls to the IsTransferFrozen method.
+		IsTransferFrozen []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Chain is the chain argument value.
+			Chain exported.Chain
+			// Asset is the asset argument value.
+			Asset string
+		}
 		// LinkAddresses holds details about calls to the LinkAddresses method.
 		LinkAddresses []struct {
 			// Ctx is the ctx argument value.
@@ -1015,18 +1188,59 @@ type NexusMock struct {
 			Chain exported.Chain
 		}
 	}
+	lockAddDistributedFees     sync.RWMutex
 	lockAddToChainTotal        sync.RWMutex
 	lockArchivePendingTransfer sync.RWMutex
 	lockEnqueueForTransfer     sync.RWMutex
 	lockGetChain               sync.RWMutex
+	lockGetChainTotal          sync.RWMutex
+	lockGetChains              sync.RWMutex
+	lockGetFeeLedger           sync.RWMutex
 	lockGetRecipient           sync.RWMutex
 	lockGetTransfersForChain   sync.RWMutex
+	lockGetUndistributedFees   sync.RWMutex
 	lockIsAssetRegistered      sync.RWMutex
+	lockIsTransferFrozen       sync.RWMutex
 	lockLinkAddresses          sync.RWMutex
 	lockRegisterAsset          sync.RWMutex
 	lockSetChain               sync.RWMutex
 }
 
+// AddDistributedFees calls AddDistributedFeesFunc.
+func (mock *NexusMock) AddDistributedFees(ctx cosmossdktypes.Context, fees cosmossdktypes.Coins) {
+	if mock.AddDistributedFeesFunc == nil {
+		panic("NexusMock.AddDistributedFeesFunc: method is nil but Nexus.AddDistributedFees was just called")
+	}
+	callInfo := struct {
+		Ctx  cosmossdktypes.Context
+		Fees cosmossdktypes.Coins
+	}{
+		Ctx:  ctx,
+		Fees: fees,
+	}
+	mock.lockAddDistributedFees.Lock()
+	mock.calls.AddDistributedFees = append(mock.calls.AddDistributedFees, callInfo)
+	mock.lockAddDistributedFees.Unlock()
+	mock.AddDistributedFeesFunc(ctx, fees)
+}
+
+// AddDistributedFeesCalls gets all the calls that were made to AddDistributedFees.
+// Check the length with:
+//     len(mockedNexus.AddDistributedFeesCalls())
+func (mock *NexusMock) AddDistributedFeesCalls() []struct {
+	Ctx  cosmossdktypes.Context
+	Fees cosmossdktypes.Coins
+} {
+	var calls []struct {
+		Ctx  cosmossdktypes.Context
+		Fees cosmossdktypes.Coins
+	}
+	mock.lockAddDistributedFees.RLock()
+	calls = mock.calls.AddDistributedFees
+	mock.lockAddDistributedFees.RUnlock()
+	return calls
+}
+
 // AddToChainTotal calls AddToChainTotalFunc.
 func (mock *NexusMock) AddToChainTotal(ctx cosmossdktypes.Context, chain exported.Chain, amount cosmossdktypes.Coin) {
 	if mock.AddToChainTotalFunc == nil {
@@ -1067,33 +1281,37 @@ func (mock *NexusMock) AddToChainTotalCalls() []struct {
 }
 
 // ArchivePendingTransfer calls ArchivePendingTransferFunc.
-func (mock *NexusMock) ArchivePendingTransfer(ctx cosmossdktypes.Context, transfer exported.CrossChainTransfer) {
+func (mock *NexusMock) ArchivePendingTransfer(ctx cosmossdktypes.Context, transfer exported.CrossChainTransfer, executionID string) {
 	if mock.ArchivePendingTransferFunc == nil {
 		panic("NexusMock.ArchivePendingTransferFunc: method is nil but Nexus.ArchivePendingTransfer was just called")
 	}
 	callInfo := struct {
-		Ctx      cosmossdktypes.Context
-		Transfer exported.CrossChainTransfer
+		Ctx         cosmossdktypes.Context
+		Transfer    exported.CrossChainTransfer
+		ExecutionID string
 	}{
-		Ctx:      ctx,
-		Transfer: transfer,
+		Ctx:         ctx,
+		Transfer:    transfer,
+		ExecutionID: executionID,
 	}
 	mock.lockArchivePendingTransfer.Lock()
 	mock.calls.ArchivePendingTransfer = append(mock.calls.ArchivePendingTransfer, callInfo)
 	mock.lockArchivePendingTransfer.Unlock()
-	mock.ArchivePendingTransferFunc(ctx, transfer)
+	mock.ArchivePendingTransferFunc(ctx, transfer, executionID)
 }
 
 // ArchivePendingTransferCalls gets all the calls that were made to ArchivePendingTransfer.
 // Check the length with:
 //     len(mockedNexus.ArchivePendingTransferCalls())
 func (mock *NexusMock) ArchivePendingTransferCalls() []struct {
-	Ctx      cosmossdktypes.Context
-	Transfer exported.CrossChainTransfer
+	Ctx         cosmossdktypes.Context
+	Transfer    exported.CrossChainTransfer
+	ExecutionID string
 } {
 	var calls []struct {
-		Ctx      cosmossdktypes.Context
-		Transfer exported.CrossChainTransfer
+		Ctx         cosmossdktypes.Context
+		Transfer    exported.CrossChainTransfer
+		ExecutionID string
 	}
 	mock.lockArchivePendingTransfer.RLock()
 	calls = mock.calls.ArchivePendingTransfer
@@ -1102,41 +1320,45 @@ func (mock *NexusMock) ArchivePendingTransferCalls() []struct {
 }
 
 // EnqueueForTransfer calls EnqueueForTransferFunc.
-func (mock *NexusMock) EnqueueForTransfer(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, amount cosmossdktypes.Coin, feeRate cosmossdktypes.Dec) error {
+func (mock *NexusMock) EnqueueForTransfer(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, amount cosmossdktypes.Coin, feeRate cosmossdktypes.Dec, depositTxID string) error {
 	if mock.EnqueueForTransferFunc == nil {
 		panic("NexusMock.EnqueueForTransferFunc: method is nil but Nexus.EnqueueForTransfer was just called")
 	}
 	callInfo := struct {
-		Ctx     cosmossdktypes.Context
-		Sender  exported.CrossChainAddress
-		Amount  cosmossdktypes.Coin
-		FeeRate cosmossdktypes.Dec
+		Ctx         cosmossdktypes.Context
+		Sender      exported.CrossChainAddress
+		Amount      cosmossdktypes.Coin
+		FeeRate     cosmossdktypes.Dec
+		DepositTxID string
 	}{
-		Ctx:     ctx,
-		Sender:  sender,
-		Amount:  amount,
-		FeeRate: feeRate,
+		Ctx:         ctx,
+		Sender:      sender,
+		Amount:      amount,
+		FeeRate:     feeRate,
+		DepositTxID: depositTxID,
 	}
 	mock.lockEnqueueForTransfer.Lock()
 	mock.calls.EnqueueForTransfer = append(mock.calls.EnqueueForTransfer, callInfo)
 	mock.lockEnqueueForTransfer.Unlock()
-	return mock.EnqueueForTransferFunc(ctx, sender, amount, feeRate)
+	return mock.EnqueueForTransferFunc(ctx, sender, amount, feeRate, depositTxID)
 }
 
 // EnqueueForTransferCalls gets all the calls that were made to EnqueueForTransfer.
 // Check the length with:
 //     len(mockedNexus.EnqueueForTransferCalls())
 func (mock *NexusMock) EnqueueForTransferCalls() []struct {
-	Ctx     cosmossdktypes.Context
-	Sender  exported.CrossChainAddress
-	Amount  cosmossdktypes.Coin
-	FeeRate cosmossdktypes.Dec
+	Ctx         cosmossdktypes.Context
+	Sender      exported.CrossChainAddress
+	Amount      cosmossdktypes.Coin
+	FeeRate     cosmossdktypes.Dec
+	DepositTxID string
 } {
 	var calls []struct {
-		Ctx     cosmossdktypes.Context
-		Sender  exported.CrossChainAddress
-		Amount  cosmossdktypes.Coin
-		FeeRate cosmossdktypes.Dec
+		Ctx         cosmossdktypes.Context
+		Sender      exported.CrossChainAddress
+		Amount      cosmossdktypes.Coin
+		FeeRate     cosmossdktypes.Dec
+		DepositTxID string
 	}
 	mock.lockEnqueueForTransfer.RLock()
 	calls = mock.calls.EnqueueForTransfer
@@ -1179,6 +1401,119 @@ func (mock *NexusMock) GetChainCalls() []struct {
 	return calls
 }
 
+// GetChainTotal calls GetChainTotalFunc.
+func (mock *NexusMock) GetChainTotal(ctx cosmossdktypes.Context, chain exported.Chain, denom string) cosmossdktypes.Coin {
+	if mock.GetChainTotalFunc == nil {
+		panic("NexusMock.GetChainTotalFunc: method is nil but Nexus.GetChainTotal was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Chain exported.Chain
+		Denom string
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+		Denom: denom,
+	}
+	mock.lockGetChainTotal.Lock()
+	mock.calls.GetChainTotal = append(mock.calls.GetChainTotal, callInfo)
+	mock.lockGetChainTotal.Unlock()
+	return mock.GetChainTotalFunc(ctx, chain, denom)
+}
+
+// GetChainTotalCalls gets all the calls that were made to GetChainTotal.
+// Check the length with:
+//     len(mockedNexus.GetChainTotalCalls())
+func (mock *NexusMock) GetChainTotalCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Chain exported.Chain
+	Denom string
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Chain exported.Chain
+		Denom string
+	}
+	mock.lockGetChainTotal.RLock()
+	calls = mock.calls.GetChainTotal
+	mock.lockGetChainTotal.RUnlock()
+	return calls
+}
+
+// GetChains calls GetChainsFunc.
+func (mock *NexusMock) GetChains(ctx cosmossdktypes.Context) []exported.Chain {
+	if mock.GetChainsFunc == nil {
+		panic("NexusMock.GetChainsFunc: method is nil but Nexus.GetChains was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetChains.Lock()
+	mock.calls.GetChains = append(mock.calls.GetChains, callInfo)
+	mock.lockGetChains.Unlock()
+	return mock.GetChainsFunc(ctx)
+}
+
+// GetChainsCalls gets all the calls that were made to GetChains.
+// Check the length with:
+//     len(mockedNexus.GetChainsCalls())
+func (mock *NexusMock) GetChainsCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockGetChains.RLock()
+	calls = mock.calls.GetChains
+	mock.lockGetChains.RUnlock()
+	return calls
+}
+
+// GetFeeLedger calls GetFeeLedgerFunc.
+func (mock *NexusMock) GetFeeLedger(ctx cosmossdktypes.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeLedgerEntry {
+	if mock.GetFeeLedgerFunc == nil {
+		panic("NexusMock.GetFeeLedgerFunc: method is nil but Nexus.GetFeeLedger was just called")
+	}
+	callInfo := struct {
+		Ctx              cosmossdktypes.Context
+		SourceChain      string
+		DestinationChain string
+		Asset            string
+	}{
+		Ctx:              ctx,
+		SourceChain:      sourceChain,
+		DestinationChain: destinationChain,
+		Asset:            asset,
+	}
+	mock.lockGetFeeLedger.Lock()
+	mock.calls.GetFeeLedger = append(mock.calls.GetFeeLedger, callInfo)
+	mock.lockGetFeeLedger.Unlock()
+	return mock.GetFeeLedgerFunc(ctx, sourceChain, destinationChain, asset)
+}
+
+// GetFeeLedgerCalls gets all the calls that were made to GetFeeLedger.
+// Check the length with:
+//     len(mockedNexus.GetFeeLedgerCalls())
+func (mock *NexusMock) GetFeeLedgerCalls() []struct {
+	Ctx              cosmossdktypes.Context
+	SourceChain      string
+	DestinationChain string
+	Asset            string
+} {
+	var calls []struct {
+		Ctx              cosmossdktypes.Context
+		SourceChain      string
+		DestinationChain string
+		Asset            string
+	}
+	mock.lockGetFeeLedger.RLock()
+	calls = mock.calls.GetFeeLedger
+	mock.lockGetFeeLedger.RUnlock()
+	return calls
+}
+
 // GetRecipient calls GetRecipientFunc.
 func (mock *NexusMock) GetRecipient(ctx cosmossdktypes.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
 	if mock.GetRecipientFunc == nil {
@@ -1253,6 +1588,37 @@ func (mock *NexusMock) GetTransfersForChainCalls() []struct {
 	return calls
 }
 
+// GetUndistributedFees calls GetUndistributedFeesFunc.
+func (mock *NexusMock) GetUndistributedFees(ctx cosmossdktypes.Context) cosmossdktypes.Coins {
+	if mock.GetUndistributedFeesFunc == nil {
+		panic("NexusMock.GetUndistributedFeesFunc: method is nil but Nexus.GetUndistributedFees was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetUndistributedFees.Lock()
+	mock.calls.GetUndistributedFees = append(mock.calls.GetUndistributedFees, callInfo)
+	mock.lockGetUndistributedFees.Unlock()
+	return mock.GetUndistributedFeesFunc(ctx)
+}
+
+// GetUndistributedFeesCalls gets all the calls that were made to GetUndistributedFees.
+// Check the length with:
+//     len(mockedNexus.GetUndistributedFeesCalls())
+func (mock *NexusMock) GetUndistributedFeesCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockGetUndistributedFees.RLock()
+	calls = mock.calls.GetUndistributedFees
+	mock.lockGetUndistributedFees.RUnlock()
+	return calls
+}
+
 // IsAssetRegistered calls IsAssetRegisteredFunc.
 func (mock *NexusMock) IsAssetRegistered(ctx cosmossdktypes.Context, chainName string, denom string) bool {
 	if mock.IsAssetRegisteredFunc == nil {
@@ -1292,6 +1658,45 @@ func (mock *NexusMock) IsAssetRegisteredCalls() []struct {
 	return calls
 }
 
+// IsTransferFrozen calls IsTransferFrozenFunc.
+func (mock *NexusMock) IsTransferFrozen(ctx cosmossdktypes.Context, chain exported.Chain, asset string) bool {
+	if mock.IsTransferFrozenFunc == nil {
+		panic("NexusMock.IsTransferFrozenFunc: method is nil but Nexus.IsTransferFrozen was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Chain exported.Chain
+		Asset string
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+		Asset: asset,
+	}
+	mock.lockIsTransferFrozen.Lock()
+	mock.calls.IsTransferFrozen = append(mock.calls.IsTransferFrozen, callInfo)
+	mock.lockIsTransferFrozen.Unlock()
+	return mock.IsTransferFrozenFunc(ctx, chain, asset)
+}
+
+// IsTransferFrozenCalls gets all the calls that were made to IsTransferFrozen.
+// Check the length with:
+//     len(mockedNexus.IsTransferFrozenCalls())
+func (mock *NexusMock) IsTransferFrozenCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Chain exported.Chain
+	Asset string
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Chain exported.Chain
+		Asset string
+	}
+	mock.lockIsTransferFrozen.RLock()
+	calls = mock.calls.IsTransferFrozen
+	mock.lockIsTransferFrozen.RUnlock()
+	return calls
+}
+
 // LinkAddresses calls LinkAddressesFunc.
 func (mock *NexusMock) LinkAddresses(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress) {
 	if mock.LinkAddressesFunc == nil {
@@ -1418,6 +1823,9 @@ var _ axelarnettypes.BankKeeper = &BankKeeperMock{}
 // 			BurnCoinsFunc: func(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error {
 // 				panic("mock out the BurnCoins method")
 // 			},
+// 			GetBalanceFunc: func(ctx cosmossdktypes.Context, addr cosmossdktypes.AccAddress, denom string) cosmossdktypes.Coin {
+// 				panic("mock out the GetBalance method")
+// 			},
 // 			MintCoinsFunc: func(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error {
 // 				panic("mock out the MintCoins method")
 // 			},
@@ -1440,6 +1848,9 @@ type BankKeeperMock struct {
 	// BurnCoinsFunc mocks the BurnCoins method.
 	BurnCoinsFunc func(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error
 
+	// GetBalanceFunc mocks the GetBalance method.
+	GetBalanceFunc func(ctx cosmossdktypes.Context, addr cosmossdktypes.AccAddress, denom string) cosmossdktypes.Coin
+
 	// MintCoinsFunc mocks the MintCoins method.
 	MintCoinsFunc func(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error
 
@@ -1463,6 +1874,15 @@ type BankKeeperMock struct {
 			// Amt is the amt argument value.
 			Amt cosmossdktypes.Coins
 		}
+		// GetBalance holds details about calls to the GetBalance method.
+		GetBalance []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Addr is the addr argument value.
+			Addr cosmossdktypes.AccAddress
+			// Denom is the denom argument value.
+			Denom string
+		}
 		// MintCoins holds details about calls to the MintCoins method.
 		MintCoins []struct {
 			// Ctx is the ctx argument value.
@@ -1507,6 +1927,7 @@ type BankKeeperMock struct {
 		}
 	}
 	lockBurnCoins                    sync.RWMutex
+	lockGetBalance                   sync.RWMutex
 	lockMintCoins                    sync.RWMutex
 	lockSendCoins                    sync.RWMutex
 	lockSendCoinsFromAccountToModule sync.RWMutex
@@ -1552,6 +1973,45 @@ func (mock *BankKeeperMock) BurnCoinsCalls() []struct {
 	return calls
 }
 
+// GetBalance calls GetBalanceFunc.
+func (mock *BankKeeperMock) GetBalance(ctx cosmossdktypes.Context, addr cosmossdktypes.AccAddress, denom string) cosmossdktypes.Coin {
+	if mock.GetBalanceFunc == nil {
+		panic("BankKeeperMock.GetBalanceFunc: method is nil but BankKeeper.GetBalance was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Addr  cosmossdktypes.AccAddress
+		Denom string
+	}{
+		Ctx:   ctx,
+		Addr:  addr,
+		Denom: denom,
+	}
+	mock.lockGetBalance.Lock()
+	mock.calls.GetBalance = append(mock.calls.GetBalance, callInfo)
+	mock.lockGetBalance.Unlock()
+	return mock.GetBalanceFunc(ctx, addr, denom)
+}
+
+// GetBalanceCalls gets all the calls that were made to GetBalance.
+// Check the length with:
+//     len(mockedBankKeeper.GetBalanceCalls())
+func (mock *BankKeeperMock) GetBalanceCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Addr  cosmossdktypes.AccAddress
+	Denom string
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Addr  cosmossdktypes.AccAddress
+		Denom string
+	}
+	mock.lockGetBalance.RLock()
+	calls = mock.calls.GetBalance
+	mock.lockGetBalance.RUnlock()
+	return calls
+}
+
 // MintCoins calls MintCoinsFunc.
 func (mock *BankKeeperMock) MintCoins(ctx cosmossdktypes.Context, moduleName string, amt cosmossdktypes.Coins) error {
 	if mock.MintCoinsFunc == nil {
@@ -2072,3 +2532,80 @@ func (mock *AccountKeeperMock) GetModuleAddressCalls() []struct {
 	mock.lockGetModuleAddress.RUnlock()
 	return calls
 }
+
+// Ensure, that DistributorMock does implement axelarnettypes.Distributor.
+// If this is not the case, regenerate this file with moq.
+var _ axelarnettypes.Distributor = &DistributorMock{}
+
+// DistributorMock is a mock implementation of axelarnettypes.Distributor.
+//
+// 	func TestSomethingThatUsesDistributor(t *testing.T) {
+//
+// 		// make and configure a mocked axelarnettypes.Distributor
+// 		mockedDistributor := &DistributorMock{
+// 			FundCommunityPoolFunc: func(ctx cosmossdktypes.Context, amount cosmossdktypes.Coins, sender cosmossdktypes.AccAddress) error {
+// 				panic("mock out the FundCommunityPool method")
+// 			},
+// 		}
+//
+// 		// use mockedDistributor in code that requires axelarnettypes.Distributor
+// 		// and then make assertions.
+//
+// 	}
+type DistributorMock struct {
+	// FundCommunityPoolFunc mocks the FundCommunityPool method.
+	FundCommunityPoolFunc func(ctx cosmossdktypes.Context, amount cosmossdktypes.Coins, sender cosmossdktypes.AccAddress) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// FundCommunityPool holds details about calls to the FundCommunityPool method.
+		FundCommunityPool []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Amount is the amount argument value.
+			Amount cosmossdktypes.Coins
+			// Sender is the sender argument value.
+			Sender cosmossdktypes.AccAddress
+		}
+	}
+	lockFundCommunityPool sync.RWMutex
+}
+
+// FundCommunityPool calls FundCommunityPoolFunc.
+func (mock *DistributorMock) FundCommunityPool(ctx cosmossdktypes.Context, amount cosmossdktypes.Coins, sender cosmossdktypes.AccAddress) error {
+	if mock.FundCommunityPoolFunc == nil {
+		panic("DistributorMock.FundCommunityPoolFunc: method is nil but Distributor.FundCommunityPool was just called")
+	}
+	callInfo := struct {
+		Ctx    cosmossdktypes.Context
+		Amount cosmossdktypes.Coins
+		Sender cosmossdktypes.AccAddress
+	}{
+		Ctx:    ctx,
+		Amount: amount,
+		Sender: sender,
+	}
+	mock.lockFundCommunityPool.Lock()
+	mock.calls.FundCommunityPool = append(mock.calls.FundCommunityPool, callInfo)
+	mock.lockFundCommunityPool.Unlock()
+	return mock.FundCommunityPoolFunc(ctx, amount, sender)
+}
+
+// FundCommunityPoolCalls gets all the calls that were made to FundCommunityPool.
+// Check the length with:
+//     len(mockedDistributor.FundCommunityPoolCalls())
+func (mock *DistributorMock) FundCommunityPoolCalls() []struct {
+	Ctx    cosmossdktypes.Context
+	Amount cosmossdktypes.Coins
+	Sender cosmossdktypes.AccAddress
+} {
+	var calls []struct {
+		Ctx    cosmossdktypes.Context
+		Amount cosmossdktypes.Coins
+		Sender cosmossdktypes.AccAddress
+	}
+	mock.lockFundCommunityPool.RLock()
+	calls = mock.calls.FundCommunityPool
+	mock.lockFundCommunityPool.RUnlock()
+	return calls
+}
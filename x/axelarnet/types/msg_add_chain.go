@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
@@ -56,3 +57,8 @@ func (m AddCosmosBasedChainRequest) GetSignBytes() []byte {
 func (m AddCosmosBasedChainRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// GetRequiredRole implements permission.RestrictedMsg
+func (m AddCosmosBasedChainRequest) GetRequiredRole() permission.Role {
+	return permission.ChainManagement
+}
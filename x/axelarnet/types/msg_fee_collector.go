@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 )
 
 // NewRegisterFeeCollectorRequest is the constructor for RegisterFeeCollector
@@ -46,3 +48,8 @@ func (m RegisterFeeCollectorRequest) GetSignBytes() []byte {
 func (m RegisterFeeCollectorRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// GetRequiredRole implements permission.RestrictedMsg
+func (m RegisterFeeCollectorRequest) GetRequiredRole() permission.Role {
+	return permission.ChainManagement
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 )
 
 // NewRegisterAssetRequest is the constructor for RegisterAssetRequest
@@ -52,3 +54,8 @@ func (m RegisterAssetRequest) GetSignBytes() []byte {
 func (m RegisterAssetRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// GetRequiredRole implements permission.RestrictedMsg
+func (m RegisterAssetRequest) GetRequiredRole() permission.Role {
+	return permission.ChainManagement
+}
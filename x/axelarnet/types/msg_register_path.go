@@ -5,6 +5,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	host "github.com/cosmos/ibc-go/modules/core/24-host"
+
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 )
 
 // NewRegisterIBCPathRequest creates a message of type RegisterIBCPathRequest
@@ -55,3 +57,8 @@ func (m RegisterIBCPathRequest) GetSignBytes() []byte {
 func (m RegisterIBCPathRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// GetRequiredRole implements permission.RestrictedMsg
+func (m RegisterIBCPathRequest) GetRequiredRole() permission.Role {
+	return permission.ChainManagement
+}
@@ -19,6 +19,9 @@ var (
 	KeyAssets             = []byte("assetInfo")
 	KeyRouteTimeoutWindow = []byte("routeTimeoutWindow")
 	KeyTransactionFeeRate = []byte("transactionFeeRate")
+
+	KeyFeeDistributionTarget   = []byte("feeDistributionTarget")
+	KeyFeeDistributionInterval = []byte("feeDistributionInterval")
 )
 
 // KeyTable retrieves a subspace table for the module
@@ -32,6 +35,9 @@ func DefaultParams() Params {
 		SupportedChains:    []string{"Bitcoin", "Ethereum"},
 		RouteTimeoutWindow: 100,
 		TransactionFeeRate: sdktypes.NewDecWithPrec(25, 5), // 0.025%
+
+		FeeDistributionTarget:   NoDistribution,
+		FeeDistributionInterval: 100,
 	}
 }
 
@@ -48,12 +54,26 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyAssets, &m.SupportedChains, validateSupportedChains),
 		params.NewParamSetPair(KeyRouteTimeoutWindow, &m.RouteTimeoutWindow, validateUint64("RouteTimeoutWindow")),
 		params.NewParamSetPair(KeyTransactionFeeRate, &m.TransactionFeeRate, validateTransactionFeeRate),
+		params.NewParamSetPair(KeyFeeDistributionTarget, &m.FeeDistributionTarget, validateFeeDistributionTarget),
+		params.NewParamSetPair(KeyFeeDistributionInterval, &m.FeeDistributionInterval, validateFeeDistributionInterval),
 	}
 }
 
 // Validate checks if the parameters are valid
 func (m Params) Validate() error {
-	return validateSupportedChains(m.SupportedChains)
+	if err := validateSupportedChains(m.SupportedChains); err != nil {
+		return err
+	}
+
+	if err := validateFeeDistributionTarget(m.FeeDistributionTarget); err != nil {
+		return err
+	}
+
+	if err := validateFeeDistributionInterval(m.FeeDistributionInterval); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func validateSupportedChains(infos interface{}) error {
@@ -98,3 +118,29 @@ func validateTransactionFeeRate(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeDistributionTarget(i interface{}) error {
+	v, ok := i.(FeeDistributionTarget)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := FeeDistributionTarget_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown fee distribution target %d", v)
+	}
+
+	return nil
+}
+
+func validateFeeDistributionInterval(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("fee distribution interval must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -30,6 +30,11 @@ type Params struct {
 	// IBC packet route timeout window
 	RouteTimeoutWindow uint64                                 `protobuf:"varint,2,opt,name=route_timeout_window,json=routeTimeoutWindow,proto3" json:"route_timeout_window,omitempty"`
 	TransactionFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=transaction_fee_rate,json=transactionFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"transaction_fee_rate"`
+	// destination of the collected bridge fees, fees are not distributed if
+	// unspecified
+	FeeDistributionTarget FeeDistributionTarget `protobuf:"varint,4,opt,name=fee_distribution_target,json=feeDistributionTarget,proto3,enum=axelarnet.v1beta1.FeeDistributionTarget" json:"fee_distribution_target,omitempty"`
+	// number of blocks between fee distributions
+	FeeDistributionInterval int64 `protobuf:"varint,5,opt,name=fee_distribution_interval,json=feeDistributionInterval,proto3" json:"fee_distribution_interval,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,26 +77,30 @@ func init() {
 func init() { proto.RegisterFile("axelarnet/v1beta1/params.proto", fileDescriptor_2ac91a59ad64a1d4) }
 
 var fileDescriptor_2ac91a59ad64a1d4 = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0xb3, 0x56, 0x0a, 0xcd, 0x45, 0x5d, 0x7a, 0x28, 0x1e, 0xb6, 0xc5, 0x83, 0xd4, 0x43,
-	0xb3, 0x16, 0xc1, 0x07, 0xa8, 0xe2, 0x59, 0x42, 0x41, 0xf0, 0x12, 0xb7, 0xdb, 0xb1, 0x0d, 0x35,
-	0x99, 0xb0, 0x3b, 0xb1, 0xf5, 0x2d, 0x7c, 0x2b, 0x7b, 0xec, 0x51, 0x3c, 0x14, 0x6d, 0x5e, 0x44,
-	0xdc, 0x84, 0xd8, 0xd3, 0xcc, 0xfc, 0xdf, 0xcc, 0x3f, 0xf0, 0xfb, 0x42, 0xad, 0xe0, 0x45, 0x99,
-	0x14, 0x48, 0xbe, 0x0e, 0x27, 0x40, 0x6a, 0x28, 0x33, 0x65, 0x54, 0x62, 0x83, 0xcc, 0x20, 0x21,
-	0x3f, 0xa9, 0x79, 0x50, 0xf1, 0xd3, 0xf6, 0x0c, 0x67, 0xe8, 0xa8, 0xfc, 0xeb, 0xca, 0xc5, 0xb3,
-	0x0f, 0xe6, 0x37, 0xef, 0xdd, 0x25, 0xbf, 0xf0, 0x8f, 0x6d, 0x9e, 0x65, 0x68, 0x08, 0xa6, 0x91,
-	0x9e, 0xab, 0x38, 0xb5, 0x1d, 0xd6, 0x6b, 0xf4, 0x5b, 0xe1, 0x51, 0xad, 0xdf, 0x38, 0x99, 0x5f,
-	0xfa, 0x6d, 0x83, 0x39, 0x41, 0x44, 0x71, 0x02, 0x98, 0x53, 0xb4, 0x8c, 0xd3, 0x29, 0x2e, 0x3b,
-	0x07, 0x3d, 0xd6, 0x3f, 0x0c, 0xb9, 0x63, 0xe3, 0x12, 0x3d, 0x38, 0xc2, 0x9f, 0xfc, 0x36, 0x19,
-	0x95, 0x5a, 0xa5, 0x29, 0xc6, 0x34, 0x7a, 0x06, 0x88, 0x8c, 0x22, 0xe8, 0x34, 0x7a, 0xac, 0xdf,
-	0x1a, 0x05, 0xeb, 0x6d, 0xd7, 0xfb, 0xda, 0x76, 0xcf, 0x67, 0x31, 0xcd, 0xf3, 0x49, 0xa0, 0x31,
-	0x91, 0x1a, 0x6d, 0x82, 0xb6, 0x2a, 0x03, 0x3b, 0x5d, 0x48, 0x7a, 0xcb, 0xc0, 0x06, 0xb7, 0xa0,
-	0x43, 0xbe, 0xe7, 0x75, 0x07, 0x10, 0x2a, 0x82, 0xd1, 0x78, 0xfd, 0x23, 0xbc, 0xf5, 0x4e, 0xb0,
-	0xcd, 0x4e, 0xb0, 0xef, 0x9d, 0x60, 0xef, 0x85, 0xf0, 0x36, 0x85, 0xf0, 0x3e, 0x0b, 0xe1, 0x3d,
-	0x5e, 0xef, 0x39, 0xd7, 0xd9, 0x2c, 0xd1, 0x2c, 0xaa, 0x69, 0xa0, 0xd1, 0x80, 0x5c, 0xfd, 0xb3,
-	0xf2, 0xdb, 0xa4, 0xe9, 0x62, 0xba, 0xfa, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x6b, 0x00, 0x65,
-	0x71, 0x01, 0x00, 0x00,
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4f, 0xcf, 0xd2, 0x40,
+	0x10, 0xc6, 0xbb, 0x2f, 0xaf, 0x24, 0xf4, 0xe0, 0x9f, 0x0d, 0x86, 0x4a, 0xe2, 0xd2, 0x78, 0x30,
+	0xf5, 0x40, 0x2b, 0x9a, 0x78, 0xf0, 0x88, 0x84, 0xc4, 0x9b, 0x69, 0x48, 0x4c, 0xbc, 0x94, 0x6d,
+	0x3b, 0x94, 0x0d, 0xb4, 0xdb, 0xec, 0x4e, 0x01, 0xbf, 0x85, 0x1f, 0x8b, 0x23, 0x47, 0xe3, 0x81,
+	0x28, 0xc4, 0xef, 0x61, 0x58, 0x1a, 0x24, 0xe2, 0x69, 0x77, 0x9f, 0xdf, 0xcc, 0x33, 0xb3, 0x33,
+	0x36, 0xe3, 0x1b, 0x58, 0x72, 0x55, 0x00, 0x06, 0xab, 0x41, 0x0c, 0xc8, 0x07, 0x41, 0xc9, 0x15,
+	0xcf, 0xb5, 0x5f, 0x2a, 0x89, 0x92, 0x3e, 0xb9, 0x70, 0xbf, 0xe6, 0xdd, 0x76, 0x26, 0x33, 0x69,
+	0x68, 0x70, 0xba, 0x9d, 0x03, 0xbb, 0xcf, 0x6f, 0x8d, 0xf0, 0x6b, 0x09, 0xb5, 0xcf, 0x8b, 0xdf,
+	0x77, 0x76, 0xf3, 0x93, 0x31, 0xa6, 0xaf, 0xec, 0xc7, 0xba, 0x2a, 0x4b, 0xa9, 0x10, 0xd2, 0x28,
+	0x99, 0x73, 0x51, 0x68, 0x87, 0xb8, 0x0d, 0xaf, 0x15, 0x3e, 0xba, 0xe8, 0x1f, 0x8c, 0x4c, 0x5f,
+	0xdb, 0x6d, 0x25, 0x2b, 0x84, 0x08, 0x45, 0x0e, 0xb2, 0xc2, 0x68, 0x2d, 0x8a, 0x54, 0xae, 0x9d,
+	0x3b, 0x97, 0x78, 0xf7, 0x21, 0x35, 0x6c, 0x72, 0x46, 0x9f, 0x0d, 0xa1, 0x53, 0xbb, 0x8d, 0x8a,
+	0x17, 0x9a, 0x27, 0x28, 0x64, 0x11, 0xcd, 0x00, 0x22, 0xc5, 0x11, 0x9c, 0x86, 0x4b, 0xbc, 0xd6,
+	0xd0, 0xdf, 0xee, 0x7b, 0xd6, 0x8f, 0x7d, 0xef, 0x65, 0x26, 0x70, 0x5e, 0xc5, 0x7e, 0x22, 0xf3,
+	0x20, 0x91, 0x3a, 0x97, 0xba, 0x3e, 0xfa, 0x3a, 0x5d, 0xd4, 0x7d, 0x8f, 0x20, 0x09, 0xe9, 0x95,
+	0xd7, 0x18, 0x20, 0xe4, 0x08, 0x74, 0x6a, 0x77, 0x4e, 0xae, 0xa9, 0xd0, 0xa8, 0x44, 0x5c, 0x99,
+	0x32, 0xc8, 0x55, 0x06, 0xe8, 0xdc, 0xbb, 0xc4, 0x7b, 0xf8, 0xc6, 0xf3, 0x6f, 0x66, 0xe6, 0x8f,
+	0x01, 0x46, 0x57, 0x09, 0x13, 0x13, 0x1f, 0x3e, 0x9d, 0xfd, 0x4f, 0xa6, 0xef, 0xed, 0x67, 0x37,
+	0x15, 0x44, 0x81, 0xa0, 0x56, 0x7c, 0xe9, 0x3c, 0x70, 0x89, 0xd7, 0x08, 0x3b, 0xff, 0x64, 0x7e,
+	0xac, 0xf1, 0x70, 0xb2, 0xfd, 0xc5, 0xac, 0xed, 0x81, 0x91, 0xdd, 0x81, 0x91, 0x9f, 0x07, 0x46,
+	0xbe, 0x1d, 0x99, 0xb5, 0x3b, 0x32, 0xeb, 0xfb, 0x91, 0x59, 0x5f, 0xde, 0x5d, 0xfd, 0xfb, 0xd2,
+	0xe4, 0x5a, 0xaa, 0x45, 0xfd, 0xea, 0x27, 0x52, 0x41, 0xb0, 0xf9, 0xcb, 0xce, 0xb3, 0x88, 0x9b,
+	0x66, 0x89, 0x6f, 0xff, 0x0c, 0x00, 0x3e, 0x27, 0x81, 0x0d, 0x2e, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +123,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeDistributionInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeDistributionInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FeeDistributionTarget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeDistributionTarget))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.TransactionFeeRate.Size()
 		i -= size
@@ -169,6 +188,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.TransactionFeeRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.FeeDistributionTarget != 0 {
+		n += 1 + sovParams(uint64(m.FeeDistributionTarget))
+	}
+	if m.FeeDistributionInterval != 0 {
+		n += 1 + sovParams(uint64(m.FeeDistributionInterval))
+	}
 	return n
 }
 
@@ -292,6 +317,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistributionTarget", wireType)
+			}
+			m.FeeDistributionTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeDistributionTarget |= FeeDistributionTarget(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistributionInterval", wireType)
+			}
+			m.FeeDistributionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeDistributionInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+// proposal types
+const (
+	ProposalTypeAddCosmosChain = "AddCosmosChain"
+)
+
+var _ govtypes.Content = &AddCosmosChainProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddCosmosChain)
+	govtypes.RegisterProposalTypeCodec(&AddCosmosChainProposal{}, "axelarnet/AddCosmosChainProposal")
+}
+
+// NewAddCosmosChainProposal is the constructor for AddCosmosChainProposal
+func NewAddCosmosChainProposal(title, description, name, nativeAsset string) *AddCosmosChainProposal {
+	return &AddCosmosChainProposal{
+		Title:       title,
+		Description: description,
+		Chain: nexus.Chain{
+			Name:                  name,
+			NativeAsset:           nativeAsset,
+			SupportsForeignAssets: true,
+			KeyType:               tss.None,
+		},
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (m AddCosmosChainProposal) GetTitle() string { return m.Title }
+
+// GetDescription implements govtypes.Content
+func (m AddCosmosChainProposal) GetDescription() string { return m.Description }
+
+// ProposalRoute implements govtypes.Content
+func (m AddCosmosChainProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (m AddCosmosChainProposal) ProposalType() string { return ProposalTypeAddCosmosChain }
+
+// ValidateBasic implements govtypes.Content
+func (m AddCosmosChainProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	return m.ToRequest().ValidateBasic()
+}
+
+// String implements govtypes.Content
+func (m AddCosmosChainProposal) String() string {
+	return fmt.Sprintf(`Add Cosmos Chain Proposal:
+  Title:        %s
+  Description:  %s
+  Name:         %s
+  Native Asset: %s
+`, m.Title, m.Description, m.Chain.Name, m.Chain.NativeAsset)
+}
+
+// ToRequest returns the AddCosmosBasedChainRequest that is executed on behalf of the gov module when the proposal passes
+func (m AddCosmosChainProposal) ToRequest() *AddCosmosBasedChainRequest {
+	return &AddCosmosBasedChainRequest{
+		Sender: authtypes.NewModuleAddress(govtypes.ModuleName),
+		Chain:  m.Chain,
+	}
+}
@@ -35,46 +35,57 @@ func init() {
 }
 
 var fileDescriptor_8c81cd7f69d43e55 = []byte{
-	// 614 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xc7, 0x3b, 0x22, 0x82, 0x23, 0x2a, 0x5d, 0x0b, 0x42, 0x90, 0xad, 0x6e, 0xad, 0xc6, 0xb4,
-	0xb3, 0xdb, 0x17, 0xe8, 0xa1, 0xb7, 0x26, 0x2a, 0x14, 0x2c, 0x94, 0xd8, 0x93, 0x17, 0x99, 0xec,
-	0x3e, 0xd9, 0x0c, 0x4d, 0x66, 0xd6, 0x99, 0x49, 0x8d, 0xa8, 0x17, 0xbd, 0x0a, 0x0a, 0xbd, 0x78,
-	0xf4, 0x2a, 0xf8, 0x01, 0x3c, 0x7a, 0xf4, 0x58, 0xf0, 0xe2, 0x51, 0x1a, 0x3f, 0x88, 0xcc, 0xee,
-	0x4e, 0xdf, 0xb2, 0x4d, 0xd6, 0x5b, 0xc2, 0xf3, 0xfb, 0xe7, 0xff, 0xcb, 0xec, 0x33, 0x8b, 0x67,
-	0xe9, 0x00, 0xba, 0x54, 0x72, 0xd0, 0xc1, 0xde, 0x72, 0x0b, 0x34, 0x5d, 0x0e, 0x14, 0xc8, 0x3d,
-	0x16, 0x82, 0x9f, 0x48, 0xa1, 0x85, 0x33, 0x7d, 0x04, 0xf8, 0x39, 0x50, 0x99, 0x89, 0x45, 0x2c,
-	0xd2, 0x69, 0x60, 0x3e, 0x65, 0x60, 0xe5, 0x56, 0x2c, 0x44, 0xdc, 0x85, 0x80, 0x26, 0x2c, 0xa0,
-	0x9c, 0x0b, 0x4d, 0x35, 0x13, 0x5c, 0xe5, 0xd3, 0xca, 0x68, 0x8f, 0x1e, 0x64, 0xb3, 0x95, 0x0f,
-	0x57, 0x30, 0xde, 0x52, 0xf1, 0xd3, 0xac, 0xd7, 0x79, 0x83, 0x2f, 0x3e, 0x61, 0x7c, 0xd7, 0x71,
-	0xfd, 0x91, 0x6a, 0xdf, 0x0c, 0x9a, 0xf0, 0xa2, 0x0f, 0x4a, 0x57, 0x66, 0xcf, 0x9d, 0xab, 0x44,
-	0x70, 0x05, 0xde, 0xea, 0xbb, 0x5f, 0x7f, 0xf7, 0x2f, 0x10, 0xaf, 0x1a, 0x64, 0x60, 0x70, 0xec,
-	0xd0, 0x65, 0x7c, 0x37, 0x78, 0x2d, 0x21, 0x64, 0x09, 0x03, 0xae, 0x9f, 0x87, 0x1d, 0xca, 0xf8,
-	0xdb, 0x75, 0x54, 0x73, 0xf6, 0x11, 0xbe, 0xd6, 0x10, 0xbc, 0xcd, 0x64, 0xef, 0x21, 0x24, 0x42,
-	0x31, 0xed, 0x54, 0x0b, 0x8a, 0x4e, 0x23, 0x56, 0xe9, 0x41, 0x09, 0x32, 0x97, 0x5b, 0x4c, 0xe5,
-	0xee, 0x79, 0x77, 0x46, 0xe5, 0xc2, 0x2c, 0x41, 0xa2, 0x2c, 0x62, 0xac, 0xbe, 0x23, 0x7c, 0xf3,
-	0xd1, 0x00, 0xc2, 0xbe, 0x86, 0x6d, 0xe0, 0x11, 0xe3, 0xf1, 0x8e, 0xa4, 0x5c, 0xb5, 0x41, 0x2a,
-	0x67, 0xb9, 0xa0, 0xf4, 0x1c, 0xd6, 0x7a, 0xae, 0xfc, 0x4f, 0x24, 0x17, 0x5e, 0x4b, 0x85, 0x97,
-	0xbc, 0x85, 0x51, 0x61, 0xc8, 0xa2, 0x24, 0xc9, 0xb2, 0x44, 0xdb, 0xb0, 0x51, 0xff, 0x8c, 0xf0,
-	0xf5, 0x26, 0xc4, 0x4c, 0x69, 0x90, 0x9b, 0xf5, 0xc6, 0x36, 0xd5, 0x1d, 0xa7, 0xe8, 0x9c, 0xce,
-	0x30, 0x56, 0xb5, 0x56, 0x06, 0xcd, 0x15, 0xfd, 0x54, 0xb1, 0xea, 0xcd, 0x8d, 0x2a, 0xca, 0x3c,
-	0x42, 0x58, 0x2b, 0x24, 0x09, 0xd5, 0x1d, 0xa3, 0xf6, 0x15, 0xe1, 0x1b, 0x1b, 0x51, 0xd4, 0x10,
-	0xaa, 0x27, 0x54, 0x9d, 0x2a, 0x88, 0x1a, 0x66, 0x0f, 0x1c, 0x52, 0xd0, 0x59, 0xc0, 0x59, 0x45,
-	0xbf, 0x2c, 0x3e, 0x79, 0x2f, 0x69, 0x14, 0x91, 0x30, 0xcd, 0x91, 0x96, 0x09, 0x92, 0x74, 0x31,
-	0x8d, 0xeb, 0x47, 0x84, 0xaf, 0xda, 0xff, 0xbd, 0xa1, 0x14, 0x68, 0xe7, 0xfe, 0x98, 0x93, 0x49,
-	0x09, 0xeb, 0x57, 0x9d, 0x0c, 0xe6, 0x66, 0x0b, 0xa9, 0xd9, 0xbc, 0x77, 0x7b, 0xcc, 0x01, 0x52,
-	0x93, 0x30, 0x46, 0xef, 0x11, 0xbe, 0xdc, 0x84, 0x76, 0x9f, 0x47, 0x5b, 0x2a, 0x76, 0xe6, 0x0a,
-	0x4b, 0xf2, 0xa9, 0x35, 0xb9, 0x3b, 0x1e, 0x2a, 0x63, 0x61, 0x60, 0xd2, 0x03, 0xa5, 0x68, 0x0c,
-	0xc6, 0xe2, 0x0b, 0xc2, 0xd3, 0x4d, 0xd1, 0xd7, 0xb0, 0x59, 0x6f, 0x1c, 0xdf, 0x89, 0x85, 0xa2,
-	0xa2, 0xb3, 0x94, 0xb5, 0x5a, 0x2c, 0x07, 0xe7, 0x76, 0x4b, 0xa9, 0x5d, 0xcd, 0x9b, 0x2f, 0xb0,
-	0x33, 0xa1, 0x74, 0xc3, 0x4e, 0xdd, 0x80, 0x6f, 0x08, 0xcf, 0xd8, 0xf3, 0x7e, 0x0c, 0xd0, 0x10,
-	0xdd, 0x2e, 0x84, 0x5a, 0x48, 0xc7, 0x1f, 0xf3, 0x60, 0x4e, 0x82, 0x56, 0x34, 0x28, 0xcd, 0x4f,
-	0xde, 0xb4, 0xa3, 0xe7, 0xd9, 0x06, 0x20, 0xa1, 0x4d, 0xae, 0xa3, 0x5a, 0x7d, 0xe7, 0xe7, 0xa1,
-	0x8b, 0x0e, 0x0e, 0x5d, 0xf4, 0xe7, 0xd0, 0x45, 0x9f, 0x86, 0xee, 0xd4, 0x8f, 0xa1, 0x8b, 0x0e,
-	0x86, 0xee, 0xd4, 0xef, 0xa1, 0x3b, 0xf5, 0x6c, 0x2d, 0x66, 0xba, 0xd3, 0x6f, 0xf9, 0xa1, 0xe8,
-	0x1d, 0xff, 0xda, 0x4b, 0x21, 0x77, 0xf3, 0x6f, 0x24, 0x14, 0x12, 0x82, 0xc1, 0x89, 0x26, 0xfd,
-	0x2a, 0x01, 0xd5, 0xba, 0x94, 0xbe, 0xeb, 0x57, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xce, 0xcd,
-	0x77, 0xd4, 0x71, 0x06, 0x00, 0x00,
+	// 790 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0xc0, 0x3b, 0x08, 0x8a, 0x18, 0x0a, 0xa8, 0x43, 0x25, 0x50, 0x04, 0x6e, 0xeb, 0x7e, 0x85,
+	0xb4, 0xb6, 0xfb, 0x21, 0xf5, 0xd0, 0x5b, 0x13, 0x40, 0xaa, 0x44, 0xa5, 0x12, 0x7a, 0xe2, 0x82,
+	0x26, 0xf6, 0x8b, 0x63, 0x35, 0xf1, 0xb8, 0x9e, 0x49, 0x49, 0x54, 0xb8, 0x80, 0xc4, 0x09, 0x09,
+	0x44, 0x2f, 0x48, 0x5c, 0xb8, 0x22, 0xf1, 0x07, 0x70, 0xe4, 0xb8, 0xc7, 0x4a, 0x7b, 0x59, 0xed,
+	0x69, 0xd5, 0xec, 0x1f, 0xb2, 0x9a, 0x19, 0x4f, 0x9b, 0xd4, 0xce, 0xc7, 0x9e, 0xe2, 0xe8, 0xfd,
+	0x9e, 0xdf, 0x2f, 0xcf, 0x6f, 0x9e, 0x83, 0x97, 0x69, 0x0f, 0xda, 0x34, 0x8d, 0x41, 0x78, 0x57,
+	0x7b, 0x0d, 0x10, 0x74, 0xcf, 0xe3, 0x90, 0x5e, 0x45, 0x3e, 0xb8, 0x49, 0xca, 0x04, 0x23, 0x8b,
+	0xf7, 0x80, 0x9b, 0x01, 0xa5, 0xa5, 0x90, 0x85, 0x4c, 0x45, 0x3d, 0x79, 0xa5, 0xc1, 0xd2, 0x27,
+	0x21, 0x63, 0x61, 0x1b, 0x3c, 0x9a, 0x44, 0x1e, 0x8d, 0x63, 0x26, 0xa8, 0x88, 0x58, 0xcc, 0xb3,
+	0x68, 0x29, 0x5f, 0x47, 0xf4, 0xb2, 0xd8, 0xa7, 0xf9, 0xd8, 0x65, 0x17, 0xd2, 0xbe, 0x0e, 0xef,
+	0xff, 0xfa, 0x2e, 0xc6, 0xa7, 0x3c, 0xfc, 0x46, 0x6b, 0x91, 0x1f, 0xf0, 0x9b, 0x5f, 0x45, 0xf1,
+	0x05, 0xb1, 0xdc, 0x9c, 0x99, 0x2b, 0x03, 0x75, 0xb8, 0xec, 0x02, 0x17, 0xa5, 0xe5, 0xb1, 0x71,
+	0x9e, 0xb0, 0x98, 0x83, 0x7d, 0xf0, 0xd3, 0xd3, 0x97, 0x37, 0x6f, 0x38, 0x76, 0xd9, 0xd3, 0xa0,
+	0xf7, 0xa0, 0xd1, 0x8e, 0xe2, 0x0b, 0xef, 0x3a, 0x05, 0x3f, 0x4a, 0x22, 0x88, 0xc5, 0x77, 0x7e,
+	0x8b, 0x46, 0xf1, 0x8f, 0x47, 0xa8, 0x42, 0x6e, 0x10, 0x7e, 0xbf, 0xc6, 0xe2, 0x66, 0x94, 0x76,
+	0x3e, 0x87, 0x84, 0xf1, 0x48, 0x90, 0x72, 0x41, 0xa1, 0x51, 0xc4, 0x28, 0x7d, 0x36, 0x03, 0x99,
+	0xc9, 0xed, 0x28, 0xb9, 0x4d, 0x7b, 0x35, 0x2f, 0xe7, 0xeb, 0x0c, 0x27, 0xd0, 0x29, 0xd2, 0xea,
+	0x3f, 0x84, 0x3f, 0xfa, 0xa2, 0x07, 0x7e, 0x57, 0xc0, 0x19, 0xc4, 0x41, 0x14, 0x87, 0xe7, 0x29,
+	0x8d, 0x79, 0x13, 0x52, 0x4e, 0xf6, 0x0a, 0x8a, 0x8e, 0x61, 0x8d, 0xe7, 0xfe, 0xeb, 0xa4, 0x64,
+	0xc2, 0x87, 0x4a, 0x78, 0xd7, 0xde, 0xce, 0x0b, 0x83, 0x4e, 0x75, 0x12, 0x9d, 0xeb, 0x08, 0x93,
+	0x2c, 0xd5, 0xff, 0x44, 0xf8, 0x83, 0x3a, 0x84, 0x11, 0x17, 0x90, 0x9e, 0x54, 0x6b, 0x67, 0x54,
+	0xb4, 0x48, 0x51, 0x9f, 0x1e, 0x31, 0x46, 0xb5, 0x32, 0x0b, 0x9a, 0x29, 0xba, 0x4a, 0xb1, 0x6c,
+	0xaf, 0xe5, 0x15, 0xd3, 0x2c, 0xc5, 0x89, 0x1a, 0xbe, 0x93, 0x50, 0xd1, 0x92, 0x6a, 0xff, 0x20,
+	0xfc, 0xe1, 0x71, 0x10, 0xd4, 0x18, 0xef, 0x30, 0x5e, 0xa5, 0x1c, 0x82, 0x9a, 0x9c, 0x03, 0xe2,
+	0x14, 0xd4, 0x2c, 0xe0, 0x8c, 0xa2, 0x3b, 0x2b, 0x3e, 0x7d, 0x2e, 0x69, 0x10, 0x38, 0xbe, 0xca,
+	0x73, 0x1a, 0x32, 0xd1, 0x51, 0x83, 0x29, 0x5d, 0x7f, 0x43, 0xf8, 0x3d, 0xf3, 0xbb, 0x8f, 0x39,
+	0x07, 0x41, 0xb6, 0x26, 0x74, 0x46, 0x11, 0xc6, 0xaf, 0x3c, 0x1d, 0xcc, 0xcc, 0xb6, 0x95, 0xd9,
+	0x86, 0xbd, 0x32, 0xa1, 0x81, 0x54, 0x66, 0x48, 0xa3, 0x9f, 0x11, 0x7e, 0xa7, 0x0e, 0xcd, 0x6e,
+	0x1c, 0x9c, 0xf2, 0x90, 0xac, 0x15, 0x16, 0xc9, 0xa2, 0xc6, 0x64, 0x7d, 0x32, 0x34, 0x8b, 0x85,
+	0x84, 0x9d, 0x0e, 0x70, 0x4e, 0x43, 0x90, 0x16, 0x7f, 0x23, 0xbc, 0x58, 0x67, 0x5d, 0x01, 0x27,
+	0xd5, 0xda, 0xc3, 0x99, 0xd8, 0x2e, 0x2a, 0xf4, 0x98, 0x32, 0x56, 0x3b, 0xb3, 0xc1, 0x99, 0xdd,
+	0xae, 0xb2, 0xab, 0xd8, 0x1b, 0x05, 0x76, 0x32, 0x49, 0x4d, 0xd8, 0xc8, 0x09, 0xf8, 0x17, 0xe1,
+	0x25, 0xd3, 0xef, 0x2f, 0x01, 0x6a, 0xac, 0xdd, 0x06, 0x5f, 0xb0, 0x94, 0xb8, 0x13, 0x1e, 0xcc,
+	0x30, 0x68, 0x44, 0xbd, 0x99, 0xf9, 0xe9, 0x93, 0x76, 0xff, 0x3c, 0x9b, 0x00, 0x8e, 0x6f, 0x32,
+	0x8f, 0x50, 0x65, 0xff, 0xf9, 0x5b, 0x78, 0xe1, 0x6b, 0xb9, 0x9e, 0xcd, 0x42, 0xee, 0xe3, 0xf9,
+	0x33, 0x9a, 0xd2, 0x0e, 0x27, 0x1b, 0x05, 0x02, 0x0a, 0xd5, 0x71, 0xe3, 0xb9, 0x39, 0x0d, 0xcb,
+	0xf4, 0x56, 0x94, 0x5e, 0x89, 0x7c, 0x9c, 0xd7, 0x4b, 0x74, 0xc1, 0x5f, 0x10, 0x7e, 0xdb, 0x2c,
+	0x8d, 0xb1, 0x77, 0x7d, 0xb4, 0x31, 0xb6, 0xa6, 0x72, 0x59, 0xf9, 0x8a, 0x2a, 0xbf, 0x4e, 0xec,
+	0x7c, 0x79, 0xb3, 0x25, 0xbc, 0x6b, 0xfd, 0x66, 0x20, 0x7f, 0x20, 0xbc, 0xa0, 0x0f, 0xb4, 0x3a,
+	0xcb, 0xc5, 0x13, 0xa6, 0xaa, 0x0c, 0x53, 0x93, 0x26, 0xac, 0x00, 0xce, 0xbc, 0xb6, 0x94, 0xd7,
+	0x2a, 0x59, 0x2e, 0x7a, 0x35, 0xa8, 0xdd, 0xe0, 0x6b, 0x87, 0xbf, 0xa4, 0x94, 0xbc, 0xac, 0xf6,
+	0xf5, 0x4a, 0x18, 0x2f, 0x35, 0x44, 0x4d, 0x97, 0x1a, 0x81, 0x47, 0xc7, 0x9e, 0x14, 0x8c, 0x92,
+	0xb2, 0x71, 0x1a, 0x7d, 0xbd, 0x1a, 0xbc, 0x6b, 0xf5, 0xa1, 0x5b, 0x36, 0x32, 0xee, 0x63, 0xed,
+	0x8a, 0x66, 0x7d, 0x67, 0x36, 0x78, 0x7a, 0xcb, 0x46, 0xe6, 0xbb, 0x7a, 0xfe, 0xe4, 0xce, 0x42,
+	0xb7, 0x77, 0x16, 0x7a, 0x71, 0x67, 0xa1, 0xdf, 0x07, 0xd6, 0xdc, 0xff, 0x03, 0x0b, 0xdd, 0x0e,
+	0xac, 0xb9, 0x67, 0x03, 0x6b, 0xee, 0xdb, 0xc3, 0x30, 0x12, 0xad, 0x6e, 0xc3, 0xf5, 0x59, 0xe7,
+	0xe1, 0x0e, 0xdf, 0xb3, 0xf4, 0x22, 0xfb, 0xe6, 0xf8, 0x2c, 0x05, 0xaf, 0x37, 0x74, 0x77, 0xd1,
+	0x4f, 0x80, 0x37, 0xe6, 0xd5, 0x1f, 0x99, 0x83, 0x57, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0x64,
+	0x30, 0xbc, 0x6d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -444,3 +455,219 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelarnet/v1beta1/service.proto",
 }
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	IBCPath(ctx context.Context, in *QueryIBCPathRequest, opts ...grpc.CallOption) (*QueryIBCPathResponse, error)
+	CosmosChains(ctx context.Context, in *QueryCosmosChainsRequest, opts ...grpc.CallOption) (*QueryCosmosChainsResponse, error)
+	ChainByAsset(ctx context.Context, in *QueryChainByAssetRequest, opts ...grpc.CallOption) (*QueryChainByAssetResponse, error)
+	FeeCollector(ctx context.Context, in *QueryFeeCollectorRequest, opts ...grpc.CallOption) (*QueryFeeCollectorResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/axelarnet.v1beta1.QueryService/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) IBCPath(ctx context.Context, in *QueryIBCPathRequest, opts ...grpc.CallOption) (*QueryIBCPathResponse, error) {
+	out := new(QueryIBCPathResponse)
+	err := c.cc.Invoke(ctx, "/axelarnet.v1beta1.QueryService/IBCPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) CosmosChains(ctx context.Context, in *QueryCosmosChainsRequest, opts ...grpc.CallOption) (*QueryCosmosChainsResponse, error) {
+	out := new(QueryCosmosChainsResponse)
+	err := c.cc.Invoke(ctx, "/axelarnet.v1beta1.QueryService/CosmosChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ChainByAsset(ctx context.Context, in *QueryChainByAssetRequest, opts ...grpc.CallOption) (*QueryChainByAssetResponse, error) {
+	out := new(QueryChainByAssetResponse)
+	err := c.cc.Invoke(ctx, "/axelarnet.v1beta1.QueryService/ChainByAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) FeeCollector(ctx context.Context, in *QueryFeeCollectorRequest, opts ...grpc.CallOption) (*QueryFeeCollectorResponse, error) {
+	out := new(QueryFeeCollectorResponse)
+	err := c.cc.Invoke(ctx, "/axelarnet.v1beta1.QueryService/FeeCollector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	IBCPath(context.Context, *QueryIBCPathRequest) (*QueryIBCPathResponse, error)
+	CosmosChains(context.Context, *QueryCosmosChainsRequest) (*QueryCosmosChainsResponse, error)
+	ChainByAsset(context.Context, *QueryChainByAssetRequest) (*QueryChainByAssetResponse, error)
+	FeeCollector(context.Context, *QueryFeeCollectorRequest) (*QueryFeeCollectorResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServiceServer) IBCPath(ctx context.Context, req *QueryIBCPathRequest) (*QueryIBCPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCPath not implemented")
+}
+func (*UnimplementedQueryServiceServer) CosmosChains(ctx context.Context, req *QueryCosmosChainsRequest) (*QueryCosmosChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CosmosChains not implemented")
+}
+func (*UnimplementedQueryServiceServer) ChainByAsset(ctx context.Context, req *QueryChainByAssetRequest) (*QueryChainByAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainByAsset not implemented")
+}
+func (*UnimplementedQueryServiceServer) FeeCollector(ctx context.Context, req *QueryFeeCollectorRequest) (*QueryFeeCollectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeCollector not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarnet.v1beta1.QueryService/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_IBCPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IBCPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarnet.v1beta1.QueryService/IBCPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IBCPath(ctx, req.(*QueryIBCPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_CosmosChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCosmosChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).CosmosChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarnet.v1beta1.QueryService/CosmosChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).CosmosChains(ctx, req.(*QueryCosmosChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ChainByAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainByAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ChainByAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarnet.v1beta1.QueryService/ChainByAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ChainByAsset(ctx, req.(*QueryChainByAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_FeeCollector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeCollectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).FeeCollector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelarnet.v1beta1.QueryService/FeeCollector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).FeeCollector(ctx, req.(*QueryFeeCollectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "axelarnet.v1beta1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _QueryService_Params_Handler,
+		},
+		{
+			MethodName: "IBCPath",
+			Handler:    _QueryService_IBCPath_Handler,
+		},
+		{
+			MethodName: "CosmosChains",
+			Handler:    _QueryService_CosmosChains_Handler,
+		},
+		{
+			MethodName: "ChainByAsset",
+			Handler:    _QueryService_ChainByAsset_Handler,
+		},
+		{
+			MethodName: "FeeCollector",
+			Handler:    _QueryService_FeeCollector_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "axelarnet/v1beta1/service.proto",
+}
@@ -25,6 +25,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FeeDistributionTarget determines where collected bridge fees are paid out to
+type FeeDistributionTarget int32
+
+const (
+	NoDistribution FeeDistributionTarget = 0
+	CommunityPool  FeeDistributionTarget = 1
+	// fees are added to the rewards distributed to validators and delegators
+	ValidatorRewards FeeDistributionTarget = 2
+)
+
+var FeeDistributionTarget_name = map[int32]string{
+	0: "FEE_DISTRIBUTION_TARGET_UNSPECIFIED",
+	1: "FEE_DISTRIBUTION_TARGET_COMMUNITY_POOL",
+	2: "FEE_DISTRIBUTION_TARGET_VALIDATOR_REWARDS",
+}
+
+var FeeDistributionTarget_value = map[string]int32{
+	"FEE_DISTRIBUTION_TARGET_UNSPECIFIED":       0,
+	"FEE_DISTRIBUTION_TARGET_COMMUNITY_POOL":    1,
+	"FEE_DISTRIBUTION_TARGET_VALIDATOR_REWARDS": 2,
+}
+
+func (x FeeDistributionTarget) String() string {
+	return proto.EnumName(FeeDistributionTarget_name, int32(x))
+}
+
+func (FeeDistributionTarget) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60cef2e8b09d640f, []int{0}
+}
+
 type IBCTransfer struct {
 	Sender   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 	Receiver string                                        `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
@@ -65,31 +95,42 @@ func (m *IBCTransfer) XXX_DiscardUnknown() {
 var xxx_messageInfo_IBCTransfer proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("axelarnet.v1beta1.FeeDistributionTarget", FeeDistributionTarget_name, FeeDistributionTarget_value)
 	proto.RegisterType((*IBCTransfer)(nil), "axelarnet.v1beta1.IBCTransfer")
 }
 
 func init() { proto.RegisterFile("axelarnet/v1beta1/types.proto", fileDescriptor_60cef2e8b09d640f) }
 
 var fileDescriptor_60cef2e8b09d640f = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xe3, 0x7b, 0xa1, 0x82, 0x94, 0x85, 0x88, 0xa1, 0x54, 0xc2, 0xad, 0x98, 0xb2, 0xc4,
-	0x56, 0x40, 0xb0, 0x37, 0x9d, 0xba, 0x46, 0x9d, 0xd8, 0x12, 0xe7, 0x10, 0xa2, 0x50, 0x9f, 0xca,
-	0x36, 0xa5, 0xbc, 0x05, 0xef, 0xc1, 0x8b, 0x64, 0xec, 0xc8, 0x54, 0x41, 0xf2, 0x16, 0x4c, 0xa8,
-	0x75, 0x08, 0x4c, 0xf6, 0xd1, 0x77, 0xf4, 0xeb, 0x3b, 0xbf, 0x7b, 0x91, 0xac, 0xe1, 0x31, 0x51,
-	0x12, 0x0c, 0x5f, 0x85, 0x29, 0x98, 0x24, 0xe4, 0xe6, 0x65, 0x09, 0x9a, 0x2d, 0x15, 0x1a, 0xf4,
-	0x4e, 0x3b, 0xcc, 0x5a, 0x3c, 0x3c, 0xcb, 0x31, 0xc7, 0x3d, 0xe5, 0xbb, 0x9f, 0x5d, 0x1c, 0x52,
-	0x81, 0x7a, 0x81, 0x9a, 0xa7, 0x89, 0x86, 0x2e, 0x49, 0x60, 0x21, 0x2d, 0xbf, 0x7c, 0x23, 0x6e,
-	0x7f, 0x16, 0x4d, 0xe7, 0x2a, 0x91, 0xfa, 0x1e, 0x94, 0x37, 0x73, 0x7b, 0x1a, 0x64, 0x06, 0x6a,
-	0x40, 0xc6, 0xc4, 0x3f, 0x89, 0xc2, 0xaf, 0xed, 0x28, 0xc8, 0x0b, 0xf3, 0xf0, 0x94, 0x32, 0x81,
-	0x0b, 0xde, 0xc6, 0xd9, 0x27, 0xd0, 0x59, 0xd9, 0x6a, 0x4d, 0x84, 0x98, 0x64, 0x99, 0x02, 0xad,
-	0xe3, 0x36, 0xc0, 0x1b, 0xba, 0x47, 0x0a, 0x04, 0x14, 0x2b, 0x50, 0x83, 0x7f, 0x63, 0xe2, 0x1f,
-	0xc7, 0xdd, 0xec, 0xdd, 0xb8, 0x87, 0x06, 0x4b, 0x90, 0x83, 0xff, 0x63, 0xe2, 0xf7, 0xaf, 0xce,
-	0x99, 0x0d, 0x64, 0x3b, 0xcd, 0x9f, 0x8b, 0xd8, 0x14, 0x0b, 0x19, 0x1d, 0x54, 0xdb, 0x91, 0x13,
-	0xdb, 0xed, 0x68, 0x5e, 0x7d, 0x52, 0xa7, 0xaa, 0x29, 0xd9, 0xd4, 0x94, 0x7c, 0xd4, 0x94, 0xbc,
-	0x36, 0xd4, 0xd9, 0x34, 0xd4, 0x79, 0x6f, 0xa8, 0x73, 0x77, 0xfb, 0xc7, 0xb3, 0xeb, 0xe7, 0x19,
-	0x55, 0xd9, 0x4e, 0x81, 0x40, 0x05, 0x7c, 0xfd, 0xcb, 0xac, 0x7b, 0xda, 0xdb, 0x57, 0x71, 0xfd,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x51, 0x03, 0x2a, 0x74, 0x01, 0x00, 0x00,
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xbd, 0xa5, 0x54, 0xe0, 0x02, 0x4a, 0xad, 0x22, 0x05, 0x4b, 0xb8, 0x16, 0x48, 0x28,
+	0x20, 0xc5, 0x56, 0x40, 0x70, 0x41, 0x1c, 0xfc, 0x15, 0x64, 0xa9, 0x8d, 0x23, 0xc7, 0x29, 0x82,
+	0x8b, 0xe5, 0x8f, 0x21, 0xac, 0x92, 0x78, 0xaa, 0xdd, 0x4d, 0x3f, 0xde, 0x00, 0xe5, 0xc4, 0x0b,
+	0xe4, 0xc4, 0x91, 0x17, 0xc9, 0xb1, 0x47, 0x4e, 0x11, 0x24, 0x6f, 0xc1, 0x09, 0xb5, 0x36, 0xa1,
+	0x97, 0x9c, 0x76, 0x47, 0xbf, 0x99, 0x9f, 0x66, 0xf5, 0x5f, 0xf9, 0x71, 0x72, 0x0e, 0xa3, 0x84,
+	0x15, 0x20, 0xcc, 0xd3, 0x56, 0x0a, 0x22, 0x69, 0x99, 0xe2, 0xe2, 0x04, 0xb8, 0x71, 0xc2, 0x50,
+	0xa0, 0xb2, 0xb7, 0xc6, 0x46, 0x85, 0xd5, 0xfd, 0x01, 0x0e, 0xf0, 0x9a, 0x9a, 0x57, 0xb7, 0xb2,
+	0x51, 0xd5, 0x32, 0xe4, 0x63, 0xe4, 0x66, 0x9a, 0x70, 0x58, 0x9b, 0x32, 0xa4, 0x45, 0xc9, 0x9f,
+	0xfc, 0x20, 0xf2, 0xae, 0x6f, 0x3b, 0x11, 0x4b, 0x0a, 0xfe, 0x19, 0x98, 0xe2, 0xcb, 0x3b, 0x1c,
+	0x8a, 0x1c, 0x58, 0x9d, 0xe8, 0xa4, 0x71, 0xcf, 0x6e, 0xfd, 0x59, 0x1c, 0x34, 0x07, 0x54, 0x7c,
+	0x99, 0xa4, 0x46, 0x86, 0x63, 0xb3, 0xd2, 0x95, 0x47, 0x93, 0xe7, 0xc3, 0x6a, 0x2d, 0x2b, 0xcb,
+	0xac, 0x3c, 0x67, 0xc0, 0x79, 0x58, 0x09, 0x14, 0x55, 0xbe, 0xc3, 0x20, 0x03, 0x7a, 0x0a, 0xac,
+	0xbe, 0xa5, 0x93, 0xc6, 0xdd, 0x70, 0x5d, 0x2b, 0xaf, 0xe5, 0xdb, 0x02, 0x87, 0x50, 0xd4, 0x6f,
+	0xe9, 0xa4, 0xb1, 0xfb, 0xf2, 0x91, 0x51, 0x0a, 0x8d, 0xab, 0x35, 0xff, 0xbd, 0xc8, 0x70, 0x90,
+	0x16, 0xf6, 0xf6, 0x7c, 0x71, 0x20, 0x85, 0x65, 0xf7, 0x8b, 0x05, 0x91, 0x1f, 0xb6, 0x01, 0x5c,
+	0xca, 0x05, 0xa3, 0xe9, 0x44, 0x50, 0x2c, 0xa2, 0x84, 0x0d, 0x40, 0x28, 0x6f, 0xe5, 0xa7, 0x6d,
+	0xcf, 0x8b, 0x5d, 0xbf, 0x17, 0x85, 0xbe, 0xdd, 0x8f, 0xfc, 0xa0, 0x13, 0x47, 0x56, 0xf8, 0xde,
+	0x8b, 0xe2, 0x7e, 0xa7, 0xd7, 0xf5, 0x1c, 0xbf, 0xed, 0x7b, 0x6e, 0x4d, 0x52, 0x95, 0xe9, 0x4c,
+	0x7f, 0xd0, 0xc1, 0x9b, 0x0a, 0xe5, 0x9d, 0xfc, 0x6c, 0xd3, 0xb0, 0x13, 0x1c, 0x1d, 0xf5, 0x3b,
+	0x7e, 0xf4, 0x31, 0xee, 0x06, 0xc1, 0x61, 0x8d, 0xa8, 0x7b, 0xd3, 0x99, 0x7e, 0xdf, 0xc1, 0xf1,
+	0x78, 0x52, 0x50, 0x71, 0xd1, 0x45, 0x1c, 0x29, 0x8e, 0xfc, 0x7c, 0xd3, 0xf8, 0xb1, 0x75, 0xe8,
+	0xbb, 0x56, 0x14, 0x84, 0x71, 0xe8, 0x7d, 0xb0, 0x42, 0xb7, 0x57, 0xdb, 0x52, 0xf7, 0xa7, 0x33,
+	0xbd, 0x76, 0x9c, 0x8c, 0x68, 0x9e, 0x08, 0x64, 0x21, 0x9c, 0x25, 0x2c, 0xe7, 0xea, 0xf6, 0xd7,
+	0xef, 0x9a, 0x64, 0x47, 0xf3, 0xdf, 0x9a, 0x34, 0x5f, 0x6a, 0xe4, 0x72, 0xa9, 0x91, 0x5f, 0x4b,
+	0x8d, 0x7c, 0x5b, 0x69, 0xd2, 0xe5, 0x4a, 0x93, 0x7e, 0xae, 0x34, 0xe9, 0xd3, 0x9b, 0x1b, 0x41,
+	0xac, 0x3f, 0xc0, 0x19, 0xb2, 0x61, 0x55, 0x35, 0x33, 0x64, 0x60, 0x9e, 0xff, 0x67, 0x65, 0x38,
+	0xe9, 0xce, 0x75, 0xd6, 0xaf, 0xfe, 0x0e, 0x00, 0x8a, 0x43, 0xdb, 0xb2, 0x55, 0x02, 0x00, 0x00,
 }
 
 func (m *IBCTransfer) Marshal() (dAtA []byte, err error) {
@@ -1,10 +1,18 @@
 package bitcoin
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
 // BeginBlocker check for infraction evidence or downtime of validators
@@ -12,7 +20,126 @@ import (
 func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock, _ types.BTCKeeper) {}
 
 // EndBlocker called every block, process inflation, update validator set.
-func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k types.BTCKeeper, signer types.Signer) []abci.ValidatorUpdate {
+func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k types.BTCKeeper, n types.Nexus, signer types.Signer, voter types.Voter, snapshotter types.Snapshotter) []abci.ValidatorUpdate {
+	startFeeRateVote(ctx, k, n)
+	scheduleConsolidation(ctx, k, n, signer, voter, snapshotter)
 
 	return nil
 }
+
+// startFeeRateVote asks validators to vote on the fee rates of all estimated confirmation targets once per vote interval
+func startFeeRateVote(ctx sdk.Context, k types.BTCKeeper, n types.Nexus) {
+	estimation := k.GetFeeRateEstimation(ctx)
+	if estimation.VoteInterval == 0 || ctx.BlockHeight()%estimation.VoteInterval != 0 || !n.IsChainActivated(ctx, exported.Bitcoin) {
+		return
+	}
+
+	confTargets := make([]string, len(estimation.ConfTargets))
+	for i, confTarget := range estimation.ConfTargets {
+		confTargets[i] = strconv.FormatInt(confTarget, 10)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFeeRate,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+		sdk.NewAttribute(types.AttributeKeyConfTargets, strings.Join(confTargets, ",")),
+	))
+}
+
+// scheduleConsolidation creates and signs a secondary key consolidation once one of the triggers of the consolidation schedule is reached
+func scheduleConsolidation(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, signer types.Signer, voter types.Voter, snapshotter types.Snapshotter) {
+	schedule := k.GetConsolidationSchedule(ctx)
+	if !schedule.Enabled || !n.IsChainActivated(ctx, exported.Bitcoin) {
+		return
+	}
+
+	if ctx.BlockHeight() < k.GetConsolidationRetryHeight(ctx) {
+		return
+	}
+
+	if _, ok := k.GetUnsignedTx(ctx, types.SecondaryConsolidation); ok {
+		return
+	}
+
+	secondaryKeyID, ok := signer.GetCurrentKeyID(ctx, exported.Bitcoin, tss.SecondaryKey)
+	if !ok {
+		return
+	}
+
+	trigger, ok := getConsolidationTrigger(ctx, k, n, schedule, secondaryKeyID)
+	if !ok {
+		return
+	}
+
+	// the consolidation must not be created unless signing can start as well
+	cachedCtx, writeCache := ctx.CacheContext()
+	if err := keeper.CreateSecondaryConsolidationTx(cachedCtx, k, n, signer, snapshotter, secondaryKeyID, 0); err != nil {
+		retryHeight := backOffConsolidation(ctx, k, schedule)
+		k.Logger(ctx).Error(fmt.Sprintf("failed to create scheduled %s transaction, retrying at height %d: %s", types.SecondaryConsolidation.SimpleString(), retryHeight, err.Error()))
+		return
+	}
+
+	if _, err := keeper.SignConsolidationTx(cachedCtx, k, signer, snapshotter, voter, types.SecondaryConsolidation, false); err != nil {
+		retryHeight := backOffConsolidation(ctx, k, schedule)
+		k.Logger(ctx).Error(fmt.Sprintf("failed to sign scheduled %s transaction, retrying at height %d: %s", types.SecondaryConsolidation.SimpleString(), retryHeight, err.Error()))
+		return
+	}
+	writeCache()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsolidationTx,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueScheduled),
+		sdk.NewAttribute(types.AttributeTxType, types.SecondaryConsolidation.SimpleString()),
+		sdk.NewAttribute(types.AttributeKeyTrigger, trigger),
+	))
+
+	k.Logger(ctx).Info(fmt.Sprintf("scheduled %s transaction triggered by %s", types.SecondaryConsolidation.SimpleString(), trigger))
+}
+
+// backOffConsolidation delays the next scheduled consolidation by the retry interval,
+// so a persistent failure is not retried and logged every block
+func backOffConsolidation(ctx sdk.Context, k types.BTCKeeper, schedule types.ConsolidationSchedule) int64 {
+	retryHeight := ctx.BlockHeight() + schedule.RetryInterval
+	k.SetConsolidationRetryHeight(ctx, retryHeight)
+
+	return retryHeight
+}
+
+// getConsolidationTrigger returns the name of the first trigger of the given schedule that is reached
+func getConsolidationTrigger(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, schedule types.ConsolidationSchedule, secondaryKeyID tss.KeyID) (string, bool) {
+	var pendingTransfers []nexus.CrossChainTransfer
+	// frozen transfers cannot be withdrawn, so they must not trigger consolidations
+	if !n.IsTransferFrozen(ctx, exported.Bitcoin, exported.Bitcoin.NativeAsset) {
+		pendingTransfers = n.GetTransfersForChain(ctx, exported.Bitcoin, nexus.Pending)
+	}
+
+	pendingAmount := sdk.ZeroInt()
+	oldestHeight := ctx.BlockHeight()
+	for _, transfer := range pendingTransfers {
+		pendingAmount = pendingAmount.Add(transfer.Asset.Amount)
+
+		if transfer.EnqueuedAtHeight < oldestHeight {
+			oldestHeight = transfer.EnqueuedAtHeight
+		}
+	}
+
+	if threshold, err := types.ToSatoshiCoin(schedule.PendingAmountThreshold); err == nil &&
+		threshold.IsPositive() && pendingAmount.GTE(threshold.Amount) {
+		return types.AttributeValuePendingAmount, true
+	}
+
+	if schedule.MaxPendingAge > 0 && len(pendingTransfers) > 0 && ctx.BlockHeight()-oldestHeight >= schedule.MaxPendingAge {
+		return types.AttributeValuePendingAge, true
+	}
+
+	if schedule.InputCountThreshold.Numerator > 0 {
+		inputCount := int64(len(k.GetConfirmedOutpointInfoQueueForKey(ctx, secondaryKeyID).ExportState().Items))
+		maxInputCount := k.GetMaxInputCount(ctx)
+
+		if inputCount*schedule.InputCountThreshold.Denominator >= maxInputCount*schedule.InputCountThreshold.Numerator {
+			return types.AttributeValueInputCount, true
+		}
+	}
+
+	return "", false
+}
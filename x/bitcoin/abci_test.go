@@ -0,0 +1,376 @@
+package bitcoin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types/mock"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tssTestUtils "github.com/axelarnetwork/axelar-core/x/tss/exported/testutils"
+)
+
+func TestScheduleConsolidation(t *testing.T) {
+	var (
+		ctx           sdk.Context
+		k             keeper.Keeper
+		n             *mock.NexusMock
+		signer        *mock.SignerMock
+		secondaryKey  tss.Key
+		outPointInfos []types.OutPointInfo
+		transfers     []nexus.CrossChainTransfer
+	)
+
+	addOutPoints := func(count int) {
+		address, err := types.NewSecondaryConsolidationAddress(secondaryKey, k.GetNetwork(ctx))
+		assert.NoError(t, err)
+
+		for i := 0; i < count; i++ {
+			txHash, err := chainhash.NewHash(rand.Bytes(chainhash.HashSize))
+			assert.NoError(t, err)
+
+			info := types.OutPointInfo{
+				OutPoint: wire.NewOutPoint(txHash, uint32(rand.I64Between(0, 100))).String(),
+				Amount:   btcutil.Amount(rand.I64Between(btcutil.SatoshiPerBitcoin, 10*btcutil.SatoshiPerBitcoin)),
+				Address:  address.Address,
+			}
+			outPointInfos = append(outPointInfos, info)
+			k.SetConfirmedOutpointInfo(ctx, secondaryKey.ID, info)
+		}
+	}
+
+	setup := func(schedule types.ConsolidationSchedule) {
+		encCfg := appParams.MakeEncodingConfig()
+		storeKey, paramsKey, tParamsKey := sdk.NewKVStoreKey("btc"), sdk.NewKVStoreKey("params"), sdk.NewTransientStoreKey("tparams")
+
+		// the scheduled consolidation needs a store that supports cache contexts
+		ms := store.NewCommitMultiStore(dbm.NewMemDB())
+		ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, nil)
+		assert.NoError(t, ms.LoadLatestVersion())
+
+		ctx = sdk.NewContext(ms, tmproto.Header{Height: rand.I64Between(1000, 100000)}, false, log.TestingLogger())
+		btcSubspace := params.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, "btc")
+		k = keeper.NewKeeper(encCfg.Marshaler, storeKey, btcSubspace)
+
+		p := types.DefaultParams()
+		p.ConsolidationSchedule = schedule
+		k.SetParams(ctx, p)
+
+		secondaryKey = randomSecondaryKey()
+		address, err := types.NewSecondaryConsolidationAddress(secondaryKey, p.Network)
+		assert.NoError(t, err)
+		k.SetAddress(ctx, address)
+
+		outPointInfos = nil
+		transfers = nil
+		// below the input count threshold of every test schedule
+		addOutPoints(5)
+
+		n = &mock.NexusMock{
+			IsChainActivatedFunc: func(_ sdk.Context, chain nexus.Chain) bool { return chain == exported.Bitcoin },
+			IsTransferFrozenFunc: func(sdk.Context, nexus.Chain, string) bool { return false },
+			GetTransfersForChainFunc: func(_ sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
+				if chain == exported.Bitcoin && state == nexus.Pending {
+					return transfers
+				}
+				return nil
+			},
+			ArchivePendingTransferFunc: func(sdk.Context, nexus.CrossChainTransfer, string) {},
+		}
+		signer = &mock.SignerMock{
+			GetCurrentKeyIDFunc: func(_ sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.KeyID, bool) {
+				return secondaryKey.ID, chain == exported.Bitcoin && keyRole == tss.SecondaryKey
+			},
+			GetCurrentKeyFunc: func(_ sdk.Context, chain nexus.Chain, keyRole tss.KeyRole) (tss.Key, bool) {
+				return secondaryKey, chain == exported.Bitcoin && keyRole == tss.SecondaryKey
+			},
+			GetKeyFunc: func(_ sdk.Context, keyID tss.KeyID) (tss.Key, bool) {
+				return secondaryKey, keyID == secondaryKey.ID
+			},
+			GetSigFunc: func(sdk.Context, string) (tss.Signature, tss.SigStatus) {
+				return tss.Signature{}, tss.SigStatus_Unspecified
+			},
+			GetSnapshotCounterForKeyIDFunc: func(sdk.Context, tss.KeyID) (int64, bool) { return rand.PosI64(), true },
+			StartSignFunc: func(sdk.Context, tss.SignInfo, types.Snapshotter, types.InitPoller) error {
+				return nil
+			},
+		}
+	}
+
+	addTransfers := func(count int, amount int64, enqueuedAt int64) {
+		for i := 0; i < count; i++ {
+			recipient, err := btcutil.NewAddressWitnessScriptHash(rand.Bytes(32), types.DefaultParams().Network.Params())
+			assert.NoError(t, err)
+
+			transfers = append(transfers, nexus.CrossChainTransfer{
+				Recipient:        nexus.CrossChainAddress{Chain: exported.Bitcoin, Address: recipient.EncodeAddress()},
+				Sender:           nexus.CrossChainAddress{Chain: nexus.Chain{Name: rand.StrBetween(5, 10)}, Address: rand.StrBetween(10, 20)},
+				Asset:            sdk.NewInt64Coin(exported.Bitcoin.NativeAsset, amount),
+				ID:               uint64(len(transfers)),
+				EnqueuedAtHeight: enqueuedAt,
+			})
+		}
+	}
+
+	schedule := func() {
+		snapshotter := &mock.SnapshotterMock{
+			GetSnapshotFunc: func(_ sdk.Context, counter int64) (snapshot.Snapshot, bool) {
+				return snapshot.Snapshot{Counter: counter}, true
+			},
+		}
+		scheduleConsolidation(ctx, k, n, signer, &mock.VoterMock{}, snapshotter)
+	}
+
+	assertScheduled := func(t *testing.T, trigger string) {
+		unsignedTx, ok := k.GetUnsignedTx(ctx, types.SecondaryConsolidation)
+		assert.True(t, ok)
+		assert.True(t, unsignedTx.Is(types.Signing))
+		assert.Len(t, unsignedTx.GetTx().TxIn, len(outPointInfos))
+
+		var triggers []string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeConsolidationTx {
+				continue
+			}
+			for _, attribute := range event.Attributes {
+				if string(attribute.Key) == types.AttributeKeyTrigger {
+					triggers = append(triggers, string(attribute.Value))
+				}
+			}
+		}
+		assert.Equal(t, []string{trigger}, triggers)
+
+		// scheduled consolidations move funds between the current keys only, so they are not part of key management
+		for _, call := range signer.StartSignCalls() {
+			assert.Equal(t, tss.SignPriority_Normal, call.Info.Priority)
+			assert.False(t, call.Info.KeyManagement)
+		}
+	}
+
+	assertNotScheduled := func(t *testing.T) {
+		_, ok := k.GetUnsignedTx(ctx, types.SecondaryConsolidation)
+		assert.False(t, ok)
+		assert.Len(t, signer.StartSignCalls(), 0)
+		assert.Len(t, n.ArchivePendingTransferCalls(), 0)
+
+		// all outpoints are still waiting to be spent
+		for _, info := range outPointInfos {
+			_, state, ok := k.GetOutPointInfo(ctx, info.GetOutPoint())
+			assert.True(t, ok)
+			assert.Equal(t, types.OutPointState_Confirmed, state)
+		}
+		assert.Len(t, k.GetConfirmedOutpointInfoQueueForKey(ctx, secondaryKey.ID).ExportState().Items, len(outPointInfos))
+	}
+
+	pendingAmountSchedule := types.ConsolidationSchedule{
+		Enabled:                true,
+		PendingAmountThreshold: sdk.NewDecCoin(types.Satoshi, sdk.NewInt(btcutil.SatoshiPerBitcoin)),
+		InputCountThreshold:    utils.Threshold{Numerator: 0, Denominator: 1},
+		RetryInterval:          rand.I64Between(1, 100),
+	}
+	pendingAgeSchedule := types.ConsolidationSchedule{
+		Enabled:                true,
+		PendingAmountThreshold: sdk.NewDecCoin(types.Satoshi, sdk.ZeroInt()),
+		MaxPendingAge:          rand.I64Between(10, 100),
+		InputCountThreshold:    utils.Threshold{Numerator: 0, Denominator: 1},
+		RetryInterval:          rand.I64Between(1, 100),
+	}
+	inputCountSchedule := types.ConsolidationSchedule{
+		Enabled:                true,
+		PendingAmountThreshold: sdk.NewDecCoin(types.Satoshi, sdk.ZeroInt()),
+		// 6 out of the default max input count of 50
+		InputCountThreshold: utils.Threshold{Numerator: 6, Denominator: 50},
+		RetryInterval:       rand.I64Between(1, 100),
+	}
+
+	repeats := 20
+
+	t.Run("should schedule a consolidation once the pending amount reaches the threshold", testutils.Func(func(t *testing.T) {
+		setup(pendingAmountSchedule)
+		minAmount := int64(k.GetMinOutputAmount(ctx))
+		addTransfers(1, btcutil.SatoshiPerBitcoin-minAmount, ctx.BlockHeight())
+		addTransfers(1, minAmount-1, ctx.BlockHeight())
+
+		schedule()
+		assertNotScheduled(t)
+
+		addTransfers(1, minAmount, ctx.BlockHeight())
+		schedule()
+		assertScheduled(t, types.AttributeValuePendingAmount)
+		// the transfer below the min output amount stays pending as dust
+		assert.Len(t, n.ArchivePendingTransferCalls(), len(transfers)-1)
+	}).Repeat(repeats))
+
+	t.Run("should schedule a consolidation once the oldest pending transfer reaches the max age", testutils.Func(func(t *testing.T) {
+		setup(pendingAgeSchedule)
+
+		schedule()
+		assertNotScheduled(t)
+
+		addTransfers(1, rand.I64Between(1, btcutil.SatoshiPerBitcoin), ctx.BlockHeight()-pendingAgeSchedule.MaxPendingAge+1)
+		addTransfers(1, rand.I64Between(1, btcutil.SatoshiPerBitcoin), ctx.BlockHeight())
+		schedule()
+		assertNotScheduled(t)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		schedule()
+		assertScheduled(t, types.AttributeValuePendingAge)
+	}).Repeat(repeats))
+
+	t.Run("should schedule a consolidation once the confirmed inputs reach the threshold", testutils.Func(func(t *testing.T) {
+		setup(inputCountSchedule)
+
+		schedule()
+		assertNotScheduled(t)
+
+		addOutPoints(1)
+		schedule()
+		assertScheduled(t, types.AttributeValueInputCount)
+	}).Repeat(repeats))
+
+	t.Run("should not schedule a consolidation when the schedule is disabled", testutils.Func(func(t *testing.T) {
+		disabled := pendingAmountSchedule
+		disabled.Enabled = false
+		setup(disabled)
+		addTransfers(1, btcutil.SatoshiPerBitcoin, ctx.BlockHeight()-rand.I64Between(1000, 10000))
+
+		schedule()
+		assertNotScheduled(t)
+	}).Repeat(repeats))
+
+	t.Run("should not schedule a consolidation for frozen transfers", testutils.Func(func(t *testing.T) {
+		setup(pendingAmountSchedule)
+		addTransfers(1, btcutil.SatoshiPerBitcoin, ctx.BlockHeight())
+		n.IsTransferFrozenFunc = func(_ sdk.Context, chain nexus.Chain, asset string) bool {
+			return chain == exported.Bitcoin && asset == exported.Bitcoin.NativeAsset
+		}
+
+		schedule()
+		assertNotScheduled(t)
+	}).Repeat(repeats))
+
+	t.Run("should leave the state untouched when the consolidation cannot be created", testutils.Func(func(t *testing.T) {
+		setup(pendingAmountSchedule)
+		addTransfers(1, btcutil.SatoshiPerBitcoin, ctx.BlockHeight())
+		signer.GetKeyFunc = func(sdk.Context, tss.KeyID) (tss.Key, bool) { return tss.Key{}, false }
+
+		schedule()
+		assertNotScheduled(t)
+		assert.Equal(t, ctx.BlockHeight()+pendingAmountSchedule.RetryInterval, k.GetConsolidationRetryHeight(ctx))
+	}).Repeat(repeats))
+
+	t.Run("should leave the state untouched when the consolidation cannot be signed", testutils.Func(func(t *testing.T) {
+		setup(pendingAmountSchedule)
+		addTransfers(1, btcutil.SatoshiPerBitcoin, ctx.BlockHeight())
+		signer.StartSignFunc = func(sdk.Context, tss.SignInfo, types.Snapshotter, types.InitPoller) error {
+			return fmt.Errorf("some error")
+		}
+
+		schedule()
+		// the consolidation was created and all its inputs were spent in the discarded cache context
+		assert.Len(t, signer.StartSignCalls(), 1)
+		assert.Len(t, n.ArchivePendingTransferCalls(), 1)
+
+		_, ok := k.GetUnsignedTx(ctx, types.SecondaryConsolidation)
+		assert.False(t, ok)
+		for _, info := range outPointInfos {
+			_, state, ok := k.GetOutPointInfo(ctx, info.GetOutPoint())
+			assert.True(t, ok)
+			assert.Equal(t, types.OutPointState_Confirmed, state)
+		}
+
+		// the failed consolidation is not retried before the retry interval has passed
+		signer.StartSignFunc = func(sdk.Context, tss.SignInfo, types.Snapshotter, types.InitPoller) error {
+			return nil
+		}
+		retryHeight := ctx.BlockHeight() + pendingAmountSchedule.RetryInterval
+		ctx = ctx.WithBlockHeight(retryHeight - 1)
+		schedule()
+		assert.Len(t, signer.StartSignCalls(), 1)
+		_, ok = k.GetUnsignedTx(ctx, types.SecondaryConsolidation)
+		assert.False(t, ok)
+
+		ctx = ctx.WithBlockHeight(retryHeight)
+		schedule()
+		assertScheduled(t, types.AttributeValuePendingAmount)
+	}).Repeat(repeats))
+
+	t.Run("should sign consolidations to a rotated key as key management with high priority", testutils.Func(func(t *testing.T) {
+		setup(pendingAmountSchedule)
+		addTransfers(1, btcutil.SatoshiPerBitcoin, ctx.BlockHeight())
+
+		nextKey := randomSecondaryKey()
+		signer.GetKeyFunc = func(_ sdk.Context, keyID tss.KeyID) (tss.Key, bool) {
+			switch keyID {
+			case secondaryKey.ID:
+				return secondaryKey, true
+			case nextKey.ID:
+				return nextKey, true
+			default:
+				return tss.Key{}, false
+			}
+		}
+		signer.GetRotationCountFunc = func(sdk.Context, nexus.Chain, tss.KeyRole) int64 { return 1 }
+		signer.GetKeyUnbondingLockingKeyRotationCountFunc = func(sdk.Context) int64 { return 1 }
+		signer.AssertMatchesRequirementsFunc = func(sdk.Context, types.Snapshotter, nexus.Chain, tss.KeyID, tss.KeyRole) error {
+			return nil
+		}
+		signer.AssignNextKeyFunc = func(sdk.Context, nexus.Chain, tss.KeyRole, tss.KeyID) error { return nil }
+		snapshotter := &mock.SnapshotterMock{
+			GetSnapshotFunc: func(_ sdk.Context, counter int64) (snapshot.Snapshot, bool) {
+				return snapshot.Snapshot{Counter: counter}, true
+			},
+		}
+
+		handler := keeper.NewKeyRotationHandler(k, signer, n, &mock.VoterMock{}, snapshotter)
+		assert.NoError(t, handler(ctx, exported.Bitcoin.Name, tss.SecondaryKey, nextKey.ID))
+
+		unsignedTx, ok := k.GetUnsignedTx(ctx, types.SecondaryConsolidation)
+		assert.True(t, ok)
+		assert.True(t, unsignedTx.Is(types.Signing))
+		assert.True(t, unsignedTx.Info.RotateKey)
+
+		assert.Len(t, signer.StartSignCalls(), len(outPointInfos))
+		for _, call := range signer.StartSignCalls() {
+			assert.Equal(t, tss.SignPriority_High, call.Info.Priority)
+			assert.True(t, call.Info.KeyManagement)
+		}
+	}).Repeat(repeats))
+}
+
+func randomSecondaryKey() tss.Key {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		panic(err)
+	}
+
+	return tss.Key{
+		ID:        tssTestUtils.RandKeyID(),
+		PublicKey: &tss.Key_ECDSAKey_{ECDSAKey: &tss.Key_ECDSAKey{Value: privKey.PubKey().SerializeCompressed()}},
+		Role:      tss.SecondaryKey,
+		RotatedAt: &time.Time{},
+	}
+}
@@ -7,10 +7,9 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
 )
 
-// InitGenesis initialize default parameters
-// from the genesis state
+// InitGenesis initializes the state from the given genesis state
 func InitGenesis(ctx sdk.Context, k types.BTCKeeper, g types.GenesisState) {
-	k.SetParams(ctx, g.Params)
+	k.InitGenesis(ctx, &g)
 
 	// expose some parameters from genesis of btc module
 	minAmount := int64(k.GetMinOutputAmount(ctx))
@@ -21,6 +20,5 @@ func InitGenesis(ctx sdk.Context, k types.BTCKeeper, g types.GenesisState) {
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k types.BTCKeeper) *types.GenesisState {
-	params := k.GetParams(ctx)
-	return &types.GenesisState{Params: params}
+	return k.ExportGenesis(ctx)
 }
@@ -33,6 +33,12 @@ func NewHandler(k types.BTCKeeper, v types.Voter, signer types.Signer, n types.N
 				k.Logger(ctx).Debug(res.Status)
 			}
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.VoteFeeRateRequest:
+			res, err := server.VoteFeeRate(sdk.WrapSDKContext(ctx), msg)
+			if err == nil {
+				k.Logger(ctx).Debug(res.Status)
+			}
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.CreatePendingTransfersTxRequest:
 			res, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
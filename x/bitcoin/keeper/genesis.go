@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoprototypes "github.com/gogo/protobuf/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// InitGenesis initializes the bitcoin module's state from a given genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, pending := range genState.PendingOutPoints {
+		k.SetPendingOutpointInfo(ctx, pending.PollKey, pending.Info)
+	}
+
+	// confirmed outpoints are stored without enqueueing them, the queues are restored separately
+	for _, info := range genState.ConfirmedOutPoints {
+		outPoint := info
+		k.getStore(ctx).Set(confirmedOutPointPrefix.Append(utils.LowerCaseKey(info.OutPoint)), &outPoint)
+	}
+
+	for _, info := range genState.SpentOutPoints {
+		k.SetSpentOutpointInfo(ctx, info)
+	}
+
+	for _, queue := range genState.ConfirmedOutPointQueues {
+		k.GetConfirmedOutpointInfoQueueForKey(ctx, queue.KeyID).ImportState(queue.Queue)
+	}
+
+	for _, address := range genState.Addresses {
+		k.SetAddress(ctx, address)
+	}
+
+	for _, dust := range genState.DustAmounts {
+		k.SetDustAmount(ctx, dust.Address, dust.Amount)
+	}
+
+	for _, tx := range genState.UnsignedTxs {
+		k.SetUnsignedTx(ctx, tx)
+	}
+
+	// signed txs are stored as is, because SetSignedTx would overwrite their link to the previous signed tx
+	for _, tx := range genState.SignedTxs {
+		signedTx := tx
+		k.getStore(ctx).Set(signedTxPrefix.Append(utils.LowerCaseKey(tx.GetTx().TxHash().String())), &signedTx)
+	}
+
+	for _, latest := range genState.LatestSignedTxHashes {
+		txHash, err := chainhash.NewHash(latest.TxHash)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetLatestSignedTxHash(ctx, latest.TxType, *txHash)
+	}
+
+	for _, unconfirmed := range genState.UnconfirmedAmounts {
+		k.SetUnconfirmedAmount(ctx, unconfirmed.KeyID, unconfirmed.Amount)
+	}
+
+	if len(genState.ExternalKeyIDs) > 0 {
+		k.SetExternalKeyIDs(ctx, genState.ExternalKeyIDs)
+	}
+
+	for _, feeRate := range genState.FeeRates {
+		k.SetFeeRate(ctx, feeRate.ConfTarget, feeRate.FeeRate)
+	}
+
+	for _, vote := range genState.FeeRateVotes {
+		k.SetFeeRateVote(ctx, vote)
+	}
+}
+
+// ExportGenesis returns the bitcoin module's genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	addresses := k.getAddresses(ctx)
+	unconfirmedAmounts := k.getUnconfirmedAmounts(ctx)
+
+	externalKeyIDs, ok := k.GetExternalKeyIDs(ctx)
+	if !ok {
+		externalKeyIDs = []tss.KeyID{}
+	}
+
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		k.getPendingOutPointInfos(ctx),
+		k.getOutPointInfos(ctx, confirmedOutPointPrefix),
+		k.getOutPointInfos(ctx, spentOutPointPrefix),
+		k.getConfirmedOutPointQueues(ctx, addresses, unconfirmedAmounts),
+		addresses,
+		k.getDustAmounts(ctx),
+		k.getUnsignedTxs(ctx),
+		k.getSignedTxs(ctx),
+		k.getLatestSignedTxHashes(ctx),
+		unconfirmedAmounts,
+		externalKeyIDs,
+		k.getFeeRates(ctx),
+		k.getFeeRateVotes(ctx),
+	)
+}
+
+func (k Keeper) getPendingOutPointInfos(ctx sdk.Context) []types.PendingOutPointInfo {
+	infos := []types.PendingOutPointInfo{}
+
+	prefix := pendingOutpointPrefix.AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var info types.OutPointInfo
+		iter.UnmarshalValue(&info)
+
+		// poll keys are stored lower case, which does not affect lookups because those are lower case as well
+		pollKey := strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey()))
+		split := strings.SplitN(pollKey, "_", 2)
+		if len(split) != 2 {
+			k.Logger(ctx).Error("skipping pending outpoint with malformed poll key " + pollKey)
+			continue
+		}
+
+		infos = append(infos, types.PendingOutPointInfo{PollKey: vote.NewPollKey(split[0], split[1]), Info: info})
+	}
+
+	return infos
+}
+
+func (k Keeper) getOutPointInfos(ctx sdk.Context, outPointPrefix utils.StringKey) []types.OutPointInfo {
+	infos := []types.OutPointInfo{}
+
+	iter := k.getStore(ctx).Iterator(outPointPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var info types.OutPointInfo
+		iter.UnmarshalValue(&info)
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// getConfirmedOutPointQueues returns all non-empty queues of confirmed outpoints. Queues are created per key,
+// and every key that owns outpoints is referenced by an address or an unconfirmed amount
+func (k Keeper) getConfirmedOutPointQueues(ctx sdk.Context, addresses []types.AddressInfo, unconfirmedAmounts []types.UnconfirmedAmount) []types.ConfirmedOutPointQueue {
+	var keyIDs []tss.KeyID
+	seen := make(map[tss.KeyID]bool)
+	for _, address := range addresses {
+		if !seen[address.KeyID] {
+			keyIDs = append(keyIDs, address.KeyID)
+			seen[address.KeyID] = true
+		}
+	}
+
+	for _, unconfirmed := range unconfirmedAmounts {
+		if !seen[unconfirmed.KeyID] {
+			keyIDs = append(keyIDs, unconfirmed.KeyID)
+			seen[unconfirmed.KeyID] = true
+		}
+	}
+
+	queues := []types.ConfirmedOutPointQueue{}
+	for _, keyID := range keyIDs {
+		state := k.GetConfirmedOutpointInfoQueueForKey(ctx, keyID).ExportState()
+		if len(state.Items) == 0 {
+			continue
+		}
+
+		queues = append(queues, types.ConfirmedOutPointQueue{KeyID: keyID, Queue: state})
+	}
+
+	return queues
+}
+
+func (k Keeper) getAddresses(ctx sdk.Context) []types.AddressInfo {
+	addresses := []types.AddressInfo{}
+
+	iter := k.getStore(ctx).Iterator(addrPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var address types.AddressInfo
+		iter.UnmarshalValue(&address)
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses
+}
+
+func (k Keeper) getDustAmounts(ctx sdk.Context) []types.DustAmount {
+	dustAmounts := []types.DustAmount{}
+
+	prefix := dustAmtPrefix.AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		address := strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey()))
+		dustAmounts = append(dustAmounts, types.DustAmount{Address: address, Amount: k.GetDustAmount(ctx, address)})
+	}
+
+	return dustAmounts
+}
+
+func (k Keeper) getUnsignedTxs(ctx sdk.Context) []types.UnsignedTx {
+	txs := []types.UnsignedTx{}
+	for _, txType := range types.GetTxTypes() {
+		if tx, ok := k.GetUnsignedTx(ctx, txType); ok {
+			txs = append(txs, tx)
+		}
+	}
+
+	return txs
+}
+
+func (k Keeper) getSignedTxs(ctx sdk.Context) []types.SignedTx {
+	txs := []types.SignedTx{}
+
+	iter := k.getStore(ctx).Iterator(signedTxPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var tx types.SignedTx
+		iter.UnmarshalValue(&tx)
+
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+func (k Keeper) getLatestSignedTxHashes(ctx sdk.Context) []types.LatestSignedTxHash {
+	hashes := []types.LatestSignedTxHash{}
+	for _, txType := range types.GetTxTypes() {
+		if txHash, ok := k.GetLatestSignedTxHash(ctx, txType); ok {
+			hashes = append(hashes, types.LatestSignedTxHash{TxType: txType, TxHash: txHash[:]})
+		}
+	}
+
+	return hashes
+}
+
+func (k Keeper) getUnconfirmedAmounts(ctx sdk.Context) []types.UnconfirmedAmount {
+	amounts := []types.UnconfirmedAmount{}
+
+	prefix := unconfirmedAmountPrefix.AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var amount gogoprototypes.Int64Value
+		iter.UnmarshalValue(&amount)
+
+		amounts = append(amounts, types.UnconfirmedAmount{
+			KeyID:  tss.KeyID(strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey()))),
+			Amount: btcutil.Amount(amount.Value),
+		})
+	}
+
+	return amounts
+}
+
+func (k Keeper) getFeeRates(ctx sdk.Context) []types.FeeRate {
+	feeRates := []types.FeeRate{}
+
+	iter := k.getStore(ctx).Iterator(feeRatePrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var feeRate types.FeeRate
+		iter.UnmarshalValue(&feeRate)
+
+		feeRates = append(feeRates, feeRate)
+	}
+
+	return feeRates
+}
+
+func (k Keeper) getFeeRateVotes(ctx sdk.Context) []types.FeeRateVote {
+	votes := []types.FeeRateVote{}
+
+	iter := k.getStore(ctx).Iterator(feeRateVotePrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var vote types.FeeRateVote
+		iter.UnmarshalValue(&vote)
+
+		votes = append(votes, vote)
+	}
+
+	return votes
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	bitcoinKeeper "github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	encCfg := appParams.MakeEncodingConfig()
+	newKeeper := func() (sdk.Context, bitcoinKeeper.Keeper) {
+		btcSubspace := params.NewSubspace(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"), "btc")
+		ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		return ctx, bitcoinKeeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey("btc"), btcSubspace)
+	}
+
+	randOutPointInfo := func(address string) types.OutPointInfo {
+		outPoint := wire.NewOutPoint(&chainhash.Hash{}, uint32(rand.I64Between(0, 100)))
+		copy(outPoint.Hash[:], rand.Bytes(chainhash.HashSize))
+		return types.NewOutPointInfo(outPoint, btcutil.Amount(rand.PosI64()), address)
+	}
+
+	t.Run("should restore outpoints, addresses and transactions", testutils.Func(func(t *testing.T) {
+		ctx, keeper := newKeeper()
+		keeper.SetParams(ctx, types.DefaultParams())
+
+		for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+			addr, err := btcutil.NewAddressWitnessScriptHash(rand.Bytes(32), types.DefaultParams().Network.Params())
+			assert.NoError(t, err)
+
+			address := types.AddressInfo{
+				Address:      addr.EncodeAddress(),
+				Role:         types.Deposit,
+				RedeemScript: rand.Bytes(200),
+				KeyID:        tss.KeyID(rand.StrBetween(5, 20)),
+			}
+			keeper.SetAddress(ctx, address)
+			keeper.SetUnconfirmedAmount(ctx, address.KeyID, btcutil.Amount(rand.PosI64()))
+			keeper.SetDustAmount(ctx, address.Address, btcutil.Amount(rand.PosI64()))
+
+			keeper.SetPendingOutpointInfo(ctx, vote.NewPollKey(types.ModuleName, rand.StrBetween(5, 20)), randOutPointInfo(address.Address))
+			keeper.SetConfirmedOutpointInfo(ctx, address.KeyID, randOutPointInfo(address.Address))
+			keeper.SetSpentOutpointInfo(ctx, randOutPointInfo(address.Address))
+
+			// dequeued outpoints stay confirmed
+			if rand.Bools(0.5).Next() {
+				var info types.OutPointInfo
+				assert.True(t, keeper.GetConfirmedOutpointInfoQueueForKey(ctx, address.KeyID).Dequeue(&info))
+			}
+		}
+
+		for _, txType := range types.GetTxTypes() {
+			tx := types.CreateTx()
+			assert.NoError(t, types.AddInput(tx, randOutPointInfo("").OutPoint))
+
+			keeper.SetUnsignedTx(ctx, types.NewUnsignedTx(txType, tx, 0, btcutil.Amount(rand.PosI64())))
+
+			signedTx := types.NewSignedTx(txType, tx, rand.Bools(0.5).Next(), 0)
+			keeper.SetSignedTx(ctx, signedTx)
+			keeper.SetLatestSignedTxHash(ctx, txType, tx.TxHash())
+		}
+
+		keeper.SetExternalKeyIDs(ctx, []tss.KeyID{tss.KeyID(rand.StrBetween(5, 20)), tss.KeyID(rand.StrBetween(5, 20))})
+
+		for _, confTarget := range types.DefaultParams().FeeRateEstimation.ConfTargets {
+			keeper.SetFeeRate(ctx, confTarget, btcutil.Amount(rand.PosI64()))
+			keeper.SetFeeRateVote(ctx, types.FeeRateVote{Voter: rand.ValAddr(), ConfTarget: confTarget, FeeRate: btcutil.Amount(rand.PosI64()), Height: rand.PosI64()})
+		}
+
+		expected := keeper.ExportGenesis(ctx)
+		assert.NoError(t, expected.Validate())
+		assert.Len(t, expected.UnsignedTxs, len(types.GetTxTypes()))
+		assert.Len(t, expected.ExternalKeyIDs, 2)
+		assert.Len(t, expected.FeeRates, len(types.DefaultParams().FeeRateEstimation.ConfTargets))
+		assert.Len(t, expected.FeeRateVotes, len(types.DefaultParams().FeeRateEstimation.ConfTargets))
+
+		bz := encCfg.Marshaler.MustMarshalJSON(expected)
+		var genState types.GenesisState
+		encCfg.Marshaler.MustUnmarshalJSON(bz, &genState)
+		assert.NoError(t, genState.Validate())
+
+		newCtx, newKeeper := newKeeper()
+		newKeeper.InitGenesis(newCtx, &genState)
+		assert.Equal(t, bz, encCfg.Marshaler.MustMarshalJSON(newKeeper.ExportGenesis(newCtx)))
+		assert.Equal(t, keeper.GetAnyoneCanSpendAddress(ctx), newKeeper.GetAnyoneCanSpendAddress(newCtx))
+
+		for _, queue := range expected.ConfirmedOutPointQueues {
+			var expectedInfo, actualInfo types.OutPointInfo
+			assert.True(t, keeper.GetConfirmedOutpointInfoQueueForKey(ctx, queue.KeyID).Dequeue(&expectedInfo))
+			assert.True(t, newKeeper.GetConfirmedOutpointInfoQueueForKey(newCtx, queue.KeyID).Dequeue(&actualInfo))
+			assert.Equal(t, expectedInfo, actualInfo)
+		}
+	}).Repeat(20))
+
+	t.Run("should reject latest signed tx hashes of unknown txs", testutils.Func(func(t *testing.T) {
+		genState := types.DefaultGenesisState()
+		tx := types.CreateTx()
+		assert.NoError(t, types.AddInput(tx, randOutPointInfo("").OutPoint))
+
+		txHash := tx.TxHash()
+		genState.LatestSignedTxHashes = append(genState.LatestSignedTxHashes, types.LatestSignedTxHash{TxType: types.MasterConsolidation, TxHash: txHash[:]})
+		assert.Error(t, genState.Validate())
+
+		genState.SignedTxs = append(genState.SignedTxs, types.NewSignedTx(types.MasterConsolidation, tx, false, 0))
+		assert.NoError(t, genState.Validate())
+	}).Repeat(20))
+}
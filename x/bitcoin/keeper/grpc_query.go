@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+)
+
+var _ types.QueryServiceServer = Querier{}
+
+// Querier implements the grpc querier
+type Querier struct {
+	keeper types.BTCKeeper
+	signer types.Signer
+	nexus  types.Nexus
+}
+
+// NewGRPCQuerier creates a new bitcoin Querier
+func NewGRPCQuerier(k types.BTCKeeper, s types.Signer, n types.Nexus) Querier {
+	return Querier{
+		keeper: k,
+		signer: s,
+		nexus:  n,
+	}
+}
+
+// DepositAddress returns the deposit address linked to the given recipient
+func (q Querier) DepositAddress(c context.Context, req *types.QueryDepositAddressRequest) (*types.QueryAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := depositAddress(ctx, q.keeper, q.signer, q.nexus, req.RecipientChain, req.RecipientAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBitcoin, err.Error())
+	}
+
+	return &resp, nil
+}
+
+// DepositStatus returns the status of the given deposit outpoint
+func (q Querier) DepositStatus(c context.Context, req *types.QueryDepositStatusRequest) (*types.QueryDepositStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := depositStatus(ctx, q.keeper, req.OutPoint)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBitcoin, err.Error())
+	}
+
+	return &resp, nil
+}
+
+// ConsolidationAddress returns the consolidation address of the given key ID,
+// or of the current key of the given key role if no key ID is given
+func (q Querier) ConsolidationAddress(c context.Context, req *types.QueryConsolidationAddressRequest) (*types.QueryAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	keyID := req.KeyID
+	if keyID == "" {
+		var ok bool
+		if keyID, ok = q.signer.GetCurrentKeyID(ctx, exported.Bitcoin, req.KeyRole); !ok {
+			return nil, sdkerrors.Wrapf(types.ErrBitcoin, "%s key not found", req.KeyRole.SimpleString())
+		}
+	}
+
+	if err := keyID.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBitcoin, err.Error())
+	}
+
+	resp, err := consolidationAddressByKeyID(ctx, q.keeper, q.signer, keyID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBitcoin, err.Error())
+	}
+
+	return &resp, nil
+}
+
+// NextKeyID returns the key ID assigned to the next rotation of the given key role
+func (q Querier) NextKeyID(c context.Context, req *types.QueryNextKeyIDRequest) (*types.QueryNextKeyIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := req.KeyRole.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBitcoin, err.Error())
+	}
+
+	next, ok := q.signer.GetNextKey(ctx, exported.Bitcoin, req.KeyRole)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrBitcoin, fmt.Sprintf("no next %s key assigned", req.KeyRole.SimpleString()))
+	}
+
+	return &types.QueryNextKeyIDResponse{KeyID: next.ID}, nil
+}
+
+// MinOutputAmount returns the minimum amount allowed for any transaction output
+func (q Querier) MinOutputAmount(c context.Context, _ *types.QueryMinOutputAmountRequest) (*types.QueryMinOutputAmountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMinOutputAmountResponse{Amount: int64(q.keeper.GetMinOutputAmount(ctx))}, nil
+}
+
+// LatestTx returns the latest consolidation transaction of the given tx type
+func (q Querier) LatestTx(c context.Context, req *types.QueryLatestTxRequest) (*types.QueryTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := req.TxType.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBitcoin, err.Error())
+	}
+
+	resp, err := latestTxByTxType(ctx, q.keeper, req.TxType)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBitcoin, err.Error())
+	}
+
+	return &resp, nil
+}
+
+// SignedTx returns the signed consolidation transaction of the given transaction hash
+func (q Querier) SignedTx(c context.Context, req *types.QuerySignedTxRequest) (*types.QueryTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := signedTxByHash(ctx, q.keeper, req.TxHash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBitcoin, err.Error())
+	}
+
+	return &resp, nil
+}
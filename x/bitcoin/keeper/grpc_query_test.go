@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types/mock"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tssTestUtils "github.com/axelarnetwork/axelar-core/x/tss/exported/testutils"
+)
+
+func TestGRPCQuerier(t *testing.T) {
+	var (
+		btcKeeper   *mock.BTCKeeperMock
+		signer      *mock.SignerMock
+		nexusKeeper *mock.NexusMock
+		querier     keeper.Querier
+		ctx         sdk.Context
+	)
+
+	setup := func() {
+		btcKeeper = &mock.BTCKeeperMock{}
+		signer = &mock.SignerMock{}
+		nexusKeeper = &mock.NexusMock{
+			GetChainFunc: func(sdk.Context, string) (nexus.Chain, bool) { return nexus.Chain{}, false },
+		}
+		querier = keeper.NewGRPCQuerier(btcKeeper, signer, nexusKeeper)
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+	}
+
+	repeatCount := 20
+
+	t.Run("should return the next key ID of the given key role", testutils.Func(func(t *testing.T) {
+		setup()
+		next := tss.Key{ID: tssTestUtils.RandKeyID(), Role: tss.SecondaryKey}
+		signer.GetNextKeyFunc = func(_ sdk.Context, _ nexus.Chain, keyRole tss.KeyRole) (tss.Key, bool) {
+			return next, keyRole == tss.SecondaryKey
+		}
+
+		res, err := querier.NextKeyID(sdk.WrapSDKContext(ctx), &types.QueryNextKeyIDRequest{KeyRole: tss.SecondaryKey})
+		assert.NoError(t, err)
+		assert.Equal(t, next.ID, res.KeyID)
+
+		_, err = querier.NextKeyID(sdk.WrapSDKContext(ctx), &types.QueryNextKeyIDRequest{KeyRole: tss.MasterKey})
+		assert.Error(t, err)
+
+		_, err = querier.NextKeyID(sdk.WrapSDKContext(ctx), &types.QueryNextKeyIDRequest{KeyRole: tss.KeyRole(rand.I64Between(100, 1000))})
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+
+	t.Run("should return the min output amount", testutils.Func(func(t *testing.T) {
+		setup()
+		amount := btcutil.Amount(rand.PosI64())
+		btcKeeper.GetMinOutputAmountFunc = func(sdk.Context) btcutil.Amount { return amount }
+
+		res, err := querier.MinOutputAmount(sdk.WrapSDKContext(ctx), &types.QueryMinOutputAmountRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(amount), res.Amount)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error when the consolidation key is not found", testutils.Func(func(t *testing.T) {
+		setup()
+		signer.GetCurrentKeyIDFunc = func(sdk.Context, nexus.Chain, tss.KeyRole) (tss.KeyID, bool) { return "", false }
+
+		_, err := querier.ConsolidationAddress(sdk.WrapSDKContext(ctx), &types.QueryConsolidationAddressRequest{KeyRole: tss.MasterKey})
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error when the recipient chain is unknown", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := querier.DepositAddress(sdk.WrapSDKContext(ctx), &types.QueryDepositAddressRequest{RecipientChain: rand.StrBetween(5, 10), RecipientAddress: rand.StrBetween(10, 20)})
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error when the latest tx type is invalid", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := querier.LatestTx(sdk.WrapSDKContext(ctx), &types.QueryLatestTxRequest{TxType: types.TxType(rand.I64Between(100, 1000))})
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+// RegisterInvariants registers all bitcoin module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "confirmed-outpoints", ConfirmedOutPointsInvariant(k))
+}
+
+// ConfirmedOutPointsInvariant checks that unconfirmed amounts only belong to keys that control addresses and that
+// the confirmed outpoint queue of every key only holds confirmed outpoints that are controlled by that key
+func ConfirmedOutPointsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		addresses := k.getAddresses(ctx)
+		keyIDs := make(map[tss.KeyID]bool)
+		for _, address := range addresses {
+			keyIDs[address.KeyID] = true
+		}
+
+		unconfirmedAmounts := k.getUnconfirmedAmounts(ctx)
+		for _, unconfirmed := range unconfirmedAmounts {
+			if unconfirmed.Amount > 0 && !keyIDs[unconfirmed.KeyID] {
+				msg += fmt.Sprintf("\tunconfirmed amount %s refers to key %s without addresses\n", unconfirmed.Amount.String(), unconfirmed.KeyID)
+				broken = true
+			}
+		}
+
+		for _, queue := range k.getConfirmedOutPointQueues(ctx, addresses, unconfirmedAmounts) {
+			for _, item := range queue.Queue.Items {
+				var info types.OutPointInfo
+				if ok := k.getStore(ctx).Get(utils.KeyFromBz(item.Key), &info); !ok {
+					msg += fmt.Sprintf("\tconfirmed outpoint queue of key %s refers to unknown outpoint %s\n", queue.KeyID, string(item.Key))
+					broken = true
+					continue
+				}
+
+				address, ok := k.GetAddress(ctx, info.Address)
+				if !ok || address.KeyID != queue.KeyID {
+					msg += fmt.Sprintf("\toutpoint %s in the confirmed outpoint queue of key %s is not controlled by that key\n", info.OutPoint, queue.KeyID)
+					broken = true
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "confirmed-outpoints",
+			fmt.Sprintf("unconfirmed amounts and confirmed outpoint queues are inconsistent\n%s", msg)), broken
+	}
+}
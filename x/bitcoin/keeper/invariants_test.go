@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	bitcoinKeeper "github.com/axelarnetwork/axelar-core/x/bitcoin/keeper"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+func TestConfirmedOutPointsInvariant(t *testing.T) {
+	var (
+		ctx       sdk.Context
+		keeper    bitcoinKeeper.Keeper
+		addresses []types.AddressInfo
+	)
+
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		btcSubspace := params.NewSubspace(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"), "btc")
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		keeper = bitcoinKeeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey("btc"), btcSubspace)
+		keeper.SetParams(ctx, types.DefaultParams())
+
+		addresses = nil
+		for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+			addr, err := btcutil.NewAddressWitnessScriptHash(rand.Bytes(32), types.DefaultParams().Network.Params())
+			assert.NoError(t, err)
+
+			address := types.AddressInfo{
+				Address:      addr.EncodeAddress(),
+				Role:         types.Deposit,
+				RedeemScript: rand.Bytes(200),
+				KeyID:        tss.KeyID(rand.StrBetween(5, 20)),
+			}
+			keeper.SetAddress(ctx, address)
+			keeper.SetUnconfirmedAmount(ctx, address.KeyID, btcutil.Amount(rand.I64Between(0, 100000)))
+			addresses = append(addresses, address)
+
+			for j := 0; j < int(rand.I64Between(1, 5)); j++ {
+				outPoint := wire.NewOutPoint(&chainhash.Hash{}, uint32(rand.I64Between(0, 100)))
+				copy(outPoint.Hash[:], rand.Bytes(chainhash.HashSize))
+				keeper.SetConfirmedOutpointInfo(ctx, address.KeyID, types.NewOutPointInfo(outPoint, btcutil.Amount(rand.PosI64()), address.Address))
+			}
+		}
+	}
+
+	t.Run("should hold when queues only hold confirmed outpoints of their key", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, broken := bitcoinKeeper.ConfirmedOutPointsInvariant(keeper)(ctx)
+		assert.False(t, broken)
+	}).Repeat(20))
+
+	t.Run("should break when an unconfirmed amount belongs to an unknown key", testutils.Func(func(t *testing.T) {
+		setup()
+		keeper.SetUnconfirmedAmount(ctx, tss.KeyID(rand.StrBetween(21, 30)), btcutil.Amount(rand.PosI64()))
+
+		_, broken := bitcoinKeeper.ConfirmedOutPointsInvariant(keeper)(ctx)
+		assert.True(t, broken)
+	}).Repeat(20))
+
+	t.Run("should break when a queued outpoint is no longer confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+		var info types.OutPointInfo
+		state := keeper.GetConfirmedOutpointInfoQueueForKey(ctx, addresses[0].KeyID).ExportState()
+		assert.NotEmpty(t, state.Items)
+		assert.True(t, keeper.GetConfirmedOutpointInfoQueueForKey(ctx, addresses[0].KeyID).Dequeue(&info))
+		keeper.DeleteOutpointInfo(ctx, info.GetOutPoint())
+		keeper.GetConfirmedOutpointInfoQueueForKey(ctx, addresses[0].KeyID).ImportState(state)
+
+		_, broken := bitcoinKeeper.ConfirmedOutPointsInvariant(keeper)(ctx)
+		assert.True(t, broken)
+	}).Repeat(20))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -30,9 +31,12 @@ var (
 	unsignedTxPrefix         = utils.KeyFromStr("unsigned_tx_")
 	latestSignedTxHashPrefix = utils.KeyFromStr("latest_signed_tx_hash_")
 	unconfirmedAmountPrefix  = utils.KeyFromStr("unconfirmed_amount_")
+	feeRatePrefix            = utils.KeyFromStr("fee_rate_")
+	feeRateVotePrefix        = utils.KeyFromStr("fee_vote_")
 
-	externalKeyIDsKey        = utils.KeyFromStr("external_key_ids")
-	anyoneCanSpendAddressKey = utils.KeyFromStr("anyone_can_spend_address")
+	externalKeyIDsKey           = utils.KeyFromStr("external_key_ids")
+	anyoneCanSpendAddressKey    = utils.KeyFromStr("anyone_can_spend_address")
+	consolidationRetryHeightKey = utils.KeyFromStr("consolidation_retry_height")
 
 	confirmedOutpointQueueName = "confirmed_outpoint"
 )
@@ -202,6 +206,35 @@ func (k Keeper) GetTransactionFeeRate(ctx sdk.Context) sdk.Dec {
 	return result
 }
 
+// GetConsolidationSchedule returns the settings for creating and signing secondary key consolidations automatically
+func (k Keeper) GetConsolidationSchedule(ctx sdk.Context) types.ConsolidationSchedule {
+	var result types.ConsolidationSchedule
+	k.params.Get(ctx, types.KeyConsolidationSchedule, &result)
+
+	return result
+}
+
+// GetConsolidationRetryHeight returns the block height before which no consolidation is scheduled after a failed attempt
+func (k Keeper) GetConsolidationRetryHeight(ctx sdk.Context) int64 {
+	var height gogoprototypes.Int64Value
+	k.getStore(ctx).Get(consolidationRetryHeightKey, &height)
+
+	return height.Value
+}
+
+// SetConsolidationRetryHeight sets the block height before which no consolidation is scheduled
+func (k Keeper) SetConsolidationRetryHeight(ctx sdk.Context, height int64) {
+	k.getStore(ctx).Set(consolidationRetryHeightKey, &gogoprototypes.Int64Value{Value: height})
+}
+
+// GetFeeRateEstimation returns the settings of the fee rate estimation
+func (k Keeper) GetFeeRateEstimation(ctx sdk.Context) types.FeeRateEstimation {
+	var result types.FeeRateEstimation
+	k.params.Get(ctx, types.KeyFeeRateEstimation, &result)
+
+	return result
+}
+
 // SetAddress stores the given address information
 func (k Keeper) SetAddress(ctx sdk.Context, address types.AddressInfo) {
 	k.getStore(ctx).Set(addrPrefix.Append(utils.LowerCaseKey(address.Address)), &address)
@@ -414,3 +447,56 @@ func (k Keeper) GetExternalKeyIDs(ctx sdk.Context) ([]tss.KeyID, bool) {
 func (k Keeper) getStore(ctx sdk.Context) utils.KVStore {
 	return utils.NewNormalizedStore(ctx.KVStore(k.storeKey), k.cdc)
 }
+
+// SetFeeRate stores the fee rate for the given confirmation target
+func (k Keeper) SetFeeRate(ctx sdk.Context, confTarget int64, feeRate btcutil.Amount) {
+	k.getStore(ctx).Set(feeRatePrefix.AppendStr(strconv.FormatInt(confTarget, 10)), &types.FeeRate{ConfTarget: confTarget, FeeRate: feeRate})
+}
+
+// GetFeeRate retrieves the fee rate for the given confirmation target
+func (k Keeper) GetFeeRate(ctx sdk.Context, confTarget int64) (btcutil.Amount, bool) {
+	var result types.FeeRate
+	if ok := k.getStore(ctx).Get(feeRatePrefix.AppendStr(strconv.FormatInt(confTarget, 10)), &result); !ok {
+		return 0, false
+	}
+
+	return result.FeeRate, true
+}
+
+// GetTxFeeRate returns the fee rate in satoshi per kilo virtual byte that consolidation, master and rescue transactions pay.
+// It falls back to the minimum relay fee while the fee rate estimation is disabled or no fee rate has been voted yet
+func (k Keeper) GetTxFeeRate(ctx sdk.Context) btcutil.Amount {
+	estimation := k.GetFeeRateEstimation(ctx)
+	if estimation.VoteInterval == 0 {
+		return types.MinRelayTxFee
+	}
+
+	feeRate, ok := k.GetFeeRate(ctx, estimation.TxConfTarget)
+	switch {
+	case !ok || feeRate < estimation.MinFeeRate:
+		return estimation.MinFeeRate
+	case feeRate > estimation.MaxFeeRate:
+		return estimation.MaxFeeRate
+	default:
+		return feeRate
+	}
+}
+
+// SetFeeRateVote stores the given fee rate vote, replacing any previous vote of the same voter for the same confirmation target
+func (k Keeper) SetFeeRateVote(ctx sdk.Context, vote types.FeeRateVote) {
+	k.getStore(ctx).Set(getFeeRateVoteKey(vote.ConfTarget, vote.Voter), &vote)
+}
+
+// GetFeeRateVote retrieves the latest fee rate vote of the given voter for the given confirmation target
+func (k Keeper) GetFeeRateVote(ctx sdk.Context, confTarget int64, voter sdk.ValAddress) (types.FeeRateVote, bool) {
+	var result types.FeeRateVote
+	if ok := k.getStore(ctx).Get(getFeeRateVoteKey(confTarget, voter), &result); !ok {
+		return types.FeeRateVote{}, false
+	}
+
+	return result, true
+}
+
+func getFeeRateVoteKey(confTarget int64, voter sdk.ValAddress) utils.Key {
+	return feeRateVotePrefix.AppendStr(strconv.FormatInt(confTarget, 10)).AppendStr(voter.String())
+}
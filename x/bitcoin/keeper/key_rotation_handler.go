@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	"github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+// NewKeyRotationHandler returns the handler for keys that have been generated automatically by the tss module.
+// It creates the consolidation transaction that moves the funds of the current key to the new key.
+// Secondary key consolidations are signed right away, master key consolidations additionally require the external signatures
+func NewKeyRotationHandler(k types.BTCKeeper, signer types.Signer, n types.Nexus, v types.Voter, snapshotter types.Snapshotter) tss.KeyRotationHandler {
+	server := NewMsgServerImpl(k, signer, n, v, snapshotter)
+
+	return func(ctx sdk.Context, chain string, keyRole tss.KeyRole, keyID tss.KeyID) error {
+		if !strings.EqualFold(chain, exported.Bitcoin.Name) {
+			return nil
+		}
+
+		switch keyRole {
+		case tss.MasterKey:
+			_, err := server.CreateMasterTx(sdk.WrapSDKContext(ctx), &types.CreateMasterTxRequest{KeyID: keyID})
+			return err
+		case tss.SecondaryKey:
+			if err := CreateSecondaryConsolidationTx(ctx, k, n, signer, snapshotter, keyID, 0); err != nil {
+				return err
+			}
+
+			_, err := SignConsolidationTx(ctx, k, signer, snapshotter, v, types.SecondaryConsolidation, true)
+			return err
+		default:
+			return fmt.Errorf("%s keys cannot be rotated on chain %s", keyRole.SimpleString(), chain)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -327,7 +328,7 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 		// handle cross-chain transfer
 		depositAddr := nexus.CrossChainAddress{Address: pendingOutPointInfo.Address, Chain: exported.Bitcoin}
 		amount := sdk.NewInt64Coin(exported.Bitcoin.NativeAsset, int64(pendingOutPointInfo.Amount))
-		if err := s.nexus.EnqueueForTransfer(ctx, depositAddr, amount, s.GetTransactionFeeRate(ctx)); err != nil {
+		if err := s.nexus.EnqueueForTransfer(ctx, depositAddr, amount, s.GetTransactionFeeRate(ctx), pendingOutPointInfo.OutPoint); err != nil {
 			return nil, sdkerrors.Wrap(err, "cross-chain transfer failed")
 		}
 
@@ -357,6 +358,70 @@ func (s msgServer) VoteConfirmOutpoint(c context.Context, req *types.VoteConfirm
 	}
 }
 
+// VoteFeeRate handles the votes on the fee rate for a confirmation target
+func (s msgServer) VoteFeeRate(c context.Context, req *types.VoteFeeRateRequest) (*types.VoteFeeRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := validateChainActivated(ctx, s.nexus, exported.Bitcoin); err != nil {
+		return nil, err
+	}
+
+	estimation := s.GetFeeRateEstimation(ctx)
+	if estimation.VoteInterval == 0 {
+		return nil, fmt.Errorf("fee rate estimation is disabled")
+	}
+
+	if !estimation.HasConfTarget(req.ConfTarget) {
+		return nil, fmt.Errorf("confirmation target %d is not estimated", req.ConfTarget)
+	}
+
+	voter := s.snapshotter.GetOperator(ctx, req.Sender)
+	if voter == nil {
+		return nil, fmt.Errorf("account %v is not registered as a validator proxy", req.Sender.String())
+	}
+
+	maintainers := s.nexus.GetChainMaintainers(ctx, exported.Bitcoin)
+	if !isMaintainer(maintainers, voter) {
+		return nil, fmt.Errorf("validator %s is not a maintainer of chain %s", voter.String(), exported.Bitcoin.Name)
+	}
+
+	s.SetFeeRateVote(ctx, types.FeeRateVote{Voter: voter, ConfTarget: req.ConfTarget, FeeRate: req.FeeRate, Height: ctx.BlockHeight()})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFeeRate,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueVoted),
+		sdk.NewAttribute(types.AttributeKeyConfTarget, strconv.FormatInt(req.ConfTarget, 10)),
+		sdk.NewAttribute(types.AttributeKeyValue, strconv.FormatInt(int64(req.FeeRate), 10)),
+	))
+
+	// only votes of current chain maintainers that have not expired count towards the median
+	var feeRates []btcutil.Amount
+	for _, maintainer := range maintainers {
+		vote, ok := s.GetFeeRateVote(ctx, req.ConfTarget, maintainer)
+		if !ok || ctx.BlockHeight()-vote.Height > estimation.VoteExpiry {
+			continue
+		}
+
+		feeRates = append(feeRates, vote.FeeRate)
+	}
+
+	if int64(len(feeRates)) < s.GetMinVoterCount(ctx) {
+		return &types.VoteFeeRateResponse{Status: fmt.Sprintf("not enough votes to update the fee rate for confirmation target %d yet", req.ConfTarget)}, nil
+	}
+
+	feeRate := getMedianFeeRate(feeRates)
+	s.SetFeeRate(ctx, req.ConfTarget, feeRate)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFeeRate,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueUpdate),
+		sdk.NewAttribute(types.AttributeKeyConfTarget, strconv.FormatInt(req.ConfTarget, 10)),
+		sdk.NewAttribute(types.AttributeKeyValue, strconv.FormatInt(int64(feeRate), 10)),
+	))
+
+	return &types.VoteFeeRateResponse{Status: fmt.Sprintf("fee rate for confirmation target %d updated to %s/kvB", req.ConfTarget, feeRate.String())}, nil
+}
+
 func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.SignTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -364,26 +429,37 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 		return nil, err
 	}
 
-	unsignedTx, ok := s.GetUnsignedTx(ctx, req.TxType)
+	pos, err := SignConsolidationTx(ctx, s, s.signer, s.snapshotter, s.voter, req.TxType, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignTxResponse{Position: pos}, nil
+}
+
+// SignConsolidationTx starts signing the unsigned transaction of the given type and returns its position in the sign queue.
+// Consolidations that are part of key management, e.g. handing over funds to an automatically rotated key, are signed with high priority
+func SignConsolidationTx(ctx sdk.Context, k types.BTCKeeper, signer types.Signer, snapshotter types.Snapshotter, voter types.InitPoller, txType types.TxType, keyManagement bool) (int64, error) {
+	unsignedTx, ok := k.GetUnsignedTx(ctx, txType)
 	if !ok || (!unsignedTx.Is(types.Created) && !unsignedTx.Is(types.Aborted)) {
-		return nil, fmt.Errorf("no unsigned %s tx ready for signing", req.TxType.SimpleString())
+		return 0, fmt.Errorf("no unsigned %s tx ready for signing", txType.SimpleString())
 	}
 
-	s.Logger(ctx).Debug(fmt.Sprintf("signing %s transaction", req.TxType.SimpleString()))
+	k.Logger(ctx).Debug(fmt.Sprintf("signing %s transaction", txType.SimpleString()))
 
 	var maxLockTime *time.Time
 	var outPointsToSign []types.OutPointToSign
 
 	for _, txIn := range unsignedTx.GetTx().TxIn {
 		outPointStr := txIn.PreviousOutPoint.String()
-		outPointInfo, state, ok := s.BTCKeeper.GetOutPointInfo(ctx, txIn.PreviousOutPoint)
+		outPointInfo, state, ok := k.GetOutPointInfo(ctx, txIn.PreviousOutPoint)
 		if !ok || state != types.OutPointState_Spent {
-			return nil, fmt.Errorf("out point info %s is not found or not spent", outPointStr)
+			return 0, fmt.Errorf("out point info %s is not found or not spent", outPointStr)
 		}
 
-		addressInfo, ok := s.BTCKeeper.GetAddress(ctx, outPointInfo.Address)
+		addressInfo, ok := k.GetAddress(ctx, outPointInfo.Address)
 		if !ok {
-			return nil, fmt.Errorf("address for outpoint %s must be known", outPointStr)
+			return 0, fmt.Errorf("address for outpoint %s must be known", outPointStr)
 		}
 
 		outPointsToSign = append(outPointsToSign, types.OutPointToSign{OutPointInfo: outPointInfo, AddressInfo: addressInfo})
@@ -401,18 +477,18 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 	case maxLockTime != nil && maxLockTime.After(ctx.BlockTime()):
 		externalSigsRequired = true
 
-		s.Logger(ctx).Debug(fmt.Sprintf("%s  transaction requires external signatures", req.TxType.SimpleString()))
+		k.Logger(ctx).Debug(fmt.Sprintf("%s  transaction requires external signatures", txType.SimpleString()))
 		fallthrough
 	// when no UTXO is locked
 	case maxLockTime == nil:
 		tx = types.DisableTimelock(tx)
 
-		s.Logger(ctx).Debug(fmt.Sprintf("disabled lock time on %s  transaction", req.TxType.SimpleString()))
+		k.Logger(ctx).Debug(fmt.Sprintf("disabled lock time on %s  transaction", txType.SimpleString()))
 	// when all UTXOs can be spent without the external key
 	default:
 		tx = types.EnableTimelock(tx, uint32(maxLockTime.Unix()))
 
-		s.Logger(ctx).Debug(fmt.Sprintf("enabled lock time as %d on %s  transaction", tx.LockTime, req.TxType.SimpleString()))
+		k.Logger(ctx).Debug(fmt.Sprintf("enabled lock time as %d on %s  transaction", tx.LockTime, txType.SimpleString()))
 	}
 
 	var sigHashes [][]byte
@@ -422,7 +498,7 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 	for i, outPointToSign := range outPointsToSign {
 		sigHash, err := txscript.CalcWitnessSigHash(outPointToSign.RedeemScript, txscript.NewTxSigHashes(tx), txscript.SigHashAll, tx, i, int64(outPointToSign.Amount))
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 
 		sigHashes = append(sigHashes, sigHash)
@@ -453,7 +529,7 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 					break
 				}
 
-				if _, status := s.signer.GetSig(ctx, getSigID(sigHash, externalKeyID)); status == tss.SigStatus_Signed {
+				if _, status := signer.GetSig(ctx, getSigID(sigHash, externalKeyID)); status == tss.SigStatus_Signed {
 					existingExternalSigCount++
 					unsignedTx.Info.InputInfos[i].SigRequirements = append(
 						unsignedTx.Info.InputInfos[i].SigRequirements,
@@ -464,10 +540,15 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 			}
 
 			if existingExternalSigCount < requiredExternalSigCount {
-				return nil, fmt.Errorf("not enough external signatures have been submitted yet for sig hash %s", hex.EncodeToString(sigHash))
+				return 0, fmt.Errorf("not enough external signatures have been submitted yet for sig hash %s", hex.EncodeToString(sigHash))
 			}
 		}
 	}
+	priority := tss.SignPriority_Normal
+	if keyManagement {
+		priority = tss.SignPriority_High
+	}
+
 	// track sign info position in queue
 	pos := int64(0)
 	var err error
@@ -475,46 +556,49 @@ func (s msgServer) SignTx(c context.Context, req *types.SignTxRequest) (*types.S
 		for _, sigRequirement := range inputInfo.SigRequirements {
 			sigID := getSigID(sigRequirement.SigHash, sigRequirement.KeyID)
 			// if the signature already exists, ignore it
-			if _, status := s.signer.GetSig(ctx, sigID); status == tss.SigStatus_Signed {
-				s.Logger(ctx).Debug(fmt.Sprintf("signature %s for %s transaction exists already and therefore skipping", req.TxType.SimpleString(), sigID))
+			if _, status := signer.GetSig(ctx, sigID); status == tss.SigStatus_Signed {
+				k.Logger(ctx).Debug(fmt.Sprintf("signature %s for %s transaction exists already and therefore skipping", txType.SimpleString(), sigID))
 				continue
 			}
 
-			counter, ok := s.signer.GetSnapshotCounterForKeyID(ctx, sigRequirement.KeyID)
+			counter, ok := signer.GetSnapshotCounterForKeyID(ctx, sigRequirement.KeyID)
 			if !ok {
-				return nil, fmt.Errorf("no snapshot counter for key ID %s registered", sigRequirement.KeyID)
+				return 0, fmt.Errorf("no snapshot counter for key ID %s registered", sigRequirement.KeyID)
 			}
 
-			snapshot, ok := s.snapshotter.GetSnapshot(ctx, counter)
+			snapshot, ok := snapshotter.GetSnapshot(ctx, counter)
 			if !ok {
-				return nil, fmt.Errorf("no snapshot found for counter num %d", counter)
+				return 0, fmt.Errorf("no snapshot found for counter num %d", counter)
 			}
 
-			err = s.signer.StartSign(ctx, tss.SignInfo{
+			err = signer.StartSign(ctx, tss.SignInfo{
 				KeyID:           sigRequirement.KeyID,
 				SigID:           sigID,
 				Msg:             sigRequirement.SigHash,
 				SnapshotCounter: snapshot.Counter,
 				RequestModule:   types.ModuleName,
 				Metadata:        "",
-			}, s.snapshotter, s.voter)
+				Priority:        priority,
+				RequestChain:    exported.Bitcoin.Name,
+				KeyManagement:   keyManagement,
+			}, snapshotter, voter)
 			if err != nil {
-				return nil, err
+				return 0, err
 			}
 		}
 	}
 
 	unsignedTx.SetTx(tx)
 	unsignedTx.Status = types.Signing
-	s.SetUnsignedTx(ctx, unsignedTx)
+	k.SetUnsignedTx(ctx, unsignedTx)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsolidationTx,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueSigning),
-		sdk.NewAttribute(types.AttributeTxType, req.TxType.SimpleString()),
+		sdk.NewAttribute(types.AttributeTxType, txType.SimpleString()),
 	))
 
-	return &types.SignTxResponse{Position: pos}, nil
+	return pos, nil
 }
 
 // CreateRescueTx creates a rescue transaction
@@ -581,8 +665,8 @@ func (s msgServer) CreateRescueTx(c context.Context, req *types.CreateRescueTxRe
 	}
 
 	outputsTotal := types.GetOutputsTotal(*tx)
-	// rescue transactions always pay 1 satoshi/byte, which is the default minimum relay fee rate bitcoin-core sets
-	fee := sdk.NewInt(txSizeUpperBound).MulRaw(types.MinRelayTxFeeSatoshiPerByte)
+	// rescue transactions pay the fee rate validators voted for, bounded by the fee rate estimation params
+	fee := types.EstimateTxFee(txSizeUpperBound, s.GetTxFeeRate(ctx))
 	change := inputsTotal.SubRaw(int64(outputsTotal)).Sub(fee)
 
 	if !change.IsPositive() {
@@ -697,8 +781,8 @@ func (s msgServer) CreateMasterTx(c context.Context, req *types.CreateMasterTxRe
 	}
 
 	outputsTotal := types.GetOutputsTotal(*tx)
-	// consolidation transactions always pay 1 satoshi/byte, which is the default minimum relay fee rate bitcoin-core sets
-	fee := sdk.NewInt(txSizeUpperBound).MulRaw(types.MinRelayTxFeeSatoshiPerByte)
+	// consolidation transactions pay the fee rate validators voted for, bounded by the fee rate estimation params
+	fee := types.EstimateTxFee(txSizeUpperBound, s.GetTxFeeRate(ctx))
 	change := inputsTotal.SubRaw(int64(outputsTotal)).Sub(fee)
 
 	if !change.IsPositive() {
@@ -758,89 +842,100 @@ func (s msgServer) CreatePendingTransfersTx(c context.Context, req *types.Create
 		return nil, err
 	}
 
-	masterMin := s.GetMinOutputAmount(ctx)
-	if req.MasterKeyAmount > 0 && req.MasterKeyAmount < masterMin {
-		return nil, fmt.Errorf("cannot transfer %d to the master key, it is below the minimum amount of %d", req.MasterKeyAmount, masterMin)
+	if err := CreateSecondaryConsolidationTx(ctx, s, s.nexus, s.signer, s.snapshotter, req.KeyID, req.MasterKeyAmount); err != nil {
+		return nil, err
 	}
 
-	if _, ok := s.GetUnsignedTx(ctx, types.SecondaryConsolidation); ok {
-		return nil, fmt.Errorf("consolidation in progress")
+	return &types.CreatePendingTransfersTxResponse{}, nil
+}
+
+// CreateSecondaryConsolidationTx creates a transaction that withdraws all pending transfers and consolidates the
+// remaining funds of the current secondary key to the given key
+func CreateSecondaryConsolidationTx(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, signer types.Signer, snapshotter types.Snapshotter, keyID tss.KeyID, masterKeyAmount btcutil.Amount) error {
+	masterMin := k.GetMinOutputAmount(ctx)
+	if masterKeyAmount > 0 && masterKeyAmount < masterMin {
+		return fmt.Errorf("cannot transfer %d to the master key, it is below the minimum amount of %d", masterKeyAmount, masterMin)
 	}
 
-	consolidationKey, ok := s.signer.GetKey(ctx, req.KeyID)
+	if _, ok := k.GetUnsignedTx(ctx, types.SecondaryConsolidation); ok {
+		return fmt.Errorf("consolidation in progress")
+	}
+
+	consolidationKey, ok := signer.GetKey(ctx, keyID)
 	if !ok {
-		return nil, fmt.Errorf("unkown key %s", req.KeyID)
+		return fmt.Errorf("unkown key %s", keyID)
 	}
 
-	currSecondaryKey, ok := s.signer.GetCurrentKey(ctx, exported.Bitcoin, tss.SecondaryKey)
+	currSecondaryKey, ok := signer.GetCurrentKey(ctx, exported.Bitcoin, tss.SecondaryKey)
 	if !ok {
-		return nil, fmt.Errorf("current %s key is not set", tss.SecondaryKey.SimpleString())
+		return fmt.Errorf("current %s key is not set", tss.SecondaryKey.SimpleString())
 	}
 
 	tx := types.CreateTx()
 
-	inputsTotal, err := addInputs(ctx, s.BTCKeeper, tx, currSecondaryKey.ID)
+	inputsTotal, err := addInputs(ctx, k, tx, currSecondaryKey.ID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := types.AddOutput(tx, s.BTCKeeper.GetAnyoneCanSpendAddress(ctx).GetAddress(), s.BTCKeeper.GetMinOutputAmount(ctx)); err != nil {
-		return nil, err
+	if err := types.AddOutput(tx, k.GetAnyoneCanSpendAddress(ctx).GetAddress(), k.GetMinOutputAmount(ctx)); err != nil {
+		return err
 	}
 	anyoneCanSpendVout := uint32(0)
 
-	if req.MasterKeyAmount > 0 {
+	if masterKeyAmount > 0 {
 		var key tss.Key
 
-		if nextKey, nextKeyFound := s.signer.GetNextKey(ctx, exported.Bitcoin, tss.MasterKey); nextKeyFound {
+		if nextKey, nextKeyFound := signer.GetNextKey(ctx, exported.Bitcoin, tss.MasterKey); nextKeyFound {
 			key = nextKey
-		} else if currKey, currKeyFound := s.signer.GetCurrentKey(ctx, exported.Bitcoin, tss.MasterKey); currKeyFound {
+		} else if currKey, currKeyFound := signer.GetCurrentKey(ctx, exported.Bitcoin, tss.MasterKey); currKeyFound {
 			key = currKey
 		} else {
-			return nil, fmt.Errorf("%s key not set", tss.MasterKey.SimpleString())
+			return fmt.Errorf("%s key not set", tss.MasterKey.SimpleString())
 		}
 
-		masterAddress, err := getMasterConsolidationAddress(ctx, s.BTCKeeper, s.signer, key)
+		masterAddress, err := getMasterConsolidationAddress(ctx, k, signer, key)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		if err := types.AddOutput(tx, masterAddress.GetAddress(), btcutil.Amount(req.MasterKeyAmount)); err != nil {
-			return nil, err
+		if err := types.AddOutput(tx, masterAddress.GetAddress(), btcutil.Amount(masterKeyAmount)); err != nil {
+			return err
 		}
-		s.SetAddress(ctx, masterAddress)
+		k.SetAddress(ctx, masterAddress)
 	}
 
-	consolidationAddress, err := getSecondaryConsolidationAddress(ctx, s.BTCKeeper, consolidationKey)
+	consolidationAddress, err := getSecondaryConsolidationAddress(ctx, k, consolidationKey)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := addWithdrawalOutputs(ctx, s.BTCKeeper, s.nexus, tx, consolidationAddress.GetAddress()); err != nil {
-		return nil, err
+	withdrawnTransfers, err := addWithdrawalOutputs(ctx, k, n, tx, consolidationAddress.GetAddress())
+	if err != nil {
+		return err
 	}
 
-	txSizeUpperBound, err := estimateTxSizeWithOutputsTo(ctx, s, *tx, consolidationAddress.GetAddress())
+	txSizeUpperBound, err := estimateTxSizeWithOutputsTo(ctx, k, *tx, consolidationAddress.GetAddress())
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	outputsTotal := types.GetOutputsTotal(*tx)
-	// consolidation transactions always pay 1 satoshi/byte, which is the default minimum relay fee rate bitcoin-core sets
-	fee := sdk.NewInt(txSizeUpperBound).MulRaw(types.MinRelayTxFeeSatoshiPerByte)
+	// consolidation transactions pay the fee rate validators voted for, bounded by the fee rate estimation params
+	fee := types.EstimateTxFee(txSizeUpperBound, k.GetTxFeeRate(ctx))
 	change := inputsTotal.SubRaw(int64(outputsTotal)).Sub(fee)
 
 	if !change.IsPositive() {
-		return nil, fmt.Errorf("not enough deposits (%s) to make all withdrawals (%s) with a transaction fee of %s",
+		return fmt.Errorf("not enough deposits (%s) to make all withdrawals (%s) with a transaction fee of %s",
 			inputsTotal.String(), outputsTotal.String(), btcutil.Amount(fee.Int64()).String(),
 		)
 	}
 
 	if err := types.AddOutput(tx, consolidationAddress.GetAddress(), btcutil.Amount(change.Int64())); err != nil {
-		return nil, err
+		return err
 	}
 
-	s.SetAddress(ctx, consolidationAddress)
+	k.SetAddress(ctx, consolidationAddress)
 	telemetry.SetGaugeWithLabels(
 		[]string{types.ModuleName, "secondary", "address", "balance"},
 		float32(change.Int64()),
@@ -850,16 +945,21 @@ func (s msgServer) CreatePendingTransfersTx(c context.Context, req *types.Create
 		})
 
 	tx = types.DisableTimelock(tx)
-	unsignedTx := types.NewUnsignedTx(types.SecondaryConsolidation, tx, anyoneCanSpendVout, req.MasterKeyAmount)
+	// the tx hash does not change anymore once the tx is complete, because signatures are only added to the witness
+	for _, transfer := range withdrawnTransfers {
+		n.ArchivePendingTransfer(ctx, transfer, tx.TxHash().String())
+	}
+
+	unsignedTx := types.NewUnsignedTx(types.SecondaryConsolidation, tx, anyoneCanSpendVout, masterKeyAmount)
 	// If consolidating to a new key, that key has to be eligible for the role
 	if currSecondaryKey.ID != consolidationKey.ID {
-		if err := validateKeyAssignment(ctx, s.BTCKeeper, s.signer, s.snapshotter, currSecondaryKey, consolidationKey); err != nil {
-			return nil, err
+		if err := validateKeyAssignment(ctx, k, signer, snapshotter, currSecondaryKey, consolidationKey); err != nil {
+			return err
 		}
 
 		unsignedTx.Info.RotateKey = true
-		if err := s.signer.AssignNextKey(ctx, exported.Bitcoin, consolidationKey.Role, consolidationKey.ID); err != nil {
-			return nil, err
+		if err := signer.AssignNextKey(ctx, exported.Bitcoin, consolidationKey.Role, consolidationKey.ID); err != nil {
+			return err
 		}
 
 		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeKey,
@@ -870,7 +970,7 @@ func (s msgServer) CreatePendingTransfersTx(c context.Context, req *types.Create
 		))
 	}
 
-	s.SetUnsignedTx(ctx, unsignedTx)
+	k.SetUnsignedTx(ctx, unsignedTx)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsolidationTx,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -878,7 +978,7 @@ func (s msgServer) CreatePendingTransfersTx(c context.Context, req *types.Create
 		sdk.NewAttribute(types.AttributeTxType, types.SecondaryConsolidation.SimpleString()),
 	))
 
-	return &types.CreatePendingTransfersTxResponse{}, nil
+	return nil
 }
 
 func getExternalKeys(ctx sdk.Context, k types.BTCKeeper, signer types.Signer) ([]tss.Key, error) {
@@ -927,14 +1027,26 @@ func estimateTxSizeWithOutputsTo(ctx sdk.Context, k types.BTCKeeper, tx wire.Msg
 	return types.EstimateTxSize(tx, outPointsToSign), nil
 }
 
-func addWithdrawalOutputs(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, tx *wire.MsgTx, changeAddress btcutil.Address) error {
+// addWithdrawalOutputs adds outputs for pending transfers to the given tx and returns the transfers that are withdrawn by it
+func addWithdrawalOutputs(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, tx *wire.MsgTx, changeAddress btcutil.Address) ([]nexus.CrossChainTransfer, error) {
+	var withdrawnTransfers []nexus.CrossChainTransfer
 	total := sdk.ZeroInt()
 	outputCount := 0
 	minAmount := sdk.NewInt(int64(k.GetMinOutputAmount(ctx)))
-	pendingTransfers := n.GetTransfersForChain(ctx, exported.Bitcoin, nexus.Pending)
 	network := k.GetNetwork(ctx).Params()
 	maxTxSize := k.GetMaxTxSize(ctx)
 
+	var pendingTransfers []nexus.CrossChainTransfer
+	for _, transfer := range n.GetTransfersForChain(ctx, exported.Bitcoin, nexus.Pending) {
+		// frozen transfers stay pending until the freeze on both the source and the destination chain is lifted
+		if n.IsTransferFrozen(ctx, exported.Bitcoin, transfer.Asset.Denom) || n.IsTransferFrozen(ctx, transfer.Sender.Chain, transfer.Asset.Denom) {
+			k.Logger(ctx).Debug(fmt.Sprintf("transfer %d is frozen, skipping withdrawal", transfer.ID))
+			continue
+		}
+
+		pendingTransfers = append(pendingTransfers, transfer)
+	}
+
 	addressToTransfers := make(map[string][]nexus.CrossChainTransfer)
 	for _, transfer := range pendingTransfers {
 		recipient, err := btcutil.DecodeAddress(transfer.Recipient.Address, network)
@@ -982,21 +1094,19 @@ func addWithdrawalOutputs(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, tx
 		}
 
 		if txSize, err := estimateTxSizeWithOutputsTo(ctx, k, *tx, recipient, changeAddress); err != nil {
-			return err
+			return nil, err
 		} else if txSize > maxTxSize {
 			// stop if transaction size is above the limit after adding the ouput
 			break
 		}
 
-		for _, transfer := range addressToTransfers[encodedAddress] {
-			n.ArchivePendingTransfer(ctx, transfer)
-		}
+		withdrawnTransfers = append(withdrawnTransfers, addressToTransfers[encodedAddress]...)
 
 		total = total.Add(amount)
 		outputCount++
 
 		if err := types.AddOutput(tx, recipient, btcutil.Amount(amount.Int64())); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -1007,7 +1117,7 @@ func addWithdrawalOutputs(ctx sdk.Context, k types.BTCKeeper, n types.Nexus, tx
 		k.Logger(ctx).Info("creating consolidation transaction without any withdrawals")
 	}
 
-	return nil
+	return withdrawnTransfers, nil
 }
 
 func addInputs(ctx sdk.Context, k types.BTCKeeper, tx *wire.MsgTx, keyID tss.KeyID) (sdk.Int, error) {
@@ -1188,3 +1298,27 @@ func validateChainActivated(ctx sdk.Context, n types.Nexus, chain nexus.Chain) e
 
 	return nil
 }
+
+func isMaintainer(maintainers []sdk.ValAddress, validator sdk.ValAddress) bool {
+	for _, maintainer := range maintainers {
+		if maintainer.Equals(validator) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getMedianFeeRate returns the median of the given non-empty list of fee rates, rounded down if the list has even length
+func getMedianFeeRate(feeRates []btcutil.Amount) btcutil.Amount {
+	sorted := make([]btcutil.Amount, len(feeRates))
+	copy(sorted, feeRates)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+
+	return sorted[mid]
+}
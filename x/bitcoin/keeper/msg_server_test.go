@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	mathRand "math/rand"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
@@ -15,13 +16,16 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	gogoprototypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
 	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	"github.com/axelarnetwork/axelar-core/utils"
 	utilsmock "github.com/axelarnetwork/axelar-core/utils/mock"
@@ -319,7 +323,7 @@ func TestHandleMsgVoteConfirmOutpoint(t *testing.T) {
 			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool {
 				return chain == exported.Bitcoin
 			},
-			EnqueueForTransferFunc: func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec) error { return nil },
+			EnqueueForTransferFunc: func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec, string) error { return nil },
 			GetRecipientFunc: func(ctx sdk.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
 				return nexus.CrossChainAddress{Chain: nexus.Chain{}, Address: ""}, true
 			},
@@ -564,7 +568,7 @@ func TestHandleMsgVoteConfirmOutpoint(t *testing.T) {
 
 	t.Run("enqueue transfer failed", testutils.Func(func(t *testing.T) {
 		setup()
-		nexusKeeper.EnqueueForTransferFunc = func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec) error {
+		nexusKeeper.EnqueueForTransferFunc = func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, sdk.Dec, string) error {
 			return fmt.Errorf("failed")
 		}
 
@@ -608,6 +612,7 @@ func TestCreateRescueTx(t *testing.T) {
 			GetUnsignedTxFunc: func(ctx sdk.Context, txType types.TxType) (types.UnsignedTx, bool) {
 				return types.UnsignedTx{}, false
 			},
+			GetTxFeeRateFunc: func(sdk.Context) btcutil.Amount { return types.MinRelayTxFee },
 			GetConfirmedOutpointInfoQueueForKeyFunc: func(ctx sdk.Context, keyID tss.KeyID) utils.KVQueue {
 				return &utilsmock.KVQueueMock{
 					IsEmptyFunc: func() bool { return true },
@@ -997,6 +1002,7 @@ func TestCreateMasterTx(t *testing.T) {
 				return types.DefaultParams().Network
 			},
 			GetMaxTxSizeFunc: func(ctx sdk.Context) int64 { return types.DefaultParams().MaxTxSize },
+			GetTxFeeRateFunc: func(sdk.Context) btcutil.Amount { return types.MinRelayTxFee },
 			GetAddressFunc: func(_ sdk.Context, encodedAddress string) (types.AddressInfo, bool) {
 				return types.AddressInfo{
 					Address:      encodedAddress,
@@ -1490,6 +1496,7 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 				return types.DefaultParams().Network
 			},
 			GetMaxTxSizeFunc: func(ctx sdk.Context) int64 { return types.DefaultParams().MaxTxSize },
+			GetTxFeeRateFunc: func(sdk.Context) btcutil.Amount { return types.MinRelayTxFee },
 			GetAddressFunc: func(_ sdk.Context, encodedAddress string) (types.AddressInfo, bool) {
 				return types.AddressInfo{
 					Address:      encodedAddress,
@@ -1517,7 +1524,8 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 
 				return []nexus.CrossChainTransfer{}
 			},
-			ArchivePendingTransferFunc: func(ctx sdk.Context, transfer nexus.CrossChainTransfer) {},
+			ArchivePendingTransferFunc: func(sdk.Context, nexus.CrossChainTransfer, string) {},
+			IsTransferFrozenFunc:       func(sdk.Context, nexus.Chain, string) bool { return false },
 		}
 		signerKeeper = &mock.SignerMock{
 			GetExternalMultisigThresholdFunc: func(ctx sdk.Context) utils.Threshold {
@@ -1666,6 +1674,9 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 		assert.Equal(t, expectedSecondaryConsolidationAddress.Address, btcKeeper.SetAddressCalls()[0].Address.Address)
 		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), len(transfers))
 		actualUnsignedTx := btcKeeper.SetUnsignedTxCalls()[0].Tx
+		for _, call := range nexusKeeper.ArchivePendingTransferCalls() {
+			assert.Equal(t, actualUnsignedTx.GetTx().TxHash().String(), call.ExecutionID)
+		}
 		assert.Equal(t, types.SecondaryConsolidation, actualUnsignedTx.Type)
 		assert.Len(t, actualUnsignedTx.GetTx().TxIn, len(inputs))
 		for i, txIn := range actualUnsignedTx.GetTx().TxIn {
@@ -1899,6 +1910,45 @@ func TestCreatePendingTransfersTx(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot assign the next secondary key while a master transaction is sending coin to the current secondary address")
 	}))
+
+	t.Run("should keep transfers from a frozen source chain pending", testutils.Func(func(t *testing.T) {
+		setup()
+
+		frozenChain := nexus.Chain{Name: rand.StrBetween(5, 10), NativeAsset: rand.StrBetween(5, 10), SupportsForeignAssets: true}
+		otherChain := nexus.Chain{Name: rand.StrBetween(11, 20), NativeAsset: rand.StrBetween(5, 10), SupportsForeignAssets: true}
+		var unfrozen []nexus.CrossChainTransfer
+		for i := range transfers {
+			if i%2 == 0 {
+				transfers[i].Sender = nexus.CrossChainAddress{Chain: frozenChain, Address: rand.StrBetween(10, 20)}
+				continue
+			}
+
+			transfers[i].Sender = nexus.CrossChainAddress{Chain: otherChain, Address: rand.StrBetween(10, 20)}
+			unfrozen = append(unfrozen, transfers[i])
+		}
+		nexusKeeper.IsTransferFrozenFunc = func(_ sdk.Context, chain nexus.Chain, _ string) bool { return chain.Name == frozenChain.Name }
+
+		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), string(secondaryKey.ID), 0)
+		_, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), req)
+		assert.NoError(t, err)
+
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), len(unfrozen))
+		for i, call := range nexusKeeper.ArchivePendingTransferCalls() {
+			assert.Equal(t, unfrozen[i].ID, call.Transfer.ID)
+		}
+	}))
+
+	t.Run("should keep all transfers pending when bitcoin is frozen", testutils.Func(func(t *testing.T) {
+		setup()
+
+		nexusKeeper.IsTransferFrozenFunc = func(_ sdk.Context, chain nexus.Chain, _ string) bool { return chain == exported.Bitcoin }
+
+		req := types.NewCreatePendingTransfersTxRequest(rand.AccAddr(), string(secondaryKey.ID), 0)
+		_, err := server.CreatePendingTransfersTx(sdk.WrapSDKContext(ctx), req)
+		assert.NoError(t, err)
+
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+	}))
 }
 
 func assertTxOutputs(t *testing.T, tx *wire.MsgTx, outputs ...types.Output) {
@@ -2025,3 +2075,136 @@ func randomAddress() *btcutil.AddressWitnessScriptHash {
 	}
 	return addr
 }
+
+func TestVoteFeeRate(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		keeper      bitcoinKeeper.Keeper
+		server      types.MsgServiceServer
+		maintainers []sdk.ValAddress
+		proxies     map[string]sdk.ValAddress
+		confTarget  int64
+	)
+
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		btcSubspace := params.NewSubspace(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"), "btc")
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.I64Between(1000, 1000000)}, false, log.TestingLogger())
+		keeper = bitcoinKeeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey("btc"), btcSubspace)
+		keeper.SetParams(ctx, types.DefaultParams())
+
+		estimation := types.DefaultParams().FeeRateEstimation
+		confTarget = estimation.ConfTargets[rand.I64Between(0, int64(len(estimation.ConfTargets)))]
+
+		maintainers = nil
+		proxies = make(map[string]sdk.ValAddress)
+		for i := 0; i < int(rand.I64Between(1, 20)); i++ {
+			maintainer := rand.ValAddr()
+			maintainers = append(maintainers, maintainer)
+			proxies[rand.AccAddr().String()] = maintainer
+		}
+
+		nexusKeeper := &mock.NexusMock{
+			IsChainActivatedFunc:    func(sdk.Context, nexus.Chain) bool { return true },
+			GetChainMaintainersFunc: func(sdk.Context, nexus.Chain) []sdk.ValAddress { return maintainers },
+		}
+		snapshotter := &mock.SnapshotterMock{
+			GetOperatorFunc: func(_ sdk.Context, proxy sdk.AccAddress) sdk.ValAddress { return proxies[proxy.String()] },
+		}
+
+		server = bitcoinKeeper.NewMsgServerImpl(keeper, &mock.SignerMock{}, nexusKeeper, &mock.VoterMock{}, snapshotter)
+	}
+
+	proxyOf := func(validator sdk.ValAddress) sdk.AccAddress {
+		for proxy, operator := range proxies {
+			if operator.Equals(validator) {
+				addr, err := sdk.AccAddressFromBech32(proxy)
+				assert.NoError(t, err)
+				return addr
+			}
+		}
+
+		panic("unknown validator")
+	}
+
+	repeats := 20
+
+	t.Run("should set the fee rate to the median of all votes", testutils.Func(func(t *testing.T) {
+		setup()
+
+		var feeRates []int64
+		for _, maintainer := range maintainers {
+			feeRate := rand.I64Between(1000, 100000)
+			feeRates = append(feeRates, feeRate)
+
+			_, err := server.VoteFeeRate(sdk.WrapSDKContext(ctx), types.NewVoteFeeRateRequest(proxyOf(maintainer), confTarget, btcutil.Amount(feeRate)))
+			assert.NoError(t, err)
+		}
+
+		sort.Slice(feeRates, func(i, j int) bool { return feeRates[i] < feeRates[j] })
+		expected := feeRates[len(feeRates)/2]
+		if len(feeRates)%2 == 0 {
+			expected = (feeRates[len(feeRates)/2-1] + feeRates[len(feeRates)/2]) / 2
+		}
+
+		actual, ok := keeper.GetFeeRate(ctx, confTarget)
+		assert.True(t, ok)
+		assert.Equal(t, btcutil.Amount(expected), actual)
+	}).Repeat(repeats))
+
+	t.Run("should ignore expired votes", testutils.Func(func(t *testing.T) {
+		setup()
+
+		expiredMaintainer := maintainers[0]
+		_, err := server.VoteFeeRate(sdk.WrapSDKContext(ctx), types.NewVoteFeeRateRequest(proxyOf(expiredMaintainer), confTarget, btcutil.Amount(1000000)))
+		assert.NoError(t, err)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.DefaultParams().FeeRateEstimation.VoteExpiry + 1)
+		feeRate := btcutil.Amount(rand.I64Between(1000, 100000))
+		for _, maintainer := range maintainers[1:] {
+			_, err := server.VoteFeeRate(sdk.WrapSDKContext(ctx), types.NewVoteFeeRateRequest(proxyOf(maintainer), confTarget, feeRate))
+			assert.NoError(t, err)
+		}
+
+		actual, ok := keeper.GetFeeRate(ctx, confTarget)
+		assert.True(t, ok)
+		if len(maintainers) == 1 {
+			assert.Equal(t, btcutil.Amount(1000000), actual)
+		} else {
+			assert.Equal(t, feeRate, actual)
+		}
+	}).Repeat(repeats))
+
+	t.Run("should bound the tx fee rate", testutils.Func(func(t *testing.T) {
+		setup()
+
+		estimation := types.DefaultParams().FeeRateEstimation
+		assert.Equal(t, estimation.MinFeeRate, keeper.GetTxFeeRate(ctx))
+
+		keeper.SetFeeRate(ctx, estimation.TxConfTarget, estimation.MaxFeeRate+btcutil.Amount(rand.PosI64()%1000000))
+		assert.Equal(t, estimation.MaxFeeRate, keeper.GetTxFeeRate(ctx))
+
+		keeper.SetFeeRate(ctx, estimation.TxConfTarget, estimation.MinFeeRate-1)
+		assert.Equal(t, estimation.MinFeeRate, keeper.GetTxFeeRate(ctx))
+	}).Repeat(repeats))
+
+	t.Run("should reject votes of validators that are not chain maintainers", testutils.Func(func(t *testing.T) {
+		setup()
+
+		proxy := rand.AccAddr()
+		proxies[proxy.String()] = rand.ValAddr()
+
+		_, err := server.VoteFeeRate(sdk.WrapSDKContext(ctx), types.NewVoteFeeRateRequest(proxy, confTarget, btcutil.Amount(rand.I64Between(1000, 100000))))
+		assert.Error(t, err)
+
+		_, ok := keeper.GetFeeRate(ctx, confTarget)
+		assert.False(t, ok)
+	}).Repeat(repeats))
+
+	t.Run("should reject votes for unknown confirmation targets", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.VoteFeeRate(sdk.WrapSDKContext(ctx), types.NewVoteFeeRateRequest(proxyOf(maintainers[0]), rand.I64Between(1000, 2000), btcutil.Amount(rand.I64Between(1000, 100000))))
+		assert.Error(t, err)
+	}).Repeat(repeats))
+}
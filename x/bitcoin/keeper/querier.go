@@ -70,9 +70,18 @@ func NewQuerier(k types.BTCKeeper, s types.Signer, n types.Nexus) sdk.Querier {
 
 // QueryDepositStatus returns the status of the queried depoist
 func QueryDepositStatus(ctx sdk.Context, k types.BTCKeeper, outpointStr string) ([]byte, error) {
+	resp, err := depositStatus(ctx, k, outpointStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
+
+func depositStatus(ctx sdk.Context, k types.BTCKeeper, outpointStr string) (types.QueryDepositStatusResponse, error) {
 	outpoint, err := types.OutPointFromStr(outpointStr)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "cannot parse outpoint")
+		return types.QueryDepositStatusResponse{}, sdkerrors.Wrap(err, "cannot parse outpoint")
 	}
 
 	key := vote.NewPollKey(types.ModuleName, outpointStr)
@@ -92,10 +101,10 @@ func QueryDepositStatus(ctx sdk.Context, k types.BTCKeeper, outpointStr string)
 	case state == types.OutPointState_Spent:
 		resp = types.QueryDepositStatusResponse{Status: types.OutPointState_Spent, Log: "deposit has been transferred to the destination address"}
 	default:
-		return nil, fmt.Errorf("deposit is in an unexpected state")
+		return types.QueryDepositStatusResponse{}, fmt.Errorf("deposit is in an unexpected state")
 	}
 
-	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+	return resp, nil
 }
 
 // QueryDepositAddress returns deposit address
@@ -105,33 +114,40 @@ func QueryDepositAddress(ctx sdk.Context, k types.BTCKeeper, s types.Signer, n t
 		return nil, fmt.Errorf("could not parse the recipient")
 	}
 
-	chain, ok := n.GetChain(ctx, params.Chain)
+	resp, err := depositAddress(ctx, k, s, n, params.Chain, params.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
+
+func depositAddress(ctx sdk.Context, k types.BTCKeeper, s types.Signer, n types.Nexus, recipientChain string, recipientAddress string) (types.QueryAddressResponse, error) {
+	chain, ok := n.GetChain(ctx, recipientChain)
 	if !ok {
-		return nil, fmt.Errorf("recipient chain not found")
+		return types.QueryAddressResponse{}, fmt.Errorf("recipient chain not found")
 	}
 
 	secondaryKey, ok := s.GetCurrentKey(ctx, exported.Bitcoin, tss.SecondaryKey)
 	if !ok {
-		return nil, fmt.Errorf("secondary key not set")
+		return types.QueryAddressResponse{}, fmt.Errorf("secondary key not set")
 	}
 
-	recipient := nexus.CrossChainAddress{Chain: chain, Address: params.Address}
+	recipient := nexus.CrossChainAddress{Chain: chain, Address: recipientAddress}
 	depositAddr, err := getDepositAddress(ctx, k, s, secondaryKey, recipient)
 	if err != nil {
-		return nil, err
+		return types.QueryAddressResponse{}, err
 	}
 
 	_, ok = n.GetRecipient(ctx, depositAddr.ToCrossChainAddr())
 	if !ok {
-		return nil, fmt.Errorf("deposit address is not linked with recipient address")
+		return types.QueryAddressResponse{}, fmt.Errorf("deposit address is not linked with recipient address")
 	}
 
-	resp := types.QueryAddressResponse{
+	return types.QueryAddressResponse{
 		Address: depositAddr.Address,
 		KeyID:   depositAddr.KeyID,
-	}
-
-	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+	}, nil
 }
 
 // QueryConsolidationAddressByKeyRole returns the current consolidation address of the given key role
@@ -151,10 +167,18 @@ func QueryConsolidationAddressByKeyRole(ctx sdk.Context, k types.BTCKeeper, s ty
 
 // QueryConsolidationAddressByKeyID returns the consolidation address of the given key ID
 func QueryConsolidationAddressByKeyID(ctx sdk.Context, k types.BTCKeeper, s types.Signer, keyID tss.KeyID) ([]byte, error) {
+	resp, err := consolidationAddressByKeyID(ctx, k, s, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
 
+func consolidationAddressByKeyID(ctx sdk.Context, k types.BTCKeeper, s types.Signer, keyID tss.KeyID) (types.QueryAddressResponse, error) {
 	key, ok := s.GetKey(ctx, keyID)
 	if !ok {
-		return nil, fmt.Errorf("no key with keyID %s found", keyID)
+		return types.QueryAddressResponse{}, fmt.Errorf("no key with keyID %s found", keyID)
 	}
 
 	var addressInfo types.AddressInfo
@@ -166,16 +190,14 @@ func QueryConsolidationAddressByKeyID(ctx sdk.Context, k types.BTCKeeper, s type
 	case tss.SecondaryKey:
 		addressInfo, err = getSecondaryConsolidationAddress(ctx, k, key)
 	default:
-		return nil, fmt.Errorf("no consolidation address supported for key %s of key role %s", keyID, key.Role.SimpleString())
+		return types.QueryAddressResponse{}, fmt.Errorf("no consolidation address supported for key %s of key role %s", keyID, key.Role.SimpleString())
 	}
 
 	if err != nil {
-		return nil, err
+		return types.QueryAddressResponse{}, err
 	}
 
-	resp := types.QueryAddressResponse{Address: addressInfo.Address, KeyID: addressInfo.KeyID}
-
-	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+	return types.QueryAddressResponse{Address: addressInfo.Address, KeyID: addressInfo.KeyID}, nil
 }
 
 // QueryNextKeyID returns the next key ID of the given key role
@@ -208,6 +230,15 @@ func QueryLatestTxByTxType(ctx sdk.Context, k types.BTCKeeper, txTypeStr string)
 		return nil, err
 	}
 
+	resp, err := latestTxByTxType(ctx, k, txType)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
+
+func latestTxByTxType(ctx sdk.Context, k types.BTCKeeper, txType types.TxType) (types.QueryTxResponse, error) {
 	unsignedTx, ok := k.GetUnsignedTx(ctx, txType)
 	if ok {
 		prevSignedTxHashHex := ""
@@ -223,12 +254,12 @@ func QueryLatestTxByTxType(ctx sdk.Context, k types.BTCKeeper, txTypeStr string)
 			outPointStr := txIn.PreviousOutPoint.String()
 			outPointInfo, state, ok := k.GetOutPointInfo(ctx, txIn.PreviousOutPoint)
 			if !ok || state != types.OutPointState_Spent {
-				return nil, fmt.Errorf("out point info %s is not found or not spent", outPointStr)
+				return types.QueryTxResponse{}, fmt.Errorf("out point info %s is not found or not spent", outPointStr)
 			}
 
 			addressInfo, ok := k.GetAddress(ctx, outPointInfo.Address)
 			if !ok {
-				return nil, fmt.Errorf("unknown outpoint address %s", outPointInfo.Address)
+				return types.QueryTxResponse{}, fmt.Errorf("unknown outpoint address %s", outPointInfo.Address)
 			}
 
 			signingInfos = append(signingInfos, &types.QueryTxResponse_SigningInfo{
@@ -237,80 +268,70 @@ func QueryLatestTxByTxType(ctx sdk.Context, k types.BTCKeeper, txTypeStr string)
 			})
 		}
 
-		resp := types.QueryTxResponse{
+		return types.QueryTxResponse{
 			Tx:                   hex.EncodeToString(types.MustEncodeTx(unsignedTx.GetTx())),
 			Status:               unsignedTx.Status,
 			ConfirmationRequired: unsignedTx.ConfirmationRequired,
 			PrevSignedTxHash:     prevSignedTxHashHex,
 			AnyoneCanSpendVout:   unsignedTx.AnyoneCanSpendVout,
 			SigningInfos:         signingInfos,
-		}
-
-		return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+		}, nil
 	}
 
 	latestSignedTxHash, ok := k.GetLatestSignedTxHash(ctx, txType)
 	if !ok {
-		return nil, fmt.Errorf("no %s transaction exists", txType.SimpleString())
+		return types.QueryTxResponse{}, fmt.Errorf("no %s transaction exists", txType.SimpleString())
 	}
 
 	signedTx, ok := k.GetSignedTx(ctx, *latestSignedTxHash)
 	if !ok {
-		return nil, fmt.Errorf("cannot find the latest signed %s transaction", txType.SimpleString())
+		return types.QueryTxResponse{}, fmt.Errorf("cannot find the latest signed %s transaction", txType.SimpleString())
 	}
 
-	prevSignedTxHashHex := ""
-	if signedTx.PrevSignedTxHash != nil {
-		prevSignedTxHash, err := chainhash.NewHash(signedTx.PrevSignedTxHash)
-		if err != nil {
-			return nil, err
-		}
-
-		prevSignedTxHashHex = prevSignedTxHash.String()
-	}
+	return signedTxToQueryResp(signedTx)
+}
 
-	resp := types.QueryTxResponse{
-		Tx:                   hex.EncodeToString(types.MustEncodeTx(signedTx.GetTx())),
-		Status:               types.Signed,
-		ConfirmationRequired: signedTx.ConfirmationRequired,
-		PrevSignedTxHash:     prevSignedTxHashHex,
-		AnyoneCanSpendVout:   signedTx.AnyoneCanSpendVout,
-		SigningInfos:         nil,
+// QuerySignedTx returns the signed consolidation transaction of the given transaction hash
+func QuerySignedTx(ctx sdk.Context, k types.BTCKeeper, txHashHex string) ([]byte, error) {
+	resp, err := signedTxByHash(ctx, k, txHashHex)
+	if err != nil {
+		return nil, err
 	}
 
 	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
 }
 
-// QuerySignedTx returns the signed consolidation transaction of the given transaction hash
-func QuerySignedTx(ctx sdk.Context, k types.BTCKeeper, txHashHex string) ([]byte, error) {
+func signedTxByHash(ctx sdk.Context, k types.BTCKeeper, txHashHex string) (types.QueryTxResponse, error) {
 	txHash, err := chainhash.NewHashFromStr(txHashHex)
 	if err != nil {
-		return nil, err
+		return types.QueryTxResponse{}, err
 	}
 
 	signedTx, ok := k.GetSignedTx(ctx, *txHash)
 	if !ok {
-		return nil, fmt.Errorf("cannot find signed consolidation transaction for the given transaction hash %s", txHash.String())
+		return types.QueryTxResponse{}, fmt.Errorf("cannot find signed consolidation transaction for the given transaction hash %s", txHash.String())
 	}
 
+	return signedTxToQueryResp(signedTx)
+}
+
+func signedTxToQueryResp(signedTx types.SignedTx) (types.QueryTxResponse, error) {
 	prevSignedTxHashHex := ""
 	if signedTx.PrevSignedTxHash != nil {
 		prevSignedTxHash, err := chainhash.NewHash(signedTx.PrevSignedTxHash)
 		if err != nil {
-			return nil, err
+			return types.QueryTxResponse{}, err
 		}
 
 		prevSignedTxHashHex = prevSignedTxHash.String()
 	}
 
-	resp := types.QueryTxResponse{
+	return types.QueryTxResponse{
 		Tx:                   hex.EncodeToString(types.MustEncodeTx(signedTx.GetTx())),
 		Status:               types.Signed,
 		ConfirmationRequired: signedTx.ConfirmationRequired,
 		PrevSignedTxHash:     prevSignedTxHashHex,
 		AnyoneCanSpendVout:   signedTx.AnyoneCanSpendVout,
 		SigningInfos:         nil,
-	}
-
-	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+	}, nil
 }
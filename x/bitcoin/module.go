@@ -1,6 +1,7 @@
 package bitcoin
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -59,7 +60,10 @@ func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Rout
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux) {
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryServiceHandlerClient(context.Background(), mux, types.NewQueryServiceClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns all CLI tx commands for this module
@@ -80,7 +84,7 @@ func (AppModuleBasic) Name() string {
 // AppModule implements module.AppModule
 type AppModule struct {
 	AppModuleBasic
-	keeper      types.BTCKeeper
+	keeper      keeper.Keeper
 	voter       types.Voter
 	signer      types.Signer
 	nexus       types.Nexus
@@ -88,7 +92,7 @@ type AppModule struct {
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(k types.BTCKeeper, voter types.Voter, signer types.Signer, nexus types.Nexus, snapshotter types.Snapshotter) AppModule {
+func NewAppModule(k keeper.Keeper, voter types.Voter, signer types.Signer, nexus types.Nexus, snapshotter types.Snapshotter) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         k,
@@ -100,8 +104,8 @@ func NewAppModule(k types.BTCKeeper, voter types.Voter, signer types.Signer, nex
 }
 
 // RegisterInvariants registers this module's invariants
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
-	// No invariants yet
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // InitGenesis initializes the module's keeper from the given genesis state
@@ -143,12 +147,13 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock executes all state transitions this module requires at the end of each new block
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return EndBlocker(ctx, req, am.keeper, am.signer)
+	return EndBlocker(ctx, req, am.keeper, am.nexus, am.signer, am.voter, am.snapshotter)
 }
 
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
-func (am AppModule) RegisterServices(module.Configurator) {
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterQueryServiceServer(cfg.QueryServer(), keeper.NewGRPCQuerier(am.keeper, am.signer, am.nexus))
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
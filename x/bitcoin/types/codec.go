@@ -14,6 +14,7 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&VoteConfirmOutpointRequest{}, "bitcoin/VoteConfirmOutpoint", nil)
 	cdc.RegisterConcrete(&ConfirmOutpointRequest{}, "bitcoin/ConfirmOutpoint", nil)
+	cdc.RegisterConcrete(&VoteFeeRateRequest{}, "bitcoin/VoteFeeRate", nil)
 	cdc.RegisterConcrete(&LinkRequest{}, "bitcoin/Link", nil)
 	cdc.RegisterConcrete(&CreatePendingTransfersTxRequest{}, "bitcoin/CreatePendingTransfersTx", nil)
 	cdc.RegisterConcrete(&CreateMasterTxRequest{}, "bitcoin/CreateMasterTx", nil)
@@ -27,6 +28,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&VoteConfirmOutpointRequest{},
 		&ConfirmOutpointRequest{},
+		&VoteFeeRateRequest{},
 		&LinkRequest{},
 		&CreatePendingTransfersTxRequest{},
 		&CreateMasterTxRequest{},
@@ -40,6 +42,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*axelarnet.Refundable)(nil),
 		&VoteConfirmOutpointRequest{},
+		&VoteFeeRateRequest{},
 	)
 }
 
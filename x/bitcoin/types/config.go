@@ -12,6 +12,10 @@ type BtcConfig struct {
 	CookiePath     string        `mapstructure:"cookie_file"`
 	RPCTimeout     time.Duration `mapstructure:"timeout_rpc"`
 	StartUpTimeout time.Duration `mapstructure:"timeout_startup"`
+	// additional endpoints used for failover or, with a quorum above one, to cross-check results
+	RPCAddrs       []string `mapstructure:"rpc_addrs"`
+	RPCQuorum      int      `mapstructure:"rpc_quorum"`
+	RPCMaxBlockLag uint64   `mapstructure:"rpc_max_block_lag"`
 }
 
 // DefaultConfig returns a BtcConfig with default values
@@ -20,5 +24,23 @@ func DefaultConfig() BtcConfig {
 		RPCAddr:        "localhost:8332",
 		RPCTimeout:     60 * time.Second,
 		StartUpTimeout: 100 * time.Second,
+		RPCQuorum:      1,
+		RPCMaxBlockLag: 2,
 	}
 }
+
+// RPCEndpoints returns the primary rpc address followed by all additional ones, without empty or duplicate entries
+func (c BtcConfig) RPCEndpoints() []string {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, addr := range append([]string{c.RPCAddr}, c.RPCAddrs...) {
+		if addr == "" || seen[addr] {
+			continue
+		}
+
+		seen[addr] = true
+		unique = append(unique, addr)
+	}
+
+	return unique
+}
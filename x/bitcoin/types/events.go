@@ -8,6 +8,7 @@ const (
 	EventTypeOutpointConfirmation = "outpointConfirmation"
 	EventTypeLink                 = "link"
 	EventTypeWithdrawal           = "withdrawal"
+	EventTypeFeeRate              = "feeRate"
 )
 
 // Event attribute keys
@@ -26,6 +27,9 @@ const (
 	AttributeKeyDestinationAddress = "destinationAddress"
 	AttributeKeyDestinationChain   = "destinationChain"
 	AttributeKeyValue              = "value"
+	AttributeKeyTrigger            = "trigger"
+	AttributeKeyConfTarget         = "confTarget"
+	AttributeKeyConfTargets        = "confTargets"
 )
 
 // Event attribute values
@@ -41,4 +45,9 @@ const (
 	AttributeValueReject         = "reject"
 	AttributeValueFailed         = "failed"
 	AttributeValueVoted          = "voted"
+	AttributeValueScheduled      = "scheduled"
+	AttributeValueUpdate         = "update"
+	AttributeValuePendingAmount  = "pendingAmount"
+	AttributeValuePendingAge     = "pendingAge"
+	AttributeValueInputCount     = "inputCount"
 )
@@ -24,6 +24,8 @@ type BTCKeeper interface {
 	Logger(ctx sdk.Context) log.Logger
 	SetParams(ctx sdk.Context, p Params)
 	GetParams(ctx sdk.Context) Params
+	InitGenesis(ctx sdk.Context, genState *GenesisState)
+	ExportGenesis(ctx sdk.Context) *GenesisState
 
 	GetAnyoneCanSpendAddress(ctx sdk.Context) AddressInfo
 	GetRequiredConfirmationHeight(ctx sdk.Context) uint64
@@ -40,6 +42,10 @@ type BTCKeeper interface {
 	GetMinVoterCount(ctx sdk.Context) int64
 	GetMaxTxSize(ctx sdk.Context) int64
 	GetTransactionFeeRate(ctx sdk.Context) sdk.Dec
+	GetConsolidationSchedule(ctx sdk.Context) ConsolidationSchedule
+	GetConsolidationRetryHeight(ctx sdk.Context) int64
+	SetConsolidationRetryHeight(ctx sdk.Context, height int64)
+	GetFeeRateEstimation(ctx sdk.Context) FeeRateEstimation
 
 	SetPendingOutpointInfo(ctx sdk.Context, key vote.PollKey, info OutPointInfo)
 	GetPendingOutPointInfo(ctx sdk.Context, key vote.PollKey) (OutPointInfo, bool)
@@ -67,6 +73,12 @@ type BTCKeeper interface {
 
 	SetUnconfirmedAmount(ctx sdk.Context, keyID tss.KeyID, amount btcutil.Amount)
 	GetUnconfirmedAmount(ctx sdk.Context, keyID tss.KeyID) btcutil.Amount
+
+	SetFeeRate(ctx sdk.Context, confTarget int64, feeRate btcutil.Amount)
+	GetFeeRate(ctx sdk.Context, confTarget int64) (btcutil.Amount, bool)
+	GetTxFeeRate(ctx sdk.Context) btcutil.Amount
+	SetFeeRateVote(ctx sdk.Context, vote FeeRateVote)
+	GetFeeRateVote(ctx sdk.Context, confTarget int64, voter sdk.ValAddress) (FeeRateVote, bool)
 }
 
 // Voter is the interface that provides voting functionality
@@ -115,9 +127,10 @@ type Signer interface {
 type Nexus interface {
 	LinkAddresses(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress)
 	GetRecipient(ctx sdk.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool)
-	EnqueueForTransfer(ctx sdk.Context, sender nexus.CrossChainAddress, amount sdk.Coin, feeRate sdk.Dec) error
+	EnqueueForTransfer(ctx sdk.Context, sender nexus.CrossChainAddress, amount sdk.Coin, feeRate sdk.Dec, depositTxID string) error
 	GetTransfersForChain(ctx sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer
-	ArchivePendingTransfer(ctx sdk.Context, transfer nexus.CrossChainTransfer)
+	ArchivePendingTransfer(ctx sdk.Context, transfer nexus.CrossChainTransfer, executionID string)
+	IsTransferFrozen(ctx sdk.Context, chain nexus.Chain, asset string) bool
 	GetChain(ctx sdk.Context, chain string) (nexus.Chain, bool)
 	IsAssetRegistered(ctx sdk.Context, chainName, denom string) bool
 	GetChainMaintainers(ctx sdk.Context, chain nexus.Chain) []sdk.ValAddress
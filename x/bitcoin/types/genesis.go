@@ -1,27 +1,279 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
+// NewGenesisState is the constructor for GenesisState
+func NewGenesisState(
+	p Params,
+	pendingOutPoints []PendingOutPointInfo,
+	confirmedOutPoints []OutPointInfo,
+	spentOutPoints []OutPointInfo,
+	confirmedOutPointQueues []ConfirmedOutPointQueue,
+	addresses []AddressInfo,
+	dustAmounts []DustAmount,
+	unsignedTxs []UnsignedTx,
+	signedTxs []SignedTx,
+	latestSignedTxHashes []LatestSignedTxHash,
+	unconfirmedAmounts []UnconfirmedAmount,
+	externalKeyIDs []tss.KeyID,
+	feeRates []FeeRate,
+	feeRateVotes []FeeRateVote,
+) *GenesisState {
+	return &GenesisState{
+		Params:                  p,
+		PendingOutPoints:        pendingOutPoints,
+		ConfirmedOutPoints:      confirmedOutPoints,
+		SpentOutPoints:          spentOutPoints,
+		ConfirmedOutPointQueues: confirmedOutPointQueues,
+		Addresses:               addresses,
+		DustAmounts:             dustAmounts,
+		UnsignedTxs:             unsignedTxs,
+		SignedTxs:               signedTxs,
+		LatestSignedTxHashes:    latestSignedTxHashes,
+		UnconfirmedAmounts:      unconfirmedAmounts,
+		ExternalKeyIDs:          externalKeyIDs,
+		FeeRates:                feeRates,
+		FeeRateVotes:            feeRateVotes,
+	}
+}
+
 // DefaultGenesisState represents the default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{Params: DefaultParams()}
+	return NewGenesisState(
+		DefaultParams(),
+		[]PendingOutPointInfo{},
+		[]OutPointInfo{},
+		[]OutPointInfo{},
+		[]ConfirmedOutPointQueue{},
+		[]AddressInfo{},
+		[]DustAmount{},
+		[]UnsignedTx{},
+		[]SignedTx{},
+		[]LatestSignedTxHash{},
+		[]UnconfirmedAmount{},
+		[]tss.KeyID{},
+		[]FeeRate{},
+		[]FeeRateVote{},
+	)
 }
 
 // Validate validates the genesis state
 func (m *GenesisState) Validate() error {
-	if err := m.Params.Validate(); err != nil {
+	if err := m.validate(); err != nil {
 		return sdkerrors.Wrap(err, fmt.Sprintf("genesis state for module %s is invalid", ModuleName))
 	}
 
 	return nil
 }
 
+func (m GenesisState) validate() error {
+	if err := m.Params.Validate(); err != nil {
+		return err
+	}
+
+	addresses := make(map[string]bool)
+	keyIDs := make(map[tss.KeyID]bool)
+	for _, address := range m.Addresses {
+		if address.Address == "" {
+			return fmt.Errorf("missing address")
+		}
+
+		if err := address.KeyID.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid key ID for address %s", address.Address)
+		}
+
+		if addresses[strings.ToLower(address.Address)] {
+			return fmt.Errorf("duplicate address %s", address.Address)
+		}
+		addresses[strings.ToLower(address.Address)] = true
+		keyIDs[address.KeyID] = true
+	}
+
+	for _, pending := range m.PendingOutPoints {
+		if err := pending.PollKey.Validate(); err != nil {
+			return err
+		}
+
+		if err := pending.Info.Validate(); err != nil {
+			return err
+		}
+	}
+
+	outPoints := make(map[string]bool)
+	confirmed := make(map[string]bool)
+	for i, infos := range [][]OutPointInfo{m.ConfirmedOutPoints, m.SpentOutPoints} {
+		for _, info := range infos {
+			if err := info.Validate(); err != nil {
+				return err
+			}
+
+			if !addresses[strings.ToLower(info.Address)] {
+				return fmt.Errorf("outpoint %s refers to unknown address %s", info.OutPoint, info.Address)
+			}
+
+			if outPoints[strings.ToLower(info.OutPoint)] {
+				return fmt.Errorf("duplicate outpoint %s", info.OutPoint)
+			}
+			outPoints[strings.ToLower(info.OutPoint)] = true
+
+			if i == 0 {
+				confirmed[strings.ToLower(info.OutPoint)] = true
+			}
+		}
+	}
+
+	seenQueues := make(map[tss.KeyID]bool)
+	for _, queue := range m.ConfirmedOutPointQueues {
+		if !keyIDs[queue.KeyID] {
+			return fmt.Errorf("confirmed outpoint queue refers to key %s without addresses", queue.KeyID)
+		}
+
+		if err := queue.Queue.ValidateBasic(); err != nil {
+			return err
+		}
+
+		for _, item := range queue.Queue.Items {
+			outPoint := strings.TrimPrefix(string(item.Key), "conf__")
+			if !confirmed[outPoint] {
+				return fmt.Errorf("confirmed outpoint queue of key %s refers to unknown outpoint %s", queue.KeyID, outPoint)
+			}
+		}
+
+		if seenQueues[queue.KeyID] {
+			return fmt.Errorf("duplicate confirmed outpoint queue for key %s", queue.KeyID)
+		}
+		seenQueues[queue.KeyID] = true
+	}
+
+	for _, dust := range m.DustAmounts {
+		if dust.Address == "" {
+			return fmt.Errorf("missing address for dust amount")
+		}
+
+		if dust.Amount < 0 {
+			return fmt.Errorf("dust amount for address %s must not be negative", dust.Address)
+		}
+	}
+
+	unsignedTxTypes := make(map[TxType]bool)
+	for _, tx := range m.UnsignedTxs {
+		if err := tx.Type.Validate(); err != nil {
+			return err
+		}
+
+		if unsignedTxTypes[tx.Type] {
+			return fmt.Errorf("duplicate unsigned tx of type %s", tx.Type.SimpleString())
+		}
+		unsignedTxTypes[tx.Type] = true
+	}
+
+	signedTxs := make(map[chainhash.Hash]bool)
+	for _, tx := range m.SignedTxs {
+		if err := tx.Type.Validate(); err != nil {
+			return err
+		}
+
+		var msgTx wire.MsgTx
+		if err := msgTx.Deserialize(bytes.NewReader(tx.Tx)); err != nil {
+			return sdkerrors.Wrapf(err, "invalid signed tx of type %s", tx.Type.SimpleString())
+		}
+
+		txHash := msgTx.TxHash()
+		if signedTxs[txHash] {
+			return fmt.Errorf("duplicate signed tx %s", txHash.String())
+		}
+		signedTxs[txHash] = true
+	}
+
+	latestTxTypes := make(map[TxType]bool)
+	for _, latest := range m.LatestSignedTxHashes {
+		if err := latest.TxType.Validate(); err != nil {
+			return err
+		}
+
+		txHash, err := chainhash.NewHash(latest.TxHash)
+		if err != nil {
+			return err
+		}
+
+		if !signedTxs[*txHash] {
+			return fmt.Errorf("latest signed tx of type %s refers to unknown tx %s", latest.TxType.SimpleString(), txHash.String())
+		}
+
+		if latestTxTypes[latest.TxType] {
+			return fmt.Errorf("duplicate latest signed tx of type %s", latest.TxType.SimpleString())
+		}
+		latestTxTypes[latest.TxType] = true
+	}
+
+	unconfirmed := make(map[tss.KeyID]bool)
+	for _, amount := range m.UnconfirmedAmounts {
+		if err := amount.KeyID.Validate(); err != nil {
+			return err
+		}
+
+		if amount.Amount < 0 {
+			return fmt.Errorf("unconfirmed amount for key %s must not be negative", amount.KeyID)
+		}
+
+		if unconfirmed[amount.KeyID] {
+			return fmt.Errorf("duplicate unconfirmed amount for key %s", amount.KeyID)
+		}
+		unconfirmed[amount.KeyID] = true
+	}
+
+	externalKeyIDs := make(map[tss.KeyID]bool)
+	for _, keyID := range m.ExternalKeyIDs {
+		if err := keyID.Validate(); err != nil {
+			return err
+		}
+
+		if externalKeyIDs[keyID] {
+			return fmt.Errorf("duplicate external key %s", keyID)
+		}
+		externalKeyIDs[keyID] = true
+	}
+
+	feeRates := make(map[int64]bool)
+	for _, feeRate := range m.FeeRates {
+		if err := feeRate.Validate(); err != nil {
+			return err
+		}
+
+		if feeRates[feeRate.ConfTarget] {
+			return fmt.Errorf("duplicate fee rate for confirmation target %d", feeRate.ConfTarget)
+		}
+		feeRates[feeRate.ConfTarget] = true
+	}
+
+	feeRateVotes := make(map[string]bool)
+	for _, vote := range m.FeeRateVotes {
+		if err := vote.Validate(); err != nil {
+			return err
+		}
+
+		voteID := fmt.Sprintf("%d_%s", vote.ConfTarget, vote.Voter.String())
+		if feeRateVotes[voteID] {
+			return fmt.Errorf("duplicate fee rate vote of %s for confirmation target %d", vote.Voter.String(), vote.ConfTarget)
+		}
+		feeRateVotes[voteID] = true
+	}
+
+	return nil
+}
+
 // GetGenesisStateFromAppState returns x/bitcoin GenesisState given raw application
 // genesis state.
 func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) GenesisState {
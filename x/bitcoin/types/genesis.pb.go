@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -24,7 +25,20 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params                  Params                                                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PendingOutPoints        []PendingOutPointInfo                                       `protobuf:"bytes,2,rep,name=pending_out_points,json=pendingOutPoints,proto3" json:"pending_out_points"`
+	ConfirmedOutPoints      []OutPointInfo                                              `protobuf:"bytes,3,rep,name=confirmed_out_points,json=confirmedOutPoints,proto3" json:"confirmed_out_points"`
+	SpentOutPoints          []OutPointInfo                                              `protobuf:"bytes,4,rep,name=spent_out_points,json=spentOutPoints,proto3" json:"spent_out_points"`
+	ConfirmedOutPointQueues []ConfirmedOutPointQueue                                    `protobuf:"bytes,5,rep,name=confirmed_out_point_queues,json=confirmedOutPointQueues,proto3" json:"confirmed_out_point_queues"`
+	Addresses               []AddressInfo                                               `protobuf:"bytes,6,rep,name=addresses,proto3" json:"addresses"`
+	DustAmounts             []DustAmount                                                `protobuf:"bytes,7,rep,name=dust_amounts,json=dustAmounts,proto3" json:"dust_amounts"`
+	UnsignedTxs             []UnsignedTx                                                `protobuf:"bytes,8,rep,name=unsigned_txs,json=unsignedTxs,proto3" json:"unsigned_txs"`
+	SignedTxs               []SignedTx                                                  `protobuf:"bytes,9,rep,name=signed_txs,json=signedTxs,proto3" json:"signed_txs"`
+	LatestSignedTxHashes    []LatestSignedTxHash                                        `protobuf:"bytes,10,rep,name=latest_signed_tx_hashes,json=latestSignedTxHashes,proto3" json:"latest_signed_tx_hashes"`
+	UnconfirmedAmounts      []UnconfirmedAmount                                         `protobuf:"bytes,11,rep,name=unconfirmed_amounts,json=unconfirmedAmounts,proto3" json:"unconfirmed_amounts"`
+	ExternalKeyIDs          []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,12,rep,name=external_key_ids,json=externalKeyIds,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"external_key_ids,omitempty"`
+	FeeRates                []FeeRate                                                   `protobuf:"bytes,13,rep,name=fee_rates,json=feeRates,proto3" json:"fee_rates"`
+	FeeRateVotes            []FeeRateVote                                               `protobuf:"bytes,14,rep,name=fee_rate_votes,json=feeRateVotes,proto3" json:"fee_rate_votes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,20 +81,45 @@ func init() {
 func init() { proto.RegisterFile("bitcoin/v1beta1/genesis.proto", fileDescriptor_af6cee78dee57118) }
 
 var fileDescriptor_af6cee78dee57118 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xca, 0x2c, 0x49,
-	0xce, 0xcf, 0xcc, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x4a, 0xeb, 0x41, 0xa5,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x72, 0xfa, 0x20, 0x16, 0x44, 0x99, 0x94, 0x0c, 0xba,
-	0x29, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x43, 0x94, 0x5c, 0xb9, 0x78, 0xdc, 0x21, 0xa6, 0x06,
-	0x97, 0x24, 0x96, 0xa4, 0x0a, 0x99, 0x72, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8,
-	0x8d, 0xc4, 0xf5, 0xd0, 0x6c, 0xd1, 0x0b, 0x00, 0x4b, 0x3b, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10,
-	0x04, 0x55, 0xec, 0x14, 0x74, 0xe2, 0xa1, 0x1c, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x99, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27,
-	0x56, 0xa4, 0xe6, 0x24, 0x16, 0xe5, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0x43, 0x79, 0xba, 0xc9,
-	0xf9, 0x45, 0xa9, 0xfa, 0x15, 0xfa, 0x30, 0x97, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x5d, 0x68, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x57, 0xe8, 0x09, 0x07, 0x01, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd4, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0x07, 0xf0, 0x56, 0x10, 0xe9, 0xb4, 0x56, 0x32, 0x92, 0xb0, 0x16, 0x58, 0x08, 0x9a, 0xc8,
+	0xc5, 0x6e, 0x40, 0x3d, 0x18, 0x13, 0x23, 0x88, 0x0a, 0x51, 0x03, 0x16, 0x31, 0xea, 0x65, 0x9d,
+	0x76, 0x5f, 0xdb, 0x95, 0x76, 0x66, 0xdd, 0x37, 0x83, 0xed, 0xb7, 0xf0, 0x63, 0x71, 0xe4, 0xe8,
+	0x89, 0x68, 0xf9, 0x16, 0x1e, 0x8c, 0xe9, 0x74, 0x76, 0xb7, 0xed, 0x96, 0x84, 0x5b, 0x77, 0xfe,
+	0xef, 0xfd, 0x76, 0xde, 0x76, 0x32, 0x64, 0xb9, 0xea, 0xcb, 0x9a, 0xf0, 0xb9, 0x73, 0xb2, 0x51,
+	0x05, 0xc9, 0x36, 0x9c, 0x06, 0x70, 0x40, 0x1f, 0xcb, 0x41, 0x28, 0xa4, 0xa0, 0xb7, 0x4c, 0x5c,
+	0x36, 0x71, 0x69, 0xbe, 0x21, 0x1a, 0x42, 0x67, 0x4e, 0xff, 0xd7, 0xa0, 0xac, 0xb4, 0x34, 0xae,
+	0x04, 0x2c, 0x64, 0x6d, 0x83, 0x94, 0x16, 0xc7, 0x53, 0xd9, 0x0d, 0xc0, 0x84, 0x6b, 0xff, 0x66,
+	0x49, 0xe1, 0xf5, 0xe0, 0x9d, 0x87, 0x92, 0x49, 0xa0, 0x8f, 0xc9, 0xcc, 0xa0, 0xdb, 0xca, 0xae,
+	0x66, 0xd7, 0xf3, 0x9b, 0x0b, 0xe5, 0xb1, 0x3d, 0x94, 0x0f, 0x74, 0xbc, 0x3d, 0x7d, 0x7a, 0xbe,
+	0x92, 0xa9, 0x98, 0x62, 0xfa, 0x89, 0xd0, 0x00, 0xb8, 0xe7, 0xf3, 0x86, 0x2b, 0x94, 0x74, 0x03,
+	0xe1, 0x73, 0x89, 0xd6, 0xb5, 0xd5, 0xa9, 0xf5, 0xfc, 0xe6, 0xbd, 0x34, 0x31, 0x28, 0xdd, 0x57,
+	0xf2, 0xa0, 0x5f, 0xb8, 0xc7, 0xeb, 0xc2, 0x78, 0x73, 0xc1, 0x68, 0x84, 0xf4, 0x88, 0xcc, 0xd7,
+	0x04, 0xaf, 0xfb, 0x61, 0x1b, 0xbc, 0x61, 0x7b, 0x4a, 0xdb, 0xcb, 0x29, 0x7b, 0x02, 0x4a, 0x63,
+	0x20, 0x61, 0xdf, 0x91, 0x39, 0x0c, 0x80, 0xcb, 0x61, 0x72, 0xfa, 0xea, 0x64, 0x51, 0x37, 0x27,
+	0xdc, 0x37, 0x52, 0x9a, 0xb0, 0x4b, 0xf7, 0xbb, 0x02, 0x05, 0x68, 0x5d, 0xd7, 0xf0, 0xfd, 0x14,
+	0xfc, 0x62, 0x7c, 0x5f, 0xef, 0xfb, 0xf5, 0xe6, 0x15, 0x0b, 0xb5, 0x89, 0x29, 0xd2, 0xe7, 0x24,
+	0xc7, 0x3c, 0x2f, 0x04, 0x44, 0x40, 0x6b, 0x46, 0xd3, 0x4b, 0x29, 0x7a, 0x6b, 0x50, 0x31, 0xb4,
+	0xe5, 0xa4, 0x89, 0xee, 0x90, 0x82, 0xa7, 0x50, 0xba, 0xac, 0x2d, 0x54, 0x7f, 0xf0, 0x1b, 0x1a,
+	0x59, 0x4c, 0x21, 0x3b, 0x0a, 0xe5, 0x96, 0xae, 0x31, 0x46, 0xde, 0x8b, 0x57, 0xb4, 0xa2, 0x38,
+	0xfa, 0x0d, 0x0e, 0x9e, 0x2b, 0x3b, 0x68, 0xcd, 0x5e, 0xa2, 0x1c, 0x99, 0xa2, 0x0f, 0x9d, 0x48,
+	0x51, 0xf1, 0x0a, 0xd2, 0x67, 0x84, 0x0c, 0x19, 0x39, 0x6d, 0xdc, 0x49, 0x19, 0x87, 0xa3, 0x42,
+	0x2e, 0xe9, 0xff, 0x4a, 0x16, 0x5a, 0x4c, 0x02, 0x4a, 0x37, 0x66, 0xdc, 0x26, 0xc3, 0x26, 0xa0,
+	0x45, 0x34, 0x76, 0x37, 0x85, 0xbd, 0xd5, 0xf5, 0x11, 0xb9, 0xcb, 0xb0, 0x69, 0xd8, 0xf9, 0x56,
+	0x2a, 0x01, 0xa4, 0x9f, 0xc9, 0x6d, 0xc5, 0x93, 0x7f, 0x37, 0xfa, 0x68, 0x79, 0xad, 0xaf, 0x4d,
+	0x18, 0x37, 0xae, 0x1d, 0xf9, 0x76, 0x54, 0x8d, 0x07, 0x48, 0xbb, 0x64, 0x0e, 0x3a, 0x12, 0x42,
+	0xce, 0x5a, 0xee, 0x31, 0x74, 0x5d, 0xdf, 0x43, 0xab, 0xb0, 0x3a, 0xb5, 0x9e, 0xdb, 0xde, 0xef,
+	0x9d, 0xaf, 0x14, 0x5f, 0x9a, 0xec, 0x0d, 0x74, 0xf7, 0x76, 0xf0, 0xef, 0xf9, 0xca, 0x93, 0x86,
+	0x2f, 0x9b, 0xaa, 0x5a, 0xae, 0x89, 0xb6, 0xc3, 0x3a, 0xd0, 0x62, 0x21, 0x07, 0xf9, 0x43, 0x84,
+	0xc7, 0xe6, 0xe9, 0x41, 0x4d, 0x84, 0xe0, 0x74, 0x1c, 0x89, 0xe8, 0x40, 0x27, 0x10, 0xa1, 0x04,
+	0xaf, 0xac, 0xbb, 0x2b, 0x45, 0x18, 0xc2, 0x3c, 0xa4, 0x4f, 0x49, 0xae, 0x0e, 0xe0, 0x86, 0xfd,
+	0x89, 0xad, 0x9b, 0x7a, 0x16, 0x2b, 0x35, 0xcb, 0x2b, 0x80, 0x0a, 0x93, 0xd1, 0x89, 0x9c, 0xad,
+	0x0f, 0x1e, 0x91, 0xee, 0x92, 0x62, 0xd4, 0xec, 0x9e, 0x88, 0xbe, 0x50, 0xbc, 0xe4, 0x1c, 0x1a,
+	0xe1, 0xa3, 0x88, 0x95, 0x42, 0x3d, 0x59, 0xc2, 0xed, 0xca, 0xe9, 0x1f, 0x3b, 0x73, 0xda, 0xb3,
+	0xb3, 0x67, 0x3d, 0x3b, 0xfb, 0xbb, 0x67, 0x67, 0x7f, 0x5e, 0xd8, 0x99, 0xb3, 0x0b, 0x3b, 0xf3,
+	0xeb, 0xc2, 0xce, 0x7c, 0x79, 0x74, 0xc5, 0x79, 0xa3, 0x2b, 0x4e, 0x5f, 0x6d, 0xd5, 0x19, 0x7d,
+	0xb7, 0x3d, 0xfc, 0x3f, 0x00, 0x78, 0xe3, 0xd4, 0x61, 0x5e, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -103,6 +142,183 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeRateVotes) > 0 {
+		for iNdEx := len(m.FeeRateVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.FeeRates) > 0 {
+		for iNdEx := len(m.FeeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ExternalKeyIDs) > 0 {
+		for iNdEx := len(m.ExternalKeyIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExternalKeyIDs[iNdEx])
+			copy(dAtA[i:], m.ExternalKeyIDs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExternalKeyIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.UnconfirmedAmounts) > 0 {
+		for iNdEx := len(m.UnconfirmedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnconfirmedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.LatestSignedTxHashes) > 0 {
+		for iNdEx := len(m.LatestSignedTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestSignedTxHashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SignedTxs) > 0 {
+		for iNdEx := len(m.SignedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.UnsignedTxs) > 0 {
+		for iNdEx := len(m.UnsignedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnsignedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DustAmounts) > 0 {
+		for iNdEx := len(m.DustAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ConfirmedOutPointQueues) > 0 {
+		for iNdEx := len(m.ConfirmedOutPointQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmedOutPointQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SpentOutPoints) > 0 {
+		for iNdEx := len(m.SpentOutPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpentOutPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConfirmedOutPoints) > 0 {
+		for iNdEx := len(m.ConfirmedOutPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmedOutPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PendingOutPoints) > 0 {
+		for iNdEx := len(m.PendingOutPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOutPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -135,6 +351,84 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingOutPoints) > 0 {
+		for _, e := range m.PendingOutPoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConfirmedOutPoints) > 0 {
+		for _, e := range m.ConfirmedOutPoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpentOutPoints) > 0 {
+		for _, e := range m.SpentOutPoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConfirmedOutPointQueues) > 0 {
+		for _, e := range m.ConfirmedOutPointQueues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DustAmounts) > 0 {
+		for _, e := range m.DustAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnsignedTxs) > 0 {
+		for _, e := range m.UnsignedTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignedTxs) > 0 {
+		for _, e := range m.SignedTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestSignedTxHashes) > 0 {
+		for _, e := range m.LatestSignedTxHashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnconfirmedAmounts) > 0 {
+		for _, e := range m.UnconfirmedAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExternalKeyIDs) > 0 {
+		for _, s := range m.ExternalKeyIDs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeRates) > 0 {
+		for _, e := range m.FeeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeRateVotes) > 0 {
+		for _, e := range m.FeeRateVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -206,6 +500,446 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOutPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOutPoints = append(m.PendingOutPoints, PendingOutPointInfo{})
+			if err := m.PendingOutPoints[len(m.PendingOutPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedOutPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmedOutPoints = append(m.ConfirmedOutPoints, OutPointInfo{})
+			if err := m.ConfirmedOutPoints[len(m.ConfirmedOutPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentOutPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentOutPoints = append(m.SpentOutPoints, OutPointInfo{})
+			if err := m.SpentOutPoints[len(m.SpentOutPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedOutPointQueues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmedOutPointQueues = append(m.ConfirmedOutPointQueues, ConfirmedOutPointQueue{})
+			if err := m.ConfirmedOutPointQueues[len(m.ConfirmedOutPointQueues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, AddressInfo{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustAmounts = append(m.DustAmounts, DustAmount{})
+			if err := m.DustAmounts[len(m.DustAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnsignedTxs = append(m.UnsignedTxs, UnsignedTx{})
+			if err := m.UnsignedTxs[len(m.UnsignedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedTxs = append(m.SignedTxs, SignedTx{})
+			if err := m.SignedTxs[len(m.SignedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignedTxHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestSignedTxHashes = append(m.LatestSignedTxHashes, LatestSignedTxHash{})
+			if err := m.LatestSignedTxHashes[len(m.LatestSignedTxHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnconfirmedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnconfirmedAmounts = append(m.UnconfirmedAmounts, UnconfirmedAmount{})
+			if err := m.UnconfirmedAmounts[len(m.UnconfirmedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalKeyIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalKeyIDs = append(m.ExternalKeyIDs, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRates = append(m.FeeRates, FeeRate{})
+			if err := m.FeeRates[len(m.FeeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRateVotes = append(m.FeeRateVotes, FeeRateVote{})
+			if err := m.FeeRateVotes[len(m.FeeRateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -13,7 +13,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	github_com_btcsuite_btcutil "github.com/btcsuite/btcutil"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 	"sync"
 	time "time"
@@ -29,13 +29,13 @@ var _ types.Voter = &VoterMock{}
 //
 // 		// make and configure a mocked types.Voter
 // 		mockedVoter := &VoterMock{
-// 			GetPollFunc: func(ctx sdk.Context, pollKey exported.PollKey) exported.Poll {
+// 			GetPollFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, pollKey exported.PollKey) exported.Poll {
 // 				panic("mock out the GetPoll method")
 // 			},
-// 			InitializePollFunc: func(ctx sdk.Context, key exported.PollKey, voters []sdk.ValAddress, pollProperties ...exported.PollProperty) error {
+// 			InitializePollFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey, voters []github_com_cosmos_cosmos_sdk_types.ValAddress, pollProperties ...exported.PollProperty) error {
 // 				panic("mock out the InitializePoll method")
 // 			},
-// 			InitializePollWithSnapshotFunc: func(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error {
+// 			InitializePollWithSnapshotFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error {
 // 				panic("mock out the InitializePollWithSnapshot method")
 // 			},
 // 		}
@@ -46,38 +46,38 @@ var _ types.Voter = &VoterMock{}
 // 	}
 type VoterMock struct {
 	// GetPollFunc mocks the GetPoll method.
-	GetPollFunc func(ctx sdk.Context, pollKey exported.PollKey) exported.Poll
+	GetPollFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, pollKey exported.PollKey) exported.Poll
 
 	// InitializePollFunc mocks the InitializePoll method.
-	InitializePollFunc func(ctx sdk.Context, key exported.PollKey, voters []sdk.ValAddress, pollProperties ...exported.PollProperty) error
+	InitializePollFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey, voters []github_com_cosmos_cosmos_sdk_types.ValAddress, pollProperties ...exported.PollProperty) error
 
 	// InitializePollWithSnapshotFunc mocks the InitializePollWithSnapshot method.
-	InitializePollWithSnapshotFunc func(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error
+	InitializePollWithSnapshotFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetPoll holds details about calls to the GetPoll method.
 		GetPoll []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// PollKey is the pollKey argument value.
 			PollKey exported.PollKey
 		}
 		// InitializePoll holds details about calls to the InitializePoll method.
 		InitializePoll []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
 			Key exported.PollKey
 			// Voters is the voters argument value.
-			Voters []sdk.ValAddress
+			Voters []github_com_cosmos_cosmos_sdk_types.ValAddress
 			// PollProperties is the pollProperties argument value.
 			PollProperties []exported.PollProperty
 		}
 		// InitializePollWithSnapshot holds details about calls to the InitializePollWithSnapshot method.
 		InitializePollWithSnapshot []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
 			Key exported.PollKey
 			// SnapshotSeqNo is the snapshotSeqNo argument value.
@@ -92,12 +92,12 @@ type VoterMock struct {
 }
 
 // GetPoll calls GetPollFunc.
-func (mock *VoterMock) GetPoll(ctx sdk.Context, pollKey exported.PollKey) exported.Poll {
+func (mock *VoterMock) GetPoll(ctx github_com_cosmos_cosmos_sdk_types.Context, pollKey exported.PollKey) exported.Poll {
 	if mock.GetPollFunc == nil {
 		panic("VoterMock.GetPollFunc: method is nil but Voter.GetPoll was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		PollKey exported.PollKey
 	}{
 		Ctx:     ctx,
@@ -113,11 +113,11 @@ func (mock *VoterMock) GetPoll(ctx sdk.Context, pollKey exported.PollKey) export
 // Check the length with:
 //     len(mockedVoter.GetPollCalls())
 func (mock *VoterMock) GetPollCalls() []struct {
-	Ctx     sdk.Context
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
 	PollKey exported.PollKey
 } {
 	var calls []struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		PollKey exported.PollKey
 	}
 	mock.lockGetPoll.RLock()
@@ -127,14 +127,14 @@ func (mock *VoterMock) GetPollCalls() []struct {
 }
 
 // InitializePoll calls InitializePollFunc.
-func (mock *VoterMock) InitializePoll(ctx sdk.Context, key exported.PollKey, voters []sdk.ValAddress, pollProperties ...exported.PollProperty) error {
+func (mock *VoterMock) InitializePoll(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey, voters []github_com_cosmos_cosmos_sdk_types.ValAddress, pollProperties ...exported.PollProperty) error {
 	if mock.InitializePollFunc == nil {
 		panic("VoterMock.InitializePollFunc: method is nil but Voter.InitializePoll was just called")
 	}
 	callInfo := struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		Key            exported.PollKey
-		Voters         []sdk.ValAddress
+		Voters         []github_com_cosmos_cosmos_sdk_types.ValAddress
 		PollProperties []exported.PollProperty
 	}{
 		Ctx:            ctx,
@@ -152,15 +152,15 @@ func (mock *VoterMock) InitializePoll(ctx sdk.Context, key exported.PollKey, vot
 // Check the length with:
 //     len(mockedVoter.InitializePollCalls())
 func (mock *VoterMock) InitializePollCalls() []struct {
-	Ctx            sdk.Context
+	Ctx            github_com_cosmos_cosmos_sdk_types.Context
 	Key            exported.PollKey
-	Voters         []sdk.ValAddress
+	Voters         []github_com_cosmos_cosmos_sdk_types.ValAddress
 	PollProperties []exported.PollProperty
 } {
 	var calls []struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		Key            exported.PollKey
-		Voters         []sdk.ValAddress
+		Voters         []github_com_cosmos_cosmos_sdk_types.ValAddress
 		PollProperties []exported.PollProperty
 	}
 	mock.lockInitializePoll.RLock()
@@ -170,12 +170,12 @@ func (mock *VoterMock) InitializePollCalls() []struct {
 }
 
 // InitializePollWithSnapshot calls InitializePollWithSnapshotFunc.
-func (mock *VoterMock) InitializePollWithSnapshot(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error {
+func (mock *VoterMock) InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error {
 	if mock.InitializePollWithSnapshotFunc == nil {
 		panic("VoterMock.InitializePollWithSnapshotFunc: method is nil but Voter.InitializePollWithSnapshot was just called")
 	}
 	callInfo := struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		Key            exported.PollKey
 		SnapshotSeqNo  int64
 		PollProperties []exported.PollProperty
@@ -195,13 +195,13 @@ func (mock *VoterMock) InitializePollWithSnapshot(ctx sdk.Context, key exported.
 // Check the length with:
 //     len(mockedVoter.InitializePollWithSnapshotCalls())
 func (mock *VoterMock) InitializePollWithSnapshotCalls() []struct {
-	Ctx            sdk.Context
+	Ctx            github_com_cosmos_cosmos_sdk_types.Context
 	Key            exported.PollKey
 	SnapshotSeqNo  int64
 	PollProperties []exported.PollProperty
 } {
 	var calls []struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		Key            exported.PollKey
 		SnapshotSeqNo  int64
 		PollProperties []exported.PollProperty
@@ -222,70 +222,70 @@ var _ types.Signer = &SignerMock{}
 //
 // 		// make and configure a mocked types.Signer
 // 		mockedSigner := &SignerMock{
-// 			AssertMatchesRequirementsFunc: func(ctx sdk.Context, snapshotter snapshot.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
+// 			AssertMatchesRequirementsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 // 				panic("mock out the AssertMatchesRequirements method")
 // 			},
-// 			AssignNextKeyFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error {
+// 			AssignNextKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error {
 // 				panic("mock out the AssignNextKey method")
 // 			},
-// 			GetCurrentKeyFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+// 			GetCurrentKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 // 				panic("mock out the GetCurrentKey method")
 // 			},
-// 			GetCurrentKeyIDFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+// 			GetCurrentKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 // 				panic("mock out the GetCurrentKeyID method")
 // 			},
-// 			GetExternalKeyIDsFunc: func(ctx sdk.Context, chain nexus.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+// 			GetExternalKeyIDsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 // 				panic("mock out the GetExternalKeyIDs method")
 // 			},
-// 			GetExternalMultisigThresholdFunc: func(ctx sdk.Context) utils.Threshold {
+// 			GetExternalMultisigThresholdFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.Threshold {
 // 				panic("mock out the GetExternalMultisigThreshold method")
 // 			},
-// 			GetKeyFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+// 			GetKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 // 				panic("mock out the GetKey method")
 // 			},
-// 			GetKeyByRotationCountFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, rotationCount int64) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+// 			GetKeyByRotationCountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, rotationCount int64) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 // 				panic("mock out the GetKeyByRotationCount method")
 // 			},
-// 			GetKeyForSigIDFunc: func(ctx sdk.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+// 			GetKeyForSigIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 // 				panic("mock out the GetKeyForSigID method")
 // 			},
-// 			GetKeyUnbondingLockingKeyRotationCountFunc: func(ctx sdk.Context) int64 {
+// 			GetKeyUnbondingLockingKeyRotationCountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 // 				panic("mock out the GetKeyUnbondingLockingKeyRotationCount method")
 // 			},
-// 			GetNextKeyFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+// 			GetNextKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 // 				panic("mock out the GetNextKey method")
 // 			},
-// 			GetOldActiveKeysFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.Key, error) {
+// 			GetOldActiveKeysFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.Key, error) {
 // 				panic("mock out the GetOldActiveKeys method")
 // 			},
-// 			GetRotationCountFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) int64 {
+// 			GetRotationCountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) int64 {
 // 				panic("mock out the GetRotationCount method")
 // 			},
-// 			GetRotationCountOfKeyIDFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
+// 			GetRotationCountOfKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
 // 				panic("mock out the GetRotationCountOfKeyID method")
 // 			},
-// 			GetSigFunc: func(ctx sdk.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
+// 			GetSigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
 // 				panic("mock out the GetSig method")
 // 			},
-// 			GetSnapshotCounterForKeyIDFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
+// 			GetSnapshotCounterForKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
 // 				panic("mock out the GetSnapshotCounterForKeyID method")
 // 			},
-// 			RotateKeyFunc: func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
+// 			RotateKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 // 				panic("mock out the RotateKey method")
 // 			},
-// 			SetInfoForSigFunc: func(ctx sdk.Context, sigID string, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo)  {
+// 			SetInfoForSigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo)  {
 // 				panic("mock out the SetInfoForSig method")
 // 			},
-// 			SetKeyFunc: func(ctx sdk.Context, key github_com_axelarnetwork_axelar_core_x_tss_exported.Key)  {
+// 			SetKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key github_com_axelarnetwork_axelar_core_x_tss_exported.Key)  {
 // 				panic("mock out the SetKey method")
 // 			},
-// 			SetSigFunc: func(ctx sdk.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature)  {
+// 			SetSigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature)  {
 // 				panic("mock out the SetSig method")
 // 			},
-// 			SetSigStatusFunc: func(ctx sdk.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)  {
+// 			SetSigStatusFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)  {
 // 				panic("mock out the SetSigStatus method")
 // 			},
-// 			StartSignFunc: func(ctx sdk.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter snapshot.Snapshotter, voter interface{InitializePollWithSnapshot(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error}) error {
+// 			StartSignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error {
 // 				panic("mock out the StartSign method")
 // 			},
 // 		}
@@ -296,81 +296,79 @@ var _ types.Signer = &SignerMock{}
 // 	}
 type SignerMock struct {
 	// AssertMatchesRequirementsFunc mocks the AssertMatchesRequirements method.
-	AssertMatchesRequirementsFunc func(ctx sdk.Context, snapshotter snapshot.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
+	AssertMatchesRequirementsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
 
 	// AssignNextKeyFunc mocks the AssignNextKey method.
-	AssignNextKeyFunc func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error
+	AssignNextKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error
 
 	// GetCurrentKeyFunc mocks the GetCurrentKey method.
-	GetCurrentKeyFunc func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
+	GetCurrentKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
 
 	// GetCurrentKeyIDFunc mocks the GetCurrentKeyID method.
-	GetCurrentKeyIDFunc func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
+	GetCurrentKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
 
 	// GetExternalKeyIDsFunc mocks the GetExternalKeyIDs method.
-	GetExternalKeyIDsFunc func(ctx sdk.Context, chain nexus.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
+	GetExternalKeyIDsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
 
 	// GetExternalMultisigThresholdFunc mocks the GetExternalMultisigThreshold method.
-	GetExternalMultisigThresholdFunc func(ctx sdk.Context) utils.Threshold
+	GetExternalMultisigThresholdFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.Threshold
 
 	// GetKeyFunc mocks the GetKey method.
-	GetKeyFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
+	GetKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
 
 	// GetKeyByRotationCountFunc mocks the GetKeyByRotationCount method.
-	GetKeyByRotationCountFunc func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, rotationCount int64) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
+	GetKeyByRotationCountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, rotationCount int64) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
 
 	// GetKeyForSigIDFunc mocks the GetKeyForSigID method.
-	GetKeyForSigIDFunc func(ctx sdk.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
+	GetKeyForSigIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
 
 	// GetKeyUnbondingLockingKeyRotationCountFunc mocks the GetKeyUnbondingLockingKeyRotationCount method.
-	GetKeyUnbondingLockingKeyRotationCountFunc func(ctx sdk.Context) int64
+	GetKeyUnbondingLockingKeyRotationCountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
 	// GetNextKeyFunc mocks the GetNextKey method.
-	GetNextKeyFunc func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
+	GetNextKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
 
 	// GetOldActiveKeysFunc mocks the GetOldActiveKeys method.
-	GetOldActiveKeysFunc func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.Key, error)
+	GetOldActiveKeysFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.Key, error)
 
 	// GetRotationCountFunc mocks the GetRotationCount method.
-	GetRotationCountFunc func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) int64
+	GetRotationCountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) int64
 
 	// GetRotationCountOfKeyIDFunc mocks the GetRotationCountOfKeyID method.
-	GetRotationCountOfKeyIDFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool)
+	GetRotationCountOfKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool)
 
 	// GetSigFunc mocks the GetSig method.
-	GetSigFunc func(ctx sdk.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)
+	GetSigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)
 
 	// GetSnapshotCounterForKeyIDFunc mocks the GetSnapshotCounterForKeyID method.
-	GetSnapshotCounterForKeyIDFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool)
+	GetSnapshotCounterForKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool)
 
 	// RotateKeyFunc mocks the RotateKey method.
-	RotateKeyFunc func(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
+	RotateKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
 
 	// SetInfoForSigFunc mocks the SetInfoForSig method.
-	SetInfoForSigFunc func(ctx sdk.Context, sigID string, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo)
+	SetInfoForSigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo)
 
 	// SetKeyFunc mocks the SetKey method.
-	SetKeyFunc func(ctx sdk.Context, key github_com_axelarnetwork_axelar_core_x_tss_exported.Key)
+	SetKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key github_com_axelarnetwork_axelar_core_x_tss_exported.Key)
 
 	// SetSigFunc mocks the SetSig method.
-	SetSigFunc func(ctx sdk.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature)
+	SetSigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature)
 
 	// SetSigStatusFunc mocks the SetSigStatus method.
-	SetSigStatusFunc func(ctx sdk.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)
+	SetSigStatusFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)
 
 	// StartSignFunc mocks the StartSign method.
-	StartSignFunc func(ctx sdk.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter snapshot.Snapshotter, voter interface {
-		InitializePollWithSnapshot(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error
-	}) error
+	StartSignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// AssertMatchesRequirements holds details about calls to the AssertMatchesRequirements method.
 		AssertMatchesRequirements []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Snapshotter is the snapshotter argument value.
-			Snapshotter snapshot.Snapshotter
+			Snapshotter types.Snapshotter
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// KeyID is the keyID argument value.
@@ -381,7 +379,7 @@ type SignerMock struct {
 		// AssignNextKey holds details about calls to the AssignNextKey method.
 		AssignNextKey []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// KeyRole is the keyRole argument value.
@@ -392,7 +390,7 @@ type SignerMock struct {
 		// GetCurrentKey holds details about calls to the GetCurrentKey method.
 		GetCurrentKey []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// KeyRole is the keyRole argument value.
@@ -401,7 +399,7 @@ type SignerMock struct {
 		// GetCurrentKeyID holds details about calls to the GetCurrentKeyID method.
 		GetCurrentKeyID []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// KeyRole is the keyRole argument value.
@@ -410,26 +408,26 @@ type SignerMock struct {
 		// GetExternalKeyIDs holds details about calls to the GetExternalKeyIDs method.
 		GetExternalKeyIDs []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 		}
 		// GetExternalMultisigThreshold holds details about calls to the GetExternalMultisigThreshold method.
 		GetExternalMultisigThreshold []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetKey holds details about calls to the GetKey method.
 		GetKey []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
 		// GetKeyByRotationCount holds details about calls to the GetKeyByRotationCount method.
 		GetKeyByRotationCount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// KeyRole is the keyRole argument value.
@@ -440,19 +438,19 @@ type SignerMock struct {
 		// GetKeyForSigID holds details about calls to the GetKeyForSigID method.
 		GetKeyForSigID []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// SigID is the sigID argument value.
 			SigID string
 		}
 		// GetKeyUnbondingLockingKeyRotationCount holds details about calls to the GetKeyUnbondingLockingKeyRotationCount method.
 		GetKeyUnbondingLockingKeyRotationCount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetNextKey holds details about calls to the GetNextKey method.
 		GetNextKey []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// KeyRole is the keyRole argument value.
@@ -461,7 +459,7 @@ type SignerMock struct {
 		// GetOldActiveKeys holds details about calls to the GetOldActiveKeys method.
 		GetOldActiveKeys []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// KeyRole is the keyRole argument value.
@@ -470,7 +468,7 @@ type SignerMock struct {
 		// GetRotationCount holds details about calls to the GetRotationCount method.
 		GetRotationCount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// KeyRole is the keyRole argument value.
@@ -479,28 +477,28 @@ type SignerMock struct {
 		// GetRotationCountOfKeyID holds details about calls to the GetRotationCountOfKeyID method.
 		GetRotationCountOfKeyID []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
 		// GetSig holds details about calls to the GetSig method.
 		GetSig []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// SigID is the sigID argument value.
 			SigID string
 		}
 		// GetSnapshotCounterForKeyID holds details about calls to the GetSnapshotCounterForKeyID method.
 		GetSnapshotCounterForKeyID []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
 		// RotateKey holds details about calls to the RotateKey method.
 		RotateKey []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// KeyRole is the keyRole argument value.
@@ -509,7 +507,7 @@ type SignerMock struct {
 		// SetInfoForSig holds details about calls to the SetInfoForSig method.
 		SetInfoForSig []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// SigID is the sigID argument value.
 			SigID string
 			// Info is the info argument value.
@@ -518,21 +516,21 @@ type SignerMock struct {
 		// SetKey holds details about calls to the SetKey method.
 		SetKey []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
 			Key github_com_axelarnetwork_axelar_core_x_tss_exported.Key
 		}
 		// SetSig holds details about calls to the SetSig method.
 		SetSig []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Signature is the signature argument value.
 			Signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature
 		}
 		// SetSigStatus holds details about calls to the SetSigStatus method.
 		SetSigStatus []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// SigID is the sigID argument value.
 			SigID string
 			// Status is the status argument value.
@@ -541,15 +539,13 @@ type SignerMock struct {
 		// StartSign holds details about calls to the StartSign method.
 		StartSign []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Info is the info argument value.
 			Info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 			// Snapshotter is the snapshotter argument value.
-			Snapshotter snapshot.Snapshotter
+			Snapshotter types.Snapshotter
 			// Voter is the voter argument value.
-			Voter interface {
-				InitializePollWithSnapshot(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error
-			}
+			Voter types.InitPoller
 		}
 	}
 	lockAssertMatchesRequirements              sync.RWMutex
@@ -577,13 +573,13 @@ type SignerMock struct {
 }
 
 // AssertMatchesRequirements calls AssertMatchesRequirementsFunc.
-func (mock *SignerMock) AssertMatchesRequirements(ctx sdk.Context, snapshotter snapshot.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
+func (mock *SignerMock) AssertMatchesRequirements(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 	if mock.AssertMatchesRequirementsFunc == nil {
 		panic("SignerMock.AssertMatchesRequirementsFunc: method is nil but Signer.AssertMatchesRequirements was just called")
 	}
 	callInfo := struct {
-		Ctx         sdk.Context
-		Snapshotter snapshot.Snapshotter
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Snapshotter types.Snapshotter
 		Chain       nexus.Chain
 		KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		KeyRole     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
@@ -604,15 +600,15 @@ func (mock *SignerMock) AssertMatchesRequirements(ctx sdk.Context, snapshotter s
 // Check the length with:
 //     len(mockedSigner.AssertMatchesRequirementsCalls())
 func (mock *SignerMock) AssertMatchesRequirementsCalls() []struct {
-	Ctx         sdk.Context
-	Snapshotter snapshot.Snapshotter
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Snapshotter types.Snapshotter
 	Chain       nexus.Chain
 	KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	KeyRole     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
-		Ctx         sdk.Context
-		Snapshotter snapshot.Snapshotter
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Snapshotter types.Snapshotter
 		Chain       nexus.Chain
 		KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		KeyRole     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
@@ -624,12 +620,12 @@ func (mock *SignerMock) AssertMatchesRequirementsCalls() []struct {
 }
 
 // AssignNextKey calls AssignNextKeyFunc.
-func (mock *SignerMock) AssignNextKey(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error {
+func (mock *SignerMock) AssignNextKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error {
 	if mock.AssignNextKeyFunc == nil {
 		panic("SignerMock.AssignNextKeyFunc: method is nil but Signer.AssignNextKey was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		KeyID   github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
@@ -649,13 +645,13 @@ func (mock *SignerMock) AssignNextKey(ctx sdk.Context, chain nexus.Chain, keyRol
 // Check the length with:
 //     len(mockedSigner.AssignNextKeyCalls())
 func (mock *SignerMock) AssignNextKeyCalls() []struct {
-	Ctx     sdk.Context
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
 	Chain   nexus.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	KeyID   github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 } {
 	var calls []struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		KeyID   github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
@@ -667,12 +663,12 @@ func (mock *SignerMock) AssignNextKeyCalls() []struct {
 }
 
 // GetCurrentKey calls GetCurrentKeyFunc.
-func (mock *SignerMock) GetCurrentKey(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+func (mock *SignerMock) GetCurrentKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 	if mock.GetCurrentKeyFunc == nil {
 		panic("SignerMock.GetCurrentKeyFunc: method is nil but Signer.GetCurrentKey was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
@@ -690,12 +686,12 @@ func (mock *SignerMock) GetCurrentKey(ctx sdk.Context, chain nexus.Chain, keyRol
 // Check the length with:
 //     len(mockedSigner.GetCurrentKeyCalls())
 func (mock *SignerMock) GetCurrentKeyCalls() []struct {
-	Ctx     sdk.Context
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
 	Chain   nexus.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
@@ -706,12 +702,12 @@ func (mock *SignerMock) GetCurrentKeyCalls() []struct {
 }
 
 // GetCurrentKeyID calls GetCurrentKeyIDFunc.
-func (mock *SignerMock) GetCurrentKeyID(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+func (mock *SignerMock) GetCurrentKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 	if mock.GetCurrentKeyIDFunc == nil {
 		panic("SignerMock.GetCurrentKeyIDFunc: method is nil but Signer.GetCurrentKeyID was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
@@ -729,12 +725,12 @@ func (mock *SignerMock) GetCurrentKeyID(ctx sdk.Context, chain nexus.Chain, keyR
 // Check the length with:
 //     len(mockedSigner.GetCurrentKeyIDCalls())
 func (mock *SignerMock) GetCurrentKeyIDCalls() []struct {
-	Ctx     sdk.Context
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
 	Chain   nexus.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
@@ -745,12 +741,12 @@ func (mock *SignerMock) GetCurrentKeyIDCalls() []struct {
 }
 
 // GetExternalKeyIDs calls GetExternalKeyIDsFunc.
-func (mock *SignerMock) GetExternalKeyIDs(ctx sdk.Context, chain nexus.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+func (mock *SignerMock) GetExternalKeyIDs(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 	if mock.GetExternalKeyIDsFunc == nil {
 		panic("SignerMock.GetExternalKeyIDsFunc: method is nil but Signer.GetExternalKeyIDs was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain nexus.Chain
 	}{
 		Ctx:   ctx,
@@ -766,11 +762,11 @@ func (mock *SignerMock) GetExternalKeyIDs(ctx sdk.Context, chain nexus.Chain) ([
 // Check the length with:
 //     len(mockedSigner.GetExternalKeyIDsCalls())
 func (mock *SignerMock) GetExternalKeyIDsCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	Chain nexus.Chain
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain nexus.Chain
 	}
 	mock.lockGetExternalKeyIDs.RLock()
@@ -780,12 +776,12 @@ func (mock *SignerMock) GetExternalKeyIDsCalls() []struct {
 }
 
 // GetExternalMultisigThreshold calls GetExternalMultisigThresholdFunc.
-func (mock *SignerMock) GetExternalMultisigThreshold(ctx sdk.Context) utils.Threshold {
+func (mock *SignerMock) GetExternalMultisigThreshold(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.Threshold {
 	if mock.GetExternalMultisigThresholdFunc == nil {
 		panic("SignerMock.GetExternalMultisigThresholdFunc: method is nil but Signer.GetExternalMultisigThreshold was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -799,10 +795,10 @@ func (mock *SignerMock) GetExternalMultisigThreshold(ctx sdk.Context) utils.Thre
 // Check the length with:
 //     len(mockedSigner.GetExternalMultisigThresholdCalls())
 func (mock *SignerMock) GetExternalMultisigThresholdCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetExternalMultisigThreshold.RLock()
 	calls = mock.calls.GetExternalMultisigThreshold
@@ -811,12 +807,12 @@ func (mock *SignerMock) GetExternalMultisigThresholdCalls() []struct {
 }
 
 // GetKey calls GetKeyFunc.
-func (mock *SignerMock) GetKey(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+func (mock *SignerMock) GetKey(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 	if mock.GetKeyFunc == nil {
 		panic("SignerMock.GetKeyFunc: method is nil but Signer.GetKey was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}{
 		Ctx:   ctx,
@@ -832,11 +828,11 @@ func (mock *SignerMock) GetKey(ctx sdk.Context, keyID github_com_axelarnetwork_a
 // Check the length with:
 //     len(mockedSigner.GetKeyCalls())
 func (mock *SignerMock) GetKeyCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}
 	mock.lockGetKey.RLock()
@@ -846,12 +842,12 @@ func (mock *SignerMock) GetKeyCalls() []struct {
 }
 
 // GetKeyByRotationCount calls GetKeyByRotationCountFunc.
-func (mock *SignerMock) GetKeyByRotationCount(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, rotationCount int64) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+func (mock *SignerMock) GetKeyByRotationCount(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, rotationCount int64) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 	if mock.GetKeyByRotationCountFunc == nil {
 		panic("SignerMock.GetKeyByRotationCountFunc: method is nil but Signer.GetKeyByRotationCount was just called")
 	}
 	callInfo := struct {
-		Ctx           sdk.Context
+		Ctx           github_com_cosmos_cosmos_sdk_types.Context
 		Chain         nexus.Chain
 		KeyRole       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		RotationCount int64
@@ -871,13 +867,13 @@ func (mock *SignerMock) GetKeyByRotationCount(ctx sdk.Context, chain nexus.Chain
 // Check the length with:
 //     len(mockedSigner.GetKeyByRotationCountCalls())
 func (mock *SignerMock) GetKeyByRotationCountCalls() []struct {
-	Ctx           sdk.Context
+	Ctx           github_com_cosmos_cosmos_sdk_types.Context
 	Chain         nexus.Chain
 	KeyRole       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	RotationCount int64
 } {
 	var calls []struct {
-		Ctx           sdk.Context
+		Ctx           github_com_cosmos_cosmos_sdk_types.Context
 		Chain         nexus.Chain
 		KeyRole       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		RotationCount int64
@@ -889,12 +885,12 @@ func (mock *SignerMock) GetKeyByRotationCountCalls() []struct {
 }
 
 // GetKeyForSigID calls GetKeyForSigIDFunc.
-func (mock *SignerMock) GetKeyForSigID(ctx sdk.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+func (mock *SignerMock) GetKeyForSigID(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 	if mock.GetKeyForSigIDFunc == nil {
 		panic("SignerMock.GetKeyForSigIDFunc: method is nil but Signer.GetKeyForSigID was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		SigID string
 	}{
 		Ctx:   ctx,
@@ -910,11 +906,11 @@ func (mock *SignerMock) GetKeyForSigID(ctx sdk.Context, sigID string) (github_co
 // Check the length with:
 //     len(mockedSigner.GetKeyForSigIDCalls())
 func (mock *SignerMock) GetKeyForSigIDCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	SigID string
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		SigID string
 	}
 	mock.lockGetKeyForSigID.RLock()
@@ -924,12 +920,12 @@ func (mock *SignerMock) GetKeyForSigIDCalls() []struct {
 }
 
 // GetKeyUnbondingLockingKeyRotationCount calls GetKeyUnbondingLockingKeyRotationCountFunc.
-func (mock *SignerMock) GetKeyUnbondingLockingKeyRotationCount(ctx sdk.Context) int64 {
+func (mock *SignerMock) GetKeyUnbondingLockingKeyRotationCount(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 	if mock.GetKeyUnbondingLockingKeyRotationCountFunc == nil {
 		panic("SignerMock.GetKeyUnbondingLockingKeyRotationCountFunc: method is nil but Signer.GetKeyUnbondingLockingKeyRotationCount was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -943,10 +939,10 @@ func (mock *SignerMock) GetKeyUnbondingLockingKeyRotationCount(ctx sdk.Context)
 // Check the length with:
 //     len(mockedSigner.GetKeyUnbondingLockingKeyRotationCountCalls())
 func (mock *SignerMock) GetKeyUnbondingLockingKeyRotationCountCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetKeyUnbondingLockingKeyRotationCount.RLock()
 	calls = mock.calls.GetKeyUnbondingLockingKeyRotationCount
@@ -955,12 +951,12 @@ func (mock *SignerMock) GetKeyUnbondingLockingKeyRotationCountCalls() []struct {
 }
 
 // GetNextKey calls GetNextKeyFunc.
-func (mock *SignerMock) GetNextKey(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+func (mock *SignerMock) GetNextKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 	if mock.GetNextKeyFunc == nil {
 		panic("SignerMock.GetNextKeyFunc: method is nil but Signer.GetNextKey was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
@@ -978,12 +974,12 @@ func (mock *SignerMock) GetNextKey(ctx sdk.Context, chain nexus.Chain, keyRole g
 // Check the length with:
 //     len(mockedSigner.GetNextKeyCalls())
 func (mock *SignerMock) GetNextKeyCalls() []struct {
-	Ctx     sdk.Context
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
 	Chain   nexus.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
@@ -994,12 +990,12 @@ func (mock *SignerMock) GetNextKeyCalls() []struct {
 }
 
 // GetOldActiveKeys calls GetOldActiveKeysFunc.
-func (mock *SignerMock) GetOldActiveKeys(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.Key, error) {
+func (mock *SignerMock) GetOldActiveKeys(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.Key, error) {
 	if mock.GetOldActiveKeysFunc == nil {
 		panic("SignerMock.GetOldActiveKeysFunc: method is nil but Signer.GetOldActiveKeys was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
@@ -1017,12 +1013,12 @@ func (mock *SignerMock) GetOldActiveKeys(ctx sdk.Context, chain nexus.Chain, key
 // Check the length with:
 //     len(mockedSigner.GetOldActiveKeysCalls())
 func (mock *SignerMock) GetOldActiveKeysCalls() []struct {
-	Ctx     sdk.Context
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
 	Chain   nexus.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
@@ -1033,12 +1029,12 @@ func (mock *SignerMock) GetOldActiveKeysCalls() []struct {
 }
 
 // GetRotationCount calls GetRotationCountFunc.
-func (mock *SignerMock) GetRotationCount(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) int64 {
+func (mock *SignerMock) GetRotationCount(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) int64 {
 	if mock.GetRotationCountFunc == nil {
 		panic("SignerMock.GetRotationCountFunc: method is nil but Signer.GetRotationCount was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
@@ -1056,12 +1052,12 @@ func (mock *SignerMock) GetRotationCount(ctx sdk.Context, chain nexus.Chain, key
 // Check the length with:
 //     len(mockedSigner.GetRotationCountCalls())
 func (mock *SignerMock) GetRotationCountCalls() []struct {
-	Ctx     sdk.Context
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
 	Chain   nexus.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
@@ -1072,12 +1068,12 @@ func (mock *SignerMock) GetRotationCountCalls() []struct {
 }
 
 // GetRotationCountOfKeyID calls GetRotationCountOfKeyIDFunc.
-func (mock *SignerMock) GetRotationCountOfKeyID(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
+func (mock *SignerMock) GetRotationCountOfKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
 	if mock.GetRotationCountOfKeyIDFunc == nil {
 		panic("SignerMock.GetRotationCountOfKeyIDFunc: method is nil but Signer.GetRotationCountOfKeyID was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}{
 		Ctx:   ctx,
@@ -1093,11 +1089,11 @@ func (mock *SignerMock) GetRotationCountOfKeyID(ctx sdk.Context, keyID github_co
 // Check the length with:
 //     len(mockedSigner.GetRotationCountOfKeyIDCalls())
 func (mock *SignerMock) GetRotationCountOfKeyIDCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}
 	mock.lockGetRotationCountOfKeyID.RLock()
@@ -1107,12 +1103,12 @@ func (mock *SignerMock) GetRotationCountOfKeyIDCalls() []struct {
 }
 
 // GetSig calls GetSigFunc.
-func (mock *SignerMock) GetSig(ctx sdk.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
+func (mock *SignerMock) GetSig(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
 	if mock.GetSigFunc == nil {
 		panic("SignerMock.GetSigFunc: method is nil but Signer.GetSig was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		SigID string
 	}{
 		Ctx:   ctx,
@@ -1128,11 +1124,11 @@ func (mock *SignerMock) GetSig(ctx sdk.Context, sigID string) (github_com_axelar
 // Check the length with:
 //     len(mockedSigner.GetSigCalls())
 func (mock *SignerMock) GetSigCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	SigID string
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		SigID string
 	}
 	mock.lockGetSig.RLock()
@@ -1142,12 +1138,12 @@ func (mock *SignerMock) GetSigCalls() []struct {
 }
 
 // GetSnapshotCounterForKeyID calls GetSnapshotCounterForKeyIDFunc.
-func (mock *SignerMock) GetSnapshotCounterForKeyID(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
+func (mock *SignerMock) GetSnapshotCounterForKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
 	if mock.GetSnapshotCounterForKeyIDFunc == nil {
 		panic("SignerMock.GetSnapshotCounterForKeyIDFunc: method is nil but Signer.GetSnapshotCounterForKeyID was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}{
 		Ctx:   ctx,
@@ -1163,11 +1159,11 @@ func (mock *SignerMock) GetSnapshotCounterForKeyID(ctx sdk.Context, keyID github
 // Check the length with:
 //     len(mockedSigner.GetSnapshotCounterForKeyIDCalls())
 func (mock *SignerMock) GetSnapshotCounterForKeyIDCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}
 	mock.lockGetSnapshotCounterForKeyID.RLock()
@@ -1177,12 +1173,12 @@ func (mock *SignerMock) GetSnapshotCounterForKeyIDCalls() []struct {
 }
 
 // RotateKey calls RotateKeyFunc.
-func (mock *SignerMock) RotateKey(ctx sdk.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
+func (mock *SignerMock) RotateKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 	if mock.RotateKeyFunc == nil {
 		panic("SignerMock.RotateKeyFunc: method is nil but Signer.RotateKey was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
@@ -1200,12 +1196,12 @@ func (mock *SignerMock) RotateKey(ctx sdk.Context, chain nexus.Chain, keyRole gi
 // Check the length with:
 //     len(mockedSigner.RotateKeyCalls())
 func (mock *SignerMock) RotateKeyCalls() []struct {
-	Ctx     sdk.Context
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
 	Chain   nexus.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Chain   nexus.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
@@ -1216,12 +1212,12 @@ func (mock *SignerMock) RotateKeyCalls() []struct {
 }
 
 // SetInfoForSig calls SetInfoForSigFunc.
-func (mock *SignerMock) SetInfoForSig(ctx sdk.Context, sigID string, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo) {
+func (mock *SignerMock) SetInfoForSig(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo) {
 	if mock.SetInfoForSigFunc == nil {
 		panic("SignerMock.SetInfoForSigFunc: method is nil but Signer.SetInfoForSig was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		SigID string
 		Info  github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 	}{
@@ -1239,12 +1235,12 @@ func (mock *SignerMock) SetInfoForSig(ctx sdk.Context, sigID string, info github
 // Check the length with:
 //     len(mockedSigner.SetInfoForSigCalls())
 func (mock *SignerMock) SetInfoForSigCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	SigID string
 	Info  github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		SigID string
 		Info  github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 	}
@@ -1255,12 +1251,12 @@ func (mock *SignerMock) SetInfoForSigCalls() []struct {
 }
 
 // SetKey calls SetKeyFunc.
-func (mock *SignerMock) SetKey(ctx sdk.Context, key github_com_axelarnetwork_axelar_core_x_tss_exported.Key) {
+func (mock *SignerMock) SetKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key github_com_axelarnetwork_axelar_core_x_tss_exported.Key) {
 	if mock.SetKeyFunc == nil {
 		panic("SignerMock.SetKeyFunc: method is nil but Signer.SetKey was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Key github_com_axelarnetwork_axelar_core_x_tss_exported.Key
 	}{
 		Ctx: ctx,
@@ -1276,11 +1272,11 @@ func (mock *SignerMock) SetKey(ctx sdk.Context, key github_com_axelarnetwork_axe
 // Check the length with:
 //     len(mockedSigner.SetKeyCalls())
 func (mock *SignerMock) SetKeyCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 	Key github_com_axelarnetwork_axelar_core_x_tss_exported.Key
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Key github_com_axelarnetwork_axelar_core_x_tss_exported.Key
 	}
 	mock.lockSetKey.RLock()
@@ -1290,12 +1286,12 @@ func (mock *SignerMock) SetKeyCalls() []struct {
 }
 
 // SetSig calls SetSigFunc.
-func (mock *SignerMock) SetSig(ctx sdk.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature) {
+func (mock *SignerMock) SetSig(ctx github_com_cosmos_cosmos_sdk_types.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature) {
 	if mock.SetSigFunc == nil {
 		panic("SignerMock.SetSigFunc: method is nil but Signer.SetSig was just called")
 	}
 	callInfo := struct {
-		Ctx       sdk.Context
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
 		Signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature
 	}{
 		Ctx:       ctx,
@@ -1311,11 +1307,11 @@ func (mock *SignerMock) SetSig(ctx sdk.Context, signature github_com_axelarnetwo
 // Check the length with:
 //     len(mockedSigner.SetSigCalls())
 func (mock *SignerMock) SetSigCalls() []struct {
-	Ctx       sdk.Context
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
 	Signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature
 } {
 	var calls []struct {
-		Ctx       sdk.Context
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
 		Signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature
 	}
 	mock.lockSetSig.RLock()
@@ -1325,12 +1321,12 @@ func (mock *SignerMock) SetSigCalls() []struct {
 }
 
 // SetSigStatus calls SetSigStatusFunc.
-func (mock *SignerMock) SetSigStatus(ctx sdk.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
+func (mock *SignerMock) SetSigStatus(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string, status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
 	if mock.SetSigStatusFunc == nil {
 		panic("SignerMock.SetSigStatusFunc: method is nil but Signer.SetSigStatus was just called")
 	}
 	callInfo := struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		SigID  string
 		Status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus
 	}{
@@ -1348,12 +1344,12 @@ func (mock *SignerMock) SetSigStatus(ctx sdk.Context, sigID string, status githu
 // Check the length with:
 //     len(mockedSigner.SetSigStatusCalls())
 func (mock *SignerMock) SetSigStatusCalls() []struct {
-	Ctx    sdk.Context
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
 	SigID  string
 	Status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus
 } {
 	var calls []struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		SigID  string
 		Status github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus
 	}
@@ -1364,19 +1360,15 @@ func (mock *SignerMock) SetSigStatusCalls() []struct {
 }
 
 // StartSign calls StartSignFunc.
-func (mock *SignerMock) StartSign(ctx sdk.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter snapshot.Snapshotter, voter interface {
-	InitializePollWithSnapshot(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error
-}) error {
+func (mock *SignerMock) StartSign(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error {
 	if mock.StartSignFunc == nil {
 		panic("SignerMock.StartSignFunc: method is nil but Signer.StartSign was just called")
 	}
 	callInfo := struct {
-		Ctx         sdk.Context
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
 		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
-		Snapshotter snapshot.Snapshotter
-		Voter       interface {
-			InitializePollWithSnapshot(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error
-		}
+		Snapshotter types.Snapshotter
+		Voter       types.InitPoller
 	}{
 		Ctx:         ctx,
 		Info:        info,
@@ -1393,20 +1385,16 @@ func (mock *SignerMock) StartSign(ctx sdk.Context, info github_com_axelarnetwork
 // Check the length with:
 //     len(mockedSigner.StartSignCalls())
 func (mock *SignerMock) StartSignCalls() []struct {
-	Ctx         sdk.Context
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
 	Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
-	Snapshotter snapshot.Snapshotter
-	Voter       interface {
-		InitializePollWithSnapshot(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error
-	}
+	Snapshotter types.Snapshotter
+	Voter       types.InitPoller
 } {
 	var calls []struct {
-		Ctx         sdk.Context
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
 		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
-		Snapshotter snapshot.Snapshotter
-		Voter       interface {
-			InitializePollWithSnapshot(ctx sdk.Context, key exported.PollKey, snapshotSeqNo int64, pollProperties ...exported.PollProperty) error
-		}
+		Snapshotter types.Snapshotter
+		Voter       types.InitPoller
 	}
 	mock.lockStartSign.RLock()
 	calls = mock.calls.StartSign
@@ -1424,31 +1412,34 @@ var _ types.Nexus = &NexusMock{}
 //
 // 		// make and configure a mocked types.Nexus
 // 		mockedNexus := &NexusMock{
-// 			ArchivePendingTransferFunc: func(ctx sdk.Context, transfer nexus.CrossChainTransfer)  {
+// 			ArchivePendingTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, transfer nexus.CrossChainTransfer, executionID string)  {
 // 				panic("mock out the ArchivePendingTransfer method")
 // 			},
-// 			EnqueueForTransferFunc: func(ctx sdk.Context, sender nexus.CrossChainAddress, amount sdk.Coin, feeRate sdk.Dec) error {
+// 			EnqueueForTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec, depositTxID string) error {
 // 				panic("mock out the EnqueueForTransfer method")
 // 			},
-// 			GetChainFunc: func(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+// 			GetChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool) {
 // 				panic("mock out the GetChain method")
 // 			},
-// 			GetChainMaintainersFunc: func(ctx sdk.Context, chain nexus.Chain) []sdk.ValAddress {
+// 			GetChainMaintainersFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress {
 // 				panic("mock out the GetChainMaintainers method")
 // 			},
-// 			GetRecipientFunc: func(ctx sdk.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
+// 			GetRecipientFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
 // 				panic("mock out the GetRecipient method")
 // 			},
-// 			GetTransfersForChainFunc: func(ctx sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
+// 			GetTransfersForChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
 // 				panic("mock out the GetTransfersForChain method")
 // 			},
-// 			IsAssetRegisteredFunc: func(ctx sdk.Context, chainName string, denom string) bool {
+// 			IsAssetRegisteredFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chainName string, denom string) bool {
 // 				panic("mock out the IsAssetRegistered method")
 // 			},
-// 			IsChainActivatedFunc: func(ctx sdk.Context, chain nexus.Chain) bool {
+// 			IsChainActivatedFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) bool {
 // 				panic("mock out the IsChainActivated method")
 // 			},
-// 			LinkAddressesFunc: func(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress)  {
+// 			IsTransferFrozenFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, asset string) bool {
+// 				panic("mock out the IsTransferFrozen method")
+// 			},
+// 			LinkAddressesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress)  {
 // 				panic("mock out the LinkAddresses method")
 // 			},
 // 		}
@@ -1459,77 +1450,84 @@ var _ types.Nexus = &NexusMock{}
 // 	}
 type NexusMock struct {
 	// ArchivePendingTransferFunc mocks the ArchivePendingTransfer method.
-	ArchivePendingTransferFunc func(ctx sdk.Context, transfer nexus.CrossChainTransfer)
+	ArchivePendingTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, transfer nexus.CrossChainTransfer, executionID string)
 
 	// EnqueueForTransferFunc mocks the EnqueueForTransfer method.
-	EnqueueForTransferFunc func(ctx sdk.Context, sender nexus.CrossChainAddress, amount sdk.Coin, feeRate sdk.Dec) error
+	EnqueueForTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec, depositTxID string) error
 
 	// GetChainFunc mocks the GetChain method.
-	GetChainFunc func(ctx sdk.Context, chain string) (nexus.Chain, bool)
+	GetChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool)
 
 	// GetChainMaintainersFunc mocks the GetChainMaintainers method.
-	GetChainMaintainersFunc func(ctx sdk.Context, chain nexus.Chain) []sdk.ValAddress
+	GetChainMaintainersFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress
 
 	// GetRecipientFunc mocks the GetRecipient method.
-	GetRecipientFunc func(ctx sdk.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool)
+	GetRecipientFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool)
 
 	// GetTransfersForChainFunc mocks the GetTransfersForChain method.
-	GetTransfersForChainFunc func(ctx sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer
+	GetTransfersForChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer
 
 	// IsAssetRegisteredFunc mocks the IsAssetRegistered method.
-	IsAssetRegisteredFunc func(ctx sdk.Context, chainName string, denom string) bool
+	IsAssetRegisteredFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chainName string, denom string) bool
 
 	// IsChainActivatedFunc mocks the IsChainActivated method.
-	IsChainActivatedFunc func(ctx sdk.Context, chain nexus.Chain) bool
+	IsChainActivatedFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) bool
+
+	// IsTransferFrozenFunc mocks the IsTransferFrozen method.
+	IsTransferFrozenFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, asset string) bool
 
 	// LinkAddressesFunc mocks the LinkAddresses method.
-	LinkAddressesFunc func(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress)
+	LinkAddressesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress)
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// ArchivePendingTransfer holds details about calls to the ArchivePendingTransfer method.
 		ArchivePendingTransfer []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Transfer is the transfer argument value.
 			Transfer nexus.CrossChainTransfer
+			// ExecutionID is the executionID argument value.
+			ExecutionID string
 		}
 		// EnqueueForTransfer holds details about calls to the EnqueueForTransfer method.
 		EnqueueForTransfer []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Sender is the sender argument value.
 			Sender nexus.CrossChainAddress
 			// Amount is the amount argument value.
-			Amount sdk.Coin
+			Amount github_com_cosmos_cosmos_sdk_types.Coin
 			// FeeRate is the feeRate argument value.
-			FeeRate sdk.Dec
+			FeeRate github_com_cosmos_cosmos_sdk_types.Dec
+			// DepositTxID is the depositTxID argument value.
+			DepositTxID string
 		}
 		// GetChain holds details about calls to the GetChain method.
 		GetChain []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain string
 		}
 		// GetChainMaintainers holds details about calls to the GetChainMaintainers method.
 		GetChainMaintainers []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 		}
 		// GetRecipient holds details about calls to the GetRecipient method.
 		GetRecipient []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Sender is the sender argument value.
 			Sender nexus.CrossChainAddress
 		}
 		// GetTransfersForChain holds details about calls to the GetTransfersForChain method.
 		GetTransfersForChain []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 			// State is the state argument value.
@@ -1538,7 +1536,7 @@ type NexusMock struct {
 		// IsAssetRegistered holds details about calls to the IsAssetRegistered method.
 		IsAssetRegistered []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// ChainName is the chainName argument value.
 			ChainName string
 			// Denom is the denom argument value.
@@ -1547,14 +1545,23 @@ type NexusMock struct {
 		// IsChainActivated holds details about calls to the IsChainActivated method.
 		IsChainActivated []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
 			Chain nexus.Chain
 		}
+		// IsTransferFrozen holds details about calls to the IsTransferFrozen method.
+		IsTransferFrozen []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// Asset is the asset argument value.
+			Asset string
+		}
 		// LinkAddresses holds details about calls to the LinkAddresses method.
 		LinkAddresses []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Sender is the sender argument value.
 			Sender nexus.CrossChainAddress
 			// Recipient is the recipient argument value.
@@ -1569,37 +1576,42 @@ type NexusMock struct {
 	lockGetTransfersForChain   sync.RWMutex
 	lockIsAssetRegistered      sync.RWMutex
 	lockIsChainActivated       sync.RWMutex
+	lockIsTransferFrozen       sync.RWMutex
 	lockLinkAddresses          sync.RWMutex
 }
 
 // ArchivePendingTransfer calls ArchivePendingTransferFunc.
-func (mock *NexusMock) ArchivePendingTransfer(ctx sdk.Context, transfer nexus.CrossChainTransfer) {
+func (mock *NexusMock) ArchivePendingTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, transfer nexus.CrossChainTransfer, executionID string) {
 	if mock.ArchivePendingTransferFunc == nil {
 		panic("NexusMock.ArchivePendingTransferFunc: method is nil but Nexus.ArchivePendingTransfer was just called")
 	}
 	callInfo := struct {
-		Ctx      sdk.Context
-		Transfer nexus.CrossChainTransfer
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Transfer    nexus.CrossChainTransfer
+		ExecutionID string
 	}{
-		Ctx:      ctx,
-		Transfer: transfer,
+		Ctx:         ctx,
+		Transfer:    transfer,
+		ExecutionID: executionID,
 	}
 	mock.lockArchivePendingTransfer.Lock()
 	mock.calls.ArchivePendingTransfer = append(mock.calls.ArchivePendingTransfer, callInfo)
 	mock.lockArchivePendingTransfer.Unlock()
-	mock.ArchivePendingTransferFunc(ctx, transfer)
+	mock.ArchivePendingTransferFunc(ctx, transfer, executionID)
 }
 
 // ArchivePendingTransferCalls gets all the calls that were made to ArchivePendingTransfer.
 // Check the length with:
 //     len(mockedNexus.ArchivePendingTransferCalls())
 func (mock *NexusMock) ArchivePendingTransferCalls() []struct {
-	Ctx      sdk.Context
-	Transfer nexus.CrossChainTransfer
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Transfer    nexus.CrossChainTransfer
+	ExecutionID string
 } {
 	var calls []struct {
-		Ctx      sdk.Context
-		Transfer nexus.CrossChainTransfer
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Transfer    nexus.CrossChainTransfer
+		ExecutionID string
 	}
 	mock.lockArchivePendingTransfer.RLock()
 	calls = mock.calls.ArchivePendingTransfer
@@ -1608,41 +1620,45 @@ func (mock *NexusMock) ArchivePendingTransferCalls() []struct {
 }
 
 // EnqueueForTransfer calls EnqueueForTransferFunc.
-func (mock *NexusMock) EnqueueForTransfer(ctx sdk.Context, sender nexus.CrossChainAddress, amount sdk.Coin, feeRate sdk.Dec) error {
+func (mock *NexusMock) EnqueueForTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec, depositTxID string) error {
 	if mock.EnqueueForTransferFunc == nil {
 		panic("NexusMock.EnqueueForTransferFunc: method is nil but Nexus.EnqueueForTransfer was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
-		Sender  nexus.CrossChainAddress
-		Amount  sdk.Coin
-		FeeRate sdk.Dec
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Sender      nexus.CrossChainAddress
+		Amount      github_com_cosmos_cosmos_sdk_types.Coin
+		FeeRate     github_com_cosmos_cosmos_sdk_types.Dec
+		DepositTxID string
 	}{
-		Ctx:     ctx,
-		Sender:  sender,
-		Amount:  amount,
-		FeeRate: feeRate,
+		Ctx:         ctx,
+		Sender:      sender,
+		Amount:      amount,
+		FeeRate:     feeRate,
+		DepositTxID: depositTxID,
 	}
 	mock.lockEnqueueForTransfer.Lock()
 	mock.calls.EnqueueForTransfer = append(mock.calls.EnqueueForTransfer, callInfo)
 	mock.lockEnqueueForTransfer.Unlock()
-	return mock.EnqueueForTransferFunc(ctx, sender, amount, feeRate)
+	return mock.EnqueueForTransferFunc(ctx, sender, amount, feeRate, depositTxID)
 }
 
 // EnqueueForTransferCalls gets all the calls that were made to EnqueueForTransfer.
 // Check the length with:
 //     len(mockedNexus.EnqueueForTransferCalls())
 func (mock *NexusMock) EnqueueForTransferCalls() []struct {
-	Ctx     sdk.Context
-	Sender  nexus.CrossChainAddress
-	Amount  sdk.Coin
-	FeeRate sdk.Dec
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Sender      nexus.CrossChainAddress
+	Amount      github_com_cosmos_cosmos_sdk_types.Coin
+	FeeRate     github_com_cosmos_cosmos_sdk_types.Dec
+	DepositTxID string
 } {
 	var calls []struct {
-		Ctx     sdk.Context
-		Sender  nexus.CrossChainAddress
-		Amount  sdk.Coin
-		FeeRate sdk.Dec
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Sender      nexus.CrossChainAddress
+		Amount      github_com_cosmos_cosmos_sdk_types.Coin
+		FeeRate     github_com_cosmos_cosmos_sdk_types.Dec
+		DepositTxID string
 	}
 	mock.lockEnqueueForTransfer.RLock()
 	calls = mock.calls.EnqueueForTransfer
@@ -1651,12 +1667,12 @@ func (mock *NexusMock) EnqueueForTransferCalls() []struct {
 }
 
 // GetChain calls GetChainFunc.
-func (mock *NexusMock) GetChain(ctx sdk.Context, chain string) (nexus.Chain, bool) {
+func (mock *NexusMock) GetChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool) {
 	if mock.GetChainFunc == nil {
 		panic("NexusMock.GetChainFunc: method is nil but Nexus.GetChain was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain string
 	}{
 		Ctx:   ctx,
@@ -1672,11 +1688,11 @@ func (mock *NexusMock) GetChain(ctx sdk.Context, chain string) (nexus.Chain, boo
 // Check the length with:
 //     len(mockedNexus.GetChainCalls())
 func (mock *NexusMock) GetChainCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	Chain string
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain string
 	}
 	mock.lockGetChain.RLock()
@@ -1686,12 +1702,12 @@ func (mock *NexusMock) GetChainCalls() []struct {
 }
 
 // GetChainMaintainers calls GetChainMaintainersFunc.
-func (mock *NexusMock) GetChainMaintainers(ctx sdk.Context, chain nexus.Chain) []sdk.ValAddress {
+func (mock *NexusMock) GetChainMaintainers(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if mock.GetChainMaintainersFunc == nil {
 		panic("NexusMock.GetChainMaintainersFunc: method is nil but Nexus.GetChainMaintainers was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain nexus.Chain
 	}{
 		Ctx:   ctx,
@@ -1707,11 +1723,11 @@ func (mock *NexusMock) GetChainMaintainers(ctx sdk.Context, chain nexus.Chain) [
 // Check the length with:
 //     len(mockedNexus.GetChainMaintainersCalls())
 func (mock *NexusMock) GetChainMaintainersCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	Chain nexus.Chain
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain nexus.Chain
 	}
 	mock.lockGetChainMaintainers.RLock()
@@ -1721,12 +1737,12 @@ func (mock *NexusMock) GetChainMaintainersCalls() []struct {
 }
 
 // GetRecipient calls GetRecipientFunc.
-func (mock *NexusMock) GetRecipient(ctx sdk.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
+func (mock *NexusMock) GetRecipient(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
 	if mock.GetRecipientFunc == nil {
 		panic("NexusMock.GetRecipientFunc: method is nil but Nexus.GetRecipient was just called")
 	}
 	callInfo := struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		Sender nexus.CrossChainAddress
 	}{
 		Ctx:    ctx,
@@ -1742,11 +1758,11 @@ func (mock *NexusMock) GetRecipient(ctx sdk.Context, sender nexus.CrossChainAddr
 // Check the length with:
 //     len(mockedNexus.GetRecipientCalls())
 func (mock *NexusMock) GetRecipientCalls() []struct {
-	Ctx    sdk.Context
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
 	Sender nexus.CrossChainAddress
 } {
 	var calls []struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		Sender nexus.CrossChainAddress
 	}
 	mock.lockGetRecipient.RLock()
@@ -1756,12 +1772,12 @@ func (mock *NexusMock) GetRecipientCalls() []struct {
 }
 
 // GetTransfersForChain calls GetTransfersForChainFunc.
-func (mock *NexusMock) GetTransfersForChain(ctx sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
+func (mock *NexusMock) GetTransfersForChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
 	if mock.GetTransfersForChainFunc == nil {
 		panic("NexusMock.GetTransfersForChainFunc: method is nil but Nexus.GetTransfersForChain was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain nexus.Chain
 		State nexus.TransferState
 	}{
@@ -1779,12 +1795,12 @@ func (mock *NexusMock) GetTransfersForChain(ctx sdk.Context, chain nexus.Chain,
 // Check the length with:
 //     len(mockedNexus.GetTransfersForChainCalls())
 func (mock *NexusMock) GetTransfersForChainCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	Chain nexus.Chain
 	State nexus.TransferState
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain nexus.Chain
 		State nexus.TransferState
 	}
@@ -1795,12 +1811,12 @@ func (mock *NexusMock) GetTransfersForChainCalls() []struct {
 }
 
 // IsAssetRegistered calls IsAssetRegisteredFunc.
-func (mock *NexusMock) IsAssetRegistered(ctx sdk.Context, chainName string, denom string) bool {
+func (mock *NexusMock) IsAssetRegistered(ctx github_com_cosmos_cosmos_sdk_types.Context, chainName string, denom string) bool {
 	if mock.IsAssetRegisteredFunc == nil {
 		panic("NexusMock.IsAssetRegisteredFunc: method is nil but Nexus.IsAssetRegistered was just called")
 	}
 	callInfo := struct {
-		Ctx       sdk.Context
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
 		ChainName string
 		Denom     string
 	}{
@@ -1818,12 +1834,12 @@ func (mock *NexusMock) IsAssetRegistered(ctx sdk.Context, chainName string, deno
 // Check the length with:
 //     len(mockedNexus.IsAssetRegisteredCalls())
 func (mock *NexusMock) IsAssetRegisteredCalls() []struct {
-	Ctx       sdk.Context
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
 	ChainName string
 	Denom     string
 } {
 	var calls []struct {
-		Ctx       sdk.Context
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
 		ChainName string
 		Denom     string
 	}
@@ -1834,12 +1850,12 @@ func (mock *NexusMock) IsAssetRegisteredCalls() []struct {
 }
 
 // IsChainActivated calls IsChainActivatedFunc.
-func (mock *NexusMock) IsChainActivated(ctx sdk.Context, chain nexus.Chain) bool {
+func (mock *NexusMock) IsChainActivated(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) bool {
 	if mock.IsChainActivatedFunc == nil {
 		panic("NexusMock.IsChainActivatedFunc: method is nil but Nexus.IsChainActivated was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain nexus.Chain
 	}{
 		Ctx:   ctx,
@@ -1855,11 +1871,11 @@ func (mock *NexusMock) IsChainActivated(ctx sdk.Context, chain nexus.Chain) bool
 // Check the length with:
 //     len(mockedNexus.IsChainActivatedCalls())
 func (mock *NexusMock) IsChainActivatedCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	Chain nexus.Chain
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		Chain nexus.Chain
 	}
 	mock.lockIsChainActivated.RLock()
@@ -1868,13 +1884,52 @@ func (mock *NexusMock) IsChainActivatedCalls() []struct {
 	return calls
 }
 
+// IsTransferFrozen calls IsTransferFrozenFunc.
+func (mock *NexusMock) IsTransferFrozen(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, asset string) bool {
+	if mock.IsTransferFrozenFunc == nil {
+		panic("NexusMock.IsTransferFrozenFunc: method is nil but Nexus.IsTransferFrozen was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Chain nexus.Chain
+		Asset string
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+		Asset: asset,
+	}
+	mock.lockIsTransferFrozen.Lock()
+	mock.calls.IsTransferFrozen = append(mock.calls.IsTransferFrozen, callInfo)
+	mock.lockIsTransferFrozen.Unlock()
+	return mock.IsTransferFrozenFunc(ctx, chain, asset)
+}
+
+// IsTransferFrozenCalls gets all the calls that were made to IsTransferFrozen.
+// Check the length with:
+//     len(mockedNexus.IsTransferFrozenCalls())
+func (mock *NexusMock) IsTransferFrozenCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Chain nexus.Chain
+	Asset string
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Chain nexus.Chain
+		Asset string
+	}
+	mock.lockIsTransferFrozen.RLock()
+	calls = mock.calls.IsTransferFrozen
+	mock.lockIsTransferFrozen.RUnlock()
+	return calls
+}
+
 // LinkAddresses calls LinkAddressesFunc.
-func (mock *NexusMock) LinkAddresses(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress) {
+func (mock *NexusMock) LinkAddresses(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress) {
 	if mock.LinkAddressesFunc == nil {
 		panic("NexusMock.LinkAddressesFunc: method is nil but Nexus.LinkAddresses was just called")
 	}
 	callInfo := struct {
-		Ctx       sdk.Context
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
 		Sender    nexus.CrossChainAddress
 		Recipient nexus.CrossChainAddress
 	}{
@@ -1892,12 +1947,12 @@ func (mock *NexusMock) LinkAddresses(ctx sdk.Context, sender nexus.CrossChainAdd
 // Check the length with:
 //     len(mockedNexus.LinkAddressesCalls())
 func (mock *NexusMock) LinkAddressesCalls() []struct {
-	Ctx       sdk.Context
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
 	Sender    nexus.CrossChainAddress
 	Recipient nexus.CrossChainAddress
 } {
 	var calls []struct {
-		Ctx       sdk.Context
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
 		Sender    nexus.CrossChainAddress
 		Recipient nexus.CrossChainAddress
 	}
@@ -1917,25 +1972,25 @@ var _ types.Snapshotter = &SnapshotterMock{}
 //
 // 		// make and configure a mocked types.Snapshotter
 // 		mockedSnapshotter := &SnapshotterMock{
-// 			GetLatestCounterFunc: func(ctx sdk.Context) int64 {
+// 			GetLatestCounterFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 // 				panic("mock out the GetLatestCounter method")
 // 			},
-// 			GetLatestSnapshotFunc: func(ctx sdk.Context) (snapshot.Snapshot, bool) {
+// 			GetLatestSnapshotFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (snapshot.Snapshot, bool) {
 // 				panic("mock out the GetLatestSnapshot method")
 // 			},
-// 			GetOperatorFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+// 			GetOperatorFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, proxy github_com_cosmos_cosmos_sdk_types.AccAddress) github_com_cosmos_cosmos_sdk_types.ValAddress {
 // 				panic("mock out the GetOperator method")
 // 			},
-// 			GetProxyFunc: func(ctx sdk.Context, principal sdk.ValAddress) (sdk.AccAddress, bool) {
+// 			GetProxyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, principal github_com_cosmos_cosmos_sdk_types.ValAddress) (github_com_cosmos_cosmos_sdk_types.AccAddress, bool) {
 // 				panic("mock out the GetProxy method")
 // 			},
-// 			GetSnapshotFunc: func(ctx sdk.Context, seqNo int64) (snapshot.Snapshot, bool) {
+// 			GetSnapshotFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, seqNo int64) (snapshot.Snapshot, bool) {
 // 				panic("mock out the GetSnapshot method")
 // 			},
-// 			GetValidatorIllegibilityFunc: func(ctx sdk.Context, validator snapshot.SDKValidator) (snapshot.ValidatorIllegibility, error) {
+// 			GetValidatorIllegibilityFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator snapshot.SDKValidator) (snapshot.ValidatorIllegibility, error) {
 // 				panic("mock out the GetValidatorIllegibility method")
 // 			},
-// 			TakeSnapshotFunc: func(ctx sdk.Context, keyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement) (snapshot.Snapshot, error) {
+// 			TakeSnapshotFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement) (snapshot.Snapshot, error) {
 // 				panic("mock out the TakeSnapshot method")
 // 			},
 // 		}
@@ -1946,70 +2001,70 @@ var _ types.Snapshotter = &SnapshotterMock{}
 // 	}
 type SnapshotterMock struct {
 	// GetLatestCounterFunc mocks the GetLatestCounter method.
-	GetLatestCounterFunc func(ctx sdk.Context) int64
+	GetLatestCounterFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
 	// GetLatestSnapshotFunc mocks the GetLatestSnapshot method.
-	GetLatestSnapshotFunc func(ctx sdk.Context) (snapshot.Snapshot, bool)
+	GetLatestSnapshotFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (snapshot.Snapshot, bool)
 
 	// GetOperatorFunc mocks the GetOperator method.
-	GetOperatorFunc func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+	GetOperatorFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, proxy github_com_cosmos_cosmos_sdk_types.AccAddress) github_com_cosmos_cosmos_sdk_types.ValAddress
 
 	// GetProxyFunc mocks the GetProxy method.
-	GetProxyFunc func(ctx sdk.Context, principal sdk.ValAddress) (sdk.AccAddress, bool)
+	GetProxyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, principal github_com_cosmos_cosmos_sdk_types.ValAddress) (github_com_cosmos_cosmos_sdk_types.AccAddress, bool)
 
 	// GetSnapshotFunc mocks the GetSnapshot method.
-	GetSnapshotFunc func(ctx sdk.Context, seqNo int64) (snapshot.Snapshot, bool)
+	GetSnapshotFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, seqNo int64) (snapshot.Snapshot, bool)
 
 	// GetValidatorIllegibilityFunc mocks the GetValidatorIllegibility method.
-	GetValidatorIllegibilityFunc func(ctx sdk.Context, validator snapshot.SDKValidator) (snapshot.ValidatorIllegibility, error)
+	GetValidatorIllegibilityFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator snapshot.SDKValidator) (snapshot.ValidatorIllegibility, error)
 
 	// TakeSnapshotFunc mocks the TakeSnapshot method.
-	TakeSnapshotFunc func(ctx sdk.Context, keyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement) (snapshot.Snapshot, error)
+	TakeSnapshotFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement) (snapshot.Snapshot, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetLatestCounter holds details about calls to the GetLatestCounter method.
 		GetLatestCounter []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetLatestSnapshot holds details about calls to the GetLatestSnapshot method.
 		GetLatestSnapshot []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetOperator holds details about calls to the GetOperator method.
 		GetOperator []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Proxy is the proxy argument value.
-			Proxy sdk.AccAddress
+			Proxy github_com_cosmos_cosmos_sdk_types.AccAddress
 		}
 		// GetProxy holds details about calls to the GetProxy method.
 		GetProxy []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Principal is the principal argument value.
-			Principal sdk.ValAddress
+			Principal github_com_cosmos_cosmos_sdk_types.ValAddress
 		}
 		// GetSnapshot holds details about calls to the GetSnapshot method.
 		GetSnapshot []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// SeqNo is the seqNo argument value.
 			SeqNo int64
 		}
 		// GetValidatorIllegibility holds details about calls to the GetValidatorIllegibility method.
 		GetValidatorIllegibility []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Validator is the validator argument value.
 			Validator snapshot.SDKValidator
 		}
 		// TakeSnapshot holds details about calls to the TakeSnapshot method.
 		TakeSnapshot []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// KeyRequirement is the keyRequirement argument value.
 			KeyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement
 		}
@@ -2024,12 +2079,12 @@ type SnapshotterMock struct {
 }
 
 // GetLatestCounter calls GetLatestCounterFunc.
-func (mock *SnapshotterMock) GetLatestCounter(ctx sdk.Context) int64 {
+func (mock *SnapshotterMock) GetLatestCounter(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 	if mock.GetLatestCounterFunc == nil {
 		panic("SnapshotterMock.GetLatestCounterFunc: method is nil but Snapshotter.GetLatestCounter was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -2043,10 +2098,10 @@ func (mock *SnapshotterMock) GetLatestCounter(ctx sdk.Context) int64 {
 // Check the length with:
 //     len(mockedSnapshotter.GetLatestCounterCalls())
 func (mock *SnapshotterMock) GetLatestCounterCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetLatestCounter.RLock()
 	calls = mock.calls.GetLatestCounter
@@ -2055,12 +2110,12 @@ func (mock *SnapshotterMock) GetLatestCounterCalls() []struct {
 }
 
 // GetLatestSnapshot calls GetLatestSnapshotFunc.
-func (mock *SnapshotterMock) GetLatestSnapshot(ctx sdk.Context) (snapshot.Snapshot, bool) {
+func (mock *SnapshotterMock) GetLatestSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context) (snapshot.Snapshot, bool) {
 	if mock.GetLatestSnapshotFunc == nil {
 		panic("SnapshotterMock.GetLatestSnapshotFunc: method is nil but Snapshotter.GetLatestSnapshot was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -2074,10 +2129,10 @@ func (mock *SnapshotterMock) GetLatestSnapshot(ctx sdk.Context) (snapshot.Snapsh
 // Check the length with:
 //     len(mockedSnapshotter.GetLatestSnapshotCalls())
 func (mock *SnapshotterMock) GetLatestSnapshotCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetLatestSnapshot.RLock()
 	calls = mock.calls.GetLatestSnapshot
@@ -2086,13 +2141,13 @@ func (mock *SnapshotterMock) GetLatestSnapshotCalls() []struct {
 }
 
 // GetOperator calls GetOperatorFunc.
-func (mock *SnapshotterMock) GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+func (mock *SnapshotterMock) GetOperator(ctx github_com_cosmos_cosmos_sdk_types.Context, proxy github_com_cosmos_cosmos_sdk_types.AccAddress) github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if mock.GetOperatorFunc == nil {
 		panic("SnapshotterMock.GetOperatorFunc: method is nil but Snapshotter.GetOperator was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
-		Proxy sdk.AccAddress
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Proxy github_com_cosmos_cosmos_sdk_types.AccAddress
 	}{
 		Ctx:   ctx,
 		Proxy: proxy,
@@ -2107,12 +2162,12 @@ func (mock *SnapshotterMock) GetOperator(ctx sdk.Context, proxy sdk.AccAddress)
 // Check the length with:
 //     len(mockedSnapshotter.GetOperatorCalls())
 func (mock *SnapshotterMock) GetOperatorCalls() []struct {
-	Ctx   sdk.Context
-	Proxy sdk.AccAddress
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Proxy github_com_cosmos_cosmos_sdk_types.AccAddress
 } {
 	var calls []struct {
-		Ctx   sdk.Context
-		Proxy sdk.AccAddress
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Proxy github_com_cosmos_cosmos_sdk_types.AccAddress
 	}
 	mock.lockGetOperator.RLock()
 	calls = mock.calls.GetOperator
@@ -2121,13 +2176,13 @@ func (mock *SnapshotterMock) GetOperatorCalls() []struct {
 }
 
 // GetProxy calls GetProxyFunc.
-func (mock *SnapshotterMock) GetProxy(ctx sdk.Context, principal sdk.ValAddress) (sdk.AccAddress, bool) {
+func (mock *SnapshotterMock) GetProxy(ctx github_com_cosmos_cosmos_sdk_types.Context, principal github_com_cosmos_cosmos_sdk_types.ValAddress) (github_com_cosmos_cosmos_sdk_types.AccAddress, bool) {
 	if mock.GetProxyFunc == nil {
 		panic("SnapshotterMock.GetProxyFunc: method is nil but Snapshotter.GetProxy was just called")
 	}
 	callInfo := struct {
-		Ctx       sdk.Context
-		Principal sdk.ValAddress
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Principal github_com_cosmos_cosmos_sdk_types.ValAddress
 	}{
 		Ctx:       ctx,
 		Principal: principal,
@@ -2142,12 +2197,12 @@ func (mock *SnapshotterMock) GetProxy(ctx sdk.Context, principal sdk.ValAddress)
 // Check the length with:
 //     len(mockedSnapshotter.GetProxyCalls())
 func (mock *SnapshotterMock) GetProxyCalls() []struct {
-	Ctx       sdk.Context
-	Principal sdk.ValAddress
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	Principal github_com_cosmos_cosmos_sdk_types.ValAddress
 } {
 	var calls []struct {
-		Ctx       sdk.Context
-		Principal sdk.ValAddress
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Principal github_com_cosmos_cosmos_sdk_types.ValAddress
 	}
 	mock.lockGetProxy.RLock()
 	calls = mock.calls.GetProxy
@@ -2156,12 +2211,12 @@ func (mock *SnapshotterMock) GetProxyCalls() []struct {
 }
 
 // GetSnapshot calls GetSnapshotFunc.
-func (mock *SnapshotterMock) GetSnapshot(ctx sdk.Context, seqNo int64) (snapshot.Snapshot, bool) {
+func (mock *SnapshotterMock) GetSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, seqNo int64) (snapshot.Snapshot, bool) {
 	if mock.GetSnapshotFunc == nil {
 		panic("SnapshotterMock.GetSnapshotFunc: method is nil but Snapshotter.GetSnapshot was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		SeqNo int64
 	}{
 		Ctx:   ctx,
@@ -2177,11 +2232,11 @@ func (mock *SnapshotterMock) GetSnapshot(ctx sdk.Context, seqNo int64) (snapshot
 // Check the length with:
 //     len(mockedSnapshotter.GetSnapshotCalls())
 func (mock *SnapshotterMock) GetSnapshotCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	SeqNo int64
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		SeqNo int64
 	}
 	mock.lockGetSnapshot.RLock()
@@ -2191,12 +2246,12 @@ func (mock *SnapshotterMock) GetSnapshotCalls() []struct {
 }
 
 // GetValidatorIllegibility calls GetValidatorIllegibilityFunc.
-func (mock *SnapshotterMock) GetValidatorIllegibility(ctx sdk.Context, validator snapshot.SDKValidator) (snapshot.ValidatorIllegibility, error) {
+func (mock *SnapshotterMock) GetValidatorIllegibility(ctx github_com_cosmos_cosmos_sdk_types.Context, validator snapshot.SDKValidator) (snapshot.ValidatorIllegibility, error) {
 	if mock.GetValidatorIllegibilityFunc == nil {
 		panic("SnapshotterMock.GetValidatorIllegibilityFunc: method is nil but Snapshotter.GetValidatorIllegibility was just called")
 	}
 	callInfo := struct {
-		Ctx       sdk.Context
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
 		Validator snapshot.SDKValidator
 	}{
 		Ctx:       ctx,
@@ -2212,11 +2267,11 @@ func (mock *SnapshotterMock) GetValidatorIllegibility(ctx sdk.Context, validator
 // Check the length with:
 //     len(mockedSnapshotter.GetValidatorIllegibilityCalls())
 func (mock *SnapshotterMock) GetValidatorIllegibilityCalls() []struct {
-	Ctx       sdk.Context
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
 	Validator snapshot.SDKValidator
 } {
 	var calls []struct {
-		Ctx       sdk.Context
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
 		Validator snapshot.SDKValidator
 	}
 	mock.lockGetValidatorIllegibility.RLock()
@@ -2226,12 +2281,12 @@ func (mock *SnapshotterMock) GetValidatorIllegibilityCalls() []struct {
 }
 
 // TakeSnapshot calls TakeSnapshotFunc.
-func (mock *SnapshotterMock) TakeSnapshot(ctx sdk.Context, keyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement) (snapshot.Snapshot, error) {
+func (mock *SnapshotterMock) TakeSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, keyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement) (snapshot.Snapshot, error) {
 	if mock.TakeSnapshotFunc == nil {
 		panic("SnapshotterMock.TakeSnapshotFunc: method is nil but Snapshotter.TakeSnapshot was just called")
 	}
 	callInfo := struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		KeyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement
 	}{
 		Ctx:            ctx,
@@ -2247,11 +2302,11 @@ func (mock *SnapshotterMock) TakeSnapshot(ctx sdk.Context, keyRequirement github
 // Check the length with:
 //     len(mockedSnapshotter.TakeSnapshotCalls())
 func (mock *SnapshotterMock) TakeSnapshotCalls() []struct {
-	Ctx            sdk.Context
+	Ctx            github_com_cosmos_cosmos_sdk_types.Context
 	KeyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement
 } {
 	var calls []struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		KeyRequirement github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement
 	}
 	mock.lockTakeSnapshot.RLock()
@@ -2270,124 +2325,157 @@ var _ types.BTCKeeper = &BTCKeeperMock{}
 //
 // 		// make and configure a mocked types.BTCKeeper
 // 		mockedBTCKeeper := &BTCKeeperMock{
-// 			DeleteDustAmountFunc: func(ctx sdk.Context, encodedAddress string)  {
+// 			DeleteDustAmountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string)  {
 // 				panic("mock out the DeleteDustAmount method")
 // 			},
-// 			DeleteOutpointInfoFunc: func(ctx sdk.Context, outPoint wire.OutPoint)  {
+// 			DeleteOutpointInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, outPoint wire.OutPoint)  {
 // 				panic("mock out the DeleteOutpointInfo method")
 // 			},
-// 			DeletePendingOutPointInfoFunc: func(ctx sdk.Context, key exported.PollKey)  {
+// 			DeletePendingOutPointInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey)  {
 // 				panic("mock out the DeletePendingOutPointInfo method")
 // 			},
-// 			DeleteUnsignedTxFunc: func(ctx sdk.Context, txType types.TxType)  {
+// 			DeleteUnsignedTxFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType)  {
 // 				panic("mock out the DeleteUnsignedTx method")
 // 			},
-// 			GetAddressFunc: func(ctx sdk.Context, encodedAddress string) (types.AddressInfo, bool) {
+// 			ExportGenesisFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) *types.GenesisState {
+// 				panic("mock out the ExportGenesis method")
+// 			},
+// 			GetAddressFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string) (types.AddressInfo, bool) {
 // 				panic("mock out the GetAddress method")
 // 			},
-// 			GetAnyoneCanSpendAddressFunc: func(ctx sdk.Context) types.AddressInfo {
+// 			GetAnyoneCanSpendAddressFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.AddressInfo {
 // 				panic("mock out the GetAnyoneCanSpendAddress method")
 // 			},
-// 			GetConfirmedOutpointInfoQueueForKeyFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) utils.KVQueue {
+// 			GetConfirmedOutpointInfoQueueForKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) utils.KVQueue {
 // 				panic("mock out the GetConfirmedOutpointInfoQueueForKey method")
 // 			},
-// 			GetDustAmountFunc: func(ctx sdk.Context, encodedAddress string) github_com_btcsuite_btcutil.Amount {
+// 			GetConsolidationRetryHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
+// 				panic("mock out the GetConsolidationRetryHeight method")
+// 			},
+// 			GetConsolidationScheduleFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.ConsolidationSchedule {
+// 				panic("mock out the GetConsolidationSchedule method")
+// 			},
+// 			GetDustAmountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string) github_com_btcsuite_btcutil.Amount {
 // 				panic("mock out the GetDustAmount method")
 // 			},
-// 			GetLatestSignedTxHashFunc: func(ctx sdk.Context, txType types.TxType) (*chainhash.Hash, bool) {
+// 			GetFeeRateFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, confTarget int64) (github_com_btcsuite_btcutil.Amount, bool) {
+// 				panic("mock out the GetFeeRate method")
+// 			},
+// 			GetFeeRateEstimationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.FeeRateEstimation {
+// 				panic("mock out the GetFeeRateEstimation method")
+// 			},
+// 			GetFeeRateVoteFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, confTarget int64, voter github_com_cosmos_cosmos_sdk_types.ValAddress) (types.FeeRateVote, bool) {
+// 				panic("mock out the GetFeeRateVote method")
+// 			},
+// 			GetLatestSignedTxHashFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType) (*chainhash.Hash, bool) {
 // 				panic("mock out the GetLatestSignedTxHash method")
 // 			},
-// 			GetMasterAddressExternalKeyLockDurationFunc: func(ctx sdk.Context) time.Duration {
+// 			GetMasterAddressExternalKeyLockDurationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) time.Duration {
 // 				panic("mock out the GetMasterAddressExternalKeyLockDuration method")
 // 			},
-// 			GetMasterAddressInternalKeyLockDurationFunc: func(ctx sdk.Context) time.Duration {
+// 			GetMasterAddressInternalKeyLockDurationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) time.Duration {
 // 				panic("mock out the GetMasterAddressInternalKeyLockDuration method")
 // 			},
-// 			GetMasterKeyRetentionPeriodFunc: func(ctx sdk.Context) int64 {
+// 			GetMasterKeyRetentionPeriodFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 // 				panic("mock out the GetMasterKeyRetentionPeriod method")
 // 			},
-// 			GetMaxInputCountFunc: func(ctx sdk.Context) int64 {
+// 			GetMaxInputCountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 // 				panic("mock out the GetMaxInputCount method")
 // 			},
-// 			GetMaxSecondaryOutputAmountFunc: func(ctx sdk.Context) github_com_btcsuite_btcutil.Amount {
+// 			GetMaxSecondaryOutputAmountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_btcsuite_btcutil.Amount {
 // 				panic("mock out the GetMaxSecondaryOutputAmount method")
 // 			},
-// 			GetMaxTxSizeFunc: func(ctx sdk.Context) int64 {
+// 			GetMaxTxSizeFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 // 				panic("mock out the GetMaxTxSize method")
 // 			},
-// 			GetMinOutputAmountFunc: func(ctx sdk.Context) github_com_btcsuite_btcutil.Amount {
+// 			GetMinOutputAmountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_btcsuite_btcutil.Amount {
 // 				panic("mock out the GetMinOutputAmount method")
 // 			},
-// 			GetMinVoterCountFunc: func(ctx sdk.Context) int64 {
+// 			GetMinVoterCountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 // 				panic("mock out the GetMinVoterCount method")
 // 			},
-// 			GetNetworkFunc: func(ctx sdk.Context) types.Network {
+// 			GetNetworkFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Network {
 // 				panic("mock out the GetNetwork method")
 // 			},
-// 			GetOutPointInfoFunc: func(ctx sdk.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
+// 			GetOutPointInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
 // 				panic("mock out the GetOutPointInfo method")
 // 			},
-// 			GetParamsFunc: func(ctx sdk.Context) types.Params {
+// 			GetParamsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Params {
 // 				panic("mock out the GetParams method")
 // 			},
-// 			GetPendingOutPointInfoFunc: func(ctx sdk.Context, key exported.PollKey) (types.OutPointInfo, bool) {
+// 			GetPendingOutPointInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey) (types.OutPointInfo, bool) {
 // 				panic("mock out the GetPendingOutPointInfo method")
 // 			},
-// 			GetRequiredConfirmationHeightFunc: func(ctx sdk.Context) uint64 {
+// 			GetRequiredConfirmationHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) uint64 {
 // 				panic("mock out the GetRequiredConfirmationHeight method")
 // 			},
-// 			GetRevoteLockingPeriodFunc: func(ctx sdk.Context) int64 {
+// 			GetRevoteLockingPeriodFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 // 				panic("mock out the GetRevoteLockingPeriod method")
 // 			},
-// 			GetSigCheckIntervalFunc: func(ctx sdk.Context) int64 {
+// 			GetSigCheckIntervalFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 // 				panic("mock out the GetSigCheckInterval method")
 // 			},
-// 			GetSignedTxFunc: func(ctx sdk.Context, txHash chainhash.Hash) (types.SignedTx, bool) {
+// 			GetSignedTxFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, txHash chainhash.Hash) (types.SignedTx, bool) {
 // 				panic("mock out the GetSignedTx method")
 // 			},
-// 			GetTransactionFeeRateFunc: func(ctx sdk.Context) sdk.Dec {
+// 			GetTransactionFeeRateFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_cosmos_cosmos_sdk_types.Dec {
 // 				panic("mock out the GetTransactionFeeRate method")
 // 			},
-// 			GetUnconfirmedAmountFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_btcsuite_btcutil.Amount {
+// 			GetTxFeeRateFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_btcsuite_btcutil.Amount {
+// 				panic("mock out the GetTxFeeRate method")
+// 			},
+// 			GetUnconfirmedAmountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_btcsuite_btcutil.Amount {
 // 				panic("mock out the GetUnconfirmedAmount method")
 // 			},
-// 			GetUnsignedTxFunc: func(ctx sdk.Context, txType types.TxType) (types.UnsignedTx, bool) {
+// 			GetUnsignedTxFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType) (types.UnsignedTx, bool) {
 // 				panic("mock out the GetUnsignedTx method")
 // 			},
-// 			GetVotingThresholdFunc: func(ctx sdk.Context) utils.Threshold {
+// 			GetVotingThresholdFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.Threshold {
 // 				panic("mock out the GetVotingThreshold method")
 // 			},
-// 			LoggerFunc: func(ctx sdk.Context) log.Logger {
+// 			InitGenesisFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, genState *types.GenesisState)  {
+// 				panic("mock out the InitGenesis method")
+// 			},
+// 			LoggerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
 // 				panic("mock out the Logger method")
 // 			},
-// 			SetAddressFunc: func(ctx sdk.Context, address types.AddressInfo)  {
+// 			SetAddressFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, address types.AddressInfo)  {
 // 				panic("mock out the SetAddress method")
 // 			},
-// 			SetConfirmedOutpointInfoFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, info types.OutPointInfo)  {
+// 			SetConfirmedOutpointInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, info types.OutPointInfo)  {
 // 				panic("mock out the SetConfirmedOutpointInfo method")
 // 			},
-// 			SetDustAmountFunc: func(ctx sdk.Context, encodedAddress string, amount github_com_btcsuite_btcutil.Amount)  {
+// 			SetConsolidationRetryHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, height int64)  {
+// 				panic("mock out the SetConsolidationRetryHeight method")
+// 			},
+// 			SetDustAmountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string, amount github_com_btcsuite_btcutil.Amount)  {
 // 				panic("mock out the SetDustAmount method")
 // 			},
-// 			SetLatestSignedTxHashFunc: func(ctx sdk.Context, txType types.TxType, txHash chainhash.Hash)  {
+// 			SetFeeRateFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, confTarget int64, feeRate github_com_btcsuite_btcutil.Amount)  {
+// 				panic("mock out the SetFeeRate method")
+// 			},
+// 			SetFeeRateVoteFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, vote types.FeeRateVote)  {
+// 				panic("mock out the SetFeeRateVote method")
+// 			},
+// 			SetLatestSignedTxHashFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType, txHash chainhash.Hash)  {
 // 				panic("mock out the SetLatestSignedTxHash method")
 // 			},
-// 			SetParamsFunc: func(ctx sdk.Context, p types.Params)  {
+// 			SetParamsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params)  {
 // 				panic("mock out the SetParams method")
 // 			},
-// 			SetPendingOutpointInfoFunc: func(ctx sdk.Context, key exported.PollKey, info types.OutPointInfo)  {
+// 			SetPendingOutpointInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey, info types.OutPointInfo)  {
 // 				panic("mock out the SetPendingOutpointInfo method")
 // 			},
-// 			SetSignedTxFunc: func(ctx sdk.Context, tx types.SignedTx)  {
+// 			SetSignedTxFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, tx types.SignedTx)  {
 // 				panic("mock out the SetSignedTx method")
 // 			},
-// 			SetSpentOutpointInfoFunc: func(ctx sdk.Context, info types.OutPointInfo)  {
+// 			SetSpentOutpointInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, info types.OutPointInfo)  {
 // 				panic("mock out the SetSpentOutpointInfo method")
 // 			},
-// 			SetUnconfirmedAmountFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, amount github_com_btcsuite_btcutil.Amount)  {
+// 			SetUnconfirmedAmountFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, amount github_com_btcsuite_btcutil.Amount)  {
 // 				panic("mock out the SetUnconfirmedAmount method")
 // 			},
-// 			SetUnsignedTxFunc: func(ctx sdk.Context, tx types.UnsignedTx)  {
+// 			SetUnsignedTxFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, tx types.UnsignedTx)  {
 // 				panic("mock out the SetUnsignedTx method")
 // 			},
 // 		}
@@ -2398,332 +2486,436 @@ var _ types.BTCKeeper = &BTCKeeperMock{}
 // 	}
 type BTCKeeperMock struct {
 	// DeleteDustAmountFunc mocks the DeleteDustAmount method.
-	DeleteDustAmountFunc func(ctx sdk.Context, encodedAddress string)
+	DeleteDustAmountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string)
 
 	// DeleteOutpointInfoFunc mocks the DeleteOutpointInfo method.
-	DeleteOutpointInfoFunc func(ctx sdk.Context, outPoint wire.OutPoint)
+	DeleteOutpointInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, outPoint wire.OutPoint)
 
 	// DeletePendingOutPointInfoFunc mocks the DeletePendingOutPointInfo method.
-	DeletePendingOutPointInfoFunc func(ctx sdk.Context, key exported.PollKey)
+	DeletePendingOutPointInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey)
 
 	// DeleteUnsignedTxFunc mocks the DeleteUnsignedTx method.
-	DeleteUnsignedTxFunc func(ctx sdk.Context, txType types.TxType)
+	DeleteUnsignedTxFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType)
+
+	// ExportGenesisFunc mocks the ExportGenesis method.
+	ExportGenesisFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) *types.GenesisState
 
 	// GetAddressFunc mocks the GetAddress method.
-	GetAddressFunc func(ctx sdk.Context, encodedAddress string) (types.AddressInfo, bool)
+	GetAddressFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string) (types.AddressInfo, bool)
 
 	// GetAnyoneCanSpendAddressFunc mocks the GetAnyoneCanSpendAddress method.
-	GetAnyoneCanSpendAddressFunc func(ctx sdk.Context) types.AddressInfo
+	GetAnyoneCanSpendAddressFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.AddressInfo
 
 	// GetConfirmedOutpointInfoQueueForKeyFunc mocks the GetConfirmedOutpointInfoQueueForKey method.
-	GetConfirmedOutpointInfoQueueForKeyFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) utils.KVQueue
+	GetConfirmedOutpointInfoQueueForKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) utils.KVQueue
+
+	// GetConsolidationRetryHeightFunc mocks the GetConsolidationRetryHeight method.
+	GetConsolidationRetryHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
+
+	// GetConsolidationScheduleFunc mocks the GetConsolidationSchedule method.
+	GetConsolidationScheduleFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.ConsolidationSchedule
 
 	// GetDustAmountFunc mocks the GetDustAmount method.
-	GetDustAmountFunc func(ctx sdk.Context, encodedAddress string) github_com_btcsuite_btcutil.Amount
+	GetDustAmountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string) github_com_btcsuite_btcutil.Amount
+
+	// GetFeeRateFunc mocks the GetFeeRate method.
+	GetFeeRateFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, confTarget int64) (github_com_btcsuite_btcutil.Amount, bool)
+
+	// GetFeeRateEstimationFunc mocks the GetFeeRateEstimation method.
+	GetFeeRateEstimationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.FeeRateEstimation
+
+	// GetFeeRateVoteFunc mocks the GetFeeRateVote method.
+	GetFeeRateVoteFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, confTarget int64, voter github_com_cosmos_cosmos_sdk_types.ValAddress) (types.FeeRateVote, bool)
 
 	// GetLatestSignedTxHashFunc mocks the GetLatestSignedTxHash method.
-	GetLatestSignedTxHashFunc func(ctx sdk.Context, txType types.TxType) (*chainhash.Hash, bool)
+	GetLatestSignedTxHashFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType) (*chainhash.Hash, bool)
 
 	// GetMasterAddressExternalKeyLockDurationFunc mocks the GetMasterAddressExternalKeyLockDuration method.
-	GetMasterAddressExternalKeyLockDurationFunc func(ctx sdk.Context) time.Duration
+	GetMasterAddressExternalKeyLockDurationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) time.Duration
 
 	// GetMasterAddressInternalKeyLockDurationFunc mocks the GetMasterAddressInternalKeyLockDuration method.
-	GetMasterAddressInternalKeyLockDurationFunc func(ctx sdk.Context) time.Duration
+	GetMasterAddressInternalKeyLockDurationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) time.Duration
 
 	// GetMasterKeyRetentionPeriodFunc mocks the GetMasterKeyRetentionPeriod method.
-	GetMasterKeyRetentionPeriodFunc func(ctx sdk.Context) int64
+	GetMasterKeyRetentionPeriodFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
 	// GetMaxInputCountFunc mocks the GetMaxInputCount method.
-	GetMaxInputCountFunc func(ctx sdk.Context) int64
+	GetMaxInputCountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
 	// GetMaxSecondaryOutputAmountFunc mocks the GetMaxSecondaryOutputAmount method.
-	GetMaxSecondaryOutputAmountFunc func(ctx sdk.Context) github_com_btcsuite_btcutil.Amount
+	GetMaxSecondaryOutputAmountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_btcsuite_btcutil.Amount
 
 	// GetMaxTxSizeFunc mocks the GetMaxTxSize method.
-	GetMaxTxSizeFunc func(ctx sdk.Context) int64
+	GetMaxTxSizeFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
 	// GetMinOutputAmountFunc mocks the GetMinOutputAmount method.
-	GetMinOutputAmountFunc func(ctx sdk.Context) github_com_btcsuite_btcutil.Amount
+	GetMinOutputAmountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_btcsuite_btcutil.Amount
 
 	// GetMinVoterCountFunc mocks the GetMinVoterCount method.
-	GetMinVoterCountFunc func(ctx sdk.Context) int64
+	GetMinVoterCountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
 	// GetNetworkFunc mocks the GetNetwork method.
-	GetNetworkFunc func(ctx sdk.Context) types.Network
+	GetNetworkFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Network
 
 	// GetOutPointInfoFunc mocks the GetOutPointInfo method.
-	GetOutPointInfoFunc func(ctx sdk.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool)
+	GetOutPointInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool)
 
 	// GetParamsFunc mocks the GetParams method.
-	GetParamsFunc func(ctx sdk.Context) types.Params
+	GetParamsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Params
 
 	// GetPendingOutPointInfoFunc mocks the GetPendingOutPointInfo method.
-	GetPendingOutPointInfoFunc func(ctx sdk.Context, key exported.PollKey) (types.OutPointInfo, bool)
+	GetPendingOutPointInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey) (types.OutPointInfo, bool)
 
 	// GetRequiredConfirmationHeightFunc mocks the GetRequiredConfirmationHeight method.
-	GetRequiredConfirmationHeightFunc func(ctx sdk.Context) uint64
+	GetRequiredConfirmationHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) uint64
 
 	// GetRevoteLockingPeriodFunc mocks the GetRevoteLockingPeriod method.
-	GetRevoteLockingPeriodFunc func(ctx sdk.Context) int64
+	GetRevoteLockingPeriodFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
 	// GetSigCheckIntervalFunc mocks the GetSigCheckInterval method.
-	GetSigCheckIntervalFunc func(ctx sdk.Context) int64
+	GetSigCheckIntervalFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
 	// GetSignedTxFunc mocks the GetSignedTx method.
-	GetSignedTxFunc func(ctx sdk.Context, txHash chainhash.Hash) (types.SignedTx, bool)
+	GetSignedTxFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, txHash chainhash.Hash) (types.SignedTx, bool)
 
 	// GetTransactionFeeRateFunc mocks the GetTransactionFeeRate method.
-	GetTransactionFeeRateFunc func(ctx sdk.Context) sdk.Dec
+	GetTransactionFeeRateFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_cosmos_cosmos_sdk_types.Dec
+
+	// GetTxFeeRateFunc mocks the GetTxFeeRate method.
+	GetTxFeeRateFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_btcsuite_btcutil.Amount
 
 	// GetUnconfirmedAmountFunc mocks the GetUnconfirmedAmount method.
-	GetUnconfirmedAmountFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_btcsuite_btcutil.Amount
+	GetUnconfirmedAmountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_btcsuite_btcutil.Amount
 
 	// GetUnsignedTxFunc mocks the GetUnsignedTx method.
-	GetUnsignedTxFunc func(ctx sdk.Context, txType types.TxType) (types.UnsignedTx, bool)
+	GetUnsignedTxFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType) (types.UnsignedTx, bool)
 
 	// GetVotingThresholdFunc mocks the GetVotingThreshold method.
-	GetVotingThresholdFunc func(ctx sdk.Context) utils.Threshold
+	GetVotingThresholdFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.Threshold
+
+	// InitGenesisFunc mocks the InitGenesis method.
+	InitGenesisFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, genState *types.GenesisState)
 
 	// LoggerFunc mocks the Logger method.
-	LoggerFunc func(ctx sdk.Context) log.Logger
+	LoggerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger
 
 	// SetAddressFunc mocks the SetAddress method.
-	SetAddressFunc func(ctx sdk.Context, address types.AddressInfo)
+	SetAddressFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, address types.AddressInfo)
 
 	// SetConfirmedOutpointInfoFunc mocks the SetConfirmedOutpointInfo method.
-	SetConfirmedOutpointInfoFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, info types.OutPointInfo)
+	SetConfirmedOutpointInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, info types.OutPointInfo)
+
+	// SetConsolidationRetryHeightFunc mocks the SetConsolidationRetryHeight method.
+	SetConsolidationRetryHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, height int64)
 
 	// SetDustAmountFunc mocks the SetDustAmount method.
-	SetDustAmountFunc func(ctx sdk.Context, encodedAddress string, amount github_com_btcsuite_btcutil.Amount)
+	SetDustAmountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string, amount github_com_btcsuite_btcutil.Amount)
+
+	// SetFeeRateFunc mocks the SetFeeRate method.
+	SetFeeRateFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, confTarget int64, feeRate github_com_btcsuite_btcutil.Amount)
+
+	// SetFeeRateVoteFunc mocks the SetFeeRateVote method.
+	SetFeeRateVoteFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, vote types.FeeRateVote)
 
 	// SetLatestSignedTxHashFunc mocks the SetLatestSignedTxHash method.
-	SetLatestSignedTxHashFunc func(ctx sdk.Context, txType types.TxType, txHash chainhash.Hash)
+	SetLatestSignedTxHashFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType, txHash chainhash.Hash)
 
 	// SetParamsFunc mocks the SetParams method.
-	SetParamsFunc func(ctx sdk.Context, p types.Params)
+	SetParamsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params)
 
 	// SetPendingOutpointInfoFunc mocks the SetPendingOutpointInfo method.
-	SetPendingOutpointInfoFunc func(ctx sdk.Context, key exported.PollKey, info types.OutPointInfo)
+	SetPendingOutpointInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey, info types.OutPointInfo)
 
 	// SetSignedTxFunc mocks the SetSignedTx method.
-	SetSignedTxFunc func(ctx sdk.Context, tx types.SignedTx)
+	SetSignedTxFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, tx types.SignedTx)
 
 	// SetSpentOutpointInfoFunc mocks the SetSpentOutpointInfo method.
-	SetSpentOutpointInfoFunc func(ctx sdk.Context, info types.OutPointInfo)
+	SetSpentOutpointInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, info types.OutPointInfo)
 
 	// SetUnconfirmedAmountFunc mocks the SetUnconfirmedAmount method.
-	SetUnconfirmedAmountFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, amount github_com_btcsuite_btcutil.Amount)
+	SetUnconfirmedAmountFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, amount github_com_btcsuite_btcutil.Amount)
 
 	// SetUnsignedTxFunc mocks the SetUnsignedTx method.
-	SetUnsignedTxFunc func(ctx sdk.Context, tx types.UnsignedTx)
+	SetUnsignedTxFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, tx types.UnsignedTx)
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// DeleteDustAmount holds details about calls to the DeleteDustAmount method.
 		DeleteDustAmount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// EncodedAddress is the encodedAddress argument value.
 			EncodedAddress string
 		}
 		// DeleteOutpointInfo holds details about calls to the DeleteOutpointInfo method.
 		DeleteOutpointInfo []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// OutPoint is the outPoint argument value.
 			OutPoint wire.OutPoint
 		}
 		// DeletePendingOutPointInfo holds details about calls to the DeletePendingOutPointInfo method.
 		DeletePendingOutPointInfo []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
 			Key exported.PollKey
 		}
 		// DeleteUnsignedTx holds details about calls to the DeleteUnsignedTx method.
 		DeleteUnsignedTx []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// TxType is the txType argument value.
 			TxType types.TxType
 		}
+		// ExportGenesis holds details about calls to the ExportGenesis method.
+		ExportGenesis []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// GetAddress holds details about calls to the GetAddress method.
 		GetAddress []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// EncodedAddress is the encodedAddress argument value.
 			EncodedAddress string
 		}
 		// GetAnyoneCanSpendAddress holds details about calls to the GetAnyoneCanSpendAddress method.
 		GetAnyoneCanSpendAddress []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetConfirmedOutpointInfoQueueForKey holds details about calls to the GetConfirmedOutpointInfoQueueForKey method.
 		GetConfirmedOutpointInfoQueueForKey []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
+		// GetConsolidationRetryHeight holds details about calls to the GetConsolidationRetryHeight method.
+		GetConsolidationRetryHeight []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetConsolidationSchedule holds details about calls to the GetConsolidationSchedule method.
+		GetConsolidationSchedule []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// GetDustAmount holds details about calls to the GetDustAmount method.
 		GetDustAmount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// EncodedAddress is the encodedAddress argument value.
 			EncodedAddress string
 		}
+		// GetFeeRate holds details about calls to the GetFeeRate method.
+		GetFeeRate []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// ConfTarget is the confTarget argument value.
+			ConfTarget int64
+		}
+		// GetFeeRateEstimation holds details about calls to the GetFeeRateEstimation method.
+		GetFeeRateEstimation []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetFeeRateVote holds details about calls to the GetFeeRateVote method.
+		GetFeeRateVote []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// ConfTarget is the confTarget argument value.
+			ConfTarget int64
+			// Voter is the voter argument value.
+			Voter github_com_cosmos_cosmos_sdk_types.ValAddress
+		}
 		// GetLatestSignedTxHash holds details about calls to the GetLatestSignedTxHash method.
 		GetLatestSignedTxHash []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// TxType is the txType argument value.
 			TxType types.TxType
 		}
 		// GetMasterAddressExternalKeyLockDuration holds details about calls to the GetMasterAddressExternalKeyLockDuration method.
 		GetMasterAddressExternalKeyLockDuration []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetMasterAddressInternalKeyLockDuration holds details about calls to the GetMasterAddressInternalKeyLockDuration method.
 		GetMasterAddressInternalKeyLockDuration []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetMasterKeyRetentionPeriod holds details about calls to the GetMasterKeyRetentionPeriod method.
 		GetMasterKeyRetentionPeriod []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetMaxInputCount holds details about calls to the GetMaxInputCount method.
 		GetMaxInputCount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetMaxSecondaryOutputAmount holds details about calls to the GetMaxSecondaryOutputAmount method.
 		GetMaxSecondaryOutputAmount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetMaxTxSize holds details about calls to the GetMaxTxSize method.
 		GetMaxTxSize []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetMinOutputAmount holds details about calls to the GetMinOutputAmount method.
 		GetMinOutputAmount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetMinVoterCount holds details about calls to the GetMinVoterCount method.
 		GetMinVoterCount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetNetwork holds details about calls to the GetNetwork method.
 		GetNetwork []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetOutPointInfo holds details about calls to the GetOutPointInfo method.
 		GetOutPointInfo []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// OutPoint is the outPoint argument value.
 			OutPoint wire.OutPoint
 		}
 		// GetParams holds details about calls to the GetParams method.
 		GetParams []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetPendingOutPointInfo holds details about calls to the GetPendingOutPointInfo method.
 		GetPendingOutPointInfo []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
 			Key exported.PollKey
 		}
 		// GetRequiredConfirmationHeight holds details about calls to the GetRequiredConfirmationHeight method.
 		GetRequiredConfirmationHeight []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetRevoteLockingPeriod holds details about calls to the GetRevoteLockingPeriod method.
 		GetRevoteLockingPeriod []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetSigCheckInterval holds details about calls to the GetSigCheckInterval method.
 		GetSigCheckInterval []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetSignedTx holds details about calls to the GetSignedTx method.
 		GetSignedTx []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// TxHash is the txHash argument value.
 			TxHash chainhash.Hash
 		}
 		// GetTransactionFeeRate holds details about calls to the GetTransactionFeeRate method.
 		GetTransactionFeeRate []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetTxFeeRate holds details about calls to the GetTxFeeRate method.
+		GetTxFeeRate []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetUnconfirmedAmount holds details about calls to the GetUnconfirmedAmount method.
 		GetUnconfirmedAmount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
 		// GetUnsignedTx holds details about calls to the GetUnsignedTx method.
 		GetUnsignedTx []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// TxType is the txType argument value.
 			TxType types.TxType
 		}
 		// GetVotingThreshold holds details about calls to the GetVotingThreshold method.
 		GetVotingThreshold []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// InitGenesis holds details about calls to the InitGenesis method.
+		InitGenesis []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// GenState is the genState argument value.
+			GenState *types.GenesisState
 		}
 		// Logger holds details about calls to the Logger method.
 		Logger []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// SetAddress holds details about calls to the SetAddress method.
 		SetAddress []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Address is the address argument value.
 			Address types.AddressInfo
 		}
 		// SetConfirmedOutpointInfo holds details about calls to the SetConfirmedOutpointInfo method.
 		SetConfirmedOutpointInfo []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 			// Info is the info argument value.
 			Info types.OutPointInfo
 		}
+		// SetConsolidationRetryHeight holds details about calls to the SetConsolidationRetryHeight method.
+		SetConsolidationRetryHeight []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Height is the height argument value.
+			Height int64
+		}
 		// SetDustAmount holds details about calls to the SetDustAmount method.
 		SetDustAmount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// EncodedAddress is the encodedAddress argument value.
 			EncodedAddress string
 			// Amount is the amount argument value.
 			Amount github_com_btcsuite_btcutil.Amount
 		}
+		// SetFeeRate holds details about calls to the SetFeeRate method.
+		SetFeeRate []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// ConfTarget is the confTarget argument value.
+			ConfTarget int64
+			// FeeRate is the feeRate argument value.
+			FeeRate github_com_btcsuite_btcutil.Amount
+		}
+		// SetFeeRateVote holds details about calls to the SetFeeRateVote method.
+		SetFeeRateVote []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Vote is the vote argument value.
+			Vote types.FeeRateVote
+		}
 		// SetLatestSignedTxHash holds details about calls to the SetLatestSignedTxHash method.
 		SetLatestSignedTxHash []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// TxType is the txType argument value.
 			TxType types.TxType
 			// TxHash is the txHash argument value.
@@ -2732,14 +2924,14 @@ type BTCKeeperMock struct {
 		// SetParams holds details about calls to the SetParams method.
 		SetParams []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// P is the p argument value.
 			P types.Params
 		}
 		// SetPendingOutpointInfo holds details about calls to the SetPendingOutpointInfo method.
 		SetPendingOutpointInfo []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
 			Key exported.PollKey
 			// Info is the info argument value.
@@ -2748,21 +2940,21 @@ type BTCKeeperMock struct {
 		// SetSignedTx holds details about calls to the SetSignedTx method.
 		SetSignedTx []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Tx is the tx argument value.
 			Tx types.SignedTx
 		}
 		// SetSpentOutpointInfo holds details about calls to the SetSpentOutpointInfo method.
 		SetSpentOutpointInfo []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Info is the info argument value.
 			Info types.OutPointInfo
 		}
 		// SetUnconfirmedAmount holds details about calls to the SetUnconfirmedAmount method.
 		SetUnconfirmedAmount []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 			// Amount is the amount argument value.
@@ -2771,7 +2963,7 @@ type BTCKeeperMock struct {
 		// SetUnsignedTx holds details about calls to the SetUnsignedTx method.
 		SetUnsignedTx []struct {
 			// Ctx is the ctx argument value.
-			Ctx sdk.Context
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Tx is the tx argument value.
 			Tx types.UnsignedTx
 		}
@@ -2780,10 +2972,16 @@ type BTCKeeperMock struct {
 	lockDeleteOutpointInfo                      sync.RWMutex
 	lockDeletePendingOutPointInfo               sync.RWMutex
 	lockDeleteUnsignedTx                        sync.RWMutex
+	lockExportGenesis                           sync.RWMutex
 	lockGetAddress                              sync.RWMutex
 	lockGetAnyoneCanSpendAddress                sync.RWMutex
 	lockGetConfirmedOutpointInfoQueueForKey     sync.RWMutex
+	lockGetConsolidationRetryHeight             sync.RWMutex
+	lockGetConsolidationSchedule                sync.RWMutex
 	lockGetDustAmount                           sync.RWMutex
+	lockGetFeeRate                              sync.RWMutex
+	lockGetFeeRateEstimation                    sync.RWMutex
+	lockGetFeeRateVote                          sync.RWMutex
 	lockGetLatestSignedTxHash                   sync.RWMutex
 	lockGetMasterAddressExternalKeyLockDuration sync.RWMutex
 	lockGetMasterAddressInternalKeyLockDuration sync.RWMutex
@@ -2802,13 +3000,18 @@ type BTCKeeperMock struct {
 	lockGetSigCheckInterval                     sync.RWMutex
 	lockGetSignedTx                             sync.RWMutex
 	lockGetTransactionFeeRate                   sync.RWMutex
+	lockGetTxFeeRate                            sync.RWMutex
 	lockGetUnconfirmedAmount                    sync.RWMutex
 	lockGetUnsignedTx                           sync.RWMutex
 	lockGetVotingThreshold                      sync.RWMutex
+	lockInitGenesis                             sync.RWMutex
 	lockLogger                                  sync.RWMutex
 	lockSetAddress                              sync.RWMutex
 	lockSetConfirmedOutpointInfo                sync.RWMutex
+	lockSetConsolidationRetryHeight             sync.RWMutex
 	lockSetDustAmount                           sync.RWMutex
+	lockSetFeeRate                              sync.RWMutex
+	lockSetFeeRateVote                          sync.RWMutex
 	lockSetLatestSignedTxHash                   sync.RWMutex
 	lockSetParams                               sync.RWMutex
 	lockSetPendingOutpointInfo                  sync.RWMutex
@@ -2819,12 +3022,12 @@ type BTCKeeperMock struct {
 }
 
 // DeleteDustAmount calls DeleteDustAmountFunc.
-func (mock *BTCKeeperMock) DeleteDustAmount(ctx sdk.Context, encodedAddress string) {
+func (mock *BTCKeeperMock) DeleteDustAmount(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string) {
 	if mock.DeleteDustAmountFunc == nil {
 		panic("BTCKeeperMock.DeleteDustAmountFunc: method is nil but BTCKeeper.DeleteDustAmount was just called")
 	}
 	callInfo := struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		EncodedAddress string
 	}{
 		Ctx:            ctx,
@@ -2840,11 +3043,11 @@ func (mock *BTCKeeperMock) DeleteDustAmount(ctx sdk.Context, encodedAddress stri
 // Check the length with:
 //     len(mockedBTCKeeper.DeleteDustAmountCalls())
 func (mock *BTCKeeperMock) DeleteDustAmountCalls() []struct {
-	Ctx            sdk.Context
+	Ctx            github_com_cosmos_cosmos_sdk_types.Context
 	EncodedAddress string
 } {
 	var calls []struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		EncodedAddress string
 	}
 	mock.lockDeleteDustAmount.RLock()
@@ -2854,12 +3057,12 @@ func (mock *BTCKeeperMock) DeleteDustAmountCalls() []struct {
 }
 
 // DeleteOutpointInfo calls DeleteOutpointInfoFunc.
-func (mock *BTCKeeperMock) DeleteOutpointInfo(ctx sdk.Context, outPoint wire.OutPoint) {
+func (mock *BTCKeeperMock) DeleteOutpointInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, outPoint wire.OutPoint) {
 	if mock.DeleteOutpointInfoFunc == nil {
 		panic("BTCKeeperMock.DeleteOutpointInfoFunc: method is nil but BTCKeeper.DeleteOutpointInfo was just called")
 	}
 	callInfo := struct {
-		Ctx      sdk.Context
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
 		OutPoint wire.OutPoint
 	}{
 		Ctx:      ctx,
@@ -2875,11 +3078,11 @@ func (mock *BTCKeeperMock) DeleteOutpointInfo(ctx sdk.Context, outPoint wire.Out
 // Check the length with:
 //     len(mockedBTCKeeper.DeleteOutpointInfoCalls())
 func (mock *BTCKeeperMock) DeleteOutpointInfoCalls() []struct {
-	Ctx      sdk.Context
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
 	OutPoint wire.OutPoint
 } {
 	var calls []struct {
-		Ctx      sdk.Context
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
 		OutPoint wire.OutPoint
 	}
 	mock.lockDeleteOutpointInfo.RLock()
@@ -2889,12 +3092,12 @@ func (mock *BTCKeeperMock) DeleteOutpointInfoCalls() []struct {
 }
 
 // DeletePendingOutPointInfo calls DeletePendingOutPointInfoFunc.
-func (mock *BTCKeeperMock) DeletePendingOutPointInfo(ctx sdk.Context, key exported.PollKey) {
+func (mock *BTCKeeperMock) DeletePendingOutPointInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey) {
 	if mock.DeletePendingOutPointInfoFunc == nil {
 		panic("BTCKeeperMock.DeletePendingOutPointInfoFunc: method is nil but BTCKeeper.DeletePendingOutPointInfo was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Key exported.PollKey
 	}{
 		Ctx: ctx,
@@ -2910,11 +3113,11 @@ func (mock *BTCKeeperMock) DeletePendingOutPointInfo(ctx sdk.Context, key export
 // Check the length with:
 //     len(mockedBTCKeeper.DeletePendingOutPointInfoCalls())
 func (mock *BTCKeeperMock) DeletePendingOutPointInfoCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 	Key exported.PollKey
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Key exported.PollKey
 	}
 	mock.lockDeletePendingOutPointInfo.RLock()
@@ -2924,12 +3127,12 @@ func (mock *BTCKeeperMock) DeletePendingOutPointInfoCalls() []struct {
 }
 
 // DeleteUnsignedTx calls DeleteUnsignedTxFunc.
-func (mock *BTCKeeperMock) DeleteUnsignedTx(ctx sdk.Context, txType types.TxType) {
+func (mock *BTCKeeperMock) DeleteUnsignedTx(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType) {
 	if mock.DeleteUnsignedTxFunc == nil {
 		panic("BTCKeeperMock.DeleteUnsignedTxFunc: method is nil but BTCKeeper.DeleteUnsignedTx was just called")
 	}
 	callInfo := struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxType types.TxType
 	}{
 		Ctx:    ctx,
@@ -2945,11 +3148,11 @@ func (mock *BTCKeeperMock) DeleteUnsignedTx(ctx sdk.Context, txType types.TxType
 // Check the length with:
 //     len(mockedBTCKeeper.DeleteUnsignedTxCalls())
 func (mock *BTCKeeperMock) DeleteUnsignedTxCalls() []struct {
-	Ctx    sdk.Context
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
 	TxType types.TxType
 } {
 	var calls []struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxType types.TxType
 	}
 	mock.lockDeleteUnsignedTx.RLock()
@@ -2958,13 +3161,44 @@ func (mock *BTCKeeperMock) DeleteUnsignedTxCalls() []struct {
 	return calls
 }
 
+// ExportGenesis calls ExportGenesisFunc.
+func (mock *BTCKeeperMock) ExportGenesis(ctx github_com_cosmos_cosmos_sdk_types.Context) *types.GenesisState {
+	if mock.ExportGenesisFunc == nil {
+		panic("BTCKeeperMock.ExportGenesisFunc: method is nil but BTCKeeper.ExportGenesis was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockExportGenesis.Lock()
+	mock.calls.ExportGenesis = append(mock.calls.ExportGenesis, callInfo)
+	mock.lockExportGenesis.Unlock()
+	return mock.ExportGenesisFunc(ctx)
+}
+
+// ExportGenesisCalls gets all the calls that were made to ExportGenesis.
+// Check the length with:
+//     len(mockedBTCKeeper.ExportGenesisCalls())
+func (mock *BTCKeeperMock) ExportGenesisCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockExportGenesis.RLock()
+	calls = mock.calls.ExportGenesis
+	mock.lockExportGenesis.RUnlock()
+	return calls
+}
+
 // GetAddress calls GetAddressFunc.
-func (mock *BTCKeeperMock) GetAddress(ctx sdk.Context, encodedAddress string) (types.AddressInfo, bool) {
+func (mock *BTCKeeperMock) GetAddress(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string) (types.AddressInfo, bool) {
 	if mock.GetAddressFunc == nil {
 		panic("BTCKeeperMock.GetAddressFunc: method is nil but BTCKeeper.GetAddress was just called")
 	}
 	callInfo := struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		EncodedAddress string
 	}{
 		Ctx:            ctx,
@@ -2980,11 +3214,11 @@ func (mock *BTCKeeperMock) GetAddress(ctx sdk.Context, encodedAddress string) (t
 // Check the length with:
 //     len(mockedBTCKeeper.GetAddressCalls())
 func (mock *BTCKeeperMock) GetAddressCalls() []struct {
-	Ctx            sdk.Context
+	Ctx            github_com_cosmos_cosmos_sdk_types.Context
 	EncodedAddress string
 } {
 	var calls []struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		EncodedAddress string
 	}
 	mock.lockGetAddress.RLock()
@@ -2994,12 +3228,12 @@ func (mock *BTCKeeperMock) GetAddressCalls() []struct {
 }
 
 // GetAnyoneCanSpendAddress calls GetAnyoneCanSpendAddressFunc.
-func (mock *BTCKeeperMock) GetAnyoneCanSpendAddress(ctx sdk.Context) types.AddressInfo {
+func (mock *BTCKeeperMock) GetAnyoneCanSpendAddress(ctx github_com_cosmos_cosmos_sdk_types.Context) types.AddressInfo {
 	if mock.GetAnyoneCanSpendAddressFunc == nil {
 		panic("BTCKeeperMock.GetAnyoneCanSpendAddressFunc: method is nil but BTCKeeper.GetAnyoneCanSpendAddress was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3013,10 +3247,10 @@ func (mock *BTCKeeperMock) GetAnyoneCanSpendAddress(ctx sdk.Context) types.Addre
 // Check the length with:
 //     len(mockedBTCKeeper.GetAnyoneCanSpendAddressCalls())
 func (mock *BTCKeeperMock) GetAnyoneCanSpendAddressCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetAnyoneCanSpendAddress.RLock()
 	calls = mock.calls.GetAnyoneCanSpendAddress
@@ -3025,12 +3259,12 @@ func (mock *BTCKeeperMock) GetAnyoneCanSpendAddressCalls() []struct {
 }
 
 // GetConfirmedOutpointInfoQueueForKey calls GetConfirmedOutpointInfoQueueForKeyFunc.
-func (mock *BTCKeeperMock) GetConfirmedOutpointInfoQueueForKey(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) utils.KVQueue {
+func (mock *BTCKeeperMock) GetConfirmedOutpointInfoQueueForKey(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) utils.KVQueue {
 	if mock.GetConfirmedOutpointInfoQueueForKeyFunc == nil {
 		panic("BTCKeeperMock.GetConfirmedOutpointInfoQueueForKeyFunc: method is nil but BTCKeeper.GetConfirmedOutpointInfoQueueForKey was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}{
 		Ctx:   ctx,
@@ -3046,11 +3280,11 @@ func (mock *BTCKeeperMock) GetConfirmedOutpointInfoQueueForKey(ctx sdk.Context,
 // Check the length with:
 //     len(mockedBTCKeeper.GetConfirmedOutpointInfoQueueForKeyCalls())
 func (mock *BTCKeeperMock) GetConfirmedOutpointInfoQueueForKeyCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}
 	mock.lockGetConfirmedOutpointInfoQueueForKey.RLock()
@@ -3059,13 +3293,75 @@ func (mock *BTCKeeperMock) GetConfirmedOutpointInfoQueueForKeyCalls() []struct {
 	return calls
 }
 
+// GetConsolidationRetryHeight calls GetConsolidationRetryHeightFunc.
+func (mock *BTCKeeperMock) GetConsolidationRetryHeight(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
+	if mock.GetConsolidationRetryHeightFunc == nil {
+		panic("BTCKeeperMock.GetConsolidationRetryHeightFunc: method is nil but BTCKeeper.GetConsolidationRetryHeight was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetConsolidationRetryHeight.Lock()
+	mock.calls.GetConsolidationRetryHeight = append(mock.calls.GetConsolidationRetryHeight, callInfo)
+	mock.lockGetConsolidationRetryHeight.Unlock()
+	return mock.GetConsolidationRetryHeightFunc(ctx)
+}
+
+// GetConsolidationRetryHeightCalls gets all the calls that were made to GetConsolidationRetryHeight.
+// Check the length with:
+//     len(mockedBTCKeeper.GetConsolidationRetryHeightCalls())
+func (mock *BTCKeeperMock) GetConsolidationRetryHeightCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetConsolidationRetryHeight.RLock()
+	calls = mock.calls.GetConsolidationRetryHeight
+	mock.lockGetConsolidationRetryHeight.RUnlock()
+	return calls
+}
+
+// GetConsolidationSchedule calls GetConsolidationScheduleFunc.
+func (mock *BTCKeeperMock) GetConsolidationSchedule(ctx github_com_cosmos_cosmos_sdk_types.Context) types.ConsolidationSchedule {
+	if mock.GetConsolidationScheduleFunc == nil {
+		panic("BTCKeeperMock.GetConsolidationScheduleFunc: method is nil but BTCKeeper.GetConsolidationSchedule was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetConsolidationSchedule.Lock()
+	mock.calls.GetConsolidationSchedule = append(mock.calls.GetConsolidationSchedule, callInfo)
+	mock.lockGetConsolidationSchedule.Unlock()
+	return mock.GetConsolidationScheduleFunc(ctx)
+}
+
+// GetConsolidationScheduleCalls gets all the calls that were made to GetConsolidationSchedule.
+// Check the length with:
+//     len(mockedBTCKeeper.GetConsolidationScheduleCalls())
+func (mock *BTCKeeperMock) GetConsolidationScheduleCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetConsolidationSchedule.RLock()
+	calls = mock.calls.GetConsolidationSchedule
+	mock.lockGetConsolidationSchedule.RUnlock()
+	return calls
+}
+
 // GetDustAmount calls GetDustAmountFunc.
-func (mock *BTCKeeperMock) GetDustAmount(ctx sdk.Context, encodedAddress string) github_com_btcsuite_btcutil.Amount {
+func (mock *BTCKeeperMock) GetDustAmount(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string) github_com_btcsuite_btcutil.Amount {
 	if mock.GetDustAmountFunc == nil {
 		panic("BTCKeeperMock.GetDustAmountFunc: method is nil but BTCKeeper.GetDustAmount was just called")
 	}
 	callInfo := struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		EncodedAddress string
 	}{
 		Ctx:            ctx,
@@ -3081,11 +3377,11 @@ func (mock *BTCKeeperMock) GetDustAmount(ctx sdk.Context, encodedAddress string)
 // Check the length with:
 //     len(mockedBTCKeeper.GetDustAmountCalls())
 func (mock *BTCKeeperMock) GetDustAmountCalls() []struct {
-	Ctx            sdk.Context
+	Ctx            github_com_cosmos_cosmos_sdk_types.Context
 	EncodedAddress string
 } {
 	var calls []struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		EncodedAddress string
 	}
 	mock.lockGetDustAmount.RLock()
@@ -3094,13 +3390,118 @@ func (mock *BTCKeeperMock) GetDustAmountCalls() []struct {
 	return calls
 }
 
+// GetFeeRate calls GetFeeRateFunc.
+func (mock *BTCKeeperMock) GetFeeRate(ctx github_com_cosmos_cosmos_sdk_types.Context, confTarget int64) (github_com_btcsuite_btcutil.Amount, bool) {
+	if mock.GetFeeRateFunc == nil {
+		panic("BTCKeeperMock.GetFeeRateFunc: method is nil but BTCKeeper.GetFeeRate was just called")
+	}
+	callInfo := struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		ConfTarget int64
+	}{
+		Ctx:        ctx,
+		ConfTarget: confTarget,
+	}
+	mock.lockGetFeeRate.Lock()
+	mock.calls.GetFeeRate = append(mock.calls.GetFeeRate, callInfo)
+	mock.lockGetFeeRate.Unlock()
+	return mock.GetFeeRateFunc(ctx, confTarget)
+}
+
+// GetFeeRateCalls gets all the calls that were made to GetFeeRate.
+// Check the length with:
+//     len(mockedBTCKeeper.GetFeeRateCalls())
+func (mock *BTCKeeperMock) GetFeeRateCalls() []struct {
+	Ctx        github_com_cosmos_cosmos_sdk_types.Context
+	ConfTarget int64
+} {
+	var calls []struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		ConfTarget int64
+	}
+	mock.lockGetFeeRate.RLock()
+	calls = mock.calls.GetFeeRate
+	mock.lockGetFeeRate.RUnlock()
+	return calls
+}
+
+// GetFeeRateEstimation calls GetFeeRateEstimationFunc.
+func (mock *BTCKeeperMock) GetFeeRateEstimation(ctx github_com_cosmos_cosmos_sdk_types.Context) types.FeeRateEstimation {
+	if mock.GetFeeRateEstimationFunc == nil {
+		panic("BTCKeeperMock.GetFeeRateEstimationFunc: method is nil but BTCKeeper.GetFeeRateEstimation was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetFeeRateEstimation.Lock()
+	mock.calls.GetFeeRateEstimation = append(mock.calls.GetFeeRateEstimation, callInfo)
+	mock.lockGetFeeRateEstimation.Unlock()
+	return mock.GetFeeRateEstimationFunc(ctx)
+}
+
+// GetFeeRateEstimationCalls gets all the calls that were made to GetFeeRateEstimation.
+// Check the length with:
+//     len(mockedBTCKeeper.GetFeeRateEstimationCalls())
+func (mock *BTCKeeperMock) GetFeeRateEstimationCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetFeeRateEstimation.RLock()
+	calls = mock.calls.GetFeeRateEstimation
+	mock.lockGetFeeRateEstimation.RUnlock()
+	return calls
+}
+
+// GetFeeRateVote calls GetFeeRateVoteFunc.
+func (mock *BTCKeeperMock) GetFeeRateVote(ctx github_com_cosmos_cosmos_sdk_types.Context, confTarget int64, voter github_com_cosmos_cosmos_sdk_types.ValAddress) (types.FeeRateVote, bool) {
+	if mock.GetFeeRateVoteFunc == nil {
+		panic("BTCKeeperMock.GetFeeRateVoteFunc: method is nil but BTCKeeper.GetFeeRateVote was just called")
+	}
+	callInfo := struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		ConfTarget int64
+		Voter      github_com_cosmos_cosmos_sdk_types.ValAddress
+	}{
+		Ctx:        ctx,
+		ConfTarget: confTarget,
+		Voter:      voter,
+	}
+	mock.lockGetFeeRateVote.Lock()
+	mock.calls.GetFeeRateVote = append(mock.calls.GetFeeRateVote, callInfo)
+	mock.lockGetFeeRateVote.Unlock()
+	return mock.GetFeeRateVoteFunc(ctx, confTarget, voter)
+}
+
+// GetFeeRateVoteCalls gets all the calls that were made to GetFeeRateVote.
+// Check the length with:
+//     len(mockedBTCKeeper.GetFeeRateVoteCalls())
+func (mock *BTCKeeperMock) GetFeeRateVoteCalls() []struct {
+	Ctx        github_com_cosmos_cosmos_sdk_types.Context
+	ConfTarget int64
+	Voter      github_com_cosmos_cosmos_sdk_types.ValAddress
+} {
+	var calls []struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		ConfTarget int64
+		Voter      github_com_cosmos_cosmos_sdk_types.ValAddress
+	}
+	mock.lockGetFeeRateVote.RLock()
+	calls = mock.calls.GetFeeRateVote
+	mock.lockGetFeeRateVote.RUnlock()
+	return calls
+}
+
 // GetLatestSignedTxHash calls GetLatestSignedTxHashFunc.
-func (mock *BTCKeeperMock) GetLatestSignedTxHash(ctx sdk.Context, txType types.TxType) (*chainhash.Hash, bool) {
+func (mock *BTCKeeperMock) GetLatestSignedTxHash(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType) (*chainhash.Hash, bool) {
 	if mock.GetLatestSignedTxHashFunc == nil {
 		panic("BTCKeeperMock.GetLatestSignedTxHashFunc: method is nil but BTCKeeper.GetLatestSignedTxHash was just called")
 	}
 	callInfo := struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxType types.TxType
 	}{
 		Ctx:    ctx,
@@ -3116,11 +3517,11 @@ func (mock *BTCKeeperMock) GetLatestSignedTxHash(ctx sdk.Context, txType types.T
 // Check the length with:
 //     len(mockedBTCKeeper.GetLatestSignedTxHashCalls())
 func (mock *BTCKeeperMock) GetLatestSignedTxHashCalls() []struct {
-	Ctx    sdk.Context
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
 	TxType types.TxType
 } {
 	var calls []struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxType types.TxType
 	}
 	mock.lockGetLatestSignedTxHash.RLock()
@@ -3130,12 +3531,12 @@ func (mock *BTCKeeperMock) GetLatestSignedTxHashCalls() []struct {
 }
 
 // GetMasterAddressExternalKeyLockDuration calls GetMasterAddressExternalKeyLockDurationFunc.
-func (mock *BTCKeeperMock) GetMasterAddressExternalKeyLockDuration(ctx sdk.Context) time.Duration {
+func (mock *BTCKeeperMock) GetMasterAddressExternalKeyLockDuration(ctx github_com_cosmos_cosmos_sdk_types.Context) time.Duration {
 	if mock.GetMasterAddressExternalKeyLockDurationFunc == nil {
 		panic("BTCKeeperMock.GetMasterAddressExternalKeyLockDurationFunc: method is nil but BTCKeeper.GetMasterAddressExternalKeyLockDuration was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3149,10 +3550,10 @@ func (mock *BTCKeeperMock) GetMasterAddressExternalKeyLockDuration(ctx sdk.Conte
 // Check the length with:
 //     len(mockedBTCKeeper.GetMasterAddressExternalKeyLockDurationCalls())
 func (mock *BTCKeeperMock) GetMasterAddressExternalKeyLockDurationCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetMasterAddressExternalKeyLockDuration.RLock()
 	calls = mock.calls.GetMasterAddressExternalKeyLockDuration
@@ -3161,12 +3562,12 @@ func (mock *BTCKeeperMock) GetMasterAddressExternalKeyLockDurationCalls() []stru
 }
 
 // GetMasterAddressInternalKeyLockDuration calls GetMasterAddressInternalKeyLockDurationFunc.
-func (mock *BTCKeeperMock) GetMasterAddressInternalKeyLockDuration(ctx sdk.Context) time.Duration {
+func (mock *BTCKeeperMock) GetMasterAddressInternalKeyLockDuration(ctx github_com_cosmos_cosmos_sdk_types.Context) time.Duration {
 	if mock.GetMasterAddressInternalKeyLockDurationFunc == nil {
 		panic("BTCKeeperMock.GetMasterAddressInternalKeyLockDurationFunc: method is nil but BTCKeeper.GetMasterAddressInternalKeyLockDuration was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3180,10 +3581,10 @@ func (mock *BTCKeeperMock) GetMasterAddressInternalKeyLockDuration(ctx sdk.Conte
 // Check the length with:
 //     len(mockedBTCKeeper.GetMasterAddressInternalKeyLockDurationCalls())
 func (mock *BTCKeeperMock) GetMasterAddressInternalKeyLockDurationCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetMasterAddressInternalKeyLockDuration.RLock()
 	calls = mock.calls.GetMasterAddressInternalKeyLockDuration
@@ -3192,12 +3593,12 @@ func (mock *BTCKeeperMock) GetMasterAddressInternalKeyLockDurationCalls() []stru
 }
 
 // GetMasterKeyRetentionPeriod calls GetMasterKeyRetentionPeriodFunc.
-func (mock *BTCKeeperMock) GetMasterKeyRetentionPeriod(ctx sdk.Context) int64 {
+func (mock *BTCKeeperMock) GetMasterKeyRetentionPeriod(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 	if mock.GetMasterKeyRetentionPeriodFunc == nil {
 		panic("BTCKeeperMock.GetMasterKeyRetentionPeriodFunc: method is nil but BTCKeeper.GetMasterKeyRetentionPeriod was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3211,10 +3612,10 @@ func (mock *BTCKeeperMock) GetMasterKeyRetentionPeriod(ctx sdk.Context) int64 {
 // Check the length with:
 //     len(mockedBTCKeeper.GetMasterKeyRetentionPeriodCalls())
 func (mock *BTCKeeperMock) GetMasterKeyRetentionPeriodCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetMasterKeyRetentionPeriod.RLock()
 	calls = mock.calls.GetMasterKeyRetentionPeriod
@@ -3223,12 +3624,12 @@ func (mock *BTCKeeperMock) GetMasterKeyRetentionPeriodCalls() []struct {
 }
 
 // GetMaxInputCount calls GetMaxInputCountFunc.
-func (mock *BTCKeeperMock) GetMaxInputCount(ctx sdk.Context) int64 {
+func (mock *BTCKeeperMock) GetMaxInputCount(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 	if mock.GetMaxInputCountFunc == nil {
 		panic("BTCKeeperMock.GetMaxInputCountFunc: method is nil but BTCKeeper.GetMaxInputCount was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3242,10 +3643,10 @@ func (mock *BTCKeeperMock) GetMaxInputCount(ctx sdk.Context) int64 {
 // Check the length with:
 //     len(mockedBTCKeeper.GetMaxInputCountCalls())
 func (mock *BTCKeeperMock) GetMaxInputCountCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetMaxInputCount.RLock()
 	calls = mock.calls.GetMaxInputCount
@@ -3254,12 +3655,12 @@ func (mock *BTCKeeperMock) GetMaxInputCountCalls() []struct {
 }
 
 // GetMaxSecondaryOutputAmount calls GetMaxSecondaryOutputAmountFunc.
-func (mock *BTCKeeperMock) GetMaxSecondaryOutputAmount(ctx sdk.Context) github_com_btcsuite_btcutil.Amount {
+func (mock *BTCKeeperMock) GetMaxSecondaryOutputAmount(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_btcsuite_btcutil.Amount {
 	if mock.GetMaxSecondaryOutputAmountFunc == nil {
 		panic("BTCKeeperMock.GetMaxSecondaryOutputAmountFunc: method is nil but BTCKeeper.GetMaxSecondaryOutputAmount was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3273,10 +3674,10 @@ func (mock *BTCKeeperMock) GetMaxSecondaryOutputAmount(ctx sdk.Context) github_c
 // Check the length with:
 //     len(mockedBTCKeeper.GetMaxSecondaryOutputAmountCalls())
 func (mock *BTCKeeperMock) GetMaxSecondaryOutputAmountCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetMaxSecondaryOutputAmount.RLock()
 	calls = mock.calls.GetMaxSecondaryOutputAmount
@@ -3285,12 +3686,12 @@ func (mock *BTCKeeperMock) GetMaxSecondaryOutputAmountCalls() []struct {
 }
 
 // GetMaxTxSize calls GetMaxTxSizeFunc.
-func (mock *BTCKeeperMock) GetMaxTxSize(ctx sdk.Context) int64 {
+func (mock *BTCKeeperMock) GetMaxTxSize(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 	if mock.GetMaxTxSizeFunc == nil {
 		panic("BTCKeeperMock.GetMaxTxSizeFunc: method is nil but BTCKeeper.GetMaxTxSize was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3304,10 +3705,10 @@ func (mock *BTCKeeperMock) GetMaxTxSize(ctx sdk.Context) int64 {
 // Check the length with:
 //     len(mockedBTCKeeper.GetMaxTxSizeCalls())
 func (mock *BTCKeeperMock) GetMaxTxSizeCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetMaxTxSize.RLock()
 	calls = mock.calls.GetMaxTxSize
@@ -3316,12 +3717,12 @@ func (mock *BTCKeeperMock) GetMaxTxSizeCalls() []struct {
 }
 
 // GetMinOutputAmount calls GetMinOutputAmountFunc.
-func (mock *BTCKeeperMock) GetMinOutputAmount(ctx sdk.Context) github_com_btcsuite_btcutil.Amount {
+func (mock *BTCKeeperMock) GetMinOutputAmount(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_btcsuite_btcutil.Amount {
 	if mock.GetMinOutputAmountFunc == nil {
 		panic("BTCKeeperMock.GetMinOutputAmountFunc: method is nil but BTCKeeper.GetMinOutputAmount was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3335,10 +3736,10 @@ func (mock *BTCKeeperMock) GetMinOutputAmount(ctx sdk.Context) github_com_btcsui
 // Check the length with:
 //     len(mockedBTCKeeper.GetMinOutputAmountCalls())
 func (mock *BTCKeeperMock) GetMinOutputAmountCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetMinOutputAmount.RLock()
 	calls = mock.calls.GetMinOutputAmount
@@ -3347,12 +3748,12 @@ func (mock *BTCKeeperMock) GetMinOutputAmountCalls() []struct {
 }
 
 // GetMinVoterCount calls GetMinVoterCountFunc.
-func (mock *BTCKeeperMock) GetMinVoterCount(ctx sdk.Context) int64 {
+func (mock *BTCKeeperMock) GetMinVoterCount(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 	if mock.GetMinVoterCountFunc == nil {
 		panic("BTCKeeperMock.GetMinVoterCountFunc: method is nil but BTCKeeper.GetMinVoterCount was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3366,10 +3767,10 @@ func (mock *BTCKeeperMock) GetMinVoterCount(ctx sdk.Context) int64 {
 // Check the length with:
 //     len(mockedBTCKeeper.GetMinVoterCountCalls())
 func (mock *BTCKeeperMock) GetMinVoterCountCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetMinVoterCount.RLock()
 	calls = mock.calls.GetMinVoterCount
@@ -3378,12 +3779,12 @@ func (mock *BTCKeeperMock) GetMinVoterCountCalls() []struct {
 }
 
 // GetNetwork calls GetNetworkFunc.
-func (mock *BTCKeeperMock) GetNetwork(ctx sdk.Context) types.Network {
+func (mock *BTCKeeperMock) GetNetwork(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Network {
 	if mock.GetNetworkFunc == nil {
 		panic("BTCKeeperMock.GetNetworkFunc: method is nil but BTCKeeper.GetNetwork was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3397,10 +3798,10 @@ func (mock *BTCKeeperMock) GetNetwork(ctx sdk.Context) types.Network {
 // Check the length with:
 //     len(mockedBTCKeeper.GetNetworkCalls())
 func (mock *BTCKeeperMock) GetNetworkCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetNetwork.RLock()
 	calls = mock.calls.GetNetwork
@@ -3409,12 +3810,12 @@ func (mock *BTCKeeperMock) GetNetworkCalls() []struct {
 }
 
 // GetOutPointInfo calls GetOutPointInfoFunc.
-func (mock *BTCKeeperMock) GetOutPointInfo(ctx sdk.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
+func (mock *BTCKeeperMock) GetOutPointInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, outPoint wire.OutPoint) (types.OutPointInfo, types.OutPointState, bool) {
 	if mock.GetOutPointInfoFunc == nil {
 		panic("BTCKeeperMock.GetOutPointInfoFunc: method is nil but BTCKeeper.GetOutPointInfo was just called")
 	}
 	callInfo := struct {
-		Ctx      sdk.Context
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
 		OutPoint wire.OutPoint
 	}{
 		Ctx:      ctx,
@@ -3430,11 +3831,11 @@ func (mock *BTCKeeperMock) GetOutPointInfo(ctx sdk.Context, outPoint wire.OutPoi
 // Check the length with:
 //     len(mockedBTCKeeper.GetOutPointInfoCalls())
 func (mock *BTCKeeperMock) GetOutPointInfoCalls() []struct {
-	Ctx      sdk.Context
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
 	OutPoint wire.OutPoint
 } {
 	var calls []struct {
-		Ctx      sdk.Context
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
 		OutPoint wire.OutPoint
 	}
 	mock.lockGetOutPointInfo.RLock()
@@ -3444,12 +3845,12 @@ func (mock *BTCKeeperMock) GetOutPointInfoCalls() []struct {
 }
 
 // GetParams calls GetParamsFunc.
-func (mock *BTCKeeperMock) GetParams(ctx sdk.Context) types.Params {
+func (mock *BTCKeeperMock) GetParams(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Params {
 	if mock.GetParamsFunc == nil {
 		panic("BTCKeeperMock.GetParamsFunc: method is nil but BTCKeeper.GetParams was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3463,10 +3864,10 @@ func (mock *BTCKeeperMock) GetParams(ctx sdk.Context) types.Params {
 // Check the length with:
 //     len(mockedBTCKeeper.GetParamsCalls())
 func (mock *BTCKeeperMock) GetParamsCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetParams.RLock()
 	calls = mock.calls.GetParams
@@ -3475,12 +3876,12 @@ func (mock *BTCKeeperMock) GetParamsCalls() []struct {
 }
 
 // GetPendingOutPointInfo calls GetPendingOutPointInfoFunc.
-func (mock *BTCKeeperMock) GetPendingOutPointInfo(ctx sdk.Context, key exported.PollKey) (types.OutPointInfo, bool) {
+func (mock *BTCKeeperMock) GetPendingOutPointInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey) (types.OutPointInfo, bool) {
 	if mock.GetPendingOutPointInfoFunc == nil {
 		panic("BTCKeeperMock.GetPendingOutPointInfoFunc: method is nil but BTCKeeper.GetPendingOutPointInfo was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Key exported.PollKey
 	}{
 		Ctx: ctx,
@@ -3496,11 +3897,11 @@ func (mock *BTCKeeperMock) GetPendingOutPointInfo(ctx sdk.Context, key exported.
 // Check the length with:
 //     len(mockedBTCKeeper.GetPendingOutPointInfoCalls())
 func (mock *BTCKeeperMock) GetPendingOutPointInfoCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 	Key exported.PollKey
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Key exported.PollKey
 	}
 	mock.lockGetPendingOutPointInfo.RLock()
@@ -3510,12 +3911,12 @@ func (mock *BTCKeeperMock) GetPendingOutPointInfoCalls() []struct {
 }
 
 // GetRequiredConfirmationHeight calls GetRequiredConfirmationHeightFunc.
-func (mock *BTCKeeperMock) GetRequiredConfirmationHeight(ctx sdk.Context) uint64 {
+func (mock *BTCKeeperMock) GetRequiredConfirmationHeight(ctx github_com_cosmos_cosmos_sdk_types.Context) uint64 {
 	if mock.GetRequiredConfirmationHeightFunc == nil {
 		panic("BTCKeeperMock.GetRequiredConfirmationHeightFunc: method is nil but BTCKeeper.GetRequiredConfirmationHeight was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3529,10 +3930,10 @@ func (mock *BTCKeeperMock) GetRequiredConfirmationHeight(ctx sdk.Context) uint64
 // Check the length with:
 //     len(mockedBTCKeeper.GetRequiredConfirmationHeightCalls())
 func (mock *BTCKeeperMock) GetRequiredConfirmationHeightCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetRequiredConfirmationHeight.RLock()
 	calls = mock.calls.GetRequiredConfirmationHeight
@@ -3541,12 +3942,12 @@ func (mock *BTCKeeperMock) GetRequiredConfirmationHeightCalls() []struct {
 }
 
 // GetRevoteLockingPeriod calls GetRevoteLockingPeriodFunc.
-func (mock *BTCKeeperMock) GetRevoteLockingPeriod(ctx sdk.Context) int64 {
+func (mock *BTCKeeperMock) GetRevoteLockingPeriod(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 	if mock.GetRevoteLockingPeriodFunc == nil {
 		panic("BTCKeeperMock.GetRevoteLockingPeriodFunc: method is nil but BTCKeeper.GetRevoteLockingPeriod was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3560,10 +3961,10 @@ func (mock *BTCKeeperMock) GetRevoteLockingPeriod(ctx sdk.Context) int64 {
 // Check the length with:
 //     len(mockedBTCKeeper.GetRevoteLockingPeriodCalls())
 func (mock *BTCKeeperMock) GetRevoteLockingPeriodCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetRevoteLockingPeriod.RLock()
 	calls = mock.calls.GetRevoteLockingPeriod
@@ -3572,12 +3973,12 @@ func (mock *BTCKeeperMock) GetRevoteLockingPeriodCalls() []struct {
 }
 
 // GetSigCheckInterval calls GetSigCheckIntervalFunc.
-func (mock *BTCKeeperMock) GetSigCheckInterval(ctx sdk.Context) int64 {
+func (mock *BTCKeeperMock) GetSigCheckInterval(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
 	if mock.GetSigCheckIntervalFunc == nil {
 		panic("BTCKeeperMock.GetSigCheckIntervalFunc: method is nil but BTCKeeper.GetSigCheckInterval was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3591,10 +3992,10 @@ func (mock *BTCKeeperMock) GetSigCheckInterval(ctx sdk.Context) int64 {
 // Check the length with:
 //     len(mockedBTCKeeper.GetSigCheckIntervalCalls())
 func (mock *BTCKeeperMock) GetSigCheckIntervalCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetSigCheckInterval.RLock()
 	calls = mock.calls.GetSigCheckInterval
@@ -3603,12 +4004,12 @@ func (mock *BTCKeeperMock) GetSigCheckIntervalCalls() []struct {
 }
 
 // GetSignedTx calls GetSignedTxFunc.
-func (mock *BTCKeeperMock) GetSignedTx(ctx sdk.Context, txHash chainhash.Hash) (types.SignedTx, bool) {
+func (mock *BTCKeeperMock) GetSignedTx(ctx github_com_cosmos_cosmos_sdk_types.Context, txHash chainhash.Hash) (types.SignedTx, bool) {
 	if mock.GetSignedTxFunc == nil {
 		panic("BTCKeeperMock.GetSignedTxFunc: method is nil but BTCKeeper.GetSignedTx was just called")
 	}
 	callInfo := struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxHash chainhash.Hash
 	}{
 		Ctx:    ctx,
@@ -3624,11 +4025,11 @@ func (mock *BTCKeeperMock) GetSignedTx(ctx sdk.Context, txHash chainhash.Hash) (
 // Check the length with:
 //     len(mockedBTCKeeper.GetSignedTxCalls())
 func (mock *BTCKeeperMock) GetSignedTxCalls() []struct {
-	Ctx    sdk.Context
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
 	TxHash chainhash.Hash
 } {
 	var calls []struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxHash chainhash.Hash
 	}
 	mock.lockGetSignedTx.RLock()
@@ -3638,12 +4039,12 @@ func (mock *BTCKeeperMock) GetSignedTxCalls() []struct {
 }
 
 // GetTransactionFeeRate calls GetTransactionFeeRateFunc.
-func (mock *BTCKeeperMock) GetTransactionFeeRate(ctx sdk.Context) sdk.Dec {
+func (mock *BTCKeeperMock) GetTransactionFeeRate(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_cosmos_cosmos_sdk_types.Dec {
 	if mock.GetTransactionFeeRateFunc == nil {
 		panic("BTCKeeperMock.GetTransactionFeeRateFunc: method is nil but BTCKeeper.GetTransactionFeeRate was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3657,10 +4058,10 @@ func (mock *BTCKeeperMock) GetTransactionFeeRate(ctx sdk.Context) sdk.Dec {
 // Check the length with:
 //     len(mockedBTCKeeper.GetTransactionFeeRateCalls())
 func (mock *BTCKeeperMock) GetTransactionFeeRateCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetTransactionFeeRate.RLock()
 	calls = mock.calls.GetTransactionFeeRate
@@ -3668,13 +4069,44 @@ func (mock *BTCKeeperMock) GetTransactionFeeRateCalls() []struct {
 	return calls
 }
 
+// GetTxFeeRate calls GetTxFeeRateFunc.
+func (mock *BTCKeeperMock) GetTxFeeRate(ctx github_com_cosmos_cosmos_sdk_types.Context) github_com_btcsuite_btcutil.Amount {
+	if mock.GetTxFeeRateFunc == nil {
+		panic("BTCKeeperMock.GetTxFeeRateFunc: method is nil but BTCKeeper.GetTxFeeRate was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetTxFeeRate.Lock()
+	mock.calls.GetTxFeeRate = append(mock.calls.GetTxFeeRate, callInfo)
+	mock.lockGetTxFeeRate.Unlock()
+	return mock.GetTxFeeRateFunc(ctx)
+}
+
+// GetTxFeeRateCalls gets all the calls that were made to GetTxFeeRate.
+// Check the length with:
+//     len(mockedBTCKeeper.GetTxFeeRateCalls())
+func (mock *BTCKeeperMock) GetTxFeeRateCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetTxFeeRate.RLock()
+	calls = mock.calls.GetTxFeeRate
+	mock.lockGetTxFeeRate.RUnlock()
+	return calls
+}
+
 // GetUnconfirmedAmount calls GetUnconfirmedAmountFunc.
-func (mock *BTCKeeperMock) GetUnconfirmedAmount(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_btcsuite_btcutil.Amount {
+func (mock *BTCKeeperMock) GetUnconfirmedAmount(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_btcsuite_btcutil.Amount {
 	if mock.GetUnconfirmedAmountFunc == nil {
 		panic("BTCKeeperMock.GetUnconfirmedAmountFunc: method is nil but BTCKeeper.GetUnconfirmedAmount was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}{
 		Ctx:   ctx,
@@ -3690,11 +4122,11 @@ func (mock *BTCKeeperMock) GetUnconfirmedAmount(ctx sdk.Context, keyID github_co
 // Check the length with:
 //     len(mockedBTCKeeper.GetUnconfirmedAmountCalls())
 func (mock *BTCKeeperMock) GetUnconfirmedAmountCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}
 	mock.lockGetUnconfirmedAmount.RLock()
@@ -3704,12 +4136,12 @@ func (mock *BTCKeeperMock) GetUnconfirmedAmountCalls() []struct {
 }
 
 // GetUnsignedTx calls GetUnsignedTxFunc.
-func (mock *BTCKeeperMock) GetUnsignedTx(ctx sdk.Context, txType types.TxType) (types.UnsignedTx, bool) {
+func (mock *BTCKeeperMock) GetUnsignedTx(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType) (types.UnsignedTx, bool) {
 	if mock.GetUnsignedTxFunc == nil {
 		panic("BTCKeeperMock.GetUnsignedTxFunc: method is nil but BTCKeeper.GetUnsignedTx was just called")
 	}
 	callInfo := struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxType types.TxType
 	}{
 		Ctx:    ctx,
@@ -3725,11 +4157,11 @@ func (mock *BTCKeeperMock) GetUnsignedTx(ctx sdk.Context, txType types.TxType) (
 // Check the length with:
 //     len(mockedBTCKeeper.GetUnsignedTxCalls())
 func (mock *BTCKeeperMock) GetUnsignedTxCalls() []struct {
-	Ctx    sdk.Context
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
 	TxType types.TxType
 } {
 	var calls []struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxType types.TxType
 	}
 	mock.lockGetUnsignedTx.RLock()
@@ -3739,12 +4171,12 @@ func (mock *BTCKeeperMock) GetUnsignedTxCalls() []struct {
 }
 
 // GetVotingThreshold calls GetVotingThresholdFunc.
-func (mock *BTCKeeperMock) GetVotingThreshold(ctx sdk.Context) utils.Threshold {
+func (mock *BTCKeeperMock) GetVotingThreshold(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.Threshold {
 	if mock.GetVotingThresholdFunc == nil {
 		panic("BTCKeeperMock.GetVotingThresholdFunc: method is nil but BTCKeeper.GetVotingThreshold was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3758,10 +4190,10 @@ func (mock *BTCKeeperMock) GetVotingThreshold(ctx sdk.Context) utils.Threshold {
 // Check the length with:
 //     len(mockedBTCKeeper.GetVotingThresholdCalls())
 func (mock *BTCKeeperMock) GetVotingThresholdCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockGetVotingThreshold.RLock()
 	calls = mock.calls.GetVotingThreshold
@@ -3769,13 +4201,48 @@ func (mock *BTCKeeperMock) GetVotingThresholdCalls() []struct {
 	return calls
 }
 
+// InitGenesis calls InitGenesisFunc.
+func (mock *BTCKeeperMock) InitGenesis(ctx github_com_cosmos_cosmos_sdk_types.Context, genState *types.GenesisState) {
+	if mock.InitGenesisFunc == nil {
+		panic("BTCKeeperMock.InitGenesisFunc: method is nil but BTCKeeper.InitGenesis was just called")
+	}
+	callInfo := struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		GenState *types.GenesisState
+	}{
+		Ctx:      ctx,
+		GenState: genState,
+	}
+	mock.lockInitGenesis.Lock()
+	mock.calls.InitGenesis = append(mock.calls.InitGenesis, callInfo)
+	mock.lockInitGenesis.Unlock()
+	mock.InitGenesisFunc(ctx, genState)
+}
+
+// InitGenesisCalls gets all the calls that were made to InitGenesis.
+// Check the length with:
+//     len(mockedBTCKeeper.InitGenesisCalls())
+func (mock *BTCKeeperMock) InitGenesisCalls() []struct {
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
+	GenState *types.GenesisState
+} {
+	var calls []struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		GenState *types.GenesisState
+	}
+	mock.lockInitGenesis.RLock()
+	calls = mock.calls.InitGenesis
+	mock.lockInitGenesis.RUnlock()
+	return calls
+}
+
 // Logger calls LoggerFunc.
-func (mock *BTCKeeperMock) Logger(ctx sdk.Context) log.Logger {
+func (mock *BTCKeeperMock) Logger(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
 	if mock.LoggerFunc == nil {
 		panic("BTCKeeperMock.LoggerFunc: method is nil but BTCKeeper.Logger was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}{
 		Ctx: ctx,
 	}
@@ -3789,10 +4256,10 @@ func (mock *BTCKeeperMock) Logger(ctx sdk.Context) log.Logger {
 // Check the length with:
 //     len(mockedBTCKeeper.LoggerCalls())
 func (mock *BTCKeeperMock) LoggerCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 	}
 	mock.lockLogger.RLock()
 	calls = mock.calls.Logger
@@ -3801,12 +4268,12 @@ func (mock *BTCKeeperMock) LoggerCalls() []struct {
 }
 
 // SetAddress calls SetAddressFunc.
-func (mock *BTCKeeperMock) SetAddress(ctx sdk.Context, address types.AddressInfo) {
+func (mock *BTCKeeperMock) SetAddress(ctx github_com_cosmos_cosmos_sdk_types.Context, address types.AddressInfo) {
 	if mock.SetAddressFunc == nil {
 		panic("BTCKeeperMock.SetAddressFunc: method is nil but BTCKeeper.SetAddress was just called")
 	}
 	callInfo := struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Address types.AddressInfo
 	}{
 		Ctx:     ctx,
@@ -3822,11 +4289,11 @@ func (mock *BTCKeeperMock) SetAddress(ctx sdk.Context, address types.AddressInfo
 // Check the length with:
 //     len(mockedBTCKeeper.SetAddressCalls())
 func (mock *BTCKeeperMock) SetAddressCalls() []struct {
-	Ctx     sdk.Context
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
 	Address types.AddressInfo
 } {
 	var calls []struct {
-		Ctx     sdk.Context
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
 		Address types.AddressInfo
 	}
 	mock.lockSetAddress.RLock()
@@ -3836,12 +4303,12 @@ func (mock *BTCKeeperMock) SetAddressCalls() []struct {
 }
 
 // SetConfirmedOutpointInfo calls SetConfirmedOutpointInfoFunc.
-func (mock *BTCKeeperMock) SetConfirmedOutpointInfo(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, info types.OutPointInfo) {
+func (mock *BTCKeeperMock) SetConfirmedOutpointInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, info types.OutPointInfo) {
 	if mock.SetConfirmedOutpointInfoFunc == nil {
 		panic("BTCKeeperMock.SetConfirmedOutpointInfoFunc: method is nil but BTCKeeper.SetConfirmedOutpointInfo was just called")
 	}
 	callInfo := struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		Info  types.OutPointInfo
 	}{
@@ -3859,12 +4326,12 @@ func (mock *BTCKeeperMock) SetConfirmedOutpointInfo(ctx sdk.Context, keyID githu
 // Check the length with:
 //     len(mockedBTCKeeper.SetConfirmedOutpointInfoCalls())
 func (mock *BTCKeeperMock) SetConfirmedOutpointInfoCalls() []struct {
-	Ctx   sdk.Context
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
 	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	Info  types.OutPointInfo
 } {
 	var calls []struct {
-		Ctx   sdk.Context
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
 		KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		Info  types.OutPointInfo
 	}
@@ -3874,13 +4341,48 @@ func (mock *BTCKeeperMock) SetConfirmedOutpointInfoCalls() []struct {
 	return calls
 }
 
+// SetConsolidationRetryHeight calls SetConsolidationRetryHeightFunc.
+func (mock *BTCKeeperMock) SetConsolidationRetryHeight(ctx github_com_cosmos_cosmos_sdk_types.Context, height int64) {
+	if mock.SetConsolidationRetryHeightFunc == nil {
+		panic("BTCKeeperMock.SetConsolidationRetryHeightFunc: method is nil but BTCKeeper.SetConsolidationRetryHeight was just called")
+	}
+	callInfo := struct {
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
+		Height int64
+	}{
+		Ctx:    ctx,
+		Height: height,
+	}
+	mock.lockSetConsolidationRetryHeight.Lock()
+	mock.calls.SetConsolidationRetryHeight = append(mock.calls.SetConsolidationRetryHeight, callInfo)
+	mock.lockSetConsolidationRetryHeight.Unlock()
+	mock.SetConsolidationRetryHeightFunc(ctx, height)
+}
+
+// SetConsolidationRetryHeightCalls gets all the calls that were made to SetConsolidationRetryHeight.
+// Check the length with:
+//     len(mockedBTCKeeper.SetConsolidationRetryHeightCalls())
+func (mock *BTCKeeperMock) SetConsolidationRetryHeightCalls() []struct {
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
+	Height int64
+} {
+	var calls []struct {
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
+		Height int64
+	}
+	mock.lockSetConsolidationRetryHeight.RLock()
+	calls = mock.calls.SetConsolidationRetryHeight
+	mock.lockSetConsolidationRetryHeight.RUnlock()
+	return calls
+}
+
 // SetDustAmount calls SetDustAmountFunc.
-func (mock *BTCKeeperMock) SetDustAmount(ctx sdk.Context, encodedAddress string, amount github_com_btcsuite_btcutil.Amount) {
+func (mock *BTCKeeperMock) SetDustAmount(ctx github_com_cosmos_cosmos_sdk_types.Context, encodedAddress string, amount github_com_btcsuite_btcutil.Amount) {
 	if mock.SetDustAmountFunc == nil {
 		panic("BTCKeeperMock.SetDustAmountFunc: method is nil but BTCKeeper.SetDustAmount was just called")
 	}
 	callInfo := struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		EncodedAddress string
 		Amount         github_com_btcsuite_btcutil.Amount
 	}{
@@ -3898,12 +4400,12 @@ func (mock *BTCKeeperMock) SetDustAmount(ctx sdk.Context, encodedAddress string,
 // Check the length with:
 //     len(mockedBTCKeeper.SetDustAmountCalls())
 func (mock *BTCKeeperMock) SetDustAmountCalls() []struct {
-	Ctx            sdk.Context
+	Ctx            github_com_cosmos_cosmos_sdk_types.Context
 	EncodedAddress string
 	Amount         github_com_btcsuite_btcutil.Amount
 } {
 	var calls []struct {
-		Ctx            sdk.Context
+		Ctx            github_com_cosmos_cosmos_sdk_types.Context
 		EncodedAddress string
 		Amount         github_com_btcsuite_btcutil.Amount
 	}
@@ -3913,13 +4415,87 @@ func (mock *BTCKeeperMock) SetDustAmountCalls() []struct {
 	return calls
 }
 
+// SetFeeRate calls SetFeeRateFunc.
+func (mock *BTCKeeperMock) SetFeeRate(ctx github_com_cosmos_cosmos_sdk_types.Context, confTarget int64, feeRate github_com_btcsuite_btcutil.Amount) {
+	if mock.SetFeeRateFunc == nil {
+		panic("BTCKeeperMock.SetFeeRateFunc: method is nil but BTCKeeper.SetFeeRate was just called")
+	}
+	callInfo := struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		ConfTarget int64
+		FeeRate    github_com_btcsuite_btcutil.Amount
+	}{
+		Ctx:        ctx,
+		ConfTarget: confTarget,
+		FeeRate:    feeRate,
+	}
+	mock.lockSetFeeRate.Lock()
+	mock.calls.SetFeeRate = append(mock.calls.SetFeeRate, callInfo)
+	mock.lockSetFeeRate.Unlock()
+	mock.SetFeeRateFunc(ctx, confTarget, feeRate)
+}
+
+// SetFeeRateCalls gets all the calls that were made to SetFeeRate.
+// Check the length with:
+//     len(mockedBTCKeeper.SetFeeRateCalls())
+func (mock *BTCKeeperMock) SetFeeRateCalls() []struct {
+	Ctx        github_com_cosmos_cosmos_sdk_types.Context
+	ConfTarget int64
+	FeeRate    github_com_btcsuite_btcutil.Amount
+} {
+	var calls []struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		ConfTarget int64
+		FeeRate    github_com_btcsuite_btcutil.Amount
+	}
+	mock.lockSetFeeRate.RLock()
+	calls = mock.calls.SetFeeRate
+	mock.lockSetFeeRate.RUnlock()
+	return calls
+}
+
+// SetFeeRateVote calls SetFeeRateVoteFunc.
+func (mock *BTCKeeperMock) SetFeeRateVote(ctx github_com_cosmos_cosmos_sdk_types.Context, vote types.FeeRateVote) {
+	if mock.SetFeeRateVoteFunc == nil {
+		panic("BTCKeeperMock.SetFeeRateVoteFunc: method is nil but BTCKeeper.SetFeeRateVote was just called")
+	}
+	callInfo := struct {
+		Ctx  github_com_cosmos_cosmos_sdk_types.Context
+		Vote types.FeeRateVote
+	}{
+		Ctx:  ctx,
+		Vote: vote,
+	}
+	mock.lockSetFeeRateVote.Lock()
+	mock.calls.SetFeeRateVote = append(mock.calls.SetFeeRateVote, callInfo)
+	mock.lockSetFeeRateVote.Unlock()
+	mock.SetFeeRateVoteFunc(ctx, vote)
+}
+
+// SetFeeRateVoteCalls gets all the calls that were made to SetFeeRateVote.
+// Check the length with:
+//     len(mockedBTCKeeper.SetFeeRateVoteCalls())
+func (mock *BTCKeeperMock) SetFeeRateVoteCalls() []struct {
+	Ctx  github_com_cosmos_cosmos_sdk_types.Context
+	Vote types.FeeRateVote
+} {
+	var calls []struct {
+		Ctx  github_com_cosmos_cosmos_sdk_types.Context
+		Vote types.FeeRateVote
+	}
+	mock.lockSetFeeRateVote.RLock()
+	calls = mock.calls.SetFeeRateVote
+	mock.lockSetFeeRateVote.RUnlock()
+	return calls
+}
+
 // SetLatestSignedTxHash calls SetLatestSignedTxHashFunc.
-func (mock *BTCKeeperMock) SetLatestSignedTxHash(ctx sdk.Context, txType types.TxType, txHash chainhash.Hash) {
+func (mock *BTCKeeperMock) SetLatestSignedTxHash(ctx github_com_cosmos_cosmos_sdk_types.Context, txType types.TxType, txHash chainhash.Hash) {
 	if mock.SetLatestSignedTxHashFunc == nil {
 		panic("BTCKeeperMock.SetLatestSignedTxHashFunc: method is nil but BTCKeeper.SetLatestSignedTxHash was just called")
 	}
 	callInfo := struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxType types.TxType
 		TxHash chainhash.Hash
 	}{
@@ -3937,12 +4513,12 @@ func (mock *BTCKeeperMock) SetLatestSignedTxHash(ctx sdk.Context, txType types.T
 // Check the length with:
 //     len(mockedBTCKeeper.SetLatestSignedTxHashCalls())
 func (mock *BTCKeeperMock) SetLatestSignedTxHashCalls() []struct {
-	Ctx    sdk.Context
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
 	TxType types.TxType
 	TxHash chainhash.Hash
 } {
 	var calls []struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		TxType types.TxType
 		TxHash chainhash.Hash
 	}
@@ -3953,12 +4529,12 @@ func (mock *BTCKeeperMock) SetLatestSignedTxHashCalls() []struct {
 }
 
 // SetParams calls SetParamsFunc.
-func (mock *BTCKeeperMock) SetParams(ctx sdk.Context, p types.Params) {
+func (mock *BTCKeeperMock) SetParams(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params) {
 	if mock.SetParamsFunc == nil {
 		panic("BTCKeeperMock.SetParamsFunc: method is nil but BTCKeeper.SetParams was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		P   types.Params
 	}{
 		Ctx: ctx,
@@ -3974,11 +4550,11 @@ func (mock *BTCKeeperMock) SetParams(ctx sdk.Context, p types.Params) {
 // Check the length with:
 //     len(mockedBTCKeeper.SetParamsCalls())
 func (mock *BTCKeeperMock) SetParamsCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 	P   types.Params
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		P   types.Params
 	}
 	mock.lockSetParams.RLock()
@@ -3988,12 +4564,12 @@ func (mock *BTCKeeperMock) SetParamsCalls() []struct {
 }
 
 // SetPendingOutpointInfo calls SetPendingOutpointInfoFunc.
-func (mock *BTCKeeperMock) SetPendingOutpointInfo(ctx sdk.Context, key exported.PollKey, info types.OutPointInfo) {
+func (mock *BTCKeeperMock) SetPendingOutpointInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported.PollKey, info types.OutPointInfo) {
 	if mock.SetPendingOutpointInfoFunc == nil {
 		panic("BTCKeeperMock.SetPendingOutpointInfoFunc: method is nil but BTCKeeper.SetPendingOutpointInfo was just called")
 	}
 	callInfo := struct {
-		Ctx  sdk.Context
+		Ctx  github_com_cosmos_cosmos_sdk_types.Context
 		Key  exported.PollKey
 		Info types.OutPointInfo
 	}{
@@ -4011,12 +4587,12 @@ func (mock *BTCKeeperMock) SetPendingOutpointInfo(ctx sdk.Context, key exported.
 // Check the length with:
 //     len(mockedBTCKeeper.SetPendingOutpointInfoCalls())
 func (mock *BTCKeeperMock) SetPendingOutpointInfoCalls() []struct {
-	Ctx  sdk.Context
+	Ctx  github_com_cosmos_cosmos_sdk_types.Context
 	Key  exported.PollKey
 	Info types.OutPointInfo
 } {
 	var calls []struct {
-		Ctx  sdk.Context
+		Ctx  github_com_cosmos_cosmos_sdk_types.Context
 		Key  exported.PollKey
 		Info types.OutPointInfo
 	}
@@ -4027,12 +4603,12 @@ func (mock *BTCKeeperMock) SetPendingOutpointInfoCalls() []struct {
 }
 
 // SetSignedTx calls SetSignedTxFunc.
-func (mock *BTCKeeperMock) SetSignedTx(ctx sdk.Context, tx types.SignedTx) {
+func (mock *BTCKeeperMock) SetSignedTx(ctx github_com_cosmos_cosmos_sdk_types.Context, tx types.SignedTx) {
 	if mock.SetSignedTxFunc == nil {
 		panic("BTCKeeperMock.SetSignedTxFunc: method is nil but BTCKeeper.SetSignedTx was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Tx  types.SignedTx
 	}{
 		Ctx: ctx,
@@ -4048,11 +4624,11 @@ func (mock *BTCKeeperMock) SetSignedTx(ctx sdk.Context, tx types.SignedTx) {
 // Check the length with:
 //     len(mockedBTCKeeper.SetSignedTxCalls())
 func (mock *BTCKeeperMock) SetSignedTxCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 	Tx  types.SignedTx
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Tx  types.SignedTx
 	}
 	mock.lockSetSignedTx.RLock()
@@ -4062,12 +4638,12 @@ func (mock *BTCKeeperMock) SetSignedTxCalls() []struct {
 }
 
 // SetSpentOutpointInfo calls SetSpentOutpointInfoFunc.
-func (mock *BTCKeeperMock) SetSpentOutpointInfo(ctx sdk.Context, info types.OutPointInfo) {
+func (mock *BTCKeeperMock) SetSpentOutpointInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, info types.OutPointInfo) {
 	if mock.SetSpentOutpointInfoFunc == nil {
 		panic("BTCKeeperMock.SetSpentOutpointInfoFunc: method is nil but BTCKeeper.SetSpentOutpointInfo was just called")
 	}
 	callInfo := struct {
-		Ctx  sdk.Context
+		Ctx  github_com_cosmos_cosmos_sdk_types.Context
 		Info types.OutPointInfo
 	}{
 		Ctx:  ctx,
@@ -4083,11 +4659,11 @@ func (mock *BTCKeeperMock) SetSpentOutpointInfo(ctx sdk.Context, info types.OutP
 // Check the length with:
 //     len(mockedBTCKeeper.SetSpentOutpointInfoCalls())
 func (mock *BTCKeeperMock) SetSpentOutpointInfoCalls() []struct {
-	Ctx  sdk.Context
+	Ctx  github_com_cosmos_cosmos_sdk_types.Context
 	Info types.OutPointInfo
 } {
 	var calls []struct {
-		Ctx  sdk.Context
+		Ctx  github_com_cosmos_cosmos_sdk_types.Context
 		Info types.OutPointInfo
 	}
 	mock.lockSetSpentOutpointInfo.RLock()
@@ -4097,12 +4673,12 @@ func (mock *BTCKeeperMock) SetSpentOutpointInfoCalls() []struct {
 }
 
 // SetUnconfirmedAmount calls SetUnconfirmedAmountFunc.
-func (mock *BTCKeeperMock) SetUnconfirmedAmount(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, amount github_com_btcsuite_btcutil.Amount) {
+func (mock *BTCKeeperMock) SetUnconfirmedAmount(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, amount github_com_btcsuite_btcutil.Amount) {
 	if mock.SetUnconfirmedAmountFunc == nil {
 		panic("BTCKeeperMock.SetUnconfirmedAmountFunc: method is nil but BTCKeeper.SetUnconfirmedAmount was just called")
 	}
 	callInfo := struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		KeyID  github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		Amount github_com_btcsuite_btcutil.Amount
 	}{
@@ -4120,12 +4696,12 @@ func (mock *BTCKeeperMock) SetUnconfirmedAmount(ctx sdk.Context, keyID github_co
 // Check the length with:
 //     len(mockedBTCKeeper.SetUnconfirmedAmountCalls())
 func (mock *BTCKeeperMock) SetUnconfirmedAmountCalls() []struct {
-	Ctx    sdk.Context
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
 	KeyID  github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	Amount github_com_btcsuite_btcutil.Amount
 } {
 	var calls []struct {
-		Ctx    sdk.Context
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
 		KeyID  github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		Amount github_com_btcsuite_btcutil.Amount
 	}
@@ -4136,12 +4712,12 @@ func (mock *BTCKeeperMock) SetUnconfirmedAmountCalls() []struct {
 }
 
 // SetUnsignedTx calls SetUnsignedTxFunc.
-func (mock *BTCKeeperMock) SetUnsignedTx(ctx sdk.Context, tx types.UnsignedTx) {
+func (mock *BTCKeeperMock) SetUnsignedTx(ctx github_com_cosmos_cosmos_sdk_types.Context, tx types.UnsignedTx) {
 	if mock.SetUnsignedTxFunc == nil {
 		panic("BTCKeeperMock.SetUnsignedTxFunc: method is nil but BTCKeeper.SetUnsignedTx was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Tx  types.UnsignedTx
 	}{
 		Ctx: ctx,
@@ -4157,11 +4733,11 @@ func (mock *BTCKeeperMock) SetUnsignedTx(ctx sdk.Context, tx types.UnsignedTx) {
 // Check the length with:
 //     len(mockedBTCKeeper.SetUnsignedTxCalls())
 func (mock *BTCKeeperMock) SetUnsignedTxCalls() []struct {
-	Ctx sdk.Context
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
 	Tx  types.UnsignedTx
 } {
 	var calls []struct {
-		Ctx sdk.Context
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
 		Tx  types.UnsignedTx
 	}
 	mock.lockSetUnsignedTx.RLock()
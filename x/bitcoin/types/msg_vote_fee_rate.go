@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewVoteFeeRateRequest - VoteFeeRateRequest constructor
+func NewVoteFeeRateRequest(sender sdk.AccAddress, confTarget int64, feeRate btcutil.Amount) *VoteFeeRateRequest {
+	return &VoteFeeRateRequest{
+		Sender:     sender,
+		ConfTarget: confTarget,
+		FeeRate:    feeRate,
+	}
+}
+
+// Route returns the route for this message
+func (m VoteFeeRateRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m VoteFeeRateRequest) Type() string {
+	return "VoteFeeRate"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m VoteFeeRateRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.ConfTarget <= 0 {
+		return fmt.Errorf("confirmation target must be greater than 0")
+	}
+
+	if m.FeeRate <= 0 {
+		return fmt.Errorf("fee rate must be greater than 0")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes that need to be signed
+func (m VoteFeeRateRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the set of signers for this message
+func (m VoteFeeRateRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -31,6 +31,8 @@ var (
 	KeyMinVoterCount                        = []byte("minVoterCount")
 	KeyMaxTxSize                            = []byte("maxTxSize")
 	KeyTransactionFeeRate                   = []byte("transactionFeeRate")
+	KeyConsolidationSchedule                = []byte("consolidationSchedule")
+	KeyFeeRateEstimation                    = []byte("feeRateEstimation")
 )
 
 // KeyTable returns a subspace.KeyTable that has registered all parameter types in this module's parameter set
@@ -55,6 +57,21 @@ func DefaultParams() Params {
 		MinVoterCount:                        1,
 		MaxTxSize:                            1024 * 1024 / 3,                // 1/3 MiB
 		TransactionFeeRate:                   sdktypes.NewDecWithPrec(25, 5), // 0.025%
+		ConsolidationSchedule: ConsolidationSchedule{
+			Enabled:                false,
+			PendingAmountThreshold: sdktypes.NewDecCoin(Bitcoin, sdktypes.NewInt(1)),
+			MaxPendingAge:          100,
+			InputCountThreshold:    utils.Threshold{Numerator: 80, Denominator: 100},
+			RetryInterval:          50,
+		},
+		FeeRateEstimation: FeeRateEstimation{
+			VoteInterval: 50,
+			VoteExpiry:   300,
+			ConfTargets:  []int64{1, 6, 144},
+			TxConfTarget: 6,
+			MinFeeRate:   MinRelayTxFee,
+			MaxFeeRate:   100 * MinRelayTxFee,
+		},
 	}
 }
 
@@ -82,6 +99,8 @@ func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMinVoterCount, &m.MinVoterCount, validateMinVoterCount),
 		paramtypes.NewParamSetPair(KeyMaxTxSize, &m.MaxTxSize, validateMaxTxSize),
 		paramtypes.NewParamSetPair(KeyTransactionFeeRate, &m.TransactionFeeRate, validateTransactionFeeRate),
+		paramtypes.NewParamSetPair(KeyConsolidationSchedule, &m.ConsolidationSchedule, validateConsolidationSchedule),
+		paramtypes.NewParamSetPair(KeyFeeRateEstimation, &m.FeeRateEstimation, validateFeeRateEstimation),
 	}
 }
 
@@ -234,6 +253,90 @@ func validateVotingThreshold(votingThreshold interface{}) error {
 	return nil
 }
 
+func validateConsolidationSchedule(schedule interface{}) error {
+	val, ok := schedule.(ConsolidationSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for ConsolidationSchedule: %T", schedule)
+	}
+
+	// the triggers are irrelevant as long as the schedule is disabled
+	if !val.Enabled {
+		return nil
+	}
+
+	if _, err := ToSatoshiCoin(val.PendingAmountThreshold); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "invalid pending amount threshold with error %s", err.Error())
+	}
+
+	if val.MaxPendingAge < 0 {
+		return fmt.Errorf("max pending age must be >=0 for ConsolidationSchedule")
+	}
+
+	if val.InputCountThreshold.Numerator < 0 || val.InputCountThreshold.Denominator <= 0 {
+		return fmt.Errorf("input count threshold must be a non-negative fraction for ConsolidationSchedule")
+	}
+
+	if val.InputCountThreshold.Numerator > val.InputCountThreshold.Denominator {
+		return fmt.Errorf("input count threshold must be <=1 for ConsolidationSchedule")
+	}
+
+	if val.RetryInterval <= 0 {
+		return fmt.Errorf("retry interval must be >0 for ConsolidationSchedule")
+	}
+
+	return nil
+}
+
+func validateFeeRateEstimation(estimation interface{}) error {
+	val, ok := estimation.(FeeRateEstimation)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for FeeRateEstimation: %T", estimation)
+	}
+
+	// the remaining settings are irrelevant as long as the estimation is disabled
+	if val.VoteInterval == 0 {
+		return nil
+	}
+
+	if val.VoteInterval < 0 {
+		return fmt.Errorf("vote interval must be >=0 for FeeRateEstimation")
+	}
+
+	if val.VoteExpiry <= 0 {
+		return fmt.Errorf("vote expiry must be >0 for FeeRateEstimation")
+	}
+
+	if len(val.ConfTargets) == 0 {
+		return fmt.Errorf("at least one confirmation target must be set for FeeRateEstimation")
+	}
+
+	confTargets := make(map[int64]bool)
+	for _, confTarget := range val.ConfTargets {
+		if confTarget <= 0 {
+			return fmt.Errorf("confirmation targets must be >0 for FeeRateEstimation")
+		}
+
+		if confTargets[confTarget] {
+			return fmt.Errorf("duplicate confirmation target %d for FeeRateEstimation", confTarget)
+		}
+		confTargets[confTarget] = true
+	}
+
+	if !confTargets[val.TxConfTarget] {
+		return fmt.Errorf("tx confirmation target %d must be one of the confirmation targets for FeeRateEstimation", val.TxConfTarget)
+	}
+
+	if val.MinFeeRate < MinRelayTxFee {
+		return fmt.Errorf("min fee rate must be >=%d for FeeRateEstimation", MinRelayTxFee)
+	}
+
+	if val.MaxFeeRate < val.MinFeeRate {
+		return fmt.Errorf("max fee rate must be >= min fee rate for FeeRateEstimation")
+	}
+
+	return nil
+}
+
 func validateMinVoterCount(minVoterCount interface{}) error {
 	val, ok := minVoterCount.(int64)
 	if !ok {
@@ -338,5 +441,13 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateConsolidationSchedule(m.ConsolidationSchedule); err != nil {
+		return err
+	}
+
+	if err := validateFeeRateEstimation(m.FeeRateEstimation); err != nil {
+		return err
+	}
+
 	return nil
 }
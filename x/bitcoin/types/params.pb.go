@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	utils "github.com/axelarnetwork/axelar-core/utils"
+	github_com_btcsuite_btcutil "github.com/btcsuite/btcutil"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -43,6 +44,8 @@ type Params struct {
 	MinVoterCount                        int64                                  `protobuf:"varint,12,opt,name=min_voter_count,json=minVoterCount,proto3" json:"min_voter_count,omitempty"`
 	MaxTxSize                            int64                                  `protobuf:"varint,13,opt,name=max_tx_size,json=maxTxSize,proto3" json:"max_tx_size,omitempty"`
 	TransactionFeeRate                   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=transaction_fee_rate,json=transactionFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"transaction_fee_rate"`
+	ConsolidationSchedule                ConsolidationSchedule                  `protobuf:"bytes,15,opt,name=consolidation_schedule,json=consolidationSchedule,proto3" json:"consolidation_schedule"`
+	FeeRateEstimation                    FeeRateEstimation                      `protobuf:"bytes,16,opt,name=fee_rate_estimation,json=feeRateEstimation,proto3" json:"fee_rate_estimation"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,55 +81,178 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// ConsolidationSchedule determines when secondary key consolidations are
+// created and signed automatically at the end of a block
+type ConsolidationSchedule struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// total amount of pending withdrawals that triggers a consolidation, zero
+	// disables this trigger
+	PendingAmountThreshold types.DecCoin `protobuf:"bytes,2,opt,name=pending_amount_threshold,json=pendingAmountThreshold,proto3" json:"pending_amount_threshold"`
+	// number of blocks after which the oldest pending withdrawal triggers a
+	// consolidation, zero disables this trigger
+	MaxPendingAge int64 `protobuf:"varint,3,opt,name=max_pending_age,json=maxPendingAge,proto3" json:"max_pending_age,omitempty"`
+	// share of max_input_count that the confirmed outpoints of the current
+	// secondary key need to reach to trigger a consolidation, zero disables
+	// this trigger
+	InputCountThreshold utils.Threshold `protobuf:"bytes,4,opt,name=input_count_threshold,json=inputCountThreshold,proto3" json:"input_count_threshold"`
+	// number of blocks to wait before a failed consolidation is attempted again
+	RetryInterval int64 `protobuf:"varint,5,opt,name=retry_interval,json=retryInterval,proto3" json:"retry_interval,omitempty"`
+}
+
+func (m *ConsolidationSchedule) Reset()         { *m = ConsolidationSchedule{} }
+func (m *ConsolidationSchedule) String() string { return proto.CompactTextString(m) }
+func (*ConsolidationSchedule) ProtoMessage()    {}
+func (*ConsolidationSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6ece90a3eaf5d5b, []int{1}
+}
+func (m *ConsolidationSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsolidationSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsolidationSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsolidationSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidationSchedule.Merge(m, src)
+}
+func (m *ConsolidationSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsolidationSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidationSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidationSchedule proto.InternalMessageInfo
+
+// FeeRateEstimation determines how validators vote on the fee rate that
+// consolidation, master and rescue transactions pay
+type FeeRateEstimation struct {
+	// number of blocks between two fee rate vote rounds, zero disables the
+	// estimation and transactions pay the minimum relay fee
+	VoteInterval int64 `protobuf:"varint,1,opt,name=vote_interval,json=voteInterval,proto3" json:"vote_interval,omitempty"`
+	// number of blocks after which a fee rate vote is no longer counted
+	VoteExpiry int64 `protobuf:"varint,2,opt,name=vote_expiry,json=voteExpiry,proto3" json:"vote_expiry,omitempty"`
+	// confirmation targets in bitcoin blocks that validators estimate the fee
+	// rate for
+	ConfTargets []int64 `protobuf:"varint,3,rep,packed,name=conf_targets,json=confTargets,proto3" json:"conf_targets,omitempty"`
+	// confirmation target whose fee rate is used to size transactions
+	TxConfTarget int64 `protobuf:"varint,4,opt,name=tx_conf_target,json=txConfTarget,proto3" json:"tx_conf_target,omitempty"`
+	// lower bound of the fee rate in satoshi per kilo virtual byte
+	MinFeeRate github_com_btcsuite_btcutil.Amount `protobuf:"varint,5,opt,name=min_fee_rate,json=minFeeRate,proto3,casttype=github.com/btcsuite/btcutil.Amount" json:"min_fee_rate,omitempty"`
+	// upper bound of the fee rate in satoshi per kilo virtual byte
+	MaxFeeRate github_com_btcsuite_btcutil.Amount `protobuf:"varint,6,opt,name=max_fee_rate,json=maxFeeRate,proto3,casttype=github.com/btcsuite/btcutil.Amount" json:"max_fee_rate,omitempty"`
+}
+
+func (m *FeeRateEstimation) Reset()         { *m = FeeRateEstimation{} }
+func (m *FeeRateEstimation) String() string { return proto.CompactTextString(m) }
+func (*FeeRateEstimation) ProtoMessage()    {}
+func (*FeeRateEstimation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6ece90a3eaf5d5b, []int{2}
+}
+func (m *FeeRateEstimation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRateEstimation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRateEstimation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRateEstimation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRateEstimation.Merge(m, src)
+}
+func (m *FeeRateEstimation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRateEstimation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRateEstimation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRateEstimation proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "bitcoin.v1beta1.Params")
+	proto.RegisterType((*ConsolidationSchedule)(nil), "bitcoin.v1beta1.ConsolidationSchedule")
+	proto.RegisterType((*FeeRateEstimation)(nil), "bitcoin.v1beta1.FeeRateEstimation")
 }
 
 func init() { proto.RegisterFile("bitcoin/v1beta1/params.proto", fileDescriptor_c6ece90a3eaf5d5b) }
 
 var fileDescriptor_c6ece90a3eaf5d5b = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x0a, 0x82, 0x0c, 0x22, 0xb8, 0x40, 0xb2, 0x01, 0x5c, 0x1a, 0x63, 0x48, 0x4d, 0x64,
-	0x37, 0xa0, 0x07, 0x2f, 0x1e, 0x28, 0x68, 0x6c, 0x30, 0x48, 0x16, 0xe2, 0xc1, 0xcb, 0x38, 0xdd,
-	0x7d, 0x6c, 0x27, 0xed, 0xce, 0x34, 0x33, 0xd3, 0xba, 0xe5, 0xaf, 0xf0, 0x8f, 0xf2, 0xc0, 0x91,
-	0xa3, 0xf1, 0x40, 0x14, 0xfe, 0x11, 0x33, 0x3f, 0xb6, 0x22, 0xc1, 0x84, 0x53, 0xbb, 0xf3, 0x7d,
-	0xef, 0x7b, 0x6f, 0xde, 0xf7, 0xde, 0xa0, 0xb5, 0x36, 0x55, 0x29, 0xa7, 0x2c, 0x1e, 0x6e, 0xb5,
-	0x41, 0x91, 0xad, 0xb8, 0x4f, 0x04, 0x29, 0x64, 0xd4, 0x17, 0x5c, 0x71, 0x7f, 0xde, 0xa1, 0x91,
-	0x43, 0x57, 0x96, 0x72, 0x9e, 0x73, 0x83, 0xc5, 0xfa, 0x9f, 0xa5, 0xad, 0xac, 0xde, 0x14, 0x51,
-	0xa3, 0x3e, 0x38, 0x8d, 0x95, 0x30, 0xe5, 0xb2, 0xe0, 0x32, 0x6e, 0x13, 0x09, 0x63, 0x82, 0x11,
-	0xb5, 0xf8, 0x93, 0x81, 0xa2, 0x3d, 0xf9, 0x37, 0xb4, 0x23, 0x40, 0x76, 0x78, 0x2f, 0xb3, 0xf0,
-	0xd3, 0xef, 0xd3, 0x68, 0xea, 0xd0, 0xd4, 0xe4, 0xbf, 0x46, 0xd3, 0x0c, 0xd4, 0x57, 0x2e, 0xba,
-	0x81, 0x57, 0xf7, 0x1a, 0xb3, 0xdb, 0x41, 0x74, 0xa3, 0xbe, 0xe8, 0xc0, 0xe2, 0xcd, 0xc9, 0xb3,
-	0x8b, 0xf5, 0x5a, 0x52, 0xd1, 0xfd, 0x18, 0x2d, 0xa6, 0x9c, 0x9d, 0x50, 0x51, 0x10, 0x45, 0x39,
-	0xc3, 0x1d, 0xa0, 0x79, 0x47, 0x05, 0xf7, 0xea, 0x5e, 0x63, 0x32, 0xf1, 0xaf, 0x43, 0xef, 0x0d,
-	0xe2, 0x6f, 0xa3, 0x65, 0x01, 0x43, 0xae, 0x00, 0xf7, 0x78, 0xda, 0xa5, 0x2c, 0xc7, 0x7d, 0x10,
-	0x94, 0x67, 0xc1, 0x44, 0xdd, 0x6b, 0x4c, 0x24, 0x8b, 0x16, 0xfc, 0x60, 0xb1, 0x43, 0x03, 0xf9,
-	0x2f, 0x90, 0x2f, 0x69, 0x8e, 0xd3, 0x0e, 0xa4, 0x5d, 0x4c, 0x99, 0x02, 0x31, 0x24, 0xbd, 0x60,
-	0xd2, 0x04, 0x2c, 0x48, 0x9a, 0xef, 0x6a, 0xa0, 0xe5, 0xce, 0xfd, 0x03, 0xf4, 0xb8, 0xa0, 0x0c,
-	0xf3, 0x81, 0xea, 0x0f, 0x14, 0x26, 0x05, 0x1f, 0x30, 0x15, 0xdc, 0x37, 0xd7, 0x5a, 0x8b, 0x6c,
-	0xcb, 0x22, 0xdd, 0xb2, 0xf1, 0xd5, 0xf6, 0x20, 0xdd, 0xe5, 0x94, 0xb9, 0xab, 0xcd, 0x17, 0x94,
-	0x7d, 0x34, 0xb1, 0x3b, 0x26, 0xd4, 0xdf, 0x40, 0xf3, 0x05, 0x29, 0x31, 0x65, 0x5a, 0x2e, 0x35,
-	0x6a, 0x53, 0x26, 0xf5, 0x5c, 0x41, 0xca, 0x96, 0x3e, 0xdd, 0x35, 0x3c, 0x82, 0x56, 0x35, 0x4f,
-	0x42, 0xca, 0x59, 0x46, 0xc4, 0xe8, 0x46, 0x05, 0xd3, 0x77, 0xae, 0x20, 0x28, 0x48, 0x79, 0x54,
-	0xa9, 0xfc, 0x53, 0xca, 0x1b, 0x9d, 0x42, 0x2a, 0x10, 0xb8, 0x0b, 0x23, 0x2c, 0x40, 0x01, 0x33,
-	0x5d, 0x77, 0x2d, 0x7c, 0x60, 0xca, 0x0a, 0x2c, 0x65, 0x1f, 0x46, 0x49, 0x45, 0x70, 0x7d, 0x64,
-	0xe8, 0xb9, 0x0b, 0x27, 0x59, 0x26, 0x40, 0x4a, 0xdb, 0x4c, 0x46, 0x7a, 0x46, 0x4f, 0x1b, 0x82,
-	0xb3, 0x81, 0x30, 0x76, 0x05, 0x33, 0x5a, 0xac, 0xb9, 0xac, 0x2b, 0xfa, 0x79, 0xb1, 0x3e, 0xa7,
-	0x68, 0x01, 0xd1, 0x9e, 0x03, 0x93, 0x67, 0x56, 0x67, 0xc7, 0xca, 0xb4, 0x9c, 0xca, 0x3e, 0x8c,
-	0xb4, 0x71, 0x15, 0xeb, 0x96, 0x7c, 0x50, 0xfe, 0x37, 0x1f, 0xba, 0x7b, 0xbe, 0xb7, 0xe5, 0xed,
-	0xf9, 0x5a, 0x68, 0x61, 0xc8, 0x95, 0x9e, 0xa9, 0xf1, 0xac, 0x07, 0xb3, 0x6e, 0x9e, 0xcd, 0x2e,
-	0x8c, 0x1b, 0x7e, 0x5c, 0xe1, 0x95, 0xe9, 0x36, 0x6e, 0x7c, 0x6c, 0x4c, 0xa7, 0x0c, 0xeb, 0x59,
-	0x14, 0xce, 0xf4, 0x87, 0xce, 0x74, 0xca, 0x3e, 0xe9, 0x53, 0x6b, 0x7a, 0x88, 0x66, 0xb5, 0xe9,
-	0xaa, 0xc4, 0x92, 0x9e, 0x42, 0x30, 0x67, 0x38, 0x33, 0x05, 0x29, 0x8f, 0xcb, 0x23, 0x7a, 0x0a,
-	0xfe, 0x17, 0xb4, 0xa4, 0x04, 0x61, 0x92, 0xa4, 0xc6, 0xa8, 0x13, 0x00, 0x2c, 0x88, 0x82, 0xe0,
-	0x51, 0xdd, 0x6b, 0xcc, 0x34, 0x23, 0x77, 0xdb, 0x8d, 0x9c, 0xaa, 0xce, 0xa0, 0x1d, 0xa5, 0xbc,
-	0x88, 0xdd, 0x52, 0xdb, 0x9f, 0x4d, 0x99, 0x75, 0xdd, 0xce, 0xef, 0x41, 0x9a, 0xf8, 0xd7, 0xb4,
-	0xde, 0x01, 0x24, 0x44, 0x41, 0x33, 0x39, 0xfb, 0x1d, 0xd6, 0xce, 0x2e, 0x43, 0xef, 0xfc, 0x32,
-	0xf4, 0x7e, 0x5d, 0x86, 0xde, 0xb7, 0xab, 0xb0, 0x76, 0x7e, 0x15, 0xd6, 0x7e, 0x5c, 0x85, 0xb5,
-	0xcf, 0xaf, 0xae, 0x29, 0x93, 0x12, 0x7a, 0x44, 0xb8, 0xcd, 0x75, 0x5f, 0x9b, 0x29, 0x17, 0x10,
-	0x97, 0x71, 0xf5, 0xce, 0x98, 0x5c, 0xed, 0x29, 0xf3, 0x42, 0xbc, 0xfc, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0xff, 0x74, 0x16, 0x3d, 0xc4, 0x04, 0x00, 0x00,
+	// 937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xb6, 0xe3, 0x34, 0x69, 0xc6, 0xce, 0xd7, 0xa4, 0xae, 0x56, 0x69, 0x71, 0x8c, 0x29, 0x91,
+	0x91, 0xe8, 0x5a, 0x2d, 0x1c, 0xb8, 0x70, 0xa8, 0x9d, 0xa0, 0x46, 0x45, 0x25, 0xda, 0x44, 0x08,
+	0x21, 0xa4, 0x65, 0xbc, 0xfb, 0x66, 0x3d, 0xb2, 0x77, 0xc6, 0x9a, 0x19, 0x87, 0x75, 0xaf, 0xfc,
+	0x01, 0x7e, 0x0b, 0x07, 0x7e, 0x43, 0x8e, 0x3d, 0x22, 0x0e, 0x11, 0x24, 0xff, 0x82, 0x13, 0x9a,
+	0x8f, 0xdd, 0x6c, 0x3e, 0x90, 0xd2, 0x53, 0xe2, 0xf7, 0x79, 0xde, 0xe7, 0x7d, 0xe7, 0xfd, 0x5a,
+	0xf4, 0x74, 0x48, 0x55, 0xc4, 0x29, 0xeb, 0x9d, 0xbe, 0x18, 0x82, 0x22, 0x2f, 0x7a, 0x53, 0x22,
+	0x48, 0x2a, 0xfd, 0xa9, 0xe0, 0x8a, 0xe3, 0x75, 0x87, 0xfa, 0x0e, 0xdd, 0x7e, 0x94, 0xf0, 0x84,
+	0x1b, 0xac, 0xa7, 0xff, 0xb3, 0xb4, 0xed, 0x27, 0x37, 0x45, 0xd4, 0x7c, 0x0a, 0x4e, 0x63, 0xbb,
+	0x15, 0x71, 0x99, 0x72, 0xd9, 0x1b, 0x12, 0x09, 0x05, 0xc1, 0x88, 0x5a, 0xfc, 0xa3, 0x99, 0xa2,
+	0x13, 0x79, 0xe5, 0x3a, 0x12, 0x20, 0x47, 0x7c, 0x12, 0x5b, 0xb8, 0xf3, 0xeb, 0x0a, 0x5a, 0x3a,
+	0x34, 0x39, 0xe1, 0xaf, 0xd0, 0x32, 0x03, 0xf5, 0x0b, 0x17, 0x63, 0xaf, 0xda, 0xae, 0x76, 0xeb,
+	0x2f, 0x3d, 0xff, 0x46, 0x7e, 0xfe, 0x5b, 0x8b, 0xf7, 0x17, 0xcf, 0xce, 0x77, 0x2a, 0x41, 0x4e,
+	0xc7, 0x3d, 0xb4, 0x15, 0x71, 0x76, 0x42, 0x45, 0x4a, 0x14, 0xe5, 0x2c, 0x1c, 0x01, 0x4d, 0x46,
+	0xca, 0x5b, 0x68, 0x57, 0xbb, 0x8b, 0x01, 0x2e, 0x43, 0xaf, 0x0d, 0x82, 0x5f, 0xa2, 0xa6, 0x80,
+	0x53, 0xae, 0x20, 0x9c, 0xf0, 0x68, 0x4c, 0x59, 0x12, 0x4e, 0x41, 0x50, 0x1e, 0x7b, 0xb5, 0x76,
+	0xb5, 0x5b, 0x0b, 0xb6, 0x2c, 0xf8, 0xad, 0xc5, 0x0e, 0x0d, 0x84, 0x3f, 0x47, 0x58, 0xd2, 0x24,
+	0x8c, 0x46, 0x10, 0x8d, 0x43, 0xca, 0x14, 0x88, 0x53, 0x32, 0xf1, 0x16, 0x8d, 0xc3, 0x86, 0xa4,
+	0xc9, 0x40, 0x03, 0x07, 0xce, 0x8e, 0xdf, 0xa2, 0xcd, 0x94, 0xb2, 0x90, 0xcf, 0xd4, 0x74, 0xa6,
+	0x42, 0x92, 0xf2, 0x19, 0x53, 0xde, 0x03, 0xf3, 0xac, 0xa7, 0xbe, 0x2d, 0x99, 0xaf, 0x4b, 0x56,
+	0x3c, 0x6d, 0x0f, 0xa2, 0x01, 0xa7, 0xcc, 0x3d, 0x6d, 0x3d, 0xa5, 0xec, 0x3b, 0xe3, 0xfb, 0xca,
+	0xb8, 0xe2, 0x5d, 0xb4, 0x9e, 0x92, 0x2c, 0xa4, 0x4c, 0xcb, 0x45, 0x46, 0x6d, 0xc9, 0x84, 0x5e,
+	0x4d, 0x49, 0x76, 0xa0, 0xad, 0x03, 0xc3, 0x23, 0xe8, 0x89, 0xe6, 0x49, 0x88, 0x38, 0x8b, 0x89,
+	0x98, 0xdf, 0xc8, 0x60, 0xf9, 0xde, 0x19, 0x78, 0x29, 0xc9, 0x8e, 0x72, 0x95, 0x6b, 0xa9, 0x7c,
+	0xad, 0x43, 0x48, 0x05, 0x22, 0x1c, 0xc3, 0x3c, 0x14, 0xa0, 0x80, 0x99, 0xaa, 0xbb, 0x12, 0x3e,
+	0x34, 0x69, 0x79, 0x96, 0xf2, 0x06, 0xe6, 0x41, 0x4e, 0x70, 0x75, 0x64, 0xe8, 0x33, 0xe7, 0x4e,
+	0xe2, 0x58, 0x80, 0x94, 0xb6, 0x98, 0x8c, 0x4c, 0x8c, 0x9e, 0x6e, 0x48, 0x18, 0xcf, 0x84, 0x69,
+	0x97, 0xb7, 0xa2, 0xc5, 0xfa, 0x4d, 0x9d, 0xd1, 0x5f, 0xe7, 0x3b, 0xab, 0x8a, 0xa6, 0xe0, 0xef,
+	0x39, 0x30, 0x78, 0x66, 0x75, 0x5e, 0x59, 0x99, 0x03, 0xa7, 0xf2, 0x06, 0xe6, 0xba, 0x71, 0x39,
+	0xeb, 0x8e, 0x78, 0x90, 0xfd, 0x6f, 0x3c, 0x74, 0xff, 0x78, 0xfb, 0xd9, 0xdd, 0xf1, 0x0e, 0xd0,
+	0xc6, 0x29, 0x57, 0x7a, 0xa6, 0x8a, 0x59, 0xf7, 0xea, 0x6e, 0x9e, 0xcd, 0x2e, 0x14, 0x05, 0x3f,
+	0xce, 0xf1, 0xbc, 0xe9, 0xd6, 0xaf, 0x30, 0x9b, 0xa6, 0x53, 0x16, 0xea, 0x59, 0x14, 0xae, 0xe9,
+	0x0d, 0xd7, 0x74, 0xca, 0xbe, 0xd7, 0x56, 0xdb, 0xf4, 0x16, 0xaa, 0xeb, 0xa6, 0xab, 0x2c, 0x94,
+	0xf4, 0x1d, 0x78, 0xab, 0x86, 0xb3, 0x92, 0x92, 0xec, 0x38, 0x3b, 0xa2, 0xef, 0x00, 0xff, 0x8c,
+	0x1e, 0x29, 0x41, 0x98, 0x24, 0x91, 0x69, 0xd4, 0x09, 0x40, 0x28, 0x88, 0x02, 0x6f, 0xad, 0x5d,
+	0xed, 0xae, 0xf4, 0x7d, 0xf7, 0xda, 0xdd, 0x84, 0xaa, 0xd1, 0x6c, 0xe8, 0x47, 0x3c, 0xed, 0xb9,
+	0xa5, 0xb6, 0x7f, 0x9e, 0xcb, 0x78, 0xec, 0x76, 0x7e, 0x0f, 0xa2, 0x00, 0x97, 0xb4, 0xbe, 0x01,
+	0x08, 0x88, 0x02, 0x1c, 0xa1, 0xc7, 0x11, 0x67, 0x92, 0x4f, 0x68, 0x6c, 0x57, 0x50, 0x46, 0x23,
+	0x88, 0x67, 0x13, 0xf0, 0xd6, 0xcd, 0xd3, 0x77, 0x6f, 0xad, 0xf2, 0xa0, 0x4c, 0x3f, 0x72, 0x6c,
+	0x57, 0x88, 0x66, 0x74, 0x17, 0x88, 0x7f, 0x40, 0x5b, 0x79, 0xea, 0x21, 0x48, 0x45, 0xed, 0x4a,
+	0x7b, 0x1b, 0x26, 0x42, 0xe7, 0x56, 0x04, 0x97, 0xdb, 0x7e, 0xc1, 0x74, 0xea, 0x9b, 0x27, 0x37,
+	0x81, 0xce, 0xef, 0x0b, 0xa8, 0x79, 0x67, 0x42, 0xd8, 0x43, 0xcb, 0xc0, 0xc8, 0x70, 0x02, 0xb1,
+	0x39, 0x4a, 0x0f, 0x83, 0xfc, 0x27, 0xfe, 0x09, 0x79, 0x53, 0x60, 0xb1, 0x6e, 0xb4, 0x5d, 0xae,
+	0x52, 0xbf, 0x17, 0xee, 0xbd, 0x66, 0x8f, 0x9d, 0x86, 0xdd, 0xad, 0xeb, 0xad, 0x27, 0x59, 0x58,
+	0x44, 0x48, 0xc0, 0xab, 0x15, 0xfb, 0x7e, 0xe8, 0x7c, 0x12, 0xc0, 0x01, 0x6a, 0x96, 0x6e, 0x42,
+	0x29, 0x85, 0xc5, 0x7b, 0x8d, 0xdc, 0x16, 0x2d, 0x4e, 0xc7, 0x55, 0xec, 0x4f, 0xd1, 0x9a, 0x00,
+	0x25, 0xe6, 0x57, 0x57, 0xee, 0x81, 0x0d, 0x6d, 0xac, 0xf9, 0x89, 0xeb, 0xfc, 0xb1, 0x80, 0x36,
+	0x6f, 0xd5, 0x18, 0x7f, 0x82, 0x56, 0xcd, 0x61, 0x2d, 0x7c, 0xab, 0xc6, 0xb7, 0xa1, 0x8d, 0xc5,
+	0x75, 0xdc, 0x41, 0x75, 0x43, 0x82, 0x6c, 0x4a, 0xc5, 0xdc, 0x94, 0xab, 0x16, 0x20, 0x6d, 0xda,
+	0x37, 0x16, 0xfc, 0x31, 0x6a, 0xe8, 0xb3, 0x1d, 0x2a, 0x22, 0x12, 0x50, 0xd2, 0xab, 0xb5, 0x6b,
+	0xdd, 0x5a, 0x50, 0xd7, 0xb6, 0x63, 0x6b, 0xc2, 0xcf, 0xd0, 0x9a, 0xca, 0xc2, 0x12, 0xcb, 0xdd,
+	0xe2, 0x86, 0xca, 0x06, 0x05, 0x0d, 0xbf, 0x46, 0x0d, 0xbd, 0x42, 0xc5, 0xc8, 0x9b, 0x97, 0xf4,
+	0x77, 0xff, 0x3d, 0xdf, 0xe9, 0x94, 0xc6, 0x7d, 0xa8, 0x22, 0x39, 0xa3, 0x0a, 0xf4, 0x3f, 0xba,
+	0x60, 0xbe, 0x6d, 0x47, 0x80, 0x52, 0x5a, 0x8c, 0xb8, 0x56, 0x22, 0xd9, 0x95, 0xd2, 0xd2, 0x07,
+	0x2a, 0x91, 0xcc, 0x29, 0xf5, 0x83, 0xb3, 0x7f, 0x5a, 0x95, 0xb3, 0x8b, 0x56, 0xf5, 0xfd, 0x45,
+	0xab, 0xfa, 0xf7, 0x45, 0xab, 0xfa, 0xdb, 0x65, 0xab, 0xf2, 0xfe, 0xb2, 0x55, 0xf9, 0xf3, 0xb2,
+	0x55, 0xf9, 0xf1, 0xcb, 0x92, 0x1a, 0xc9, 0x60, 0x42, 0x84, 0xfb, 0xcc, 0xb9, 0x5f, 0xcf, 0x23,
+	0x2e, 0xa0, 0x97, 0xf5, 0xf2, 0x8f, 0xb2, 0x59, 0xcc, 0xe1, 0x92, 0xf9, 0x9c, 0x7e, 0xf1, 0xdf,
+	0x00, 0xdf, 0x9b, 0x47, 0x0b, 0xf1, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -149,6 +275,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeRateEstimation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.ConsolidationSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
 	{
 		size := m.TransactionFeeRate.Size()
 		i -= size
@@ -247,6 +395,136 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsolidationSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsolidationSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsolidationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.InputCountThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxPendingAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPendingAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.PendingAmountThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRateEstimation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRateEstimation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRateEstimation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFeeRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFeeRate))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinFeeRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinFeeRate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxConfTarget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TxConfTarget))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ConfTargets) > 0 {
+		dAtA10 := make([]byte, len(m.ConfTargets)*10)
+		var j9 int
+		for _, num1 := range m.ConfTargets {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintParams(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VoteExpiry != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteExpiry))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VoteInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -301,6 +579,63 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.TransactionFeeRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.ConsolidationSchedule.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeRateEstimation.Size()
+	n += 2 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ConsolidationSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.PendingAmountThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxPendingAge != 0 {
+		n += 1 + sovParams(uint64(m.MaxPendingAge))
+	}
+	l = m.InputCountThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RetryInterval != 0 {
+		n += 1 + sovParams(uint64(m.RetryInterval))
+	}
+	return n
+}
+
+func (m *FeeRateEstimation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteInterval != 0 {
+		n += 1 + sovParams(uint64(m.VoteInterval))
+	}
+	if m.VoteExpiry != 0 {
+		n += 1 + sovParams(uint64(m.VoteExpiry))
+	}
+	if len(m.ConfTargets) > 0 {
+		l = 0
+		for _, e := range m.ConfTargets {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.TxConfTarget != 0 {
+		n += 1 + sovParams(uint64(m.TxConfTarget))
+	}
+	if m.MinFeeRate != 0 {
+		n += 1 + sovParams(uint64(m.MinFeeRate))
+	}
+	if m.MaxFeeRate != 0 {
+		n += 1 + sovParams(uint64(m.MaxFeeRate))
+	}
 	return n
 }
 
@@ -676,6 +1011,467 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsolidationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsolidationSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateEstimation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRateEstimation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsolidationSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsolidationSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsolidationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAmountThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingAmountThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingAge", wireType)
+			}
+			m.MaxPendingAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCountThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InputCountThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInterval", wireType)
+			}
+			m.RetryInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRateEstimation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRateEstimation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRateEstimation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteInterval", wireType)
+			}
+			m.VoteInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExpiry", wireType)
+			}
+			m.VoteExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConfTargets = append(m.ConfTargets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ConfTargets) == 0 {
+					m.ConfTargets = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConfTargets = append(m.ConfTargets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfTargets", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxConfTarget", wireType)
+			}
+			m.TxConfTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxConfTarget |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeRate", wireType)
+			}
+			m.MinFeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFeeRate |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeRate", wireType)
+			}
+			m.MaxFeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeeRate |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
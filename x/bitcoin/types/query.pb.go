@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -220,52 +221,413 @@ func (m *QueryTxResponse_SigningInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTxResponse_SigningInfo proto.InternalMessageInfo
 
+type QueryDepositAddressRequest struct {
+	RecipientChain   string `protobuf:"bytes,1,opt,name=recipient_chain,json=recipientChain,proto3" json:"recipient_chain,omitempty"`
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+}
+
+func (m *QueryDepositAddressRequest) Reset()         { *m = QueryDepositAddressRequest{} }
+func (m *QueryDepositAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositAddressRequest) ProtoMessage()    {}
+func (*QueryDepositAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{4}
+}
+func (m *QueryDepositAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositAddressRequest.Merge(m, src)
+}
+func (m *QueryDepositAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositAddressRequest proto.InternalMessageInfo
+
+type QueryDepositStatusRequest struct {
+	OutPoint string `protobuf:"bytes,1,opt,name=out_point,json=outPoint,proto3" json:"out_point,omitempty"`
+}
+
+func (m *QueryDepositStatusRequest) Reset()         { *m = QueryDepositStatusRequest{} }
+func (m *QueryDepositStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositStatusRequest) ProtoMessage()    {}
+func (*QueryDepositStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{5}
+}
+func (m *QueryDepositStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositStatusRequest.Merge(m, src)
+}
+func (m *QueryDepositStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositStatusRequest proto.InternalMessageInfo
+
+// QueryConsolidationAddressRequest queries the consolidation address of the
+// given key ID or, if no key ID is given, of the current key of the given key
+// role
+type QueryConsolidationAddressRequest struct {
+	KeyRole exported.KeyRole                                          `protobuf:"varint,1,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+	KeyID   github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+}
+
+func (m *QueryConsolidationAddressRequest) Reset()         { *m = QueryConsolidationAddressRequest{} }
+func (m *QueryConsolidationAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsolidationAddressRequest) ProtoMessage()    {}
+func (*QueryConsolidationAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{6}
+}
+func (m *QueryConsolidationAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsolidationAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsolidationAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsolidationAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsolidationAddressRequest.Merge(m, src)
+}
+func (m *QueryConsolidationAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsolidationAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsolidationAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsolidationAddressRequest proto.InternalMessageInfo
+
+type QueryNextKeyIDRequest struct {
+	KeyRole exported.KeyRole `protobuf:"varint,1,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+}
+
+func (m *QueryNextKeyIDRequest) Reset()         { *m = QueryNextKeyIDRequest{} }
+func (m *QueryNextKeyIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextKeyIDRequest) ProtoMessage()    {}
+func (*QueryNextKeyIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{7}
+}
+func (m *QueryNextKeyIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextKeyIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextKeyIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextKeyIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextKeyIDRequest.Merge(m, src)
+}
+func (m *QueryNextKeyIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextKeyIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextKeyIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextKeyIDRequest proto.InternalMessageInfo
+
+type QueryNextKeyIDResponse struct {
+	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+}
+
+func (m *QueryNextKeyIDResponse) Reset()         { *m = QueryNextKeyIDResponse{} }
+func (m *QueryNextKeyIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextKeyIDResponse) ProtoMessage()    {}
+func (*QueryNextKeyIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{8}
+}
+func (m *QueryNextKeyIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextKeyIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextKeyIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextKeyIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextKeyIDResponse.Merge(m, src)
+}
+func (m *QueryNextKeyIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextKeyIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextKeyIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextKeyIDResponse proto.InternalMessageInfo
+
+type QueryMinOutputAmountRequest struct {
+}
+
+func (m *QueryMinOutputAmountRequest) Reset()         { *m = QueryMinOutputAmountRequest{} }
+func (m *QueryMinOutputAmountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinOutputAmountRequest) ProtoMessage()    {}
+func (*QueryMinOutputAmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{9}
+}
+func (m *QueryMinOutputAmountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinOutputAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinOutputAmountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinOutputAmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinOutputAmountRequest.Merge(m, src)
+}
+func (m *QueryMinOutputAmountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinOutputAmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinOutputAmountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinOutputAmountRequest proto.InternalMessageInfo
+
+type QueryMinOutputAmountResponse struct {
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryMinOutputAmountResponse) Reset()         { *m = QueryMinOutputAmountResponse{} }
+func (m *QueryMinOutputAmountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinOutputAmountResponse) ProtoMessage()    {}
+func (*QueryMinOutputAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{10}
+}
+func (m *QueryMinOutputAmountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinOutputAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinOutputAmountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinOutputAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinOutputAmountResponse.Merge(m, src)
+}
+func (m *QueryMinOutputAmountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinOutputAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinOutputAmountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinOutputAmountResponse proto.InternalMessageInfo
+
+type QueryLatestTxRequest struct {
+	TxType TxType `protobuf:"varint,1,opt,name=tx_type,json=txType,proto3,enum=bitcoin.v1beta1.TxType" json:"tx_type,omitempty"`
+}
+
+func (m *QueryLatestTxRequest) Reset()         { *m = QueryLatestTxRequest{} }
+func (m *QueryLatestTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestTxRequest) ProtoMessage()    {}
+func (*QueryLatestTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{11}
+}
+func (m *QueryLatestTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestTxRequest.Merge(m, src)
+}
+func (m *QueryLatestTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestTxRequest proto.InternalMessageInfo
+
+type QuerySignedTxRequest struct {
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QuerySignedTxRequest) Reset()         { *m = QuerySignedTxRequest{} }
+func (m *QuerySignedTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignedTxRequest) ProtoMessage()    {}
+func (*QuerySignedTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f1bd927442f8a6, []int{12}
+}
+func (m *QuerySignedTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignedTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignedTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignedTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignedTxRequest.Merge(m, src)
+}
+func (m *QuerySignedTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignedTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignedTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignedTxRequest proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DepositQueryParams)(nil), "bitcoin.v1beta1.DepositQueryParams")
 	proto.RegisterType((*QueryAddressResponse)(nil), "bitcoin.v1beta1.QueryAddressResponse")
 	proto.RegisterType((*QueryDepositStatusResponse)(nil), "bitcoin.v1beta1.QueryDepositStatusResponse")
 	proto.RegisterType((*QueryTxResponse)(nil), "bitcoin.v1beta1.QueryTxResponse")
 	proto.RegisterType((*QueryTxResponse_SigningInfo)(nil), "bitcoin.v1beta1.QueryTxResponse.SigningInfo")
+	proto.RegisterType((*QueryDepositAddressRequest)(nil), "bitcoin.v1beta1.QueryDepositAddressRequest")
+	proto.RegisterType((*QueryDepositStatusRequest)(nil), "bitcoin.v1beta1.QueryDepositStatusRequest")
+	proto.RegisterType((*QueryConsolidationAddressRequest)(nil), "bitcoin.v1beta1.QueryConsolidationAddressRequest")
+	proto.RegisterType((*QueryNextKeyIDRequest)(nil), "bitcoin.v1beta1.QueryNextKeyIDRequest")
+	proto.RegisterType((*QueryNextKeyIDResponse)(nil), "bitcoin.v1beta1.QueryNextKeyIDResponse")
+	proto.RegisterType((*QueryMinOutputAmountRequest)(nil), "bitcoin.v1beta1.QueryMinOutputAmountRequest")
+	proto.RegisterType((*QueryMinOutputAmountResponse)(nil), "bitcoin.v1beta1.QueryMinOutputAmountResponse")
+	proto.RegisterType((*QueryLatestTxRequest)(nil), "bitcoin.v1beta1.QueryLatestTxRequest")
+	proto.RegisterType((*QuerySignedTxRequest)(nil), "bitcoin.v1beta1.QuerySignedTxRequest")
 }
 
 func init() { proto.RegisterFile("bitcoin/v1beta1/query.proto", fileDescriptor_47f1bd927442f8a6) }
 
 var fileDescriptor_47f1bd927442f8a6 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xe6, 0x4b, 0x3e, 0x98, 0xfe, 0xd5, 0x90, 0x22, 0x13, 0x24, 0x37, 0x0a, 0x9b,
-	0x2c, 0xa8, 0xad, 0xb4, 0x08, 0x89, 0x25, 0x25, 0x42, 0x04, 0x16, 0xb4, 0x4e, 0xc4, 0x02, 0x09,
-	0x59, 0x13, 0xfb, 0xc6, 0x19, 0xa5, 0x99, 0x71, 0x67, 0xc6, 0xc1, 0x7e, 0x05, 0x36, 0xf0, 0x58,
-	0x5d, 0x76, 0xc9, 0xaa, 0x82, 0xe4, 0x2d, 0x58, 0x21, 0x8f, 0xa7, 0xb4, 0x4a, 0x24, 0xc4, 0x6e,
-	0xee, 0xfc, 0xce, 0x3d, 0x3e, 0x73, 0xe5, 0x8b, 0x1e, 0x8f, 0xa8, 0x0a, 0x39, 0x65, 0xde, 0xbc,
-	0x3b, 0x02, 0x45, 0xba, 0xde, 0x45, 0x0a, 0x22, 0x77, 0x13, 0xc1, 0x15, 0xc7, 0xbb, 0x06, 0xba,
-	0x06, 0x36, 0x1b, 0x31, 0x8f, 0xb9, 0x66, 0x5e, 0x71, 0x2a, 0x65, 0xcd, 0x35, 0x0f, 0x95, 0x27,
-	0x20, 0x4b, 0xd8, 0xee, 0x21, 0xdc, 0x83, 0x84, 0x4b, 0xaa, 0xce, 0x0a, 0xe7, 0x53, 0x22, 0xc8,
-	0x4c, 0x62, 0x1b, 0xfd, 0x4f, 0xa2, 0x48, 0x80, 0x94, 0xb6, 0xd5, 0xb2, 0x3a, 0xf7, 0xfd, 0x9b,
-	0x12, 0x37, 0x50, 0x2d, 0x9c, 0x10, 0xca, 0xec, 0x0d, 0x7d, 0x5f, 0x16, 0xed, 0xaf, 0x16, 0x6a,
-	0xe8, 0xfe, 0x97, 0xa5, 0xcc, 0x07, 0x99, 0x70, 0x26, 0xe1, 0x2f, 0x46, 0x9f, 0x50, 0x7d, 0x0a,
-	0x79, 0x40, 0xa3, 0xd2, 0xe9, 0xe4, 0xf5, 0xe2, 0xfa, 0xa0, 0xf6, 0x0e, 0xf2, 0x7e, 0xef, 0xd7,
-	0xf5, 0xc1, 0x8b, 0x98, 0xaa, 0x49, 0x3a, 0x72, 0x43, 0x3e, 0xf3, 0x48, 0x06, 0xe7, 0x44, 0x30,
-	0x50, 0x9f, 0xb9, 0x98, 0x9a, 0xea, 0x30, 0xe4, 0x02, 0xbc, 0xcc, 0x53, 0x52, 0x7a, 0x90, 0x25,
-	0x5c, 0x28, 0x88, 0x5c, 0xdd, 0xec, 0xd7, 0xa6, 0x90, 0xf7, 0xa3, 0xf6, 0x18, 0x35, 0x75, 0x20,
-	0xf3, 0xb8, 0x81, 0x22, 0x2a, 0xbd, 0x8d, 0xb5, 0x87, 0xaa, 0xe7, 0x3c, 0x36, 0x91, 0x8a, 0x23,
-	0x7e, 0x8e, 0xea, 0x52, 0x6b, 0x74, 0x9c, 0x9d, 0x23, 0xc7, 0x5d, 0x19, 0xae, 0xfb, 0x3e, 0x55,
-	0xa7, 0x9c, 0x32, 0x6d, 0x05, 0xbe, 0x51, 0xb7, 0xbf, 0x54, 0xd1, 0xae, 0xfe, 0xd0, 0x30, 0xfb,
-	0xe3, 0xbe, 0x83, 0x36, 0x54, 0x66, 0xcc, 0x37, 0x54, 0x86, 0xbb, 0x2b, 0xde, 0x8f, 0xd6, 0xbc,
-	0x87, 0x99, 0x09, 0x68, 0x84, 0xf8, 0x18, 0xed, 0x87, 0x9c, 0x8d, 0xa9, 0x98, 0x11, 0x45, 0x39,
-	0x0b, 0x04, 0x5c, 0xa4, 0x54, 0x40, 0x64, 0x57, 0x5b, 0x56, 0xe7, 0x9e, 0xdf, 0xb8, 0x0b, 0x7d,
-	0xc3, 0xf0, 0x21, 0x7a, 0x90, 0x08, 0x98, 0x07, 0x92, 0xc6, 0x0c, 0xa2, 0x40, 0x65, 0xc1, 0x84,
-	0xc8, 0x89, 0xfd, 0x9f, 0x0e, 0xb2, 0x57, 0xa0, 0x81, 0x26, 0xc3, 0xec, 0x0d, 0x91, 0x13, 0xdc,
-	0x45, 0xfb, 0x84, 0xe5, 0x9c, 0x41, 0x10, 0x12, 0x16, 0xc8, 0x04, 0x58, 0x14, 0xcc, 0x79, 0xaa,
-	0xec, 0x5a, 0xcb, 0xea, 0x6c, 0xfb, 0xb8, 0x84, 0xaf, 0x08, 0x1b, 0x14, 0xe8, 0x03, 0x4f, 0x15,
-	0x3e, 0x43, 0xdb, 0x85, 0x39, 0x65, 0x71, 0x40, 0xd9, 0x98, 0x4b, 0xbb, 0xde, 0xaa, 0x76, 0x36,
-	0x8f, 0x9e, 0xae, 0x3d, 0x68, 0x65, 0x24, 0xee, 0xa0, 0xec, 0xea, 0xb3, 0x31, 0xf7, 0xb7, 0xe4,
-	0x6d, 0x21, 0x9b, 0x6f, 0xd1, 0xe6, 0x1d, 0x88, 0x9f, 0xa0, 0x6d, 0x01, 0x11, 0xc0, 0x2c, 0x90,
-	0xa1, 0xa0, 0x89, 0x32, 0x63, 0xdc, 0x2a, 0x2f, 0x07, 0xfa, 0x0e, 0x3f, 0x44, 0x75, 0x32, 0xe3,
-	0x29, 0x53, 0x7a, 0xa0, 0x55, 0xdf, 0x54, 0x27, 0xfe, 0xe5, 0x4f, 0xa7, 0x72, 0xb9, 0x70, 0xac,
-	0xab, 0x85, 0x63, 0xfd, 0x58, 0x38, 0xd6, 0xb7, 0xa5, 0x53, 0xb9, 0x5a, 0x3a, 0x95, 0xef, 0x4b,
-	0xa7, 0xf2, 0xf1, 0xd9, 0x3f, 0xfe, 0x54, 0x37, 0xeb, 0xa2, 0xd7, 0x64, 0x54, 0xd7, 0x7b, 0x72,
-	0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x1e, 0x99, 0xe3, 0x8a, 0x03, 0x00, 0x00,
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0x8e, 0xe2, 0xc5, 0x49, 0xd9, 0xc6, 0xc9, 0x34, 0xa7, 0x75, 0x9d, 0x55, 0x35, 0xb4, 0xc3,
+	0x0c, 0x6c, 0x95, 0xe6, 0x74, 0x28, 0xba, 0x63, 0x9b, 0x60, 0x68, 0xd6, 0x6d, 0x6d, 0x64, 0x63,
+	0x87, 0x01, 0x83, 0x40, 0x4b, 0xcf, 0x36, 0x61, 0x9b, 0x54, 0xc8, 0xa7, 0x54, 0xfa, 0x0b, 0xbb,
+	0x6c, 0xff, 0x67, 0x7f, 0xa0, 0xc7, 0x1e, 0x77, 0x2a, 0x36, 0xe7, 0x5f, 0xec, 0x34, 0x88, 0xa2,
+	0xed, 0xd4, 0x31, 0x86, 0x01, 0x45, 0x4f, 0x22, 0xf9, 0x3d, 0x7e, 0xfc, 0xde, 0xd3, 0xf7, 0x48,
+	0x72, 0xd8, 0x67, 0x18, 0x09, 0xc6, 0xfd, 0x8b, 0x4e, 0x1f, 0x90, 0x76, 0xfc, 0xf3, 0x14, 0x64,
+	0xee, 0x25, 0x52, 0xa0, 0xb0, 0xf7, 0x0c, 0xe8, 0x19, 0xb0, 0x59, 0x1f, 0x8a, 0xa1, 0xd0, 0x98,
+	0x5f, 0x8c, 0xca, 0xb0, 0xe6, 0x35, 0x0e, 0xcc, 0x13, 0x50, 0x06, 0x6c, 0xa1, 0x52, 0x3e, 0x64,
+	0x89, 0x90, 0x08, 0xf1, 0xba, 0x08, 0xf7, 0x84, 0xd8, 0x27, 0x90, 0x08, 0xc5, 0xf0, 0xac, 0x38,
+	0xfb, 0x25, 0x95, 0x74, 0xaa, 0xec, 0x06, 0xd9, 0xa6, 0x71, 0x2c, 0x41, 0xa9, 0x86, 0xd5, 0xb2,
+	0xda, 0x37, 0x82, 0xf9, 0xd4, 0xae, 0x93, 0xad, 0x68, 0x44, 0x19, 0x6f, 0x6c, 0xea, 0xf5, 0x72,
+	0xe2, 0xfe, 0x66, 0x91, 0xba, 0xde, 0xff, 0xa4, 0x0c, 0x0b, 0x40, 0x25, 0x82, 0x2b, 0xf8, 0x0f,
+	0xa2, 0x5f, 0x48, 0x75, 0x0c, 0x79, 0xc8, 0xe2, 0x92, 0xe9, 0xe9, 0xb7, 0xb3, 0xb7, 0xf7, 0xb7,
+	0x9e, 0x43, 0x7e, 0x7a, 0xf2, 0xcf, 0xdb, 0xfb, 0xdf, 0x0c, 0x19, 0x8e, 0xd2, 0xbe, 0x17, 0x89,
+	0xa9, 0x4f, 0x33, 0x98, 0x50, 0xc9, 0x01, 0x5f, 0x09, 0x39, 0x36, 0xb3, 0x07, 0x91, 0x90, 0xe0,
+	0x67, 0xfe, 0xd5, 0xf4, 0x3c, 0xbd, 0x39, 0xd8, 0x1a, 0x43, 0x7e, 0x1a, 0xbb, 0x03, 0xd2, 0xd4,
+	0x82, 0x4c, 0x72, 0x5d, 0xa4, 0x98, 0x2e, 0x65, 0xed, 0x93, 0xca, 0x44, 0x0c, 0x8d, 0xa4, 0x62,
+	0x68, 0x3f, 0x22, 0x55, 0xa5, 0x63, 0xb4, 0x9c, 0xda, 0x91, 0xe3, 0xad, 0x94, 0xdf, 0x7b, 0x91,
+	0xe2, 0x4b, 0xc1, 0xb8, 0xa6, 0x82, 0xc0, 0x44, 0xbb, 0xbf, 0x56, 0xc8, 0x9e, 0x3e, 0xa8, 0x97,
+	0x2d, 0xd8, 0x6b, 0x64, 0x13, 0x33, 0x43, 0xbe, 0x89, 0x99, 0xdd, 0x59, 0xe1, 0xbe, 0x7b, 0x8d,
+	0xbb, 0x97, 0x19, 0x81, 0x26, 0xd0, 0x7e, 0x48, 0x0e, 0x22, 0xc1, 0x07, 0x4c, 0x4e, 0x29, 0x32,
+	0xc1, 0x43, 0x09, 0xe7, 0x29, 0x93, 0x10, 0x37, 0x2a, 0x2d, 0xab, 0xbd, 0x13, 0xd4, 0xaf, 0x82,
+	0x81, 0xc1, 0xec, 0x07, 0xe4, 0x93, 0x44, 0xc2, 0x45, 0xa8, 0xd8, 0x90, 0x43, 0x1c, 0x62, 0x16,
+	0x8e, 0xa8, 0x1a, 0x35, 0x3e, 0xd2, 0x42, 0xf6, 0x0b, 0xa8, 0xab, 0x91, 0x5e, 0xf6, 0x8c, 0xaa,
+	0x91, 0xdd, 0x21, 0x07, 0x94, 0xe7, 0x82, 0x43, 0x18, 0x51, 0x1e, 0xaa, 0x04, 0x78, 0x1c, 0x5e,
+	0x88, 0x14, 0x1b, 0x5b, 0x2d, 0xab, 0xbd, 0x1b, 0xd8, 0x25, 0x78, 0x4c, 0x79, 0xb7, 0x80, 0x7e,
+	0x12, 0x29, 0xda, 0x67, 0x64, 0xb7, 0x20, 0x67, 0x7c, 0x18, 0x32, 0x3e, 0x10, 0xaa, 0x51, 0x6d,
+	0x55, 0xda, 0x37, 0x8f, 0xbe, 0xbc, 0x96, 0xd0, 0x4a, 0x49, 0xbc, 0x6e, 0xb9, 0xeb, 0x94, 0x0f,
+	0x44, 0x70, 0x4b, 0x2d, 0x27, 0xaa, 0xf9, 0x1d, 0xb9, 0x79, 0x05, 0xb4, 0x3f, 0x23, 0xbb, 0x12,
+	0x62, 0x80, 0x69, 0xa8, 0x22, 0xc9, 0x12, 0x34, 0x65, 0xbc, 0x55, 0x2e, 0x76, 0xf5, 0x9a, 0x7d,
+	0x9b, 0x54, 0xe9, 0x54, 0xa4, 0x1c, 0x75, 0x41, 0x2b, 0x81, 0x99, 0xb9, 0xf2, 0xdd, 0x9f, 0xbe,
+	0x30, 0xe3, 0x79, 0x0a, 0x0a, 0xed, 0xcf, 0xc9, 0x9e, 0x84, 0x88, 0x25, 0x0c, 0x38, 0x86, 0xa5,
+	0x89, 0x4b, 0xf2, 0xda, 0x62, 0xf9, 0xb8, 0x58, 0xb5, 0xbf, 0x20, 0x1f, 0x2f, 0x03, 0xe7, 0xf6,
+	0x2d, 0xfd, 0xbe, 0xbf, 0x00, 0x0c, 0xb9, 0xfb, 0x98, 0xdc, 0x5d, 0x67, 0xb4, 0xf2, 0xc8, 0x43,
+	0x72, 0x43, 0xa4, 0x18, 0x26, 0x85, 0x6f, 0xcc, 0x61, 0x3b, 0xc2, 0xf8, 0xc8, 0xfd, 0xc3, 0x22,
+	0x2d, 0xbd, 0xf5, 0x58, 0x70, 0x25, 0x26, 0x2c, 0xd6, 0x7f, 0x73, 0x45, 0xf4, 0x63, 0xb2, 0x53,
+	0xb4, 0x89, 0x14, 0x13, 0xd0, 0x04, 0xb5, 0xa3, 0x7b, 0x1e, 0x2a, 0xe5, 0x2d, 0x5c, 0x3f, 0xaf,
+	0xf8, 0x73, 0xc8, 0x03, 0x31, 0x81, 0x60, 0x7b, 0x5c, 0x0e, 0x3e, 0x74, 0x83, 0x9d, 0x91, 0x03,
+	0x2d, 0xfe, 0x47, 0xc8, 0xb0, 0x04, 0xde, 0x57, 0xb1, 0xfb, 0x8a, 0xdc, 0x5e, 0xa5, 0x34, 0x1d,
+	0xb5, 0xcc, 0xc5, 0xfa, 0x10, 0xb9, 0xdc, 0x23, 0x87, 0xfa, 0xe0, 0x1f, 0x18, 0x7f, 0x91, 0x62,
+	0x92, 0xe2, 0x13, 0xed, 0x27, 0x93, 0x91, 0xfb, 0x88, 0x7c, 0xba, 0x1e, 0x36, 0xea, 0x96, 0x76,
+	0xb4, 0xde, 0xb1, 0xe3, 0x33, 0x73, 0x29, 0x7e, 0x4f, 0x11, 0x14, 0x16, 0xdd, 0x50, 0x56, 0xe8,
+	0x2b, 0xb2, 0x8d, 0x59, 0x58, 0xdc, 0xc2, 0xa6, 0x40, 0x77, 0xd6, 0x5c, 0x08, 0xbd, 0x3c, 0x81,
+	0xa0, 0x8a, 0xfa, 0xeb, 0xfa, 0x86, 0x69, 0xde, 0xbf, 0x73, 0xa6, 0x3b, 0x9a, 0x49, 0x77, 0x79,
+	0xe9, 0xae, 0x2a, 0xea, 0xde, 0x7e, 0x1a, 0xbc, 0xfe, 0xdb, 0xd9, 0x78, 0x3d, 0x73, 0xac, 0x37,
+	0x33, 0xc7, 0xfa, 0x6b, 0xe6, 0x58, 0xbf, 0x5f, 0x3a, 0x1b, 0x6f, 0x2e, 0x9d, 0x8d, 0x3f, 0x2f,
+	0x9d, 0x8d, 0x9f, 0xbf, 0xfe, 0x9f, 0x15, 0x9b, 0x3f, 0x2d, 0xfa, 0xc1, 0xe8, 0x57, 0xf5, 0x8b,
+	0xf1, 0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0x17, 0x90, 0x42, 0xb6, 0x06, 0x00, 0x00,
 }
 
 func (m *DepositQueryParams) Marshal() (dAtA []byte, err error) {
@@ -483,49 +845,318 @@ func (m *QueryTxResponse_SigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDepositAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DepositQueryParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryDepositAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RecipientChain) > 0 {
+		i -= len(m.RecipientChain)
+		copy(dAtA[i:], m.RecipientChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipientChain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDepositStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OutPoint) > 0 {
+		i -= len(m.OutPoint)
+		copy(dAtA[i:], m.OutPoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutPoint)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsolidationAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsolidationAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsolidationAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextKeyIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextKeyIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextKeyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyRole != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextKeyIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextKeyIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextKeyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinOutputAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinOutputAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinOutputAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinOutputAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinOutputAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinOutputAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignedTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignedTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignedTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DepositQueryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryDepositStatusResponse) Size() (n int) {
@@ -586,19 +1217,772 @@ func (m *QueryTxResponse_SigningInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *QueryDepositAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutPoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsolidationAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyRole != 0 {
+		n += 1 + sovQuery(uint64(m.KeyRole))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextKeyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyRole != 0 {
+		n += 1 + sovQuery(uint64(m.KeyRole))
+	}
+	return n
+}
+
+func (m *QueryNextKeyIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinOutputAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinOutputAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *QueryLatestTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovQuery(uint64(m.TxType))
+	}
+	return n
+}
+
+func (m *QuerySignedTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DepositQueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositQueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositQueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OutPointState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConfirmationRequired = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevSignedTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevSignedTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyoneCanSpendVout", wireType)
+			}
+			m.AnyoneCanSpendVout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnyoneCanSpendVout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, &QueryTxResponse_SigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryTxResponse_SigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemScript", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemScript = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DepositQueryParams) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,15 +2005,15 @@ func (m *DepositQueryParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositQueryParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositQueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -657,11 +2041,11 @@ func (m *DepositQueryParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.RecipientChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -689,7 +2073,7 @@ func (m *DepositQueryParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -712,7 +2096,7 @@ func (m *DepositQueryParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -735,15 +2119,15 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutPoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -771,8 +2155,77 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.OutPoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsolidationAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsolidationAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsolidationAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
@@ -803,8 +2256,77 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextKeyIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextKeyIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextKeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -826,7 +2348,7 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextKeyIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -849,15 +2371,15 @@ func (m *QueryDepositStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextKeyIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextKeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -885,27 +2407,8 @@ func (m *QueryDepositStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Log = string(dAtA[iNdEx:postIndex])
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= OutPointState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -927,7 +2430,7 @@ func (m *QueryDepositStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMinOutputAmountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -950,88 +2453,67 @@ func (m *QueryTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMinOutputAmountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMinOutputAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tx = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= TxStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinOutputAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationRequired", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.ConfirmationRequired = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevSignedTxHash", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinOutputAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinOutputAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1041,48 +2523,66 @@ func (m *QueryTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevSignedTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnyoneCanSpendVout", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.AnyoneCanSpendVout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AnyoneCanSpendVout |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1092,26 +2592,11 @@ func (m *QueryTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TxType |= TxType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SigningInfos = append(m.SigningInfos, &QueryTxResponse_SigningInfo{})
-			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1133,7 +2618,7 @@ func (m *QueryTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxResponse_SigningInfo) Unmarshal(dAtA []byte) error {
+func (m *QuerySignedTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1156,15 +2641,15 @@ func (m *QueryTxResponse_SigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignedTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignedTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemScript", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1192,27 +2677,8 @@ func (m *QueryTxResponse_SigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedeemScript = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -35,41 +35,61 @@ func init() {
 }
 
 var fileDescriptor_6065c0ad9b83e388 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x1b, 0x91, 0x05, 0x73, 0x70, 0x61, 0x14, 0x76, 0x29, 0xdd, 0x28, 0x01, 0x77, 0x75,
-	0x6b, 0x32, 0xad, 0x2e, 0x1e, 0xf6, 0xe8, 0xe2, 0xcd, 0x45, 0x69, 0x8b, 0x07, 0x2f, 0x32, 0x8d,
-	0xcf, 0x66, 0x87, 0xb6, 0x33, 0x71, 0xe6, 0x49, 0x8d, 0x88, 0x17, 0x4f, 0x7a, 0x11, 0xc1, 0x6f,
-	0xe2, 0xc5, 0xab, 0x47, 0x8f, 0x0b, 0x5e, 0x3c, 0x4a, 0xeb, 0x07, 0x91, 0x4e, 0x66, 0x14, 0x9a,
-	0x8d, 0xad, 0xb7, 0x24, 0xff, 0xb7, 0x5f, 0x86, 0x10, 0x7f, 0x67, 0xc8, 0x31, 0x91, 0x5c, 0xd0,
-	0x69, 0x77, 0x08, 0xc8, 0xba, 0x54, 0x83, 0x9a, 0xf2, 0x04, 0xe2, 0x4c, 0x49, 0x94, 0x64, 0xd3,
-	0xca, 0xb1, 0x95, 0x9b, 0x57, 0x53, 0x99, 0x4a, 0xa3, 0xd1, 0xc5, 0x55, 0x69, 0x6b, 0xb6, 0x52,
-	0x29, 0xd3, 0x31, 0x50, 0x96, 0x71, 0xca, 0x84, 0x90, 0xc8, 0x90, 0x4b, 0xa1, 0xad, 0xba, 0xbd,
-	0xbc, 0x81, 0x45, 0xa9, 0xdc, 0x79, 0x7f, 0xc9, 0xf7, 0x8f, 0x75, 0xda, 0x2f, 0x37, 0xc9, 0xd4,
-	0xbf, 0xf8, 0x90, 0x8b, 0x11, 0x69, 0xc5, 0x4b, 0xb3, 0xf1, 0xe2, 0x71, 0x0f, 0x5e, 0xe4, 0xa0,
-	0xb1, 0xb9, 0x53, 0xa3, 0xea, 0x4c, 0x0a, 0x0d, 0x61, 0xf7, 0xed, 0xf7, 0x5f, 0x9f, 0x2e, 0xb4,
-	0xc3, 0x5d, 0xca, 0x0a, 0x18, 0x33, 0x45, 0xdd, 0xfa, 0x98, 0x8b, 0x11, 0x7d, 0xad, 0x20, 0xe1,
-	0x19, 0x07, 0x81, 0xcf, 0x92, 0x53, 0xc6, 0xc5, 0x9b, 0x43, 0x6f, 0x9f, 0xbc, 0xf3, 0xfc, 0xcd,
-	0x23, 0x29, 0x4e, 0xb8, 0x9a, 0x3c, 0xca, 0x31, 0x93, 0x5c, 0x20, 0xd9, 0xab, 0xac, 0x2c, 0x39,
-	0x1c, 0xce, 0xcd, 0xd5, 0x46, 0x4b, 0x16, 0x1a, 0xb2, 0x56, 0xb8, 0xb5, 0x4c, 0x96, 0x94, 0x81,
-	0x05, 0x4a, 0xe1, 0x5f, 0x79, 0x22, 0x11, 0x96, 0x69, 0xda, 0x95, 0x91, 0x73, 0x5c, 0x8e, 0xe8,
-	0xf6, 0x7a, 0x66, 0x4b, 0xb5, 0x61, 0xa8, 0x1a, 0xe4, 0x8b, 0xe7, 0x6f, 0x1f, 0x29, 0x60, 0x08,
-	0x8f, 0x41, 0x3c, 0xe7, 0x22, 0x1d, 0x28, 0x26, 0xf4, 0x09, 0x28, 0x3d, 0x28, 0x48, 0xa7, 0xfa,
-	0x92, 0x35, 0x56, 0x07, 0xd1, 0xfd, 0x8f, 0x84, 0x25, 0xb9, 0x67, 0x48, 0x3a, 0x61, 0xbb, 0x72,
-	0x3e, 0x26, 0x19, 0x65, 0x65, 0x34, 0x42, 0x97, 0x8d, 0xb0, 0x58, 0x9c, 0xd9, 0x07, 0xcf, 0xbf,
-	0x5c, 0x96, 0x1f, 0x33, 0x8d, 0xa0, 0x06, 0x05, 0xd9, 0xad, 0x59, 0x77, 0x06, 0x47, 0xb9, 0xb7,
-	0xd2, 0x67, 0xd9, 0xda, 0x86, 0xed, 0x46, 0x78, 0xbd, 0x86, 0x6d, 0x62, 0x02, 0x15, 0xa0, 0x1e,
-	0xe8, 0x24, 0x87, 0x7f, 0x00, 0x39, 0xc3, 0x2a, 0xa0, 0xbf, 0xbe, 0x35, 0x81, 0x94, 0x09, 0x58,
-	0xa0, 0x89, 0xbf, 0xd1, 0xe7, 0xa9, 0x18, 0x14, 0x24, 0xa8, 0xf4, 0x97, 0x82, 0xdb, 0xbf, 0x56,
-	0xab, 0xaf, 0xfa, 0x88, 0x35, 0x4f, 0x85, 0x9d, 0xfb, 0xec, 0xf9, 0x5b, 0xfd, 0x7c, 0x38, 0xe1,
-	0xf8, 0xa0, 0x40, 0x50, 0x82, 0x8d, 0x17, 0x25, 0x0c, 0x73, 0x05, 0x84, 0x56, 0x07, 0xce, 0x77,
-	0x3a, 0xa2, 0xce, 0xfa, 0x01, 0x8b, 0x78, 0x60, 0x10, 0xe3, 0xf0, 0x56, 0x05, 0xd1, 0x04, 0x23,
-	0xb0, 0xc9, 0x48, 0xbb, 0xe8, 0xa1, 0xb7, 0x7f, 0xbf, 0xf7, 0x6d, 0x16, 0x78, 0x67, 0xb3, 0xc0,
-	0xfb, 0x39, 0x0b, 0xbc, 0x8f, 0xf3, 0xa0, 0xf1, 0x75, 0x1e, 0x78, 0x67, 0xf3, 0xa0, 0xf1, 0x63,
-	0x1e, 0x34, 0x9e, 0x1e, 0xa4, 0x1c, 0x4f, 0xf3, 0x61, 0x9c, 0xc8, 0x89, 0x6d, 0x15, 0x80, 0x2f,
-	0xa5, 0x1a, 0xd9, 0xbb, 0x28, 0x91, 0x0a, 0x68, 0xf1, 0x67, 0x0a, 0x5f, 0x65, 0xa0, 0x87, 0x1b,
-	0xe6, 0x37, 0x77, 0xf7, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x69, 0xd8, 0x92, 0x66, 0x05,
-	0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xbd, 0x6f, 0x1c, 0x45,
+	0x18, 0xc6, 0x3d, 0x08, 0x59, 0xce, 0x00, 0xb1, 0x34, 0x44, 0x4a, 0xf0, 0xc7, 0x91, 0x9c, 0xb1,
+	0x4d, 0x7c, 0xde, 0x5b, 0x9f, 0x89, 0x28, 0xd2, 0x05, 0x27, 0x20, 0x44, 0xcc, 0x87, 0x7d, 0xa2,
+	0xa0, 0x31, 0x73, 0xb7, 0x6f, 0xd6, 0x23, 0xdf, 0xcd, 0x6c, 0x66, 0xde, 0x35, 0x6b, 0x59, 0x96,
+	0x80, 0x8a, 0x0a, 0x21, 0x51, 0x21, 0x21, 0xc1, 0xdf, 0x00, 0x05, 0x2d, 0x25, 0x65, 0x24, 0x1a,
+	0x4a, 0x64, 0xd3, 0xf1, 0x4f, 0xa0, 0x9d, 0x9d, 0x71, 0xc2, 0x7e, 0xd8, 0x97, 0xce, 0xbe, 0xe7,
+	0x79, 0xde, 0xe7, 0xa7, 0x59, 0xcd, 0xbb, 0x4b, 0x17, 0x07, 0x02, 0x87, 0x4a, 0xc8, 0xf0, 0xb0,
+	0x37, 0x00, 0xe4, 0xbd, 0xd0, 0x80, 0x3e, 0x14, 0x43, 0xe8, 0x26, 0x5a, 0xa1, 0x62, 0xb3, 0x4e,
+	0xee, 0x3a, 0x79, 0xee, 0x5a, 0xac, 0x62, 0x65, 0xb5, 0x30, 0xff, 0xab, 0xb0, 0xcd, 0x2d, 0xc4,
+	0x4a, 0xc5, 0x23, 0x08, 0x79, 0x22, 0x42, 0x2e, 0xa5, 0x42, 0x8e, 0x42, 0x49, 0xe3, 0xd4, 0x1b,
+	0xe5, 0x0e, 0xcc, 0x9c, 0x32, 0x5f, 0x56, 0x1e, 0xa7, 0xa0, 0x8f, 0x0a, 0x71, 0xf3, 0xdf, 0x2b,
+	0x94, 0x6e, 0x9b, 0x78, 0xb7, 0x00, 0x62, 0x87, 0xf4, 0xc5, 0x87, 0x42, 0x1e, 0xb0, 0x85, 0x6e,
+	0x89, 0xa9, 0x9b, 0xff, 0xbc, 0x03, 0x8f, 0x53, 0x30, 0x38, 0xb7, 0xd8, 0xa0, 0x9a, 0x44, 0x49,
+	0x03, 0xed, 0xde, 0xd7, 0x7f, 0xfe, 0xf3, 0xfd, 0x0b, 0x9d, 0xf6, 0x4a, 0xc8, 0x33, 0x18, 0x71,
+	0x1d, 0x7a, 0x80, 0x91, 0x90, 0x07, 0xe1, 0xb1, 0x86, 0xa1, 0x48, 0x04, 0x48, 0xdc, 0x1b, 0xee,
+	0x73, 0x21, 0x4f, 0xee, 0x92, 0x35, 0xf6, 0x0d, 0xa1, 0xb3, 0x5b, 0x4a, 0x3e, 0x12, 0x7a, 0xfc,
+	0x51, 0x8a, 0x89, 0x12, 0x12, 0xd9, 0x6a, 0xa5, 0xa5, 0xe4, 0xf0, 0x38, 0x6f, 0x5e, 0x6e, 0x74,
+	0x64, 0x6d, 0x4b, 0xb6, 0xd0, 0xbe, 0x5e, 0x26, 0x1b, 0x16, 0x81, 0x1c, 0x25, 0xa3, 0xaf, 0x7e,
+	0xaa, 0x10, 0xca, 0x34, 0x9d, 0x4a, 0x49, 0x8d, 0xcb, 0x13, 0xad, 0x4f, 0x66, 0x76, 0x54, 0xd3,
+	0x96, 0x6a, 0x8a, 0x7d, 0x4e, 0x5f, 0xca, 0x6d, 0xef, 0x02, 0xec, 0x70, 0x04, 0xb6, 0x54, 0x3b,
+	0xc4, 0xa9, 0xbe, 0xe9, 0x8d, 0x8b, 0x4d, 0xa5, 0x86, 0xdf, 0x08, 0xbd, 0xb1, 0xa5, 0x81, 0x23,
+	0x7c, 0x0c, 0x32, 0x12, 0x32, 0xee, 0x6b, 0x2e, 0xcd, 0x23, 0xd0, 0xa6, 0x9f, 0xb1, 0x8d, 0xea,
+	0x31, 0x36, 0x58, 0x7d, 0x79, 0xef, 0x39, 0x12, 0x8e, 0xe4, 0x6d, 0x4b, 0xb2, 0xd1, 0xee, 0x54,
+	0x9e, 0x80, 0x4d, 0x06, 0x49, 0x11, 0x0d, 0xd0, 0x67, 0x03, 0xcc, 0xf2, 0xa7, 0xf2, 0x2d, 0xa1,
+	0x57, 0x8b, 0xe1, 0xdb, 0xdc, 0x20, 0xe8, 0x7e, 0xc6, 0x56, 0x1a, 0xda, 0xbd, 0xc1, 0x53, 0xae,
+	0x5e, 0xea, 0x73, 0x6c, 0x1d, 0xcb, 0xb6, 0xdc, 0xbe, 0xd9, 0xc0, 0x36, 0xb6, 0x81, 0x0a, 0xd0,
+	0x0e, 0x98, 0x61, 0x0a, 0x17, 0x00, 0x79, 0xc3, 0x65, 0x40, 0x4f, 0x7d, 0x13, 0x02, 0x69, 0x1b,
+	0x70, 0x40, 0x63, 0x3a, 0xbd, 0x2b, 0x62, 0xd9, 0xcf, 0x58, 0xab, 0x32, 0xbf, 0x10, 0x7c, 0xff,
+	0xeb, 0x8d, 0xfa, 0x65, 0xd7, 0xc4, 0x88, 0x58, 0xba, 0xba, 0x5f, 0x08, 0xbd, 0xbe, 0x9b, 0x0e,
+	0xc6, 0x02, 0x1f, 0x64, 0x08, 0x5a, 0xf2, 0x51, 0x3e, 0x84, 0x63, 0xaa, 0x81, 0x85, 0xd5, 0x82,
+	0x7a, 0xa7, 0x27, 0xda, 0x98, 0x3c, 0xe0, 0x10, 0xef, 0x58, 0xc4, 0x6e, 0xfb, 0x76, 0x05, 0xd1,
+	0x06, 0x03, 0x70, 0xc9, 0xc0, 0xf8, 0xe8, 0x5d, 0xb2, 0xb6, 0xf9, 0xf3, 0x0c, 0x7d, 0xf9, 0x93,
+	0x7c, 0xfb, 0xf9, 0x7d, 0xf7, 0x2b, 0xa1, 0x57, 0xef, 0x43, 0xa2, 0x8c, 0xc0, 0x7b, 0x51, 0xa4,
+	0xc1, 0x98, 0x9a, 0x8b, 0x6e, 0x13, 0xff, 0x77, 0x79, 0xf0, 0xe5, 0x7a, 0xf3, 0xb9, 0xcb, 0xd1,
+	0x6e, 0x5b, 0xda, 0xf7, 0xd8, 0x83, 0x32, 0x6d, 0x54, 0x4c, 0x0d, 0x78, 0x11, 0xa8, 0x2e, 0xc7,
+	0x67, 0x7f, 0x71, 0xa6, 0x13, 0xf6, 0x23, 0xa1, 0xaf, 0x38, 0x9e, 0x5d, 0xe4, 0x98, 0x1a, 0xb6,
+	0x76, 0x21, 0x74, 0x61, 0xf2, 0xcc, 0x9d, 0x89, 0xbc, 0x8e, 0x7c, 0xd3, 0x92, 0xaf, 0xb3, 0xb5,
+	0x26, 0x72, 0x63, 0xfd, 0xe1, 0xb1, 0x4a, 0x71, 0xcf, 0xae, 0xb5, 0x13, 0xf6, 0x13, 0xa1, 0xd7,
+	0xb6, 0x94, 0x34, 0x6a, 0x24, 0x22, 0xfb, 0x8e, 0xf2, 0x47, 0xdb, 0xab, 0x6f, 0xae, 0xf3, 0x3e,
+	0xe7, 0x01, 0x07, 0x16, 0x73, 0x95, 0x2d, 0xd7, 0x2c, 0xf6, 0xa7, 0xb3, 0xfd, 0x31, 0xb3, 0xaf,
+	0x08, 0xbd, 0xf2, 0x21, 0x64, 0xf8, 0x01, 0x1c, 0xbd, 0x7f, 0x9f, 0xad, 0xd4, 0x77, 0x9c, 0x1b,
+	0x9a, 0xef, 0x6d, 0xd9, 0xe7, 0x68, 0x96, 0x2c, 0xcd, 0x22, 0x9b, 0x2f, 0xd3, 0x48, 0xc8, 0x30,
+	0x38, 0x80, 0xa3, 0x40, 0x44, 0xec, 0x07, 0x42, 0x67, 0xb7, 0x85, 0xcc, 0xdf, 0x06, 0x29, 0xde,
+	0x1b, 0xab, 0x54, 0x22, 0x5b, 0xaf, 0x6f, 0x28, 0xd9, 0x3c, 0x4f, 0x30, 0xa1, 0xdb, 0x51, 0xdd,
+	0xb6, 0x54, 0x4b, 0xec, 0x56, 0x99, 0x6a, 0x2c, 0x64, 0xa0, 0x6c, 0x22, 0xe0, 0x05, 0xc7, 0x21,
+	0x9d, 0x79, 0xc8, 0x11, 0x0c, 0xf6, 0x33, 0xd6, 0xf0, 0x04, 0xbc, 0xee, 0x61, 0x6e, 0xd6, 0xdb,
+	0x9e, 0xd9, 0x2a, 0xb7, 0x6c, 0xff, 0x3c, 0x7b, 0xad, 0xf2, 0x59, 0x60, 0x47, 0x05, 0x98, 0xb1,
+	0x2f, 0x09, 0x9d, 0xc9, 0xef, 0x3a, 0x44, 0xcd, 0xc5, 0x5e, 0x9f, 0xbc, 0xd8, 0xad, 0x51, 0xb6,
+	0x54, 0xb7, 0xce, 0x20, 0x0a, 0x30, 0x0b, 0x8f, 0x31, 0xdb, 0xdb, 0xe7, 0x66, 0xff, 0xe4, 0x9d,
+	0x9d, 0x3f, 0x4e, 0x5b, 0xe4, 0xc9, 0x69, 0x8b, 0xfc, 0x7d, 0xda, 0x22, 0xdf, 0x9d, 0xb5, 0xa6,
+	0x7e, 0x3f, 0x6b, 0x91, 0x27, 0x67, 0xad, 0xa9, 0xbf, 0xce, 0x5a, 0x53, 0x9f, 0xdd, 0x89, 0x05,
+	0xee, 0xa7, 0x83, 0xee, 0x50, 0x8d, 0xdd, 0x30, 0x09, 0xf8, 0x85, 0xd2, 0x07, 0xee, 0xbf, 0x60,
+	0xa8, 0x34, 0x84, 0xd9, 0x79, 0x03, 0x1e, 0x25, 0x60, 0x06, 0xd3, 0xf6, 0x5b, 0xeb, 0xad, 0xff,
+	0x06, 0x00, 0xd2, 0x67, 0xd8, 0x48, 0x08, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -87,6 +107,7 @@ type MsgServiceClient interface {
 	Link(ctx context.Context, in *LinkRequest, opts ...grpc.CallOption) (*LinkResponse, error)
 	ConfirmOutpoint(ctx context.Context, in *ConfirmOutpointRequest, opts ...grpc.CallOption) (*ConfirmOutpointResponse, error)
 	VoteConfirmOutpoint(ctx context.Context, in *VoteConfirmOutpointRequest, opts ...grpc.CallOption) (*VoteConfirmOutpointResponse, error)
+	VoteFeeRate(ctx context.Context, in *VoteFeeRateRequest, opts ...grpc.CallOption) (*VoteFeeRateResponse, error)
 	CreatePendingTransfersTx(ctx context.Context, in *CreatePendingTransfersTxRequest, opts ...grpc.CallOption) (*CreatePendingTransfersTxResponse, error)
 	CreateMasterTx(ctx context.Context, in *CreateMasterTxRequest, opts ...grpc.CallOption) (*CreateMasterTxResponse, error)
 	CreateRescueTx(ctx context.Context, in *CreateRescueTxRequest, opts ...grpc.CallOption) (*CreateRescueTxResponse, error)
@@ -129,6 +150,15 @@ func (c *msgServiceClient) VoteConfirmOutpoint(ctx context.Context, in *VoteConf
 	return out, nil
 }
 
+func (c *msgServiceClient) VoteFeeRate(ctx context.Context, in *VoteFeeRateRequest, opts ...grpc.CallOption) (*VoteFeeRateResponse, error) {
+	out := new(VoteFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.MsgService/VoteFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) CreatePendingTransfersTx(ctx context.Context, in *CreatePendingTransfersTxRequest, opts ...grpc.CallOption) (*CreatePendingTransfersTxResponse, error) {
 	out := new(CreatePendingTransfersTxResponse)
 	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.MsgService/CreatePendingTransfersTx", in, out, opts...)
@@ -179,6 +209,7 @@ type MsgServiceServer interface {
 	Link(context.Context, *LinkRequest) (*LinkResponse, error)
 	ConfirmOutpoint(context.Context, *ConfirmOutpointRequest) (*ConfirmOutpointResponse, error)
 	VoteConfirmOutpoint(context.Context, *VoteConfirmOutpointRequest) (*VoteConfirmOutpointResponse, error)
+	VoteFeeRate(context.Context, *VoteFeeRateRequest) (*VoteFeeRateResponse, error)
 	CreatePendingTransfersTx(context.Context, *CreatePendingTransfersTxRequest) (*CreatePendingTransfersTxResponse, error)
 	CreateMasterTx(context.Context, *CreateMasterTxRequest) (*CreateMasterTxResponse, error)
 	CreateRescueTx(context.Context, *CreateRescueTxRequest) (*CreateRescueTxResponse, error)
@@ -199,6 +230,9 @@ func (*UnimplementedMsgServiceServer) ConfirmOutpoint(ctx context.Context, req *
 func (*UnimplementedMsgServiceServer) VoteConfirmOutpoint(ctx context.Context, req *VoteConfirmOutpointRequest) (*VoteConfirmOutpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteConfirmOutpoint not implemented")
 }
+func (*UnimplementedMsgServiceServer) VoteFeeRate(ctx context.Context, req *VoteFeeRateRequest) (*VoteFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteFeeRate not implemented")
+}
 func (*UnimplementedMsgServiceServer) CreatePendingTransfersTx(ctx context.Context, req *CreatePendingTransfersTxRequest) (*CreatePendingTransfersTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePendingTransfersTx not implemented")
 }
@@ -273,6 +307,24 @@ func _MsgService_VoteConfirmOutpoint_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_VoteFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteFeeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).VoteFeeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitcoin.v1beta1.MsgService/VoteFeeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).VoteFeeRate(ctx, req.(*VoteFeeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_CreatePendingTransfersTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePendingTransfersTxRequest)
 	if err := dec(in); err != nil {
@@ -379,6 +431,10 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VoteConfirmOutpoint",
 			Handler:    _MsgService_VoteConfirmOutpoint_Handler,
 		},
+		{
+			MethodName: "VoteFeeRate",
+			Handler:    _MsgService_VoteFeeRate_Handler,
+		},
 		{
 			MethodName: "CreatePendingTransfersTx",
 			Handler:    _MsgService_CreatePendingTransfersTx_Handler,
@@ -403,3 +459,291 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bitcoin/v1beta1/service.proto",
 }
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	DepositAddress(ctx context.Context, in *QueryDepositAddressRequest, opts ...grpc.CallOption) (*QueryAddressResponse, error)
+	DepositStatus(ctx context.Context, in *QueryDepositStatusRequest, opts ...grpc.CallOption) (*QueryDepositStatusResponse, error)
+	ConsolidationAddress(ctx context.Context, in *QueryConsolidationAddressRequest, opts ...grpc.CallOption) (*QueryAddressResponse, error)
+	NextKeyID(ctx context.Context, in *QueryNextKeyIDRequest, opts ...grpc.CallOption) (*QueryNextKeyIDResponse, error)
+	MinOutputAmount(ctx context.Context, in *QueryMinOutputAmountRequest, opts ...grpc.CallOption) (*QueryMinOutputAmountResponse, error)
+	LatestTx(ctx context.Context, in *QueryLatestTxRequest, opts ...grpc.CallOption) (*QueryTxResponse, error)
+	SignedTx(ctx context.Context, in *QuerySignedTxRequest, opts ...grpc.CallOption) (*QueryTxResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) DepositAddress(ctx context.Context, in *QueryDepositAddressRequest, opts ...grpc.CallOption) (*QueryAddressResponse, error) {
+	out := new(QueryAddressResponse)
+	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.QueryService/DepositAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) DepositStatus(ctx context.Context, in *QueryDepositStatusRequest, opts ...grpc.CallOption) (*QueryDepositStatusResponse, error) {
+	out := new(QueryDepositStatusResponse)
+	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.QueryService/DepositStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ConsolidationAddress(ctx context.Context, in *QueryConsolidationAddressRequest, opts ...grpc.CallOption) (*QueryAddressResponse, error) {
+	out := new(QueryAddressResponse)
+	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.QueryService/ConsolidationAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) NextKeyID(ctx context.Context, in *QueryNextKeyIDRequest, opts ...grpc.CallOption) (*QueryNextKeyIDResponse, error) {
+	out := new(QueryNextKeyIDResponse)
+	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.QueryService/NextKeyID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) MinOutputAmount(ctx context.Context, in *QueryMinOutputAmountRequest, opts ...grpc.CallOption) (*QueryMinOutputAmountResponse, error) {
+	out := new(QueryMinOutputAmountResponse)
+	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.QueryService/MinOutputAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) LatestTx(ctx context.Context, in *QueryLatestTxRequest, opts ...grpc.CallOption) (*QueryTxResponse, error) {
+	out := new(QueryTxResponse)
+	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.QueryService/LatestTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) SignedTx(ctx context.Context, in *QuerySignedTxRequest, opts ...grpc.CallOption) (*QueryTxResponse, error) {
+	out := new(QueryTxResponse)
+	err := c.cc.Invoke(ctx, "/bitcoin.v1beta1.QueryService/SignedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	DepositAddress(context.Context, *QueryDepositAddressRequest) (*QueryAddressResponse, error)
+	DepositStatus(context.Context, *QueryDepositStatusRequest) (*QueryDepositStatusResponse, error)
+	ConsolidationAddress(context.Context, *QueryConsolidationAddressRequest) (*QueryAddressResponse, error)
+	NextKeyID(context.Context, *QueryNextKeyIDRequest) (*QueryNextKeyIDResponse, error)
+	MinOutputAmount(context.Context, *QueryMinOutputAmountRequest) (*QueryMinOutputAmountResponse, error)
+	LatestTx(context.Context, *QueryLatestTxRequest) (*QueryTxResponse, error)
+	SignedTx(context.Context, *QuerySignedTxRequest) (*QueryTxResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) DepositAddress(ctx context.Context, req *QueryDepositAddressRequest) (*QueryAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAddress not implemented")
+}
+func (*UnimplementedQueryServiceServer) DepositStatus(ctx context.Context, req *QueryDepositStatusRequest) (*QueryDepositStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositStatus not implemented")
+}
+func (*UnimplementedQueryServiceServer) ConsolidationAddress(ctx context.Context, req *QueryConsolidationAddressRequest) (*QueryAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidationAddress not implemented")
+}
+func (*UnimplementedQueryServiceServer) NextKeyID(ctx context.Context, req *QueryNextKeyIDRequest) (*QueryNextKeyIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextKeyID not implemented")
+}
+func (*UnimplementedQueryServiceServer) MinOutputAmount(ctx context.Context, req *QueryMinOutputAmountRequest) (*QueryMinOutputAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinOutputAmount not implemented")
+}
+func (*UnimplementedQueryServiceServer) LatestTx(ctx context.Context, req *QueryLatestTxRequest) (*QueryTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestTx not implemented")
+}
+func (*UnimplementedQueryServiceServer) SignedTx(ctx context.Context, req *QuerySignedTxRequest) (*QueryTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignedTx not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_DepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).DepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitcoin.v1beta1.QueryService/DepositAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).DepositAddress(ctx, req.(*QueryDepositAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_DepositStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).DepositStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitcoin.v1beta1.QueryService/DepositStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).DepositStatus(ctx, req.(*QueryDepositStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ConsolidationAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsolidationAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ConsolidationAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitcoin.v1beta1.QueryService/ConsolidationAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ConsolidationAddress(ctx, req.(*QueryConsolidationAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_NextKeyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextKeyIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).NextKeyID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitcoin.v1beta1.QueryService/NextKeyID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).NextKeyID(ctx, req.(*QueryNextKeyIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_MinOutputAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinOutputAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).MinOutputAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitcoin.v1beta1.QueryService/MinOutputAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).MinOutputAmount(ctx, req.(*QueryMinOutputAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_LatestTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).LatestTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitcoin.v1beta1.QueryService/LatestTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).LatestTx(ctx, req.(*QueryLatestTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_SignedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).SignedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitcoin.v1beta1.QueryService/SignedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).SignedTx(ctx, req.(*QuerySignedTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bitcoin.v1beta1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DepositAddress",
+			Handler:    _QueryService_DepositAddress_Handler,
+		},
+		{
+			MethodName: "DepositStatus",
+			Handler:    _QueryService_DepositStatus_Handler,
+		},
+		{
+			MethodName: "ConsolidationAddress",
+			Handler:    _QueryService_ConsolidationAddress_Handler,
+		},
+		{
+			MethodName: "NextKeyID",
+			Handler:    _QueryService_NextKeyID_Handler,
+		},
+		{
+			MethodName: "MinOutputAmount",
+			Handler:    _QueryService_MinOutputAmount_Handler,
+		},
+		{
+			MethodName: "LatestTx",
+			Handler:    _QueryService_LatestTx_Handler,
+		},
+		{
+			MethodName: "SignedTx",
+			Handler:    _QueryService_SignedTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitcoin/v1beta1/service.proto",
+}
@@ -336,6 +336,85 @@ func (m *VoteConfirmOutpointResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VoteConfirmOutpointResponse proto.InternalMessageInfo
 
+// VoteFeeRateRequest represents a message that votes on the fee rate for a
+// confirmation target
+type VoteFeeRateRequest struct {
+	Sender     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	ConfTarget int64                                         `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	// fee rate in satoshi per kilo virtual byte
+	FeeRate github_com_btcsuite_btcutil.Amount `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3,casttype=github.com/btcsuite/btcutil.Amount" json:"fee_rate,omitempty"`
+}
+
+func (m *VoteFeeRateRequest) Reset()         { *m = VoteFeeRateRequest{} }
+func (m *VoteFeeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*VoteFeeRateRequest) ProtoMessage()    {}
+func (*VoteFeeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f5c2c0447d15a63, []int{8}
+}
+func (m *VoteFeeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteFeeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteFeeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteFeeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteFeeRateRequest.Merge(m, src)
+}
+func (m *VoteFeeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteFeeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteFeeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteFeeRateRequest proto.InternalMessageInfo
+
+type VoteFeeRateResponse struct {
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *VoteFeeRateResponse) Reset()         { *m = VoteFeeRateResponse{} }
+func (m *VoteFeeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*VoteFeeRateResponse) ProtoMessage()    {}
+func (*VoteFeeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f5c2c0447d15a63, []int{9}
+}
+func (m *VoteFeeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteFeeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteFeeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteFeeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteFeeRateResponse.Merge(m, src)
+}
+func (m *VoteFeeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteFeeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteFeeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteFeeRateResponse proto.InternalMessageInfo
+
 type SubmitExternalSignatureRequest struct {
 	Sender    github_com_cosmos_cosmos_sdk_types.AccAddress             `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
 	KeyID     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
@@ -347,7 +426,7 @@ func (m *SubmitExternalSignatureRequest) Reset()         { *m = SubmitExternalSi
 func (m *SubmitExternalSignatureRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitExternalSignatureRequest) ProtoMessage()    {}
 func (*SubmitExternalSignatureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f5c2c0447d15a63, []int{8}
+	return fileDescriptor_5f5c2c0447d15a63, []int{10}
 }
 func (m *SubmitExternalSignatureRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +462,7 @@ func (m *SubmitExternalSignatureResponse) Reset()         { *m = SubmitExternalS
 func (m *SubmitExternalSignatureResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitExternalSignatureResponse) ProtoMessage()    {}
 func (*SubmitExternalSignatureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f5c2c0447d15a63, []int{9}
+	return fileDescriptor_5f5c2c0447d15a63, []int{11}
 }
 func (m *SubmitExternalSignatureResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,7 +501,7 @@ func (m *CreateMasterTxRequest) Reset()         { *m = CreateMasterTxRequest{} }
 func (m *CreateMasterTxRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateMasterTxRequest) ProtoMessage()    {}
 func (*CreateMasterTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f5c2c0447d15a63, []int{10}
+	return fileDescriptor_5f5c2c0447d15a63, []int{12}
 }
 func (m *CreateMasterTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +537,7 @@ func (m *CreateRescueTxResponse) Reset()         { *m = CreateRescueTxResponse{}
 func (m *CreateRescueTxResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateRescueTxResponse) ProtoMessage()    {}
 func (*CreateRescueTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f5c2c0447d15a63, []int{11}
+	return fileDescriptor_5f5c2c0447d15a63, []int{13}
 }
 func (m *CreateRescueTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +574,7 @@ func (m *CreateRescueTxRequest) Reset()         { *m = CreateRescueTxRequest{} }
 func (m *CreateRescueTxRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRescueTxRequest) ProtoMessage()    {}
 func (*CreateRescueTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f5c2c0447d15a63, []int{12}
+	return fileDescriptor_5f5c2c0447d15a63, []int{14}
 }
 func (m *CreateRescueTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +610,7 @@ func (m *CreateMasterTxResponse) Reset()         { *m = CreateMasterTxResponse{}
 func (m *CreateMasterTxResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateMasterTxResponse) ProtoMessage()    {}
 func (*CreateMasterTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f5c2c0447d15a63, []int{13}
+	return fileDescriptor_5f5c2c0447d15a63, []int{15}
 }
 func (m *CreateMasterTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +648,7 @@ func (m *SignTxRequest) Reset()         { *m = SignTxRequest{} }
 func (m *SignTxRequest) String() string { return proto.CompactTextString(m) }
 func (*SignTxRequest) ProtoMessage()    {}
 func (*SignTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f5c2c0447d15a63, []int{14}
+	return fileDescriptor_5f5c2c0447d15a63, []int{16}
 }
 func (m *SignTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,7 +685,7 @@ func (m *SignTxResponse) Reset()         { *m = SignTxResponse{} }
 func (m *SignTxResponse) String() string { return proto.CompactTextString(m) }
 func (*SignTxResponse) ProtoMessage()    {}
 func (*SignTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f5c2c0447d15a63, []int{15}
+	return fileDescriptor_5f5c2c0447d15a63, []int{17}
 }
 func (m *SignTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,6 +723,8 @@ func init() {
 	proto.RegisterType((*CreatePendingTransfersTxResponse)(nil), "bitcoin.v1beta1.CreatePendingTransfersTxResponse")
 	proto.RegisterType((*VoteConfirmOutpointRequest)(nil), "bitcoin.v1beta1.VoteConfirmOutpointRequest")
 	proto.RegisterType((*VoteConfirmOutpointResponse)(nil), "bitcoin.v1beta1.VoteConfirmOutpointResponse")
+	proto.RegisterType((*VoteFeeRateRequest)(nil), "bitcoin.v1beta1.VoteFeeRateRequest")
+	proto.RegisterType((*VoteFeeRateResponse)(nil), "bitcoin.v1beta1.VoteFeeRateResponse")
 	proto.RegisterType((*SubmitExternalSignatureRequest)(nil), "bitcoin.v1beta1.SubmitExternalSignatureRequest")
 	proto.RegisterType((*SubmitExternalSignatureResponse)(nil), "bitcoin.v1beta1.SubmitExternalSignatureResponse")
 	proto.RegisterType((*CreateMasterTxRequest)(nil), "bitcoin.v1beta1.CreateMasterTxRequest")
@@ -657,56 +738,60 @@ func init() {
 func init() { proto.RegisterFile("bitcoin/v1beta1/tx.proto", fileDescriptor_5f5c2c0447d15a63) }
 
 var fileDescriptor_5f5c2c0447d15a63 = []byte{
-	// 778 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xc1, 0x6f, 0x32, 0x45,
-	0x14, 0x67, 0x69, 0x4b, 0x61, 0x4a, 0x69, 0xdc, 0xd4, 0x96, 0xd2, 0xba, 0xd0, 0x4d, 0xd4, 0x1e,
-	0xec, 0x22, 0x55, 0x0f, 0x9e, 0x4c, 0xa9, 0x1a, 0x49, 0x35, 0x6d, 0xb6, 0xc4, 0x18, 0x13, 0x43,
-	0x96, 0xdd, 0x07, 0x4c, 0x80, 0x99, 0x75, 0x66, 0xb6, 0xee, 0x9e, 0xbd, 0x7a, 0xf0, 0xee, 0xc1,
-	0xab, 0x27, 0xff, 0x8e, 0x1e, 0x7b, 0xf4, 0x44, 0x14, 0xfe, 0x8b, 0x9e, 0xcc, 0xce, 0x0e, 0x2c,
-	0xda, 0x36, 0xf9, 0xf2, 0xe5, 0xe3, 0xd0, 0xd3, 0xce, 0xbc, 0x79, 0xf3, 0xde, 0xfb, 0xfd, 0xde,
-	0xef, 0xc1, 0xa0, 0x72, 0x17, 0x0b, 0x97, 0x62, 0x52, 0xbf, 0x6d, 0x74, 0x41, 0x38, 0x8d, 0xba,
-	0x08, 0x2d, 0x9f, 0x51, 0x41, 0xf5, 0x1d, 0x75, 0x62, 0xa9, 0x93, 0xca, 0xe1, 0x23, 0xd7, 0xc8,
-	0x07, 0x9e, 0x78, 0x57, 0x8e, 0x6f, 0xa9, 0x80, 0x3a, 0x84, 0x3e, 0x65, 0x02, 0xbc, 0x27, 0x5d,
-	0x76, 0xfb, 0xb4, 0x4f, 0xe5, 0xb2, 0x1e, 0xaf, 0x12, 0xab, 0xf9, 0xa7, 0x86, 0xf6, 0x2e, 0x28,
-	0xe9, 0x61, 0x36, 0xbe, 0x0a, 0x84, 0x4f, 0x31, 0x11, 0x36, 0xfc, 0x18, 0x00, 0x17, 0x7a, 0x0b,
-	0xe5, 0x38, 0x10, 0x0f, 0x58, 0x59, 0xab, 0x69, 0x27, 0xc5, 0x66, 0xe3, 0x61, 0x52, 0x3d, 0xed,
-	0x63, 0x31, 0x08, 0xba, 0x96, 0x4b, 0xc7, 0x75, 0x97, 0xf2, 0x31, 0xe5, 0xea, 0x73, 0xca, 0xbd,
-	0xa1, 0x4a, 0x77, 0xee, 0xba, 0xe7, 0x9e, 0xc7, 0x80, 0x73, 0x5b, 0x05, 0xd0, 0x5b, 0xa8, 0x44,
-	0x03, 0xd1, 0x91, 0xe1, 0x3b, 0x98, 0xf4, 0x68, 0x39, 0x5b, 0xd3, 0x4e, 0xb6, 0xce, 0xde, 0xb1,
-	0xfe, 0x87, 0xd2, 0xba, 0x0a, 0xc4, 0x75, 0xec, 0xd5, 0x22, 0x3d, 0xda, 0x5c, 0xbf, 0x9b, 0x54,
-	0x33, 0x76, 0x91, 0x2e, 0xd9, 0xcc, 0x03, 0xb4, 0xff, 0xa8, 0x5e, 0xee, 0x53, 0xc2, 0xc1, 0xfc,
-	0x43, 0x43, 0x5b, 0x5f, 0x63, 0x32, 0x5c, 0x01, 0x80, 0x77, 0x51, 0x89, 0x81, 0x8b, 0x7d, 0x0c,
-	0x44, 0x74, 0x1c, 0xcf, 0x63, 0x12, 0x40, 0xc1, 0xde, 0x5e, 0x58, 0xe3, 0x1b, 0xfa, 0xfb, 0x68,
-	0x27, 0x75, 0x73, 0x07, 0x0e, 0x26, 0xe5, 0x35, 0xe9, 0x97, 0xde, 0xbe, 0x88, 0xad, 0x66, 0x03,
-	0x15, 0x93, 0x4a, 0x93, 0xd2, 0xf5, 0x63, 0x54, 0xf4, 0xc0, 0xa7, 0x1c, 0xff, 0x27, 0xfa, 0x96,
-	0xb2, 0xc5, 0xb1, 0xcd, 0xdf, 0xb3, 0xa8, 0x7a, 0xc1, 0xc0, 0x11, 0x70, 0x0d, 0xc4, 0xc3, 0xa4,
-	0xdf, 0x66, 0x0e, 0xe1, 0x3d, 0x60, 0xbc, 0x1d, 0xae, 0x00, 0xf1, 0x0f, 0x28, 0x37, 0x84, 0xa8,
-	0x83, 0xbd, 0xa4, 0x96, 0xe6, 0x97, 0xd3, 0x49, 0x75, 0xe3, 0x12, 0xa2, 0xd6, 0xe7, 0x0f, 0x93,
-	0xea, 0xa7, 0x4b, 0x31, 0x9d, 0x10, 0x46, 0x0e, 0x23, 0x20, 0x7e, 0xa2, 0x6c, 0xa8, 0x76, 0xa7,
-	0x2e, 0x65, 0x50, 0x0f, 0xeb, 0x82, 0xf3, 0x85, 0x28, 0x2d, 0x79, 0xd9, 0xde, 0x18, 0x42, 0xd4,
-	0xf2, 0x74, 0x1b, 0xbd, 0x35, 0x76, 0xb8, 0x00, 0xd6, 0x89, 0xb3, 0x38, 0x63, 0x1a, 0x10, 0x21,
-	0xb9, 0x5a, 0x6b, 0xbe, 0xf7, 0x30, 0xa9, 0x9a, 0x4b, 0x09, 0xba, 0xc2, 0xe5, 0x01, 0x16, 0x10,
-	0x2f, 0x02, 0x81, 0x47, 0xd6, 0xb9, 0xf4, 0xb6, 0x77, 0x92, 0x00, 0x97, 0x10, 0x25, 0x06, 0xd3,
-	0x44, 0xb5, 0xe7, 0x09, 0x52, 0x1a, 0x99, 0x69, 0xa8, 0xf2, 0x2d, 0x15, 0xb0, 0x7a, 0xcd, 0x7f,
-	0x86, 0xf2, 0x3e, 0x1d, 0x8d, 0x62, 0x7c, 0x4a, 0xed, 0x86, 0x15, 0x4f, 0xa9, 0xb5, 0x20, 0x64,
-	0xae, 0xf9, 0x6b, 0x3a, 0x1a, 0x5d, 0x42, 0xa4, 0xe4, 0xbe, 0xe9, 0x27, 0x5b, 0xfd, 0x10, 0x15,
-	0x16, 0x43, 0xa3, 0x64, 0x94, 0x9f, 0x8f, 0x82, 0x7e, 0x84, 0x0a, 0x6e, 0x02, 0x01, 0xbc, 0xf2,
-	0x7a, 0x4d, 0x3b, 0xc9, 0xdb, 0xa9, 0xc1, 0xfc, 0x04, 0x1d, 0x3e, 0x09, 0x52, 0xa9, 0x6d, 0x0f,
-	0xe5, 0xb8, 0x70, 0x44, 0xc0, 0x25, 0xca, 0x82, 0xad, 0x76, 0xe6, 0xcf, 0x59, 0x64, 0xdc, 0x04,
-	0xdd, 0x31, 0x16, 0x5f, 0x84, 0x02, 0x18, 0x71, 0x46, 0x37, 0xb8, 0x4f, 0x1c, 0x11, 0x30, 0x78,
-	0x79, 0x0a, 0x3b, 0x42, 0x05, 0x3e, 0xaf, 0x5e, 0xd2, 0x57, 0xb4, 0x53, 0x83, 0x7e, 0x80, 0xf2,
-	0x1c, 0xf7, 0x3b, 0x03, 0x87, 0x0f, 0x24, 0x7d, 0x45, 0x7b, 0x93, 0xe3, 0xfe, 0x57, 0x0e, 0x1f,
-	0x98, 0xc7, 0xa8, 0xfa, 0x2c, 0x09, 0x4a, 0x45, 0xbf, 0x65, 0xd1, 0xdb, 0x89, 0xd4, 0xbe, 0x91,
-	0x1a, 0x7c, 0x89, 0x13, 0xf8, 0x1d, 0xda, 0xe5, 0xe0, 0x52, 0xe2, 0x39, 0x2c, 0x7a, 0xfd, 0x21,
-	0xd4, 0x17, 0x31, 0xd2, 0x39, 0x2c, 0xa3, 0xbd, 0x84, 0x1c, 0x1b, 0xb8, 0x1b, 0xc0, 0xd2, 0xf4,
-	0x75, 0xe7, 0xb4, 0xa5, 0x27, 0x6f, 0x9a, 0xb6, 0x34, 0x7b, 0xda, 0x1a, 0x95, 0xfd, 0x17, 0x0d,
-	0x6d, 0xc7, 0xbd, 0x5c, 0x49, 0xb7, 0x3e, 0x44, 0x9b, 0x22, 0xec, 0xc4, 0xc7, 0xb2, 0x5d, 0xa5,
-	0xb3, 0xfd, 0x47, 0xff, 0x6d, 0xed, 0xb0, 0x1d, 0xf9, 0x60, 0xe7, 0x84, 0xfc, 0x9a, 0x1f, 0xa0,
-	0xd2, 0xbc, 0x1a, 0x35, 0x97, 0x95, 0xf8, 0x27, 0x83, 0x63, 0x81, 0x29, 0x91, 0x05, 0xad, 0xd9,
-	0x8b, 0x7d, 0xd3, 0xbe, 0xfb, 0xc7, 0xc8, 0xdc, 0x4d, 0x0d, 0xed, 0x7e, 0x6a, 0x68, 0x7f, 0x4f,
-	0x0d, 0xed, 0xd7, 0x99, 0x91, 0xb9, 0x9f, 0x19, 0x99, 0xbf, 0x66, 0x46, 0xe6, 0xfb, 0x8f, 0x5f,
-	0x51, 0x0e, 0xf3, 0x37, 0x84, 0x84, 0xd1, 0xcd, 0xc9, 0x37, 0xc0, 0x47, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x21, 0x2e, 0x6b, 0xe1, 0x86, 0x08, 0x00, 0x00,
+	// 834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0xad, 0x63, 0xbf, 0xb8, 0x89, 0x58, 0xda, 0xd4, 0x4d, 0x8a, 0x9d, 0xac, 0x04,
+	0xe4, 0x40, 0xd6, 0xa4, 0xc0, 0x81, 0x13, 0x8a, 0x03, 0x15, 0x56, 0x40, 0x8d, 0xa6, 0x16, 0x42,
+	0x48, 0x68, 0x35, 0xde, 0x7d, 0xb6, 0x47, 0xb6, 0x67, 0x96, 0x99, 0xb7, 0xc5, 0x3e, 0x73, 0xe5,
+	0xc0, 0x9d, 0x03, 0x57, 0x4e, 0xfc, 0x13, 0x5c, 0x72, 0xec, 0x91, 0x93, 0x05, 0xc9, 0x7f, 0x91,
+	0x13, 0xda, 0xd9, 0xb5, 0x9d, 0x36, 0xad, 0xf8, 0xa1, 0xfa, 0xd0, 0x93, 0x67, 0xbe, 0x79, 0xf3,
+	0xde, 0xfb, 0xde, 0x7c, 0xef, 0x79, 0xa1, 0xda, 0x11, 0x14, 0x2a, 0x21, 0x1b, 0x4f, 0x0e, 0x3b,
+	0x48, 0xfc, 0xb0, 0x41, 0x63, 0x3f, 0xd6, 0x8a, 0x94, 0xbb, 0x99, 0x9f, 0xf8, 0xf9, 0xc9, 0xf6,
+	0xce, 0x35, 0xd3, 0x49, 0x8c, 0x26, 0xb3, 0xde, 0xde, 0x7b, 0xa2, 0x08, 0x1b, 0x38, 0x8e, 0x95,
+	0x26, 0x8c, 0x5e, 0x68, 0x72, 0xbb, 0xa7, 0x7a, 0xca, 0x2e, 0x1b, 0xe9, 0x2a, 0x43, 0xbd, 0xdf,
+	0x1c, 0xd8, 0x3a, 0x56, 0xb2, 0x2b, 0xf4, 0xe8, 0x51, 0x42, 0xb1, 0x12, 0x92, 0x18, 0x7e, 0x97,
+	0xa0, 0x21, 0xb7, 0x05, 0x45, 0x83, 0x32, 0x42, 0x5d, 0x75, 0x76, 0x9d, 0xfd, 0x4a, 0xf3, 0xf0,
+	0x72, 0x5a, 0x3f, 0xe8, 0x09, 0xea, 0x27, 0x1d, 0x3f, 0x54, 0xa3, 0x46, 0xa8, 0xcc, 0x48, 0x99,
+	0xfc, 0xe7, 0xc0, 0x44, 0x83, 0x3c, 0xdc, 0x51, 0x18, 0x1e, 0x45, 0x91, 0x46, 0x63, 0x58, 0xee,
+	0xc0, 0x6d, 0xc1, 0x86, 0x4a, 0x28, 0xb0, 0xee, 0x03, 0x21, 0xbb, 0xaa, 0xba, 0xb2, 0xeb, 0xec,
+	0xaf, 0x3f, 0x78, 0xcb, 0x7f, 0x8e, 0xa5, 0xff, 0x28, 0xa1, 0xd3, 0xd4, 0xaa, 0x25, 0xbb, 0xaa,
+	0x79, 0xe3, 0x6c, 0x5a, 0x2f, 0xb0, 0x8a, 0xba, 0x82, 0x79, 0xf7, 0xe0, 0xee, 0xb5, 0x7c, 0x4d,
+	0xac, 0xa4, 0x41, 0xef, 0x57, 0x07, 0xd6, 0xbf, 0x10, 0x72, 0xb0, 0x04, 0x02, 0x6f, 0xc3, 0x86,
+	0xc6, 0x50, 0xc4, 0x02, 0x25, 0x05, 0x3c, 0x8a, 0xb4, 0x25, 0x50, 0x66, 0xb7, 0xe6, 0x68, 0x7a,
+	0xc3, 0x7d, 0x17, 0x36, 0x17, 0x66, 0x61, 0x9f, 0x0b, 0x59, 0x5d, 0xb5, 0x76, 0x8b, 0xdb, 0xc7,
+	0x29, 0xea, 0x1d, 0x42, 0x25, 0xcb, 0x34, 0x4b, 0xdd, 0xdd, 0x83, 0x4a, 0x84, 0xb1, 0x32, 0xe2,
+	0x19, 0xef, 0xeb, 0x39, 0x96, 0xfa, 0xf6, 0x7e, 0x59, 0x81, 0xfa, 0xb1, 0x46, 0x4e, 0x78, 0x8a,
+	0x32, 0x12, 0xb2, 0xd7, 0xd6, 0x5c, 0x9a, 0x2e, 0x6a, 0xd3, 0x1e, 0x2f, 0x81, 0xf1, 0xb7, 0x50,
+	0x1c, 0xe0, 0x24, 0x10, 0x51, 0x96, 0x4b, 0xf3, 0xe1, 0xf9, 0xb4, 0x7e, 0xf3, 0x04, 0x27, 0xad,
+	0x4f, 0x2f, 0xa7, 0xf5, 0x8f, 0xaf, 0xf8, 0xe4, 0x63, 0x1c, 0x72, 0x2d, 0x91, 0xbe, 0x57, 0x7a,
+	0x90, 0xef, 0x0e, 0x42, 0xa5, 0xb1, 0x31, 0x6e, 0x90, 0x31, 0x73, 0x51, 0xfa, 0xf6, 0x32, 0xbb,
+	0x39, 0xc0, 0x49, 0x2b, 0x72, 0x19, 0xbc, 0x31, 0xe2, 0x86, 0x50, 0x07, 0x69, 0x14, 0x3e, 0x52,
+	0x89, 0x24, 0x5b, 0xab, 0xd5, 0xe6, 0x3b, 0x97, 0xd3, 0xba, 0x77, 0x25, 0x40, 0x87, 0x42, 0x93,
+	0x08, 0xc2, 0x74, 0x91, 0x90, 0x18, 0xfa, 0x47, 0xd6, 0x9a, 0x6d, 0x66, 0x0e, 0x4e, 0x70, 0x92,
+	0x01, 0x9e, 0x07, 0xbb, 0x2f, 0x2f, 0x50, 0xae, 0x91, 0x0b, 0x07, 0xb6, 0xbf, 0x52, 0x84, 0xcb,
+	0xd7, 0xfc, 0x27, 0x50, 0x8a, 0xd5, 0x70, 0x98, 0xf2, 0xcb, 0xd5, 0x5e, 0xf3, 0xd3, 0x2e, 0xf5,
+	0xe7, 0x05, 0x99, 0x69, 0xfe, 0x54, 0x0d, 0x87, 0x27, 0x38, 0xc9, 0xe5, 0xbe, 0x16, 0x67, 0x5b,
+	0x77, 0x07, 0xca, 0xf3, 0xa6, 0xc9, 0x65, 0x54, 0x9a, 0xb5, 0x82, 0x7b, 0x1f, 0xca, 0x61, 0x46,
+	0x01, 0xa3, 0xea, 0x8d, 0x5d, 0x67, 0xbf, 0xc4, 0x16, 0x80, 0xf7, 0x11, 0xec, 0xbc, 0x90, 0x64,
+	0xae, 0xb6, 0x2d, 0x28, 0x1a, 0xe2, 0x94, 0x18, 0xcb, 0xb2, 0xcc, 0xf2, 0x9d, 0xf7, 0xbb, 0x03,
+	0x6e, 0x7a, 0xef, 0x21, 0x22, 0xe3, 0x84, 0x4b, 0x28, 0x4a, 0x1d, 0xd6, 0xd3, 0x2c, 0x03, 0xe2,
+	0xba, 0x87, 0x64, 0xeb, 0xb2, 0xca, 0x20, 0x85, 0xda, 0x16, 0x71, 0x8f, 0xa0, 0xd4, 0x45, 0x0c,
+	0x34, 0x27, 0xfc, 0x8f, 0x72, 0x58, 0xeb, 0x66, 0x59, 0x7b, 0x07, 0xf0, 0xe6, 0x33, 0x24, 0xfe,
+	0x81, 0xf4, 0x0f, 0x2b, 0x50, 0x7b, 0x9c, 0x74, 0x46, 0x82, 0x3e, 0x1b, 0x13, 0x6a, 0xc9, 0x87,
+	0x8f, 0x45, 0x4f, 0x72, 0x4a, 0x34, 0xbe, 0x7e, 0x6d, 0x75, 0x1f, 0xca, 0x66, 0x96, 0xbd, 0xad,
+	0x5f, 0x85, 0x2d, 0x00, 0xf7, 0x1e, 0x94, 0x8c, 0xe8, 0x05, 0x7d, 0x6e, 0xfa, 0x56, 0x33, 0x15,
+	0xb6, 0x66, 0x44, 0xef, 0x73, 0x6e, 0xfa, 0xde, 0x1e, 0xd4, 0x5f, 0x5a, 0x84, 0xbc, 0x75, 0x7e,
+	0x5e, 0x81, 0x3b, 0x59, 0x7f, 0x7d, 0x69, 0x1b, 0xef, 0x75, 0x1c, 0x3b, 0x5f, 0xc3, 0x6d, 0x83,
+	0xa1, 0x92, 0x11, 0xd7, 0x93, 0xff, 0x3f, 0x79, 0xdc, 0xb9, 0x8f, 0xc5, 0xf0, 0xa9, 0xc2, 0x56,
+	0x56, 0x1c, 0x86, 0x26, 0x4c, 0xf0, 0xca, 0xc8, 0xe9, 0xc0, 0x9d, 0xe7, 0x4f, 0x5e, 0x75, 0xd9,
+	0x16, 0xd1, 0x17, 0x4f, 0x93, 0x47, 0xff, 0xd1, 0x81, 0x5b, 0xe9, 0x5b, 0x2e, 0xe5, 0xb5, 0xde,
+	0x87, 0x35, 0x1a, 0x07, 0xe9, 0xb1, 0x7d, 0xae, 0x8d, 0x07, 0x77, 0xaf, 0xfd, 0xa1, 0xb7, 0xc7,
+	0xed, 0x49, 0x8c, 0xac, 0x48, 0xf6, 0xd7, 0x7b, 0x0f, 0x36, 0x66, 0xd9, 0xe4, 0x7d, 0xb9, 0x9d,
+	0xce, 0x49, 0x23, 0x48, 0x28, 0x69, 0x13, 0x5a, 0x65, 0xf3, 0x7d, 0x93, 0x9d, 0xfd, 0x55, 0x2b,
+	0x9c, 0x9d, 0xd7, 0x9c, 0xa7, 0xe7, 0x35, 0xe7, 0xcf, 0xf3, 0x9a, 0xf3, 0xd3, 0x45, 0xad, 0xf0,
+	0xf4, 0xa2, 0x56, 0xf8, 0xe3, 0xa2, 0x56, 0xf8, 0xe6, 0xc3, 0x7f, 0x29, 0x87, 0xd9, 0x87, 0x93,
+	0xa5, 0xd1, 0x29, 0xda, 0x0f, 0x9f, 0x0f, 0xfe, 0x1e, 0x00, 0x02, 0x5a, 0x6c, 0xc9, 0x7b, 0x09,
+	0x00, 0x00,
 }
 
 func (m *ConfirmOutpointRequest) Marshal() (dAtA []byte, err error) {
@@ -998,6 +1083,76 @@ func (m *VoteConfirmOutpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteFeeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteFeeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteFeeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfTarget != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ConfTarget))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SubmitExternalSignatureRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1386,6 +1541,38 @@ func (m *VoteConfirmOutpointResponse) Size() (n int) {
 	return n
 }
 
+func (m *VoteFeeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConfTarget != 0 {
+		n += 1 + sovTx(uint64(m.ConfTarget))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovTx(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *VoteFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *SubmitExternalSignatureRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2338,6 +2525,210 @@ func (m *VoteConfirmOutpointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteFeeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteFeeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteFeeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfTarget", wireType)
+			}
+			m.ConfTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfTarget |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteFeeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteFeeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SubmitExternalSignatureRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
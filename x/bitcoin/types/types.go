@@ -40,8 +40,8 @@ const (
 // https://transactionfee.info/charts/bitcoin-script-ecdsa-length/#:~:text=The%20ECDSA%20signatures%20used%20in,normally%20taking%20up%2032%20bytes
 const maxDerSigLength = 72
 
-// MinRelayTxFeeSatoshiPerByte defines bitcoin's default minimum relay fee in satoshi/byte
-const MinRelayTxFeeSatoshiPerByte = int64(mempool.DefaultMinRelayTxFee / 1000)
+// MinRelayTxFee defines bitcoin's default minimum relay fee in satoshi per kilo virtual byte
+const MinRelayTxFee = btcutil.Amount(mempool.DefaultMinRelayTxFee)
 
 // Params returns the network parameters
 func (m Network) Params() *chaincfg.Params {
@@ -568,8 +568,8 @@ func validateTxScript(tx *wire.MsgTx, idx int, amount int64, payScript []byte) e
 
 // OutPointToSign gathers all information needed to sign an outpoint
 type OutPointToSign struct {
-	OutPointInfo
-	AddressInfo
+	OutPointInfo `json:"out_point_info"`
+	AddressInfo  `json:"address_info"`
 }
 
 // AssembleBtcTx assembles the unsigned transaction and given signature.
@@ -645,6 +645,56 @@ func EstimateTxSize(tx wire.MsgTx, outpointsToSign []OutPointToSign) int64 {
 	return mempool.GetTxVirtualSize(btcutil.NewTx(&tx))
 }
 
+// EstimateTxFee calculates the fee a transaction of the given virtual size pays at the given fee rate in satoshi per kilo virtual byte, rounded up
+func EstimateTxFee(txSize int64, feeRate btcutil.Amount) sdk.Int {
+	return sdk.NewInt(txSize).MulRaw(int64(feeRate)).AddRaw(999).QuoRaw(1000)
+}
+
+// HasConfTarget returns true if validators estimate the fee rate for the given confirmation target
+func (m FeeRateEstimation) HasConfTarget(confTarget int64) bool {
+	for _, target := range m.ConfTargets {
+		if target == confTarget {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate performs a stateless check to ensure the FeeRate object has been properly initialized
+func (m FeeRate) Validate() error {
+	if m.ConfTarget <= 0 {
+		return fmt.Errorf("confirmation target must be greater than 0")
+	}
+
+	if m.FeeRate <= 0 {
+		return fmt.Errorf("fee rate must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate performs a stateless check to ensure the FeeRateVote object has been properly initialized
+func (m FeeRateVote) Validate() error {
+	if err := sdk.VerifyAddressFormat(m.Voter); err != nil {
+		return sdkerrors.Wrap(err, "invalid voter")
+	}
+
+	if m.ConfTarget <= 0 {
+		return fmt.Errorf("confirmation target must be greater than 0")
+	}
+
+	if m.FeeRate <= 0 {
+		return fmt.Errorf("fee rate must be greater than 0")
+	}
+
+	if m.Height < 0 {
+		return fmt.Errorf("height must not be negative")
+	}
+
+	return nil
+}
+
 // Native asset denominations
 const (
 	Sat     = "sat"
@@ -5,9 +5,12 @@ package types
 
 import (
 	fmt "fmt"
+	utils "github.com/axelarnetwork/axelar-core/utils"
 	_ "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	exported "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	github_com_btcsuite_btcutil "github.com/btcsuite/btcutil"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -520,6 +523,288 @@ func (m *Network) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Network proto.InternalMessageInfo
 
+// PendingOutPointInfo is an outpoint that is pending confirmation by the
+// given poll
+type PendingOutPointInfo struct {
+	PollKey exported.PollKey `protobuf:"bytes,1,opt,name=poll_key,json=pollKey,proto3" json:"poll_key"`
+	Info    OutPointInfo     `protobuf:"bytes,2,opt,name=info,proto3" json:"info"`
+}
+
+func (m *PendingOutPointInfo) Reset()         { *m = PendingOutPointInfo{} }
+func (m *PendingOutPointInfo) String() string { return proto.CompactTextString(m) }
+func (*PendingOutPointInfo) ProtoMessage()    {}
+func (*PendingOutPointInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca561ce6167cd5e4, []int{5}
+}
+func (m *PendingOutPointInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOutPointInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOutPointInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingOutPointInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOutPointInfo.Merge(m, src)
+}
+func (m *PendingOutPointInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOutPointInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOutPointInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOutPointInfo proto.InternalMessageInfo
+
+// ConfirmedOutPointQueue holds the queued confirmed outpoints of a key
+type ConfirmedOutPointQueue struct {
+	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+	Queue utils.QueueState                                          `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue"`
+}
+
+func (m *ConfirmedOutPointQueue) Reset()         { *m = ConfirmedOutPointQueue{} }
+func (m *ConfirmedOutPointQueue) String() string { return proto.CompactTextString(m) }
+func (*ConfirmedOutPointQueue) ProtoMessage()    {}
+func (*ConfirmedOutPointQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca561ce6167cd5e4, []int{6}
+}
+func (m *ConfirmedOutPointQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmedOutPointQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmedOutPointQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmedOutPointQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmedOutPointQueue.Merge(m, src)
+}
+func (m *ConfirmedOutPointQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmedOutPointQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmedOutPointQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmedOutPointQueue proto.InternalMessageInfo
+
+// DustAmount is the amount that has been held back for a destination address
+// because it was too small to be transferred
+type DustAmount struct {
+	Address string                             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_btcsuite_btcutil.Amount `protobuf:"varint,2,opt,name=amount,proto3,casttype=github.com/btcsuite/btcutil.Amount" json:"amount,omitempty"`
+}
+
+func (m *DustAmount) Reset()         { *m = DustAmount{} }
+func (m *DustAmount) String() string { return proto.CompactTextString(m) }
+func (*DustAmount) ProtoMessage()    {}
+func (*DustAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca561ce6167cd5e4, []int{7}
+}
+func (m *DustAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DustAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DustAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DustAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DustAmount.Merge(m, src)
+}
+func (m *DustAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DustAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DustAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DustAmount proto.InternalMessageInfo
+
+// LatestSignedTxHash is the hash of the most recently signed transaction of a
+// tx type
+type LatestSignedTxHash struct {
+	TxType TxType `protobuf:"varint,1,opt,name=tx_type,json=txType,proto3,enum=bitcoin.v1beta1.TxType" json:"tx_type,omitempty"`
+	TxHash []byte `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *LatestSignedTxHash) Reset()         { *m = LatestSignedTxHash{} }
+func (m *LatestSignedTxHash) String() string { return proto.CompactTextString(m) }
+func (*LatestSignedTxHash) ProtoMessage()    {}
+func (*LatestSignedTxHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca561ce6167cd5e4, []int{8}
+}
+func (m *LatestSignedTxHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LatestSignedTxHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LatestSignedTxHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LatestSignedTxHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatestSignedTxHash.Merge(m, src)
+}
+func (m *LatestSignedTxHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *LatestSignedTxHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatestSignedTxHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatestSignedTxHash proto.InternalMessageInfo
+
+// UnconfirmedAmount is the amount sent to a key that has not been confirmed
+// yet
+type UnconfirmedAmount struct {
+	KeyID  github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+	Amount github_com_btcsuite_btcutil.Amount                        `protobuf:"varint,2,opt,name=amount,proto3,casttype=github.com/btcsuite/btcutil.Amount" json:"amount,omitempty"`
+}
+
+func (m *UnconfirmedAmount) Reset()         { *m = UnconfirmedAmount{} }
+func (m *UnconfirmedAmount) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedAmount) ProtoMessage()    {}
+func (*UnconfirmedAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca561ce6167cd5e4, []int{9}
+}
+func (m *UnconfirmedAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnconfirmedAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnconfirmedAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnconfirmedAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedAmount.Merge(m, src)
+}
+func (m *UnconfirmedAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnconfirmedAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnconfirmedAmount proto.InternalMessageInfo
+
+// FeeRate is the median fee rate validators voted for a confirmation target
+type FeeRate struct {
+	ConfTarget int64 `protobuf:"varint,1,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	// fee rate in satoshi per kilo virtual byte
+	FeeRate github_com_btcsuite_btcutil.Amount `protobuf:"varint,2,opt,name=fee_rate,json=feeRate,proto3,casttype=github.com/btcsuite/btcutil.Amount" json:"fee_rate,omitempty"`
+}
+
+func (m *FeeRate) Reset()         { *m = FeeRate{} }
+func (m *FeeRate) String() string { return proto.CompactTextString(m) }
+func (*FeeRate) ProtoMessage()    {}
+func (*FeeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca561ce6167cd5e4, []int{10}
+}
+func (m *FeeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRate.Merge(m, src)
+}
+func (m *FeeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRate proto.InternalMessageInfo
+
+// FeeRateVote is the latest fee rate a validator voted for a confirmation
+// target
+type FeeRateVote struct {
+	Voter      github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"voter,omitempty"`
+	ConfTarget int64                                         `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	// fee rate in satoshi per kilo virtual byte
+	FeeRate github_com_btcsuite_btcutil.Amount `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3,casttype=github.com/btcsuite/btcutil.Amount" json:"fee_rate,omitempty"`
+	Height  int64                              `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FeeRateVote) Reset()         { *m = FeeRateVote{} }
+func (m *FeeRateVote) String() string { return proto.CompactTextString(m) }
+func (*FeeRateVote) ProtoMessage()    {}
+func (*FeeRateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca561ce6167cd5e4, []int{11}
+}
+func (m *FeeRateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRateVote.Merge(m, src)
+}
+func (m *FeeRateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRateVote proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("bitcoin.v1beta1.TxStatus", TxStatus_name, TxStatus_value)
 	proto.RegisterEnum("bitcoin.v1beta1.TxType", TxType_name, TxType_value)
@@ -534,98 +819,123 @@ func init() {
 	proto.RegisterType((*AddressInfo)(nil), "bitcoin.v1beta1.AddressInfo")
 	proto.RegisterType((*AddressInfo_SpendingCondition)(nil), "bitcoin.v1beta1.AddressInfo.SpendingCondition")
 	proto.RegisterType((*Network)(nil), "bitcoin.v1beta1.Network")
+	proto.RegisterType((*PendingOutPointInfo)(nil), "bitcoin.v1beta1.PendingOutPointInfo")
+	proto.RegisterType((*ConfirmedOutPointQueue)(nil), "bitcoin.v1beta1.ConfirmedOutPointQueue")
+	proto.RegisterType((*DustAmount)(nil), "bitcoin.v1beta1.DustAmount")
+	proto.RegisterType((*LatestSignedTxHash)(nil), "bitcoin.v1beta1.LatestSignedTxHash")
+	proto.RegisterType((*UnconfirmedAmount)(nil), "bitcoin.v1beta1.UnconfirmedAmount")
+	proto.RegisterType((*FeeRate)(nil), "bitcoin.v1beta1.FeeRate")
+	proto.RegisterType((*FeeRateVote)(nil), "bitcoin.v1beta1.FeeRateVote")
 }
 
 func init() { proto.RegisterFile("bitcoin/v1beta1/types.proto", fileDescriptor_ca561ce6167cd5e4) }
 
 var fileDescriptor_ca561ce6167cd5e4 = []byte{
-	// 1367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xda, 0x8e, 0x63, 0x8f, 0x9b, 0xd4, 0x99, 0x36, 0xad, 0xb3, 0x69, 0xed, 0x55, 0xfe,
-	0x7f, 0x2a, 0x53, 0xe8, 0x9a, 0xa4, 0x5c, 0x5a, 0xa9, 0x45, 0x8e, 0xbd, 0x2d, 0x56, 0x1b, 0xdb,
-	0xcc, 0x6e, 0x50, 0x8b, 0x54, 0x2d, 0x1b, 0x7b, 0xe2, 0x0c, 0xb1, 0x77, 0xcc, 0xce, 0xb8, 0xd8,
-	0x77, 0x0e, 0xc8, 0x5c, 0x7a, 0x84, 0x83, 0x25, 0x24, 0x38, 0xf0, 0x39, 0xb8, 0xd0, 0x63, 0x4f,
-	0x88, 0x53, 0x80, 0x94, 0x4f, 0xc0, 0xb1, 0x17, 0xd0, 0xcc, 0xee, 0x26, 0xb6, 0xdb, 0x88, 0x42,
-	0xcb, 0x6d, 0xe7, 0xbd, 0xdf, 0x7b, 0x6f, 0xde, 0xfb, 0xbd, 0xf7, 0x66, 0xc1, 0xea, 0x0e, 0xe1,
-	0x4d, 0x4a, 0xdc, 0xe2, 0xc3, 0xf5, 0x1d, 0xcc, 0x9d, 0xf5, 0x22, 0x1f, 0xf6, 0x30, 0xd3, 0x7b,
-	0x1e, 0xe5, 0x14, 0x9e, 0x0e, 0x94, 0x7a, 0xa0, 0x54, 0xcf, 0xb6, 0x69, 0x9b, 0x4a, 0x5d, 0x51,
-	0x7c, 0xf9, 0x30, 0x55, 0xe3, 0x8c, 0x15, 0xf1, 0xa0, 0x47, 0x3d, 0x8e, 0x5b, 0x2f, 0x72, 0xa4,
-	0xe6, 0xdb, 0x94, 0xb6, 0x3b, 0xb8, 0x28, 0x4f, 0x3b, 0xfd, 0xdd, 0x22, 0x27, 0x5d, 0xcc, 0xb8,
-	0xd3, 0xed, 0xf9, 0x80, 0xb5, 0x3f, 0x12, 0x00, 0x6c, 0xbb, 0x8c, 0xb4, 0x5d, 0xdc, 0xb2, 0x06,
-	0xf0, 0x2d, 0x10, 0x17, 0xe6, 0x59, 0x45, 0x53, 0x0a, 0x8b, 0x1b, 0xe7, 0xf5, 0x99, 0x7b, 0xe8,
-	0xd6, 0xc0, 0x1a, 0xf6, 0x30, 0x92, 0x20, 0xb8, 0x08, 0xa2, 0x7c, 0x90, 0x8d, 0x6a, 0x4a, 0xe1,
-	0x14, 0x8a, 0xf2, 0x01, 0xbc, 0x0e, 0xe2, 0xc4, 0xdd, 0xa5, 0xd9, 0x98, 0xa6, 0x14, 0xd2, 0x1b,
-	0xda, 0x73, 0xc6, 0xc7, 0x71, 0xf4, 0xaa, 0xbb, 0x4b, 0x37, 0xe3, 0x8f, 0x0f, 0xf2, 0x11, 0x24,
-	0x6d, 0xe0, 0x3a, 0x48, 0x30, 0xee, 0xf0, 0x3e, 0xcb, 0xc6, 0x65, 0xe8, 0x95, 0x17, 0x84, 0x36,
-	0x25, 0x00, 0x05, 0x40, 0x78, 0x15, 0x2c, 0x37, 0xa9, 0xbb, 0x4b, 0xbc, 0xae, 0xc3, 0x09, 0x75,
-	0x6d, 0x0f, 0x7f, 0xda, 0x27, 0x1e, 0x6e, 0x65, 0xe7, 0x34, 0xa5, 0x90, 0x44, 0x67, 0x27, 0x95,
-	0x28, 0xd0, 0xc1, 0x75, 0xb0, 0xec, 0xb8, 0x43, 0xea, 0x62, 0xbb, 0xe9, 0xb8, 0x36, 0xeb, 0x61,
-	0xb7, 0x65, 0x3f, 0xa4, 0x7d, 0x9e, 0x4d, 0x68, 0x4a, 0x61, 0x01, 0x41, 0x5f, 0x59, 0x76, 0x5c,
-	0x53, 0xa8, 0x3e, 0xa4, 0x7d, 0x0e, 0x3b, 0xe0, 0x4c, 0xcf, 0xc3, 0x0f, 0x6d, 0x67, 0x47, 0xd6,
-	0xd9, 0xde, 0xc7, 0x43, 0x9b, 0xb4, 0xb2, 0xf3, 0x9a, 0x52, 0x48, 0x6d, 0xde, 0x78, 0x76, 0x90,
-	0xbf, 0xd6, 0x26, 0x7c, 0xaf, 0xbf, 0xa3, 0x37, 0x69, 0xb7, 0xe8, 0x0c, 0x70, 0xc7, 0xf1, 0x5c,
-	0xcc, 0x3f, 0xa3, 0xde, 0x7e, 0x70, 0xba, 0xd2, 0xa4, 0x1e, 0x2e, 0x0e, 0x8a, 0x93, 0x6c, 0xe9,
-	0x77, 0xf0, 0xb0, 0x5a, 0x41, 0x19, 0xe1, 0xb9, 0xe4, 0x3b, 0x16, 0x92, 0x16, 0xfc, 0x18, 0x64,
-	0x89, 0xcb, 0xb1, 0xe7, 0x3a, 0x1d, 0x9b, 0x7b, 0x8e, 0xcb, 0x76, 0xb1, 0x67, 0x3b, 0x5d, 0xda,
-	0x77, 0x79, 0x36, 0xa9, 0x29, 0x85, 0xd8, 0xe6, 0xa5, 0x67, 0x07, 0xf9, 0xb5, 0x89, 0x90, 0x3b,
-	0xbc, 0xc9, 0xfa, 0x84, 0x63, 0xf1, 0xd1, 0xe7, 0xa4, 0xa3, 0x97, 0x24, 0x1a, 0x9d, 0x0b, 0xfd,
-	0x58, 0x81, 0x1b, 0x5f, 0xae, 0xfe, 0x19, 0x05, 0x71, 0x51, 0x7f, 0x78, 0x11, 0x00, 0x8f, 0x72,
-	0x87, 0x63, 0x91, 0x92, 0xa4, 0x3c, 0x89, 0x52, 0xbe, 0xe4, 0x0e, 0x1e, 0xc2, 0x0f, 0x40, 0x9a,
-	0xb8, 0xbd, 0x3e, 0xb7, 0x05, 0x41, 0x2c, 0x1b, 0xd5, 0x62, 0x85, 0xf4, 0xc6, 0xe5, 0xbf, 0x63,
-	0x55, 0xaf, 0x0a, 0x9b, 0x09, 0x7e, 0x01, 0x09, 0x05, 0x4c, 0xfd, 0x3c, 0x0a, 0x52, 0x47, 0x7a,
-	0xf8, 0x09, 0xc8, 0x30, 0xd2, 0x0e, 0x79, 0xeb, 0x62, 0x97, 0xb3, 0xac, 0x22, 0xa3, 0x5c, 0x7b,
-	0xf9, 0x28, 0xba, 0x49, 0xda, 0xe8, 0xd8, 0x43, 0x10, 0xf4, 0x34, 0x9b, 0x92, 0x32, 0x75, 0xa4,
-	0x80, 0xc5, 0x69, 0x24, 0x7c, 0x00, 0x12, 0x01, 0x95, 0x8a, 0xa4, 0xf2, 0xd6, 0xe1, 0x41, 0x7e,
-	0x4e, 0xd2, 0xf2, 0x6a, 0x9c, 0xce, 0xed, 0x4b, 0x22, 0x57, 0x40, 0x52, 0x64, 0xb7, 0xe7, 0xb0,
-	0xbd, 0x60, 0x46, 0xe6, 0x19, 0x69, 0xbf, 0xef, 0xb0, 0xbd, 0xb5, 0x03, 0x05, 0x24, 0xcd, 0xd7,
-	0x32, 0x72, 0x57, 0x82, 0xde, 0xf4, 0x8b, 0x63, 0xf3, 0x81, 0x1f, 0x2f, 0x26, 0x01, 0xb2, 0xb9,
-	0xc2, 0x38, 0x22, 0xf0, 0xc9, 0x23, 0x13, 0xff, 0x37, 0x23, 0x33, 0x77, 0xd2, 0xc8, 0xac, 0x7d,
-	0xa9, 0x80, 0x53, 0xf5, 0x3e, 0x6f, 0x50, 0xe2, 0xfa, 0x54, 0xaf, 0x82, 0x14, 0xed, 0x73, 0xbb,
-	0x27, 0x04, 0x7e, 0xb9, 0x51, 0x92, 0x06, 0x00, 0x78, 0x13, 0x24, 0x82, 0x06, 0x8f, 0xfe, 0xa3,
-	0x06, 0x0f, 0xac, 0x60, 0x16, 0xcc, 0x3b, 0xad, 0x96, 0x87, 0x19, 0x93, 0x89, 0xa7, 0x50, 0x78,
-	0xbc, 0x1e, 0xff, 0xea, 0x9b, 0x7c, 0x64, 0xed, 0xc7, 0x39, 0x90, 0x2e, 0xf9, 0x12, 0x79, 0x99,
-	0x09, 0xbc, 0x32, 0x85, 0x87, 0xef, 0x80, 0xb8, 0x47, 0x3b, 0x58, 0xde, 0x63, 0x71, 0xe3, 0xc2,
-	0x73, 0x5c, 0x04, 0x5e, 0x10, 0xed, 0x60, 0x24, 0x91, 0xf0, 0x7f, 0x60, 0xc1, 0xc3, 0x2d, 0x8c,
-	0xbb, 0x36, 0x6b, 0x7a, 0xa4, 0xc7, 0x83, 0xd2, 0x9f, 0xf2, 0x85, 0xa6, 0x94, 0x4d, 0x74, 0x5a,
-	0xfc, 0xbf, 0xe8, 0xb4, 0x35, 0xb0, 0xd0, 0x75, 0x06, 0xa2, 0x07, 0xec, 0xa6, 0x2c, 0xa3, 0x4f,
-	0x4c, 0xba, 0xeb, 0x0c, 0x4c, 0xd2, 0x2e, 0xcb, 0x1a, 0x3d, 0x00, 0x50, 0x32, 0x47, 0x5c, 0x01,
-	0x72, 0x5b, 0x44, 0x50, 0x2c, 0x97, 0x5e, 0x7a, 0x43, 0x3f, 0x29, 0x4f, 0x7f, 0xbe, 0x02, 0xb3,
-	0x72, 0x68, 0x85, 0x96, 0xd8, 0xac, 0x48, 0xfd, 0x3d, 0x0a, 0x96, 0x9e, 0x03, 0xc2, 0x36, 0xc8,
-	0x1c, 0xed, 0x32, 0xbf, 0x00, 0xfe, 0x80, 0xbf, 0xf2, 0xda, 0x5c, 0x0c, 0xdd, 0xca, 0x9d, 0xc9,
-	0x44, 0x20, 0x3c, 0x98, 0x09, 0x14, 0x7d, 0x2d, 0x81, 0x42, 0xb7, 0x41, 0xa0, 0x9b, 0x60, 0xf5,
-	0x28, 0x50, 0xb7, 0xdf, 0xe1, 0x44, 0x14, 0x9d, 0xef, 0x79, 0x98, 0xed, 0xd1, 0x4e, 0x4b, 0x92,
-	0x1f, 0x43, 0x2b, 0x21, 0x64, 0x2b, 0x40, 0x58, 0x21, 0x00, 0xde, 0x00, 0xa9, 0x0e, 0x6d, 0xee,
-	0xdb, 0xe2, 0x19, 0x96, 0xcd, 0x90, 0xde, 0x50, 0x75, 0xff, 0x8d, 0xd6, 0xc3, 0x37, 0x5a, 0xb7,
-	0xc2, 0x37, 0x7a, 0x33, 0xfe, 0xe8, 0x97, 0xbc, 0x82, 0x92, 0xc2, 0x44, 0x08, 0xd7, 0x2e, 0x82,
-	0xf9, 0x9a, 0x7f, 0x75, 0x08, 0x41, 0xdc, 0x75, 0xba, 0x38, 0xe8, 0x60, 0xf9, 0x7d, 0xf9, 0x27,
-	0x05, 0x24, 0xc3, 0x67, 0x12, 0x6e, 0x80, 0x65, 0xeb, 0x9e, 0x6d, 0x5a, 0x25, 0x6b, 0xdb, 0xb4,
-	0xb7, 0x6b, 0x66, 0xc3, 0x28, 0x57, 0x6f, 0x55, 0x8d, 0x4a, 0x26, 0xa2, 0x9e, 0x1f, 0x8d, 0xb5,
-	0x33, 0x21, 0x70, 0xdb, 0x65, 0x3d, 0xdc, 0x24, 0xbb, 0x04, 0x8b, 0x4e, 0x5a, 0x3a, 0xb6, 0x29,
-	0x23, 0xa3, 0x64, 0x19, 0x95, 0x8c, 0xa2, 0xa6, 0x47, 0x63, 0x6d, 0xbe, 0xec, 0x61, 0x87, 0xcf,
-	0x62, 0xcc, 0xea, 0xed, 0x5a, 0xb5, 0x76, 0x3b, 0x13, 0xf5, 0x31, 0x62, 0xd9, 0x10, 0xb7, 0x3d,
-	0x8d, 0x29, 0x6d, 0xd6, 0x91, 0xf0, 0x13, 0xf3, 0x31, 0xc1, 0x6b, 0x07, 0x35, 0x90, 0x99, 0xf6,
-	0x63, 0x54, 0x32, 0x71, 0x15, 0x8c, 0xc6, 0x5a, 0xc2, 0xdf, 0x59, 0x6a, 0xf2, 0x8b, 0x6f, 0x73,
-	0x91, 0xef, 0xbf, 0xcb, 0x29, 0x97, 0x0f, 0x14, 0x90, 0xf0, 0xf7, 0x20, 0xd4, 0xc1, 0x19, 0xeb,
-	0x9e, 0x6d, 0xdd, 0x6f, 0x18, 0x33, 0x49, 0x2d, 0x8f, 0xc6, 0xda, 0x92, 0x0f, 0x9a, 0x4c, 0xe9,
-	0x1a, 0xb8, 0x10, 0xe2, 0xb7, 0x4a, 0xa6, 0x65, 0x20, 0xbb, 0x5c, 0xaf, 0x99, 0xf5, 0xbb, 0xd5,
-	0x4a, 0xc9, 0xaa, 0xd6, 0x6b, 0x19, 0xc5, 0xaf, 0xc6, 0x96, 0xc3, 0x38, 0xf6, 0xca, 0xd4, 0x65,
-	0xb4, 0x43, 0x5a, 0x72, 0x01, 0xc2, 0xf7, 0x40, 0x3e, 0x34, 0x35, 0x8d, 0x72, 0xbd, 0x56, 0x29,
-	0xa1, 0xfb, 0x33, 0xd6, 0x51, 0x55, 0x1d, 0x8d, 0xb5, 0x73, 0x26, 0x16, 0x33, 0xe5, 0x78, 0xc3,
-	0x69, 0x07, 0x39, 0xb0, 0x18, 0x3a, 0x40, 0x86, 0x59, 0xde, 0x36, 0x32, 0x31, 0x3f, 0x41, 0x84,
-	0x59, 0xb3, 0x8f, 0x27, 0x12, 0xfc, 0x41, 0x01, 0x0b, 0xe1, 0xc2, 0x14, 0xb4, 0x60, 0xf8, 0x26,
-	0x58, 0xad, 0x6f, 0x5b, 0x76, 0xa3, 0x5e, 0xad, 0x59, 0xb2, 0x4a, 0xb3, 0xf9, 0x26, 0x47, 0x63,
-	0x2d, 0x5e, 0xa3, 0x2e, 0x86, 0x05, 0x70, 0x7e, 0x16, 0xda, 0x30, 0x6a, 0x15, 0xc1, 0x4b, 0xc0,
-	0x5d, 0xc3, 0x9f, 0x4c, 0xf8, 0x36, 0x58, 0x99, 0x45, 0x96, 0xeb, 0xb5, 0x5b, 0x55, 0xb4, 0x65,
-	0x54, 0x32, 0x51, 0x75, 0x61, 0x34, 0xd6, 0x52, 0x65, 0xff, 0x0d, 0xc0, 0x2d, 0xf8, 0x7f, 0xb0,
-	0x3c, 0x8b, 0x36, 0x1b, 0x46, 0xcd, 0xca, 0xc4, 0xd4, 0xd4, 0x68, 0xac, 0xcd, 0x89, 0x81, 0xe7,
-	0x32, 0x09, 0x45, 0x26, 0xf1, 0xb5, 0x72, 0xb4, 0x67, 0xc5, 0x86, 0x84, 0x97, 0x40, 0xb6, 0x54,
-	0xa9, 0x20, 0xc3, 0x34, 0x6d, 0x54, 0xbf, 0x7b, 0xf2, 0xfd, 0xdf, 0x00, 0x67, 0xa7, 0x70, 0x15,
-	0xa3, 0x51, 0x37, 0xab, 0x56, 0x78, 0xf9, 0x0a, 0xee, 0x51, 0x46, 0x38, 0x5c, 0x07, 0xea, 0x14,
-	0x6c, 0x96, 0x89, 0xa5, 0xd1, 0x58, 0x5b, 0x98, 0x22, 0xe0, 0xb8, 0xc0, 0x9b, 0xe8, 0xf1, 0x6f,
-	0xb9, 0xc8, 0xe3, 0xc3, 0x9c, 0xf2, 0xe4, 0x30, 0xa7, 0xfc, 0x7a, 0x98, 0x53, 0x1e, 0x3d, 0xcd,
-	0x45, 0x9e, 0x3c, 0xcd, 0x45, 0x7e, 0x7e, 0x9a, 0x8b, 0x7c, 0xf4, 0xee, 0x4b, 0x6e, 0x88, 0xf0,
-	0x9f, 0x5d, 0xfe, 0x62, 0xef, 0x24, 0xe4, 0xc4, 0x5e, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xcc,
-	0xbe, 0xf2, 0x8f, 0xcb, 0x0b, 0x00, 0x00,
+	// 1659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x93, 0x1b, 0x47,
+	0x15, 0xdf, 0x91, 0xb4, 0x92, 0xf6, 0xc9, 0xbb, 0xd1, 0xb6, 0xbd, 0xb6, 0x76, 0x1c, 0x4b, 0x83,
+	0x80, 0x94, 0x30, 0x78, 0x94, 0xdd, 0x40, 0x51, 0x4e, 0x55, 0x92, 0xd2, 0x4a, 0xb2, 0x51, 0xc5,
+	0x96, 0x94, 0x99, 0x59, 0x57, 0x42, 0x55, 0x6a, 0x98, 0x95, 0x5a, 0xda, 0x61, 0xa5, 0x69, 0x65,
+	0xba, 0xc7, 0x68, 0xef, 0x1c, 0x28, 0x71, 0x20, 0x47, 0x38, 0xa8, 0x8a, 0x2a, 0x38, 0x70, 0xe2,
+	0xc0, 0x47, 0xe0, 0x82, 0x8f, 0x29, 0x0e, 0x14, 0xa7, 0x05, 0x6c, 0x3e, 0x01, 0x47, 0x5f, 0xa0,
+	0xfa, 0xcf, 0xac, 0x25, 0x6d, 0x1c, 0x9c, 0x38, 0x3e, 0xad, 0xe6, 0xf5, 0xef, 0xfd, 0xfd, 0xbd,
+	0x7e, 0xaf, 0x17, 0xae, 0x1f, 0xf9, 0xac, 0x47, 0xfc, 0xa0, 0xfa, 0x70, 0xef, 0x08, 0x33, 0x6f,
+	0xaf, 0xca, 0x4e, 0x27, 0x98, 0x9a, 0x93, 0x90, 0x30, 0x82, 0x5e, 0x53, 0x87, 0xa6, 0x3a, 0xd4,
+	0xaf, 0x0c, 0xc9, 0x90, 0x88, 0xb3, 0x2a, 0xff, 0x25, 0x61, 0xba, 0xc1, 0x28, 0xad, 0xe2, 0xe9,
+	0x84, 0x84, 0x0c, 0xf7, 0x3f, 0xcf, 0x90, 0x5e, 0x1a, 0x12, 0x32, 0x1c, 0xe1, 0xaa, 0xf8, 0x3a,
+	0x8a, 0x06, 0x55, 0xe6, 0x8f, 0x31, 0x65, 0xde, 0x78, 0xa2, 0x00, 0xdf, 0x78, 0x48, 0x18, 0xfe,
+	0x62, 0x1b, 0x7a, 0xc4, 0xfc, 0x11, 0x3d, 0x3f, 0xfa, 0x24, 0xc2, 0x11, 0x0e, 0xe5, 0x59, 0xf9,
+	0x3f, 0x69, 0x80, 0xc3, 0x80, 0xfa, 0xc3, 0x00, 0xf7, 0x9d, 0x29, 0xfa, 0x2e, 0xa4, 0xb8, 0x66,
+	0x41, 0x33, 0xb4, 0xca, 0xd6, 0xfe, 0x35, 0x73, 0x25, 0x0d, 0xd3, 0x99, 0x3a, 0xa7, 0x13, 0x6c,
+	0x09, 0x10, 0xda, 0x82, 0x04, 0x9b, 0x16, 0x12, 0x86, 0x56, 0xb9, 0x64, 0x25, 0xd8, 0x14, 0xbd,
+	0x0d, 0x29, 0x3f, 0x18, 0x90, 0x42, 0xd2, 0xd0, 0x2a, 0xb9, 0x7d, 0xe3, 0x82, 0xf2, 0x33, 0x3f,
+	0x66, 0x2b, 0x18, 0x90, 0x83, 0xd4, 0xa3, 0xb3, 0xd2, 0x9a, 0x25, 0x74, 0xd0, 0x1e, 0xa4, 0x29,
+	0xf3, 0x58, 0x44, 0x0b, 0x29, 0xe1, 0x7a, 0xf7, 0x73, 0x5c, 0xdb, 0x02, 0x60, 0x29, 0x20, 0x7a,
+	0x0b, 0x76, 0x7a, 0x24, 0x18, 0xf8, 0xe1, 0xd8, 0x63, 0x3e, 0x09, 0xdc, 0x10, 0x7f, 0x12, 0xf9,
+	0x21, 0xee, 0x17, 0xd6, 0x0d, 0xad, 0x92, 0xb5, 0xae, 0x2c, 0x1e, 0x5a, 0xea, 0x0c, 0xed, 0xc1,
+	0x8e, 0x17, 0x9c, 0x92, 0x00, 0xbb, 0x3d, 0x2f, 0x70, 0xe9, 0x04, 0x07, 0x7d, 0xf7, 0x21, 0x89,
+	0x58, 0x21, 0x6d, 0x68, 0x95, 0x4d, 0x0b, 0xc9, 0xc3, 0xba, 0x17, 0xd8, 0xfc, 0xe8, 0x01, 0x89,
+	0x18, 0x1a, 0xc1, 0xe5, 0x49, 0x88, 0x1f, 0xba, 0xde, 0x91, 0x28, 0xb1, 0x7b, 0x82, 0x4f, 0x5d,
+	0xbf, 0x5f, 0xc8, 0x18, 0x5a, 0x65, 0xe3, 0xe0, 0x9d, 0xa7, 0x67, 0xa5, 0xdb, 0x43, 0x9f, 0x1d,
+	0x47, 0x47, 0x66, 0x8f, 0x8c, 0xab, 0xde, 0x14, 0x8f, 0xbc, 0x30, 0xc0, 0xec, 0x67, 0x24, 0x3c,
+	0x51, 0x5f, 0xb7, 0x7a, 0x24, 0xc4, 0xd5, 0x69, 0x75, 0x91, 0x6c, 0xf3, 0x7d, 0x7c, 0xda, 0x6a,
+	0x58, 0x79, 0x6e, 0xb9, 0x26, 0x0d, 0x73, 0x49, 0x1f, 0xfd, 0x04, 0x0a, 0x7e, 0xc0, 0x70, 0x18,
+	0x78, 0x23, 0x97, 0x85, 0x5e, 0x40, 0x07, 0x38, 0x74, 0xbd, 0x31, 0x89, 0x02, 0x56, 0xc8, 0x1a,
+	0x5a, 0x25, 0x79, 0xf0, 0xc6, 0xd3, 0xb3, 0x52, 0x79, 0xc1, 0xe5, 0x11, 0xeb, 0xd1, 0xc8, 0x67,
+	0x98, 0xff, 0xe0, 0x4c, 0x9b, 0x35, 0x81, 0xb6, 0xae, 0xc6, 0x76, 0x1c, 0x65, 0x46, 0xca, 0xf5,
+	0xff, 0x26, 0x20, 0xc5, 0xeb, 0x8f, 0x6e, 0x00, 0x84, 0x84, 0x79, 0x0c, 0xf3, 0x94, 0x04, 0xe5,
+	0x59, 0x6b, 0x43, 0x4a, 0xde, 0xc7, 0xa7, 0xe8, 0x03, 0xc8, 0xf9, 0xc1, 0x24, 0x62, 0x2e, 0x27,
+	0x88, 0x16, 0x12, 0x46, 0xb2, 0x92, 0xdb, 0xbf, 0xf9, 0xff, 0x58, 0x35, 0x5b, 0x5c, 0x67, 0x81,
+	0x5f, 0xf0, 0x63, 0x01, 0xd5, 0x7f, 0x9e, 0x80, 0x8d, 0xf3, 0x73, 0xf4, 0x53, 0xc8, 0x53, 0x7f,
+	0x18, 0xf3, 0x36, 0xc6, 0x01, 0xa3, 0x05, 0x4d, 0x78, 0xb9, 0xfd, 0xe2, 0x5e, 0x4c, 0xdb, 0x1f,
+	0x5a, 0xcf, 0x2c, 0x28, 0xa7, 0xaf, 0xd1, 0x25, 0x29, 0xd5, 0x67, 0x1a, 0x6c, 0x2d, 0x23, 0xd1,
+	0xc7, 0x90, 0x56, 0x54, 0x6a, 0x82, 0xca, 0x3b, 0x8f, 0xcf, 0x4a, 0xeb, 0x82, 0x96, 0x97, 0xe3,
+	0x74, 0xfd, 0x44, 0x10, 0xb9, 0x0b, 0x59, 0x9e, 0xdd, 0xb1, 0x47, 0x8f, 0xd5, 0x1d, 0xc9, 0x50,
+	0x7f, 0xf8, 0x23, 0x8f, 0x1e, 0x97, 0xcf, 0x34, 0xc8, 0xda, 0x5f, 0xcb, 0x95, 0xbb, 0xa5, 0x7a,
+	0x53, 0x16, 0xc7, 0x65, 0x53, 0xe9, 0x2f, 0x29, 0x00, 0xa2, 0xb9, 0x62, 0x3f, 0xdc, 0xf1, 0xf3,
+	0xaf, 0x4c, 0xea, 0xab, 0x5c, 0x99, 0xf5, 0xe7, 0x5d, 0x99, 0xf2, 0x2f, 0x35, 0xb8, 0xd4, 0x89,
+	0x58, 0x97, 0xf8, 0x81, 0xa4, 0xfa, 0x3a, 0x6c, 0x90, 0x88, 0xb9, 0x13, 0x2e, 0x90, 0xe5, 0xb6,
+	0xb2, 0x44, 0x01, 0xd0, 0xbb, 0x90, 0x56, 0x0d, 0x9e, 0xf8, 0x52, 0x0d, 0xae, 0xb4, 0x50, 0x01,
+	0x32, 0x5e, 0xbf, 0x1f, 0x62, 0x4a, 0x45, 0xe2, 0x1b, 0x56, 0xfc, 0xf9, 0x76, 0xea, 0xd7, 0xbf,
+	0x2d, 0xad, 0x95, 0xff, 0xb2, 0x0e, 0xb9, 0x9a, 0x94, 0x88, 0x60, 0x16, 0xf0, 0xda, 0x12, 0x1e,
+	0xbd, 0x09, 0xa9, 0x90, 0x8c, 0xb0, 0x88, 0x63, 0x6b, 0xff, 0xf5, 0x0b, 0x5c, 0x28, 0x2b, 0x16,
+	0x19, 0x61, 0x4b, 0x20, 0xd1, 0x37, 0x61, 0x33, 0xc4, 0x7d, 0x8c, 0xc7, 0x2e, 0xed, 0x85, 0xfe,
+	0x84, 0xa9, 0xd2, 0x5f, 0x92, 0x42, 0x5b, 0xc8, 0x16, 0x3a, 0x2d, 0xf5, 0x2a, 0x3a, 0xad, 0x0c,
+	0x9b, 0x63, 0x6f, 0xca, 0x7b, 0xc0, 0xed, 0x89, 0x32, 0x4a, 0x62, 0x72, 0x63, 0x6f, 0x6a, 0xfb,
+	0xc3, 0xba, 0xa8, 0xd1, 0xc7, 0x80, 0x04, 0x73, 0x7e, 0xc0, 0x41, 0x41, 0xdf, 0xe7, 0x14, 0x8b,
+	0xa1, 0x97, 0xdb, 0x37, 0x9f, 0x97, 0xa7, 0xbc, 0x5f, 0x4a, 0xad, 0x1e, 0x6b, 0x59, 0xdb, 0x74,
+	0x55, 0xa4, 0xff, 0x3b, 0x01, 0xdb, 0x17, 0x80, 0x68, 0x08, 0xf9, 0xf3, 0x59, 0x26, 0x0b, 0x20,
+	0x2f, 0xf8, 0x4b, 0x8f, 0xcd, 0xad, 0xd8, 0x2c, 0xff, 0xec, 0x53, 0xee, 0x08, 0x4f, 0x57, 0x1c,
+	0x25, 0xbe, 0x16, 0x47, 0xb1, 0x59, 0xe5, 0xe8, 0x5d, 0xb8, 0x7e, 0xee, 0x68, 0x1c, 0x8d, 0x98,
+	0xcf, 0x8b, 0xce, 0x8e, 0x43, 0x4c, 0x8f, 0xc9, 0xa8, 0x2f, 0xc8, 0x4f, 0x5a, 0xbb, 0x31, 0xe4,
+	0xbe, 0x42, 0x38, 0x31, 0x00, 0xbd, 0x03, 0x1b, 0x23, 0xd2, 0x3b, 0x71, 0xf9, 0x16, 0x17, 0xcd,
+	0x90, 0xdb, 0xd7, 0x4d, 0xb9, 0xe2, 0xcd, 0x78, 0xc5, 0x9b, 0x4e, 0xbc, 0xe2, 0x0f, 0x52, 0x9f,
+	0xfe, 0xa3, 0xa4, 0x59, 0x59, 0xae, 0xc2, 0x85, 0xe5, 0x1b, 0x90, 0x69, 0xcb, 0xd0, 0x11, 0x82,
+	0x54, 0xe0, 0x8d, 0xb1, 0xea, 0x60, 0xf1, 0xbb, 0xfc, 0x2b, 0x0d, 0x2e, 0x77, 0x25, 0x09, 0x4b,
+	0xb7, 0xef, 0x3d, 0xc8, 0x4e, 0xc8, 0x68, 0x74, 0x3e, 0xe6, 0x73, 0xfb, 0x45, 0x93, 0x3f, 0x1b,
+	0xcc, 0xf3, 0x6c, 0x63, 0xe2, 0xbb, 0x64, 0xc4, 0x53, 0x55, 0x53, 0x34, 0x33, 0x91, 0x9f, 0xe8,
+	0x87, 0x6a, 0xb3, 0x27, 0x84, 0xf2, 0x8d, 0x0b, 0xfd, 0xb2, 0xe8, 0x6d, 0x71, 0xad, 0x97, 0xff,
+	0xa8, 0xc1, 0xd5, 0xba, 0x1c, 0x2a, 0xb8, 0x1f, 0xa3, 0x3e, 0xe0, 0x0f, 0x90, 0x57, 0x3d, 0x7e,
+	0x7f, 0x00, 0xeb, 0xe2, 0xa1, 0xa3, 0x62, 0xde, 0x35, 0xc5, 0x23, 0xe8, 0x3c, 0x62, 0x11, 0x03,
+	0x7f, 0x50, 0x60, 0x15, 0xaf, 0x44, 0x97, 0x07, 0x00, 0x8d, 0x88, 0xb2, 0xda, 0x85, 0xc9, 0xb2,
+	0x32, 0x29, 0x5e, 0x72, 0x66, 0x95, 0x5d, 0x40, 0xf7, 0x3c, 0x86, 0x29, 0x5b, 0x9a, 0xcf, 0x6f,
+	0x42, 0x86, 0x4d, 0xdd, 0x17, 0x59, 0x07, 0x69, 0x26, 0xfe, 0xa2, 0x6b, 0x90, 0x89, 0x87, 0xbe,
+	0xdc, 0x0a, 0x69, 0x26, 0x4c, 0x95, 0xff, 0xa4, 0xc1, 0xf6, 0x61, 0xd0, 0x8b, 0x6b, 0xaf, 0x12,
+	0x7a, 0xc5, 0x45, 0x7f, 0xd9, 0xaa, 0x8c, 0x21, 0x73, 0x07, 0x63, 0xcb, 0x63, 0x18, 0x95, 0x20,
+	0xc7, 0x83, 0x77, 0x99, 0x17, 0x0e, 0xb1, 0xdc, 0x19, 0x49, 0x0b, 0xb8, 0xc8, 0x11, 0x12, 0x54,
+	0x83, 0xec, 0x00, 0x63, 0x37, 0xf4, 0x18, 0xfe, 0x92, 0xde, 0x32, 0x03, 0xe9, 0xa3, 0xfc, 0x57,
+	0x0d, 0x72, 0xca, 0xdf, 0x03, 0xc2, 0x30, 0xba, 0x0b, 0xeb, 0xfc, 0x5a, 0x84, 0xc2, 0xdb, 0xa5,
+	0x83, 0xbd, 0xa7, 0x67, 0xa5, 0x5b, 0x0b, 0xf6, 0x7a, 0x84, 0x8e, 0x09, 0x55, 0x7f, 0x6e, 0xd1,
+	0xfe, 0x89, 0x7a, 0x65, 0x3f, 0xf0, 0x46, 0xf1, 0x62, 0x90, 0xfa, 0xab, 0xc1, 0x27, 0xbe, 0x30,
+	0xf8, 0xe4, 0x57, 0x0a, 0x1e, 0x5d, 0x85, 0xf4, 0x31, 0xf6, 0x87, 0xc7, 0x4c, 0xcc, 0x91, 0xa4,
+	0xa5, 0xbe, 0x6e, 0xfe, 0x4d, 0x83, 0x6c, 0xfc, 0x56, 0x46, 0xfb, 0xb0, 0xe3, 0x7c, 0xe8, 0xda,
+	0x4e, 0xcd, 0x39, 0xb4, 0xdd, 0xc3, 0xb6, 0xdd, 0x6d, 0xd6, 0x5b, 0x77, 0x5a, 0xcd, 0x46, 0x7e,
+	0x4d, 0xbf, 0x36, 0x9b, 0x1b, 0x97, 0x63, 0xe0, 0x61, 0x40, 0x27, 0xb8, 0xe7, 0x0f, 0x7c, 0xcc,
+	0xd7, 0xc9, 0xf6, 0x33, 0x9d, 0xba, 0xd5, 0xac, 0x39, 0xcd, 0x46, 0x5e, 0xd3, 0x73, 0xb3, 0xb9,
+	0x91, 0xa9, 0x87, 0xd8, 0x63, 0xab, 0x18, 0xbb, 0x75, 0xb7, 0xdd, 0x6a, 0xdf, 0xcd, 0x27, 0x24,
+	0x86, 0x77, 0xb4, 0x1f, 0x0c, 0x97, 0x31, 0xb5, 0x83, 0x8e, 0xc5, 0xed, 0x24, 0x25, 0x46, 0x3d,
+	0x79, 0x91, 0x01, 0xf9, 0x65, 0x3b, 0xcd, 0x46, 0x3e, 0xa5, 0xc3, 0x6c, 0x6e, 0xa4, 0xe5, 0xc5,
+	0xd0, 0xb3, 0xbf, 0xf8, 0x5d, 0x71, 0xed, 0x0f, 0xbf, 0x2f, 0x6a, 0x37, 0xcf, 0x34, 0x48, 0xcb,
+	0xee, 0x47, 0x26, 0x5c, 0x76, 0x3e, 0x74, 0x9d, 0x8f, 0xba, 0xcd, 0x95, 0xa4, 0x76, 0x66, 0x73,
+	0x63, 0x5b, 0x82, 0x16, 0x53, 0xba, 0x0d, 0xaf, 0xc7, 0xf8, 0xfb, 0x35, 0xdb, 0x69, 0x5a, 0x6e,
+	0xbd, 0xd3, 0xb6, 0x3b, 0xf7, 0x5a, 0x8d, 0x9a, 0xd3, 0xea, 0xb4, 0xf3, 0x9a, 0xac, 0xc6, 0x7d,
+	0x8f, 0x32, 0x1c, 0xd6, 0x49, 0x40, 0xc9, 0xc8, 0xef, 0x8b, 0x57, 0x10, 0x7a, 0x0f, 0x4a, 0xb1,
+	0xaa, 0xdd, 0xac, 0x77, 0xda, 0x8d, 0x9a, 0xf5, 0xd1, 0x8a, 0x76, 0x42, 0xd7, 0x67, 0x73, 0xe3,
+	0xaa, 0x8d, 0xf9, 0x62, 0xf5, 0xc2, 0xd3, 0x65, 0x03, 0x45, 0xd8, 0x8a, 0x0d, 0x58, 0x4d, 0xbb,
+	0x7e, 0xd8, 0xcc, 0x27, 0x65, 0x82, 0x16, 0xa6, 0xbd, 0x08, 0x2f, 0x24, 0xf8, 0x67, 0x0d, 0x36,
+	0xe3, 0x19, 0x29, 0x46, 0x13, 0xfa, 0x0e, 0x5c, 0xef, 0x1c, 0x3a, 0x6e, 0xb7, 0xd3, 0x6a, 0x3b,
+	0xa2, 0x4a, 0xab, 0xf9, 0x66, 0x67, 0x73, 0x23, 0xd5, 0x26, 0x01, 0x46, 0x15, 0xb8, 0xb6, 0x0a,
+	0xed, 0x36, 0xdb, 0x0d, 0xce, 0x8b, 0xe2, 0x4e, 0x6d, 0x06, 0xf4, 0x3d, 0xd8, 0x5d, 0x45, 0xd6,
+	0x3b, 0xed, 0x3b, 0x2d, 0xeb, 0x7e, 0xb3, 0x91, 0x4f, 0xe8, 0x9b, 0xb3, 0xb9, 0xb1, 0x71, 0x3e,
+	0xb3, 0xd1, 0xb7, 0x60, 0x67, 0x15, 0x6d, 0x77, 0x9b, 0x6d, 0x27, 0x9f, 0xd4, 0x37, 0x66, 0x73,
+	0x63, 0x9d, 0x6f, 0x7d, 0x26, 0x92, 0xd0, 0x44, 0x12, 0xbf, 0xd1, 0x20, 0xb7, 0xf0, 0x4c, 0x42,
+	0x6f, 0x40, 0xa1, 0xd6, 0x68, 0x58, 0x4d, 0xdb, 0x76, 0xad, 0xce, 0xbd, 0xe7, 0xc7, 0xff, 0x6d,
+	0xb8, 0xb2, 0x84, 0x6b, 0x34, 0xbb, 0x1d, 0xbb, 0xe5, 0xc4, 0xc1, 0x37, 0xf0, 0x84, 0x50, 0x9f,
+	0xa1, 0x3d, 0xd0, 0x97, 0x60, 0xab, 0x4c, 0x6c, 0xcf, 0xe6, 0xc6, 0xe6, 0x12, 0x01, 0xcf, 0x0a,
+	0x7c, 0x60, 0x3d, 0xfa, 0x57, 0x71, 0xed, 0xd1, 0xe3, 0xa2, 0xf6, 0xd9, 0xe3, 0xa2, 0xf6, 0xcf,
+	0xc7, 0x45, 0xed, 0xd3, 0x27, 0xc5, 0xb5, 0xcf, 0x9e, 0x14, 0xd7, 0xfe, 0xfe, 0xa4, 0xb8, 0xf6,
+	0xe3, 0xef, 0xbf, 0xe0, 0xf8, 0x8b, 0xff, 0xef, 0x17, 0x97, 0xff, 0x28, 0x2d, 0xd6, 0xf6, 0x5b,
+	0xff, 0x1b, 0x00, 0x12, 0x0f, 0x70, 0x64, 0x0f, 0x10, 0x00, 0x00,
 }
 
 func (m *UnsignedTx) Marshal() (dAtA []byte, err error) {
@@ -1077,93 +1387,359 @@ func (m *Network) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingOutPointInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *UnsignedTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovTypes(uint64(m.Type))
-	}
-	l = len(m.Tx)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.Info.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
-	}
-	if m.ConfirmationRequired {
-		n += 2
-	}
-	if m.AnyoneCanSpendVout != 0 {
-		n += 1 + sovTypes(uint64(m.AnyoneCanSpendVout))
-	}
-	l = len(m.PrevAbortedKeyId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.InternalTransferAmount != 0 {
-		n += 1 + sovTypes(uint64(m.InternalTransferAmount))
-	}
-	return n
+
+func (m *PendingOutPointInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnsignedTx_Info) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PendingOutPointInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RotateKey {
-		n += 2
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if len(m.InputInfos) > 0 {
-		for _, e := range m.InputInfos {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PollKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UnsignedTx_Info_InputInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SigRequirements) > 0 {
-		for _, e := range m.SigRequirements {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+func (m *ConfirmedOutPointQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnsignedTx_Info_InputInfo_SigRequirement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConfirmedOutPointQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmedOutPointQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DustAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DustAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DustAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestSignedTxHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestSignedTxHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestSignedTxHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnconfirmedAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnconfirmedAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnconfirmedAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConfTarget != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConfTarget))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FeeRate != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfTarget != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConfTarget))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnsignedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.ConfirmationRequired {
+		n += 2
+	}
+	if m.AnyoneCanSpendVout != 0 {
+		n += 1 + sovTypes(uint64(m.AnyoneCanSpendVout))
+	}
+	l = len(m.PrevAbortedKeyId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.InternalTransferAmount != 0 {
+		n += 1 + sovTypes(uint64(m.InternalTransferAmount))
+	}
+	return n
+}
+
+func (m *UnsignedTx_Info) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RotateKey {
+		n += 2
+	}
+	if len(m.InputInfos) > 0 {
+		for _, e := range m.InputInfos {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnsignedTx_Info_InputInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigRequirements) > 0 {
+		for _, e := range m.SigRequirements {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnsignedTx_Info_InputInfo_SigRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	l = len(m.SigHash)
 	if l > 0 {
@@ -1287,16 +1863,987 @@ func (m *Network) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *PendingOutPointInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PollKey.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Info.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ConfirmedOutPointQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Queue.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *DustAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *LatestSignedTxHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovTypes(uint64(m.TxType))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *UnconfirmedAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *FeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfTarget != 0 {
+		n += 1 + sovTypes(uint64(m.ConfTarget))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovTypes(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *FeeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ConfTarget != 0 {
+		n += 1 + sovTypes(uint64(m.ConfTarget))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovTypes(uint64(m.FeeRate))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TxType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConfirmationRequired = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyoneCanSpendVout", wireType)
+			}
+			m.AnyoneCanSpendVout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnyoneCanSpendVout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevAbortedKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevAbortedKeyId = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalTransferAmount", wireType)
+			}
+			m.InternalTransferAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalTransferAmount |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedTx_Info) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotateKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RotateKey = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputInfos = append(m.InputInfos, UnsignedTx_Info_InputInfo{})
+			if err := m.InputInfos[len(m.InputInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedTx_Info_InputInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigRequirements = append(m.SigRequirements, UnsignedTx_Info_InputInfo_SigRequirement{})
+			if err := m.SigRequirements[len(m.SigRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedTx_Info_InputInfo_SigRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigHash = append(m.SigHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigHash == nil {
+				m.SigHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TxType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevSignedTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevSignedTxHash = append(m.PrevSignedTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevSignedTxHash == nil {
+				m.PrevSignedTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConfirmationRequired = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyoneCanSpendVout", wireType)
+			}
+			m.AnyoneCanSpendVout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnyoneCanSpendVout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutPointInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutPointInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutPointInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
+func (m *AddressInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1319,17 +2866,17 @@ func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1339,16 +2886,29 @@ func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= TxType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var byteLen int
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1358,31 +2918,16 @@ func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Role |= AddressRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
-			if m.Tx == nil {
-				m.Tx = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemScript", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1392,30 +2937,31 @@ func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RedeemScript = append(m.RedeemScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.RedeemScript == nil {
+				m.RedeemScript = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1425,36 +2971,29 @@ func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= TxStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationRequired", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.ConfirmationRequired = bool(v != 0)
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnyoneCanSpendVout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigCount", wireType)
 			}
-			m.AnyoneCanSpendVout = 0
+			m.MaxSigCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1464,16 +3003,16 @@ func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AnyoneCanSpendVout |= uint32(b&0x7F) << shift
+				m.MaxSigCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevAbortedKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingCondition", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1483,43 +3022,28 @@ func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevAbortedKeyId = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalTransferAmount", wireType)
+			if m.SpendingCondition == nil {
+				m.SpendingCondition = &AddressInfo_SpendingCondition{}
 			}
-			m.InternalTransferAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InternalTransferAmount |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.SpendingCondition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1541,7 +3065,7 @@ func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedTx_Info) Unmarshal(dAtA []byte) error {
+func (m *AddressInfo_SpendingCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1564,17 +3088,17 @@ func (m *UnsignedTx_Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpendingCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpendingCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RotateKey", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKeyIds", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1584,17 +3108,29 @@ func (m *UnsignedTx_Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RotateKey = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalKeyIds = append(m.InternalKeyIds, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalKeyIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1604,79 +3140,46 @@ func (m *UnsignedTx_Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputInfos = append(m.InputInfos, UnsignedTx_Info_InputInfo{})
-			if err := m.InputInfos[len(m.InputInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExternalKeyIds = append(m.ExternalKeyIds, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnsignedTx_Info_InputInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalMultisigThreshold", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ExternalMultisigThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalMultisigThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigRequirements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1703,8 +3206,10 @@ func (m *UnsignedTx_Info_InputInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SigRequirements = append(m.SigRequirements, UnsignedTx_Info_InputInfo_SigRequirement{})
-			if err := m.SigRequirements[len(m.SigRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LockTime == nil {
+				m.LockTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1729,7 +3234,7 @@ func (m *UnsignedTx_Info_InputInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedTx_Info_InputInfo_SigRequirement) Unmarshal(dAtA []byte) error {
+func (m *Network) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1752,15 +3257,15 @@ func (m *UnsignedTx_Info_InputInfo_SigRequirement) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigRequirement: wiretype end group for non-group")
+			return fmt.Errorf("proto: Network: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Network: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1788,41 +3293,7 @@ func (m *UnsignedTx_Info_InputInfo_SigRequirement) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SigHash = append(m.SigHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.SigHash == nil {
-				m.SigHash = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1845,7 +3316,7 @@ func (m *UnsignedTx_Info_InputInfo_SigRequirement) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *SignedTx) Unmarshal(dAtA []byte) error {
+func (m *PendingOutPointInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1868,36 +3339,17 @@ func (m *SignedTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignedTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingOutPointInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingOutPointInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= TxType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollKey", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1907,31 +3359,30 @@ func (m *SignedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
-			if m.Tx == nil {
-				m.Tx = []byte{}
+			if err := m.PollKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevSignedTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1941,65 +3392,25 @@ func (m *SignedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevSignedTxHash = append(m.PrevSignedTxHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrevSignedTxHash == nil {
-				m.PrevSignedTxHash = []byte{}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationRequired", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ConfirmationRequired = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnyoneCanSpendVout", wireType)
-			}
-			m.AnyoneCanSpendVout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AnyoneCanSpendVout |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2021,7 +3432,7 @@ func (m *SignedTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OutPointInfo) Unmarshal(dAtA []byte) error {
+func (m *ConfirmedOutPointQueue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2044,15 +3455,15 @@ func (m *OutPointInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutPointInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfirmedOutPointQueue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutPointInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfirmedOutPointQueue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutPoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2080,32 +3491,13 @@ func (m *OutPointInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutPoint = string(dAtA[iNdEx:postIndex])
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2115,23 +3507,24 @@ func (m *OutPointInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Queue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2154,7 +3547,7 @@ func (m *OutPointInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressInfo) Unmarshal(dAtA []byte) error {
+func (m *DustAmount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2177,68 +3570,17 @@ func (m *AddressInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DustAmount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DustAmount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
-			}
-			m.Role = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Role |= AddressRole(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemScript", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2248,31 +3590,29 @@ func (m *AddressInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedeemScript = append(m.RedeemScript[:0], dAtA[iNdEx:postIndex]...)
-			if m.RedeemScript == nil {
-				m.RedeemScript = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2282,29 +3622,66 @@ func (m *AddressInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestSignedTxHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestSignedTxHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestSignedTxHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
 			}
-			m.MaxSigCount = 0
+			m.TxType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2314,16 +3691,16 @@ func (m *AddressInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSigCount |= uint32(b&0x7F) << shift
+				m.TxType |= TxType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendingCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2333,26 +3710,24 @@ func (m *AddressInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpendingCondition == nil {
-				m.SpendingCondition = &AddressInfo_SpendingCondition{}
-			}
-			if err := m.SpendingCondition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2376,7 +3751,7 @@ func (m *AddressInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressInfo_SpendingCondition) Unmarshal(dAtA []byte) error {
+func (m *UnconfirmedAmount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2399,15 +3774,15 @@ func (m *AddressInfo_SpendingCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpendingCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnconfirmedAmount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpendingCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnconfirmedAmount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalKeyIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2435,13 +3810,13 @@ func (m *AddressInfo_SpendingCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InternalKeyIds = append(m.InternalKeyIds, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalKeyIds", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2451,29 +3826,66 @@ func (m *AddressInfo_SpendingCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalKeyIds = append(m.ExternalKeyIds, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalMultisigThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfTarget", wireType)
 			}
-			m.ExternalMultisigThreshold = 0
+			m.ConfTarget = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2483,16 +3895,16 @@ func (m *AddressInfo_SpendingCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalMultisigThreshold |= int64(b&0x7F) << shift
+				m.ConfTarget |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockTime", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
 			}
-			var msglen int
+			m.FeeRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2502,28 +3914,11 @@ func (m *AddressInfo_SpendingCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FeeRate |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LockTime == nil {
-				m.LockTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2545,7 +3940,7 @@ func (m *AddressInfo_SpendingCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Network) Unmarshal(dAtA []byte) error {
+func (m *FeeRateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2568,17 +3963,17 @@ func (m *Network) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Network: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeRateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Network: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2588,24 +3983,83 @@ func (m *Network) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfTarget", wireType)
+			}
+			m.ConfTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfTarget |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= github_com_btcsuite_btcutil.Amount(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1,10 +1,15 @@
 package evm
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/axelarnetwork/axelar-core/x/evm/keeper"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 )
 
 // BeginBlocker check for infraction evidence or downtime of validators
@@ -12,7 +17,99 @@ import (
 func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock, _ types.BaseKeeper) {}
 
 // EndBlocker called every block, process inflation, update validator set.
-func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k types.BaseKeeper, nexus types.Nexus, signer types.Signer) []abci.ValidatorUpdate {
+func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k types.BaseKeeper, n types.Nexus, signer types.Signer, voter types.Voter, snapshotter types.Snapshotter) []abci.ValidatorUpdate {
+	for _, p := range k.GetParams(ctx) {
+		if !p.AutoBatch.Enabled {
+			continue
+		}
+
+		chain, ok := n.GetChain(ctx, p.Chain)
+		if !ok || !n.IsChainActivated(ctx, chain) {
+			continue
+		}
+
+		autoBatchCommands(ctx, k, n, signer, voter, snapshotter, chain)
+	}
 
 	return nil
 }
+
+// autoBatchCommands turns the pending transfers of the given chain into commands and starts signing the queued commands
+// once they have either waited long enough or fill enough of the commands gas limit
+func autoBatchCommands(ctx sdk.Context, k types.BaseKeeper, n types.Nexus, signer types.Signer, voter types.Voter, snapshotter types.Snapshotter, chain nexus.Chain) {
+	// pending transfers are held back during key rotations, but the queued commands still need to be signed
+	cachedCtx, writeCache := ctx.CacheContext()
+	if err := keeper.CreatePendingTransferCommands(cachedCtx, k, n, signer, chain); err != nil {
+		k.Logger(ctx).Debug(fmt.Sprintf("cannot create commands for pending transfers to chain %s: %s", chain.Name, err.Error()))
+	} else {
+		writeCache()
+	}
+
+	if !isBatchDue(ctx, k.ForChain(chain.Name)) {
+		return
+	}
+
+	cachedCtx, writeCache = ctx.CacheContext()
+	batch, err := keeper.SignNextCommandBatch(cachedCtx, k, signer, snapshotter, voter, chain)
+	if err != nil {
+		// back off for max wait blocks so a persistent failure is not retried and logged every block
+		chainKeeper := k.ForChain(chain.Name)
+		autoBatch, _ := chainKeeper.GetAutoBatchConfig(ctx)
+		retryHeight := ctx.BlockHeight() + autoBatch.MaxWait
+		chainKeeper.SetAutoBatchRetryHeight(ctx, retryHeight)
+
+		k.Logger(ctx).Error(fmt.Sprintf("failed to sign command batch for chain %s, retrying at height %d: %s", chain.Name, retryHeight, err.Error()))
+		return
+	}
+	writeCache()
+
+	batchedCommandsIDHex := hex.EncodeToString(batch.GetID())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoBatch,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
+			sdk.NewAttribute(types.AttributeKeyBatchedCommandsID, batchedCommandsIDHex),
+		),
+	)
+
+	k.Logger(ctx).Info(fmt.Sprintf("started signing command batch %s for chain %s", batchedCommandsIDHex, chain.Name))
+}
+
+func isBatchDue(ctx sdk.Context, keeper types.ChainKeeper) bool {
+	if ctx.BlockHeight() < keeper.GetAutoBatchRetryHeight(ctx) {
+		return false
+	}
+
+	latest := keeper.GetLatestCommandBatch(ctx)
+	switch {
+	case latest.Is(types.BatchSigning):
+		return false
+	case latest.Is(types.BatchAborted):
+		// an aborted batch gets signed again, it must not block the queued commands behind it
+		return true
+	}
+
+	oldestHeight, ok := keeper.GetOldestQueuedCommandHeight(ctx)
+	if !ok {
+		return false
+	}
+
+	autoBatch, ok := keeper.GetAutoBatchConfig(ctx)
+	if !ok {
+		return false
+	}
+
+	if ctx.BlockHeight()-oldestHeight >= autoBatch.MaxWait {
+		return true
+	}
+
+	gasCost := sdk.ZeroInt()
+	for _, cmd := range keeper.GetQueuedCommands(ctx) {
+		gasCost = gasCost.AddRaw(int64(cmd.MaxGasCost))
+	}
+
+	gasLimit := sdk.NewInt(int64(keeper.GetCommandsGasLimit(ctx)))
+
+	return gasCost.MulRaw(autoBatch.GasThreshold.Denominator).GTE(gasLimit.MulRaw(autoBatch.GasThreshold.Numerator))
+}
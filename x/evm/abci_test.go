@@ -0,0 +1,186 @@
+package evm
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramsKeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/evm/keeper"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	"github.com/axelarnetwork/axelar-core/x/evm/types/mock"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tssTestUtils "github.com/axelarnetwork/axelar-core/x/tss/exported/testutils"
+)
+
+func TestAutoBatchCommands(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		k           types.BaseKeeper
+		chainKeeper types.ChainKeeper
+		n           *mock.NexusMock
+		signer      *mock.SignerMock
+		keyID       tss.KeyID
+		maxWait     int64
+	)
+
+	chain := exported.Ethereum
+
+	enqueueCommands := func(count int) {
+		for i := 0; i < count; i++ {
+			cmd, err := types.CreateMintTokenCommand(big.NewInt(1), keyID, types.NewCommandID(rand.Bytes(32), big.NewInt(1)), rand.StrBetween(3, 5), common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(rand.PosI64()))
+			assert.NoError(t, err)
+			assert.NoError(t, chainKeeper.EnqueueCommand(ctx, cmd))
+		}
+	}
+
+	setup := func(gasLimitInCommands uint32) {
+		encCfg := appParams.MakeEncodingConfig()
+		storeKey, paramsKey, tParamsKey := sdk.NewKVStoreKey("evm"), sdk.NewKVStoreKey("subspace"), sdk.NewTransientStoreKey("tsubspace")
+
+		// the auto batching needs a store that supports cache contexts
+		ms := store.NewCommitMultiStore(dbm.NewMemDB())
+		ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, nil)
+		assert.NoError(t, ms.LoadLatestVersion())
+
+		ctx = sdk.NewContext(ms, tmproto.Header{Height: rand.I64Between(1000, 100000)}, false, log.TestingLogger())
+		paramsK := paramsKeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey)
+		k = keeper.NewKeeper(encCfg.Marshaler, storeKey, paramsK)
+
+		maxWait = rand.I64Between(10, 100)
+		mintCmd, _ := types.CreateMintTokenCommand(big.NewInt(1), tssTestUtils.RandKeyID(), types.CommandID{}, "", common.Address{}, big.NewInt(1))
+		params := types.DefaultParams()[0]
+		params.Chain = chain.Name
+		params.CommandsGasLimit = gasLimitInCommands * uint32(mintCmd.MaxGasCost)
+		params.AutoBatch = types.AutoBatchConfig{Enabled: true, MaxWait: maxWait, GasThreshold: utils.Threshold{Numerator: 1, Denominator: 2}}
+		k.SetParams(ctx, params)
+		chainKeeper = k.ForChain(chain.Name)
+
+		keyID = tssTestUtils.RandKeyID()
+		n = &mock.NexusMock{
+			GetTransfersForChainFunc: func(sdk.Context, nexus.Chain, nexus.TransferState) []nexus.CrossChainTransfer { return nil },
+		}
+		signer = &mock.SignerMock{
+			GetSnapshotCounterForKeyIDFunc: func(sdk.Context, tss.KeyID) (int64, bool) { return rand.PosI64(), true },
+			StartSignFunc: func(sdk.Context, tss.SignInfo, types.Snapshotter, types.InitPoller) error {
+				return nil
+			},
+		}
+	}
+
+	autoBatch := func() {
+		autoBatchCommands(ctx, k, n, signer, &mock.VoterMock{}, &mock.SnapshotterMock{}, chain)
+	}
+
+	repeats := 20
+
+	t.Run("should not batch without queued commands", testutils.Func(func(t *testing.T) {
+		setup(10)
+
+		assert.False(t, isBatchDue(ctx, chainKeeper))
+		autoBatch()
+		assert.Len(t, signer.StartSignCalls(), 0)
+		assert.True(t, chainKeeper.GetLatestCommandBatch(ctx).Is(types.BatchNonExistent))
+	}).Repeat(repeats))
+
+	t.Run("should batch once the oldest command waited max wait blocks", testutils.Func(func(t *testing.T) {
+		setup(10)
+		enqueueCommands(1)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + maxWait - 1)
+		assert.False(t, isBatchDue(ctx, chainKeeper))
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		assert.True(t, isBatchDue(ctx, chainKeeper))
+
+		autoBatch()
+		assert.Len(t, signer.StartSignCalls(), 1)
+		latest := chainKeeper.GetLatestCommandBatch(ctx)
+		assert.True(t, latest.Is(types.BatchSigning))
+		assert.Equal(t, hex.EncodeToString(latest.GetID()), signer.StartSignCalls()[0].Info.SigID)
+		assert.Len(t, chainKeeper.GetQueuedCommands(ctx), 0)
+	}).Repeat(repeats))
+
+	t.Run("should batch once the queued commands fill the gas threshold", testutils.Func(func(t *testing.T) {
+		setup(4)
+
+		enqueueCommands(1)
+		assert.False(t, isBatchDue(ctx, chainKeeper))
+
+		enqueueCommands(1)
+		assert.True(t, isBatchDue(ctx, chainKeeper))
+	}).Repeat(repeats))
+
+	t.Run("should not batch while the latest batch is being signed", testutils.Func(func(t *testing.T) {
+		setup(2)
+		enqueueCommands(2)
+
+		autoBatch()
+		assert.Len(t, signer.StartSignCalls(), 1)
+
+		enqueueCommands(2)
+		assert.False(t, isBatchDue(ctx, chainKeeper))
+		autoBatch()
+		assert.Len(t, signer.StartSignCalls(), 1)
+	}).Repeat(repeats))
+
+	t.Run("should sign an aborted batch again", testutils.Func(func(t *testing.T) {
+		setup(2)
+		enqueueCommands(2)
+
+		autoBatch()
+		assert.Len(t, signer.StartSignCalls(), 1)
+
+		aborted := chainKeeper.GetLatestCommandBatch(ctx)
+		assert.True(t, aborted.SetStatus(types.BatchAborted))
+		assert.True(t, isBatchDue(ctx, chainKeeper))
+
+		autoBatch()
+		assert.Len(t, signer.StartSignCalls(), 2)
+		latest := chainKeeper.GetLatestCommandBatch(ctx)
+		assert.True(t, latest.Is(types.BatchSigning))
+		assert.Equal(t, aborted.GetID(), latest.GetID())
+		assert.Equal(t, signer.StartSignCalls()[0].Info.SigID, signer.StartSignCalls()[1].Info.SigID)
+	}).Repeat(repeats))
+
+	t.Run("should leave state untouched and back off when signing fails", testutils.Func(func(t *testing.T) {
+		setup(2)
+		enqueueCommands(2)
+		signer.StartSignFunc = func(sdk.Context, tss.SignInfo, types.Snapshotter, types.InitPoller) error {
+			return fmt.Errorf("some error")
+		}
+
+		autoBatch()
+		assert.Len(t, signer.StartSignCalls(), 1)
+		assert.True(t, chainKeeper.GetLatestCommandBatch(ctx).Is(types.BatchNonExistent))
+		assert.Len(t, chainKeeper.GetQueuedCommands(ctx), 2)
+
+		retryHeight := ctx.BlockHeight() + maxWait
+		assert.Equal(t, retryHeight, chainKeeper.GetAutoBatchRetryHeight(ctx))
+
+		ctx = ctx.WithBlockHeight(retryHeight - 1)
+		assert.False(t, isBatchDue(ctx, chainKeeper))
+		autoBatch()
+		assert.Len(t, signer.StartSignCalls(), 1)
+
+		ctx = ctx.WithBlockHeight(retryHeight)
+		assert.True(t, isBatchDue(ctx, chainKeeper))
+	}).Repeat(repeats))
+}
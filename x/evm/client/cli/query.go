@@ -31,6 +31,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdAxelarGatewayAddress(queryRoute),
 		GetCmdTokenAddress(queryRoute),
 		GetCmdDepositState(queryRoute),
+		GetCmdBurnerAddresses(),
 		GetCmdBytecode(queryRoute),
 		GetCmdSignedTx(queryRoute),
 		GetCmdQueryBatchedCommands(queryRoute),
@@ -197,6 +198,38 @@ func GetCmdDepositState(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// GetCmdBurnerAddresses returns the query for the burner addresses of a chain
+func GetCmdBurnerAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burner-addresses [chain]",
+		Short: "Query the burner addresses of a chain and the tokens deposited to them",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(cliCtx).BurnerAddresses(cmd.Context(),
+				&types.QueryBurnerAddressesRequest{Chain: args[0], Pagination: pageReq})
+			if err != nil {
+				return sdkerrors.Wrap(err, "could not get burner addresses")
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burner-addresses")
+	return cmd
+}
+
 // GetCmdAxelarGatewayAddress returns the query for the AxelarGateway contract address
 func GetCmdAxelarGatewayAddress(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
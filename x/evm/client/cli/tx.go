@@ -11,8 +11,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
+	clientUtils "github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	tsstypes "github.com/axelarnetwork/axelar-core/x/tss/types"
@@ -429,26 +431,70 @@ func GetCmdAddChain() *cobra.Command {
 				return fmt.Errorf("TSS is disabled")
 			}
 
-			byteValue, err := ioutil.ReadFile(jsonFile)
+			params, err := readChainConfig(jsonFile)
 			if err != nil {
 				return err
 			}
-			var chainConf struct {
-				Params types.Params `json:"params"`
+
+			msg := types.NewAddChainRequest(cliCtx.GetFromAddress(), name, nativeAsset, keyType, params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
-			err = json.Unmarshal([]byte(byteValue), &chainConf)
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSubmitAddEVMChainProposal returns the cli command to submit a governance proposal adding a new EVM chain
+func NewCmdSubmitAddEVMChainProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-evm-chain [name] [native asset] [key type] [chain config]",
+		Short: "Submit a proposal to add a new EVM chain",
+		Long:  "Submit a proposal to add a new EVM chain. The chain config parameter should be the path to a json file containing the evm module parameters",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			nativeAsset := args[1]
+
+			keyType, err := tss.KeyTypeFromSimpleStr(args[2])
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewAddChainRequest(cliCtx.GetFromAddress(), name, nativeAsset, keyType, chainConf.Params)
-			if err := msg.ValidateBasic(); err != nil {
+			if !tsstypes.TSSEnabled && keyType == tss.Threshold {
+				return fmt.Errorf("TSS is disabled")
+			}
+
+			params, err := readChainConfig(args[3])
+			if err != nil {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+			return clientUtils.SubmitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewAddEVMChainProposal(title, description, name, nativeAsset, keyType, params)
+			})
 		},
 	}
-	flags.AddTxFlagsToCmd(cmd)
+
+	clientUtils.AddProposalFlags(cmd)
 	return cmd
 }
+
+func readChainConfig(jsonFile string) (types.Params, error) {
+	byteValue, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	var chainConf struct {
+		Params types.Params `json:"params"`
+	}
+	if err := json.Unmarshal(byteValue, &chainConf); err != nil {
+		return types.Params{}, err
+	}
+
+	return chainConf.Params, nil
+}
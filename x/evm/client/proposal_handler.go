@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/axelarnetwork/axelar-core/x/evm/client/cli"
+	"github.com/axelarnetwork/axelar-core/x/evm/client/rest"
+)
+
+// AddEVMChainProposalHandler is the proposal handler to submit add EVM chain proposals through the gov module's cli and REST endpoints
+var AddEVMChainProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitAddEVMChainProposal, rest.AddEVMChainProposalRESTHandler)
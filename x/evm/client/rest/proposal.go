@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clientUtils "github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+// ReqAddEVMChainProposal defines the properties of an add EVM chain proposal request's body
+type ReqAddEVMChainProposal struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Name        string       `json:"name" yaml:"name"`
+	NativeAsset string       `json:"native_asset" yaml:"native_asset"`
+	KeyType     string       `json:"key_type" yaml:"key_type"`
+	Params      types.Params `json:"params" yaml:"params"`
+}
+
+// AddEVMChainProposalRESTHandler returns the REST handler to submit a proposal adding a new EVM chain
+func AddEVMChainProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_evm_chain",
+		Handler:  addEVMChainProposalHandlerFn(cliCtx),
+	}
+}
+
+func addEVMChainProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqAddEVMChainProposal
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		keyType, err := tss.KeyTypeFromSimpleStr(req.KeyType)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewAddEVMChainProposal(req.Title, req.Description, req.Name, req.NativeAsset, keyType, req.Params)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
@@ -6,16 +6,14 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 )
 
-// InitGenesis initialize default parameters
-// from the genesis state
+// InitGenesis initializes the state from the given genesis state
 func InitGenesis(ctx sdk.Context, k types.BaseKeeper, g types.GenesisState) {
-	k.SetParams(ctx, g.Params...)
+	k.InitGenesis(ctx, &g)
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k types.BaseKeeper) types.GenesisState {
-	p := k.GetParams(ctx)
-	return types.GenesisState{Params: p}
+	return *k.ExportGenesis(ctx)
 }
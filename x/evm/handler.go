@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/axelarnetwork/axelar-core/x/evm/keeper"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
@@ -52,6 +53,13 @@ func NewHandler(k types.BaseKeeper, t types.TSS, v types.Voter, s types.Signer,
 				result.Log = fmt.Sprintf("votes on confirmation of deposit %s started", msg.TxID.Hex())
 			}
 			return result, err
+		case *types.ReportDepositRequest:
+			res, err := server.ReportDeposit(sdk.WrapSDKContext(ctx), msg)
+			result, err := sdk.WrapServiceResult(ctx, res, err)
+			if err == nil {
+				result.Log = res.Log
+			}
+			return result, err
 		case *types.ConfirmTransferKeyRequest:
 			res, err := server.ConfirmTransferKey(sdk.WrapSDKContext(ctx), msg)
 			result, err := sdk.WrapServiceResult(ctx, res, err)
@@ -140,3 +148,20 @@ func NewHandler(k types.BaseKeeper, t types.TSS, v types.Voter, s types.Signer,
 		return res, nil
 	}
 }
+
+// NewProposalHandler returns the handler for the EVM module's governance proposals
+func NewProposalHandler(k types.BaseKeeper, t types.TSS, v types.Voter, s types.Signer, n types.Nexus, snapshotter types.Snapshotter) govtypes.Handler {
+	server := keeper.NewMsgServerImpl(k, t, n, s, v, snapshotter)
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddEVMChainProposal:
+			if _, err := server.AddChain(sdk.WrapSDKContext(ctx), c.ToRequest()); err != nil {
+				return sdkerrors.Wrap(types.ErrEVM, err.Error())
+			}
+
+			return nil
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -50,6 +50,10 @@ func (k baseKeeper) Logger(ctx sdk.Context) log.Logger {
 
 // ForChain returns the keeper associated to the given chain
 func (k baseKeeper) ForChain(chain string) types.ChainKeeper {
+	return k.forChain(chain)
+}
+
+func (k baseKeeper) forChain(chain string) chainKeeper {
 	return chainKeeper{
 		baseKeeper: k,
 		chain:      strings.ToLower(chain),
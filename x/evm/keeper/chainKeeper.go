@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"crypto/ecdsa"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogoprototypes "github.com/gogo/protobuf/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -28,6 +30,7 @@ var (
 	gatewayKey             = utils.KeyFromStr("gateway")
 	unsignedBatchIDKey     = utils.KeyFromStr("unsigned_command_batch_id")
 	latestSignedBatchIDKey = utils.KeyFromStr("latest_signed_command_batch_id")
+	autoBatchRetryKey      = utils.KeyFromStr("auto_batch_retry_height")
 
 	unsignedTxPrefix            = utils.KeyFromStr("unsigned_tx")
 	tokenMetadataByAssetPrefix  = utils.KeyFromStr("token_deployment_by_asset")
@@ -57,7 +60,8 @@ func (k chainKeeper) GetName() string {
 }
 
 // returns the EVM network's gas limist for batched commands
-func (k chainKeeper) getCommandsGasLimit(ctx sdk.Context) uint32 {
+// GetCommandsGasLimit returns the maximum gas cost of all commands in a batch
+func (k chainKeeper) GetCommandsGasLimit(ctx sdk.Context) uint32 {
 	var commandsGasLimit uint32
 	subspace, ok := k.getSubspace(ctx, k.chain)
 
@@ -135,6 +139,19 @@ func (k chainKeeper) GetMinVoterCount(ctx sdk.Context) (int64, bool) {
 	return minVoterCount, true
 }
 
+// GetAutoBatchConfig returns the settings for batching and signing commands automatically
+func (k chainKeeper) GetAutoBatchConfig(ctx sdk.Context) (types.AutoBatchConfig, bool) {
+	var autoBatch types.AutoBatchConfig
+
+	subspace, ok := k.getSubspace(ctx, k.chain)
+	if !ok {
+		return autoBatch, false
+	}
+
+	subspace.Get(ctx, types.KeyAutoBatch, &autoBatch)
+	return autoBatch, true
+}
+
 // GetTransactionFeeRate returns the transaction fee rate for evm
 func (k chainKeeper) GetTransactionFeeRate(ctx sdk.Context) (sdk.Dec, bool) {
 	var feeRate sdk.Dec
@@ -299,7 +316,7 @@ func (k chainKeeper) GetERC20TokenBySymbol(ctx sdk.Context, symbol string) types
 	}
 
 	return types.CreateERC20Token(func(m types.ERC20TokenMetadata) {
-		k.setTokenMetadata(ctx, symbol, m)
+		k.setTokenMetadata(ctx, m.Asset, m)
 	}, metadata)
 }
 
@@ -314,6 +331,14 @@ func (k chainKeeper) EnqueueCommand(ctx sdk.Context, command types.Command) erro
 	return nil
 }
 
+// GetCommand returns the command specified by the given ID
+func (k chainKeeper) GetCommand(ctx sdk.Context, id types.CommandID) (types.Command, bool) {
+	var cmd types.Command
+	ok := k.getStore(ctx, k.chain).Get(commandPrefix.AppendStr(id.Hex()), &cmd)
+
+	return cmd, ok
+}
+
 // SetUnsignedTx stores an unsigned transaction
 func (k chainKeeper) SetUnsignedTx(ctx sdk.Context, txID string, rawTx *evmTypes.Transaction, pk ecdsa.PublicKey) error {
 	bzTX, err := rawTx.MarshalBinary()
@@ -590,14 +615,19 @@ func (k chainKeeper) GetLatestCommandBatch(ctx sdk.Context) types.CommandBatch {
 	return types.NewCommandBatch(batch, func(types.CommandBatchMetadata) {})
 }
 
-// CreateNewBatchToSign creates a new batch of commands to be signed
+// CreateNewBatchToSign creates a new batch of commands to be signed.
+// If signing the latest batch was aborted, that batch is returned to be signed again instead
 func (k chainKeeper) CreateNewBatchToSign(ctx sdk.Context) ([]byte, error) {
 	unsigned := k.getUnsigned(ctx)
-	switch k.getUnsigned(ctx).Status {
+	switch unsigned.Status {
 	case types.BatchSigning:
-		fallthrough
+		return nil, fmt.Errorf("signing for command batch '%s' is still in progress", hex.EncodeToString(unsigned.ID))
 	case types.BatchAborted:
-		return nil, fmt.Errorf("signing for command batch '%s' is still in progress", unsigned.ID)
+		// the commands of an aborted batch have already been dequeued, so the batch itself needs to be signed again
+		unsigned.Status = types.BatchSigning
+		k.setCommandBatchMetadata(ctx, unsigned)
+
+		return unsigned.ID, nil
 	case types.BatchSigned:
 		k.getStore(ctx, k.chain).SetRaw(latestSignedBatchIDKey, unsigned.ID)
 	default:
@@ -610,7 +640,7 @@ func (k chainKeeper) CreateNewBatchToSign(ctx sdk.Context) ([]byte, error) {
 	}
 
 	chainID := sdk.NewIntFromBigInt(k.getSigner(ctx).ChainID())
-	gasLimit := k.getCommandsGasLimit(ctx)
+	gasLimit := k.GetCommandsGasLimit(ctx)
 	gasCost := uint32(command.MaxGasCost)
 	keyID := command.KeyID
 	filter := func(value codec.ProtoMarshaler) bool {
@@ -645,6 +675,44 @@ func (k chainKeeper) CreateNewBatchToSign(ctx sdk.Context) ([]byte, error) {
 	return batchedCommands.ID, nil
 }
 
+// GetQueuedCommands returns the commands that have not been batched yet in the order they will be batched
+func (k chainKeeper) GetQueuedCommands(ctx sdk.Context) []types.Command {
+	var commands []types.Command
+	for _, item := range k.getCommandQueue(ctx).ExportState().Items {
+		var cmd types.Command
+		if ok := k.getStore(ctx, k.chain).Get(utils.KeyFromBz(item.Key), &cmd); !ok {
+			continue
+		}
+
+		commands = append(commands, cmd)
+	}
+
+	return commands
+}
+
+// GetOldestQueuedCommandHeight returns the block height at which the oldest command that has not been batched yet was enqueued
+func (k chainKeeper) GetOldestQueuedCommandHeight(ctx sdk.Context) (int64, bool) {
+	items := k.getCommandQueue(ctx).ExportState().Items
+	if len(items) == 0 {
+		return 0, false
+	}
+
+	return items[0].Height, true
+}
+
+// GetAutoBatchRetryHeight returns the block height before which commands are not batched automatically after a failed attempt
+func (k chainKeeper) GetAutoBatchRetryHeight(ctx sdk.Context) int64 {
+	var height gogoprototypes.Int64Value
+	k.getStore(ctx, k.chain).Get(autoBatchRetryKey, &height)
+
+	return height.Value
+}
+
+// SetAutoBatchRetryHeight sets the block height before which commands are not batched automatically
+func (k chainKeeper) SetAutoBatchRetryHeight(ctx sdk.Context, height int64) {
+	k.getStore(ctx, k.chain).Set(autoBatchRetryKey, &gogoprototypes.Int64Value{Value: height})
+}
+
 // returns the queue of commands
 func (k chainKeeper) getCommandQueue(ctx sdk.Context) utils.KVQueue {
 	return utils.NewBlockHeightKVQueue(commandQueueName, k.getStore(ctx, k.chain), ctx.BlockHeight(), k.Logger(ctx))
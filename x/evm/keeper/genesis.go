@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// InitGenesis initializes the evm module's state from a given genesis state
+func (k baseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params...)
+
+	for _, chain := range genState.Chains {
+		k.forChain(chain.Name).initChainRecord(ctx, chain)
+	}
+}
+
+// ExportGenesis returns the evm module's genesis state
+func (k baseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params := k.GetParams(ctx)
+
+	chains := make([]types.ChainRecord, 0, len(params))
+	for _, p := range params {
+		chains = append(chains, k.forChain(p.Chain).getChainRecord(ctx, p.Chain))
+	}
+
+	return types.NewGenesisState(params, chains)
+}
+
+func (k chainKeeper) initChainRecord(ctx sdk.Context, record types.ChainRecord) {
+	store := k.getStore(ctx, k.chain)
+
+	if record.PendingChain != nil {
+		k.SetPendingChain(ctx, *record.PendingChain)
+	}
+
+	if record.Gateway != nil {
+		store.Set(gatewayKey, record.Gateway)
+	}
+
+	if len(record.UnsignedBatchID) > 0 {
+		store.SetRaw(unsignedBatchIDKey, record.UnsignedBatchID)
+	}
+
+	if len(record.LatestSignedBatchID) > 0 {
+		store.SetRaw(latestSignedBatchIDKey, record.LatestSignedBatchID)
+	}
+
+	for _, tx := range record.UnsignedTxs {
+		meta := tx.Metadata
+		store.Set(unsignedTxPrefix.AppendStr(tx.TxID), &meta)
+	}
+
+	for _, token := range record.Tokens {
+		k.setTokenMetadata(ctx, token.Asset, token)
+	}
+
+	for _, pending := range record.PendingDeposits {
+		deposit := pending.Deposit
+		k.SetPendingDeposit(ctx, pending.PollKey, &deposit)
+	}
+
+	for _, deposit := range record.ConfirmedDeposits {
+		k.SetDeposit(ctx, deposit, types.CONFIRMED)
+	}
+
+	for _, deposit := range record.BurnedDeposits {
+		k.SetDeposit(ctx, deposit, types.BURNED)
+	}
+
+	for _, batch := range record.CommandBatches {
+		k.setCommandBatchMetadata(ctx, batch)
+	}
+
+	for _, command := range record.Commands {
+		cmd := command
+		store.Set(commandPrefix.AppendStr(cmd.ID.Hex()), &cmd)
+	}
+
+	k.getCommandQueue(ctx).ImportState(record.CommandQueue)
+
+	for _, burner := range record.Burners {
+		info := burner.Info
+		k.SetBurnerInfo(ctx, common.Address(burner.Address), &info)
+	}
+
+	for _, pending := range record.PendingTransferKeys {
+		transferKey := pending.TransferKey
+		k.SetPendingTransferKey(ctx, pending.PollKey, &transferKey)
+	}
+
+	for _, archived := range record.ArchivedTransferKeys {
+		transferKey := archived.TransferKey
+		store.Set(archivedTransferKeyPrefix.AppendStr(archived.PollKey.String()), &transferKey)
+	}
+}
+
+func (k chainKeeper) getChainRecord(ctx sdk.Context, name string) types.ChainRecord {
+	store := k.getStore(ctx, k.chain)
+
+	record := types.ChainRecord{
+		Name:                 name,
+		UnsignedBatchID:      store.GetRaw(unsignedBatchIDKey),
+		LatestSignedBatchID:  store.GetRaw(latestSignedBatchIDKey),
+		UnsignedTxs:          k.getUnsignedTxs(ctx),
+		Tokens:               k.getTokenMetadatas(ctx),
+		PendingDeposits:      k.getPendingDeposits(ctx),
+		ConfirmedDeposits:    k.getDeposits(ctx, confirmedDepositPrefix),
+		BurnedDeposits:       k.getDeposits(ctx, burnedDepositPrefix),
+		CommandBatches:       k.getCommandBatchesMetadata(ctx),
+		Commands:             k.getCommands(ctx),
+		CommandQueue:         k.getCommandQueue(ctx).ExportState(),
+		Burners:              k.GetBurners(ctx),
+		PendingTransferKeys:  k.getTransferKeys(ctx, pendingTransferKeyPrefix),
+		ArchivedTransferKeys: k.getTransferKeys(ctx, archivedTransferKeyPrefix),
+	}
+
+	if chain, ok := k.GetPendingChain(ctx, name); ok {
+		record.PendingChain = &chain
+	}
+
+	var gateway types.Gateway
+	if store.Get(gatewayKey, &gateway) {
+		record.Gateway = &gateway
+	}
+
+	return record
+}
+
+func (k chainKeeper) getUnsignedTxs(ctx sdk.Context) []types.ChainRecord_UnsignedTx {
+	txs := []types.ChainRecord_UnsignedTx{}
+
+	prefix := unsignedTxPrefix.AppendStr("")
+	iter := k.getStore(ctx, k.chain).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var meta types.TransactionMetadata
+		iter.UnmarshalValue(&meta)
+
+		txs = append(txs, types.ChainRecord_UnsignedTx{
+			TxID:     strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey())),
+			Metadata: meta,
+		})
+	}
+
+	return txs
+}
+
+func (k chainKeeper) getTokenMetadatas(ctx sdk.Context) []types.ERC20TokenMetadata {
+	tokens := []types.ERC20TokenMetadata{}
+
+	prefix := tokenMetadataByAssetPrefix.AppendStr("")
+	iter := k.getStore(ctx, k.chain).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var token types.ERC20TokenMetadata
+		iter.UnmarshalValue(&token)
+
+		// the symbol lookup is restored from the same metadata, so only entries stored under their own asset are exported
+		if strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey())) != strings.ToLower(token.Asset) {
+			continue
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+func (k chainKeeper) getPendingDeposits(ctx sdk.Context) []types.ChainRecord_PollDeposit {
+	deposits := []types.ChainRecord_PollDeposit{}
+
+	prefix := pendingDepositPrefix.AppendStr("")
+	iter := k.getStore(ctx, k.chain).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.ERC20Deposit
+		iter.UnmarshalValue(&deposit)
+
+		deposits = append(deposits, types.ChainRecord_PollDeposit{
+			PollKey: parsePollKey(strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey()))),
+			Deposit: deposit,
+		})
+	}
+
+	return deposits
+}
+
+func (k chainKeeper) getDeposits(ctx sdk.Context, depositPrefix utils.StringKey) []types.ERC20Deposit {
+	deposits := []types.ERC20Deposit{}
+
+	iter := k.getStore(ctx, k.chain).Iterator(depositPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.ERC20Deposit
+		iter.UnmarshalValue(&deposit)
+
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+func (k chainKeeper) getCommandBatchesMetadata(ctx sdk.Context) []types.CommandBatchMetadata {
+	batches := []types.CommandBatchMetadata{}
+
+	iter := k.getStore(ctx, k.chain).Iterator(commandBatchPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var batch types.CommandBatchMetadata
+		iter.UnmarshalValue(&batch)
+
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+func (k chainKeeper) getCommands(ctx sdk.Context) []types.Command {
+	commands := []types.Command{}
+
+	iter := k.getStore(ctx, k.chain).Iterator(commandPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	batchPrefix := string(commandBatchPrefix.AppendStr("").AsKey())
+	queuePrefix := string(utils.KeyFromStr(commandQueueName).AppendStr("").AsKey())
+	for ; iter.Valid(); iter.Next() {
+		// command batches and the command queue share the command prefix
+		if key := string(iter.Key()); strings.HasPrefix(key, batchPrefix) || strings.HasPrefix(key, queuePrefix) {
+			continue
+		}
+
+		var command types.Command
+		iter.UnmarshalValue(&command)
+
+		commands = append(commands, command)
+	}
+
+	return commands
+}
+
+// GetBurners returns all burner addresses of the chain together with their burner info
+func (k chainKeeper) GetBurners(ctx sdk.Context) []types.ChainRecord_Burner {
+	burners := []types.ChainRecord_Burner{}
+
+	prefix := burnerAddrPrefix.AppendStr("")
+	iter := k.getStore(ctx, k.chain).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var info types.BurnerInfo
+		iter.UnmarshalValue(&info)
+
+		address := common.HexToAddress(strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey())))
+		burners = append(burners, types.ChainRecord_Burner{Address: types.Address(address), Info: info})
+	}
+
+	return burners
+}
+
+func (k chainKeeper) getTransferKeys(ctx sdk.Context, transferKeyPrefix utils.StringKey) []types.ChainRecord_PollTransferKey {
+	transferKeys := []types.ChainRecord_PollTransferKey{}
+
+	prefix := transferKeyPrefix.AppendStr("")
+	iter := k.getStore(ctx, k.chain).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var transferKey types.TransferKey
+		iter.UnmarshalValue(&transferKey)
+
+		transferKeys = append(transferKeys, types.ChainRecord_PollTransferKey{
+			PollKey:     parsePollKey(strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey()))),
+			TransferKey: transferKey,
+		})
+	}
+
+	return transferKeys
+}
+
+// parsePollKey reverses vote.PollKey.String(), relying on module names not containing the delimiter
+func parsePollKey(key string) vote.PollKey {
+	split := strings.SplitN(key, "_", 2)
+	if len(split) != 2 {
+		return vote.PollKey{ID: key}
+	}
+
+	return vote.NewPollKey(split[0], split[1])
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramsKeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/evm/exported"
+	evmKeeper "github.com/axelarnetwork/axelar-core/x/evm/keeper"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	encCfg := params.MakeEncodingConfig()
+	newKeeper := func() (sdk.Context, types.BaseKeeper) {
+		paramsK := paramsKeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey(paramstypes.StoreKey), sdk.NewKVStoreKey(paramstypes.TStoreKey))
+		ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		return ctx, evmKeeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey(types.StoreKey), paramsK)
+	}
+
+	t.Run("should restore the state of all chains", testutils.Func(func(t *testing.T) {
+		ctx, keeper := newKeeper()
+		keeper.SetParams(ctx, types.DefaultParams()...)
+
+		pendingChain := nexus.Chain{Name: rand.StrBetween(5, 10), NativeAsset: rand.StrBetween(3, 5), SupportsForeignAssets: true, KeyType: tss.Multisig}
+		pendingParams := types.DefaultParams()[0]
+		pendingParams.Chain = pendingChain.Name
+		keeper.SetPendingChain(ctx, pendingChain)
+		keeper.SetParams(ctx, pendingParams)
+
+		chain := keeper.ForChain(exported.Ethereum.Name)
+		chain.SetPendingGateway(ctx, common.BytesToAddress(rand.Bytes(common.AddressLength)))
+		assert.NoError(t, chain.ConfirmPendingGateway(ctx))
+
+		network, ok := chain.GetNetwork(ctx)
+		assert.True(t, ok)
+		chainID := chain.GetChainIDByNetwork(ctx, network)
+
+		token, err := chain.CreateERC20Token(ctx, rand.StrBetween(3, 5), types.NewTokenDetails(rand.StrBetween(5, 10), rand.StrBetween(3, 5), 8, sdk.NewInt(rand.PosI64())))
+		assert.NoError(t, err)
+		assert.NoError(t, token.RecordDeployment(types.Hash(common.BytesToHash(rand.Bytes(common.HashLength)))))
+
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		assert.NoError(t, err)
+		tx := evmTypes.NewTransaction(uint64(rand.PosI64()), common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(rand.PosI64()), uint64(rand.PosI64()), big.NewInt(rand.PosI64()), rand.Bytes(30))
+		assert.NoError(t, chain.SetUnsignedTx(ctx, rand.HexStr(64), tx, privKey.PublicKey))
+
+		for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+			burnerAddr := common.BytesToAddress(rand.Bytes(common.AddressLength))
+			chain.SetBurnerInfo(ctx, burnerAddr, &types.BurnerInfo{
+				TokenAddress:     token.GetAddress(),
+				DestinationChain: rand.StrBetween(5, 10),
+				Symbol:           token.GetDetails().Symbol,
+				Asset:            token.GetAsset(),
+				Salt:             types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+			})
+
+			deposit := types.ERC20Deposit{
+				TxID:             types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+				Amount:           sdk.NewUint(uint64(rand.PosI64())),
+				Asset:            token.GetAsset(),
+				DestinationChain: rand.StrBetween(5, 10),
+				BurnerAddress:    types.Address(burnerAddr),
+			}
+			switch rand.I64Between(0, 3) {
+			case 0:
+				chain.SetPendingDeposit(ctx, vote.NewPollKey(types.ModuleName, rand.StrBetween(5, 20)), &deposit)
+			case 1:
+				chain.SetDeposit(ctx, deposit, types.CONFIRMED)
+			default:
+				chain.SetDeposit(ctx, deposit, types.BURNED)
+			}
+
+			pollKey := vote.NewPollKey(types.ModuleName, rand.StrBetween(5, 20))
+			chain.SetPendingTransferKey(ctx, pollKey, &types.TransferKey{
+				TxID:      types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+				Type:      types.Ownership,
+				NextKeyID: tss.KeyID(rand.StrBetween(5, 20)),
+			})
+			if rand.Bools(0.5).Next() {
+				chain.ArchiveTransferKey(ctx, pollKey)
+			}
+		}
+
+		keyID := tss.KeyID(rand.StrBetween(5, 20))
+		for i := 0; i < int(rand.I64Between(2, 10)); i++ {
+			cmd, err := types.CreateMintTokenCommand(chainID, keyID, types.NewCommandID(rand.Bytes(32), chainID), token.GetDetails().Symbol, common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(rand.PosI64()))
+			assert.NoError(t, err)
+			assert.NoError(t, chain.EnqueueCommand(ctx, cmd))
+		}
+
+		_, err = chain.CreateNewBatchToSign(ctx)
+		assert.NoError(t, err)
+
+		expected := keeper.ExportGenesis(ctx)
+		assert.NoError(t, expected.Validate())
+		assert.Len(t, expected.Chains, 2)
+
+		bz := encCfg.Marshaler.MustMarshalJSON(expected)
+		var genState types.GenesisState
+		encCfg.Marshaler.MustUnmarshalJSON(bz, &genState)
+		assert.NoError(t, genState.Validate())
+
+		newCtx, newKeeper := newKeeper()
+		newKeeper.InitGenesis(newCtx, &genState)
+		assert.Equal(t, bz, encCfg.Marshaler.MustMarshalJSON(newKeeper.ExportGenesis(newCtx)))
+
+		actualPendingChain, ok := newKeeper.GetPendingChain(newCtx, pendingChain.Name)
+		assert.True(t, ok)
+		assert.Equal(t, pendingChain, actualPendingChain)
+		assert.Equal(t, chain.GetLatestCommandBatch(ctx).GetID(), newKeeper.ForChain(exported.Ethereum.Name).GetLatestCommandBatch(newCtx).GetID())
+		actualToken := newKeeper.ForChain(exported.Ethereum.Name).GetERC20TokenBySymbol(newCtx, token.GetDetails().Symbol)
+		assert.Equal(t, token.GetAddress(), actualToken.GetAddress())
+	}).Repeat(20))
+
+	t.Run("should reject command batches with unknown commands", testutils.Func(func(t *testing.T) {
+		genState := types.DefaultGenesisState()
+		chainID := big.NewInt(rand.PosI64())
+		cmd, err := types.CreateMintTokenCommand(chainID, tss.KeyID(rand.StrBetween(5, 20)), types.NewCommandID(rand.Bytes(32), chainID), rand.StrBetween(3, 5), common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(rand.PosI64()))
+		assert.NoError(t, err)
+		batch, err := types.NewCommandBatchMetadata(chainID, cmd.KeyID, []types.Command{cmd})
+		assert.NoError(t, err)
+
+		genState.Chains = append(genState.Chains, types.ChainRecord{
+			Name:           exported.Ethereum.Name,
+			CommandBatches: []types.CommandBatchMetadata{batch},
+		})
+		assert.Error(t, genState.Validate())
+
+		genState.Chains[0].Commands = append(genState.Chains[0].Commands, cmd)
+		assert.NoError(t, genState.Validate())
+
+		genState.Chains[0].Name = rand.StrBetween(5, 10)
+		assert.Error(t, genState.Validate())
+	}).Repeat(20))
+}
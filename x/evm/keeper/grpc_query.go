@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+var _ types.QueryServiceServer = Querier{}
+
+// Querier implements the grpc querier
+type Querier struct {
+	keeper types.BaseKeeper
+	signer types.Signer
+	nexus  types.Nexus
+}
+
+// NewGRPCQuerier creates a new evm Querier
+func NewGRPCQuerier(k types.BaseKeeper, s types.Signer, n types.Nexus) Querier {
+	return Querier{
+		keeper: k,
+		signer: s,
+		nexus:  n,
+	}
+}
+
+func (q Querier) getChain(ctx sdk.Context, chainName string) (nexus.Chain, types.ChainKeeper, error) {
+	chain, ok := q.nexus.GetChain(ctx, chainName)
+	if !ok {
+		return nexus.Chain{}, nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("%s is not a registered chain", chainName))
+	}
+
+	return chain, q.keeper.ForChain(chain.Name), nil
+}
+
+// BatchedCommands returns the command batch with the given ID, or the latest command batch if no ID is given
+func (q Querier) BatchedCommands(c context.Context, req *types.QueryBatchedCommandsRequest) (*types.QueryBatchedCommandsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, chainKeeper, err := q.getChain(ctx, req.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchedCommands types.CommandBatch
+	if req.ID == "" {
+		batchedCommands = chainKeeper.GetLatestCommandBatch(ctx)
+		if batchedCommands.Is(types.BatchNonExistent) {
+			return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("cannot find the latest batched commands for chain %s", chainKeeper.GetName()))
+		}
+	} else {
+		batchedCommandsID, err := hex.DecodeString(req.ID)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("invalid batched commands ID: %v", err))
+		}
+
+		var ok bool
+		if batchedCommands, ok = getBatchedCommands(ctx, chainKeeper, batchedCommandsID); !ok {
+			return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("batched commands with ID %s not found", req.ID))
+		}
+	}
+
+	resp, err := batchedCommandsToQueryResp(ctx, batchedCommands, q.signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// KeyAddress returns the address of the given key ID, or of the current key of the given key role if no key ID is given
+func (q Querier) KeyAddress(c context.Context, req *types.QueryKeyAddressRequest) (*types.QueryAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, _, err := q.getChain(ctx, req.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID := req.KeyID
+	if keyID == "" {
+		var ok bool
+		if keyID, ok = q.signer.GetCurrentKeyID(ctx, chain, req.KeyRole); !ok {
+			return nil, sdkerrors.Wrapf(types.ErrEVM, "%s key not found", req.KeyRole.SimpleString())
+		}
+	}
+
+	if err := keyID.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrEVM, err.Error())
+	}
+
+	resp, err := addressByKeyID(ctx, q.signer, q.nexus, chain.Name, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GatewayAddress returns the address of the axelar gateway contract on the given chain
+func (q Querier) GatewayAddress(c context.Context, req *types.QueryGatewayAddressRequest) (*types.QueryGatewayAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, chainKeeper, err := q.getChain(ctx, req.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, ok := chainKeeper.GetGatewayAddress(ctx)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrEVM, "axelar gateway not set")
+	}
+
+	return &types.QueryGatewayAddressResponse{Address: addr.Hex()}, nil
+}
+
+// DepositAddress returns the deposit address linked to the given recipient address
+func (q Querier) DepositAddress(c context.Context, req *types.QueryDepositAddressRequest) (*types.QueryDepositAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, chainKeeper, err := q.getChain(ctx, req.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	depositAddr, err := depositAddress(ctx, chainKeeper, q.nexus, chain, req.RecipientAddress, req.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDepositAddressResponse{Address: depositAddr.Hex()}, nil
+}
+
+// DepositState returns the state of an ERC20 deposit confirmation
+func (q Querier) DepositState(c context.Context, req *types.QueryDepositStateRequest) (*types.QueryDepositStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, chainKeeper, err := q.getChain(ctx, req.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, err := hex.DecodeString(strings.TrimPrefix(req.TxHash, "0x"))
+	if err != nil || len(txHash) != common.HashLength {
+		return nil, sdkerrors.Wrap(types.ErrEVM, "invalid transaction hash")
+	}
+
+	if !common.IsHexAddress(req.BurnerAddress) {
+		return nil, sdkerrors.Wrap(types.ErrEVM, "invalid burner address")
+	}
+
+	params := types.QueryDepositStateParams{
+		TxID:          types.Hash(common.BytesToHash(txHash)),
+		BurnerAddress: types.Address(common.HexToAddress(req.BurnerAddress)),
+		Amount:        req.Amount,
+	}
+
+	resp, err := getDepositState(ctx, chainKeeper, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// TokenAddress returns the address of the token contract by asset or by symbol
+func (q Querier) TokenAddress(c context.Context, req *types.QueryTokenAddressRequest) (*types.QueryTokenAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, chainKeeper, err := q.getChain(ctx, req.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	var token types.ERC20Token
+	switch {
+	case req.Asset != "" && req.Symbol == "":
+		token = chainKeeper.GetERC20TokenByAsset(ctx, req.Asset)
+	case req.Symbol != "" && req.Asset == "":
+		token = chainKeeper.GetERC20TokenBySymbol(ctx, req.Symbol)
+	default:
+		return nil, sdkerrors.Wrap(types.ErrEVM, "either asset or symbol must be set")
+	}
+
+	if token.Is(types.NonExistent) {
+		return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("token for asset '%s' and symbol '%s' non-existent", req.Asset, req.Symbol))
+	}
+
+	return &types.QueryTokenAddressResponse{Address: token.GetAddress().Hex()}, nil
+}
+
+// Bytecode returns the bytecode of the given contract
+func (q Querier) Bytecode(c context.Context, req *types.QueryBytecodeRequest) (*types.QueryBytecodeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, chainKeeper, err := q.getChain(ctx, req.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := queryBytecode(ctx, chainKeeper, q.signer, q.nexus, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBytecodeResponse{Bytecode: hex.EncodeToString(bz)}, nil
+}
+
+// BurnerAddresses returns a page of the burner addresses of a chain and the tokens expected to be deposited to them
+func (q Querier) BurnerAddresses(c context.Context, req *types.QueryBurnerAddressesRequest) (*types.QueryBurnerAddressesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, chainKeeper, err := q.getChain(ctx, req.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	confHeight, ok := chainKeeper.GetRequiredConfirmationHeight(ctx)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("could not get confirmation height for chain %s", chainKeeper.GetName()))
+	}
+
+	burners := chainKeeper.GetBurners(ctx)
+	start, end, pageResponse, err := utils.PaginateSlice(len(burners), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrEVM, err.Error())
+	}
+
+	resp := types.QueryBurnerAddressesResponse{ConfirmationHeight: confHeight, Pagination: pageResponse}
+	for _, burner := range burners[start:end] {
+		resp.Burners = append(resp.Burners, types.QueryBurnerAddressesResponse_Burner{
+			Address:      burner.Address.Hex(),
+			TokenAddress: burner.Info.TokenAddress.Hex(),
+		})
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/evm/exported"
+	evmKeeper "github.com/axelarnetwork/axelar-core/x/evm/keeper"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	"github.com/axelarnetwork/axelar-core/x/evm/types/mock"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+func TestGRPCQuerier(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		chainKeeper *mock.ChainKeeperMock
+		querier     evmKeeper.Querier
+		evmChain    string
+		asset       string
+		symbol      string
+		token       common.Address
+		gateway     common.Address
+		burners     []types.ChainRecord_Burner
+		confHeight  uint64
+	)
+
+	setup := func() {
+		evmChain = exported.Ethereum.Name
+		asset = rand.StrBetween(5, 20)
+		symbol = rand.StrBetween(3, 5)
+		token = randomAddress()
+		gateway = randomAddress()
+		confHeight = uint64(rand.PosI64())
+		burners = nil
+		for i := 0; i < int(rand.I64Between(0, 250)); i++ {
+			burners = append(burners, types.ChainRecord_Burner{
+				Address: types.Address(randomAddress()),
+				Info:    types.BurnerInfo{TokenAddress: types.Address(randomAddress())},
+			})
+		}
+
+		chainKeeper = &mock.ChainKeeperMock{
+			GetNameFunc:                       func() string { return evmChain },
+			GetGatewayAddressFunc:             func(sdk.Context) (common.Address, bool) { return gateway, true },
+			GetBurnersFunc:                    func(sdk.Context) []types.ChainRecord_Burner { return burners },
+			GetRequiredConfirmationHeightFunc: func(sdk.Context) (uint64, bool) { return confHeight, true },
+			GetERC20TokenBySymbolFunc: func(_ sdk.Context, s string) types.ERC20Token {
+				if s == symbol {
+					return createMockConfirmedERC20Token(asset, types.Address(token), createDetails(asset, symbol))
+				}
+				return types.NilToken
+			},
+			GetERC20TokenByAssetFunc: func(_ sdk.Context, a string) types.ERC20Token {
+				if a == asset {
+					return createMockConfirmedERC20Token(asset, types.Address(token), createDetails(asset, symbol))
+				}
+				return types.NilToken
+			},
+		}
+		baseKeeper := &mock.BaseKeeperMock{
+			ForChainFunc: func(chain string) types.ChainKeeper { return chainKeeper },
+		}
+		nexusKeeper := &mock.NexusMock{
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				if strings.EqualFold(chain, evmChain) {
+					return exported.Ethereum, true
+				}
+				return nexus.Chain{}, false
+			},
+		}
+
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		querier = evmKeeper.NewGRPCQuerier(baseKeeper, &mock.SignerMock{}, nexusKeeper)
+	}
+
+	repeatCount := 20
+
+	t.Run("should return the token address by asset or symbol", testutils.Func(func(t *testing.T) {
+		setup()
+
+		res, err := querier.TokenAddress(sdk.WrapSDKContext(ctx), &types.QueryTokenAddressRequest{Chain: evmChain, Asset: asset})
+		assert.NoError(t, err)
+		assert.Equal(t, token.Hex(), res.Address)
+
+		res, err = querier.TokenAddress(sdk.WrapSDKContext(ctx), &types.QueryTokenAddressRequest{Chain: evmChain, Symbol: symbol})
+		assert.NoError(t, err)
+		assert.Equal(t, token.Hex(), res.Address)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error when the token cannot be identified", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := querier.TokenAddress(sdk.WrapSDKContext(ctx), &types.QueryTokenAddressRequest{Chain: evmChain, Asset: asset, Symbol: symbol})
+		assert.Error(t, err)
+
+		_, err = querier.TokenAddress(sdk.WrapSDKContext(ctx), &types.QueryTokenAddressRequest{Chain: evmChain})
+		assert.Error(t, err)
+
+		_, err = querier.TokenAddress(sdk.WrapSDKContext(ctx), &types.QueryTokenAddressRequest{Chain: evmChain, Asset: rand.StrBetween(21, 30)})
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+
+	t.Run("should return the gateway address", testutils.Func(func(t *testing.T) {
+		setup()
+
+		res, err := querier.GatewayAddress(sdk.WrapSDKContext(ctx), &types.QueryGatewayAddressRequest{Chain: evmChain})
+		assert.NoError(t, err)
+		assert.Equal(t, gateway.Hex(), res.Address)
+
+		chainKeeper.GetGatewayAddressFunc = func(sdk.Context) (common.Address, bool) { return common.Address{}, false }
+		_, err = querier.GatewayAddress(sdk.WrapSDKContext(ctx), &types.QueryGatewayAddressRequest{Chain: evmChain})
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error for unknown chains", testutils.Func(func(t *testing.T) {
+		setup()
+		chain := rand.StrBetween(10, 20)
+
+		_, err := querier.GatewayAddress(sdk.WrapSDKContext(ctx), &types.QueryGatewayAddressRequest{Chain: chain})
+		assert.Error(t, err)
+
+		_, err = querier.TokenAddress(sdk.WrapSDKContext(ctx), &types.QueryTokenAddressRequest{Chain: chain, Asset: asset})
+		assert.Error(t, err)
+
+		_, err = querier.BatchedCommands(sdk.WrapSDKContext(ctx), &types.QueryBatchedCommandsRequest{Chain: chain})
+		assert.Error(t, err)
+
+		_, err = querier.BurnerAddresses(sdk.WrapSDKContext(ctx), &types.QueryBurnerAddressesRequest{Chain: chain})
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+
+	t.Run("should return all burner addresses page by page", testutils.Func(func(t *testing.T) {
+		setup()
+		limit := uint64(rand.I64Between(1, 50))
+
+		var actual []types.QueryBurnerAddressesResponse_Burner
+		var nextKey []byte
+		for {
+			res, err := querier.BurnerAddresses(sdk.WrapSDKContext(ctx), &types.QueryBurnerAddressesRequest{
+				Chain:      evmChain,
+				Pagination: &query.PageRequest{Key: nextKey, Limit: limit},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, confHeight, res.ConfirmationHeight)
+			assert.LessOrEqual(t, uint64(len(res.Burners)), limit)
+
+			actual = append(actual, res.Burners...)
+			if nextKey = res.Pagination.NextKey; nextKey == nil {
+				break
+			}
+		}
+
+		assert.Len(t, actual, len(burners))
+		for i, burner := range actual {
+			assert.Equal(t, burners[i].Address.Hex(), burner.Address)
+			assert.Equal(t, burners[i].Info.TokenAddress.Hex(), burner.TokenAddress)
+		}
+	}).Repeat(repeatCount))
+
+	t.Run("should return error when the confirmation height is unknown", testutils.Func(func(t *testing.T) {
+		setup()
+		chainKeeper.GetRequiredConfirmationHeightFunc = func(sdk.Context) (uint64, bool) { return 0, false }
+
+		_, err := querier.BurnerAddresses(sdk.WrapSDKContext(ctx), &types.QueryBurnerAddressesRequest{Chain: evmChain})
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+
+	t.Run("should return error for malformed deposit state requests", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := querier.DepositState(sdk.WrapSDKContext(ctx), &types.QueryDepositStateRequest{Chain: evmChain, TxHash: rand.HexStr(10), BurnerAddress: randomAddress().Hex()})
+		assert.Error(t, err)
+
+		_, err = querier.DepositState(sdk.WrapSDKContext(ctx), &types.QueryDepositStateRequest{Chain: evmChain, TxHash: common.BytesToHash(rand.Bytes(common.HashLength)).Hex(), BurnerAddress: rand.StrBetween(5, 10)})
+		assert.Error(t, err)
+	}).Repeat(repeatCount))
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+)
+
+// RegisterInvariants registers all evm module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k types.BaseKeeper) {
+	ir.RegisterRoute(types.ModuleName, "command-batches", CommandBatchesInvariant(k))
+}
+
+// CommandBatchesInvariant checks that the latest command batch of every chain, which is the unsigned batch
+// as long as one exists, only refers to commands that are stored
+func CommandBatchesInvariant(k types.BaseKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, p := range k.GetParams(ctx) {
+			chain := k.ForChain(p.Chain)
+
+			batch := chain.GetLatestCommandBatch(ctx)
+			if batch.Is(types.BatchNonExistent) {
+				continue
+			}
+
+			for _, commandID := range batch.GetCommandIDs() {
+				if _, ok := chain.GetCommand(ctx, commandID); !ok {
+					msg += fmt.Sprintf("\tcommand batch %x of chain %s refers to unknown command %s\n", batch.GetID(), p.Chain, commandID.Hex())
+					broken = true
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "command-batches",
+			fmt.Sprintf("command batches refer to missing commands\n%s", msg)), broken
+	}
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramsKeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/evm/exported"
+	evmKeeper "github.com/axelarnetwork/axelar-core/x/evm/keeper"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+func TestCommandBatchesInvariant(t *testing.T) {
+	encCfg := params.MakeEncodingConfig()
+	newKeeper := func() (sdk.Context, types.BaseKeeper) {
+		paramsK := paramsKeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey(paramstypes.StoreKey), sdk.NewKVStoreKey(paramstypes.TStoreKey))
+		ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		return ctx, evmKeeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey(types.StoreKey), paramsK)
+	}
+
+	randCommands := func(chainID *big.Int) []types.Command {
+		keyID := tss.KeyID(rand.StrBetween(5, 20))
+
+		var commands []types.Command
+		for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+			cmd, err := types.CreateMintTokenCommand(chainID, keyID, types.NewCommandID(rand.Bytes(32), chainID), rand.StrBetween(3, 5), common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(rand.PosI64()))
+			assert.NoError(t, err)
+			commands = append(commands, cmd)
+		}
+
+		return commands
+	}
+
+	t.Run("should hold when the unsigned batch refers to stored commands", testutils.Func(func(t *testing.T) {
+		ctx, keeper := newKeeper()
+		keeper.SetParams(ctx, types.DefaultParams()...)
+
+		chain := keeper.ForChain(exported.Ethereum.Name)
+		network, ok := chain.GetNetwork(ctx)
+		assert.True(t, ok)
+
+		for _, cmd := range randCommands(chain.GetChainIDByNetwork(ctx, network)) {
+			assert.NoError(t, chain.EnqueueCommand(ctx, cmd))
+		}
+
+		_, err := chain.CreateNewBatchToSign(ctx)
+		assert.NoError(t, err)
+
+		_, broken := evmKeeper.CommandBatchesInvariant(keeper)(ctx)
+		assert.False(t, broken)
+	}).Repeat(20))
+
+	t.Run("should break when the unsigned batch refers to unknown commands", testutils.Func(func(t *testing.T) {
+		ctx, keeper := newKeeper()
+
+		chainID := big.NewInt(rand.PosI64())
+		commands := randCommands(chainID)
+		batch, err := types.NewCommandBatchMetadata(chainID, commands[0].KeyID, commands)
+		assert.NoError(t, err)
+
+		genState := types.DefaultGenesisState()
+		genState.Chains = append(genState.Chains, types.ChainRecord{
+			Name:            exported.Ethereum.Name,
+			CommandBatches:  []types.CommandBatchMetadata{batch},
+			UnsignedBatchID: batch.ID,
+			Commands:        commands[1:],
+		})
+		keeper.InitGenesis(ctx, genState)
+
+		msg, broken := evmKeeper.CommandBatchesInvariant(keeper)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, commands[0].ID.Hex())
+	}).Repeat(20))
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	evmKeeper "github.com/axelarnetwork/axelar-core/x/evm/keeper"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
 func TestSetBurnerInfoGetBurnerInfo(t *testing.T) {
@@ -96,3 +98,39 @@ func TestKeeper_GetParams(t *testing.T) {
 		assert.Equal(t, gasWithSubspace, gasWithoutSubspace)
 	}).Repeat(20))
 }
+
+func TestGetQueuedCommands(t *testing.T) {
+	encCfg := params.MakeEncodingConfig()
+	paramsK := paramsKeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"))
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper := evmKeeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey("evm"), paramsK)
+	keeper.SetParams(ctx, types.DefaultParams()...)
+	chainKeeper := keeper.ForChain(types.DefaultParams()[0].Chain)
+
+	_, ok := chainKeeper.GetOldestQueuedCommandHeight(ctx)
+	assert.False(t, ok)
+	assert.Empty(t, chainKeeper.GetQueuedCommands(ctx))
+
+	startHeight := rand.I64Between(1, 1000)
+	var expected []types.Command
+	for i := int64(0); i < rand.I64Between(1, 20); i++ {
+		cmd := types.Command{
+			ID:         types.NewCommandID(rand.Bytes(32), big.NewInt(1)),
+			Command:    rand.Str(10),
+			KeyID:      tss.KeyID(rand.Str(10)),
+			MaxGasCost: uint32(rand.I64Between(1, 100000)),
+		}
+		assert.NoError(t, chainKeeper.EnqueueCommand(ctx.WithBlockHeight(startHeight+i), cmd))
+		expected = append(expected, cmd)
+	}
+
+	height, ok := chainKeeper.GetOldestQueuedCommandHeight(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, startHeight, height)
+	assert.Equal(t, expected, chainKeeper.GetQueuedCommands(ctx))
+	assert.Equal(t, types.DefaultParams()[0].CommandsGasLimit, chainKeeper.GetCommandsGasLimit(ctx))
+
+	autoBatch, ok := chainKeeper.GetAutoBatchConfig(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, types.DefaultParams()[0].AutoBatch, autoBatch)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+// NewKeyRotationHandler returns the handler for keys that have been generated automatically by the tss module.
+// It enqueues the command transferring ownership or operatorship of the gateway to the new key
+func NewKeyRotationHandler(keeper types.BaseKeeper, t types.TSS, n types.Nexus, s types.Signer, v types.Voter, snap types.Snapshotter) tss.KeyRotationHandler {
+	server := NewMsgServerImpl(keeper, t, n, s, v, snap)
+
+	return func(ctx sdk.Context, chain string, keyRole tss.KeyRole, keyID tss.KeyID) error {
+		if _, ok := keeper.ForChain(chain).GetNetwork(ctx); !ok {
+			return nil
+		}
+
+		switch keyRole {
+		case tss.MasterKey:
+			_, err := server.CreateTransferOwnership(sdk.WrapSDKContext(ctx), &types.CreateTransferOwnershipRequest{Chain: chain, KeyID: keyID})
+			return err
+		case tss.SecondaryKey:
+			_, err := server.CreateTransferOperatorship(sdk.WrapSDKContext(ctx), &types.CreateTransferOperatorshipRequest{Chain: chain, KeyID: keyID})
+			return err
+		default:
+			return fmt.Errorf("%s keys cannot be rotated on chain %s", keyRole.SimpleString(), chain)
+		}
+	}
+}
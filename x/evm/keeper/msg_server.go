@@ -453,22 +453,87 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 		return nil, fmt.Errorf("no burner info found for address %s", req.BurnerAddress)
 	}
 
+	if err := s.initializeDepositPoll(ctx, chain, keeper, req.TxID, req.Amount, req.BurnerAddress, *burnerInfo); err != nil {
+		return nil, err
+	}
+
+	return &types.ConfirmDepositResponse{}, nil
+}
+
+// ReportDeposit starts the confirmation of a deposit that a validator detected by itself.
+// Reports of deposits that are already being confirmed or have been confirmed are ignored,
+// so all validators can report the same deposit independently
+func (s msgServer) ReportDeposit(c context.Context, req *types.ReportDepositRequest) (*types.ReportDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	validator := s.snapshotter.GetOperator(ctx, req.Sender)
+	if validator.Empty() {
+		return nil, fmt.Errorf("account %v is not registered as a validator proxy", req.Sender.String())
+	}
+
+	if !isChainMaintainer(s.nexus.GetChainMaintainers(ctx, chain), validator) {
+		return nil, fmt.Errorf("validator %s is not a maintainer of chain %s", validator.String(), chain.Name)
+	}
+
+	keeper := s.ForChain(chain.Name)
+
+	if _, _, ok := keeper.GetDeposit(ctx, common.Hash(req.TxID), common.Address(req.BurnerAddress)); ok {
+		return &types.ReportDepositResponse{Log: fmt.Sprintf("deposit %s is already confirmed", req.TxID.Hex())}, nil
+	}
+
+	pollKey := types.GetDepositPollKey(req.TxID, req.BurnerAddress, req.Amount)
+	if _, ok := keeper.GetPendingDeposit(ctx, pollKey); ok {
+		return &types.ReportDepositResponse{Log: fmt.Sprintf("deposit %s is already being confirmed", req.TxID.Hex())}, nil
+	}
+
+	burnerInfo := keeper.GetBurnerInfo(ctx, common.Address(req.BurnerAddress))
+	if burnerInfo == nil {
+		return nil, fmt.Errorf("no burner info found for address %s", req.BurnerAddress)
+	}
+
+	if err := s.initializeDepositPoll(ctx, chain, keeper, req.TxID, req.Amount, req.BurnerAddress, *burnerInfo); err != nil {
+		return nil, err
+	}
+
+	return &types.ReportDepositResponse{Log: fmt.Sprintf("votes on confirmation of deposit %s started", req.TxID.Hex())}, nil
+}
+
+func isChainMaintainer(maintainers []sdk.ValAddress, validator sdk.ValAddress) bool {
+	for _, maintainer := range maintainers {
+		if maintainer.Equals(validator) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// initializeDepositPoll starts a poll on the given deposit and notifies vald to vote on it
+func (s msgServer) initializeDepositPoll(ctx sdk.Context, chain nexus.Chain, keeper types.ChainKeeper, txID types.Hash, amount sdk.Uint, burnerAddr types.Address, burnerInfo types.BurnerInfo) error {
 	period, ok := keeper.GetRevoteLockingPeriod(ctx)
 	if !ok {
-		return nil, fmt.Errorf("could not retrieve revote locking period for chain %s", req.Chain)
+		return fmt.Errorf("could not retrieve revote locking period for chain %s", chain.Name)
 	}
 
 	votingThreshold, ok := keeper.GetVotingThreshold(ctx)
 	if !ok {
-		return nil, fmt.Errorf("voting threshold for chain %s not found", chain.Name)
+		return fmt.Errorf("voting threshold for chain %s not found", chain.Name)
 	}
 
 	minVoterCount, ok := keeper.GetMinVoterCount(ctx)
 	if !ok {
-		return nil, fmt.Errorf("min voter count for chain %s not found", chain.Name)
+		return fmt.Errorf("min voter count for chain %s not found", chain.Name)
 	}
 
-	pollKey := vote.NewPollKey(types.ModuleName, fmt.Sprintf("%s_%s_%d", req.TxID.Hex(), req.BurnerAddress.Hex(), req.Amount.Uint64()))
+	pollKey := types.GetDepositPollKey(txID, burnerAddr, amount)
 	if err := s.voter.InitializePoll(
 		ctx,
 		pollKey,
@@ -478,15 +543,15 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 		vote.MinVoterCount(minVoterCount),
 		vote.RewardPool(chain.Name),
 	); err != nil {
-		return nil, err
+		return err
 	}
 
 	erc20Deposit := types.ERC20Deposit{
-		TxID:             req.TxID,
-		Amount:           req.Amount,
+		TxID:             txID,
+		Amount:           amount,
 		Asset:            burnerInfo.Asset,
 		DestinationChain: burnerInfo.DestinationChain,
-		BurnerAddress:    req.BurnerAddress,
+		BurnerAddress:    burnerAddr,
 	}
 	keeper.SetPendingDeposit(ctx, pollKey, &erc20Deposit)
 
@@ -496,16 +561,16 @@ func (s msgServer) ConfirmDeposit(c context.Context, req *types.ConfirmDepositRe
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
 			sdk.NewAttribute(types.AttributeKeyChain, chain.Name),
-			sdk.NewAttribute(types.AttributeKeyTxID, req.TxID.Hex()),
-			sdk.NewAttribute(types.AttributeKeyAmount, req.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyBurnAddress, req.BurnerAddress.Hex()),
+			sdk.NewAttribute(types.AttributeKeyTxID, txID.Hex()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnAddress, burnerAddr.Hex()),
 			sdk.NewAttribute(types.AttributeKeyTokenAddress, burnerInfo.TokenAddress.Hex()),
 			sdk.NewAttribute(types.AttributeKeyConfHeight, strconv.FormatUint(height, 10)),
 			sdk.NewAttribute(types.AttributeKeyPoll, string(types.ModuleCdc.MustMarshalJSON(&pollKey))),
 		),
 	)
 
-	return &types.ConfirmDepositResponse{}, nil
+	return nil
 }
 
 // ConfirmTransferKey handles transfer ownership/operatorship confirmations
@@ -809,7 +874,7 @@ func (s msgServer) VoteConfirmDeposit(c context.Context, req *types.VoteConfirmD
 		return nil, fmt.Errorf("could not retrieve transaction fee rate")
 	}
 
-	if err := s.nexus.EnqueueForTransfer(ctx, depositAddr, amount, feeRate); err != nil {
+	if err := s.nexus.EnqueueForTransfer(ctx, depositAddr, amount, feeRate, pendingDeposit.TxID.Hex()); err != nil {
 		return nil, err
 	}
 	keeper.SetDeposit(ctx, pendingDeposit, types.CONFIRMED)
@@ -1064,7 +1129,7 @@ func (s msgServer) CreateBurnTokens(c context.Context, req *types.CreateBurnToke
 		return &types.CreateBurnTokensResponse{}, nil
 	}
 
-	chainID := s.getChainID(ctx, req.Chain)
+	chainID := getChainID(ctx, s, req.Chain)
 	if chainID == nil {
 		return nil, fmt.Errorf("could not find chain ID for '%s'", req.Chain)
 	}
@@ -1210,7 +1275,6 @@ func getGatewayDeploymentBytecode(ctx sdk.Context, k types.ChainKeeper, s types.
 
 func (s msgServer) CreatePendingTransfers(c context.Context, req *types.CreatePendingTransfersRequest) (*types.CreatePendingTransfersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	keeper := s.ForChain(req.Chain)
 
 	chain, ok := s.nexus.GetChain(ctx, req.Chain)
 	if !ok {
@@ -1221,45 +1285,67 @@ func (s msgServer) CreatePendingTransfers(c context.Context, req *types.CreatePe
 		return nil, err
 	}
 
-	pendingTransfers := s.nexus.GetTransfersForChain(ctx, chain, nexus.Pending)
+	if err := CreatePendingTransferCommands(ctx, s, s.nexus, s.signer, chain); err != nil {
+		return nil, err
+	}
+
+	return &types.CreatePendingTransfersResponse{}, nil
+}
+
+// CreatePendingTransferCommands enqueues mint commands for all pending transfers to the given chain and archives the transfers
+func CreatePendingTransferCommands(ctx sdk.Context, k types.BaseKeeper, n types.Nexus, s types.Signer, chain nexus.Chain) error {
+	keeper := k.ForChain(chain.Name)
+
+	var pendingTransfers []nexus.CrossChainTransfer
+	for _, transfer := range n.GetTransfersForChain(ctx, chain, nexus.Pending) {
+		// frozen transfers stay pending until the freeze on both the source and the destination chain is lifted
+		if n.IsTransferFrozen(ctx, chain, transfer.Asset.Denom) || n.IsTransferFrozen(ctx, transfer.Sender.Chain, transfer.Asset.Denom) {
+			continue
+		}
+
+		pendingTransfers = append(pendingTransfers, transfer)
+	}
+
 	if len(pendingTransfers) == 0 {
-		return &types.CreatePendingTransfersResponse{}, nil
+		return nil
 	}
 
-	if _, nextSecondaryKeyAssigned := s.signer.GetNextKeyID(ctx, chain, tss.SecondaryKey); nextSecondaryKeyAssigned {
-		return nil, fmt.Errorf("next %s key already assigned for chain %s, rotate key first", tss.SecondaryKey.SimpleString(), chain.Name)
+	if _, nextSecondaryKeyAssigned := s.GetNextKeyID(ctx, chain, tss.SecondaryKey); nextSecondaryKeyAssigned {
+		return fmt.Errorf("next %s key already assigned for chain %s, rotate key first", tss.SecondaryKey.SimpleString(), chain.Name)
 	}
 
-	secondaryKeyID, ok := s.signer.GetCurrentKeyID(ctx, chain, tss.SecondaryKey)
+	secondaryKeyID, ok := s.GetCurrentKeyID(ctx, chain, tss.SecondaryKey)
 	if !ok {
-		return nil, fmt.Errorf("no %s key for chain %s found", tss.SecondaryKey.SimpleString(), chain.Name)
+		return fmt.Errorf("no %s key for chain %s found", tss.SecondaryKey.SimpleString(), chain.Name)
 	}
 
 	getRecipientAndAsset := func(transfer nexus.CrossChainTransfer) string {
 		return fmt.Sprintf("%s-%s", transfer.Recipient.Address, transfer.Asset.Denom)
 	}
 	transfers := nexus.MergeTransfersBy(pendingTransfers, getRecipientAndAsset)
+	commandIDs := make(map[string]types.CommandID, len(transfers))
 
 	for _, transfer := range transfers {
 		token := keeper.GetERC20TokenByAsset(ctx, transfer.Asset.Denom)
 		cmd, err := token.CreateMintCommand(secondaryKeyID, transfer)
 
 		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed create mint-token command for transfer %d", transfer.ID)
+			return sdkerrors.Wrapf(err, "failed create mint-token command for transfer %d", transfer.ID)
 		}
 
-		s.Logger(ctx).Info(fmt.Sprintf("storing data for mint command %s", cmd.ID.Hex()))
+		k.Logger(ctx).Info(fmt.Sprintf("storing data for mint command %s", cmd.ID.Hex()))
 
 		if err := keeper.EnqueueCommand(ctx, cmd); err != nil {
-			return nil, err
+			return err
 		}
+		commandIDs[getRecipientAndAsset(transfer)] = cmd.ID
 	}
 
 	for _, pendingTransfer := range pendingTransfers {
-		s.nexus.ArchivePendingTransfer(ctx, pendingTransfer)
+		n.ArchivePendingTransfer(ctx, pendingTransfer, commandIDs[getRecipientAndAsset(pendingTransfer)].Hex())
 	}
 
-	return &types.CreatePendingTransfersResponse{}, nil
+	return nil
 }
 
 func (s msgServer) createTransferKeyCommand(ctx sdk.Context, transferKeyType types.TransferKeyType, chainStr string, nextKeyID tss.KeyID) (types.Command, error) {
@@ -1272,7 +1358,7 @@ func (s msgServer) createTransferKeyCommand(ctx sdk.Context, transferKeyType typ
 		return types.Command{}, err
 	}
 
-	chainID := s.getChainID(ctx, chainStr)
+	chainID := getChainID(ctx, s, chainStr)
 	if chainID == nil {
 		return types.Command{}, fmt.Errorf("could not find chain ID for '%s'", chainStr)
 	}
@@ -1394,23 +1480,44 @@ func (s msgServer) SignCommands(c context.Context, req *types.SignCommandsReques
 		return nil, err
 	}
 
-	chainID := s.getChainID(ctx, req.Chain)
+	batchedCommands, err := SignNextCommandBatch(ctx, s, s.signer, s.snapshotter, s.voter, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	batchedCommandsIDHex := hex.EncodeToString(batchedCommands.GetID())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChain, req.Chain),
+			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyBatchedCommandsID, batchedCommandsIDHex),
+		),
+	)
+
+	return &types.SignCommandsResponse{BatchedCommandsID: batchedCommands.GetID()}, nil
+}
+
+// SignNextCommandBatch creates a new batch from the queued commands of the given chain and starts signing it
+func SignNextCommandBatch(ctx sdk.Context, k types.BaseKeeper, s types.Signer, snapshotter types.Snapshotter, voter types.InitPoller, chain nexus.Chain) (types.CommandBatch, error) {
+	chainID := getChainID(ctx, k, chain.Name)
 	if chainID == nil {
-		return nil, fmt.Errorf("could not find chain ID for '%s'", req.Chain)
+		return types.CommandBatch{}, fmt.Errorf("could not find chain ID for '%s'", chain.Name)
 	}
 
-	keeper := s.ForChain(chain.Name)
+	keeper := k.ForChain(chain.Name)
 	id, err := keeper.CreateNewBatchToSign(ctx)
 	if err != nil {
-		return nil, err
+		return types.CommandBatch{}, err
 	}
 
 	// if no error was thrown above, the batch exists
 	batchedCommands := keeper.GetBatchByID(ctx, id)
 
-	counter, ok := s.signer.GetSnapshotCounterForKeyID(ctx, batchedCommands.GetKeyID())
+	counter, ok := s.GetSnapshotCounterForKeyID(ctx, batchedCommands.GetKeyID())
 	if !ok {
-		return nil, fmt.Errorf("no snapshot counter for key ID %s registered", batchedCommands.GetKeyID())
+		return types.CommandBatch{}, fmt.Errorf("no snapshot counter for key ID %s registered", batchedCommands.GetKeyID())
 	}
 
 	sigMetadata := types.SigMetadata{
@@ -1418,30 +1525,36 @@ func (s msgServer) SignCommands(c context.Context, req *types.SignCommandsReques
 		Chain: chain.Name,
 	}
 
-	batchedCommandsIDHex := hex.EncodeToString(batchedCommands.GetID())
-	err = s.signer.StartSign(ctx, tss.SignInfo{
+	// batches that hand the gateway over to a new key must not wait behind regular batches
+	keyManagement := false
+	for _, commandID := range batchedCommands.GetCommandIDs() {
+		if cmd, ok := keeper.GetCommand(ctx, commandID); ok && cmd.IsKeyTransfer() {
+			keyManagement = true
+			break
+		}
+	}
+
+	priority := tss.SignPriority_Normal
+	if keyManagement {
+		priority = tss.SignPriority_High
+	}
+
+	err = s.StartSign(ctx, tss.SignInfo{
 		KeyID:           batchedCommands.GetKeyID(),
-		SigID:           batchedCommandsIDHex,
+		SigID:           hex.EncodeToString(batchedCommands.GetID()),
 		Msg:             batchedCommands.GetSigHash().Bytes(),
 		SnapshotCounter: counter,
 		RequestModule:   types.ModuleName,
 		Metadata:        string(types.ModuleCdc.MustMarshalJSON(&sigMetadata)),
-	}, s.snapshotter, s.voter)
+		Priority:        priority,
+		RequestChain:    chain.Name,
+		KeyManagement:   keyManagement,
+	}, snapshotter, voter)
 	if err != nil {
-		return nil, err
+		return types.CommandBatch{}, err
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyChain, req.Chain),
-			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender.String()),
-			sdk.NewAttribute(types.AttributeKeyBatchedCommandsID, batchedCommandsIDHex),
-		),
-	)
-
-	return &types.SignCommandsResponse{BatchedCommandsID: batchedCommands.GetID()}, nil
+	return batchedCommands, nil
 }
 
 func (s msgServer) AddChain(c context.Context, req *types.AddChainRequest) (*types.AddChainResponse, error) {
@@ -1474,10 +1587,10 @@ func (s msgServer) AddChain(c context.Context, req *types.AddChainRequest) (*typ
 	return &types.AddChainResponse{}, nil
 }
 
-func (s msgServer) getChainID(ctx sdk.Context, chain string) (chainID *big.Int) {
-	for _, p := range s.GetParams(ctx) {
+func getChainID(ctx sdk.Context, k types.BaseKeeper, chain string) (chainID *big.Int) {
+	for _, p := range k.GetParams(ctx) {
 		if strings.EqualFold(p.Chain, chain) {
-			chainID = s.ForChain(chain).GetChainIDByNetwork(ctx, p.Network)
+			chainID = k.ForChain(chain).GetChainIDByNetwork(ctx, p.Network)
 		}
 	}
 
@@ -1198,6 +1198,164 @@ func TestHandleMsgConfirmDeposit(t *testing.T) {
 	}).Repeat(repeats))
 }
 
+func TestHandleMsgReportDeposit(t *testing.T) {
+	var (
+		ctx       sdk.Context
+		chaink    *mock.ChainKeeperMock
+		v         *mock.VoterMock
+		n         *mock.NexusMock
+		msg       *types.ReportDepositRequest
+		server    types.MsgServiceServer
+		validator sdk.ValAddress
+	)
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{}, false, log.TestingLogger())
+		validator = rand.ValAddr()
+
+		basek := &mock.BaseKeeperMock{
+			ForChainFunc: func(chain string) types.ChainKeeper {
+				if strings.EqualFold(chain, evmChain) {
+					return chaink
+				}
+				return nil
+			},
+		}
+		chaink = &mock.ChainKeeperMock{
+			GetDepositFunc: func(sdk.Context, common.Hash, common.Address) (types.ERC20Deposit, types.DepositState, bool) {
+				return types.ERC20Deposit{}, 0, false
+			},
+			GetPendingDepositFunc: func(sdk.Context, vote.PollKey) (types.ERC20Deposit, bool) {
+				return types.ERC20Deposit{}, false
+			},
+			GetBurnerInfoFunc: func(sdk.Context, common.Address) *types.BurnerInfo {
+				return &types.BurnerInfo{
+					TokenAddress: types.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+					Symbol:       rand.StrBetween(5, 10),
+					Salt:         types.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+				}
+			},
+			GetRevoteLockingPeriodFunc:        func(sdk.Context) (int64, bool) { return rand.PosI64(), true },
+			SetPendingDepositFunc:             func(sdk.Context, vote.PollKey, *types.ERC20Deposit) {},
+			GetRequiredConfirmationHeightFunc: func(sdk.Context) (uint64, bool) { return mathRand.Uint64(), true },
+			GetVotingThresholdFunc: func(sdk.Context) (utils.Threshold, bool) {
+				return utils.Threshold{Numerator: 15, Denominator: 100}, true
+			},
+			GetMinVoterCountFunc: func(sdk.Context) (int64, bool) { return 15, true },
+		}
+		v = &mock.VoterMock{
+			InitializePollFunc: func(sdk.Context, vote.PollKey, []sdk.ValAddress, ...vote.PollProperty) error { return nil },
+		}
+		n = &mock.NexusMock{
+			GetChainMaintainersFunc: func(sdk.Context, nexus.Chain) []sdk.ValAddress {
+				return []sdk.ValAddress{rand.ValAddr(), validator}
+			},
+			IsChainActivatedFunc: func(sdk.Context, nexus.Chain) bool { return true },
+			GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) {
+				if strings.EqualFold(chain, evmChain) {
+					return exported.Ethereum, true
+				}
+				return nexus.Chain{}, false
+			},
+		}
+
+		msg = types.NewReportDepositRequest(
+			rand.AccAddr(),
+			evmChain,
+			common.BytesToHash(rand.Bytes(common.HashLength)),
+			sdk.NewUint(mathRand.Uint64()),
+			common.BytesToAddress(rand.Bytes(common.AddressLength)),
+		)
+		server = keeper.NewMsgServerImpl(basek, &mock.TSSMock{}, n, &mock.SignerMock{}, v, &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return validator },
+		})
+	}
+
+	repeats := 20
+	t.Run("should start the deposit confirmation", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.ReportDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		assert.Len(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool { return event.Type == types.EventTypeDepositConfirmation }), 1)
+		assert.Len(t, v.InitializePollCalls(), 1)
+		assert.Equal(t, v.InitializePollCalls()[0].Key, chaink.SetPendingDepositCalls()[0].Key)
+	}).Repeat(repeats))
+
+	t.Run("should use the same poll as a manual deposit confirmation", testutils.Func(func(t *testing.T) {
+		setup()
+
+		_, err := server.ReportDeposit(sdk.WrapSDKContext(ctx), msg)
+		assert.NoError(t, err)
+
+		_, err = server.ConfirmDeposit(sdk.WrapSDKContext(ctx), types.NewConfirmDepositRequest(rand.AccAddr(), msg.Chain, common.Hash(msg.TxID), msg.Amount, common.Address(msg.BurnerAddress)))
+		assert.NoError(t, err)
+
+		assert.Equal(t, v.InitializePollCalls()[0].Key, v.InitializePollCalls()[1].Key)
+	}).Repeat(repeats))
+
+	t.Run("should ignore deposits that are already pending", testutils.Func(func(t *testing.T) {
+		setup()
+		chaink.GetPendingDepositFunc = func(sdk.Context, vote.PollKey) (types.ERC20Deposit, bool) {
+			return types.ERC20Deposit{}, true
+		}
+
+		_, err := server.ReportDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		assert.Empty(t, v.InitializePollCalls())
+		assert.Empty(t, ctx.EventManager().ABCIEvents())
+	}).Repeat(repeats))
+
+	t.Run("should ignore deposits that are already confirmed", testutils.Func(func(t *testing.T) {
+		setup()
+		chaink.GetDepositFunc = func(sdk.Context, common.Hash, common.Address) (types.ERC20Deposit, types.DepositState, bool) {
+			return types.ERC20Deposit{}, types.CONFIRMED, true
+		}
+
+		_, err := server.ReportDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.NoError(t, err)
+		assert.Empty(t, v.InitializePollCalls())
+	}).Repeat(repeats))
+
+	t.Run("should reject reports from accounts that are not validator proxies", testutils.Func(func(t *testing.T) {
+		setup()
+		validator = nil
+
+		_, err := server.ReportDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+
+	t.Run("should reject reports from validators that do not maintain the chain", testutils.Func(func(t *testing.T) {
+		setup()
+		n.GetChainMaintainersFunc = func(sdk.Context, nexus.Chain) []sdk.ValAddress { return []sdk.ValAddress{rand.ValAddr()} }
+
+		_, err := server.ReportDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+
+	t.Run("should reject deposits to unknown burner addresses", testutils.Func(func(t *testing.T) {
+		setup()
+		chaink.GetBurnerInfoFunc = func(sdk.Context, common.Address) *types.BurnerInfo { return nil }
+
+		_, err := server.ReportDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+
+	t.Run("should reject unknown chains", testutils.Func(func(t *testing.T) {
+		setup()
+		msg.Chain = rand.StrBetween(5, 20)
+
+		_, err := server.ReportDeposit(sdk.WrapSDKContext(ctx), msg)
+
+		assert.Error(t, err)
+	}).Repeat(repeats))
+}
+
 func TestHandleMsgCreateDeployToken(t *testing.T) {
 	var (
 		ctx    sdk.Context
@@ -1377,6 +1535,89 @@ func createSignedTx() *evmTypes.Transaction {
 	return sign(evmTypes.NewTransaction(nonce, contractAddr, value, gasLimit, gasPrice, data))
 }
 
+func TestCreatePendingTransferCommands(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		baseKeeper  *mock.BaseKeeperMock
+		chainKeeper *mock.ChainKeeperMock
+		nexusKeeper *mock.NexusMock
+		signer      *mock.SignerMock
+		transfers   []nexus.CrossChainTransfer
+		sourceChain nexus.Chain
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		asset := rand.Strings(5, 10).WithAlphabet([]rune("abcdefghijklmnopqrstuvwxyz")).Next()
+		sourceChain = nexus.Chain{Name: rand.StrBetween(5, 10), NativeAsset: rand.StrBetween(5, 10), SupportsForeignAssets: true}
+
+		transfers = make([]nexus.CrossChainTransfer, rand.I64Between(1, 20))
+		for i := range transfers {
+			transfers[i] = nexus.CrossChainTransfer{
+				Sender:    nexus.CrossChainAddress{Chain: sourceChain, Address: rand.StrBetween(10, 20)},
+				Recipient: nexus.CrossChainAddress{Chain: exported.Ethereum, Address: randomAddress().Hex()},
+				Asset:     sdk.NewInt64Coin(asset, rand.PosI64()),
+				ID:        uint64(i),
+			}
+		}
+
+		chainKeeper = &mock.ChainKeeperMock{
+			GetERC20TokenByAssetFunc: func(sdk.Context, string) types.ERC20Token {
+				return createMockConfirmedERC20Token(asset, types.Address(randomAddress()), createDetails(asset, asset))
+			},
+			EnqueueCommandFunc: func(sdk.Context, types.Command) error { return nil },
+		}
+		baseKeeper = &mock.BaseKeeperMock{
+			ForChainFunc: func(string) types.ChainKeeper { return chainKeeper },
+			LoggerFunc:   func(sdk.Context) log.Logger { return log.TestingLogger() },
+		}
+		nexusKeeper = &mock.NexusMock{
+			GetTransfersForChainFunc: func(sdk.Context, nexus.Chain, nexus.TransferState) []nexus.CrossChainTransfer {
+				return transfers
+			},
+			IsTransferFrozenFunc:       func(sdk.Context, nexus.Chain, string) bool { return false },
+			ArchivePendingTransferFunc: func(sdk.Context, nexus.CrossChainTransfer, string) {},
+		}
+		signer = &mock.SignerMock{
+			GetNextKeyIDFunc:    func(sdk.Context, nexus.Chain, tss.KeyRole) (tss.KeyID, bool) { return "", false },
+			GetCurrentKeyIDFunc: func(sdk.Context, nexus.Chain, tss.KeyRole) (tss.KeyID, bool) { return tssTestUtils.RandKeyID(), true },
+		}
+	}
+
+	repeatCount := 20
+
+	t.Run("should create mint commands for all pending transfers", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, keeper.CreatePendingTransferCommands(ctx, baseKeeper, nexusKeeper, signer, exported.Ethereum))
+		assert.Len(t, chainKeeper.EnqueueCommandCalls(), len(transfers))
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), len(transfers))
+	}).Repeat(repeatCount))
+
+	t.Run("should keep transfers to a frozen destination chain pending", testutils.Func(func(t *testing.T) {
+		setup()
+		nexusKeeper.IsTransferFrozenFunc = func(_ sdk.Context, chain nexus.Chain, _ string) bool { return chain.Name == exported.Ethereum.Name }
+
+		assert.NoError(t, keeper.CreatePendingTransferCommands(ctx, baseKeeper, nexusKeeper, signer, exported.Ethereum))
+		assert.Len(t, chainKeeper.EnqueueCommandCalls(), 0)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 0)
+	}).Repeat(repeatCount))
+
+	t.Run("should keep transfers from a frozen source chain pending", testutils.Func(func(t *testing.T) {
+		setup()
+		nexusKeeper.IsTransferFrozenFunc = func(_ sdk.Context, chain nexus.Chain, _ string) bool { return chain.Name == sourceChain.Name }
+
+		unfrozen := transfers[len(transfers)-1]
+		unfrozen.Sender.Chain = btc.Bitcoin
+		transfers[len(transfers)-1] = unfrozen
+
+		assert.NoError(t, keeper.CreatePendingTransferCommands(ctx, baseKeeper, nexusKeeper, signer, exported.Ethereum))
+		assert.Len(t, chainKeeper.EnqueueCommandCalls(), 1)
+		assert.Len(t, nexusKeeper.ArchivePendingTransferCalls(), 1)
+		assert.Equal(t, unfrozen, nexusKeeper.ArchivePendingTransferCalls()[0].Transfer)
+	}).Repeat(repeatCount))
+}
+
 func newKeeper(ctx sdk.Context, chain string, confHeight int64) types.BaseKeeper {
 	encCfg := app.MakeEncodingConfig()
 	paramsK := paramsKeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("subspace"), sdk.NewKVStoreKey("tsubspace"))
@@ -204,21 +204,30 @@ func QueryAddressByKeyRole(ctx sdk.Context, s types.Signer, n types.Nexus, chain
 
 // QueryAddressByKeyID returns the address of the given key ID
 func QueryAddressByKeyID(ctx sdk.Context, s types.Signer, n types.Nexus, chainName string, keyID tss.KeyID) ([]byte, error) {
+	resp, err := addressByKeyID(ctx, s, n, chainName, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Marshal()
+}
+
+func addressByKeyID(ctx sdk.Context, s types.Signer, n types.Nexus, chainName string, keyID tss.KeyID) (types.QueryAddressResponse, error) {
 	chain, ok := n.GetChain(ctx, chainName)
 	if !ok {
-		return nil, sdkerrors.Wrapf(types.ErrEVM, "%s is not a registered chain", chainName)
+		return types.QueryAddressResponse{}, sdkerrors.Wrapf(types.ErrEVM, "%s is not a registered chain", chainName)
 	}
 
 	key, ok := s.GetKey(ctx, keyID)
 	if !ok {
-		return nil, sdkerrors.Wrapf(types.ErrEVM, "threshold key %s not found", keyID)
+		return types.QueryAddressResponse{}, sdkerrors.Wrapf(types.ErrEVM, "threshold key %s not found", keyID)
 	}
 
 	switch chain.KeyType {
 	case tss.Multisig:
 		multisigPubKey, err := key.GetMultisigPubKey()
 		if err != nil {
-			return nil, sdkerrors.Wrap(types.ErrEVM, err.Error())
+			return types.QueryAddressResponse{}, sdkerrors.Wrap(types.ErrEVM, err.Error())
 		}
 
 		addressStrs := make([]string, len(multisigPubKey))
@@ -228,27 +237,23 @@ func QueryAddressByKeyID(ctx sdk.Context, s types.Signer, n types.Nexus, chainNa
 
 		threshold := uint32(key.GetMultisigKey().Threshold)
 
-		resp := types.QueryAddressResponse{
+		return types.QueryAddressResponse{
 			Address: &types.QueryAddressResponse_MultisigAddresses_{MultisigAddresses: &types.QueryAddressResponse_MultisigAddresses{Addresses: addressStrs, Threshold: threshold}},
 			KeyID:   keyID,
-		}
-
-		return resp.Marshal()
+		}, nil
 	case tss.Threshold:
 		pk, err := key.GetECDSAPubKey()
 		if err != nil {
-			return nil, sdkerrors.Wrap(types.ErrEVM, err.Error())
+			return types.QueryAddressResponse{}, sdkerrors.Wrap(types.ErrEVM, err.Error())
 		}
 
 		address := crypto.PubkeyToAddress(pk)
-		resp := types.QueryAddressResponse{
+		return types.QueryAddressResponse{
 			Address: &types.QueryAddressResponse_ThresholdAddress_{ThresholdAddress: &types.QueryAddressResponse_ThresholdAddress{Address: address.Hex()}},
 			KeyID:   key.ID,
-		}
-
-		return resp.Marshal()
+		}, nil
 	default:
-		return nil, sdkerrors.Wrapf(types.ErrEVM, "unknown key type %s of chain %s", chain.KeyType, chain.Name)
+		return types.QueryAddressResponse{}, sdkerrors.Wrapf(types.ErrEVM, "unknown key type %s of chain %s", chain.KeyType, chain.Name)
 	}
 }
 
@@ -263,27 +268,36 @@ func QueryDepositAddress(ctx sdk.Context, k types.ChainKeeper, n types.Nexus, da
 		return nil, sdkerrors.Wrap(types.ErrEVM, "could not parse the recipient")
 	}
 
+	depositAddr, err := depositAddress(ctx, k, n, depositChain, params.Address, params.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	return depositAddr.Bytes(), nil
+}
+
+func depositAddress(ctx sdk.Context, k types.ChainKeeper, n types.Nexus, depositChain nexus.Chain, recipientAddress string, asset string) (common.Address, error) {
 	gatewayAddr, ok := k.GetGatewayAddress(ctx)
 	if !ok {
-		return nil, sdkerrors.Wrap(types.ErrEVM, "axelar gateway address not set")
+		return common.Address{}, sdkerrors.Wrap(types.ErrEVM, "axelar gateway address not set")
 	}
 
-	token := k.GetERC20TokenByAsset(ctx, params.Asset)
+	token := k.GetERC20TokenByAsset(ctx, asset)
 	if !token.Is(types.Confirmed) {
-		return nil, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("token for asset '%s' not confirmed", params.Asset))
+		return common.Address{}, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("token for asset '%s' not confirmed", asset))
 	}
 
-	depositAddr, _, err := k.GetBurnerAddressAndSalt(ctx, token.GetAddress(), params.Address, gatewayAddr)
+	depositAddr, _, err := k.GetBurnerAddressAndSalt(ctx, token.GetAddress(), recipientAddress, gatewayAddr)
 	if err != nil {
-		return nil, err
+		return common.Address{}, err
 	}
 
 	_, ok = n.GetRecipient(ctx, nexus.CrossChainAddress{Chain: depositChain, Address: depositAddr.String()})
 	if !ok {
-		return nil, sdkerrors.Wrap(types.ErrEVM, "deposit address is not linked with recipient address")
+		return common.Address{}, sdkerrors.Wrap(types.ErrEVM, "deposit address is not linked with recipient address")
 	}
 
-	return depositAddr.Bytes(), nil
+	return depositAddr, nil
 }
 
 func queryNextMasterAddress(ctx sdk.Context, s types.Signer, n types.Nexus, chainName string) ([]byte, error) {
@@ -359,6 +373,15 @@ func QueryDepositState(ctx sdk.Context, k types.ChainKeeper, n types.Nexus, data
 		return nil, sdkerrors.Wrap(types.ErrEVM, "could not unmarshal parameters")
 	}
 
+	depositState, err := getDepositState(ctx, k, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&depositState)
+}
+
+func getDepositState(ctx sdk.Context, k types.ChainKeeper, params types.QueryDepositStateParams) (types.QueryDepositStateResponse, error) {
 	pollKey := vote.NewPollKey(types.ModuleName, fmt.Sprintf("%s_%s_%d", params.TxID.Hex(), params.BurnerAddress.Hex(), params.Amount))
 	_, isPending := k.GetPendingDeposit(ctx, pollKey)
 	_, state, ok := k.GetDeposit(ctx, common.Hash(params.TxID), common.Address(params.BurnerAddress))
@@ -374,10 +397,10 @@ func QueryDepositState(ctx sdk.Context, k types.ChainKeeper, n types.Nexus, data
 	case state == types.BURNED:
 		depositState = types.QueryDepositStateResponse{Status: types.DepositStatus_Burned, Log: "deposit has been transferred to the destination chain"}
 	default:
-		return nil, sdkerrors.Wrap(types.ErrEVM, "deposit is in an unexpected state")
+		return types.QueryDepositStateResponse{}, sdkerrors.Wrap(types.ErrEVM, "deposit is in an unexpected state")
 	}
 
-	return types.ModuleCdc.MarshalLengthPrefixed(&depositState)
+	return depositState, nil
 }
 
 func queryBytecode(ctx sdk.Context, k types.ChainKeeper, s types.Signer, n types.Nexus, contract string) ([]byte, error) {
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	gogoprototypes "github.com/gogo/protobuf/types"
 
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
@@ -20,6 +21,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&VoteConfirmTransferKeyRequest{}, "evm/VoteConfirmTransferKey", nil)
 	cdc.RegisterConcrete(&ConfirmTokenRequest{}, "evm/ConfirmToken", nil)
 	cdc.RegisterConcrete(&ConfirmDepositRequest{}, "evm/ConfirmDeposit", nil)
+	cdc.RegisterConcrete(&ReportDepositRequest{}, "evm/ReportDeposit", nil)
 	cdc.RegisterConcrete(&ConfirmChainRequest{}, "evm/ConfirmChain", nil)
 	cdc.RegisterConcrete(&ConfirmGatewayDeploymentRequest{}, "evm/ConfirmGatewayDeployment", nil)
 	cdc.RegisterConcrete(&ConfirmTransferKeyRequest{}, "evm/ConfirmTransferKey", nil)
@@ -30,6 +32,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&CreateTransferOperatorshipRequest{}, "evm/CreateTransferOperatorship", nil)
 	cdc.RegisterConcrete(&SignCommandsRequest{}, "evm/SignCommands", nil)
 	cdc.RegisterConcrete(&AddChainRequest{}, "evm/AddChainRequest", nil)
+	cdc.RegisterConcrete(&AddEVMChainProposal{}, "evm/AddEVMChainProposal", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -43,6 +46,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&VoteConfirmTransferKeyRequest{},
 		&ConfirmTokenRequest{},
 		&ConfirmDepositRequest{},
+		&ReportDepositRequest{},
 		&ConfirmChainRequest{},
 		&ConfirmGatewayDeploymentRequest{},
 		&ConfirmTransferKeyRequest{},
@@ -54,6 +58,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&SignCommandsRequest{},
 		&AddChainRequest{},
 	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddEVMChainProposal{},
+	)
 	registry.RegisterImplementations((*codec.ProtoMarshaler)(nil),
 		&gogoprototypes.BoolValue{},
 	)
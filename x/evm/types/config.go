@@ -5,13 +5,37 @@ type EVMConfig struct {
 	Name       string `mapstructure:"name"`
 	RPCAddr    string `mapstructure:"rpc_addr"`
 	WithBridge bool   `mapstructure:"start-with-bridge"`
+	// additional endpoints of the same chain used for failover or, with a quorum above one, to cross-check results
+	RPCAddrs       []string `mapstructure:"rpc_addrs"`
+	RPCQuorum      int      `mapstructure:"rpc_quorum"`
+	RPCMaxBlockLag uint64   `mapstructure:"rpc_max_block_lag"`
+	// scan the chain for deposits to burner addresses and report them, so they are confirmed without anyone submitting them
+	DetectDeposits bool `mapstructure:"detect_deposits"`
 }
 
 // DefaultConfig returns a configuration populated with default values
 func DefaultConfig() []EVMConfig {
 	return []EVMConfig{{
-		Name:       "Ethereum",
-		RPCAddr:    "http://127.0.0.1:7545",
-		WithBridge: true,
+		Name:           "Ethereum",
+		RPCAddr:        "http://127.0.0.1:7545",
+		WithBridge:     true,
+		RPCQuorum:      1,
+		RPCMaxBlockLag: 10,
 	}}
 }
+
+// RPCEndpoints returns the primary rpc address followed by all additional ones, without empty or duplicate entries
+func (c EVMConfig) RPCEndpoints() []string {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, addr := range append([]string{c.RPCAddr}, c.RPCAddrs...) {
+		if addr == "" || seen[addr] {
+			continue
+		}
+
+		seen[addr] = true
+		unique = append(unique, addr)
+	}
+
+	return unique
+}
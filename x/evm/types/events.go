@@ -9,6 +9,7 @@ const (
 	EventTypeTokenConfirmation             = "tokenConfirmation"
 	EventTypeTransferKeyConfirmation       = "transferKeyConfirmation"
 	EventTypeLink                          = "link"
+	EventTypeAutoBatch                     = "autoBatch"
 )
 
 // Event attribute keys
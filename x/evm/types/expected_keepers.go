@@ -25,6 +25,9 @@ import (
 type BaseKeeper interface {
 	Logger(ctx sdk.Context) log.Logger
 
+	InitGenesis(ctx sdk.Context, genState *GenesisState)
+	ExportGenesis(ctx sdk.Context) *GenesisState
+
 	GetParams(ctx sdk.Context) []Params
 	SetParams(ctx sdk.Context, params ...Params)
 
@@ -53,6 +56,7 @@ type ChainKeeper interface {
 	GetGatewayAddress(ctx sdk.Context) (common.Address, bool)
 	GetDeposit(ctx sdk.Context, txID common.Hash, burnerAddr common.Address) (ERC20Deposit, DepositState, bool)
 	GetBurnerInfo(ctx sdk.Context, address common.Address) *BurnerInfo
+	GetBurners(ctx sdk.Context) []ChainRecord_Burner
 	SetPendingDeposit(ctx sdk.Context, key vote.PollKey, deposit *ERC20Deposit)
 	GetBurnerAddressAndSalt(ctx sdk.Context, tokenAddr Address, recipient string, gatewayAddr common.Address) (common.Address, common.Hash, error)
 	SetBurnerInfo(ctx sdk.Context, burnerAddr common.Address, burnerInfo *BurnerInfo)
@@ -80,7 +84,14 @@ type ChainKeeper interface {
 	GetERC20TokenBySymbol(ctx sdk.Context, symbol string) ERC20Token
 
 	EnqueueCommand(ctx sdk.Context, cmd Command) error
+	GetCommand(ctx sdk.Context, id CommandID) (Command, bool)
 	CreateNewBatchToSign(ctx sdk.Context) ([]byte, error)
+	GetQueuedCommands(ctx sdk.Context) []Command
+	GetOldestQueuedCommandHeight(ctx sdk.Context) (int64, bool)
+	GetCommandsGasLimit(ctx sdk.Context) uint32
+	GetAutoBatchConfig(ctx sdk.Context) (AutoBatchConfig, bool)
+	GetAutoBatchRetryHeight(ctx sdk.Context) int64
+	SetAutoBatchRetryHeight(ctx sdk.Context, height int64)
 	GetLatestCommandBatch(ctx sdk.Context) CommandBatch
 	GetBatchByID(ctx sdk.Context, id []byte) CommandBatch
 }
@@ -108,9 +119,10 @@ type Voter interface {
 type Nexus interface {
 	LinkAddresses(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress)
 	GetRecipient(ctx sdk.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool)
-	EnqueueForTransfer(ctx sdk.Context, sender nexus.CrossChainAddress, amount sdk.Coin, feeRate sdk.Dec) error
+	EnqueueForTransfer(ctx sdk.Context, sender nexus.CrossChainAddress, amount sdk.Coin, feeRate sdk.Dec, depositTxID string) error
 	GetTransfersForChain(ctx sdk.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer
-	ArchivePendingTransfer(ctx sdk.Context, transfer nexus.CrossChainTransfer)
+	ArchivePendingTransfer(ctx sdk.Context, transfer nexus.CrossChainTransfer, executionID string)
+	IsTransferFrozen(ctx sdk.Context, chain nexus.Chain, asset string) bool
 	SetChain(ctx sdk.Context, chain nexus.Chain)
 	GetChains(ctx sdk.Context) []nexus.Chain
 	GetChain(ctx sdk.Context, chain string) (nexus.Chain, bool)
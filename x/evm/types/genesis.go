@@ -3,21 +3,190 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// NewGenesisState is the constructor for GenesisState
+func NewGenesisState(params []Params, chains []ChainRecord) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Chains: chains,
+	}
+}
+
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{DefaultParams()}
+	return NewGenesisState(DefaultParams(), []ChainRecord{})
 }
 
-// Validate calidates the genesis state
+// Validate validates the genesis state
 func (m GenesisState) Validate() error {
+	if err := m.validate(); err != nil {
+		return sdkerrors.Wrapf(err, "genesis state for module %s is invalid", ModuleName)
+	}
+
+	return nil
+}
+
+func (m GenesisState) validate() error {
+	chains := make(map[string]bool)
 	for _, p := range m.Params {
 		if err := p.Validate(); err != nil {
-			return sdkerrors.Wrap(err, fmt.Sprintf("genesis m for module %s is invalid", ModuleName))
+			return err
+		}
+
+		if chains[strings.ToLower(p.Chain)] {
+			return fmt.Errorf("duplicate params for chain %s", p.Chain)
+		}
+		chains[strings.ToLower(p.Chain)] = true
+	}
+
+	seenChains := make(map[string]bool)
+	for _, chain := range m.Chains {
+		if err := chain.Validate(); err != nil {
+			return err
+		}
+
+		if !chains[strings.ToLower(chain.Name)] {
+			return fmt.Errorf("no params found for chain %s", chain.Name)
+		}
+
+		if seenChains[strings.ToLower(chain.Name)] {
+			return fmt.Errorf("duplicate state for chain %s", chain.Name)
+		}
+		seenChains[strings.ToLower(chain.Name)] = true
+	}
+
+	return nil
+}
+
+// Validate returns an error if the chain record is not valid; nil otherwise
+func (m ChainRecord) Validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("missing chain name")
+	}
+
+	if err := m.validate(); err != nil {
+		return sdkerrors.Wrapf(err, "invalid state for chain %s", m.Name)
+	}
+
+	return nil
+}
+
+func (m ChainRecord) validate() error {
+	if m.PendingChain != nil {
+		if err := m.PendingChain.Validate(); err != nil {
+			return err
+		}
+
+		if !strings.EqualFold(m.PendingChain.Name, m.Name) {
+			return fmt.Errorf("pending chain %s does not match", m.PendingChain.Name)
+		}
+	}
+
+	if m.Gateway != nil && m.Gateway.Status == GatewayStatusNone {
+		return fmt.Errorf("gateway status must be set")
+	}
+
+	for _, tx := range m.UnsignedTxs {
+		if tx.TxID == "" {
+			return fmt.Errorf("missing unsigned tx ID")
+		}
+	}
+
+	assets := make(map[string]bool)
+	symbols := make(map[string]bool)
+	for _, token := range m.Tokens {
+		if token.Asset == "" {
+			return fmt.Errorf("missing token asset")
+		}
+
+		if err := token.Details.Validate(); err != nil {
+			return err
+		}
+
+		if assets[strings.ToLower(token.Asset)] {
+			return fmt.Errorf("duplicate token for asset %s", token.Asset)
+		}
+		assets[strings.ToLower(token.Asset)] = true
+
+		if symbols[strings.ToLower(token.Details.Symbol)] {
+			return fmt.Errorf("duplicate token for symbol %s", token.Details.Symbol)
+		}
+		symbols[strings.ToLower(token.Details.Symbol)] = true
+	}
+
+	for _, pending := range m.PendingDeposits {
+		if err := pending.PollKey.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, deposits := range [][]ERC20Deposit{m.ConfirmedDeposits, m.BurnedDeposits} {
+		for _, deposit := range deposits {
+			if deposit.Asset == "" {
+				return fmt.Errorf("missing asset for deposit %s", deposit.TxID.Hex())
+			}
+		}
+	}
+
+	commands := make(map[string]bool)
+	for _, command := range m.Commands {
+		if commands[command.ID.Hex()] {
+			return fmt.Errorf("duplicate command %s", command.ID.Hex())
+		}
+		commands[command.ID.Hex()] = true
+	}
+
+	batches := make(map[string]bool)
+	for _, batch := range m.CommandBatches {
+		if len(batch.ID) == 0 {
+			return fmt.Errorf("missing command batch ID")
+		}
+
+		for _, commandID := range batch.CommandIDs {
+			if !commands[commandID.Hex()] {
+				return fmt.Errorf("command batch %x refers to unknown command %s", batch.ID, commandID.Hex())
+			}
+		}
+
+		if batches[string(batch.ID)] {
+			return fmt.Errorf("duplicate command batch %x", batch.ID)
+		}
+		batches[string(batch.ID)] = true
+	}
+
+	for _, batchID := range [][]byte{m.UnsignedBatchID, m.LatestSignedBatchID} {
+		if len(batchID) > 0 && !batches[string(batchID)] {
+			return fmt.Errorf("unknown command batch %x", batchID)
+		}
+	}
+
+	if err := m.CommandQueue.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, item := range m.CommandQueue.Items {
+		commandID := strings.TrimPrefix(string(item.Key), "command_")
+		if !commands[commandID] {
+			return fmt.Errorf("command queue refers to unknown command %s", commandID)
+		}
+	}
+
+	for _, burner := range m.Burners {
+		if burner.Info.Asset == "" {
+			return fmt.Errorf("missing asset for burner address %s", burner.Address.Hex())
+		}
+	}
+
+	for _, transferKeys := range [][]ChainRecord_PollTransferKey{m.PendingTransferKeys, m.ArchivedTransferKeys} {
+		for _, transferKey := range transferKeys {
+			if err := transferKey.PollKey.Validate(); err != nil {
+				return err
+			}
 		}
 	}
 
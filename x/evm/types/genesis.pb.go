@@ -25,7 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState represents the genesis state
 type GenesisState struct {
-	Params []Params `protobuf:"bytes,1,rep,name=params,proto3" json:"params"`
+	Params []Params      `protobuf:"bytes,1,rep,name=params,proto3" json:"params"`
+	Chains []ChainRecord `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,20 +69,23 @@ func init() {
 func init() { proto.RegisterFile("evm/v1beta1/genesis.proto", fileDescriptor_b9e6f17f1815805e) }
 
 var fileDescriptor_b9e6f17f1815805e = []byte{
-	// 205 bytes of a gzipped FileDescriptorProto
+	// 242 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x2d, 0xcb, 0xd5,
 	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
 	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4e, 0x2d, 0xcb, 0xd5, 0x83, 0x4a, 0x49, 0x89, 0xa4,
 	0xe7, 0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0x29, 0x09, 0x64, 0xdd, 0x05, 0x89,
-	0x45, 0x89, 0xb9, 0x50, 0xcd, 0x4a, 0x8e, 0x5c, 0x3c, 0xee, 0x10, 0xd3, 0x82, 0x4b, 0x12, 0x4b,
-	0x52, 0x85, 0x0c, 0xb9, 0xd8, 0x20, 0xf2, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xc2, 0x7a,
-	0x48, 0xa6, 0xeb, 0x05, 0x80, 0xa5, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x2a, 0x74,
-	0xf2, 0x3b, 0xf1, 0x50, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
-	0x0c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x13, 0x2b, 0x52, 0x73,
-	0x12, 0x8b, 0xf2, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xa1, 0x3c, 0xdd, 0xe4, 0xfc, 0xa2, 0x54,
-	0xfd, 0x0a, 0x7d, 0x90, 0x0b, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x2e, 0x33, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x68, 0xd6, 0x9b, 0x58, 0xf3, 0x00, 0x00, 0x00,
+	0x45, 0x89, 0xb9, 0x50, 0xcd, 0x52, 0xe2, 0xc8, 0x32, 0x25, 0x95, 0x05, 0xa9, 0x50, 0x09, 0xa5,
+	0x4a, 0x2e, 0x1e, 0x77, 0x88, 0x35, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x86, 0x5c, 0x6c, 0x10,
+	0x8d, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xc2, 0x7a, 0x48, 0xd6, 0xea, 0x05, 0x80, 0xa5,
+	0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x2a, 0x14, 0x32, 0xe3, 0x62, 0x4b, 0xce, 0x48,
+	0xcc, 0xcc, 0x2b, 0x96, 0x60, 0x02, 0x6b, 0x91, 0x40, 0xd1, 0xe2, 0x0c, 0x92, 0x0a, 0x4a, 0x4d,
+	0xce, 0x2f, 0x4a, 0x81, 0xe9, 0x83, 0xa8, 0x76, 0xf2, 0x3b, 0xf1, 0x50, 0x8e, 0xe1, 0xc4, 0x23,
+	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
+	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
+	0x92, 0xf3, 0x73, 0xf5, 0x13, 0x2b, 0x52, 0x73, 0x12, 0x8b, 0xf2, 0x52, 0x4b, 0xca, 0xf3, 0x8b,
+	0xb2, 0xa1, 0x3c, 0xdd, 0xe4, 0xfc, 0xa2, 0x54, 0xfd, 0x0a, 0x7d, 0x90, 0xc7, 0xc0, 0x1e, 0x4a,
+	0x62, 0x03, 0xfb, 0xc8, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x71, 0xbe, 0xd3, 0xd8, 0x44, 0x01,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -104,6 +108,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Params) > 0 {
 		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -144,6 +162,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -216,6 +240,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, ChainRecord{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7,10 +7,10 @@ import (
 	"crypto/ecdsa"
 	utils "github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
-	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
-	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	exported1 "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	evmTypes "github.com/ethereum/go-ethereum/core/types"
@@ -106,13 +106,13 @@ var _ types.Voter = &VoterMock{}
 //
 // 		// make and configure a mocked types.Voter
 // 		mockedVoter := &VoterMock{
-// 			GetPollFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, pollKey vote.PollKey) vote.Poll {
+// 			GetPollFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, pollKey exported1.PollKey) exported1.Poll {
 // 				panic("mock out the GetPoll method")
 // 			},
-// 			InitializePollFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, voters []github_com_cosmos_cosmos_sdk_types.ValAddress, pollProperties ...vote.PollProperty) error {
+// 			InitializePollFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, voters []github_com_cosmos_cosmos_sdk_types.ValAddress, pollProperties ...exported1.PollProperty) error {
 // 				panic("mock out the InitializePoll method")
 // 			},
-// 			InitializePollWithSnapshotFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error {
+// 			InitializePollWithSnapshotFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error {
 // 				panic("mock out the InitializePollWithSnapshot method")
 // 			},
 // 		}
@@ -123,13 +123,13 @@ var _ types.Voter = &VoterMock{}
 // 	}
 type VoterMock struct {
 	// GetPollFunc mocks the GetPoll method.
-	GetPollFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, pollKey vote.PollKey) vote.Poll
+	GetPollFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, pollKey exported1.PollKey) exported1.Poll
 
 	// InitializePollFunc mocks the InitializePoll method.
-	InitializePollFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, voters []github_com_cosmos_cosmos_sdk_types.ValAddress, pollProperties ...vote.PollProperty) error
+	InitializePollFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, voters []github_com_cosmos_cosmos_sdk_types.ValAddress, pollProperties ...exported1.PollProperty) error
 
 	// InitializePollWithSnapshotFunc mocks the InitializePollWithSnapshot method.
-	InitializePollWithSnapshotFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error
+	InitializePollWithSnapshotFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -138,29 +138,29 @@ type VoterMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// PollKey is the pollKey argument value.
-			PollKey vote.PollKey
+			PollKey exported1.PollKey
 		}
 		// InitializePoll holds details about calls to the InitializePoll method.
 		InitializePoll []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
 			// Voters is the voters argument value.
 			Voters []github_com_cosmos_cosmos_sdk_types.ValAddress
 			// PollProperties is the pollProperties argument value.
-			PollProperties []vote.PollProperty
+			PollProperties []exported1.PollProperty
 		}
 		// InitializePollWithSnapshot holds details about calls to the InitializePollWithSnapshot method.
 		InitializePollWithSnapshot []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
 			// SnapshotSeqNo is the snapshotSeqNo argument value.
 			SnapshotSeqNo int64
 			// PollProperties is the pollProperties argument value.
-			PollProperties []vote.PollProperty
+			PollProperties []exported1.PollProperty
 		}
 	}
 	lockGetPoll                    sync.RWMutex
@@ -169,13 +169,13 @@ type VoterMock struct {
 }
 
 // GetPoll calls GetPollFunc.
-func (mock *VoterMock) GetPoll(ctx github_com_cosmos_cosmos_sdk_types.Context, pollKey vote.PollKey) vote.Poll {
+func (mock *VoterMock) GetPoll(ctx github_com_cosmos_cosmos_sdk_types.Context, pollKey exported1.PollKey) exported1.Poll {
 	if mock.GetPollFunc == nil {
 		panic("VoterMock.GetPollFunc: method is nil but Voter.GetPoll was just called")
 	}
 	callInfo := struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		PollKey vote.PollKey
+		PollKey exported1.PollKey
 	}{
 		Ctx:     ctx,
 		PollKey: pollKey,
@@ -191,11 +191,11 @@ func (mock *VoterMock) GetPoll(ctx github_com_cosmos_cosmos_sdk_types.Context, p
 //     len(mockedVoter.GetPollCalls())
 func (mock *VoterMock) GetPollCalls() []struct {
 	Ctx     github_com_cosmos_cosmos_sdk_types.Context
-	PollKey vote.PollKey
+	PollKey exported1.PollKey
 } {
 	var calls []struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		PollKey vote.PollKey
+		PollKey exported1.PollKey
 	}
 	mock.lockGetPoll.RLock()
 	calls = mock.calls.GetPoll
@@ -204,15 +204,15 @@ func (mock *VoterMock) GetPollCalls() []struct {
 }
 
 // InitializePoll calls InitializePollFunc.
-func (mock *VoterMock) InitializePoll(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, voters []github_com_cosmos_cosmos_sdk_types.ValAddress, pollProperties ...vote.PollProperty) error {
+func (mock *VoterMock) InitializePoll(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, voters []github_com_cosmos_cosmos_sdk_types.ValAddress, pollProperties ...exported1.PollProperty) error {
 	if mock.InitializePollFunc == nil {
 		panic("VoterMock.InitializePollFunc: method is nil but Voter.InitializePoll was just called")
 	}
 	callInfo := struct {
 		Ctx            github_com_cosmos_cosmos_sdk_types.Context
-		Key            vote.PollKey
+		Key            exported1.PollKey
 		Voters         []github_com_cosmos_cosmos_sdk_types.ValAddress
-		PollProperties []vote.PollProperty
+		PollProperties []exported1.PollProperty
 	}{
 		Ctx:            ctx,
 		Key:            key,
@@ -230,15 +230,15 @@ func (mock *VoterMock) InitializePoll(ctx github_com_cosmos_cosmos_sdk_types.Con
 //     len(mockedVoter.InitializePollCalls())
 func (mock *VoterMock) InitializePollCalls() []struct {
 	Ctx            github_com_cosmos_cosmos_sdk_types.Context
-	Key            vote.PollKey
+	Key            exported1.PollKey
 	Voters         []github_com_cosmos_cosmos_sdk_types.ValAddress
-	PollProperties []vote.PollProperty
+	PollProperties []exported1.PollProperty
 } {
 	var calls []struct {
 		Ctx            github_com_cosmos_cosmos_sdk_types.Context
-		Key            vote.PollKey
+		Key            exported1.PollKey
 		Voters         []github_com_cosmos_cosmos_sdk_types.ValAddress
-		PollProperties []vote.PollProperty
+		PollProperties []exported1.PollProperty
 	}
 	mock.lockInitializePoll.RLock()
 	calls = mock.calls.InitializePoll
@@ -247,15 +247,15 @@ func (mock *VoterMock) InitializePollCalls() []struct {
 }
 
 // InitializePollWithSnapshot calls InitializePollWithSnapshotFunc.
-func (mock *VoterMock) InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error {
+func (mock *VoterMock) InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error {
 	if mock.InitializePollWithSnapshotFunc == nil {
 		panic("VoterMock.InitializePollWithSnapshotFunc: method is nil but Voter.InitializePollWithSnapshot was just called")
 	}
 	callInfo := struct {
 		Ctx            github_com_cosmos_cosmos_sdk_types.Context
-		Key            vote.PollKey
+		Key            exported1.PollKey
 		SnapshotSeqNo  int64
-		PollProperties []vote.PollProperty
+		PollProperties []exported1.PollProperty
 	}{
 		Ctx:            ctx,
 		Key:            key,
@@ -273,15 +273,15 @@ func (mock *VoterMock) InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_s
 //     len(mockedVoter.InitializePollWithSnapshotCalls())
 func (mock *VoterMock) InitializePollWithSnapshotCalls() []struct {
 	Ctx            github_com_cosmos_cosmos_sdk_types.Context
-	Key            vote.PollKey
+	Key            exported1.PollKey
 	SnapshotSeqNo  int64
-	PollProperties []vote.PollProperty
+	PollProperties []exported1.PollProperty
 } {
 	var calls []struct {
 		Ctx            github_com_cosmos_cosmos_sdk_types.Context
-		Key            vote.PollKey
+		Key            exported1.PollKey
 		SnapshotSeqNo  int64
-		PollProperties []vote.PollProperty
+		PollProperties []exported1.PollProperty
 	}
 	mock.lockInitializePollWithSnapshot.RLock()
 	calls = mock.calls.InitializePollWithSnapshot
@@ -299,19 +299,19 @@ var _ types.Signer = &SignerMock{}
 //
 // 		// make and configure a mocked types.Signer
 // 		mockedSigner := &SignerMock{
-// 			AssertMatchesRequirementsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter snapshot.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
+// 			AssertMatchesRequirementsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, chain exported.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 // 				panic("mock out the AssertMatchesRequirements method")
 // 			},
-// 			AssignNextKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error {
+// 			AssignNextKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error {
 // 				panic("mock out the AssignNextKey method")
 // 			},
-// 			GetCurrentKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+// 			GetCurrentKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 // 				panic("mock out the GetCurrentKey method")
 // 			},
-// 			GetCurrentKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+// 			GetCurrentKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 // 				panic("mock out the GetCurrentKeyID method")
 // 			},
-// 			GetExternalKeyIDsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+// 			GetExternalKeyIDsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 // 				panic("mock out the GetExternalKeyIDs method")
 // 			},
 // 			GetExternalMultisigThresholdFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.Threshold {
@@ -335,7 +335,7 @@ var _ types.Signer = &SignerMock{}
 // 			GetMultisigPubKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (github_com_axelarnetwork_axelar_core_x_tss_exported.MultisigKey, bool) {
 // 				panic("mock out the GetMultisigPubKey method")
 // 			},
-// 			GetNextKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+// 			GetNextKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 // 				panic("mock out the GetNextKeyID method")
 // 			},
 // 			GetSigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
@@ -344,10 +344,10 @@ var _ types.Signer = &SignerMock{}
 // 			GetSnapshotCounterForKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
 // 				panic("mock out the GetSnapshotCounterForKeyID method")
 // 			},
-// 			RotateKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
+// 			RotateKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 // 				panic("mock out the RotateKey method")
 // 			},
-// 			StartSignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter snapshot.Snapshotter, voter interface{InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error}) error {
+// 			StartSignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error {
 // 				panic("mock out the StartSign method")
 // 			},
 // 		}
@@ -358,19 +358,19 @@ var _ types.Signer = &SignerMock{}
 // 	}
 type SignerMock struct {
 	// AssertMatchesRequirementsFunc mocks the AssertMatchesRequirements method.
-	AssertMatchesRequirementsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter snapshot.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
+	AssertMatchesRequirementsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, chain exported.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
 
 	// AssignNextKeyFunc mocks the AssignNextKey method.
-	AssignNextKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error
+	AssignNextKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error
 
 	// GetCurrentKeyFunc mocks the GetCurrentKey method.
-	GetCurrentKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
+	GetCurrentKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool)
 
 	// GetCurrentKeyIDFunc mocks the GetCurrentKeyID method.
-	GetCurrentKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
+	GetCurrentKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
 
 	// GetExternalKeyIDsFunc mocks the GetExternalKeyIDs method.
-	GetExternalKeyIDsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
+	GetExternalKeyIDsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
 
 	// GetExternalMultisigThresholdFunc mocks the GetExternalMultisigThreshold method.
 	GetExternalMultisigThresholdFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) utils.Threshold
@@ -394,7 +394,7 @@ type SignerMock struct {
 	GetMultisigPubKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (github_com_axelarnetwork_axelar_core_x_tss_exported.MultisigKey, bool)
 
 	// GetNextKeyIDFunc mocks the GetNextKeyID method.
-	GetNextKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
+	GetNextKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool)
 
 	// GetSigFunc mocks the GetSig method.
 	GetSigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)
@@ -403,12 +403,10 @@ type SignerMock struct {
 	GetSnapshotCounterForKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool)
 
 	// RotateKeyFunc mocks the RotateKey method.
-	RotateKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
+	RotateKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
 
 	// StartSignFunc mocks the StartSign method.
-	StartSignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter snapshot.Snapshotter, voter interface {
-		InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error
-	}) error
+	StartSignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -417,9 +415,9 @@ type SignerMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Snapshotter is the snapshotter argument value.
-			Snapshotter snapshot.Snapshotter
+			Snapshotter types.Snapshotter
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 			// KeyRole is the keyRole argument value.
@@ -430,7 +428,7 @@ type SignerMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 			// KeyRole is the keyRole argument value.
 			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 			// KeyID is the keyID argument value.
@@ -441,7 +439,7 @@ type SignerMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 			// KeyRole is the keyRole argument value.
 			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		}
@@ -450,7 +448,7 @@ type SignerMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 			// KeyRole is the keyRole argument value.
 			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		}
@@ -459,7 +457,7 @@ type SignerMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 		}
 		// GetExternalMultisigThreshold holds details about calls to the GetExternalMultisigThreshold method.
 		GetExternalMultisigThreshold []struct {
@@ -515,7 +513,7 @@ type SignerMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 			// KeyRole is the keyRole argument value.
 			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		}
@@ -538,7 +536,7 @@ type SignerMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 			// KeyRole is the keyRole argument value.
 			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		}
@@ -549,11 +547,9 @@ type SignerMock struct {
 			// Info is the info argument value.
 			Info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 			// Snapshotter is the snapshotter argument value.
-			Snapshotter snapshot.Snapshotter
+			Snapshotter types.Snapshotter
 			// Voter is the voter argument value.
-			Voter interface {
-				InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error
-			}
+			Voter types.InitPoller
 		}
 	}
 	lockAssertMatchesRequirements    sync.RWMutex
@@ -576,14 +572,14 @@ type SignerMock struct {
 }
 
 // AssertMatchesRequirements calls AssertMatchesRequirementsFunc.
-func (mock *SignerMock) AssertMatchesRequirements(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter snapshot.Snapshotter, chain nexus.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
+func (mock *SignerMock) AssertMatchesRequirements(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, chain exported.Chain, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 	if mock.AssertMatchesRequirementsFunc == nil {
 		panic("SignerMock.AssertMatchesRequirementsFunc: method is nil but Signer.AssertMatchesRequirements was just called")
 	}
 	callInfo := struct {
 		Ctx         github_com_cosmos_cosmos_sdk_types.Context
-		Snapshotter snapshot.Snapshotter
-		Chain       nexus.Chain
+		Snapshotter types.Snapshotter
+		Chain       exported.Chain
 		KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		KeyRole     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
@@ -604,15 +600,15 @@ func (mock *SignerMock) AssertMatchesRequirements(ctx github_com_cosmos_cosmos_s
 //     len(mockedSigner.AssertMatchesRequirementsCalls())
 func (mock *SignerMock) AssertMatchesRequirementsCalls() []struct {
 	Ctx         github_com_cosmos_cosmos_sdk_types.Context
-	Snapshotter snapshot.Snapshotter
-	Chain       nexus.Chain
+	Snapshotter types.Snapshotter
+	Chain       exported.Chain
 	KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	KeyRole     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
 		Ctx         github_com_cosmos_cosmos_sdk_types.Context
-		Snapshotter snapshot.Snapshotter
-		Chain       nexus.Chain
+		Snapshotter types.Snapshotter
+		Chain       exported.Chain
 		KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		KeyRole     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
@@ -623,13 +619,13 @@ func (mock *SignerMock) AssertMatchesRequirementsCalls() []struct {
 }
 
 // AssignNextKey calls AssignNextKeyFunc.
-func (mock *SignerMock) AssignNextKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error {
+func (mock *SignerMock) AssignNextKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) error {
 	if mock.AssignNextKeyFunc == nil {
 		panic("SignerMock.AssignNextKeyFunc: method is nil but Signer.AssignNextKey was just called")
 	}
 	callInfo := struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		KeyID   github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}{
@@ -649,13 +645,13 @@ func (mock *SignerMock) AssignNextKey(ctx github_com_cosmos_cosmos_sdk_types.Con
 //     len(mockedSigner.AssignNextKeyCalls())
 func (mock *SignerMock) AssignNextKeyCalls() []struct {
 	Ctx     github_com_cosmos_cosmos_sdk_types.Context
-	Chain   nexus.Chain
+	Chain   exported.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	KeyID   github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 } {
 	var calls []struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		KeyID   github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 	}
@@ -666,13 +662,13 @@ func (mock *SignerMock) AssignNextKeyCalls() []struct {
 }
 
 // GetCurrentKey calls GetCurrentKeyFunc.
-func (mock *SignerMock) GetCurrentKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
+func (mock *SignerMock) GetCurrentKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.Key, bool) {
 	if mock.GetCurrentKeyFunc == nil {
 		panic("SignerMock.GetCurrentKeyFunc: method is nil but Signer.GetCurrentKey was just called")
 	}
 	callInfo := struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
 		Ctx:     ctx,
@@ -690,12 +686,12 @@ func (mock *SignerMock) GetCurrentKey(ctx github_com_cosmos_cosmos_sdk_types.Con
 //     len(mockedSigner.GetCurrentKeyCalls())
 func (mock *SignerMock) GetCurrentKeyCalls() []struct {
 	Ctx     github_com_cosmos_cosmos_sdk_types.Context
-	Chain   nexus.Chain
+	Chain   exported.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
 	mock.lockGetCurrentKey.RLock()
@@ -705,13 +701,13 @@ func (mock *SignerMock) GetCurrentKeyCalls() []struct {
 }
 
 // GetCurrentKeyID calls GetCurrentKeyIDFunc.
-func (mock *SignerMock) GetCurrentKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+func (mock *SignerMock) GetCurrentKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 	if mock.GetCurrentKeyIDFunc == nil {
 		panic("SignerMock.GetCurrentKeyIDFunc: method is nil but Signer.GetCurrentKeyID was just called")
 	}
 	callInfo := struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
 		Ctx:     ctx,
@@ -729,12 +725,12 @@ func (mock *SignerMock) GetCurrentKeyID(ctx github_com_cosmos_cosmos_sdk_types.C
 //     len(mockedSigner.GetCurrentKeyIDCalls())
 func (mock *SignerMock) GetCurrentKeyIDCalls() []struct {
 	Ctx     github_com_cosmos_cosmos_sdk_types.Context
-	Chain   nexus.Chain
+	Chain   exported.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
 	mock.lockGetCurrentKeyID.RLock()
@@ -744,13 +740,13 @@ func (mock *SignerMock) GetCurrentKeyIDCalls() []struct {
 }
 
 // GetExternalKeyIDs calls GetExternalKeyIDsFunc.
-func (mock *SignerMock) GetExternalKeyIDs(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+func (mock *SignerMock) GetExternalKeyIDs(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) ([]github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 	if mock.GetExternalKeyIDsFunc == nil {
 		panic("SignerMock.GetExternalKeyIDsFunc: method is nil but Signer.GetExternalKeyIDs was just called")
 	}
 	callInfo := struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}{
 		Ctx:   ctx,
 		Chain: chain,
@@ -766,11 +762,11 @@ func (mock *SignerMock) GetExternalKeyIDs(ctx github_com_cosmos_cosmos_sdk_types
 //     len(mockedSigner.GetExternalKeyIDsCalls())
 func (mock *SignerMock) GetExternalKeyIDsCalls() []struct {
 	Ctx   github_com_cosmos_cosmos_sdk_types.Context
-	Chain nexus.Chain
+	Chain exported.Chain
 } {
 	var calls []struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}
 	mock.lockGetExternalKeyIDs.RLock()
 	calls = mock.calls.GetExternalKeyIDs
@@ -1024,13 +1020,13 @@ func (mock *SignerMock) GetMultisigPubKeyCalls() []struct {
 }
 
 // GetNextKeyID calls GetNextKeyIDFunc.
-func (mock *SignerMock) GetNextKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
+func (mock *SignerMock) GetNextKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, bool) {
 	if mock.GetNextKeyIDFunc == nil {
 		panic("SignerMock.GetNextKeyIDFunc: method is nil but Signer.GetNextKeyID was just called")
 	}
 	callInfo := struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
 		Ctx:     ctx,
@@ -1048,12 +1044,12 @@ func (mock *SignerMock) GetNextKeyID(ctx github_com_cosmos_cosmos_sdk_types.Cont
 //     len(mockedSigner.GetNextKeyIDCalls())
 func (mock *SignerMock) GetNextKeyIDCalls() []struct {
 	Ctx     github_com_cosmos_cosmos_sdk_types.Context
-	Chain   nexus.Chain
+	Chain   exported.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
 	mock.lockGetNextKeyID.RLock()
@@ -1133,13 +1129,13 @@ func (mock *SignerMock) GetSnapshotCounterForKeyIDCalls() []struct {
 }
 
 // RotateKey calls RotateKeyFunc.
-func (mock *SignerMock) RotateKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
+func (mock *SignerMock) RotateKey(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 	if mock.RotateKeyFunc == nil {
 		panic("SignerMock.RotateKeyFunc: method is nil but Signer.RotateKey was just called")
 	}
 	callInfo := struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}{
 		Ctx:     ctx,
@@ -1157,12 +1153,12 @@ func (mock *SignerMock) RotateKey(ctx github_com_cosmos_cosmos_sdk_types.Context
 //     len(mockedSigner.RotateKeyCalls())
 func (mock *SignerMock) RotateKeyCalls() []struct {
 	Ctx     github_com_cosmos_cosmos_sdk_types.Context
-	Chain   nexus.Chain
+	Chain   exported.Chain
 	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 } {
 	var calls []struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Chain   nexus.Chain
+		Chain   exported.Chain
 		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 	}
 	mock.lockRotateKey.RLock()
@@ -1172,19 +1168,15 @@ func (mock *SignerMock) RotateKeyCalls() []struct {
 }
 
 // StartSign calls StartSignFunc.
-func (mock *SignerMock) StartSign(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter snapshot.Snapshotter, voter interface {
-	InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error
-}) error {
+func (mock *SignerMock) StartSign(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error {
 	if mock.StartSignFunc == nil {
 		panic("SignerMock.StartSignFunc: method is nil but Signer.StartSign was just called")
 	}
 	callInfo := struct {
 		Ctx         github_com_cosmos_cosmos_sdk_types.Context
 		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
-		Snapshotter snapshot.Snapshotter
-		Voter       interface {
-			InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error
-		}
+		Snapshotter types.Snapshotter
+		Voter       types.InitPoller
 	}{
 		Ctx:         ctx,
 		Info:        info,
@@ -1203,18 +1195,14 @@ func (mock *SignerMock) StartSign(ctx github_com_cosmos_cosmos_sdk_types.Context
 func (mock *SignerMock) StartSignCalls() []struct {
 	Ctx         github_com_cosmos_cosmos_sdk_types.Context
 	Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
-	Snapshotter snapshot.Snapshotter
-	Voter       interface {
-		InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error
-	}
+	Snapshotter types.Snapshotter
+	Voter       types.InitPoller
 } {
 	var calls []struct {
 		Ctx         github_com_cosmos_cosmos_sdk_types.Context
 		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
-		Snapshotter snapshot.Snapshotter
-		Voter       interface {
-			InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, snapshotSeqNo int64, pollProperties ...vote.PollProperty) error
-		}
+		Snapshotter types.Snapshotter
+		Voter       types.InitPoller
 	}
 	mock.lockStartSign.RLock()
 	calls = mock.calls.StartSign
@@ -1232,40 +1220,43 @@ var _ types.Nexus = &NexusMock{}
 //
 // 		// make and configure a mocked types.Nexus
 // 		mockedNexus := &NexusMock{
-// 			ArchivePendingTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, transfer nexus.CrossChainTransfer)  {
+// 			ArchivePendingTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, transfer exported.CrossChainTransfer, executionID string)  {
 // 				panic("mock out the ArchivePendingTransfer method")
 // 			},
-// 			EnqueueForTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec) error {
+// 			EnqueueForTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender exported.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec, depositTxID string) error {
 // 				panic("mock out the EnqueueForTransfer method")
 // 			},
-// 			GetChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool) {
+// 			GetChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (exported.Chain, bool) {
 // 				panic("mock out the GetChain method")
 // 			},
-// 			GetChainMaintainersFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress {
+// 			GetChainMaintainersFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress {
 // 				panic("mock out the GetChainMaintainers method")
 // 			},
-// 			GetChainsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []nexus.Chain {
+// 			GetChainsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []exported.Chain {
 // 				panic("mock out the GetChains method")
 // 			},
-// 			GetRecipientFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
+// 			GetRecipientFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
 // 				panic("mock out the GetRecipient method")
 // 			},
-// 			GetTransfersForChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
+// 			GetTransfersForChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, state exported.TransferState) []exported.CrossChainTransfer {
 // 				panic("mock out the GetTransfersForChain method")
 // 			},
 // 			IsAssetRegisteredFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chainName string, denom string) bool {
 // 				panic("mock out the IsAssetRegistered method")
 // 			},
-// 			IsChainActivatedFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) bool {
+// 			IsChainActivatedFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) bool {
 // 				panic("mock out the IsChainActivated method")
 // 			},
-// 			LinkAddressesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress)  {
+// 			IsTransferFrozenFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, asset string) bool {
+// 				panic("mock out the IsTransferFrozen method")
+// 			},
+// 			LinkAddressesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress)  {
 // 				panic("mock out the LinkAddresses method")
 // 			},
 // 			RegisterAssetFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chainName string, denom string)  {
 // 				panic("mock out the RegisterAsset method")
 // 			},
-// 			SetChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain)  {
+// 			SetChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain)  {
 // 				panic("mock out the SetChain method")
 // 			},
 // 		}
@@ -1276,40 +1267,43 @@ var _ types.Nexus = &NexusMock{}
 // 	}
 type NexusMock struct {
 	// ArchivePendingTransferFunc mocks the ArchivePendingTransfer method.
-	ArchivePendingTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, transfer nexus.CrossChainTransfer)
+	ArchivePendingTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, transfer exported.CrossChainTransfer, executionID string)
 
 	// EnqueueForTransferFunc mocks the EnqueueForTransfer method.
-	EnqueueForTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec) error
+	EnqueueForTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender exported.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec, depositTxID string) error
 
 	// GetChainFunc mocks the GetChain method.
-	GetChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool)
+	GetChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (exported.Chain, bool)
 
 	// GetChainMaintainersFunc mocks the GetChainMaintainers method.
-	GetChainMaintainersFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress
+	GetChainMaintainersFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress
 
 	// GetChainsFunc mocks the GetChains method.
-	GetChainsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []nexus.Chain
+	GetChainsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []exported.Chain
 
 	// GetRecipientFunc mocks the GetRecipient method.
-	GetRecipientFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool)
+	GetRecipientFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool)
 
 	// GetTransfersForChainFunc mocks the GetTransfersForChain method.
-	GetTransfersForChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer
+	GetTransfersForChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, state exported.TransferState) []exported.CrossChainTransfer
 
 	// IsAssetRegisteredFunc mocks the IsAssetRegistered method.
 	IsAssetRegisteredFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chainName string, denom string) bool
 
 	// IsChainActivatedFunc mocks the IsChainActivated method.
-	IsChainActivatedFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) bool
+	IsChainActivatedFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) bool
+
+	// IsTransferFrozenFunc mocks the IsTransferFrozen method.
+	IsTransferFrozenFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, asset string) bool
 
 	// LinkAddressesFunc mocks the LinkAddresses method.
-	LinkAddressesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress)
+	LinkAddressesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress)
 
 	// RegisterAssetFunc mocks the RegisterAsset method.
 	RegisterAssetFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chainName string, denom string)
 
 	// SetChainFunc mocks the SetChain method.
-	SetChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain)
+	SetChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -1318,18 +1312,22 @@ type NexusMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Transfer is the transfer argument value.
-			Transfer nexus.CrossChainTransfer
+			Transfer exported.CrossChainTransfer
+			// ExecutionID is the executionID argument value.
+			ExecutionID string
 		}
 		// EnqueueForTransfer holds details about calls to the EnqueueForTransfer method.
 		EnqueueForTransfer []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Sender is the sender argument value.
-			Sender nexus.CrossChainAddress
+			Sender exported.CrossChainAddress
 			// Amount is the amount argument value.
 			Amount github_com_cosmos_cosmos_sdk_types.Coin
 			// FeeRate is the feeRate argument value.
 			FeeRate github_com_cosmos_cosmos_sdk_types.Dec
+			// DepositTxID is the depositTxID argument value.
+			DepositTxID string
 		}
 		// GetChain holds details about calls to the GetChain method.
 		GetChain []struct {
@@ -1343,7 +1341,7 @@ type NexusMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 		}
 		// GetChains holds details about calls to the GetChains method.
 		GetChains []struct {
@@ -1355,16 +1353,16 @@ type NexusMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Sender is the sender argument value.
-			Sender nexus.CrossChainAddress
+			Sender exported.CrossChainAddress
 		}
 		// GetTransfersForChain holds details about calls to the GetTransfersForChain method.
 		GetTransfersForChain []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 			// State is the state argument value.
-			State nexus.TransferState
+			State exported.TransferState
 		}
 		// IsAssetRegistered holds details about calls to the IsAssetRegistered method.
 		IsAssetRegistered []struct {
@@ -1380,16 +1378,25 @@ type NexusMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
+		}
+		// IsTransferFrozen holds details about calls to the IsTransferFrozen method.
+		IsTransferFrozen []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain exported.Chain
+			// Asset is the asset argument value.
+			Asset string
 		}
 		// LinkAddresses holds details about calls to the LinkAddresses method.
 		LinkAddresses []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Sender is the sender argument value.
-			Sender nexus.CrossChainAddress
+			Sender exported.CrossChainAddress
 			// Recipient is the recipient argument value.
-			Recipient nexus.CrossChainAddress
+			Recipient exported.CrossChainAddress
 		}
 		// RegisterAsset holds details about calls to the RegisterAsset method.
 		RegisterAsset []struct {
@@ -1405,7 +1412,7 @@ type NexusMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 		}
 	}
 	lockArchivePendingTransfer sync.RWMutex
@@ -1417,39 +1424,44 @@ type NexusMock struct {
 	lockGetTransfersForChain   sync.RWMutex
 	lockIsAssetRegistered      sync.RWMutex
 	lockIsChainActivated       sync.RWMutex
+	lockIsTransferFrozen       sync.RWMutex
 	lockLinkAddresses          sync.RWMutex
 	lockRegisterAsset          sync.RWMutex
 	lockSetChain               sync.RWMutex
 }
 
 // ArchivePendingTransfer calls ArchivePendingTransferFunc.
-func (mock *NexusMock) ArchivePendingTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, transfer nexus.CrossChainTransfer) {
+func (mock *NexusMock) ArchivePendingTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, transfer exported.CrossChainTransfer, executionID string) {
 	if mock.ArchivePendingTransferFunc == nil {
 		panic("NexusMock.ArchivePendingTransferFunc: method is nil but Nexus.ArchivePendingTransfer was just called")
 	}
 	callInfo := struct {
-		Ctx      github_com_cosmos_cosmos_sdk_types.Context
-		Transfer nexus.CrossChainTransfer
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Transfer    exported.CrossChainTransfer
+		ExecutionID string
 	}{
-		Ctx:      ctx,
-		Transfer: transfer,
+		Ctx:         ctx,
+		Transfer:    transfer,
+		ExecutionID: executionID,
 	}
 	mock.lockArchivePendingTransfer.Lock()
 	mock.calls.ArchivePendingTransfer = append(mock.calls.ArchivePendingTransfer, callInfo)
 	mock.lockArchivePendingTransfer.Unlock()
-	mock.ArchivePendingTransferFunc(ctx, transfer)
+	mock.ArchivePendingTransferFunc(ctx, transfer, executionID)
 }
 
 // ArchivePendingTransferCalls gets all the calls that were made to ArchivePendingTransfer.
 // Check the length with:
 //     len(mockedNexus.ArchivePendingTransferCalls())
 func (mock *NexusMock) ArchivePendingTransferCalls() []struct {
-	Ctx      github_com_cosmos_cosmos_sdk_types.Context
-	Transfer nexus.CrossChainTransfer
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Transfer    exported.CrossChainTransfer
+	ExecutionID string
 } {
 	var calls []struct {
-		Ctx      github_com_cosmos_cosmos_sdk_types.Context
-		Transfer nexus.CrossChainTransfer
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Transfer    exported.CrossChainTransfer
+		ExecutionID string
 	}
 	mock.lockArchivePendingTransfer.RLock()
 	calls = mock.calls.ArchivePendingTransfer
@@ -1458,41 +1470,45 @@ func (mock *NexusMock) ArchivePendingTransferCalls() []struct {
 }
 
 // EnqueueForTransfer calls EnqueueForTransferFunc.
-func (mock *NexusMock) EnqueueForTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec) error {
+func (mock *NexusMock) EnqueueForTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, sender exported.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin, feeRate github_com_cosmos_cosmos_sdk_types.Dec, depositTxID string) error {
 	if mock.EnqueueForTransferFunc == nil {
 		panic("NexusMock.EnqueueForTransferFunc: method is nil but Nexus.EnqueueForTransfer was just called")
 	}
 	callInfo := struct {
-		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Sender  nexus.CrossChainAddress
-		Amount  github_com_cosmos_cosmos_sdk_types.Coin
-		FeeRate github_com_cosmos_cosmos_sdk_types.Dec
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Sender      exported.CrossChainAddress
+		Amount      github_com_cosmos_cosmos_sdk_types.Coin
+		FeeRate     github_com_cosmos_cosmos_sdk_types.Dec
+		DepositTxID string
 	}{
-		Ctx:     ctx,
-		Sender:  sender,
-		Amount:  amount,
-		FeeRate: feeRate,
+		Ctx:         ctx,
+		Sender:      sender,
+		Amount:      amount,
+		FeeRate:     feeRate,
+		DepositTxID: depositTxID,
 	}
 	mock.lockEnqueueForTransfer.Lock()
 	mock.calls.EnqueueForTransfer = append(mock.calls.EnqueueForTransfer, callInfo)
 	mock.lockEnqueueForTransfer.Unlock()
-	return mock.EnqueueForTransferFunc(ctx, sender, amount, feeRate)
+	return mock.EnqueueForTransferFunc(ctx, sender, amount, feeRate, depositTxID)
 }
 
 // EnqueueForTransferCalls gets all the calls that were made to EnqueueForTransfer.
 // Check the length with:
 //     len(mockedNexus.EnqueueForTransferCalls())
 func (mock *NexusMock) EnqueueForTransferCalls() []struct {
-	Ctx     github_com_cosmos_cosmos_sdk_types.Context
-	Sender  nexus.CrossChainAddress
-	Amount  github_com_cosmos_cosmos_sdk_types.Coin
-	FeeRate github_com_cosmos_cosmos_sdk_types.Dec
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Sender      exported.CrossChainAddress
+	Amount      github_com_cosmos_cosmos_sdk_types.Coin
+	FeeRate     github_com_cosmos_cosmos_sdk_types.Dec
+	DepositTxID string
 } {
 	var calls []struct {
-		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Sender  nexus.CrossChainAddress
-		Amount  github_com_cosmos_cosmos_sdk_types.Coin
-		FeeRate github_com_cosmos_cosmos_sdk_types.Dec
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Sender      exported.CrossChainAddress
+		Amount      github_com_cosmos_cosmos_sdk_types.Coin
+		FeeRate     github_com_cosmos_cosmos_sdk_types.Dec
+		DepositTxID string
 	}
 	mock.lockEnqueueForTransfer.RLock()
 	calls = mock.calls.EnqueueForTransfer
@@ -1501,7 +1517,7 @@ func (mock *NexusMock) EnqueueForTransferCalls() []struct {
 }
 
 // GetChain calls GetChainFunc.
-func (mock *NexusMock) GetChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool) {
+func (mock *NexusMock) GetChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (exported.Chain, bool) {
 	if mock.GetChainFunc == nil {
 		panic("NexusMock.GetChainFunc: method is nil but Nexus.GetChain was just called")
 	}
@@ -1536,13 +1552,13 @@ func (mock *NexusMock) GetChainCalls() []struct {
 }
 
 // GetChainMaintainers calls GetChainMaintainersFunc.
-func (mock *NexusMock) GetChainMaintainers(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (mock *NexusMock) GetChainMaintainers(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress {
 	if mock.GetChainMaintainersFunc == nil {
 		panic("NexusMock.GetChainMaintainersFunc: method is nil but Nexus.GetChainMaintainers was just called")
 	}
 	callInfo := struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}{
 		Ctx:   ctx,
 		Chain: chain,
@@ -1558,11 +1574,11 @@ func (mock *NexusMock) GetChainMaintainers(ctx github_com_cosmos_cosmos_sdk_type
 //     len(mockedNexus.GetChainMaintainersCalls())
 func (mock *NexusMock) GetChainMaintainersCalls() []struct {
 	Ctx   github_com_cosmos_cosmos_sdk_types.Context
-	Chain nexus.Chain
+	Chain exported.Chain
 } {
 	var calls []struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}
 	mock.lockGetChainMaintainers.RLock()
 	calls = mock.calls.GetChainMaintainers
@@ -1571,7 +1587,7 @@ func (mock *NexusMock) GetChainMaintainersCalls() []struct {
 }
 
 // GetChains calls GetChainsFunc.
-func (mock *NexusMock) GetChains(ctx github_com_cosmos_cosmos_sdk_types.Context) []nexus.Chain {
+func (mock *NexusMock) GetChains(ctx github_com_cosmos_cosmos_sdk_types.Context) []exported.Chain {
 	if mock.GetChainsFunc == nil {
 		panic("NexusMock.GetChainsFunc: method is nil but Nexus.GetChains was just called")
 	}
@@ -1602,13 +1618,13 @@ func (mock *NexusMock) GetChainsCalls() []struct {
 }
 
 // GetRecipient calls GetRecipientFunc.
-func (mock *NexusMock) GetRecipient(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress) (nexus.CrossChainAddress, bool) {
+func (mock *NexusMock) GetRecipient(ctx github_com_cosmos_cosmos_sdk_types.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
 	if mock.GetRecipientFunc == nil {
 		panic("NexusMock.GetRecipientFunc: method is nil but Nexus.GetRecipient was just called")
 	}
 	callInfo := struct {
 		Ctx    github_com_cosmos_cosmos_sdk_types.Context
-		Sender nexus.CrossChainAddress
+		Sender exported.CrossChainAddress
 	}{
 		Ctx:    ctx,
 		Sender: sender,
@@ -1624,11 +1640,11 @@ func (mock *NexusMock) GetRecipient(ctx github_com_cosmos_cosmos_sdk_types.Conte
 //     len(mockedNexus.GetRecipientCalls())
 func (mock *NexusMock) GetRecipientCalls() []struct {
 	Ctx    github_com_cosmos_cosmos_sdk_types.Context
-	Sender nexus.CrossChainAddress
+	Sender exported.CrossChainAddress
 } {
 	var calls []struct {
 		Ctx    github_com_cosmos_cosmos_sdk_types.Context
-		Sender nexus.CrossChainAddress
+		Sender exported.CrossChainAddress
 	}
 	mock.lockGetRecipient.RLock()
 	calls = mock.calls.GetRecipient
@@ -1637,14 +1653,14 @@ func (mock *NexusMock) GetRecipientCalls() []struct {
 }
 
 // GetTransfersForChain calls GetTransfersForChainFunc.
-func (mock *NexusMock) GetTransfersForChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, state nexus.TransferState) []nexus.CrossChainTransfer {
+func (mock *NexusMock) GetTransfersForChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, state exported.TransferState) []exported.CrossChainTransfer {
 	if mock.GetTransfersForChainFunc == nil {
 		panic("NexusMock.GetTransfersForChainFunc: method is nil but Nexus.GetTransfersForChain was just called")
 	}
 	callInfo := struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
-		State nexus.TransferState
+		Chain exported.Chain
+		State exported.TransferState
 	}{
 		Ctx:   ctx,
 		Chain: chain,
@@ -1661,13 +1677,13 @@ func (mock *NexusMock) GetTransfersForChain(ctx github_com_cosmos_cosmos_sdk_typ
 //     len(mockedNexus.GetTransfersForChainCalls())
 func (mock *NexusMock) GetTransfersForChainCalls() []struct {
 	Ctx   github_com_cosmos_cosmos_sdk_types.Context
-	Chain nexus.Chain
-	State nexus.TransferState
+	Chain exported.Chain
+	State exported.TransferState
 } {
 	var calls []struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
-		State nexus.TransferState
+		Chain exported.Chain
+		State exported.TransferState
 	}
 	mock.lockGetTransfersForChain.RLock()
 	calls = mock.calls.GetTransfersForChain
@@ -1715,13 +1731,13 @@ func (mock *NexusMock) IsAssetRegisteredCalls() []struct {
 }
 
 // IsChainActivated calls IsChainActivatedFunc.
-func (mock *NexusMock) IsChainActivated(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) bool {
+func (mock *NexusMock) IsChainActivated(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) bool {
 	if mock.IsChainActivatedFunc == nil {
 		panic("NexusMock.IsChainActivatedFunc: method is nil but Nexus.IsChainActivated was just called")
 	}
 	callInfo := struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}{
 		Ctx:   ctx,
 		Chain: chain,
@@ -1737,11 +1753,11 @@ func (mock *NexusMock) IsChainActivated(ctx github_com_cosmos_cosmos_sdk_types.C
 //     len(mockedNexus.IsChainActivatedCalls())
 func (mock *NexusMock) IsChainActivatedCalls() []struct {
 	Ctx   github_com_cosmos_cosmos_sdk_types.Context
-	Chain nexus.Chain
+	Chain exported.Chain
 } {
 	var calls []struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}
 	mock.lockIsChainActivated.RLock()
 	calls = mock.calls.IsChainActivated
@@ -1749,15 +1765,54 @@ func (mock *NexusMock) IsChainActivatedCalls() []struct {
 	return calls
 }
 
+// IsTransferFrozen calls IsTransferFrozenFunc.
+func (mock *NexusMock) IsTransferFrozen(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, asset string) bool {
+	if mock.IsTransferFrozenFunc == nil {
+		panic("NexusMock.IsTransferFrozenFunc: method is nil but Nexus.IsTransferFrozen was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Chain exported.Chain
+		Asset string
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+		Asset: asset,
+	}
+	mock.lockIsTransferFrozen.Lock()
+	mock.calls.IsTransferFrozen = append(mock.calls.IsTransferFrozen, callInfo)
+	mock.lockIsTransferFrozen.Unlock()
+	return mock.IsTransferFrozenFunc(ctx, chain, asset)
+}
+
+// IsTransferFrozenCalls gets all the calls that were made to IsTransferFrozen.
+// Check the length with:
+//     len(mockedNexus.IsTransferFrozenCalls())
+func (mock *NexusMock) IsTransferFrozenCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Chain exported.Chain
+	Asset string
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Chain exported.Chain
+		Asset string
+	}
+	mock.lockIsTransferFrozen.RLock()
+	calls = mock.calls.IsTransferFrozen
+	mock.lockIsTransferFrozen.RUnlock()
+	return calls
+}
+
 // LinkAddresses calls LinkAddressesFunc.
-func (mock *NexusMock) LinkAddresses(ctx github_com_cosmos_cosmos_sdk_types.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress) {
+func (mock *NexusMock) LinkAddresses(ctx github_com_cosmos_cosmos_sdk_types.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress) {
 	if mock.LinkAddressesFunc == nil {
 		panic("NexusMock.LinkAddressesFunc: method is nil but Nexus.LinkAddresses was just called")
 	}
 	callInfo := struct {
 		Ctx       github_com_cosmos_cosmos_sdk_types.Context
-		Sender    nexus.CrossChainAddress
-		Recipient nexus.CrossChainAddress
+		Sender    exported.CrossChainAddress
+		Recipient exported.CrossChainAddress
 	}{
 		Ctx:       ctx,
 		Sender:    sender,
@@ -1774,13 +1829,13 @@ func (mock *NexusMock) LinkAddresses(ctx github_com_cosmos_cosmos_sdk_types.Cont
 //     len(mockedNexus.LinkAddressesCalls())
 func (mock *NexusMock) LinkAddressesCalls() []struct {
 	Ctx       github_com_cosmos_cosmos_sdk_types.Context
-	Sender    nexus.CrossChainAddress
-	Recipient nexus.CrossChainAddress
+	Sender    exported.CrossChainAddress
+	Recipient exported.CrossChainAddress
 } {
 	var calls []struct {
 		Ctx       github_com_cosmos_cosmos_sdk_types.Context
-		Sender    nexus.CrossChainAddress
-		Recipient nexus.CrossChainAddress
+		Sender    exported.CrossChainAddress
+		Recipient exported.CrossChainAddress
 	}
 	mock.lockLinkAddresses.RLock()
 	calls = mock.calls.LinkAddresses
@@ -1828,13 +1883,13 @@ func (mock *NexusMock) RegisterAssetCalls() []struct {
 }
 
 // SetChain calls SetChainFunc.
-func (mock *NexusMock) SetChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) {
+func (mock *NexusMock) SetChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) {
 	if mock.SetChainFunc == nil {
 		panic("NexusMock.SetChainFunc: method is nil but Nexus.SetChain was just called")
 	}
 	callInfo := struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}{
 		Ctx:   ctx,
 		Chain: chain,
@@ -1850,11 +1905,11 @@ func (mock *NexusMock) SetChain(ctx github_com_cosmos_cosmos_sdk_types.Context,
 //     len(mockedNexus.SetChainCalls())
 func (mock *NexusMock) SetChainCalls() []struct {
 	Ctx   github_com_cosmos_cosmos_sdk_types.Context
-	Chain nexus.Chain
+	Chain exported.Chain
 } {
 	var calls []struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}
 	mock.lockSetChain.RLock()
 	calls = mock.calls.SetChain
@@ -2228,22 +2283,28 @@ var _ types.BaseKeeper = &BaseKeeperMock{}
 // 			DeletePendingChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string)  {
 // 				panic("mock out the DeletePendingChain method")
 // 			},
+// 			ExportGenesisFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) *types.GenesisState {
+// 				panic("mock out the ExportGenesis method")
+// 			},
 // 			ForChainFunc: func(chain string) types.ChainKeeper {
 // 				panic("mock out the ForChain method")
 // 			},
 // 			GetParamsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.Params {
 // 				panic("mock out the GetParams method")
 // 			},
-// 			GetPendingChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool) {
+// 			GetPendingChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (exported.Chain, bool) {
 // 				panic("mock out the GetPendingChain method")
 // 			},
+// 			InitGenesisFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, genState *types.GenesisState)  {
+// 				panic("mock out the InitGenesis method")
+// 			},
 // 			LoggerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
 // 				panic("mock out the Logger method")
 // 			},
 // 			SetParamsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, params ...types.Params)  {
 // 				panic("mock out the SetParams method")
 // 			},
-// 			SetPendingChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain)  {
+// 			SetPendingChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain)  {
 // 				panic("mock out the SetPendingChain method")
 // 			},
 // 		}
@@ -2256,6 +2317,9 @@ type BaseKeeperMock struct {
 	// DeletePendingChainFunc mocks the DeletePendingChain method.
 	DeletePendingChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string)
 
+	// ExportGenesisFunc mocks the ExportGenesis method.
+	ExportGenesisFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) *types.GenesisState
+
 	// ForChainFunc mocks the ForChain method.
 	ForChainFunc func(chain string) types.ChainKeeper
 
@@ -2263,7 +2327,10 @@ type BaseKeeperMock struct {
 	GetParamsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.Params
 
 	// GetPendingChainFunc mocks the GetPendingChain method.
-	GetPendingChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool)
+	GetPendingChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (exported.Chain, bool)
+
+	// InitGenesisFunc mocks the InitGenesis method.
+	InitGenesisFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, genState *types.GenesisState)
 
 	// LoggerFunc mocks the Logger method.
 	LoggerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger
@@ -2272,7 +2339,7 @@ type BaseKeeperMock struct {
 	SetParamsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, params ...types.Params)
 
 	// SetPendingChainFunc mocks the SetPendingChain method.
-	SetPendingChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain)
+	SetPendingChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -2283,6 +2350,11 @@ type BaseKeeperMock struct {
 			// Chain is the chain argument value.
 			Chain string
 		}
+		// ExportGenesis holds details about calls to the ExportGenesis method.
+		ExportGenesis []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// ForChain holds details about calls to the ForChain method.
 		ForChain []struct {
 			// Chain is the chain argument value.
@@ -2300,6 +2372,13 @@ type BaseKeeperMock struct {
 			// Chain is the chain argument value.
 			Chain string
 		}
+		// InitGenesis holds details about calls to the InitGenesis method.
+		InitGenesis []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// GenState is the genState argument value.
+			GenState *types.GenesisState
+		}
 		// Logger holds details about calls to the Logger method.
 		Logger []struct {
 			// Ctx is the ctx argument value.
@@ -2317,13 +2396,15 @@ type BaseKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Chain is the chain argument value.
-			Chain nexus.Chain
+			Chain exported.Chain
 		}
 	}
 	lockDeletePendingChain sync.RWMutex
+	lockExportGenesis      sync.RWMutex
 	lockForChain           sync.RWMutex
 	lockGetParams          sync.RWMutex
 	lockGetPendingChain    sync.RWMutex
+	lockInitGenesis        sync.RWMutex
 	lockLogger             sync.RWMutex
 	lockSetParams          sync.RWMutex
 	lockSetPendingChain    sync.RWMutex
@@ -2364,6 +2445,37 @@ func (mock *BaseKeeperMock) DeletePendingChainCalls() []struct {
 	return calls
 }
 
+// ExportGenesis calls ExportGenesisFunc.
+func (mock *BaseKeeperMock) ExportGenesis(ctx github_com_cosmos_cosmos_sdk_types.Context) *types.GenesisState {
+	if mock.ExportGenesisFunc == nil {
+		panic("BaseKeeperMock.ExportGenesisFunc: method is nil but BaseKeeper.ExportGenesis was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockExportGenesis.Lock()
+	mock.calls.ExportGenesis = append(mock.calls.ExportGenesis, callInfo)
+	mock.lockExportGenesis.Unlock()
+	return mock.ExportGenesisFunc(ctx)
+}
+
+// ExportGenesisCalls gets all the calls that were made to ExportGenesis.
+// Check the length with:
+//     len(mockedBaseKeeper.ExportGenesisCalls())
+func (mock *BaseKeeperMock) ExportGenesisCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockExportGenesis.RLock()
+	calls = mock.calls.ExportGenesis
+	mock.lockExportGenesis.RUnlock()
+	return calls
+}
+
 // ForChain calls ForChainFunc.
 func (mock *BaseKeeperMock) ForChain(chain string) types.ChainKeeper {
 	if mock.ForChainFunc == nil {
@@ -2427,7 +2539,7 @@ func (mock *BaseKeeperMock) GetParamsCalls() []struct {
 }
 
 // GetPendingChain calls GetPendingChainFunc.
-func (mock *BaseKeeperMock) GetPendingChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (nexus.Chain, bool) {
+func (mock *BaseKeeperMock) GetPendingChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (exported.Chain, bool) {
 	if mock.GetPendingChainFunc == nil {
 		panic("BaseKeeperMock.GetPendingChainFunc: method is nil but BaseKeeper.GetPendingChain was just called")
 	}
@@ -2461,6 +2573,41 @@ func (mock *BaseKeeperMock) GetPendingChainCalls() []struct {
 	return calls
 }
 
+// InitGenesis calls InitGenesisFunc.
+func (mock *BaseKeeperMock) InitGenesis(ctx github_com_cosmos_cosmos_sdk_types.Context, genState *types.GenesisState) {
+	if mock.InitGenesisFunc == nil {
+		panic("BaseKeeperMock.InitGenesisFunc: method is nil but BaseKeeper.InitGenesis was just called")
+	}
+	callInfo := struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		GenState *types.GenesisState
+	}{
+		Ctx:      ctx,
+		GenState: genState,
+	}
+	mock.lockInitGenesis.Lock()
+	mock.calls.InitGenesis = append(mock.calls.InitGenesis, callInfo)
+	mock.lockInitGenesis.Unlock()
+	mock.InitGenesisFunc(ctx, genState)
+}
+
+// InitGenesisCalls gets all the calls that were made to InitGenesis.
+// Check the length with:
+//     len(mockedBaseKeeper.InitGenesisCalls())
+func (mock *BaseKeeperMock) InitGenesisCalls() []struct {
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
+	GenState *types.GenesisState
+} {
+	var calls []struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		GenState *types.GenesisState
+	}
+	mock.lockInitGenesis.RLock()
+	calls = mock.calls.InitGenesis
+	mock.lockInitGenesis.RUnlock()
+	return calls
+}
+
 // Logger calls LoggerFunc.
 func (mock *BaseKeeperMock) Logger(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
 	if mock.LoggerFunc == nil {
@@ -2528,13 +2675,13 @@ func (mock *BaseKeeperMock) SetParamsCalls() []struct {
 }
 
 // SetPendingChain calls SetPendingChainFunc.
-func (mock *BaseKeeperMock) SetPendingChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain) {
+func (mock *BaseKeeperMock) SetPendingChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) {
 	if mock.SetPendingChainFunc == nil {
 		panic("BaseKeeperMock.SetPendingChainFunc: method is nil but BaseKeeper.SetPendingChain was just called")
 	}
 	callInfo := struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}{
 		Ctx:   ctx,
 		Chain: chain,
@@ -2550,11 +2697,11 @@ func (mock *BaseKeeperMock) SetPendingChain(ctx github_com_cosmos_cosmos_sdk_typ
 //     len(mockedBaseKeeper.SetPendingChainCalls())
 func (mock *BaseKeeperMock) SetPendingChainCalls() []struct {
 	Ctx   github_com_cosmos_cosmos_sdk_types.Context
-	Chain nexus.Chain
+	Chain exported.Chain
 } {
 	var calls []struct {
 		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Chain nexus.Chain
+		Chain exported.Chain
 	}
 	mock.lockSetPendingChain.RLock()
 	calls = mock.calls.SetPendingChain
@@ -2572,7 +2719,7 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 //
 // 		// make and configure a mocked types.ChainKeeper
 // 		mockedChainKeeper := &ChainKeeperMock{
-// 			ArchiveTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)  {
+// 			ArchiveTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey)  {
 // 				panic("mock out the ArchiveTransferKey method")
 // 			},
 // 			AssembleTxFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, txID string, sig github_com_axelarnetwork_axelar_core_x_tss_exported.Signature) (*evmTypes.Transaction, error) {
@@ -2590,21 +2737,27 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			DeleteDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, deposit types.ERC20Deposit)  {
 // 				panic("mock out the DeleteDeposit method")
 // 			},
-// 			DeletePendingDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)  {
+// 			DeletePendingDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey)  {
 // 				panic("mock out the DeletePendingDeposit method")
 // 			},
 // 			DeletePendingGatewayFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) error {
 // 				panic("mock out the DeletePendingGateway method")
 // 			},
-// 			DeletePendingTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)  {
+// 			DeletePendingTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey)  {
 // 				panic("mock out the DeletePendingTransferKey method")
 // 			},
 // 			EnqueueCommandFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, cmd types.Command) error {
 // 				panic("mock out the EnqueueCommand method")
 // 			},
-// 			GetArchivedTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.TransferKey, bool) {
+// 			GetArchivedTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) (types.TransferKey, bool) {
 // 				panic("mock out the GetArchivedTransferKey method")
 // 			},
+// 			GetAutoBatchConfigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.AutoBatchConfig, bool) {
+// 				panic("mock out the GetAutoBatchConfig method")
+// 			},
+// 			GetAutoBatchRetryHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
+// 				panic("mock out the GetAutoBatchRetryHeight method")
+// 			},
 // 			GetBatchByIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte) types.CommandBatch {
 // 				panic("mock out the GetBatchByID method")
 // 			},
@@ -2617,9 +2770,18 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetBurnerInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, address common.Address) *types.BurnerInfo {
 // 				panic("mock out the GetBurnerInfo method")
 // 			},
+// 			GetBurnersFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ChainRecord_Burner {
+// 				panic("mock out the GetBurners method")
+// 			},
 // 			GetChainIDByNetworkFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, network string) *big.Int {
 // 				panic("mock out the GetChainIDByNetwork method")
 // 			},
+// 			GetCommandFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) (types.Command, bool) {
+// 				panic("mock out the GetCommand method")
+// 			},
+// 			GetCommandsGasLimitFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) uint32 {
+// 				panic("mock out the GetCommandsGasLimit method")
+// 			},
 // 			GetConfirmedDepositsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ERC20Deposit {
 // 				panic("mock out the GetConfirmedDeposits method")
 // 			},
@@ -2656,15 +2818,21 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetNetworkByIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, id *big.Int) (string, bool) {
 // 				panic("mock out the GetNetworkByID method")
 // 			},
-// 			GetPendingDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.ERC20Deposit, bool) {
+// 			GetOldestQueuedCommandHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool) {
+// 				panic("mock out the GetOldestQueuedCommandHeight method")
+// 			},
+// 			GetPendingDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) (types.ERC20Deposit, bool) {
 // 				panic("mock out the GetPendingDeposit method")
 // 			},
 // 			GetPendingGatewayAddressFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool) {
 // 				panic("mock out the GetPendingGatewayAddress method")
 // 			},
-// 			GetPendingTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.TransferKey, bool) {
+// 			GetPendingTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) (types.TransferKey, bool) {
 // 				panic("mock out the GetPendingTransferKey method")
 // 			},
+// 			GetQueuedCommandsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.Command {
+// 				panic("mock out the GetQueuedCommands method")
+// 			},
 // 			GetRequiredConfirmationHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (uint64, bool) {
 // 				panic("mock out the GetRequiredConfirmationHeight method")
 // 			},
@@ -2683,19 +2851,22 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			LoggerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
 // 				panic("mock out the Logger method")
 // 			},
+// 			SetAutoBatchRetryHeightFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, height int64)  {
+// 				panic("mock out the SetAutoBatchRetryHeight method")
+// 			},
 // 			SetBurnerInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, burnerAddr common.Address, burnerInfo *types.BurnerInfo)  {
 // 				panic("mock out the SetBurnerInfo method")
 // 			},
 // 			SetDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, deposit types.ERC20Deposit, state types.DepositState)  {
 // 				panic("mock out the SetDeposit method")
 // 			},
-// 			SetPendingDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, deposit *types.ERC20Deposit)  {
+// 			SetPendingDepositFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, deposit *types.ERC20Deposit)  {
 // 				panic("mock out the SetPendingDeposit method")
 // 			},
 // 			SetPendingGatewayFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, address common.Address)  {
 // 				panic("mock out the SetPendingGateway method")
 // 			},
-// 			SetPendingTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, transferOwnership *types.TransferKey)  {
+// 			SetPendingTransferKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, transferOwnership *types.TransferKey)  {
 // 				panic("mock out the SetPendingTransferKey method")
 // 			},
 // 			SetUnsignedTxFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, txID string, tx *evmTypes.Transaction, pk ecdsa.PublicKey) error {
@@ -2709,7 +2880,7 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 	}
 type ChainKeeperMock struct {
 	// ArchiveTransferKeyFunc mocks the ArchiveTransferKey method.
-	ArchiveTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)
+	ArchiveTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey)
 
 	// AssembleTxFunc mocks the AssembleTx method.
 	AssembleTxFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, txID string, sig github_com_axelarnetwork_axelar_core_x_tss_exported.Signature) (*evmTypes.Transaction, error)
@@ -2727,19 +2898,25 @@ type ChainKeeperMock struct {
 	DeleteDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, deposit types.ERC20Deposit)
 
 	// DeletePendingDepositFunc mocks the DeletePendingDeposit method.
-	DeletePendingDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)
+	DeletePendingDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey)
 
 	// DeletePendingGatewayFunc mocks the DeletePendingGateway method.
 	DeletePendingGatewayFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) error
 
 	// DeletePendingTransferKeyFunc mocks the DeletePendingTransferKey method.
-	DeletePendingTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey)
+	DeletePendingTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey)
 
 	// EnqueueCommandFunc mocks the EnqueueCommand method.
 	EnqueueCommandFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, cmd types.Command) error
 
 	// GetArchivedTransferKeyFunc mocks the GetArchivedTransferKey method.
-	GetArchivedTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.TransferKey, bool)
+	GetArchivedTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) (types.TransferKey, bool)
+
+	// GetAutoBatchConfigFunc mocks the GetAutoBatchConfig method.
+	GetAutoBatchConfigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.AutoBatchConfig, bool)
+
+	// GetAutoBatchRetryHeightFunc mocks the GetAutoBatchRetryHeight method.
+	GetAutoBatchRetryHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
 
 	// GetBatchByIDFunc mocks the GetBatchByID method.
 	GetBatchByIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte) types.CommandBatch
@@ -2753,9 +2930,18 @@ type ChainKeeperMock struct {
 	// GetBurnerInfoFunc mocks the GetBurnerInfo method.
 	GetBurnerInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, address common.Address) *types.BurnerInfo
 
+	// GetBurnersFunc mocks the GetBurners method.
+	GetBurnersFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ChainRecord_Burner
+
 	// GetChainIDByNetworkFunc mocks the GetChainIDByNetwork method.
 	GetChainIDByNetworkFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, network string) *big.Int
 
+	// GetCommandFunc mocks the GetCommand method.
+	GetCommandFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) (types.Command, bool)
+
+	// GetCommandsGasLimitFunc mocks the GetCommandsGasLimit method.
+	GetCommandsGasLimitFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) uint32
+
 	// GetConfirmedDepositsFunc mocks the GetConfirmedDeposits method.
 	GetConfirmedDepositsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ERC20Deposit
 
@@ -2792,14 +2978,20 @@ type ChainKeeperMock struct {
 	// GetNetworkByIDFunc mocks the GetNetworkByID method.
 	GetNetworkByIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, id *big.Int) (string, bool)
 
+	// GetOldestQueuedCommandHeightFunc mocks the GetOldestQueuedCommandHeight method.
+	GetOldestQueuedCommandHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool)
+
 	// GetPendingDepositFunc mocks the GetPendingDeposit method.
-	GetPendingDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.ERC20Deposit, bool)
+	GetPendingDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) (types.ERC20Deposit, bool)
 
 	// GetPendingGatewayAddressFunc mocks the GetPendingGatewayAddress method.
 	GetPendingGatewayAddressFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (common.Address, bool)
 
 	// GetPendingTransferKeyFunc mocks the GetPendingTransferKey method.
-	GetPendingTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.TransferKey, bool)
+	GetPendingTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) (types.TransferKey, bool)
+
+	// GetQueuedCommandsFunc mocks the GetQueuedCommands method.
+	GetQueuedCommandsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.Command
 
 	// GetRequiredConfirmationHeightFunc mocks the GetRequiredConfirmationHeight method.
 	GetRequiredConfirmationHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (uint64, bool)
@@ -2819,6 +3011,9 @@ type ChainKeeperMock struct {
 	// LoggerFunc mocks the Logger method.
 	LoggerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger
 
+	// SetAutoBatchRetryHeightFunc mocks the SetAutoBatchRetryHeight method.
+	SetAutoBatchRetryHeightFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, height int64)
+
 	// SetBurnerInfoFunc mocks the SetBurnerInfo method.
 	SetBurnerInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, burnerAddr common.Address, burnerInfo *types.BurnerInfo)
 
@@ -2826,13 +3021,13 @@ type ChainKeeperMock struct {
 	SetDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, deposit types.ERC20Deposit, state types.DepositState)
 
 	// SetPendingDepositFunc mocks the SetPendingDeposit method.
-	SetPendingDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, deposit *types.ERC20Deposit)
+	SetPendingDepositFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, deposit *types.ERC20Deposit)
 
 	// SetPendingGatewayFunc mocks the SetPendingGateway method.
 	SetPendingGatewayFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, address common.Address)
 
 	// SetPendingTransferKeyFunc mocks the SetPendingTransferKey method.
-	SetPendingTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, transferOwnership *types.TransferKey)
+	SetPendingTransferKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, transferOwnership *types.TransferKey)
 
 	// SetUnsignedTxFunc mocks the SetUnsignedTx method.
 	SetUnsignedTxFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, txID string, tx *evmTypes.Transaction, pk ecdsa.PublicKey) error
@@ -2844,7 +3039,7 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
 		}
 		// AssembleTx holds details about calls to the AssembleTx method.
 		AssembleTx []struct {
@@ -2886,7 +3081,7 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
 		}
 		// DeletePendingGateway holds details about calls to the DeletePendingGateway method.
 		DeletePendingGateway []struct {
@@ -2898,7 +3093,7 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
 		}
 		// EnqueueCommand holds details about calls to the EnqueueCommand method.
 		EnqueueCommand []struct {
@@ -2912,7 +3107,17 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
+		}
+		// GetAutoBatchConfig holds details about calls to the GetAutoBatchConfig method.
+		GetAutoBatchConfig []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetAutoBatchRetryHeight holds details about calls to the GetAutoBatchRetryHeight method.
+		GetAutoBatchRetryHeight []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetBatchByID holds details about calls to the GetBatchByID method.
 		GetBatchByID []struct {
@@ -2944,6 +3149,11 @@ type ChainKeeperMock struct {
 			// Address is the address argument value.
 			Address common.Address
 		}
+		// GetBurners holds details about calls to the GetBurners method.
+		GetBurners []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// GetChainIDByNetwork holds details about calls to the GetChainIDByNetwork method.
 		GetChainIDByNetwork []struct {
 			// Ctx is the ctx argument value.
@@ -2951,6 +3161,18 @@ type ChainKeeperMock struct {
 			// Network is the network argument value.
 			Network string
 		}
+		// GetCommand holds details about calls to the GetCommand method.
+		GetCommand []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// ID is the id argument value.
+			ID types.CommandID
+		}
+		// GetCommandsGasLimit holds details about calls to the GetCommandsGasLimit method.
+		GetCommandsGasLimit []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// GetConfirmedDeposits holds details about calls to the GetConfirmedDeposits method.
 		GetConfirmedDeposits []struct {
 			// Ctx is the ctx argument value.
@@ -3021,12 +3243,17 @@ type ChainKeeperMock struct {
 			// ID is the id argument value.
 			ID *big.Int
 		}
+		// GetOldestQueuedCommandHeight holds details about calls to the GetOldestQueuedCommandHeight method.
+		GetOldestQueuedCommandHeight []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
 		// GetPendingDeposit holds details about calls to the GetPendingDeposit method.
 		GetPendingDeposit []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
 		}
 		// GetPendingGatewayAddress holds details about calls to the GetPendingGatewayAddress method.
 		GetPendingGatewayAddress []struct {
@@ -3038,7 +3265,12 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
+		}
+		// GetQueuedCommands holds details about calls to the GetQueuedCommands method.
+		GetQueuedCommands []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
 		// GetRequiredConfirmationHeight holds details about calls to the GetRequiredConfirmationHeight method.
 		GetRequiredConfirmationHeight []struct {
@@ -3070,6 +3302,13 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// SetAutoBatchRetryHeight holds details about calls to the SetAutoBatchRetryHeight method.
+		SetAutoBatchRetryHeight []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Height is the height argument value.
+			Height int64
+		}
 		// SetBurnerInfo holds details about calls to the SetBurnerInfo method.
 		SetBurnerInfo []struct {
 			// Ctx is the ctx argument value.
@@ -3093,7 +3332,7 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
 			// Deposit is the deposit argument value.
 			Deposit *types.ERC20Deposit
 		}
@@ -3109,7 +3348,7 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Key is the key argument value.
-			Key vote.PollKey
+			Key exported1.PollKey
 			// TransferOwnership is the transferOwnership argument value.
 			TransferOwnership *types.TransferKey
 		}
@@ -3136,11 +3375,16 @@ type ChainKeeperMock struct {
 	lockDeletePendingTransferKey      sync.RWMutex
 	lockEnqueueCommand                sync.RWMutex
 	lockGetArchivedTransferKey        sync.RWMutex
+	lockGetAutoBatchConfig            sync.RWMutex
+	lockGetAutoBatchRetryHeight       sync.RWMutex
 	lockGetBatchByID                  sync.RWMutex
 	lockGetBurnerAddressAndSalt       sync.RWMutex
 	lockGetBurnerByteCodes            sync.RWMutex
 	lockGetBurnerInfo                 sync.RWMutex
+	lockGetBurners                    sync.RWMutex
 	lockGetChainIDByNetwork           sync.RWMutex
+	lockGetCommand                    sync.RWMutex
+	lockGetCommandsGasLimit           sync.RWMutex
 	lockGetConfirmedDeposits          sync.RWMutex
 	lockGetDeposit                    sync.RWMutex
 	lockGetERC20TokenByAsset          sync.RWMutex
@@ -3153,15 +3397,18 @@ type ChainKeeperMock struct {
 	lockGetName                       sync.RWMutex
 	lockGetNetwork                    sync.RWMutex
 	lockGetNetworkByID                sync.RWMutex
+	lockGetOldestQueuedCommandHeight  sync.RWMutex
 	lockGetPendingDeposit             sync.RWMutex
 	lockGetPendingGatewayAddress      sync.RWMutex
 	lockGetPendingTransferKey         sync.RWMutex
+	lockGetQueuedCommands             sync.RWMutex
 	lockGetRequiredConfirmationHeight sync.RWMutex
 	lockGetRevoteLockingPeriod        sync.RWMutex
 	lockGetTokenByteCodes             sync.RWMutex
 	lockGetTransactionFeeRate         sync.RWMutex
 	lockGetVotingThreshold            sync.RWMutex
 	lockLogger                        sync.RWMutex
+	lockSetAutoBatchRetryHeight       sync.RWMutex
 	lockSetBurnerInfo                 sync.RWMutex
 	lockSetDeposit                    sync.RWMutex
 	lockSetPendingDeposit             sync.RWMutex
@@ -3171,13 +3418,13 @@ type ChainKeeperMock struct {
 }
 
 // ArchiveTransferKey calls ArchiveTransferKeyFunc.
-func (mock *ChainKeeperMock) ArchiveTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) {
+func (mock *ChainKeeperMock) ArchiveTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) {
 	if mock.ArchiveTransferKeyFunc == nil {
 		panic("ChainKeeperMock.ArchiveTransferKeyFunc: method is nil but ChainKeeper.ArchiveTransferKey was just called")
 	}
 	callInfo := struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}{
 		Ctx: ctx,
 		Key: key,
@@ -3193,11 +3440,11 @@ func (mock *ChainKeeperMock) ArchiveTransferKey(ctx github_com_cosmos_cosmos_sdk
 //     len(mockedChainKeeper.ArchiveTransferKeyCalls())
 func (mock *ChainKeeperMock) ArchiveTransferKeyCalls() []struct {
 	Ctx github_com_cosmos_cosmos_sdk_types.Context
-	Key vote.PollKey
+	Key exported1.PollKey
 } {
 	var calls []struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}
 	mock.lockArchiveTransferKey.RLock()
 	calls = mock.calls.ArchiveTransferKey
@@ -3381,13 +3628,13 @@ func (mock *ChainKeeperMock) DeleteDepositCalls() []struct {
 }
 
 // DeletePendingDeposit calls DeletePendingDepositFunc.
-func (mock *ChainKeeperMock) DeletePendingDeposit(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) {
+func (mock *ChainKeeperMock) DeletePendingDeposit(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) {
 	if mock.DeletePendingDepositFunc == nil {
 		panic("ChainKeeperMock.DeletePendingDepositFunc: method is nil but ChainKeeper.DeletePendingDeposit was just called")
 	}
 	callInfo := struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}{
 		Ctx: ctx,
 		Key: key,
@@ -3403,11 +3650,11 @@ func (mock *ChainKeeperMock) DeletePendingDeposit(ctx github_com_cosmos_cosmos_s
 //     len(mockedChainKeeper.DeletePendingDepositCalls())
 func (mock *ChainKeeperMock) DeletePendingDepositCalls() []struct {
 	Ctx github_com_cosmos_cosmos_sdk_types.Context
-	Key vote.PollKey
+	Key exported1.PollKey
 } {
 	var calls []struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}
 	mock.lockDeletePendingDeposit.RLock()
 	calls = mock.calls.DeletePendingDeposit
@@ -3447,13 +3694,13 @@ func (mock *ChainKeeperMock) DeletePendingGatewayCalls() []struct {
 }
 
 // DeletePendingTransferKey calls DeletePendingTransferKeyFunc.
-func (mock *ChainKeeperMock) DeletePendingTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) {
+func (mock *ChainKeeperMock) DeletePendingTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) {
 	if mock.DeletePendingTransferKeyFunc == nil {
 		panic("ChainKeeperMock.DeletePendingTransferKeyFunc: method is nil but ChainKeeper.DeletePendingTransferKey was just called")
 	}
 	callInfo := struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}{
 		Ctx: ctx,
 		Key: key,
@@ -3469,11 +3716,11 @@ func (mock *ChainKeeperMock) DeletePendingTransferKey(ctx github_com_cosmos_cosm
 //     len(mockedChainKeeper.DeletePendingTransferKeyCalls())
 func (mock *ChainKeeperMock) DeletePendingTransferKeyCalls() []struct {
 	Ctx github_com_cosmos_cosmos_sdk_types.Context
-	Key vote.PollKey
+	Key exported1.PollKey
 } {
 	var calls []struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}
 	mock.lockDeletePendingTransferKey.RLock()
 	calls = mock.calls.DeletePendingTransferKey
@@ -3517,13 +3764,13 @@ func (mock *ChainKeeperMock) EnqueueCommandCalls() []struct {
 }
 
 // GetArchivedTransferKey calls GetArchivedTransferKeyFunc.
-func (mock *ChainKeeperMock) GetArchivedTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.TransferKey, bool) {
+func (mock *ChainKeeperMock) GetArchivedTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) (types.TransferKey, bool) {
 	if mock.GetArchivedTransferKeyFunc == nil {
 		panic("ChainKeeperMock.GetArchivedTransferKeyFunc: method is nil but ChainKeeper.GetArchivedTransferKey was just called")
 	}
 	callInfo := struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}{
 		Ctx: ctx,
 		Key: key,
@@ -3539,11 +3786,11 @@ func (mock *ChainKeeperMock) GetArchivedTransferKey(ctx github_com_cosmos_cosmos
 //     len(mockedChainKeeper.GetArchivedTransferKeyCalls())
 func (mock *ChainKeeperMock) GetArchivedTransferKeyCalls() []struct {
 	Ctx github_com_cosmos_cosmos_sdk_types.Context
-	Key vote.PollKey
+	Key exported1.PollKey
 } {
 	var calls []struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}
 	mock.lockGetArchivedTransferKey.RLock()
 	calls = mock.calls.GetArchivedTransferKey
@@ -3551,6 +3798,68 @@ func (mock *ChainKeeperMock) GetArchivedTransferKeyCalls() []struct {
 	return calls
 }
 
+// GetAutoBatchConfig calls GetAutoBatchConfigFunc.
+func (mock *ChainKeeperMock) GetAutoBatchConfig(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.AutoBatchConfig, bool) {
+	if mock.GetAutoBatchConfigFunc == nil {
+		panic("ChainKeeperMock.GetAutoBatchConfigFunc: method is nil but ChainKeeper.GetAutoBatchConfig was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAutoBatchConfig.Lock()
+	mock.calls.GetAutoBatchConfig = append(mock.calls.GetAutoBatchConfig, callInfo)
+	mock.lockGetAutoBatchConfig.Unlock()
+	return mock.GetAutoBatchConfigFunc(ctx)
+}
+
+// GetAutoBatchConfigCalls gets all the calls that were made to GetAutoBatchConfig.
+// Check the length with:
+//     len(mockedChainKeeper.GetAutoBatchConfigCalls())
+func (mock *ChainKeeperMock) GetAutoBatchConfigCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetAutoBatchConfig.RLock()
+	calls = mock.calls.GetAutoBatchConfig
+	mock.lockGetAutoBatchConfig.RUnlock()
+	return calls
+}
+
+// GetAutoBatchRetryHeight calls GetAutoBatchRetryHeightFunc.
+func (mock *ChainKeeperMock) GetAutoBatchRetryHeight(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
+	if mock.GetAutoBatchRetryHeightFunc == nil {
+		panic("ChainKeeperMock.GetAutoBatchRetryHeightFunc: method is nil but ChainKeeper.GetAutoBatchRetryHeight was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAutoBatchRetryHeight.Lock()
+	mock.calls.GetAutoBatchRetryHeight = append(mock.calls.GetAutoBatchRetryHeight, callInfo)
+	mock.lockGetAutoBatchRetryHeight.Unlock()
+	return mock.GetAutoBatchRetryHeightFunc(ctx)
+}
+
+// GetAutoBatchRetryHeightCalls gets all the calls that were made to GetAutoBatchRetryHeight.
+// Check the length with:
+//     len(mockedChainKeeper.GetAutoBatchRetryHeightCalls())
+func (mock *ChainKeeperMock) GetAutoBatchRetryHeightCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetAutoBatchRetryHeight.RLock()
+	calls = mock.calls.GetAutoBatchRetryHeight
+	mock.lockGetAutoBatchRetryHeight.RUnlock()
+	return calls
+}
+
 // GetBatchByID calls GetBatchByIDFunc.
 func (mock *ChainKeeperMock) GetBatchByID(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte) types.CommandBatch {
 	if mock.GetBatchByIDFunc == nil {
@@ -3695,6 +4004,37 @@ func (mock *ChainKeeperMock) GetBurnerInfoCalls() []struct {
 	return calls
 }
 
+// GetBurners calls GetBurnersFunc.
+func (mock *ChainKeeperMock) GetBurners(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ChainRecord_Burner {
+	if mock.GetBurnersFunc == nil {
+		panic("ChainKeeperMock.GetBurnersFunc: method is nil but ChainKeeper.GetBurners was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetBurners.Lock()
+	mock.calls.GetBurners = append(mock.calls.GetBurners, callInfo)
+	mock.lockGetBurners.Unlock()
+	return mock.GetBurnersFunc(ctx)
+}
+
+// GetBurnersCalls gets all the calls that were made to GetBurners.
+// Check the length with:
+//     len(mockedChainKeeper.GetBurnersCalls())
+func (mock *ChainKeeperMock) GetBurnersCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetBurners.RLock()
+	calls = mock.calls.GetBurners
+	mock.lockGetBurners.RUnlock()
+	return calls
+}
+
 // GetChainIDByNetwork calls GetChainIDByNetworkFunc.
 func (mock *ChainKeeperMock) GetChainIDByNetwork(ctx github_com_cosmos_cosmos_sdk_types.Context, network string) *big.Int {
 	if mock.GetChainIDByNetworkFunc == nil {
@@ -3730,6 +4070,72 @@ func (mock *ChainKeeperMock) GetChainIDByNetworkCalls() []struct {
 	return calls
 }
 
+// GetCommand calls GetCommandFunc.
+func (mock *ChainKeeperMock) GetCommand(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) (types.Command, bool) {
+	if mock.GetCommandFunc == nil {
+		panic("ChainKeeperMock.GetCommandFunc: method is nil but ChainKeeper.GetCommand was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		ID  types.CommandID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetCommand.Lock()
+	mock.calls.GetCommand = append(mock.calls.GetCommand, callInfo)
+	mock.lockGetCommand.Unlock()
+	return mock.GetCommandFunc(ctx, id)
+}
+
+// GetCommandCalls gets all the calls that were made to GetCommand.
+// Check the length with:
+//     len(mockedChainKeeper.GetCommandCalls())
+func (mock *ChainKeeperMock) GetCommandCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	ID  types.CommandID
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		ID  types.CommandID
+	}
+	mock.lockGetCommand.RLock()
+	calls = mock.calls.GetCommand
+	mock.lockGetCommand.RUnlock()
+	return calls
+}
+
+// GetCommandsGasLimit calls GetCommandsGasLimitFunc.
+func (mock *ChainKeeperMock) GetCommandsGasLimit(ctx github_com_cosmos_cosmos_sdk_types.Context) uint32 {
+	if mock.GetCommandsGasLimitFunc == nil {
+		panic("ChainKeeperMock.GetCommandsGasLimitFunc: method is nil but ChainKeeper.GetCommandsGasLimit was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCommandsGasLimit.Lock()
+	mock.calls.GetCommandsGasLimit = append(mock.calls.GetCommandsGasLimit, callInfo)
+	mock.lockGetCommandsGasLimit.Unlock()
+	return mock.GetCommandsGasLimitFunc(ctx)
+}
+
+// GetCommandsGasLimitCalls gets all the calls that were made to GetCommandsGasLimit.
+// Check the length with:
+//     len(mockedChainKeeper.GetCommandsGasLimitCalls())
+func (mock *ChainKeeperMock) GetCommandsGasLimitCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetCommandsGasLimit.RLock()
+	calls = mock.calls.GetCommandsGasLimit
+	mock.lockGetCommandsGasLimit.RUnlock()
+	return calls
+}
+
 // GetConfirmedDeposits calls GetConfirmedDepositsFunc.
 func (mock *ChainKeeperMock) GetConfirmedDeposits(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ERC20Deposit {
 	if mock.GetConfirmedDepositsFunc == nil {
@@ -4121,14 +4527,45 @@ func (mock *ChainKeeperMock) GetNetworkByIDCalls() []struct {
 	return calls
 }
 
+// GetOldestQueuedCommandHeight calls GetOldestQueuedCommandHeightFunc.
+func (mock *ChainKeeperMock) GetOldestQueuedCommandHeight(ctx github_com_cosmos_cosmos_sdk_types.Context) (int64, bool) {
+	if mock.GetOldestQueuedCommandHeightFunc == nil {
+		panic("ChainKeeperMock.GetOldestQueuedCommandHeightFunc: method is nil but ChainKeeper.GetOldestQueuedCommandHeight was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetOldestQueuedCommandHeight.Lock()
+	mock.calls.GetOldestQueuedCommandHeight = append(mock.calls.GetOldestQueuedCommandHeight, callInfo)
+	mock.lockGetOldestQueuedCommandHeight.Unlock()
+	return mock.GetOldestQueuedCommandHeightFunc(ctx)
+}
+
+// GetOldestQueuedCommandHeightCalls gets all the calls that were made to GetOldestQueuedCommandHeight.
+// Check the length with:
+//     len(mockedChainKeeper.GetOldestQueuedCommandHeightCalls())
+func (mock *ChainKeeperMock) GetOldestQueuedCommandHeightCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetOldestQueuedCommandHeight.RLock()
+	calls = mock.calls.GetOldestQueuedCommandHeight
+	mock.lockGetOldestQueuedCommandHeight.RUnlock()
+	return calls
+}
+
 // GetPendingDeposit calls GetPendingDepositFunc.
-func (mock *ChainKeeperMock) GetPendingDeposit(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.ERC20Deposit, bool) {
+func (mock *ChainKeeperMock) GetPendingDeposit(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) (types.ERC20Deposit, bool) {
 	if mock.GetPendingDepositFunc == nil {
 		panic("ChainKeeperMock.GetPendingDepositFunc: method is nil but ChainKeeper.GetPendingDeposit was just called")
 	}
 	callInfo := struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}{
 		Ctx: ctx,
 		Key: key,
@@ -4144,11 +4581,11 @@ func (mock *ChainKeeperMock) GetPendingDeposit(ctx github_com_cosmos_cosmos_sdk_
 //     len(mockedChainKeeper.GetPendingDepositCalls())
 func (mock *ChainKeeperMock) GetPendingDepositCalls() []struct {
 	Ctx github_com_cosmos_cosmos_sdk_types.Context
-	Key vote.PollKey
+	Key exported1.PollKey
 } {
 	var calls []struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}
 	mock.lockGetPendingDeposit.RLock()
 	calls = mock.calls.GetPendingDeposit
@@ -4188,13 +4625,13 @@ func (mock *ChainKeeperMock) GetPendingGatewayAddressCalls() []struct {
 }
 
 // GetPendingTransferKey calls GetPendingTransferKeyFunc.
-func (mock *ChainKeeperMock) GetPendingTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey) (types.TransferKey, bool) {
+func (mock *ChainKeeperMock) GetPendingTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey) (types.TransferKey, bool) {
 	if mock.GetPendingTransferKeyFunc == nil {
 		panic("ChainKeeperMock.GetPendingTransferKeyFunc: method is nil but ChainKeeper.GetPendingTransferKey was just called")
 	}
 	callInfo := struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}{
 		Ctx: ctx,
 		Key: key,
@@ -4210,11 +4647,11 @@ func (mock *ChainKeeperMock) GetPendingTransferKey(ctx github_com_cosmos_cosmos_
 //     len(mockedChainKeeper.GetPendingTransferKeyCalls())
 func (mock *ChainKeeperMock) GetPendingTransferKeyCalls() []struct {
 	Ctx github_com_cosmos_cosmos_sdk_types.Context
-	Key vote.PollKey
+	Key exported1.PollKey
 } {
 	var calls []struct {
 		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		Key vote.PollKey
+		Key exported1.PollKey
 	}
 	mock.lockGetPendingTransferKey.RLock()
 	calls = mock.calls.GetPendingTransferKey
@@ -4222,6 +4659,37 @@ func (mock *ChainKeeperMock) GetPendingTransferKeyCalls() []struct {
 	return calls
 }
 
+// GetQueuedCommands calls GetQueuedCommandsFunc.
+func (mock *ChainKeeperMock) GetQueuedCommands(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.Command {
+	if mock.GetQueuedCommandsFunc == nil {
+		panic("ChainKeeperMock.GetQueuedCommandsFunc: method is nil but ChainKeeper.GetQueuedCommands was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetQueuedCommands.Lock()
+	mock.calls.GetQueuedCommands = append(mock.calls.GetQueuedCommands, callInfo)
+	mock.lockGetQueuedCommands.Unlock()
+	return mock.GetQueuedCommandsFunc(ctx)
+}
+
+// GetQueuedCommandsCalls gets all the calls that were made to GetQueuedCommands.
+// Check the length with:
+//     len(mockedChainKeeper.GetQueuedCommandsCalls())
+func (mock *ChainKeeperMock) GetQueuedCommandsCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetQueuedCommands.RLock()
+	calls = mock.calls.GetQueuedCommands
+	mock.lockGetQueuedCommands.RUnlock()
+	return calls
+}
+
 // GetRequiredConfirmationHeight calls GetRequiredConfirmationHeightFunc.
 func (mock *ChainKeeperMock) GetRequiredConfirmationHeight(ctx github_com_cosmos_cosmos_sdk_types.Context) (uint64, bool) {
 	if mock.GetRequiredConfirmationHeightFunc == nil {
@@ -4408,6 +4876,41 @@ func (mock *ChainKeeperMock) LoggerCalls() []struct {
 	return calls
 }
 
+// SetAutoBatchRetryHeight calls SetAutoBatchRetryHeightFunc.
+func (mock *ChainKeeperMock) SetAutoBatchRetryHeight(ctx github_com_cosmos_cosmos_sdk_types.Context, height int64) {
+	if mock.SetAutoBatchRetryHeightFunc == nil {
+		panic("ChainKeeperMock.SetAutoBatchRetryHeightFunc: method is nil but ChainKeeper.SetAutoBatchRetryHeight was just called")
+	}
+	callInfo := struct {
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
+		Height int64
+	}{
+		Ctx:    ctx,
+		Height: height,
+	}
+	mock.lockSetAutoBatchRetryHeight.Lock()
+	mock.calls.SetAutoBatchRetryHeight = append(mock.calls.SetAutoBatchRetryHeight, callInfo)
+	mock.lockSetAutoBatchRetryHeight.Unlock()
+	mock.SetAutoBatchRetryHeightFunc(ctx, height)
+}
+
+// SetAutoBatchRetryHeightCalls gets all the calls that were made to SetAutoBatchRetryHeight.
+// Check the length with:
+//     len(mockedChainKeeper.SetAutoBatchRetryHeightCalls())
+func (mock *ChainKeeperMock) SetAutoBatchRetryHeightCalls() []struct {
+	Ctx    github_com_cosmos_cosmos_sdk_types.Context
+	Height int64
+} {
+	var calls []struct {
+		Ctx    github_com_cosmos_cosmos_sdk_types.Context
+		Height int64
+	}
+	mock.lockSetAutoBatchRetryHeight.RLock()
+	calls = mock.calls.SetAutoBatchRetryHeight
+	mock.lockSetAutoBatchRetryHeight.RUnlock()
+	return calls
+}
+
 // SetBurnerInfo calls SetBurnerInfoFunc.
 func (mock *ChainKeeperMock) SetBurnerInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, burnerAddr common.Address, burnerInfo *types.BurnerInfo) {
 	if mock.SetBurnerInfoFunc == nil {
@@ -4487,13 +4990,13 @@ func (mock *ChainKeeperMock) SetDepositCalls() []struct {
 }
 
 // SetPendingDeposit calls SetPendingDepositFunc.
-func (mock *ChainKeeperMock) SetPendingDeposit(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, deposit *types.ERC20Deposit) {
+func (mock *ChainKeeperMock) SetPendingDeposit(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, deposit *types.ERC20Deposit) {
 	if mock.SetPendingDepositFunc == nil {
 		panic("ChainKeeperMock.SetPendingDepositFunc: method is nil but ChainKeeper.SetPendingDeposit was just called")
 	}
 	callInfo := struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Key     vote.PollKey
+		Key     exported1.PollKey
 		Deposit *types.ERC20Deposit
 	}{
 		Ctx:     ctx,
@@ -4511,12 +5014,12 @@ func (mock *ChainKeeperMock) SetPendingDeposit(ctx github_com_cosmos_cosmos_sdk_
 //     len(mockedChainKeeper.SetPendingDepositCalls())
 func (mock *ChainKeeperMock) SetPendingDepositCalls() []struct {
 	Ctx     github_com_cosmos_cosmos_sdk_types.Context
-	Key     vote.PollKey
+	Key     exported1.PollKey
 	Deposit *types.ERC20Deposit
 } {
 	var calls []struct {
 		Ctx     github_com_cosmos_cosmos_sdk_types.Context
-		Key     vote.PollKey
+		Key     exported1.PollKey
 		Deposit *types.ERC20Deposit
 	}
 	mock.lockSetPendingDeposit.RLock()
@@ -4561,13 +5064,13 @@ func (mock *ChainKeeperMock) SetPendingGatewayCalls() []struct {
 }
 
 // SetPendingTransferKey calls SetPendingTransferKeyFunc.
-func (mock *ChainKeeperMock) SetPendingTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key vote.PollKey, transferOwnership *types.TransferKey) {
+func (mock *ChainKeeperMock) SetPendingTransferKey(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, transferOwnership *types.TransferKey) {
 	if mock.SetPendingTransferKeyFunc == nil {
 		panic("ChainKeeperMock.SetPendingTransferKeyFunc: method is nil but ChainKeeper.SetPendingTransferKey was just called")
 	}
 	callInfo := struct {
 		Ctx               github_com_cosmos_cosmos_sdk_types.Context
-		Key               vote.PollKey
+		Key               exported1.PollKey
 		TransferOwnership *types.TransferKey
 	}{
 		Ctx:               ctx,
@@ -4585,12 +5088,12 @@ func (mock *ChainKeeperMock) SetPendingTransferKey(ctx github_com_cosmos_cosmos_
 //     len(mockedChainKeeper.SetPendingTransferKeyCalls())
 func (mock *ChainKeeperMock) SetPendingTransferKeyCalls() []struct {
 	Ctx               github_com_cosmos_cosmos_sdk_types.Context
-	Key               vote.PollKey
+	Key               exported1.PollKey
 	TransferOwnership *types.TransferKey
 } {
 	var calls []struct {
 		Ctx               github_com_cosmos_cosmos_sdk_types.Context
-		Key               vote.PollKey
+		Key               exported1.PollKey
 		TransferOwnership *types.TransferKey
 	}
 	mock.lockSetPendingTransferKey.RLock()
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -69,3 +70,8 @@ func (m AddChainRequest) GetSignBytes() []byte {
 func (m AddChainRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// GetRequiredRole implements permission.RestrictedMsg
+func (m AddChainRequest) GetRequiredRole() permission.Role {
+	return permission.ChainManagement
+}
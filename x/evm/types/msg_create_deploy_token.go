@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 )
 
 // NewCreateDeployTokenRequest is the constructor for CreateDeployTokenRequest
@@ -38,6 +40,11 @@ func (m CreateDeployTokenRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
 
+// GetRequiredRole implements permission.RestrictedMsg
+func (m CreateDeployTokenRequest) GetRequiredRole() permission.Role {
+	return permission.ChainManagement
+}
+
 // ValidateBasic implements sdk.Msg
 func (m CreateDeployTokenRequest) ValidateBasic() error {
 	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
@@ -55,3 +56,8 @@ func (m CreateTransferOperatorshipRequest) GetSignBytes() []byte {
 func (m CreateTransferOperatorshipRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// GetRequiredRole implements permission.RestrictedMsg
+func (m CreateTransferOperatorshipRequest) GetRequiredRole() permission.Role {
+	return permission.KeyManagement
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
@@ -34,6 +35,11 @@ func (m CreateTransferOwnershipRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
 
+// GetRequiredRole implements permission.RestrictedMsg
+func (m CreateTransferOwnershipRequest) GetRequiredRole() permission.Role {
+	return permission.KeyManagement
+}
+
 // ValidateBasic implements sdk.Msg
 func (m CreateTransferOwnershipRequest) ValidateBasic() error {
 	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewReportDepositRequest creates a message of type ReportDepositRequest
+func NewReportDepositRequest(sender sdk.AccAddress, chain string, txID common.Hash, amount sdk.Uint, burnerAddr common.Address) *ReportDepositRequest {
+	return &ReportDepositRequest{
+		Sender:        sender,
+		Chain:         chain,
+		TxID:          Hash(txID),
+		Amount:        amount,
+		BurnerAddress: Address(burnerAddr),
+	}
+}
+
+// Route implements sdk.Msg
+func (m ReportDepositRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m ReportDepositRequest) Type() string {
+	return "ReportERC20Deposit"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m ReportDepositRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if m.Amount.IsZero() {
+		return fmt.Errorf("deposit amount must be greater than zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m ReportDepositRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m ReportDepositRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
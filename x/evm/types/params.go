@@ -28,6 +28,7 @@ var (
 	KeyMinVoterCount       = []byte("minVoterCount")
 	KeyCommandsGasLimit    = []byte("commandsGasLimit")
 	KeyTransactionFeeRate  = []byte("transactionFeeRate")
+	KeyAutoBatch           = []byte("autoBatch")
 )
 
 // KeyTable returns a subspace.KeyTable that has registered all parameter types in this module's parameter set
@@ -84,6 +85,11 @@ func DefaultParams() []Params {
 		MinVoterCount:      1,
 		CommandsGasLimit:   5000000,
 		TransactionFeeRate: sdk.NewDecWithPrec(25, 5), // 0.025%
+		AutoBatch: AutoBatchConfig{
+			Enabled:      false,
+			MaxWait:      50,
+			GasThreshold: utils.Threshold{Numerator: 80, Denominator: 100},
+		},
 	}}
 }
 
@@ -109,6 +115,7 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyMinVoterCount, &m.MinVoterCount, validateMinVoterCount),
 		params.NewParamSetPair(KeyCommandsGasLimit, &m.CommandsGasLimit, validateCommandsGasLimit),
 		params.NewParamSetPair(KeyTransactionFeeRate, &m.TransactionFeeRate, validateTransactionFeeRate),
+		params.NewParamSetPair(KeyAutoBatch, &m.AutoBatch, validateAutoBatch),
 	}
 }
 
@@ -249,6 +256,32 @@ func validateTransactionFeeRate(i interface{}) error {
 	return nil
 }
 
+func validateAutoBatch(autoBatch interface{}) error {
+	val, ok := autoBatch.(AutoBatchConfig)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for AutoBatch: %T", autoBatch)
+	}
+
+	// the remaining settings are irrelevant as long as auto batching is disabled
+	if !val.Enabled {
+		return nil
+	}
+
+	if val.MaxWait <= 0 {
+		return fmt.Errorf("max wait must be >0 for AutoBatch")
+	}
+
+	if val.GasThreshold.Numerator <= 0 || val.GasThreshold.Denominator <= 0 {
+		return fmt.Errorf("gas threshold must be a positive fraction for AutoBatch")
+	}
+
+	if val.GasThreshold.Numerator > val.GasThreshold.Denominator {
+		return fmt.Errorf("gas threshold must be <=1 for AutoBatch")
+	}
+
+	return nil
+}
+
 // Validate checks the validity of the values of the parameter set
 func (m Params) Validate() error {
 	if err := validateConfirmationHeight(m.ConfirmationHeight); err != nil {
@@ -279,6 +312,10 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateAutoBatch(m.AutoBatch); err != nil {
+		return err
+	}
+
 	// ensure that the network is one of the supported ones
 	for _, n := range m.Networks {
 		if n.Name == m.Network {
@@ -39,6 +39,7 @@ type Params struct {
 	MinVoterCount       int64                                  `protobuf:"varint,10,opt,name=min_voter_count,json=minVoterCount,proto3" json:"min_voter_count,omitempty"`
 	CommandsGasLimit    uint32                                 `protobuf:"varint,11,opt,name=commands_gas_limit,json=commandsGasLimit,proto3" json:"commands_gas_limit,omitempty"`
 	TransactionFeeRate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=transaction_fee_rate,json=transactionFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"transaction_fee_rate"`
+	AutoBatch           AutoBatchConfig                        `protobuf:"bytes,13,opt,name=auto_batch,json=autoBatch,proto3" json:"auto_batch"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -74,46 +75,96 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// AutoBatchConfig determines when queued commands are batched and signed
+// automatically at the end of a block
+type AutoBatchConfig struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// maximum number of blocks a queued command waits before it gets batched
+	MaxWait int64 `protobuf:"varint,2,opt,name=max_wait,json=maxWait,proto3" json:"max_wait,omitempty"`
+	// share of the commands gas limit that the queued commands need to fill to
+	// get batched before max_wait is reached
+	GasThreshold utils.Threshold `protobuf:"bytes,3,opt,name=gas_threshold,json=gasThreshold,proto3" json:"gas_threshold"`
+}
+
+func (m *AutoBatchConfig) Reset()         { *m = AutoBatchConfig{} }
+func (m *AutoBatchConfig) String() string { return proto.CompactTextString(m) }
+func (*AutoBatchConfig) ProtoMessage()    {}
+func (*AutoBatchConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f93e40c01ed2cb88, []int{1}
+}
+func (m *AutoBatchConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoBatchConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoBatchConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoBatchConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoBatchConfig.Merge(m, src)
+}
+func (m *AutoBatchConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoBatchConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoBatchConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoBatchConfig proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "evm.v1beta1.Params")
+	proto.RegisterType((*AutoBatchConfig)(nil), "evm.v1beta1.AutoBatchConfig")
 }
 
 func init() { proto.RegisterFile("evm/v1beta1/params.proto", fileDescriptor_f93e40c01ed2cb88) }
 
 var fileDescriptor_f93e40c01ed2cb88 = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xb1, 0x6e, 0xdb, 0x3c,
-	0x14, 0x85, 0xad, 0xdf, 0x8e, 0xe3, 0xd0, 0x09, 0x12, 0x30, 0xfe, 0x51, 0xc2, 0x40, 0x15, 0xa1,
-	0x43, 0xa0, 0xa1, 0x91, 0x9a, 0x74, 0xeb, 0xe8, 0x16, 0x6d, 0x03, 0x04, 0x41, 0x20, 0x14, 0x1d,
-	0xba, 0xa8, 0xb4, 0x7c, 0x2d, 0x11, 0x16, 0x49, 0x83, 0xa4, 0x9d, 0xe4, 0x2d, 0xfa, 0x18, 0x7d,
-	0x14, 0x8f, 0x19, 0x8b, 0x0e, 0x41, 0x6b, 0xbf, 0x48, 0x41, 0x4a, 0x16, 0x3c, 0x89, 0xe7, 0x7c,
-	0xd4, 0xb9, 0x17, 0xbc, 0x17, 0x11, 0x58, 0xf2, 0x78, 0x79, 0x39, 0x06, 0x43, 0x2f, 0xe3, 0x39,
-	0x55, 0x94, 0xeb, 0x68, 0xae, 0xa4, 0x91, 0xb8, 0x0f, 0x4b, 0x1e, 0xd5, 0x64, 0xf8, 0x72, 0x61,
-	0x58, 0xa9, 0x9b, 0x8b, 0xa6, 0x50, 0xa0, 0x0b, 0x59, 0x4e, 0xaa, 0xbb, 0xc3, 0x17, 0xbb, 0x29,
-	0xe6, 0x71, 0x0e, 0x75, 0xc8, 0x70, 0x90, 0xcb, 0x5c, 0xba, 0x63, 0x6c, 0x4f, 0x95, 0xfb, 0xea,
-	0x67, 0x07, 0x75, 0xef, 0x5c, 0x2d, 0x3c, 0x40, 0x7b, 0x59, 0x41, 0x99, 0x20, 0x5e, 0xe0, 0x85,
-	0x07, 0x49, 0x25, 0x70, 0x8c, 0x4e, 0x33, 0x29, 0xa6, 0x4c, 0x71, 0x6a, 0x98, 0x14, 0x69, 0x01,
-	0x2c, 0x2f, 0x0c, 0xf9, 0x2f, 0xf0, 0xc2, 0x4e, 0x82, 0x77, 0xd1, 0x67, 0x47, 0x30, 0x41, 0xfb,
-	0x02, 0xcc, 0xbd, 0x54, 0x33, 0xd2, 0x76, 0x41, 0x5b, 0x69, 0x49, 0x4e, 0x0d, 0xdc, 0xd3, 0x47,
-	0xd2, 0x09, 0xbc, 0xf0, 0x30, 0xd9, 0x4a, 0x5b, 0xda, 0xc8, 0x19, 0x08, 0xb2, 0xe7, 0xfc, 0x4a,
-	0xe0, 0x21, 0xea, 0x8d, 0x17, 0x4a, 0xd0, 0x71, 0x09, 0xa4, 0xeb, 0x40, 0xa3, 0xf1, 0x15, 0xfa,
-	0x5f, 0xc1, 0x52, 0x1a, 0x48, 0x4b, 0x99, 0xcd, 0x98, 0xc8, 0xd3, 0x39, 0x28, 0x26, 0x27, 0x64,
-	0x3f, 0xf0, 0xc2, 0x76, 0x72, 0x5a, 0xc1, 0x9b, 0x8a, 0xdd, 0x39, 0x84, 0xdf, 0xa1, 0x5e, 0xdd,
-	0x8a, 0x26, 0xbd, 0xa0, 0x1d, 0xf6, 0xaf, 0x48, 0xb4, 0xf3, 0xb2, 0xd1, 0x6d, 0x05, 0xaf, 0xc5,
-	0x54, 0x8e, 0x3a, 0xab, 0xe7, 0xb3, 0x56, 0xd2, 0xdc, 0xc7, 0xd7, 0xe8, 0x64, 0x29, 0x8d, 0xad,
-	0xd3, 0x3c, 0x38, 0x39, 0x08, 0x3c, 0x97, 0xe1, 0x06, 0xd2, 0xa4, 0x7c, 0xd9, 0xf2, 0x3a, 0xe3,
-	0xb8, 0xfa, 0xaf, 0xb1, 0xf1, 0x39, 0x3a, 0xe6, 0x4c, 0xa4, 0xb6, 0x3f, 0x95, 0x66, 0x72, 0x21,
-	0x0c, 0x41, 0xae, 0xe9, 0x23, 0xce, 0xc4, 0x57, 0xeb, 0xbe, 0xb7, 0x26, 0x7e, 0x8d, 0x70, 0x26,
-	0x39, 0xa7, 0x62, 0xa2, 0xd3, 0x9c, 0xea, 0xb4, 0x64, 0x9c, 0x19, 0xd2, 0x0f, 0xbc, 0xf0, 0x28,
-	0x39, 0xd9, 0x92, 0x4f, 0x54, 0xdf, 0x58, 0x1f, 0x7f, 0x47, 0x03, 0xa3, 0xa8, 0xd0, 0x34, 0x73,
-	0x63, 0x9a, 0x02, 0xa4, 0x8a, 0x1a, 0x20, 0x87, 0x76, 0x06, 0xa3, 0xc8, 0xb6, 0xf2, 0xfb, 0xf9,
-	0xec, 0x3c, 0x67, 0xa6, 0x58, 0x8c, 0xa3, 0x4c, 0xf2, 0x38, 0x93, 0x9a, 0x4b, 0x5d, 0x7f, 0x2e,
-	0xf4, 0x64, 0x56, 0xaf, 0xcb, 0x07, 0xc8, 0x12, 0xbc, 0x93, 0xf5, 0x11, 0x20, 0xa1, 0x06, 0x46,
-	0xb7, 0xab, 0xbf, 0x7e, 0x6b, 0xb5, 0xf6, 0xbd, 0xa7, 0xb5, 0xef, 0xfd, 0x59, 0xfb, 0xde, 0x8f,
-	0x8d, 0xdf, 0x7a, 0xda, 0xf8, 0xad, 0x5f, 0x1b, 0xbf, 0xf5, 0xed, 0xcd, 0x4e, 0x32, 0x7d, 0x80,
-	0x92, 0xaa, 0xfa, 0xed, 0x6a, 0x75, 0x91, 0x49, 0x05, 0xf1, 0x43, 0x6c, 0xd7, 0xd3, 0xd5, 0x19,
-	0x77, 0xdd, 0x06, 0xbe, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0xce, 0x37, 0x1b, 0xf1, 0xf8, 0x02,
-	0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0xda, 0x02, 0x65, 0xa0, 0x81, 0x0c, 0x18, 0xc7, 0x46, 0x97, 0x0d, 0x07, 0xd2, 0x83,
+	0x74, 0x05, 0x6f, 0xde, 0x00, 0xa3, 0x92, 0x10, 0x42, 0x36, 0x46, 0x13, 0x2f, 0xeb, 0xeb, 0x76,
+	0xd8, 0x9d, 0xb4, 0x33, 0xd3, 0xcc, 0xcc, 0x96, 0xf2, 0x37, 0x78, 0xf1, 0xcf, 0xe2, 0xc8, 0xd1,
+	0x78, 0x20, 0x4a, 0x8f, 0xfe, 0x13, 0x66, 0x66, 0x7f, 0x50, 0x3d, 0x79, 0xea, 0x7c, 0xdf, 0xf7,
+	0xfa, 0xcd, 0xdb, 0xef, 0xbd, 0x41, 0x84, 0x4e, 0x79, 0x38, 0x3d, 0x18, 0x50, 0x03, 0x07, 0xe1,
+	0x04, 0x14, 0x70, 0xdd, 0x9f, 0x28, 0x69, 0x24, 0x5e, 0xa3, 0x53, 0xde, 0x2f, 0x95, 0xee, 0xf3,
+	0xdc, 0xb0, 0xb1, 0xae, 0x0b, 0x4d, 0xa6, 0xa8, 0xce, 0xe4, 0x78, 0x58, 0xd4, 0x76, 0x9f, 0x2c,
+	0xba, 0x98, 0xeb, 0x09, 0x2d, 0x4d, 0xba, 0xdb, 0xa9, 0x4c, 0xa5, 0x3b, 0x86, 0xf6, 0x54, 0xb0,
+	0xbb, 0xbf, 0x5b, 0x68, 0xf9, 0xc2, 0xdd, 0x85, 0xb7, 0xd1, 0x52, 0x92, 0x01, 0x13, 0xc4, 0x0b,
+	0xbc, 0xde, 0x6a, 0x54, 0x00, 0x1c, 0xa2, 0xad, 0x44, 0x8a, 0x4b, 0xa6, 0x38, 0x18, 0x26, 0x45,
+	0x9c, 0x51, 0x96, 0x66, 0x86, 0x3c, 0x0a, 0xbc, 0x5e, 0x2b, 0xc2, 0x8b, 0xd2, 0x7b, 0xa7, 0x60,
+	0x82, 0x56, 0x04, 0x35, 0x57, 0x52, 0x8d, 0x48, 0xd3, 0x19, 0x55, 0xd0, 0x2a, 0x29, 0x18, 0x7a,
+	0x05, 0xd7, 0xa4, 0x15, 0x78, 0xbd, 0xf5, 0xa8, 0x82, 0xf6, 0x6a, 0x23, 0x47, 0x54, 0x90, 0x25,
+	0xc7, 0x17, 0x00, 0x77, 0x51, 0x7b, 0x90, 0x2b, 0x01, 0x83, 0x31, 0x25, 0xcb, 0x4e, 0xa8, 0x31,
+	0x3e, 0x44, 0x8f, 0x15, 0x9d, 0x4a, 0x43, 0xe3, 0xb1, 0x4c, 0x46, 0x4c, 0xa4, 0xf1, 0x84, 0x2a,
+	0x26, 0x87, 0x64, 0x25, 0xf0, 0x7a, 0xcd, 0x68, 0xab, 0x10, 0xcf, 0x0a, 0xed, 0xc2, 0x49, 0xf8,
+	0x35, 0x6a, 0x97, 0xad, 0x68, 0xd2, 0x0e, 0x9a, 0xbd, 0xb5, 0x43, 0xd2, 0x5f, 0x48, 0xb6, 0x7f,
+	0x5e, 0x88, 0xa7, 0xe2, 0x52, 0x1e, 0xb7, 0x6e, 0xee, 0x76, 0x1a, 0x51, 0x5d, 0x8f, 0x4f, 0xd1,
+	0xe6, 0x54, 0x1a, 0x7b, 0x4f, 0x1d, 0x38, 0x59, 0x0d, 0x3c, 0xe7, 0xe1, 0x06, 0x52, 0xbb, 0x7c,
+	0xa8, 0xf4, 0xd2, 0x63, 0xa3, 0xf8, 0x5f, 0x4d, 0xe3, 0x3d, 0xb4, 0xc1, 0x99, 0x88, 0x6d, 0x7f,
+	0x2a, 0x4e, 0x64, 0x2e, 0x0c, 0x41, 0xae, 0xe9, 0x0e, 0x67, 0xe2, 0xa3, 0x65, 0x4f, 0x2c, 0x89,
+	0x5f, 0x20, 0x9c, 0x48, 0xce, 0x41, 0x0c, 0x75, 0x9c, 0x82, 0x8e, 0xc7, 0x8c, 0x33, 0x43, 0xd6,
+	0x02, 0xaf, 0xd7, 0x89, 0x36, 0x2b, 0xe5, 0x1d, 0xe8, 0x33, 0xcb, 0xe3, 0x2f, 0x68, 0xdb, 0x28,
+	0x10, 0x1a, 0x12, 0x37, 0xa6, 0x4b, 0x4a, 0x63, 0x05, 0x86, 0x92, 0x75, 0x3b, 0x83, 0xe3, 0xbe,
+	0x6d, 0xe5, 0xc7, 0xdd, 0xce, 0x5e, 0xca, 0x4c, 0x96, 0x0f, 0xfa, 0x89, 0xe4, 0x61, 0x22, 0x35,
+	0x97, 0xba, 0xfc, 0xd9, 0xd7, 0xc3, 0x51, 0xb9, 0x2e, 0x6f, 0x68, 0x12, 0xe1, 0x05, 0xaf, 0xb7,
+	0x94, 0x46, 0x60, 0x28, 0x3e, 0x42, 0x08, 0x72, 0x23, 0xe3, 0x01, 0x98, 0x24, 0x23, 0x1d, 0xf7,
+	0xf1, 0xcf, 0xfe, 0x0a, 0xf0, 0x28, 0x37, 0xf2, 0xd8, 0xaa, 0x27, 0x76, 0x2d, 0xd2, 0x32, 0x80,
+	0x55, 0xa8, 0xe8, 0xdd, 0xaf, 0x1e, 0xda, 0xf8, 0xa7, 0xc8, 0x6e, 0x05, 0x75, 0x33, 0x1d, 0xba,
+	0xc5, 0x6b, 0x47, 0x15, 0xc4, 0x4f, 0x51, 0x9b, 0xc3, 0x2c, 0xbe, 0x02, 0x56, 0xec, 0x5b, 0x33,
+	0x5a, 0xe1, 0x30, 0xfb, 0x04, 0xcc, 0xe0, 0x13, 0xd4, 0xb1, 0x91, 0x3c, 0xcc, 0xa2, 0xf9, 0x5f,
+	0xb3, 0x58, 0x4f, 0x41, 0x3f, 0x70, 0xe7, 0x37, 0xbf, 0xfc, 0xc6, 0xcd, 0xbd, 0xef, 0xdd, 0xde,
+	0xfb, 0xde, 0xcf, 0x7b, 0xdf, 0xfb, 0x36, 0xf7, 0x1b, 0xb7, 0x73, 0xbf, 0xf1, 0x7d, 0xee, 0x37,
+	0x3e, 0xbf, 0x5c, 0x88, 0x0a, 0x66, 0x74, 0x0c, 0xaa, 0x5c, 0x86, 0x12, 0xed, 0x27, 0x52, 0xd1,
+	0x70, 0x16, 0xda, 0xf7, 0xe6, 0x82, 0x1b, 0x2c, 0xbb, 0x27, 0xf5, 0xea, 0xcf, 0x00, 0x0a, 0x0a,
+	0x8a, 0x98, 0xc9, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -136,6 +187,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.AutoBatch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
 	{
 		size := m.TransactionFeeRate.Size()
 		i -= size
@@ -228,6 +289,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoBatchConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoBatchConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoBatchConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxWait != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxWait))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -287,6 +396,25 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.TransactionFeeRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.AutoBatch.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *AutoBatchConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxWait != 0 {
+		n += 1 + sovParams(uint64(m.MaxWait))
+	}
+	l = m.GasThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -668,6 +796,161 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoBatchConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoBatchConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoBatchConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWait", wireType)
+			}
+			m.MaxWait = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWait |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
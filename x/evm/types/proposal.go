@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+// proposal types
+const (
+	ProposalTypeAddEVMChain = "AddEVMChain"
+)
+
+var _ govtypes.Content = &AddEVMChainProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddEVMChain)
+	govtypes.RegisterProposalTypeCodec(&AddEVMChainProposal{}, "evm/AddEVMChainProposal")
+}
+
+// NewAddEVMChainProposal is the constructor for AddEVMChainProposal
+func NewAddEVMChainProposal(title, description, name, nativeAsset string, keyType tss.KeyType, params Params) *AddEVMChainProposal {
+	return &AddEVMChainProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+		NativeAsset: nativeAsset,
+		KeyType:     keyType,
+		Params:      params,
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (m AddEVMChainProposal) GetTitle() string { return m.Title }
+
+// GetDescription implements govtypes.Content
+func (m AddEVMChainProposal) GetDescription() string { return m.Description }
+
+// ProposalRoute implements govtypes.Content
+func (m AddEVMChainProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (m AddEVMChainProposal) ProposalType() string { return ProposalTypeAddEVMChain }
+
+// ValidateBasic implements govtypes.Content
+func (m AddEVMChainProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	return m.ToRequest().ValidateBasic()
+}
+
+// String implements govtypes.Content
+func (m AddEVMChainProposal) String() string {
+	return fmt.Sprintf(`Add EVM Chain Proposal:
+  Title:        %s
+  Description:  %s
+  Name:         %s
+  Native Asset: %s
+  Key Type:     %s
+`, m.Title, m.Description, m.Name, m.NativeAsset, m.KeyType.SimpleString())
+}
+
+// ToRequest returns the AddChainRequest that is executed on behalf of the gov module when the proposal passes
+func (m AddEVMChainProposal) ToRequest() *AddChainRequest {
+	return NewAddChainRequest(authtypes.NewModuleAddress(govtypes.ModuleName), m.Name, m.NativeAsset, m.KeyType, m.Params)
+}
@@ -0,0 +1,527 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evm/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddEVMChainProposal is a gov proposal to add a new EVM chain
+type AddEVMChainProposal struct {
+	Title       string           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Name        string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	NativeAsset string           `protobuf:"bytes,4,opt,name=native_asset,json=nativeAsset,proto3" json:"native_asset,omitempty"`
+	KeyType     exported.KeyType `protobuf:"varint,5,opt,name=key_type,json=keyType,proto3,enum=tss.exported.v1beta1.KeyType" json:"key_type,omitempty"`
+	Params      Params           `protobuf:"bytes,6,opt,name=params,proto3,customtype=Params" json:"params"`
+}
+
+func (m *AddEVMChainProposal) Reset()      { *m = AddEVMChainProposal{} }
+func (*AddEVMChainProposal) ProtoMessage() {}
+func (*AddEVMChainProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59e3e82c55b76443, []int{0}
+}
+func (m *AddEVMChainProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddEVMChainProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddEVMChainProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddEVMChainProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEVMChainProposal.Merge(m, src)
+}
+func (m *AddEVMChainProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddEVMChainProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEVMChainProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddEVMChainProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AddEVMChainProposal)(nil), "evm.v1beta1.AddEVMChainProposal")
+}
+
+func init() { proto.RegisterFile("evm/v1beta1/proposal.proto", fileDescriptor_59e3e82c55b76443) }
+
+var fileDescriptor_59e3e82c55b76443 = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xff, 0x6f, 0x03, 0xb8, 0x55, 0x07, 0xd3, 0x21, 0xaa, 0x44, 0x1a, 0x18, 0x50,
+	0x17, 0x62, 0x0a, 0x0b, 0x62, 0x6b, 0x11, 0x13, 0x02, 0x55, 0x15, 0x62, 0x60, 0xa9, 0xdc, 0xe6,
+	0xaa, 0x8d, 0xda, 0xc4, 0x96, 0x6d, 0x42, 0xf3, 0x16, 0x8c, 0x8c, 0x3c, 0x4e, 0xc7, 0x8e, 0x88,
+	0xa1, 0x82, 0x76, 0xe5, 0x21, 0x50, 0x9c, 0x14, 0xb1, 0x9d, 0xfb, 0x9d, 0x6b, 0xfb, 0xfa, 0x5c,
+	0xdc, 0x80, 0x24, 0xa2, 0x49, 0x7b, 0x08, 0x9a, 0xb5, 0xa9, 0x90, 0x5c, 0x70, 0xc5, 0x66, 0xbe,
+	0x90, 0x5c, 0x73, 0x52, 0x81, 0x24, 0xf2, 0x0b, 0xaf, 0x51, 0x1f, 0xf3, 0x31, 0x37, 0x9c, 0x66,
+	0x2a, 0x6f, 0x69, 0x78, 0x5a, 0x29, 0x0a, 0x73, 0xc1, 0xa5, 0x86, 0xe0, 0xf7, 0x1e, 0x9d, 0x0a,
+	0x50, 0x79, 0xc7, 0xd1, 0x37, 0xc2, 0xfb, 0x9d, 0x20, 0xb8, 0x7e, 0xb8, 0xbd, 0x9a, 0xb0, 0x30,
+	0xee, 0x15, 0x4f, 0x90, 0x3a, 0x2e, 0xeb, 0x50, 0xcf, 0xc0, 0x41, 0x1e, 0x6a, 0xed, 0xf5, 0xf3,
+	0x82, 0x78, 0xb8, 0x12, 0x80, 0x1a, 0xc9, 0x50, 0xe8, 0x90, 0xc7, 0xce, 0x3f, 0xe3, 0xfd, 0x45,
+	0x84, 0xe0, 0x52, 0xcc, 0x22, 0x70, 0xfe, 0x1b, 0xcb, 0x68, 0x72, 0x88, 0xab, 0x31, 0xd3, 0x61,
+	0x02, 0x03, 0xa6, 0x14, 0x68, 0xa7, 0x94, 0x1f, 0xcb, 0x59, 0x27, 0x43, 0xe4, 0x02, 0xef, 0x4e,
+	0x21, 0x1d, 0x64, 0x93, 0x39, 0x65, 0x0f, 0xb5, 0x6a, 0x67, 0x07, 0xbe, 0x56, 0xca, 0xdf, 0xce,
+	0xbe, 0xfd, 0xa7, 0x7f, 0x03, 0xe9, 0x7d, 0x2a, 0xa0, 0xbf, 0x33, 0xcd, 0x05, 0x39, 0xc6, 0xb6,
+	0x60, 0x92, 0x45, 0xca, 0xb1, 0x3d, 0xd4, 0xaa, 0x76, 0x6b, 0x8b, 0x55, 0xd3, 0xfa, 0x58, 0x35,
+	0xed, 0x9e, 0xa1, 0xfd, 0xc2, 0xbd, 0x2c, 0xbd, 0xbe, 0x35, 0xad, 0xee, 0xdd, 0xe2, 0xcb, 0xb5,
+	0x16, 0x6b, 0x17, 0x2d, 0xd7, 0x2e, 0xfa, 0x5c, 0xbb, 0xe8, 0x65, 0xe3, 0x5a, 0xcb, 0x8d, 0x6b,
+	0xbd, 0x6f, 0x5c, 0xeb, 0xf1, 0x74, 0x1c, 0xea, 0xc9, 0xd3, 0xd0, 0x1f, 0xf1, 0x88, 0xb2, 0x39,
+	0xcc, 0x98, 0x8c, 0x41, 0x3f, 0x73, 0x39, 0x2d, 0xaa, 0x93, 0x11, 0x97, 0x40, 0xe7, 0x34, 0x5b,
+	0x8a, 0x09, 0x71, 0x68, 0x9b, 0x14, 0xcf, 0x7f, 0x06, 0x00, 0xeb, 0x66, 0x43, 0x7f, 0xa8, 0x01,
+	0x00, 0x00,
+}
+
+func (m *AddEVMChainProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddEVMChainProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddEVMChainProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Params.Size()
+		i -= size
+		if _, err := m.Params.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.KeyType != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NativeAsset) > 0 {
+		i -= len(m.NativeAsset)
+		copy(dAtA[i:], m.NativeAsset)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.NativeAsset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddEVMChainProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.NativeAsset)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovProposal(uint64(m.KeyType))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddEVMChainProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddEVMChainProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddEVMChainProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= exported.KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
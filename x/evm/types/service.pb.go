@@ -33,61 +33,81 @@ func init() { proto.RegisterFile("evm/v1beta1/service.proto", fileDescriptor_e67
 func init() { golang_proto.RegisterFile("evm/v1beta1/service.proto", fileDescriptor_e674b0c159b5e0b5) }
 
 var fileDescriptor_e674b0c159b5e0b5 = []byte{
-	// 855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0xc7, 0x7d, 0x45, 0x51, 0x14, 0xd7, 0xa2, 0x70, 0x09, 0xd7, 0xb5, 0x05, 0x97, 0xb5, 0x69,
-	0x49, 0xae, 0x25, 0x53, 0x94, 0x6c, 0xa0, 0x43, 0xb7, 0x5a, 0x06, 0x3a, 0xf4, 0x27, 0xea, 0xa2,
-	0x43, 0x97, 0x82, 0xa2, 0x9e, 0xe9, 0xab, 0xa4, 0x3b, 0x96, 0x3c, 0xc9, 0x16, 0x82, 0x00, 0x49,
-	0x96, 0x00, 0x19, 0x82, 0x20, 0x59, 0x32, 0x27, 0x40, 0x86, 0x8c, 0x59, 0xb3, 0x64, 0xcc, 0x68,
-	0x20, 0x4b, 0xb2, 0x05, 0x56, 0xfe, 0x90, 0x80, 0xc7, 0x3b, 0x99, 0xa4, 0x48, 0x4a, 0xd9, 0xec,
-	0x7b, 0xdf, 0xf7, 0xbe, 0x1f, 0xbd, 0xf7, 0x8e, 0x24, 0x5e, 0x87, 0xd1, 0xc0, 0x1a, 0xb5, 0x3a,
-	0xc0, 0xed, 0x96, 0x15, 0x80, 0x3f, 0x22, 0x0e, 0x34, 0x3c, 0x9f, 0x71, 0xa6, 0x7d, 0x06, 0xa3,
-	0x41, 0x43, 0x86, 0x4a, 0x2b, 0x2e, 0x73, 0x99, 0x38, 0xb7, 0xc2, 0xbf, 0x22, 0x49, 0x69, 0xc3,
-	0x65, 0xcc, 0xed, 0x83, 0x65, 0x7b, 0xc4, 0xb2, 0x29, 0x65, 0xdc, 0xe6, 0x84, 0xd1, 0x40, 0x46,
-	0x57, 0xe2, 0xb5, 0xf9, 0x79, 0x74, 0xba, 0xff, 0x66, 0x05, 0xe3, 0x5f, 0x03, 0xf7, 0x38, 0xf2,
-	0xd2, 0xfe, 0xc3, 0x1f, 0xff, 0x42, 0x68, 0x4f, 0x5b, 0x6b, 0xc4, 0xec, 0x1a, 0xe1, 0xd1, 0x9f,
-	0xf0, 0xff, 0x10, 0x02, 0x5e, 0x5a, 0xcf, 0x88, 0x04, 0x1e, 0xa3, 0x01, 0x18, 0xe6, 0xad, 0x57,
-	0xef, 0x1e, 0x7c, 0xb4, 0x63, 0x18, 0x96, 0x7d, 0x0e, 0x7d, 0xdb, 0xb7, 0x42, 0xc7, 0x3e, 0xa1,
-	0x3d, 0xeb, 0x9a, 0x0f, 0x0e, 0xf1, 0x08, 0x50, 0xfe, 0xaf, 0x73, 0x6a, 0x13, 0x7a, 0xfd, 0x07,
-	0x54, 0xd3, 0xc6, 0xf8, 0xf3, 0x36, 0xa3, 0x27, 0xc4, 0x1f, 0xb4, 0xc3, 0x33, 0x6d, 0x33, 0x51,
-	0x39, 0x1e, 0x52, 0xde, 0x5b, 0x05, 0x0a, 0xc9, 0x50, 0x16, 0x0c, 0xba, 0xb1, 0x1e, 0x67, 0x70,
-	0x22, 0xa5, 0x29, 0xbc, 0x43, 0xeb, 0xa7, 0x08, 0xaf, 0xc9, 0xf4, 0x9f, 0x6c, 0x0e, 0x67, 0xf6,
-	0xf8, 0x08, 0xbc, 0x3e, 0x1b, 0x0f, 0x80, 0x72, 0x6d, 0x2f, 0xcb, 0x65, 0x46, 0xa6, 0x98, 0xcc,
-	0x05, 0xd5, 0x92, 0xaf, 0x25, 0xf8, 0xea, 0x46, 0x35, 0x8b, 0xcf, 0x8d, 0xd2, 0xcc, 0xee, 0x34,
-	0x2f, 0x84, 0xbd, 0x81, 0xa6, 0x8d, 0xfa, 0x8b, 0xf5, 0x20, 0xa7, 0x51, 0x22, 0x54, 0xd8, 0x28,
-	0xa9, 0x90, 0x20, 0x75, 0x01, 0x52, 0x31, 0x36, 0xb3, 0x40, 0xc0, 0x77, 0xf6, 0x9b, 0x12, 0x23,
-	0x44, 0xb8, 0x8d, 0xf0, 0x17, 0xb2, 0xca, 0x11, 0x78, 0x2c, 0x20, 0x5c, 0x33, 0xb2, 0x2c, 0x64,
-	0x50, 0x61, 0x6c, 0x17, 0x6a, 0x24, 0xc8, 0x9e, 0x00, 0xa9, 0x1a, 0x5b, 0x85, 0x20, 0x61, 0x4a,
-	0x48, 0xf2, 0x10, 0x61, 0x4d, 0xfd, 0x1e, 0xdf, 0xa6, 0xc1, 0x09, 0xf8, 0x3f, 0xc3, 0x58, 0xab,
-	0x66, 0xfe, 0xe0, 0x2b, 0x81, 0x22, 0xda, 0x99, 0xab, 0x5b, 0x64, 0x4e, 0x5c, 0x26, 0x98, 0xec,
-	0x8c, 0x82, 0x1f, 0x9c, 0x12, 0x2f, 0x44, 0xbb, 0x83, 0xf0, 0xf2, 0xdf, 0x8c, 0x43, 0x62, 0xa9,
-	0xcb, 0x09, 0xc3, 0x74, 0x58, 0x61, 0x55, 0xe6, 0xa8, 0x24, 0xd4, 0xae, 0x80, 0xda, 0x36, 0xf4,
-	0x38, 0xd4, 0x88, 0x71, 0x30, 0x67, 0x36, 0xfc, 0x39, 0xc2, 0x1b, 0xb1, 0x3a, 0xb3, 0x5b, 0xde,
-	0xcc, 0xb3, 0xcc, 0xdd, 0xf4, 0xd6, 0x07, 0x64, 0x48, 0xe0, 0xef, 0x05, 0x70, 0xd3, 0xa8, 0xe7,
-	0x02, 0x67, 0xaf, 0xfc, 0x7d, 0x84, 0xb5, 0x98, 0x81, 0xda, 0xb9, 0x6a, 0x1e, 0x41, 0x6a, 0xef,
-	0x76, 0xe6, 0xea, 0x8a, 0x2e, 0x41, 0x82, 0x2f, 0xb6, 0x7a, 0xa9, 0xf9, 0x46, 0x77, 0x31, 0x77,
-	0xbe, 0x89, 0xfb, 0x58, 0x99, 0xa3, 0x5a, 0x78, 0xbe, 0x3c, 0xd4, 0x87, 0x30, 0x8f, 0x11, 0x5e,
-	0x8d, 0xd7, 0x89, 0xdd, 0x85, 0x5a, 0xae, 0xd9, 0xec, 0x7d, 0xa8, 0x2f, 0xa4, 0x95, 0x78, 0x4d,
-	0x81, 0x57, 0x33, 0x2a, 0xf9, 0x78, 0xea, 0x62, 0xf4, 0x40, 0x3c, 0x37, 0xee, 0x22, 0xfc, 0x65,
-	0xdb, 0x07, 0x9b, 0x43, 0xb4, 0x1c, 0x51, 0xcf, 0x92, 0xdd, 0x98, 0x89, 0x2b, 0xb6, 0xea, 0x3c,
-	0x99, 0xc4, 0xaa, 0x09, 0xac, 0xb2, 0xf1, 0x6d, 0xe2, 0xaa, 0x0a, 0xb9, 0x5c, 0xab, 0xab, 0xb6,
-	0xdd, 0x44, 0x78, 0x39, 0xaa, 0x74, 0x38, 0xf4, 0xa9, 0xa8, 0x13, 0xa4, 0x66, 0x98, 0x0e, 0x67,
-	0xcf, 0x70, 0x56, 0x25, 0x69, 0x36, 0x05, 0x4d, 0xc9, 0xf8, 0x2a, 0x4e, 0x13, 0x10, 0x97, 0x9a,
-	0x9d, 0xa1, 0x2f, 0x18, 0x1e, 0x21, 0xbc, 0x1a, 0xa5, 0xff, 0x01, 0xb4, 0x4b, 0xa8, 0xab, 0x7a,
-	0x1d, 0xa4, 0x46, 0x97, 0x2d, 0xca, 0x1e, 0x5d, 0x9e, 0x56, 0x52, 0x59, 0x82, 0x6a, 0xd7, 0x28,
-	0x67, 0xf4, 0xc8, 0x8b, 0x92, 0xa6, 0xc3, 0x0b, 0x42, 0xc8, 0x27, 0x08, 0x7f, 0x1d, 0xd5, 0x54,
-	0xc5, 0x7e, 0x57, 0xcf, 0x3a, 0x2d, 0xcb, 0x79, 0x46, 0xa5, 0x30, 0xf7, 0x16, 0x13, 0x17, 0xad,
-	0x98, 0xe4, 0xcc, 0x7e, 0xea, 0x3e, 0x43, 0xb8, 0x94, 0xaa, 0xea, 0x81, 0x6f, 0x73, 0x16, 0xb1,
-	0x36, 0x8a, 0xec, 0x63, 0x42, 0x85, 0x6b, 0x2d, 0xac, 0x97, 0xc4, 0x07, 0x82, 0xd8, 0x34, 0xbe,
-	0x2b, 0x24, 0x8e, 0x65, 0xca, 0x4f, 0x9f, 0x63, 0xe2, 0xd2, 0x36, 0x1b, 0x0c, 0x6c, 0xda, 0x0d,
-	0x52, 0x6f, 0xf4, 0x78, 0x28, 0xfb, 0x8d, 0x9e, 0x54, 0x14, 0x7d, 0xfa, 0x88, 0xcd, 0x73, 0xa4,
-	0x34, 0xb4, 0x26, 0xf8, 0xd3, 0x1f, 0xbb, 0xdd, 0xe8, 0xe5, 0xb4, 0x91, 0x28, 0xaa, 0x8e, 0x95,
-	0xe5, 0x37, 0x39, 0xd1, 0xa2, 0x45, 0xb7, 0xbb, 0xdd, 0xe9, 0x3b, 0xe8, 0xf0, 0xb7, 0x97, 0x97,
-	0x3a, 0xba, 0xb8, 0xd4, 0xd1, 0xdb, 0x4b, 0x1d, 0xdd, 0x9b, 0xe8, 0x4b, 0x2f, 0x26, 0x3a, 0xba,
-	0x98, 0xe8, 0x4b, 0xaf, 0x27, 0xfa, 0xd2, 0x3f, 0x4d, 0x97, 0xf0, 0xd3, 0x61, 0xa7, 0xe1, 0xb0,
-	0x81, 0xac, 0x40, 0x81, 0x9f, 0x31, 0xbf, 0x27, 0xff, 0x33, 0x1d, 0xe6, 0x83, 0x75, 0x2e, 0xca,
-	0xf2, 0xb1, 0x07, 0x41, 0xe7, 0x13, 0xf1, 0xc9, 0x7a, 0xf0, 0x3e, 0x00, 0x00, 0xff, 0xff, 0xb0,
-	0xef, 0x69, 0xc0, 0x26, 0x0b, 0x00, 0x00,
+	// 1174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0x3b, 0x6f, 0x24, 0x45,
+	0x10, 0xc7, 0xdd, 0x08, 0xa1, 0xa3, 0x31, 0xe6, 0x68, 0xdd, 0xcb, 0x96, 0x59, 0xec, 0xf1, 0x63,
+	0xfd, 0xda, 0x1d, 0xdb, 0x27, 0x11, 0x20, 0x04, 0xf2, 0x43, 0xba, 0xe0, 0x78, 0x9e, 0x11, 0x01,
+	0xc9, 0x69, 0x3c, 0x53, 0xb7, 0x3b, 0xac, 0x77, 0x7a, 0xae, 0xbb, 0xfd, 0x58, 0x59, 0x2b, 0xc1,
+	0x11, 0x80, 0x08, 0xd0, 0x09, 0x08, 0x88, 0x41, 0x22, 0x40, 0x44, 0x10, 0x92, 0x10, 0x12, 0x9e,
+	0x44, 0x42, 0x06, 0xb2, 0xf9, 0x20, 0x68, 0x7a, 0xba, 0x77, 0xa7, 0x67, 0x7a, 0x66, 0xf7, 0x32,
+	0xbb, 0xeb, 0xdf, 0x55, 0xbf, 0xa9, 0xae, 0xee, 0xaa, 0xc5, 0xd3, 0x70, 0xd2, 0x75, 0x4f, 0xb6,
+	0x0e, 0x41, 0x78, 0x5b, 0x2e, 0x07, 0x76, 0x12, 0xfa, 0xd0, 0x8c, 0x19, 0x15, 0x94, 0xbc, 0x00,
+	0x27, 0xdd, 0xa6, 0x32, 0xcd, 0x5c, 0x6b, 0xd1, 0x16, 0x95, 0xeb, 0x6e, 0xf2, 0x57, 0x2a, 0x99,
+	0x99, 0x6d, 0x51, 0xda, 0x3a, 0x02, 0xd7, 0x8b, 0x43, 0xd7, 0x8b, 0x22, 0x2a, 0x3c, 0x11, 0xd2,
+	0x88, 0x2b, 0xeb, 0xb5, 0xac, 0x6f, 0x71, 0xa6, 0x56, 0x6f, 0x66, 0x57, 0x1f, 0x1e, 0x03, 0xeb,
+	0xa5, 0x86, 0xed, 0x7f, 0xae, 0x63, 0xfc, 0x0e, 0x6f, 0x1d, 0xa4, 0x10, 0xe4, 0x13, 0xfc, 0xec,
+	0xdb, 0x61, 0xd4, 0x21, 0xb7, 0x9a, 0x19, 0x8e, 0x66, 0xb2, 0x74, 0x0f, 0x1e, 0x1e, 0x03, 0x17,
+	0x33, 0xd3, 0x16, 0x0b, 0x8f, 0x69, 0xc4, 0xc1, 0x69, 0x3c, 0xfa, 0xeb, 0xbf, 0x6f, 0x9f, 0xa9,
+	0x3b, 0x8e, 0xeb, 0x9d, 0xc1, 0x91, 0xc7, 0xdc, 0x24, 0xe8, 0x51, 0x18, 0x75, 0xdc, 0x73, 0x06,
+	0x7e, 0x18, 0x87, 0x10, 0x89, 0xfb, 0x7e, 0xdb, 0x0b, 0xa3, 0xfe, 0xeb, 0x68, 0x8d, 0xf4, 0xf0,
+	0xe4, 0x1e, 0x8d, 0x1e, 0x84, 0xac, 0xbb, 0x97, 0xac, 0x91, 0x39, 0xc3, 0x73, 0xd6, 0xa4, 0x63,
+	0xcf, 0x57, 0x28, 0x14, 0xc3, 0xa2, 0x64, 0xa8, 0x39, 0xd3, 0x59, 0x06, 0x3f, 0x55, 0x36, 0x64,
+	0xec, 0x24, 0xf4, 0xcf, 0x08, 0xdf, 0x52, 0xdb, 0xef, 0x78, 0x02, 0x4e, 0xbd, 0xde, 0x3e, 0xc4,
+	0x47, 0xb4, 0xd7, 0x85, 0x48, 0x90, 0x0d, 0x5b, 0x94, 0x82, 0x4c, 0x33, 0x35, 0xc6, 0x54, 0x2b,
+	0xbe, 0x2d, 0xc9, 0xb7, 0xee, 0x2c, 0xdb, 0xf8, 0x5a, 0xe9, 0xb6, 0x46, 0x30, 0xd8, 0x97, 0xc0,
+	0x7e, 0x8a, 0x06, 0x89, 0xfa, 0x90, 0x76, 0xa0, 0x24, 0x51, 0xd2, 0x54, 0x99, 0x28, 0xa5, 0x50,
+	0x20, 0xeb, 0x12, 0x64, 0xc9, 0x99, 0xb3, 0x81, 0x00, 0xf3, 0xb7, 0x37, 0x15, 0x46, 0x82, 0xf0,
+	0x05, 0xc2, 0x53, 0xca, 0xcb, 0x3e, 0xc4, 0x94, 0x87, 0x82, 0x38, 0xb6, 0x10, 0xca, 0xa8, 0x31,
+	0x16, 0x2a, 0x35, 0x0a, 0x64, 0x43, 0x82, 0x2c, 0x3b, 0xf3, 0x95, 0x20, 0xc9, 0x96, 0x84, 0xe4,
+	0x73, 0x84, 0x5f, 0xbc, 0x07, 0x31, 0x65, 0x42, 0x83, 0x98, 0xdf, 0x6a, 0xd8, 0x34, 0x87, 0x53,
+	0x25, 0xa9, 0xca, 0x07, 0x93, 0xd2, 0x22, 0xc5, 0xf7, 0x08, 0x13, 0x9d, 0x55, 0xe6, 0x45, 0xfc,
+	0x01, 0xb0, 0xbb, 0xd0, 0x23, 0xcb, 0xd6, 0xb4, 0x0f, 0x05, 0x9a, 0xa7, 0x3e, 0x52, 0x37, 0x4e,
+	0xb5, 0x08, 0xb5, 0xa1, 0x41, 0x4f, 0x23, 0x60, 0xbc, 0x1d, 0xc6, 0x09, 0xda, 0x57, 0x08, 0x5f,
+	0xfd, 0x88, 0x0a, 0x30, 0xae, 0xd6, 0xa2, 0x11, 0x30, 0x6f, 0xd6, 0x58, 0x4b, 0x23, 0x54, 0x0a,
+	0x6a, 0x55, 0x42, 0x2d, 0x38, 0xb5, 0x2c, 0xd4, 0x09, 0x15, 0xd0, 0x28, 0xdc, 0xb3, 0xdf, 0x11,
+	0x9e, 0xcd, 0xf8, 0x29, 0xde, 0xb5, 0xcd, 0xb2, 0x90, 0xa5, 0xf7, 0x6d, 0xeb, 0x29, 0x76, 0x28,
+	0xe0, 0xd7, 0x24, 0xf0, 0xa6, 0xb3, 0x5e, 0x0a, 0x6c, 0xbf, 0x78, 0xdf, 0x20, 0x4c, 0x32, 0x01,
+	0x74, 0xc1, 0x2d, 0x97, 0x11, 0xe4, 0xaa, 0xae, 0x3e, 0x52, 0x57, 0x55, 0x7a, 0x06, 0x5f, 0xa6,
+	0xf4, 0x72, 0xe7, 0x9b, 0xbe, 0x08, 0xa5, 0xe7, 0x6b, 0xbc, 0x0a, 0x4b, 0x23, 0x54, 0x63, 0x9f,
+	0xaf, 0x48, 0xf4, 0x09, 0xcc, 0x8f, 0x08, 0xdf, 0xc8, 0xfa, 0xc9, 0xdc, 0x85, 0xb5, 0xd2, 0x60,
+	0xc5, 0xfb, 0xb0, 0x3e, 0x96, 0x56, 0xe1, 0x6d, 0x4a, 0xbc, 0x35, 0x67, 0xa9, 0x1c, 0x4f, 0x5f,
+	0x8c, 0x0e, 0xc8, 0xd7, 0xeb, 0x6b, 0x84, 0x5f, 0xde, 0x63, 0xe0, 0x09, 0x48, 0x8b, 0x23, 0xcd,
+	0x99, 0x99, 0x8d, 0x82, 0x5d, 0xb3, 0x2d, 0x8f, 0x92, 0x29, 0xac, 0x35, 0x89, 0xb5, 0xe8, 0xbc,
+	0x6a, 0x5c, 0x55, 0x29, 0x57, 0x65, 0x35, 0x4c, 0xdb, 0x67, 0x08, 0x5f, 0x4d, 0x3d, 0xed, 0x1e,
+	0xb3, 0x48, 0xfa, 0xe1, 0xb9, 0x33, 0xcc, 0x9b, 0xed, 0x67, 0x58, 0x54, 0x29, 0x9a, 0x39, 0x49,
+	0x33, 0xe3, 0x5c, 0xcf, 0xd2, 0xf0, 0xb0, 0x15, 0x35, 0x0e, 0x8f, 0x99, 0x64, 0xf8, 0x01, 0xe1,
+	0x1b, 0xe9, 0xf6, 0xf7, 0x21, 0x0a, 0xc2, 0xa8, 0xa5, 0x73, 0xcd, 0x73, 0x47, 0x67, 0x17, 0xd9,
+	0x8f, 0xae, 0x4c, 0xab, 0xa8, 0x5c, 0x49, 0xb5, 0xea, 0x2c, 0x5a, 0x72, 0x14, 0xa7, 0x9b, 0x06,
+	0x87, 0xc7, 0x13, 0xc8, 0x9f, 0x10, 0xbe, 0x99, 0xfa, 0xd4, 0xce, 0xde, 0xd3, 0x6f, 0x1d, 0xb1,
+	0x45, 0x2e, 0xa8, 0x34, 0xe6, 0xc6, 0x78, 0xe2, 0xaa, 0x12, 0x53, 0x9c, 0xf6, 0x57, 0xf7, 0x57,
+	0x84, 0x67, 0x72, 0x5e, 0x63, 0x60, 0x9e, 0xa0, 0x29, 0x6b, 0xb3, 0x2a, 0x7c, 0x46, 0xa8, 0x71,
+	0xdd, 0xb1, 0xf5, 0x8a, 0xf8, 0xb6, 0x24, 0x6e, 0x38, 0x2b, 0x95, 0xc4, 0x99, 0x9d, 0x6a, 0x00,
+	0x3b, 0x08, 0x5b, 0xd1, 0x1e, 0xed, 0x76, 0xbd, 0x28, 0xe0, 0xb9, 0xb9, 0x22, 0x6b, 0xb2, 0xcf,
+	0x15, 0xa6, 0xa2, 0x6a, 0x00, 0x93, 0x95, 0xe7, 0x2b, 0x69, 0x12, 0x3a, 0xc4, 0x57, 0x76, 0x82,
+	0x20, 0x6d, 0x4e, 0xb3, 0x86, 0x53, 0xbd, 0xac, 0x43, 0xbe, 0x52, 0x62, 0xad, 0x2a, 0x74, 0x2f,
+	0x08, 0x06, 0x3d, 0x68, 0xfb, 0xb7, 0xe7, 0xf1, 0xe4, 0x07, 0xc9, 0xc4, 0xab, 0x67, 0xdc, 0xef,
+	0x10, 0x7e, 0x69, 0xd7, 0x13, 0x7e, 0x1b, 0x82, 0xc1, 0xa7, 0xaf, 0x18, 0x51, 0xa4, 0x3c, 0x27,
+	0xd1, 0x3c, 0xab, 0x63, 0x28, 0xcd, 0xc9, 0x86, 0x18, 0xe5, 0x7e, 0x98, 0x8a, 0x07, 0xd9, 0x70,
+	0xcf, 0xd3, 0x89, 0x98, 0xf4, 0x31, 0xbe, 0x0b, 0xbd, 0x9d, 0x20, 0x60, 0xc0, 0x39, 0x59, 0x28,
+	0x86, 0x19, 0x5a, 0xed, 0xc7, 0x21, 0x45, 0x03, 0x85, 0x62, 0xa8, 0x4b, 0x86, 0x79, 0x62, 0x3c,
+	0x4b, 0x1d, 0xe8, 0x35, 0xbc, 0x54, 0x38, 0x08, 0xff, 0x18, 0xe1, 0x29, 0xd5, 0x42, 0x35, 0x43,
+	0xbd, 0xe8, 0xde, 0x54, 0x68, 0x8e, 0x95, 0xd1, 0x42, 0xb3, 0xd5, 0x91, 0x85, 0x2c, 0x8e, 0xee,
+	0xbe, 0x79, 0xa4, 0x5f, 0x10, 0x9e, 0x52, 0xbd, 0xb2, 0x02, 0xc9, 0x54, 0x54, 0x20, 0xe5, 0x85,
+	0x0a, 0xe9, 0x8e, 0x44, 0xda, 0x21, 0x6f, 0x65, 0x91, 0x54, 0xc3, 0xcd, 0x23, 0x65, 0x7f, 0xc8,
+	0x28, 0x53, 0xdf, 0x3d, 0xf7, 0x38, 0x07, 0xd1, 0x4f, 0x9a, 0xe1, 0xa4, 0x8a, 0x71, 0x20, 0x3c,
+	0x01, 0x64, 0xa9, 0x94, 0x41, 0xda, 0xed, 0x1d, 0xc6, 0x22, 0x53, 0xa0, 0xfb, 0x12, 0xf4, 0x4d,
+	0xf2, 0x86, 0x0d, 0x94, 0x27, 0xd2, 0x21, 0xa6, 0x38, 0xbb, 0xdf, 0xf6, 0x78, 0xbb, 0xef, 0x9e,
+	0x27, 0xef, 0x3d, 0xb0, 0x01, 0x2d, 0xf9, 0x12, 0xe1, 0x49, 0xd9, 0x2c, 0x74, 0x4a, 0x2d, 0x94,
+	0x59, 0x7b, 0x05, 0xa5, 0x29, 0x33, 0xa7, 0x07, 0x62, 0x8c, 0xf3, 0xb2, 0xf3, 0x15, 0xce, 0xf7,
+	0x11, 0xc2, 0x57, 0x76, 0x7b, 0x02, 0x7c, 0x1a, 0x00, 0xb1, 0xd4, 0xb2, 0xb6, 0xd9, 0xc7, 0xf8,
+	0x9c, 0xc4, 0x6c, 0x31, 0xa4, 0x6e, 0xdc, 0x39, 0xa5, 0x1a, 0xe6, 0xc7, 0xa7, 0x91, 0x60, 0x9e,
+	0x2f, 0xfa, 0xe9, 0x6b, 0x20, 0x73, 0xa4, 0xbe, 0x04, 0xec, 0xaf, 0x81, 0x29, 0xa9, 0x7a, 0x0d,
+	0xf2, 0xca, 0xca, 0xd7, 0x40, 0x8a, 0x75, 0x66, 0x60, 0x90, 0x9b, 0xdd, 0x77, 0xff, 0xbc, 0xa8,
+	0xa1, 0x27, 0x17, 0x35, 0xf4, 0xef, 0x45, 0x0d, 0x3d, 0xbe, 0xac, 0x4d, 0xfc, 0x71, 0x59, 0x43,
+	0x4f, 0x2e, 0x6b, 0x13, 0x7f, 0x5f, 0xd6, 0x26, 0x3e, 0xde, 0x6c, 0x85, 0xa2, 0x7d, 0x7c, 0xd8,
+	0xf4, 0x69, 0x57, 0x79, 0x8b, 0x40, 0x9c, 0x52, 0xd6, 0x51, 0xff, 0x35, 0x7c, 0xca, 0xc0, 0x3d,
+	0x4b, 0x73, 0xdf, 0x8b, 0x81, 0x1f, 0x3e, 0x27, 0x7f, 0xee, 0xdf, 0xfe, 0x7f, 0x00, 0xb0, 0x84,
+	0x67, 0x16, 0x7b, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -107,6 +127,7 @@ type MsgServiceClient interface {
 	ConfirmGatewayDeployment(ctx context.Context, in *ConfirmGatewayDeploymentRequest, opts ...grpc.CallOption) (*ConfirmGatewayDeploymentResponse, error)
 	ConfirmToken(ctx context.Context, in *ConfirmTokenRequest, opts ...grpc.CallOption) (*ConfirmTokenResponse, error)
 	ConfirmDeposit(ctx context.Context, in *ConfirmDepositRequest, opts ...grpc.CallOption) (*ConfirmDepositResponse, error)
+	ReportDeposit(ctx context.Context, in *ReportDepositRequest, opts ...grpc.CallOption) (*ReportDepositResponse, error)
 	ConfirmTransferKey(ctx context.Context, in *ConfirmTransferKeyRequest, opts ...grpc.CallOption) (*ConfirmTransferKeyResponse, error)
 	VoteConfirmChain(ctx context.Context, in *VoteConfirmChainRequest, opts ...grpc.CallOption) (*VoteConfirmChainResponse, error)
 	VoteConfirmGatewayDeployment(ctx context.Context, in *VoteConfirmGatewayDeploymentRequest, opts ...grpc.CallOption) (*VoteConfirmGatewayDeploymentResponse, error)
@@ -175,6 +196,15 @@ func (c *msgServiceClient) ConfirmDeposit(ctx context.Context, in *ConfirmDeposi
 	return out, nil
 }
 
+func (c *msgServiceClient) ReportDeposit(ctx context.Context, in *ReportDepositRequest, opts ...grpc.CallOption) (*ReportDepositResponse, error) {
+	out := new(ReportDepositResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/ReportDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) ConfirmTransferKey(ctx context.Context, in *ConfirmTransferKeyRequest, opts ...grpc.CallOption) (*ConfirmTransferKeyResponse, error) {
 	out := new(ConfirmTransferKeyResponse)
 	err := c.cc.Invoke(ctx, "/evm.v1beta1.MsgService/ConfirmTransferKey", in, out, opts...)
@@ -299,6 +329,7 @@ type MsgServiceServer interface {
 	ConfirmGatewayDeployment(context.Context, *ConfirmGatewayDeploymentRequest) (*ConfirmGatewayDeploymentResponse, error)
 	ConfirmToken(context.Context, *ConfirmTokenRequest) (*ConfirmTokenResponse, error)
 	ConfirmDeposit(context.Context, *ConfirmDepositRequest) (*ConfirmDepositResponse, error)
+	ReportDeposit(context.Context, *ReportDepositRequest) (*ReportDepositResponse, error)
 	ConfirmTransferKey(context.Context, *ConfirmTransferKeyRequest) (*ConfirmTransferKeyResponse, error)
 	VoteConfirmChain(context.Context, *VoteConfirmChainRequest) (*VoteConfirmChainResponse, error)
 	VoteConfirmGatewayDeployment(context.Context, *VoteConfirmGatewayDeploymentRequest) (*VoteConfirmGatewayDeploymentResponse, error)
@@ -333,6 +364,9 @@ func (*UnimplementedMsgServiceServer) ConfirmToken(ctx context.Context, req *Con
 func (*UnimplementedMsgServiceServer) ConfirmDeposit(ctx context.Context, req *ConfirmDepositRequest) (*ConfirmDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmDeposit not implemented")
 }
+func (*UnimplementedMsgServiceServer) ReportDeposit(ctx context.Context, req *ReportDepositRequest) (*ReportDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDeposit not implemented")
+}
 func (*UnimplementedMsgServiceServer) ConfirmTransferKey(ctx context.Context, req *ConfirmTransferKeyRequest) (*ConfirmTransferKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTransferKey not implemented")
 }
@@ -467,6 +501,24 @@ func _MsgService_ConfirmDeposit_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_ReportDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ReportDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.MsgService/ReportDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ReportDeposit(ctx, req.(*ReportDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_ConfirmTransferKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConfirmTransferKeyRequest)
 	if err := dec(in); err != nil {
@@ -725,6 +777,10 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmDeposit",
 			Handler:    _MsgService_ConfirmDeposit_Handler,
 		},
+		{
+			MethodName: "ReportDeposit",
+			Handler:    _MsgService_ReportDeposit_Handler,
+		},
 		{
 			MethodName: "ConfirmTransferKey",
 			Handler:    _MsgService_ConfirmTransferKey_Handler,
@@ -781,3 +837,327 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evm/v1beta1/service.proto",
 }
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	BatchedCommands(ctx context.Context, in *QueryBatchedCommandsRequest, opts ...grpc.CallOption) (*QueryBatchedCommandsResponse, error)
+	KeyAddress(ctx context.Context, in *QueryKeyAddressRequest, opts ...grpc.CallOption) (*QueryAddressResponse, error)
+	GatewayAddress(ctx context.Context, in *QueryGatewayAddressRequest, opts ...grpc.CallOption) (*QueryGatewayAddressResponse, error)
+	DepositAddress(ctx context.Context, in *QueryDepositAddressRequest, opts ...grpc.CallOption) (*QueryDepositAddressResponse, error)
+	DepositState(ctx context.Context, in *QueryDepositStateRequest, opts ...grpc.CallOption) (*QueryDepositStateResponse, error)
+	TokenAddress(ctx context.Context, in *QueryTokenAddressRequest, opts ...grpc.CallOption) (*QueryTokenAddressResponse, error)
+	Bytecode(ctx context.Context, in *QueryBytecodeRequest, opts ...grpc.CallOption) (*QueryBytecodeResponse, error)
+	BurnerAddresses(ctx context.Context, in *QueryBurnerAddressesRequest, opts ...grpc.CallOption) (*QueryBurnerAddressesResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) BatchedCommands(ctx context.Context, in *QueryBatchedCommandsRequest, opts ...grpc.CallOption) (*QueryBatchedCommandsResponse, error) {
+	out := new(QueryBatchedCommandsResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.QueryService/BatchedCommands", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) KeyAddress(ctx context.Context, in *QueryKeyAddressRequest, opts ...grpc.CallOption) (*QueryAddressResponse, error) {
+	out := new(QueryAddressResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.QueryService/KeyAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GatewayAddress(ctx context.Context, in *QueryGatewayAddressRequest, opts ...grpc.CallOption) (*QueryGatewayAddressResponse, error) {
+	out := new(QueryGatewayAddressResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.QueryService/GatewayAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) DepositAddress(ctx context.Context, in *QueryDepositAddressRequest, opts ...grpc.CallOption) (*QueryDepositAddressResponse, error) {
+	out := new(QueryDepositAddressResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.QueryService/DepositAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) DepositState(ctx context.Context, in *QueryDepositStateRequest, opts ...grpc.CallOption) (*QueryDepositStateResponse, error) {
+	out := new(QueryDepositStateResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.QueryService/DepositState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) TokenAddress(ctx context.Context, in *QueryTokenAddressRequest, opts ...grpc.CallOption) (*QueryTokenAddressResponse, error) {
+	out := new(QueryTokenAddressResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.QueryService/TokenAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Bytecode(ctx context.Context, in *QueryBytecodeRequest, opts ...grpc.CallOption) (*QueryBytecodeResponse, error) {
+	out := new(QueryBytecodeResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.QueryService/Bytecode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) BurnerAddresses(ctx context.Context, in *QueryBurnerAddressesRequest, opts ...grpc.CallOption) (*QueryBurnerAddressesResponse, error) {
+	out := new(QueryBurnerAddressesResponse)
+	err := c.cc.Invoke(ctx, "/evm.v1beta1.QueryService/BurnerAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	BatchedCommands(context.Context, *QueryBatchedCommandsRequest) (*QueryBatchedCommandsResponse, error)
+	KeyAddress(context.Context, *QueryKeyAddressRequest) (*QueryAddressResponse, error)
+	GatewayAddress(context.Context, *QueryGatewayAddressRequest) (*QueryGatewayAddressResponse, error)
+	DepositAddress(context.Context, *QueryDepositAddressRequest) (*QueryDepositAddressResponse, error)
+	DepositState(context.Context, *QueryDepositStateRequest) (*QueryDepositStateResponse, error)
+	TokenAddress(context.Context, *QueryTokenAddressRequest) (*QueryTokenAddressResponse, error)
+	Bytecode(context.Context, *QueryBytecodeRequest) (*QueryBytecodeResponse, error)
+	BurnerAddresses(context.Context, *QueryBurnerAddressesRequest) (*QueryBurnerAddressesResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) BatchedCommands(ctx context.Context, req *QueryBatchedCommandsRequest) (*QueryBatchedCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchedCommands not implemented")
+}
+func (*UnimplementedQueryServiceServer) KeyAddress(ctx context.Context, req *QueryKeyAddressRequest) (*QueryAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyAddress not implemented")
+}
+func (*UnimplementedQueryServiceServer) GatewayAddress(ctx context.Context, req *QueryGatewayAddressRequest) (*QueryGatewayAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayAddress not implemented")
+}
+func (*UnimplementedQueryServiceServer) DepositAddress(ctx context.Context, req *QueryDepositAddressRequest) (*QueryDepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAddress not implemented")
+}
+func (*UnimplementedQueryServiceServer) DepositState(ctx context.Context, req *QueryDepositStateRequest) (*QueryDepositStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositState not implemented")
+}
+func (*UnimplementedQueryServiceServer) TokenAddress(ctx context.Context, req *QueryTokenAddressRequest) (*QueryTokenAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenAddress not implemented")
+}
+func (*UnimplementedQueryServiceServer) Bytecode(ctx context.Context, req *QueryBytecodeRequest) (*QueryBytecodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bytecode not implemented")
+}
+func (*UnimplementedQueryServiceServer) BurnerAddresses(ctx context.Context, req *QueryBurnerAddressesRequest) (*QueryBurnerAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnerAddresses not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_BatchedCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchedCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).BatchedCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.QueryService/BatchedCommands",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).BatchedCommands(ctx, req.(*QueryBatchedCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_KeyAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).KeyAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.QueryService/KeyAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).KeyAddress(ctx, req.(*QueryKeyAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GatewayAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGatewayAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GatewayAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.QueryService/GatewayAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GatewayAddress(ctx, req.(*QueryGatewayAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_DepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).DepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.QueryService/DepositAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).DepositAddress(ctx, req.(*QueryDepositAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_DepositState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).DepositState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.QueryService/DepositState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).DepositState(ctx, req.(*QueryDepositStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_TokenAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).TokenAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.QueryService/TokenAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).TokenAddress(ctx, req.(*QueryTokenAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Bytecode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBytecodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Bytecode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.QueryService/Bytecode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Bytecode(ctx, req.(*QueryBytecodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_BurnerAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnerAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).BurnerAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evm.v1beta1.QueryService/BurnerAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).BurnerAddresses(ctx, req.(*QueryBurnerAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evm.v1beta1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchedCommands",
+			Handler:    _QueryService_BatchedCommands_Handler,
+		},
+		{
+			MethodName: "KeyAddress",
+			Handler:    _QueryService_KeyAddress_Handler,
+		},
+		{
+			MethodName: "GatewayAddress",
+			Handler:    _QueryService_GatewayAddress_Handler,
+		},
+		{
+			MethodName: "DepositAddress",
+			Handler:    _QueryService_DepositAddress_Handler,
+		},
+		{
+			MethodName: "DepositState",
+			Handler:    _QueryService_DepositState_Handler,
+		},
+		{
+			MethodName: "TokenAddress",
+			Handler:    _QueryService_TokenAddress_Handler,
+		},
+		{
+			MethodName: "Bytecode",
+			Handler:    _QueryService_Bytecode_Handler,
+		},
+		{
+			MethodName: "BurnerAddresses",
+			Handler:    _QueryService_BurnerAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evm/v1beta1/service.proto",
+}
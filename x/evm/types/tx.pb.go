@@ -179,6 +179,86 @@ func (m *ConfirmDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConfirmDepositResponse proto.InternalMessageInfo
 
+// ReportDepositRequest represents a deposit to a burner address that a
+// validator detected on its own
+type ReportDepositRequest struct {
+	Sender        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	Chain         string                                        `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	TxID          Hash                                          `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3,customtype=Hash" json:"tx_id"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	BurnerAddress Address                                       `protobuf:"bytes,5,opt,name=burner_address,json=burnerAddress,proto3,customtype=Address" json:"burner_address"`
+}
+
+func (m *ReportDepositRequest) Reset()         { *m = ReportDepositRequest{} }
+func (m *ReportDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportDepositRequest) ProtoMessage()    {}
+func (*ReportDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b4b69622c19e531, []int{4}
+}
+func (m *ReportDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportDepositRequest.Merge(m, src)
+}
+func (m *ReportDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportDepositRequest proto.InternalMessageInfo
+
+type ReportDepositResponse struct {
+	Log string `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *ReportDepositResponse) Reset()         { *m = ReportDepositResponse{} }
+func (m *ReportDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*ReportDepositResponse) ProtoMessage()    {}
+func (*ReportDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b4b69622c19e531, []int{5}
+}
+func (m *ReportDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportDepositResponse.Merge(m, src)
+}
+func (m *ReportDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportDepositResponse proto.InternalMessageInfo
+
 // MsgConfirmToken represents a token deploy confirmation message
 type ConfirmTokenRequest struct {
 	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
@@ -191,7 +271,7 @@ func (m *ConfirmTokenRequest) Reset()         { *m = ConfirmTokenRequest{} }
 func (m *ConfirmTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmTokenRequest) ProtoMessage()    {}
 func (*ConfirmTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{4}
+	return fileDescriptor_0b4b69622c19e531, []int{6}
 }
 func (m *ConfirmTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +307,7 @@ func (m *ConfirmTokenResponse) Reset()         { *m = ConfirmTokenResponse{} }
 func (m *ConfirmTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmTokenResponse) ProtoMessage()    {}
 func (*ConfirmTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{5}
+	return fileDescriptor_0b4b69622c19e531, []int{7}
 }
 func (m *ConfirmTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +348,7 @@ func (m *ConfirmTransferKeyRequest) Reset()         { *m = ConfirmTransferKeyReq
 func (m *ConfirmTransferKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmTransferKeyRequest) ProtoMessage()    {}
 func (*ConfirmTransferKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{6}
+	return fileDescriptor_0b4b69622c19e531, []int{8}
 }
 func (m *ConfirmTransferKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +384,7 @@ func (m *ConfirmTransferKeyResponse) Reset()         { *m = ConfirmTransferKeyRe
 func (m *ConfirmTransferKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmTransferKeyResponse) ProtoMessage()    {}
 func (*ConfirmTransferKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{7}
+	return fileDescriptor_0b4b69622c19e531, []int{9}
 }
 func (m *ConfirmTransferKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +427,7 @@ func (m *LinkRequest) Reset()         { *m = LinkRequest{} }
 func (m *LinkRequest) String() string { return proto.CompactTextString(m) }
 func (*LinkRequest) ProtoMessage()    {}
 func (*LinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{8}
+	return fileDescriptor_0b4b69622c19e531, []int{10}
 }
 func (m *LinkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +464,7 @@ func (m *LinkResponse) Reset()         { *m = LinkResponse{} }
 func (m *LinkResponse) String() string { return proto.CompactTextString(m) }
 func (*LinkResponse) ProtoMessage()    {}
 func (*LinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{9}
+	return fileDescriptor_0b4b69622c19e531, []int{11}
 }
 func (m *LinkResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +504,7 @@ func (m *CreateBurnTokensRequest) Reset()         { *m = CreateBurnTokensRequest
 func (m *CreateBurnTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateBurnTokensRequest) ProtoMessage()    {}
 func (*CreateBurnTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{10}
+	return fileDescriptor_0b4b69622c19e531, []int{12}
 }
 func (m *CreateBurnTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +540,7 @@ func (m *CreateBurnTokensResponse) Reset()         { *m = CreateBurnTokensRespon
 func (m *CreateBurnTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateBurnTokensResponse) ProtoMessage()    {}
 func (*CreateBurnTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{11}
+	return fileDescriptor_0b4b69622c19e531, []int{13}
 }
 func (m *CreateBurnTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +582,7 @@ func (m *CreateDeployTokenRequest) Reset()         { *m = CreateDeployTokenReque
 func (m *CreateDeployTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateDeployTokenRequest) ProtoMessage()    {}
 func (*CreateDeployTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{12}
+	return fileDescriptor_0b4b69622c19e531, []int{14}
 }
 func (m *CreateDeployTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -538,7 +618,7 @@ func (m *CreateDeployTokenResponse) Reset()         { *m = CreateDeployTokenResp
 func (m *CreateDeployTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateDeployTokenResponse) ProtoMessage()    {}
 func (*CreateDeployTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{13}
+	return fileDescriptor_0b4b69622c19e531, []int{15}
 }
 func (m *CreateDeployTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +658,7 @@ func (m *CreatePendingTransfersRequest) Reset()         { *m = CreatePendingTran
 func (m *CreatePendingTransfersRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePendingTransfersRequest) ProtoMessage()    {}
 func (*CreatePendingTransfersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{14}
+	return fileDescriptor_0b4b69622c19e531, []int{16}
 }
 func (m *CreatePendingTransfersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,7 +694,7 @@ func (m *CreatePendingTransfersResponse) Reset()         { *m = CreatePendingTra
 func (m *CreatePendingTransfersResponse) String() string { return proto.CompactTextString(m) }
 func (*CreatePendingTransfersResponse) ProtoMessage()    {}
 func (*CreatePendingTransfersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{15}
+	return fileDescriptor_0b4b69622c19e531, []int{17}
 }
 func (m *CreatePendingTransfersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +735,7 @@ func (m *VoteConfirmChainRequest) Reset()         { *m = VoteConfirmChainRequest
 func (m *VoteConfirmChainRequest) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmChainRequest) ProtoMessage()    {}
 func (*VoteConfirmChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{16}
+	return fileDescriptor_0b4b69622c19e531, []int{18}
 }
 func (m *VoteConfirmChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,7 +772,7 @@ func (m *VoteConfirmChainResponse) Reset()         { *m = VoteConfirmChainRespon
 func (m *VoteConfirmChainResponse) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmChainResponse) ProtoMessage()    {}
 func (*VoteConfirmChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{17}
+	return fileDescriptor_0b4b69622c19e531, []int{19}
 }
 func (m *VoteConfirmChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +815,7 @@ func (m *VoteConfirmDepositRequest) Reset()         { *m = VoteConfirmDepositReq
 func (m *VoteConfirmDepositRequest) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmDepositRequest) ProtoMessage()    {}
 func (*VoteConfirmDepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{18}
+	return fileDescriptor_0b4b69622c19e531, []int{20}
 }
 func (m *VoteConfirmDepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +852,7 @@ func (m *VoteConfirmDepositResponse) Reset()         { *m = VoteConfirmDepositRe
 func (m *VoteConfirmDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmDepositResponse) ProtoMessage()    {}
 func (*VoteConfirmDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{19}
+	return fileDescriptor_0b4b69622c19e531, []int{21}
 }
 func (m *VoteConfirmDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -814,7 +894,7 @@ func (m *VoteConfirmTokenRequest) Reset()         { *m = VoteConfirmTokenRequest
 func (m *VoteConfirmTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmTokenRequest) ProtoMessage()    {}
 func (*VoteConfirmTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{20}
+	return fileDescriptor_0b4b69622c19e531, []int{22}
 }
 func (m *VoteConfirmTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +931,7 @@ func (m *VoteConfirmTokenResponse) Reset()         { *m = VoteConfirmTokenRespon
 func (m *VoteConfirmTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmTokenResponse) ProtoMessage()    {}
 func (*VoteConfirmTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{21}
+	return fileDescriptor_0b4b69622c19e531, []int{23}
 }
 func (m *VoteConfirmTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +971,7 @@ func (m *VoteConfirmTransferKeyRequest) Reset()         { *m = VoteConfirmTransf
 func (m *VoteConfirmTransferKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmTransferKeyRequest) ProtoMessage()    {}
 func (*VoteConfirmTransferKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{22}
+	return fileDescriptor_0b4b69622c19e531, []int{24}
 }
 func (m *VoteConfirmTransferKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,7 +1008,7 @@ func (m *VoteConfirmTransferKeyResponse) Reset()         { *m = VoteConfirmTrans
 func (m *VoteConfirmTransferKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmTransferKeyResponse) ProtoMessage()    {}
 func (*VoteConfirmTransferKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{23}
+	return fileDescriptor_0b4b69622c19e531, []int{25}
 }
 func (m *VoteConfirmTransferKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -967,7 +1047,7 @@ func (m *CreateTransferOwnershipRequest) Reset()         { *m = CreateTransferOw
 func (m *CreateTransferOwnershipRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateTransferOwnershipRequest) ProtoMessage()    {}
 func (*CreateTransferOwnershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{24}
+	return fileDescriptor_0b4b69622c19e531, []int{26}
 }
 func (m *CreateTransferOwnershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1083,7 @@ func (m *CreateTransferOwnershipResponse) Reset()         { *m = CreateTransferO
 func (m *CreateTransferOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateTransferOwnershipResponse) ProtoMessage()    {}
 func (*CreateTransferOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{25}
+	return fileDescriptor_0b4b69622c19e531, []int{27}
 }
 func (m *CreateTransferOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1042,7 +1122,7 @@ func (m *CreateTransferOperatorshipRequest) Reset()         { *m = CreateTransfe
 func (m *CreateTransferOperatorshipRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateTransferOperatorshipRequest) ProtoMessage()    {}
 func (*CreateTransferOperatorshipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{26}
+	return fileDescriptor_0b4b69622c19e531, []int{28}
 }
 func (m *CreateTransferOperatorshipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1078,7 +1158,7 @@ func (m *CreateTransferOperatorshipResponse) Reset()         { *m = CreateTransf
 func (m *CreateTransferOperatorshipResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateTransferOperatorshipResponse) ProtoMessage()    {}
 func (*CreateTransferOperatorshipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{27}
+	return fileDescriptor_0b4b69622c19e531, []int{29}
 }
 func (m *CreateTransferOperatorshipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1196,7 @@ func (m *SignCommandsRequest) Reset()         { *m = SignCommandsRequest{} }
 func (m *SignCommandsRequest) String() string { return proto.CompactTextString(m) }
 func (*SignCommandsRequest) ProtoMessage()    {}
 func (*SignCommandsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{28}
+	return fileDescriptor_0b4b69622c19e531, []int{30}
 }
 func (m *SignCommandsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1233,7 @@ func (m *SignCommandsResponse) Reset()         { *m = SignCommandsResponse{} }
 func (m *SignCommandsResponse) String() string { return proto.CompactTextString(m) }
 func (*SignCommandsResponse) ProtoMessage()    {}
 func (*SignCommandsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{29}
+	return fileDescriptor_0b4b69622c19e531, []int{31}
 }
 func (m *SignCommandsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,7 +1274,7 @@ func (m *AddChainRequest) Reset()         { *m = AddChainRequest{} }
 func (m *AddChainRequest) String() string { return proto.CompactTextString(m) }
 func (*AddChainRequest) ProtoMessage()    {}
 func (*AddChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{30}
+	return fileDescriptor_0b4b69622c19e531, []int{32}
 }
 func (m *AddChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,7 +1310,7 @@ func (m *AddChainResponse) Reset()         { *m = AddChainResponse{} }
 func (m *AddChainResponse) String() string { return proto.CompactTextString(m) }
 func (*AddChainResponse) ProtoMessage()    {}
 func (*AddChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{31}
+	return fileDescriptor_0b4b69622c19e531, []int{33}
 }
 func (m *AddChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1270,7 +1350,7 @@ func (m *ConfirmGatewayDeploymentRequest) Reset()         { *m = ConfirmGatewayD
 func (m *ConfirmGatewayDeploymentRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmGatewayDeploymentRequest) ProtoMessage()    {}
 func (*ConfirmGatewayDeploymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{32}
+	return fileDescriptor_0b4b69622c19e531, []int{34}
 }
 func (m *ConfirmGatewayDeploymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1306,7 +1386,7 @@ func (m *ConfirmGatewayDeploymentResponse) Reset()         { *m = ConfirmGateway
 func (m *ConfirmGatewayDeploymentResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmGatewayDeploymentResponse) ProtoMessage()    {}
 func (*ConfirmGatewayDeploymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{33}
+	return fileDescriptor_0b4b69622c19e531, []int{35}
 }
 func (m *ConfirmGatewayDeploymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1346,7 +1426,7 @@ func (m *VoteConfirmGatewayDeploymentRequest) Reset()         { *m = VoteConfirm
 func (m *VoteConfirmGatewayDeploymentRequest) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmGatewayDeploymentRequest) ProtoMessage()    {}
 func (*VoteConfirmGatewayDeploymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{34}
+	return fileDescriptor_0b4b69622c19e531, []int{36}
 }
 func (m *VoteConfirmGatewayDeploymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1463,7 @@ func (m *VoteConfirmGatewayDeploymentResponse) Reset()         { *m = VoteConfir
 func (m *VoteConfirmGatewayDeploymentResponse) String() string { return proto.CompactTextString(m) }
 func (*VoteConfirmGatewayDeploymentResponse) ProtoMessage()    {}
 func (*VoteConfirmGatewayDeploymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b4b69622c19e531, []int{35}
+	return fileDescriptor_0b4b69622c19e531, []int{37}
 }
 func (m *VoteConfirmGatewayDeploymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1417,6 +1497,8 @@ func init() {
 	proto.RegisterType((*ConfirmChainResponse)(nil), "evm.v1beta1.ConfirmChainResponse")
 	proto.RegisterType((*ConfirmDepositRequest)(nil), "evm.v1beta1.ConfirmDepositRequest")
 	proto.RegisterType((*ConfirmDepositResponse)(nil), "evm.v1beta1.ConfirmDepositResponse")
+	proto.RegisterType((*ReportDepositRequest)(nil), "evm.v1beta1.ReportDepositRequest")
+	proto.RegisterType((*ReportDepositResponse)(nil), "evm.v1beta1.ReportDepositResponse")
 	proto.RegisterType((*ConfirmTokenRequest)(nil), "evm.v1beta1.ConfirmTokenRequest")
 	proto.RegisterType((*ConfirmTokenResponse)(nil), "evm.v1beta1.ConfirmTokenResponse")
 	proto.RegisterType((*ConfirmTransferKeyRequest)(nil), "evm.v1beta1.ConfirmTransferKeyRequest")
@@ -1454,79 +1536,80 @@ func init() {
 func init() { proto.RegisterFile("evm/v1beta1/tx.proto", fileDescriptor_0b4b69622c19e531) }
 
 var fileDescriptor_0b4b69622c19e531 = []byte{
-	// 1140 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0xb6, 0x13, 0x3f, 0x3b, 0x49, 0xbb, 0x71, 0x1b, 0x27, 0x24, 0x76, 0xb2, 0x14,
-	0xda, 0x4a, 0xc4, 0x26, 0x41, 0x42, 0xe5, 0x84, 0xe2, 0x18, 0x8a, 0x15, 0x04, 0xd1, 0x12, 0x38,
-	0x20, 0x55, 0xd6, 0xda, 0xfb, 0xea, 0xac, 0xec, 0x9d, 0x59, 0x76, 0x26, 0x8e, 0xcd, 0x89, 0x8f,
-	0xc0, 0x99, 0x33, 0x9f, 0x82, 0x4f, 0x10, 0x6e, 0x45, 0x42, 0xa2, 0xe2, 0x60, 0x8a, 0x23, 0x3e,
-	0x00, 0x07, 0x2e, 0x3d, 0xa1, 0xdd, 0x99, 0xb5, 0xd7, 0x49, 0x9c, 0x46, 0xa0, 0x2c, 0x11, 0xa7,
-	0xec, 0xcc, 0x7b, 0x33, 0xef, 0xfd, 0x7e, 0xef, 0xdf, 0x38, 0x90, 0xc5, 0x8e, 0x5d, 0xea, 0x6c,
-	0xd5, 0x91, 0x1b, 0x5b, 0x25, 0xde, 0x2d, 0x3a, 0x2e, 0xe5, 0x54, 0x4d, 0x63, 0xc7, 0x2e, 0xca,
-	0xdd, 0x95, 0x6c, 0x93, 0x36, 0xa9, 0xbf, 0x5f, 0xf2, 0xbe, 0x84, 0xca, 0xca, 0x46, 0x87, 0x72,
-	0x2c, 0x61, 0xd7, 0xa1, 0x2e, 0x47, 0x73, 0x74, 0x45, 0xcf, 0x41, 0x26, 0x55, 0xd6, 0x39, 0x63,
-	0x97, 0x6b, 0x2c, 0x8d, 0x59, 0x1f, 0x09, 0x34, 0x0e, 0x8b, 0xbb, 0x94, 0x3c, 0xb5, 0x5c, 0x7b,
-	0xf7, 0xd0, 0xb0, 0x88, 0x8e, 0x5f, 0x1d, 0x21, 0xe3, 0x6a, 0x15, 0x92, 0x0c, 0x89, 0x89, 0x6e,
-	0x4e, 0x59, 0x57, 0x1e, 0x64, 0xca, 0x5b, 0x2f, 0xfb, 0x85, 0xcd, 0xa6, 0xc5, 0x0f, 0x8f, 0xea,
-	0xc5, 0x06, 0xb5, 0x4b, 0x0d, 0xca, 0x6c, 0xca, 0xe4, 0x9f, 0x4d, 0x66, 0xb6, 0xe4, 0xa5, 0x3b,
-	0x8d, 0xc6, 0x8e, 0x69, 0xba, 0xc8, 0x98, 0x2e, 0x2f, 0x50, 0x55, 0x88, 0x13, 0xc3, 0xc6, 0x5c,
-	0x6c, 0x5d, 0x79, 0x90, 0xd2, 0xfd, 0x6f, 0xed, 0x2e, 0x64, 0xc7, 0xad, 0x32, 0x87, 0x12, 0x86,
-	0xda, 0xf7, 0x31, 0xb8, 0x23, 0x05, 0x15, 0x74, 0x28, 0xb3, 0xf8, 0x35, 0x38, 0x94, 0x85, 0x44,
-	0xc3, 0xb3, 0x2a, 0x3d, 0x12, 0x0b, 0xf5, 0x21, 0x24, 0x78, 0xb7, 0x66, 0x99, 0xb9, 0x69, 0xff,
-	0xfe, 0xec, 0x49, 0xbf, 0x30, 0xf5, 0x6b, 0xbf, 0x10, 0xff, 0xc8, 0x60, 0x87, 0x83, 0x7e, 0x21,
-	0x7e, 0xd0, 0xad, 0x56, 0xf4, 0x38, 0xef, 0x56, 0x4d, 0xf5, 0x31, 0x24, 0x0d, 0x9b, 0x1e, 0x11,
-	0x9e, 0x8b, 0xfb, 0xba, 0x25, 0xa9, 0x7b, 0xff, 0x0a, 0xfe, 0x7c, 0x6e, 0x11, 0xae, 0xcb, 0xe3,
-	0xea, 0xbb, 0x30, 0x5f, 0x3f, 0x72, 0x09, 0xba, 0x35, 0x43, 0xf8, 0x98, 0x4b, 0xf8, 0x17, 0x2e,
-	0xc8, 0x0b, 0x67, 0x02, 0xd7, 0xe7, 0x84, 0x9a, 0x5c, 0x6a, 0x39, 0xb8, 0x7b, 0x96, 0x25, 0x49,
-	0xe0, 0x4f, 0xca, 0x30, 0x9e, 0x07, 0xb4, 0x85, 0xe4, 0x26, 0xd2, 0x57, 0x84, 0x84, 0xc1, 0x18,
-	0x0a, 0xf6, 0xd2, 0xdb, 0x6a, 0x31, 0x54, 0x03, 0xc5, 0x1d, 0x4f, 0x52, 0x8e, 0x7b, 0xc7, 0x75,
-	0xa1, 0x16, 0x4a, 0x16, 0x09, 0x49, 0x62, 0xfd, 0x31, 0x06, 0xcb, 0x81, 0xc0, 0x35, 0x08, 0x7b,
-	0x8a, 0xee, 0x1e, 0xf6, 0x6e, 0x22, 0xe2, 0x1d, 0x98, 0xe3, 0xd2, 0xc3, 0x9a, 0x67, 0xc5, 0x47,
-	0x3e, 0xbf, 0xbd, 0x3a, 0x86, 0x3c, 0x84, 0xe1, 0xa0, 0xe7, 0xa0, 0x9e, 0x09, 0x8e, 0x78, 0x2b,
-	0xf5, 0x09, 0x24, 0x5b, 0xd8, 0xf3, 0xcc, 0x79, 0x29, 0x92, 0x2a, 0x7f, 0x38, 0xe8, 0x17, 0x12,
-	0x7b, 0xd8, 0xab, 0x56, 0x5e, 0xf6, 0x0b, 0xef, 0x85, 0x70, 0x19, 0x5d, 0x6c, 0x1b, 0x2e, 0x41,
-	0x7e, 0x4c, 0xdd, 0x96, 0x5c, 0x6d, 0x36, 0xa8, 0x8b, 0xa5, 0x6e, 0x29, 0xdc, 0x26, 0x8a, 0xfe,
-	0x61, 0x3d, 0xd1, 0xc2, 0x5e, 0xd5, 0xd4, 0x56, 0x61, 0xe5, 0x22, 0x2a, 0x25, 0xd3, 0x3f, 0x2b,
-	0x90, 0xfe, 0xd8, 0x22, 0xad, 0xc8, 0xb8, 0x7d, 0x03, 0xe6, 0x5d, 0x6c, 0x58, 0x8e, 0x85, 0x84,
-	0xfb, 0xb5, 0xe1, 0x93, 0x9c, 0xd2, 0xe7, 0x86, 0xbb, 0xde, 0x3d, 0xde, 0xe1, 0x51, 0x26, 0xa5,
-	0x64, 0xbe, 0xa8, 0xf7, 0x61, 0x61, 0x74, 0x58, 0x5c, 0xee, 0x73, 0xa6, 0x8f, 0xee, 0xf4, 0xbb,
-	0x8e, 0xb6, 0x05, 0x19, 0x81, 0x4a, 0xc0, 0x54, 0x37, 0x20, 0x63, 0x8a, 0x7a, 0x12, 0x36, 0x15,
-	0xff, 0x54, 0x5a, 0xee, 0x79, 0x16, 0xb5, 0xaf, 0x61, 0x69, 0xd7, 0x45, 0x83, 0x63, 0xf9, 0xc8,
-	0x25, 0x7e, 0x3a, 0xb2, 0xa8, 0x48, 0xd1, 0x56, 0x20, 0x77, 0xde, 0xb6, 0x8c, 0xd0, 0x9f, 0x4a,
-	0x20, 0xac, 0xa0, 0xd3, 0xa6, 0xbd, 0x68, 0x8b, 0x7f, 0x58, 0xd1, 0xd3, 0x57, 0xaa, 0x68, 0xb5,
-	0x02, 0x73, 0xdc, 0x73, 0xb0, 0x66, 0x22, 0x37, 0xac, 0x36, 0x93, 0x9d, 0x60, 0x79, 0xbc, 0x1e,
-	0x3c, 0x8d, 0x8a, 0x50, 0x90, 0xc7, 0x33, 0x3c, 0xb4, 0xa7, 0xbd, 0x06, 0xcb, 0x17, 0x40, 0x96,
-	0x84, 0x7c, 0xa3, 0xc0, 0x9a, 0x90, 0xee, 0x23, 0x31, 0x2d, 0xd2, 0x0c, 0xf2, 0x3a, 0xba, 0x78,
-	0xad, 0x43, 0x7e, 0x92, 0x07, 0xd2, 0xc9, 0x5f, 0x14, 0x58, 0xfa, 0x82, 0x72, 0x8c, 0x7e, 0x02,
-	0xab, 0xef, 0xc3, 0xac, 0x43, 0xdb, 0xed, 0x5a, 0x0b, 0x7b, 0x32, 0x6a, 0xf9, 0xa2, 0xf7, 0xd0,
-	0x28, 0x0e, 0xfb, 0x43, 0x10, 0x87, 0x7d, 0xda, 0x6e, 0xef, 0x61, 0x4f, 0x86, 0x60, 0xc6, 0x11,
-	0x4b, 0x75, 0x15, 0x52, 0x0d, 0xe1, 0x36, 0x9a, 0x7e, 0xfc, 0x66, 0xf5, 0xd1, 0x86, 0xf6, 0x16,
-	0xe4, 0xce, 0x03, 0x93, 0x65, 0x76, 0x0b, 0xa6, 0xdb, 0xb4, 0x29, 0xab, 0xcb, 0xfb, 0xd4, 0x7e,
-	0x88, 0xc1, 0x72, 0x48, 0x3d, 0xea, 0xd1, 0xff, 0xaf, 0xb9, 0x18, 0x8e, 0x82, 0xf8, 0x2b, 0x47,
-	0xc1, 0x36, 0x64, 0xbc, 0x59, 0xfe, 0xaa, 0x81, 0x9f, 0xf6, 0x94, 0xe4, 0x62, 0x9c, 0xea, 0xe4,
-	0x59, 0xaa, 0x8b, 0xb0, 0x72, 0x11, 0x77, 0x13, 0xc9, 0xfe, 0x2e, 0x36, 0x96, 0x74, 0xd1, 0x76,
-	0x8a, 0x28, 0xa9, 0x1e, 0x4e, 0x87, 0x44, 0x78, 0x3a, 0x5c, 0x4e, 0xe6, 0x78, 0xde, 0x8e, 0xb5,
-	0x94, 0x0b, 0xa8, 0xfc, 0x4d, 0x81, 0xb5, 0xb0, 0xfa, 0x7f, 0xf0, 0x0a, 0xb9, 0xe6, 0x3a, 0xde,
-	0x86, 0xfc, 0x24, 0x80, 0x13, 0x59, 0x79, 0xa1, 0x04, 0x8d, 0x2f, 0xd0, 0xff, 0xf4, 0x98, 0xa0,
-	0xcb, 0x0e, 0x2d, 0x27, 0x32, 0x5a, 0x46, 0xcf, 0xa5, 0xe9, 0xeb, 0x78, 0x2e, 0x6d, 0x40, 0x61,
-	0x22, 0x42, 0xd9, 0xdb, 0x4f, 0x15, 0xd8, 0x38, 0xa3, 0xe3, 0xa0, 0x6b, 0x70, 0xfa, 0xbf, 0x22,
-	0xe2, 0x1e, 0x68, 0x97, 0x81, 0x94, 0x5c, 0x74, 0x60, 0xf1, 0x33, 0xab, 0x49, 0x76, 0xa9, 0x6d,
-	0x1b, 0xc4, 0x8c, 0x6e, 0x02, 0x3f, 0x81, 0xec, 0xb8, 0x5d, 0x99, 0xb3, 0x1f, 0xc0, 0x62, 0xdd,
-	0xe0, 0x8d, 0x43, 0x34, 0x6b, 0x0d, 0x29, 0xf3, 0x18, 0x12, 0x5e, 0xdc, 0x19, 0xf4, 0x0b, 0xb7,
-	0xcb, 0x42, 0x1c, 0x9c, 0xac, 0x56, 0xf4, 0xdb, 0xf5, 0x33, 0x5b, 0xa6, 0xf6, 0x97, 0x02, 0x0b,
-	0x3b, 0xa6, 0x19, 0xe5, 0xd8, 0xde, 0x80, 0x0c, 0x31, 0xb8, 0xd5, 0xc1, 0xda, 0xe8, 0xc1, 0x95,
-	0xd2, 0xd3, 0x62, 0xcf, 0x7f, 0x69, 0xa9, 0x8f, 0x60, 0xd6, 0x8b, 0x78, 0xe8, 0x77, 0xc6, 0x5a,
-	0x91, 0x33, 0x76, 0xbe, 0x21, 0x04, 0x3f, 0x34, 0x66, 0x5a, 0xe2, 0x43, 0x7d, 0x13, 0x92, 0x8e,
-	0xe1, 0x1a, 0x76, 0x30, 0x95, 0xe6, 0x65, 0x73, 0x4d, 0xee, 0xfb, 0xbb, 0xba, 0x94, 0x6a, 0x2a,
-	0xdc, 0x1a, 0xc1, 0x96, 0x21, 0x7e, 0xae, 0x40, 0x41, 0x76, 0x89, 0xc7, 0x06, 0xc7, 0x63, 0xa3,
-	0x27, 0x5e, 0x65, 0x36, 0x92, 0x1b, 0xf9, 0x1b, 0xfe, 0x21, 0xcc, 0x04, 0x23, 0x38, 0x7e, 0xf1,
-	0x08, 0x0e, 0xe4, 0x9a, 0x06, 0xeb, 0x93, 0x91, 0x49, 0xf8, 0x7f, 0x28, 0xf0, 0x7a, 0xa8, 0x51,
-	0x46, 0x41, 0x41, 0xb8, 0xf3, 0xc7, 0xfe, 0x49, 0xe7, 0x1f, 0x72, 0x38, 0x1d, 0xe6, 0xf0, 0xf2,
-	0x79, 0xf0, 0x08, 0xee, 0x5d, 0x0e, 0x73, 0xd2, 0x54, 0x28, 0x7f, 0x72, 0xf2, 0x7b, 0x7e, 0xea,
-	0x64, 0x90, 0x57, 0x9e, 0x0d, 0xf2, 0xca, 0x8b, 0x41, 0x5e, 0xf9, 0xf6, 0x34, 0x3f, 0xf5, 0xec,
-	0x34, 0x3f, 0xf5, 0xfc, 0x34, 0x3f, 0xf5, 0xe5, 0xdb, 0x57, 0xec, 0x44, 0xd8, 0xb1, 0x05, 0x23,
-	0xf5, 0xa4, 0xff, 0xff, 0xab, 0x77, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xec, 0x94, 0x55,
-	0x58, 0x13, 0x00, 0x00,
+	// 1160 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xd3, 0x24, 0xdd, 0xbc, 0xa4, 0xed, 0xae, 0x9b, 0x6e, 0xd3, 0xd2, 0x26, 0xad, 0x59,
+	0xd8, 0xad, 0x44, 0x1d, 0x5a, 0x24, 0xb4, 0x9c, 0x50, 0xd3, 0xc0, 0x12, 0x15, 0x41, 0x65, 0x0a,
+	0x07, 0xa4, 0x55, 0xe4, 0xc4, 0x6f, 0x53, 0x2b, 0xb1, 0xc7, 0x78, 0xa6, 0x69, 0xc2, 0x89, 0x8f,
+	0xc0, 0x99, 0x2b, 0x7c, 0x0a, 0x3e, 0x41, 0xb9, 0x2d, 0x12, 0x12, 0x2b, 0x0e, 0x61, 0x49, 0xc5,
+	0x07, 0xe0, 0xc0, 0x65, 0x4f, 0xc8, 0x9e, 0x71, 0xe2, 0xb4, 0x4d, 0xb6, 0x02, 0xd5, 0x54, 0x88,
+	0x53, 0x66, 0xe6, 0xbd, 0x79, 0x7f, 0x7e, 0xf3, 0xfe, 0x39, 0x90, 0xc5, 0xb6, 0x55, 0x6c, 0x6f,
+	0xd7, 0x90, 0xe9, 0xdb, 0x45, 0xd6, 0x51, 0x1d, 0x97, 0x30, 0x22, 0xa7, 0xb1, 0x6d, 0xa9, 0xe2,
+	0x74, 0x25, 0xdb, 0x20, 0x0d, 0xe2, 0x9f, 0x17, 0xbd, 0x15, 0x67, 0x59, 0xd9, 0x68, 0x13, 0x86,
+	0x45, 0xec, 0x38, 0xc4, 0x65, 0x68, 0x0c, 0x45, 0x74, 0x1d, 0xa4, 0x82, 0x65, 0x9d, 0x51, 0x3a,
+	0x99, 0x63, 0x69, 0x44, 0xfb, 0x90, 0xa0, 0x30, 0x58, 0xd8, 0x23, 0xf6, 0x13, 0xd3, 0xb5, 0xf6,
+	0x8e, 0x74, 0xd3, 0xd6, 0xf0, 0x8b, 0x63, 0xa4, 0x4c, 0xae, 0x40, 0x92, 0xa2, 0x6d, 0xa0, 0x9b,
+	0x93, 0xd6, 0xa5, 0x07, 0x99, 0xd2, 0xf6, 0x8b, 0x5e, 0x61, 0xab, 0x61, 0xb2, 0xa3, 0xe3, 0x9a,
+	0x5a, 0x27, 0x56, 0xb1, 0x4e, 0xa8, 0x45, 0xa8, 0xf8, 0xd9, 0xa2, 0x46, 0x53, 0x08, 0xdd, 0xad,
+	0xd7, 0x77, 0x0d, 0xc3, 0x45, 0x4a, 0x35, 0x21, 0x40, 0x96, 0x21, 0x6e, 0xeb, 0x16, 0xe6, 0x62,
+	0xeb, 0xd2, 0x83, 0x94, 0xe6, 0xaf, 0x95, 0xbb, 0x90, 0x1d, 0xd5, 0x4a, 0x1d, 0x62, 0x53, 0x54,
+	0xbe, 0x8b, 0xc1, 0xa2, 0x20, 0x94, 0xd1, 0x21, 0xd4, 0x64, 0xd7, 0x60, 0x50, 0x16, 0x12, 0x75,
+	0x4f, 0xab, 0xb0, 0x88, 0x6f, 0xe4, 0x4d, 0x48, 0xb0, 0x4e, 0xd5, 0x34, 0x72, 0xd3, 0xbe, 0xfc,
+	0xec, 0x69, 0xaf, 0x30, 0xf5, 0x4b, 0xaf, 0x10, 0xff, 0x40, 0xa7, 0x47, 0xfd, 0x5e, 0x21, 0x7e,
+	0xd8, 0xa9, 0x94, 0xb5, 0x38, 0xeb, 0x54, 0x0c, 0xf9, 0x11, 0x24, 0x75, 0x8b, 0x1c, 0xdb, 0x2c,
+	0x17, 0xf7, 0x79, 0x8b, 0x82, 0xf7, 0xfe, 0x15, 0xec, 0xf9, 0xd4, 0xb4, 0x99, 0x26, 0xae, 0xcb,
+	0x6f, 0xc3, 0x5c, 0xed, 0xd8, 0xb5, 0xd1, 0xad, 0xea, 0xdc, 0xc6, 0x5c, 0xc2, 0x17, 0x38, 0x2f,
+	0x04, 0xce, 0x04, 0xa6, 0xcf, 0x72, 0x36, 0xb1, 0x55, 0x72, 0x70, 0xf7, 0x3c, 0x4a, 0x02, 0xc0,
+	0x6f, 0x63, 0x90, 0xd5, 0xd0, 0x0b, 0x84, 0xff, 0xf1, 0x1b, 0x8f, 0xdf, 0x26, 0x2c, 0x9e, 0x03,
+	0x89, 0xc3, 0x27, 0xdf, 0x86, 0xe9, 0x16, 0x69, 0xf8, 0x10, 0xa5, 0x34, 0x6f, 0xa9, 0xfc, 0x28,
+	0x0d, 0x12, 0xe4, 0x90, 0x34, 0xd1, 0xbe, 0x89, 0x78, 0xaa, 0x90, 0xd0, 0x29, 0x45, 0x0e, 0x67,
+	0x7a, 0x47, 0x56, 0x43, 0x45, 0x45, 0xdd, 0xf5, 0x28, 0xa5, 0xb8, 0x77, 0x5d, 0xe3, 0x6c, 0xa1,
+	0xec, 0x13, 0x2e, 0x89, 0xe0, 0xf9, 0x21, 0x06, 0xcb, 0x01, 0xc1, 0xd5, 0x6d, 0xfa, 0x04, 0xdd,
+	0x7d, 0xec, 0xde, 0x44, 0x8f, 0x77, 0x61, 0x96, 0x09, 0x0b, 0xab, 0x9e, 0x16, 0xdf, 0xf3, 0xb9,
+	0x9d, 0xd5, 0x11, 0xcf, 0x43, 0x3e, 0x1c, 0x76, 0x1d, 0xd4, 0x32, 0xc1, 0x15, 0x6f, 0x27, 0x3f,
+	0x86, 0x64, 0x13, 0xbb, 0x9e, 0x3a, 0x2f, 0x66, 0x52, 0xa5, 0xf7, 0xfb, 0xbd, 0x42, 0x62, 0x1f,
+	0xbb, 0x95, 0xf2, 0x8b, 0x5e, 0xe1, 0x9d, 0x90, 0x5f, 0x7a, 0x07, 0x5b, 0xba, 0x6b, 0x23, 0x3b,
+	0x21, 0x6e, 0x53, 0xec, 0xb6, 0xea, 0xc4, 0xc5, 0x62, 0xa7, 0x18, 0xae, 0xbb, 0xaa, 0x7f, 0x59,
+	0x4b, 0x34, 0xb1, 0x5b, 0x31, 0x94, 0x55, 0x58, 0xb9, 0x0c, 0x4a, 0x81, 0xf4, 0x4f, 0x12, 0xa4,
+	0x3f, 0x34, 0xed, 0x66, 0x64, 0xd8, 0xbe, 0x06, 0x73, 0x2e, 0xd6, 0x4d, 0xc7, 0x44, 0x9b, 0xf9,
+	0xc9, 0xe2, 0x83, 0x9c, 0xd2, 0x66, 0x07, 0xa7, 0x9e, 0x1c, 0xef, 0xf2, 0x30, 0x92, 0x52, 0x22,
+	0x5e, 0xe4, 0xfb, 0x30, 0x3f, 0xbc, 0xcc, 0x85, 0xfb, 0x98, 0x69, 0x43, 0x99, 0x7e, 0x19, 0x57,
+	0xb6, 0x21, 0xc3, 0xbd, 0x12, 0xe9, 0xb4, 0x01, 0x19, 0x83, 0x67, 0x18, 0xd7, 0xc9, 0xf3, 0x2a,
+	0x2d, 0xce, 0x3c, 0x8d, 0xca, 0x97, 0xb0, 0xb4, 0xe7, 0xa2, 0xce, 0xb0, 0x74, 0xec, 0xda, 0x7e,
+	0x38, 0xd2, 0xa8, 0x40, 0x51, 0x56, 0x20, 0x77, 0x51, 0xb7, 0x78, 0xa1, 0x3f, 0xa4, 0x80, 0x58,
+	0x46, 0xa7, 0x45, 0xba, 0xd1, 0x26, 0xff, 0x20, 0xa3, 0xa7, 0xaf, 0x94, 0xd1, 0x72, 0x19, 0x66,
+	0x99, 0x67, 0x60, 0xd5, 0x40, 0xa6, 0x9b, 0x2d, 0x2a, 0x2a, 0xc1, 0xf2, 0x68, 0x3e, 0x78, 0x1c,
+	0x65, 0xce, 0x20, 0xae, 0x67, 0x58, 0xe8, 0x4c, 0x79, 0x05, 0x96, 0x2f, 0x71, 0x59, 0x00, 0xf2,
+	0x95, 0x04, 0x6b, 0x9c, 0x7a, 0x80, 0xb6, 0x61, 0xda, 0x8d, 0x20, 0xae, 0xa3, 0x7b, 0xaf, 0x75,
+	0xc8, 0x8f, 0xb3, 0x40, 0x18, 0xf9, 0xb3, 0x04, 0x4b, 0x9f, 0x11, 0x86, 0xd1, 0x8f, 0x34, 0xf2,
+	0xbb, 0x70, 0xcb, 0x21, 0xad, 0x56, 0xb5, 0x89, 0x5d, 0xf1, 0x6a, 0x79, 0xd5, 0x9b, 0xdc, 0xd4,
+	0x41, 0x7d, 0x08, 0xde, 0xe1, 0x80, 0xb4, 0x5a, 0xfb, 0xd8, 0x15, 0x4f, 0x30, 0xe3, 0xf0, 0xad,
+	0xbc, 0x0a, 0xa9, 0x3a, 0x37, 0x1b, 0x0d, 0xff, 0xfd, 0x6e, 0x69, 0xc3, 0x03, 0xe5, 0x0d, 0xc8,
+	0x5d, 0x74, 0x6c, 0x6c, 0xd7, 0xfa, 0x3e, 0x06, 0xcb, 0x21, 0xf6, 0xa8, 0x67, 0x81, 0x7f, 0x8c,
+	0xc5, 0xa0, 0x15, 0xc4, 0x5f, 0xda, 0x0a, 0x76, 0x20, 0xe3, 0x35, 0xf7, 0x97, 0x4d, 0x00, 0x69,
+	0x8f, 0x49, 0x6c, 0x46, 0xa1, 0x4e, 0x9e, 0x87, 0x5a, 0x85, 0x95, 0xcb, 0xb0, 0x1b, 0x0b, 0xf6,
+	0x37, 0xb1, 0x91, 0xa0, 0x8b, 0xb6, 0x52, 0x44, 0x09, 0xf5, 0xa0, 0x3b, 0x24, 0xc2, 0xdd, 0x61,
+	0x32, 0x98, 0xa3, 0x71, 0x3b, 0x52, 0x52, 0x2e, 0x81, 0xf2, 0x57, 0x09, 0xd6, 0xc2, 0xec, 0xff,
+	0xc2, 0x14, 0x72, 0xcd, 0x79, 0xbc, 0x03, 0xf9, 0x71, 0x0e, 0x8e, 0x45, 0xe5, 0xb9, 0x14, 0x14,
+	0xbe, 0x80, 0xff, 0xe3, 0x13, 0x1b, 0x5d, 0x7a, 0x64, 0x3a, 0x91, 0xc1, 0x32, 0x1c, 0x97, 0xa6,
+	0xaf, 0x63, 0x5c, 0xda, 0x80, 0xc2, 0x58, 0x0f, 0x45, 0x6d, 0x3f, 0x93, 0x60, 0xe3, 0x1c, 0x8f,
+	0x83, 0xae, 0xce, 0xc8, 0x7f, 0x0a, 0x88, 0x7b, 0xa0, 0x4c, 0x72, 0x52, 0x60, 0xd1, 0x86, 0x85,
+	0x4f, 0xcc, 0x86, 0xbd, 0x47, 0x2c, 0x4b, 0xb7, 0x8d, 0xe8, 0x3a, 0xf0, 0x63, 0xc8, 0x8e, 0xea,
+	0x15, 0x31, 0xfb, 0x1e, 0x2c, 0xd4, 0x74, 0x56, 0x3f, 0x42, 0xa3, 0x5a, 0x17, 0x34, 0x0f, 0x21,
+	0x6e, 0xc5, 0x62, 0xbf, 0x57, 0xb8, 0x53, 0xe2, 0xe4, 0xe0, 0x66, 0xa5, 0xac, 0xdd, 0xa9, 0x9d,
+	0x3b, 0x32, 0x94, 0x3f, 0x25, 0x98, 0xdf, 0x35, 0x8c, 0x28, 0xdb, 0xf6, 0x06, 0x64, 0x6c, 0x9d,
+	0x99, 0x6d, 0xac, 0x0e, 0x07, 0xae, 0x94, 0x96, 0xe6, 0x67, 0xfe, 0xa4, 0x25, 0x3f, 0x84, 0x5b,
+	0xde, 0x8b, 0x87, 0xbe, 0x33, 0xd6, 0x54, 0x46, 0xe9, 0xc5, 0x82, 0x10, 0x7c, 0x68, 0xcc, 0x34,
+	0xf9, 0x42, 0x7e, 0x1d, 0x92, 0x8e, 0xee, 0xea, 0x56, 0xd0, 0x95, 0xe6, 0x44, 0x71, 0x4d, 0x1e,
+	0xf8, 0xa7, 0x9a, 0xa0, 0x2a, 0x32, 0xdc, 0x1e, 0xba, 0x2d, 0x9e, 0xf8, 0x99, 0x04, 0x05, 0x51,
+	0x25, 0x1e, 0xe9, 0x0c, 0x4f, 0xf4, 0x2e, 0x9f, 0xca, 0x2c, 0xb4, 0x6f, 0xe4, 0x47, 0xfd, 0x26,
+	0xcc, 0x04, 0x2d, 0x38, 0x7e, 0x79, 0x0b, 0x0e, 0xe8, 0x8a, 0x02, 0xeb, 0xe3, 0x3d, 0x13, 0xee,
+	0xff, 0x2e, 0xc1, 0xab, 0xa1, 0x42, 0x19, 0x05, 0x04, 0xe1, 0xca, 0x1f, 0xfb, 0x3b, 0x95, 0x7f,
+	0x80, 0xe1, 0x74, 0x18, 0xc3, 0xc9, 0xfd, 0xe0, 0x21, 0xdc, 0x9b, 0xec, 0xe6, 0xb8, 0xae, 0x50,
+	0xfa, 0xe8, 0xf4, 0xb7, 0xfc, 0xd4, 0x69, 0x3f, 0x2f, 0x3d, 0xed, 0xe7, 0xa5, 0xe7, 0xfd, 0xbc,
+	0xf4, 0xf5, 0x59, 0x7e, 0xea, 0xe9, 0x59, 0x7e, 0xea, 0xd9, 0x59, 0x7e, 0xea, 0xf3, 0x37, 0xaf,
+	0x58, 0x89, 0xbc, 0xff, 0x05, 0x7d, 0x44, 0x6a, 0x49, 0xff, 0x0f, 0xc1, 0xb7, 0xfe, 0x1a, 0x00,
+	0x9a, 0x92, 0xea, 0x71, 0xa9, 0x14, 0x00, 0x00,
 }
 
 func (m *ConfirmChainRequest) Marshal() (dAtA []byte, err error) {
@@ -1679,6 +1762,103 @@ func (m *ConfirmDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ReportDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnerAddress.Size()
+		i -= size
+		if _, err := m.BurnerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TxID.Size()
+		i -= size
+		if _, err := m.TxID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConfirmTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3033,6 +3213,42 @@ func (m *ConfirmDepositResponse) Size() (n int) {
 	return n
 }
 
+func (m *ReportDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TxID.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BurnerAddress.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *ReportDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *ConfirmTokenRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3999,6 +4215,303 @@ func (m *ConfirmDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReportDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ConfirmTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -212,6 +212,11 @@ func GetConfirmGatewayDeploymentPollKey(chain nexus.Chain, txID Hash, address Ad
 	return vote.NewPollKey(ModuleName, fmt.Sprintf("%s_%s_%s", chain.Name, txID.Hex(), address.Hex()))
 }
 
+// GetDepositPollKey creates a poll key for the confirmation of a reported deposit
+func GetDepositPollKey(txID Hash, burnerAddr Address, amount sdk.Uint) vote.PollKey {
+	return vote.NewPollKey(ModuleName, fmt.Sprintf("%s_%s_%d", txID.Hex(), burnerAddr.Hex(), amount.Uint64()))
+}
+
 // GetConfirmTokenKey creates a poll key for token confirmation
 func GetConfirmTokenKey(txID Hash, asset string) vote.PollKey {
 	return vote.NewPollKey(ModuleName, txID.Hex()+"_"+strings.ToLower(asset))
@@ -677,6 +682,16 @@ func (c Command) Clone() Command {
 	return clone
 }
 
+// IsKeyTransfer returns true if the command transfers ownership or operatorship of the gateway to a new key
+func (c Command) IsKeyTransfer() bool {
+	switch c.Command {
+	case axelarGatewayCommandTransferOwnership, axelarGatewayCommandTransferOperatorship:
+		return true
+	default:
+		return false
+	}
+}
+
 // CommandBatch represents a batch of commands
 type CommandBatch struct {
 	metadata CommandBatchMetadata
@@ -712,6 +727,11 @@ func (b CommandBatch) GetID() []byte {
 
 }
 
+// GetCommandIDs returns the IDs of the commands included in the batch
+func (b CommandBatch) GetCommandIDs() []CommandID {
+	return b.metadata.CommandIDs
+}
+
 // GetKeyID returns the batch's key ID
 func (b CommandBatch) GetKeyID() tss.KeyID {
 	return b.metadata.KeyID
@@ -5,9 +5,11 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	utils "github.com/axelarnetwork/axelar-core/utils"
+	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	_ "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	exported1 "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -691,6 +693,212 @@ func (m *Gateway) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Gateway proto.InternalMessageInfo
 
+// ChainRecord holds the complete state of an EVM chain
+type ChainRecord struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pending_chain is only set if the chain has not been confirmed yet
+	PendingChain         *exported.Chain               `protobuf:"bytes,2,opt,name=pending_chain,json=pendingChain,proto3" json:"pending_chain,omitempty"`
+	Gateway              *Gateway                      `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	UnsignedBatchID      []byte                        `protobuf:"bytes,4,opt,name=unsigned_batch_id,json=unsignedBatchId,proto3" json:"unsigned_batch_id,omitempty"`
+	LatestSignedBatchID  []byte                        `protobuf:"bytes,5,opt,name=latest_signed_batch_id,json=latestSignedBatchId,proto3" json:"latest_signed_batch_id,omitempty"`
+	UnsignedTxs          []ChainRecord_UnsignedTx      `protobuf:"bytes,6,rep,name=unsigned_txs,json=unsignedTxs,proto3" json:"unsigned_txs"`
+	Tokens               []ERC20TokenMetadata          `protobuf:"bytes,7,rep,name=tokens,proto3" json:"tokens"`
+	PendingDeposits      []ChainRecord_PollDeposit     `protobuf:"bytes,8,rep,name=pending_deposits,json=pendingDeposits,proto3" json:"pending_deposits"`
+	ConfirmedDeposits    []ERC20Deposit                `protobuf:"bytes,9,rep,name=confirmed_deposits,json=confirmedDeposits,proto3" json:"confirmed_deposits"`
+	BurnedDeposits       []ERC20Deposit                `protobuf:"bytes,10,rep,name=burned_deposits,json=burnedDeposits,proto3" json:"burned_deposits"`
+	CommandBatches       []CommandBatchMetadata        `protobuf:"bytes,11,rep,name=command_batches,json=commandBatches,proto3" json:"command_batches"`
+	Commands             []Command                     `protobuf:"bytes,12,rep,name=commands,proto3" json:"commands"`
+	CommandQueue         utils.QueueState              `protobuf:"bytes,13,opt,name=command_queue,json=commandQueue,proto3" json:"command_queue"`
+	Burners              []ChainRecord_Burner          `protobuf:"bytes,14,rep,name=burners,proto3" json:"burners"`
+	PendingTransferKeys  []ChainRecord_PollTransferKey `protobuf:"bytes,15,rep,name=pending_transfer_keys,json=pendingTransferKeys,proto3" json:"pending_transfer_keys"`
+	ArchivedTransferKeys []ChainRecord_PollTransferKey `protobuf:"bytes,16,rep,name=archived_transfer_keys,json=archivedTransferKeys,proto3" json:"archived_transfer_keys"`
+}
+
+func (m *ChainRecord) Reset()         { *m = ChainRecord{} }
+func (m *ChainRecord) String() string { return proto.CompactTextString(m) }
+func (*ChainRecord) ProtoMessage()    {}
+func (*ChainRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{12}
+}
+func (m *ChainRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRecord.Merge(m, src)
+}
+func (m *ChainRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRecord proto.InternalMessageInfo
+
+type ChainRecord_UnsignedTx struct {
+	TxID     string              `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Metadata TransactionMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *ChainRecord_UnsignedTx) Reset()         { *m = ChainRecord_UnsignedTx{} }
+func (m *ChainRecord_UnsignedTx) String() string { return proto.CompactTextString(m) }
+func (*ChainRecord_UnsignedTx) ProtoMessage()    {}
+func (*ChainRecord_UnsignedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{12, 0}
+}
+func (m *ChainRecord_UnsignedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRecord_UnsignedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRecord_UnsignedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRecord_UnsignedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRecord_UnsignedTx.Merge(m, src)
+}
+func (m *ChainRecord_UnsignedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRecord_UnsignedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRecord_UnsignedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRecord_UnsignedTx proto.InternalMessageInfo
+
+type ChainRecord_PollDeposit struct {
+	PollKey exported1.PollKey `protobuf:"bytes,1,opt,name=poll_key,json=pollKey,proto3" json:"poll_key"`
+	Deposit ERC20Deposit      `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *ChainRecord_PollDeposit) Reset()         { *m = ChainRecord_PollDeposit{} }
+func (m *ChainRecord_PollDeposit) String() string { return proto.CompactTextString(m) }
+func (*ChainRecord_PollDeposit) ProtoMessage()    {}
+func (*ChainRecord_PollDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{12, 1}
+}
+func (m *ChainRecord_PollDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRecord_PollDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRecord_PollDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRecord_PollDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRecord_PollDeposit.Merge(m, src)
+}
+func (m *ChainRecord_PollDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRecord_PollDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRecord_PollDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRecord_PollDeposit proto.InternalMessageInfo
+
+type ChainRecord_Burner struct {
+	Address Address    `protobuf:"bytes,1,opt,name=address,proto3,customtype=Address" json:"address"`
+	Info    BurnerInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info"`
+}
+
+func (m *ChainRecord_Burner) Reset()         { *m = ChainRecord_Burner{} }
+func (m *ChainRecord_Burner) String() string { return proto.CompactTextString(m) }
+func (*ChainRecord_Burner) ProtoMessage()    {}
+func (*ChainRecord_Burner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{12, 2}
+}
+func (m *ChainRecord_Burner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRecord_Burner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRecord_Burner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRecord_Burner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRecord_Burner.Merge(m, src)
+}
+func (m *ChainRecord_Burner) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRecord_Burner) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRecord_Burner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRecord_Burner proto.InternalMessageInfo
+
+type ChainRecord_PollTransferKey struct {
+	PollKey     exported1.PollKey `protobuf:"bytes,1,opt,name=poll_key,json=pollKey,proto3" json:"poll_key"`
+	TransferKey TransferKey       `protobuf:"bytes,2,opt,name=transfer_key,json=transferKey,proto3" json:"transfer_key"`
+}
+
+func (m *ChainRecord_PollTransferKey) Reset()         { *m = ChainRecord_PollTransferKey{} }
+func (m *ChainRecord_PollTransferKey) String() string { return proto.CompactTextString(m) }
+func (*ChainRecord_PollTransferKey) ProtoMessage()    {}
+func (*ChainRecord_PollTransferKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2cf809b4baed32, []int{12, 3}
+}
+func (m *ChainRecord_PollTransferKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRecord_PollTransferKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRecord_PollTransferKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRecord_PollTransferKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRecord_PollTransferKey.Merge(m, src)
+}
+func (m *ChainRecord_PollTransferKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRecord_PollTransferKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRecord_PollTransferKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRecord_PollTransferKey proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("evm.v1beta1.Status", Status_name, Status_value)
 	proto.RegisterEnum("evm.v1beta1.BatchedCommandsStatus", BatchedCommandsStatus_name, BatchedCommandsStatus_value)
@@ -710,111 +918,148 @@ func init() {
 	proto.RegisterType((*Asset)(nil), "evm.v1beta1.Asset")
 	proto.RegisterType((*TokenDetails)(nil), "evm.v1beta1.TokenDetails")
 	proto.RegisterType((*Gateway)(nil), "evm.v1beta1.Gateway")
+	proto.RegisterType((*ChainRecord)(nil), "evm.v1beta1.ChainRecord")
+	proto.RegisterType((*ChainRecord_UnsignedTx)(nil), "evm.v1beta1.ChainRecord.UnsignedTx")
+	proto.RegisterType((*ChainRecord_PollDeposit)(nil), "evm.v1beta1.ChainRecord.PollDeposit")
+	proto.RegisterType((*ChainRecord_Burner)(nil), "evm.v1beta1.ChainRecord.Burner")
+	proto.RegisterType((*ChainRecord_PollTransferKey)(nil), "evm.v1beta1.ChainRecord.PollTransferKey")
 }
 
 func init() { proto.RegisterFile("evm/v1beta1/types.proto", fileDescriptor_af2cf809b4baed32) }
 
 var fileDescriptor_af2cf809b4baed32 = []byte{
-	// 1581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x23, 0x49,
-	0x15, 0x4e, 0x3b, 0xfe, 0x89, 0x8f, 0x9d, 0x8c, 0xb7, 0x36, 0x99, 0xf1, 0xf6, 0xb2, 0x76, 0xcb,
-	0xcb, 0x90, 0xb0, 0xc3, 0xd8, 0xf3, 0xb3, 0x20, 0x06, 0x09, 0x84, 0xed, 0xf6, 0x64, 0x7a, 0x87,
-	0xd8, 0x56, 0xbb, 0x23, 0x76, 0x57, 0x42, 0xad, 0xb2, 0xbb, 0xc6, 0x69, 0x12, 0x77, 0x5b, 0x5d,
-	0xe5, 0xa4, 0xcd, 0x13, 0x20, 0x4b, 0x48, 0x3c, 0x00, 0xbe, 0x40, 0x70, 0x81, 0x78, 0x02, 0xee,
-	0xd8, 0x1b, 0xa4, 0xb9, 0xdc, 0x2b, 0x84, 0xb8, 0xb0, 0xc0, 0x23, 0x5e, 0x62, 0x25, 0x04, 0xea,
-	0xea, 0x8a, 0xdd, 0xf6, 0x78, 0x66, 0x67, 0x24, 0xb8, 0x8a, 0xab, 0xea, 0x3b, 0x75, 0xbe, 0xf3,
-	0x9d, 0x9f, 0x4a, 0xc3, 0x2d, 0x72, 0x39, 0xa8, 0x5c, 0xde, 0xef, 0x12, 0x86, 0xef, 0x57, 0xd8,
-	0x78, 0x48, 0x68, 0x79, 0xe8, 0xb9, 0xcc, 0x45, 0x19, 0x72, 0x39, 0x28, 0x8b, 0x03, 0x79, 0xbf,
-	0xef, 0xf6, 0x5d, 0xbe, 0x5f, 0x09, 0x7e, 0x85, 0x10, 0xb9, 0xe4, 0x10, 0x7f, 0x44, 0x2b, 0xc4,
-	0x1f, 0xba, 0x1e, 0x23, 0xd6, 0xa6, 0x6b, 0x64, 0x85, 0xd1, 0xd7, 0x23, 0x4a, 0x18, 0x32, 0x4d,
-	0xc2, 0xae, 0x5c, 0xef, 0x5c, 0x73, 0x9e, 0xb9, 0x08, 0x41, 0xdc, 0xc1, 0x03, 0x92, 0x97, 0x14,
-	0xe9, 0x28, 0xad, 0xf3, 0xdf, 0xe8, 0x47, 0x10, 0xb3, 0xad, 0x7c, 0x4c, 0x91, 0x8e, 0xb2, 0xb5,
-	0xf2, 0xf3, 0x59, 0x71, 0xeb, 0xef, 0xb3, 0xe2, 0xb7, 0xfa, 0x36, 0x3b, 0x1b, 0x75, 0xcb, 0x3d,
-	0x77, 0x50, 0xe9, 0xb9, 0x74, 0xe0, 0x52, 0xf1, 0xe7, 0x2e, 0xb5, 0xce, 0x85, 0x03, 0xcd, 0x61,
-	0x7a, 0xcc, 0xb6, 0x4a, 0x7f, 0x96, 0x00, 0x6a, 0x23, 0xcf, 0x21, 0x1e, 0x77, 0xf1, 0x31, 0xec,
-	0x32, 0xf7, 0x9c, 0x38, 0x26, 0xb6, 0x2c, 0x8f, 0x50, 0xca, 0x7d, 0x65, 0x6b, 0x37, 0xc4, 0xcd,
-	0xa9, 0x6a, 0xb8, 0xad, 0x67, 0x39, 0x4a, 0xac, 0xd0, 0x1d, 0x78, 0xc7, 0x22, 0x94, 0xd9, 0x0e,
-	0x66, 0xb6, 0xeb, 0x98, 0xbd, 0x33, 0x6c, 0x3b, 0x9c, 0x53, 0x5a, 0xcf, 0x45, 0x0e, 0xea, 0xc1,
-	0x3e, 0xba, 0x09, 0x49, 0x3a, 0x1e, 0x74, 0xdd, 0x8b, 0xfc, 0x36, 0x47, 0x88, 0x15, 0xda, 0x87,
-	0x04, 0xa6, 0x94, 0xb0, 0x7c, 0x9c, 0x6f, 0x87, 0x0b, 0xa4, 0x40, 0x9c, 0xe2, 0x0b, 0x96, 0x4f,
-	0x70, 0x1e, 0x59, 0xc1, 0x23, 0xfe, 0x04, 0xd3, 0x33, 0x9d, 0x9f, 0x94, 0xfe, 0x2d, 0x41, 0xb6,
-	0xa1, 0xd7, 0x1f, 0xdc, 0x53, 0xc9, 0xd0, 0xa5, 0x36, 0x43, 0xdf, 0x86, 0x04, 0xf3, 0x4d, 0xdb,
-	0x12, 0xdc, 0xf7, 0xa3, 0x36, 0xf3, 0x59, 0x31, 0x6e, 0xf8, 0x9a, 0xaa, 0xc7, 0x99, 0xaf, 0x59,
-	0xe8, 0x18, 0x92, 0x78, 0xe0, 0x8e, 0x1c, 0x26, 0x14, 0xac, 0x08, 0xec, 0xe1, 0x1b, 0x28, 0x78,
-	0x6a, 0x3b, 0x4c, 0x17, 0xe6, 0x4b, 0xf2, 0xdb, 0x51, 0xf2, 0x1b, 0x75, 0x89, 0xbf, 0x42, 0x97,
-	0xef, 0xc1, 0x5e, 0x97, 0x27, 0x62, 0xa1, 0x7d, 0x62, 0xb3, 0xf6, 0xbb, 0x21, 0x4c, 0x2c, 0x4b,
-	0x7f, 0x89, 0x01, 0xe2, 0xf1, 0x1b, 0x41, 0x4a, 0x4e, 0x08, 0xc3, 0x16, 0x66, 0x78, 0xc9, 0x48,
-	0x8a, 0x32, 0x32, 0x60, 0x87, 0xb3, 0x30, 0x17, 0x45, 0xf3, 0xe8, 0xed, 0x8a, 0x66, 0x3e, 0x2b,
-	0xa6, 0x38, 0x5f, 0x4d, 0xd5, 0x53, 0xfc, 0x2a, 0xcd, 0x42, 0x8f, 0x20, 0x65, 0x11, 0x86, 0xed,
-	0x0b, 0xca, 0xe3, 0xcf, 0x3c, 0x78, 0xaf, 0x1c, 0x69, 0x91, 0x32, 0x27, 0xa6, 0x86, 0x80, 0x5a,
-	0x3c, 0xf0, 0xa7, 0x5f, 0xe3, 0x5f, 0x2e, 0x38, 0x2e, 0xcf, 0xd7, 0x15, 0xdc, 0x6d, 0x48, 0x31,
-	0xdf, 0x3c, 0xc3, 0xf4, 0x8c, 0x8b, 0x94, 0x5e, 0x2b, 0x8c, 0x24, 0xf3, 0x83, 0xbf, 0xe8, 0x0e,
-	0x24, 0x29, 0xc3, 0x6c, 0x44, 0xf3, 0x49, 0x45, 0x3a, 0xda, 0x7b, 0xf0, 0xee, 0x0a, 0xad, 0x0e,
-	0x3f, 0xd2, 0x05, 0xa4, 0xd4, 0x86, 0x77, 0x0d, 0x0f, 0x3b, 0x14, 0xf7, 0x82, 0x9c, 0x2c, 0x74,
-	0x54, 0x20, 0xe9, 0xe1, 0x2b, 0x93, 0xf9, 0xa2, 0x9c, 0xd2, 0xf3, 0x59, 0x31, 0xa1, 0xe3, 0x2b,
-	0xe3, 0x53, 0x3d, 0xe1, 0xe1, 0x2b, 0xc3, 0x47, 0xb7, 0x20, 0x35, 0x1c, 0x75, 0xcd, 0x73, 0x32,
-	0x0e, 0x25, 0xd5, 0x93, 0xc3, 0x51, 0xf7, 0x29, 0x19, 0x97, 0xfe, 0x25, 0x41, 0xaa, 0xee, 0x0e,
-	0x06, 0xd8, 0xb1, 0xd0, 0x21, 0xef, 0xd3, 0xf0, 0x8a, 0x5b, 0x82, 0x6c, 0x5a, 0x1c, 0x6a, 0xea,
-	0x7c, 0x56, 0x8c, 0x69, 0x6a, 0xd0, 0x90, 0x28, 0x0f, 0xa9, 0x5e, 0xb8, 0x2d, 0x3a, 0xe8, 0x7a,
-	0x19, 0x34, 0xce, 0x10, 0x7b, 0x78, 0x10, 0x8a, 0x1c, 0xb8, 0xe1, 0x2b, 0xf4, 0x33, 0x48, 0x9e,
-	0x93, 0x71, 0x90, 0xd1, 0x50, 0xbb, 0xc7, 0x01, 0xc3, 0xa7, 0x64, 0xac, 0xa9, 0x5f, 0xcd, 0x8a,
-	0x8f, 0x22, 0x69, 0xc5, 0x3e, 0xb9, 0xc0, 0x9e, 0x13, 0x4e, 0x14, 0xb1, 0xba, 0xdb, 0x73, 0x3d,
-	0x52, 0xf1, 0x2b, 0xd1, 0x59, 0x54, 0xe6, 0xc6, 0x7a, 0xe2, 0x9c, 0x8c, 0x35, 0x0b, 0x29, 0x90,
-	0x1d, 0x60, 0xdf, 0xec, 0x63, 0x6a, 0xf6, 0x5c, 0x1a, 0x76, 0xe2, 0xae, 0x0e, 0x03, 0xec, 0x1f,
-	0x63, 0x5a, 0x77, 0x29, 0x2b, 0xfd, 0x66, 0x1b, 0xf6, 0x45, 0x28, 0x35, 0xcc, 0x7a, 0x67, 0x0b,
-	0xed, 0x6e, 0x46, 0x82, 0x4e, 0x46, 0x62, 0xfc, 0x31, 0x64, 0x44, 0x50, 0xa6, 0x6d, 0xd1, 0x7c,
-	0x4c, 0xd9, 0x3e, 0xca, 0xd6, 0x8a, 0x9b, 0x54, 0x81, 0xc5, 0x82, 0xea, 0x20, 0x6c, 0x34, 0x8b,
-	0x06, 0xa3, 0x30, 0xf0, 0x20, 0x94, 0xe0, 0xbf, 0xd1, 0x21, 0xec, 0x50, 0xbb, 0x1f, 0x56, 0x45,
-	0x7c, 0xc3, 0xb8, 0x48, 0x51, 0xbb, 0xcf, 0xcb, 0xe2, 0x07, 0x8b, 0xb2, 0x48, 0xf0, 0xb2, 0x28,
-	0xad, 0x94, 0x05, 0x0f, 0x81, 0x58, 0xc2, 0x31, 0x5d, 0xad, 0x92, 0x88, 0xd8, 0xc9, 0xff, 0x87,
-	0xd8, 0x3a, 0xe4, 0x87, 0x1e, 0xb9, 0x34, 0xbb, 0x21, 0x09, 0x53, 0x84, 0x4c, 0x03, 0x87, 0x29,
-	0x1e, 0xd3, 0x7b, 0xf3, 0x59, 0xf1, 0xa0, 0xed, 0x91, 0xcb, 0x35, 0x9e, 0x9a, 0xaa, 0x1f, 0x0c,
-	0x37, 0x6c, 0x5b, 0xa5, 0x13, 0xc8, 0x74, 0xec, 0xfe, 0x22, 0x29, 0x47, 0x10, 0x0f, 0xba, 0x99,
-	0xa7, 0x65, 0xef, 0xc1, 0xfe, 0x6a, 0x4b, 0xd8, 0x7d, 0x63, 0x3c, 0x24, 0x3a, 0x47, 0x04, 0x23,
-	0x24, 0x3a, 0xca, 0xc3, 0x45, 0xe9, 0xaf, 0x12, 0x64, 0x78, 0xa3, 0x3c, 0x23, 0xde, 0x53, 0x32,
-	0x7e, 0x9b, 0x71, 0x7b, 0x4f, 0xb8, 0x8e, 0x71, 0xd7, 0xdf, 0x58, 0x1d, 0x12, 0xcb, 0x2b, 0x23,
-	0x14, 0x7e, 0x0e, 0x19, 0x87, 0xf8, 0xcc, 0x14, 0x9a, 0xf3, 0xe9, 0x5a, 0xfb, 0x64, 0x3e, 0x2b,
-	0xa6, 0x9b, 0xc4, 0x67, 0xff, 0x03, 0xdd, 0xd3, 0x8e, 0xb8, 0xc7, 0x2a, 0xdd, 0x87, 0x44, 0x95,
-	0x0f, 0xc9, 0x45, 0xdc, 0x52, 0x24, 0xee, 0xc5, 0xeb, 0x1b, 0x5b, 0xbe, 0xbe, 0xa5, 0x3f, 0x49,
-	0x90, 0x8d, 0x4e, 0x37, 0xf4, 0x01, 0x40, 0x38, 0xce, 0x22, 0x0f, 0x75, 0x9a, 0xef, 0x34, 0x83,
-	0xd7, 0x7a, 0xf9, 0xf6, 0xc5, 0x56, 0xde, 0xbe, 0xdb, 0xb0, 0x63, 0x91, 0x9e, 0x3d, 0xc0, 0x62,
-	0x82, 0xee, 0xd6, 0xd2, 0x5f, 0xcd, 0x8a, 0x89, 0x91, 0xed, 0xb0, 0xef, 0xeb, 0x8b, 0x23, 0xf4,
-	0x09, 0xec, 0xf4, 0xf0, 0x10, 0xf7, 0x6c, 0x36, 0x16, 0x15, 0xfe, 0xb6, 0x4f, 0xfe, 0xc2, 0xbe,
-	0xf4, 0xab, 0x18, 0xa4, 0x8e, 0x31, 0x23, 0x57, 0x38, 0x48, 0x61, 0xea, 0x6b, 0xde, 0xfb, 0xeb,
-	0x73, 0xf4, 0x70, 0xd1, 0x3b, 0x61, 0x12, 0xdf, 0x5f, 0x49, 0xa2, 0xb8, 0x70, 0x7d, 0xb4, 0xfe,
-	0x56, 0x82, 0x64, 0xb8, 0x85, 0xee, 0x02, 0xea, 0x18, 0x55, 0xe3, 0xb4, 0x63, 0x9e, 0x36, 0x3b,
-	0xed, 0x46, 0x5d, 0x7b, 0xac, 0x35, 0xd4, 0xdc, 0x96, 0x7c, 0x30, 0x99, 0x2a, 0xef, 0x08, 0xf3,
-	0x10, 0xda, 0x74, 0x1d, 0x82, 0xbe, 0x03, 0x7b, 0x02, 0xde, 0x6e, 0x34, 0x55, 0xad, 0x79, 0x9c,
-	0x93, 0xe4, 0xfc, 0x64, 0xaa, 0xec, 0xaf, 0x40, 0xdb, 0xc4, 0xb1, 0x6c, 0xa7, 0x8f, 0xee, 0x41,
-	0x4e, 0xa0, 0xeb, 0xad, 0xe6, 0x63, 0x4d, 0x3f, 0x69, 0xa8, 0xb9, 0x98, 0x2c, 0x4f, 0xa6, 0xca,
-	0xcd, 0x15, 0x7c, 0xdd, 0x75, 0x9e, 0xd9, 0xde, 0x80, 0x58, 0xf2, 0xce, 0x2f, 0x7f, 0x57, 0xd8,
-	0xfa, 0xc3, 0xef, 0x0b, 0xd2, 0x47, 0x7f, 0x5c, 0x72, 0x3c, 0x7c, 0x05, 0xc7, 0x1b, 0x93, 0xa9,
-	0x92, 0x69, 0xba, 0x4e, 0xc3, 0xb7, 0x29, 0x23, 0x0e, 0x8b, 0x00, 0xb5, 0xa6, 0x66, 0x68, 0xd5,
-	0x9f, 0x68, 0x9f, 0x37, 0xd4, 0x9c, 0x14, 0x02, 0x35, 0xc7, 0x66, 0x36, 0xbe, 0xb0, 0x7f, 0x41,
-	0x2c, 0x54, 0x7c, 0x29, 0x8c, 0x98, 0x9c, 0x99, 0x4c, 0x95, 0xd4, 0x35, 0xf3, 0x0f, 0x37, 0x30,
-	0x8f, 0xcb, 0xbb, 0x93, 0xa9, 0x92, 0xde, 0x44, 0xf6, 0x3f, 0x12, 0x1c, 0x6c, 0x9c, 0x53, 0xe8,
-	0x87, 0xf0, 0x61, 0xad, 0x6a, 0xd4, 0x9f, 0x34, 0x54, 0xb3, 0xde, 0x3a, 0x39, 0xa9, 0x36, 0xd5,
-	0x8e, 0xb9, 0x31, 0x98, 0xfd, 0xc9, 0x54, 0xc9, 0xf1, 0x3b, 0xa2, 0x11, 0x7d, 0x17, 0x8a, 0xaf,
-	0x32, 0xef, 0x68, 0xc7, 0xcd, 0x30, 0x01, 0xb9, 0xc9, 0x54, 0xc9, 0x72, 0xd3, 0x8e, 0xdd, 0x77,
-	0x02, 0xfa, 0xaf, 0x31, 0xab, 0xd6, 0x5a, 0xba, 0xc1, 0xf3, 0xb0, 0x34, 0xab, 0x76, 0x79, 0x0b,
-	0xa2, 0x87, 0x50, 0x78, 0x9d, 0xb7, 0x86, 0x9a, 0xdb, 0x0e, 0xb5, 0x5c, 0x38, 0x23, 0x96, 0x1c,
-	0x0f, 0x54, 0xf8, 0xe8, 0x0b, 0x09, 0x6e, 0xac, 0x8d, 0x0c, 0x54, 0x85, 0x0f, 0x0c, 0xbd, 0xda,
-	0xec, 0x3c, 0x6e, 0xe8, 0xe6, 0xd3, 0xc6, 0x67, 0xa6, 0xf1, 0x59, 0xbb, 0xb1, 0x16, 0x75, 0x61,
-	0x32, 0x55, 0xe4, 0x53, 0x87, 0x0e, 0x49, 0xcf, 0x7e, 0x66, 0x13, 0x6b, 0xfd, 0x8a, 0x32, 0xbc,
-	0xff, 0xf2, 0x15, 0xad, 0x9f, 0x36, 0x1b, 0x7a, 0xe7, 0x89, 0xd6, 0xce, 0x49, 0x61, 0x4a, 0x5a,
-	0x57, 0x0e, 0xf1, 0xe8, 0x99, 0x3d, 0x44, 0x1f, 0x43, 0x61, 0x03, 0xbe, 0xdd, 0xd0, 0xab, 0x46,
-	0x2b, 0x34, 0x11, 0x71, 0xb7, 0x86, 0xc4, 0xc3, 0xcc, 0xe5, 0x56, 0x22, 0x84, 0x31, 0xa4, 0xc4,
-	0xbc, 0x45, 0x25, 0xd8, 0xef, 0x68, 0xc7, 0x9b, 0x08, 0xef, 0x4c, 0xa6, 0x4a, 0x9c, 0xb7, 0x82,
-	0x0c, 0x99, 0x05, 0xc6, 0xf8, 0x34, 0x27, 0xc9, 0xe9, 0xc9, 0x54, 0x49, 0x04, 0x37, 0xf8, 0xe8,
-	0x9b, 0x90, 0x5b, 0x9c, 0x09, 0x25, 0x73, 0x31, 0x79, 0x6f, 0x32, 0x55, 0xa0, 0x63, 0xf7, 0x45,
-	0x89, 0x44, 0xea, 0xe7, 0x0b, 0x09, 0x76, 0xc5, 0xbf, 0xcb, 0xa2, 0x6e, 0x8e, 0x40, 0x56, 0x1b,
-	0xed, 0x56, 0x47, 0x33, 0x36, 0x97, 0xcb, 0x92, 0xc7, 0x21, 0xdc, 0x5c, 0x43, 0x2e, 0x5b, 0x73,
-	0xa5, 0xa6, 0xef, 0x40, 0x7e, 0x0d, 0x18, 0xed, 0xca, 0xd5, 0xda, 0x46, 0xb7, 0xe1, 0x60, 0x0d,
-	0x5c, 0x3b, 0xd5, 0xc3, 0x0a, 0x80, 0xc9, 0x54, 0x49, 0xf2, 0x6f, 0x94, 0x30, 0x04, 0x29, 0x08,
-	0xa1, 0xd6, 0x7c, 0xfe, 0xcf, 0xc2, 0xd6, 0xf3, 0x79, 0x41, 0xfa, 0x72, 0x5e, 0x90, 0xfe, 0x31,
-	0x2f, 0x48, 0xbf, 0x7e, 0x51, 0xd8, 0xfa, 0xf2, 0x45, 0x61, 0xeb, 0x6f, 0x2f, 0x0a, 0x5b, 0x9f,
-	0xdf, 0x7b, 0xc3, 0x17, 0x21, 0xf8, 0xc4, 0xe3, 0xd3, 0xb1, 0x9b, 0xe4, 0x9f, 0x5c, 0x0f, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x63, 0x64, 0x58, 0x95, 0xf6, 0x0d, 0x00, 0x00,
+	// 2096 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdb, 0x6f, 0x1b, 0x59,
+	0x19, 0xcf, 0x38, 0xbe, 0x7e, 0x76, 0x12, 0xf7, 0xc4, 0x49, 0xbd, 0x5e, 0x6a, 0xcf, 0xba, 0x5b,
+	0x1a, 0x5a, 0xea, 0xb4, 0xe9, 0xb2, 0xa2, 0x48, 0x4b, 0xb1, 0x63, 0x37, 0x9d, 0x6d, 0xeb, 0x98,
+	0xb1, 0x23, 0x76, 0x57, 0x42, 0xd6, 0xc4, 0x73, 0xe2, 0x0c, 0xb1, 0x67, 0x8c, 0xcf, 0x71, 0x62,
+	0xf3, 0x17, 0x80, 0x25, 0x24, 0x5e, 0x78, 0xc3, 0x0f, 0x08, 0x1e, 0x10, 0x7f, 0x01, 0x6f, 0xec,
+	0x0b, 0x52, 0x1f, 0xf7, 0x09, 0x21, 0x1e, 0x2c, 0x70, 0xc5, 0x3f, 0x51, 0x09, 0x81, 0xe6, 0x9c,
+	0x33, 0xe3, 0x19, 0xc7, 0xbd, 0x71, 0x79, 0x8a, 0xcf, 0x39, 0xdf, 0xf7, 0xfb, 0xee, 0x97, 0x09,
+	0x5c, 0xc5, 0xe7, 0xdd, 0xdd, 0xf3, 0x7b, 0xc7, 0x98, 0x6a, 0xf7, 0x76, 0xe9, 0xa8, 0x87, 0x49,
+	0xa1, 0xd7, 0xb7, 0xa8, 0x85, 0xe2, 0xf8, 0xbc, 0x5b, 0x10, 0x0f, 0x99, 0x54, 0xdb, 0x6a, 0x5b,
+	0xec, 0x7e, 0xd7, 0xfe, 0xc5, 0x49, 0x32, 0x79, 0x13, 0x0f, 0x07, 0x64, 0x17, 0x0f, 0x7b, 0x56,
+	0x9f, 0x62, 0x7d, 0x19, 0x4c, 0x46, 0xa6, 0xe4, 0x0d, 0x14, 0x1f, 0x9c, 0x5b, 0x14, 0xbf, 0x9e,
+	0x24, 0x33, 0xa0, 0x46, 0x87, 0xb8, 0x4f, 0x3f, 0x1e, 0xe0, 0x01, 0xee, 0xf3, 0xb7, 0xbc, 0x06,
+	0xf1, 0x2a, 0xa6, 0x17, 0x56, 0xff, 0x4c, 0x31, 0x4f, 0x2c, 0x84, 0x20, 0x68, 0x6a, 0x5d, 0x9c,
+	0x96, 0x64, 0x69, 0x27, 0xa6, 0xb2, 0xdf, 0xe8, 0xbb, 0x10, 0x30, 0xf4, 0x74, 0x40, 0x96, 0x76,
+	0x12, 0xa5, 0xc2, 0xf3, 0x69, 0x6e, 0xe5, 0xaf, 0xd3, 0xdc, 0xd7, 0xdb, 0x06, 0x3d, 0x1d, 0x1c,
+	0x17, 0x5a, 0x56, 0x77, 0xb7, 0x65, 0x91, 0xae, 0x45, 0xc4, 0x9f, 0x3b, 0x44, 0x3f, 0x13, 0xc2,
+	0x15, 0x93, 0xaa, 0x01, 0x43, 0xcf, 0xff, 0x51, 0x02, 0x28, 0x0d, 0xfa, 0x26, 0xee, 0x33, 0x11,
+	0x1f, 0xc1, 0x1a, 0xb5, 0xce, 0xb0, 0xd9, 0xd4, 0x74, 0xbd, 0x8f, 0x09, 0x61, 0xb2, 0x12, 0xa5,
+	0x0d, 0x81, 0x1c, 0x29, 0xf2, 0x6b, 0x35, 0xc1, 0xa8, 0xc4, 0x09, 0xdd, 0x86, 0x2b, 0x3a, 0x26,
+	0xd4, 0x30, 0x35, 0x6a, 0x58, 0x66, 0xb3, 0x75, 0xaa, 0x19, 0x26, 0xd3, 0x29, 0xa6, 0x26, 0x3d,
+	0x0f, 0xfb, 0xf6, 0x3d, 0xda, 0x86, 0x30, 0x19, 0x75, 0x8f, 0xad, 0x4e, 0x7a, 0x95, 0x51, 0x88,
+	0x13, 0x4a, 0x41, 0x48, 0x23, 0x04, 0xd3, 0x74, 0x90, 0x5d, 0xf3, 0x03, 0x92, 0x21, 0x48, 0xb4,
+	0x0e, 0x4d, 0x87, 0x98, 0x1e, 0x09, 0xa1, 0x47, 0xf0, 0xb1, 0x46, 0x4e, 0x55, 0xf6, 0x92, 0xff,
+	0xa7, 0x04, 0x89, 0x8a, 0xba, 0xbf, 0x77, 0xb7, 0x8c, 0x7b, 0x16, 0x31, 0x28, 0xfa, 0x06, 0x84,
+	0xe8, 0xb0, 0x69, 0xe8, 0x42, 0xf7, 0x94, 0x97, 0x67, 0x36, 0xcd, 0x05, 0x1b, 0x43, 0xa5, 0xac,
+	0x06, 0xe9, 0x50, 0xd1, 0xd1, 0x01, 0x84, 0xb5, 0xae, 0x35, 0x30, 0xa9, 0xf0, 0xe0, 0xae, 0xa0,
+	0xbd, 0xf9, 0x16, 0x1e, 0x3c, 0x32, 0x4c, 0xaa, 0x0a, 0xf6, 0xb9, 0xf2, 0xab, 0x5e, 0xe5, 0x97,
+	0xfa, 0x25, 0xf8, 0x0a, 0xbf, 0x7c, 0x0c, 0xeb, 0xc7, 0x2c, 0x10, 0xae, 0xef, 0x43, 0xcb, 0x7d,
+	0xbf, 0xc6, 0xc9, 0xc4, 0x31, 0xff, 0xa7, 0x00, 0x20, 0x66, 0x7f, 0xc3, 0x0e, 0xc9, 0x33, 0x4c,
+	0x35, 0x5d, 0xa3, 0xda, 0x5c, 0x23, 0xc9, 0xab, 0x51, 0x03, 0xa2, 0x4c, 0x8b, 0xa6, 0x9b, 0x34,
+	0x0f, 0xde, 0x2d, 0x69, 0x66, 0xd3, 0x5c, 0x84, 0xe9, 0xab, 0x94, 0xd5, 0x08, 0x83, 0x52, 0x74,
+	0xf4, 0x00, 0x22, 0x3a, 0xa6, 0x9a, 0xd1, 0x21, 0xcc, 0xfe, 0xf8, 0xde, 0x7b, 0x05, 0x4f, 0x85,
+	0x15, 0x98, 0x62, 0x65, 0x4e, 0x50, 0x0a, 0xda, 0xf2, 0x54, 0x87, 0xfe, 0x72, 0xc2, 0x31, 0xf7,
+	0xbc, 0x29, 0xe1, 0x6e, 0x40, 0x84, 0x0e, 0x9b, 0xa7, 0x1a, 0x39, 0x65, 0x4e, 0x8a, 0x2d, 0x24,
+	0x46, 0x98, 0x0e, 0xed, 0xbf, 0xe8, 0x36, 0x84, 0x09, 0xd5, 0xe8, 0x80, 0xa4, 0xc3, 0xb2, 0xb4,
+	0xb3, 0xbe, 0xb7, 0xe9, 0x53, 0xab, 0xce, 0x9e, 0x54, 0x41, 0x92, 0xaf, 0xc1, 0x66, 0xa3, 0xaf,
+	0x99, 0x44, 0x6b, 0xd9, 0x31, 0x71, 0xfd, 0x28, 0x43, 0xb8, 0xaf, 0x5d, 0x34, 0xe9, 0x50, 0xa4,
+	0x53, 0x6c, 0x36, 0xcd, 0x85, 0x54, 0xed, 0xa2, 0xf1, 0x99, 0x1a, 0xea, 0x6b, 0x17, 0x8d, 0x21,
+	0xba, 0x0a, 0x91, 0xde, 0xe0, 0xb8, 0x79, 0x86, 0x47, 0xdc, 0xa5, 0x6a, 0xb8, 0x37, 0x38, 0x7e,
+	0x82, 0x47, 0xf9, 0x7f, 0x48, 0x10, 0xd9, 0xb7, 0xba, 0x5d, 0xcd, 0xd4, 0xd1, 0x4d, 0x56, 0xa7,
+	0x1c, 0xe2, 0xaa, 0x50, 0x36, 0x26, 0x1e, 0x95, 0xf2, 0x6c, 0x9a, 0x0b, 0x28, 0x65, 0xbb, 0x20,
+	0x51, 0x1a, 0x22, 0x2d, 0x7e, 0x2d, 0x2a, 0xc8, 0x39, 0xda, 0x85, 0xd3, 0xd3, 0xfa, 0x5a, 0x97,
+	0x3b, 0xd9, 0x16, 0xc3, 0x4e, 0xe8, 0x87, 0x10, 0x3e, 0xc3, 0x23, 0x3b, 0xa2, 0xdc, 0x77, 0x8f,
+	0x6c, 0x0d, 0x9f, 0xe0, 0x91, 0x52, 0x7e, 0x39, 0xcd, 0x3d, 0xf0, 0x84, 0x55, 0x1b, 0xe2, 0x8e,
+	0xd6, 0x37, 0x79, 0x47, 0x11, 0xa7, 0x3b, 0x2d, 0xab, 0x8f, 0x77, 0x87, 0xbb, 0xde, 0x56, 0x56,
+	0x60, 0xcc, 0x6a, 0xe8, 0x0c, 0x8f, 0x14, 0x1d, 0xc9, 0x90, 0xe8, 0x6a, 0xc3, 0x66, 0x5b, 0x23,
+	0xcd, 0x96, 0x45, 0x78, 0x25, 0xae, 0xa9, 0xd0, 0xd5, 0x86, 0x07, 0x1a, 0xd9, 0xb7, 0x08, 0xcd,
+	0xff, 0x6a, 0x15, 0x52, 0xc2, 0x94, 0x92, 0x46, 0x5b, 0xa7, 0xae, 0xef, 0xb6, 0x3d, 0x46, 0x87,
+	0x3d, 0x36, 0x7e, 0x0f, 0xe2, 0xc2, 0xa8, 0xa6, 0xa1, 0x93, 0x74, 0x40, 0x5e, 0xdd, 0x49, 0x94,
+	0x72, 0xcb, 0xbc, 0x02, 0xee, 0x81, 0xa8, 0x20, 0x78, 0x14, 0x9d, 0xd8, 0xad, 0xd0, 0x96, 0x20,
+	0x3c, 0xc1, 0x7e, 0xa3, 0x9b, 0x10, 0x25, 0x46, 0x9b, 0x67, 0x45, 0x70, 0x49, 0xbb, 0x88, 0x10,
+	0xa3, 0xcd, 0xd2, 0xe2, 0x3b, 0x6e, 0x5a, 0x84, 0x58, 0x5a, 0xe4, 0x7d, 0x69, 0xc1, 0x4c, 0xc0,
+	0xba, 0x10, 0x4c, 0xfc, 0x59, 0xe2, 0x71, 0x76, 0xf8, 0xff, 0xe1, 0x6c, 0x15, 0xd2, 0xbd, 0x3e,
+	0x3e, 0x6f, 0x1e, 0x73, 0x25, 0x9a, 0xc2, 0x64, 0x62, 0x0b, 0x8c, 0x30, 0x9b, 0xde, 0x9b, 0x4d,
+	0x73, 0x5b, 0xb5, 0x3e, 0x3e, 0x5f, 0xd0, 0x53, 0x29, 0xab, 0x5b, 0xbd, 0x25, 0xd7, 0x7a, 0xfe,
+	0x19, 0xc4, 0xeb, 0x46, 0xdb, 0x0d, 0xca, 0x0e, 0x04, 0xed, 0x6a, 0x66, 0x61, 0x59, 0xdf, 0x4b,
+	0xf9, 0x4b, 0xc2, 0x68, 0x37, 0x46, 0x3d, 0xac, 0x32, 0x0a, 0xbb, 0x85, 0x78, 0x5b, 0x39, 0x3f,
+	0xe4, 0xff, 0x2c, 0x41, 0x9c, 0x15, 0xca, 0x09, 0xee, 0x3f, 0xc1, 0xa3, 0x77, 0x69, 0xb7, 0x77,
+	0x85, 0xe8, 0x00, 0x13, 0xfd, 0x35, 0x7f, 0x93, 0x98, 0x43, 0x7a, 0x54, 0xf8, 0x11, 0xc4, 0x4d,
+	0x3c, 0xa4, 0x4d, 0xe1, 0x73, 0xd6, 0x5d, 0x4b, 0x9f, 0xce, 0xa6, 0xb9, 0x58, 0x15, 0x0f, 0xe9,
+	0xff, 0xc0, 0xef, 0x31, 0x53, 0xe0, 0xe8, 0xf9, 0x7b, 0x10, 0x2a, 0xb2, 0x26, 0xe9, 0xda, 0x2d,
+	0x79, 0xec, 0x76, 0xa7, 0x6f, 0x60, 0x3e, 0x7d, 0xf3, 0x7f, 0x90, 0x20, 0xe1, 0xed, 0x6e, 0xe8,
+	0x1a, 0x00, 0x6f, 0x67, 0x9e, 0x41, 0x1d, 0x63, 0x37, 0x55, 0x7b, 0x5a, 0xcf, 0x67, 0x5f, 0xc0,
+	0x37, 0xfb, 0x6e, 0x40, 0x54, 0xc7, 0x2d, 0xa3, 0xab, 0x89, 0x0e, 0xba, 0x56, 0x8a, 0xbd, 0x9c,
+	0xe6, 0x42, 0x03, 0xc3, 0xa4, 0xdf, 0x56, 0xdd, 0x27, 0xf4, 0x29, 0x44, 0x5b, 0x5a, 0x4f, 0x6b,
+	0x19, 0x74, 0x24, 0x32, 0xfc, 0x5d, 0x47, 0xbe, 0xcb, 0x9f, 0xff, 0x79, 0x00, 0x22, 0x07, 0x1a,
+	0xc5, 0x17, 0x9a, 0x1d, 0xc2, 0xc8, 0x1b, 0xe6, 0xbd, 0xf3, 0x8e, 0xee, 0xbb, 0xb5, 0xc3, 0x83,
+	0xf8, 0xbe, 0x2f, 0x88, 0x02, 0x70, 0xb1, 0xb5, 0xfe, 0x5a, 0x82, 0x30, 0xbf, 0x42, 0x77, 0x00,
+	0xd5, 0x1b, 0xc5, 0xc6, 0x51, 0xbd, 0x79, 0x54, 0xad, 0xd7, 0x2a, 0xfb, 0xca, 0x23, 0xa5, 0x52,
+	0x4e, 0xae, 0x64, 0xb6, 0xc6, 0x13, 0xf9, 0x8a, 0x60, 0xe7, 0xa4, 0x55, 0xcb, 0xc4, 0xe8, 0x9b,
+	0xb0, 0x2e, 0xc8, 0x6b, 0x95, 0x6a, 0x59, 0xa9, 0x1e, 0x24, 0xa5, 0x4c, 0x7a, 0x3c, 0x91, 0x53,
+	0x3e, 0xd2, 0x1a, 0x36, 0x75, 0xc3, 0x6c, 0xa3, 0xbb, 0x90, 0x14, 0xd4, 0xfb, 0x87, 0xd5, 0x47,
+	0x8a, 0xfa, 0xac, 0x52, 0x4e, 0x06, 0x32, 0x99, 0xf1, 0x44, 0xde, 0xf6, 0xd1, 0xef, 0x5b, 0xe6,
+	0x89, 0xd1, 0xef, 0x62, 0x3d, 0x13, 0xfd, 0xe9, 0x6f, 0xb2, 0x2b, 0xbf, 0xfb, 0x6d, 0x56, 0xca,
+	0xbf, 0x4c, 0x40, 0x9c, 0x0d, 0x36, 0x15, 0xb7, 0xac, 0xbe, 0xbe, 0x74, 0xd9, 0x2a, 0xc1, 0x5a,
+	0x8f, 0x8b, 0xf2, 0xec, 0x38, 0xf1, 0xbd, 0x6b, 0x05, 0xb6, 0x2c, 0x16, 0xdc, 0xd4, 0x72, 0xdc,
+	0xc1, 0xf1, 0x12, 0x82, 0x87, 0x8f, 0xf9, 0x02, 0x44, 0xda, 0x5c, 0x17, 0x31, 0x2b, 0x53, 0xcb,
+	0x3c, 0xa8, 0x3a, 0x44, 0xe8, 0x21, 0x5c, 0x19, 0x98, 0xc4, 0x68, 0x9b, 0x58, 0xe7, 0x5d, 0xc1,
+	0x69, 0xf4, 0x89, 0xd2, 0xe6, 0x6c, 0x9a, 0xdb, 0x38, 0x12, 0x8f, 0xac, 0xee, 0x95, 0xb2, 0xba,
+	0x31, 0xf0, 0x5d, 0xe8, 0xe8, 0x29, 0x6c, 0x77, 0x34, 0x8a, 0x09, 0x6d, 0x2e, 0xa2, 0xf0, 0xfd,
+	0xe2, 0xea, 0x6c, 0x9a, 0xdb, 0x7c, 0xca, 0x28, 0xea, 0x3e, 0xa4, 0xcd, 0xce, 0xa5, 0x4b, 0x1b,
+	0x2d, 0xe1, 0xaa, 0x43, 0x87, 0xf6, 0x60, 0x5d, 0xdd, 0x89, 0xef, 0x5d, 0xf7, 0xd9, 0xe0, 0x71,
+	0x63, 0xc1, 0x51, 0xaf, 0x31, 0x14, 0x93, 0x3f, 0x3e, 0x70, 0x6f, 0x08, 0xfa, 0x04, 0xc2, 0xac,
+	0x38, 0x48, 0x3a, 0xc2, 0x70, 0x72, 0x3e, 0x9c, 0xcb, 0x5b, 0x8d, 0xc0, 0x10, 0x4c, 0xe8, 0x08,
+	0x92, 0x4e, 0x3c, 0x74, 0xbe, 0xfc, 0x91, 0x74, 0x94, 0x01, 0x7d, 0xf8, 0x4a, 0x85, 0x6a, 0x56,
+	0xa7, 0x23, 0x36, 0x45, 0x81, 0xb6, 0x21, 0x30, 0xc4, 0x2d, 0x41, 0x55, 0x40, 0x2d, 0x27, 0x43,
+	0xe6, 0xc0, 0x31, 0x06, 0xfc, 0xde, 0x65, 0x0d, 0xfd, 0x68, 0x57, 0x5c, 0x56, 0x17, 0xef, 0x31,
+	0x6c, 0xb0, 0x95, 0xcd, 0x03, 0x06, 0x6f, 0x07, 0xc6, 0x37, 0xc2, 0x39, 0x52, 0x0d, 0x36, 0x9c,
+	0xc1, 0xc9, 0x27, 0x04, 0x49, 0xc7, 0x19, 0xd2, 0x07, 0x7e, 0x7b, 0x97, 0x0c, 0x63, 0x07, 0xb1,
+	0xe5, 0x79, 0xc3, 0x04, 0x7d, 0x0c, 0x51, 0x67, 0xc6, 0xa4, 0x13, 0x0c, 0x2a, 0xb5, 0x14, 0x8a,
+	0x73, 0xbb, 0xb4, 0xa8, 0x0c, 0x6b, 0x8e, 0x26, 0xec, 0x93, 0x25, 0xbd, 0x26, 0x16, 0x3f, 0xf6,
+	0x39, 0xe3, 0xb2, 0x7f, 0xdf, 0x7e, 0xb3, 0x8b, 0x0e, 0x0b, 0x84, 0x84, 0xe0, 0x62, 0x0f, 0xe8,
+	0x21, 0x44, 0xf8, 0x32, 0x4b, 0xd2, 0xeb, 0x4b, 0x12, 0xc0, 0x1b, 0x37, 0xfe, 0x91, 0xe2, 0xac,
+	0x8f, 0x82, 0x0b, 0x1d, 0xc3, 0x96, 0x93, 0x01, 0x54, 0x0c, 0x10, 0x7b, 0x56, 0x90, 0xf4, 0x06,
+	0x83, 0xdb, 0x79, 0x6d, 0x1a, 0x78, 0x46, 0x8e, 0xc0, 0xdd, 0x14, 0x60, 0x9e, 0x17, 0x82, 0x74,
+	0xd8, 0xd6, 0xfa, 0xad, 0x53, 0xe3, 0xdc, 0x4e, 0x79, 0x9f, 0x90, 0xe4, 0x7f, 0x24, 0x24, 0xe5,
+	0xa0, 0x79, 0xa5, 0x64, 0x2c, 0x80, 0x79, 0xad, 0xa0, 0x6b, 0xde, 0xa1, 0x1a, 0x2b, 0x45, 0x17,
+	0x06, 0x69, 0x09, 0xa2, 0x5d, 0x11, 0x57, 0xd1, 0x83, 0xe4, 0xcb, 0xc3, 0xd4, 0xbf, 0xc8, 0x3a,
+	0x11, 0x74, 0xf8, 0x32, 0x3f, 0x93, 0x20, 0xee, 0x29, 0x06, 0xf4, 0x10, 0xa2, 0x3d, 0xab, 0xd3,
+	0x61, 0x7b, 0xac, 0xc4, 0x30, 0xb3, 0x05, 0xfb, 0xf3, 0xf5, 0x72, 0x5b, 0xb3, 0xb9, 0xe6, 0xe6,
+	0x44, 0x7a, 0xfc, 0xc8, 0xbf, 0x02, 0x18, 0x96, 0xd0, 0xe9, 0x8d, 0xe9, 0xed, 0xd0, 0x67, 0x4e,
+	0x20, 0xcc, 0xe3, 0xfb, 0x2e, 0xa3, 0xe8, 0x1e, 0x04, 0x0d, 0xf3, 0xc4, 0x12, 0xc2, 0xae, 0xfa,
+	0x97, 0x38, 0xf7, 0x93, 0x56, 0x88, 0x62, 0xa4, 0x99, 0x5f, 0x4a, 0xb0, 0xb1, 0x10, 0x94, 0xff,
+	0xde, 0xee, 0x22, 0x24, 0xbc, 0x69, 0x21, 0xf4, 0x49, 0xbf, 0x6a, 0xbb, 0x71, 0xfa, 0x20, 0x9d,
+	0x5f, 0xdd, 0xfa, 0xfd, 0x7c, 0x40, 0xde, 0x7c, 0xc5, 0x80, 0xdc, 0x18, 0x4f, 0xe4, 0x78, 0xd5,
+	0x32, 0x2b, 0x43, 0x83, 0x50, 0x6c, 0x52, 0x0f, 0xa1, 0x52, 0x55, 0x1a, 0x4a, 0xf1, 0xa9, 0xf2,
+	0x45, 0xa5, 0x9c, 0x94, 0x38, 0xa1, 0x62, 0x1a, 0xd4, 0xd0, 0x3a, 0xc6, 0x4f, 0xb0, 0x8e, 0x72,
+	0x97, 0x66, 0x68, 0x20, 0x13, 0x1f, 0x4f, 0xe4, 0x88, 0x33, 0x36, 0xaf, 0x2f, 0x19, 0x9b, 0xc1,
+	0xcc, 0xda, 0x78, 0x22, 0xc7, 0x96, 0x4c, 0xca, 0x5b, 0xff, 0x92, 0x60, 0x6b, 0xe9, 0x92, 0x8c,
+	0x3e, 0x81, 0xeb, 0xa5, 0x62, 0x63, 0xff, 0x71, 0xa5, 0xdc, 0xdc, 0x3f, 0x7c, 0xf6, 0xac, 0x58,
+	0x2d, 0xd7, 0x9b, 0x4b, 0x8d, 0x49, 0x8d, 0x27, 0x72, 0x92, 0x61, 0x78, 0x2d, 0xfa, 0x16, 0xe4,
+	0x5e, 0xc5, 0x5e, 0x57, 0x0e, 0xaa, 0x7c, 0xfa, 0x27, 0xc7, 0x13, 0x39, 0xc1, 0x58, 0xed, 0xc1,
+	0x64, 0xab, 0xff, 0x1a, 0xb6, 0x62, 0xe9, 0x50, 0x6d, 0xb0, 0x25, 0x60, 0xce, 0x56, 0x3c, 0x66,
+	0x51, 0x45, 0xf7, 0x21, 0xfb, 0x3a, 0x69, 0x95, 0x72, 0x72, 0x95, 0xfb, 0xd2, 0x15, 0x86, 0xf5,
+	0x4c, 0xd0, 0xf6, 0xc2, 0xad, 0x2f, 0x25, 0xd8, 0x58, 0xd8, 0x57, 0x51, 0x11, 0xae, 0x35, 0xd4,
+	0x62, 0xb5, 0xfe, 0xa8, 0xa2, 0x36, 0x9f, 0x54, 0x3e, 0x6f, 0x36, 0x3e, 0xaf, 0x55, 0x16, 0xac,
+	0xce, 0x8e, 0x27, 0x72, 0xe6, 0xc8, 0x24, 0x3d, 0xdc, 0x32, 0x4e, 0x0c, 0x5f, 0xfd, 0x33, 0x88,
+	0x02, 0xbc, 0x7f, 0x19, 0xe2, 0xf0, 0x07, 0xd5, 0x8a, 0x5a, 0x7f, 0xac, 0xd4, 0x92, 0x12, 0x0f,
+	0xc9, 0xe1, 0x85, 0xdd, 0xf9, 0x4e, 0x8d, 0x1e, 0xfa, 0x08, 0xb2, 0x4b, 0xe8, 0x6b, 0x15, 0xb5,
+	0xd8, 0x38, 0xe4, 0x2c, 0xc2, 0xee, 0xc3, 0x1e, 0xee, 0x6b, 0xd4, 0x62, 0x5c, 0xc2, 0x84, 0x11,
+	0x44, 0xc4, 0xb2, 0x8f, 0xf2, 0x90, 0xaa, 0x2b, 0x07, 0xcb, 0x14, 0x8e, 0x8e, 0x27, 0x72, 0x90,
+	0xed, 0x61, 0x19, 0x88, 0xbb, 0x34, 0x8d, 0xcf, 0x92, 0x52, 0x26, 0x36, 0x9e, 0xc8, 0x21, 0x1b,
+	0x61, 0x88, 0x3e, 0x84, 0xa4, 0xfb, 0x26, 0x3c, 0x99, 0x0c, 0x64, 0xd6, 0xc7, 0x13, 0x19, 0xea,
+	0x46, 0x5b, 0xa4, 0x88, 0x27, 0x7f, 0xbe, 0x94, 0x60, 0x4d, 0xf4, 0x01, 0x91, 0x37, 0x3b, 0x90,
+	0x29, 0x57, 0x6a, 0x87, 0x75, 0xa5, 0xb1, 0x3c, 0x5d, 0xe6, 0x7a, 0xdc, 0x84, 0xed, 0x05, 0xca,
+	0xf9, 0x5e, 0xe8, 0xcb, 0xe9, 0xdb, 0x90, 0x5e, 0x20, 0xf4, 0xae, 0x84, 0xfe, 0xdc, 0x46, 0x37,
+	0x60, 0x6b, 0x81, 0xb8, 0x74, 0xa4, 0xf2, 0x0c, 0x80, 0xf1, 0x44, 0xe6, 0xbd, 0x89, 0x9b, 0x20,
+	0xd9, 0x26, 0x94, 0xaa, 0xcf, 0xff, 0x9e, 0x5d, 0x79, 0x3e, 0xcb, 0x4a, 0x5f, 0xcd, 0xb2, 0xd2,
+	0xdf, 0x66, 0x59, 0xe9, 0x17, 0x2f, 0xb2, 0x2b, 0x5f, 0xbd, 0xc8, 0xae, 0xfc, 0xe5, 0x45, 0x76,
+	0xe5, 0x8b, 0xbb, 0x6f, 0xf9, 0x39, 0x82, 0xcf, 0xbb, 0x7c, 0x35, 0x3f, 0x0e, 0xb3, 0xff, 0xf7,
+	0xdd, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xca, 0x66, 0x78, 0x89, 0xb2, 0x14, 0x00, 0x00,
 }
 
 func (m *NetworkInfo) Marshal() (dAtA []byte, err error) {
@@ -1446,210 +1691,599 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ChainRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *NetworkInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+
+func (m *ChainRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BurnerInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ChainRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TokenAddress.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.DestinationChain)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ArchivedTransferKeys) > 0 {
+		for iNdEx := len(m.ArchivedTransferKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedTransferKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.PendingTransferKeys) > 0 {
+		for iNdEx := len(m.PendingTransferKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTransferKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	l = m.Salt.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func (m *ERC20Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.CommandQueue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.TxID.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.Amount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x6a
+	if len(m.Commands) > 0 {
+		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	l = len(m.DestinationChain)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.CommandBatches) > 0 {
+		for iNdEx := len(m.CommandBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommandBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	l = m.BurnerAddress.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func (m *ERC20TokenMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BurnedDeposits) > 0 {
+		for iNdEx := len(m.BurnedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ConfirmedDeposits) > 0 {
+		for iNdEx := len(m.ConfirmedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = m.ChainID.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.Details.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.TokenAddress.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.TxHash.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
+	if len(m.PendingDeposits) > 0 {
+		for iNdEx := len(m.PendingDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	return n
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UnsignedTxs) > 0 {
+		for iNdEx := len(m.UnsignedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnsignedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.LatestSignedBatchID) > 0 {
+		i -= len(m.LatestSignedBatchID)
+		copy(dAtA[i:], m.LatestSignedBatchID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LatestSignedBatchID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UnsignedBatchID) > 0 {
+		i -= len(m.UnsignedBatchID)
+		copy(dAtA[i:], m.UnsignedBatchID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UnsignedBatchID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Gateway != nil {
+		{
+			size, err := m.Gateway.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PendingChain != nil {
+		{
+			size, err := m.PendingChain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TransactionMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChainRecord_UnsignedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainRecord_UnsignedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRecord_UnsignedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RawTX)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Command) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChainRecord_PollDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainRecord_PollDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRecord_PollDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Command)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Params)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.MaxGasCost != 0 {
-		n += 1 + sovTypes(uint64(m.MaxGasCost))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PollKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *CommandBatchMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChainRecord_Burner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainRecord_Burner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRecord_Burner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.CommandIDs) > 0 {
-		for _, e := range m.CommandIDs {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = m.SigHash.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainRecord_PollTransferKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ChainRecord_PollTransferKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRecord_PollTransferKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransferKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.PrevBatchedCommandsID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PollKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SigMetadata) Size() (n int) {
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NetworkInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovTypes(uint64(m.Type))
-	}
-	l = len(m.Chain)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
-func (m *TransferKey) Size() (n int) {
+func (m *BurnerInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TxID.Size()
+	l = m.TokenAddress.Size()
 	n += 1 + l + sovTypes(uint64(l))
-	if m.Type != 0 {
-		n += 1 + sovTypes(uint64(m.Type))
-	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Salt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ERC20Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BurnerAddress.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ERC20TokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.ChainID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Details.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TokenAddress.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TxHash.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *TransactionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RawTX)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Command) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Params)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxGasCost != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGasCost))
+	}
+	return n
+}
+
+func (m *CommandBatchMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.CommandIDs) > 0 {
+		for _, e := range m.CommandIDs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.SigHash.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PrevBatchedCommandsID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SigMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
 	l = len(m.NextKeyID)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
@@ -1710,23 +2344,1061 @@ func (m *Gateway) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ChainRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PendingChain != nil {
+		l = m.PendingChain.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Gateway != nil {
+		l = m.Gateway.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.UnsignedBatchID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.LatestSignedBatchID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.UnsignedTxs) > 0 {
+		for _, e := range m.UnsignedTxs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PendingDeposits) > 0 {
+		for _, e := range m.PendingDeposits {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ConfirmedDeposits) > 0 {
+		for _, e := range m.ConfirmedDeposits {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.BurnedDeposits) > 0 {
+		for _, e := range m.BurnedDeposits {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.CommandBatches) > 0 {
+		for _, e := range m.CommandBatches {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Commands) > 0 {
+		for _, e := range m.Commands {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.CommandQueue.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Burners) > 0 {
+		for _, e := range m.Burners {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PendingTransferKeys) > 0 {
+		for _, e := range m.PendingTransferKeys {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ArchivedTransferKeys) > 0 {
+		for _, e := range m.ArchivedTransferKeys {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ChainRecord_UnsignedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
 }
-func (m *NetworkInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
+
+func (m *ChainRecord_PollDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PollKey.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ChainRecord_Burner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Info.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ChainRecord_PollTransferKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PollKey.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TransferKey.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NetworkInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Salt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20TokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20TokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawTX", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawTX = append(m.RawTX[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawTX == nil {
+				m.RawTX = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Command) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -1735,19 +3407,118 @@ func (m *NetworkInfo) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params[:0], dAtA[iNdEx:postIndex]...)
+			if m.Params == nil {
+				m.Params = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1775,13 +3546,13 @@ func (m *NetworkInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasCost", wireType)
 			}
-			var byteLen int
+			m.MaxGasCost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1791,25 +3562,11 @@ func (m *NetworkInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxGasCost |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1831,7 +3588,7 @@ func (m *NetworkInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BurnerInfo) Unmarshal(dAtA []byte) error {
+func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,15 +3611,15 @@ func (m *BurnerInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BurnerInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandBatchMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BurnerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandBatchMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1889,15 +3646,16 @@ func (m *BurnerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandIDs", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1907,29 +3665,32 @@ func (m *BurnerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationChain = string(dAtA[iNdEx:postIndex])
+			var v CommandID
+			m.CommandIDs = append(m.CommandIDs, v)
+			if err := m.CommandIDs[len(m.CommandIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1939,27 +3700,81 @@ func (m *BurnerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SigHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BatchedCommandsStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1987,11 +3802,11 @@ func (m *BurnerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBatchedCommandsID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2018,8 +3833,9 @@ func (m *BurnerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Salt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PrevBatchedCommandsID = append(m.PrevBatchedCommandsID[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevBatchedCommandsID == nil {
+				m.PrevBatchedCommandsID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2043,7 +3859,7 @@ func (m *BurnerInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20Deposit) Unmarshal(dAtA []byte) error {
+func (m *SigMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2066,17 +3882,17 @@ func (m *ERC20Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2086,30 +3902,16 @@ func (m *ERC20Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Type |= SigType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2119,30 +3921,79 @@ func (m *ERC20Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2152,29 +4003,30 @@ func (m *ERC20Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2184,29 +4036,16 @@ func (m *ERC20Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= TransferKeyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKeyID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2216,24 +4055,23 @@ func (m *ERC20Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NextKeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2256,7 +4094,7 @@ func (m *ERC20Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
+func (m *Asset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2279,15 +4117,15 @@ func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20TokenMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20TokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2315,13 +4153,13 @@ func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2331,30 +4169,79 @@ func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2364,28 +4251,27 @@ func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2413,15 +4299,13 @@ func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			var stringLen uint64
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2431,31 +4315,16 @@ func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Decimals |= uint8(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			m.Status = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2465,11 +4334,25 @@ func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2491,7 +4374,7 @@ func (m *ERC20TokenMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionMetadata) Unmarshal(dAtA []byte) error {
+func (m *Gateway) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2514,15 +4397,15 @@ func (m *TransactionMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawTX", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2549,16 +4432,15 @@ func (m *TransactionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawTX = append(m.RawTX[:0], dAtA[iNdEx:postIndex]...)
-			if m.RawTX == nil {
-				m.RawTX = []byte{}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2568,26 +4450,11 @@ func (m *TransactionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Status |= Gateway_Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2609,7 +4476,7 @@ func (m *TransactionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(dAtA []byte) error {
+func (m *ChainRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2632,17 +4499,49 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChain", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2652,30 +4551,33 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingChain == nil {
+				m.PendingChain = &exported.Chain{}
+			}
+			if err := m.PendingChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2685,27 +4587,31 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			if m.Gateway == nil {
+				m.Gateway = &Gateway{}
+			}
+			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedBatchID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2732,16 +4638,16 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params[:0], dAtA[iNdEx:postIndex]...)
-			if m.Params == nil {
-				m.Params = []byte{}
+			m.UnsignedBatchID = append(m.UnsignedBatchID[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnsignedBatchID == nil {
+				m.UnsignedBatchID = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignedBatchID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2751,29 +4657,31 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			m.LatestSignedBatchID = append(m.LatestSignedBatchID[:0], dAtA[iNdEx:postIndex]...)
+			if m.LatestSignedBatchID == nil {
+				m.LatestSignedBatchID = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasCost", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedTxs", wireType)
 			}
-			m.MaxGasCost = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2783,66 +4691,31 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGasCost |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UnsignedTxs = append(m.UnsignedTxs, ChainRecord_UnsignedTx{})
+			if err := m.UnsignedTxs[len(m.UnsignedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommandBatchMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommandBatchMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2852,31 +4725,31 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			m.Tokens = append(m.Tokens, ERC20TokenMetadata{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommandIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeposits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2886,32 +4759,31 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v CommandID
-			m.CommandIDs = append(m.CommandIDs, v)
-			if err := m.CommandIDs[len(m.CommandIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingDeposits = append(m.PendingDeposits, ChainRecord_PollDeposit{})
+			if err := m.PendingDeposits[len(m.PendingDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedDeposits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2921,31 +4793,31 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.ConfirmedDeposits = append(m.ConfirmedDeposits, ERC20Deposit{})
+			if err := m.ConfirmedDeposits[len(m.ConfirmedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedDeposits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2955,30 +4827,31 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SigHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BurnedDeposits = append(m.BurnedDeposits, ERC20Deposit{})
+			if err := m.BurnedDeposits[len(m.BurnedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandBatches", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2988,16 +4861,31 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BatchedCommandsStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommandBatches = append(m.CommandBatches, CommandBatchMetadata{})
+			if err := m.CommandBatches[len(m.CommandBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3007,29 +4895,31 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			m.Commands = append(m.Commands, Command{})
+			if err := m.Commands[len(m.Commands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevBatchedCommandsID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandQueue", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3039,81 +4929,64 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevBatchedCommandsID = append(m.PrevBatchedCommandsID[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrevBatchedCommandsID == nil {
-				m.PrevBatchedCommandsID = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CommandQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SigMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Burners = append(m.Burners, ChainRecord_Burner{})
+			if err := m.Burners[len(m.Burners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SigMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransferKeys", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3123,16 +4996,31 @@ func (m *SigMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= SigType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTransferKeys = append(m.PendingTransferKeys, ChainRecord_PollTransferKey{})
+			if err := m.PendingTransferKeys[len(m.PendingTransferKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTransferKeys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3142,23 +5030,25 @@ func (m *SigMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.ArchivedTransferKeys = append(m.ArchivedTransferKeys, ChainRecord_PollTransferKey{})
+			if err := m.ArchivedTransferKeys[len(m.ArchivedTransferKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3181,7 +5071,7 @@ func (m *SigMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferKey) Unmarshal(dAtA []byte) error {
+func (m *ChainRecord_UnsignedTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3204,17 +5094,17 @@ func (m *TransferKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsignedTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsignedTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3224,49 +5114,29 @@ func (m *TransferKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= TransferKeyType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextKeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3276,23 +5146,24 @@ func (m *TransferKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextKeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3315,7 +5186,7 @@ func (m *TransferKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Asset) Unmarshal(dAtA []byte) error {
+func (m *ChainRecord_PollDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3338,17 +5209,17 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3358,29 +5229,30 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			if err := m.PollKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3390,23 +5262,24 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3429,7 +5302,7 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenDetails) Unmarshal(dAtA []byte) error {
+func (m *ChainRecord_Burner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3452,17 +5325,17 @@ func (m *TokenDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: Burner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Burner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3472,80 +5345,30 @@ func (m *TokenDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint8(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3555,22 +5378,22 @@ func (m *TokenDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3595,7 +5418,7 @@ func (m *TokenDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Gateway) Unmarshal(dAtA []byte) error {
+func (m *ChainRecord_PollTransferKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3618,17 +5441,17 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollTransferKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollTransferKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollKey", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3638,30 +5461,30 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PollKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferKey", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3671,11 +5494,25 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Gateway_Status(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
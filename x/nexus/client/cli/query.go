@@ -2,12 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/keeper"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 )
@@ -24,6 +27,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCommandChainMaintainers(queryRoute),
+		GetCommandTransfer(),
+		GetCommandTransfersBySender(),
+		GetCommandTransfersByRecipient(),
+		GetCommandTransfersByState(),
+		GetCommandFeeSchedules(),
+		GetCommandFeeLedger(),
 	)
 
 	return queryCmd
@@ -56,3 +65,201 @@ func GetCommandChainMaintainers(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCommandTransfer returns the query for the transfer with the given ID
+func GetCommandTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [id]",
+		Short: "Returns the cross-chain transfer with the given ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid transfer ID")
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).Transfer(cmd.Context(), &types.QueryTransferRequest{ID: id})
+			if err != nil {
+				return sdkerrors.Wrapf(err, "couldn't resolve transfer %d", id)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCommandTransfersBySender returns the query for the transfers sent from the given address
+func GetCommandTransfersBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfers-by-sender [chain] [address]",
+		Short: "Returns the cross-chain transfers sent from the given deposit address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).TransfersBySender(cmd.Context(),
+				&types.QueryTransfersByAddressRequest{Chain: args[0], Address: args[1], Pagination: pageReq})
+			if err != nil {
+				return sdkerrors.Wrap(err, "couldn't resolve transfers")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfers-by-sender")
+	return cmd
+}
+
+// GetCommandTransfersByRecipient returns the query for the transfers sent to the given address
+func GetCommandTransfersByRecipient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfers-by-recipient [chain] [address]",
+		Short: "Returns the cross-chain transfers sent to the given recipient address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).TransfersByRecipient(cmd.Context(),
+				&types.QueryTransfersByAddressRequest{Chain: args[0], Address: args[1], Pagination: pageReq})
+			if err != nil {
+				return sdkerrors.Wrap(err, "couldn't resolve transfers")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfers-by-recipient")
+	return cmd
+}
+
+// GetCommandTransfersByState returns the query for the transfers with the given state
+func GetCommandTransfersByState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfers-by-state [pending|archived]",
+		Short: "Returns the cross-chain transfers with the given state",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var state exported.TransferState
+			switch strings.ToLower(args[0]) {
+			case "pending":
+				state = exported.Pending
+			case "archived":
+				state = exported.Archived
+			default:
+				return fmt.Errorf("invalid transfer state %s", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).TransfersByState(cmd.Context(),
+				&types.QueryTransfersByStateRequest{State: state, Pagination: pageReq})
+			if err != nil {
+				return sdkerrors.Wrap(err, "couldn't resolve transfers")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfers-by-state")
+	return cmd
+}
+
+// GetCommandFeeSchedules returns the query for the fee schedules that apply to the given route and asset
+func GetCommandFeeSchedules() *cobra.Command {
+	var sourceChain, destinationChain, asset string
+
+	cmd := &cobra.Command{
+		Use:   "fee-schedules",
+		Short: "Returns the fee schedules that apply to the given route and asset",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).FeeSchedules(cmd.Context(),
+				&types.QueryFeeSchedulesRequest{SourceChain: sourceChain, DestinationChain: destinationChain, Asset: asset})
+			if err != nil {
+				return sdkerrors.Wrap(err, "couldn't resolve fee schedules")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringVar(&sourceChain, "source-chain", "", "only return fee schedules that apply to transfers from this chain")
+	cmd.Flags().StringVar(&destinationChain, "destination-chain", "", "only return fee schedules that apply to transfers to this chain")
+	cmd.Flags().StringVar(&asset, "asset", "", "only return fee schedules that apply to this asset")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCommandFeeLedger returns the query for the fees collected for the given route and asset
+func GetCommandFeeLedger() *cobra.Command {
+	var sourceChain, destinationChain, asset string
+
+	cmd := &cobra.Command{
+		Use:   "fee-ledger",
+		Short: "Returns the fees collected for the given route and asset",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).FeeLedger(cmd.Context(),
+				&types.QueryFeeLedgerRequest{SourceChain: sourceChain, DestinationChain: destinationChain, Asset: asset})
+			if err != nil {
+				return sdkerrors.Wrap(err, "couldn't resolve fee ledger")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringVar(&sourceChain, "source-chain", "", "only return fees collected for transfers from this chain")
+	cmd.Flags().StringVar(&destinationChain, "destination-chain", "", "only return fees collected for transfers to this chain")
+	cmd.Flags().StringVar(&asset, "asset", "", "only return fees collected in this asset")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,10 +3,12 @@ package cli
 import (
 	"fmt"
 
+	clientUtils "github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 )
 
@@ -80,3 +82,23 @@ func GetCmdDeregisterChainMaintainer() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCmdSubmitRegisterAssetProposal returns the cli command to submit a governance proposal registering an asset for a chain
+func NewCmdSubmitRegisterAssetProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-asset [chain] [asset]",
+		Short: "Submit a proposal to register an asset for a chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chain := args[0]
+			asset := args[1]
+
+			return clientUtils.SubmitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRegisterAssetProposal(title, description, chain, asset)
+			})
+		},
+	}
+
+	clientUtils.AddProposalFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/axelarnetwork/axelar-core/x/nexus/client/cli"
+	"github.com/axelarnetwork/axelar-core/x/nexus/client/rest"
+)
+
+// RegisterAssetProposalHandler is the proposal handler to submit register asset proposals through the gov module's cli and REST endpoints
+var RegisterAssetProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRegisterAssetProposal, rest.RegisterAssetProposalRESTHandler)
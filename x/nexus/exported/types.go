@@ -23,6 +23,16 @@ func (m Chain) Validate() error {
 	return nil
 }
 
+// Validate validates the TransferState
+func (x TransferState) Validate() error {
+	switch x {
+	case Pending, Archived:
+		return nil
+	default:
+		return fmt.Errorf("invalid transfer state %d", x)
+	}
+}
+
 // MergeTransfersBy merges cross chain transfers grouped by the given function
 func MergeTransfersBy(transfers []CrossChainTransfer, groupFn func(transfer CrossChainTransfer) string) []CrossChainTransfer {
 	results := []CrossChainTransfer{}
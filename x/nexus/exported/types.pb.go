@@ -139,6 +139,19 @@ type CrossChainTransfer struct {
 	Recipient CrossChainAddress `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient"`
 	Asset     types.Coin        `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
 	ID        uint64            `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// deposit address the asset was sent to on the source chain
+	Sender CrossChainAddress `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender"`
+	// reference to the source chain transaction of the deposit (tx hash or
+	// outpoint)
+	DepositTxID string `protobuf:"bytes,5,opt,name=deposit_tx_id,json=depositTxId,proto3" json:"deposit_tx_id,omitempty"`
+	// fee deducted from the deposited amount
+	Fee              types.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+	EnqueuedAtHeight int64      `protobuf:"varint,7,opt,name=enqueued_at_height,json=enqueuedAtHeight,proto3" json:"enqueued_at_height,omitempty"`
+	// reference to the command or transaction that executed the transfer on the
+	// recipient chain
+	ExecutionID      string        `protobuf:"bytes,8,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	ArchivedAtHeight int64         `protobuf:"varint,9,opt,name=archived_at_height,json=archivedAtHeight,proto3" json:"archived_at_height,omitempty"`
+	State            TransferState `protobuf:"varint,10,opt,name=state,proto3,enum=nexus.exported.v1beta1.TransferState" json:"state,omitempty"`
 }
 
 func (m *CrossChainTransfer) Reset()         { *m = CrossChainTransfer{} }
@@ -186,41 +199,50 @@ func init() {
 }
 
 var fileDescriptor_5a644c78dbaa440f = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x8f, 0xd2, 0x40,
-	0x18, 0xee, 0x20, 0xbb, 0xb0, 0xc3, 0x6a, 0x70, 0xa2, 0x2c, 0x36, 0xd9, 0x6e, 0xe5, 0x22, 0x6b,
-	0x62, 0x9b, 0xc5, 0xac, 0xd1, 0x63, 0x81, 0xa2, 0xc4, 0x48, 0x48, 0x41, 0x0f, 0x5e, 0x48, 0x69,
-	0xdf, 0x85, 0x06, 0xe9, 0x34, 0x33, 0x03, 0xc2, 0x3f, 0x30, 0x9b, 0x98, 0xf8, 0x07, 0xf6, 0xa4,
-	0x07, 0x8f, 0xc6, 0x5f, 0xc1, 0x71, 0x8f, 0x9e, 0x36, 0x0a, 0x7f, 0xc4, 0xf4, 0x03, 0x89, 0xf8,
-	0x71, 0x7b, 0xdf, 0x79, 0x9e, 0xf7, 0x79, 0x9e, 0x79, 0xa7, 0xc5, 0x25, 0x1f, 0x66, 0x13, 0xae,
-	0xc3, 0x2c, 0xa0, 0x4c, 0x80, 0xab, 0x4f, 0x4f, 0xfa, 0x20, 0xec, 0x13, 0x5d, 0xcc, 0x03, 0xe0,
-	0x5a, 0xc0, 0xa8, 0xa0, 0xa4, 0x10, 0x71, 0xb4, 0x35, 0x47, 0x4b, 0x38, 0xf2, 0xad, 0x01, 0x1d,
-	0xd0, 0x88, 0xa2, 0x87, 0x55, 0xcc, 0x96, 0x15, 0x87, 0xf2, 0x31, 0xe5, 0x7a, 0xdf, 0xe6, 0xf0,
-	0x4b, 0xce, 0xa1, 0x9e, 0x9f, 0xe0, 0xaa, 0xe0, 0xff, 0xf7, 0x2b, 0x7d, 0x41, 0x78, 0xa7, 0x36,
-	0xb4, 0x3d, 0x9f, 0x10, 0x9c, 0xf6, 0xed, 0x31, 0x14, 0x91, 0x8a, 0xca, 0x7b, 0x56, 0x54, 0x93,
-	0xbb, 0x78, 0xdf, 0xb7, 0x85, 0x37, 0x85, 0x9e, 0xcd, 0x39, 0x88, 0x62, 0x2a, 0xc2, 0x72, 0xf1,
-	0x99, 0x11, 0x1e, 0x91, 0x47, 0xf8, 0x80, 0x4f, 0x82, 0xd0, 0x81, 0xf7, 0xce, 0x28, 0x03, 0x6f,
-	0xe0, 0xc7, 0x64, 0x5e, 0xbc, 0xa6, 0xa2, 0x72, 0xd6, 0xba, 0xbd, 0x86, 0x1b, 0x31, 0x1a, 0x8d,
-	0x71, 0xf2, 0x18, 0x67, 0x47, 0x30, 0xef, 0x85, 0x59, 0x8a, 0x69, 0x15, 0x95, 0x6f, 0x54, 0x0e,
-	0x35, 0xc1, 0xff, 0xbc, 0xb9, 0xf6, 0x1c, 0xe6, 0xdd, 0x79, 0x00, 0x56, 0x66, 0x14, 0x17, 0xa5,
-	0x21, 0xbe, 0x59, 0x63, 0x94, 0xf3, 0x28, 0xb6, 0xe1, 0xba, 0x0c, 0x38, 0x27, 0x4f, 0xf0, 0x8e,
-	0x13, 0xf6, 0x51, 0xfc, 0x5c, 0xe5, 0x50, 0xfb, 0xfb, 0x1e, 0xb5, 0x68, 0xa8, 0x9a, 0x5e, 0x5c,
-	0x1d, 0x49, 0x56, 0x3c, 0x41, 0x8a, 0x38, 0x63, 0xc7, 0x2a, 0xc9, 0xfd, 0xd6, 0x6d, 0xe9, 0x2b,
-	0xc2, 0x64, 0x63, 0xd5, 0x65, 0xb6, 0xcf, 0xcf, 0x80, 0x91, 0x17, 0x78, 0x8f, 0x81, 0xe3, 0x05,
-	0x1e, 0xf8, 0x22, 0xf1, 0x3b, 0xfe, 0xa7, 0xdf, 0x76, 0xd2, 0xc4, 0x7b, 0xa3, 0x40, 0x4e, 0xf1,
-	0xce, 0x66, 0xbb, 0xb9, 0xca, 0x1d, 0x2d, 0x7e, 0x54, 0x2d, 0x7c, 0xd4, 0x8d, 0x0e, 0xdd, 0xc4,
-	0x8e, 0xd8, 0xa4, 0x80, 0x53, 0x9e, 0x1b, 0xed, 0x38, 0x5d, 0xdd, 0x5d, 0x5e, 0x1d, 0xa5, 0x9a,
-	0x75, 0x2b, 0xe5, 0xb9, 0xf7, 0xdf, 0x23, 0x7c, 0x7d, 0x1d, 0xb5, 0x23, 0x6c, 0x01, 0x44, 0xc1,
-	0x72, 0xd7, 0x32, 0x5a, 0x9d, 0x86, 0x69, 0xf5, 0x3a, 0x5d, 0xa3, 0x6b, 0xf6, 0x5e, 0xb6, 0x3a,
-	0x6d, 0xb3, 0xd6, 0x6c, 0x34, 0xcd, 0x7a, 0x5e, 0x22, 0xf7, 0x70, 0x61, 0x0b, 0x6f, 0x9b, 0xad,
-	0x7a, 0xb3, 0xf5, 0x34, 0x8f, 0xe4, 0xdc, 0xf9, 0x85, 0x9a, 0x69, 0x83, 0xef, 0x7a, 0xfe, 0x80,
-	0x1c, 0xe3, 0x83, 0x2d, 0xa2, 0x61, 0xd5, 0x9e, 0x35, 0x5f, 0x99, 0xf5, 0x7c, 0x4a, 0xde, 0x3f,
-	0xbf, 0x50, 0xb3, 0x06, 0x73, 0x86, 0xde, 0x14, 0x5c, 0x39, 0xfb, 0xee, 0xa3, 0x22, 0x7d, 0xfe,
-	0xa4, 0xa0, 0x6a, 0x67, 0xf1, 0x43, 0x91, 0x16, 0x4b, 0x05, 0x5d, 0x2e, 0x15, 0xf4, 0x7d, 0xa9,
-	0xa0, 0x0f, 0x2b, 0x45, 0xba, 0x5c, 0x29, 0xd2, 0xb7, 0x95, 0x22, 0xbd, 0x3e, 0x1d, 0x78, 0x62,
-	0x38, 0xe9, 0x6b, 0x0e, 0x1d, 0xeb, 0xf6, 0x0c, 0xde, 0xd8, 0xcc, 0x07, 0xf1, 0x96, 0xb2, 0x51,
-	0xd2, 0x3d, 0x70, 0x28, 0x03, 0x7d, 0xa6, 0xff, 0xfe, 0xeb, 0xf4, 0x77, 0xa3, 0xaf, 0xf7, 0xe1,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0xb2, 0x44, 0x10, 0x53, 0x03, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0xd3, 0xfc, 0x75, 0xd2, 0xde, 0x9b, 0x3b, 0xba, 0xb7, 0xf5, 0x8d, 0x54, 0x37, 0x44,
+	0x42, 0xa4, 0x08, 0x6c, 0x35, 0x55, 0x11, 0x88, 0x55, 0xfe, 0xda, 0x46, 0x88, 0xa8, 0x72, 0x02,
+	0x0b, 0x36, 0x96, 0x63, 0x9f, 0x26, 0xa3, 0xd2, 0x99, 0xe0, 0x99, 0x14, 0xe7, 0x0d, 0x50, 0x25,
+	0x24, 0x5e, 0xa0, 0x2b, 0x58, 0xb0, 0xec, 0x63, 0x74, 0xd9, 0x25, 0xab, 0x0a, 0xd2, 0x17, 0x41,
+	0x1e, 0xdb, 0x89, 0x5a, 0x28, 0x42, 0xec, 0xe6, 0x9c, 0xef, 0x3b, 0xe7, 0xfb, 0xce, 0x99, 0xd1,
+	0xa0, 0x32, 0x05, 0x7f, 0xcc, 0x0d, 0xf0, 0x47, 0xcc, 0x13, 0xe0, 0x1a, 0xc7, 0x9b, 0x7d, 0x10,
+	0xf6, 0xa6, 0x21, 0x26, 0x23, 0xe0, 0xfa, 0xc8, 0x63, 0x82, 0xe1, 0x15, 0xc9, 0xd1, 0x63, 0x8e,
+	0x1e, 0x71, 0x8a, 0xff, 0x0e, 0xd8, 0x80, 0x49, 0x8a, 0x11, 0x9c, 0x42, 0x76, 0x51, 0x73, 0x18,
+	0x3f, 0x62, 0xdc, 0xe8, 0xdb, 0x1c, 0x66, 0xed, 0x1c, 0x46, 0x68, 0x84, 0x97, 0x04, 0xff, 0xb5,
+	0x5e, 0xf9, 0x4c, 0x41, 0xe9, 0xc6, 0xd0, 0x26, 0x14, 0x63, 0x94, 0xa2, 0xf6, 0x11, 0xa8, 0x4a,
+	0x49, 0xa9, 0x2c, 0x9a, 0xf2, 0x8c, 0xef, 0xa0, 0x25, 0x6a, 0x0b, 0x72, 0x0c, 0x96, 0xcd, 0x39,
+	0x08, 0x35, 0x29, 0xb1, 0x7c, 0x98, 0xab, 0x05, 0x29, 0xfc, 0x08, 0xad, 0xf2, 0xf1, 0x28, 0x50,
+	0xe0, 0xd6, 0x01, 0xf3, 0x80, 0x0c, 0x68, 0x48, 0xe6, 0xea, 0x42, 0x49, 0xa9, 0xe4, 0xcc, 0xff,
+	0x62, 0x78, 0x27, 0x44, 0x65, 0x19, 0xc7, 0x8f, 0x51, 0xee, 0x10, 0x26, 0x56, 0xe0, 0x45, 0x4d,
+	0x95, 0x94, 0xca, 0x5f, 0xd5, 0x35, 0x5d, 0xf0, 0x1f, 0x27, 0xd7, 0x9f, 0xc1, 0xa4, 0x37, 0x19,
+	0x81, 0x99, 0x3d, 0x0c, 0x0f, 0xe5, 0x21, 0xfa, 0xa7, 0xe1, 0x31, 0xce, 0xa5, 0xed, 0x9a, 0xeb,
+	0x7a, 0xc0, 0x39, 0x7e, 0x82, 0xd2, 0x4e, 0x10, 0x4b, 0xfb, 0xf9, 0xea, 0x9a, 0xfe, 0xf3, 0x3d,
+	0xea, 0xb2, 0xa8, 0x9e, 0x3a, 0xbf, 0x5c, 0x4f, 0x98, 0x61, 0x05, 0x56, 0x51, 0xd6, 0x0e, 0xbb,
+	0x44, 0xf3, 0xc5, 0x61, 0xf9, 0x2c, 0x85, 0xf0, 0x5c, 0xaa, 0xe7, 0xd9, 0x94, 0x1f, 0x80, 0x87,
+	0x9f, 0xa3, 0x45, 0x0f, 0x1c, 0x32, 0x22, 0x40, 0x45, 0xa4, 0xb7, 0x71, 0xab, 0xde, 0x4d, 0xa7,
+	0x91, 0xf6, 0xbc, 0x03, 0xde, 0x46, 0xe9, 0xf9, 0x76, 0xf3, 0xd5, 0xff, 0xf5, 0xf0, 0x52, 0xf5,
+	0xe0, 0x52, 0xe7, 0x7d, 0xd8, 0xdc, 0xb6, 0x64, 0xe3, 0x15, 0x94, 0x24, 0xae, 0xdc, 0x71, 0xaa,
+	0x9e, 0x99, 0x5e, 0xae, 0x27, 0xdb, 0x4d, 0x33, 0x49, 0x5c, 0xbc, 0x8b, 0x32, 0x1c, 0xa8, 0x0b,
+	0x9e, 0x5c, 0xeb, 0x1f, 0x58, 0x8b, 0xca, 0xf1, 0x16, 0x5a, 0x76, 0x61, 0xc4, 0x38, 0x11, 0x96,
+	0xf0, 0x2d, 0xe2, 0xaa, 0xe9, 0x60, 0x3b, 0xf5, 0xbf, 0xa7, 0x97, 0xeb, 0xf9, 0x66, 0x08, 0xf4,
+	0xfc, 0x76, 0xd3, 0xcc, 0xbb, 0xb3, 0xc0, 0xc5, 0x9b, 0x68, 0xe1, 0x00, 0x40, 0xcd, 0xfc, 0xde,
+	0x28, 0x01, 0x17, 0x3f, 0x40, 0x18, 0xe8, 0x9b, 0x31, 0x8c, 0xc1, 0xb5, 0x6c, 0x61, 0x0d, 0x81,
+	0x0c, 0x86, 0x42, 0xcd, 0x96, 0x94, 0xca, 0x82, 0x59, 0x88, 0x91, 0x9a, 0xd8, 0x93, 0x79, 0x5c,
+	0x45, 0x4b, 0xe0, 0x83, 0x33, 0x16, 0x84, 0xd1, 0xc0, 0x54, 0x6e, 0x6e, 0xaa, 0x15, 0xe7, 0x03,
+	0x53, 0x33, 0x52, 0xdb, 0x0d, 0x14, 0x6c, 0xcf, 0x19, 0x92, 0xe3, 0x6b, 0x0a, 0x8b, 0xa1, 0x42,
+	0x8c, 0xcc, 0x14, 0x9e, 0xa2, 0x34, 0x17, 0xb6, 0x00, 0x15, 0xc9, 0x67, 0x79, 0xf7, 0xb6, 0xfd,
+	0xc5, 0xef, 0xa1, 0x1b, 0x90, 0xcd, 0xb0, 0xe6, 0xfe, 0x7b, 0x05, 0x2d, 0x5f, 0x03, 0xb0, 0x86,
+	0x8a, 0x3d, 0xb3, 0xd6, 0xe9, 0xee, 0xb4, 0x4c, 0xab, 0xdb, 0xab, 0xf5, 0x5a, 0xd6, 0x8b, 0x4e,
+	0x77, 0xbf, 0xd5, 0x68, 0xef, 0xb4, 0x5b, 0xcd, 0x42, 0x02, 0xdf, 0x43, 0x2b, 0x37, 0xf0, 0xfd,
+	0x56, 0xa7, 0xd9, 0xee, 0xec, 0x16, 0x94, 0x62, 0xfe, 0xe4, 0xb4, 0x94, 0xdd, 0x07, 0xea, 0x12,
+	0x3a, 0xc0, 0x1b, 0x68, 0xf5, 0x06, 0xb1, 0x66, 0x36, 0xf6, 0xda, 0x2f, 0x5b, 0xcd, 0x42, 0xb2,
+	0xb8, 0x74, 0x72, 0x5a, 0xca, 0xd5, 0xa2, 0x51, 0x8a, 0xb9, 0x77, 0x1f, 0xb5, 0xc4, 0xe7, 0x4f,
+	0x9a, 0x52, 0xef, 0x9e, 0x7f, 0xd3, 0x12, 0xe7, 0x53, 0x4d, 0xb9, 0x98, 0x6a, 0xca, 0xd7, 0xa9,
+	0xa6, 0x7c, 0xb8, 0xd2, 0x12, 0x17, 0x57, 0x5a, 0xe2, 0xcb, 0x95, 0x96, 0x78, 0xb5, 0x3d, 0x20,
+	0x62, 0x38, 0xee, 0xeb, 0x0e, 0x3b, 0x32, 0x6c, 0x1f, 0x5e, 0xdb, 0x1e, 0x05, 0xf1, 0x96, 0x79,
+	0x87, 0x51, 0xf4, 0xd0, 0x61, 0x1e, 0x18, 0xbe, 0x71, 0xfd, 0xe3, 0xea, 0x67, 0xe4, 0xdf, 0xb1,
+	0xf5, 0x3d, 0x00, 0x00, 0xff, 0xff, 0xf8, 0xeb, 0xa7, 0x7b, 0xd1, 0x04, 0x00, 0x00,
 }
 
 func (m *Chain) Marshal() (dAtA []byte, err error) {
@@ -335,6 +357,55 @@ func (m *CrossChainTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.State != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ArchivedAtHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ArchivedAtHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ExecutionID) > 0 {
+		i -= len(m.ExecutionID)
+		copy(dAtA[i:], m.ExecutionID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExecutionID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EnqueuedAtHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EnqueuedAtHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DepositTxID) > 0 {
+		i -= len(m.DepositTxID)
+		copy(dAtA[i:], m.DepositTxID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DepositTxID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Sender.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.ID != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
 		i--
@@ -425,6 +496,27 @@ func (m *CrossChainTransfer) Size() (n int) {
 	if m.ID != 0 {
 		n += 1 + sovTypes(uint64(m.ID))
 	}
+	l = m.Sender.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.DepositTxID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.EnqueuedAtHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EnqueuedAtHeight))
+	}
+	l = len(m.ExecutionID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ArchivedAtHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ArchivedAtHeight))
+	}
+	if m.State != 0 {
+		n += 1 + sovTypes(uint64(m.State))
+	}
 	return n
 }
 
@@ -816,6 +908,193 @@ func (m *CrossChainTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositTxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositTxID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueuedAtHeight", wireType)
+			}
+			m.EnqueuedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnqueuedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedAtHeight", wireType)
+			}
+			m.ArchivedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArchivedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= TransferState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
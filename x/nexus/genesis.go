@@ -6,16 +6,14 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 )
 
-// InitGenesis initialize default parameters
-// from the genesis state
+// InitGenesis initializes the state from the given genesis state
 func InitGenesis(ctx sdk.Context, k types.Nexus, g types.GenesisState) {
-	k.SetParams(ctx, g.Params)
+	k.InitGenesis(ctx, &g)
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k types.Nexus) types.GenesisState {
-	params := k.GetParams(ctx)
-	return types.GenesisState{Params: params}
+	return *k.ExportGenesis(ctx)
 }
@@ -2,10 +2,13 @@ package nexus
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/keeper"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 )
@@ -42,3 +45,45 @@ func NewHandler(k types.Nexus, snapshotter types.Snapshotter) sdk.Handler {
 		return res, nil
 	}
 }
+
+// NewProposalHandler returns the handler for the nexus module's governance proposals
+func NewProposalHandler(k types.Nexus, a types.AxelarnetKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RegisterAssetProposal:
+			chain, ok := k.GetChain(ctx, c.Chain)
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrNexus, "chain '%s' not found", c.Chain)
+			}
+
+			if k.IsAssetRegistered(ctx, chain.Name, c.Asset) {
+				return sdkerrors.Wrapf(types.ErrNexus, "asset '%s' is already registered for chain '%s'", c.Asset, chain.Name)
+			}
+
+			k.RegisterAsset(ctx, chain.Name, c.Asset)
+
+			// assets of cosmos based chains are routed through axelarnet via IBC,
+			// so they are registered the same way axelarnet's RegisterAsset message does it
+			if isCosmosChain(ctx, a, chain.Name) {
+				k.RegisterAsset(ctx, axelarnet.Axelarnet.Name, c.Asset)
+				a.RegisterAssetToCosmosChain(ctx, c.Asset, chain.Name)
+			}
+
+			k.Logger(ctx).Debug(fmt.Sprintf("registered asset %s for chain %s", c.Asset, chain.Name))
+
+			return nil
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func isCosmosChain(ctx sdk.Context, a types.AxelarnetKeeper, chain string) bool {
+	for _, cosmosChain := range a.GetCosmosChains(ctx) {
+		if strings.EqualFold(cosmosChain, chain) {
+			return true
+		}
+	}
+
+	return false
+}
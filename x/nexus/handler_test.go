@@ -0,0 +1,93 @@
+package nexus_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types/mock"
+)
+
+func TestRegisterAssetProposal(t *testing.T) {
+	var (
+		ctx      sdk.Context
+		k        *mock.NexusMock
+		a        *mock.AxelarnetKeeperMock
+		chain    exported.Chain
+		asset    string
+		proposal *types.RegisterAssetProposal
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		chain = exported.Chain{Name: rand.StrBetween(5, 20), NativeAsset: rand.StrBetween(3, 10)}
+		asset = rand.StrBetween(3, 10)
+		proposal = types.NewRegisterAssetProposal(rand.StrBetween(5, 20), rand.StrBetween(5, 50), chain.Name, asset)
+
+		k = &mock.NexusMock{
+			LoggerFunc: func(sdk.Context) log.Logger { return log.TestingLogger() },
+			GetChainFunc: func(_ sdk.Context, name string) (exported.Chain, bool) {
+				return chain, name == chain.Name
+			},
+			IsAssetRegisteredFunc: func(sdk.Context, string, string) bool { return false },
+			RegisterAssetFunc:     func(sdk.Context, string, string) {},
+		}
+		a = &mock.AxelarnetKeeperMock{
+			GetCosmosChainsFunc:            func(sdk.Context) []string { return nil },
+			RegisterAssetToCosmosChainFunc: func(sdk.Context, string, string) {},
+		}
+	}
+
+	t.Run("should register the asset", func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, proposal.ValidateBasic())
+		assert.NoError(t, nexus.NewProposalHandler(k, a)(ctx, proposal))
+		assert.Len(t, k.RegisterAssetCalls(), 1)
+		assert.Equal(t, chain.Name, k.RegisterAssetCalls()[0].ChainName)
+		assert.Equal(t, asset, k.RegisterAssetCalls()[0].Denom)
+		assert.Empty(t, a.RegisterAssetToCosmosChainCalls())
+	})
+
+	t.Run("should register the asset of cosmos based chains with axelarnet", func(t *testing.T) {
+		setup()
+		a.GetCosmosChainsFunc = func(sdk.Context) []string { return []string{rand.StrBetween(21, 30), strings.ToLower(chain.Name)} }
+
+		assert.NoError(t, nexus.NewProposalHandler(k, a)(ctx, proposal))
+		assert.Len(t, k.RegisterAssetCalls(), 2)
+		assert.Equal(t, chain.Name, k.RegisterAssetCalls()[0].ChainName)
+		assert.Equal(t, asset, k.RegisterAssetCalls()[0].Denom)
+		assert.Equal(t, axelarnet.Axelarnet.Name, k.RegisterAssetCalls()[1].ChainName)
+		assert.Equal(t, asset, k.RegisterAssetCalls()[1].Denom)
+
+		assert.Len(t, a.RegisterAssetToCosmosChainCalls(), 1)
+		assert.Equal(t, asset, a.RegisterAssetToCosmosChainCalls()[0].Asset)
+		assert.Equal(t, chain.Name, a.RegisterAssetToCosmosChainCalls()[0].Chain)
+	})
+
+	t.Run("should fail for unknown chains", func(t *testing.T) {
+		setup()
+		proposal.Chain = rand.StrBetween(21, 30)
+
+		assert.Error(t, nexus.NewProposalHandler(k, a)(ctx, proposal))
+		assert.Empty(t, k.RegisterAssetCalls())
+	})
+
+	t.Run("should fail for already registered assets", func(t *testing.T) {
+		setup()
+		k.IsAssetRegisteredFunc = func(sdk.Context, string, string) bool { return true }
+
+		assert.Error(t, nexus.NewProposalHandler(k, a)(ctx, proposal))
+		assert.Empty(t, k.RegisterAssetCalls())
+	})
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+// GetFeeSchedules returns all fee schedules that apply to transfers of the given asset from the source to the destination chain.
+// Empty arguments match any chain or asset
+func (k Keeper) GetFeeSchedules(ctx sdk.Context, sourceChain string, destinationChain string, asset string) []types.FeeSchedule {
+	schedules := []types.FeeSchedule{}
+	for _, schedule := range k.GetParams(ctx).FeeSchedules {
+		if schedule.Matches(sourceChain, destinationChain, asset) {
+			schedules = append(schedules, schedule)
+		}
+	}
+
+	return schedules
+}
+
+// getFeeSchedule returns the most specific fee schedule for transfers of the given asset from the source to the destination chain.
+// If several schedules are equally specific, the first one takes precedence
+func (k Keeper) getFeeSchedule(ctx sdk.Context, sourceChain exported.Chain, destinationChain exported.Chain, asset string) (types.FeeSchedule, bool) {
+	var result types.FeeSchedule
+	found := false
+	for _, schedule := range k.GetFeeSchedules(ctx, sourceChain.Name, destinationChain.Name, asset) {
+		if !found || schedule.Specificity() > result.Specificity() {
+			result = schedule
+			found = true
+		}
+	}
+
+	return result, found
+}
+
+// computeFee returns the fee due for the transfer of the given amount from the source to the destination chain.
+// The given fee rate applies if no fee schedule matches the transfer
+func (k Keeper) computeFee(ctx sdk.Context, sourceChain exported.Chain, destinationChain exported.Chain, amount sdk.Coin, feeRate sdk.Dec) sdk.Int {
+	fee := sdk.NewDecFromInt(amount.Amount).Mul(feeRate).TruncateInt()
+	if schedule, ok := k.getFeeSchedule(ctx, sourceChain, destinationChain, amount.Denom); ok {
+		fee = schedule.ComputeFee(amount.Amount)
+	}
+
+	// transfers that do not cover the fee are collected as fee in full
+	if fee.GT(amount.Amount) {
+		return amount.Amount
+	}
+
+	return fee
+}
+
+// GetFeeLedger returns the fees collected for transfers of the given asset from the source to the destination chain.
+// Empty arguments match any chain or asset
+func (k Keeper) GetFeeLedger(ctx sdk.Context, sourceChain string, destinationChain string, asset string) []types.FeeLedgerEntry {
+	entries := []types.FeeLedgerEntry{}
+
+	iter := k.getStore(ctx).Iterator(feeLedgerPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var entry types.FeeLedgerEntry
+		iter.UnmarshalValue(&entry)
+
+		if entry.Matches(sourceChain, destinationChain, asset) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// GetDistributedFees returns the total fees that have been distributed
+func (k Keeper) GetDistributedFees(ctx sdk.Context) sdk.Coins {
+	distributed := sdk.NewCoins()
+
+	iter := k.getStore(ctx).Iterator(distributedFeePrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var coin sdk.Coin
+		iter.UnmarshalValue(&coin)
+
+		distributed = distributed.Add(coin)
+	}
+
+	return distributed
+}
+
+// GetUndistributedFees returns the fees that have been collected but not distributed yet
+func (k Keeper) GetUndistributedFees(ctx sdk.Context) sdk.Coins {
+	collected := sdk.NewCoins()
+	for _, entry := range k.GetFeeLedger(ctx, "", "", "") {
+		collected = collected.Add(entry.Collected)
+	}
+
+	undistributed, _ := collected.SafeSub(k.GetDistributedFees(ctx))
+
+	return undistributed
+}
+
+// AddDistributedFees records the given fees as distributed
+func (k Keeper) AddDistributedFees(ctx sdk.Context, fees sdk.Coins) {
+	for _, fee := range fees {
+		var distributed sdk.Coin
+		if ok := k.getStore(ctx).Get(getDistributedFeeKey(fee.Denom), &distributed); !ok {
+			distributed = sdk.NewCoin(fee.Denom, sdk.ZeroInt())
+		}
+
+		k.setDistributedFee(ctx, distributed.Add(fee))
+	}
+}
+
+func (k Keeper) addCollectedFee(ctx sdk.Context, sourceChain exported.Chain, destinationChain exported.Chain, fee sdk.Coin) {
+	key := getFeeLedgerKey(sourceChain.Name, destinationChain.Name, fee.Denom)
+
+	var entry types.FeeLedgerEntry
+	if ok := k.getStore(ctx).Get(key, &entry); !ok {
+		entry = types.FeeLedgerEntry{
+			SourceChain:      sourceChain.Name,
+			DestinationChain: destinationChain.Name,
+			Collected:        sdk.NewCoin(fee.Denom, sdk.ZeroInt()),
+		}
+	}
+
+	entry.Collected = entry.Collected.Add(fee)
+	k.setFeeLedgerEntry(ctx, entry)
+}
+
+func (k Keeper) setFeeLedgerEntry(ctx sdk.Context, entry types.FeeLedgerEntry) {
+	k.getStore(ctx).Set(getFeeLedgerKey(entry.SourceChain, entry.DestinationChain, entry.Collected.Denom), &entry)
+}
+
+func (k Keeper) setDistributedFee(ctx sdk.Context, distributed sdk.Coin) {
+	k.getStore(ctx).Set(getDistributedFeeKey(distributed.Denom), &distributed)
+}
+
+func getFeeLedgerKey(sourceChain string, destinationChain string, asset string) utils.Key {
+	return feeLedgerPrefix.
+		AppendStr(sourceChain, strings.ToLower).
+		AppendStr(destinationChain, strings.ToLower).
+		AppendStr(asset, strings.ToLower)
+}
+
+func getDistributedFeeKey(asset string) utils.Key {
+	return distributedFeePrefix.AppendStr(asset, strings.ToLower)
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+func TestFeeSchedules(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+
+	params := types.DefaultParams()
+	params.FeeSchedules = []types.FeeSchedule{
+		{SourceChain: btc.Bitcoin.Name, Asset: btcTypes.Satoshi, Rate: sdk.NewDecWithPrec(1, 2), FlatFee: sdk.ZeroInt(), MinFee: sdk.ZeroInt(), MaxFee: sdk.NewInt(5000)},
+		{SourceChain: btc.Bitcoin.Name, DestinationChain: evm.Ethereum.Name, Asset: btcTypes.Satoshi, Rate: sdk.ZeroDec(), FlatFee: sdk.NewInt(100), MinFee: sdk.NewInt(1000), MaxFee: sdk.ZeroInt()},
+	}
+	assert.NoError(t, params.Validate())
+	keeper.SetParams(ctx, params)
+	keeper.SetChain(ctx, btc.Bitcoin)
+
+	assert.Len(t, keeper.GetFeeSchedules(ctx, "", "", ""), 2)
+	assert.Len(t, keeper.GetFeeSchedules(ctx, btc.Bitcoin.Name, axelarnet.Axelarnet.Name, btcTypes.Satoshi), 1)
+	assert.Len(t, keeper.GetFeeSchedules(ctx, evm.Ethereum.Name, "", ""), 0)
+
+	enqueue := func(recipientChain exported.Chain, amount int64) exported.CrossChainTransfer {
+		sender, recipient := makeRandAddressesForChain(btc.Bitcoin, recipientChain)
+		keeper.LinkAddresses(ctx, sender, recipient)
+		assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewInt64Coin(btcTypes.Satoshi, amount), feeRate, rand.Str(64)))
+
+		transfers, _, err := keeper.GetTransfersByRecipientPaginated(ctx, recipient, nil)
+		assert.NoError(t, err)
+		assert.Len(t, transfers, 1)
+		return transfers[0]
+	}
+
+	// the route specific schedule takes precedence and enforces the min fee
+	transfer := enqueue(evm.Ethereum, 50000)
+	assert.Equal(t, sdk.NewInt(1000), transfer.Fee.Amount)
+	assert.Equal(t, sdk.NewInt(49000), transfer.Asset.Amount)
+
+	// the asset wide schedule applies to other destinations and caps the fee
+	transfer = enqueue(axelarnet.Axelarnet, 50000)
+	assert.Equal(t, sdk.NewInt(500), transfer.Fee.Amount)
+	transfer = enqueue(axelarnet.Axelarnet, 1000000)
+	assert.Equal(t, sdk.NewInt(5000), transfer.Fee.Amount)
+
+	// deposits that do not cover the fee are collected in full
+	transfer = enqueue(evm.Ethereum, 500)
+	assert.Equal(t, sdk.NewInt(500), transfer.Fee.Amount)
+	assert.True(t, transfer.Asset.Amount.IsZero())
+
+	// the default fee rate applies without a matching schedule
+	keeper.SetParams(ctx, types.DefaultParams())
+	transfer = enqueue(evm.Ethereum, 50000)
+	assert.Equal(t, sdk.NewDec(50000).Mul(feeRate).TruncateInt(), transfer.Fee.Amount)
+}
+
+func TestFeeLedger(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.SetChain(ctx, btc.Bitcoin)
+
+	assert.Empty(t, keeper.GetFeeLedger(ctx, "", "", ""))
+	assert.True(t, keeper.GetUndistributedFees(ctx).IsZero())
+
+	expected := map[string]sdk.Int{}
+	for _, recipientChain := range []exported.Chain{evm.Ethereum, axelarnet.Axelarnet, evm.Ethereum} {
+		sender, recipient := makeRandAddressesForChain(btc.Bitcoin, recipientChain)
+		keeper.LinkAddresses(ctx, sender, recipient)
+		assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewInt64Coin(btcTypes.Satoshi, rand.I64Between(100000, 10000000)), feeRate, rand.Str(64)))
+
+		transfers, _, err := keeper.GetTransfersByRecipientPaginated(ctx, recipient, nil)
+		assert.NoError(t, err)
+		fee, ok := expected[recipientChain.Name]
+		if !ok {
+			fee = sdk.ZeroInt()
+		}
+		expected[recipientChain.Name] = fee.Add(transfers[0].Fee.Amount)
+	}
+
+	entries := keeper.GetFeeLedger(ctx, btc.Bitcoin.Name, evm.Ethereum.Name, btcTypes.Satoshi)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, expected[evm.Ethereum.Name], entries[0].Collected.Amount)
+
+	assert.Len(t, keeper.GetFeeLedger(ctx, btc.Bitcoin.Name, "", ""), 2)
+	assert.Empty(t, keeper.GetFeeLedger(ctx, evm.Ethereum.Name, "", ""))
+	assert.Empty(t, keeper.GetFeeLedger(ctx, "", "", "uaxl"))
+
+	total := expected[evm.Ethereum.Name].Add(expected[axelarnet.Axelarnet.Name])
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin(btcTypes.Satoshi, total)), keeper.GetUndistributedFees(ctx))
+
+	distributed := sdk.NewCoin(btcTypes.Satoshi, total.QuoRaw(2))
+	keeper.AddDistributedFees(ctx, sdk.NewCoins(distributed))
+	keeper.AddDistributedFees(ctx, sdk.NewCoins(distributed))
+	assert.Equal(t, sdk.NewCoins(distributed.Add(distributed)), keeper.GetDistributedFees(ctx))
+	assert.True(t, total.Sub(distributed.Amount.MulRaw(2)).Equal(keeper.GetUndistributedFees(ctx).AmountOf(btcTypes.Satoshi)))
+
+	genState := keeper.ExportGenesis(ctx)
+	assert.NoError(t, genState.Validate())
+	assert.Len(t, genState.FeeLedger, 2)
+
+	genState.DistributedFees = genState.DistributedFees.Add(sdk.NewCoin(btcTypes.Satoshi, total))
+	assert.Error(t, genState.Validate())
+}
+
+func TestFeeScheduleValidate(t *testing.T) {
+	valid := types.FeeSchedule{Rate: sdk.NewDecWithPrec(1, 3), FlatFee: sdk.ZeroInt(), MinFee: sdk.NewInt(10), MaxFee: sdk.NewInt(100)}
+	assert.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.Rate = sdk.NewDec(2)
+	assert.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.MaxFee = sdk.NewInt(5)
+	assert.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.FlatFee = sdk.Int{}
+	assert.Error(t, invalid.Validate())
+
+	params := types.DefaultParams()
+	params.FeeSchedules = []types.FeeSchedule{valid, valid}
+	assert.Error(t, params.Validate())
+}
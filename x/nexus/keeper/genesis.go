@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+// InitGenesis initializes the nexus module's state from a given genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.setNonce(ctx, genState.Nonce)
+
+	for _, chain := range genState.Chains {
+		k.SetChain(ctx, chain)
+	}
+
+	for _, chainState := range genState.ChainStates {
+		k.setChainState(ctx, chainState)
+	}
+
+	for _, chainAssets := range genState.ChainAssets {
+		for _, asset := range chainAssets.Assets {
+			k.RegisterAsset(ctx, chainAssets.Chain, asset)
+		}
+
+		for _, total := range chainAssets.Totals {
+			k.setChainTotal(ctx, chainAssets.Chain, total)
+		}
+	}
+
+	for _, linkedAddresses := range genState.LinkedAddresses {
+		k.LinkAddresses(ctx, linkedAddresses.DepositAddress, linkedAddresses.RecipientAddress)
+	}
+
+	for _, transfer := range genState.PendingTransfers {
+		k.setTransfer(ctx, transfer, exported.Pending)
+	}
+
+	for _, transfer := range genState.ArchivedTransfers {
+		k.setTransfer(ctx, transfer, exported.Archived)
+	}
+
+	for _, flow := range genState.TransferFlows {
+		k.setTransferFlow(ctx, flow)
+	}
+
+	for _, entry := range genState.FeeLedger {
+		k.setFeeLedgerEntry(ctx, entry)
+	}
+
+	for _, distributed := range genState.DistributedFees {
+		k.setDistributedFee(ctx, distributed)
+	}
+}
+
+// ExportGenesis returns the nexus module's genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	chains := k.GetChains(ctx)
+	chainAssets := make([]types.ChainAssets, 0, len(chains))
+	for _, chain := range chains {
+		assets := k.getChainAssets(ctx, chain)
+		if len(assets.Assets) == 0 && len(assets.Totals) == 0 {
+			continue
+		}
+
+		chainAssets = append(chainAssets, assets)
+	}
+
+	if chains == nil {
+		chains = []exported.Chain{}
+	}
+
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		k.getNonce(ctx),
+		chains,
+		k.getChainStates(ctx),
+		chainAssets,
+		k.getAllLinkedAddresses(ctx),
+		k.getAllTransfers(ctx, exported.Pending),
+		k.getAllTransfers(ctx, exported.Archived),
+		k.getAllTransferFlows(ctx),
+		k.GetFeeLedger(ctx, "", "", ""),
+		k.GetDistributedFees(ctx),
+	)
+}
+
+func (k Keeper) getChainStates(ctx sdk.Context) []types.ChainState {
+	chainStates := []types.ChainState{}
+
+	iter := k.getStore(ctx).Iterator(chainStatePrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var chainState types.ChainState
+		iter.UnmarshalValue(&chainState)
+
+		chainStates = append(chainStates, chainState)
+	}
+
+	return chainStates
+}
+
+func (k Keeper) getChainAssets(ctx sdk.Context, chain exported.Chain) types.ChainAssets {
+	chainAssets := types.ChainAssets{Chain: chain.Name, Assets: []string{}, Totals: sdk.Coins{}}
+
+	prefix := registeredPrefix.AppendStr(chain.Name, strings.ToLower).AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		asset := strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey()))
+		chainAssets.Assets = append(chainAssets.Assets, asset)
+	}
+
+	for _, total := range k.getChainTotals(ctx, chain) {
+		if total.IsZero() {
+			continue
+		}
+
+		chainAssets.Totals = chainAssets.Totals.Add(total)
+	}
+
+	return chainAssets
+}
+
+func (k Keeper) getAllLinkedAddresses(ctx sdk.Context) []types.LinkedAddresses {
+	linkedAddresses := []types.LinkedAddresses{}
+
+	iter := k.getStore(ctx).Iterator(senderPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var linked types.LinkedAddresses
+		iter.UnmarshalValue(&linked)
+
+		linkedAddresses = append(linkedAddresses, linked)
+	}
+
+	return linkedAddresses
+}
+
+func (k Keeper) getAllTransfers(ctx sdk.Context, state exported.TransferState) []exported.CrossChainTransfer {
+	transfers := []exported.CrossChainTransfer{}
+
+	iter := k.getStore(ctx).Iterator(utils.LowerCaseKey(state.String()).AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var transfer exported.CrossChainTransfer
+		iter.UnmarshalValue(&transfer)
+
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.SetChain(ctx, btc.Bitcoin)
+	keeper.RegisterAsset(ctx, evm.Ethereum.Name, btc.Bitcoin.NativeAsset)
+	keeper.ActivateChain(ctx, evm.Ethereum)
+	assert.NoError(t, keeper.AddChainMaintainer(ctx, evm.Ethereum, rand.ValAddr()))
+
+	for i := 0; i < linkedAddr; i++ {
+		sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
+		keeper.LinkAddresses(ctx, sender, recipient)
+		assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64)))
+	}
+
+	for _, transfer := range keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)[:linkedAddr/2] {
+		keeper.ArchivePendingTransfer(ctx, transfer, rand.Str(64))
+	}
+
+	expected := keeper.ExportGenesis(ctx)
+	assert.NoError(t, expected.Validate())
+	assert.Len(t, expected.LinkedAddresses, linkedAddr)
+	assert.Len(t, expected.ArchivedTransfers, linkedAddr/2)
+	assert.Equal(t, uint64(len(expected.PendingTransfers)+len(expected.ArchivedTransfers)), expected.Nonce)
+
+	newCtx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.InitGenesis(newCtx, expected)
+
+	actual := keeper.ExportGenesis(newCtx)
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending), keeper.GetTransfersForChain(newCtx, evm.Ethereum, exported.Pending))
+	assert.True(t, keeper.IsChainActivated(newCtx, evm.Ethereum))
+
+	for _, linked := range expected.LinkedAddresses {
+		recipient, ok := keeper.GetRecipient(newCtx, linked.DepositAddress)
+		assert.True(t, ok)
+		assert.Equal(t, linked.RecipientAddress, recipient)
+	}
+}
+
+func TestValidateGenesis_UnknownChain(t *testing.T) {
+	genState := types.DefaultGenesisState()
+	sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
+	genState.LinkedAddresses = append(genState.LinkedAddresses, types.LinkedAddresses{DepositAddress: sender, RecipientAddress: recipient})
+	assert.Error(t, genState.Validate())
+
+	genState.Chains = append(genState.Chains, btc.Bitcoin)
+	assert.NoError(t, genState.Validate())
+}
+
+func TestValidateGenesis_TransferIDExceedsNonce(t *testing.T) {
+	genState := types.DefaultGenesisState()
+	_, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
+	genState.PendingTransfers = append(genState.PendingTransfers, exported.CrossChainTransfer{
+		Recipient: recipient,
+		Asset:     makeRandAmount(btcTypes.Satoshi),
+		ID:        uint64(rand.I64Between(0, 100)),
+	})
+	genState.Nonce = genState.PendingTransfers[0].ID
+	assert.Error(t, genState.Validate())
+
+	genState.Nonce++
+	assert.NoError(t, genState.Validate())
+}
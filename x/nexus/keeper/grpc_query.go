@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+var _ types.QueryServiceServer = Querier{}
+
+// Querier implements the grpc querier
+type Querier struct {
+	keeper types.Nexus
+}
+
+// NewGRPCQuerier creates a new nexus Querier
+func NewGRPCQuerier(k types.Nexus) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// ChainMaintainers returns the maintainers of the given chain
+func (q Querier) ChainMaintainers(c context.Context, req *types.QueryChainMaintainersRequest) (*types.QueryChainMaintainersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := q.keeper.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrNexus, fmt.Sprintf("%s is not a registered chain", req.Chain))
+	}
+
+	return &types.QueryChainMaintainersResponse{Maintainers: q.keeper.GetChainMaintainers(ctx, chain)}, nil
+}
+
+// Chains returns all registered chains
+func (q Querier) Chains(c context.Context, _ *types.QueryChainsRequest) (*types.QueryChainsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryChainsResponse{Chains: q.keeper.GetChains(ctx)}, nil
+}
+
+// Transfers returns a page of the transfers with the given state to the given chain
+func (q Querier) Transfers(c context.Context, req *types.QueryTransfersRequest) (*types.QueryTransfersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := q.keeper.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrNexus, fmt.Sprintf("%s is not a registered chain", req.Chain))
+	}
+
+	if err := req.State.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNexus, err.Error())
+	}
+
+	transfers, pageResponse, err := q.keeper.GetTransfersForChainPaginated(ctx, chain, req.State, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNexus, err.Error())
+	}
+
+	return &types.QueryTransfersResponse{Transfers: transfers, Pagination: pageResponse}, nil
+}
+
+// Transfer returns the transfer with the given ID
+func (q Querier) Transfer(c context.Context, req *types.QueryTransferRequest) (*types.QueryTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	transfer, ok := q.keeper.GetTransfer(ctx, req.ID)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrNexus, fmt.Sprintf("transfer %d not found", req.ID))
+	}
+
+	return &types.QueryTransferResponse{Transfer: transfer}, nil
+}
+
+// TransfersBySender returns a page of the transfers sent from the given address
+func (q Querier) TransfersBySender(c context.Context, req *types.QueryTransfersByAddressRequest) (*types.QueryTransfersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := q.getCrossChainAddress(ctx, req.Chain, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, pageResponse, err := q.keeper.GetTransfersBySenderPaginated(ctx, address, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNexus, err.Error())
+	}
+
+	return &types.QueryTransfersResponse{Transfers: transfers, Pagination: pageResponse}, nil
+}
+
+// TransfersByRecipient returns a page of the transfers sent to the given address
+func (q Querier) TransfersByRecipient(c context.Context, req *types.QueryTransfersByAddressRequest) (*types.QueryTransfersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := q.getCrossChainAddress(ctx, req.Chain, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, pageResponse, err := q.keeper.GetTransfersByRecipientPaginated(ctx, address, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNexus, err.Error())
+	}
+
+	return &types.QueryTransfersResponse{Transfers: transfers, Pagination: pageResponse}, nil
+}
+
+// TransfersByState returns a page of the transfers with the given state to any chain
+func (q Querier) TransfersByState(c context.Context, req *types.QueryTransfersByStateRequest) (*types.QueryTransfersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := req.State.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNexus, err.Error())
+	}
+
+	transfers, pageResponse, err := q.keeper.GetTransfersByStatePaginated(ctx, req.State, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNexus, err.Error())
+	}
+
+	return &types.QueryTransfersResponse{Transfers: transfers, Pagination: pageResponse}, nil
+}
+
+// FeeSchedules returns the fee schedules that apply to the given route and asset
+func (q Querier) FeeSchedules(c context.Context, req *types.QueryFeeSchedulesRequest) (*types.QueryFeeSchedulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeSchedulesResponse{FeeSchedules: q.keeper.GetFeeSchedules(ctx, req.SourceChain, req.DestinationChain, req.Asset)}, nil
+}
+
+// FeeLedger returns the fees collected for the given route and asset
+func (q Querier) FeeLedger(c context.Context, req *types.QueryFeeLedgerRequest) (*types.QueryFeeLedgerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entries := q.keeper.GetFeeLedger(ctx, req.SourceChain, req.DestinationChain, req.Asset)
+
+	collected := sdk.NewCoins()
+	for _, entry := range entries {
+		collected = collected.Add(entry.Collected)
+	}
+
+	distributed := q.keeper.GetDistributedFees(ctx)
+	if req.Asset != "" {
+		distributed = sdk.NewCoins(sdk.NewCoin(req.Asset, distributed.AmountOf(req.Asset)))
+	}
+
+	return &types.QueryFeeLedgerResponse{Entries: entries, Collected: collected, Distributed: distributed}, nil
+}
+
+func (q Querier) getCrossChainAddress(ctx sdk.Context, chainName string, address string) (exported.CrossChainAddress, error) {
+	chain, ok := q.keeper.GetChain(ctx, chainName)
+	if !ok {
+		return exported.CrossChainAddress{}, sdkerrors.Wrap(types.ErrNexus, fmt.Sprintf("%s is not a registered chain", chainName))
+	}
+
+	if address == "" {
+		return exported.CrossChainAddress{}, sdkerrors.Wrap(types.ErrNexus, "address must not be empty")
+	}
+
+	return exported.CrossChainAddress{Chain: chain, Address: address}, nil
+}
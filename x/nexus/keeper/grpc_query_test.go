@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+func TestQuerier_Transfers(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	// a chain whose name has ethereum's name as prefix must not show up in ethereum's transfers
+	similarChain := evm.Ethereum
+	similarChain.Name = evm.Ethereum.Name + "x"
+	keeper.SetChain(ctx, similarChain)
+
+	for _, chain := range []exported.Chain{evm.Ethereum, similarChain} {
+		for i := 0; i < linkedAddr; i++ {
+			sender, recipient := makeRandAddressesForChain(btc.Bitcoin, chain)
+			keeper.LinkAddresses(ctx, sender, recipient)
+			assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64)))
+		}
+	}
+
+	querier := nexusKeeper.NewGRPCQuerier(keeper)
+
+	var transfers []exported.CrossChainTransfer
+	var nextKey []byte
+	for {
+		res, err := querier.Transfers(sdk.WrapSDKContext(ctx), &types.QueryTransfersRequest{
+			Chain:      evm.Ethereum.Name,
+			State:      exported.Pending,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 7},
+		})
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(res.Transfers), 7)
+
+		transfers = append(transfers, res.Transfers...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+
+	assert.Len(t, transfers, linkedAddr)
+	for _, transfer := range transfers {
+		assert.Equal(t, evm.Ethereum, transfer.Recipient.Chain)
+	}
+
+	_, err := querier.Transfers(sdk.WrapSDKContext(ctx), &types.QueryTransfersRequest{Chain: evm.Ethereum.Name, State: exported.Pending + 100})
+	assert.Error(t, err)
+
+	_, err = querier.Transfers(sdk.WrapSDKContext(ctx), &types.QueryTransfersRequest{Chain: "unknown", State: exported.Pending})
+	assert.Error(t, err)
+}
+
+func TestQuerier_TransfersByAddress(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.SetChain(ctx, btc.Bitcoin)
+	querier := nexusKeeper.NewGRPCQuerier(keeper)
+
+	sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
+	keeper.LinkAddresses(ctx, sender, recipient)
+
+	depositCount := int(rand.I64Between(1, 20))
+	for i := 0; i < depositCount; i++ {
+		assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64)))
+	}
+
+	res, err := querier.TransfersByRecipient(sdk.WrapSDKContext(ctx), &types.QueryTransfersByAddressRequest{Chain: recipient.Chain.Name, Address: recipient.Address})
+	assert.NoError(t, err)
+	assert.Len(t, res.Transfers, depositCount)
+
+	res, err = querier.TransfersBySender(sdk.WrapSDKContext(ctx), &types.QueryTransfersByAddressRequest{Chain: sender.Chain.Name, Address: sender.Address})
+	assert.NoError(t, err)
+	// each deposit results in a transfer to the recipient and a fee transfer to the fee collector
+	assert.Len(t, res.Transfers, 2*depositCount)
+
+	byID, err := querier.Transfer(sdk.WrapSDKContext(ctx), &types.QueryTransferRequest{ID: res.Transfers[0].ID})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Transfers[0], byID.Transfer)
+
+	byState, err := querier.TransfersByState(sdk.WrapSDKContext(ctx), &types.QueryTransfersByStateRequest{State: exported.Pending})
+	assert.NoError(t, err)
+	assert.Len(t, byState.Transfers, 2*depositCount)
+
+	byState, err = querier.TransfersByState(sdk.WrapSDKContext(ctx), &types.QueryTransfersByStateRequest{State: exported.Archived})
+	assert.NoError(t, err)
+	assert.Len(t, byState.Transfers, 0)
+
+	_, err = querier.TransfersBySender(sdk.WrapSDKContext(ctx), &types.QueryTransfersByAddressRequest{Chain: rand.Str(5), Address: sender.Address})
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+// RegisterInvariants registers all nexus module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "chain-totals", ChainTotalsInvariant(k))
+}
+
+// ChainTotalsInvariant checks that no chain total is negative and that the total of every asset on a chain
+// is backed by the transfers archived to that chain. Axelarnet is exempt from the latter,
+// because its totals are credited directly when IBC tokens are deposited
+func ChainTotalsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, chain := range k.GetChains(ctx) {
+			archived := make(map[string]sdk.Int)
+			for _, transfer := range k.GetTransfersForChain(ctx, chain, exported.Archived) {
+				denom := strings.ToLower(transfer.Asset.Denom)
+				if _, ok := archived[denom]; !ok {
+					archived[denom] = sdk.ZeroInt()
+				}
+				archived[denom] = archived[denom].Add(transfer.Asset.Amount)
+			}
+
+			for _, total := range k.getChainTotals(ctx, chain) {
+				if total.Amount.IsNil() || total.IsNegative() {
+					msg += fmt.Sprintf("\tnegative total %s for chain %s\n", total.String(), chain.Name)
+					broken = true
+					continue
+				}
+
+				if chain.Name == axelarnet.Axelarnet.Name {
+					continue
+				}
+
+				transferred, ok := archived[strings.ToLower(total.Denom)]
+				if !ok {
+					transferred = sdk.ZeroInt()
+				}
+
+				// the difference between the archived transfers and the total is what has been deposited from the chain
+				if transferred.LT(total.Amount) {
+					msg += fmt.Sprintf("\ttotal %s for chain %s exceeds the archived transfers of %s%s\n",
+						total.String(), chain.Name, transferred.String(), total.Denom)
+					broken = true
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "chain-totals",
+			fmt.Sprintf("chain totals are inconsistent with the archived transfers\n%s", msg)), broken
+	}
+}
+
+func (k Keeper) getChainTotals(ctx sdk.Context, chain exported.Chain) []sdk.Coin {
+	var totals []sdk.Coin
+
+	iter := k.getStore(ctx).Iterator(totalPrefix.AppendStr(chain.Name, strings.ToLower).AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var total sdk.Coin
+		iter.UnmarshalValue(&total)
+
+		totals = append(totals, total)
+	}
+
+	return totals
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+func TestChainTotalsInvariant(t *testing.T) {
+	setup := func() sdk.Context {
+		ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		keeper.SetParams(ctx, types.DefaultParams())
+
+		for i := 0; i < int(rand.I64Between(1, 20)); i++ {
+			sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
+			keeper.LinkAddresses(ctx, sender, recipient)
+			assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64)))
+		}
+
+		for _, transfer := range keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending) {
+			keeper.ArchivePendingTransfer(ctx, transfer, rand.Str(64))
+		}
+
+		return ctx
+	}
+
+	t.Run("should hold when assets are withdrawn from a chain", testutils.Func(func(t *testing.T) {
+		ctx := setup()
+
+		sender, recipient := makeRandAddressesForChain(evm.Ethereum, btc.Bitcoin)
+		keeper.LinkAddresses(ctx, sender, recipient)
+		total := keeper.GetChainTotal(ctx, evm.Ethereum, btcTypes.Satoshi)
+		assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewCoin(btcTypes.Satoshi, sdk.NewInt(rand.I64Between(1, total.Amount.Int64()+1))), feeRate, rand.Str(64)))
+
+		// only the amount net of the fee is subtracted from the sender chain's total
+		pending := keeper.GetTransfersForChain(ctx, btc.Bitcoin, exported.Pending)
+		assert.Len(t, pending, 1)
+		assert.True(t, total.Sub(pending[0].Asset).IsEqual(keeper.GetChainTotal(ctx, evm.Ethereum, btcTypes.Satoshi)))
+
+		_, broken := nexusKeeper.ChainTotalsInvariant(keeper)(ctx)
+		assert.False(t, broken)
+	}).Repeat(20))
+
+	t.Run("should break when a total is not backed by archived transfers", testutils.Func(func(t *testing.T) {
+		ctx := setup()
+
+		keeper.AddToChainTotal(ctx, evm.Ethereum, sdk.NewCoin(btcTypes.Satoshi, sdk.NewInt(rand.PosI64())))
+
+		msg, broken := nexusKeeper.ChainTotalsInvariant(keeper)(ctx)
+		assert.True(t, broken)
+		assert.Contains(t, msg, evm.Ethereum.Name)
+	}).Repeat(20))
+}
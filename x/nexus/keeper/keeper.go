@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -23,6 +27,13 @@ var (
 	registeredPrefix = utils.KeyFromStr("registered")
 	chainStatePrefix = utils.KeyFromStr("chain_state")
 
+	transferIDPrefix          = utils.KeyFromStr("transfer_id")
+	transferBySenderPrefix    = utils.KeyFromStr("transfer_by_sender")
+	transferByRecipientPrefix = utils.KeyFromStr("transfer_by_recipient")
+	transferFlowPrefix        = utils.KeyFromStr("transfer_flow")
+	feeLedgerPrefix           = utils.KeyFromStr("fee_ledger")
+	distributedFeePrefix      = utils.KeyFromStr("distributed_fee")
+
 	sequenceKey = utils.KeyFromStr("nextID")
 	registered  = []byte{0x01}
 )
@@ -87,6 +98,11 @@ func (k Keeper) GetChains(ctx sdk.Context) []exported.Chain {
 	defer utils.CloseLogError(iter, k.Logger(ctx))
 
 	for ; iter.Valid(); iter.Next() {
+		// chain states share the chain prefix, so they need to be skipped
+		if bytes.HasPrefix(iter.Key(), chainStatePrefix.AsKey()) {
+			continue
+		}
+
 		var chain exported.Chain
 		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &chain)
 		results = append(results, chain)
@@ -111,23 +127,26 @@ func (k Keeper) SetChain(ctx sdk.Context, chain exported.Chain) {
 
 // LinkAddresses links a sender address to a cross-chain recipient address
 func (k Keeper) LinkAddresses(ctx sdk.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress) {
-	k.getStore(ctx).Set(senderPrefix.Append(utils.LowerCaseKey(sender.String())), &recipient)
+	linkedAddresses := types.LinkedAddresses{DepositAddress: sender, RecipientAddress: recipient}
+	k.getStore(ctx).Set(senderPrefix.Append(utils.LowerCaseKey(sender.String())), &linkedAddresses)
 }
 
 // GetRecipient retrieves the cross chain recipient associated to the specified sender
 func (k Keeper) GetRecipient(ctx sdk.Context, sender exported.CrossChainAddress) (exported.CrossChainAddress, bool) {
-	var recp exported.CrossChainAddress
-	ok := k.getStore(ctx).Get(senderPrefix.Append(utils.LowerCaseKey(sender.String())), &recp)
-	return recp, ok
+	var linkedAddresses types.LinkedAddresses
+	ok := k.getStore(ctx).Get(senderPrefix.Append(utils.LowerCaseKey(sender.String())), &linkedAddresses)
+	return linkedAddresses.RecipientAddress, ok
 }
 
-// EnqueueForTransfer appoints the amount of tokens to be transfered/minted to the recipient previously linked to the specified sender
-func (k Keeper) EnqueueForTransfer(ctx sdk.Context, sender exported.CrossChainAddress, asset sdk.Coin, feeRate sdk.Dec) error {
+// EnqueueForTransfer appoints the amount of tokens to be transfered/minted to the recipient previously linked to the specified sender.
+// The given deposit tx ID references the source chain transaction of the deposit and is recorded with the transfer.
+// The fee is determined by the fee schedule of the transfer's route, or by the given fee rate if no schedule applies
+func (k Keeper) EnqueueForTransfer(ctx sdk.Context, sender exported.CrossChainAddress, asset sdk.Coin, feeRate sdk.Dec, depositTxID string) error {
 	if !sender.Chain.SupportsForeignAssets && sender.Chain.NativeAsset != asset.Denom {
 		return fmt.Errorf("sender's chain %s does not support foreign assets", sender.Chain.Name)
 	}
 
-	if sender.Chain.NativeAsset != asset.Denom && k.getChainTotal(ctx, sender.Chain, asset.Denom).IsLT(asset) {
+	if sender.Chain.NativeAsset != asset.Denom && k.GetChainTotal(ctx, sender.Chain, asset.Denom).IsLT(asset) {
 		return fmt.Errorf("not enough funds available for asset '%s' in chain %s", asset.Denom, sender.Chain.Name)
 	}
 
@@ -140,50 +159,82 @@ func (k Keeper) EnqueueForTransfer(ctx sdk.Context, sender exported.CrossChainAd
 		return fmt.Errorf("recipient's chain %s does not support foreign assets", recipient.Chain.Name)
 	}
 
+	for _, chain := range []exported.Chain{sender.Chain, recipient.Chain} {
+		if k.IsTransferFrozen(ctx, chain, asset.Denom) {
+			return fmt.Errorf("transfers of asset '%s' are frozen on chain %s", asset.Denom, chain.Name)
+		}
+	}
+
+	if err := k.recordTransferFlow(ctx, sender.Chain, asset); err != nil {
+		return err
+	}
+
 	// collect fee
 	feeCollector, ok := k.axelarnetKeeper.GetFeeCollector(ctx)
-	feeDue := sdk.NewDecFromInt(asset.Amount).Mul(feeRate).TruncateInt()
+	feeDue := k.computeFee(ctx, sender.Chain, recipient.Chain, asset, feeRate)
+	fee := sdk.NewCoin(asset.Denom, sdk.ZeroInt())
 	if ok && feeDue.IsPositive() {
 		asset.Amount = asset.Amount.Sub(feeDue)
-		fee := sdk.NewCoin(asset.Denom, feeDue)
+		fee = sdk.NewCoin(asset.Denom, feeDue)
 		feeRecipient := exported.CrossChainAddress{Chain: axelarnet.Axelarnet, Address: feeCollector.String()}
-		k.setPendingTransfer(ctx, feeRecipient, fee)
+		k.setPendingTransfer(ctx, sender, feeRecipient, fee, sdk.NewCoin(asset.Denom, sdk.ZeroInt()), depositTxID)
+		k.addCollectedFee(ctx, sender.Chain, recipient.Chain, fee)
 	}
 
 	if sender.Chain.NativeAsset != asset.Denom {
 		k.subtractFromChainTotal(ctx, sender.Chain, asset)
 	}
-	k.setPendingTransfer(ctx, recipient, asset)
-	k.Logger(ctx).Info(fmt.Sprintf("Transfer of %s to cross chain address %s in %s successfully prepared",
-		asset.Amount.String(), recipient.Address, recipient.Chain.Name))
+	id := k.setPendingTransfer(ctx, sender, recipient, asset, fee, depositTxID)
+	k.Logger(ctx).Info(fmt.Sprintf("Transfer %d of %s to cross chain address %s in %s successfully prepared",
+		id, asset.Amount.String(), recipient.Address, recipient.Chain.Name))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueEnqueue),
+			sdk.NewAttribute(types.AttributeKeyTransferID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositTxID, depositTxID),
+		),
+	)
 
 	return nil
 }
 
-// ArchivePendingTransfer marks the transfer for the given recipient as concluded and archived
-func (k Keeper) ArchivePendingTransfer(ctx sdk.Context, transfer exported.CrossChainTransfer) {
+// ArchivePendingTransfer marks the transfer for the given recipient as concluded and archived.
+// The given execution ID references the command or transaction that executed the transfer on the recipient chain
+func (k Keeper) ArchivePendingTransfer(ctx sdk.Context, transfer exported.CrossChainTransfer, executionID string) {
 	store := k.getStore(ctx)
-	key := utils.LowerCaseKey(transfer.Recipient.Chain.Name).
-		Append(utils.LowerCaseKey(strconv.FormatUint(transfer.ID, 10)))
-	bz := store.GetRaw(utils.LowerCaseKey(exported.Pending.String()).Append(key))
-	if bz == nil {
+	pendingKey := getTransferKey(exported.Pending, transfer.Recipient.Chain.Name, transfer.ID)
+
+	var t exported.CrossChainTransfer
+	if ok := store.Get(pendingKey, &t); !ok {
 		return
 	}
 
 	// Archive the transfer
-	store.Delete(utils.LowerCaseKey(exported.Pending.String()).Append(key))
-	store.SetRaw(utils.LowerCaseKey(exported.Archived.String()).Append(key), bz)
+	store.Delete(pendingKey)
+	t.ExecutionID = executionID
+	t.ArchivedAtHeight = ctx.BlockHeight()
+	k.setTransfer(ctx, t, exported.Archived)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueArchive),
+			sdk.NewAttribute(types.AttributeKeyTransferID, strconv.FormatUint(t.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyExecutionID, executionID),
+		),
+	)
 
 	// Update the total nexus for the chain if it is a foreign asset
-	var t exported.CrossChainTransfer
-	k.cdc.MustUnmarshalLengthPrefixed(bz, &t)
 	info, _ := k.GetChain(ctx, t.Recipient.Chain.Name)
 	if info.NativeAsset != t.Asset.Denom {
 		k.AddToChainTotal(ctx, t.Recipient.Chain, t.Asset)
 	}
 }
 
-func (k Keeper) getChainTotal(ctx sdk.Context, chain exported.Chain, denom string) sdk.Coin {
+// GetChainTotal returns the total amount of the given asset that has been transferred to the given chain and not yet withdrawn from it
+func (k Keeper) GetChainTotal(ctx sdk.Context, chain exported.Chain, denom string) sdk.Coin {
 	var total sdk.Coin
 	ok := k.getStore(ctx).Get(totalPrefix.Append(utils.LowerCaseKey(chain.Name)).Append(utils.LowerCaseKey(denom)), &total)
 	if !ok {
@@ -195,37 +246,134 @@ func (k Keeper) getChainTotal(ctx sdk.Context, chain exported.Chain, denom strin
 
 // AddToChainTotal add balance for an asset for a chain
 func (k Keeper) AddToChainTotal(ctx sdk.Context, chain exported.Chain, amount sdk.Coin) {
-	total := k.getChainTotal(ctx, chain, amount.Denom)
+	total := k.GetChainTotal(ctx, chain, amount.Denom)
 	total = total.Add(amount)
 
-	k.getStore(ctx).Set(totalPrefix.Append(utils.LowerCaseKey(chain.Name)).Append(utils.LowerCaseKey(amount.Denom)), &total)
+	k.setChainTotal(ctx, chain.Name, total)
 }
 
 func (k Keeper) subtractFromChainTotal(ctx sdk.Context, chain exported.Chain, withdrawal sdk.Coin) {
-	total := k.getChainTotal(ctx, chain, withdrawal.Denom)
+	total := k.GetChainTotal(ctx, chain, withdrawal.Denom)
 	total = total.Sub(withdrawal)
 
-	k.getStore(ctx).Set(totalPrefix.Append(utils.LowerCaseKey(chain.Name)).Append(utils.LowerCaseKey(withdrawal.Denom)), &total)
+	k.setChainTotal(ctx, chain.Name, total)
+}
+
+func (k Keeper) setChainTotal(ctx sdk.Context, chainName string, total sdk.Coin) {
+	k.getStore(ctx).Set(totalPrefix.Append(utils.LowerCaseKey(chainName)).Append(utils.LowerCaseKey(total.Denom)), &total)
 }
 
-func (k Keeper) setPendingTransfer(ctx sdk.Context, recipient exported.CrossChainAddress, amount sdk.Coin) {
-	var next uint64
+func (k Keeper) setPendingTransfer(ctx sdk.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress, amount sdk.Coin, fee sdk.Coin, depositTxID string) uint64 {
+	next := k.getNonce(ctx)
+	transfer := exported.CrossChainTransfer{
+		Recipient:        recipient,
+		Asset:            amount,
+		ID:               next,
+		Sender:           sender,
+		DepositTxID:      depositTxID,
+		Fee:              fee,
+		EnqueuedAtHeight: ctx.BlockHeight(),
+	}
+	k.setTransfer(ctx, transfer, exported.Pending)
+	k.setNonce(ctx, next+1)
+
+	return next
+}
+
+// setTransfer stores the transfer under the given state and indexes it by ID, sender and recipient
+func (k Keeper) setTransfer(ctx sdk.Context, transfer exported.CrossChainTransfer, state exported.TransferState) {
+	transfer.State = state
+	store := k.getStore(ctx)
+	store.Set(getTransferKey(state, transfer.Recipient.Chain.Name, transfer.ID), &transfer)
+
+	id := sdk.Uint64ToBigEndian(transfer.ID)
+	store.SetRaw(transferIDPrefix.Append(utils.KeyFromBz(id)), []byte(strings.ToLower(transfer.Recipient.Chain.Name)))
+	store.SetRaw(getAddressTransferPrefix(transferByRecipientPrefix, transfer.Recipient).Append(utils.KeyFromBz(id)), id)
+	// transfers imported from before senders were recorded have no sender to index
+	if transfer.Sender.Address != "" {
+		store.SetRaw(getAddressTransferPrefix(transferBySenderPrefix, transfer.Sender).Append(utils.KeyFromBz(id)), id)
+	}
+}
+
+// GetTransfer returns the transfer with the given ID, regardless of its state
+func (k Keeper) GetTransfer(ctx sdk.Context, id uint64) (exported.CrossChainTransfer, bool) {
 	store := k.getStore(ctx)
-	bz := store.GetRaw(sequenceKey)
-	if bz != nil {
-		next = binary.LittleEndian.Uint64(bz)
+
+	chainName := store.GetRaw(transferIDPrefix.Append(utils.KeyFromBz(sdk.Uint64ToBigEndian(id))))
+	if chainName == nil {
+		return exported.CrossChainTransfer{}, false
 	}
 
-	transfer := exported.CrossChainTransfer{Recipient: recipient, Asset: amount, ID: next}
-	key := utils.LowerCaseKey(exported.Pending.String()).
-		Append(utils.LowerCaseKey(recipient.Chain.Name)).
-		Append(utils.LowerCaseKey(strconv.FormatUint(next, 10)))
-	store.Set(key, &transfer)
+	for _, state := range []exported.TransferState{exported.Pending, exported.Archived} {
+		var transfer exported.CrossChainTransfer
+		if ok := store.Get(getTransferKey(state, string(chainName), id), &transfer); ok {
+			return transfer, true
+		}
+	}
 
-	next++
-	bz = make([]byte, 8)
-	binary.LittleEndian.PutUint64(bz, next)
-	store.SetRaw(sequenceKey, bz)
+	return exported.CrossChainTransfer{}, false
+}
+
+// GetTransfersBySenderPaginated returns a page of the transfers sent from the given address
+func (k Keeper) GetTransfersBySenderPaginated(ctx sdk.Context, sender exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	return k.getTransfersByAddressPaginated(ctx, getAddressTransferPrefix(transferBySenderPrefix, sender), pageRequest)
+}
+
+// GetTransfersByRecipientPaginated returns a page of the transfers sent to the given address
+func (k Keeper) GetTransfersByRecipientPaginated(ctx sdk.Context, recipient exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	return k.getTransfersByAddressPaginated(ctx, getAddressTransferPrefix(transferByRecipientPrefix, recipient), pageRequest)
+}
+
+func (k Keeper) getTransfersByAddressPaginated(ctx sdk.Context, addressPrefix utils.Key, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	transfers := make([]exported.CrossChainTransfer, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), addressPrefix.Append(utils.KeyFromStr("")).AsKey())
+
+	pageResponse, err := query.Paginate(store, pageRequest, func(_ []byte, value []byte) error {
+		transfer, ok := k.GetTransfer(ctx, sdk.BigEndianToUint64(value))
+		if !ok {
+			return fmt.Errorf("transfer %d not found", sdk.BigEndianToUint64(value))
+		}
+
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return transfers, pageResponse, nil
+}
+
+// GetTransfersByStatePaginated returns a page of the transfers with the given state for all chains
+func (k Keeper) GetTransfersByStatePaginated(ctx sdk.Context, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	return k.getTransfersPaginated(ctx, utils.LowerCaseKey(state.String()).Append(utils.KeyFromStr("")), pageRequest)
+}
+
+func getTransferKey(state exported.TransferState, chainName string, id uint64) utils.Key {
+	return utils.LowerCaseKey(state.String()).
+		Append(utils.LowerCaseKey(chainName)).
+		Append(utils.LowerCaseKey(strconv.FormatUint(id, 10)))
+}
+
+func getAddressTransferPrefix(addressPrefix utils.Key, address exported.CrossChainAddress) utils.Key {
+	return addressPrefix.
+		Append(utils.LowerCaseKey(address.Chain.Name)).
+		Append(utils.LowerCaseKey(address.Address))
+}
+
+func (k Keeper) getNonce(ctx sdk.Context) uint64 {
+	bz := k.getStore(ctx).GetRaw(sequenceKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(bz)
+}
+
+func (k Keeper) setNonce(ctx sdk.Context, nonce uint64) {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, nonce)
+	k.getStore(ctx).SetRaw(sequenceKey, bz)
 }
 
 // GetTransfersForChain returns the current set of transfers with the given state for the given chain
@@ -246,6 +394,34 @@ func (k Keeper) GetTransfersForChain(ctx sdk.Context, chain exported.Chain, stat
 	return transfers
 }
 
+// GetTransfersForChainPaginated returns a page of the current set of transfers with the given state for the given chain
+func (k Keeper) GetTransfersForChainPaginated(ctx sdk.Context, chain exported.Chain, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	// the trailing delimiter prevents transfers of chains whose names share the same prefix from being included
+	keyPrefix := utils.LowerCaseKey(state.String()).Append(utils.LowerCaseKey(chain.Name)).Append(utils.KeyFromStr(""))
+
+	return k.getTransfersPaginated(ctx, keyPrefix, pageRequest)
+}
+
+func (k Keeper) getTransfersPaginated(ctx sdk.Context, keyPrefix utils.Key, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	transfers := make([]exported.CrossChainTransfer, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix.AsKey())
+
+	pageResponse, err := query.Paginate(store, pageRequest, func(_ []byte, value []byte) error {
+		var transfer exported.CrossChainTransfer
+		if err := k.cdc.UnmarshalLengthPrefixed(value, &transfer); err != nil {
+			return err
+		}
+
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return transfers, pageResponse, nil
+}
+
 func (k Keeper) getChainState(ctx sdk.Context, chain exported.Chain) types.ChainState {
 	key := chainStatePrefix.Append(utils.LowerCaseKey(chain.Name))
 
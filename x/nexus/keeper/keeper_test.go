@@ -48,7 +48,7 @@ func TestLinkNoForeignAssetSupport(t *testing.T) {
 
 	sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
 	keeper.LinkAddresses(ctx, sender, recipient)
-	err := keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(makeRandomDenom()), feeRate)
+	err := keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(makeRandomDenom()), feeRate, rand.Str(64))
 	assert.Error(t, err)
 }
 
@@ -58,14 +58,14 @@ func TestLinkSuccess(t *testing.T) {
 
 	sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
 	keeper.LinkAddresses(ctx, sender, recipient)
-	err := keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate)
+	err := keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64))
 	assert.NoError(t, err)
 	recp, ok := keeper.GetRecipient(ctx, sender)
 	assert.True(t, ok)
 	assert.Equal(t, recipient, recp)
 
 	sender.Address = rand.Str(20)
-	err = keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate)
+	err = keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64))
 	assert.Error(t, err)
 	recp, ok = keeper.GetRecipient(ctx, sender)
 	assert.False(t, ok)
@@ -77,7 +77,7 @@ func TestPrepareNoLink(t *testing.T) {
 	keeper.SetParams(ctx, types.DefaultParams())
 
 	sender, _ := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
-	err := keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate)
+	err := keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64))
 	assert.Error(t, err)
 }
 
@@ -90,7 +90,7 @@ func TestPrepareSuccess(t *testing.T) {
 		sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
 		amounts[recipient] = makeRandAmount(btcTypes.Satoshi)
 		keeper.LinkAddresses(ctx, sender, recipient)
-		err := keeper.EnqueueForTransfer(ctx, sender, amounts[recipient], feeRate)
+		err := keeper.EnqueueForTransfer(ctx, sender, amounts[recipient], feeRate, rand.Str(64))
 		assert.NoError(t, err)
 	}
 
@@ -118,14 +118,14 @@ func TestArchive(t *testing.T) {
 		sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
 		keeper.LinkAddresses(ctx, sender, recipient)
 		amount := makeRandAmount(btcTypes.Satoshi)
-		err := keeper.EnqueueForTransfer(ctx, sender, amount, feeRate)
+		err := keeper.EnqueueForTransfer(ctx, sender, amount, feeRate, rand.Str(64))
 		assert.NoError(t, err)
 	}
 
 	transfers := keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)
 
 	for _, transfer := range transfers {
-		keeper.ArchivePendingTransfer(ctx, transfer)
+		keeper.ArchivePendingTransfer(ctx, transfer, rand.Str(64))
 	}
 
 	archived := keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Archived)
@@ -144,6 +144,52 @@ func TestArchive(t *testing.T) {
 	assert.Equal(t, 0, len(keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)))
 }
 
+func TestTransferLifecycle(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.I64Between(1, 1000000)}, false, log.TestingLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
+	keeper.LinkAddresses(ctx, sender, recipient)
+	amount := makeRandAmount(btcTypes.Satoshi)
+	depositTxID := rand.Str(64)
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, amount, feeRate, depositTxID))
+
+	pending := keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)
+	assert.Len(t, pending, 1)
+
+	transfer, ok := keeper.GetTransfer(ctx, pending[0].ID)
+	assert.True(t, ok)
+	assert.Equal(t, pending[0], transfer)
+	assert.Equal(t, exported.Pending, transfer.State)
+	assert.Equal(t, sender, transfer.Sender)
+	assert.Equal(t, depositTxID, transfer.DepositTxID)
+	assert.Equal(t, ctx.BlockHeight(), transfer.EnqueuedAtHeight)
+	assert.Equal(t, amount, transfer.Asset.Add(transfer.Fee))
+	assert.Equal(t, sdk.NewDecFromInt(amount.Amount).Mul(feeRate).TruncateInt(), transfer.Fee.Amount)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + rand.I64Between(1, 100))
+	executionID := rand.Str(64)
+	keeper.ArchivePendingTransfer(ctx, transfer, executionID)
+
+	transfer, ok = keeper.GetTransfer(ctx, transfer.ID)
+	assert.True(t, ok)
+	assert.Equal(t, exported.Archived, transfer.State)
+	assert.Equal(t, executionID, transfer.ExecutionID)
+	assert.Equal(t, ctx.BlockHeight(), transfer.ArchivedAtHeight)
+
+	bySender, _, err := keeper.GetTransfersBySenderPaginated(ctx, sender, nil)
+	assert.NoError(t, err)
+	// the fee transfer to the fee collector is sent from the same deposit address
+	assert.Len(t, bySender, 2)
+
+	byRecipient, _, err := keeper.GetTransfersByRecipientPaginated(ctx, recipient, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []exported.CrossChainTransfer{transfer}, byRecipient)
+
+	_, ok = keeper.GetTransfer(ctx, transfer.ID+100)
+	assert.False(t, ok)
+}
+
 func TestTotalInvalid(t *testing.T) {
 	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
 	keeper.SetParams(ctx, types.DefaultParams())
@@ -152,13 +198,13 @@ func TestTotalInvalid(t *testing.T) {
 	ethSender, ethRecipient := makeRandAddressesForChain(evm.Ethereum, btc.Bitcoin)
 	keeper.LinkAddresses(ctx, ethSender, ethRecipient)
 
-	err := keeper.EnqueueForTransfer(ctx, btcSender, makeRandAmount(btcTypes.Satoshi), feeRate)
+	err := keeper.EnqueueForTransfer(ctx, btcSender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64))
 	assert.NoError(t, err)
 	transfer := keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)[0]
-	keeper.ArchivePendingTransfer(ctx, transfer)
+	keeper.ArchivePendingTransfer(ctx, transfer, rand.Str(64))
 	total := transfer.Asset.Amount.Int64()
 	amount := sdk.NewCoin(btcTypes.Satoshi, sdk.NewInt(total+rand.I64Between(1, 100000)))
-	err = keeper.EnqueueForTransfer(ctx, ethSender, amount, feeRate)
+	err = keeper.EnqueueForTransfer(ctx, ethSender, amount, feeRate, rand.Str(64))
 	assert.Error(t, err)
 }
 
@@ -171,16 +217,16 @@ func TestTotalSucess(t *testing.T) {
 	ethSender, ethRecipient := makeRandAddressesForChain(evm.Ethereum, btc.Bitcoin)
 	keeper.LinkAddresses(ctx, ethSender, ethRecipient)
 
-	err := keeper.EnqueueForTransfer(ctx, btcSender, makeRandAmount(btcTypes.Satoshi), feeRate)
+	err := keeper.EnqueueForTransfer(ctx, btcSender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64))
 	assert.NoError(t, err)
 	transfer := keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)[0]
-	keeper.ArchivePendingTransfer(ctx, transfer)
+	keeper.ArchivePendingTransfer(ctx, transfer, rand.Str(64))
 	total := transfer.Asset.Amount.Int64()
 	amount := sdk.NewCoin(btcTypes.Satoshi, sdk.NewInt(rand.I64Between(1, total)))
-	err = keeper.EnqueueForTransfer(ctx, ethSender, amount, feeRate)
+	err = keeper.EnqueueForTransfer(ctx, ethSender, amount, feeRate, rand.Str(64))
 	assert.NoError(t, err)
 	amount = sdk.NewCoin(btcTypes.Satoshi, sdk.NewInt(total))
-	err = keeper.EnqueueForTransfer(ctx, ethSender, amount, feeRate)
+	err = keeper.EnqueueForTransfer(ctx, ethSender, amount, feeRate, rand.Str(64))
 	assert.Error(t, err)
 }
 
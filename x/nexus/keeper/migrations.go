@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper           Keeper
+	addressResolvers []types.DepositAddressResolver
+}
+
+// NewMigrator returns a new Migrator. The given resolvers recover the original case of deposit addresses
+// that the store only knows in lower case
+func NewMigrator(k Keeper, addressResolvers ...types.DepositAddressResolver) Migrator {
+	return Migrator{keeper: k, addressResolvers: addressResolvers}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+// Linked addresses used to store only the recipient address, they now store the deposit address alongside it.
+// Transfers get indexed by ID, sender and recipient and record their lifecycle state
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.migrateLinkedAddresses(ctx); err != nil {
+		return err
+	}
+
+	m.migrateTransfers(ctx)
+	return nil
+}
+
+func (m Migrator) migrateTransfers(ctx sdk.Context) {
+	for _, state := range []exported.TransferState{exported.Pending, exported.Archived} {
+		for _, transfer := range m.keeper.getAllTransfers(ctx, state) {
+			// transfers enqueued before fees were recorded had no fee deducted
+			if transfer.Fee.Denom == "" {
+				transfer.Fee = sdk.NewCoin(transfer.Asset.Denom, sdk.ZeroInt())
+			}
+
+			m.keeper.setTransfer(ctx, transfer, state)
+		}
+	}
+}
+
+func (m Migrator) migrateLinkedAddresses(ctx sdk.Context) error {
+	store := m.keeper.getStore(ctx)
+	keyPrefix := senderPrefix.AppendStr("").AsKey()
+
+	iter := store.Iterator(senderPrefix.AppendStr(""))
+	var keys []utils.Key
+	var recipients []exported.CrossChainAddress
+	for ; iter.Valid(); iter.Next() {
+		var recipient exported.CrossChainAddress
+		iter.UnmarshalValue(&recipient)
+
+		keys = append(keys, iter.GetKey())
+		recipients = append(recipients, recipient)
+	}
+	utils.CloseLogError(iter, m.keeper.Logger(ctx))
+
+	for i, key := range keys {
+		chainName, address, err := decodeLinkedDepositAddress(key.AsKey()[len(keyPrefix):])
+		if err != nil {
+			return err
+		}
+
+		chain, ok := m.keeper.GetChain(ctx, chainName)
+		if !ok {
+			return fmt.Errorf("unknown chain %s of linked deposit address %s", chainName, address)
+		}
+
+		depositAddress := m.resolveDepositAddress(ctx, exported.CrossChainAddress{Chain: chain, Address: address})
+		store.Set(key, &types.LinkedAddresses{DepositAddress: depositAddress, RecipientAddress: recipients[i]})
+	}
+
+	return nil
+}
+
+// resolveDepositAddress restores the original case of the given deposit address.
+// Addresses no resolver knows are kept in lower case, which resolves to the same link
+func (m Migrator) resolveDepositAddress(ctx sdk.Context, address exported.CrossChainAddress) exported.CrossChainAddress {
+	for _, resolve := range m.addressResolvers {
+		original, ok := resolve(ctx, address)
+		if ok && strings.EqualFold(original, address.Address) {
+			return exported.CrossChainAddress{Chain: address.Chain, Address: original}
+		}
+	}
+
+	return address
+}
+
+// decodeLinkedDepositAddress decodes the chain name and address from a linked deposit address key,
+// i.e. the lower case text encoding of a CrossChainAddress
+func decodeLinkedDepositAddress(bz []byte) (string, string, error) {
+	str := string(bz)
+	invalidKey := func(reason string) error {
+		return fmt.Errorf("invalid linked deposit address %s: %s", str, reason)
+	}
+
+	rest := strings.TrimPrefix(str, "chain:<")
+	if len(rest) == len(str) {
+		return "", "", invalidKey("missing chain")
+	}
+
+	chainName := ""
+	for {
+		rest = strings.TrimLeft(rest, " ")
+		if strings.HasPrefix(rest, ">") {
+			rest = rest[1:]
+			break
+		}
+
+		sep := strings.Index(rest, ":")
+		if sep < 0 {
+			return "", "", invalidKey("malformed chain field")
+		}
+		field := rest[:sep]
+		rest = rest[sep+1:]
+
+		var value string
+		var err error
+		value, rest, err = decodeTextValue(rest)
+		if err != nil {
+			return "", "", invalidKey(err.Error())
+		}
+
+		if field == "name" {
+			chainName = value
+		}
+	}
+
+	if chainName == "" {
+		return "", "", invalidKey("missing chain name")
+	}
+
+	rest = strings.TrimLeft(rest, " ")
+	if !strings.HasPrefix(rest, "address:") {
+		return "", "", invalidKey("missing address")
+	}
+
+	address, rest, err := decodeTextValue(strings.TrimPrefix(rest, "address:"))
+	if err != nil {
+		return "", "", invalidKey(err.Error())
+	}
+
+	if strings.TrimSpace(rest) != "" {
+		return "", "", invalidKey("unexpected trailing data")
+	}
+
+	return chainName, address, nil
+}
+
+// decodeTextValue decodes a quoted or bare scalar value at the start of the given text and returns the remaining text
+func decodeTextValue(str string) (string, string, error) {
+	if !strings.HasPrefix(str, `"`) {
+		end := strings.IndexAny(str, " >")
+		if end < 0 {
+			end = len(str)
+		}
+
+		return str[:end], str[end:], nil
+	}
+
+	quoted, err := strconv.QuotedPrefix(str)
+	if err != nil {
+		return "", "", err
+	}
+
+	value, err := strconv.Unquote(quoted)
+	if err != nil {
+		return "", "", err
+	}
+
+	return value, str[len(quoted):], nil
+}
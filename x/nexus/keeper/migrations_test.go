@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/utils"
+	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types/mock"
+)
+
+func setupMigration() (Keeper, sdk.Context) {
+	encCfg := appParams.MakeEncodingConfig()
+	subspace := params.NewSubspace(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("nexusKey"), sdk.NewKVStoreKey("tNexusKey"), "nexus")
+	k := NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey("nexus"), subspace, &mock.AxelarnetKeeperMock{})
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	k.SetParams(ctx, types.DefaultParams())
+
+	return k, ctx
+}
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := setupMigration()
+	k.SetChain(ctx, btc.Bitcoin)
+	k.SetChain(ctx, evm.Ethereum)
+
+	// the resolver only knows bitcoin deposit addresses, all others stay in lower case
+	btcAddresses := make(map[string]string)
+	resolver := func(_ sdk.Context, address exported.CrossChainAddress) (string, bool) {
+		original, ok := btcAddresses[address.Address]
+		return original, ok && address.Chain.Name == btc.Bitcoin.Name
+	}
+
+	var links []types.LinkedAddresses
+	for i := 0; i < 20; i++ {
+		sender := exported.CrossChainAddress{Chain: evm.Ethereum, Address: "0x" + rand.HexStr(40)}
+		recipient := exported.CrossChainAddress{Chain: btc.Bitcoin, Address: rand.StrBetween(5, 20)}
+		if i%2 == 0 {
+			sender, recipient = exported.CrossChainAddress{Chain: btc.Bitcoin, Address: rand.StrBetween(5, 20) + `"> address:"`}, sender
+			btcAddresses[strings.ToLower(sender.Address)] = sender.Address
+		}
+
+		// consensus version 1 stored the recipient address only
+		k.getStore(ctx).Set(senderPrefix.Append(utils.LowerCaseKey(sender.String())), &recipient)
+		links = append(links, types.LinkedAddresses{DepositAddress: sender, RecipientAddress: recipient})
+	}
+
+	assert.NoError(t, NewMigrator(k, resolver).Migrate1to2(ctx))
+
+	migrated := k.getAllLinkedAddresses(ctx)
+	assert.Len(t, migrated, len(links))
+	for _, link := range links {
+		recipient, ok := k.GetRecipient(ctx, link.DepositAddress)
+		assert.True(t, ok)
+		assert.Equal(t, link.RecipientAddress, recipient)
+
+		expected := link
+		if expected.DepositAddress.Chain.Name != btc.Bitcoin.Name {
+			expected.DepositAddress.Address = strings.ToLower(expected.DepositAddress.Address)
+		}
+		assert.Contains(t, migrated, expected)
+	}
+
+	// migrated deposit addresses resolve to the same links after a genesis export and import
+	genState := k.ExportGenesis(ctx)
+	ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	k.InitGenesis(ctx, genState)
+	for _, link := range links {
+		recipient, ok := k.GetRecipient(ctx, link.DepositAddress)
+		assert.True(t, ok)
+		assert.Equal(t, link.RecipientAddress, recipient)
+	}
+}
+
+func TestMigrate1to2_UnknownChain(t *testing.T) {
+	k, ctx := setupMigration()
+	k.SetChain(ctx, evm.Ethereum)
+
+	sender := exported.CrossChainAddress{Chain: btc.Bitcoin, Address: rand.StrBetween(5, 20)}
+	recipient := exported.CrossChainAddress{Chain: evm.Ethereum, Address: "0x" + rand.HexStr(40)}
+	k.getStore(ctx).Set(senderPrefix.Append(utils.LowerCaseKey(sender.String())), &recipient)
+
+	assert.Error(t, NewMigrator(k).Migrate1to2(ctx))
+}
+
+func TestMigrate1to2_Transfers(t *testing.T) {
+	k, ctx := setupMigration()
+	k.SetChain(ctx, btc.Bitcoin)
+	k.SetChain(ctx, evm.Ethereum)
+
+	recipient := exported.CrossChainAddress{Chain: evm.Ethereum, Address: "0x" + rand.HexStr(40)}
+	var transfers []exported.CrossChainTransfer
+	for i := 0; i < 20; i++ {
+		state := exported.Pending
+		if i%2 == 0 {
+			state = exported.Archived
+		}
+
+		// consensus version 1 stored transfers without indexes and lifecycle fields
+		transfer := exported.CrossChainTransfer{Recipient: recipient, Asset: sdk.NewInt64Coin(rand.Strings(3, 3).WithAlphabet([]rune("abcdefghijklmnopqrstuvwxyz")).Next(), rand.PosI64()), ID: uint64(i)}
+		k.getStore(ctx).Set(getTransferKey(state, recipient.Chain.Name, transfer.ID), &transfer)
+
+		transfer.State = state
+		transfer.Fee = sdk.NewCoin(transfer.Asset.Denom, sdk.ZeroInt())
+		transfers = append(transfers, transfer)
+	}
+	k.setNonce(ctx, uint64(len(transfers)))
+
+	assert.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	for _, expected := range transfers {
+		transfer, ok := k.GetTransfer(ctx, expected.ID)
+		assert.True(t, ok)
+		assert.Equal(t, expected, transfer)
+	}
+
+	byRecipient, _, err := k.GetTransfersByRecipientPaginated(ctx, recipient, &query.PageRequest{Limit: uint64(len(transfers))})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, transfers, byRecipient)
+
+	assert.NoError(t, k.ExportGenesis(ctx).Validate())
+}
+
+func TestDecodeLinkedDepositAddress(t *testing.T) {
+	address := exported.CrossChainAddress{Chain: btc.Bitcoin, Address: rand.StrBetween(5, 20)}
+
+	chainName, decoded, err := decodeLinkedDepositAddress([]byte(strings.ToLower(address.String())))
+	assert.NoError(t, err)
+	assert.Equal(t, strings.ToLower(btc.Bitcoin.Name), chainName)
+	assert.Equal(t, strings.ToLower(address.Address), decoded)
+
+	for _, invalid := range []string{
+		"",
+		`address:"abc"`,
+		`chain:<native_asset:"satoshi" > address:"abc"`,
+		`chain:<name:"bitcoin" > address:"abc`,
+		`chain:<name:"bitcoin" > address:"abc" unknown:1`,
+	} {
+		_, _, err := decodeLinkedDepositAddress([]byte(invalid))
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+// IsTransferFrozen returns true if transfers of the given asset from and to the given chain are frozen
+func (k Keeper) IsTransferFrozen(ctx sdk.Context, chain exported.Chain, asset string) bool {
+	for _, freeze := range k.GetParams(ctx).TransferFreezes {
+		if freeze.Matches(chain.Name, asset) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k Keeper) getTransferRateLimit(ctx sdk.Context, chain exported.Chain, asset string) (types.TransferRateLimit, bool) {
+	for _, rateLimit := range k.GetParams(ctx).TransferRateLimits {
+		if strings.EqualFold(rateLimit.Chain, chain.Name) && strings.EqualFold(rateLimit.Asset, asset) {
+			return rateLimit, true
+		}
+	}
+
+	return types.TransferRateLimit{}, false
+}
+
+// recordTransferFlow adds the given amount to the outflow of the given chain within the current rate limit window.
+// Returns an error if the outflow would exceed the chain's rate limit for the asset
+func (k Keeper) recordTransferFlow(ctx sdk.Context, chain exported.Chain, amount sdk.Coin) error {
+	rateLimit, ok := k.getTransferRateLimit(ctx, chain, amount.Denom)
+	if !ok {
+		// drop any flows that were recorded while a rate limit was in place
+		k.pruneTransferFlows(ctx, chain, amount.Denom, ctx.BlockHeight()+1)
+		return nil
+	}
+
+	// only flows of the last window blocks (including the current one) count towards the limit
+	k.pruneTransferFlows(ctx, chain, amount.Denom, ctx.BlockHeight()-rateLimit.Window+1)
+
+	total := amount.Amount
+	flow := types.TransferFlow{Chain: chain.Name, Amount: sdk.NewCoin(amount.Denom, sdk.ZeroInt()), Height: ctx.BlockHeight()}
+	for _, f := range k.getTransferFlows(ctx, chain, amount.Denom) {
+		total = total.Add(f.Amount.Amount)
+
+		if f.Height == ctx.BlockHeight() {
+			flow = f
+		}
+	}
+
+	if total.GT(rateLimit.Limit) {
+		return fmt.Errorf("transfer of %s exceeds the rate limit of %s%s per %d blocks on chain %s",
+			amount.String(), rateLimit.Limit.String(), rateLimit.Asset, rateLimit.Window, chain.Name)
+	}
+
+	flow.Amount = flow.Amount.Add(amount)
+	k.setTransferFlow(ctx, flow)
+
+	return nil
+}
+
+func (k Keeper) getTransferFlows(ctx sdk.Context, chain exported.Chain, asset string) []types.TransferFlow {
+	var flows []types.TransferFlow
+
+	iter := k.getStore(ctx).Iterator(getTransferFlowPrefix(chain.Name, asset).AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var flow types.TransferFlow
+		iter.UnmarshalValue(&flow)
+
+		// assets containing the key delimiter can share the prefix with other assets
+		if !strings.EqualFold(flow.Amount.Denom, asset) {
+			continue
+		}
+
+		flows = append(flows, flow)
+	}
+
+	return flows
+}
+
+// pruneTransferFlows deletes all flows of the given asset on the given chain that were recorded before the cutoff height
+func (k Keeper) pruneTransferFlows(ctx sdk.Context, chain exported.Chain, asset string, cutoff int64) {
+	for _, flow := range k.getTransferFlows(ctx, chain, asset) {
+		if flow.Height < cutoff {
+			k.getStore(ctx).Delete(getTransferFlowKey(flow))
+		}
+	}
+}
+
+func (k Keeper) setTransferFlow(ctx sdk.Context, flow types.TransferFlow) {
+	k.getStore(ctx).Set(getTransferFlowKey(flow), &flow)
+}
+
+func (k Keeper) getAllTransferFlows(ctx sdk.Context) []types.TransferFlow {
+	flows := []types.TransferFlow{}
+
+	iter := k.getStore(ctx).Iterator(transferFlowPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var flow types.TransferFlow
+		iter.UnmarshalValue(&flow)
+
+		flows = append(flows, flow)
+	}
+
+	return flows
+}
+
+func getTransferFlowPrefix(chainName string, asset string) utils.StringKey {
+	return transferFlowPrefix.AppendStr(chainName, strings.ToLower).AppendStr(asset, strings.ToLower)
+}
+
+func getTransferFlowKey(flow types.TransferFlow) utils.Key {
+	return getTransferFlowPrefix(flow.Chain, flow.Amount.Denom).Append(utils.KeyFromBz(sdk.Uint64ToBigEndian(uint64(flow.Height))))
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	btc "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	btcTypes "github.com/axelarnetwork/axelar-core/x/bitcoin/types"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+)
+
+func TestTransferRateLimit(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.I64Between(100, 1000000)}, false, log.TestingLogger())
+
+	limit := sdk.NewInt(rand.I64Between(1000, 100000))
+	window := rand.I64Between(2, 100)
+	params := types.DefaultParams()
+	params.TransferRateLimits = []types.TransferRateLimit{{Chain: btc.Bitcoin.Name, Asset: btcTypes.Satoshi, Limit: limit, Window: window}}
+	keeper.SetParams(ctx, params)
+
+	sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
+	keeper.LinkAddresses(ctx, sender, recipient)
+
+	// use up the whole limit in the first block of the window
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewCoin(btcTypes.Satoshi, limit), feeRate, rand.Str(64)))
+	assert.Error(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewInt64Coin(btcTypes.Satoshi, 1), feeRate, rand.Str(64)))
+
+	// the limit still applies in the last block of the window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + window - 1)
+	assert.Error(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewInt64Coin(btcTypes.Satoshi, 1), feeRate, rand.Str(64)))
+
+	// and the previous flow is released once it leaves the window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewCoin(btcTypes.Satoshi, limit.SubRaw(1)), feeRate, rand.Str(64)))
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewInt64Coin(btcTypes.Satoshi, 1), feeRate, rand.Str(64)))
+	assert.Error(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewInt64Coin(btcTypes.Satoshi, 1), feeRate, rand.Str(64)))
+
+	// transfers are not restricted anymore once the rate limit is removed
+	keeper.SetParams(ctx, types.DefaultParams())
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, sdk.NewCoin(btcTypes.Satoshi, limit), feeRate, rand.Str(64)))
+}
+
+func TestTransferFreeze(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+
+	params := types.DefaultParams()
+	params.TransferFreezes = []types.TransferFreeze{{Chain: evm.Ethereum.Name}}
+	keeper.SetParams(ctx, params)
+
+	assert.True(t, keeper.IsTransferFrozen(ctx, evm.Ethereum, btcTypes.Satoshi))
+	assert.True(t, keeper.IsTransferFrozen(ctx, evm.Ethereum, makeRandomDenom()))
+	assert.False(t, keeper.IsTransferFrozen(ctx, btc.Bitcoin, btcTypes.Satoshi))
+
+	sender, recipient := makeRandAddressesForChain(btc.Bitcoin, evm.Ethereum)
+	keeper.LinkAddresses(ctx, sender, recipient)
+	assert.Error(t, keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64)))
+	assert.Len(t, keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending), 0)
+
+	params.TransferFreezes = []types.TransferFreeze{{Chain: btc.Bitcoin.Name, Asset: makeRandomDenom()}}
+	keeper.SetParams(ctx, params)
+	assert.NoError(t, keeper.EnqueueForTransfer(ctx, sender, makeRandAmount(btcTypes.Satoshi), feeRate, rand.Str(64)))
+}
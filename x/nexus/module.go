@@ -1,6 +1,7 @@
 package nexus
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -61,7 +62,11 @@ func (AppModuleBasic) RegisterRESTRoutes(client.Context, *mux.Router) {
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryServiceHandlerClient(context.Background(), mux, types.NewQueryServiceClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd returns all CLI tx commands for this module
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
@@ -76,29 +81,38 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 // AppModule implements module.AppModule
 type AppModule struct {
 	AppModuleBasic
-	keeper      types.Nexus
-	snapshotter types.Snapshotter
-	staking     types.StakingKeeper
+	keeper           keeper.Keeper
+	snapshotter      types.Snapshotter
+	staking          types.StakingKeeper
+	addressResolvers []types.DepositAddressResolver
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(k keeper.Keeper, snapshotter types.Snapshotter, staking types.StakingKeeper) AppModule {
+// NewAppModule creates a new AppModule object. The address resolvers are used by store migrations
+// to recover the original case of linked deposit addresses
+func NewAppModule(k keeper.Keeper, snapshotter types.Snapshotter, staking types.StakingKeeper, addressResolvers ...types.DepositAddressResolver) AppModule {
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{},
-		keeper:         k,
-		snapshotter:    snapshotter,
-		staking:        staking,
+		AppModuleBasic:   AppModuleBasic{},
+		keeper:           k,
+		snapshotter:      snapshotter,
+		staking:          staking,
+		addressResolvers: addressResolvers,
 	}
 }
 
 // RegisterInvariants registers this module's invariants
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
-	// No invariants yet
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
-func (am AppModule) RegisterServices(module.Configurator) {}
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterQueryServiceServer(cfg.QueryServer(), keeper.NewGRPCQuerier(am.keeper))
+
+	if err := cfg.RegisterMigration(types.ModuleName, 1, keeper.NewMigrator(am.keeper, am.addressResolvers...).Migrate1to2); err != nil {
+		panic(err)
+	}
+}
 
 // InitGenesis initializes the module's keeper from the given genesis state
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
@@ -146,4 +160,4 @@ func (am AppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier {
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
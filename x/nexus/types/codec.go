@@ -5,12 +5,14 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers concrete types on codec
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RegisterChainMaintainerRequest{}, "nexus/RegisterChainMaintainer", nil)
 	cdc.RegisterConcrete(&DeregisterChainMaintainerRequest{}, "nexus/DeregisterChainMaintainer", nil)
+	cdc.RegisterConcrete(&RegisterAssetProposal{}, "nexus/RegisterAssetProposal", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -19,6 +21,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&RegisterChainMaintainerRequest{},
 		&DeregisterChainMaintainerRequest{},
 	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&RegisterAssetProposal{},
+	)
 }
 
 var amino = codec.NewLegacyAmino()
@@ -4,12 +4,16 @@ package types
 const (
 	EventTypeChain           = "chain"
 	EventTypeChainMaintainer = "chainMaintainer"
+	EventTypeTransfer        = "transfer"
 )
 
 // Event attribute keys
 const (
 	AttributeKeyChain                  = "chain"
 	AttributeKeyChainMaintainerAddress = "chainMaintainerAddress"
+	AttributeKeyTransferID             = "transferID"
+	AttributeKeyDepositTxID            = "depositTxID"
+	AttributeKeyExecutionID            = "executionID"
 )
 
 // Event attribute values
@@ -17,4 +21,6 @@ const (
 	AttributeValueRegister   = "register"
 	AttributeValueDeregister = "deregister"
 	AttributeValueActivated  = "activated"
+	AttributeValueEnqueue    = "enqueue"
+	AttributeValueArchive    = "archive"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -14,6 +15,9 @@ import (
 type Nexus interface {
 	Logger(ctx sdk.Context) log.Logger
 
+	InitGenesis(ctx sdk.Context, genState *GenesisState)
+	ExportGenesis(ctx sdk.Context) *GenesisState
+
 	SetParams(ctx sdk.Context, p Params)
 	GetParams(ctx sdk.Context) Params
 
@@ -25,6 +29,16 @@ type Nexus interface {
 	AddChainMaintainer(ctx sdk.Context, chain exported.Chain, validator sdk.ValAddress) error
 	RemoveChainMaintainer(ctx sdk.Context, chain exported.Chain, validator sdk.ValAddress) error
 	GetChainMaintainers(ctx sdk.Context, chain exported.Chain) []sdk.ValAddress
+	RegisterAsset(ctx sdk.Context, chainName, denom string)
+	IsAssetRegistered(ctx sdk.Context, chainName, denom string) bool
+	GetTransfersForChainPaginated(ctx sdk.Context, chain exported.Chain, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error)
+	GetTransfer(ctx sdk.Context, id uint64) (exported.CrossChainTransfer, bool)
+	GetTransfersBySenderPaginated(ctx sdk.Context, sender exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error)
+	GetTransfersByRecipientPaginated(ctx sdk.Context, recipient exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error)
+	GetTransfersByStatePaginated(ctx sdk.Context, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error)
+	GetFeeSchedules(ctx sdk.Context, sourceChain string, destinationChain string, asset string) []FeeSchedule
+	GetFeeLedger(ctx sdk.Context, sourceChain string, destinationChain string, asset string) []FeeLedgerEntry
+	GetDistributedFees(ctx sdk.Context) sdk.Coins
 }
 
 // Snapshotter provides functionality to the snapshot module
@@ -42,4 +56,10 @@ type StakingKeeper interface {
 // AxelarnetKeeper provides functionality to the axelarnet module
 type AxelarnetKeeper interface {
 	GetFeeCollector(ctx sdk.Context) (sdk.AccAddress, bool)
+	GetCosmosChains(ctx sdk.Context) []string
+	RegisterAssetToCosmosChain(ctx sdk.Context, asset string, chain string)
 }
+
+// DepositAddressResolver returns the original representation of a deposit address that is only known in lower case,
+// or false if the address is unknown to the resolver
+type DepositAddressResolver func(ctx sdk.Context, lowerCaseAddress exported.CrossChainAddress) (string, bool)
@@ -2,25 +2,230 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 )
 
+// NewGenesisState is the constructor for GenesisState
+func NewGenesisState(p Params, nonce uint64, chains []exported.Chain, chainStates []ChainState, chainAssets []ChainAssets, linkedAddresses []LinkedAddresses, pendingTransfers []exported.CrossChainTransfer, archivedTransfers []exported.CrossChainTransfer, transferFlows []TransferFlow, feeLedger []FeeLedgerEntry, distributedFees sdk.Coins) *GenesisState {
+	return &GenesisState{
+		Params:            p,
+		Nonce:             nonce,
+		Chains:            chains,
+		ChainStates:       chainStates,
+		ChainAssets:       chainAssets,
+		LinkedAddresses:   linkedAddresses,
+		PendingTransfers:  pendingTransfers,
+		ArchivedTransfers: archivedTransfers,
+		TransferFlows:     transferFlows,
+		FeeLedger:         feeLedger,
+		DistributedFees:   distributedFees,
+	}
+}
+
 // DefaultGenesisState creates the default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{DefaultParams()}
+	return NewGenesisState(
+		DefaultParams(),
+		0,
+		[]exported.Chain{},
+		[]ChainState{},
+		[]ChainAssets{},
+		[]LinkedAddresses{},
+		[]exported.CrossChainTransfer{},
+		[]exported.CrossChainTransfer{},
+		[]TransferFlow{},
+		[]FeeLedgerEntry{},
+		sdk.NewCoins(),
+	)
 }
 
 // Validate checks if the genesis state is valid
 func (m GenesisState) Validate() error {
-	if err := m.Params.Validate(); err != nil {
+	if err := m.validate(); err != nil {
 		return sdkerrors.Wrapf(err, "genesis state for module %s is invalid", ModuleName)
 	}
 
 	return nil
 }
 
+func (m GenesisState) validate() error {
+	if err := m.Params.Validate(); err != nil {
+		return err
+	}
+
+	chains := make(map[string]bool)
+	for _, chain := range m.Params.Chains {
+		chains[strings.ToLower(chain.Name)] = true
+	}
+
+	for _, chain := range m.Chains {
+		if err := chain.Validate(); err != nil {
+			return err
+		}
+
+		chains[strings.ToLower(chain.Name)] = true
+	}
+
+	seenChainStates := make(map[string]bool)
+	for _, chainState := range m.ChainStates {
+		chainName := strings.ToLower(chainState.Chain.Name)
+		if !chains[chainName] {
+			return fmt.Errorf("chain state refers to unknown chain %s", chainState.Chain.Name)
+		}
+
+		if seenChainStates[chainName] {
+			return fmt.Errorf("duplicate chain state for chain %s", chainState.Chain.Name)
+		}
+		seenChainStates[chainName] = true
+
+		seenMaintainers := make(map[string]bool)
+		for _, maintainer := range chainState.Maintainers {
+			if err := sdk.VerifyAddressFormat(maintainer); err != nil {
+				return sdkerrors.Wrapf(err, "invalid maintainer for chain %s", chainState.Chain.Name)
+			}
+
+			if seenMaintainers[maintainer.String()] {
+				return fmt.Errorf("duplicate maintainer %s for chain %s", maintainer.String(), chainState.Chain.Name)
+			}
+			seenMaintainers[maintainer.String()] = true
+		}
+	}
+
+	seenChainAssets := make(map[string]bool)
+	for _, chainAssets := range m.ChainAssets {
+		chainName := strings.ToLower(chainAssets.Chain)
+		if !chains[chainName] {
+			return fmt.Errorf("assets refer to unknown chain %s", chainAssets.Chain)
+		}
+
+		if seenChainAssets[chainName] {
+			return fmt.Errorf("duplicate assets for chain %s", chainAssets.Chain)
+		}
+		seenChainAssets[chainName] = true
+
+		for _, asset := range chainAssets.Assets {
+			if err := sdk.ValidateDenom(asset); err != nil {
+				return sdkerrors.Wrapf(err, "invalid asset registered for chain %s", chainAssets.Chain)
+			}
+		}
+
+		if err := chainAssets.Totals.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid totals for chain %s", chainAssets.Chain)
+		}
+	}
+
+	depositAddresses := make(map[string]bool)
+	for _, linkedAddresses := range m.LinkedAddresses {
+		if err := validateCrossChainAddress(linkedAddresses.DepositAddress, chains); err != nil {
+			return sdkerrors.Wrap(err, "invalid deposit address")
+		}
+
+		if err := validateCrossChainAddress(linkedAddresses.RecipientAddress, chains); err != nil {
+			return sdkerrors.Wrap(err, "invalid recipient address")
+		}
+
+		depositAddress := strings.ToLower(linkedAddresses.DepositAddress.String())
+		if depositAddresses[depositAddress] {
+			return fmt.Errorf("deposit address %s is linked more than once", linkedAddresses.DepositAddress.Address)
+		}
+		depositAddresses[depositAddress] = true
+	}
+
+	transferIDs := make(map[uint64]bool)
+	for _, transfers := range [][]exported.CrossChainTransfer{m.PendingTransfers, m.ArchivedTransfers} {
+		for _, transfer := range transfers {
+			if err := validateCrossChainAddress(transfer.Recipient, chains); err != nil {
+				return sdkerrors.Wrapf(err, "invalid recipient for transfer %d", transfer.ID)
+			}
+
+			if err := transfer.Asset.Validate(); err != nil {
+				return sdkerrors.Wrapf(err, "invalid asset for transfer %d", transfer.ID)
+			}
+
+			// transfers enqueued before senders and fees were recorded leave these fields empty
+			if transfer.Sender.Address != "" {
+				if err := validateCrossChainAddress(transfer.Sender, chains); err != nil {
+					return sdkerrors.Wrapf(err, "invalid sender for transfer %d", transfer.ID)
+				}
+			}
+
+			if transfer.Fee.Denom != "" {
+				if err := transfer.Fee.Validate(); err != nil {
+					return sdkerrors.Wrapf(err, "invalid fee for transfer %d", transfer.ID)
+				}
+			}
+
+			if transfer.ID >= m.Nonce {
+				return fmt.Errorf("transfer ID %d must be less than the nonce %d", transfer.ID, m.Nonce)
+			}
+
+			if transferIDs[transfer.ID] {
+				return fmt.Errorf("duplicate transfer ID %d", transfer.ID)
+			}
+			transferIDs[transfer.ID] = true
+		}
+	}
+
+	for _, flow := range m.TransferFlows {
+		if err := flow.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid transfer flow of chain %s", flow.Chain)
+		}
+
+		if !chains[strings.ToLower(flow.Chain)] {
+			return fmt.Errorf("transfer flow refers to unknown chain %s", flow.Chain)
+		}
+	}
+
+	collectedFees := sdk.NewCoins()
+	seenFeeLedgerEntries := make(map[string]bool)
+	for _, entry := range m.FeeLedger {
+		if err := entry.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid fee ledger entry from chain %s to chain %s", entry.SourceChain, entry.DestinationChain)
+		}
+
+		if !chains[strings.ToLower(entry.SourceChain)] || !chains[strings.ToLower(entry.DestinationChain)] {
+			return fmt.Errorf("fee ledger entry refers to unknown chain")
+		}
+
+		key := strings.ToLower(fmt.Sprintf("%s_%s_%s", entry.SourceChain, entry.DestinationChain, entry.Collected.Denom))
+		if seenFeeLedgerEntries[key] {
+			return fmt.Errorf("duplicate fee ledger entry for asset %s from chain %s to chain %s", entry.Collected.Denom, entry.SourceChain, entry.DestinationChain)
+		}
+		seenFeeLedgerEntries[key] = true
+
+		collectedFees = collectedFees.Add(entry.Collected)
+	}
+
+	if err := m.DistributedFees.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid distributed fees")
+	}
+
+	if !collectedFees.IsAllGTE(m.DistributedFees) {
+		return fmt.Errorf("distributed fees %s exceed the collected fees %s", m.DistributedFees.String(), collectedFees.String())
+	}
+
+	return nil
+}
+
+func validateCrossChainAddress(address exported.CrossChainAddress, chains map[string]bool) error {
+	if !chains[strings.ToLower(address.Chain.Name)] {
+		return fmt.Errorf("unknown chain %s", address.Chain.Name)
+	}
+
+	if address.Address == "" {
+		return fmt.Errorf("missing address")
+	}
+
+	return nil
+}
+
 // GetGenesisStateFromAppState returns x/nexus GenesisState given raw application
 // genesis state.
 func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) GenesisState {
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,7 +28,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState represents the genesis state
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params            Params                                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Nonce             uint64                                   `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Chains            []exported.Chain                         `protobuf:"bytes,3,rep,name=chains,proto3" json:"chains"`
+	ChainStates       []ChainState                             `protobuf:"bytes,4,rep,name=chain_states,json=chainStates,proto3" json:"chain_states"`
+	ChainAssets       []ChainAssets                            `protobuf:"bytes,5,rep,name=chain_assets,json=chainAssets,proto3" json:"chain_assets"`
+	LinkedAddresses   []LinkedAddresses                        `protobuf:"bytes,6,rep,name=linked_addresses,json=linkedAddresses,proto3" json:"linked_addresses"`
+	PendingTransfers  []exported.CrossChainTransfer            `protobuf:"bytes,7,rep,name=pending_transfers,json=pendingTransfers,proto3" json:"pending_transfers"`
+	ArchivedTransfers []exported.CrossChainTransfer            `protobuf:"bytes,8,rep,name=archived_transfers,json=archivedTransfers,proto3" json:"archived_transfers"`
+	TransferFlows     []TransferFlow                           `protobuf:"bytes,9,rep,name=transfer_flows,json=transferFlows,proto3" json:"transfer_flows"`
+	FeeLedger         []FeeLedgerEntry                         `protobuf:"bytes,10,rep,name=fee_ledger,json=feeLedger,proto3" json:"fee_ledger"`
+	DistributedFees   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=distributed_fees,json=distributedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,20 +81,42 @@ func init() {
 func init() { proto.RegisterFile("nexus/v1beta1/genesis.proto", fileDescriptor_d58ead19bb1ba601) }
 
 var fileDescriptor_d58ead19bb1ba601 = []byte{
-	// 206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4b, 0xad, 0x28,
-	0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x05, 0x4b, 0xea, 0x41, 0x25, 0xa5, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x32, 0xfa, 0x20, 0x16, 0x44, 0x91, 0x94, 0x14, 0xaa, 0x09, 0x05,
-	0x89, 0x45, 0x89, 0xb9, 0x50, 0x03, 0x94, 0x9c, 0xb9, 0x78, 0xdc, 0x21, 0x26, 0x06, 0x97, 0x24,
-	0x96, 0xa4, 0x0a, 0x19, 0x73, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x44,
-	0xf5, 0x50, 0x6c, 0xd0, 0x0b, 0x00, 0x4b, 0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55,
-	0xea, 0x14, 0x70, 0xe2, 0xa1, 0x1c, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0x19, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27, 0x56, 0xa4,
-	0xe6, 0x24, 0x16, 0xe5, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0x43, 0x79, 0xba, 0xc9, 0xf9, 0x45,
-	0xa9, 0xfa, 0x15, 0xfa, 0x10, 0x57, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x67,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x7b, 0x7b, 0xce, 0xfd, 0x00, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0xda, 0x06, 0xba, 0x69, 0x69, 0x6a, 0x15, 0xc9, 0x4d, 0x85, 0x1b, 0xf5, 0x14,
+	0x21, 0xd5, 0xa6, 0xe9, 0x91, 0x53, 0x53, 0x11, 0x38, 0x54, 0xa2, 0x0a, 0x9c, 0x90, 0x90, 0xb5,
+	0xb1, 0x27, 0x8e, 0x15, 0x67, 0x37, 0xda, 0xd9, 0x7c, 0xf4, 0x2d, 0x78, 0x0e, 0x1e, 0x80, 0x67,
+	0xc8, 0xb1, 0x47, 0x4e, 0x7c, 0x24, 0x2f, 0x82, 0xbc, 0xde, 0x4d, 0xe3, 0xa8, 0x5c, 0x38, 0x79,
+	0x77, 0xfe, 0xff, 0xf9, 0xcd, 0xec, 0x7a, 0x87, 0x9c, 0x30, 0x98, 0x8d, 0xd1, 0x9f, 0x5c, 0x74,
+	0x41, 0xd2, 0x0b, 0x3f, 0x06, 0x06, 0x98, 0xa0, 0x37, 0x12, 0x5c, 0x72, 0x7b, 0x5f, 0x89, 0x9e,
+	0x16, 0x6b, 0x47, 0x31, 0x8f, 0xb9, 0x52, 0xfc, 0x6c, 0x95, 0x9b, 0x6a, 0x6e, 0xc8, 0x71, 0xc8,
+	0xd1, 0xef, 0x52, 0x84, 0x15, 0x27, 0xe4, 0x09, 0xd3, 0x7a, 0xad, 0x58, 0x61, 0x44, 0x05, 0x1d,
+	0xea, 0x02, 0xb5, 0xe3, 0xa2, 0x26, 0xef, 0x46, 0x60, 0xa4, 0xb3, 0x5c, 0x82, 0xd9, 0x88, 0x0b,
+	0x09, 0xd1, 0x63, 0x9e, 0xb3, 0xef, 0x65, 0xb2, 0xf7, 0x2e, 0xef, 0xf8, 0xa3, 0xa4, 0x12, 0xec,
+	0x4b, 0x52, 0xce, 0xf9, 0x8e, 0x55, 0xb7, 0x1a, 0x95, 0xe6, 0x0b, 0xaf, 0x70, 0x02, 0xef, 0x56,
+	0x89, 0xad, 0xed, 0xf9, 0xcf, 0xd3, 0x52, 0x47, 0x5b, 0xed, 0x23, 0xb2, 0xc3, 0x38, 0x0b, 0xc1,
+	0x79, 0x52, 0xb7, 0x1a, 0xdb, 0x9d, 0x7c, 0x63, 0xbf, 0x21, 0xe5, 0xb0, 0x4f, 0x13, 0x86, 0xce,
+	0x56, 0x7d, 0xab, 0x51, 0x69, 0xbe, 0xd4, 0x28, 0xd3, 0xd0, 0x8a, 0x79, 0x9d, 0xb9, 0x0c, 0x32,
+	0x4f, 0xb1, 0x5b, 0x64, 0x4f, 0xad, 0x02, 0xcc, 0xda, 0x42, 0x67, 0x5b, 0x21, 0x8e, 0x37, 0xba,
+	0x51, 0x99, 0xaa, 0x71, 0x9d, 0x5e, 0x09, 0x57, 0x11, 0xb4, 0xaf, 0x0d, 0x83, 0x22, 0x82, 0x44,
+	0x67, 0x47, 0x31, 0x6a, 0x8f, 0x31, 0xae, 0x94, 0xa3, 0x00, 0xc9, 0x43, 0xf6, 0x07, 0x52, 0x4d,
+	0x13, 0x36, 0x80, 0x28, 0xa0, 0x51, 0x24, 0x00, 0x11, 0xd0, 0x29, 0x2b, 0x90, 0xbb, 0x01, 0xba,
+	0x51, 0xb6, 0x2b, 0xe3, 0xd2, 0xb0, 0x83, 0xb4, 0x18, 0xb6, 0xbf, 0x90, 0xc3, 0x11, 0xb0, 0x28,
+	0x61, 0x71, 0x20, 0x05, 0x65, 0xd8, 0x03, 0x81, 0xce, 0x53, 0x45, 0x7c, 0xf5, 0xcf, 0x1b, 0x12,
+	0x1c, 0x51, 0x35, 0xfa, 0x49, 0xa7, 0x68, 0x7a, 0x55, 0xa3, 0x4c, 0x18, 0xed, 0x80, 0xd8, 0x54,
+	0x84, 0xfd, 0x64, 0x02, 0xd1, 0x1a, 0xff, 0xd9, 0x7f, 0xf2, 0x0f, 0x0d, 0xeb, 0xa1, 0xc0, 0x7b,
+	0xf2, 0xdc, 0x70, 0x83, 0x5e, 0xca, 0xa7, 0xe8, 0xec, 0x2a, 0xf8, 0xc9, 0xc6, 0x75, 0x98, 0x8c,
+	0x76, 0xca, 0xa7, 0x9a, 0xb6, 0x2f, 0xd7, 0x62, 0xd9, 0x3f, 0x26, 0x3d, 0x80, 0x20, 0x85, 0x28,
+	0x06, 0xe1, 0x90, 0xc2, 0x23, 0x31, 0x94, 0x36, 0xc0, 0x8d, 0xd2, 0xdf, 0x32, 0x29, 0xee, 0x34,
+	0x67, 0xb7, 0x67, 0xa2, 0xf6, 0x84, 0x54, 0xa3, 0x04, 0xa5, 0x48, 0xba, 0x63, 0x09, 0x51, 0xd0,
+	0x03, 0x40, 0xa7, 0xa2, 0xdf, 0x4a, 0x3e, 0x56, 0x5e, 0x36, 0x56, 0x0f, 0x27, 0xe5, 0x09, 0x6b,
+	0xbd, 0xce, 0x28, 0xdf, 0x7e, 0x9d, 0x36, 0xe2, 0x44, 0xf6, 0xc7, 0x5d, 0x2f, 0xe4, 0x43, 0x5f,
+	0xcf, 0x60, 0xfe, 0x39, 0xc7, 0x68, 0xa0, 0xe7, 0x24, 0x4b, 0xc0, 0xce, 0xc1, 0x5a, 0x91, 0x36,
+	0x00, 0xb6, 0x6e, 0xe7, 0x7f, 0xdc, 0xd2, 0x7c, 0xe1, 0x5a, 0xf7, 0x0b, 0xd7, 0xfa, 0xbd, 0x70,
+	0xad, 0xaf, 0x4b, 0xb7, 0x74, 0xbf, 0x74, 0x4b, 0x3f, 0x96, 0x6e, 0xe9, 0x73, 0x73, 0x0d, 0x4c,
+	0x67, 0x90, 0x52, 0xc1, 0x40, 0x4e, 0xb9, 0x18, 0xe8, 0xdd, 0x79, 0xc8, 0x05, 0xf8, 0x33, 0x3f,
+	0x9f, 0x50, 0x55, 0xa8, 0x5b, 0x56, 0x13, 0x79, 0xf9, 0x77, 0x00, 0x83, 0xfc, 0x8d, 0xf4, 0x50,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -104,6 +139,137 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DistributedFees) > 0 {
+		for iNdEx := len(m.DistributedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FeeLedger) > 0 {
+		for iNdEx := len(m.FeeLedger) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLedger[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TransferFlows) > 0 {
+		for iNdEx := len(m.TransferFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ArchivedTransfers) > 0 {
+		for iNdEx := len(m.ArchivedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PendingTransfers) > 0 {
+		for iNdEx := len(m.PendingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LinkedAddresses) > 0 {
+		for iNdEx := len(m.LinkedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LinkedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChainAssets) > 0 {
+		for iNdEx := len(m.ChainAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ChainStates) > 0 {
+		for iNdEx := len(m.ChainStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -136,6 +302,63 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainStates) > 0 {
+		for _, e := range m.ChainStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainAssets) > 0 {
+		for _, e := range m.ChainAssets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LinkedAddresses) > 0 {
+		for _, e := range m.LinkedAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTransfers) > 0 {
+		for _, e := range m.PendingTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedTransfers) > 0 {
+		for _, e := range m.ArchivedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferFlows) > 0 {
+		for _, e := range m.TransferFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeLedger) > 0 {
+		for _, e := range m.FeeLedger {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributedFees) > 0 {
+		for _, e := range m.DistributedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -207,6 +430,331 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, exported.Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainStates = append(m.ChainStates, ChainState{})
+			if err := m.ChainStates[len(m.ChainStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainAssets = append(m.ChainAssets, ChainAssets{})
+			if err := m.ChainAssets[len(m.ChainAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkedAddresses = append(m.LinkedAddresses, LinkedAddresses{})
+			if err := m.LinkedAddresses[len(m.LinkedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTransfers = append(m.PendingTransfers, exported.CrossChainTransfer{})
+			if err := m.PendingTransfers[len(m.PendingTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedTransfers = append(m.ArchivedTransfers, exported.CrossChainTransfer{})
+			if err := m.ArchivedTransfers[len(m.ArchivedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFlows = append(m.TransferFlows, TransferFlow{})
+			if err := m.TransferFlows[len(m.TransferFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLedger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLedger = append(m.FeeLedger, FeeLedgerEntry{})
+			if err := m.FeeLedger[len(m.FeeLedger)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedFees = append(m.DistributedFees, types.Coin{})
+			if err := m.DistributedFees[len(m.DistributedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,195 +5,382 @@ package mock
 
 import (
 	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
-	"github.com/axelarnetwork/axelar-core/x/nexus/types"
-	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	cosmossdktypes "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/tendermint/tendermint/libs/log"
 	"sync"
 )
 
-// Ensure, that NexusMock does implement types.Nexus.
+// Ensure, that NexusMock does implement nexustypes.Nexus.
 // If this is not the case, regenerate this file with moq.
-var _ types.Nexus = &NexusMock{}
+var _ nexustypes.Nexus = &NexusMock{}
 
-// NexusMock is a mock implementation of types.Nexus.
+// NexusMock is a mock implementation of nexustypes.Nexus.
 //
 // 	func TestSomethingThatUsesNexus(t *testing.T) {
 //
-// 		// make and configure a mocked types.Nexus
+// 		// make and configure a mocked nexustypes.Nexus
 // 		mockedNexus := &NexusMock{
-// 			ActivateChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain)  {
+// 			ActivateChainFunc: func(ctx cosmossdktypes.Context, chain exported.Chain)  {
 // 				panic("mock out the ActivateChain method")
 // 			},
-// 			AddChainMaintainerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, validator github_com_cosmos_cosmos_sdk_types.ValAddress) error {
+// 			AddChainMaintainerFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error {
 // 				panic("mock out the AddChainMaintainer method")
 // 			},
-// 			GetChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (exported.Chain, bool) {
+// 			ExportGenesisFunc: func(ctx cosmossdktypes.Context) *nexustypes.GenesisState {
+// 				panic("mock out the ExportGenesis method")
+// 			},
+// 			GetChainFunc: func(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool) {
 // 				panic("mock out the GetChain method")
 // 			},
-// 			GetChainMaintainersFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress {
+// 			GetChainMaintainersFunc: func(ctx cosmossdktypes.Context, chain exported.Chain) []cosmossdktypes.ValAddress {
 // 				panic("mock out the GetChainMaintainers method")
 // 			},
-// 			GetChainsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []exported.Chain {
+// 			GetChainsFunc: func(ctx cosmossdktypes.Context) []exported.Chain {
 // 				panic("mock out the GetChains method")
 // 			},
-// 			GetParamsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Params {
+// 			GetDistributedFeesFunc: func(ctx cosmossdktypes.Context) cosmossdktypes.Coins {
+// 				panic("mock out the GetDistributedFees method")
+// 			},
+// 			GetFeeLedgerFunc: func(ctx cosmossdktypes.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeLedgerEntry {
+// 				panic("mock out the GetFeeLedger method")
+// 			},
+// 			GetFeeSchedulesFunc: func(ctx cosmossdktypes.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeSchedule {
+// 				panic("mock out the GetFeeSchedules method")
+// 			},
+// 			GetParamsFunc: func(ctx cosmossdktypes.Context) nexustypes.Params {
 // 				panic("mock out the GetParams method")
 // 			},
-// 			IsChainActivatedFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) bool {
+// 			GetTransferFunc: func(ctx cosmossdktypes.Context, id uint64) (exported.CrossChainTransfer, bool) {
+// 				panic("mock out the GetTransfer method")
+// 			},
+// 			GetTransfersByRecipientPaginatedFunc: func(ctx cosmossdktypes.Context, recipient exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+// 				panic("mock out the GetTransfersByRecipientPaginated method")
+// 			},
+// 			GetTransfersBySenderPaginatedFunc: func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+// 				panic("mock out the GetTransfersBySenderPaginated method")
+// 			},
+// 			GetTransfersByStatePaginatedFunc: func(ctx cosmossdktypes.Context, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+// 				panic("mock out the GetTransfersByStatePaginated method")
+// 			},
+// 			GetTransfersForChainPaginatedFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+// 				panic("mock out the GetTransfersForChainPaginated method")
+// 			},
+// 			InitGenesisFunc: func(ctx cosmossdktypes.Context, genState *nexustypes.GenesisState)  {
+// 				panic("mock out the InitGenesis method")
+// 			},
+// 			IsAssetRegisteredFunc: func(ctx cosmossdktypes.Context, chainName string, denom string) bool {
+// 				panic("mock out the IsAssetRegistered method")
+// 			},
+// 			IsChainActivatedFunc: func(ctx cosmossdktypes.Context, chain exported.Chain) bool {
 // 				panic("mock out the IsChainActivated method")
 // 			},
-// 			IsChainMaintainerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, maintainer github_com_cosmos_cosmos_sdk_types.ValAddress) bool {
+// 			IsChainMaintainerFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, maintainer cosmossdktypes.ValAddress) bool {
 // 				panic("mock out the IsChainMaintainer method")
 // 			},
-// 			LoggerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
+// 			LoggerFunc: func(ctx cosmossdktypes.Context) log.Logger {
 // 				panic("mock out the Logger method")
 // 			},
-// 			RemoveChainMaintainerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, validator github_com_cosmos_cosmos_sdk_types.ValAddress) error {
+// 			RegisterAssetFunc: func(ctx cosmossdktypes.Context, chainName string, denom string)  {
+// 				panic("mock out the RegisterAsset method")
+// 			},
+// 			RemoveChainMaintainerFunc: func(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error {
 // 				panic("mock out the RemoveChainMaintainer method")
 // 			},
-// 			SetParamsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params)  {
+// 			SetParamsFunc: func(ctx cosmossdktypes.Context, p nexustypes.Params)  {
 // 				panic("mock out the SetParams method")
 // 			},
 // 		}
 //
-// 		// use mockedNexus in code that requires types.Nexus
+// 		// use mockedNexus in code that requires nexustypes.Nexus
 // 		// and then make assertions.
 //
 // 	}
 type NexusMock struct {
 	// ActivateChainFunc mocks the ActivateChain method.
-	ActivateChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain)
+	ActivateChainFunc func(ctx cosmossdktypes.Context, chain exported.Chain)
 
 	// AddChainMaintainerFunc mocks the AddChainMaintainer method.
-	AddChainMaintainerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, validator github_com_cosmos_cosmos_sdk_types.ValAddress) error
+	AddChainMaintainerFunc func(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error
+
+	// ExportGenesisFunc mocks the ExportGenesis method.
+	ExportGenesisFunc func(ctx cosmossdktypes.Context) *nexustypes.GenesisState
 
 	// GetChainFunc mocks the GetChain method.
-	GetChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (exported.Chain, bool)
+	GetChainFunc func(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool)
 
 	// GetChainMaintainersFunc mocks the GetChainMaintainers method.
-	GetChainMaintainersFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress
+	GetChainMaintainersFunc func(ctx cosmossdktypes.Context, chain exported.Chain) []cosmossdktypes.ValAddress
 
 	// GetChainsFunc mocks the GetChains method.
-	GetChainsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []exported.Chain
+	GetChainsFunc func(ctx cosmossdktypes.Context) []exported.Chain
+
+	// GetDistributedFeesFunc mocks the GetDistributedFees method.
+	GetDistributedFeesFunc func(ctx cosmossdktypes.Context) cosmossdktypes.Coins
+
+	// GetFeeLedgerFunc mocks the GetFeeLedger method.
+	GetFeeLedgerFunc func(ctx cosmossdktypes.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeLedgerEntry
+
+	// GetFeeSchedulesFunc mocks the GetFeeSchedules method.
+	GetFeeSchedulesFunc func(ctx cosmossdktypes.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeSchedule
 
 	// GetParamsFunc mocks the GetParams method.
-	GetParamsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Params
+	GetParamsFunc func(ctx cosmossdktypes.Context) nexustypes.Params
+
+	// GetTransferFunc mocks the GetTransfer method.
+	GetTransferFunc func(ctx cosmossdktypes.Context, id uint64) (exported.CrossChainTransfer, bool)
+
+	// GetTransfersByRecipientPaginatedFunc mocks the GetTransfersByRecipientPaginated method.
+	GetTransfersByRecipientPaginatedFunc func(ctx cosmossdktypes.Context, recipient exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error)
+
+	// GetTransfersBySenderPaginatedFunc mocks the GetTransfersBySenderPaginated method.
+	GetTransfersBySenderPaginatedFunc func(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error)
+
+	// GetTransfersByStatePaginatedFunc mocks the GetTransfersByStatePaginated method.
+	GetTransfersByStatePaginatedFunc func(ctx cosmossdktypes.Context, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error)
+
+	// GetTransfersForChainPaginatedFunc mocks the GetTransfersForChainPaginated method.
+	GetTransfersForChainPaginatedFunc func(ctx cosmossdktypes.Context, chain exported.Chain, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error)
+
+	// InitGenesisFunc mocks the InitGenesis method.
+	InitGenesisFunc func(ctx cosmossdktypes.Context, genState *nexustypes.GenesisState)
+
+	// IsAssetRegisteredFunc mocks the IsAssetRegistered method.
+	IsAssetRegisteredFunc func(ctx cosmossdktypes.Context, chainName string, denom string) bool
 
 	// IsChainActivatedFunc mocks the IsChainActivated method.
-	IsChainActivatedFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) bool
+	IsChainActivatedFunc func(ctx cosmossdktypes.Context, chain exported.Chain) bool
 
 	// IsChainMaintainerFunc mocks the IsChainMaintainer method.
-	IsChainMaintainerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, maintainer github_com_cosmos_cosmos_sdk_types.ValAddress) bool
+	IsChainMaintainerFunc func(ctx cosmossdktypes.Context, chain exported.Chain, maintainer cosmossdktypes.ValAddress) bool
 
 	// LoggerFunc mocks the Logger method.
-	LoggerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger
+	LoggerFunc func(ctx cosmossdktypes.Context) log.Logger
+
+	// RegisterAssetFunc mocks the RegisterAsset method.
+	RegisterAssetFunc func(ctx cosmossdktypes.Context, chainName string, denom string)
 
 	// RemoveChainMaintainerFunc mocks the RemoveChainMaintainer method.
-	RemoveChainMaintainerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, validator github_com_cosmos_cosmos_sdk_types.ValAddress) error
+	RemoveChainMaintainerFunc func(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error
 
 	// SetParamsFunc mocks the SetParams method.
-	SetParamsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params)
+	SetParamsFunc func(ctx cosmossdktypes.Context, p nexustypes.Params)
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// ActivateChain holds details about calls to the ActivateChain method.
 		ActivateChain []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
 			// Chain is the chain argument value.
 			Chain exported.Chain
 		}
 		// AddChainMaintainer holds details about calls to the AddChainMaintainer method.
 		AddChainMaintainer []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
 			// Chain is the chain argument value.
 			Chain exported.Chain
 			// Validator is the validator argument value.
-			Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+			Validator cosmossdktypes.ValAddress
+		}
+		// ExportGenesis holds details about calls to the ExportGenesis method.
+		ExportGenesis []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
 		}
 		// GetChain holds details about calls to the GetChain method.
 		GetChain []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
 			// Chain is the chain argument value.
 			Chain string
 		}
 		// GetChainMaintainers holds details about calls to the GetChainMaintainers method.
 		GetChainMaintainers []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
 			// Chain is the chain argument value.
 			Chain exported.Chain
 		}
 		// GetChains holds details about calls to the GetChains method.
 		GetChains []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
+		}
+		// GetDistributedFees holds details about calls to the GetDistributedFees method.
+		GetDistributedFees []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+		}
+		// GetFeeLedger holds details about calls to the GetFeeLedger method.
+		GetFeeLedger []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// SourceChain is the sourceChain argument value.
+			SourceChain string
+			// DestinationChain is the destinationChain argument value.
+			DestinationChain string
+			// Asset is the asset argument value.
+			Asset string
+		}
+		// GetFeeSchedules holds details about calls to the GetFeeSchedules method.
+		GetFeeSchedules []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// SourceChain is the sourceChain argument value.
+			SourceChain string
+			// DestinationChain is the destinationChain argument value.
+			DestinationChain string
+			// Asset is the asset argument value.
+			Asset string
 		}
 		// GetParams holds details about calls to the GetParams method.
 		GetParams []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
+		}
+		// GetTransfer holds details about calls to the GetTransfer method.
+		GetTransfer []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// ID is the id argument value.
+			ID uint64
+		}
+		// GetTransfersByRecipientPaginated holds details about calls to the GetTransfersByRecipientPaginated method.
+		GetTransfersByRecipientPaginated []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Recipient is the recipient argument value.
+			Recipient exported.CrossChainAddress
+			// PageRequest is the pageRequest argument value.
+			PageRequest *query.PageRequest
+		}
+		// GetTransfersBySenderPaginated holds details about calls to the GetTransfersBySenderPaginated method.
+		GetTransfersBySenderPaginated []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Sender is the sender argument value.
+			Sender exported.CrossChainAddress
+			// PageRequest is the pageRequest argument value.
+			PageRequest *query.PageRequest
+		}
+		// GetTransfersByStatePaginated holds details about calls to the GetTransfersByStatePaginated method.
+		GetTransfersByStatePaginated []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// State is the state argument value.
+			State exported.TransferState
+			// PageRequest is the pageRequest argument value.
+			PageRequest *query.PageRequest
+		}
+		// GetTransfersForChainPaginated holds details about calls to the GetTransfersForChainPaginated method.
+		GetTransfersForChainPaginated []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Chain is the chain argument value.
+			Chain exported.Chain
+			// State is the state argument value.
+			State exported.TransferState
+			// PageRequest is the pageRequest argument value.
+			PageRequest *query.PageRequest
+		}
+		// InitGenesis holds details about calls to the InitGenesis method.
+		InitGenesis []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// GenState is the genState argument value.
+			GenState *nexustypes.GenesisState
+		}
+		// IsAssetRegistered holds details about calls to the IsAssetRegistered method.
+		IsAssetRegistered []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// ChainName is the chainName argument value.
+			ChainName string
+			// Denom is the denom argument value.
+			Denom string
 		}
 		// IsChainActivated holds details about calls to the IsChainActivated method.
 		IsChainActivated []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
 			// Chain is the chain argument value.
 			Chain exported.Chain
 		}
 		// IsChainMaintainer holds details about calls to the IsChainMaintainer method.
 		IsChainMaintainer []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
 			// Chain is the chain argument value.
 			Chain exported.Chain
 			// Maintainer is the maintainer argument value.
-			Maintainer github_com_cosmos_cosmos_sdk_types.ValAddress
+			Maintainer cosmossdktypes.ValAddress
 		}
 		// Logger holds details about calls to the Logger method.
 		Logger []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
+		}
+		// RegisterAsset holds details about calls to the RegisterAsset method.
+		RegisterAsset []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// ChainName is the chainName argument value.
+			ChainName string
+			// Denom is the denom argument value.
+			Denom string
 		}
 		// RemoveChainMaintainer holds details about calls to the RemoveChainMaintainer method.
 		RemoveChainMaintainer []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
 			// Chain is the chain argument value.
 			Chain exported.Chain
 			// Validator is the validator argument value.
-			Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+			Validator cosmossdktypes.ValAddress
 		}
 		// SetParams holds details about calls to the SetParams method.
 		SetParams []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
 			// P is the p argument value.
-			P types.Params
+			P nexustypes.Params
 		}
 	}
-	lockActivateChain         sync.RWMutex
-	lockAddChainMaintainer    sync.RWMutex
-	lockGetChain              sync.RWMutex
-	lockGetChainMaintainers   sync.RWMutex
-	lockGetChains             sync.RWMutex
-	lockGetParams             sync.RWMutex
-	lockIsChainActivated      sync.RWMutex
-	lockIsChainMaintainer     sync.RWMutex
-	lockLogger                sync.RWMutex
-	lockRemoveChainMaintainer sync.RWMutex
-	lockSetParams             sync.RWMutex
+	lockActivateChain                    sync.RWMutex
+	lockAddChainMaintainer               sync.RWMutex
+	lockExportGenesis                    sync.RWMutex
+	lockGetChain                         sync.RWMutex
+	lockGetChainMaintainers              sync.RWMutex
+	lockGetChains                        sync.RWMutex
+	lockGetDistributedFees               sync.RWMutex
+	lockGetFeeLedger                     sync.RWMutex
+	lockGetFeeSchedules                  sync.RWMutex
+	lockGetParams                        sync.RWMutex
+	lockGetTransfer                      sync.RWMutex
+	lockGetTransfersByRecipientPaginated sync.RWMutex
+	lockGetTransfersBySenderPaginated    sync.RWMutex
+	lockGetTransfersByStatePaginated     sync.RWMutex
+	lockGetTransfersForChainPaginated    sync.RWMutex
+	lockInitGenesis                      sync.RWMutex
+	lockIsAssetRegistered                sync.RWMutex
+	lockIsChainActivated                 sync.RWMutex
+	lockIsChainMaintainer                sync.RWMutex
+	lockLogger                           sync.RWMutex
+	lockRegisterAsset                    sync.RWMutex
+	lockRemoveChainMaintainer            sync.RWMutex
+	lockSetParams                        sync.RWMutex
 }
 
 // ActivateChain calls ActivateChainFunc.
-func (mock *NexusMock) ActivateChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) {
+func (mock *NexusMock) ActivateChain(ctx cosmossdktypes.Context, chain exported.Chain) {
 	if mock.ActivateChainFunc == nil {
 		panic("NexusMock.ActivateChainFunc: method is nil but Nexus.ActivateChain was just called")
 	}
 	callInfo := struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Ctx   cosmossdktypes.Context
 		Chain exported.Chain
 	}{
 		Ctx:   ctx,
@@ -209,11 +396,11 @@ func (mock *NexusMock) ActivateChain(ctx github_com_cosmos_cosmos_sdk_types.Cont
 // Check the length with:
 //     len(mockedNexus.ActivateChainCalls())
 func (mock *NexusMock) ActivateChainCalls() []struct {
-	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Ctx   cosmossdktypes.Context
 	Chain exported.Chain
 } {
 	var calls []struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Ctx   cosmossdktypes.Context
 		Chain exported.Chain
 	}
 	mock.lockActivateChain.RLock()
@@ -223,14 +410,14 @@ func (mock *NexusMock) ActivateChainCalls() []struct {
 }
 
 // AddChainMaintainer calls AddChainMaintainerFunc.
-func (mock *NexusMock) AddChainMaintainer(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, validator github_com_cosmos_cosmos_sdk_types.ValAddress) error {
+func (mock *NexusMock) AddChainMaintainer(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error {
 	if mock.AddChainMaintainerFunc == nil {
 		panic("NexusMock.AddChainMaintainerFunc: method is nil but Nexus.AddChainMaintainer was just called")
 	}
 	callInfo := struct {
-		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Ctx       cosmossdktypes.Context
 		Chain     exported.Chain
-		Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+		Validator cosmossdktypes.ValAddress
 	}{
 		Ctx:       ctx,
 		Chain:     chain,
@@ -246,14 +433,14 @@ func (mock *NexusMock) AddChainMaintainer(ctx github_com_cosmos_cosmos_sdk_types
 // Check the length with:
 //     len(mockedNexus.AddChainMaintainerCalls())
 func (mock *NexusMock) AddChainMaintainerCalls() []struct {
-	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	Ctx       cosmossdktypes.Context
 	Chain     exported.Chain
-	Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+	Validator cosmossdktypes.ValAddress
 } {
 	var calls []struct {
-		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Ctx       cosmossdktypes.Context
 		Chain     exported.Chain
-		Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+		Validator cosmossdktypes.ValAddress
 	}
 	mock.lockAddChainMaintainer.RLock()
 	calls = mock.calls.AddChainMaintainer
@@ -261,13 +448,44 @@ func (mock *NexusMock) AddChainMaintainerCalls() []struct {
 	return calls
 }
 
+// ExportGenesis calls ExportGenesisFunc.
+func (mock *NexusMock) ExportGenesis(ctx cosmossdktypes.Context) *nexustypes.GenesisState {
+	if mock.ExportGenesisFunc == nil {
+		panic("NexusMock.ExportGenesisFunc: method is nil but Nexus.ExportGenesis was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockExportGenesis.Lock()
+	mock.calls.ExportGenesis = append(mock.calls.ExportGenesis, callInfo)
+	mock.lockExportGenesis.Unlock()
+	return mock.ExportGenesisFunc(ctx)
+}
+
+// ExportGenesisCalls gets all the calls that were made to ExportGenesis.
+// Check the length with:
+//     len(mockedNexus.ExportGenesisCalls())
+func (mock *NexusMock) ExportGenesisCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockExportGenesis.RLock()
+	calls = mock.calls.ExportGenesis
+	mock.lockExportGenesis.RUnlock()
+	return calls
+}
+
 // GetChain calls GetChainFunc.
-func (mock *NexusMock) GetChain(ctx github_com_cosmos_cosmos_sdk_types.Context, chain string) (exported.Chain, bool) {
+func (mock *NexusMock) GetChain(ctx cosmossdktypes.Context, chain string) (exported.Chain, bool) {
 	if mock.GetChainFunc == nil {
 		panic("NexusMock.GetChainFunc: method is nil but Nexus.GetChain was just called")
 	}
 	callInfo := struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Ctx   cosmossdktypes.Context
 		Chain string
 	}{
 		Ctx:   ctx,
@@ -283,11 +501,11 @@ func (mock *NexusMock) GetChain(ctx github_com_cosmos_cosmos_sdk_types.Context,
 // Check the length with:
 //     len(mockedNexus.GetChainCalls())
 func (mock *NexusMock) GetChainCalls() []struct {
-	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Ctx   cosmossdktypes.Context
 	Chain string
 } {
 	var calls []struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Ctx   cosmossdktypes.Context
 		Chain string
 	}
 	mock.lockGetChain.RLock()
@@ -297,12 +515,12 @@ func (mock *NexusMock) GetChainCalls() []struct {
 }
 
 // GetChainMaintainers calls GetChainMaintainersFunc.
-func (mock *NexusMock) GetChainMaintainers(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) []github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (mock *NexusMock) GetChainMaintainers(ctx cosmossdktypes.Context, chain exported.Chain) []cosmossdktypes.ValAddress {
 	if mock.GetChainMaintainersFunc == nil {
 		panic("NexusMock.GetChainMaintainersFunc: method is nil but Nexus.GetChainMaintainers was just called")
 	}
 	callInfo := struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Ctx   cosmossdktypes.Context
 		Chain exported.Chain
 	}{
 		Ctx:   ctx,
@@ -318,11 +536,11 @@ func (mock *NexusMock) GetChainMaintainers(ctx github_com_cosmos_cosmos_sdk_type
 // Check the length with:
 //     len(mockedNexus.GetChainMaintainersCalls())
 func (mock *NexusMock) GetChainMaintainersCalls() []struct {
-	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Ctx   cosmossdktypes.Context
 	Chain exported.Chain
 } {
 	var calls []struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Ctx   cosmossdktypes.Context
 		Chain exported.Chain
 	}
 	mock.lockGetChainMaintainers.RLock()
@@ -332,12 +550,12 @@ func (mock *NexusMock) GetChainMaintainersCalls() []struct {
 }
 
 // GetChains calls GetChainsFunc.
-func (mock *NexusMock) GetChains(ctx github_com_cosmos_cosmos_sdk_types.Context) []exported.Chain {
+func (mock *NexusMock) GetChains(ctx cosmossdktypes.Context) []exported.Chain {
 	if mock.GetChainsFunc == nil {
 		panic("NexusMock.GetChainsFunc: method is nil but Nexus.GetChains was just called")
 	}
 	callInfo := struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Ctx cosmossdktypes.Context
 	}{
 		Ctx: ctx,
 	}
@@ -351,10 +569,10 @@ func (mock *NexusMock) GetChains(ctx github_com_cosmos_cosmos_sdk_types.Context)
 // Check the length with:
 //     len(mockedNexus.GetChainsCalls())
 func (mock *NexusMock) GetChainsCalls() []struct {
-	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Ctx cosmossdktypes.Context
 } {
 	var calls []struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Ctx cosmossdktypes.Context
 	}
 	mock.lockGetChains.RLock()
 	calls = mock.calls.GetChains
@@ -362,13 +580,130 @@ func (mock *NexusMock) GetChainsCalls() []struct {
 	return calls
 }
 
+// GetDistributedFees calls GetDistributedFeesFunc.
+func (mock *NexusMock) GetDistributedFees(ctx cosmossdktypes.Context) cosmossdktypes.Coins {
+	if mock.GetDistributedFeesFunc == nil {
+		panic("NexusMock.GetDistributedFeesFunc: method is nil but Nexus.GetDistributedFees was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFees.Lock()
+	mock.calls.GetDistributedFees = append(mock.calls.GetDistributedFees, callInfo)
+	mock.lockGetDistributedFees.Unlock()
+	return mock.GetDistributedFeesFunc(ctx)
+}
+
+// GetDistributedFeesCalls gets all the calls that were made to GetDistributedFees.
+// Check the length with:
+//     len(mockedNexus.GetDistributedFeesCalls())
+func (mock *NexusMock) GetDistributedFeesCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockGetDistributedFees.RLock()
+	calls = mock.calls.GetDistributedFees
+	mock.lockGetDistributedFees.RUnlock()
+	return calls
+}
+
+// GetFeeLedger calls GetFeeLedgerFunc.
+func (mock *NexusMock) GetFeeLedger(ctx cosmossdktypes.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeLedgerEntry {
+	if mock.GetFeeLedgerFunc == nil {
+		panic("NexusMock.GetFeeLedgerFunc: method is nil but Nexus.GetFeeLedger was just called")
+	}
+	callInfo := struct {
+		Ctx              cosmossdktypes.Context
+		SourceChain      string
+		DestinationChain string
+		Asset            string
+	}{
+		Ctx:              ctx,
+		SourceChain:      sourceChain,
+		DestinationChain: destinationChain,
+		Asset:            asset,
+	}
+	mock.lockGetFeeLedger.Lock()
+	mock.calls.GetFeeLedger = append(mock.calls.GetFeeLedger, callInfo)
+	mock.lockGetFeeLedger.Unlock()
+	return mock.GetFeeLedgerFunc(ctx, sourceChain, destinationChain, asset)
+}
+
+// GetFeeLedgerCalls gets all the calls that were made to GetFeeLedger.
+// Check the length with:
+//     len(mockedNexus.GetFeeLedgerCalls())
+func (mock *NexusMock) GetFeeLedgerCalls() []struct {
+	Ctx              cosmossdktypes.Context
+	SourceChain      string
+	DestinationChain string
+	Asset            string
+} {
+	var calls []struct {
+		Ctx              cosmossdktypes.Context
+		SourceChain      string
+		DestinationChain string
+		Asset            string
+	}
+	mock.lockGetFeeLedger.RLock()
+	calls = mock.calls.GetFeeLedger
+	mock.lockGetFeeLedger.RUnlock()
+	return calls
+}
+
+// GetFeeSchedules calls GetFeeSchedulesFunc.
+func (mock *NexusMock) GetFeeSchedules(ctx cosmossdktypes.Context, sourceChain string, destinationChain string, asset string) []nexustypes.FeeSchedule {
+	if mock.GetFeeSchedulesFunc == nil {
+		panic("NexusMock.GetFeeSchedulesFunc: method is nil but Nexus.GetFeeSchedules was just called")
+	}
+	callInfo := struct {
+		Ctx              cosmossdktypes.Context
+		SourceChain      string
+		DestinationChain string
+		Asset            string
+	}{
+		Ctx:              ctx,
+		SourceChain:      sourceChain,
+		DestinationChain: destinationChain,
+		Asset:            asset,
+	}
+	mock.lockGetFeeSchedules.Lock()
+	mock.calls.GetFeeSchedules = append(mock.calls.GetFeeSchedules, callInfo)
+	mock.lockGetFeeSchedules.Unlock()
+	return mock.GetFeeSchedulesFunc(ctx, sourceChain, destinationChain, asset)
+}
+
+// GetFeeSchedulesCalls gets all the calls that were made to GetFeeSchedules.
+// Check the length with:
+//     len(mockedNexus.GetFeeSchedulesCalls())
+func (mock *NexusMock) GetFeeSchedulesCalls() []struct {
+	Ctx              cosmossdktypes.Context
+	SourceChain      string
+	DestinationChain string
+	Asset            string
+} {
+	var calls []struct {
+		Ctx              cosmossdktypes.Context
+		SourceChain      string
+		DestinationChain string
+		Asset            string
+	}
+	mock.lockGetFeeSchedules.RLock()
+	calls = mock.calls.GetFeeSchedules
+	mock.lockGetFeeSchedules.RUnlock()
+	return calls
+}
+
 // GetParams calls GetParamsFunc.
-func (mock *NexusMock) GetParams(ctx github_com_cosmos_cosmos_sdk_types.Context) types.Params {
+func (mock *NexusMock) GetParams(ctx cosmossdktypes.Context) nexustypes.Params {
 	if mock.GetParamsFunc == nil {
 		panic("NexusMock.GetParamsFunc: method is nil but Nexus.GetParams was just called")
 	}
 	callInfo := struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Ctx cosmossdktypes.Context
 	}{
 		Ctx: ctx,
 	}
@@ -382,10 +717,10 @@ func (mock *NexusMock) GetParams(ctx github_com_cosmos_cosmos_sdk_types.Context)
 // Check the length with:
 //     len(mockedNexus.GetParamsCalls())
 func (mock *NexusMock) GetParamsCalls() []struct {
-	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Ctx cosmossdktypes.Context
 } {
 	var calls []struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Ctx cosmossdktypes.Context
 	}
 	mock.lockGetParams.RLock()
 	calls = mock.calls.GetParams
@@ -393,13 +728,282 @@ func (mock *NexusMock) GetParamsCalls() []struct {
 	return calls
 }
 
+// GetTransfer calls GetTransferFunc.
+func (mock *NexusMock) GetTransfer(ctx cosmossdktypes.Context, id uint64) (exported.CrossChainTransfer, bool) {
+	if mock.GetTransferFunc == nil {
+		panic("NexusMock.GetTransferFunc: method is nil but Nexus.GetTransfer was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+		ID  uint64
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetTransfer.Lock()
+	mock.calls.GetTransfer = append(mock.calls.GetTransfer, callInfo)
+	mock.lockGetTransfer.Unlock()
+	return mock.GetTransferFunc(ctx, id)
+}
+
+// GetTransferCalls gets all the calls that were made to GetTransfer.
+// Check the length with:
+//     len(mockedNexus.GetTransferCalls())
+func (mock *NexusMock) GetTransferCalls() []struct {
+	Ctx cosmossdktypes.Context
+	ID  uint64
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+		ID  uint64
+	}
+	mock.lockGetTransfer.RLock()
+	calls = mock.calls.GetTransfer
+	mock.lockGetTransfer.RUnlock()
+	return calls
+}
+
+// GetTransfersByRecipientPaginated calls GetTransfersByRecipientPaginatedFunc.
+func (mock *NexusMock) GetTransfersByRecipientPaginated(ctx cosmossdktypes.Context, recipient exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	if mock.GetTransfersByRecipientPaginatedFunc == nil {
+		panic("NexusMock.GetTransfersByRecipientPaginatedFunc: method is nil but Nexus.GetTransfersByRecipientPaginated was just called")
+	}
+	callInfo := struct {
+		Ctx         cosmossdktypes.Context
+		Recipient   exported.CrossChainAddress
+		PageRequest *query.PageRequest
+	}{
+		Ctx:         ctx,
+		Recipient:   recipient,
+		PageRequest: pageRequest,
+	}
+	mock.lockGetTransfersByRecipientPaginated.Lock()
+	mock.calls.GetTransfersByRecipientPaginated = append(mock.calls.GetTransfersByRecipientPaginated, callInfo)
+	mock.lockGetTransfersByRecipientPaginated.Unlock()
+	return mock.GetTransfersByRecipientPaginatedFunc(ctx, recipient, pageRequest)
+}
+
+// GetTransfersByRecipientPaginatedCalls gets all the calls that were made to GetTransfersByRecipientPaginated.
+// Check the length with:
+//     len(mockedNexus.GetTransfersByRecipientPaginatedCalls())
+func (mock *NexusMock) GetTransfersByRecipientPaginatedCalls() []struct {
+	Ctx         cosmossdktypes.Context
+	Recipient   exported.CrossChainAddress
+	PageRequest *query.PageRequest
+} {
+	var calls []struct {
+		Ctx         cosmossdktypes.Context
+		Recipient   exported.CrossChainAddress
+		PageRequest *query.PageRequest
+	}
+	mock.lockGetTransfersByRecipientPaginated.RLock()
+	calls = mock.calls.GetTransfersByRecipientPaginated
+	mock.lockGetTransfersByRecipientPaginated.RUnlock()
+	return calls
+}
+
+// GetTransfersBySenderPaginated calls GetTransfersBySenderPaginatedFunc.
+func (mock *NexusMock) GetTransfersBySenderPaginated(ctx cosmossdktypes.Context, sender exported.CrossChainAddress, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	if mock.GetTransfersBySenderPaginatedFunc == nil {
+		panic("NexusMock.GetTransfersBySenderPaginatedFunc: method is nil but Nexus.GetTransfersBySenderPaginated was just called")
+	}
+	callInfo := struct {
+		Ctx         cosmossdktypes.Context
+		Sender      exported.CrossChainAddress
+		PageRequest *query.PageRequest
+	}{
+		Ctx:         ctx,
+		Sender:      sender,
+		PageRequest: pageRequest,
+	}
+	mock.lockGetTransfersBySenderPaginated.Lock()
+	mock.calls.GetTransfersBySenderPaginated = append(mock.calls.GetTransfersBySenderPaginated, callInfo)
+	mock.lockGetTransfersBySenderPaginated.Unlock()
+	return mock.GetTransfersBySenderPaginatedFunc(ctx, sender, pageRequest)
+}
+
+// GetTransfersBySenderPaginatedCalls gets all the calls that were made to GetTransfersBySenderPaginated.
+// Check the length with:
+//     len(mockedNexus.GetTransfersBySenderPaginatedCalls())
+func (mock *NexusMock) GetTransfersBySenderPaginatedCalls() []struct {
+	Ctx         cosmossdktypes.Context
+	Sender      exported.CrossChainAddress
+	PageRequest *query.PageRequest
+} {
+	var calls []struct {
+		Ctx         cosmossdktypes.Context
+		Sender      exported.CrossChainAddress
+		PageRequest *query.PageRequest
+	}
+	mock.lockGetTransfersBySenderPaginated.RLock()
+	calls = mock.calls.GetTransfersBySenderPaginated
+	mock.lockGetTransfersBySenderPaginated.RUnlock()
+	return calls
+}
+
+// GetTransfersByStatePaginated calls GetTransfersByStatePaginatedFunc.
+func (mock *NexusMock) GetTransfersByStatePaginated(ctx cosmossdktypes.Context, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	if mock.GetTransfersByStatePaginatedFunc == nil {
+		panic("NexusMock.GetTransfersByStatePaginatedFunc: method is nil but Nexus.GetTransfersByStatePaginated was just called")
+	}
+	callInfo := struct {
+		Ctx         cosmossdktypes.Context
+		State       exported.TransferState
+		PageRequest *query.PageRequest
+	}{
+		Ctx:         ctx,
+		State:       state,
+		PageRequest: pageRequest,
+	}
+	mock.lockGetTransfersByStatePaginated.Lock()
+	mock.calls.GetTransfersByStatePaginated = append(mock.calls.GetTransfersByStatePaginated, callInfo)
+	mock.lockGetTransfersByStatePaginated.Unlock()
+	return mock.GetTransfersByStatePaginatedFunc(ctx, state, pageRequest)
+}
+
+// GetTransfersByStatePaginatedCalls gets all the calls that were made to GetTransfersByStatePaginated.
+// Check the length with:
+//     len(mockedNexus.GetTransfersByStatePaginatedCalls())
+func (mock *NexusMock) GetTransfersByStatePaginatedCalls() []struct {
+	Ctx         cosmossdktypes.Context
+	State       exported.TransferState
+	PageRequest *query.PageRequest
+} {
+	var calls []struct {
+		Ctx         cosmossdktypes.Context
+		State       exported.TransferState
+		PageRequest *query.PageRequest
+	}
+	mock.lockGetTransfersByStatePaginated.RLock()
+	calls = mock.calls.GetTransfersByStatePaginated
+	mock.lockGetTransfersByStatePaginated.RUnlock()
+	return calls
+}
+
+// GetTransfersForChainPaginated calls GetTransfersForChainPaginatedFunc.
+func (mock *NexusMock) GetTransfersForChainPaginated(ctx cosmossdktypes.Context, chain exported.Chain, state exported.TransferState, pageRequest *query.PageRequest) ([]exported.CrossChainTransfer, *query.PageResponse, error) {
+	if mock.GetTransfersForChainPaginatedFunc == nil {
+		panic("NexusMock.GetTransfersForChainPaginatedFunc: method is nil but Nexus.GetTransfersForChainPaginated was just called")
+	}
+	callInfo := struct {
+		Ctx         cosmossdktypes.Context
+		Chain       exported.Chain
+		State       exported.TransferState
+		PageRequest *query.PageRequest
+	}{
+		Ctx:         ctx,
+		Chain:       chain,
+		State:       state,
+		PageRequest: pageRequest,
+	}
+	mock.lockGetTransfersForChainPaginated.Lock()
+	mock.calls.GetTransfersForChainPaginated = append(mock.calls.GetTransfersForChainPaginated, callInfo)
+	mock.lockGetTransfersForChainPaginated.Unlock()
+	return mock.GetTransfersForChainPaginatedFunc(ctx, chain, state, pageRequest)
+}
+
+// GetTransfersForChainPaginatedCalls gets all the calls that were made to GetTransfersForChainPaginated.
+// Check the length with:
+//     len(mockedNexus.GetTransfersForChainPaginatedCalls())
+func (mock *NexusMock) GetTransfersForChainPaginatedCalls() []struct {
+	Ctx         cosmossdktypes.Context
+	Chain       exported.Chain
+	State       exported.TransferState
+	PageRequest *query.PageRequest
+} {
+	var calls []struct {
+		Ctx         cosmossdktypes.Context
+		Chain       exported.Chain
+		State       exported.TransferState
+		PageRequest *query.PageRequest
+	}
+	mock.lockGetTransfersForChainPaginated.RLock()
+	calls = mock.calls.GetTransfersForChainPaginated
+	mock.lockGetTransfersForChainPaginated.RUnlock()
+	return calls
+}
+
+// InitGenesis calls InitGenesisFunc.
+func (mock *NexusMock) InitGenesis(ctx cosmossdktypes.Context, genState *nexustypes.GenesisState) {
+	if mock.InitGenesisFunc == nil {
+		panic("NexusMock.InitGenesisFunc: method is nil but Nexus.InitGenesis was just called")
+	}
+	callInfo := struct {
+		Ctx      cosmossdktypes.Context
+		GenState *nexustypes.GenesisState
+	}{
+		Ctx:      ctx,
+		GenState: genState,
+	}
+	mock.lockInitGenesis.Lock()
+	mock.calls.InitGenesis = append(mock.calls.InitGenesis, callInfo)
+	mock.lockInitGenesis.Unlock()
+	mock.InitGenesisFunc(ctx, genState)
+}
+
+// InitGenesisCalls gets all the calls that were made to InitGenesis.
+// Check the length with:
+//     len(mockedNexus.InitGenesisCalls())
+func (mock *NexusMock) InitGenesisCalls() []struct {
+	Ctx      cosmossdktypes.Context
+	GenState *nexustypes.GenesisState
+} {
+	var calls []struct {
+		Ctx      cosmossdktypes.Context
+		GenState *nexustypes.GenesisState
+	}
+	mock.lockInitGenesis.RLock()
+	calls = mock.calls.InitGenesis
+	mock.lockInitGenesis.RUnlock()
+	return calls
+}
+
+// IsAssetRegistered calls IsAssetRegisteredFunc.
+func (mock *NexusMock) IsAssetRegistered(ctx cosmossdktypes.Context, chainName string, denom string) bool {
+	if mock.IsAssetRegisteredFunc == nil {
+		panic("NexusMock.IsAssetRegisteredFunc: method is nil but Nexus.IsAssetRegistered was just called")
+	}
+	callInfo := struct {
+		Ctx       cosmossdktypes.Context
+		ChainName string
+		Denom     string
+	}{
+		Ctx:       ctx,
+		ChainName: chainName,
+		Denom:     denom,
+	}
+	mock.lockIsAssetRegistered.Lock()
+	mock.calls.IsAssetRegistered = append(mock.calls.IsAssetRegistered, callInfo)
+	mock.lockIsAssetRegistered.Unlock()
+	return mock.IsAssetRegisteredFunc(ctx, chainName, denom)
+}
+
+// IsAssetRegisteredCalls gets all the calls that were made to IsAssetRegistered.
+// Check the length with:
+//     len(mockedNexus.IsAssetRegisteredCalls())
+func (mock *NexusMock) IsAssetRegisteredCalls() []struct {
+	Ctx       cosmossdktypes.Context
+	ChainName string
+	Denom     string
+} {
+	var calls []struct {
+		Ctx       cosmossdktypes.Context
+		ChainName string
+		Denom     string
+	}
+	mock.lockIsAssetRegistered.RLock()
+	calls = mock.calls.IsAssetRegistered
+	mock.lockIsAssetRegistered.RUnlock()
+	return calls
+}
+
 // IsChainActivated calls IsChainActivatedFunc.
-func (mock *NexusMock) IsChainActivated(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain) bool {
+func (mock *NexusMock) IsChainActivated(ctx cosmossdktypes.Context, chain exported.Chain) bool {
 	if mock.IsChainActivatedFunc == nil {
 		panic("NexusMock.IsChainActivatedFunc: method is nil but Nexus.IsChainActivated was just called")
 	}
 	callInfo := struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Ctx   cosmossdktypes.Context
 		Chain exported.Chain
 	}{
 		Ctx:   ctx,
@@ -415,11 +1019,11 @@ func (mock *NexusMock) IsChainActivated(ctx github_com_cosmos_cosmos_sdk_types.C
 // Check the length with:
 //     len(mockedNexus.IsChainActivatedCalls())
 func (mock *NexusMock) IsChainActivatedCalls() []struct {
-	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Ctx   cosmossdktypes.Context
 	Chain exported.Chain
 } {
 	var calls []struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Ctx   cosmossdktypes.Context
 		Chain exported.Chain
 	}
 	mock.lockIsChainActivated.RLock()
@@ -429,14 +1033,14 @@ func (mock *NexusMock) IsChainActivatedCalls() []struct {
 }
 
 // IsChainMaintainer calls IsChainMaintainerFunc.
-func (mock *NexusMock) IsChainMaintainer(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, maintainer github_com_cosmos_cosmos_sdk_types.ValAddress) bool {
+func (mock *NexusMock) IsChainMaintainer(ctx cosmossdktypes.Context, chain exported.Chain, maintainer cosmossdktypes.ValAddress) bool {
 	if mock.IsChainMaintainerFunc == nil {
 		panic("NexusMock.IsChainMaintainerFunc: method is nil but Nexus.IsChainMaintainer was just called")
 	}
 	callInfo := struct {
-		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		Ctx        cosmossdktypes.Context
 		Chain      exported.Chain
-		Maintainer github_com_cosmos_cosmos_sdk_types.ValAddress
+		Maintainer cosmossdktypes.ValAddress
 	}{
 		Ctx:        ctx,
 		Chain:      chain,
@@ -452,14 +1056,14 @@ func (mock *NexusMock) IsChainMaintainer(ctx github_com_cosmos_cosmos_sdk_types.
 // Check the length with:
 //     len(mockedNexus.IsChainMaintainerCalls())
 func (mock *NexusMock) IsChainMaintainerCalls() []struct {
-	Ctx        github_com_cosmos_cosmos_sdk_types.Context
+	Ctx        cosmossdktypes.Context
 	Chain      exported.Chain
-	Maintainer github_com_cosmos_cosmos_sdk_types.ValAddress
+	Maintainer cosmossdktypes.ValAddress
 } {
 	var calls []struct {
-		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		Ctx        cosmossdktypes.Context
 		Chain      exported.Chain
-		Maintainer github_com_cosmos_cosmos_sdk_types.ValAddress
+		Maintainer cosmossdktypes.ValAddress
 	}
 	mock.lockIsChainMaintainer.RLock()
 	calls = mock.calls.IsChainMaintainer
@@ -468,12 +1072,12 @@ func (mock *NexusMock) IsChainMaintainerCalls() []struct {
 }
 
 // Logger calls LoggerFunc.
-func (mock *NexusMock) Logger(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
+func (mock *NexusMock) Logger(ctx cosmossdktypes.Context) log.Logger {
 	if mock.LoggerFunc == nil {
 		panic("NexusMock.LoggerFunc: method is nil but Nexus.Logger was just called")
 	}
 	callInfo := struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Ctx cosmossdktypes.Context
 	}{
 		Ctx: ctx,
 	}
@@ -487,10 +1091,10 @@ func (mock *NexusMock) Logger(ctx github_com_cosmos_cosmos_sdk_types.Context) lo
 // Check the length with:
 //     len(mockedNexus.LoggerCalls())
 func (mock *NexusMock) LoggerCalls() []struct {
-	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Ctx cosmossdktypes.Context
 } {
 	var calls []struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Ctx cosmossdktypes.Context
 	}
 	mock.lockLogger.RLock()
 	calls = mock.calls.Logger
@@ -498,15 +1102,54 @@ func (mock *NexusMock) LoggerCalls() []struct {
 	return calls
 }
 
+// RegisterAsset calls RegisterAssetFunc.
+func (mock *NexusMock) RegisterAsset(ctx cosmossdktypes.Context, chainName string, denom string) {
+	if mock.RegisterAssetFunc == nil {
+		panic("NexusMock.RegisterAssetFunc: method is nil but Nexus.RegisterAsset was just called")
+	}
+	callInfo := struct {
+		Ctx       cosmossdktypes.Context
+		ChainName string
+		Denom     string
+	}{
+		Ctx:       ctx,
+		ChainName: chainName,
+		Denom:     denom,
+	}
+	mock.lockRegisterAsset.Lock()
+	mock.calls.RegisterAsset = append(mock.calls.RegisterAsset, callInfo)
+	mock.lockRegisterAsset.Unlock()
+	mock.RegisterAssetFunc(ctx, chainName, denom)
+}
+
+// RegisterAssetCalls gets all the calls that were made to RegisterAsset.
+// Check the length with:
+//     len(mockedNexus.RegisterAssetCalls())
+func (mock *NexusMock) RegisterAssetCalls() []struct {
+	Ctx       cosmossdktypes.Context
+	ChainName string
+	Denom     string
+} {
+	var calls []struct {
+		Ctx       cosmossdktypes.Context
+		ChainName string
+		Denom     string
+	}
+	mock.lockRegisterAsset.RLock()
+	calls = mock.calls.RegisterAsset
+	mock.lockRegisterAsset.RUnlock()
+	return calls
+}
+
 // RemoveChainMaintainer calls RemoveChainMaintainerFunc.
-func (mock *NexusMock) RemoveChainMaintainer(ctx github_com_cosmos_cosmos_sdk_types.Context, chain exported.Chain, validator github_com_cosmos_cosmos_sdk_types.ValAddress) error {
+func (mock *NexusMock) RemoveChainMaintainer(ctx cosmossdktypes.Context, chain exported.Chain, validator cosmossdktypes.ValAddress) error {
 	if mock.RemoveChainMaintainerFunc == nil {
 		panic("NexusMock.RemoveChainMaintainerFunc: method is nil but Nexus.RemoveChainMaintainer was just called")
 	}
 	callInfo := struct {
-		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Ctx       cosmossdktypes.Context
 		Chain     exported.Chain
-		Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+		Validator cosmossdktypes.ValAddress
 	}{
 		Ctx:       ctx,
 		Chain:     chain,
@@ -522,14 +1165,14 @@ func (mock *NexusMock) RemoveChainMaintainer(ctx github_com_cosmos_cosmos_sdk_ty
 // Check the length with:
 //     len(mockedNexus.RemoveChainMaintainerCalls())
 func (mock *NexusMock) RemoveChainMaintainerCalls() []struct {
-	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	Ctx       cosmossdktypes.Context
 	Chain     exported.Chain
-	Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+	Validator cosmossdktypes.ValAddress
 } {
 	var calls []struct {
-		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Ctx       cosmossdktypes.Context
 		Chain     exported.Chain
-		Validator github_com_cosmos_cosmos_sdk_types.ValAddress
+		Validator cosmossdktypes.ValAddress
 	}
 	mock.lockRemoveChainMaintainer.RLock()
 	calls = mock.calls.RemoveChainMaintainer
@@ -538,13 +1181,13 @@ func (mock *NexusMock) RemoveChainMaintainerCalls() []struct {
 }
 
 // SetParams calls SetParamsFunc.
-func (mock *NexusMock) SetParams(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params) {
+func (mock *NexusMock) SetParams(ctx cosmossdktypes.Context, p nexustypes.Params) {
 	if mock.SetParamsFunc == nil {
 		panic("NexusMock.SetParamsFunc: method is nil but Nexus.SetParams was just called")
 	}
 	callInfo := struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		P   types.Params
+		Ctx cosmossdktypes.Context
+		P   nexustypes.Params
 	}{
 		Ctx: ctx,
 		P:   p,
@@ -559,12 +1202,12 @@ func (mock *NexusMock) SetParams(ctx github_com_cosmos_cosmos_sdk_types.Context,
 // Check the length with:
 //     len(mockedNexus.SetParamsCalls())
 func (mock *NexusMock) SetParamsCalls() []struct {
-	Ctx github_com_cosmos_cosmos_sdk_types.Context
-	P   types.Params
+	Ctx cosmossdktypes.Context
+	P   nexustypes.Params
 } {
 	var calls []struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
-		P   types.Params
+		Ctx cosmossdktypes.Context
+		P   nexustypes.Params
 	}
 	mock.lockSetParams.RLock()
 	calls = mock.calls.SetParams
@@ -572,50 +1215,50 @@ func (mock *NexusMock) SetParamsCalls() []struct {
 	return calls
 }
 
-// Ensure, that SnapshotterMock does implement types.Snapshotter.
+// Ensure, that SnapshotterMock does implement nexustypes.Snapshotter.
 // If this is not the case, regenerate this file with moq.
-var _ types.Snapshotter = &SnapshotterMock{}
+var _ nexustypes.Snapshotter = &SnapshotterMock{}
 
-// SnapshotterMock is a mock implementation of types.Snapshotter.
+// SnapshotterMock is a mock implementation of nexustypes.Snapshotter.
 //
 // 	func TestSomethingThatUsesSnapshotter(t *testing.T) {
 //
-// 		// make and configure a mocked types.Snapshotter
+// 		// make and configure a mocked nexustypes.Snapshotter
 // 		mockedSnapshotter := &SnapshotterMock{
-// 			GetOperatorFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, proxy github_com_cosmos_cosmos_sdk_types.AccAddress) github_com_cosmos_cosmos_sdk_types.ValAddress {
+// 			GetOperatorFunc: func(ctx cosmossdktypes.Context, proxy cosmossdktypes.AccAddress) cosmossdktypes.ValAddress {
 // 				panic("mock out the GetOperator method")
 // 			},
 // 		}
 //
-// 		// use mockedSnapshotter in code that requires types.Snapshotter
+// 		// use mockedSnapshotter in code that requires nexustypes.Snapshotter
 // 		// and then make assertions.
 //
 // 	}
 type SnapshotterMock struct {
 	// GetOperatorFunc mocks the GetOperator method.
-	GetOperatorFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, proxy github_com_cosmos_cosmos_sdk_types.AccAddress) github_com_cosmos_cosmos_sdk_types.ValAddress
+	GetOperatorFunc func(ctx cosmossdktypes.Context, proxy cosmossdktypes.AccAddress) cosmossdktypes.ValAddress
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetOperator holds details about calls to the GetOperator method.
 		GetOperator []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
 			// Proxy is the proxy argument value.
-			Proxy github_com_cosmos_cosmos_sdk_types.AccAddress
+			Proxy cosmossdktypes.AccAddress
 		}
 	}
 	lockGetOperator sync.RWMutex
 }
 
 // GetOperator calls GetOperatorFunc.
-func (mock *SnapshotterMock) GetOperator(ctx github_com_cosmos_cosmos_sdk_types.Context, proxy github_com_cosmos_cosmos_sdk_types.AccAddress) github_com_cosmos_cosmos_sdk_types.ValAddress {
+func (mock *SnapshotterMock) GetOperator(ctx cosmossdktypes.Context, proxy cosmossdktypes.AccAddress) cosmossdktypes.ValAddress {
 	if mock.GetOperatorFunc == nil {
 		panic("SnapshotterMock.GetOperatorFunc: method is nil but Snapshotter.GetOperator was just called")
 	}
 	callInfo := struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Proxy github_com_cosmos_cosmos_sdk_types.AccAddress
+		Ctx   cosmossdktypes.Context
+		Proxy cosmossdktypes.AccAddress
 	}{
 		Ctx:   ctx,
 		Proxy: proxy,
@@ -630,12 +1273,12 @@ func (mock *SnapshotterMock) GetOperator(ctx github_com_cosmos_cosmos_sdk_types.
 // Check the length with:
 //     len(mockedSnapshotter.GetOperatorCalls())
 func (mock *SnapshotterMock) GetOperatorCalls() []struct {
-	Ctx   github_com_cosmos_cosmos_sdk_types.Context
-	Proxy github_com_cosmos_cosmos_sdk_types.AccAddress
+	Ctx   cosmossdktypes.Context
+	Proxy cosmossdktypes.AccAddress
 } {
 	var calls []struct {
-		Ctx   github_com_cosmos_cosmos_sdk_types.Context
-		Proxy github_com_cosmos_cosmos_sdk_types.AccAddress
+		Ctx   cosmossdktypes.Context
+		Proxy cosmossdktypes.AccAddress
 	}
 	mock.lockGetOperator.RLock()
 	calls = mock.calls.GetOperator
@@ -643,47 +1286,106 @@ func (mock *SnapshotterMock) GetOperatorCalls() []struct {
 	return calls
 }
 
-// Ensure, that AxelarnetKeeperMock does implement types.AxelarnetKeeper.
+// Ensure, that AxelarnetKeeperMock does implement nexustypes.AxelarnetKeeper.
 // If this is not the case, regenerate this file with moq.
-var _ types.AxelarnetKeeper = &AxelarnetKeeperMock{}
+var _ nexustypes.AxelarnetKeeper = &AxelarnetKeeperMock{}
 
-// AxelarnetKeeperMock is a mock implementation of types.AxelarnetKeeper.
+// AxelarnetKeeperMock is a mock implementation of nexustypes.AxelarnetKeeper.
 //
 // 	func TestSomethingThatUsesAxelarnetKeeper(t *testing.T) {
 //
-// 		// make and configure a mocked types.AxelarnetKeeper
+// 		// make and configure a mocked nexustypes.AxelarnetKeeper
 // 		mockedAxelarnetKeeper := &AxelarnetKeeperMock{
-// 			GetFeeCollectorFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (github_com_cosmos_cosmos_sdk_types.AccAddress, bool) {
+// 			GetCosmosChainsFunc: func(ctx cosmossdktypes.Context) []string {
+// 				panic("mock out the GetCosmosChains method")
+// 			},
+// 			GetFeeCollectorFunc: func(ctx cosmossdktypes.Context) (cosmossdktypes.AccAddress, bool) {
 // 				panic("mock out the GetFeeCollector method")
 // 			},
+// 			RegisterAssetToCosmosChainFunc: func(ctx cosmossdktypes.Context, asset string, chain string)  {
+// 				panic("mock out the RegisterAssetToCosmosChain method")
+// 			},
 // 		}
 //
-// 		// use mockedAxelarnetKeeper in code that requires types.AxelarnetKeeper
+// 		// use mockedAxelarnetKeeper in code that requires nexustypes.AxelarnetKeeper
 // 		// and then make assertions.
 //
 // 	}
 type AxelarnetKeeperMock struct {
+	// GetCosmosChainsFunc mocks the GetCosmosChains method.
+	GetCosmosChainsFunc func(ctx cosmossdktypes.Context) []string
+
 	// GetFeeCollectorFunc mocks the GetFeeCollector method.
-	GetFeeCollectorFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (github_com_cosmos_cosmos_sdk_types.AccAddress, bool)
+	GetFeeCollectorFunc func(ctx cosmossdktypes.Context) (cosmossdktypes.AccAddress, bool)
+
+	// RegisterAssetToCosmosChainFunc mocks the RegisterAssetToCosmosChain method.
+	RegisterAssetToCosmosChainFunc func(ctx cosmossdktypes.Context, asset string, chain string)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// GetCosmosChains holds details about calls to the GetCosmosChains method.
+		GetCosmosChains []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+		}
 		// GetFeeCollector holds details about calls to the GetFeeCollector method.
 		GetFeeCollector []struct {
 			// Ctx is the ctx argument value.
-			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			Ctx cosmossdktypes.Context
+		}
+		// RegisterAssetToCosmosChain holds details about calls to the RegisterAssetToCosmosChain method.
+		RegisterAssetToCosmosChain []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Asset is the asset argument value.
+			Asset string
+			// Chain is the chain argument value.
+			Chain string
 		}
 	}
-	lockGetFeeCollector sync.RWMutex
+	lockGetCosmosChains            sync.RWMutex
+	lockGetFeeCollector            sync.RWMutex
+	lockRegisterAssetToCosmosChain sync.RWMutex
+}
+
+// GetCosmosChains calls GetCosmosChainsFunc.
+func (mock *AxelarnetKeeperMock) GetCosmosChains(ctx cosmossdktypes.Context) []string {
+	if mock.GetCosmosChainsFunc == nil {
+		panic("AxelarnetKeeperMock.GetCosmosChainsFunc: method is nil but AxelarnetKeeper.GetCosmosChains was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCosmosChains.Lock()
+	mock.calls.GetCosmosChains = append(mock.calls.GetCosmosChains, callInfo)
+	mock.lockGetCosmosChains.Unlock()
+	return mock.GetCosmosChainsFunc(ctx)
+}
+
+// GetCosmosChainsCalls gets all the calls that were made to GetCosmosChains.
+// Check the length with:
+//     len(mockedAxelarnetKeeper.GetCosmosChainsCalls())
+func (mock *AxelarnetKeeperMock) GetCosmosChainsCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockGetCosmosChains.RLock()
+	calls = mock.calls.GetCosmosChains
+	mock.lockGetCosmosChains.RUnlock()
+	return calls
 }
 
 // GetFeeCollector calls GetFeeCollectorFunc.
-func (mock *AxelarnetKeeperMock) GetFeeCollector(ctx github_com_cosmos_cosmos_sdk_types.Context) (github_com_cosmos_cosmos_sdk_types.AccAddress, bool) {
+func (mock *AxelarnetKeeperMock) GetFeeCollector(ctx cosmossdktypes.Context) (cosmossdktypes.AccAddress, bool) {
 	if mock.GetFeeCollectorFunc == nil {
 		panic("AxelarnetKeeperMock.GetFeeCollectorFunc: method is nil but AxelarnetKeeper.GetFeeCollector was just called")
 	}
 	callInfo := struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Ctx cosmossdktypes.Context
 	}{
 		Ctx: ctx,
 	}
@@ -697,13 +1399,52 @@ func (mock *AxelarnetKeeperMock) GetFeeCollector(ctx github_com_cosmos_cosmos_sd
 // Check the length with:
 //     len(mockedAxelarnetKeeper.GetFeeCollectorCalls())
 func (mock *AxelarnetKeeperMock) GetFeeCollectorCalls() []struct {
-	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	Ctx cosmossdktypes.Context
 } {
 	var calls []struct {
-		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		Ctx cosmossdktypes.Context
 	}
 	mock.lockGetFeeCollector.RLock()
 	calls = mock.calls.GetFeeCollector
 	mock.lockGetFeeCollector.RUnlock()
 	return calls
 }
+
+// RegisterAssetToCosmosChain calls RegisterAssetToCosmosChainFunc.
+func (mock *AxelarnetKeeperMock) RegisterAssetToCosmosChain(ctx cosmossdktypes.Context, asset string, chain string) {
+	if mock.RegisterAssetToCosmosChainFunc == nil {
+		panic("AxelarnetKeeperMock.RegisterAssetToCosmosChainFunc: method is nil but AxelarnetKeeper.RegisterAssetToCosmosChain was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Asset string
+		Chain string
+	}{
+		Ctx:   ctx,
+		Asset: asset,
+		Chain: chain,
+	}
+	mock.lockRegisterAssetToCosmosChain.Lock()
+	mock.calls.RegisterAssetToCosmosChain = append(mock.calls.RegisterAssetToCosmosChain, callInfo)
+	mock.lockRegisterAssetToCosmosChain.Unlock()
+	mock.RegisterAssetToCosmosChainFunc(ctx, asset, chain)
+}
+
+// RegisterAssetToCosmosChainCalls gets all the calls that were made to RegisterAssetToCosmosChain.
+// Check the length with:
+//     len(mockedAxelarnetKeeper.RegisterAssetToCosmosChainCalls())
+func (mock *AxelarnetKeeperMock) RegisterAssetToCosmosChainCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Asset string
+	Chain string
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Asset string
+		Chain string
+	}
+	mock.lockRegisterAssetToCosmosChain.RLock()
+	calls = mock.calls.RegisterAssetToCosmosChain
+	mock.lockRegisterAssetToCosmosChain.RUnlock()
+	return calls
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -19,6 +20,12 @@ var (
 	KeyChains = []byte("assetInfo")
 	// KeyChainActivationThreshold represents the key for chain activation threshold
 	KeyChainActivationThreshold = []byte("chainActivationThreshold")
+	// KeyTransferRateLimits represents the key for the transfer rate limits
+	KeyTransferRateLimits = []byte("transferRateLimits")
+	// KeyTransferFreezes represents the key for the transfer freezes
+	KeyTransferFreezes = []byte("transferFreezes")
+	// KeyFeeSchedules represents the key for the transfer fee schedules
+	KeyFeeSchedules = []byte("feeSchedules")
 )
 
 // KeyTable retrieves a subspace table for the module
@@ -31,6 +38,9 @@ func DefaultParams() Params {
 	return Params{
 		Chains:                   []exported.Chain{evm.Ethereum, axelarnet.Axelarnet},
 		ChainActivationThreshold: utils.NewThreshold(40, 100),
+		TransferRateLimits:       []TransferRateLimit{},
+		TransferFreezes:          []TransferFreeze{},
+		FeeSchedules:             []FeeSchedule{},
 	}
 }
 
@@ -46,6 +56,9 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyChains, &m.Chains, validateChains),
 		params.NewParamSetPair(KeyChainActivationThreshold, &m.ChainActivationThreshold, validateChainActivationThreshold),
+		params.NewParamSetPair(KeyTransferRateLimits, &m.TransferRateLimits, validateTransferRateLimits),
+		params.NewParamSetPair(KeyTransferFreezes, &m.TransferFreezes, validateTransferFreezes),
+		params.NewParamSetPair(KeyFeeSchedules, &m.FeeSchedules, validateFeeSchedules),
 	}
 }
 
@@ -59,6 +72,18 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateTransferRateLimits(m.TransferRateLimits); err != nil {
+		return err
+	}
+
+	if err := validateTransferFreezes(m.TransferFreezes); err != nil {
+		return err
+	}
+
+	if err := validateFeeSchedules(m.FeeSchedules); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -89,3 +114,63 @@ func validateChainActivationThreshold(chainActivationThreshold interface{}) erro
 
 	return nil
 }
+
+func validateTransferRateLimits(transferRateLimits interface{}) error {
+	rateLimits, ok := transferRateLimits.([]TransferRateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for TransferRateLimits: %T", transferRateLimits)
+	}
+
+	seen := make(map[string]bool)
+	for _, rateLimit := range rateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "invalid transfer rate limit")
+		}
+
+		key := strings.ToLower(rateLimit.Chain + "_" + rateLimit.Asset)
+		if seen[key] {
+			return fmt.Errorf("duplicate transfer rate limit for asset %s on chain %s", rateLimit.Asset, rateLimit.Chain)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func validateTransferFreezes(transferFreezes interface{}) error {
+	freezes, ok := transferFreezes.([]TransferFreeze)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for TransferFreezes: %T", transferFreezes)
+	}
+
+	for _, freeze := range freezes {
+		if err := freeze.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "invalid transfer freeze")
+		}
+	}
+
+	return nil
+}
+
+func validateFeeSchedules(feeSchedules interface{}) error {
+	schedules, ok := feeSchedules.([]FeeSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for FeeSchedules: %T", feeSchedules)
+	}
+
+	seen := make(map[string]bool)
+	for _, schedule := range schedules {
+		if err := schedule.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "invalid fee schedule")
+		}
+
+		key := strings.ToLower(schedule.SourceChain + "_" + schedule.DestinationChain + "_" + schedule.Asset)
+		if seen[key] {
+			return fmt.Errorf("duplicate fee schedule for asset %s from chain %s to chain %s",
+				schedule.Asset, schedule.SourceChain, schedule.DestinationChain)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
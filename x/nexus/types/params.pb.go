@@ -27,8 +27,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params represent the genesis parameters for the module
 type Params struct {
-	Chains                   []exported.Chain `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains"`
-	ChainActivationThreshold utils.Threshold  `protobuf:"bytes,2,opt,name=chain_activation_threshold,json=chainActivationThreshold,proto3" json:"chain_activation_threshold"`
+	Chains                   []exported.Chain    `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains"`
+	ChainActivationThreshold utils.Threshold     `protobuf:"bytes,2,opt,name=chain_activation_threshold,json=chainActivationThreshold,proto3" json:"chain_activation_threshold"`
+	TransferRateLimits       []TransferRateLimit `protobuf:"bytes,3,rep,name=transfer_rate_limits,json=transferRateLimits,proto3" json:"transfer_rate_limits"`
+	TransferFreezes          []TransferFreeze    `protobuf:"bytes,4,rep,name=transfer_freezes,json=transferFreezes,proto3" json:"transfer_freezes"`
+	FeeSchedules             []FeeSchedule       `protobuf:"bytes,5,rep,name=fee_schedules,json=feeSchedules,proto3" json:"fee_schedules"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,25 +74,31 @@ func init() {
 func init() { proto.RegisterFile("nexus/v1beta1/params.proto", fileDescriptor_d5f543f4e48d22e3) }
 
 var fileDescriptor_d5f543f4e48d22e3 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x6d, 0x40, 0x19, 0x52, 0xb1, 0x44, 0x0c, 0x51, 0xa4, 0x9a, 0xaa, 0x53, 0x17, 0x6c,
-	0x35, 0x8c, 0x4c, 0xc0, 0x0b, 0x54, 0x88, 0x09, 0x21, 0x55, 0x4e, 0x6a, 0x25, 0x16, 0x69, 0x2e,
-	0x72, 0x9c, 0x12, 0xde, 0x82, 0x77, 0xe0, 0x65, 0x32, 0x76, 0x64, 0x42, 0x90, 0xbc, 0x08, 0xaa,
-	0xe3, 0x24, 0xdb, 0x59, 0xdf, 0xdd, 0x77, 0xff, 0xd9, 0x0d, 0x72, 0x51, 0x57, 0x25, 0x3b, 0xac,
-	0x23, 0xa1, 0xf9, 0x9a, 0x15, 0x5c, 0xf1, 0x7d, 0x49, 0x0b, 0x05, 0x1a, 0xbc, 0x4b, 0xc3, 0xa8,
-	0x65, 0xc1, 0x55, 0x02, 0x09, 0x18, 0xc2, 0x4e, 0x55, 0xdf, 0x14, 0xcc, 0x2b, 0x2d, 0xb3, 0x49,
-	0xa0, 0x53, 0x25, 0xca, 0x14, 0xb2, 0x9d, 0xc5, 0xcb, 0xde, 0x2f, 0xea, 0x02, 0x94, 0x16, 0xbb,
-	0xa9, 0xef, 0xa3, 0x10, 0x76, 0xcf, 0xf2, 0x0b, 0xbb, 0xce, 0xc6, 0x2c, 0xf6, 0xee, 0x5c, 0x27,
-	0x4e, 0xb9, 0xcc, 0x4b, 0x1f, 0x2f, 0xce, 0x57, 0xb3, 0x70, 0x4e, 0xfb, 0x0c, 0xc3, 0xfc, 0x10,
-	0x86, 0x3e, 0x9e, 0xba, 0x1e, 0x2e, 0x9a, 0x9f, 0x6b, 0xf4, 0x64, 0x47, 0xbc, 0x57, 0x37, 0x30,
-	0xd5, 0x96, 0xc7, 0x5a, 0x1e, 0xb8, 0x96, 0x90, 0x6f, 0xc7, 0x3c, 0xfe, 0xd9, 0x02, 0xaf, 0x66,
-	0xa1, 0x4f, 0x4d, 0xde, 0xd1, 0xf3, 0x3c, 0x70, 0xeb, 0xf2, 0x8d, 0xe1, 0x7e, 0x14, 0x4c, 0x7c,
-	0xd3, 0xfc, 0x11, 0xd4, 0xb4, 0x04, 0x1f, 0x5b, 0x82, 0x7f, 0x5b, 0x82, 0x3f, 0x3b, 0x82, 0x8e,
-	0x1d, 0x41, 0xdf, 0x1d, 0x41, 0x2f, 0x61, 0x22, 0x75, 0x5a, 0x45, 0x34, 0x86, 0x3d, 0xe3, 0xb5,
-	0xc8, 0xb8, 0xca, 0x85, 0x7e, 0x07, 0xf5, 0x66, 0x5f, 0x37, 0x31, 0x28, 0xc1, 0x6a, 0xd6, 0x7f,
-	0x87, 0xb9, 0x3e, 0x72, 0xcc, 0xf9, 0xb7, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x59, 0x4a, 0x6b,
-	0xd2, 0x84, 0x01, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x31, 0x6f, 0xda, 0x40,
+	0x14, 0xc7, 0xed, 0x42, 0x19, 0x4c, 0x51, 0x2b, 0x8b, 0xc1, 0xb5, 0x84, 0x8b, 0x98, 0x58, 0x7a,
+	0x16, 0x74, 0xec, 0x54, 0xaa, 0x32, 0x55, 0x11, 0x22, 0x0c, 0x51, 0x14, 0xc9, 0x3a, 0xcc, 0x33,
+	0xb6, 0x62, 0x7c, 0xd6, 0xdd, 0x33, 0x71, 0xf2, 0x29, 0x32, 0xe4, 0x43, 0x31, 0x32, 0x66, 0x8a,
+	0x12, 0xf8, 0x22, 0x11, 0xe7, 0xc3, 0xc4, 0x6c, 0xe7, 0xfb, 0xfd, 0xfd, 0xf3, 0xff, 0xf9, 0x19,
+	0x76, 0x02, 0x79, 0x26, 0xdc, 0xf5, 0x60, 0x0e, 0x48, 0x07, 0x6e, 0x4a, 0x39, 0x5d, 0x09, 0x92,
+	0x72, 0x86, 0xcc, 0x6c, 0x49, 0x46, 0x14, 0xb3, 0xdb, 0x4b, 0xb6, 0x64, 0x92, 0xb8, 0x87, 0x53,
+	0x11, 0xb2, 0x3b, 0x19, 0x46, 0xf1, 0x49, 0x80, 0x21, 0x07, 0x11, 0xb2, 0x78, 0xa1, 0x70, 0xaf,
+	0xf0, 0x43, 0x9e, 0x32, 0x8e, 0xb0, 0x38, 0xe5, 0xee, 0x53, 0x50, 0xdf, 0xb1, 0xbf, 0x57, 0x3b,
+	0x7c, 0x40, 0xbd, 0xa7, 0x9a, 0xd1, 0x98, 0xc8, 0x4e, 0xe6, 0x6f, 0xa3, 0xe1, 0x87, 0x34, 0x4a,
+	0x84, 0xa5, 0x77, 0x6b, 0xfd, 0xe6, 0xb0, 0x43, 0x8a, 0x7a, 0x47, 0xf5, 0xb1, 0x27, 0xf9, 0x7b,
+	0x48, 0x8d, 0xea, 0x9b, 0x97, 0x1f, 0xda, 0x54, 0xbd, 0x62, 0xde, 0x18, 0xb6, 0x3c, 0x79, 0xd4,
+	0xc7, 0x68, 0x4d, 0x31, 0x62, 0x89, 0x57, 0x56, 0xb5, 0x3e, 0x75, 0xf5, 0x7e, 0x73, 0x68, 0x11,
+	0x39, 0x4a, 0xe9, 0x99, 0x1d, 0xb9, 0x72, 0x59, 0xd2, 0xf0, 0xa7, 0x14, 0x94, 0xdc, 0xbc, 0x32,
+	0xda, 0xc8, 0x69, 0x22, 0x02, 0xe0, 0x1e, 0xa7, 0x08, 0x5e, 0x1c, 0xad, 0x22, 0x14, 0x56, 0x4d,
+	0x16, 0xed, 0x92, 0xca, 0x7f, 0x24, 0x33, 0x15, 0x9d, 0x52, 0x84, 0xff, 0x87, 0xa0, 0xf2, 0x9b,
+	0x78, 0x0e, 0x84, 0x79, 0x61, 0x7c, 0x2b, 0xcd, 0x01, 0x07, 0x78, 0x00, 0x61, 0xd5, 0x2b, 0xe3,
+	0x9f, 0x5b, 0xc7, 0x32, 0xa5, 0x94, 0x5f, 0xb1, 0x72, 0x2b, 0xcc, 0x7f, 0x46, 0x2b, 0x00, 0xf0,
+	0x84, 0x1f, 0xc2, 0x22, 0x8b, 0x41, 0x58, 0x9f, 0xa5, 0xcc, 0x3e, 0x93, 0x8d, 0x01, 0x2e, 0x55,
+	0x44, 0x99, 0xbe, 0x04, 0xa7, 0x2b, 0x31, 0x9a, 0x6c, 0xde, 0x1c, 0x6d, 0xb3, 0x73, 0xf4, 0xed,
+	0xce, 0xd1, 0x5f, 0x77, 0x8e, 0xfe, 0xb8, 0x77, 0xb4, 0xed, 0xde, 0xd1, 0x9e, 0xf7, 0x8e, 0x76,
+	0x3d, 0x5c, 0x46, 0x18, 0x66, 0x73, 0xe2, 0xb3, 0x95, 0x4b, 0x73, 0x88, 0x29, 0x4f, 0x00, 0xef,
+	0x18, 0xbf, 0x55, 0x4f, 0x3f, 0x7d, 0xc6, 0xc1, 0xcd, 0xdd, 0x62, 0xed, 0x72, 0xdd, 0xf3, 0x86,
+	0xdc, 0xf7, 0xaf, 0xf7, 0x01, 0x00, 0xa3, 0x1c, 0xb1, 0xf2, 0x90, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +121,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeSchedules) > 0 {
+		for iNdEx := len(m.FeeSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TransferFreezes) > 0 {
+		for iNdEx := len(m.TransferFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TransferRateLimits) > 0 {
+		for iNdEx := len(m.TransferRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.ChainActivationThreshold.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -164,6 +215,24 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ChainActivationThreshold.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.TransferRateLimits) > 0 {
+		for _, e := range m.TransferRateLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.TransferFreezes) > 0 {
+		for _, e := range m.TransferFreezes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.FeeSchedules) > 0 {
+		for _, e := range m.FeeSchedules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +338,108 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferRateLimits = append(m.TransferRateLimits, TransferRateLimit{})
+			if err := m.TransferRateLimits[len(m.TransferRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFreezes = append(m.TransferFreezes, TransferFreeze{})
+			if err := m.TransferFreezes[len(m.TransferFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSchedules = append(m.FeeSchedules, FeeSchedule{})
+			if err := m.FeeSchedules[len(m.FeeSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
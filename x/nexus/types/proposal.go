@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// proposal types
+const (
+	ProposalTypeRegisterAsset = "RegisterAsset"
+)
+
+var _ govtypes.Content = &RegisterAssetProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRegisterAsset)
+	govtypes.RegisterProposalTypeCodec(&RegisterAssetProposal{}, "nexus/RegisterAssetProposal")
+}
+
+// NewRegisterAssetProposal is the constructor for RegisterAssetProposal
+func NewRegisterAssetProposal(title, description, chain, asset string) *RegisterAssetProposal {
+	return &RegisterAssetProposal{
+		Title:       title,
+		Description: description,
+		Chain:       chain,
+		Asset:       asset,
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (m RegisterAssetProposal) GetTitle() string { return m.Title }
+
+// GetDescription implements govtypes.Content
+func (m RegisterAssetProposal) GetDescription() string { return m.Description }
+
+// ProposalRoute implements govtypes.Content
+func (m RegisterAssetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (m RegisterAssetProposal) ProposalType() string { return ProposalTypeRegisterAsset }
+
+// ValidateBasic implements govtypes.Content
+func (m RegisterAssetProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if m.Asset == "" {
+		return fmt.Errorf("missing asset")
+	}
+
+	return nil
+}
+
+// String implements govtypes.Content
+func (m RegisterAssetProposal) String() string {
+	return fmt.Sprintf(`Register Asset Proposal:
+  Title:       %s
+  Description: %s
+  Chain:       %s
+  Asset:       %s
+`, m.Title, m.Description, m.Chain, m.Asset)
+}
@@ -35,26 +35,46 @@ func init() {
 }
 
 var fileDescriptor_e8a22d972057ace6 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4a, 0x03, 0x31,
-	0x18, 0xc7, 0x9b, 0x0e, 0x0e, 0x07, 0x2e, 0x87, 0x28, 0x56, 0xc9, 0x50, 0x10, 0xa1, 0xd8, 0xa4,
-	0x3d, 0x37, 0x47, 0x75, 0x2d, 0x48, 0xdd, 0xdc, 0x72, 0xe7, 0x47, 0x1a, 0xac, 0xf9, 0xce, 0x24,
-	0x57, 0xcf, 0xd5, 0x27, 0x10, 0x7c, 0x0b, 0x47, 0x9f, 0xc0, 0xd1, 0xb1, 0xe0, 0xe2, 0x28, 0x77,
-	0xce, 0x3e, 0x83, 0x78, 0x17, 0x87, 0x13, 0x0f, 0xba, 0x25, 0xf9, 0xff, 0xbe, 0xef, 0xff, 0x83,
-	0x04, 0x3b, 0x1a, 0xf2, 0xcc, 0xf2, 0xc5, 0x38, 0x06, 0x27, 0xc6, 0xdc, 0x82, 0x59, 0xa8, 0x04,
-	0x58, 0x6a, 0xd0, 0x61, 0xb8, 0x5e, 0x85, 0xcc, 0x87, 0xbd, 0x0d, 0x89, 0x12, 0xab, 0x84, 0xff,
-	0x9c, 0x6a, 0xa8, 0xb7, 0x2b, 0x11, 0xe5, 0x1c, 0xb8, 0x48, 0x15, 0x17, 0x5a, 0xa3, 0x13, 0x4e,
-	0xa1, 0xb6, 0x3e, 0xdd, 0x6c, 0xee, 0x77, 0x79, 0xfd, 0x1e, 0x7d, 0x75, 0x83, 0x60, 0x62, 0xe5,
-	0x79, 0xdd, 0x17, 0x3e, 0x91, 0x60, 0x6b, 0x0a, 0x52, 0x59, 0x07, 0xe6, 0x64, 0x26, 0x94, 0x9e,
-	0x08, 0xa5, 0x9d, 0x50, 0x1a, 0x4c, 0x38, 0x64, 0x0d, 0x0d, 0xd6, 0xc2, 0x4d, 0xe1, 0x26, 0x03,
-	0xeb, 0x7a, 0x6c, 0x55, 0xdc, 0xa6, 0xa8, 0x2d, 0xf4, 0x47, 0xf7, 0x6f, 0x9f, 0x8f, 0xdd, 0x41,
-	0x7f, 0x8f, 0x8b, 0x1c, 0xe6, 0xc2, 0xf0, 0xda, 0xd8, 0xfc, 0x3f, 0x76, 0x44, 0x06, 0xe1, 0x33,
-	0x09, 0xb6, 0x4f, 0xa1, 0x05, 0x08, 0xf9, 0x9f, 0xfe, 0x56, 0xf2, 0x57, 0x78, 0xb4, 0xfa, 0x80,
-	0x57, 0x8e, 0x2a, 0xe5, 0x83, 0xfe, 0x7e, 0x53, 0xf9, 0x12, 0xda, 0xa5, 0x8f, 0xcf, 0x5e, 0x0b,
-	0x4a, 0x96, 0x05, 0x25, 0x1f, 0x05, 0x25, 0x0f, 0x25, 0xed, 0xbc, 0x94, 0x94, 0x2c, 0x4b, 0xda,
-	0x79, 0x2f, 0x69, 0xe7, 0x22, 0x92, 0xca, 0xcd, 0xb2, 0x98, 0x25, 0x78, 0xed, 0x77, 0x6a, 0x70,
-	0xb7, 0x68, 0xae, 0xfc, 0x6d, 0x98, 0xa0, 0x01, 0x9e, 0xfb, 0x22, 0x77, 0x97, 0x82, 0x8d, 0xd7,
-	0xaa, 0x9f, 0x3c, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x4f, 0xac, 0x63, 0xbf, 0x43, 0x02, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x19, 0x0e, 0x44, 0x26, 0x98, 0xe0, 0x84, 0x20, 0x14, 0x5c, 0xa1, 0x40, 0x40, 0xa4,
+	0x1d, 0x28, 0xd1, 0x18, 0x4d, 0x4c, 0x44, 0xc3, 0x49, 0x12, 0x05, 0x4f, 0xde, 0xa6, 0xdd, 0xc7,
+	0xb2, 0xb1, 0xcc, 0x94, 0x99, 0x59, 0x6c, 0x25, 0x1c, 0xf4, 0x60, 0xe2, 0x8d, 0xc4, 0xbb, 0x47,
+	0x0f, 0xc6, 0x93, 0x9f, 0xc0, 0xa3, 0x47, 0x12, 0x2f, 0x1e, 0x0d, 0xf5, 0xec, 0x67, 0x30, 0x9d,
+	0xce, 0xae, 0xdd, 0x75, 0x77, 0x29, 0x07, 0x6f, 0xed, 0xbc, 0xdf, 0x7b, 0xff, 0xdf, 0xbc, 0x66,
+	0x52, 0x3c, 0xc5, 0xa1, 0x19, 0x28, 0x7a, 0xb8, 0x56, 0x05, 0xcd, 0xd6, 0xa8, 0x02, 0x79, 0xe8,
+	0xd7, 0xa0, 0xdc, 0x90, 0x42, 0x0b, 0x72, 0xd9, 0x14, 0xcb, 0xb6, 0x58, 0x18, 0xf3, 0x84, 0x27,
+	0x4c, 0x85, 0x76, 0x3e, 0x75, 0xa1, 0xc2, 0xb4, 0x27, 0x84, 0x57, 0x07, 0xca, 0x1a, 0x3e, 0x65,
+	0x9c, 0x0b, 0xcd, 0xb4, 0x2f, 0xb8, 0xb2, 0xd5, 0xf1, 0xf8, 0x7c, 0xdd, 0xb4, 0xe7, 0x93, 0xf1,
+	0xf3, 0x83, 0x00, 0x64, 0xab, 0x5b, 0xaa, 0xfc, 0x1e, 0xc4, 0x78, 0x4b, 0x79, 0x3b, 0x5d, 0x15,
+	0xf2, 0x09, 0xe1, 0xab, 0xdb, 0xe0, 0xf9, 0x4a, 0x83, 0x7c, 0xb8, 0xc7, 0x7c, 0xbe, 0xc5, 0x7c,
+	0xae, 0x99, 0xcf, 0x41, 0x92, 0x52, 0x39, 0x66, 0x58, 0xce, 0xe0, 0xb6, 0xe1, 0x20, 0x00, 0xa5,
+	0x0b, 0xe5, 0x7e, 0x71, 0xd5, 0x10, 0x5c, 0x41, 0x71, 0xf5, 0xcd, 0xf7, 0x5f, 0xef, 0x07, 0x97,
+	0x8b, 0x0b, 0x94, 0x35, 0xa1, 0xce, 0x24, 0xed, 0x4a, 0xcb, 0xf4, 0xb6, 0xbb, 0x68, 0x99, 0x7c,
+	0x41, 0x78, 0xf2, 0x11, 0x64, 0x00, 0x84, 0x26, 0xf2, 0x33, 0xc9, 0x50, 0x78, 0xb5, 0xff, 0x06,
+	0xab, 0x5c, 0x31, 0xca, 0x2b, 0xc5, 0xc5, 0xb8, 0xb2, 0x0b, 0xd9, 0xd2, 0x95, 0x77, 0x18, 0x8f,
+	0x3c, 0xed, 0xfc, 0x00, 0xe1, 0xca, 0x3f, 0x20, 0x3c, 0x9a, 0xe0, 0x14, 0xb9, 0x99, 0x70, 0x31,
+	0x1d, 0x49, 0x2a, 0x14, 0x5f, 0xe9, 0x0f, 0xb6, 0xd2, 0xd4, 0x48, 0xdf, 0x20, 0x09, 0xe9, 0x5a,
+	0x87, 0x2f, 0xed, 0xff, 0x6d, 0xa0, 0x47, 0xe6, 0xe8, 0x98, 0xec, 0xe3, 0x21, 0x33, 0x4c, 0x91,
+	0xd9, 0xcc, 0xa0, 0xc8, 0xa5, 0x98, 0x87, 0x58, 0x83, 0x69, 0x63, 0x30, 0x4e, 0xc6, 0x52, 0x0c,
+	0x14, 0x79, 0x8d, 0xf0, 0xf0, 0x33, 0xc9, 0xb8, 0xda, 0xed, 0x2c, 0x62, 0x3e, 0x6d, 0x5e, 0x54,
+	0x0e, 0x53, 0x17, 0xce, 0xa1, 0x6c, 0xf0, 0xa2, 0x09, 0x9e, 0x25, 0xd7, 0xe3, 0xc1, 0x3a, 0x04,
+	0xa3, 0x2b, 0x07, 0xf8, 0x52, 0xd8, 0x4d, 0xe6, 0xf2, 0x66, 0x87, 0x02, 0xf3, 0xf9, 0x90, 0xcd,
+	0x77, 0x4c, 0xfe, 0x04, 0x19, 0x4f, 0xcf, 0x27, 0x1f, 0x11, 0xbe, 0x12, 0x59, 0x6f, 0xb4, 0x76,
+	0x80, 0xbb, 0x29, 0xef, 0x2e, 0x7e, 0xb9, 0x8d, 0xd6, 0x03, 0xd7, 0x95, 0xa0, 0x2e, 0xba, 0x8b,
+	0x7b, 0xc6, 0xe5, 0x16, 0x59, 0xcf, 0xd8, 0x45, 0xa9, 0xda, 0x2a, 0x29, 0x23, 0x10, 0x6e, 0x85,
+	0x1e, 0xb1, 0x6e, 0xd2, 0x31, 0xf9, 0x8c, 0xf0, 0x58, 0x8f, 0xc1, 0x36, 0xd4, 0xfc, 0x86, 0x0f,
+	0x5c, 0xff, 0x27, 0xd7, 0xfb, 0xc6, 0xf5, 0x0e, 0xb9, 0x9d, 0xe3, 0x2a, 0x43, 0x87, 0x14, 0xdd,
+	0x13, 0x84, 0x47, 0x7b, 0xf7, 0xaa, 0x99, 0x86, 0xf4, 0x27, 0x96, 0xa4, 0x2e, 0x28, 0xba, 0x64,
+	0x44, 0x8b, 0x64, 0x26, 0x6f, 0xa9, 0x26, 0xfd, 0x2d, 0xc2, 0x23, 0x9b, 0x00, 0x3b, 0xb5, 0x3d,
+	0x70, 0x83, 0x3a, 0x28, 0xb2, 0x98, 0x96, 0xd0, 0x4b, 0x84, 0x2a, 0x4b, 0xe7, 0x83, 0xd6, 0x66,
+	0xce, 0xd8, 0x5c, 0x23, 0x53, 0x71, 0x9b, 0x5d, 0x80, 0x92, 0x8a, 0x72, 0x5f, 0xe1, 0xe1, 0x4d,
+	0x80, 0xc7, 0xe0, 0x7a, 0x20, 0xd3, 0x5f, 0x5b, 0x54, 0xce, 0x5d, 0x46, 0x0f, 0x65, 0xe3, 0x67,
+	0x4c, 0x7c, 0x81, 0x4c, 0xfc, 0x1b, 0x5f, 0x37, 0xe4, 0xc6, 0x93, 0x6f, 0x67, 0x0e, 0x3a, 0x3d,
+	0x73, 0xd0, 0xcf, 0x33, 0x07, 0x9d, 0xb4, 0x9d, 0x81, 0xaf, 0x6d, 0x07, 0x9d, 0xb6, 0x9d, 0x81,
+	0x1f, 0x6d, 0x67, 0xe0, 0x79, 0xc5, 0xf3, 0xf5, 0x5e, 0x50, 0x2d, 0xd7, 0xc4, 0xbe, 0x9d, 0xc0,
+	0x41, 0xbf, 0x14, 0xf2, 0x85, 0xfd, 0x56, 0xaa, 0x09, 0x09, 0xb4, 0x19, 0xee, 0xb8, 0xd5, 0x00,
+	0x55, 0x1d, 0x32, 0xff, 0x6a, 0xeb, 0x7f, 0x06, 0x00, 0xff, 0xaf, 0x95, 0x64, 0x6a, 0x07, 0x00,
 	0x00,
 }
 
@@ -173,3 +193,363 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nexus/v1beta1/service.proto",
 }
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	ChainMaintainers(ctx context.Context, in *QueryChainMaintainersRequest, opts ...grpc.CallOption) (*QueryChainMaintainersResponse, error)
+	Chains(ctx context.Context, in *QueryChainsRequest, opts ...grpc.CallOption) (*QueryChainsResponse, error)
+	Transfers(ctx context.Context, in *QueryTransfersRequest, opts ...grpc.CallOption) (*QueryTransfersResponse, error)
+	Transfer(ctx context.Context, in *QueryTransferRequest, opts ...grpc.CallOption) (*QueryTransferResponse, error)
+	TransfersBySender(ctx context.Context, in *QueryTransfersByAddressRequest, opts ...grpc.CallOption) (*QueryTransfersResponse, error)
+	TransfersByRecipient(ctx context.Context, in *QueryTransfersByAddressRequest, opts ...grpc.CallOption) (*QueryTransfersResponse, error)
+	TransfersByState(ctx context.Context, in *QueryTransfersByStateRequest, opts ...grpc.CallOption) (*QueryTransfersResponse, error)
+	FeeSchedules(ctx context.Context, in *QueryFeeSchedulesRequest, opts ...grpc.CallOption) (*QueryFeeSchedulesResponse, error)
+	FeeLedger(ctx context.Context, in *QueryFeeLedgerRequest, opts ...grpc.CallOption) (*QueryFeeLedgerResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) ChainMaintainers(ctx context.Context, in *QueryChainMaintainersRequest, opts ...grpc.CallOption) (*QueryChainMaintainersResponse, error) {
+	out := new(QueryChainMaintainersResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.QueryService/ChainMaintainers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Chains(ctx context.Context, in *QueryChainsRequest, opts ...grpc.CallOption) (*QueryChainsResponse, error) {
+	out := new(QueryChainsResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.QueryService/Chains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Transfers(ctx context.Context, in *QueryTransfersRequest, opts ...grpc.CallOption) (*QueryTransfersResponse, error) {
+	out := new(QueryTransfersResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.QueryService/Transfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Transfer(ctx context.Context, in *QueryTransferRequest, opts ...grpc.CallOption) (*QueryTransferResponse, error) {
+	out := new(QueryTransferResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.QueryService/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) TransfersBySender(ctx context.Context, in *QueryTransfersByAddressRequest, opts ...grpc.CallOption) (*QueryTransfersResponse, error) {
+	out := new(QueryTransfersResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.QueryService/TransfersBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) TransfersByRecipient(ctx context.Context, in *QueryTransfersByAddressRequest, opts ...grpc.CallOption) (*QueryTransfersResponse, error) {
+	out := new(QueryTransfersResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.QueryService/TransfersByRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) TransfersByState(ctx context.Context, in *QueryTransfersByStateRequest, opts ...grpc.CallOption) (*QueryTransfersResponse, error) {
+	out := new(QueryTransfersResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.QueryService/TransfersByState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) FeeSchedules(ctx context.Context, in *QueryFeeSchedulesRequest, opts ...grpc.CallOption) (*QueryFeeSchedulesResponse, error) {
+	out := new(QueryFeeSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.QueryService/FeeSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) FeeLedger(ctx context.Context, in *QueryFeeLedgerRequest, opts ...grpc.CallOption) (*QueryFeeLedgerResponse, error) {
+	out := new(QueryFeeLedgerResponse)
+	err := c.cc.Invoke(ctx, "/nexus.v1beta1.QueryService/FeeLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	ChainMaintainers(context.Context, *QueryChainMaintainersRequest) (*QueryChainMaintainersResponse, error)
+	Chains(context.Context, *QueryChainsRequest) (*QueryChainsResponse, error)
+	Transfers(context.Context, *QueryTransfersRequest) (*QueryTransfersResponse, error)
+	Transfer(context.Context, *QueryTransferRequest) (*QueryTransferResponse, error)
+	TransfersBySender(context.Context, *QueryTransfersByAddressRequest) (*QueryTransfersResponse, error)
+	TransfersByRecipient(context.Context, *QueryTransfersByAddressRequest) (*QueryTransfersResponse, error)
+	TransfersByState(context.Context, *QueryTransfersByStateRequest) (*QueryTransfersResponse, error)
+	FeeSchedules(context.Context, *QueryFeeSchedulesRequest) (*QueryFeeSchedulesResponse, error)
+	FeeLedger(context.Context, *QueryFeeLedgerRequest) (*QueryFeeLedgerResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) ChainMaintainers(ctx context.Context, req *QueryChainMaintainersRequest) (*QueryChainMaintainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainMaintainers not implemented")
+}
+func (*UnimplementedQueryServiceServer) Chains(ctx context.Context, req *QueryChainsRequest) (*QueryChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chains not implemented")
+}
+func (*UnimplementedQueryServiceServer) Transfers(ctx context.Context, req *QueryTransfersRequest) (*QueryTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfers not implemented")
+}
+func (*UnimplementedQueryServiceServer) Transfer(ctx context.Context, req *QueryTransferRequest) (*QueryTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (*UnimplementedQueryServiceServer) TransfersBySender(ctx context.Context, req *QueryTransfersByAddressRequest) (*QueryTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransfersBySender not implemented")
+}
+func (*UnimplementedQueryServiceServer) TransfersByRecipient(ctx context.Context, req *QueryTransfersByAddressRequest) (*QueryTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransfersByRecipient not implemented")
+}
+func (*UnimplementedQueryServiceServer) TransfersByState(ctx context.Context, req *QueryTransfersByStateRequest) (*QueryTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransfersByState not implemented")
+}
+func (*UnimplementedQueryServiceServer) FeeSchedules(ctx context.Context, req *QueryFeeSchedulesRequest) (*QueryFeeSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSchedules not implemented")
+}
+func (*UnimplementedQueryServiceServer) FeeLedger(ctx context.Context, req *QueryFeeLedgerRequest) (*QueryFeeLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeLedger not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_ChainMaintainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainMaintainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ChainMaintainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.QueryService/ChainMaintainers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ChainMaintainers(ctx, req.(*QueryChainMaintainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Chains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Chains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.QueryService/Chains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Chains(ctx, req.(*QueryChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Transfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Transfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.QueryService/Transfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Transfers(ctx, req.(*QueryTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.QueryService/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Transfer(ctx, req.(*QueryTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_TransfersBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransfersByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).TransfersBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.QueryService/TransfersBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).TransfersBySender(ctx, req.(*QueryTransfersByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_TransfersByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransfersByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).TransfersByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.QueryService/TransfersByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).TransfersByRecipient(ctx, req.(*QueryTransfersByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_TransfersByState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransfersByStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).TransfersByState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.QueryService/TransfersByState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).TransfersByState(ctx, req.(*QueryTransfersByStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_FeeSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).FeeSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.QueryService/FeeSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).FeeSchedules(ctx, req.(*QueryFeeSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_FeeLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).FeeLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexus.v1beta1.QueryService/FeeLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).FeeLedger(ctx, req.(*QueryFeeLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nexus.v1beta1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChainMaintainers",
+			Handler:    _QueryService_ChainMaintainers_Handler,
+		},
+		{
+			MethodName: "Chains",
+			Handler:    _QueryService_Chains_Handler,
+		},
+		{
+			MethodName: "Transfers",
+			Handler:    _QueryService_Transfers_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _QueryService_Transfer_Handler,
+		},
+		{
+			MethodName: "TransfersBySender",
+			Handler:    _QueryService_TransfersBySender_Handler,
+		},
+		{
+			MethodName: "TransfersByRecipient",
+			Handler:    _QueryService_TransfersByRecipient_Handler,
+		},
+		{
+			MethodName: "TransfersByState",
+			Handler:    _QueryService_TransfersByState_Handler,
+		},
+		{
+			MethodName: "FeeSchedules",
+			Handler:    _QueryService_FeeSchedules_Handler,
+		},
+		{
+			MethodName: "FeeLedger",
+			Handler:    _QueryService_FeeLedger_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nexus/v1beta1/service.proto",
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -44,3 +45,156 @@ func (m *ChainState) RemoveMaintainer(maintainer sdk.ValAddress) error {
 
 	return nil
 }
+
+// Validate returns an error if the rate limit is invalid
+func (m TransferRateLimit) Validate() error {
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if err := sdk.ValidateDenom(m.Asset); err != nil {
+		return err
+	}
+
+	if m.Limit.IsNil() || m.Limit.IsNegative() {
+		return fmt.Errorf("limit must be >=0")
+	}
+
+	if m.Window <= 0 {
+		return fmt.Errorf("window must be >0")
+	}
+
+	return nil
+}
+
+// Validate returns an error if the freeze is invalid
+func (m TransferFreeze) Validate() error {
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if m.Asset != "" {
+		if err := sdk.ValidateDenom(m.Asset); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the freeze applies to the given asset on the given chain
+func (m TransferFreeze) Matches(chain string, asset string) bool {
+	return strings.EqualFold(m.Chain, chain) && (m.Asset == "" || strings.EqualFold(m.Asset, asset))
+}
+
+// Validate returns an error if the transfer flow is invalid
+func (m TransferFlow) Validate() error {
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain")
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if m.Height < 0 {
+		return fmt.Errorf("height must be >=0")
+	}
+
+	return nil
+}
+
+// Validate returns an error if the fee schedule is invalid
+func (m FeeSchedule) Validate() error {
+	if m.Asset != "" {
+		if err := sdk.ValidateDenom(m.Asset); err != nil {
+			return err
+		}
+	}
+
+	if m.Rate.IsNil() || m.Rate.IsNegative() || m.Rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("rate must be >=0 and <=1")
+	}
+
+	if m.FlatFee.IsNil() || m.FlatFee.IsNegative() {
+		return fmt.Errorf("flat fee must be >=0")
+	}
+
+	if m.MinFee.IsNil() || m.MinFee.IsNegative() {
+		return fmt.Errorf("min fee must be >=0")
+	}
+
+	if m.MaxFee.IsNil() || m.MaxFee.IsNegative() {
+		return fmt.Errorf("max fee must be >=0")
+	}
+
+	if m.MaxFee.IsPositive() && m.MaxFee.LT(m.MinFee) {
+		return fmt.Errorf("max fee must be >= min fee")
+	}
+
+	return nil
+}
+
+// Matches returns true if the fee schedule applies to transfers of the given asset from the source to the destination chain.
+// Empty arguments match any fee schedule
+func (m FeeSchedule) Matches(sourceChain string, destinationChain string, asset string) bool {
+	return matchesFeeScheduleField(m.SourceChain, sourceChain) &&
+		matchesFeeScheduleField(m.DestinationChain, destinationChain) &&
+		matchesFeeScheduleField(m.Asset, asset)
+}
+
+// Specificity returns the number of fields the fee schedule is restricted to
+func (m FeeSchedule) Specificity() int {
+	specificity := 0
+	for _, field := range []string{m.SourceChain, m.DestinationChain, m.Asset} {
+		if field != "" {
+			specificity++
+		}
+	}
+
+	return specificity
+}
+
+// ComputeFee returns the fee due for transferring the given amount
+func (m FeeSchedule) ComputeFee(amount sdk.Int) sdk.Int {
+	fee := m.Rate.MulInt(amount).TruncateInt().Add(m.FlatFee)
+
+	if fee.LT(m.MinFee) {
+		fee = m.MinFee
+	}
+
+	if m.MaxFee.IsPositive() && fee.GT(m.MaxFee) {
+		fee = m.MaxFee
+	}
+
+	return fee
+}
+
+// Validate returns an error if the fee ledger entry is invalid
+func (m FeeLedgerEntry) Validate() error {
+	if m.SourceChain == "" {
+		return fmt.Errorf("missing source chain")
+	}
+
+	if m.DestinationChain == "" {
+		return fmt.Errorf("missing destination chain")
+	}
+
+	if err := m.Collected.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Matches returns true if the fee ledger entry records fees of the given asset collected for transfers from the source to the destination chain.
+// Empty arguments match any fee ledger entry
+func (m FeeLedgerEntry) Matches(sourceChain string, destinationChain string, asset string) bool {
+	return matchesFeeScheduleField(m.SourceChain, sourceChain) &&
+		matchesFeeScheduleField(m.DestinationChain, destinationChain) &&
+		matchesFeeScheduleField(m.Collected.Denom, asset)
+}
+
+func matchesFeeScheduleField(field string, value string) bool {
+	return field == "" || value == "" || strings.EqualFold(field, value)
+}
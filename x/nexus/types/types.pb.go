@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -65,32 +66,357 @@ func (m *ChainState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChainState proto.InternalMessageInfo
 
+// LinkedAddresses represents a deposit address and the recipient address it
+// is linked to
+type LinkedAddresses struct {
+	DepositAddress   exported.CrossChainAddress `protobuf:"bytes,1,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address"`
+	RecipientAddress exported.CrossChainAddress `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address"`
+}
+
+func (m *LinkedAddresses) Reset()         { *m = LinkedAddresses{} }
+func (m *LinkedAddresses) String() string { return proto.CompactTextString(m) }
+func (*LinkedAddresses) ProtoMessage()    {}
+func (*LinkedAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1651b8508c88d62f, []int{1}
+}
+func (m *LinkedAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkedAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkedAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkedAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkedAddresses.Merge(m, src)
+}
+func (m *LinkedAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkedAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkedAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkedAddresses proto.InternalMessageInfo
+
+// ChainAssets represents the assets registered for a chain and the total
+// amount of foreign assets the chain holds
+type ChainAssets struct {
+	Chain  string                                   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Assets []string                                 `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
+	Totals github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=totals,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"totals"`
+}
+
+func (m *ChainAssets) Reset()         { *m = ChainAssets{} }
+func (m *ChainAssets) String() string { return proto.CompactTextString(m) }
+func (*ChainAssets) ProtoMessage()    {}
+func (*ChainAssets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1651b8508c88d62f, []int{2}
+}
+func (m *ChainAssets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainAssets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainAssets.Merge(m, src)
+}
+func (m *ChainAssets) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainAssets) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainAssets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainAssets proto.InternalMessageInfo
+
+// TransferRateLimit limits the amount of an asset that can be transferred out
+// of a chain within a rolling window of blocks
+type TransferRateLimit struct {
+	Chain  string                                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Asset  string                                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Limit  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"limit"`
+	Window int64                                  `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *TransferRateLimit) Reset()         { *m = TransferRateLimit{} }
+func (m *TransferRateLimit) String() string { return proto.CompactTextString(m) }
+func (*TransferRateLimit) ProtoMessage()    {}
+func (*TransferRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1651b8508c88d62f, []int{3}
+}
+func (m *TransferRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRateLimit.Merge(m, src)
+}
+func (m *TransferRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRateLimit proto.InternalMessageInfo
+
+// TransferFreeze stops all transfers of an asset from and to a chain. An empty
+// asset freezes all assets of the chain
+type TransferFreeze struct {
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+}
+
+func (m *TransferFreeze) Reset()         { *m = TransferFreeze{} }
+func (m *TransferFreeze) String() string { return proto.CompactTextString(m) }
+func (*TransferFreeze) ProtoMessage()    {}
+func (*TransferFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1651b8508c88d62f, []int{4}
+}
+func (m *TransferFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFreeze.Merge(m, src)
+}
+func (m *TransferFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFreeze proto.InternalMessageInfo
+
+// TransferFlow represents the amount of an asset transferred out of a chain at
+// the given block height
+type TransferFlow struct {
+	Chain  string     `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	Height int64      `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TransferFlow) Reset()         { *m = TransferFlow{} }
+func (m *TransferFlow) String() string { return proto.CompactTextString(m) }
+func (*TransferFlow) ProtoMessage()    {}
+func (*TransferFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1651b8508c88d62f, []int{5}
+}
+func (m *TransferFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFlow.Merge(m, src)
+}
+func (m *TransferFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFlow proto.InternalMessageInfo
+
+// FeeSchedule defines the fee charged for transfers of an asset from a source
+// chain to a destination chain. Empty fields match any chain or asset
+type FeeSchedule struct {
+	SourceChain      string                                 `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	DestinationChain string                                 `protobuf:"bytes,2,opt,name=destination_chain,json=destinationChain,proto3" json:"destination_chain,omitempty"`
+	Asset            string                                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Rate             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	FlatFee          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=flat_fee,json=flatFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"flat_fee"`
+	MinFee           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
+	// a max fee of zero does not cap the fee
+	MaxFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_fee"`
+}
+
+func (m *FeeSchedule) Reset()         { *m = FeeSchedule{} }
+func (m *FeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*FeeSchedule) ProtoMessage()    {}
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1651b8508c88d62f, []int{6}
+}
+func (m *FeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSchedule.Merge(m, src)
+}
+func (m *FeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSchedule proto.InternalMessageInfo
+
+// FeeLedgerEntry represents the total fees collected for transfers of an asset
+// from a source chain to a destination chain
+type FeeLedgerEntry struct {
+	SourceChain      string     `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	DestinationChain string     `protobuf:"bytes,2,opt,name=destination_chain,json=destinationChain,proto3" json:"destination_chain,omitempty"`
+	Collected        types.Coin `protobuf:"bytes,3,opt,name=collected,proto3" json:"collected"`
+}
+
+func (m *FeeLedgerEntry) Reset()         { *m = FeeLedgerEntry{} }
+func (m *FeeLedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeLedgerEntry) ProtoMessage()    {}
+func (*FeeLedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1651b8508c88d62f, []int{7}
+}
+func (m *FeeLedgerEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeLedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeLedgerEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeLedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeLedgerEntry.Merge(m, src)
+}
+func (m *FeeLedgerEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeLedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeLedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeLedgerEntry proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ChainState)(nil), "nexus.v1beta1.ChainState")
+	proto.RegisterType((*LinkedAddresses)(nil), "nexus.v1beta1.LinkedAddresses")
+	proto.RegisterType((*ChainAssets)(nil), "nexus.v1beta1.ChainAssets")
+	proto.RegisterType((*TransferRateLimit)(nil), "nexus.v1beta1.TransferRateLimit")
+	proto.RegisterType((*TransferFreeze)(nil), "nexus.v1beta1.TransferFreeze")
+	proto.RegisterType((*TransferFlow)(nil), "nexus.v1beta1.TransferFlow")
+	proto.RegisterType((*FeeSchedule)(nil), "nexus.v1beta1.FeeSchedule")
+	proto.RegisterType((*FeeLedgerEntry)(nil), "nexus.v1beta1.FeeLedgerEntry")
 }
 
 func init() { proto.RegisterFile("nexus/v1beta1/types.proto", fileDescriptor_1651b8508c88d62f) }
 
 var fileDescriptor_1651b8508c88d62f = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x50, 0x3d, 0x4e, 0xc3, 0x30,
-	0x18, 0x8d, 0x29, 0x20, 0x70, 0x61, 0x89, 0x18, 0x4a, 0x05, 0x6e, 0xd5, 0x29, 0x4b, 0x62, 0xa5,
-	0x4c, 0x8c, 0x84, 0x0b, 0xa0, 0x54, 0x62, 0x60, 0x73, 0x92, 0x4f, 0x69, 0xd4, 0x26, 0x8e, 0xec,
-	0xaf, 0x25, 0xdc, 0x82, 0xf3, 0x70, 0x82, 0x8c, 0x1d, 0x99, 0x2a, 0x48, 0x6e, 0xc1, 0x84, 0x9a,
-	0xa4, 0xa2, 0x03, 0x93, 0xfd, 0xfc, 0xfc, 0x7e, 0xf4, 0xe8, 0x75, 0x06, 0xc5, 0x4a, 0xf3, 0xb5,
-	0x1b, 0x00, 0x0a, 0x97, 0xe3, 0x5b, 0x0e, 0xda, 0xc9, 0x95, 0x44, 0x69, 0x5e, 0x36, 0x94, 0xd3,
-	0x51, 0xc3, 0xab, 0x58, 0xc6, 0xb2, 0x61, 0xf8, 0xee, 0xd6, 0x7e, 0x1a, 0x4e, 0x5a, 0x3d, 0x14,
-	0xb9, 0x54, 0x08, 0xd1, 0x7f, 0x46, 0x93, 0x0f, 0x42, 0xe9, 0xe3, 0x5c, 0x24, 0xd9, 0x0c, 0x05,
-	0x82, 0x79, 0x4f, 0x4f, 0xc2, 0x1d, 0x1a, 0x90, 0x31, 0xb1, 0xfa, 0xd3, 0x5b, 0xa7, 0xcd, 0xd9,
-	0x5b, 0xec, 0x03, 0x9d, 0x46, 0xe2, 0x1d, 0x97, 0xdb, 0x91, 0xe1, 0xb7, 0x0a, 0x73, 0x46, 0xfb,
-	0xa9, 0x48, 0x32, 0x14, 0x49, 0x06, 0x4a, 0x0f, 0x8e, 0xc6, 0x3d, 0xeb, 0xc2, 0x73, 0x7f, 0xb6,
-	0x23, 0x3b, 0x4e, 0x70, 0xbe, 0x0a, 0x9c, 0x50, 0xa6, 0x3c, 0x94, 0x3a, 0x95, 0xba, 0x3b, 0x6c,
-	0x1d, 0x2d, 0xba, 0x32, 0xcf, 0x62, 0xf9, 0x10, 0x45, 0x0a, 0xb4, 0xf6, 0x0f, 0x5d, 0xcc, 0x1b,
-	0x7a, 0x2e, 0x42, 0x4c, 0xd6, 0x02, 0x21, 0x1a, 0xf4, 0xc6, 0xc4, 0x3a, 0xf3, 0xff, 0x1e, 0xbc,
-	0xa7, 0xf2, 0x9b, 0x19, 0x65, 0xc5, 0xc8, 0xa6, 0x62, 0xe4, 0xab, 0x62, 0xe4, 0xbd, 0x66, 0xc6,
-	0xa6, 0x66, 0xc6, 0x67, 0xcd, 0x8c, 0x97, 0xe9, 0x41, 0xae, 0x28, 0x60, 0x29, 0x54, 0x06, 0xf8,
-	0x2a, 0xd5, 0xa2, 0x43, 0x76, 0x28, 0x15, 0xf0, 0x82, 0xb7, 0x2b, 0x35, 0x3d, 0x82, 0xd3, 0x66,
-	0x95, 0xbb, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x56, 0xbd, 0x23, 0xf9, 0x7b, 0x01, 0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0xeb, 0x24, 0x6d, 0x26, 0x79, 0xfd, 0xb0, 0xaa, 0xa7, 0xb4, 0x7a, 0xcf, 0xc9, 0xf3,
+	0xe2, 0x29, 0x08, 0xd5, 0xa6, 0x65, 0x81, 0x90, 0x60, 0x41, 0x5a, 0x82, 0x2a, 0x75, 0x81, 0x5c,
+	0x84, 0x10, 0x42, 0xaa, 0x26, 0xf6, 0x6d, 0x32, 0xaa, 0x3d, 0x13, 0xcd, 0x4c, 0x9a, 0x94, 0x5f,
+	0xc1, 0x82, 0x2d, 0x82, 0x35, 0x3f, 0x81, 0x5f, 0x50, 0x76, 0x5d, 0x22, 0x16, 0x05, 0xda, 0x7f,
+	0xc1, 0x0a, 0x79, 0x66, 0xd2, 0x04, 0x89, 0xa2, 0x36, 0x62, 0x95, 0xdc, 0x7b, 0xe7, 0x1c, 0x9f,
+	0x73, 0xcf, 0xc8, 0x46, 0x2b, 0x14, 0x86, 0x7d, 0x11, 0x1c, 0xae, 0xb7, 0x41, 0xe2, 0xf5, 0x40,
+	0x1e, 0xf5, 0x40, 0xf8, 0x3d, 0xce, 0x24, 0x73, 0xfe, 0x52, 0x23, 0xdf, 0x8c, 0x56, 0x97, 0x3b,
+	0xac, 0xc3, 0xd4, 0x24, 0xc8, 0xfe, 0xe9, 0x43, 0xab, 0x6e, 0xc4, 0x44, 0xca, 0x44, 0xd0, 0xc6,
+	0x02, 0x2e, 0x58, 0x22, 0x46, 0xa8, 0x99, 0x7b, 0x9a, 0x1f, 0x86, 0x3d, 0xc6, 0x25, 0xc4, 0xbf,
+	0x7a, 0x90, 0xf7, 0xc1, 0x42, 0x68, 0xb3, 0x8b, 0x09, 0xdd, 0x95, 0x58, 0x82, 0x73, 0x17, 0x15,
+	0xa2, 0xac, 0xaa, 0x5a, 0x75, 0xab, 0x51, 0xde, 0xf8, 0xd7, 0xd7, 0x3a, 0x46, 0x14, 0x23, 0x41,
+	0xbe, 0x82, 0x34, 0xf3, 0xc7, 0xa7, 0xb5, 0x5c, 0xa8, 0x11, 0xce, 0x2e, 0x2a, 0xa7, 0x98, 0x50,
+	0x89, 0x09, 0x05, 0x2e, 0xaa, 0x33, 0x75, 0xbb, 0x51, 0x69, 0xae, 0x7f, 0x3f, 0xad, 0xad, 0x75,
+	0x88, 0xec, 0xf6, 0xdb, 0x7e, 0xc4, 0xd2, 0xc0, 0x28, 0xd6, 0x3f, 0x6b, 0x22, 0x3e, 0x30, 0x62,
+	0x9e, 0xe2, 0xe4, 0x41, 0x1c, 0x73, 0x10, 0x22, 0x9c, 0x64, 0x71, 0xfe, 0x41, 0x25, 0x1c, 0x49,
+	0x72, 0x88, 0x25, 0xc4, 0x55, 0xbb, 0x6e, 0x35, 0xe6, 0xc2, 0x71, 0xc3, 0xfb, 0x68, 0xa1, 0x85,
+	0x1d, 0x42, 0x0f, 0x20, 0x36, 0x60, 0x10, 0xce, 0x33, 0xb4, 0x10, 0x43, 0x8f, 0x09, 0x22, 0xf7,
+	0xb0, 0x6e, 0x1a, 0x2f, 0x37, 0x2e, 0xf5, 0xc2, 0x99, 0x10, 0xca, 0x90, 0x61, 0x31, 0xbe, 0xe6,
+	0x0d, 0x8f, 0xe9, 0x3a, 0x2f, 0xd0, 0x12, 0x87, 0x88, 0xf4, 0x08, 0xd0, 0x31, 0xf7, 0xcc, 0x74,
+	0xdc, 0x8b, 0x17, 0x4c, 0xa6, 0xef, 0xbd, 0xb3, 0x50, 0x59, 0x1f, 0x14, 0x02, 0xa4, 0x70, 0x96,
+	0x27, 0x93, 0x28, 0x8d, 0x96, 0xfc, 0x37, 0x2a, 0x62, 0x35, 0x57, 0xfb, 0x2d, 0x85, 0xa6, 0x72,
+	0x22, 0x54, 0x94, 0x4c, 0xe2, 0x44, 0x54, 0xed, 0xba, 0xdd, 0x28, 0x6f, 0xac, 0xf8, 0x7a, 0xc5,
+	0x7e, 0x76, 0x37, 0xc6, 0x6a, 0x18, 0xa1, 0xcd, 0x5b, 0x99, 0x80, 0xf7, 0x5f, 0x6a, 0x8d, 0x2b,
+	0xc4, 0x92, 0x01, 0x44, 0x68, 0xa8, 0xbd, 0x37, 0x16, 0x5a, 0x7a, 0xc2, 0x31, 0x15, 0xfb, 0xc0,
+	0x43, 0x2c, 0x61, 0x87, 0xa4, 0x44, 0x5e, 0x22, 0x74, 0x19, 0x15, 0x94, 0x34, 0xb5, 0xa0, 0x52,
+	0xa8, 0x0b, 0x67, 0x0b, 0x15, 0x92, 0x0c, 0xa4, 0xa2, 0xac, 0x34, 0xfd, 0x4c, 0xca, 0xe7, 0xd3,
+	0xda, 0xff, 0x57, 0x90, 0xb2, 0x4d, 0x65, 0xa8, 0xc1, 0xd9, 0x12, 0x06, 0x84, 0xc6, 0x6c, 0x50,
+	0xcd, 0xd7, 0xad, 0x86, 0x1d, 0x9a, 0xca, 0xbb, 0x87, 0xe6, 0x47, 0xf2, 0x5a, 0x1c, 0xe0, 0x25,
+	0x5c, 0x47, 0x9b, 0xd7, 0x47, 0x95, 0x0b, 0x74, 0xc2, 0x06, 0x97, 0x60, 0xef, 0xa0, 0x22, 0x4e,
+	0x59, 0x9f, 0x4a, 0x93, 0xfc, 0x6f, 0x16, 0xad, 0x93, 0x36, 0xc7, 0x33, 0xd1, 0x5d, 0x20, 0x9d,
+	0xae, 0xf6, 0x6e, 0x87, 0xa6, 0xf2, 0x5e, 0xdb, 0xa8, 0xdc, 0x02, 0xd8, 0x8d, 0xba, 0x10, 0xf7,
+	0x13, 0x70, 0xfe, 0x43, 0x15, 0xc1, 0xfa, 0x3c, 0x82, 0xbd, 0xc9, 0xa7, 0x97, 0x75, 0x4f, 0x5d,
+	0x10, 0xe7, 0x26, 0x5a, 0x8a, 0x41, 0x48, 0x42, 0xb1, 0x24, 0x8c, 0x9a, 0x73, 0xda, 0xcb, 0xe2,
+	0xc4, 0x60, 0xf3, 0x67, 0xb3, 0xf6, 0x64, 0x10, 0x4d, 0x94, 0xe7, 0x58, 0x42, 0x35, 0x7f, 0xed,
+	0x1c, 0xb6, 0x20, 0x0a, 0x15, 0xd6, 0xd9, 0x46, 0x73, 0xfb, 0x09, 0x96, 0x7b, 0xfb, 0x00, 0xd5,
+	0xc2, 0x54, 0x79, 0xce, 0x66, 0xf8, 0x16, 0x80, 0xf3, 0x08, 0xcd, 0xa6, 0x84, 0x2a, 0xa6, 0xe2,
+	0x54, 0x4c, 0xc5, 0x94, 0xd0, 0x11, 0x11, 0x1e, 0x2a, 0xa2, 0xd9, 0x29, 0x89, 0xf0, 0xb0, 0x05,
+	0xe0, 0xbd, 0xb5, 0xd0, 0x7c, 0x0b, 0x60, 0x07, 0xe2, 0x0e, 0xf0, 0x87, 0x54, 0xf2, 0xa3, 0x3f,
+	0x9e, 0xcc, 0x7d, 0x54, 0x8a, 0x58, 0x92, 0x40, 0x34, 0x7a, 0xb7, 0x5d, 0xe1, 0x36, 0x8d, 0x11,
+	0xcd, 0xc7, 0xc7, 0xdf, 0xdc, 0xdc, 0xf1, 0x99, 0x6b, 0x9d, 0x9c, 0xb9, 0xd6, 0xd7, 0x33, 0xd7,
+	0x7a, 0x75, 0xee, 0xe6, 0x4e, 0xce, 0xdd, 0xdc, 0xa7, 0x73, 0x37, 0xf7, 0x7c, 0x63, 0xc2, 0x2f,
+	0x1e, 0x42, 0x82, 0x39, 0x05, 0x39, 0x60, 0xfc, 0xc0, 0x54, 0x6b, 0x11, 0xe3, 0x10, 0x0c, 0x03,
+	0xfd, 0x89, 0x50, 0xfe, 0xdb, 0x45, 0xf5, 0x49, 0xb8, 0xfd, 0x63, 0x00, 0x6f, 0x24, 0x03, 0x08,
+	0x98, 0x06, 0x00, 0x00,
 }
 
 func (m *ChainState) Marshal() (dAtA []byte, err error) {
@@ -137,52 +463,1647 @@ func (m *ChainState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkedAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkedAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RecipientAddress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DepositAddress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainAssets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainAssets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainAssets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Totals) > 0 {
+		for iNdEx := len(m.Totals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Totals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Assets[iNdEx])
+			copy(dAtA[i:], m.Assets[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Assets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FlatFee.Size()
+		i -= size
+		if _, err := m.FlatFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeLedgerEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeLedgerEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeLedgerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collected.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Maintainers) > 0 {
+		for _, b := range m.Maintainers {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Activated {
+		n += 2
+	}
+	return n
+}
+
+func (m *LinkedAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositAddress.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RecipientAddress.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ChainAssets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Assets) > 0 {
+		for _, s := range m.Assets {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Totals) > 0 {
+		for _, e := range m.Totals {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovTypes(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *TransferFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FlatFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *FeeLedgerEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Collected.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintainers = append(m.Maintainers, make([]byte, postIndex-iNdEx))
+			copy(m.Maintainers[len(m.Maintainers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Activated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinkedAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkedAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecipientAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainAssets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainAssets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainAssets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Totals = append(m.Totals, types.Coin{})
+			if err := m.Totals[len(m.Totals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ChainState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Chain.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.Maintainers) > 0 {
-		for _, b := range m.Maintainers {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	if m.Activated {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ChainState) Unmarshal(dAtA []byte) error {
+func (m *FeeLedgerEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -205,17 +2126,17 @@ func (m *ChainState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainState: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeLedgerEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeLedgerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -225,30 +2146,29 @@ func (m *ChainState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -258,29 +2178,29 @@ func (m *ChainState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Maintainers = append(m.Maintainers, make([]byte, postIndex-iNdEx))
-			copy(m.Maintainers[len(m.Maintainers)-1], dAtA[iNdEx:postIndex])
+			m.DestinationChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Activated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -290,12 +2210,25 @@ func (m *ChainState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Activated = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
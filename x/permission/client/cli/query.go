@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCommandRoleHolders(),
+		GetCommandRoles(),
+	)
+
+	return queryCmd
+}
+
+// GetCommandRoleHolders returns the query for all accounts that have been granted the given role
+func GetCommandRoleHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role-holders [role]",
+		Short: "Returns all accounts that have been granted the given role",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := exported.RoleFromSimpleStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).RoleHolders(cmd.Context(), &types.QueryRoleHoldersRequest{Role: role})
+			if err != nil {
+				return sdkerrors.Wrapf(err, "couldn't resolve holders of role %s", role.SimpleString())
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCommandRoles returns the query for all roles that have been granted to the given account
+func GetCommandRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles [address]",
+		Short: "Returns all roles that have been granted to the given account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryServiceClient(clientCtx).Roles(cmd.Context(), &types.QueryRolesRequest{Address: args[0]})
+			if err != nil {
+				return sdkerrors.Wrapf(err, "couldn't resolve roles of account %s", args[0])
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	clientUtils "github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		TraverseChildren:           true,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdGrantRole(),
+		GetCmdRevokeRole(),
+	)
+
+	return txCmd
+}
+
+// GetCmdGrantRole returns the cli command to grant a role to an account
+func GetCmdGrantRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [role] [address]",
+		Short: "grant a role to an account (requires the access-control role)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, address, err := parseRoleAndAddress(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewGrantRoleRequest(cliCtx.GetFromAddress(), role, address)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRevokeRole returns the cli command to revoke a role from an account
+func GetCmdRevokeRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [role] [address]",
+		Short: "revoke a role from an account (requires the access-control role)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, address, err := parseRoleAndAddress(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewRevokeRoleRequest(cliCtx.GetFromAddress(), role, address)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSubmitGrantRoleProposal returns the cli command to submit a governance proposal granting a role to an account
+func NewCmdSubmitGrantRoleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [role] [address]",
+		Short: "Submit a proposal to grant a role to an account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role, address, err := parseRoleAndAddress(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			return clientUtils.SubmitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewGrantRoleProposal(title, description, role, address)
+			})
+		},
+	}
+
+	clientUtils.AddProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitRevokeRoleProposal returns the cli command to submit a governance proposal revoking a role from an account
+func NewCmdSubmitRevokeRoleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [role] [address]",
+		Short: "Submit a proposal to revoke a role from an account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role, address, err := parseRoleAndAddress(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			return clientUtils.SubmitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRevokeRoleProposal(title, description, role, address)
+			})
+		},
+	}
+
+	clientUtils.AddProposalFlags(cmd)
+	return cmd
+}
+
+func parseRoleAndAddress(roleStr, addressStr string) (exported.Role, sdk.AccAddress, error) {
+	role, err := exported.RoleFromSimpleStr(roleStr)
+	if err != nil {
+		return exported.Unspecified, nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(addressStr)
+	if err != nil {
+		return exported.Unspecified, nil, err
+	}
+
+	return role, address, nil
+}
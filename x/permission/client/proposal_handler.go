@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/client/cli"
+	"github.com/axelarnetwork/axelar-core/x/permission/client/rest"
+)
+
+// proposal handlers to submit role proposals through the gov module's cli and REST endpoints
+var (
+	GrantRoleProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitGrantRoleProposal, rest.GrantRoleProposalRESTHandler)
+	RevokeRoleProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRevokeRoleProposal, rest.RevokeRoleProposalRESTHandler)
+)
@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clientUtils "github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// ReqRoleProposal defines the properties of a role proposal request's body
+type ReqRoleProposal struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Role        string       `json:"role" yaml:"role"`
+	Address     string       `json:"address" yaml:"address"`
+}
+
+// GrantRoleProposalRESTHandler returns the REST handler to submit a proposal granting a role to an account
+func GrantRoleProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "grant_role",
+		Handler: roleProposalHandlerFn(cliCtx, func(req ReqRoleProposal, role exported.Role, address sdk.AccAddress) govtypes.Content {
+			return types.NewGrantRoleProposal(req.Title, req.Description, role, address)
+		}),
+	}
+}
+
+// RevokeRoleProposalRESTHandler returns the REST handler to submit a proposal revoking a role from an account
+func RevokeRoleProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "revoke_role",
+		Handler: roleProposalHandlerFn(cliCtx, func(req ReqRoleProposal, role exported.Role, address sdk.AccAddress) govtypes.Content {
+			return types.NewRevokeRoleProposal(req.Title, req.Description, role, address)
+		}),
+	}
+}
+
+func roleProposalHandlerFn(cliCtx client.Context, newContent func(ReqRoleProposal, exported.Role, sdk.AccAddress) govtypes.Content) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqRoleProposal
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		role, err := exported.RoleFromSimpleStr(req.Role)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		address, err := sdk.AccAddressFromBech32(req.Address)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg, err := govtypes.NewMsgSubmitProposal(newContent(req, role, address), req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
@@ -0,0 +1,57 @@
+package exported
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RestrictedMsg is a message that can only be sent by accounts that have been granted the required role
+type RestrictedMsg interface {
+	sdk.Msg
+	GetRequiredRole() Role
+}
+
+// GetRoles returns an array of all roles
+func GetRoles() []Role {
+	return []Role{ChainManagement, KeyManagement, AccessControl}
+}
+
+// RoleFromSimpleStr creates a Role from string
+func RoleFromSimpleStr(str string) (Role, error) {
+	switch strings.ToLower(str) {
+	case ChainManagement.SimpleString():
+		return ChainManagement, nil
+	case KeyManagement.SimpleString():
+		return KeyManagement, nil
+	case AccessControl.SimpleString():
+		return AccessControl, nil
+	default:
+		return -1, fmt.Errorf("invalid role %s", str)
+	}
+}
+
+// SimpleString returns a human-readable string
+func (x Role) SimpleString() string {
+	switch x {
+	case ChainManagement:
+		return "chain-management"
+	case KeyManagement:
+		return "key-management"
+	case AccessControl:
+		return "access-control"
+	default:
+		return "unknown"
+	}
+}
+
+// Validate validates the Role
+func (x Role) Validate() error {
+	switch x {
+	case ChainManagement, KeyManagement, AccessControl:
+		return nil
+	default:
+		return fmt.Errorf("invalid role %d", x)
+	}
+}
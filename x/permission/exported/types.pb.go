@@ -0,0 +1,86 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: permission/exported/v1beta1/types.proto
+
+package exported
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role is a set of privileged messages that an account can be allowed to send
+type Role int32
+
+const (
+	Unspecified     Role = 0
+	ChainManagement Role = 1
+	KeyManagement   Role = 2
+	AccessControl   Role = 3
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_CHAIN_MANAGEMENT",
+	2: "ROLE_KEY_MANAGEMENT",
+	3: "ROLE_ACCESS_CONTROL",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":      0,
+	"ROLE_CHAIN_MANAGEMENT": 1,
+	"ROLE_KEY_MANAGEMENT":   2,
+	"ROLE_ACCESS_CONTROL":   3,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_122b0697c9fb828e, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("permission.exported.v1beta1.Role", Role_name, Role_value)
+}
+
+func init() {
+	proto.RegisterFile("permission/exported/v1beta1/types.proto", fileDescriptor_122b0697c9fb828e)
+}
+
+var fileDescriptor_122b0697c9fb828e = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0x3f, 0x4f, 0xc2, 0x40,
+	0x18, 0xc7, 0xf1, 0x56, 0x8d, 0x31, 0x35, 0x86, 0x5a, 0x74, 0xa9, 0xc9, 0x85, 0xc5, 0x98, 0x90,
+	0xd8, 0x0b, 0x71, 0x73, 0xab, 0xe7, 0xa9, 0x04, 0x28, 0x86, 0x3f, 0x89, 0xba, 0x90, 0x52, 0x1e,
+	0x4b, 0x23, 0xdc, 0x35, 0x77, 0xa7, 0xc2, 0x3b, 0x30, 0x4c, 0xbe, 0x01, 0x26, 0x1d, 0x7c, 0x17,
+	0xae, 0x8c, 0x8c, 0x8e, 0x4a, 0xdf, 0x88, 0xb1, 0x60, 0x74, 0x60, 0x7b, 0x9e, 0xe4, 0xf3, 0x5b,
+	0xbe, 0xc6, 0x41, 0x0c, 0xa2, 0x1f, 0x49, 0x19, 0x71, 0x86, 0x61, 0x10, 0x73, 0xa1, 0xa0, 0x83,
+	0x1f, 0x0a, 0x6d, 0x50, 0x7e, 0x01, 0xab, 0x61, 0x0c, 0xd2, 0x89, 0x05, 0x57, 0xdc, 0xda, 0xfb,
+	0x83, 0xce, 0x2f, 0x74, 0x16, 0xd0, 0xde, 0x09, 0x79, 0xc8, 0x53, 0x87, 0x7f, 0xae, 0xf9, 0x24,
+	0xff, 0xae, 0x1b, 0x6b, 0x35, 0xde, 0x03, 0x6b, 0xdf, 0x30, 0x6b, 0xd5, 0x32, 0x6d, 0x35, 0xbd,
+	0xfa, 0x25, 0x25, 0xc5, 0xb3, 0x22, 0x3d, 0x35, 0x35, 0x3b, 0x33, 0x1a, 0xe7, 0x36, 0x9b, 0x4c,
+	0xc6, 0x10, 0x44, 0xb7, 0x11, 0x74, 0x2c, 0xc7, 0xd8, 0x4d, 0x19, 0xb9, 0x70, 0x8b, 0x5e, 0xab,
+	0xe2, 0x7a, 0xee, 0x39, 0xad, 0x50, 0xaf, 0x61, 0xea, 0x76, 0x76, 0x34, 0xce, 0x65, 0x48, 0xd7,
+	0x8f, 0x58, 0xc5, 0x67, 0x7e, 0x08, 0x7d, 0x60, 0xca, 0xca, 0x1b, 0xd9, 0xd4, 0x97, 0xe8, 0xf5,
+	0x7f, 0xbd, 0x62, 0x6f, 0x8f, 0xc6, 0xb9, 0xad, 0x12, 0x0c, 0x97, 0x58, 0x97, 0x10, 0x5a, 0xaf,
+	0xb7, 0x48, 0xd5, 0x6b, 0xd4, 0xaa, 0x65, 0x73, 0x75, 0x6e, 0xdd, 0x20, 0x00, 0x29, 0x09, 0x67,
+	0x4a, 0xf0, 0x9e, 0xbd, 0xf1, 0xf4, 0x82, 0xb4, 0xb7, 0x57, 0xa4, 0x9f, 0x5c, 0x4d, 0xbe, 0x90,
+	0x36, 0x99, 0x21, 0x7d, 0x3a, 0x43, 0xfa, 0xe7, 0x0c, 0xe9, 0xcf, 0x09, 0xd2, 0xa6, 0x09, 0xd2,
+	0x3e, 0x12, 0xa4, 0xdd, 0x1c, 0x87, 0x91, 0xea, 0xde, 0xb7, 0x9d, 0x80, 0xf7, 0xb1, 0x3f, 0x80,
+	0x9e, 0x2f, 0x18, 0xa8, 0x47, 0x2e, 0xee, 0x16, 0xdf, 0x61, 0xc0, 0x05, 0xe0, 0x01, 0x5e, 0x92,
+	0xb8, 0xbd, 0x9e, 0x26, 0x3a, 0xfa, 0x1e, 0x00, 0x58, 0xa8, 0xb9, 0xf6, 0x80, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,34 @@
+package permission
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/keeper"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// InitGenesis initializes the role assignments from the genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, state types.GenesisState) {
+	k.InitGenesis(ctx, &state)
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return k.ExportGenesis(ctx)
+}
+
+// GetGenesisStateFromAppState returns x/permission GenesisState given raw application
+// genesis state.
+func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) types.GenesisState {
+	var genesisState types.GenesisState
+	if appState[types.ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[types.ModuleName], &genesisState)
+	}
+
+	return genesisState
+}
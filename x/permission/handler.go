@@ -0,0 +1,77 @@
+package permission
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/keeper"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// NewHandler returns the handler of the permission module
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	server := keeper.NewMsgServerImpl(k)
+	h := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case *types.GrantRoleRequest:
+			res, err := server.GrantRole(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.RevokeRoleRequest:
+			res, err := server.RevokeRole(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg))
+		}
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		res, err := h(ctx, msg)
+		if err != nil {
+			k.Logger(ctx).Debug(err.Error())
+			return nil, sdkerrors.Wrap(types.ErrPermission, err.Error())
+		}
+
+		if len(res.Log) > 0 {
+			k.Logger(ctx).Debug(res.Log)
+		}
+
+		return res, nil
+	}
+}
+
+// NewProposalHandler returns the handler for the permission module's governance proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.GrantRoleProposal:
+			address, err := sdk.AccAddressFromBech32(c.Address)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+
+			if err := k.GrantRole(ctx, c.Role, address); err != nil {
+				return sdkerrors.Wrap(types.ErrPermission, err.Error())
+			}
+
+			return nil
+		case *types.RevokeRoleProposal:
+			address, err := sdk.AccAddressFromBech32(c.Address)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+
+			if err := k.RevokeRole(ctx, c.Role, address); err != nil {
+				return sdkerrors.Wrap(types.ErrPermission, err.Error())
+			}
+
+			return nil
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// InitGenesis initializes the permission module's state from a given genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, assignment := range genState.RoleAssignments {
+		k.setRoleAssignment(ctx, assignment)
+	}
+
+	// an exported genesis without roles (e.g. of a chain that predates the permission module) locks all restricted messages
+	if len(genState.RoleAssignments) == 0 {
+		k.Logger(ctx).Info("no roles assigned in genesis, restricted messages are rejected until roles are granted by governance proposals")
+	}
+}
+
+// ExportGenesis returns the permission module's genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.getRoleAssignments(ctx, roleAssignmentPrefix.AppendStr("")))
+}
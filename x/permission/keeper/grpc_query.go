@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+var _ types.QueryServiceServer = Querier{}
+
+// Querier implements the grpc querier
+type Querier struct {
+	keeper Keeper
+}
+
+// NewGRPCQuerier creates a new permission Querier
+func NewGRPCQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// RoleHolders returns all accounts that have been granted the given role
+func (q Querier) RoleHolders(c context.Context, req *types.QueryRoleHoldersRequest) (*types.QueryRoleHoldersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := req.Role.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPermission, err.Error())
+	}
+
+	addresses := []string{}
+	for _, holder := range q.keeper.GetRoleHolders(ctx, req.Role) {
+		addresses = append(addresses, holder.String())
+	}
+
+	return &types.QueryRoleHoldersResponse{Addresses: addresses}, nil
+}
+
+// Roles returns all roles that have been granted to the given account
+func (q Querier) Roles(c context.Context, req *types.QueryRolesRequest) (*types.QueryRolesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPermission, err.Error())
+	}
+
+	return &types.QueryRolesResponse{Roles: q.keeper.GetRoles(ctx, address)}, nil
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+var (
+	roleAssignmentPrefix = utils.KeyFromStr("role")
+)
+
+// Keeper provides access to all state changes regarding the permission module
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+}
+
+// NewKeeper returns a new permission keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantRole grants the given role to the given account
+func (k Keeper) GrantRole(ctx sdk.Context, role exported.Role, address sdk.AccAddress) error {
+	assignment := types.NewRoleAssignment(role, address)
+	if err := assignment.Validate(); err != nil {
+		return err
+	}
+
+	if k.HasRole(ctx, address, role) {
+		return fmt.Errorf("account %s already has role %s", address.String(), role.SimpleString())
+	}
+
+	k.setRoleAssignment(ctx, assignment)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRole,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueGrant),
+			sdk.NewAttribute(types.AttributeKeyRole, role.SimpleString()),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("granted role %s to account %s", role.SimpleString(), address.String()))
+
+	return nil
+}
+
+// RevokeRole revokes the given role from the given account
+func (k Keeper) RevokeRole(ctx sdk.Context, role exported.Role, address sdk.AccAddress) error {
+	if !k.HasRole(ctx, address, role) {
+		return fmt.Errorf("account %s does not have role %s", address.String(), role.SimpleString())
+	}
+
+	k.getStore(ctx).Delete(getRoleAssignmentKey(role, address))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRole,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueRevoke),
+			sdk.NewAttribute(types.AttributeKeyRole, role.SimpleString()),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("revoked role %s from account %s", role.SimpleString(), address.String()))
+
+	return nil
+}
+
+// HasRole returns true if the given account has been granted the given role
+func (k Keeper) HasRole(ctx sdk.Context, address sdk.AccAddress, role exported.Role) bool {
+	return k.getStore(ctx).Has(getRoleAssignmentKey(role, address))
+}
+
+// GetRoleHolders returns all accounts that have been granted the given role
+func (k Keeper) GetRoleHolders(ctx sdk.Context, role exported.Role) []sdk.AccAddress {
+	var holders []sdk.AccAddress
+	for _, assignment := range k.getRoleAssignments(ctx, roleAssignmentPrefix.AppendStr(role.SimpleString()).AppendStr("")) {
+		holders = append(holders, assignment.Address)
+	}
+
+	return holders
+}
+
+// GetRoles returns all roles that have been granted to the given account
+func (k Keeper) GetRoles(ctx sdk.Context, address sdk.AccAddress) []exported.Role {
+	var roles []exported.Role
+	for _, role := range exported.GetRoles() {
+		if k.HasRole(ctx, address, role) {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+func (k Keeper) setRoleAssignment(ctx sdk.Context, assignment types.RoleAssignment) {
+	k.getStore(ctx).Set(getRoleAssignmentKey(assignment.Role, assignment.Address), &assignment)
+}
+
+func (k Keeper) getRoleAssignments(ctx sdk.Context, prefix utils.Key) []types.RoleAssignment {
+	assignments := []types.RoleAssignment{}
+
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var assignment types.RoleAssignment
+		iter.UnmarshalValue(&assignment)
+
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments
+}
+
+func getRoleAssignmentKey(role exported.Role, address sdk.AccAddress) utils.Key {
+	return roleAssignmentPrefix.AppendStr(role.SimpleString()).Append(utils.KeyFromBz(address))
+}
+
+func (k Keeper) getStore(ctx sdk.Context) utils.KVStore {
+	return utils.NewNormalizedStore(ctx.KVStore(k.storeKey), k.cdc)
+}
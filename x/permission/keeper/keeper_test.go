@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+func setup() (sdk.Context, Keeper) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	encodingConfig := params.MakeEncodingConfig()
+	keeper := NewKeeper(encodingConfig.Marshaler, sdk.NewKVStoreKey(types.StoreKey))
+
+	return ctx, keeper
+}
+
+func randRole() exported.Role {
+	roles := exported.GetRoles()
+	return roles[rand.I64Between(0, int64(len(roles)))]
+}
+
+func TestKeeper_GrantRole(t *testing.T) {
+	repeats := 20
+
+	t.Run("should grant role to account", testutils.Func(func(t *testing.T) {
+		ctx, keeper := setup()
+		role := randRole()
+		address := rand.AccAddr()
+
+		assert.False(t, keeper.HasRole(ctx, address, role))
+		assert.NoError(t, keeper.GrantRole(ctx, role, address))
+		assert.True(t, keeper.HasRole(ctx, address, role))
+		assert.Equal(t, []exported.Role{role}, keeper.GetRoles(ctx, address))
+		assert.Len(t, ctx.EventManager().Events(), 1)
+
+		for _, other := range exported.GetRoles() {
+			if other != role {
+				assert.False(t, keeper.HasRole(ctx, address, other))
+			}
+		}
+	}).Repeat(repeats))
+
+	t.Run("should return error when role is already granted", testutils.Func(func(t *testing.T) {
+		ctx, keeper := setup()
+		role := randRole()
+		address := rand.AccAddr()
+
+		assert.NoError(t, keeper.GrantRole(ctx, role, address))
+		assert.Error(t, keeper.GrantRole(ctx, role, address))
+	}).Repeat(repeats))
+
+	t.Run("should return error when role is invalid", testutils.Func(func(t *testing.T) {
+		ctx, keeper := setup()
+
+		assert.Error(t, keeper.GrantRole(ctx, exported.Unspecified, rand.AccAddr()))
+	}).Repeat(repeats))
+}
+
+func TestKeeper_RevokeRole(t *testing.T) {
+	repeats := 20
+
+	t.Run("should revoke role from account", testutils.Func(func(t *testing.T) {
+		ctx, keeper := setup()
+		role := randRole()
+		address := rand.AccAddr()
+
+		assert.NoError(t, keeper.GrantRole(ctx, role, address))
+		assert.NoError(t, keeper.RevokeRole(ctx, role, address))
+		assert.False(t, keeper.HasRole(ctx, address, role))
+		assert.Empty(t, keeper.GetRoles(ctx, address))
+		assert.Empty(t, keeper.GetRoleHolders(ctx, role))
+	}).Repeat(repeats))
+
+	t.Run("should return error when account does not have the role", testutils.Func(func(t *testing.T) {
+		ctx, keeper := setup()
+
+		assert.Error(t, keeper.RevokeRole(ctx, randRole(), rand.AccAddr()))
+	}).Repeat(repeats))
+}
+
+func TestKeeper_GetRoleHolders(t *testing.T) {
+	ctx, keeper := setup()
+
+	holders := make(map[exported.Role][]string)
+	for i := 0; i < int(rand.I64Between(1, 50)); i++ {
+		role := randRole()
+		address := rand.AccAddr()
+
+		assert.NoError(t, keeper.GrantRole(ctx, role, address))
+		holders[role] = append(holders[role], address.String())
+	}
+
+	for _, role := range exported.GetRoles() {
+		var actual []string
+		for _, holder := range keeper.GetRoleHolders(ctx, role) {
+			actual = append(actual, holder.String())
+		}
+
+		assert.ElementsMatch(t, holders[role], actual)
+	}
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, keeper := setup()
+
+	for i := 0; i < int(rand.I64Between(1, 50)); i++ {
+		assert.NoError(t, keeper.GrantRole(ctx, randRole(), rand.AccAddr()))
+	}
+
+	expected := keeper.ExportGenesis(ctx)
+	assert.NoError(t, expected.Validate())
+	assert.NotEmpty(t, expected.RoleAssignments)
+
+	newCtx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.InitGenesis(newCtx, expected)
+
+	assert.Equal(t, expected, keeper.ExportGenesis(newCtx))
+	for _, assignment := range expected.RoleAssignments {
+		assert.True(t, keeper.HasRole(newCtx, assignment.Address, assignment.Role))
+	}
+}
+
+func TestValidateGenesis_DuplicateRoleAssignment(t *testing.T) {
+	genState := types.DefaultGenesisState()
+	assignment := types.NewRoleAssignment(randRole(), rand.AccAddr())
+
+	genState.RoleAssignments = append(genState.RoleAssignments, assignment)
+	assert.NoError(t, genState.Validate())
+
+	genState.RoleAssignments = append(genState.RoleAssignments, assignment)
+	assert.Error(t, genState.Validate())
+}
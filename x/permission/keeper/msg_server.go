@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+var _ types.MsgServiceServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the permission MsgServiceServer interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServiceServer {
+	return msgServer{
+		Keeper: k,
+	}
+}
+
+// GrantRole grants a role to an account. The sender's access-control role is checked by the ante handler
+func (s msgServer) GrantRole(c context.Context, req *types.GrantRoleRequest) (*types.GrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.Keeper.GrantRole(ctx, req.Role, req.Address); err != nil {
+		return nil, err
+	}
+
+	return &types.GrantRoleResponse{}, nil
+}
+
+// RevokeRole revokes a role from an account. The sender's access-control role is checked by the ante handler
+func (s msgServer) RevokeRole(c context.Context, req *types.RevokeRoleRequest) (*types.RevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.Keeper.RevokeRole(ctx, req.Role, req.Address); err != nil {
+		return nil, err
+	}
+
+	return &types.RevokeRoleResponse{}, nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&GrantRoleRequest{}, "permission/GrantRole", nil)
+	cdc.RegisterConcrete(&RevokeRoleRequest{}, "permission/RevokeRole", nil)
+	cdc.RegisterConcrete(&GrantRoleProposal{}, "permission/GrantRoleProposal", nil)
+	cdc.RegisterConcrete(&RevokeRoleProposal{}, "permission/RevokeRoleProposal", nil)
+}
+
+// RegisterInterfaces registers types and interfaces with the given registry
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&GrantRoleRequest{},
+		&RevokeRoleRequest{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&GrantRoleProposal{},
+		&RevokeRoleProposal{},
+	)
+}
+
+var amino = codec.NewLegacyAmino()
+
+// ModuleCdc defines the module codec
+var ModuleCdc = codec.NewAminoCodec(amino)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,18 @@
+package types
+
+// Event types
+const (
+	EventTypeRole = "role"
+)
+
+// Event attribute keys
+const (
+	AttributeKeyRole    = "role"
+	AttributeKeyAddress = "address"
+)
+
+// Event attribute values
+const (
+	AttributeValueGrant  = "grant"
+	AttributeValueRevoke = "revoke"
+)
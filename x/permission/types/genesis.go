@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState is the constructor for GenesisState
+func NewGenesisState(roleAssignments []RoleAssignment) *GenesisState {
+	return &GenesisState{
+		RoleAssignments: roleAssignments,
+	}
+}
+
+// DefaultGenesisState returns a genesis state without any role assignments.
+// Without assigned roles all restricted messages are rejected, so roles must either be assigned
+// in genesis (see the add-genesis-role command) or be granted by governance proposals after the chain started
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]RoleAssignment{})
+}
+
+// Validate performs a validation check on the genesis parameters
+func (m GenesisState) Validate() error {
+	if err := m.validate(); err != nil {
+		return sdkerrors.Wrap(err, fmt.Sprintf("genesis state for module %s is invalid", ModuleName))
+	}
+
+	return nil
+}
+
+func (m GenesisState) validate() error {
+	seen := make(map[string]bool)
+	for _, assignment := range m.RoleAssignments {
+		if err := assignment.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s_%s", assignment.Role.SimpleString(), assignment.Address.String())
+		if seen[key] {
+			return fmt.Errorf("duplicate assignment of role %s to %s", assignment.Role.SimpleString(), assignment.Address.String())
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "permission"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for legacy query routing
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+)
+
+// NewRevokeRoleRequest creates a message of type RevokeRoleRequest
+func NewRevokeRoleRequest(sender sdk.AccAddress, role exported.Role, address sdk.AccAddress) *RevokeRoleRequest {
+	return &RevokeRoleRequest{
+		Sender:  sender,
+		Role:    role,
+		Address: address,
+	}
+}
+
+// Route implements sdk.Msg
+func (m RevokeRoleRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m RevokeRoleRequest) Type() string {
+	return "RevokeRole"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m RevokeRoleRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if err := m.Role.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrPermission, err.Error())
+	}
+
+	if err := sdk.VerifyAddressFormat(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "address").Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m RevokeRoleRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m RevokeRoleRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// GetRequiredRole implements exported.RestrictedMsg
+func (m RevokeRoleRequest) GetRequiredRole() exported.Role {
+	return exported.AccessControl
+}
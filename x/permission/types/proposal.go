@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+)
+
+// proposal types
+const (
+	ProposalTypeGrantRole  = "GrantRole"
+	ProposalTypeRevokeRole = "RevokeRole"
+)
+
+var (
+	_ govtypes.Content = &GrantRoleProposal{}
+	_ govtypes.Content = &RevokeRoleProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeGrantRole)
+	govtypes.RegisterProposalTypeCodec(&GrantRoleProposal{}, "permission/GrantRoleProposal")
+	govtypes.RegisterProposalType(ProposalTypeRevokeRole)
+	govtypes.RegisterProposalTypeCodec(&RevokeRoleProposal{}, "permission/RevokeRoleProposal")
+}
+
+// NewGrantRoleProposal is the constructor for GrantRoleProposal
+func NewGrantRoleProposal(title, description string, role exported.Role, address sdk.AccAddress) *GrantRoleProposal {
+	return &GrantRoleProposal{
+		Title:       title,
+		Description: description,
+		Role:        role,
+		Address:     address.String(),
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (m GrantRoleProposal) GetTitle() string { return m.Title }
+
+// GetDescription implements govtypes.Content
+func (m GrantRoleProposal) GetDescription() string { return m.Description }
+
+// ProposalRoute implements govtypes.Content
+func (m GrantRoleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (m GrantRoleProposal) ProposalType() string { return ProposalTypeGrantRole }
+
+// ValidateBasic implements govtypes.Content
+func (m GrantRoleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	return validateRoleAndAddress(m.Role, m.Address)
+}
+
+// String implements govtypes.Content
+func (m GrantRoleProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Grant Role Proposal:
+  Title:       %s
+  Description: %s
+  Role:        %s
+  Address:     %s
+`, m.Title, m.Description, m.Role.SimpleString(), m.Address))
+	return b.String()
+}
+
+// NewRevokeRoleProposal is the constructor for RevokeRoleProposal
+func NewRevokeRoleProposal(title, description string, role exported.Role, address sdk.AccAddress) *RevokeRoleProposal {
+	return &RevokeRoleProposal{
+		Title:       title,
+		Description: description,
+		Role:        role,
+		Address:     address.String(),
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (m RevokeRoleProposal) GetTitle() string { return m.Title }
+
+// GetDescription implements govtypes.Content
+func (m RevokeRoleProposal) GetDescription() string { return m.Description }
+
+// ProposalRoute implements govtypes.Content
+func (m RevokeRoleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (m RevokeRoleProposal) ProposalType() string { return ProposalTypeRevokeRole }
+
+// ValidateBasic implements govtypes.Content
+func (m RevokeRoleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	return validateRoleAndAddress(m.Role, m.Address)
+}
+
+// String implements govtypes.Content
+func (m RevokeRoleProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Revoke Role Proposal:
+  Title:       %s
+  Description: %s
+  Role:        %s
+  Address:     %s
+`, m.Title, m.Description, m.Role.SimpleString(), m.Address))
+	return b.String()
+}
+
+func validateRoleAndAddress(role exported.Role, address string) error {
+	if err := role.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrPermission, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: permission/v1beta1/service.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("permission/v1beta1/service.proto", fileDescriptor_9b173fb9014451c4) }
+func init() {
+	golang_proto.RegisterFile("permission/v1beta1/service.proto", fileDescriptor_9b173fb9014451c4)
+}
+
+var fileDescriptor_9b173fb9014451c4 = []byte{
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0xef, 0xd2, 0x40,
+	0x18, 0xc6, 0x39, 0x12, 0x4d, 0x3c, 0x9d, 0x2e, 0x4e, 0xa8, 0x17, 0x53, 0x81, 0x20, 0x4a, 0x2f,
+	0xe0, 0x60, 0xe2, 0xe8, 0xa2, 0x8b, 0x83, 0x18, 0x17, 0xb7, 0x03, 0xde, 0x94, 0x86, 0xd2, 0xb7,
+	0xdc, 0x1d, 0x08, 0x21, 0x2c, 0x0c, 0xce, 0x26, 0x2e, 0xc6, 0x0f, 0xe1, 0x67, 0x70, 0x74, 0x24,
+	0x71, 0x71, 0x34, 0xd4, 0x0f, 0x62, 0x7a, 0xbd, 0x4a, 0x13, 0x2b, 0xfc, 0xb7, 0x5e, 0x9e, 0xdf,
+	0xbd, 0xcf, 0x2f, 0x6f, 0x8f, 0xde, 0x4f, 0x40, 0xcd, 0x43, 0xad, 0x43, 0x8c, 0xc5, 0xaa, 0x3f,
+	0x02, 0x23, 0xfb, 0x42, 0x83, 0x5a, 0x85, 0x63, 0xf0, 0x13, 0x85, 0x06, 0x19, 0x3b, 0x11, 0xbe,
+	0x23, 0x1a, 0xb7, 0x03, 0x0c, 0xd0, 0xc6, 0x22, 0xfb, 0xca, 0xc9, 0xc6, 0xdd, 0x00, 0x31, 0x88,
+	0x40, 0xc8, 0x24, 0x14, 0x32, 0x8e, 0xd1, 0x48, 0x13, 0x62, 0xac, 0x5d, 0x7a, 0xa7, 0xa2, 0xc9,
+	0xac, 0x5d, 0xc8, 0x2b, 0xc2, 0xc5, 0x12, 0xd4, 0x26, 0xcf, 0x07, 0x5f, 0xea, 0x94, 0xbe, 0xd2,
+	0xc1, 0x9b, 0xdc, 0x8c, 0xed, 0x09, 0xbd, 0xf1, 0x42, 0xc9, 0xd8, 0x0c, 0x31, 0x02, 0xd6, 0xf4,
+	0xff, 0x55, 0xf4, 0xff, 0xc6, 0x43, 0x58, 0x2c, 0x41, 0x9b, 0x46, 0xeb, 0x02, 0xa5, 0x13, 0x8c,
+	0x35, 0x78, 0x9d, 0xfd, 0x8f, 0xdf, 0x9f, 0xea, 0x9e, 0x77, 0x4f, 0xc8, 0x35, 0x44, 0x52, 0x89,
+	0x92, 0x59, 0x90, 0xd1, 0x3d, 0x85, 0x11, 0x3c, 0x23, 0x5d, 0xf6, 0x81, 0x50, 0x3a, 0x84, 0x15,
+	0xce, 0xc0, 0x5a, 0x54, 0xce, 0x3f, 0xe5, 0x85, 0x46, 0xfb, 0x12, 0xe6, 0x3c, 0x1e, 0x5a, 0x8f,
+	0x07, 0x1e, 0xaf, 0xf0, 0x50, 0x16, 0x2f, 0x44, 0x06, 0x5f, 0xeb, 0xf4, 0xd6, 0xeb, 0x6c, 0x59,
+	0xc5, 0x7a, 0x3e, 0x13, 0x7a, 0x33, 0x1b, 0xf6, 0x12, 0xa3, 0x09, 0x28, 0xcd, 0x1e, 0x55, 0x75,
+	0xda, 0x1b, 0x25, 0xaa, 0x10, 0x7c, 0x7c, 0x35, 0xd8, 0x69, 0xfa, 0x56, 0xb3, 0xc3, 0xda, 0x55,
+	0x9a, 0x18, 0x41, 0x6f, 0x9a, 0x5f, 0x10, 0xdb, 0xec, 0xb4, 0xcb, 0xfe, 0xdc, 0xb5, 0x6c, 0x8e,
+	0x66, 0xad, 0xb3, 0x3d, 0xfa, 0xec, 0xbe, 0xca, 0x98, 0x13, 0xe9, 0x5a, 0x91, 0x26, 0xf3, 0xfe,
+	0x23, 0xa2, 0xc5, 0x56, 0x4e, 0x26, 0x0a, 0xb4, 0xde, 0x3d, 0x7f, 0xfb, 0xfd, 0xc8, 0xc9, 0xe1,
+	0xc8, 0xc9, 0xaf, 0x23, 0x27, 0x1f, 0x53, 0x5e, 0xfb, 0x96, 0x72, 0x72, 0x48, 0x79, 0xed, 0x67,
+	0xca, 0x6b, 0xef, 0x9e, 0x06, 0xa1, 0x99, 0x2e, 0x47, 0xfe, 0x18, 0xe7, 0x6e, 0x56, 0x0c, 0xe6,
+	0x3d, 0xaa, 0x99, 0x3b, 0xf5, 0xc6, 0xa8, 0x40, 0xac, 0xcb, 0x05, 0x66, 0x93, 0x80, 0x1e, 0x5d,
+	0xb7, 0x6f, 0xf5, 0xc9, 0x9f, 0x01, 0x00, 0xd1, 0xce, 0x59, 0x30, 0x54, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgServiceClient is the client API for MsgService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgServiceClient interface {
+	GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error)
+}
+
+type msgServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgServiceClient(cc grpc1.ClientConn) MsgServiceClient {
+	return &msgServiceClient{cc}
+}
+
+func (c *msgServiceClient) GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleResponse, error) {
+	out := new(GrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/permission.v1beta1.MsgService/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error) {
+	out := new(RevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/permission.v1beta1.MsgService/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServiceServer is the server API for MsgService service.
+type MsgServiceServer interface {
+	GrantRole(context.Context, *GrantRoleRequest) (*GrantRoleResponse, error)
+	RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleResponse, error)
+}
+
+// UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServiceServer struct {
+}
+
+func (*UnimplementedMsgServiceServer) GrantRole(ctx context.Context, req *GrantRoleRequest) (*GrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMsgServiceServer) RevokeRole(ctx context.Context, req *RevokeRoleRequest) (*RevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+
+func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
+	s.RegisterService(&_MsgService_serviceDesc, srv)
+}
+
+func _MsgService_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/permission.v1beta1.MsgService/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).GrantRole(ctx, req.(*GrantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/permission.v1beta1.MsgService/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RevokeRole(ctx, req.(*RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MsgService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "permission.v1beta1.MsgService",
+	HandlerType: (*MsgServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GrantRole",
+			Handler:    _MsgService_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _MsgService_RevokeRole_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "permission/v1beta1/service.proto",
+}
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	RoleHolders(ctx context.Context, in *QueryRoleHoldersRequest, opts ...grpc.CallOption) (*QueryRoleHoldersResponse, error)
+	Roles(ctx context.Context, in *QueryRolesRequest, opts ...grpc.CallOption) (*QueryRolesResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) RoleHolders(ctx context.Context, in *QueryRoleHoldersRequest, opts ...grpc.CallOption) (*QueryRoleHoldersResponse, error) {
+	out := new(QueryRoleHoldersResponse)
+	err := c.cc.Invoke(ctx, "/permission.v1beta1.QueryService/RoleHolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Roles(ctx context.Context, in *QueryRolesRequest, opts ...grpc.CallOption) (*QueryRolesResponse, error) {
+	out := new(QueryRolesResponse)
+	err := c.cc.Invoke(ctx, "/permission.v1beta1.QueryService/Roles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	RoleHolders(context.Context, *QueryRoleHoldersRequest) (*QueryRoleHoldersResponse, error)
+	Roles(context.Context, *QueryRolesRequest) (*QueryRolesResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) RoleHolders(ctx context.Context, req *QueryRoleHoldersRequest) (*QueryRoleHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleHolders not implemented")
+}
+func (*UnimplementedQueryServiceServer) Roles(ctx context.Context, req *QueryRolesRequest) (*QueryRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Roles not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_RoleHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).RoleHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/permission.v1beta1.QueryService/RoleHolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).RoleHolders(ctx, req.(*QueryRoleHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Roles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Roles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/permission.v1beta1.QueryService/Roles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Roles(ctx, req.(*QueryRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "permission.v1beta1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RoleHolders",
+			Handler:    _QueryService_RoleHolders_Handler,
+		},
+		{
+			MethodName: "Roles",
+			Handler:    _QueryService_Roles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "permission/v1beta1/service.proto",
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+)
+
+// NewRoleAssignment is the constructor for RoleAssignment
+func NewRoleAssignment(role exported.Role, address sdk.AccAddress) RoleAssignment {
+	return RoleAssignment{
+		Role:    role,
+		Address: address,
+	}
+}
+
+// Validate returns an error if the role assignment is invalid
+func (m RoleAssignment) Validate() error {
+	if err := m.Role.Validate(); err != nil {
+		return err
+	}
+
+	if err := sdk.VerifyAddressFormat(m.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+
+	return nil
+}
@@ -10,12 +10,12 @@ import (
 // InitGenesis initialize default parameters
 // from the genesis state
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, state types.GenesisState) {
-	k.SetParams(ctx, state.Params)
+	k.InitGenesis(ctx, &state)
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return &types.GenesisState{Params: k.GetParams(ctx)}
+	return k.ExportGenesis(ctx)
 }
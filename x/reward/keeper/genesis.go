@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+)
+
+// InitGenesis initializes the reward module's state from a given genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, pool := range genState.Pools {
+		k.setPool(ctx, pool)
+	}
+}
+
+// ExportGenesis returns the reward module's genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.getPools(ctx))
+}
+
+func (k Keeper) getPools(ctx sdk.Context) []types.Pool {
+	pools := []types.Pool{}
+
+	iter := k.getStore(ctx).Iterator(poolNamePrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var pool types.Pool
+		iter.UnmarshalValue(&pool)
+
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
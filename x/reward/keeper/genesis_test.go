@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, _, keeper, _, _, _ := setup()
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	for _, name := range rand.Strings(5, 20).Distinct().Take(int(rand.I64Between(1, 20))) {
+		pool := keeper.GetPool(ctx, name)
+		for i := 0; i < int(rand.I64Between(1, 20)); i++ {
+			pool.AddReward(rand.ValAddr(), sdk.NewCoin(denom, sdk.NewInt(rand.I64Between(1, 10000000))))
+		}
+	}
+
+	expected := keeper.ExportGenesis(ctx)
+	assert.NoError(t, expected.Validate())
+	assert.NotEmpty(t, expected.Pools)
+
+	newCtx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	keeper.InitGenesis(newCtx, expected)
+
+	assert.Equal(t, expected, keeper.ExportGenesis(newCtx))
+	for _, pool := range expected.Pools {
+		assert.Equal(t, keeper.GetPool(ctx, pool.Name).(*rewardPool).Pool, keeper.GetPool(newCtx, pool.Name).(*rewardPool).Pool)
+	}
+}
+
+func TestValidateGenesis_DuplicatePool(t *testing.T) {
+	genState := types.DefaultGenesisState()
+	name := rand.Str(10)
+
+	genState.Pools = append(genState.Pools, types.NewPool(name))
+	assert.NoError(t, genState.Validate())
+
+	genState.Pools = append(genState.Pools, types.NewPool(name))
+	assert.Error(t, genState.Validate())
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+)
+
+var _ types.QueryServiceServer = Querier{}
+
+// Querier implements the grpc querier
+type Querier struct {
+	keeper Keeper
+}
+
+// NewGRPCQuerier creates a new reward Querier
+func NewGRPCQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// Params returns the module parameters
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: q.keeper.GetParams(ctx)}, nil
+}
+
+// Pool returns the reward pool with the given name
+func (q Querier) Pool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pool types.Pool
+	if ok := q.keeper.getStore(ctx).Get(poolNamePrefix.Append(utils.LowerCaseKey(req.Name)), &pool); !ok {
+		return nil, sdkerrors.Wrap(types.ErrReward, fmt.Sprintf("reward pool %s not found", req.Name))
+	}
+
+	return &types.QueryPoolResponse{Pool: pool}, nil
+}
+
+// Pools returns a page of all reward pools
+func (q Querier) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pools := make([]types.Pool, 0)
+	store := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), poolNamePrefix.AppendStr("").AsKey())
+
+	pageResponse, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var pool types.Pool
+		if err := q.keeper.cdc.UnmarshalLengthPrefixed(value, &pool); err != nil {
+			return err
+		}
+
+		pools = append(pools, pool)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrReward, err.Error())
+	}
+
+	return &types.QueryPoolsResponse{Pools: pools, Pagination: pageResponse}, nil
+}
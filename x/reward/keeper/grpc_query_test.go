@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+)
+
+func TestQuerier_Pools(t *testing.T) {
+	ctx, _, keeper, _, _, _ := setup()
+	querier := NewGRPCQuerier(keeper)
+
+	poolCount := int(rand.I64Between(10, 50))
+	for i := 0; i < poolCount; i++ {
+		keeper.GetPool(ctx, rand.Str(10)).AddReward(rand.ValAddr(), sdk.NewCoin(denom, sdk.NewInt(rand.I64Between(1, 1000))))
+	}
+
+	var pools []types.Pool
+	var nextKey []byte
+	for {
+		res, err := querier.Pools(sdk.WrapSDKContext(ctx), &types.QueryPoolsRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: 3}})
+		assert.NoError(t, err)
+
+		pools = append(pools, res.Pools...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	assert.Len(t, pools, poolCount)
+
+	res, err := querier.Pool(sdk.WrapSDKContext(ctx), &types.QueryPoolRequest{Name: pools[0].Name})
+	assert.NoError(t, err)
+	assert.Equal(t, pools[0], res.Pool)
+
+	_, err = querier.Pool(sdk.WrapSDKContext(ctx), &types.QueryPoolRequest{Name: rand.Str(11)})
+	assert.Error(t, err)
+}
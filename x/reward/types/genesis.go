@@ -2,20 +2,49 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// NewGenesisState is the constructor for GenesisState
+func NewGenesisState(p Params, pools []Pool) *GenesisState {
+	return &GenesisState{
+		Params: p,
+		Pools:  pools,
+	}
+}
+
 // DefaultGenesisState returns a genesis state with default parameters
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{Params: DefaultParams()}
+	return NewGenesisState(DefaultParams(), []Pool{})
 }
 
 // Validate performs a validation check on the genesis parameters
 func (m GenesisState) Validate() error {
-	if err := m.Params.Validate(); err != nil {
+	if err := m.validate(); err != nil {
 		return sdkerrors.Wrap(err, fmt.Sprintf("genesis state for module %s is invalid", ModuleName))
 	}
 
 	return nil
 }
+
+func (m GenesisState) validate() error {
+	if err := m.Params.Validate(); err != nil {
+		return err
+	}
+
+	poolNames := make(map[string]bool)
+	for _, pool := range m.Pools {
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+
+		if poolNames[strings.ToLower(pool.Name)] {
+			return fmt.Errorf("duplicate reward pool %s", pool.Name)
+		}
+		poolNames[strings.ToLower(pool.Name)] = true
+	}
+
+	return nil
+}
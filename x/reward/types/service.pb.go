@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: reward/v1beta1/service.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("reward/v1beta1/service.proto", fileDescriptor_eeaa6c3866bbb685) }
+func init() {
+	golang_proto.RegisterFile("reward/v1beta1/service.proto", fileDescriptor_eeaa6c3866bbb685)
+}
+
+var fileDescriptor_eeaa6c3866bbb685 = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x4f, 0x4a, 0x03, 0x31,
+	0x14, 0xc6, 0x3b, 0x45, 0xbb, 0x18, 0xc4, 0x45, 0xa8, 0x0a, 0xc3, 0x18, 0x74, 0x5c, 0x3b, 0xa1,
+	0xf6, 0x06, 0x9e, 0xa0, 0xd5, 0x9d, 0xbb, 0xb4, 0x3e, 0xc6, 0xc1, 0x34, 0x2f, 0x4d, 0xd2, 0x7f,
+	0x88, 0x1b, 0x4f, 0x20, 0x78, 0x21, 0x97, 0x2e, 0x0b, 0x6e, 0xdc, 0x29, 0x1d, 0x0f, 0x22, 0x33,
+	0x49, 0x05, 0x4b, 0x9d, 0x5d, 0x1e, 0xbf, 0xef, 0x7d, 0xbf, 0x84, 0x84, 0xb1, 0x86, 0x19, 0xd7,
+	0xb7, 0x6c, 0xda, 0x19, 0x80, 0xe5, 0x1d, 0x66, 0x40, 0x4f, 0xf3, 0x21, 0xa4, 0x4a, 0xa3, 0x45,
+	0xb2, 0xef, 0x68, 0xea, 0x69, 0xd4, 0xce, 0x30, 0xc3, 0x0a, 0xb1, 0xf2, 0xe4, 0x52, 0x51, 0x9c,
+	0x21, 0x66, 0x02, 0x18, 0x57, 0x39, 0xe3, 0x52, 0xa2, 0xe5, 0x36, 0x47, 0x69, 0x3c, 0x8d, 0x36,
+	0x0c, 0xe3, 0x09, 0xe8, 0x85, 0x63, 0x17, 0x9f, 0xcd, 0x70, 0xaf, 0x5f, 0xce, 0xd7, 0x4e, 0x4b,
+	0x54, 0xd8, 0xea, 0x71, 0xcd, 0x47, 0x86, 0x24, 0xe9, 0x5f, 0x77, 0x5a, 0xe5, 0x1c, 0xbc, 0x82,
+	0xf1, 0x04, 0x8c, 0x8d, 0xce, 0x6a, 0x33, 0x46, 0xa1, 0x34, 0x90, 0x1c, 0x3f, 0xbd, 0x7f, 0xbf,
+	0x34, 0x8f, 0xc8, 0x01, 0xe3, 0x73, 0x10, 0x5c, 0x33, 0x7f, 0x1f, 0xe5, 0x3c, 0x18, 0xee, 0xf4,
+	0x10, 0x05, 0x39, 0xd9, 0xde, 0x85, 0x28, 0xd6, 0xb6, 0xd3, 0x9a, 0x84, 0x77, 0x25, 0x95, 0x2b,
+	0x26, 0xd1, 0xa6, 0x0b, 0x51, 0xb0, 0x07, 0xc9, 0x47, 0xf0, 0x48, 0x44, 0xb8, 0x5b, 0xee, 0x18,
+	0xf2, 0x7f, 0xdf, 0xef, 0x03, 0x93, 0xba, 0x88, 0x77, 0xc6, 0x95, 0xf3, 0x90, 0xb4, 0xb7, 0x38,
+	0xcd, 0x65, 0xff, 0x6d, 0x45, 0x83, 0xe5, 0x8a, 0x06, 0x5f, 0x2b, 0x1a, 0x3c, 0x17, 0xb4, 0xf1,
+	0x5a, 0xd0, 0x60, 0x59, 0xd0, 0xc6, 0x47, 0x41, 0x1b, 0x37, 0xdd, 0x2c, 0xb7, 0x77, 0x93, 0x41,
+	0x3a, 0xc4, 0x91, 0xdf, 0x96, 0x60, 0x67, 0xa8, 0xef, 0xfd, 0x74, 0x3e, 0x44, 0x0d, 0x6c, 0xbe,
+	0xae, 0xb4, 0x0b, 0x05, 0x66, 0xd0, 0xaa, 0xfe, 0xae, 0xfb, 0x13, 0x00, 0x00, 0xff, 0xff, 0x65,
+	0x78, 0x34, 0xf9, 0x3b, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/reward.v1beta1.QueryService/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/reward.v1beta1.QueryService/Pool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/reward.v1beta1.QueryService/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServiceServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServiceServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward.v1beta1.QueryService/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward.v1beta1.QueryService/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward.v1beta1.QueryService/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "reward.v1beta1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _QueryService_Params_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _QueryService_Pool_Handler,
+		},
+		{
+			MethodName: "Pools",
+			Handler:    _QueryService_Pools_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reward/v1beta1/service.proto",
+}
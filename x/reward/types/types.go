@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // NewPool is the constructor of Pool
 func NewPool(name string) Pool {
 	return Pool{
@@ -7,3 +14,32 @@ func NewPool(name string) Pool {
 		Rewards: []*Pool_Reward{},
 	}
 }
+
+// Validate returns an error if the pool is not valid; nil otherwise
+func (m Pool) Validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("pool name must not be empty")
+	}
+
+	validators := make(map[string]bool)
+	for _, reward := range m.Rewards {
+		if reward == nil {
+			return fmt.Errorf("pool %s contains an empty reward", m.Name)
+		}
+
+		if err := sdk.VerifyAddressFormat(reward.Validator); err != nil {
+			return sdkerrors.Wrapf(err, "invalid validator in pool %s", m.Name)
+		}
+
+		if validators[reward.Validator.String()] {
+			return fmt.Errorf("validator %s is rewarded more than once in pool %s", reward.Validator.String(), m.Name)
+		}
+		validators[reward.Validator.String()] = true
+
+		if err := reward.Coins.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid rewards for validator %s in pool %s", reward.Validator.String(), m.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
+)
+
+// InitGenesis initializes the snapshot module's state from a given genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, snapshot := range genState.Snapshots {
+		k.setSnapshot(ctx, snapshot)
+		k.setLatestCounter(ctx, snapshot.Counter)
+	}
+
+	for _, proxied := range genState.ProxiedValidators {
+		k.setProxy(ctx, proxied.Validator, proxied.Proxy, proxied.Active)
+	}
+}
+
+// ExportGenesis returns the snapshot module's genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	snapshots := []exported.Snapshot{}
+	for counter := int64(0); counter <= k.GetLatestCounter(ctx); counter++ {
+		snapshot, ok := k.GetSnapshot(ctx, counter)
+		if !ok {
+			panic(fmt.Errorf("snapshot %d not found", counter))
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), snapshots, k.getProxiedValidators(ctx))
+}
+
+func (k Keeper) getProxiedValidators(ctx sdk.Context) []types.ProxiedValidator {
+	proxiedValidators := []types.ProxiedValidator{}
+
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(proxyPrefix))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Value())
+		proxy, active := k.GetProxy(ctx, operator)
+
+		proxiedValidators = append(proxiedValidators, types.NewProxiedValidator(operator, proxy, active))
+	}
+
+	return proxiedValidators
+}
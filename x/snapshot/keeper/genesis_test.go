@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/utils"
+	snapshotMock "github.com/axelarnetwork/axelar-core/x/snapshot/exported/mock"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/keeper"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/types/mock"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tsstypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	validators := genValidators(t, 10, 100)
+	staker := newMockStaker(validators...)
+
+	newKeeper := func() keeper.Keeper {
+		snapSubspace := params.NewSubspace(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("paramsKey"), sdk.NewKVStoreKey("tparamsKey"), "snap")
+		slasher := &snapshotMock.SlasherMock{
+			GetValidatorSigningInfoFunc: func(ctx sdk.Context, address sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) {
+				return slashingtypes.NewValidatorSigningInfo(address, 0, 0, time.Unix(0, 0), false, 0), true
+			},
+			SignedBlocksWindowFunc: func(sdk.Context) int64 { return 100 },
+		}
+		tssMock := &snapshotMock.TssMock{
+			GetMaxMissedBlocksPerWindowFunc: func(sdk.Context) utils.Threshold { return tsstypes.DefaultParams().MaxMissedBlocksPerWindow },
+			GetTssSuspendedUntilFunc:        func(sdk.Context, sdk.ValAddress) int64 { return 0 },
+			IsOperatorAvailableFunc:         func(sdk.Context, sdk.ValAddress, ...tss.KeyID) bool { return true },
+		}
+		bank := &mock.BankKeeperMock{
+			GetBalanceFunc: func(sdk.Context, sdk.AccAddress, string) sdk.Coin { return sdk.NewCoin("uaxl", sdk.NewInt(5000000)) },
+		}
+
+		return keeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey("snapshot"), snapSubspace, staker, bank, slasher, tssMock)
+	}
+
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	k := newKeeper()
+	k.SetParams(ctx, types.DefaultParams())
+	for _, validator := range validators {
+		assert.NoError(t, k.RegisterProxy(ctx, validator.GetOperator(), rand.AccAddr()))
+	}
+	assert.NoError(t, k.DeactivateProxy(ctx, validators[0].GetOperator()))
+
+	keyRequirement := tsstypes.DefaultParams().KeyRequirements[0]
+	for i := 0; i < 3; i++ {
+		_, err := k.TakeSnapshot(ctx, keyRequirement)
+		assert.NoError(t, err)
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultParams().LockingPeriod + 1))
+	}
+
+	expected := k.ExportGenesis(ctx)
+	assert.NoError(t, expected.Validate())
+	assert.Len(t, expected.Snapshots, 3)
+	assert.Len(t, expected.ProxiedValidators, len(validators))
+
+	bz := encCfg.Marshaler.MustMarshalJSON(expected)
+	var genState types.GenesisState
+	encCfg.Marshaler.MustUnmarshalJSON(bz, &genState)
+	assert.NoError(t, genState.Validate())
+
+	newCtx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	newK := newKeeper()
+	newK.InitGenesis(newCtx, &genState)
+
+	assert.Equal(t, bz, encCfg.Marshaler.MustMarshalJSON(newK.ExportGenesis(newCtx)))
+	assert.Equal(t, k.GetLatestCounter(ctx), newK.GetLatestCounter(newCtx))
+	for _, validator := range validators {
+		expectedProxy, expectedActive := k.GetProxy(ctx, validator.GetOperator())
+		actualProxy, actualActive := newK.GetProxy(newCtx, validator.GetOperator())
+		assert.Equal(t, expectedProxy, actualProxy)
+		assert.Equal(t, expectedActive, actualActive)
+		assert.Equal(t, validator.GetOperator(), newK.GetOperator(newCtx, actualProxy))
+	}
+
+	_, err := newK.TakeSnapshot(newCtx.WithBlockTime(ctx.BlockTime()), keyRequirement)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), newK.GetLatestCounter(newCtx))
+}
+
+func TestValidateGenesis_DuplicateProxy(t *testing.T) {
+	genState := types.DefaultGenesisState()
+	assert.NoError(t, genState.Validate())
+
+	genState.ProxiedValidators = append(genState.ProxiedValidators, types.NewProxiedValidator(rand.ValAddr(), rand.AccAddr(), true))
+	assert.NoError(t, genState.Validate())
+
+	genState.ProxiedValidators = append(genState.ProxiedValidators, types.NewProxiedValidator(rand.ValAddr(), genState.ProxiedValidators[0].Proxy, true))
+	assert.Error(t, genState.Validate())
+}
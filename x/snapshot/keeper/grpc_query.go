@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
+)
+
+var _ types.QueryServiceServer = Querier{}
+
+// Querier implements the grpc querier
+type Querier struct {
+	keeper Keeper
+}
+
+// NewGRPCQuerier creates a new snapshot Querier
+func NewGRPCQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// Proxy returns the proxy address of the given operator and whether it is active
+func (q Querier) Proxy(c context.Context, req *types.QueryProxyRequest) (*types.QueryProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	operator, err := sdk.ValAddressFromBech32(req.OperatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, "address invalid")
+	}
+
+	proxy, active := q.keeper.GetProxy(ctx, operator)
+	if proxy == nil {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, "no proxy set for operator address")
+	}
+
+	status := "inactive"
+	if active {
+		status = "active"
+	}
+
+	return &types.QueryProxyResponse{Address: proxy.String(), Status: status}, nil
+}
+
+// Operator returns the operator address associated to the given proxy
+func (q Querier) Operator(c context.Context, req *types.QueryOperatorRequest) (*types.QueryOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proxy, err := sdk.AccAddressFromBech32(req.ProxyAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, "invalid proxy address")
+	}
+
+	operator := q.keeper.GetOperator(ctx, proxy)
+	if operator == nil {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, "no operator associated to the proxy address")
+	}
+
+	return &types.QueryOperatorResponse{OperatorAddress: operator.String()}, nil
+}
+
+// Snapshot returns the snapshot with the given counter
+func (q Querier) Snapshot(c context.Context, req *types.QuerySnapshotRequest) (*types.QuerySnapshotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	snapshot, ok := q.keeper.GetSnapshot(ctx, req.Counter)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, "no snapshot found")
+	}
+
+	resp := snapshotToQueryResp(snapshot)
+	return &resp, nil
+}
+
+// LatestSnapshot returns the latest snapshot
+func (q Querier) LatestSnapshot(c context.Context, _ *types.QueryLatestSnapshotRequest) (*types.QuerySnapshotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	snapshot, ok := q.keeper.GetLatestSnapshot(ctx)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, "no snapshot found")
+	}
+
+	resp := snapshotToQueryResp(snapshot)
+	return &resp, nil
+}
+
+// Validators returns a page of the tss information of all bonded validators
+func (q Querier) Validators(c context.Context, req *types.QueryValidatorsRequest) (*types.QueryValidatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := getValidators(ctx, q.keeper)
+
+	start, end, pageResponse, err := utils.PaginateSlice(len(resp.Validators), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, err.Error())
+	}
+
+	resp.Validators = resp.Validators[start:end]
+	resp.Pagination = pageResponse
+
+	return &resp, nil
+}
+
+func snapshotToQueryResp(snapshot exported.Snapshot) types.QuerySnapshotResponse {
+	validators := make([]types.QuerySnapshotResponse_Validator, len(snapshot.Validators))
+	for i, validator := range snapshot.Validators {
+		validators[i] = types.QuerySnapshotResponse_Validator{
+			OperatorAddress: validator.GetSDKValidator().GetOperator().String(),
+			ShareCount:      validator.ShareCount,
+		}
+	}
+
+	return types.QuerySnapshotResponse{
+		Counter:                    snapshot.Counter,
+		Timestamp:                  snapshot.Timestamp,
+		Height:                     snapshot.Height,
+		TotalShareCount:            snapshot.TotalShareCount,
+		KeyShareDistributionPolicy: snapshot.KeyShareDistributionPolicy,
+		CorruptionThreshold:        snapshot.CorruptionThreshold,
+		Validators:                 validators,
+	}
+}
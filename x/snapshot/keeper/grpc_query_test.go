@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/utils"
+	snapshotMock "github.com/axelarnetwork/axelar-core/x/snapshot/exported/mock"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/keeper"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/types/mock"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	tsstypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func setupGRPCQuerier(t *testing.T, numValidators int) (sdk.Context, keeper.Keeper, keeper.Querier, *mockStaker) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	staker := newMockStaker(genValidators(t, numValidators, numValidators*10)...)
+
+	slasher := &snapshotMock.SlasherMock{
+		GetValidatorSigningInfoFunc: func(_ sdk.Context, address sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) {
+			return slashingtypes.NewValidatorSigningInfo(address, 0, 0, time.Unix(0, 0), false, 0), true
+		},
+		SignedBlocksWindowFunc: func(sdk.Context) int64 { return 100 },
+	}
+	tssMock := &snapshotMock.TssMock{
+		GetMaxMissedBlocksPerWindowFunc: func(sdk.Context) utils.Threshold {
+			return tsstypes.DefaultParams().MaxMissedBlocksPerWindow
+		},
+		GetTssSuspendedUntilFunc: func(sdk.Context, sdk.ValAddress) int64 { return 0 },
+		IsOperatorAvailableFunc:  func(sdk.Context, sdk.ValAddress, ...tss.KeyID) bool { return true },
+	}
+	bank := &mock.BankKeeperMock{
+		GetBalanceFunc: func(_ sdk.Context, _ sdk.AccAddress, denom string) sdk.Coin {
+			return sdk.NewCoin(denom, sdk.NewInt(5000000))
+		},
+	}
+
+	subspace := params.NewSubspace(encCfg.Marshaler, encCfg.Amino, sdk.NewKVStoreKey("paramsKey"), sdk.NewKVStoreKey("tparamsKey"), "snap")
+	k := keeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey("snapshot"), subspace, staker, bank, slasher, tssMock)
+	k.SetParams(ctx, types.DefaultParams())
+
+	return ctx, k, keeper.NewGRPCQuerier(k), staker
+}
+
+func TestQuerier_Validators(t *testing.T) {
+	numValidators := int(rand.I64Between(1, 30))
+	ctx, k, querier, staker := setupGRPCQuerier(t, numValidators)
+	for _, v := range staker.validators {
+		assert.NoError(t, k.RegisterProxy(ctx, v.GetOperator(), rand.AccAddr()))
+	}
+
+	var validators []*types.QueryValidatorsResponse_Validator
+	var nextKey []byte
+	for {
+		res, err := querier.Validators(sdk.WrapSDKContext(ctx), &types.QueryValidatorsRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: 4}})
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(res.Validators), 4)
+
+		validators = append(validators, res.Validators...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+
+	assert.Len(t, validators, numValidators)
+	for i, validator := range validators {
+		assert.Equal(t, staker.validators[i].GetOperator().String(), validator.OperatorAddress)
+		assert.False(t, validator.TssIllegibilityInfo.NoProxyRegistered)
+	}
+
+	res, err := querier.Validators(sdk.WrapSDKContext(ctx), &types.QueryValidatorsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, res.Validators, numValidators)
+	assert.Equal(t, uint64(numValidators), res.Pagination.Total)
+
+	_, err = querier.Validators(sdk.WrapSDKContext(ctx), &types.QueryValidatorsRequest{Pagination: &query.PageRequest{Reverse: true}})
+	assert.Error(t, err)
+}
+
+func TestQuerier_ProxyAndOperator(t *testing.T) {
+	ctx, k, querier, staker := setupGRPCQuerier(t, 3)
+	operator := staker.validators[0].GetOperator()
+	proxy := rand.AccAddr()
+
+	_, err := querier.Proxy(sdk.WrapSDKContext(ctx), &types.QueryProxyRequest{OperatorAddress: operator.String()})
+	assert.Error(t, err)
+	_, err = querier.Operator(sdk.WrapSDKContext(ctx), &types.QueryOperatorRequest{ProxyAddress: proxy.String()})
+	assert.Error(t, err)
+
+	assert.NoError(t, k.RegisterProxy(ctx, operator, proxy))
+
+	proxyRes, err := querier.Proxy(sdk.WrapSDKContext(ctx), &types.QueryProxyRequest{OperatorAddress: operator.String()})
+	assert.NoError(t, err)
+	assert.Equal(t, proxy.String(), proxyRes.Address)
+	assert.Equal(t, "active", proxyRes.Status)
+
+	operatorRes, err := querier.Operator(sdk.WrapSDKContext(ctx), &types.QueryOperatorRequest{ProxyAddress: proxy.String()})
+	assert.NoError(t, err)
+	assert.Equal(t, operator.String(), operatorRes.OperatorAddress)
+
+	_, err = querier.Proxy(sdk.WrapSDKContext(ctx), &types.QueryProxyRequest{OperatorAddress: rand.StrBetween(5, 20)})
+	assert.Error(t, err)
+	_, err = querier.Operator(sdk.WrapSDKContext(ctx), &types.QueryOperatorRequest{ProxyAddress: rand.StrBetween(5, 20)})
+	assert.Error(t, err)
+}
+
+func TestQuerier_Snapshot(t *testing.T) {
+	ctx, _, querier, _ := setupGRPCQuerier(t, 3)
+
+	_, err := querier.Snapshot(sdk.WrapSDKContext(ctx), &types.QuerySnapshotRequest{Counter: rand.I64Between(0, 100)})
+	assert.Error(t, err)
+
+	_, err = querier.LatestSnapshot(sdk.WrapSDKContext(ctx), &types.QueryLatestSnapshotRequest{})
+	assert.Error(t, err)
+}
@@ -293,11 +293,15 @@ func (k Keeper) executeSnapshot(ctx sdk.Context, counter int64, keyRequirement t
 		KeyShareDistributionPolicy: keyRequirement.KeyShareDistributionPolicy,
 		CorruptionThreshold:        corruptionThreshold,
 	}
-	ctx.KVStore(k.storeKey).Set(counterKey(counter), k.cdc.MustMarshalLengthPrefixed(&snapshot))
+	k.setSnapshot(ctx, snapshot)
 
 	return snapshot, nil
 }
 
+func (k Keeper) setSnapshot(ctx sdk.Context, snapshot exported.Snapshot) {
+	ctx.KVStore(k.storeKey).Set(counterKey(snapshot.Counter), k.cdc.MustMarshalLengthPrefixed(&snapshot))
+}
+
 func (k Keeper) setLatestCounter(ctx sdk.Context, counter int64) {
 	bz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(bz, uint64(counter))
@@ -332,12 +336,21 @@ func (k Keeper) RegisterProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sd
 			proxy.String(), minBalance.String(), denom, balance.String())
 	}
 
-	bz := append([]byte{1}, proxy...)
+	k.setProxy(ctx, operator, proxy, true)
+	return nil
+}
+
+func (k Keeper) setProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress, active bool) {
+	var bz []byte
+	if active {
+		bz = append([]byte{1}, proxy...)
+	} else {
+		bz = append([]byte{0}, proxy...)
+	}
 	ctx.KVStore(k.storeKey).Set([]byte(operatorPrefix+operator.String()), bz)
 
 	// Reverse lookup
 	ctx.KVStore(k.storeKey).Set([]byte(proxyPrefix+proxy.String()), operator)
-	return nil
 }
 
 // DeactivateProxy deactivates the proxy address for a given operator
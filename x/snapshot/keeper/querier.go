@@ -115,6 +115,12 @@ func querySnapshot(ctx sdk.Context, k Keeper, counter string) ([]byte, error) {
 
 // QueryValidators returns validators' tss information
 func QueryValidators(ctx sdk.Context, k Keeper) ([]byte, error) {
+	resp := getValidators(ctx, k)
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
+
+func getValidators(ctx sdk.Context, k Keeper) types.QueryValidatorsResponse {
 	var validators []*types.QueryValidatorsResponse_Validator
 
 	validatorIter := func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
@@ -146,7 +152,6 @@ func QueryValidators(ctx sdk.Context, k Keeper) ([]byte, error) {
 	}
 
 	k.staking.IterateBondedValidatorsByPower(ctx, validatorIter)
-	resp := types.QueryValidatorsResponse{Validators: validators}
 
-	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+	return types.QueryValidatorsResponse{Validators: validators}
 }
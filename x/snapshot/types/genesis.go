@@ -5,23 +5,98 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 )
 
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState is the constructor for GenesisState
+func NewGenesisState(p Params, snapshots []exported.Snapshot, proxiedValidators []ProxiedValidator) *GenesisState {
+	return &GenesisState{
+		Params:            p,
+		Snapshots:         snapshots,
+		ProxiedValidators: proxiedValidators,
+	}
+}
+
 // DefaultGenesisState returns a genesis state with default parameters
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{Params: DefaultParams()}
+	return NewGenesisState(DefaultParams(), []exported.Snapshot{}, []ProxiedValidator{})
 }
 
 // Validate performs a validation check on the genesis parameters
 func (m GenesisState) Validate() error {
-	if err := m.Params.Validate(); err != nil {
+	if err := m.validate(); err != nil {
 		return sdkerrors.Wrap(err, fmt.Sprintf("genesis state for module %s is invalid", ModuleName))
 	}
 
 	return nil
 }
 
+func (m GenesisState) validate() error {
+	if err := m.Params.Validate(); err != nil {
+		return err
+	}
+
+	for i, snapshot := range m.Snapshots {
+		if snapshot.Counter != int64(i) {
+			return fmt.Errorf("snapshots must be ordered by counter without gaps, expected counter %d, got %d", i, snapshot.Counter)
+		}
+
+		if len(snapshot.Validators) == 0 {
+			return fmt.Errorf("snapshot %d has no validators", snapshot.Counter)
+		}
+
+		if snapshot.TotalShareCount.IsNil() || !snapshot.TotalShareCount.IsPositive() {
+			return fmt.Errorf("snapshot %d must have a positive total share count", snapshot.Counter)
+		}
+
+		for _, validator := range snapshot.Validators {
+			if validator.SDKValidator == nil {
+				return fmt.Errorf("snapshot %d contains a validator without details", snapshot.Counter)
+			}
+
+			if validator.ShareCount <= 0 {
+				return fmt.Errorf("snapshot %d contains a validator with non-positive share count", snapshot.Counter)
+			}
+		}
+	}
+
+	validators := make(map[string]bool)
+	proxies := make(map[string]bool)
+	for _, proxied := range m.ProxiedValidators {
+		if err := proxied.Validate(); err != nil {
+			return err
+		}
+
+		if validators[proxied.Validator.String()] {
+			return fmt.Errorf("validator %s has more than one proxy", proxied.Validator.String())
+		}
+		validators[proxied.Validator.String()] = true
+
+		if proxies[proxied.Proxy.String()] {
+			return fmt.Errorf("proxy %s is registered for more than one validator", proxied.Proxy.String())
+		}
+		proxies[proxied.Proxy.String()] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (m GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, snapshot := range m.Snapshots {
+		if err := snapshot.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetGenesisStateFromAppState returns x/snapshot GenesisState given raw application
 // genesis state.
 func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) GenesisState {
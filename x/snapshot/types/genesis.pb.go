@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,7 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState represents the genesis state
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params            Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Snapshots         []exported.Snapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots"`
+	ProxiedValidators []ProxiedValidator  `protobuf:"bytes,3,rep,name=proxied_validators,json=proxiedValidators,proto3" json:"proxied_validators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,21 +71,26 @@ func init() {
 func init() { proto.RegisterFile("snapshot/v1beta1/genesis.proto", fileDescriptor_98ccaae8dbe20ef9) }
 
 var fileDescriptor_98ccaae8dbe20ef9 = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0xce, 0x4b, 0x2c,
-	0x28, 0xce, 0xc8, 0x2f, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xeb, 0x41,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x2c,
-	0x86, 0x39, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x63, 0x94, 0xdc, 0xb8, 0x78, 0xdc, 0x21, 0xe6,
-	0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x99, 0x71, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35,
-	0xb8, 0x8d, 0x24, 0xf4, 0xd0, 0xed, 0xd1, 0x0b, 0x00, 0xcb, 0x3b, 0xb1, 0x9c, 0xb8, 0x27, 0xcf,
-	0x10, 0x04, 0x55, 0xed, 0x14, 0x7c, 0xe2, 0xa1, 0x1c, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x27, 0x56, 0xa4, 0xe6, 0x24, 0x16, 0xe5, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0x43, 0x79, 0xba,
-	0xc9, 0xf9, 0x45, 0xa9, 0xfa, 0x15, 0xfa, 0x70, 0xb7, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
-	0x81, 0xdd, 0x68, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x94, 0x3c, 0x87, 0xb7, 0x0c, 0x01, 0x00,
-	0x00,
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
+	0x18, 0xc7, 0x1b, 0x27, 0x03, 0x3b, 0x0f, 0x5a, 0x3c, 0x94, 0xa1, 0x71, 0x4c, 0x84, 0x5d, 0x6c,
+	0xd8, 0x44, 0x1f, 0x60, 0x97, 0x5d, 0xc5, 0x82, 0x82, 0x17, 0x49, 0xd7, 0x8f, 0xae, 0xb8, 0xf5,
+	0x0b, 0x49, 0x9c, 0xf5, 0x2d, 0x7c, 0xac, 0x1e, 0x77, 0xf4, 0x24, 0xda, 0x5e, 0x7c, 0x0c, 0xb1,
+	0x4d, 0x3b, 0xd8, 0x76, 0x4b, 0xf2, 0xfb, 0x7f, 0xbf, 0xfc, 0xf9, 0x6c, 0xaa, 0x12, 0x2e, 0xd4,
+	0x0c, 0x35, 0x5b, 0x0e, 0x03, 0xd0, 0x7c, 0xc8, 0x22, 0x48, 0x40, 0xc5, 0xca, 0x13, 0x12, 0x35,
+	0x3a, 0x47, 0x35, 0xf7, 0x0c, 0xef, 0x9e, 0x44, 0x18, 0x61, 0x09, 0xd9, 0xff, 0xa9, 0xca, 0x75,
+	0xcf, 0xb6, 0x3c, 0x82, 0x4b, 0xbe, 0x30, 0x9a, 0xee, 0xe9, 0x16, 0xd6, 0xef, 0x02, 0x6a, 0x7a,
+	0xd9, 0x50, 0x48, 0x05, 0x4a, 0x0d, 0xe1, 0xae, 0x58, 0xff, 0x97, 0xd8, 0x87, 0x93, 0xaa, 0x9d,
+	0xaf, 0xb9, 0x06, 0xe7, 0xd6, 0x6e, 0x57, 0xbf, 0xb8, 0xa4, 0x47, 0x06, 0x9d, 0x91, 0xeb, 0x6d,
+	0xb6, 0xf5, 0xee, 0x4a, 0x3e, 0xde, 0xcf, 0xbe, 0xce, 0xad, 0x7b, 0x93, 0x76, 0x26, 0xf6, 0x41,
+	0x1d, 0x54, 0xee, 0x5e, 0xaf, 0x35, 0xe8, 0x8c, 0x2e, 0xd6, 0xa3, 0x75, 0x87, 0xc6, 0xe1, 0x1b,
+	0x62, 0x2c, 0xeb, 0x59, 0xe7, 0xd1, 0x76, 0x84, 0xc4, 0x34, 0x86, 0xf0, 0x79, 0xc9, 0xe7, 0x71,
+	0xc8, 0x35, 0x4a, 0xe5, 0xb6, 0x4a, 0x63, 0x7f, 0x47, 0x99, 0x2a, 0xfb, 0x50, 0x47, 0x8d, 0xf0,
+	0x58, 0x6c, 0xbc, 0xab, 0xb1, 0x9f, 0xfd, 0x50, 0x2b, 0xcb, 0x29, 0x59, 0xe5, 0x94, 0x7c, 0xe7,
+	0x94, 0x7c, 0x14, 0xd4, 0x5a, 0x15, 0xd4, 0xfa, 0x2c, 0xa8, 0xf5, 0x74, 0x13, 0xc5, 0x7a, 0xf6,
+	0x1a, 0x78, 0x53, 0x5c, 0x30, 0x9e, 0xc2, 0x9c, 0xcb, 0x04, 0xf4, 0x1b, 0xca, 0x17, 0x73, 0xbb,
+	0x9a, 0xa2, 0x04, 0x96, 0xb2, 0x66, 0xad, 0xe5, 0x16, 0x83, 0x76, 0xb9, 0xc6, 0xeb, 0xbf, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x8e, 0x8b, 0x01, 0x03, 0xf4, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -105,6 +113,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProxiedValidators) > 0 {
+		for iNdEx := len(m.ProxiedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProxiedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -137,6 +173,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProxiedValidators) > 0 {
+		for _, e := range m.ProxiedValidators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -208,6 +256,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, exported.Snapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxiedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxiedValidators = append(m.ProxiedValidators, ProxiedValidator{})
+			if err := m.ProxiedValidators[len(m.ProxiedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,17 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +32,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueryValidatorsResponse struct {
 	Validators []*QueryValidatorsResponse_Validator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	Pagination *query.PageResponse                  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryValidatorsResponse) Reset()         { *m = QueryValidatorsResponse{} }
@@ -146,47 +154,424 @@ func (m *QueryValidatorsResponse_Validator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryValidatorsResponse_Validator proto.InternalMessageInfo
 
+type QueryValidatorsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsRequest) Reset()         { *m = QueryValidatorsRequest{} }
+func (m *QueryValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsRequest) ProtoMessage()    {}
+func (*QueryValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12258278c48b9b0b, []int{1}
+}
+func (m *QueryValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsRequest.Merge(m, src)
+}
+func (m *QueryValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsRequest proto.InternalMessageInfo
+
+type QueryProxyRequest struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+}
+
+func (m *QueryProxyRequest) Reset()         { *m = QueryProxyRequest{} }
+func (m *QueryProxyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyRequest) ProtoMessage()    {}
+func (*QueryProxyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12258278c48b9b0b, []int{2}
+}
+func (m *QueryProxyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyRequest.Merge(m, src)
+}
+func (m *QueryProxyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyRequest proto.InternalMessageInfo
+
+type QueryProxyResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *QueryProxyResponse) Reset()         { *m = QueryProxyResponse{} }
+func (m *QueryProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyResponse) ProtoMessage()    {}
+func (*QueryProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12258278c48b9b0b, []int{3}
+}
+func (m *QueryProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyResponse.Merge(m, src)
+}
+func (m *QueryProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyResponse proto.InternalMessageInfo
+
+type QueryOperatorRequest struct {
+	ProxyAddress string `protobuf:"bytes,1,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
+}
+
+func (m *QueryOperatorRequest) Reset()         { *m = QueryOperatorRequest{} }
+func (m *QueryOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorRequest) ProtoMessage()    {}
+func (*QueryOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12258278c48b9b0b, []int{4}
+}
+func (m *QueryOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorRequest.Merge(m, src)
+}
+func (m *QueryOperatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorRequest proto.InternalMessageInfo
+
+type QueryOperatorResponse struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+}
+
+func (m *QueryOperatorResponse) Reset()         { *m = QueryOperatorResponse{} }
+func (m *QueryOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorResponse) ProtoMessage()    {}
+func (*QueryOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12258278c48b9b0b, []int{5}
+}
+func (m *QueryOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorResponse.Merge(m, src)
+}
+func (m *QueryOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorResponse proto.InternalMessageInfo
+
+type QuerySnapshotRequest struct {
+	Counter int64 `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
+}
+
+func (m *QuerySnapshotRequest) Reset()         { *m = QuerySnapshotRequest{} }
+func (m *QuerySnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySnapshotRequest) ProtoMessage()    {}
+func (*QuerySnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12258278c48b9b0b, []int{6}
+}
+func (m *QuerySnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnapshotRequest.Merge(m, src)
+}
+func (m *QuerySnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySnapshotRequest proto.InternalMessageInfo
+
+type QueryLatestSnapshotRequest struct {
+}
+
+func (m *QueryLatestSnapshotRequest) Reset()         { *m = QueryLatestSnapshotRequest{} }
+func (m *QueryLatestSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestSnapshotRequest) ProtoMessage()    {}
+func (*QueryLatestSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12258278c48b9b0b, []int{7}
+}
+func (m *QueryLatestSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestSnapshotRequest.Merge(m, src)
+}
+func (m *QueryLatestSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestSnapshotRequest proto.InternalMessageInfo
+
+// QuerySnapshotResponse describes a snapshot without the full validator data
+type QuerySnapshotResponse struct {
+	Counter                    int64                                  `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
+	Timestamp                  time.Time                              `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Height                     int64                                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	TotalShareCount            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_share_count,json=totalShareCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_share_count"`
+	KeyShareDistributionPolicy exported.KeyShareDistributionPolicy    `protobuf:"varint,5,opt,name=key_share_distribution_policy,json=keyShareDistributionPolicy,proto3,enum=tss.exported.v1beta1.KeyShareDistributionPolicy" json:"key_share_distribution_policy,omitempty"`
+	CorruptionThreshold        int64                                  `protobuf:"varint,6,opt,name=corruption_threshold,json=corruptionThreshold,proto3" json:"corruption_threshold,omitempty"`
+	Validators                 []QuerySnapshotResponse_Validator      `protobuf:"bytes,7,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *QuerySnapshotResponse) Reset()         { *m = QuerySnapshotResponse{} }
+func (m *QuerySnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySnapshotResponse) ProtoMessage()    {}
+func (*QuerySnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12258278c48b9b0b, []int{8}
+}
+func (m *QuerySnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnapshotResponse.Merge(m, src)
+}
+func (m *QuerySnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySnapshotResponse proto.InternalMessageInfo
+
+type QuerySnapshotResponse_Validator struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	ShareCount      int64  `protobuf:"varint,2,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+}
+
+func (m *QuerySnapshotResponse_Validator) Reset()         { *m = QuerySnapshotResponse_Validator{} }
+func (m *QuerySnapshotResponse_Validator) String() string { return proto.CompactTextString(m) }
+func (*QuerySnapshotResponse_Validator) ProtoMessage()    {}
+func (*QuerySnapshotResponse_Validator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12258278c48b9b0b, []int{8, 0}
+}
+func (m *QuerySnapshotResponse_Validator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnapshotResponse_Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnapshotResponse_Validator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySnapshotResponse_Validator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnapshotResponse_Validator.Merge(m, src)
+}
+func (m *QuerySnapshotResponse_Validator) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnapshotResponse_Validator) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnapshotResponse_Validator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySnapshotResponse_Validator proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "snapshot.v1beta1.QueryValidatorsResponse")
 	proto.RegisterType((*QueryValidatorsResponse_TssIllegibilityInfo)(nil), "snapshot.v1beta1.QueryValidatorsResponse.TssIllegibilityInfo")
 	proto.RegisterType((*QueryValidatorsResponse_Validator)(nil), "snapshot.v1beta1.QueryValidatorsResponse.Validator")
+	proto.RegisterType((*QueryValidatorsRequest)(nil), "snapshot.v1beta1.QueryValidatorsRequest")
+	proto.RegisterType((*QueryProxyRequest)(nil), "snapshot.v1beta1.QueryProxyRequest")
+	proto.RegisterType((*QueryProxyResponse)(nil), "snapshot.v1beta1.QueryProxyResponse")
+	proto.RegisterType((*QueryOperatorRequest)(nil), "snapshot.v1beta1.QueryOperatorRequest")
+	proto.RegisterType((*QueryOperatorResponse)(nil), "snapshot.v1beta1.QueryOperatorResponse")
+	proto.RegisterType((*QuerySnapshotRequest)(nil), "snapshot.v1beta1.QuerySnapshotRequest")
+	proto.RegisterType((*QueryLatestSnapshotRequest)(nil), "snapshot.v1beta1.QueryLatestSnapshotRequest")
+	proto.RegisterType((*QuerySnapshotResponse)(nil), "snapshot.v1beta1.QuerySnapshotResponse")
+	proto.RegisterType((*QuerySnapshotResponse_Validator)(nil), "snapshot.v1beta1.QuerySnapshotResponse.Validator")
 }
 
 func init() { proto.RegisterFile("snapshot/v1beta1/query.proto", fileDescriptor_12258278c48b9b0b) }
 
 var fileDescriptor_12258278c48b9b0b = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x6f, 0x13, 0x4d,
-	0x10, 0xc6, 0x7d, 0x89, 0x5f, 0xe7, 0xf5, 0x06, 0x44, 0x58, 0x93, 0xe4, 0x64, 0xa1, 0x23, 0x82,
-	0x26, 0x14, 0xdc, 0x29, 0xb1, 0xa0, 0xa3, 0x20, 0x05, 0xc2, 0x05, 0x12, 0x9c, 0x2d, 0x0a, 0x9a,
-	0xd3, 0x9e, 0x77, 0x6c, 0x2f, 0x3e, 0xef, 0x1c, 0x3b, 0xeb, 0xc4, 0xf7, 0x2d, 0xf8, 0x46, 0xb4,
-	0xa6, 0x4b, 0x49, 0x85, 0xc0, 0xfe, 0x1a, 0x14, 0xe8, 0xd6, 0x7f, 0xb0, 0x50, 0x90, 0xe8, 0x76,
-	0x9e, 0xdf, 0x33, 0xb3, 0xab, 0x67, 0xee, 0xd8, 0x7d, 0xd2, 0x22, 0xa7, 0x21, 0xda, 0xe8, 0xf2,
-	0x2c, 0x05, 0x2b, 0xce, 0xa2, 0x8f, 0x13, 0x30, 0x45, 0x98, 0x1b, 0xb4, 0xc8, 0x0f, 0xd6, 0x34,
-	0x5c, 0xd1, 0xe6, 0xbd, 0x01, 0x0e, 0xd0, 0xc1, 0xa8, 0x3c, 0x2d, 0x7d, 0x0f, 0x7f, 0x56, 0xd9,
-	0xf1, 0xdb, 0xb2, 0xef, 0x9d, 0xc8, 0x94, 0x14, 0x16, 0x0d, 0xc5, 0x40, 0x39, 0x6a, 0x02, 0xde,
-	0x61, 0xec, 0x72, 0xa3, 0xfa, 0xde, 0xc9, 0xee, 0xe9, 0xfe, 0x79, 0x2b, 0xfc, 0x73, 0x70, 0xf8,
-	0x97, 0xf6, 0x70, 0x23, 0xc5, 0x5b, 0x63, 0x9a, 0x9f, 0x77, 0x58, 0xa3, 0x4b, 0xd4, 0xce, 0x32,
-	0x18, 0xa8, 0x54, 0x65, 0xca, 0x16, 0x6d, 0xdd, 0x47, 0x1e, 0x30, 0x66, 0x71, 0x9c, 0x92, 0x45,
-	0x0d, 0xd2, 0xf7, 0x4e, 0xbc, 0xd3, 0xff, 0xe3, 0x2d, 0x85, 0x1f, 0xb1, 0xda, 0x07, 0xa1, 0x32,
-	0x90, 0xfe, 0x8e, 0x63, 0xab, 0x8a, 0xb7, 0xd8, 0xd1, 0x58, 0x11, 0x81, 0x4c, 0x2c, 0x62, 0x32,
-	0x16, 0xba, 0x48, 0xd2, 0x0c, 0x7b, 0x23, 0xf2, 0x77, 0x9d, 0xaf, 0xb1, 0xa4, 0x5d, 0xc4, 0xd7,
-	0x42, 0x17, 0x17, 0x0e, 0xf1, 0x90, 0x35, 0x34, 0x26, 0xb9, 0xc1, 0x69, 0x91, 0x18, 0x18, 0x28,
-	0xb2, 0x60, 0x40, 0xfa, 0x55, 0xd7, 0x71, 0x57, 0xe3, 0x9b, 0x92, 0xc4, 0x1b, 0xc0, 0x1f, 0xb1,
-	0xdb, 0x96, 0x28, 0xa1, 0x09, 0xe5, 0xa0, 0x25, 0x48, 0xff, 0x3f, 0xe7, 0xbc, 0x65, 0x89, 0x3a,
-	0x6b, 0x8d, 0x3f, 0x63, 0xc7, 0xcb, 0x89, 0x4a, 0xd3, 0xa4, 0xaf, 0x7a, 0x0a, 0xb4, 0x4d, 0xfa,
-	0x13, 0x2d, 0xc9, 0xaf, 0x39, 0xfb, 0xa1, 0xc3, 0xed, 0xdf, 0xf4, 0x65, 0x09, 0xcb, 0xc7, 0x90,
-	0x15, 0x19, 0x24, 0xe5, 0x15, 0x43, 0x10, 0xc6, 0xa6, 0x20, 0xac, 0xbf, 0xb7, 0x7c, 0x8c, 0x43,
-	0x5d, 0xa2, 0x57, 0x6b, 0xd0, 0xfc, 0xe2, 0xb1, 0xfa, 0x26, 0x5b, 0xfe, 0x98, 0x1d, 0x60, 0x0e,
-	0xa6, 0x3c, 0x27, 0x42, 0x4a, 0x03, 0x44, 0x2e, 0xbd, 0x7a, 0x7c, 0x67, 0xad, 0xbf, 0x58, 0xca,
-	0xdc, 0x67, 0x7b, 0x63, 0xd4, 0x6a, 0x04, 0xc6, 0x65, 0x58, 0x8f, 0xd7, 0x25, 0xbf, 0x62, 0x87,
-	0xe5, 0xe5, 0x6a, 0x6b, 0x29, 0x89, 0xd2, 0x7d, 0x74, 0x19, 0xee, 0x9f, 0x3f, 0xff, 0xf7, 0xa5,
-	0xdf, 0xb0, 0xda, 0x8b, 0xea, 0xec, 0xdb, 0x83, 0x4a, 0xdc, 0xb0, 0x37, 0xa0, 0xce, 0xec, 0x47,
-	0x50, 0x99, 0xcd, 0x03, 0xef, 0x7a, 0x1e, 0x78, 0xdf, 0xe7, 0x81, 0xf7, 0x69, 0x11, 0x54, 0xae,
-	0x17, 0x41, 0xe5, 0xeb, 0x22, 0xa8, 0xbc, 0x7f, 0x3a, 0x50, 0x76, 0x38, 0x49, 0xc3, 0x1e, 0x8e,
-	0x23, 0x31, 0x85, 0x4c, 0x18, 0x0d, 0xf6, 0x0a, 0xcd, 0x68, 0x55, 0x3d, 0xe9, 0xa1, 0x81, 0x68,
-	0x1a, 0x6d, 0xfe, 0x04, 0x5b, 0xe4, 0x40, 0x69, 0xcd, 0x7d, 0xda, 0xad, 0x5f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x8f, 0xdf, 0xa8, 0xef, 0x22, 0x03, 0x00, 0x00,
+	// 920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x51, 0x6f, 0x1b, 0x45,
+	0x10, 0xce, 0xd5, 0x69, 0x9c, 0x6c, 0x52, 0xda, 0x9c, 0x93, 0xf4, 0x64, 0x15, 0x3b, 0x72, 0xa5,
+	0x12, 0x90, 0x7a, 0xd7, 0x24, 0x82, 0x17, 0x04, 0x12, 0x06, 0x05, 0x22, 0x40, 0x84, 0xb3, 0x45,
+	0xa5, 0xbe, 0x1c, 0x7b, 0xbe, 0xf1, 0x79, 0xf1, 0x79, 0xf7, 0xba, 0xb3, 0xd7, 0xc6, 0xff, 0xa2,
+	0xff, 0x88, 0x07, 0x84, 0x14, 0xde, 0xfa, 0x88, 0x78, 0x28, 0x90, 0xfc, 0x11, 0x74, 0x7b, 0x7b,
+	0xe7, 0x4b, 0xda, 0x88, 0xc2, 0x93, 0x6f, 0xf6, 0x9b, 0xf9, 0x66, 0x76, 0x66, 0xf6, 0x33, 0xb9,
+	0x87, 0x9c, 0xa6, 0x38, 0x11, 0xca, 0x7b, 0xb6, 0x1f, 0x82, 0xa2, 0xfb, 0xde, 0xd3, 0x0c, 0xe4,
+	0xdc, 0x4d, 0xa5, 0x50, 0xc2, 0xbe, 0x53, 0xa2, 0xae, 0x41, 0xdb, 0x5b, 0xb1, 0x88, 0x85, 0x06,
+	0xbd, 0xfc, 0xab, 0xf0, 0x6b, 0x7f, 0x30, 0x12, 0x38, 0x13, 0xe8, 0x85, 0x14, 0xa1, 0x20, 0xa8,
+	0xe8, 0x52, 0x1a, 0x33, 0x4e, 0x15, 0x13, 0xdc, 0xf8, 0x76, 0x63, 0x21, 0xe2, 0x04, 0x3c, 0x6d,
+	0x85, 0xd9, 0xd8, 0x53, 0x6c, 0x06, 0xa8, 0xe8, 0x2c, 0x35, 0x0e, 0xbb, 0x0a, 0xd1, 0x83, 0xd3,
+	0x54, 0x48, 0x05, 0x51, 0xc5, 0xa3, 0xe6, 0x29, 0x60, 0xe1, 0xd1, 0xfb, 0xf5, 0x26, 0xb9, 0xfb,
+	0x7d, 0x9e, 0xe5, 0x07, 0x9a, 0xb0, 0x88, 0x2a, 0x21, 0xd1, 0x07, 0x4c, 0x05, 0x47, 0xb0, 0x07,
+	0x84, 0x3c, 0xab, 0x4e, 0x1d, 0x6b, 0xb7, 0xb1, 0xb7, 0x7e, 0x70, 0xe8, 0x5e, 0xbd, 0x87, 0x7b,
+	0x4d, 0xb8, 0x5b, 0x1d, 0xf9, 0x35, 0x1a, 0xfb, 0x4b, 0x42, 0x16, 0xf7, 0x70, 0x6e, 0xec, 0x5a,
+	0x7b, 0xeb, 0x07, 0xef, 0xb9, 0xc5, 0xa5, 0xdd, 0xfc, 0xd2, 0x6e, 0xd1, 0xb5, 0x92, 0xfd, 0x84,
+	0xc6, 0x50, 0x52, 0xfa, 0xb5, 0xd0, 0xf6, 0xcf, 0x37, 0x48, 0x6b, 0x88, 0x78, 0x9c, 0x24, 0x10,
+	0xb3, 0x90, 0x25, 0x4c, 0xcd, 0x8f, 0xf9, 0x58, 0xd8, 0x1d, 0x42, 0x94, 0x98, 0x85, 0xa8, 0x04,
+	0x87, 0xc8, 0xb1, 0x76, 0xad, 0xbd, 0x55, 0xbf, 0x76, 0x62, 0xef, 0x90, 0x95, 0x9f, 0x28, 0x4b,
+	0x20, 0xd2, 0xc9, 0x57, 0x7d, 0x63, 0xd9, 0x87, 0x64, 0x67, 0xc6, 0x10, 0x21, 0x0a, 0x94, 0x10,
+	0xc1, 0x8c, 0xf2, 0x79, 0x10, 0x26, 0x62, 0x34, 0x45, 0xa7, 0xa1, 0xfd, 0x5a, 0x05, 0x3a, 0x14,
+	0xe2, 0x5b, 0xca, 0xe7, 0x7d, 0x0d, 0xd9, 0x2e, 0x69, 0x71, 0x11, 0xa4, 0x52, 0x9c, 0xce, 0x03,
+	0x09, 0x31, 0x43, 0x05, 0x12, 0x22, 0x67, 0x59, 0x47, 0x6c, 0x72, 0x71, 0x92, 0x23, 0x7e, 0x05,
+	0xd8, 0xf7, 0xc9, 0x2d, 0x85, 0x18, 0x60, 0x86, 0x29, 0xf0, 0x08, 0x22, 0xe7, 0xa6, 0xf6, 0xdc,
+	0x50, 0x88, 0x83, 0xf2, 0xcc, 0xfe, 0x88, 0xdc, 0x2d, 0x18, 0x19, 0xc7, 0x6c, 0xcc, 0x46, 0x0c,
+	0xb8, 0x0a, 0xc6, 0x19, 0x8f, 0xd0, 0x59, 0xd1, 0xee, 0xdb, 0x1a, 0x3e, 0x5e, 0xa0, 0x47, 0x39,
+	0x98, 0x17, 0x83, 0x8a, 0x26, 0x10, 0xe4, 0x29, 0x26, 0x40, 0xa5, 0x0a, 0x81, 0x2a, 0xa7, 0x59,
+	0x14, 0xa3, 0xa1, 0x21, 0xe2, 0x57, 0x25, 0xd0, 0xfe, 0xcd, 0x22, 0x6b, 0xd5, 0x90, 0xec, 0xf7,
+	0xc9, 0x1d, 0x91, 0x82, 0xcc, 0xbf, 0x03, 0x1a, 0x45, 0x12, 0x10, 0x75, 0xf7, 0xd6, 0xfc, 0xdb,
+	0xe5, 0xf9, 0x67, 0xc5, 0xb1, 0xed, 0x90, 0xe6, 0x4c, 0x70, 0x36, 0x05, 0xa9, 0x7b, 0xb8, 0xe6,
+	0x97, 0xa6, 0xfd, 0x9c, 0x6c, 0xe7, 0xc9, 0x59, 0x6d, 0x28, 0x01, 0xe3, 0x63, 0xa1, 0x7b, 0xb8,
+	0x7e, 0xf0, 0xc9, 0xdb, 0x6f, 0xcf, 0x1b, 0x46, 0xdb, 0x5f, 0x3e, 0x7b, 0xd5, 0x5d, 0xf2, 0x5b,
+	0xea, 0x75, 0xa8, 0xf7, 0x23, 0xd9, 0x79, 0x8d, 0xe9, 0x69, 0x06, 0xa8, 0xec, 0xa3, 0x4b, 0x0b,
+	0x67, 0xe9, 0x3a, 0x1e, 0xfc, 0xeb, 0xc2, 0xe9, 0xd8, 0xfa, 0xbe, 0xf5, 0x3e, 0x25, 0x9b, 0x3a,
+	0x83, 0x19, 0x69, 0x41, 0xfe, 0xf6, 0x4d, 0xeb, 0x1d, 0x11, 0xbb, 0x1e, 0x6f, 0xde, 0x98, 0x43,
+	0x9a, 0x97, 0xe3, 0x4a, 0x33, 0xdf, 0x53, 0x54, 0x54, 0x65, 0x68, 0x7a, 0x6c, 0xac, 0xde, 0xc7,
+	0x64, 0x4b, 0xf3, 0x7c, 0x67, 0xf8, 0xcb, 0x52, 0xee, 0x93, 0x5b, 0xc5, 0xd6, 0x5c, 0xe6, 0xdb,
+	0xd0, 0x87, 0x65, 0x11, 0x7d, 0xb2, 0x7d, 0x25, 0xd8, 0xd4, 0xf1, 0x1f, 0x2e, 0xf2, 0xc8, 0x14,
+	0x30, 0x30, 0xa3, 0x2c, 0x0b, 0x70, 0x48, 0x73, 0x24, 0x32, 0xae, 0x40, 0xea, 0xc8, 0x86, 0x5f,
+	0x9a, 0xbd, 0x7b, 0xa4, 0xad, 0x23, 0xbe, 0xa1, 0x0a, 0x50, 0x5d, 0x89, 0xeb, 0xfd, 0xb2, 0x6c,
+	0x8a, 0x5a, 0x00, 0x8b, 0xe6, 0xbc, 0x99, 0xd1, 0xee, 0x93, 0xb5, 0x4a, 0xeb, 0x8c, 0x88, 0xb4,
+	0xdd, 0x42, 0x0d, 0xdd, 0x52, 0x0d, 0xdd, 0x61, 0xe9, 0xd1, 0x5f, 0xcd, 0x17, 0xe7, 0xc5, 0x9f,
+	0x5d, 0xcb, 0x5f, 0x84, 0xe5, 0x0d, 0x9e, 0x00, 0x8b, 0x27, 0x4a, 0x2f, 0x67, 0xc3, 0x37, 0x96,
+	0xfd, 0x84, 0x6c, 0x2a, 0xa1, 0x68, 0x12, 0xe0, 0x84, 0x4a, 0x08, 0x74, 0x4a, 0xfd, 0xa2, 0x37,
+	0xfa, 0x6e, 0xce, 0xf3, 0xc7, 0xab, 0xee, 0x83, 0x98, 0xa9, 0x49, 0x16, 0xba, 0x23, 0x31, 0xf3,
+	0x8c, 0x5e, 0x17, 0x3f, 0x0f, 0x31, 0x9a, 0x1a, 0x7d, 0x3d, 0xe6, 0xca, 0xbf, 0xad, 0x89, 0x06,
+	0x39, 0xcf, 0xe7, 0x39, 0x8d, 0x8d, 0xe4, 0xdd, 0x29, 0xcc, 0x0d, 0x73, 0xc4, 0x50, 0x49, 0x16,
+	0x66, 0xf9, 0x7a, 0x05, 0xa9, 0x48, 0xd8, 0x68, 0xae, 0xf5, 0xe0, 0x9d, 0x83, 0x47, 0xae, 0x42,
+	0x74, 0x4b, 0xe1, 0xae, 0x56, 0xf3, 0x6b, 0x98, 0x6b, 0xae, 0x2f, 0x6a, 0x81, 0x27, 0x3a, 0xce,
+	0x6f, 0x4f, 0xaf, 0xc5, 0xec, 0x7d, 0xb2, 0x35, 0x12, 0x52, 0x66, 0xa9, 0x4e, 0xa4, 0x26, 0x12,
+	0x70, 0x22, 0x92, 0x48, 0x8b, 0x49, 0xc3, 0x6f, 0x2d, 0xb0, 0x61, 0x09, 0xd9, 0x8f, 0x2f, 0x49,
+	0x7f, 0x53, 0x4b, 0xff, 0xfe, 0x35, 0x8f, 0xf7, 0xea, 0xd8, 0x16, 0xc2, 0x6f, 0x1e, 0x6c, 0x8d,
+	0xaa, 0xfd, 0xf8, 0x7f, 0x4a, 0x4e, 0x97, 0xac, 0xd7, 0xc7, 0x71, 0x43, 0x97, 0x4e, 0xb0, 0xea,
+	0x6c, 0x7f, 0x70, 0xf6, 0x77, 0x67, 0xe9, 0xec, 0xbc, 0x63, 0xbd, 0x3c, 0xef, 0x58, 0x7f, 0x9d,
+	0x77, 0xac, 0x17, 0x17, 0x9d, 0xa5, 0x97, 0x17, 0x9d, 0xa5, 0xdf, 0x2f, 0x3a, 0x4b, 0x4f, 0x3e,
+	0xac, 0x0d, 0x8c, 0x9e, 0x42, 0x42, 0x25, 0x07, 0xf5, 0x5c, 0xc8, 0xa9, 0xb1, 0x1e, 0x8e, 0x84,
+	0x04, 0xef, 0xd4, 0xab, 0xfe, 0xc2, 0xf5, 0x0c, 0xc3, 0x15, 0xbd, 0x4b, 0x87, 0xff, 0x0c, 0x00,
+	0xec, 0x67, 0x52, 0x35, 0xdb, 0x07, 0x00, 0x00,
 }
 
 func (m *QueryValidatorsResponse) Marshal() (dAtA []byte, err error) {
@@ -209,6 +594,18 @@ func (m *QueryValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Validators) > 0 {
 		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -366,88 +763,1455 @@ func (m *QueryValidatorsResponse_Validator) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProxyAddress) > 0 {
+		i -= len(m.ProxyAddress)
+		copy(dAtA[i:], m.ProxyAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProxyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Counter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Counter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.CorruptionThreshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CorruptionThreshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.KeyShareDistributionPolicy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyShareDistributionPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TotalShareCount.Size()
+		i -= size
+		if _, err := m.TotalShareCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Counter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Counter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySnapshotResponse_Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnapshotResponse_Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnapshotResponse_Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShareCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsResponse_TssIllegibilityInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.MissedTooManyBlocks {
+		n += 2
+	}
+	if m.NoProxyRegistered {
+		n += 2
+	}
+	if m.TssSuspended {
+		n += 2
+	}
+	if m.ProxyInsuficientFunds {
+		n += 2
+	}
+	if m.StaleTssHeartbeat {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidatorsResponse_Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TssIllegibilityInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProxyAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Counter != 0 {
+		n += 1 + sovQuery(uint64(m.Counter))
+	}
+	return n
+}
+
+func (m *QueryLatestSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Counter != 0 {
+		n += 1 + sovQuery(uint64(m.Counter))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.TotalShareCount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.KeyShareDistributionPolicy != 0 {
+		n += 1 + sovQuery(uint64(m.KeyShareDistributionPolicy))
+	}
+	if m.CorruptionThreshold != 0 {
+		n += 1 + sovQuery(uint64(m.CorruptionThreshold))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySnapshotResponse_Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ShareCount != 0 {
+		n += 1 + sovQuery(uint64(m.ShareCount))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &QueryValidatorsResponse_Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TssIllegibilityInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TssIllegibilityInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedTooManyBlocks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MissedTooManyBlocks = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoProxyRegistered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoProxyRegistered = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssSuspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TssSuspended = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyInsuficientFunds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProxyInsuficientFunds = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleTssHeartbeat", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StaleTssHeartbeat = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsResponse_Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssIllegibilityInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TssIllegibilityInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryValidatorsResponse_TssIllegibilityInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tombstoned {
-		n += 2
-	}
-	if m.Jailed {
-		n += 2
-	}
-	if m.MissedTooManyBlocks {
-		n += 2
-	}
-	if m.NoProxyRegistered {
-		n += 2
-	}
-	if m.TssSuspended {
-		n += 2
-	}
-	if m.ProxyInsuficientFunds {
-		n += 2
-	}
-	if m.StaleTssHeartbeat {
-		n += 2
-	}
-	return n
-}
 
-func (m *QueryValidatorsResponse_Validator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.TssIllegibilityInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -470,17 +2234,17 @@ func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
-			var msglen int
+			m.Counter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -490,26 +2254,61 @@ func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Counter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &QueryValidatorsResponse_Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -531,7 +2330,7 @@ func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) error {
+func (m *QuerySnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -554,17 +2353,17 @@ func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TssIllegibilityInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TssIllegibilityInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
-			var v int
+			m.Counter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -574,17 +2373,16 @@ func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Counter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -594,17 +2392,30 @@ func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedTooManyBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -614,17 +2425,16 @@ func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MissedTooManyBlocks = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoProxyRegistered", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShareCount", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -634,17 +2444,30 @@ func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoProxyRegistered = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShareCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssSuspended", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareDistributionPolicy", wireType)
 			}
-			var v int
+			m.KeyShareDistributionPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -654,17 +2477,16 @@ func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.KeyShareDistributionPolicy |= exported.KeyShareDistributionPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TssSuspended = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyInsuficientFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorruptionThreshold", wireType)
 			}
-			var v int
+			m.CorruptionThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -674,17 +2496,16 @@ func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CorruptionThreshold |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ProxyInsuficientFunds = bool(v != 0)
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleTssHeartbeat", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -694,12 +2515,26 @@ func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StaleTssHeartbeat = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, QuerySnapshotResponse_Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -721,7 +2556,7 @@ func (m *QueryValidatorsResponse_TssIllegibilityInfo) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryValidatorsResponse_Validator) Unmarshal(dAtA []byte) error {
+func (m *QuerySnapshotResponse_Validator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -783,42 +2618,10 @@ func (m *QueryValidatorsResponse_Validator) Unmarshal(dAtA []byte) error {
 			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssIllegibilityInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCount", wireType)
 			}
-			var msglen int
+			m.ShareCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -828,25 +2631,11 @@ func (m *QueryValidatorsResponse_Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShareCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TssIllegibilityInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
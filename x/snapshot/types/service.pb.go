@@ -35,27 +35,39 @@ func init() {
 }
 
 var fileDescriptor_f6341aa52566ee00 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbd, 0x4a, 0x33, 0x41,
-	0x14, 0x86, 0x33, 0x29, 0xbe, 0x62, 0xe1, 0x43, 0x59, 0x6c, 0x0c, 0x32, 0x48, 0xc0, 0xbf, 0x04,
-	0x77, 0x8c, 0xa2, 0x45, 0x4a, 0xb1, 0x15, 0x24, 0xe9, 0x6c, 0x64, 0xb2, 0x39, 0x4c, 0x16, 0xe3,
-	0x9c, 0x71, 0xe6, 0x24, 0x6e, 0x10, 0x1b, 0xaf, 0x40, 0x48, 0xe3, 0xcd, 0x08, 0x96, 0x96, 0x01,
-	0x1b, 0x4b, 0xc9, 0x7a, 0x21, 0x92, 0xec, 0x46, 0x4d, 0xb6, 0xd0, 0x6a, 0x66, 0x78, 0x9f, 0x39,
-	0xef, 0x03, 0xc7, 0xe3, 0x4e, 0x4b, 0xe3, 0x3a, 0x48, 0xa2, 0x5f, 0x6b, 0x01, 0xc9, 0x9a, 0x70,
-	0x60, 0xfb, 0x51, 0x08, 0x81, 0xb1, 0x48, 0xe8, 0x2f, 0xcf, 0xf2, 0x20, 0xcb, 0x4b, 0x2b, 0x0a,
-	0x15, 0x4e, 0x43, 0x31, 0xb9, 0xa5, 0x5c, 0x69, 0x4d, 0x21, 0xaa, 0x2e, 0x08, 0x69, 0x22, 0x21,
-	0xb5, 0x46, 0x92, 0x14, 0xa1, 0x76, 0x59, 0xba, 0x9a, 0x6b, 0xa1, 0x38, 0x8d, 0xf6, 0x9f, 0x8a,
-	0x9e, 0x77, 0xea, 0x54, 0x33, 0x6d, 0xf5, 0x1f, 0x99, 0xf7, 0xbf, 0x01, 0x2a, 0x72, 0x04, 0xf6,
-	0xcc, 0x62, 0x3c, 0xf0, 0x37, 0x83, 0x45, 0x85, 0x60, 0x0e, 0x68, 0xc0, 0x75, 0x0f, 0x1c, 0x95,
-	0xb6, 0x7e, 0xe5, 0x9c, 0x41, 0xed, 0xa0, 0x7c, 0x74, 0xff, 0xfa, 0x31, 0x2c, 0xee, 0x95, 0xab,
-	0x42, 0xc6, 0xd0, 0x95, 0x56, 0x7c, 0xc9, 0xd9, 0x9f, 0xbc, 0xb8, 0x35, 0x93, 0xe3, 0x42, 0xb6,
-	0xdb, 0xf6, 0xae, 0xce, 0x2a, 0xfe, 0x90, 0x79, 0x4b, 0x27, 0x20, 0x43, 0x8a, 0xfa, 0x92, 0x20,
-	0x95, 0xdb, 0xce, 0x97, 0x2e, 0x20, 0x33, 0xbd, 0x9d, 0x3f, 0x90, 0x99, 0x60, 0x75, 0x2a, 0xb8,
-	0x51, 0x5e, 0xcf, 0x09, 0xb6, 0xe7, 0x7f, 0xd4, 0x59, 0xe5, 0xb8, 0xf9, 0x32, 0xe6, 0x6c, 0x34,
-	0xe6, 0xec, 0x7d, 0xcc, 0xd9, 0x43, 0xc2, 0x0b, 0xcf, 0x09, 0x67, 0xa3, 0x84, 0x17, 0xde, 0x12,
-	0x5e, 0x38, 0x3f, 0x54, 0x11, 0x75, 0x7a, 0xad, 0x20, 0xc4, 0xab, 0x6c, 0x98, 0x06, 0xba, 0x41,
-	0x7b, 0x99, 0xbd, 0x76, 0x43, 0xb4, 0x20, 0xe2, 0xef, 0x06, 0x1a, 0x18, 0x70, 0xad, 0x7f, 0xd3,
-	0xdd, 0x1c, 0x7c, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xa1, 0x1c, 0xdd, 0x1e, 0x02, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x85, 0x4a, 0x19, 0xfc, 0xc5, 0xe0, 0xc5, 0xa8, 0x8b, 0x24, 0xfe, 0x68, 0x1b,
+	0xbb, 0x6b, 0x2a, 0x7a, 0xe8, 0x51, 0x3c, 0x2a, 0x6a, 0x03, 0x1e, 0xbc, 0xc8, 0x64, 0xf3, 0xd8,
+	0x2e, 0xc6, 0x7d, 0xdb, 0x99, 0x97, 0x98, 0x50, 0x72, 0x11, 0x14, 0x4f, 0xa2, 0xf4, 0xe2, 0x3f,
+	0x23, 0x78, 0xf4, 0x66, 0xc1, 0x8b, 0x47, 0x49, 0xfc, 0x43, 0x24, 0xb3, 0xb3, 0x49, 0x37, 0xd3,
+	0x24, 0xf6, 0x94, 0x99, 0xbc, 0xcf, 0xcc, 0xf7, 0xc3, 0x7b, 0xc3, 0x72, 0x4f, 0x27, 0x32, 0xd5,
+	0x7b, 0x48, 0x41, 0xb7, 0xde, 0x04, 0x92, 0xf5, 0x40, 0x83, 0xea, 0xc6, 0x21, 0xf8, 0xa9, 0x42,
+	0x42, 0x71, 0x31, 0xaf, 0xfb, 0xb6, 0x5e, 0xbe, 0x14, 0x61, 0x84, 0xa6, 0x18, 0x8c, 0x57, 0x19,
+	0x57, 0xbe, 0x1a, 0x21, 0x46, 0x6d, 0x08, 0x64, 0x1a, 0x07, 0x32, 0x49, 0x90, 0x24, 0xc5, 0x98,
+	0x68, 0x5b, 0xbd, 0xec, 0xa4, 0x50, 0x2f, 0x3f, 0xe8, 0x94, 0xf6, 0x3b, 0xa0, 0xfa, 0x59, 0x75,
+	0xfb, 0xdb, 0x0a, 0xe7, 0x4f, 0x74, 0xd4, 0xc8, 0x9c, 0xc4, 0x57, 0xc6, 0xcf, 0xed, 0x42, 0x14,
+	0x6b, 0x02, 0xf5, 0x4c, 0x61, 0xaf, 0x2f, 0x6e, 0xf9, 0xb3, 0x82, 0x7e, 0x01, 0xd8, 0x85, 0xfd,
+	0x0e, 0x68, 0x2a, 0xdf, 0x5e, 0xca, 0xe9, 0x14, 0x13, 0x0d, 0x95, 0x07, 0xef, 0x7e, 0xfd, 0x3d,
+	0x5c, 0xb9, 0x5b, 0xa9, 0x05, 0xb2, 0x07, 0x6d, 0xa9, 0x82, 0x89, 0x9f, 0x3a, 0xce, 0x07, 0x07,
+	0xe9, 0xf8, 0xe7, 0x95, 0x6c, 0xb5, 0xd4, 0x60, 0x87, 0x6d, 0x8a, 0x43, 0xc6, 0x2f, 0x3c, 0x02,
+	0x19, 0x52, 0xdc, 0x95, 0x04, 0x99, 0xdc, 0xba, 0x1b, 0x3a, 0x83, 0xe4, 0x7a, 0x1b, 0xff, 0x41,
+	0x5a, 0xc1, 0x9a, 0x11, 0xbc, 0x59, 0xb9, 0xee, 0x08, 0xb6, 0x8a, 0x27, 0x76, 0xd8, 0xe6, 0xf6,
+	0xcf, 0x55, 0x7e, 0xf6, 0xf9, 0xb8, 0x9f, 0x79, 0x07, 0xdf, 0x33, 0xbe, 0x9a, 0xc9, 0x55, 0xdd,
+	0x48, 0x43, 0x16, 0xbc, 0x6e, 0x2c, 0x86, 0xac, 0x52, 0xdd, 0x28, 0xd5, 0xc4, 0x86, 0xa3, 0x94,
+	0x66, 0xbd, 0xc2, 0x14, 0x94, 0x24, 0x54, 0xa6, 0x5d, 0xa0, 0xf5, 0x40, 0x7c, 0x62, 0x7c, 0xed,
+	0xa9, 0xfd, 0xf7, 0xa4, 0x21, 0x9a, 0x94, 0x1c, 0x58, 0x30, 0xc4, 0x19, 0x6e, 0xa9, 0x50, 0x6e,
+	0x72, 0x7c, 0x7e, 0x46, 0xe8, 0x23, 0xe3, 0x6b, 0x0d, 0x4b, 0xcd, 0x15, 0xca, 0x81, 0x65, 0x42,
+	0x53, 0xae, 0x38, 0x34, 0x51, 0x75, 0x84, 0x26, 0x8b, 0x83, 0x10, 0x3b, 0x09, 0x81, 0x1a, 0x88,
+	0x2f, 0x8c, 0x9f, 0x7f, 0x2c, 0x09, 0x34, 0x4d, 0x84, 0xee, 0xcc, 0x09, 0x2a, 0x62, 0xa7, 0xd6,
+	0x5a, 0x37, 0x5a, 0x15, 0xe1, 0xbe, 0xa5, 0xb6, 0xb9, 0x78, 0x2b, 0xdf, 0x8b, 0x0f, 0x8c, 0xf3,
+	0x17, 0xb2, 0x1d, 0xb7, 0xc6, 0xcd, 0xd3, 0x27, 0xbd, 0x6c, 0x93, 0x30, 0x45, 0x16, 0xbc, 0x6c,
+	0x87, 0xb4, 0x36, 0x55, 0x63, 0x73, 0x4d, 0x5c, 0x71, 0x6c, 0xba, 0x13, 0xf8, 0x61, 0xe3, 0xc7,
+	0xd0, 0x63, 0x47, 0x43, 0x8f, 0xfd, 0x19, 0x7a, 0xec, 0xf3, 0xc8, 0x2b, 0x7d, 0x1f, 0x79, 0xec,
+	0x68, 0xe4, 0x95, 0x7e, 0x8f, 0xbc, 0xd2, 0xcb, 0xfb, 0x51, 0x4c, 0x7b, 0x9d, 0xa6, 0x1f, 0xe2,
+	0x1b, 0x7b, 0x49, 0x02, 0xf4, 0x16, 0xd5, 0x6b, 0xbb, 0xdb, 0x0a, 0x51, 0x41, 0xd0, 0x9b, 0xde,
+	0x4c, 0xfd, 0x14, 0x74, 0xf3, 0x8c, 0xf9, 0xda, 0xdc, 0xfb, 0x17, 0x00, 0x00, 0xff, 0xff, 0x3b,
+	0xcd, 0x6f, 0xb9, 0x0e, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -179,3 +191,219 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "snapshot/v1beta1/service.proto",
 }
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	Proxy(ctx context.Context, in *QueryProxyRequest, opts ...grpc.CallOption) (*QueryProxyResponse, error)
+	Operator(ctx context.Context, in *QueryOperatorRequest, opts ...grpc.CallOption) (*QueryOperatorResponse, error)
+	Snapshot(ctx context.Context, in *QuerySnapshotRequest, opts ...grpc.CallOption) (*QuerySnapshotResponse, error)
+	LatestSnapshot(ctx context.Context, in *QueryLatestSnapshotRequest, opts ...grpc.CallOption) (*QuerySnapshotResponse, error)
+	Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) Proxy(ctx context.Context, in *QueryProxyRequest, opts ...grpc.CallOption) (*QueryProxyResponse, error) {
+	out := new(QueryProxyResponse)
+	err := c.cc.Invoke(ctx, "/snapshot.v1beta1.QueryService/Proxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Operator(ctx context.Context, in *QueryOperatorRequest, opts ...grpc.CallOption) (*QueryOperatorResponse, error) {
+	out := new(QueryOperatorResponse)
+	err := c.cc.Invoke(ctx, "/snapshot.v1beta1.QueryService/Operator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Snapshot(ctx context.Context, in *QuerySnapshotRequest, opts ...grpc.CallOption) (*QuerySnapshotResponse, error) {
+	out := new(QuerySnapshotResponse)
+	err := c.cc.Invoke(ctx, "/snapshot.v1beta1.QueryService/Snapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) LatestSnapshot(ctx context.Context, in *QueryLatestSnapshotRequest, opts ...grpc.CallOption) (*QuerySnapshotResponse, error) {
+	out := new(QuerySnapshotResponse)
+	err := c.cc.Invoke(ctx, "/snapshot.v1beta1.QueryService/LatestSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error) {
+	out := new(QueryValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/snapshot.v1beta1.QueryService/Validators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	Proxy(context.Context, *QueryProxyRequest) (*QueryProxyResponse, error)
+	Operator(context.Context, *QueryOperatorRequest) (*QueryOperatorResponse, error)
+	Snapshot(context.Context, *QuerySnapshotRequest) (*QuerySnapshotResponse, error)
+	LatestSnapshot(context.Context, *QueryLatestSnapshotRequest) (*QuerySnapshotResponse, error)
+	Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) Proxy(ctx context.Context, req *QueryProxyRequest) (*QueryProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proxy not implemented")
+}
+func (*UnimplementedQueryServiceServer) Operator(ctx context.Context, req *QueryOperatorRequest) (*QueryOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Operator not implemented")
+}
+func (*UnimplementedQueryServiceServer) Snapshot(ctx context.Context, req *QuerySnapshotRequest) (*QuerySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (*UnimplementedQueryServiceServer) LatestSnapshot(ctx context.Context, req *QueryLatestSnapshotRequest) (*QuerySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestSnapshot not implemented")
+}
+func (*UnimplementedQueryServiceServer) Validators(ctx context.Context, req *QueryValidatorsRequest) (*QueryValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_Proxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Proxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snapshot.v1beta1.QueryService/Proxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Proxy(ctx, req.(*QueryProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Operator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Operator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snapshot.v1beta1.QueryService/Operator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Operator(ctx, req.(*QueryOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snapshot.v1beta1.QueryService/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Snapshot(ctx, req.(*QuerySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_LatestSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).LatestSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snapshot.v1beta1.QueryService/LatestSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).LatestSnapshot(ctx, req.(*QueryLatestSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Validators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snapshot.v1beta1.QueryService/Validators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Validators(ctx, req.(*QueryValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "snapshot.v1beta1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Proxy",
+			Handler:    _QueryService_Proxy_Handler,
+		},
+		{
+			MethodName: "Operator",
+			Handler:    _QueryService_Operator_Handler,
+		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _QueryService_Snapshot_Handler,
+		},
+		{
+			MethodName: "LatestSnapshot",
+			Handler:    _QueryService_LatestSnapshot_Handler,
+		},
+		{
+			MethodName: "Validators",
+			Handler:    _QueryService_Validators_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "snapshot/v1beta1/service.proto",
+}
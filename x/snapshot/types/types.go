@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewProxiedValidator is the constructor for ProxiedValidator
+func NewProxiedValidator(validator sdk.ValAddress, proxy sdk.AccAddress, active bool) ProxiedValidator {
+	return ProxiedValidator{
+		Validator: validator,
+		Proxy:     proxy,
+		Active:    active,
+	}
+}
+
+// Validate returns an error if the proxied validator is not valid; nil otherwise
+func (m ProxiedValidator) Validate() error {
+	if err := sdk.VerifyAddressFormat(m.Validator); err != nil {
+		return sdkerrors.Wrap(err, "invalid validator")
+	}
+
+	if err := sdk.VerifyAddressFormat(m.Proxy); err != nil {
+		return sdkerrors.Wrap(err, "invalid proxy")
+	}
+
+	if m.Validator.Equals(sdk.ValAddress(m.Proxy)) {
+		return fmt.Errorf("proxy address cannot be the same as the operator address")
+	}
+
+	return nil
+}
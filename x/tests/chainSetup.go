@@ -133,10 +133,11 @@ func newNode(moniker string, mocks testMocks) *fake.Node {
 	snapshotHandler := snapshot.NewHandler(snapKeeper)
 	btcHandler := bitcoin.NewHandler(bitcoinKeeper, voter, signer, nexusK, snapKeeper)
 	ethHandler := evm.NewHandler(EVMKeeper, mocks.Tss, voter, signer, nexusK, snapKeeper)
-	tssHandler := tss.NewHandler(signer, snapKeeper, nexusK, voter, &tssMock.StakingKeeperMock{
+	tssStaker := &tssMock.StakingKeeperMock{
 		GetLastTotalPowerFunc: mocks.Staker.GetLastTotalPowerFunc,
 		ValidatorFunc:         mocks.Staker.ValidatorFunc,
-	}, rewardKeeper)
+	}
+	tssHandler := tss.NewHandler(signer, snapKeeper, nexusK, voter, tssStaker, rewardKeeper)
 	nexusHandler := nexus.NewHandler(nexusK, snapKeeper)
 
 	router = router.
@@ -157,13 +158,13 @@ func newNode(moniker string, mocks testMocks) *fake.Node {
 				return vote.EndBlocker(ctx, req, voter)
 			},
 			func(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-				return bitcoin.EndBlocker(ctx, req, bitcoinKeeper, signer)
+				return bitcoin.EndBlocker(ctx, req, bitcoinKeeper, nexusK, signer, voter, snapKeeper)
 			},
 			func(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-				return evm.EndBlocker(ctx, req, EVMKeeper, nexusK, signer)
+				return evm.EndBlocker(ctx, req, EVMKeeper, nexusK, signer, voter, snapKeeper)
 			},
 			func(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-				return tss.EndBlocker(ctx, req, signer, voter, nexusK, snapKeeper)
+				return tss.EndBlocker(ctx, req, signer, voter, nexusK, snapKeeper, tssStaker, rewardKeeper)
 			},
 			func(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
 				return nexus.EndBlocker(ctx, req, nexusK, mocks.Staker)
@@ -3,6 +3,7 @@ package tss
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -11,6 +12,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/axelarnetwork/axelar-core/utils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/keeper"
@@ -18,16 +20,21 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/tss/types"
 )
 
+// maxFailedKeyHandovers is the number of blocks in which handing over a new key may fail before the key rotation is aborted
+const maxFailedKeyHandovers = 100
+
 // BeginBlocker check for infraction evidence or downtime of validators
 // on every begin block
 func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock, _ keeper.Keeper) {}
 
 // EndBlocker called every block, process inflation, update validator set.
-func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, keeper keeper.Keeper, voter types.Voter, nexus types.Nexus, snapshotter types.Snapshotter) []abci.ValidatorUpdate {
-	emitHeartbeatEvent(ctx, keeper, nexus)
-	sequentialSign(ctx, keeper.GetSignQueue(ctx), keeper, snapshotter, voter)
-	timeoutMultiSigKeygen(ctx, keeper.GetMultisigKeygenQueue(ctx), keeper)
-	timeoutMultiSigSign(ctx, keeper.GetMultisigSignQueue(ctx), keeper)
+func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k keeper.Keeper, voter types.Voter, nexus types.Nexus, snapshotter types.Snapshotter, staker types.StakingKeeper, rewarder types.Rewarder) []abci.ValidatorUpdate {
+	emitHeartbeatEvent(ctx, k, nexus)
+	monitorSnapshotDrift(ctx, k, nexus, snapshotter)
+	sequentialSign(ctx, k, snapshotter, voter)
+	timeoutMultiSigKeygen(ctx, k.GetMultisigKeygenQueue(ctx), k)
+	timeoutMultiSigSign(ctx, k.GetMultisigSignQueue(ctx), k)
+	rotateKeys(ctx, k, nexus, snapshotter, staker, keeper.NewMsgServerImpl(k, snapshotter, staker, voter, nexus, rewarder))
 
 	return nil
 }
@@ -64,46 +71,85 @@ func emitHeartbeatEvent(ctx sdk.Context, keeper keeper.Keeper, nexus types.Nexus
 
 }
 
-// sequentialSign limits tss sign within max signing shares
-func sequentialSign(ctx sdk.Context, signQueue utils.SequenceKVQueue, k types.TSSKeeper, s types.Snapshotter, voter types.Voter) {
-	i := uint64(0)
-	signShares := int64(0)
-	var signInfo exported.SignInfo
+// monitorSnapshotDrift reports every active key whose eligible share count gets close to or falls below its signing threshold
+func monitorSnapshotDrift(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, s types.Snapshotter) {
+	if ctx.BlockHeight() <= 0 || (ctx.BlockHeight()%k.GetHeartbeatPeriodInBlocks(ctx)) != 0 {
+		return
+	}
 
-	defer func() {
-		ctx.Logger().Debug(fmt.Sprintf("%d active sign shares, %d signatures in queue", signShares, signQueue.Size()))
-	}()
+	for _, chain := range n.GetChains(ctx) {
+		for _, role := range exported.GetKeyRoles() {
+			if role == exported.ExternalKey {
+				continue
+			}
 
-	maxSignShares := k.GetMaxSimultaneousSignShares(ctx)
-	for signShares < maxSignShares && signQueue.Peek(i, &signInfo) {
-		_, sigStatus := k.GetSig(ctx, signInfo.SigID)
-		// no need to check if snapshot exists again, sanity check for that passed at this point
-		snap, _ := s.GetSnapshot(ctx, signInfo.SnapshotCounter)
+			currentKeyID, ok := k.GetCurrentKeyID(ctx, chain, role)
+			if !ok {
+				continue
+			}
 
-		switch sigStatus {
-		case exported.SigStatus_Queued:
-			signShares += snap.CorruptionThreshold + 1
-			if signShares > maxSignShares {
-				return
+			keyIDs := []exported.KeyID{currentKeyID}
+			oldKeys, err := k.GetOldActiveKeys(ctx, chain, role)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("unable to retrieve old keys for chain %s with role %s: %s",
+					chain.Name, role.SimpleString(), err))
+			}
+
+			for _, key := range oldKeys {
+				keyIDs = append(keyIDs, key.ID)
+			}
+
+			for _, keyID := range keyIDs {
+				drift, err := k.GetSnapshotDrift(ctx, s, keyID)
+				if err != nil {
+					k.Logger(ctx).Error(fmt.Sprintf("unable to compute snapshot drift of key %s: %s", keyID, err))
+					continue
+				}
+
+				telemetry.SetGaugeWithLabels(
+					[]string{types.ModuleName, "key", "eligible_share_count"},
+					float32(drift.EligibleShareCount),
+					[]metrics.Label{
+						telemetry.NewLabel("chain", chain.Name),
+						telemetry.NewLabel("keyRole", role.SimpleString()),
+						telemetry.NewLabel("keyID", string(keyID)),
+					})
+
+				if drift.Status == types.SnapshotDriftStatus_Healthy {
+					continue
+				}
+
+				k.Logger(ctx).Info(fmt.Sprintf("key %s of chain %s has drifted from its snapshot (%s): eligible share count [%d], corruption threshold [%d], total share count [%d]",
+					keyID, chain.Name, drift.Status.SimpleString(), drift.EligibleShareCount, drift.CorruptionThreshold, drift.TotalShareCount))
+
+				ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSnapshotDrift,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(sdk.AttributeKeyAction, drift.Status.SimpleString()),
+					sdk.NewAttribute(types.AttributeChain, chain.Name),
+					sdk.NewAttribute(types.AttributeKeyRole, role.SimpleString()),
+					sdk.NewAttribute(types.AttributeKeyKeyID, string(keyID)),
+					sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatInt(drift.CorruptionThreshold, 10)),
+					sdk.NewAttribute(types.AttributeKeyEligibleShareCount, strconv.FormatInt(drift.EligibleShareCount, 10)),
+					sdk.NewAttribute(types.AttributeKeyTotalShareCount, strconv.FormatInt(drift.TotalShareCount, 10)),
+				))
 			}
-			emitSignStartEvent(ctx, k, voter, signInfo, snap)
-			k.SetInfoForSig(ctx, signInfo.SigID, signInfo)
-			k.SetSigStatus(ctx, signInfo.SigID, exported.SigStatus_Signing)
-			ctx.Logger().Debug(fmt.Sprintf("starting sign %s", signInfo.SigID))
-			i++
-		case exported.SigStatus_Signing:
-			signShares += snap.CorruptionThreshold + 1
-			ctx.Logger().Debug(fmt.Sprintf("signing %s in progress", signInfo.SigID))
-			i++
-		case exported.SigStatus_Signed, exported.SigStatus_Aborted, exported.SigStatus_Invalid:
-			signQueue.Dequeue(i, &signInfo)
-			ctx.Logger().Debug(fmt.Sprintf("dequeque %s, sign status %s", signInfo.SigID, sigStatus))
-		default:
-			panic("invalid sig status type")
 		}
 	}
 }
 
+// sequentialSign starts the sign requests scheduled for this block within the max simultaneous sign shares
+func sequentialSign(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, voter types.Voter) {
+	for _, signInfo := range k.ScheduleSigns(ctx, s) {
+		// no need to check if snapshot exists again, sanity check for that passed at this point
+		snap, _ := s.GetSnapshot(ctx, signInfo.SnapshotCounter)
+
+		emitSignStartEvent(ctx, k, voter, signInfo, snap)
+		k.SetInfoForSig(ctx, signInfo.SigID, signInfo)
+		k.SetSigStatus(ctx, signInfo.SigID, exported.SigStatus_Signing)
+		ctx.Logger().Debug(fmt.Sprintf("starting sign %s", signInfo.SigID))
+	}
+}
+
 // request proxies to initiate a tss signing protocol using the specified signing metadata
 func emitSignStartEvent(ctx sdk.Context, k types.TSSKeeper, voter types.InitPoller, info exported.SignInfo, snap snapshot.Snapshot) {
 	var nonParticipantShareCounts []int64
@@ -252,3 +298,207 @@ func timeoutMultiSigSign(ctx sdk.Context, sequenceQueue utils.SequenceKVQueue, k
 		sequenceQueue.Dequeue(0, &sigIDStr)
 	}
 }
+
+// rotateKeys starts keygen for every chain and key role whose key rotation policy is triggered
+// and hands the new keys over to the modules managing the chains once keygen has completed
+func rotateKeys(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, s types.Snapshotter, staker types.StakingKeeper, server types.MsgServiceServer) {
+	for _, policy := range k.GetKeyRotationPolicies(ctx) {
+		chain, ok := n.GetChain(ctx, policy.Chain)
+		if !ok {
+			k.Logger(ctx).Debug(fmt.Sprintf("skipping key rotation policy for unknown chain %s", policy.Chain))
+			continue
+		}
+
+		if rotation, ok := k.GetScheduledKeyRotation(ctx, chain, policy.KeyRole); ok {
+			completeKeyRotation(ctx, k, s, chain, rotation)
+			continue
+		}
+
+		if policy.IsDisabled() {
+			continue
+		}
+
+		scheduleKeyRotation(ctx, k, s, staker, server, chain, policy)
+	}
+}
+
+func scheduleKeyRotation(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, staker types.StakingKeeper, server types.MsgServiceServer, chain nexus.Chain, policy types.KeyRotationPolicy) {
+	// the initial key of a chain is always set up manually
+	currentKeyID, ok := k.GetCurrentKeyID(ctx, chain, policy.KeyRole)
+	if !ok {
+		return
+	}
+
+	// wait for any pending rotation to complete first
+	if _, ok := k.GetNextKeyID(ctx, chain, policy.KeyRole); ok {
+		return
+	}
+
+	// back off after a failed attempt, otherwise a rotation that keeps failing would be retried every block
+	if abort, ok := k.GetKeyRotationAbort(ctx, chain, policy.KeyRole); ok && ctx.BlockHeight() < abort.AbortedAt+k.GetKeyRotationCooldown(ctx) {
+		return
+	}
+
+	trigger, ok := getKeyRotationTrigger(ctx, k, s, staker, policy, currentKeyID)
+	if !ok {
+		return
+	}
+
+	keyID := exported.KeyID(fmt.Sprintf("%s-%s-%d", strings.ToLower(chain.Name), policy.KeyRole.SimpleString(), ctx.BlockHeight()))
+	keyInfo := types.KeyInfo{KeyID: keyID, KeyRole: policy.KeyRole, KeyType: chain.KeyType}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	if _, err := server.StartKeygen(sdk.WrapSDKContext(cachedCtx), &types.StartKeygenRequest{KeyInfo: keyInfo}); err != nil {
+		abortKeyRotation(ctx, k, chain, types.ScheduledKeyRotation{Chain: chain.Name, KeyRole: policy.KeyRole, KeyID: keyID, Trigger: trigger},
+			fmt.Sprintf("failed to start keygen: %s", err.Error()))
+		return
+	}
+
+	k.SetScheduledKeyRotation(cachedCtx, types.ScheduledKeyRotation{
+		Chain:     chain.Name,
+		KeyRole:   policy.KeyRole,
+		KeyID:     keyID,
+		Trigger:   trigger,
+		StartedAt: ctx.BlockHeight(),
+	})
+	writeCache()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeKeyRotation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueScheduled),
+		sdk.NewAttribute(types.AttributeChain, chain.Name),
+		sdk.NewAttribute(types.AttributeKeyRole, policy.KeyRole.SimpleString()),
+		sdk.NewAttribute(types.AttributeKeyKeyID, string(keyID)),
+		sdk.NewAttribute(types.AttributeKeyTrigger, trigger.SimpleString()),
+	))
+
+	k.Logger(ctx).Info(fmt.Sprintf("scheduled %s key rotation for chain %s with key %s (trigger: %s)",
+		policy.KeyRole.SimpleString(), chain.Name, keyID, trigger.SimpleString()))
+}
+
+// getKeyRotationTrigger returns the first trigger of the given policy that fires for the given key
+func getKeyRotationTrigger(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, staker types.StakingKeeper, policy types.KeyRotationPolicy, keyID exported.KeyID) (types.KeyRotationTrigger, bool) {
+	counter, ok := k.GetSnapshotCounterForKeyID(ctx, keyID)
+	if !ok {
+		k.Logger(ctx).Error(fmt.Sprintf("could not find snapshot counter for key %s", keyID))
+		return types.KeyRotationTrigger_Unspecified, false
+	}
+
+	snap, ok := s.GetSnapshot(ctx, counter)
+	if !ok {
+		k.Logger(ctx).Error(fmt.Sprintf("could not find snapshot %d for key %s", counter, keyID))
+		return types.KeyRotationTrigger_Unspecified, false
+	}
+
+	if policy.Period > 0 && ctx.BlockHeight()-snap.Height >= policy.Period {
+		return types.KeyRotationTrigger_Period, true
+	}
+
+	unbonded := false
+	driftedShareCount := sdk.ZeroInt()
+	for _, validator := range snap.Validators {
+		if v := staker.Validator(ctx, validator.GetSDKValidator().GetOperator()); v == nil || !v.IsBonded() {
+			unbonded = true
+			driftedShareCount = driftedShareCount.AddRaw(validator.ShareCount)
+			continue
+		}
+
+		illegibility, err := s.GetValidatorIllegibility(ctx, validator.GetSDKValidator())
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("could not determine eligibility of validator %s: %s", validator.GetSDKValidator().GetOperator().String(), err.Error()))
+			return types.KeyRotationTrigger_Unspecified, false
+		}
+
+		if !illegibility.FilterIllegibilityForNewKey().Is(snapshot.None) {
+			driftedShareCount = driftedShareCount.AddRaw(validator.ShareCount)
+		}
+	}
+
+	if policy.RotateOnUnbonding && unbonded {
+		return types.KeyRotationTrigger_Unbonding, true
+	}
+
+	if policy.MaxSnapshotDrift.Numerator > 0 && policy.MaxSnapshotDrift.IsMet(driftedShareCount, snap.TotalShareCount) {
+		return types.KeyRotationTrigger_SnapshotDrift, true
+	}
+
+	return types.KeyRotationTrigger_Unspecified, false
+}
+
+func completeKeyRotation(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, chain nexus.Chain, rotation types.ScheduledKeyRotation) {
+	if _, ok := k.GetKey(ctx, rotation.KeyID); !ok {
+		// failed keygens lose their snapshot, otherwise keygen is still in progress
+		if _, ok := k.GetSnapshotCounterForKeyID(ctx, rotation.KeyID); !ok {
+			abortKeyRotation(ctx, k, chain, rotation, "keygen failed")
+		}
+
+		return
+	}
+
+	if nextKeyID, ok := k.GetNextKeyID(ctx, chain, rotation.KeyRole); ok && nextKeyID != rotation.KeyID {
+		abortKeyRotation(ctx, k, chain, rotation, fmt.Sprintf("key %s has been assigned in the meantime", nextKeyID))
+		return
+	}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	for _, handler := range k.GetKeyRotationRouter().GetRoutes() {
+		if err := handler(cachedCtx, chain.Name, rotation.KeyRole, rotation.KeyID); err != nil {
+			rotation.FailedHandovers++
+			if rotation.FailedHandovers >= maxFailedKeyHandovers {
+				abortKeyRotation(ctx, k, chain, rotation, fmt.Sprintf("failed to hand over the key %d times: %s", rotation.FailedHandovers, err.Error()))
+				return
+			}
+
+			k.SetScheduledKeyRotation(ctx, rotation)
+			k.Logger(ctx).Error(fmt.Sprintf("failed to hand over %s key %s to chain %s, retrying next block: %s",
+				rotation.KeyRole.SimpleString(), rotation.KeyID, chain.Name, err.Error()))
+			return
+		}
+	}
+
+	// chains without a module that reacts to key rotations get the key assigned directly,
+	// so it can be rotated in with RotateKey
+	if _, ok := k.GetNextKeyID(cachedCtx, chain, rotation.KeyRole); !ok {
+		if err := k.AssertMatchesRequirements(cachedCtx, s, chain, rotation.KeyID, rotation.KeyRole); err != nil {
+			abortKeyRotation(ctx, k, chain, rotation, err.Error())
+			return
+		}
+
+		if err := k.AssignNextKey(cachedCtx, chain, rotation.KeyRole, rotation.KeyID); err != nil {
+			abortKeyRotation(ctx, k, chain, rotation, err.Error())
+			return
+		}
+	}
+
+	k.DeleteScheduledKeyRotation(cachedCtx, chain, rotation.KeyRole)
+	writeCache()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeKeyRotation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueAssigned),
+		sdk.NewAttribute(types.AttributeChain, chain.Name),
+		sdk.NewAttribute(types.AttributeKeyRole, rotation.KeyRole.SimpleString()),
+		sdk.NewAttribute(types.AttributeKeyKeyID, string(rotation.KeyID)),
+		sdk.NewAttribute(types.AttributeKeyTrigger, rotation.Trigger.SimpleString()),
+	))
+
+	k.Logger(ctx).Info(fmt.Sprintf("assigned %s key %s as next key for chain %s", rotation.KeyRole.SimpleString(), rotation.KeyID, chain.Name))
+}
+
+// abortKeyRotation drops the given rotation and records the abort, so the next attempt waits for the key rotation cooldown
+func abortKeyRotation(ctx sdk.Context, k types.TSSKeeper, chain nexus.Chain, rotation types.ScheduledKeyRotation, reason string) {
+	k.DeleteScheduledKeyRotation(ctx, chain, rotation.KeyRole)
+	k.SetKeyRotationAbort(ctx, types.KeyRotationAbort{Chain: chain.Name, KeyRole: rotation.KeyRole, AbortedAt: ctx.BlockHeight()})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeKeyRotation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueFailed),
+		sdk.NewAttribute(types.AttributeChain, chain.Name),
+		sdk.NewAttribute(types.AttributeKeyRole, rotation.KeyRole.SimpleString()),
+		sdk.NewAttribute(types.AttributeKeyKeyID, string(rotation.KeyID)),
+		sdk.NewAttribute(types.AttributeKeyTrigger, rotation.Trigger.SimpleString()),
+	))
+
+	k.Logger(ctx).Info(fmt.Sprintf("aborted %s key rotation for chain %s with key %s, retrying in %d blocks: %s",
+		rotation.KeyRole.SimpleString(), chain.Name, rotation.KeyID, k.GetKeyRotationCooldown(ctx), reason))
+}
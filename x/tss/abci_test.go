@@ -0,0 +1,383 @@
+package tss
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/utils"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	snapMock "github.com/axelarnetwork/axelar-core/x/snapshot/exported/mock"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+	"github.com/axelarnetwork/axelar-core/x/tss/types/mock"
+)
+
+type keygenStarter struct {
+	types.MsgServiceServer
+	requests []*types.StartKeygenRequest
+	err      error
+}
+
+func (s *keygenStarter) StartKeygen(_ context.Context, req *types.StartKeygenRequest) (*types.StartKeygenResponse, error) {
+	s.requests = append(s.requests, req)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &types.StartKeygenResponse{}, nil
+}
+
+func TestRotateKeys(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		k           *mock.TSSKeeperMock
+		n           *mock.NexusMock
+		s           *mock.SnapshotterMock
+		staker      *mock.StakingKeeperMock
+		server      *keygenStarter
+		policy      types.KeyRotationPolicy
+		snap        snapshot.Snapshot
+		unbonded    map[string]bool
+		illegible   map[string]bool
+		scheduled   map[string]types.ScheduledKeyRotation
+		aborts      map[string]types.KeyRotationAbort
+		cooldown    int64
+		nextKeyIDs  map[string]exported.KeyID
+		currentKeys map[string]exported.KeyID
+	)
+
+	chain := evm.Ethereum
+	rotationKey := func(chain string, keyRole exported.KeyRole) string {
+		return fmt.Sprintf("%s_%s", strings.ToLower(chain), keyRole.SimpleString())
+	}
+
+	setup := func() {
+		ctx = sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB()), tmproto.Header{Height: rand.I64Between(10000, 100000)}, false, log.TestingLogger())
+		policy = types.KeyRotationPolicy{
+			Chain:            chain.Name,
+			KeyRole:          exported.SecondaryKey,
+			MaxSnapshotDrift: utils.ZeroThreshold,
+		}
+
+		validators := make([]snapshot.Validator, 4)
+		for i := range validators {
+			address := rand.ValAddr()
+			validators[i] = snapshot.NewValidator(&snapMock.SDKValidatorMock{
+				GetOperatorFunc: func() sdk.ValAddress { return address },
+				StringFunc:      func() string { return address.String() },
+			}, 100)
+		}
+		snap = snapshot.Snapshot{
+			Validators:      validators,
+			Height:          ctx.BlockHeight() - 100,
+			TotalShareCount: sdk.NewInt(400),
+			Counter:         rand.PosI64(),
+		}
+
+		unbonded = map[string]bool{}
+		illegible = map[string]bool{}
+		scheduled = map[string]types.ScheduledKeyRotation{}
+		aborts = map[string]types.KeyRotationAbort{}
+		cooldown = rand.I64Between(10, 1000)
+		nextKeyIDs = map[string]exported.KeyID{}
+		currentKeys = map[string]exported.KeyID{rotationKey(chain.Name, policy.KeyRole): exported.KeyID(rand.HexStr(10))}
+
+		k = &mock.TSSKeeperMock{
+			LoggerFunc:                 func(sdk.Context) log.Logger { return log.TestingLogger() },
+			GetKeyRotationPoliciesFunc: func(sdk.Context) []types.KeyRotationPolicy { return []types.KeyRotationPolicy{policy} },
+			GetScheduledKeyRotationFunc: func(_ sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (types.ScheduledKeyRotation, bool) {
+				rotation, ok := scheduled[rotationKey(chain.Name, keyRole)]
+				return rotation, ok
+			},
+			SetScheduledKeyRotationFunc: func(_ sdk.Context, rotation types.ScheduledKeyRotation) {
+				scheduled[rotationKey(rotation.Chain, rotation.KeyRole)] = rotation
+			},
+			DeleteScheduledKeyRotationFunc: func(_ sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) {
+				delete(scheduled, rotationKey(chain.Name, keyRole))
+			},
+			GetKeyRotationAbortFunc: func(_ sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (types.KeyRotationAbort, bool) {
+				abort, ok := aborts[rotationKey(chain.Name, keyRole)]
+				return abort, ok
+			},
+			SetKeyRotationAbortFunc: func(_ sdk.Context, abort types.KeyRotationAbort) {
+				aborts[rotationKey(abort.Chain, abort.KeyRole)] = abort
+			},
+			GetKeyRotationCooldownFunc: func(sdk.Context) int64 { return cooldown },
+			GetCurrentKeyIDFunc: func(_ sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (exported.KeyID, bool) {
+				keyID, ok := currentKeys[rotationKey(chain.Name, keyRole)]
+				return keyID, ok
+			},
+			GetNextKeyIDFunc: func(_ sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (exported.KeyID, bool) {
+				keyID, ok := nextKeyIDs[rotationKey(chain.Name, keyRole)]
+				return keyID, ok
+			},
+			GetSnapshotCounterForKeyIDFunc: func(sdk.Context, exported.KeyID) (int64, bool) { return snap.Counter, true },
+		}
+		n = &mock.NexusMock{
+			GetChainFunc: func(_ sdk.Context, name string) (nexus.Chain, bool) {
+				return chain, strings.EqualFold(name, chain.Name)
+			},
+		}
+		s = &mock.SnapshotterMock{
+			GetSnapshotFunc: func(_ sdk.Context, counter int64) (snapshot.Snapshot, bool) { return snap, counter == snap.Counter },
+			GetValidatorIllegibilityFunc: func(_ sdk.Context, validator snapshot.SDKValidator) (snapshot.ValidatorIllegibility, error) {
+				if illegible[validator.GetOperator().String()] {
+					return snapshot.Jailed, nil
+				}
+				return snapshot.None, nil
+			},
+		}
+		staker = &mock.StakingKeeperMock{
+			ValidatorFunc: func(_ sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI {
+				if unbonded[addr.String()] {
+					return stakingtypes.Validator{OperatorAddress: addr.String(), Status: stakingtypes.Unbonding}
+				}
+				return stakingtypes.Validator{OperatorAddress: addr.String(), Status: stakingtypes.Bonded}
+			},
+		}
+		server = &keygenStarter{}
+	}
+
+	assertScheduled := func(t *testing.T, trigger types.KeyRotationTrigger) {
+		assert.Len(t, server.requests, 1)
+		rotation, ok := scheduled[rotationKey(chain.Name, policy.KeyRole)]
+		assert.True(t, ok)
+		assert.Equal(t, trigger, rotation.Trigger)
+		assert.Equal(t, rotation.KeyID, server.requests[0].KeyInfo.KeyID)
+		assert.Equal(t, policy.KeyRole, server.requests[0].KeyInfo.KeyRole)
+		assert.Equal(t, chain.KeyType, server.requests[0].KeyInfo.KeyType)
+		assert.Equal(t, ctx.BlockHeight(), rotation.StartedAt)
+	}
+
+	t.Run("should start keygen once the period has passed", testutils.Func(func(t *testing.T) {
+		setup()
+		policy.Period = ctx.BlockHeight() - snap.Height + 1
+
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, server.requests)
+
+		policy.Period = ctx.BlockHeight() - snap.Height
+		rotateKeys(ctx, k, n, s, staker, server)
+		assertScheduled(t, types.KeyRotationTrigger_Period)
+	}).Repeat(20))
+
+	t.Run("should start keygen once the snapshot drift exceeds the maximum", testutils.Func(func(t *testing.T) {
+		setup()
+		policy.MaxSnapshotDrift = utils.NewThreshold(1, 4)
+		illegible[snap.Validators[0].GetSDKValidator().GetOperator().String()] = true
+
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, server.requests)
+
+		unbonded[snap.Validators[1].GetSDKValidator().GetOperator().String()] = true
+		rotateKeys(ctx, k, n, s, staker, server)
+		assertScheduled(t, types.KeyRotationTrigger_SnapshotDrift)
+	}).Repeat(20))
+
+	t.Run("should start keygen when a participant unbonds", testutils.Func(func(t *testing.T) {
+		setup()
+		policy.RotateOnUnbonding = true
+
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, server.requests)
+
+		unbonded[snap.Validators[rand.I64Between(0, int64(len(snap.Validators)))].GetSDKValidator().GetOperator().String()] = true
+		rotateKeys(ctx, k, n, s, staker, server)
+		assertScheduled(t, types.KeyRotationTrigger_Unbonding)
+	}).Repeat(20))
+
+	t.Run("should not start keygen while a rotation is pending", testutils.Func(func(t *testing.T) {
+		setup()
+		policy.Period = 1
+		nextKeyIDs[rotationKey(chain.Name, policy.KeyRole)] = exported.KeyID(rand.HexStr(10))
+
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, server.requests)
+		assert.Empty(t, scheduled)
+	}).Repeat(20))
+
+	t.Run("should hand over the new key once keygen has completed", testutils.Func(func(t *testing.T) {
+		setup()
+		policy.Period = 1
+		keyID := exported.KeyID(rand.HexStr(10))
+		scheduled[rotationKey(chain.Name, policy.KeyRole)] = types.ScheduledKeyRotation{
+			Chain: chain.Name, KeyRole: policy.KeyRole, KeyID: keyID, Trigger: types.KeyRotationTrigger_Period, StartedAt: ctx.BlockHeight() - 1,
+		}
+
+		keygenCompleted := false
+		k.GetKeyFunc = func(_ sdk.Context, id exported.KeyID) (exported.Key, bool) {
+			return exported.Key{ID: id, Role: policy.KeyRole}, keygenCompleted
+		}
+		var handledKeyIDs []exported.KeyID
+		k.GetKeyRotationRouterFunc = func() types.KeyRotationRouter {
+			return types.NewKeyRotationRouter().AddRoute(rand.StrBetween(5, 10), func(_ sdk.Context, chain string, keyRole exported.KeyRole, keyID exported.KeyID) error {
+				handledKeyIDs = append(handledKeyIDs, keyID)
+				nextKeyIDs[rotationKey(chain, keyRole)] = keyID
+				return nil
+			})
+		}
+
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, handledKeyIDs)
+		assert.Len(t, scheduled, 1)
+
+		keygenCompleted = true
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Equal(t, []exported.KeyID{keyID}, handledKeyIDs)
+		assert.Empty(t, scheduled)
+		assert.Empty(t, server.requests)
+	}).Repeat(20))
+
+	t.Run("should assign the new key directly if no module handles the chain", testutils.Func(func(t *testing.T) {
+		setup()
+		keyID := exported.KeyID(rand.HexStr(10))
+		scheduled[rotationKey(chain.Name, policy.KeyRole)] = types.ScheduledKeyRotation{
+			Chain: chain.Name, KeyRole: policy.KeyRole, KeyID: keyID, Trigger: types.KeyRotationTrigger_Unbonding, StartedAt: ctx.BlockHeight() - 1,
+		}
+
+		k.GetKeyFunc = func(_ sdk.Context, id exported.KeyID) (exported.Key, bool) { return exported.Key{ID: id}, true }
+		k.GetKeyRotationRouterFunc = types.NewKeyRotationRouter
+		k.AssertMatchesRequirementsFunc = func(sdk.Context, snapshot.Snapshotter, nexus.Chain, exported.KeyID, exported.KeyRole) error {
+			return nil
+		}
+		k.AssignNextKeyFunc = func(_ sdk.Context, chain nexus.Chain, keyRole exported.KeyRole, keyID exported.KeyID) error {
+			nextKeyIDs[rotationKey(chain.Name, keyRole)] = keyID
+			return nil
+		}
+
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Len(t, k.AssignNextKeyCalls(), 1)
+		assert.Equal(t, keyID, nextKeyIDs[rotationKey(chain.Name, policy.KeyRole)])
+		assert.Empty(t, scheduled)
+	}).Repeat(20))
+
+	t.Run("should retry handing over the new key if a handler fails", testutils.Func(func(t *testing.T) {
+		setup()
+		keyID := exported.KeyID(rand.HexStr(10))
+		scheduled[rotationKey(chain.Name, policy.KeyRole)] = types.ScheduledKeyRotation{
+			Chain: chain.Name, KeyRole: policy.KeyRole, KeyID: keyID, Trigger: types.KeyRotationTrigger_Period, StartedAt: ctx.BlockHeight() - 1,
+		}
+
+		k.GetKeyFunc = func(_ sdk.Context, id exported.KeyID) (exported.Key, bool) { return exported.Key{ID: id}, true }
+		k.GetKeyRotationRouterFunc = func() types.KeyRotationRouter {
+			return types.NewKeyRotationRouter().AddRoute(rand.StrBetween(5, 10), func(sdk.Context, string, exported.KeyRole, exported.KeyID) error {
+				return fmt.Errorf("gateway not deployed")
+			})
+		}
+
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Len(t, scheduled, 1)
+		assert.Empty(t, nextKeyIDs)
+		assert.EqualValues(t, 1, scheduled[rotationKey(chain.Name, policy.KeyRole)].FailedHandovers)
+	}).Repeat(20))
+
+	t.Run("should abort the rotation if handing over the new key keeps failing", testutils.Func(func(t *testing.T) {
+		setup()
+		policy.Period = 1
+		keyID := exported.KeyID(rand.HexStr(10))
+		scheduled[rotationKey(chain.Name, policy.KeyRole)] = types.ScheduledKeyRotation{
+			Chain: chain.Name, KeyRole: policy.KeyRole, KeyID: keyID, Trigger: types.KeyRotationTrigger_Period, StartedAt: ctx.BlockHeight() - 1,
+		}
+
+		k.GetKeyFunc = func(_ sdk.Context, id exported.KeyID) (exported.Key, bool) { return exported.Key{ID: id}, true }
+		k.GetKeyRotationRouterFunc = func() types.KeyRotationRouter {
+			return types.NewKeyRotationRouter().AddRoute(rand.StrBetween(5, 10), func(sdk.Context, string, exported.KeyRole, exported.KeyID) error {
+				return fmt.Errorf("gateway not deployed")
+			})
+		}
+
+		for i := 1; i < maxFailedKeyHandovers; i++ {
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			rotateKeys(ctx, k, n, s, staker, server)
+		}
+		assert.Len(t, scheduled, 1)
+		assert.Empty(t, aborts)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		abortedAt := ctx.BlockHeight()
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, scheduled)
+		assert.Empty(t, nextKeyIDs)
+		assert.Equal(t, abortedAt, aborts[rotationKey(chain.Name, policy.KeyRole)].AbortedAt)
+
+		// no new keygen before the cooldown has passed
+		ctx = ctx.WithBlockHeight(abortedAt + cooldown - 1)
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, server.requests)
+	}).Repeat(20))
+
+	t.Run("should abort the rotation if keygen failed", testutils.Func(func(t *testing.T) {
+		setup()
+		keyID := exported.KeyID(rand.HexStr(10))
+		scheduled[rotationKey(chain.Name, policy.KeyRole)] = types.ScheduledKeyRotation{
+			Chain: chain.Name, KeyRole: policy.KeyRole, KeyID: keyID, Trigger: types.KeyRotationTrigger_Period, StartedAt: ctx.BlockHeight() - 1,
+		}
+
+		k.GetKeyFunc = func(sdk.Context, exported.KeyID) (exported.Key, bool) { return exported.Key{}, false }
+		k.GetSnapshotCounterForKeyIDFunc = func(_ sdk.Context, id exported.KeyID) (int64, bool) { return snap.Counter, id != keyID }
+
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, scheduled)
+		assert.Empty(t, server.requests)
+	}).Repeat(20))
+
+	t.Run("should wait for the cooldown before starting keygen again after an aborted rotation", testutils.Func(func(t *testing.T) {
+		setup()
+		policy.Period = 1
+		keyID := exported.KeyID(rand.HexStr(10))
+		scheduled[rotationKey(chain.Name, policy.KeyRole)] = types.ScheduledKeyRotation{
+			Chain: chain.Name, KeyRole: policy.KeyRole, KeyID: keyID, Trigger: types.KeyRotationTrigger_Period, StartedAt: ctx.BlockHeight() - 1,
+		}
+
+		k.GetKeyFunc = func(sdk.Context, exported.KeyID) (exported.Key, bool) { return exported.Key{}, false }
+		k.GetSnapshotCounterForKeyIDFunc = func(_ sdk.Context, id exported.KeyID) (int64, bool) { return snap.Counter, id != keyID }
+
+		abortedAt := ctx.BlockHeight()
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, scheduled)
+		assert.Equal(t, abortedAt, aborts[rotationKey(chain.Name, policy.KeyRole)].AbortedAt)
+
+		ctx = ctx.WithBlockHeight(abortedAt + cooldown - 1)
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Empty(t, server.requests)
+
+		ctx = ctx.WithBlockHeight(abortedAt + cooldown)
+		rotateKeys(ctx, k, n, s, staker, server)
+		assertScheduled(t, types.KeyRotationTrigger_Period)
+	}).Repeat(20))
+
+	t.Run("should back off when keygen cannot be started", testutils.Func(func(t *testing.T) {
+		setup()
+		policy.Period = 1
+		server.err = fmt.Errorf("not enough eligible validators")
+
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Len(t, server.requests, 1)
+		assert.Empty(t, scheduled)
+		assert.Equal(t, ctx.BlockHeight(), aborts[rotationKey(chain.Name, policy.KeyRole)].AbortedAt)
+
+		abortedAt := ctx.BlockHeight()
+		for height := abortedAt + 1; height < abortedAt+cooldown; height += rand.I64Between(1, 10) {
+			rotateKeys(ctx.WithBlockHeight(height), k, n, s, staker, server)
+		}
+		assert.Len(t, server.requests, 1)
+
+		ctx = ctx.WithBlockHeight(abortedAt + cooldown)
+		rotateKeys(ctx, k, n, s, staker, server)
+		assert.Len(t, server.requests, 2)
+		assert.Equal(t, ctx.BlockHeight(), aborts[rotationKey(chain.Name, policy.KeyRole)].AbortedAt)
+	}).Repeat(20))
+}
@@ -40,6 +40,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdGetActiveOldKeysByValidator(queryRoute),
 		GetCmdGetDeactivatedOperators(queryRoute),
 		GetCmdExternalKeyID(queryRoute),
+		GetCmdSnapshotDrift(queryRoute),
+		GetCmdSignQueue(queryRoute),
 	)
 
 	return tssQueryCmd
@@ -407,3 +409,64 @@ func GetCmdNextKeyID(queryRoute string) *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdSnapshotDrift returns the query for the snapshot drift of the given key ID
+func GetCmdSnapshotDrift(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot-drift [key ID]",
+		Short: "Returns how much of the share count of the given key is still held by validators eligible to sign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyID := args[0]
+			path := fmt.Sprintf("custom/%s/%s/%s", queryRoute, keeper.QuerySnapshotDrift, keyID)
+
+			bz, _, err := clientCtx.Query(path)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "could not get the snapshot drift of key %s", keyID)
+			}
+
+			var res types.QuerySnapshotDriftResponse
+			types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+
+			return clientCtx.PrintProto(&res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSignQueue returns the query for the queued sign requests in the order they are going to start
+func GetCmdSignQueue(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-queue",
+		Short: "Returns the queued sign requests in the order they are going to start and their estimated start heights",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QuerySignQueue)
+
+			bz, _, err := clientCtx.Query(path)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "could not get the sign queue")
+			}
+
+			var res types.QuerySignQueueResponse
+			types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+
+			return clientCtx.PrintProto(&res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
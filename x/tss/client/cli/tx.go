@@ -3,13 +3,16 @@ package cli
 import (
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
+	clientUtils "github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/types"
 )
@@ -111,6 +114,60 @@ func getCmdRotateKey() *cobra.Command {
 	return cmd
 }
 
+// NewCmdSubmitRotateKeyProposal returns the cli command to submit a governance proposal rotating a chain's key
+func NewCmdSubmitRotateKeyProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key [chain] [role] [keyID]",
+		Short: "Submit a proposal to rotate the given chain from the old key to the given key",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chain := args[0]
+			keyRole, err := exported.KeyRoleFromSimpleStr(args[1])
+			if err != nil {
+				return err
+			}
+			keyID := exported.KeyID(args[2])
+
+			return clientUtils.SubmitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRotateKeyProposal(title, description, chain, keyRole, keyID)
+			})
+		},
+	}
+
+	clientUtils.AddProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitUpdateKeyRequirementProposal returns the cli command to submit a governance proposal updating a key requirement
+func NewCmdSubmitUpdateKeyRequirementProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-key-requirement [key requirement file]",
+		Short: "Submit a proposal to add or replace the key requirement for a key role and key type",
+		Long:  "Submit a proposal to add or replace the key requirement for a key role and key type. The key requirement file should be the path to a json file containing the new key requirement",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var keyRequirement exported.KeyRequirement
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &keyRequirement); err != nil {
+				return err
+			}
+
+			return clientUtils.SubmitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewUpdateKeyRequirementProposal(title, description, keyRequirement)
+			})
+		},
+	}
+
+	clientUtils.AddProposalFlags(cmd)
+	return cmd
+}
+
 // GetCmdRegisterExternalKeys returns the cli command to register an external key
 func GetCmdRegisterExternalKeys() *cobra.Command {
 	cmd := &cobra.Command{
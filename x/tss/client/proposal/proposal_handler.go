@@ -0,0 +1,16 @@
+// Package proposal holds the tss proposal handlers. They cannot live in the client package like in other modules,
+// because the cli and rest packages already import it.
+package proposal
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/axelarnetwork/axelar-core/x/tss/client/cli"
+	"github.com/axelarnetwork/axelar-core/x/tss/client/rest"
+)
+
+// proposal handlers to submit key proposals through the gov module's cli and REST endpoints
+var (
+	RotateKeyProposalHandler            = govclient.NewProposalHandler(cli.NewCmdSubmitRotateKeyProposal, rest.RotateKeyProposalRESTHandler)
+	UpdateKeyRequirementProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateKeyRequirementProposal, rest.UpdateKeyRequirementProposalRESTHandler)
+)
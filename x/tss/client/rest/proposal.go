@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clientUtils "github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+// ReqRotateKeyProposal defines the properties of a rotate key proposal request's body
+type ReqRotateKeyProposal struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Chain       string       `json:"chain" yaml:"chain"`
+	KeyRole     string       `json:"key_role" yaml:"key_role"`
+	KeyID       string       `json:"key_id" yaml:"key_id"`
+}
+
+// ReqUpdateKeyRequirementProposal defines the properties of an update key requirement proposal request's body
+type ReqUpdateKeyRequirementProposal struct {
+	BaseReq        rest.BaseReq            `json:"base_req" yaml:"base_req"`
+	Title          string                  `json:"title" yaml:"title"`
+	Description    string                  `json:"description" yaml:"description"`
+	Deposit        sdk.Coins               `json:"deposit" yaml:"deposit"`
+	KeyRequirement exported.KeyRequirement `json:"key_requirement" yaml:"key_requirement"`
+}
+
+// RotateKeyProposalRESTHandler returns the REST handler to submit a proposal rotating a chain's key
+func RotateKeyProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "rotate_key",
+		Handler:  rotateKeyProposalHandlerFn(cliCtx),
+	}
+}
+
+// UpdateKeyRequirementProposalRESTHandler returns the REST handler to submit a proposal updating a key requirement
+func UpdateKeyRequirementProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_key_requirement",
+		Handler:  updateKeyRequirementProposalHandlerFn(cliCtx),
+	}
+}
+
+func rotateKeyProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqRotateKeyProposal
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		keyRole, err := exported.KeyRoleFromSimpleStr(req.KeyRole)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewRotateKeyProposal(req.Title, req.Description, req.Chain, keyRole, exported.KeyID(req.KeyID))
+		writeProposalTx(w, cliCtx, baseReq, content, req.Deposit, fromAddr)
+	}
+}
+
+func updateKeyRequirementProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReqUpdateKeyRequirementProposal
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, ok := clientUtils.ExtractReqSender(w, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		content := types.NewUpdateKeyRequirementProposal(req.Title, req.Description, req.KeyRequirement)
+		writeProposalTx(w, cliCtx, baseReq, content, req.Deposit, fromAddr)
+	}
+}
+
+func writeProposalTx(w http.ResponseWriter, cliCtx client.Context, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+}
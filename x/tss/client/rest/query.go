@@ -343,3 +343,50 @@ func QueryHandlerExternalKeyID(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// QueryHandlerSnapshotDrift returns a handler to query the snapshot drift of the given key ID
+func QueryHandlerSnapshotDrift(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		keyID := mux.Vars(r)[utils.PathVarKeyID]
+		path := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QuerySnapshotDrift, keyID)
+
+		bz, _, err := cliCtx.Query(path)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, sdkerrors.Wrapf(err, "could not get the snapshot drift of key %s", keyID).Error())
+			return
+		}
+
+		var res types.QuerySnapshotDriftResponse
+		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// QueryHandlerSignQueue returns a handler to query the queued sign requests in the order they are going to start
+func QueryHandlerSignQueue(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QuerySignQueue)
+
+		bz, _, err := cliCtx.Query(path)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, sdkerrors.Wrapf(err, "could not get the sign queue").Error())
+			return
+		}
+
+		var res types.QuerySignQueueResponse
+		types.ModuleCdc.MustUnmarshalLengthPrefixed(bz, &res)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
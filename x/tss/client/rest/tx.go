@@ -34,6 +34,8 @@ const (
 	QueryKeySharesByValidator     = keeper.QueryKeySharesByValidator
 	QueryDeactivated              = keeper.QueryDeactivated
 	QueryExternalKeyID            = "external-key-id"
+	QuerySnapshotDrift            = keeper.QuerySnapshotDrift
+	QuerySignQueue                = keeper.QuerySignQueue
 )
 
 // ReqRegisterExternalKey represents a request to register external keys for a chain
@@ -78,6 +80,8 @@ func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerQuery(QueryHandlerKeySharesByValidator(cliCtx), QueryKeySharesByValidator, clientUtils.PathVarCosmosAddress)
 	registerQuery(QueryHandlerDeactivatedOperator(cliCtx), QueryDeactivated)
 	registerQuery(QueryHandlerExternalKeyID(cliCtx), QueryExternalKeyID, clientUtils.PathVarChain)
+	registerQuery(QueryHandlerSnapshotDrift(cliCtx), QuerySnapshotDrift, clientUtils.PathVarKeyID)
+	registerQuery(QueryHandlerSignQueue(cliCtx), QuerySignQueue)
 }
 
 // GetHandlerKeygenStart returns the handler to start a keygen
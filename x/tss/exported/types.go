@@ -16,6 +16,11 @@ import (
 // been generated and voted on
 type Handler func(ctx sdk.Context, info SignInfo) error
 
+// KeyRotationHandler defines a function that hands the key that has been generated
+// automatically for the given chain and key role over to the module managing the chain.
+// Handlers must ignore chains that are not managed by their module
+type KeyRotationHandler func(ctx sdk.Context, chain string, keyRole KeyRole, keyID KeyID) error
+
 // key id length range bounds dictated by tofnd
 const (
 	KeyIDLengthMin = 4
@@ -247,6 +252,65 @@ func (x KeyType) Validate() error {
 	}
 }
 
+// KeyManagementLaneClass is the sign queue lane class of sign requests that are part of key management, e.g. key transfers
+const KeyManagementLaneClass = "key-management"
+
+// GetSignQueueLaneClass returns the class of sign queue lanes the sign request is scheduled in
+func (m SignInfo) GetSignQueueLaneClass() string {
+	if m.KeyManagement {
+		return KeyManagementLaneClass
+	}
+
+	return m.RequestModule
+}
+
+// GetSignQueueLane returns the sign queue lane the sign request is scheduled in.
+// Chain specific sign requests of the same lane class get a separate lane per chain
+func (m SignInfo) GetSignQueueLane() string {
+	if m.RequestChain == "" {
+		return m.GetSignQueueLaneClass()
+	}
+
+	return fmt.Sprintf("%s/%s", m.GetSignQueueLaneClass(), strings.ToLower(m.RequestChain))
+}
+
+// SimpleString returns a human-readable string
+func (x SignPriority) SimpleString() string {
+	switch x {
+	case SignPriority_Low:
+		return "low"
+	case SignPriority_Normal, SignPriority_Unspecified:
+		return "normal"
+	case SignPriority_High:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// Validate validates the SignPriority
+func (x SignPriority) Validate() error {
+	switch x {
+	case SignPriority_Unspecified, SignPriority_Low, SignPriority_Normal, SignPriority_High:
+		return nil
+	default:
+		return fmt.Errorf("invalid sign priority %d", x)
+	}
+}
+
+// IsHigherThan returns true if the priority is higher than the given one. An unspecified priority counts as normal
+func (x SignPriority) IsHigherThan(other SignPriority) bool {
+	rank := func(priority SignPriority) SignPriority {
+		if priority == SignPriority_Unspecified {
+			return SignPriority_Normal
+		}
+
+		return priority
+	}
+
+	return rank(x) > rank(other)
+}
+
 // Validate validates the SigKeyPair
 func (m SigKeyPair) Validate() error {
 	_, err := btcec.ParsePubKey(m.PubKey, btcec.S256())
@@ -115,6 +115,37 @@ func (AckType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6a3f02740fd114b9, []int{2}
 }
 
+type SignPriority int32
+
+const (
+	SignPriority_Unspecified SignPriority = 0
+	SignPriority_Low         SignPriority = 1
+	SignPriority_Normal      SignPriority = 2
+	SignPriority_High        SignPriority = 3
+)
+
+var SignPriority_name = map[int32]string{
+	0: "SIGN_PRIORITY_UNSPECIFIED",
+	1: "SIGN_PRIORITY_LOW",
+	2: "SIGN_PRIORITY_NORMAL",
+	3: "SIGN_PRIORITY_HIGH",
+}
+
+var SignPriority_value = map[string]int32{
+	"SIGN_PRIORITY_UNSPECIFIED": 0,
+	"SIGN_PRIORITY_LOW":         1,
+	"SIGN_PRIORITY_NORMAL":      2,
+	"SIGN_PRIORITY_HIGH":        3,
+}
+
+func (x SignPriority) String() string {
+	return proto.EnumName(SignPriority_name, int32(x))
+}
+
+func (SignPriority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6a3f02740fd114b9, []int{3}
+}
+
 type SigStatus int32
 
 const (
@@ -149,7 +180,7 @@ func (x SigStatus) String() string {
 }
 
 func (SigStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6a3f02740fd114b9, []int{3}
+	return fileDescriptor_6a3f02740fd114b9, []int{4}
 }
 
 type KeyType int32
@@ -180,7 +211,7 @@ func (x KeyType) String() string {
 }
 
 func (KeyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6a3f02740fd114b9, []int{4}
+	return fileDescriptor_6a3f02740fd114b9, []int{5}
 }
 
 // KeyRequirement defines requirements for keys
@@ -310,12 +341,15 @@ func (m *KeyRequirement) GetSignTimeout() int64 {
 
 // SignInfo holds information about a sign request
 type SignInfo struct {
-	KeyID           KeyID  `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=KeyID" json:"key_id,omitempty"`
-	SigID           string `protobuf:"bytes,2,opt,name=sig_id,json=sigId,proto3" json:"sig_id,omitempty"`
-	Msg             []byte `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
-	SnapshotCounter int64  `protobuf:"varint,4,opt,name=snapshot_counter,json=snapshotCounter,proto3" json:"snapshot_counter,omitempty"`
-	RequestModule   string `protobuf:"bytes,5,opt,name=request_module,json=requestModule,proto3" json:"request_module,omitempty"`
-	Metadata        string `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	KeyID           KeyID        `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=KeyID" json:"key_id,omitempty"`
+	SigID           string       `protobuf:"bytes,2,opt,name=sig_id,json=sigId,proto3" json:"sig_id,omitempty"`
+	Msg             []byte       `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	SnapshotCounter int64        `protobuf:"varint,4,opt,name=snapshot_counter,json=snapshotCounter,proto3" json:"snapshot_counter,omitempty"`
+	RequestModule   string       `protobuf:"bytes,5,opt,name=request_module,json=requestModule,proto3" json:"request_module,omitempty"`
+	Metadata        string       `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Priority        SignPriority `protobuf:"varint,7,opt,name=priority,proto3,enum=tss.exported.v1beta1.SignPriority" json:"priority,omitempty"`
+	RequestChain    string       `protobuf:"bytes,8,opt,name=request_chain,json=requestChain,proto3" json:"request_chain,omitempty"`
+	KeyManagement   bool         `protobuf:"varint,9,opt,name=key_management,json=keyManagement,proto3" json:"key_management,omitempty"`
 }
 
 func (m *SignInfo) Reset()         { *m = SignInfo{} }
@@ -393,6 +427,27 @@ func (m *SignInfo) GetMetadata() string {
 	return ""
 }
 
+func (m *SignInfo) GetPriority() SignPriority {
+	if m != nil {
+		return m.Priority
+	}
+	return SignPriority_Unspecified
+}
+
+func (m *SignInfo) GetRequestChain() string {
+	if m != nil {
+		return m.RequestChain
+	}
+	return ""
+}
+
+func (m *SignInfo) GetKeyManagement() bool {
+	if m != nil {
+		return m.KeyManagement
+	}
+	return false
+}
+
 // PubKeyInfo holds a pubkey and a signature
 type SigKeyPair struct {
 	PubKey    []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
@@ -853,6 +908,7 @@ func init() {
 	proto.RegisterEnum("tss.exported.v1beta1.KeyRole", KeyRole_name, KeyRole_value)
 	proto.RegisterEnum("tss.exported.v1beta1.KeyShareDistributionPolicy", KeyShareDistributionPolicy_name, KeyShareDistributionPolicy_value)
 	proto.RegisterEnum("tss.exported.v1beta1.AckType", AckType_name, AckType_value)
+	proto.RegisterEnum("tss.exported.v1beta1.SignPriority", SignPriority_name, SignPriority_value)
 	proto.RegisterEnum("tss.exported.v1beta1.SigStatus", SigStatus_name, SigStatus_value)
 	proto.RegisterEnum("tss.exported.v1beta1.KeyType", KeyType_name, KeyType_value)
 	proto.RegisterType((*KeyRequirement)(nil), "tss.exported.v1beta1.KeyRequirement")
@@ -869,101 +925,109 @@ func init() {
 func init() { proto.RegisterFile("tss/exported/v1beta1/types.proto", fileDescriptor_6a3f02740fd114b9) }
 
 var fileDescriptor_6a3f02740fd114b9 = []byte{
-	// 1499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcd, 0x72, 0xdb, 0x46,
-	0x12, 0x16, 0x48, 0xfd, 0x90, 0x43, 0x4a, 0x82, 0xc7, 0x92, 0xcd, 0xc2, 0xae, 0x49, 0x58, 0x2e,
-	0x6f, 0x49, 0xde, 0x5d, 0x72, 0x25, 0x5f, 0xf6, 0xe4, 0x2d, 0xfe, 0x60, 0x25, 0x98, 0x12, 0x49,
-	0x03, 0xa0, 0xbc, 0xda, 0x0b, 0x0a, 0x24, 0x46, 0xd0, 0x14, 0x49, 0x80, 0x06, 0x06, 0xb2, 0x58,
-	0x95, 0x07, 0x48, 0xf1, 0xe4, 0x53, 0x6e, 0x3c, 0x25, 0x87, 0x3c, 0x42, 0x0e, 0x79, 0x00, 0x1f,
-	0x7d, 0x4b, 0x4e, 0x72, 0x4a, 0x7e, 0x83, 0x5c, 0x52, 0xe5, 0x53, 0x6a, 0x66, 0xc0, 0x9f, 0x92,
-	0xc5, 0x48, 0xb9, 0xcd, 0xf4, 0x7c, 0xdf, 0x37, 0xdd, 0x3d, 0xdd, 0x0d, 0x12, 0xc8, 0x24, 0x08,
-	0x0a, 0xe8, 0xa2, 0xef, 0xf9, 0x04, 0xd9, 0x85, 0xf3, 0xdd, 0x16, 0x22, 0xd6, 0x6e, 0x81, 0x0c,
-	0xfa, 0x28, 0xc8, 0xf7, 0x7d, 0x8f, 0x78, 0x70, 0x83, 0x04, 0x41, 0x7e, 0x8c, 0xc8, 0x47, 0x08,
-	0xe9, 0x51, 0x48, 0x70, 0x37, 0x98, 0x12, 0xce, 0x7c, 0x14, 0x9c, 0x79, 0x5d, 0x9b, 0x93, 0xa4,
-	0x0d, 0xc7, 0x73, 0x3c, 0xb6, 0x2c, 0xd0, 0x55, 0x64, 0xcd, 0x39, 0x9e, 0xe7, 0x74, 0x51, 0x81,
-	0xed, 0x5a, 0xe1, 0x69, 0x81, 0xe0, 0x1e, 0x0a, 0x88, 0xd5, 0xeb, 0x73, 0xc0, 0xd6, 0xaf, 0x4b,
-	0x60, 0xad, 0x8a, 0x06, 0x1a, 0x7a, 0x13, 0x62, 0x1f, 0xf5, 0x90, 0x4b, 0xe0, 0xbf, 0x41, 0xa2,
-	0x83, 0x06, 0xa6, 0xef, 0x75, 0x51, 0x46, 0x90, 0x85, 0xed, 0xb5, 0xbd, 0x47, 0xf9, 0x9b, 0x3c,
-	0xca, 0x53, 0x9e, 0xd7, 0x45, 0xda, 0x4a, 0x87, 0x2f, 0xc6, 0x4c, 0x1a, 0x4b, 0x26, 0x76, 0x0b,
-	0xd3, 0x18, 0xf4, 0x39, 0x93, 0x2e, 0x60, 0x03, 0x6c, 0xf4, 0xb0, 0x6b, 0x76, 0xd0, 0xc0, 0x41,
-	0xae, 0x39, 0x89, 0x2d, 0x13, 0x97, 0x85, 0xed, 0xd4, 0x5e, 0x26, 0xcf, 0x62, 0x9f, 0xd0, 0x8d,
-	0xf1, 0x79, 0x69, 0xf1, 0xfd, 0x65, 0x6e, 0x41, 0x83, 0x3d, 0xec, 0x56, 0x19, 0x75, 0x72, 0x02,
-	0x55, 0x20, 0x06, 0xd6, 0x29, 0x22, 0x83, 0x19, 0xb5, 0xc5, 0x3b, 0xa9, 0xad, 0x73, 0xde, 0x54,
-	0x2a, 0x00, 0x8f, 0x68, 0x58, 0xc1, 0x99, 0xe5, 0x23, 0xd3, 0xc6, 0x01, 0xf1, 0x71, 0x2b, 0x24,
-	0xd8, 0x73, 0xcd, 0xbe, 0xd7, 0xc5, 0xed, 0x41, 0x66, 0x89, 0xc5, 0xfa, 0xaf, 0xb9, 0xb1, 0xea,
-	0x94, 0x59, 0x99, 0x21, 0x36, 0x18, 0x4f, 0x93, 0x3a, 0x73, 0xcf, 0xe0, 0x2e, 0xd8, 0xec, 0x59,
-	0x17, 0x26, 0xf1, 0x88, 0xd5, 0x8d, 0xae, 0x6e, 0x7b, 0xa1, 0x4b, 0x32, 0xcb, 0xb2, 0xb0, 0x1d,
-	0xd7, 0x60, 0xcf, 0xba, 0x30, 0xe8, 0x19, 0xe3, 0x97, 0xe9, 0x09, 0xa3, 0x60, 0xf7, 0x06, 0xca,
-	0x4a, 0x44, 0xc1, 0xee, 0x75, 0xca, 0x31, 0x78, 0x18, 0xe5, 0xfc, 0xdc, 0x23, 0xd8, 0x75, 0x66,
-	0x92, 0x95, 0xb8, 0x53, 0xb2, 0x36, 0x39, 0xfd, 0x98, 0xb1, 0xa7, 0x29, 0xd3, 0xc0, 0x66, 0x80,
-	0x9d, 0x1b, 0x54, 0x93, 0x77, 0x52, 0xbd, 0x4f, 0xc9, 0xd7, 0x35, 0x9f, 0x82, 0xb5, 0x71, 0x7d,
-	0xe0, 0x1e, 0xf2, 0x42, 0x92, 0x01, 0x2c, 0xae, 0x55, 0x6e, 0x35, 0xb8, 0x11, 0x3e, 0x06, 0x69,
-	0x76, 0xf5, 0x18, 0x94, 0x62, 0xa0, 0x14, 0xb5, 0x45, 0x90, 0xad, 0x9f, 0x04, 0x90, 0xd0, 0xb1,
-	0xe3, 0xaa, 0xee, 0xa9, 0x07, 0x77, 0xc0, 0x32, 0x7d, 0x5d, 0x6c, 0xb3, 0x62, 0x4f, 0x96, 0xe0,
-	0xd5, 0x65, 0x6e, 0xa9, 0x8a, 0x06, 0x6a, 0xe5, 0xf3, 0x78, 0xa1, 0x2d, 0x75, 0xd0, 0x40, 0xb5,
-	0xa1, 0x0c, 0x96, 0x03, 0xec, 0x50, 0x68, 0x8c, 0x41, 0x93, 0x14, 0xaa, 0x63, 0x87, 0x22, 0x02,
-	0xec, 0xa8, 0x36, 0x14, 0x41, 0xbc, 0x17, 0x38, 0xac, 0x6c, 0xd3, 0x1a, 0x5d, 0xc2, 0x1d, 0x20,
-	0x06, 0xae, 0xd5, 0x0f, 0xce, 0x3c, 0xc2, 0x5f, 0x03, 0xf9, 0xac, 0x0e, 0xe3, 0xda, 0xfa, 0xd8,
-	0x5e, 0xe6, 0x66, 0x1a, 0xa0, 0x8f, 0xde, 0x84, 0x28, 0x20, 0x66, 0xcf, 0xb3, 0xc3, 0x2e, 0x62,
-	0x85, 0x95, 0xd4, 0x56, 0x23, 0xeb, 0x11, 0x33, 0x42, 0x09, 0x24, 0x7a, 0x88, 0x58, 0xb6, 0x45,
-	0x2c, 0x56, 0x0c, 0x49, 0x6d, 0xb2, 0xdf, 0x2a, 0x03, 0xa0, 0x63, 0xa7, 0x8a, 0x06, 0x0d, 0x0b,
-	0xfb, 0xf0, 0x21, 0x58, 0xe9, 0x87, 0x2d, 0xda, 0x55, 0x2c, 0xb6, 0xb4, 0xb6, 0xdc, 0x0f, 0x5b,
-	0x55, 0x34, 0x80, 0x7f, 0x05, 0x49, 0x9a, 0x0f, 0x8b, 0x84, 0x3e, 0xef, 0xd4, 0xb4, 0x36, 0x35,
-	0x6c, 0xfd, 0x10, 0x07, 0x49, 0x7d, 0xbc, 0x9b, 0x09, 0x5a, 0x98, 0x13, 0xf4, 0x4b, 0x00, 0x02,
-	0xec, 0x3a, 0x5d, 0x64, 0x06, 0xd8, 0x61, 0x72, 0xa9, 0xbd, 0x9d, 0x9b, 0x9b, 0x61, 0x22, 0x9b,
-	0xd7, 0x19, 0x43, 0xc7, 0xce, 0xc1, 0x02, 0xbd, 0x3b, 0xda, 0xc0, 0x7d, 0x90, 0xec, 0x85, 0x5d,
-	0x82, 0x99, 0x14, 0xef, 0xfe, 0xed, 0xdb, 0xa4, 0x8e, 0x28, 0x81, 0x2b, 0x25, 0x7a, 0xd1, 0x1a,
-	0xbe, 0xa0, 0x4e, 0x39, 0x66, 0x40, 0x2c, 0x12, 0x06, 0x2c, 0xe3, 0x6b, 0x7b, 0xb9, 0xb9, 0x4a,
-	0x3a, 0x83, 0xb1, 0x24, 0xf0, 0xa5, 0xd4, 0xa4, 0x39, 0x18, 0x7b, 0x75, 0xc0, 0x6a, 0x8a, 0x26,
-	0xd2, 0xec, 0x5b, 0xd8, 0x67, 0x99, 0x48, 0xed, 0xc9, 0x73, 0xe5, 0xa2, 0x07, 0x88, 0xaa, 0x99,
-	0x3a, 0x12, 0x59, 0xa4, 0x63, 0x90, 0x18, 0xbb, 0x0b, 0x5f, 0x82, 0xd5, 0x59, 0xd5, 0x20, 0x23,
-	0xc8, 0xf1, 0x3f, 0x21, 0x9b, 0x9a, 0xca, 0x06, 0xa5, 0x25, 0x10, 0x0f, 0xb0, 0xb3, 0xf5, 0x31,
-	0x0e, 0xe2, 0xf4, 0x81, 0x73, 0x20, 0x36, 0x79, 0xb0, 0xf5, 0xab, 0xcb, 0x5c, 0x6c, 0xb6, 0x9a,
-	0x63, 0xd8, 0x86, 0xbb, 0x60, 0x91, 0x0d, 0xf8, 0xd8, 0x5d, 0x06, 0x3c, 0x83, 0x52, 0x0a, 0x9b,
-	0xec, 0xf1, 0xbb, 0x4c, 0x76, 0x06, 0x85, 0x75, 0x90, 0x44, 0x6d, 0x3b, 0xb0, 0x58, 0x09, 0xf2,
-	0xe9, 0xbb, 0x35, 0x97, 0x97, 0x57, 0xca, 0x15, 0xbd, 0x58, 0x45, 0x83, 0x52, 0xfa, 0xea, 0x32,
-	0x97, 0x18, 0xef, 0xe8, 0xab, 0x32, 0x11, 0x1a, 0xd7, 0x4b, 0x90, 0x66, 0x2f, 0x1c, 0xe5, 0x8d,
-	0x35, 0x48, 0x6a, 0xef, 0xe9, 0x7c, 0xcd, 0xa3, 0x08, 0xcd, 0x85, 0x52, 0xbd, 0xe9, 0x16, 0xfe,
-	0x07, 0x00, 0xdf, 0x23, 0x16, 0x41, 0xb6, 0x69, 0xf1, 0xb1, 0x9a, 0xda, 0x93, 0xf2, 0xfc, 0x83,
-	0x99, 0x1f, 0x7f, 0x30, 0xf3, 0xc6, 0xf8, 0x83, 0x59, 0x5a, 0x7c, 0xf7, 0x31, 0x27, 0x68, 0xc9,
-	0x88, 0x53, 0x24, 0x92, 0x0c, 0x26, 0x4e, 0xc2, 0x0d, 0xb0, 0x74, 0x6e, 0x75, 0x43, 0x14, 0x35,
-	0x1a, 0xdf, 0x48, 0x65, 0x90, 0x9a, 0x71, 0x00, 0x3e, 0x00, 0xcb, 0xcc, 0xce, 0x5f, 0x3a, 0xad,
-	0x45, 0x3b, 0xda, 0x8e, 0xd3, 0x09, 0x19, 0x63, 0xc3, 0x61, 0x6a, 0x28, 0xa5, 0x01, 0xe8, 0x87,
-	0xad, 0x2e, 0x6e, 0xd3, 0x88, 0x9f, 0xfd, 0x28, 0x80, 0x95, 0xe8, 0x5d, 0xe0, 0x53, 0xb0, 0x51,
-	0x55, 0x4e, 0x4c, 0xad, 0x7e, 0xa8, 0x98, 0xcd, 0x9a, 0xde, 0x50, 0xca, 0xea, 0x7f, 0x55, 0xa5,
-	0x22, 0x2e, 0x48, 0xa9, 0xe1, 0x48, 0x5e, 0x69, 0xba, 0x1d, 0xd7, 0x7b, 0xeb, 0xc2, 0xbf, 0x81,
-	0xfb, 0x13, 0xd8, 0x51, 0x51, 0x37, 0x14, 0xcd, 0xac, 0x2a, 0x27, 0xa2, 0x20, 0xad, 0x0e, 0x47,
-	0x72, 0xf2, 0xc8, 0x0a, 0x08, 0xf2, 0xa9, 0x7b, 0xff, 0x00, 0x0f, 0x26, 0x38, 0x5d, 0x29, 0xd7,
-	0x6b, 0x95, 0xa2, 0x76, 0xc2, 0xa0, 0x31, 0x49, 0x1c, 0x8e, 0xe4, 0xb4, 0x8e, 0xda, 0x9e, 0x6b,
-	0x5b, 0xfe, 0x80, 0xa2, 0x9f, 0x81, 0xcd, 0x09, 0x5a, 0xf9, 0x9f, 0xa1, 0x68, 0xb5, 0xe2, 0x21,
-	0x03, 0xc7, 0xa5, 0xf5, 0xe1, 0x48, 0x4e, 0x29, 0x17, 0x04, 0xf9, 0xae, 0xd5, 0xad, 0xa2, 0x81,
-	0x94, 0xf8, 0xfa, 0xdb, 0xec, 0xc2, 0xf7, 0xdf, 0x65, 0x85, 0x67, 0x9f, 0x05, 0x20, 0xcd, 0xff,
-	0x22, 0xc2, 0x17, 0x60, 0x87, 0x8a, 0xea, 0x07, 0x45, 0x4d, 0x31, 0x2b, 0xaa, 0x6e, 0x68, 0x6a,
-	0xa9, 0x69, 0xa8, 0xf5, 0x9a, 0xd9, 0xa8, 0x1f, 0xaa, 0xe5, 0x93, 0x6b, 0x61, 0xb2, 0x8b, 0x9a,
-	0x6e, 0xd0, 0x47, 0x6d, 0x7c, 0x8a, 0x91, 0x0d, 0x0f, 0x40, 0xe1, 0x8f, 0xf9, 0xaf, 0x15, 0x75,
-	0xff, 0xc0, 0x50, 0x2a, 0x66, 0xe9, 0xc4, 0xd4, 0x8d, 0x62, 0x55, 0x11, 0x05, 0xe9, 0xfe, 0x70,
-	0x24, 0xaf, 0xbf, 0x46, 0xd8, 0x39, 0x23, 0xc8, 0x2e, 0x0d, 0x74, 0x62, 0x75, 0xd0, 0xed, 0x4a,
-	0xf5, 0x9a, 0x62, 0x36, 0x14, 0xcd, 0x3c, 0x2e, 0x1e, 0xaa, 0x95, 0xa2, 0x51, 0xd7, 0xc4, 0x18,
-	0x57, 0xaa, 0xbb, 0xa8, 0x81, 0xfc, 0x63, 0xab, 0x8b, 0x6d, 0x8b, 0x78, 0xfe, 0x4c, 0xf0, 0x5f,
-	0x81, 0x95, 0x62, 0xbb, 0xc3, 0x7e, 0xf0, 0xec, 0x80, 0x8d, 0x62, 0xb9, 0x6a, 0x1a, 0x27, 0x0d,
-	0xe5, 0xb6, 0x98, 0x72, 0x60, 0x7d, 0x02, 0xad, 0x2a, 0x27, 0xfb, 0x4a, 0x4d, 0x14, 0x24, 0x30,
-	0x1c, 0xc9, 0xcb, 0xfc, 0x37, 0x0f, 0xfc, 0x0b, 0x58, 0x9d, 0x00, 0x74, 0x75, 0xbf, 0x26, 0xc6,
-	0xa4, 0xc4, 0x70, 0x24, 0x2f, 0xd2, 0x09, 0xc9, 0x6e, 0x17, 0xd8, 0xed, 0xbf, 0x09, 0x6c, 0xac,
-	0xf3, 0xf9, 0x06, 0xff, 0x0e, 0x1e, 0xe8, 0xea, 0x3e, 0xcd, 0x81, 0xd1, 0xd4, 0x6f, 0x73, 0xe1,
-	0x31, 0xb8, 0x37, 0x03, 0x7e, 0xd5, 0x54, 0x9a, 0x4a, 0x65, 0xec, 0xc4, 0xab, 0x10, 0x85, 0xc8,
-	0x86, 0x4f, 0x00, 0x9c, 0x81, 0x50, 0x37, 0xd4, 0xda, 0xbe, 0x18, 0xe3, 0x95, 0x48, 0x3d, 0xc1,
-	0xae, 0x73, 0x4d, 0x87, 0x82, 0x94, 0x8a, 0x18, 0xe7, 0x3a, 0x14, 0xf3, 0x85, 0x4e, 0xb1, 0x54,
-	0xd7, 0x0c, 0xa5, 0x22, 0x2e, 0x72, 0x9d, 0x62, 0x8b, 0x75, 0xf8, 0x35, 0x90, 0x5a, 0x63, 0x6f,
-	0x20, 0x2e, 0x71, 0x90, 0xea, 0x9e, 0xd3, 0xdc, 0xcf, 0x44, 0xfe, 0x0d, 0xef, 0x19, 0x96, 0xf8,
-	0x0c, 0xef, 0x99, 0x2f, 0x13, 0x4f, 0xd3, 0x38, 0x39, 0xa9, 0xd5, 0x6b, 0xb4, 0x32, 0x58, 0x1a,
-	0x6b, 0x9e, 0x4b, 0x5b, 0x0d, 0x4e, 0x0e, 0x8d, 0x03, 0x4d, 0xd1, 0x0f, 0xea, 0x87, 0x15, 0x31,
-	0xc6, 0x5b, 0x68, 0xfa, 0x1b, 0xe5, 0x09, 0xb8, 0x37, 0x81, 0x1d, 0x35, 0x0f, 0x0d, 0x55, 0x57,
-	0xf7, 0xc5, 0xb8, 0x94, 0x1e, 0x8e, 0xe4, 0xc4, 0x78, 0x12, 0x4c, 0x0b, 0xa2, 0x74, 0xf4, 0xfe,
-	0x2a, 0x2b, 0x7c, 0xb8, 0xca, 0x0a, 0xbf, 0x5c, 0x65, 0x85, 0x77, 0x9f, 0xb2, 0x0b, 0x1f, 0x3e,
-	0x65, 0x17, 0x7e, 0xfe, 0x94, 0x5d, 0xf8, 0xff, 0x73, 0x07, 0x93, 0xb3, 0xb0, 0x95, 0x6f, 0x7b,
-	0xbd, 0x82, 0x75, 0x81, 0xba, 0x96, 0xef, 0x22, 0xf2, 0xd6, 0xf3, 0x3b, 0xd1, 0xee, 0x9f, 0x6d,
-	0xcf, 0x47, 0x85, 0x8b, 0xc2, 0xec, 0x9f, 0x89, 0xd6, 0x32, 0x9b, 0x5a, 0xcf, 0x7f, 0x0f, 0x00,
-	0x00, 0xff, 0xff, 0xf4, 0xe9, 0x1a, 0xf7, 0x63, 0x0c, 0x00, 0x00,
+	// 1628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x3d, 0x73, 0xdb, 0xc8,
+	0x19, 0x16, 0x48, 0x7d, 0x90, 0x2f, 0x29, 0x09, 0x5e, 0xcb, 0x3e, 0x06, 0x89, 0x49, 0x9c, 0x1c,
+	0x67, 0x2c, 0x27, 0x21, 0x63, 0x5d, 0x93, 0xca, 0x19, 0x7e, 0x20, 0x22, 0xcc, 0xcf, 0x03, 0x40,
+	0x39, 0x4a, 0x83, 0x01, 0xc9, 0x35, 0xb4, 0x43, 0x12, 0xe0, 0x01, 0x0b, 0x5b, 0x9c, 0xc9, 0x0f,
+	0xc8, 0xb0, 0xba, 0x2a, 0x1d, 0xab, 0xa4, 0x48, 0x99, 0x32, 0x45, 0x7e, 0xc0, 0x95, 0x57, 0xa6,
+	0xf2, 0x65, 0xe4, 0x7f, 0x90, 0x26, 0x33, 0x57, 0x65, 0x76, 0x01, 0x82, 0xb4, 0x4e, 0x8c, 0x94,
+	0x6e, 0xf7, 0xdd, 0xe7, 0x79, 0xf0, 0x7e, 0xed, 0xbb, 0x24, 0xc8, 0xd4, 0xf7, 0x4b, 0xf8, 0x6a,
+	0xea, 0x7a, 0x14, 0x0f, 0x4b, 0xef, 0x5e, 0xf6, 0x31, 0xb5, 0x5e, 0x96, 0xe8, 0x6c, 0x8a, 0xfd,
+	0xe2, 0xd4, 0x73, 0xa9, 0x8b, 0x8e, 0xa8, 0xef, 0x17, 0x97, 0x88, 0x62, 0x84, 0x90, 0x9e, 0x04,
+	0x94, 0x8c, 0xfd, 0x15, 0xe1, 0xd2, 0xc3, 0xfe, 0xa5, 0x3b, 0x1e, 0x86, 0x24, 0xe9, 0xc8, 0x76,
+	0x6d, 0x97, 0x2f, 0x4b, 0x6c, 0x15, 0x59, 0x0b, 0xb6, 0xeb, 0xda, 0x63, 0x5c, 0xe2, 0xbb, 0x7e,
+	0xf0, 0xb6, 0x44, 0xc9, 0x04, 0xfb, 0xd4, 0x9a, 0x4c, 0x43, 0xc0, 0xf1, 0xbf, 0x77, 0xe0, 0xa0,
+	0x81, 0x67, 0x1a, 0xfe, 0x2a, 0x20, 0x1e, 0x9e, 0x60, 0x87, 0xa2, 0x5f, 0x43, 0x6a, 0x84, 0x67,
+	0xa6, 0xe7, 0x8e, 0x71, 0x4e, 0x90, 0x85, 0xe7, 0x07, 0xa7, 0x4f, 0x8a, 0xb7, 0x79, 0x54, 0x64,
+	0x3c, 0x77, 0x8c, 0xb5, 0xbd, 0x51, 0xb8, 0x58, 0x32, 0x59, 0x2c, 0xb9, 0xc4, 0x1d, 0x4c, 0x63,
+	0x36, 0x0d, 0x99, 0x6c, 0x81, 0xba, 0x70, 0x34, 0x21, 0x8e, 0x39, 0xc2, 0x33, 0x1b, 0x3b, 0x66,
+	0x1c, 0x5b, 0x2e, 0x29, 0x0b, 0xcf, 0x33, 0xa7, 0xb9, 0x22, 0x8f, 0x3d, 0xa6, 0x1b, 0xcb, 0xf3,
+	0xca, 0xf6, 0x37, 0x1f, 0x0a, 0x5b, 0x1a, 0x9a, 0x10, 0xa7, 0xc1, 0xa9, 0xf1, 0x09, 0x52, 0x41,
+	0xf4, 0xad, 0xb7, 0x98, 0xce, 0xd6, 0xd4, 0xb6, 0xef, 0xa5, 0x76, 0x18, 0xf2, 0x56, 0x52, 0x3e,
+	0x3c, 0x61, 0x61, 0xf9, 0x97, 0x96, 0x87, 0xcd, 0x21, 0xf1, 0xa9, 0x47, 0xfa, 0x01, 0x25, 0xae,
+	0x63, 0x4e, 0xdd, 0x31, 0x19, 0xcc, 0x72, 0x3b, 0x3c, 0xd6, 0x5f, 0x6d, 0x8c, 0x55, 0x67, 0xcc,
+	0xda, 0x1a, 0xb1, 0xcb, 0x79, 0x9a, 0x34, 0xda, 0x78, 0x86, 0x5e, 0xc2, 0xa3, 0x89, 0x75, 0x65,
+	0x52, 0x97, 0x5a, 0xe3, 0xe8, 0xd3, 0x03, 0x37, 0x70, 0x68, 0x6e, 0x57, 0x16, 0x9e, 0x27, 0x35,
+	0x34, 0xb1, 0xae, 0x0c, 0x76, 0xc6, 0xf9, 0x55, 0x76, 0xc2, 0x29, 0xc4, 0xb9, 0x85, 0xb2, 0x17,
+	0x51, 0x88, 0x73, 0x93, 0x72, 0x0e, 0x9f, 0x45, 0x39, 0x7f, 0xe7, 0x52, 0xe2, 0xd8, 0x6b, 0xc9,
+	0x4a, 0xdd, 0x2b, 0x59, 0x8f, 0x42, 0xfa, 0x39, 0x67, 0xaf, 0x52, 0xa6, 0xc1, 0x23, 0x9f, 0xd8,
+	0xb7, 0xa8, 0xa6, 0xef, 0xa5, 0xfa, 0x90, 0x91, 0x6f, 0x6a, 0x3e, 0x83, 0x83, 0x65, 0x7f, 0x90,
+	0x09, 0x76, 0x03, 0x9a, 0x03, 0x1e, 0xd7, 0x7e, 0x68, 0x35, 0x42, 0x23, 0xfa, 0x1c, 0xb2, 0xfc,
+	0xd3, 0x4b, 0x50, 0x86, 0x83, 0x32, 0xcc, 0x16, 0x41, 0x8e, 0x3f, 0x26, 0x20, 0xa5, 0x13, 0xdb,
+	0x51, 0x9d, 0xb7, 0x2e, 0x3a, 0x81, 0x5d, 0x56, 0x5d, 0x32, 0xe4, 0xcd, 0x9e, 0xae, 0xa0, 0xeb,
+	0x0f, 0x85, 0x9d, 0x06, 0x9e, 0xa9, 0xb5, 0xef, 0x97, 0x0b, 0x6d, 0x67, 0x84, 0x67, 0xea, 0x10,
+	0xc9, 0xb0, 0xeb, 0x13, 0x9b, 0x41, 0x13, 0x1c, 0x9a, 0x66, 0x50, 0x9d, 0xd8, 0x0c, 0xe1, 0x13,
+	0x5b, 0x1d, 0x22, 0x11, 0x92, 0x13, 0xdf, 0xe6, 0x6d, 0x9b, 0xd5, 0xd8, 0x12, 0x9d, 0x80, 0xe8,
+	0x3b, 0xd6, 0xd4, 0xbf, 0x74, 0x69, 0x58, 0x0d, 0xec, 0xf1, 0x3e, 0x4c, 0x6a, 0x87, 0x4b, 0x7b,
+	0x35, 0x34, 0xb3, 0x00, 0x3d, 0xfc, 0x55, 0x80, 0x7d, 0x6a, 0x4e, 0xdc, 0x61, 0x30, 0xc6, 0xbc,
+	0xb1, 0xd2, 0xda, 0x7e, 0x64, 0x6d, 0x71, 0x23, 0x92, 0x20, 0x35, 0xc1, 0xd4, 0x1a, 0x5a, 0xd4,
+	0xe2, 0xcd, 0x90, 0xd6, 0xe2, 0x3d, 0x7a, 0x05, 0xa9, 0xa9, 0x47, 0x5c, 0x8f, 0xd0, 0x19, 0xaf,
+	0xfa, 0xc1, 0xe9, 0xf1, 0xed, 0x5d, 0xc9, 0xc2, 0xef, 0x46, 0x48, 0x2d, 0xe6, 0xa0, 0xa7, 0xb0,
+	0xfc, 0x98, 0x39, 0xb8, 0xb4, 0x88, 0xc3, 0xbb, 0x20, 0xad, 0x65, 0x23, 0x63, 0x95, 0xd9, 0xa2,
+	0x42, 0x98, 0x13, 0xcb, 0xb1, 0x6c, 0x3e, 0x32, 0x78, 0x55, 0x53, 0xbc, 0x10, 0xad, 0xd8, 0x78,
+	0x5c, 0x05, 0xd0, 0x89, 0xdd, 0xc0, 0xb3, 0xae, 0x45, 0x3c, 0xf4, 0x19, 0xec, 0x4d, 0x83, 0x3e,
+	0xbb, 0xe1, 0x3c, 0xcf, 0x59, 0x6d, 0x77, 0x1a, 0xf4, 0x1b, 0x78, 0x86, 0x7e, 0x02, 0x69, 0x56,
+	0x1b, 0x8b, 0x06, 0x5e, 0x38, 0x35, 0xb2, 0xda, 0xca, 0x70, 0xfc, 0xf7, 0x24, 0xa4, 0xf5, 0xe5,
+	0x6e, 0xad, 0x00, 0xc2, 0x86, 0x02, 0xbc, 0x06, 0xf0, 0x89, 0x63, 0x8f, 0xb1, 0xe9, 0x13, 0x9b,
+	0xcb, 0x65, 0x4e, 0x4f, 0x36, 0xa7, 0x80, 0xcb, 0x16, 0x75, 0xce, 0xd0, 0x89, 0x5d, 0xdf, 0x62,
+	0xdf, 0x8e, 0x36, 0xe8, 0x0c, 0xd2, 0x93, 0x60, 0x4c, 0x09, 0x97, 0x0a, 0x27, 0xd1, 0xf3, 0xbb,
+	0xa4, 0x5a, 0x8c, 0x10, 0x2a, 0xa5, 0x26, 0xd1, 0x1a, 0xbd, 0x62, 0x4e, 0xd9, 0xa6, 0x4f, 0x2d,
+	0x1a, 0xf8, 0xbc, 0xfa, 0x07, 0xa7, 0x85, 0x8d, 0x4a, 0x3a, 0x87, 0xf1, 0x24, 0x84, 0x4b, 0xa9,
+	0xc7, 0x72, 0xb0, 0xf4, 0xaa, 0xce, 0xfb, 0x9b, 0x25, 0xd2, 0x9c, 0x5a, 0xc4, 0xe3, 0x99, 0xc8,
+	0x9c, 0xca, 0x1b, 0xe5, 0xa2, 0x02, 0x44, 0x37, 0x0b, 0xfc, 0xd8, 0x22, 0x9d, 0x43, 0x6a, 0xe9,
+	0x2e, 0x7a, 0x0d, 0xfb, 0xeb, 0xaa, 0x7e, 0x4e, 0x90, 0x93, 0xff, 0x87, 0x6c, 0x66, 0x25, 0xeb,
+	0x57, 0x76, 0x20, 0xe9, 0x13, 0xfb, 0xf8, 0xbb, 0x24, 0x24, 0x59, 0x81, 0x0b, 0x90, 0x88, 0x0b,
+	0x76, 0x78, 0xfd, 0xa1, 0x90, 0x58, 0xbf, 0x59, 0x09, 0x32, 0x44, 0x2f, 0x61, 0x9b, 0x3f, 0x36,
+	0x89, 0xfb, 0x3c, 0x36, 0x1c, 0xca, 0x28, 0xfc, 0x95, 0x49, 0xde, 0xe7, 0x95, 0xe1, 0x50, 0xd4,
+	0x81, 0x34, 0x1e, 0x0c, 0x7d, 0x8b, 0xb7, 0x60, 0xf8, 0x12, 0x1c, 0x6f, 0xe4, 0x15, 0x95, 0x6a,
+	0x4d, 0x2f, 0x37, 0xf0, 0xac, 0x92, 0xbd, 0xfe, 0x50, 0x48, 0x2d, 0x77, 0xac, 0xaa, 0x5c, 0x84,
+	0xc5, 0xf5, 0x1a, 0xb2, 0xbc, 0xc2, 0x51, 0xde, 0xf8, 0x65, 0xcd, 0x9c, 0x3e, 0xdb, 0xac, 0xd9,
+	0x8a, 0xd0, 0xa1, 0x50, 0x66, 0xb2, 0xda, 0xa2, 0xdf, 0x00, 0x78, 0x2e, 0xb5, 0x28, 0x1e, 0x9a,
+	0x56, 0x38, 0xe2, 0x33, 0xa7, 0x52, 0x31, 0x7c, 0xbc, 0x8b, 0xcb, 0xc7, 0xbb, 0x68, 0x2c, 0x1f,
+	0xef, 0xca, 0xf6, 0xd7, 0xdf, 0x15, 0x04, 0x2d, 0x1d, 0x71, 0xca, 0x54, 0x92, 0x21, 0x76, 0x12,
+	0x1d, 0xc1, 0xce, 0x3b, 0x6b, 0x1c, 0xe0, 0xe8, 0xa2, 0x85, 0x1b, 0xa9, 0x0a, 0x99, 0x35, 0x07,
+	0xd0, 0x63, 0xd8, 0xe5, 0xf6, 0xb0, 0xd2, 0x59, 0x2d, 0xda, 0xb1, 0xeb, 0xb8, 0x9a, 0xd6, 0x09,
+	0x3e, 0xa8, 0x56, 0x86, 0x4a, 0x16, 0x60, 0x1a, 0xf4, 0xc7, 0x64, 0xc0, 0x22, 0x7e, 0xf1, 0x0f,
+	0x01, 0xf6, 0xa2, 0xba, 0xa0, 0x67, 0x70, 0xd4, 0x50, 0x2e, 0x4c, 0xad, 0xd3, 0x54, 0xcc, 0x5e,
+	0x5b, 0xef, 0x2a, 0x55, 0xf5, 0xb7, 0xaa, 0x52, 0x13, 0xb7, 0xa4, 0xcc, 0x7c, 0x21, 0xef, 0xf5,
+	0x9c, 0x91, 0xe3, 0xbe, 0x77, 0xd0, 0xcf, 0xe0, 0x61, 0x0c, 0x6b, 0x95, 0x75, 0x43, 0xd1, 0xcc,
+	0x86, 0x72, 0x21, 0x0a, 0xd2, 0xfe, 0x7c, 0x21, 0xa7, 0x5b, 0x96, 0x4f, 0xb1, 0xc7, 0xdc, 0xfb,
+	0x05, 0x3c, 0x8e, 0x71, 0xba, 0x52, 0xed, 0xb4, 0x6b, 0x65, 0xed, 0x82, 0x43, 0x13, 0x92, 0x38,
+	0x5f, 0xc8, 0x59, 0x1d, 0x0f, 0x5c, 0x67, 0x68, 0x79, 0x33, 0x86, 0x7e, 0x01, 0x8f, 0x62, 0xb4,
+	0xf2, 0x3b, 0x43, 0xd1, 0xda, 0xe5, 0x26, 0x07, 0x27, 0xa5, 0xc3, 0xf9, 0x42, 0xce, 0x28, 0x57,
+	0x14, 0x7b, 0x8e, 0x35, 0x6e, 0xe0, 0x99, 0x94, 0xfa, 0xe3, 0x9f, 0xf3, 0x5b, 0x7f, 0xfd, 0x4b,
+	0x5e, 0x78, 0xf1, 0xbd, 0x00, 0xd2, 0xe6, 0xd7, 0x19, 0xbd, 0x82, 0x13, 0x26, 0xaa, 0xd7, 0xcb,
+	0x9a, 0x62, 0xd6, 0x54, 0xdd, 0xd0, 0xd4, 0x4a, 0xcf, 0x50, 0x3b, 0x6d, 0xb3, 0xdb, 0x69, 0xaa,
+	0xd5, 0x8b, 0x1b, 0x61, 0xf2, 0x0f, 0xf5, 0x1c, 0x7f, 0x8a, 0x07, 0xe4, 0x2d, 0xc1, 0x43, 0x54,
+	0x87, 0xd2, 0xff, 0xe6, 0xbf, 0x51, 0xd4, 0xb3, 0xba, 0xa1, 0xd4, 0xcc, 0xca, 0x85, 0xa9, 0x1b,
+	0xe5, 0x86, 0x22, 0x0a, 0xd2, 0xc3, 0xf9, 0x42, 0x3e, 0x7c, 0x83, 0x89, 0x7d, 0x49, 0xf1, 0xb0,
+	0x32, 0xd3, 0xa9, 0x35, 0xc2, 0x77, 0x2b, 0x75, 0xda, 0x8a, 0xd9, 0x55, 0x34, 0xf3, 0xbc, 0xdc,
+	0x54, 0x6b, 0x65, 0xa3, 0xa3, 0x89, 0x89, 0x50, 0xa9, 0xe3, 0xe0, 0x2e, 0xf6, 0xce, 0xad, 0x31,
+	0x19, 0x5a, 0xd4, 0xf5, 0xd6, 0x82, 0xff, 0x03, 0xec, 0x95, 0x07, 0x23, 0xfe, 0xe3, 0xeb, 0x04,
+	0x8e, 0xca, 0xd5, 0x86, 0x69, 0x5c, 0x74, 0x95, 0xbb, 0x62, 0x2a, 0xc0, 0x61, 0x0c, 0x6d, 0x28,
+	0x17, 0x67, 0x4a, 0x5b, 0x14, 0x24, 0x98, 0x2f, 0xe4, 0xdd, 0xf0, 0xf7, 0x17, 0xfa, 0x31, 0xec,
+	0xc7, 0x00, 0x5d, 0x3d, 0x6b, 0x8b, 0x09, 0x29, 0x35, 0x5f, 0xc8, 0xdb, 0x6c, 0x42, 0xf2, 0xaf,
+	0x0b, 0xfc, 0xeb, 0x7f, 0x13, 0x20, 0xbb, 0xfe, 0x04, 0xa1, 0x22, 0xfc, 0x88, 0xc1, 0xcd, 0xae,
+	0xa6, 0x76, 0x34, 0xd5, 0xb8, 0x33, 0xb9, 0x79, 0x78, 0xf0, 0x29, 0xbe, 0xd9, 0x79, 0x23, 0x0a,
+	0xd2, 0xde, 0x7c, 0x21, 0x27, 0x9b, 0xee, 0x7b, 0xf4, 0x53, 0x38, 0xfa, 0xf4, 0xbc, 0xdd, 0xd1,
+	0x5a, 0xe5, 0xa6, 0x98, 0x08, 0xbd, 0x6d, 0xbb, 0xde, 0xc4, 0x1a, 0x23, 0x19, 0xd0, 0xa7, 0xa8,
+	0xba, 0x7a, 0x56, 0x17, 0x93, 0xa1, 0xcb, 0x75, 0x62, 0x5f, 0xae, 0xb9, 0xfc, 0x1f, 0x81, 0xbf,
+	0x44, 0xe1, 0x48, 0x46, 0x3f, 0x87, 0xc7, 0xba, 0x7a, 0xc6, 0xca, 0x66, 0xf4, 0xf4, 0xbb, 0x9c,
+	0xfd, 0x1c, 0x1e, 0xac, 0x81, 0xbf, 0xec, 0x29, 0x3d, 0xa5, 0xb6, 0xcc, 0xdb, 0x97, 0x01, 0x0e,
+	0xf0, 0x10, 0x3d, 0x05, 0xb4, 0x06, 0x61, 0x4e, 0xa9, 0xed, 0x33, 0x31, 0x11, 0x5e, 0x1e, 0x96,
+	0x29, 0xe2, 0xd8, 0x37, 0x74, 0x18, 0x48, 0xa9, 0x89, 0xc9, 0x50, 0x87, 0x61, 0x7e, 0xa0, 0x53,
+	0xae, 0x74, 0x34, 0x43, 0xa9, 0x89, 0xdb, 0xa1, 0x4e, 0xb9, 0xcf, 0x87, 0xd2, 0x0d, 0x90, 0xda,
+	0xe6, 0x6d, 0x23, 0xee, 0x84, 0x20, 0xd5, 0x79, 0xc7, 0xda, 0x65, 0x2d, 0xf2, 0x3f, 0x85, 0xd7,
+	0x9c, 0xf7, 0x4a, 0x2e, 0xbc, 0xe6, 0x3f, 0xec, 0x15, 0x56, 0xf9, 0xf8, 0xa4, 0xdd, 0x69, 0xb3,
+	0x66, 0xe6, 0x69, 0x6c, 0xbb, 0x0e, 0x9b, 0x0e, 0x28, 0x3e, 0x34, 0xea, 0x9a, 0xa2, 0xd7, 0x3b,
+	0xcd, 0x9a, 0x98, 0x08, 0x6f, 0xfd, 0xea, 0x27, 0xde, 0x53, 0x78, 0x10, 0xc3, 0x5a, 0xbd, 0xa6,
+	0xa1, 0xea, 0xea, 0x99, 0x98, 0x94, 0xb2, 0xf3, 0x85, 0x9c, 0x5a, 0x0e, 0xaf, 0x55, 0x0f, 0x57,
+	0x5a, 0xdf, 0x5c, 0xe7, 0x85, 0x6f, 0xaf, 0xf3, 0xc2, 0xbf, 0xae, 0xf3, 0xc2, 0xd7, 0x1f, 0xf3,
+	0x5b, 0xdf, 0x7e, 0xcc, 0x6f, 0xfd, 0xf3, 0x63, 0x7e, 0xeb, 0xf7, 0x5f, 0xd8, 0x84, 0x5e, 0x06,
+	0xfd, 0xe2, 0xc0, 0x9d, 0x94, 0xac, 0x2b, 0x3c, 0xb6, 0x3c, 0x07, 0xd3, 0xf7, 0xae, 0x37, 0x8a,
+	0x76, 0xbf, 0x1c, 0xb8, 0x1e, 0x2e, 0x5d, 0x95, 0xd6, 0xff, 0x8b, 0xf5, 0x77, 0xf9, 0xa0, 0xfd,
+	0xe2, 0xbf, 0x03, 0x00, 0xe3, 0x13, 0x7f, 0x87, 0xa2, 0x0d, 0x00, 0x00,
 }
 
 func (m *KeyRequirement) Marshal() (dAtA []byte, err error) {
@@ -1084,6 +1148,28 @@ func (m *SignInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyManagement {
+		i--
+		if m.KeyManagement {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RequestChain) > 0 {
+		i -= len(m.RequestChain)
+		copy(dAtA[i:], m.RequestChain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RequestChain)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1566,6 +1652,16 @@ func (m *SignInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
+	l = len(m.RequestChain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.KeyManagement {
+		n += 2
+	}
 	return n
 }
 
@@ -2267,6 +2363,77 @@ func (m *SignInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= SignPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyManagement", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyManagement = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
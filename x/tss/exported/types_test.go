@@ -63,3 +63,22 @@ func TestComputeAbsCorruptionThreshold(t *testing.T) {
 	assert.Equal(t, int64(7), exported.ComputeAbsCorruptionThreshold(utils.NewThreshold(2, 3), sdk.NewInt(12)))
 	assert.Equal(t, int64(3), exported.ComputeAbsCorruptionThreshold(utils.NewThreshold(11, 20), sdk.NewInt(7)))
 }
+
+func TestSignInfo_GetSignQueueLane(t *testing.T) {
+	assert.Equal(t, "bitcoin", exported.SignInfo{RequestModule: "bitcoin"}.GetSignQueueLane())
+	assert.Equal(t, "evm/ethereum", exported.SignInfo{RequestModule: "evm", RequestChain: "Ethereum"}.GetSignQueueLane())
+	assert.Equal(t, "evm", exported.SignInfo{RequestModule: "evm", RequestChain: "Ethereum"}.GetSignQueueLaneClass())
+
+	keyTransfer := exported.SignInfo{RequestModule: "evm", RequestChain: "Ethereum", KeyManagement: true}
+	assert.Equal(t, exported.KeyManagementLaneClass, keyTransfer.GetSignQueueLaneClass())
+	assert.Equal(t, exported.KeyManagementLaneClass+"/ethereum", keyTransfer.GetSignQueueLane())
+}
+
+func TestSignPriority_IsHigherThan(t *testing.T) {
+	assert.True(t, exported.SignPriority_High.IsHigherThan(exported.SignPriority_Normal))
+	assert.True(t, exported.SignPriority_Normal.IsHigherThan(exported.SignPriority_Low))
+	assert.True(t, exported.SignPriority_Unspecified.IsHigherThan(exported.SignPriority_Low))
+	assert.False(t, exported.SignPriority_Unspecified.IsHigherThan(exported.SignPriority_Normal))
+	assert.False(t, exported.SignPriority_Normal.IsHigherThan(exported.SignPriority_Unspecified))
+	assert.False(t, exported.SignPriority_Low.IsHigherThan(exported.SignPriority_High))
+}
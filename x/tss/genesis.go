@@ -10,13 +10,12 @@ import (
 // InitGenesis initialize default parameters
 // from the genesis state
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, g types.GenesisState) {
-	k.SetParams(ctx, g.Params)
+	k.InitGenesis(ctx, &g)
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
-	params := k.GetParams(ctx)
-	return types.GenesisState{Params: params}
+	return *k.ExportGenesis(ctx)
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/axelarnetwork/axelar-core/x/tss/keeper"
 	"github.com/axelarnetwork/axelar-core/x/tss/types"
@@ -73,3 +74,31 @@ func NewHandler(k keeper.Keeper, s types.Snapshotter, n types.Nexus, v types.Vot
 		return res, nil
 	}
 }
+
+// NewProposalHandler returns the handler for the tss module's governance proposals
+func NewProposalHandler(k keeper.Keeper, s types.Snapshotter, n types.Nexus, v types.Voter, staker types.StakingKeeper, rewarder types.Rewarder) govtypes.Handler {
+	server := keeper.NewMsgServerImpl(k, s, staker, v, n, rewarder)
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RotateKeyProposal:
+			if _, err := server.RotateKey(sdk.WrapSDKContext(ctx), c.ToRequest()); err != nil {
+				return sdkerrors.Wrap(types.ErrTss, err.Error())
+			}
+
+			return nil
+		case *types.UpdateKeyRequirementProposal:
+			params := c.UpdateKeyRequirement(k.GetParams(ctx))
+			if err := params.Validate(); err != nil {
+				return sdkerrors.Wrap(types.ErrTss, err.Error())
+			}
+
+			k.SetParams(ctx, params)
+			k.Logger(ctx).Debug(fmt.Sprintf("updated %s key requirement for key type %s",
+				c.KeyRequirement.KeyRole.SimpleString(), c.KeyRequirement.KeyType.SimpleString()))
+
+			return nil
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -0,0 +1,434 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoprototypes "github.com/gogo/protobuf/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+// InitGenesis initializes the tss module's state from a given genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, key := range genState.Keys {
+		k.setKeyRecord(ctx, key)
+	}
+
+	for _, sig := range genState.Signatures {
+		k.setSignRecord(ctx, sig)
+	}
+
+	for _, rotations := range genState.KeyRotations {
+		chain := nexus.Chain{Name: rotations.Chain}
+		for i, keyID := range rotations.KeyIDs {
+			k.setKeyID(ctx, chain, int64(i+1), rotations.KeyRole, keyID)
+		}
+
+		if rotations.RotationCount > 0 {
+			k.setRotationCount(ctx, chain, rotations.KeyRole, rotations.RotationCount)
+		}
+	}
+
+	for _, externalKeys := range genState.ExternalKeys {
+		k.SetExternalKeyIDs(ctx, nexus.Chain{Name: externalKeys.Chain}, externalKeys.KeyIDs)
+	}
+
+	for _, suspended := range genState.SuspendedValidators {
+		k.setTssSuspendedUntil(ctx, suspended.Validator, suspended.SuspendedUntil)
+	}
+
+	signQueue := k.GetSignQueue(ctx)
+	for i := range genState.SignQueue {
+		if err := signQueue.Enqueue(&genState.SignQueue[i]); err != nil {
+			panic(err)
+		}
+	}
+
+	multisigKeygenQueue := k.GetMultisigKeygenQueue(ctx)
+	for _, keyID := range genState.MultisigKeygenQueue {
+		if err := multisigKeygenQueue.Enqueue(&gogoprototypes.StringValue{Value: string(keyID)}); err != nil {
+			panic(err)
+		}
+	}
+
+	multisigSignQueue := k.GetMultisigSignQueue(ctx)
+	for _, sigID := range genState.MultisigSignQueue {
+		if err := multisigSignQueue.Enqueue(&gogoprototypes.StringValue{Value: sigID}); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rotation := range genState.ScheduledKeyRotations {
+		k.SetScheduledKeyRotation(ctx, rotation)
+	}
+
+	for _, abort := range genState.KeyRotationAborts {
+		k.SetKeyRotationAbort(ctx, abort)
+	}
+}
+
+// ExportGenesis returns the tss module's genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	signQueue := k.GetSignQueue(ctx)
+	signInfos := make([]exported.SignInfo, signQueue.Size())
+	for i := range signInfos {
+		signQueue.Peek(uint64(i), &signInfos[i])
+	}
+
+	multisigKeygenQueue := k.GetMultisigKeygenQueue(ctx)
+	keygenKeyIDs := make([]exported.KeyID, multisigKeygenQueue.Size())
+	for i := range keygenKeyIDs {
+		var keyID gogoprototypes.StringValue
+		multisigKeygenQueue.Peek(uint64(i), &keyID)
+		keygenKeyIDs[i] = exported.KeyID(keyID.Value)
+	}
+
+	multisigSignQueue := k.GetMultisigSignQueue(ctx)
+	signSigIDs := make([]string, multisigSignQueue.Size())
+	for i := range signSigIDs {
+		var sigID gogoprototypes.StringValue
+		multisigSignQueue.Peek(uint64(i), &sigID)
+		signSigIDs[i] = sigID.Value
+	}
+
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		k.getKeyRecords(ctx),
+		k.getSignRecords(ctx),
+		k.getKeyRotations(ctx),
+		k.getExternalKeys(ctx),
+		k.getSuspendedValidators(ctx),
+		signInfos,
+		keygenKeyIDs,
+		signSigIDs,
+		k.getScheduledKeyRotations(ctx),
+		k.getKeyRotationAborts(ctx),
+	)
+}
+
+func (k Keeper) setKeyRecord(ctx sdk.Context, record types.KeyRecord) {
+	keyID := record.KeyInfo.KeyID
+	k.SetKeyInfo(ctx, record.KeyInfo)
+
+	if record.Key != nil {
+		k.SetKey(ctx, *record.Key)
+	}
+
+	if record.KeygenStarted {
+		k.setKeygenStart(ctx, keyID)
+	}
+
+	for _, participant := range record.KeygenParticipants {
+		k.setParticipatesInKeygen(ctx, keyID, participant)
+	}
+
+	if record.HasSnapshotCounter {
+		k.setSnapshotCounterForKeyID(ctx, keyID, record.SnapshotCounter)
+	}
+
+	if record.RotationCount > 0 {
+		k.setRotationCountOfKeyID(ctx, keyID, record.RotationCount)
+	}
+
+	if record.RotatedAt != 0 {
+		k.setRotatedAt(ctx, keyID, time.Unix(record.RotatedAt, 0))
+	}
+
+	if len(record.GroupRecoveryInfo) > 0 {
+		k.SetGroupRecoveryInfo(ctx, keyID, record.GroupRecoveryInfo)
+	}
+
+	for _, info := range record.PrivateRecoveryInfos {
+		k.SetPrivateRecoveryInfo(ctx, info.Validator, keyID, info.RecoveryInfo)
+	}
+
+	if record.MultisigKeygenInfo != nil {
+		k.SetMultisigKeygenInfo(ctx, *record.MultisigKeygenInfo)
+	}
+}
+
+func (k Keeper) getKeyRecords(ctx sdk.Context) []types.KeyRecord {
+	records := []types.KeyRecord{}
+
+	iter := k.getStore(ctx).Iterator(keyInfoPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var keyInfo types.KeyInfo
+		iter.UnmarshalValue(&keyInfo)
+
+		records = append(records, k.getKeyRecord(ctx, keyInfo))
+	}
+
+	return records
+}
+
+func (k Keeper) getKeyRecord(ctx sdk.Context, keyInfo types.KeyInfo) types.KeyRecord {
+	keyID := keyInfo.KeyID
+	record := types.KeyRecord{
+		KeyInfo:              keyInfo,
+		KeygenStarted:        k.HasKeygenStarted(ctx, keyID),
+		KeygenParticipants:   k.GetParticipantsInKeygen(ctx, keyID),
+		GroupRecoveryInfo:    k.GetGroupRecoveryInfo(ctx, keyID),
+		PrivateRecoveryInfos: k.getPrivateRecoveryInfos(ctx, keyID),
+	}
+
+	var key exported.Key
+	if ok := k.getStore(ctx).Get(pkPrefix.AppendStr(string(keyID)), &key); ok {
+		record.Key = &key
+	}
+
+	record.SnapshotCounter, record.HasSnapshotCounter = k.GetSnapshotCounterForKeyID(ctx, keyID)
+	record.RotationCount, _ = k.GetRotationCountOfKeyID(ctx, keyID)
+
+	if rotatedAt := k.getRotatedAt(ctx, keyID); rotatedAt != nil {
+		record.RotatedAt = rotatedAt.Unix()
+	}
+
+	var multisigInfo types.MultisigInfo
+	if ok := k.getStore(ctx).Get(multiSigKeyPrefix.AppendStr(string(keyID)), &multisigInfo); ok {
+		record.MultisigKeygenInfo = &multisigInfo
+	}
+
+	return record
+}
+
+func (k Keeper) getPrivateRecoveryInfos(ctx sdk.Context, keyID exported.KeyID) []types.KeyRecord_PrivateRecoveryInfo {
+	infos := []types.KeyRecord_PrivateRecoveryInfo{}
+
+	prefix := privateRecoverPrefix.AppendStr(string(keyID)).AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		// the prefix might match the recovery infos of other keys whose ID starts with the given key ID
+		validator, err := sdk.ValAddressFromBech32(strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey())))
+		if err != nil {
+			continue
+		}
+
+		infos = append(infos, types.KeyRecord_PrivateRecoveryInfo{Validator: validator, RecoveryInfo: iter.Value()})
+	}
+
+	return infos
+}
+
+func (k Keeper) setSignRecord(ctx sdk.Context, record types.SignRecord) {
+	if record.SigStatus != exported.SigStatus_Unspecified {
+		k.SetSigStatus(ctx, record.SigID, record.SigStatus)
+	}
+
+	if record.Signature != nil {
+		k.SetSig(ctx, *record.Signature)
+	}
+
+	if record.SignInfo != nil {
+		k.SetInfoForSig(ctx, record.SigID, *record.SignInfo)
+	}
+
+	for _, participant := range record.Participants {
+		k.setParticipateInSign(ctx, record.SigID, participant.Validator, participant.ShareCount)
+	}
+
+	if record.MultisigSignInfo != nil {
+		k.SetMultisigSignInfo(ctx, *record.MultisigSignInfo)
+	}
+}
+
+func (k Keeper) getSignRecords(ctx sdk.Context) []types.SignRecord {
+	sigIDs := make(map[string]bool)
+	for _, prefix := range []utils.StringKey{sigStatusPrefix, infoForSigPrefix, sigPrefix} {
+		iter := k.getStore(ctx).Iterator(prefix.AppendStr(""))
+
+		for ; iter.Valid(); iter.Next() {
+			// the signature prefix is also a prefix of the signature status prefix
+			if prefix.Equals(sigPrefix) && strings.HasPrefix(string(iter.Key()), string(sigStatusPrefix.AppendStr("").AsKey())) {
+				continue
+			}
+
+			sigIDs[strings.TrimPrefix(string(iter.Key()), string(prefix.AppendStr("").AsKey()))] = true
+		}
+
+		utils.CloseLogError(iter, k.Logger(ctx))
+	}
+
+	records := make([]types.SignRecord, 0, len(sigIDs))
+	for sigID := range sigIDs {
+		records = append(records, k.getSignRecord(ctx, sigID))
+	}
+
+	sort.Slice(records, func(i, j int) bool { return records[i].SigID < records[j].SigID })
+
+	return records
+}
+
+func (k Keeper) getSignRecord(ctx sdk.Context, sigID string) types.SignRecord {
+	record := types.SignRecord{
+		SigID:        sigID,
+		SigStatus:    k.getSigStatus(ctx, sigID),
+		Participants: k.getSignParticipants(ctx, sigID),
+	}
+
+	var signature exported.Signature
+	if ok := k.getStore(ctx).Get(sigPrefix.AppendStr(sigID), &signature); ok {
+		record.Signature = &signature
+	}
+
+	if info, ok := k.GetInfoForSig(ctx, sigID); ok {
+		record.SignInfo = &info
+	}
+
+	var multisigInfo types.MultisigInfo
+	if ok := k.getStore(ctx).Get(multiSigSignPrefix.AppendStr(sigID), &multisigInfo); ok {
+		record.MultisigSignInfo = &multisigInfo
+	}
+
+	return record
+}
+
+func (k Keeper) getSignParticipants(ctx sdk.Context, sigID string) []types.SignRecord_Participant {
+	participants := []types.SignRecord_Participant{}
+
+	prefix := participatePrefix.AppendStr("sign").AppendStr(sigID).AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		// the prefix might match the participants of other signatures whose ID starts with the given sig ID
+		validator, err := sdk.ValAddressFromBech32(strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey())))
+		if err != nil {
+			continue
+		}
+
+		participants = append(participants, types.SignRecord_Participant{
+			Validator:  validator,
+			ShareCount: big.NewInt(0).SetBytes(iter.Value()).Int64(),
+		})
+	}
+
+	return participants
+}
+
+func (k Keeper) getKeyRotations(ctx sdk.Context) []types.KeyRotations {
+	rotationsByChainAndRole := make(map[string]*types.KeyRotations)
+
+	prefix := rotationPrefix.AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		// the rotation prefix is also a prefix of the rotation count prefixes
+		if strings.HasPrefix(string(iter.Key()), string(rotationCountPrefix.AppendStr("").AsKey())) {
+			continue
+		}
+
+		// keys have the form <chain>_<role>_<rotation>, the chain name itself might contain the delimiter
+		segments := strings.Split(strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey())), "_")
+		if len(segments) < 3 {
+			k.Logger(ctx).Error(fmt.Sprintf("skipping malformed key rotation %s", string(iter.Key())))
+			continue
+		}
+
+		chain := strings.Join(segments[:len(segments)-2], "_")
+		keyRole, err := exported.KeyRoleFromSimpleStr(segments[len(segments)-2])
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("skipping malformed key rotation %s: %s", string(iter.Key()), err.Error()))
+			continue
+		}
+
+		if _, err := strconv.ParseInt(segments[len(segments)-1], 10, 64); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("skipping malformed key rotation %s: %s", string(iter.Key()), err.Error()))
+			continue
+		}
+
+		id := fmt.Sprintf("%s_%s", chain, keyRole.SimpleString())
+		if _, ok := rotationsByChainAndRole[id]; !ok {
+			rotationsByChainAndRole[id] = &types.KeyRotations{Chain: chain, KeyRole: keyRole}
+		}
+	}
+
+	rotations := make([]types.KeyRotations, 0, len(rotationsByChainAndRole))
+	for _, r := range rotationsByChainAndRole {
+		chain := nexus.Chain{Name: r.Chain}
+		r.RotationCount = k.GetRotationCount(ctx, chain, r.KeyRole)
+
+		for rotation := int64(1); ; rotation++ {
+			keyID, ok := k.getKeyID(ctx, chain, rotation, r.KeyRole)
+			if !ok {
+				break
+			}
+
+			r.KeyIDs = append(r.KeyIDs, keyID)
+		}
+
+		rotations = append(rotations, *r)
+	}
+
+	sort.Slice(rotations, func(i, j int) bool {
+		if rotations[i].Chain != rotations[j].Chain {
+			return rotations[i].Chain < rotations[j].Chain
+		}
+
+		return rotations[i].KeyRole < rotations[j].KeyRole
+	})
+
+	return rotations
+}
+
+func (k Keeper) getExternalKeys(ctx sdk.Context) []types.ExternalKeys {
+	externalKeys := []types.ExternalKeys{}
+
+	prefix := externalKeyIDsPrefix.AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var keyIDs []exported.KeyID
+		if err := json.Unmarshal(iter.Value(), &keyIDs); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("skipping malformed external key IDs %s: %s", string(iter.Key()), err.Error()))
+			continue
+		}
+
+		externalKeys = append(externalKeys, types.ExternalKeys{
+			Chain:  strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey())),
+			KeyIDs: keyIDs,
+		})
+	}
+
+	return externalKeys
+}
+
+func (k Keeper) getSuspendedValidators(ctx sdk.Context) []types.SuspendedValidator {
+	suspended := []types.SuspendedValidator{}
+
+	prefix := keyTssSuspendedUntil.AppendStr("")
+	iter := k.getStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		validator, err := sdk.ValAddressFromBech32(strings.TrimPrefix(string(iter.Key()), string(prefix.AsKey())))
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("skipping suspension of validator %s due to parsing error: %s", string(iter.Key()), err.Error()))
+			continue
+		}
+
+		suspended = append(suspended, types.SuspendedValidator{
+			Validator:      validator,
+			SuspendedUntil: k.GetTssSuspendedUntil(ctx, validator),
+		})
+	}
+
+	return suspended
+}
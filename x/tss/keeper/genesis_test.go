@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	bitcoin "github.com/axelarnetwork/axelar-core/x/bitcoin/exported"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	cdc := appParams.MakeEncodingConfig().Marshaler
+
+	t.Run("should restore keys, signatures and rotations", testutils.Func(func(t *testing.T) {
+		s := setup()
+		s.Ctx = s.Ctx.WithBlockTime(time.Unix(time.Now().Unix(), 0))
+
+		chains := []nexus.Chain{bitcoin.Bitcoin, evm.Ethereum}
+		for _, chain := range chains {
+			for i := 0; i < int(rand.I64Between(1, 5)); i++ {
+				key := s.SetKey(t, s.Ctx, exported.MasterKey, exported.Threshold)
+				s.Keeper.SetGroupRecoveryInfo(s.Ctx, key.ID, rand.Bytes(10))
+				s.Keeper.SetPrivateRecoveryInfo(s.Ctx, val1.GetSDKValidator().GetOperator(), key.ID, rand.Bytes(10))
+
+				assert.NoError(t, s.Keeper.AssignNextKey(s.Ctx, chain, exported.MasterKey, key.ID))
+				assert.NoError(t, s.Keeper.RotateKey(s.Ctx, chain, exported.MasterKey))
+			}
+
+			nextKey := s.SetKey(t, s.Ctx, exported.MasterKey, exported.Threshold)
+			assert.NoError(t, s.Keeper.AssignNextKey(s.Ctx, chain, exported.MasterKey, nextKey.ID))
+		}
+
+		multisigKey := s.SetKey(t, s.Ctx, exported.SecondaryKey, exported.Multisig)
+		externalKey := generateECDSAKey(exported.KeyID(randDistinctStr.Next()))
+		externalKey.Role = exported.ExternalKey
+		s.Keeper.SetKey(s.Ctx, externalKey)
+		s.Keeper.SetKeyInfo(s.Ctx, types.KeyInfo{KeyID: externalKey.ID, KeyRole: exported.ExternalKey, KeyType: exported.Threshold})
+		s.Keeper.SetExternalKeyIDs(s.Ctx, bitcoin.Bitcoin, []exported.KeyID{externalKey.ID})
+		s.Keeper.setTssSuspendedUntil(s.Ctx, val2.GetSDKValidator().GetOperator(), rand.PosI64())
+		s.Keeper.SetScheduledKeyRotation(s.Ctx, types.ScheduledKeyRotation{
+			Chain:     evm.Ethereum.Name,
+			KeyRole:   exported.SecondaryKey,
+			KeyID:     multisigKey.ID,
+			Trigger:   types.KeyRotationTrigger_SnapshotDrift,
+			StartedAt: rand.PosI64(),
+		})
+		s.Keeper.SetKeyRotationAbort(s.Ctx, types.KeyRotationAbort{
+			Chain:     evm.Ethereum.Name,
+			KeyRole:   exported.MasterKey,
+			AbortedAt: rand.PosI64(),
+		})
+
+		for i := 0; i < int(rand.I64Between(1, 10)); i++ {
+			info := exported.SignInfo{
+				KeyID:           multisigKey.ID,
+				SigID:           rand.HexStr(64),
+				Msg:             rand.Bytes(32),
+				SnapshotCounter: snap.Counter,
+			}
+			s.Keeper.SetInfoForSig(s.Ctx, info.SigID, info)
+			s.Keeper.setParticipateInSign(s.Ctx, info.SigID, val3.GetSDKValidator().GetOperator(), val3.ShareCount)
+
+			if rand.Bools(0.5).Next() {
+				s.Keeper.SetSigStatus(s.Ctx, info.SigID, exported.SigStatus_Signed)
+				s.Keeper.SetSig(s.Ctx, exported.Signature{SigID: info.SigID, SigStatus: exported.SigStatus_Signed})
+				continue
+			}
+
+			s.Keeper.SetSigStatus(s.Ctx, info.SigID, exported.SigStatus_Queued)
+			queue := s.Keeper.GetSignQueue(s.Ctx)
+			assert.NoError(t, queue.Enqueue(&info))
+		}
+
+		expected := s.Keeper.ExportGenesis(s.Ctx)
+		assert.NoError(t, expected.Validate())
+		assert.NotEmpty(t, expected.KeyRotations)
+		assert.Len(t, expected.ExternalKeys, 1)
+		assert.Len(t, expected.SuspendedValidators, 1)
+		assert.Len(t, expected.MultisigKeygenQueue, 1)
+		assert.Len(t, expected.ScheduledKeyRotations, 1)
+		assert.Len(t, expected.KeyRotationAborts, 1)
+
+		bz := cdc.MustMarshalJSON(expected)
+		var genState types.GenesisState
+		cdc.MustUnmarshalJSON(bz, &genState)
+		assert.NoError(t, genState.Validate())
+
+		newSetup := setup()
+		newSetup.Keeper.InitGenesis(newSetup.Ctx, &genState)
+		assert.Equal(t, bz, cdc.MustMarshalJSON(newSetup.Keeper.ExportGenesis(newSetup.Ctx)))
+
+		for _, chain := range chains {
+			expectedKey, ok := s.Keeper.GetCurrentKey(s.Ctx, chain, exported.MasterKey)
+			assert.True(t, ok)
+			actualKey, ok := newSetup.Keeper.GetCurrentKey(newSetup.Ctx, chain, exported.MasterKey)
+			assert.True(t, ok)
+			assert.Equal(t, expectedKey, actualKey)
+
+			expectedNextKeyID, ok := s.Keeper.GetNextKeyID(s.Ctx, chain, exported.MasterKey)
+			assert.True(t, ok)
+			actualNextKeyID, ok := newSetup.Keeper.GetNextKeyID(newSetup.Ctx, chain, exported.MasterKey)
+			assert.True(t, ok)
+			assert.Equal(t, expectedNextKeyID, actualNextKeyID)
+		}
+	}).Repeat(20))
+
+	t.Run("should reject rotations to unknown keys", testutils.Func(func(t *testing.T) {
+		genState := types.DefaultGenesis()
+		genState.KeyRotations = append(genState.KeyRotations, types.KeyRotations{
+			Chain:         bitcoin.Bitcoin.Name,
+			KeyRole:       exported.MasterKey,
+			RotationCount: 1,
+			KeyIDs:        []exported.KeyID{exported.KeyID(rand.HexStr(10))},
+		})
+		assert.Error(t, genState.Validate())
+
+		genState.Keys = append(genState.Keys, types.KeyRecord{
+			KeyInfo: types.KeyInfo{KeyID: genState.KeyRotations[0].KeyIDs[0], KeyRole: exported.MasterKey, KeyType: exported.Threshold},
+		})
+		assert.NoError(t, genState.Validate())
+
+		genState.Keys[0].KeyInfo.KeyRole = exported.SecondaryKey
+		assert.Error(t, genState.Validate())
+	}).Repeat(20))
+
+	t.Run("should reject scheduled key rotations to unknown keys", testutils.Func(func(t *testing.T) {
+		genState := types.DefaultGenesis()
+		genState.ScheduledKeyRotations = append(genState.ScheduledKeyRotations, types.ScheduledKeyRotation{
+			Chain:     evm.Ethereum.Name,
+			KeyRole:   exported.MasterKey,
+			KeyID:     exported.KeyID(rand.HexStr(10)),
+			Trigger:   types.KeyRotationTrigger_Period,
+			StartedAt: rand.PosI64(),
+		})
+		assert.Error(t, genState.Validate())
+
+		genState.Keys = append(genState.Keys, types.KeyRecord{
+			KeyInfo: types.KeyInfo{KeyID: genState.ScheduledKeyRotations[0].KeyID, KeyRole: exported.MasterKey, KeyType: exported.Multisig},
+		})
+		assert.NoError(t, genState.Validate())
+
+		genState.ScheduledKeyRotations[0].Trigger = types.KeyRotationTrigger_Unspecified
+		assert.Error(t, genState.Validate())
+	}).Repeat(20))
+
+	t.Run("should reject duplicate key rotation aborts", testutils.Func(func(t *testing.T) {
+		genState := types.DefaultGenesis()
+		abort := types.KeyRotationAbort{Chain: evm.Ethereum.Name, KeyRole: exported.MasterKey, AbortedAt: rand.PosI64()}
+		genState.KeyRotationAborts = append(genState.KeyRotationAborts, abort)
+		assert.NoError(t, genState.Validate())
+
+		abort.Chain = strings.ToUpper(abort.Chain)
+		genState.KeyRotationAborts = append(genState.KeyRotationAborts, abort)
+		assert.Error(t, genState.Validate())
+	}).Repeat(20))
+
+	t.Run("should reject queued signatures for unknown keys", testutils.Func(func(t *testing.T) {
+		genState := types.DefaultGenesis()
+		info := exported.SignInfo{KeyID: exported.KeyID(rand.HexStr(10)), SigID: rand.HexStr(64)}
+		genState.Signatures = append(genState.Signatures, types.SignRecord{SigID: info.SigID, SigStatus: exported.SigStatus_Queued, SignInfo: &info})
+		genState.SignQueue = append(genState.SignQueue, info)
+		assert.Error(t, genState.Validate())
+
+		genState.Keys = append(genState.Keys, types.KeyRecord{KeyInfo: types.KeyInfo{KeyID: info.KeyID, KeyRole: exported.MasterKey}})
+		assert.NoError(t, genState.Validate())
+	}).Repeat(20))
+}
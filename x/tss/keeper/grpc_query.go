@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+var _ types.QueryServiceServer = Querier{}
+
+// Querier implements the grpc querier
+type Querier struct {
+	keeper      types.TSSKeeper
+	voter       types.Voter
+	snapshotter types.Snapshotter
+	staking     types.StakingKeeper
+	nexus       types.Nexus
+}
+
+// NewGRPCQuerier creates a new tss Querier
+func NewGRPCQuerier(k types.TSSKeeper, v types.Voter, s types.Snapshotter, staking types.StakingKeeper, n types.Nexus) Querier {
+	return Querier{
+		keeper:      k,
+		voter:       v,
+		snapshotter: s,
+		staking:     staking,
+		nexus:       n,
+	}
+}
+
+// Signature returns the signature for the given sig ID, or its vote status if it is not signed yet
+func (q Querier) Signature(c context.Context, req *types.QuerySignatureRequest) (*types.QuerySignatureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := signatureStatus(ctx, q.keeper, q.voter, req.SigID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	return &resp, nil
+}
+
+// Key returns the public key for the given key ID, or its vote status if keygen is not done yet
+func (q Querier) Key(c context.Context, req *types.QueryKeyRequest) (*types.QueryKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := req.KeyID.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp, err := keyStatus(ctx, q.keeper, q.voter, req.KeyID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	return &resp, nil
+}
+
+// Recovery returns the recovery info of the given validator for the given key ID
+func (q Querier) Recovery(c context.Context, req *types.QueryRecoveryRequest) (*types.QueryRecoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := req.KeyID.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp, err := recovery(ctx, q.keeper, q.snapshotter, req.KeyID, req.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	return &resp, nil
+}
+
+// KeyID returns the ID of the current key of the given chain and key role
+func (q Querier) KeyID(c context.Context, req *types.QueryKeyIDRequest) (*types.QueryKeyIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := q.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrTss, fmt.Sprintf("%s is not a registered chain", req.Chain))
+	}
+
+	if err := req.KeyRole.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	if req.KeyRole == exported.ExternalKey {
+		return nil, sdkerrors.Wrap(types.ErrTss, fmt.Sprintf("use the external key ID query to get the external keys of chain %s", chain.Name))
+	}
+
+	keyID, ok := q.keeper.GetCurrentKeyID(ctx, chain, req.KeyRole)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrTss, fmt.Sprintf("no key from chain %s role %s exists", chain.Name, req.KeyRole.SimpleString()))
+	}
+
+	return &types.QueryKeyIDResponse{KeyID: keyID}, nil
+}
+
+// NextKeyID returns the ID of the key assigned to the next rotation of the given chain and key role
+func (q Querier) NextKeyID(c context.Context, req *types.QueryNextKeyIDRequest) (*types.QueryNextKeyIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := nextKeyID(ctx, q.keeper, q.nexus, *req)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	return &resp, nil
+}
+
+// KeySharesByKeyID returns a page of the key share distribution of the given key ID
+func (q Querier) KeySharesByKeyID(c context.Context, req *types.QueryKeySharesByKeyIDRequest) (*types.QueryKeyShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := req.KeyID.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp, err := keySharesByKeyID(ctx, q.keeper, q.snapshotter, req.KeyID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	start, end, pageResponse, err := utils.PaginateSlice(len(resp.ShareInfos), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp.ShareInfos = resp.ShareInfos[start:end]
+	resp.Pagination = pageResponse
+
+	return &resp, nil
+}
+
+// KeySharesByValidator returns a page of the key shares the given validator holds of all current keys
+func (q Querier) KeySharesByValidator(c context.Context, req *types.QueryKeySharesByValidatorRequest) (*types.QueryKeyShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := keySharesByValidator(ctx, q.keeper, q.nexus, q.snapshotter, req.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	start, end, pageResponse, err := utils.PaginateSlice(len(resp.ShareInfos), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp.ShareInfos = resp.ShareInfos[start:end]
+	resp.Pagination = pageResponse
+
+	return &resp, nil
+}
+
+// ActiveOldKeys returns a page of the IDs of the old keys of the given chain and key role that are still active
+func (q Querier) ActiveOldKeys(c context.Context, req *types.QueryActiveOldKeysRequest) (*types.QueryActiveOldKeysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := req.KeyRole.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp, err := activeOldKeyIDs(ctx, q.keeper, q.nexus, req.Chain, req.KeyRole.SimpleString())
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	start, end, pageResponse, err := utils.PaginateSlice(len(resp.KeyIDs), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp.KeyIDs = resp.KeyIDs[start:end]
+	resp.Pagination = pageResponse
+
+	return &resp, nil
+}
+
+// ActiveOldKeysByValidator returns a page of the old keys that are still active and that the given validator holds shares of
+func (q Querier) ActiveOldKeysByValidator(c context.Context, req *types.QueryActiveOldKeysByValidatorRequest) (*types.QueryActiveOldKeysValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := activeOldKeyIDsByValidator(ctx, q.keeper, q.nexus, q.snapshotter, req.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	start, end, pageResponse, err := utils.PaginateSlice(len(resp.KeysInfo), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp.KeysInfo = resp.KeysInfo[start:end]
+	resp.Pagination = pageResponse
+
+	return &resp, nil
+}
+
+// DeactivatedOperators returns a page of the bonded validators that have deactivated their proxy
+func (q Querier) DeactivatedOperators(c context.Context, req *types.QueryDeactivatedOperatorsRequest) (*types.QueryDeactivatedOperatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := deactivatedOperators(ctx, q.keeper, q.snapshotter, q.staking)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	start, end, pageResponse, err := utils.PaginateSlice(len(resp.OperatorAddresses), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp.OperatorAddresses = resp.OperatorAddresses[start:end]
+	resp.Pagination = pageResponse
+
+	return &resp, nil
+}
+
+// ExternalKeyID returns a page of the IDs of the current set of external keys of the given chain
+func (q Querier) ExternalKeyID(c context.Context, req *types.QueryExternalKeyIDRequest) (*types.QueryExternalKeyIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := externalKeyID(ctx, q.keeper, q.nexus, req.Chain)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	start, end, pageResponse, err := utils.PaginateSlice(len(resp.KeyIDs), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp.KeyIDs = resp.KeyIDs[start:end]
+	resp.Pagination = pageResponse
+
+	return &resp, nil
+}
+
+// SnapshotDrift returns how much of the share count of the given key is still held by eligible validators
+func (q Querier) SnapshotDrift(c context.Context, req *types.QuerySnapshotDriftRequest) (*types.QuerySnapshotDriftResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := req.KeyID.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp, err := snapshotDrift(ctx, q.keeper, q.snapshotter, req.KeyID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	return &resp, nil
+}
+
+// SignQueue returns a page of the queued sign requests in the order they are going to start
+func (q Querier) SignQueue(c context.Context, req *types.QuerySignQueueRequest) (*types.QuerySignQueueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := signQueue(ctx, q.keeper, q.snapshotter)
+
+	start, end, pageResponse, err := utils.PaginateSlice(len(resp.Entries), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrTss, err.Error())
+	}
+
+	resp.Entries = resp.Entries[start:end]
+	resp.Pagination = pageResponse
+
+	return &resp, nil
+}
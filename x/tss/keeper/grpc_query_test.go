@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+	tssMock "github.com/axelarnetwork/axelar-core/x/tss/types/mock"
+)
+
+func setupGRPCQuerier() (*testSetup, Querier, snapshot.Snapshot) {
+	var validators []snapshot.Validator
+	for i := 0; i < int(rand.I64Between(5, 20)); i++ {
+		validators = append(validators, newValidator(rand.ValAddr(), 100))
+	}
+
+	querySnap := snapshot.Snapshot{
+		Validators:      validators,
+		Timestamp:       time.Now(),
+		Height:          rand.I64Between(1, 1000000),
+		TotalShareCount: sdk.NewInt(int64(100 * len(validators))),
+		Counter:         rand.I64Between(0, 100000),
+	}
+
+	s := setup()
+	s.Snapshotter.GetSnapshotFunc = func(ctx sdk.Context, seqNo int64) (snapshot.Snapshot, bool) {
+		return querySnap, seqNo == querySnap.Counter
+	}
+
+	n := &tssMock.NexusMock{
+		GetChainFunc: func(_ sdk.Context, chain string) (nexus.Chain, bool) { return nexus.Chain{}, false },
+	}
+
+	return s, NewGRPCQuerier(s.Keeper, s.Voter, s.Snapshotter, &tssMock.StakingKeeperMock{}, n), querySnap
+}
+
+func TestQuerier_KeySharesByKeyID(t *testing.T) {
+	s, querier, querySnap := setupGRPCQuerier()
+
+	keyID := exported.KeyID(randDistinctStr.Next())
+	keyInfo := types.KeyInfo{KeyID: keyID, KeyRole: exported.MasterKey, KeyType: exported.Threshold}
+	assert.NoError(t, s.Keeper.StartKeygen(s.Ctx, s.Voter, keyInfo, querySnap))
+
+	var shareInfos []types.QueryKeyShareResponse_ShareInfo
+	var nextKey []byte
+	for {
+		res, err := querier.KeySharesByKeyID(sdk.WrapSDKContext(s.Ctx), &types.QueryKeySharesByKeyIDRequest{
+			KeyID:      keyID,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 3},
+		})
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(res.ShareInfos), 3)
+
+		shareInfos = append(shareInfos, res.ShareInfos...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+
+	assert.Len(t, shareInfos, len(querySnap.Validators))
+	for i, shareInfo := range shareInfos {
+		assert.Equal(t, keyID, shareInfo.KeyID)
+		assert.Equal(t, querySnap.Validators[i].GetSDKValidator().GetOperator().String(), shareInfo.ValidatorAddress)
+	}
+
+	res, err := querier.KeySharesByKeyID(sdk.WrapSDKContext(s.Ctx), &types.QueryKeySharesByKeyIDRequest{KeyID: keyID})
+	assert.NoError(t, err)
+	assert.Len(t, res.ShareInfos, len(querySnap.Validators))
+	assert.Equal(t, uint64(len(querySnap.Validators)), res.Pagination.Total)
+
+	_, err = querier.KeySharesByKeyID(sdk.WrapSDKContext(s.Ctx), &types.QueryKeySharesByKeyIDRequest{KeyID: exported.KeyID(randDistinctStr.Next())})
+	assert.Error(t, err)
+
+	_, err = querier.KeySharesByKeyID(sdk.WrapSDKContext(s.Ctx), &types.QueryKeySharesByKeyIDRequest{KeyID: ""})
+	assert.Error(t, err)
+}
+
+func TestQuerier_SignQueue(t *testing.T) {
+	s, querier, querySnap := setupGRPCQuerier()
+
+	keyID := exported.KeyID(randDistinctStr.Next())
+	s.Keeper.SetKeyInfo(s.Ctx, types.KeyInfo{KeyID: keyID, KeyRole: exported.MasterKey, KeyType: exported.Threshold})
+
+	q := s.Keeper.GetSignQueue(s.Ctx)
+	queued := int(rand.I64Between(1, 30))
+	for i := 0; i < queued; i++ {
+		info := exported.SignInfo{KeyID: keyID, SigID: fmt.Sprintf("sig-%d", i), SnapshotCounter: querySnap.Counter, RequestModule: "evm", RequestChain: "Ethereum"}
+		assert.NoError(t, q.Enqueue(&info))
+		s.Keeper.SetSigStatus(s.Ctx, info.SigID, exported.SigStatus_Queued)
+	}
+
+	var entries []types.QuerySignQueueResponse_Entry
+	var nextKey []byte
+	for {
+		res, err := querier.SignQueue(sdk.WrapSDKContext(s.Ctx), &types.QuerySignQueueRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: 4}})
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(res.Entries), 4)
+
+		entries = append(entries, res.Entries...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+
+	assert.Len(t, entries, queued)
+	for i, entry := range entries {
+		assert.Equal(t, int64(i), entry.Position)
+		assert.Equal(t, fmt.Sprintf("sig-%d", i), entry.SigID)
+	}
+
+	_, err := querier.SignQueue(sdk.WrapSDKContext(s.Ctx), &types.QuerySignQueueRequest{Pagination: &query.PageRequest{Key: nextKey, Offset: 1, Limit: 4}})
+	assert.NoError(t, err)
+
+	_, err = querier.SignQueue(sdk.WrapSDKContext(s.Ctx), &types.QuerySignQueueRequest{Pagination: &query.PageRequest{Key: rand.Bytes(8), Offset: 1}})
+	assert.Error(t, err)
+}
+
+func TestQuerier_ExternalKeyID(t *testing.T) {
+	s, querier, _ := setupGRPCQuerier()
+
+	_, err := querier.ExternalKeyID(sdk.WrapSDKContext(s.Ctx), &types.QueryExternalKeyIDRequest{Chain: rand.StrBetween(5, 10)})
+	assert.Error(t, err)
+}
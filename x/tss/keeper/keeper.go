@@ -41,6 +41,10 @@ var (
 	multiSigKeyPrefix          = utils.KeyFromStr("multi_sig_keygen")
 	multiSigSignPrefix         = utils.KeyFromStr("multi_sig_sign")
 	keyInfoPrefix              = utils.KeyFromStr("key_info")
+	scheduledKeyRotationPrefix = utils.KeyFromStr("scheduled_key_rotation")
+	keyRotationAbortPrefix     = utils.KeyFromStr("key_rotation_abort")
+	signQueueLaneCreditPrefix  = utils.KeyFromStr("sign_queue_lane_credit")
+	signStartedAtPrefix        = utils.KeyFromStr("sign_started_at")
 
 	multisigKeygenQueue = "multisig_keygen"
 	multisigSignQueue   = "multisig_sign"
@@ -54,6 +58,8 @@ type Keeper struct {
 	storeKey sdk.StoreKey
 	cdc      codec.BinaryCodec
 	router   types.Router
+
+	keyRotationRouter types.KeyRotationRouter
 }
 
 // AssertMatchesRequirements checks if the properties of the given key match the requirements for the given role
@@ -158,6 +164,25 @@ func (k Keeper) GetRouter() types.Router {
 	return k.router
 }
 
+// SetKeyRotationRouter sets the key rotation router. It will panic if called more than once
+func (k *Keeper) SetKeyRotationRouter(router types.KeyRotationRouter) {
+	if k.keyRotationRouter != nil {
+		panic("key rotation router already set")
+	}
+
+	k.keyRotationRouter = router
+	k.keyRotationRouter.Seal()
+}
+
+// GetKeyRotationRouter returns the key rotation router. If no router was set, it returns a (sealed) router with no handlers
+func (k Keeper) GetKeyRotationRouter() types.KeyRotationRouter {
+	if k.keyRotationRouter == nil {
+		k.SetKeyRotationRouter(types.NewKeyRotationRouter())
+	}
+
+	return k.keyRotationRouter
+}
+
 // SetParams sets the tss module's parameters
 func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
 	k.params.SetParamSet(ctx, &p)
@@ -175,6 +200,22 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
+// GetKeyRotationPolicies returns the policies for automatic key rotations
+func (k Keeper) GetKeyRotationPolicies(ctx sdk.Context) []types.KeyRotationPolicy {
+	var result []types.KeyRotationPolicy
+	k.params.Get(ctx, types.KeyKeyRotationPolicies, &result)
+
+	return result
+}
+
+// GetKeyRotationCooldown returns the number of blocks to wait after an aborted automatic key rotation before the next attempt
+func (k Keeper) GetKeyRotationCooldown(ctx sdk.Context) int64 {
+	var result int64
+	k.params.Get(ctx, types.KeyKeyRotationCooldown, &result)
+
+	return result
+}
+
 // GetExternalMultisigThreshold returns the external multisig threshold
 func (k Keeper) GetExternalMultisigThreshold(ctx sdk.Context) utils.Threshold {
 	var result utils.Threshold
@@ -191,6 +232,22 @@ func (k Keeper) GetHeartbeatPeriodInBlocks(ctx sdk.Context) int64 {
 	return result
 }
 
+// GetSnapshotDriftWarningMargin returns the margin of the total share count above the signing threshold
+// below which the snapshot drift of a key is reported
+func (k Keeper) GetSnapshotDriftWarningMargin(ctx sdk.Context) utils.Threshold {
+	var result utils.Threshold
+	k.params.Get(ctx, types.KeySnapshotDriftWarningMargin, &result)
+
+	return result
+}
+
+func (k Keeper) getBlockSignBelowThreshold(ctx sdk.Context) bool {
+	var result bool
+	k.params.Get(ctx, types.KeyBlockSignBelowThreshold, &result)
+
+	return result
+}
+
 // SetGroupRecoveryInfo sets the group recovery info for a given party
 func (k Keeper) SetGroupRecoveryInfo(ctx sdk.Context, keyID exported.KeyID, recoveryInfo []byte) {
 	k.getStore(ctx).SetRaw(groupRecoverPrefix.AppendStr(string(keyID)), recoveryInfo)
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func getScheduledKeyRotationKey(chain string, keyRole exported.KeyRole) utils.Key {
+	return scheduledKeyRotationPrefix.Append(utils.LowerCaseKey(chain)).Append(utils.KeyFromStr(keyRole.SimpleString()))
+}
+
+// SetScheduledKeyRotation stores the key that has been generated automatically for the given chain and key role
+func (k Keeper) SetScheduledKeyRotation(ctx sdk.Context, rotation types.ScheduledKeyRotation) {
+	k.getStore(ctx).Set(getScheduledKeyRotationKey(rotation.Chain, rotation.KeyRole), &rotation)
+}
+
+// GetScheduledKeyRotation returns the key that has been generated automatically for the given chain and key role
+// and has not been rotated in yet
+func (k Keeper) GetScheduledKeyRotation(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (types.ScheduledKeyRotation, bool) {
+	var rotation types.ScheduledKeyRotation
+	ok := k.getStore(ctx).Get(getScheduledKeyRotationKey(chain.Name, keyRole), &rotation)
+
+	return rotation, ok
+}
+
+// DeleteScheduledKeyRotation deletes the scheduled key rotation for the given chain and key role
+func (k Keeper) DeleteScheduledKeyRotation(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) {
+	k.getStore(ctx).Delete(getScheduledKeyRotationKey(chain.Name, keyRole))
+}
+
+func (k Keeper) getScheduledKeyRotations(ctx sdk.Context) []types.ScheduledKeyRotation {
+	rotations := []types.ScheduledKeyRotation{}
+
+	iter := k.getStore(ctx).Iterator(scheduledKeyRotationPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var rotation types.ScheduledKeyRotation
+		iter.UnmarshalValue(&rotation)
+
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations
+}
+
+func getKeyRotationAbortKey(chain string, keyRole exported.KeyRole) utils.Key {
+	return keyRotationAbortPrefix.Append(utils.LowerCaseKey(chain)).Append(utils.KeyFromStr(keyRole.SimpleString()))
+}
+
+// SetKeyRotationAbort records that the latest automatic key rotation for the given chain and key role has been aborted
+func (k Keeper) SetKeyRotationAbort(ctx sdk.Context, abort types.KeyRotationAbort) {
+	k.getStore(ctx).Set(getKeyRotationAbortKey(abort.Chain, abort.KeyRole), &abort)
+}
+
+// GetKeyRotationAbort returns when the latest automatic key rotation for the given chain and key role has been aborted
+func (k Keeper) GetKeyRotationAbort(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (types.KeyRotationAbort, bool) {
+	var abort types.KeyRotationAbort
+	ok := k.getStore(ctx).Get(getKeyRotationAbortKey(chain.Name, keyRole), &abort)
+
+	return abort, ok
+}
+
+func (k Keeper) getKeyRotationAborts(ctx sdk.Context) []types.KeyRotationAbort {
+	aborts := []types.KeyRotationAbort{}
+
+	iter := k.getStore(ctx).Iterator(keyRotationAbortPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var abort types.KeyRotationAbort
+		iter.UnmarshalValue(&abort)
+
+		aborts = append(aborts, abort)
+	}
+
+	return aborts
+}
@@ -148,9 +148,9 @@ func (k Keeper) GetKeyRole(ctx sdk.Context, keyID exported.KeyID) exported.KeyRo
 	return keyInfo.KeyRole
 }
 
-func (k Keeper) setRotatedAt(ctx sdk.Context, keyID exported.KeyID) {
+func (k Keeper) setRotatedAt(ctx sdk.Context, keyID exported.KeyID, rotatedAt time.Time) {
 	storageKey := keyRotatedAtPrefix.AppendStr(string(keyID))
-	k.getStore(ctx).Set(storageKey, &gogoprototypes.Int64Value{Value: ctx.BlockTime().Unix()})
+	k.getStore(ctx).Set(storageKey, &gogoprototypes.Int64Value{Value: rotatedAt.Unix()})
 }
 
 func (k Keeper) getRotatedAt(ctx sdk.Context, keyID exported.KeyID) *time.Time {
@@ -191,7 +191,7 @@ func (k Keeper) RotateKey(ctx sdk.Context, chain nexus.Chain, keyRole exported.K
 	}
 
 	k.setRotationCount(ctx, chain, keyRole, r+1)
-	k.setRotatedAt(ctx, keyID)
+	k.setRotatedAt(ctx, keyID, ctx.BlockTime())
 
 	return nil
 }
@@ -41,6 +41,22 @@ func (k Keeper) StartSign(ctx sdk.Context, info exported.SignInfo, snapshotter t
 		return fmt.Errorf("could not find snapshot with sequence number #%d", info.SnapshotCounter)
 	}
 
+	if k.getBlockSignBelowThreshold(ctx) {
+		drift, err := k.GetSnapshotDrift(ctx, snapshotter, info.KeyID)
+		if err != nil {
+			return err
+		}
+
+		if !drift.CanSign() {
+			return fmt.Errorf("key %s can no longer meet its signing threshold: corruption threshold [%d], eligible share count [%d], total share count [%d]",
+				info.KeyID,
+				drift.CorruptionThreshold,
+				drift.EligibleShareCount,
+				drift.TotalShareCount,
+			)
+		}
+	}
+
 	participants, active, err := k.SelectSignParticipants(ctx, snapshotter, info, snap, keyInfo.KeyType)
 	if err != nil {
 		return err
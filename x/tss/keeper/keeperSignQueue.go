@@ -0,0 +1,307 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoprototypes "github.com/gogo/protobuf/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+type signRequest struct {
+	info       exported.SignInfo
+	shareCount int64
+}
+
+// signScheduler picks the sign queue lane to start the next sign request from following smooth weighted round robin.
+// Every lane accumulates credits according to the weight of its lane class, the picked lane pays for the round
+// with the total weight of all lanes that take part in it
+type signScheduler struct {
+	lanes   map[string][]signRequest
+	weights map[string]int64
+	credits map[string]int64
+}
+
+func (s signScheduler) activeLanes() []string {
+	lanes := make([]string, 0, len(s.lanes))
+	for lane := range s.lanes {
+		lanes = append(lanes, lane)
+	}
+	sort.Strings(lanes)
+
+	return lanes
+}
+
+func (s signScheduler) weight(lane string) int64 {
+	weight, ok := s.weights[s.lanes[lane][0].info.GetSignQueueLaneClass()]
+	if !ok {
+		return types.DefaultSignQueueWeight
+	}
+
+	return weight
+}
+
+// peek returns the next sign request to start without changing the state of the scheduler
+func (s signScheduler) peek() (string, signRequest, bool) {
+	var next string
+	var nextCredit int64
+	found := false
+
+	for _, lane := range s.activeLanes() {
+		if credit := s.credits[lane] + s.weight(lane); !found || credit > nextCredit {
+			next, nextCredit, found = lane, credit, true
+		}
+	}
+
+	if !found {
+		return "", signRequest{}, false
+	}
+
+	return next, s.lanes[next][0], true
+}
+
+// pop removes the next sign request from the given lane and settles the credits of the round
+func (s *signScheduler) pop(lane string) signRequest {
+	total := int64(0)
+	for _, active := range s.activeLanes() {
+		weight := s.weight(active)
+		s.credits[active] += weight
+		total += weight
+	}
+	s.credits[lane] -= total
+
+	request := s.lanes[lane][0]
+	s.lanes[lane] = s.lanes[lane][1:]
+	if len(s.lanes[lane]) == 0 {
+		delete(s.lanes, lane)
+		delete(s.credits, lane)
+	}
+
+	return request
+}
+
+func (k Keeper) newSignScheduler(ctx sdk.Context, lanes map[string][]signRequest) *signScheduler {
+	weights := make(map[string]int64)
+	for _, weight := range k.getSignQueueWeights(ctx) {
+		weights[weight.LaneClass] = weight.Weight
+	}
+
+	credits := make(map[string]int64)
+	for lane := range lanes {
+		var credit gogoprototypes.Int64Value
+		if ok := k.getStore(ctx).Get(signQueueLaneCreditPrefix.AppendStr(lane), &credit); ok {
+			credits[lane] = credit.Value
+		}
+	}
+
+	return &signScheduler{lanes: lanes, weights: weights, credits: credits}
+}
+
+// setSignQueueLaneCredits keeps the credits of the lanes with queued sign requests for the next block
+// and resets the credits of all other lanes
+func (k Keeper) setSignQueueLaneCredits(ctx sdk.Context, scheduler *signScheduler) {
+	store := k.getStore(ctx)
+
+	iter := store.Iterator(signQueueLaneCreditPrefix)
+	var stale []utils.Key
+	for ; iter.Valid(); iter.Next() {
+		stale = append(stale, iter.GetKey())
+	}
+	utils.CloseLogError(iter, k.Logger(ctx))
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+
+	for _, lane := range scheduler.activeLanes() {
+		store.Set(signQueueLaneCreditPrefix.AppendStr(lane), &gogoprototypes.Int64Value{Value: scheduler.credits[lane]})
+	}
+}
+
+func (k Keeper) setSignStartedAt(ctx sdk.Context, sigID string, height int64) {
+	k.getStore(ctx).Set(signStartedAtPrefix.AppendStr(sigID), &gogoprototypes.Int64Value{Value: height})
+}
+
+func (k Keeper) getSignStartedAt(ctx sdk.Context, sigID string) (int64, bool) {
+	var height gogoprototypes.Int64Value
+	ok := k.getStore(ctx).Get(signStartedAtPrefix.AppendStr(sigID), &height)
+
+	return height.Value, ok
+}
+
+// loadSignQueue returns the sign requests in progress and the queued sign requests by lane, ordered by priority and
+// position in the sign queue. Completed sign requests are removed from the queue if prune is set
+func (k Keeper) loadSignQueue(ctx sdk.Context, snapshotter types.Snapshotter, prune bool) ([]signRequest, map[string][]signRequest) {
+	signQueue := k.GetSignQueue(ctx)
+
+	var signing []signRequest
+	lanes := make(map[string][]signRequest)
+
+	i := uint64(0)
+	var info exported.SignInfo
+	for signQueue.Peek(i, &info) {
+		// no need to check if snapshot exists again, sanity check for that passed at this point
+		snap, _ := snapshotter.GetSnapshot(ctx, info.SnapshotCounter)
+		request := signRequest{info: info, shareCount: snap.CorruptionThreshold + 1}
+
+		switch _, sigStatus := k.GetSig(ctx, info.SigID); sigStatus {
+		case exported.SigStatus_Queued:
+			lanes[info.GetSignQueueLane()] = append(lanes[info.GetSignQueueLane()], request)
+			i++
+		case exported.SigStatus_Signing:
+			signing = append(signing, request)
+			i++
+		case exported.SigStatus_Signed, exported.SigStatus_Aborted, exported.SigStatus_Invalid:
+			if !prune {
+				i++
+				continue
+			}
+
+			signQueue.Dequeue(i, &info)
+			k.getStore(ctx).Delete(signStartedAtPrefix.AppendStr(info.SigID))
+			k.Logger(ctx).Debug(fmt.Sprintf("dequeque %s, sign status %s", info.SigID, sigStatus))
+		default:
+			panic("invalid sig status type")
+		}
+
+		info = exported.SignInfo{}
+	}
+
+	for _, requests := range lanes {
+		sort.SliceStable(requests, func(i, j int) bool {
+			return requests[i].info.Priority.IsHigherThan(requests[j].info.Priority)
+		})
+	}
+
+	return signing, lanes
+}
+
+// ScheduleSigns removes completed sign requests from the sign queue and returns the queued sign requests to start
+// in the current block. Within a lane, requests start in order of priority and then in order of arrival. Across lanes,
+// requests start in weighted round robin as long as the simultaneous sign shares stay within the configured maximum
+func (k Keeper) ScheduleSigns(ctx sdk.Context, snapshotter types.Snapshotter) []exported.SignInfo {
+	signing, lanes := k.loadSignQueue(ctx, snapshotter, true)
+
+	signShares := int64(0)
+	for _, request := range signing {
+		signShares += request.shareCount
+	}
+
+	for lane, requests := range lanes {
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "sign", "queue", "size"},
+			float32(len(requests)),
+			[]metrics.Label{telemetry.NewLabel("lane", lane)})
+	}
+
+	scheduler := k.newSignScheduler(ctx, lanes)
+	maxSignShares := k.GetMaxSimultaneousSignShares(ctx)
+
+	var started []exported.SignInfo
+	for lane, next, ok := scheduler.peek(); ok && signShares+next.shareCount <= maxSignShares; lane, next, ok = scheduler.peek() {
+		scheduler.pop(lane)
+		signShares += next.shareCount
+
+		k.setSignStartedAt(ctx, next.info.SigID, ctx.BlockHeight())
+		started = append(started, next.info)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "sign", "queue", "started"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("lane", lane)})
+	}
+
+	k.setSignQueueLaneCredits(ctx, scheduler)
+
+	telemetry.SetGauge(float32(signShares), types.ModuleName, "sign", "queue", "active_shares")
+	k.Logger(ctx).Debug(fmt.Sprintf("%d active sign shares, %d signatures in queue", signShares, k.GetSignQueue(ctx).Size()))
+
+	return started
+}
+
+// GetSignQueueSchedule returns the share count of the sign requests in progress and the queued sign requests in the
+// order they are going to start. Start heights are estimated assuming every sign request takes its full sign timeout,
+// they are zero if a request can never start because it exceeds the maximum simultaneous sign shares
+func (k Keeper) GetSignQueueSchedule(ctx sdk.Context, snapshotter types.Snapshotter) (int64, []types.QuerySignQueueResponse_Entry) {
+	type release struct {
+		height     int64
+		shareCount int64
+	}
+
+	signing, lanes := k.loadSignQueue(ctx, snapshotter, false)
+	height := ctx.BlockHeight() + 1
+	freeShares := k.GetMaxSimultaneousSignShares(ctx)
+	signShares := int64(0)
+
+	var releases []release
+	for _, request := range signing {
+		startedAt, ok := k.getSignStartedAt(ctx, request.info.SigID)
+		if !ok {
+			startedAt = ctx.BlockHeight()
+		}
+
+		releases = append(releases, release{height: startedAt + k.getSignTimeout(ctx, request.info.KeyID), shareCount: request.shareCount})
+		freeShares -= request.shareCount
+		signShares += request.shareCount
+	}
+
+	scheduler := k.newSignScheduler(ctx, lanes)
+	entries := []types.QuerySignQueueResponse_Entry{}
+	blocked := false
+	for lane, next, ok := scheduler.peek(); ok; lane, next, ok = scheduler.peek() {
+		scheduler.pop(lane)
+
+		sort.SliceStable(releases, func(i, j int) bool { return releases[i].height < releases[j].height })
+		for next.shareCount > freeShares && len(releases) > 0 {
+			if releases[0].height > height {
+				height = releases[0].height
+			}
+			freeShares += releases[0].shareCount
+			releases = releases[1:]
+		}
+
+		entry := types.QuerySignQueueResponse_Entry{
+			SigID:         next.info.SigID,
+			KeyID:         next.info.KeyID,
+			RequestModule: next.info.RequestModule,
+			Lane:          lane,
+			Priority:      next.info.Priority,
+			ShareCount:    next.shareCount,
+			Position:      int64(len(entries)),
+		}
+
+		// a request that can never start blocks all requests scheduled after it
+		blocked = blocked || next.shareCount > freeShares
+		if !blocked {
+			entry.EstimatedStartHeight = height
+			freeShares -= next.shareCount
+			releases = append(releases, release{height: height + k.getSignTimeout(ctx, next.info.KeyID), shareCount: next.shareCount})
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return signShares, entries
+}
+
+func (k Keeper) getSignTimeout(ctx sdk.Context, keyID exported.KeyID) int64 {
+	keyRequirement, ok := k.GetKeyRequirement(ctx, k.GetKeyRole(ctx, keyID), k.GetKeyType(ctx, keyID))
+	if !ok {
+		return 0
+	}
+
+	return keyRequirement.SignTimeout
+}
+
+func (k Keeper) getSignQueueWeights(ctx sdk.Context) []types.SignQueueWeight {
+	var result []types.SignQueueWeight
+	k.params.Get(ctx, types.KeySignQueueWeights, &result)
+
+	return result
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestScheduleSigns(t *testing.T) {
+	queueSnap := snapshot.Snapshot{
+		Validators:      []snapshot.Validator{val1, val2, val3, val4},
+		Timestamp:       time.Now(),
+		Height:          rand.I64Between(1, 1000000),
+		TotalShareCount: sdk.NewInt(400),
+		Counter:         rand.I64Between(0, 100000),
+	}
+	keyID := exported.KeyID(randDistinctStr.Next())
+
+	setupQueue := func(maxSignShares int64) *testSetup {
+		s := setup()
+		s.Ctx = s.Ctx.WithBlockHeight(rand.I64Between(1, 1000000))
+		s.Snapshotter.GetSnapshotFunc = func(ctx sdk.Context, seqNo int64) (snapshot.Snapshot, bool) {
+			return queueSnap, seqNo == queueSnap.Counter
+		}
+
+		params := types.DefaultParams()
+		params.MaxSimultaneousSignShares = maxSignShares
+		s.Keeper.SetParams(s.Ctx, params)
+		s.Keeper.SetKeyInfo(s.Ctx, types.KeyInfo{KeyID: keyID, KeyRole: exported.MasterKey, KeyType: exported.Threshold})
+
+		return s
+	}
+
+	enqueue := func(s *testSetup, info exported.SignInfo) {
+		info.KeyID = keyID
+		info.SnapshotCounter = queueSnap.Counter
+
+		q := s.Keeper.GetSignQueue(s.Ctx)
+		assert.NoError(t, q.Enqueue(&info))
+		s.Keeper.SetSigStatus(s.Ctx, info.SigID, exported.SigStatus_Queued)
+	}
+
+	start := func(s *testSetup) []exported.SignInfo {
+		started := s.Keeper.ScheduleSigns(s.Ctx, s.Snapshotter)
+		for _, info := range started {
+			s.Keeper.SetSigStatus(s.Ctx, info.SigID, exported.SigStatus_Signing)
+		}
+
+		return started
+	}
+
+	t.Run("schedule lanes in weighted round robin", func(t *testing.T) {
+		s := setupQueue(1)
+		for i := 0; i < 6; i++ {
+			enqueue(s, exported.SignInfo{SigID: fmt.Sprintf("evm-%d", i), RequestModule: "evm", RequestChain: "Ethereum"})
+		}
+		for i := 0; i < 3; i++ {
+			enqueue(s, exported.SignInfo{SigID: fmt.Sprintf("btc-%d", i), RequestModule: "bitcoin"})
+		}
+
+		var order []string
+		for i := 0; i < 9; i++ {
+			started := start(s)
+			assert.Len(t, started, 1)
+			order = append(order, started[0].SigID)
+
+			// only one sign fits at a time
+			assert.Empty(t, start(s))
+			s.Keeper.SetSigStatus(s.Ctx, started[0].SigID, exported.SigStatus_Signed)
+			s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+		}
+
+		assert.Equal(t, []string{"btc-0", "evm-0", "btc-1", "btc-2", "evm-1", "evm-2", "evm-3", "evm-4", "evm-5"}, order)
+		assert.Empty(t, start(s))
+		assert.Equal(t, uint64(0), s.Keeper.GetSignQueue(s.Ctx).Size())
+	})
+
+	t.Run("schedule by priority within a lane", func(t *testing.T) {
+		s := setupQueue(10)
+		enqueue(s, exported.SignInfo{SigID: "low", RequestModule: "evm", RequestChain: "Ethereum", Priority: exported.SignPriority_Low})
+		enqueue(s, exported.SignInfo{SigID: "unspecified", RequestModule: "evm", RequestChain: "Ethereum"})
+		enqueue(s, exported.SignInfo{SigID: "high", RequestModule: "evm", RequestChain: "Ethereum", Priority: exported.SignPriority_High})
+		enqueue(s, exported.SignInfo{SigID: "normal", RequestModule: "evm", RequestChain: "Ethereum", Priority: exported.SignPriority_Normal})
+
+		var order []string
+		for _, info := range start(s) {
+			order = append(order, info.SigID)
+		}
+
+		assert.Equal(t, []string{"high", "unspecified", "normal", "low"}, order)
+	})
+
+	t.Run("estimate start heights", func(t *testing.T) {
+		s := setupQueue(1)
+		enqueue(s, exported.SignInfo{SigID: "signing", RequestModule: "bitcoin"})
+		assert.Len(t, start(s), 1)
+
+		enqueue(s, exported.SignInfo{SigID: "evm", RequestModule: "evm", RequestChain: "Ethereum"})
+		enqueue(s, exported.SignInfo{SigID: "key-transfer", RequestModule: "evm", RequestChain: "Ethereum", KeyManagement: true})
+
+		signTimeout := s.Keeper.getSignTimeout(s.Ctx, keyID)
+		assert.True(t, signTimeout > 0)
+
+		signingShareCount, entries := s.Keeper.GetSignQueueSchedule(s.Ctx, s.Snapshotter)
+		assert.Equal(t, int64(1), signingShareCount)
+		assert.Len(t, entries, 2)
+
+		assert.Equal(t, "key-transfer", entries[0].SigID)
+		assert.Equal(t, exported.KeyManagementLaneClass+"/ethereum", entries[0].Lane)
+		assert.Equal(t, int64(0), entries[0].Position)
+		assert.Equal(t, s.Ctx.BlockHeight()+signTimeout, entries[0].EstimatedStartHeight)
+
+		assert.Equal(t, "evm", entries[1].SigID)
+		assert.Equal(t, "evm/ethereum", entries[1].Lane)
+		assert.Equal(t, int64(1), entries[1].Position)
+		assert.Equal(t, s.Ctx.BlockHeight()+2*signTimeout, entries[1].EstimatedStartHeight)
+
+		// querying the schedule must not affect scheduling
+		s.Keeper.SetSigStatus(s.Ctx, "signing", exported.SigStatus_Signed)
+		started := start(s)
+		assert.Len(t, started, 1)
+		assert.Equal(t, "key-transfer", started[0].SigID)
+	})
+
+	t.Run("requests exceeding the max sign shares cannot be estimated", func(t *testing.T) {
+		s := setupQueue(1)
+		queueSnap.CorruptionThreshold = 1
+		defer func() { queueSnap.CorruptionThreshold = 0 }()
+
+		enqueue(s, exported.SignInfo{SigID: "too-big", RequestModule: "bitcoin"})
+
+		_, entries := s.Keeper.GetSignQueueSchedule(s.Ctx, s.Snapshotter)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, int64(0), entries[0].EstimatedStartHeight)
+		assert.Empty(t, start(s))
+	})
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+// GetSnapshotDrift returns how much of the share count of the snapshot the given key was generated from
+// is still held by validators that are eligible and available to sign with the key
+func (k Keeper) GetSnapshotDrift(ctx sdk.Context, snapshotter types.Snapshotter, keyID exported.KeyID) (types.SnapshotDrift, error) {
+	counter, ok := k.GetSnapshotCounterForKeyID(ctx, keyID)
+	if !ok {
+		return types.SnapshotDrift{}, fmt.Errorf("could not find snapshot counter for key %s", keyID)
+	}
+
+	snap, ok := snapshotter.GetSnapshot(ctx, counter)
+	if !ok {
+		return types.SnapshotDrift{}, fmt.Errorf("could not find snapshot with sequence number #%d", counter)
+	}
+
+	drift := types.SnapshotDrift{
+		KeyID:                keyID,
+		SnapshotCounter:      counter,
+		TotalShareCount:      snap.TotalShareCount.Int64(),
+		CorruptionThreshold:  snap.CorruptionThreshold,
+		IneligibleValidators: []string{},
+	}
+
+	for _, validator := range snap.Validators {
+		operator := validator.GetSDKValidator().GetOperator()
+
+		illegibility, err := snapshotter.GetValidatorIllegibility(ctx, validator.GetSDKValidator())
+		if err != nil {
+			return types.SnapshotDrift{}, err
+		}
+
+		if !illegibility.FilterIllegibilityForSigning().Is(snapshot.None) || !k.IsOperatorAvailable(ctx, operator, keyID) {
+			drift.IneligibleValidators = append(drift.IneligibleValidators, operator.String())
+			continue
+		}
+
+		drift.EligibleShareCount += validator.ShareCount
+	}
+
+	drift.Status = drift.ComputeStatus(k.GetSnapshotDriftWarningMargin(ctx))
+
+	return drift, nil
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestGetSnapshotDrift(t *testing.T) {
+	driftSnap := snapshot.Snapshot{
+		Validators:      []snapshot.Validator{val1, val2, val3, val4},
+		Timestamp:       time.Now(),
+		Height:          rand.I64Between(1, 1000000),
+		TotalShareCount: sdk.NewInt(400),
+		Counter:         rand.I64Between(0, 100000),
+	}
+
+	setupDrift := func(corruptionThreshold int64, jailed sdk.ValAddress, unavailable sdk.ValAddress) (*testSetup, exported.KeyID) {
+		s := setup()
+		keyID := exported.KeyID(randDistinctStr.Next())
+
+		keySnap := driftSnap
+		keySnap.CorruptionThreshold = corruptionThreshold
+		s.Snapshotter.GetSnapshotFunc = func(ctx sdk.Context, seqNo int64) (snapshot.Snapshot, bool) {
+			if seqNo == keySnap.Counter {
+				return keySnap, true
+			}
+			return snapshot.Snapshot{}, false
+		}
+		s.Snapshotter.GetValidatorIllegibilityFunc = func(ctx sdk.Context, validator snapshot.SDKValidator) (snapshot.ValidatorIllegibility, error) {
+			if validator.GetOperator().Equals(jailed) {
+				return snapshot.Jailed, nil
+			}
+			return snapshot.None, nil
+		}
+
+		s.Ctx = s.Ctx.WithBlockHeight(rand.I64Between(1, 1000000))
+		for _, val := range keySnap.Validators {
+			if val.GetSDKValidator().GetOperator().Equals(unavailable) {
+				continue
+			}
+			s.Keeper.SetAvailableOperator(s.Ctx, val.GetSDKValidator().GetOperator(), keyID)
+		}
+
+		keyInfo := types.KeyInfo{KeyID: keyID, KeyRole: exported.MasterKey, KeyType: exported.Threshold}
+		assert.NoError(t, s.Keeper.StartKeygen(s.Ctx, s.Voter, keyInfo, keySnap))
+		s.Keeper.SetKey(s.Ctx, generateECDSAKey(keyID))
+		s.Keeper.SetKeyInfo(s.Ctx, keyInfo)
+
+		return s, keyID
+	}
+
+	t.Run("all validators eligible", func(t *testing.T) {
+		s, keyID := setupDrift(199, nil, nil)
+
+		drift, err := s.Keeper.GetSnapshotDrift(s.Ctx, s.Snapshotter, keyID)
+		assert.NoError(t, err)
+		assert.Equal(t, keyID, drift.KeyID)
+		assert.Equal(t, driftSnap.Counter, drift.SnapshotCounter)
+		assert.Equal(t, int64(400), drift.TotalShareCount)
+		assert.Equal(t, int64(400), drift.EligibleShareCount)
+		assert.Empty(t, drift.IneligibleValidators)
+		assert.Equal(t, types.SnapshotDriftStatus_Healthy, drift.Status)
+	})
+
+	t.Run("eligible share count close to threshold", func(t *testing.T) {
+		s, keyID := setupDrift(199, val1.GetSDKValidator().GetOperator(), val2.GetSDKValidator().GetOperator())
+
+		drift, err := s.Keeper.GetSnapshotDrift(s.Ctx, s.Snapshotter, keyID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(200), drift.EligibleShareCount)
+		assert.ElementsMatch(t, []string{val1.GetSDKValidator().GetOperator().String(), val2.GetSDKValidator().GetOperator().String()}, drift.IneligibleValidators)
+		assert.Equal(t, types.SnapshotDriftStatus_Warning, drift.Status)
+	})
+
+	t.Run("eligible share count below threshold", func(t *testing.T) {
+		s, keyID := setupDrift(266, val1.GetSDKValidator().GetOperator(), val2.GetSDKValidator().GetOperator())
+
+		drift, err := s.Keeper.GetSnapshotDrift(s.Ctx, s.Snapshotter, keyID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(200), drift.EligibleShareCount)
+		assert.Equal(t, types.SnapshotDriftStatus_Insufficient, drift.Status)
+		assert.False(t, drift.CanSign())
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		s := setup()
+
+		_, err := s.Keeper.GetSnapshotDrift(s.Ctx, s.Snapshotter, exported.KeyID(randDistinctStr.Next()))
+		assert.Error(t, err)
+	})
+
+	t.Run("block sign below threshold", func(t *testing.T) {
+		s, keyID := setupDrift(266, val1.GetSDKValidator().GetOperator(), val2.GetSDKValidator().GetOperator())
+		params := types.DefaultParams()
+		params.BlockSignBelowThreshold = true
+		s.Keeper.SetParams(s.Ctx, params)
+
+		err := s.Keeper.StartSign(s.Ctx, exported.SignInfo{
+			KeyID:           keyID,
+			SigID:           rand.StrBetween(5, 20),
+			Msg:             rand.BytesBetween(5, 100),
+			SnapshotCounter: driftSnap.Counter,
+		}, s.Snapshotter, s.Voter)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can no longer meet its signing threshold")
+	})
+}
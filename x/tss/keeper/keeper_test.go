@@ -43,7 +43,7 @@ var (
 		Counter:         rand2.I64Between(0, 100000),
 	}
 	randPosInt      = rand2.I64GenBetween(0, 100000000)
-	randDistinctStr = rand2.Strings(3, 15).Distinct()
+	randDistinctStr = rand2.Strings(exported.KeyIDLengthMin, 15).Distinct()
 )
 
 type testSetup struct {
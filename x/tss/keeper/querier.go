@@ -30,6 +30,8 @@ const (
 	QueryActiveOldKeysByValidator = "active-old-keys-validator"
 	QueryDeactivated              = "deactivated"
 	QExternalKeyID                = "external-key-id"
+	QuerySnapshotDrift            = "snapshot-drift"
+	QuerySignQueue                = "sign-queue"
 )
 
 // NewQuerier returns a new querier for the TSS module
@@ -80,6 +82,15 @@ func NewQuerier(k types.TSSKeeper, v types.Voter, s types.Snapshotter, staking t
 			res, err = queryActiveOldKeyIDsByValidator(ctx, k, n, s, path[1])
 		case QueryDeactivated:
 			res, err = queryDeactivatedOperator(ctx, k, s, staking)
+		case QuerySnapshotDrift:
+			keyID := exported.KeyID(path[1])
+			err = keyID.Validate()
+			if err != nil {
+				break
+			}
+			res, err = QuerySnapshotDriftByKeyID(ctx, k, s, keyID)
+		case QuerySignQueue:
+			res, err = QuerySignQueueSchedule(ctx, k, s)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unknown tss query endpoint: %s", path[0]))
 		}
@@ -92,35 +103,52 @@ func NewQuerier(k types.TSSKeeper, v types.Voter, s types.Snapshotter, staking t
 }
 
 func queryNextKeyID(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, req types.QueryNextKeyIDRequest) ([]byte, error) {
+	resp, err := nextKeyID(ctx, k, n, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Marshal()
+}
+
+func nextKeyID(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, req types.QueryNextKeyIDRequest) (types.QueryNextKeyIDResponse, error) {
 	chain, ok := n.GetChain(ctx, req.Chain)
 	if !ok {
-		return nil, fmt.Errorf("chain \"%s\" not found", req.Chain)
+		return types.QueryNextKeyIDResponse{}, fmt.Errorf("chain \"%s\" not found", req.Chain)
 	}
 
 	key, ok := k.GetNextKey(ctx, chain, req.KeyRole)
 	if !ok {
-		return nil, fmt.Errorf("no next key assigned for key role \"%s\" on chain \"%s\"", req.KeyRole.SimpleString(), chain.Name)
+		return types.QueryNextKeyIDResponse{}, fmt.Errorf("no next key assigned for key role \"%s\" on chain \"%s\"", req.KeyRole.SimpleString(), chain.Name)
 	}
 
-	response := types.QueryNextKeyIDResponse{KeyID: key.ID}
-	return response.Marshal()
+	return types.QueryNextKeyIDResponse{KeyID: key.ID}, nil
 }
 
 func queryRecovery(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, keyID exported.KeyID, addressStr string) ([]byte, error) {
+	resp, err := recovery(ctx, k, s, keyID, addressStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Marshal()
+}
+
+func recovery(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, keyID exported.KeyID, addressStr string) (types.QueryRecoveryResponse, error) {
 
 	address, err := sdk.ValAddressFromBech32(addressStr)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(err, "failed to parse validator address")
+		return types.QueryRecoveryResponse{}, sdkerrors.Wrapf(err, "failed to parse validator address")
 	}
 
 	counter, ok := k.GetSnapshotCounterForKeyID(ctx, keyID)
 	if !ok {
-		return nil, fmt.Errorf("could not obtain snapshot counter for key ID %s", keyID)
+		return types.QueryRecoveryResponse{}, fmt.Errorf("could not obtain snapshot counter for key ID %s", keyID)
 	}
 
 	snapshot, ok := s.GetSnapshot(ctx, counter)
 	if !ok {
-		return nil, fmt.Errorf("could not obtain snapshot for counter %d", counter)
+		return types.QueryRecoveryResponse{}, fmt.Errorf("could not obtain snapshot for counter %d", counter)
 	}
 
 	participants := make([]string, 0, len(snapshot.Validators))
@@ -133,12 +161,12 @@ func queryRecovery(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, keyI
 	// get voted pub key
 	pubKey, ok := k.GetKey(ctx, keyID)
 	if !ok {
-		return nil, fmt.Errorf("could not obtain pubkey for key ID %s", keyID)
+		return types.QueryRecoveryResponse{}, fmt.Errorf("could not obtain pubkey for key ID %s", keyID)
 	}
 
 	pk, err := pubKey.GetECDSAPubKey()
 	if err != nil {
-		return nil, err
+		return types.QueryRecoveryResponse{}, err
 	}
 
 	// convert ecdsa pub key to bytes
@@ -148,12 +176,12 @@ func queryRecovery(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, keyI
 	// get voted group recover info
 	groupRecoverInfo := k.GetGroupRecoveryInfo(ctx, keyID)
 	if groupRecoverInfo == nil {
-		return nil, fmt.Errorf("could not obtain group info for key ID %s", keyID)
+		return types.QueryRecoveryResponse{}, fmt.Errorf("could not obtain group info for key ID %s", keyID)
 	}
 
 	privateRecoverInfo := k.GetPrivateRecoveryInfo(ctx, address, keyID)
 	if privateRecoverInfo == nil {
-		return nil, fmt.Errorf("could not obtain private info for key ID %s", keyID)
+		return types.QueryRecoveryResponse{}, fmt.Errorf("could not obtain private info for key ID %s", keyID)
 	}
 
 	resp := types.QueryRecoveryResponse{
@@ -167,10 +195,19 @@ func queryRecovery(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, keyI
 		},
 	}
 
-	return resp.Marshal()
+	return resp, nil
 }
 
 func querySignatureStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, sigID string) ([]byte, error) {
+	resp, err := signatureStatus(ctx, k, v, sigID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Marshal()
+}
+
+func signatureStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, sigID string) (types.QuerySignatureResponse, error) {
 	if sig, status := k.GetSig(ctx, sigID); status == exported.SigStatus_Signed {
 		// poll was successful
 		switch signature := sig.GetSig().(type) {
@@ -187,11 +224,11 @@ func querySignatureStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, sig
 					},
 				},
 			}
-			return res.Marshal()
+			return res, nil
 		case *exported.Signature_MultiSig_:
 			btcecSigs, err := signature.GetSignature()
 			if err != nil {
-				return nil, err
+				return types.QuerySignatureResponse{}, err
 			}
 			var signatures []types.QuerySignatureResponse_Signature
 			for _, btcecSig := range btcecSigs {
@@ -208,9 +245,9 @@ func querySignatureStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, sig
 					},
 				},
 			}
-			return res.Marshal()
+			return res, nil
 		default:
-			return nil, fmt.Errorf("unexpected signature type %T", signature)
+			return types.QuerySignatureResponse{}, fmt.Errorf("unexpected signature type %T", signature)
 		}
 
 	}
@@ -232,17 +269,26 @@ func querySignatureStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, sig
 		},
 	}
 
-	return res.Marshal()
+	return res, nil
 }
 
 func queryKeyStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, keyID exported.KeyID) ([]byte, error) {
+	resp, err := keyStatus(ctx, k, v, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Marshal()
+}
+
+func keyStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, keyID exported.KeyID) (types.QueryKeyResponse, error) {
 	if key, ok := k.GetKey(ctx, keyID); ok {
 		switch pubKey := key.GetPublicKey().(type) {
 		// poll was successful
 		case *exported.Key_ECDSAKey_:
 			pk, err := pubKey.ECDSAKey.GetPubKey()
 			if err != nil {
-				return nil, err
+				return types.QueryKeyResponse{}, err
 			}
 
 			res := types.QueryKeyResponse{
@@ -258,11 +304,11 @@ func queryKeyStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, keyID exp
 				Role:      key.Role,
 				RotatedAt: key.RotatedAt,
 			}
-			return res.Marshal()
+			return res, nil
 		case *exported.Key_MultisigKey_:
 			pks, err := pubKey.MultisigKey.GetPubKey()
 			if err != nil {
-				return nil, err
+				return types.QueryKeyResponse{}, err
 			}
 
 			var keys []types.QueryKeyResponse_Key
@@ -283,9 +329,9 @@ func queryKeyStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, keyID exp
 				Role:      key.Role,
 				RotatedAt: key.RotatedAt,
 			}
-			return res.Marshal()
+			return res, nil
 		default:
-			return nil, fmt.Errorf("unexpected key type %T", key)
+			return types.QueryKeyResponse{}, fmt.Errorf("unexpected key type %T", key)
 		}
 	}
 
@@ -305,7 +351,7 @@ func queryKeyStatus(ctx sdk.Context, k types.TSSKeeper, v types.Voter, keyID exp
 		},
 	}
 
-	return res.Marshal()
+	return res, nil
 }
 
 // queryKeyID returns the keyID of the most recent key for a provided keyChain and keyRole
@@ -333,15 +379,24 @@ func queryKeyID(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, keyChainStr s
 }
 
 func queryKeySharesByKeyID(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, keyID exported.KeyID) ([]byte, error) {
+	resp, err := keySharesByKeyID(ctx, k, s, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Marshal()
+}
+
+func keySharesByKeyID(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, keyID exported.KeyID) (types.QueryKeyShareResponse, error) {
 
 	counter, ok := k.GetSnapshotCounterForKeyID(ctx, keyID)
 	if !ok {
-		return nil, fmt.Errorf("invalid keyID %s", keyID)
+		return types.QueryKeyShareResponse{}, fmt.Errorf("invalid keyID %s", keyID)
 	}
 
 	snapshot, ok := s.GetSnapshot(ctx, counter)
 	if !ok {
-		return nil, fmt.Errorf("no snapshot found for counter number %d", counter)
+		return types.QueryKeyShareResponse{}, fmt.Errorf("no snapshot found for counter number %d", counter)
 	}
 
 	var allShareInfos []types.QueryKeyShareResponse_ShareInfo
@@ -362,34 +417,52 @@ func queryKeySharesByKeyID(ctx sdk.Context, k types.TSSKeeper, s types.Snapshott
 		ShareInfos: allShareInfos,
 	}
 
-	return keyShareInfos.Marshal()
+	return keyShareInfos, nil
 }
 
 func queryActiveOldKeyIDs(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, chainName, roleStr string) ([]byte, error) {
+	resp, err := activeOldKeyIDs(ctx, k, n, chainName, roleStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Marshal()
+}
+
+func activeOldKeyIDs(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, chainName, roleStr string) (types.QueryActiveOldKeysResponse, error) {
 	var queryResponse types.QueryActiveOldKeysResponse
 
 	chain, ok := n.GetChain(ctx, chainName)
 	if !ok {
-		return nil, fmt.Errorf("could not find chain '%s'", chainName)
+		return types.QueryActiveOldKeysResponse{}, fmt.Errorf("could not find chain '%s'", chainName)
 	}
 
 	role, err := exported.KeyRoleFromSimpleStr(roleStr)
 	if err != nil {
-		return nil, err
+		return types.QueryActiveOldKeysResponse{}, err
 	}
 
 	keys, err := k.GetOldActiveKeys(ctx, chain, role)
 	if err != nil {
-		return nil, err
+		return types.QueryActiveOldKeysResponse{}, err
 	}
 
 	for _, key := range keys {
 		queryResponse.KeyIDs = append(queryResponse.KeyIDs, key.ID)
 	}
-	return queryResponse.Marshal()
+	return queryResponse, nil
 }
 
 func queryActiveOldKeyIDsByValidator(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, s types.Snapshotter, targetValidatorAddr string) ([]byte, error) {
+	resp, err := activeOldKeyIDsByValidator(ctx, k, n, s, targetValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Marshal()
+}
+
+func activeOldKeyIDsByValidator(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, s types.Snapshotter, targetValidatorAddr string) (types.QueryActiveOldKeysValidatorResponse, error) {
 	var allKeys []types.QueryActiveOldKeysValidatorResponse_KeyInfo
 	var queryResponse types.QueryActiveOldKeysValidatorResponse
 
@@ -397,7 +470,7 @@ func queryActiveOldKeyIDsByValidator(ctx sdk.Context, k types.TSSKeeper, n types
 		for _, role := range exported.GetKeyRoles() {
 			keys, err := k.GetOldActiveKeys(ctx, chain, role)
 			if err != nil {
-				return nil, err
+				return types.QueryActiveOldKeysValidatorResponse{}, err
 			}
 
 			for _, key := range keys {
@@ -413,12 +486,12 @@ func queryActiveOldKeyIDsByValidator(ctx sdk.Context, k types.TSSKeeper, n types
 	for _, key := range allKeys {
 		counter, ok := k.GetSnapshotCounterForKeyID(ctx, key.ID)
 		if !ok {
-			return nil, fmt.Errorf("could not get snapshot counter from keyID %s", key.ID)
+			return types.QueryActiveOldKeysValidatorResponse{}, fmt.Errorf("could not get snapshot counter from keyID %s", key.ID)
 		}
 
 		snapshot, ok := s.GetSnapshot(ctx, counter)
 		if !ok {
-			return nil, fmt.Errorf("no snapshot found for counter number %d", counter)
+			return types.QueryActiveOldKeysValidatorResponse{}, fmt.Errorf("no snapshot found for counter number %d", counter)
 		}
 
 		for _, validator := range snapshot.Validators {
@@ -429,10 +502,19 @@ func queryActiveOldKeyIDsByValidator(ctx sdk.Context, k types.TSSKeeper, n types
 			}
 		}
 	}
-	return queryResponse.Marshal()
+	return queryResponse, nil
 }
 
 func queryKeySharesByValidator(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, s types.Snapshotter, targetValidatorAddr string) ([]byte, error) {
+	resp, err := keySharesByValidator(ctx, k, n, s, targetValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Marshal()
+}
+
+func keySharesByValidator(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, s types.Snapshotter, targetValidatorAddr string) (types.QueryKeyShareResponse, error) {
 
 	var allShareInfos []types.QueryKeyShareResponse_ShareInfo
 
@@ -447,12 +529,12 @@ func queryKeySharesByValidator(ctx sdk.Context, k types.TSSKeeper, n types.Nexus
 
 			counter, ok := k.GetSnapshotCounterForKeyID(ctx, keyID)
 			if !ok {
-				return nil, fmt.Errorf("could not get snapshot counter from keyID %s", keyID)
+				return types.QueryKeyShareResponse{}, fmt.Errorf("could not get snapshot counter from keyID %s", keyID)
 			}
 
 			snapshot, ok := s.GetSnapshot(ctx, counter)
 			if !ok {
-				return nil, fmt.Errorf("no snapshot found for counter number %d", counter)
+				return types.QueryKeyShareResponse{}, fmt.Errorf("no snapshot found for counter number %d", counter)
 			}
 
 			for _, validator := range snapshot.Validators {
@@ -480,10 +562,19 @@ func queryKeySharesByValidator(ctx sdk.Context, k types.TSSKeeper, n types.Nexus
 		ShareInfos: allShareInfos,
 	}
 
-	return keyShareInfos.Marshal()
+	return keyShareInfos, nil
 }
 
 func queryDeactivatedOperator(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, staking types.StakingKeeper) ([]byte, error) {
+	resp, err := deactivatedOperators(ctx, k, s, staking)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
+
+func deactivatedOperators(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, staking types.StakingKeeper) (types.QueryDeactivatedOperatorsResponse, error) {
 
 	var deactivatedValidators []string
 	validatorIter := func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
@@ -510,24 +601,67 @@ func queryDeactivatedOperator(ctx sdk.Context, k types.TSSKeeper, s types.Snapsh
 		OperatorAddresses: deactivatedValidators,
 	}
 
-	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+	return resp, nil
 }
 
 // QueryExternalKeyID returns the keyIDs of the current set of external keys for the given chain
 func QueryExternalKeyID(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, chainStr string) ([]byte, error) {
+	resp, err := externalKeyID(ctx, k, n, chainStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
+
+func externalKeyID(ctx sdk.Context, k types.TSSKeeper, n types.Nexus, chainStr string) (types.QueryExternalKeyIDResponse, error) {
 	chain, ok := n.GetChain(ctx, chainStr)
 	if !ok {
-		return nil, fmt.Errorf("unknown chain %s", chainStr)
+		return types.QueryExternalKeyIDResponse{}, fmt.Errorf("unknown chain %s", chainStr)
 	}
 
 	externalKeyIDs, ok := k.GetExternalKeyIDs(ctx, chain)
 	if !ok {
-		return nil, fmt.Errorf("external keys not found")
+		return types.QueryExternalKeyIDResponse{}, fmt.Errorf("external keys not found")
 	}
 
-	resp := types.QueryExternalKeyIDResponse{
+	return types.QueryExternalKeyIDResponse{
 		KeyIDs: externalKeyIDs,
+	}, nil
+}
+
+// QuerySnapshotDriftByKeyID returns the snapshot drift of the given key
+func QuerySnapshotDriftByKeyID(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, keyID exported.KeyID) ([]byte, error) {
+	resp, err := snapshotDrift(ctx, k, s, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
+}
+
+func snapshotDrift(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter, keyID exported.KeyID) (types.QuerySnapshotDriftResponse, error) {
+	drift, err := k.GetSnapshotDrift(ctx, s, keyID)
+	if err != nil {
+		return types.QuerySnapshotDriftResponse{}, err
 	}
 
+	return types.QuerySnapshotDriftResponse{SnapshotDrift: drift}, nil
+}
+
+// QuerySignQueueSchedule returns the queued sign requests in the order they are going to start
+func QuerySignQueueSchedule(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter) ([]byte, error) {
+	resp := signQueue(ctx, k, s)
+
 	return types.ModuleCdc.MarshalLengthPrefixed(&resp)
 }
+
+func signQueue(ctx sdk.Context, k types.TSSKeeper, s types.Snapshotter) types.QuerySignQueueResponse {
+	signingShareCount, entries := k.GetSignQueueSchedule(ctx, s)
+
+	return types.QuerySignQueueResponse{
+		MaxSimultaneousSignShares: k.GetMaxSimultaneousSignShares(ctx),
+		SigningShareCount:         signingShareCount,
+		Entries:                   entries,
+	}
+}
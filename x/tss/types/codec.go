@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
@@ -22,6 +23,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RegisterExternalKeysRequest{}, "tss/RegisterExternalKey", nil)
 	cdc.RegisterConcrete(&SubmitMultisigPubKeysRequest{}, "tss/SubmitMultisigPubKeys", nil)
 	cdc.RegisterConcrete(&SubmitMultisigSignaturesRequest{}, "tss/SubmitMultisigSignatures", nil)
+	cdc.RegisterConcrete(&RotateKeyProposal{}, "tss/RotateKeyProposal", nil)
+	cdc.RegisterConcrete(&UpdateKeyRequirementProposal{}, "tss/UpdateKeyRequirementProposal", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -38,6 +41,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&SubmitMultisigPubKeysRequest{},
 		&SubmitMultisigSignaturesRequest{},
 	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&RotateKeyProposal{},
+		&UpdateKeyRequirementProposal{},
+	)
 	registry.RegisterImplementations((*codec.ProtoMarshaler)(nil),
 		&tofnd.MessageOut_SignResult{},
 		&tofnd.MessageOut_KeygenResult{},
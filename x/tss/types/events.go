@@ -2,10 +2,12 @@ package types
 
 // Event types
 const (
-	EventTypeKeygen    = "keygen"
-	EventTypeSign      = "sign"
-	EventTypeHeartBeat = "heartbeat"
-	EventTypeKey       = "key"
+	EventTypeKeygen        = "keygen"
+	EventTypeSign          = "sign"
+	EventTypeHeartBeat     = "heartbeat"
+	EventTypeKey           = "key"
+	EventTypeKeyRotation   = "keyRotation"
+	EventTypeSnapshotDrift = "snapshotDrift"
 )
 
 // Event attribute keys
@@ -30,14 +32,19 @@ const (
 	AttributeKeyRole                      = "keyRole"
 	AttributeKeyKeyIDs                    = "keyIDs"
 	AttributeKeyKeyInfos                  = "keyInfos"
+	AttributeKeyTrigger                   = "trigger"
+	AttributeKeyEligibleShareCount        = "eligibleShareCount"
+	AttributeKeyTotalShareCount           = "totalShareCount"
 )
 
 // Event attribute values
 const (
-	AttributeValueSend     = "send"
-	AttributeValueStart    = "start"
-	AttributeValueMsg      = "message"
-	AttributeValueDecided  = "decided"
-	AttributeValueReject   = "reject"
-	AttributeValueAssigned = "assigned"
+	AttributeValueSend      = "send"
+	AttributeValueStart     = "start"
+	AttributeValueMsg       = "message"
+	AttributeValueDecided   = "decided"
+	AttributeValueReject    = "reject"
+	AttributeValueAssigned  = "assigned"
+	AttributeValueScheduled = "scheduled"
+	AttributeValueFailed    = "failed"
 )
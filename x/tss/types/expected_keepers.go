@@ -70,6 +70,7 @@ type TSSKeeper interface {
 	SetParams(ctx sdk.Context, p Params)
 	GetParams(ctx sdk.Context) (params Params)
 	GetRouter() Router
+	GetKeyRotationRouter() KeyRotationRouter
 	SetPrivateRecoveryInfo(ctx sdk.Context, sender sdk.ValAddress, keyID exported.KeyID, recoveryInfo []byte)
 	HasPrivateRecoveryInfos(ctx sdk.Context, sender sdk.ValAddress, keyID exported.KeyID) bool
 	GetPrivateRecoveryInfo(ctx sdk.Context, sender sdk.ValAddress, keyID exported.KeyID) []byte
@@ -117,6 +118,16 @@ type TSSKeeper interface {
 	GetHeartbeatPeriodInBlocks(ctx sdk.Context) int64
 	GetOldActiveKeys(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) ([]exported.Key, error)
 	GetMaxSimultaneousSignShares(ctx sdk.Context) int64
+	GetKeyRotationPolicies(ctx sdk.Context) []KeyRotationPolicy
+	SetScheduledKeyRotation(ctx sdk.Context, rotation ScheduledKeyRotation)
+	GetScheduledKeyRotation(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (ScheduledKeyRotation, bool)
+	DeleteScheduledKeyRotation(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole)
+	SetKeyRotationAbort(ctx sdk.Context, abort KeyRotationAbort)
+	GetKeyRotationAbort(ctx sdk.Context, chain nexus.Chain, keyRole exported.KeyRole) (KeyRotationAbort, bool)
+	GetKeyRotationCooldown(ctx sdk.Context) int64
+	GetSnapshotDrift(ctx sdk.Context, snapshotter Snapshotter, keyID exported.KeyID) (SnapshotDrift, error)
+	ScheduleSigns(ctx sdk.Context, snapshotter Snapshotter) []exported.SignInfo
+	GetSignQueueSchedule(ctx sdk.Context, snapshotter Snapshotter) (int64, []QuerySignQueueResponse_Entry)
 
 	SubmitPubKeys(ctx sdk.Context, keyID exported.KeyID, validator sdk.ValAddress, pubKeys ...[]byte) bool
 	GetMultisigKeygenInfo(ctx sdk.Context, keyID exported.KeyID) (MultisigKeygenInfo, bool)
@@ -3,24 +3,357 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
+// NewGenesisState is the constructor for GenesisState
+func NewGenesisState(
+	p Params,
+	keys []KeyRecord,
+	signatures []SignRecord,
+	keyRotations []KeyRotations,
+	externalKeys []ExternalKeys,
+	suspendedValidators []SuspendedValidator,
+	signQueue []exported.SignInfo,
+	multisigKeygenQueue []exported.KeyID,
+	multisigSignQueue []string,
+	scheduledKeyRotations []ScheduledKeyRotation,
+	keyRotationAborts []KeyRotationAbort,
+) *GenesisState {
+	return &GenesisState{
+		Params:                p,
+		Keys:                  keys,
+		Signatures:            signatures,
+		KeyRotations:          keyRotations,
+		ExternalKeys:          externalKeys,
+		SuspendedValidators:   suspendedValidators,
+		SignQueue:             signQueue,
+		MultisigKeygenQueue:   multisigKeygenQueue,
+		MultisigSignQueue:     multisigSignQueue,
+		ScheduledKeyRotations: scheduledKeyRotations,
+		KeyRotationAborts:     keyRotationAborts,
+	}
+}
+
 // DefaultGenesis represents the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{DefaultParams()}
+	return NewGenesisState(
+		DefaultParams(),
+		[]KeyRecord{},
+		[]SignRecord{},
+		[]KeyRotations{},
+		[]ExternalKeys{},
+		[]SuspendedValidator{},
+		[]exported.SignInfo{},
+		[]exported.KeyID{},
+		[]string{},
+		[]ScheduledKeyRotation{},
+		[]KeyRotationAbort{},
+	)
 }
 
 // Validate validates the genesis state
 func (m GenesisState) Validate() error {
-	if err := m.Params.Validate(); err != nil {
+	if err := m.validate(); err != nil {
 		return sdkerrors.Wrap(err, fmt.Sprintf("genesis state for module %s is invalid", ModuleName))
 	}
 	return nil
 }
 
+func (m GenesisState) validate() error {
+	if err := m.Params.Validate(); err != nil {
+		return err
+	}
+
+	keyRoles := make(map[exported.KeyID]exported.KeyRole)
+	for _, key := range m.Keys {
+		if err := key.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := keyRoles[key.KeyInfo.KeyID]; ok {
+			return fmt.Errorf("duplicate key %s", key.KeyInfo.KeyID)
+		}
+		keyRoles[key.KeyInfo.KeyID] = key.KeyInfo.KeyRole
+	}
+
+	sigIDs := make(map[string]bool)
+	for _, sig := range m.Signatures {
+		if err := sig.Validate(); err != nil {
+			return err
+		}
+
+		if sig.SignInfo != nil {
+			if _, ok := keyRoles[sig.SignInfo.KeyID]; !ok {
+				return fmt.Errorf("signature %s refers to unknown key %s", sig.SigID, sig.SignInfo.KeyID)
+			}
+		}
+
+		if sigIDs[sig.SigID] {
+			return fmt.Errorf("duplicate signature %s", sig.SigID)
+		}
+		sigIDs[sig.SigID] = true
+	}
+
+	seenRotations := make(map[string]bool)
+	for _, rotations := range m.KeyRotations {
+		if err := rotations.Validate(); err != nil {
+			return err
+		}
+
+		for _, keyID := range rotations.KeyIDs {
+			role, ok := keyRoles[keyID]
+			if !ok {
+				return fmt.Errorf("%s key rotation of chain %s refers to unknown key %s", rotations.KeyRole.SimpleString(), rotations.Chain, keyID)
+			}
+
+			if role != rotations.KeyRole {
+				return fmt.Errorf("%s key rotation of chain %s refers to key %s with role %s", rotations.KeyRole.SimpleString(), rotations.Chain, keyID, role.SimpleString())
+			}
+		}
+
+		id := fmt.Sprintf("%s_%s", strings.ToLower(rotations.Chain), rotations.KeyRole.SimpleString())
+		if seenRotations[id] {
+			return fmt.Errorf("duplicate %s key rotations for chain %s", rotations.KeyRole.SimpleString(), rotations.Chain)
+		}
+		seenRotations[id] = true
+	}
+
+	seenExternalKeys := make(map[string]bool)
+	for _, externalKeys := range m.ExternalKeys {
+		if externalKeys.Chain == "" {
+			return fmt.Errorf("missing chain for external keys")
+		}
+
+		for _, keyID := range externalKeys.KeyIDs {
+			if _, ok := keyRoles[keyID]; !ok {
+				return fmt.Errorf("external keys of chain %s refer to unknown key %s", externalKeys.Chain, keyID)
+			}
+		}
+
+		if seenExternalKeys[strings.ToLower(externalKeys.Chain)] {
+			return fmt.Errorf("duplicate external keys for chain %s", externalKeys.Chain)
+		}
+		seenExternalKeys[strings.ToLower(externalKeys.Chain)] = true
+	}
+
+	suspended := make(map[string]bool)
+	for _, validator := range m.SuspendedValidators {
+		if err := sdk.VerifyAddressFormat(validator.Validator); err != nil {
+			return sdkerrors.Wrap(err, "invalid suspended validator")
+		}
+
+		if suspended[validator.Validator.String()] {
+			return fmt.Errorf("duplicate suspension of validator %s", validator.Validator.String())
+		}
+		suspended[validator.Validator.String()] = true
+	}
+
+	for _, queue := range []int{len(m.SignQueue), len(m.MultisigKeygenQueue), len(m.MultisigSignQueue)} {
+		if int64(queue) > m.Params.MaxSignQueueSize {
+			return fmt.Errorf("queue size %d exceeds the maximum of %d", queue, m.Params.MaxSignQueueSize)
+		}
+	}
+
+	for _, info := range m.SignQueue {
+		if _, ok := keyRoles[info.KeyID]; !ok {
+			return fmt.Errorf("queued signature %s refers to unknown key %s", info.SigID, info.KeyID)
+		}
+
+		if !sigIDs[info.SigID] {
+			return fmt.Errorf("queued signature %s is unknown", info.SigID)
+		}
+
+		if err := info.Priority.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, keyID := range m.MultisigKeygenQueue {
+		if _, ok := keyRoles[keyID]; !ok {
+			return fmt.Errorf("queued multisig keygen refers to unknown key %s", keyID)
+		}
+	}
+
+	for _, sigID := range m.MultisigSignQueue {
+		if !sigIDs[sigID] {
+			return fmt.Errorf("queued multisig sign refers to unknown signature %s", sigID)
+		}
+	}
+
+	seenScheduledRotations := make(map[string]bool)
+	for _, rotation := range m.ScheduledKeyRotations {
+		if err := rotation.Validate(); err != nil {
+			return err
+		}
+
+		role, ok := keyRoles[rotation.KeyID]
+		if !ok {
+			return fmt.Errorf("scheduled %s key rotation of chain %s refers to unknown key %s", rotation.KeyRole.SimpleString(), rotation.Chain, rotation.KeyID)
+		}
+
+		if role != rotation.KeyRole {
+			return fmt.Errorf("scheduled %s key rotation of chain %s refers to key %s with role %s", rotation.KeyRole.SimpleString(), rotation.Chain, rotation.KeyID, role.SimpleString())
+		}
+
+		id := fmt.Sprintf("%s_%s", strings.ToLower(rotation.Chain), rotation.KeyRole.SimpleString())
+		if seenScheduledRotations[id] {
+			return fmt.Errorf("duplicate scheduled %s key rotation for chain %s", rotation.KeyRole.SimpleString(), rotation.Chain)
+		}
+		seenScheduledRotations[id] = true
+	}
+
+	seenAborts := make(map[string]bool)
+	for _, abort := range m.KeyRotationAborts {
+		if err := abort.Validate(); err != nil {
+			return err
+		}
+
+		id := fmt.Sprintf("%s_%s", strings.ToLower(abort.Chain), abort.KeyRole.SimpleString())
+		if seenAborts[id] {
+			return fmt.Errorf("duplicate aborted %s key rotation for chain %s", abort.KeyRole.SimpleString(), abort.Chain)
+		}
+		seenAborts[id] = true
+	}
+
+	return nil
+}
+
+// Validate returns an error if the key record is not valid; nil otherwise
+func (m KeyRecord) Validate() error {
+	keyID := m.KeyInfo.KeyID
+	if err := keyID.Validate(); err != nil {
+		return err
+	}
+
+	if m.Key != nil && m.Key.ID != keyID {
+		return fmt.Errorf("key %s does not match key ID %s", m.Key.ID, keyID)
+	}
+
+	for _, participant := range m.KeygenParticipants {
+		if err := sdk.VerifyAddressFormat(participant); err != nil {
+			return sdkerrors.Wrapf(err, "invalid keygen participant for key %s", keyID)
+		}
+	}
+
+	if m.RotationCount < 0 {
+		return fmt.Errorf("rotation count of key %s must not be negative", keyID)
+	}
+
+	for _, info := range m.PrivateRecoveryInfos {
+		if err := sdk.VerifyAddressFormat(info.Validator); err != nil {
+			return sdkerrors.Wrapf(err, "invalid validator for private recovery info of key %s", keyID)
+		}
+	}
+
+	if m.MultisigKeygenInfo != nil && m.MultisigKeygenInfo.ID != string(keyID) {
+		return fmt.Errorf("multisig keygen info %s does not match key ID %s", m.MultisigKeygenInfo.ID, keyID)
+	}
+
+	return nil
+}
+
+// Validate returns an error if the sign record is not valid; nil otherwise
+func (m SignRecord) Validate() error {
+	if m.SigID == "" {
+		return fmt.Errorf("missing sig ID")
+	}
+
+	if m.Signature != nil && m.Signature.SigID != m.SigID {
+		return fmt.Errorf("signature %s does not match sig ID %s", m.Signature.SigID, m.SigID)
+	}
+
+	if m.SignInfo != nil && m.SignInfo.SigID != m.SigID {
+		return fmt.Errorf("sign info %s does not match sig ID %s", m.SignInfo.SigID, m.SigID)
+	}
+
+	for _, participant := range m.Participants {
+		if err := sdk.VerifyAddressFormat(participant.Validator); err != nil {
+			return sdkerrors.Wrapf(err, "invalid sign participant for signature %s", m.SigID)
+		}
+
+		if participant.ShareCount < 0 {
+			return fmt.Errorf("share count of sign participant %s for signature %s must not be negative", participant.Validator.String(), m.SigID)
+		}
+	}
+
+	if m.MultisigSignInfo != nil && m.MultisigSignInfo.ID != m.SigID {
+		return fmt.Errorf("multisig sign info %s does not match sig ID %s", m.MultisigSignInfo.ID, m.SigID)
+	}
+
+	return nil
+}
+
+// Validate returns an error if the key rotations are not valid; nil otherwise
+func (m KeyRotations) Validate() error {
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain for key rotations")
+	}
+
+	if err := m.KeyRole.Validate(); err != nil {
+		return err
+	}
+
+	if m.RotationCount < 0 {
+		return fmt.Errorf("rotation count must not be negative")
+	}
+
+	if int64(len(m.KeyIDs)) < m.RotationCount || int64(len(m.KeyIDs)) > m.RotationCount+1 {
+		return fmt.Errorf("%s key rotations of chain %s must have %d or %d key IDs, got %d",
+			m.KeyRole.SimpleString(), m.Chain, m.RotationCount, m.RotationCount+1, len(m.KeyIDs))
+	}
+
+	return nil
+}
+
+// Validate returns an error if the scheduled key rotation is not valid; nil otherwise
+func (m ScheduledKeyRotation) Validate() error {
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain for scheduled key rotation")
+	}
+
+	if err := m.KeyRole.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.KeyID.Validate(); err != nil {
+		return err
+	}
+
+	if _, ok := KeyRotationTrigger_name[int32(m.Trigger)]; !ok || m.Trigger == KeyRotationTrigger_Unspecified {
+		return fmt.Errorf("invalid trigger %d for scheduled key rotation of chain %s", m.Trigger, m.Chain)
+	}
+
+	if m.StartedAt < 0 {
+		return fmt.Errorf("start height of scheduled key rotation must not be negative")
+	}
+
+	return nil
+}
+
+// Validate returns an error if the key rotation abort is not valid; nil otherwise
+func (m KeyRotationAbort) Validate() error {
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain for aborted key rotation")
+	}
+
+	if err := m.KeyRole.Validate(); err != nil {
+		return err
+	}
+
+	if m.AbortedAt < 0 {
+		return fmt.Errorf("abort height of key rotation must not be negative")
+	}
+
+	return nil
+}
+
 // GetGenesisStateFromAppState returns x/tss GenesisState given raw application
 // genesis state.
 func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) GenesisState {
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -23,8 +25,21 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// GenesisState represents the genesis state of the tss module. Validator
+// heartbeats are not part of it, they are restored with the next heartbeat
+// period after the chain has restarted
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params                Params                                                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Keys                  []KeyRecord                                                 `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys"`
+	Signatures            []SignRecord                                                `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures"`
+	KeyRotations          []KeyRotations                                              `protobuf:"bytes,4,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations"`
+	ExternalKeys          []ExternalKeys                                              `protobuf:"bytes,5,rep,name=external_keys,json=externalKeys,proto3" json:"external_keys"`
+	SuspendedValidators   []SuspendedValidator                                        `protobuf:"bytes,6,rep,name=suspended_validators,json=suspendedValidators,proto3" json:"suspended_validators"`
+	SignQueue             []exported.SignInfo                                         `protobuf:"bytes,7,rep,name=sign_queue,json=signQueue,proto3" json:"sign_queue"`
+	MultisigKeygenQueue   []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,8,rep,name=multisig_keygen_queue,json=multisigKeygenQueue,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"multisig_keygen_queue,omitempty"`
+	MultisigSignQueue     []string                                                    `protobuf:"bytes,9,rep,name=multisig_sign_queue,json=multisigSignQueue,proto3" json:"multisig_sign_queue,omitempty"`
+	ScheduledKeyRotations []ScheduledKeyRotation                                      `protobuf:"bytes,10,rep,name=scheduled_key_rotations,json=scheduledKeyRotations,proto3" json:"scheduled_key_rotations"`
+	KeyRotationAborts     []KeyRotationAbort                                          `protobuf:"bytes,11,rep,name=key_rotation_aborts,json=keyRotationAborts,proto3" json:"key_rotation_aborts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,20 +82,40 @@ func init() {
 func init() { proto.RegisterFile("tss/v1beta1/genesis.proto", fileDescriptor_eb5f1c2be1950e47) }
 
 var fileDescriptor_eb5f1c2be1950e47 = []byte{
-	// 205 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x29, 0x2e, 0xd6,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x29, 0x2e, 0xd6, 0x83, 0x4a, 0x49, 0x89, 0xa4,
-	0xe7, 0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0x29, 0x09, 0x64, 0xdd, 0x05, 0x89,
-	0x45, 0x89, 0xb9, 0x50, 0xcd, 0x4a, 0x8e, 0x5c, 0x3c, 0xee, 0x10, 0xd3, 0x82, 0x4b, 0x12, 0x4b,
-	0x52, 0x85, 0x0c, 0xb9, 0xd8, 0x20, 0xf2, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xc2, 0x7a,
-	0x48, 0xa6, 0xeb, 0x05, 0x80, 0xa5, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x2a, 0x74,
-	0xf2, 0x3b, 0xf1, 0x50, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
-	0x0c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x13, 0x2b, 0x52, 0x73,
-	0x12, 0x8b, 0xf2, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xa1, 0x3c, 0xdd, 0xe4, 0xfc, 0xa2, 0x54,
-	0xfd, 0x0a, 0x7d, 0x90, 0x0b, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x2e, 0x33, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x19, 0xdb, 0xd7, 0xf3, 0x00, 0x00, 0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x13, 0x1a, 0x02, 0xd9, 0x94, 0x43, 0x9d, 0x96, 0xb8, 0x91, 0x70, 0x02, 0xa7, 0x5c,
+	0xb0, 0x9b, 0x72, 0xe2, 0xd0, 0x03, 0xa1, 0x08, 0x55, 0x91, 0x10, 0x24, 0x12, 0x42, 0x5c, 0xac,
+	0x4d, 0x3c, 0xb8, 0x56, 0x1c, 0xaf, 0xbb, 0xb3, 0x2e, 0xf1, 0x5b, 0xf0, 0x34, 0x3c, 0x43, 0x8e,
+	0x3d, 0x72, 0xaa, 0x20, 0x79, 0x0b, 0x4e, 0xc8, 0xeb, 0x75, 0xb4, 0xa6, 0xe1, 0xc0, 0x2d, 0x99,
+	0xff, 0xff, 0xbf, 0x9d, 0x99, 0xf5, 0x92, 0x63, 0x81, 0xe8, 0x5c, 0x0f, 0xa6, 0x20, 0xe8, 0xc0,
+	0xf1, 0x21, 0x02, 0x0c, 0xd0, 0x8e, 0x39, 0x13, 0xcc, 0x68, 0x0a, 0x44, 0x5b, 0x49, 0x9d, 0x43,
+	0x9f, 0xf9, 0x4c, 0xd6, 0x9d, 0xec, 0x57, 0x6e, 0xe9, 0x98, 0x7a, 0x3a, 0xa6, 0x9c, 0x2e, 0x54,
+	0xb8, 0xd3, 0xd6, 0x15, 0x91, 0xc6, 0x50, 0x08, 0xbd, 0x4c, 0x80, 0x65, 0xcc, 0xb8, 0x00, 0x6f,
+	0x97, 0xe3, 0xd9, 0xf7, 0x3a, 0xd9, 0x7f, 0x9b, 0x77, 0x32, 0x11, 0x54, 0x80, 0x31, 0x20, 0xf5,
+	0x9c, 0x6d, 0x56, 0x7b, 0xd5, 0x7e, 0xf3, 0xb4, 0x65, 0x6b, 0x9d, 0xd9, 0xef, 0xa5, 0x34, 0xac,
+	0xad, 0x6e, 0xbb, 0x95, 0xb1, 0x32, 0x1a, 0x27, 0xa4, 0x36, 0x87, 0x14, 0xcd, 0x7b, 0xbd, 0xbd,
+	0x7e, 0xf3, 0xf4, 0x71, 0x29, 0x30, 0x82, 0x74, 0x0c, 0x33, 0xc6, 0x3d, 0x95, 0x91, 0x4e, 0xe3,
+	0x8c, 0x10, 0x0c, 0xfc, 0x88, 0x8a, 0x84, 0x03, 0x9a, 0x7b, 0x32, 0xd7, 0x2e, 0xe5, 0x26, 0x81,
+	0x1f, 0x95, 0x82, 0x5a, 0xc0, 0x38, 0x27, 0x8f, 0xe6, 0x90, 0xba, 0x9c, 0x09, 0x2a, 0x02, 0x16,
+	0xa1, 0x59, 0x93, 0x84, 0xe3, 0x3b, 0x27, 0x17, 0x06, 0xc5, 0xd8, 0x9f, 0x6b, 0xb5, 0x8c, 0x02,
+	0x4b, 0x01, 0x3c, 0xa2, 0xa1, 0x2b, 0xfb, 0xbf, 0xbf, 0x83, 0xf2, 0x46, 0x39, 0x46, 0x90, 0x6e,
+	0x29, 0xa0, 0xd5, 0x8c, 0x4f, 0xe4, 0x10, 0x13, 0x8c, 0x21, 0xf2, 0xc0, 0x73, 0xaf, 0x69, 0x18,
+	0x78, 0x54, 0x30, 0x8e, 0x66, 0x5d, 0xc2, 0xba, 0xe5, 0xa1, 0x0a, 0xe3, 0xc7, 0xc2, 0xa7, 0x90,
+	0x2d, 0xbc, 0xa3, 0xa0, 0xf1, 0x3a, 0x5f, 0x92, 0x7b, 0x95, 0x40, 0x02, 0xe6, 0x03, 0xc9, 0xb3,
+	0x24, 0xaf, 0xb8, 0xd1, 0xd2, 0xb6, 0x2e, 0xa2, 0x2f, 0x4c, 0xe1, 0x1a, 0x59, 0xee, 0x43, 0x16,
+	0x33, 0xae, 0xc8, 0xd1, 0x22, 0x09, 0x45, 0x80, 0x81, 0x9f, 0x0d, 0xe9, 0x43, 0xc1, 0x7b, 0xd8,
+	0xdb, 0xeb, 0x37, 0x86, 0x67, 0xbf, 0x6f, 0xbb, 0x2f, 0xfd, 0x40, 0x5c, 0x26, 0x53, 0x7b, 0xc6,
+	0x16, 0x0e, 0x5d, 0x42, 0x48, 0x79, 0x04, 0xe2, 0x2b, 0xe3, 0x73, 0xf5, 0xef, 0xf9, 0x8c, 0x71,
+	0x70, 0x96, 0x8e, 0xfe, 0x2d, 0x65, 0xdb, 0xbd, 0x38, 0x1f, 0xb7, 0x0a, 0xf6, 0x48, 0xa2, 0xf3,
+	0x23, 0x6d, 0xb2, 0x2d, 0xbb, 0xda, 0x00, 0x8d, 0xec, 0xc0, 0xf1, 0x41, 0x21, 0x4d, 0xb6, 0x2d,
+	0xba, 0xa4, 0x8d, 0xb3, 0x4b, 0xf0, 0x92, 0x10, 0x3c, 0xb7, 0x7c, 0xaf, 0x44, 0x0e, 0xfd, 0xb4,
+	0xbc, 0xc4, 0xc2, 0xab, 0x5d, 0xb0, 0x9a, 0xfb, 0x08, 0x77, 0x68, 0x68, 0x4c, 0x48, 0x4b, 0xc7,
+	0xba, 0x74, 0xca, 0xb8, 0x40, 0xb3, 0x29, 0xe1, 0x4f, 0xfe, 0xf5, 0xd1, 0xbc, 0xca, 0x5c, 0x0a,
+	0x7c, 0x30, 0xff, 0xab, 0x8e, 0xc3, 0x77, 0xab, 0x5f, 0x56, 0x65, 0xb5, 0xb6, 0xaa, 0x37, 0x6b,
+	0xab, 0xfa, 0x73, 0x6d, 0x55, 0xbf, 0x6d, 0xac, 0xca, 0xcd, 0xc6, 0xaa, 0xfc, 0xd8, 0x58, 0x95,
+	0xcf, 0x27, 0xff, 0xb1, 0x53, 0xf9, 0x1c, 0xa7, 0x75, 0xf9, 0x1e, 0x5f, 0xfc, 0x19, 0x00, 0x5d,
+	0xba, 0x77, 0x6d, 0x24, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -103,6 +138,136 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.KeyRotationAborts) > 0 {
+		for iNdEx := len(m.KeyRotationAborts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotationAborts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ScheduledKeyRotations) > 0 {
+		for iNdEx := len(m.ScheduledKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MultisigSignQueue) > 0 {
+		for iNdEx := len(m.MultisigSignQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MultisigSignQueue[iNdEx])
+			copy(dAtA[i:], m.MultisigSignQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MultisigSignQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MultisigKeygenQueue) > 0 {
+		for iNdEx := len(m.MultisigKeygenQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MultisigKeygenQueue[iNdEx])
+			copy(dAtA[i:], m.MultisigKeygenQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MultisigKeygenQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SignQueue) > 0 {
+		for iNdEx := len(m.SignQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SuspendedValidators) > 0 {
+		for iNdEx := len(m.SuspendedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuspendedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ExternalKeys) > 0 {
+		for iNdEx := len(m.ExternalKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -135,6 +300,66 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyRotations) > 0 {
+		for _, e := range m.KeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExternalKeys) > 0 {
+		for _, e := range m.ExternalKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SuspendedValidators) > 0 {
+		for _, e := range m.SuspendedValidators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignQueue) > 0 {
+		for _, e := range m.SignQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MultisigKeygenQueue) > 0 {
+		for _, s := range m.MultisigKeygenQueue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MultisigSignQueue) > 0 {
+		for _, s := range m.MultisigSignQueue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledKeyRotations) > 0 {
+		for _, e := range m.ScheduledKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyRotationAborts) > 0 {
+		for _, e := range m.KeyRotationAborts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -206,6 +431,342 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, KeyRecord{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, SignRecord{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotations = append(m.KeyRotations, KeyRotations{})
+			if err := m.KeyRotations[len(m.KeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalKeys = append(m.ExternalKeys, ExternalKeys{})
+			if err := m.ExternalKeys[len(m.ExternalKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspendedValidators = append(m.SuspendedValidators, SuspendedValidator{})
+			if err := m.SuspendedValidators[len(m.SuspendedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignQueue = append(m.SignQueue, exported.SignInfo{})
+			if err := m.SignQueue[len(m.SignQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigKeygenQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigKeygenQueue = append(m.MultisigKeygenQueue, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigSignQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigSignQueue = append(m.MultisigSignQueue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledKeyRotations = append(m.ScheduledKeyRotations, ScheduledKeyRotation{})
+			if err := m.ScheduledKeyRotations[len(m.ScheduledKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationAborts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotationAborts = append(m.KeyRotationAborts, KeyRotationAbort{})
+			if err := m.KeyRotationAborts[len(m.KeyRotationAborts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
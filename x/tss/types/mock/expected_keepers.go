@@ -1243,6 +1243,9 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			DeleteParticipantsInKeygenFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID)  {
 // 				panic("mock out the DeleteParticipantsInKeygen method")
 // 			},
+// 			DeleteScheduledKeyRotationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole)  {
+// 				panic("mock out the DeleteScheduledKeyRotation method")
+// 			},
 // 			DeleteSnapshotCounterForKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID)  {
 // 				panic("mock out the DeleteSnapshotCounterForKeyID method")
 // 			},
@@ -1285,6 +1288,18 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			GetKeyRequirementFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement, bool) {
 // 				panic("mock out the GetKeyRequirement method")
 // 			},
+// 			GetKeyRotationAbortFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (types.KeyRotationAbort, bool) {
+// 				panic("mock out the GetKeyRotationAbort method")
+// 			},
+// 			GetKeyRotationCooldownFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
+// 				panic("mock out the GetKeyRotationCooldown method")
+// 			},
+// 			GetKeyRotationPoliciesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.KeyRotationPolicy {
+// 				panic("mock out the GetKeyRotationPolicies method")
+// 			},
+// 			GetKeyRotationRouterFunc: func() types.KeyRotationRouter {
+// 				panic("mock out the GetKeyRotationRouter method")
+// 			},
 // 			GetKeyTypeFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType {
 // 				panic("mock out the GetKeyType method")
 // 			},
@@ -1321,6 +1336,9 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			GetRouterFunc: func() types.Router {
 // 				panic("mock out the GetRouter method")
 // 			},
+// 			GetScheduledKeyRotationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (types.ScheduledKeyRotation, bool) {
+// 				panic("mock out the GetScheduledKeyRotation method")
+// 			},
 // 			GetSigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
 // 				panic("mock out the GetSig method")
 // 			},
@@ -1333,9 +1351,15 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			GetSignParticipantsSharesAsJSONFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) []byte {
 // 				panic("mock out the GetSignParticipantsSharesAsJSON method")
 // 			},
+// 			GetSignQueueScheduleFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter) (int64, []types.QuerySignQueueResponse_Entry) {
+// 				panic("mock out the GetSignQueueSchedule method")
+// 			},
 // 			GetSnapshotCounterForKeyIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
 // 				panic("mock out the GetSnapshotCounterForKeyID method")
 // 			},
+// 			GetSnapshotDriftFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (types.SnapshotDrift, error) {
+// 				panic("mock out the GetSnapshotDrift method")
+// 			},
 // 			GetTssSuspendedUntilFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) int64 {
 // 				panic("mock out the GetTssSuspendedUntil method")
 // 			},
@@ -1357,7 +1381,10 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			RotateKeyFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error {
 // 				panic("mock out the RotateKey method")
 // 			},
-// 			SelectSignParticipantsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snap github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) ([]github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, []github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, error) {
+// 			ScheduleSignsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter) []github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo {
+// 				panic("mock out the ScheduleSigns method")
+// 			},
+// 			SelectSignParticipantsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snap github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) ([]github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, []github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, error) {
 // 				panic("mock out the SelectSignParticipants method")
 // 			},
 // 			SetAvailableOperatorFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress, keyIDs ...github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID)  {
@@ -1378,12 +1405,18 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			SetKeyInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, info types.KeyInfo)  {
 // 				panic("mock out the SetKeyInfo method")
 // 			},
+// 			SetKeyRotationAbortFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, abort types.KeyRotationAbort)  {
+// 				panic("mock out the SetKeyRotationAbort method")
+// 			},
 // 			SetParamsFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params)  {
 // 				panic("mock out the SetParams method")
 // 			},
 // 			SetPrivateRecoveryInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_cosmos_cosmos_sdk_types.ValAddress, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, recoveryInfo []byte)  {
 // 				panic("mock out the SetPrivateRecoveryInfo method")
 // 			},
+// 			SetScheduledKeyRotationFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, rotation types.ScheduledKeyRotation)  {
+// 				panic("mock out the SetScheduledKeyRotation method")
+// 			},
 // 			SetSigFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature)  {
 // 				panic("mock out the SetSig method")
 // 			},
@@ -1393,7 +1426,7 @@ var _ types.TSSKeeper = &TSSKeeperMock{}
 // 			StartKeygenFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, voter types.Voter, keyInfo types.KeyInfo, snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot) error {
 // 				panic("mock out the StartKeygen method")
 // 			},
-// 			StartSignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, voter interface{InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error}) error {
+// 			StartSignFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error {
 // 				panic("mock out the StartSign method")
 // 			},
 // 			SubmitPubKeysFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, validator github_com_cosmos_cosmos_sdk_types.ValAddress, pubKeys ...[]byte) bool {
@@ -1433,6 +1466,9 @@ type TSSKeeperMock struct {
 	// DeleteParticipantsInKeygenFunc mocks the DeleteParticipantsInKeygen method.
 	DeleteParticipantsInKeygenFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID)
 
+	// DeleteScheduledKeyRotationFunc mocks the DeleteScheduledKeyRotation method.
+	DeleteScheduledKeyRotationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole)
+
 	// DeleteSnapshotCounterForKeyIDFunc mocks the DeleteSnapshotCounterForKeyID method.
 	DeleteSnapshotCounterForKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID)
 
@@ -1475,6 +1511,18 @@ type TSSKeeperMock struct {
 	// GetKeyRequirementFunc mocks the GetKeyRequirement method.
 	GetKeyRequirementFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) (github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRequirement, bool)
 
+	// GetKeyRotationAbortFunc mocks the GetKeyRotationAbort method.
+	GetKeyRotationAbortFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (types.KeyRotationAbort, bool)
+
+	// GetKeyRotationCooldownFunc mocks the GetKeyRotationCooldown method.
+	GetKeyRotationCooldownFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) int64
+
+	// GetKeyRotationPoliciesFunc mocks the GetKeyRotationPolicies method.
+	GetKeyRotationPoliciesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.KeyRotationPolicy
+
+	// GetKeyRotationRouterFunc mocks the GetKeyRotationRouter method.
+	GetKeyRotationRouterFunc func() types.KeyRotationRouter
+
 	// GetKeyTypeFunc mocks the GetKeyType method.
 	GetKeyTypeFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType
 
@@ -1511,6 +1559,9 @@ type TSSKeeperMock struct {
 	// GetRouterFunc mocks the GetRouter method.
 	GetRouterFunc func() types.Router
 
+	// GetScheduledKeyRotationFunc mocks the GetScheduledKeyRotation method.
+	GetScheduledKeyRotationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (types.ScheduledKeyRotation, bool)
+
 	// GetSigFunc mocks the GetSig method.
 	GetSigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus)
 
@@ -1523,9 +1574,15 @@ type TSSKeeperMock struct {
 	// GetSignParticipantsSharesAsJSONFunc mocks the GetSignParticipantsSharesAsJSON method.
 	GetSignParticipantsSharesAsJSONFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) []byte
 
+	// GetSignQueueScheduleFunc mocks the GetSignQueueSchedule method.
+	GetSignQueueScheduleFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter) (int64, []types.QuerySignQueueResponse_Entry)
+
 	// GetSnapshotCounterForKeyIDFunc mocks the GetSnapshotCounterForKeyID method.
 	GetSnapshotCounterForKeyIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool)
 
+	// GetSnapshotDriftFunc mocks the GetSnapshotDrift method.
+	GetSnapshotDriftFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (types.SnapshotDrift, error)
+
 	// GetTssSuspendedUntilFunc mocks the GetTssSuspendedUntil method.
 	GetTssSuspendedUntilFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) int64
 
@@ -1547,8 +1604,11 @@ type TSSKeeperMock struct {
 	// RotateKeyFunc mocks the RotateKey method.
 	RotateKeyFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) error
 
+	// ScheduleSignsFunc mocks the ScheduleSigns method.
+	ScheduleSignsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter) []github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
+
 	// SelectSignParticipantsFunc mocks the SelectSignParticipants method.
-	SelectSignParticipantsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snap github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) ([]github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, []github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, error)
+	SelectSignParticipantsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snap github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) ([]github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, []github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, error)
 
 	// SetAvailableOperatorFunc mocks the SetAvailableOperator method.
 	SetAvailableOperatorFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress, keyIDs ...github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID)
@@ -1568,12 +1628,18 @@ type TSSKeeperMock struct {
 	// SetKeyInfoFunc mocks the SetKeyInfo method.
 	SetKeyInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, info types.KeyInfo)
 
+	// SetKeyRotationAbortFunc mocks the SetKeyRotationAbort method.
+	SetKeyRotationAbortFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, abort types.KeyRotationAbort)
+
 	// SetParamsFunc mocks the SetParams method.
 	SetParamsFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params)
 
 	// SetPrivateRecoveryInfoFunc mocks the SetPrivateRecoveryInfo method.
 	SetPrivateRecoveryInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_cosmos_cosmos_sdk_types.ValAddress, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, recoveryInfo []byte)
 
+	// SetScheduledKeyRotationFunc mocks the SetScheduledKeyRotation method.
+	SetScheduledKeyRotationFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, rotation types.ScheduledKeyRotation)
+
 	// SetSigFunc mocks the SetSig method.
 	SetSigFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature)
 
@@ -1584,9 +1650,7 @@ type TSSKeeperMock struct {
 	StartKeygenFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, voter types.Voter, keyInfo types.KeyInfo, snapshot github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot) error
 
 	// StartSignFunc mocks the StartSign method.
-	StartSignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, voter interface {
-		InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
-	}) error
+	StartSignFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error
 
 	// SubmitPubKeysFunc mocks the SubmitPubKeys method.
 	SubmitPubKeysFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID, validator github_com_cosmos_cosmos_sdk_types.ValAddress, pubKeys ...[]byte) bool
@@ -1662,6 +1726,15 @@ type TSSKeeperMock struct {
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
+		// DeleteScheduledKeyRotation holds details about calls to the DeleteScheduledKeyRotation method.
+		DeleteScheduledKeyRotation []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+		}
 		// DeleteSnapshotCounterForKeyID holds details about calls to the DeleteSnapshotCounterForKeyID method.
 		DeleteSnapshotCounterForKeyID []struct {
 			// Ctx is the ctx argument value.
@@ -1766,6 +1839,28 @@ type TSSKeeperMock struct {
 			// KeyType is the keyType argument value.
 			KeyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType
 		}
+		// GetKeyRotationAbort holds details about calls to the GetKeyRotationAbort method.
+		GetKeyRotationAbort []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+		}
+		// GetKeyRotationCooldown holds details about calls to the GetKeyRotationCooldown method.
+		GetKeyRotationCooldown []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetKeyRotationPolicies holds details about calls to the GetKeyRotationPolicies method.
+		GetKeyRotationPolicies []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+		}
+		// GetKeyRotationRouter holds details about calls to the GetKeyRotationRouter method.
+		GetKeyRotationRouter []struct {
+		}
 		// GetKeyType holds details about calls to the GetKeyType method.
 		GetKeyType []struct {
 			// Ctx is the ctx argument value.
@@ -1852,6 +1947,15 @@ type TSSKeeperMock struct {
 		// GetRouter holds details about calls to the GetRouter method.
 		GetRouter []struct {
 		}
+		// GetScheduledKeyRotation holds details about calls to the GetScheduledKeyRotation method.
+		GetScheduledKeyRotation []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+			// KeyRole is the keyRole argument value.
+			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+		}
 		// GetSig holds details about calls to the GetSig method.
 		GetSig []struct {
 			// Ctx is the ctx argument value.
@@ -1880,6 +1984,13 @@ type TSSKeeperMock struct {
 			// SigID is the sigID argument value.
 			SigID string
 		}
+		// GetSignQueueSchedule holds details about calls to the GetSignQueueSchedule method.
+		GetSignQueueSchedule []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Snapshotter is the snapshotter argument value.
+			Snapshotter types.Snapshotter
+		}
 		// GetSnapshotCounterForKeyID holds details about calls to the GetSnapshotCounterForKeyID method.
 		GetSnapshotCounterForKeyID []struct {
 			// Ctx is the ctx argument value.
@@ -1887,6 +1998,15 @@ type TSSKeeperMock struct {
 			// KeyID is the keyID argument value.
 			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
 		}
+		// GetSnapshotDrift holds details about calls to the GetSnapshotDrift method.
+		GetSnapshotDrift []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Snapshotter is the snapshotter argument value.
+			Snapshotter types.Snapshotter
+			// KeyID is the keyID argument value.
+			KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+		}
 		// GetTssSuspendedUntil holds details about calls to the GetTssSuspendedUntil method.
 		GetTssSuspendedUntil []struct {
 			// Ctx is the ctx argument value.
@@ -1940,12 +2060,19 @@ type TSSKeeperMock struct {
 			// KeyRole is the keyRole argument value.
 			KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
 		}
+		// ScheduleSigns holds details about calls to the ScheduleSigns method.
+		ScheduleSigns []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Snapshotter is the snapshotter argument value.
+			Snapshotter types.Snapshotter
+		}
 		// SelectSignParticipants holds details about calls to the SelectSignParticipants method.
 		SelectSignParticipants []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 			// Snapshotter is the snapshotter argument value.
-			Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
+			Snapshotter types.Snapshotter
 			// Info is the info argument value.
 			Info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 			// Snap is the snap argument value.
@@ -2003,6 +2130,13 @@ type TSSKeeperMock struct {
 			// Info is the info argument value.
 			Info types.KeyInfo
 		}
+		// SetKeyRotationAbort holds details about calls to the SetKeyRotationAbort method.
+		SetKeyRotationAbort []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Abort is the abort argument value.
+			Abort types.KeyRotationAbort
+		}
 		// SetParams holds details about calls to the SetParams method.
 		SetParams []struct {
 			// Ctx is the ctx argument value.
@@ -2021,6 +2155,13 @@ type TSSKeeperMock struct {
 			// RecoveryInfo is the recoveryInfo argument value.
 			RecoveryInfo []byte
 		}
+		// SetScheduledKeyRotation holds details about calls to the SetScheduledKeyRotation method.
+		SetScheduledKeyRotation []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Rotation is the rotation argument value.
+			Rotation types.ScheduledKeyRotation
+		}
 		// SetSig holds details about calls to the SetSig method.
 		SetSig []struct {
 			// Ctx is the ctx argument value.
@@ -2055,11 +2196,9 @@ type TSSKeeperMock struct {
 			// Info is the info argument value.
 			Info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 			// Snapshotter is the snapshotter argument value.
-			Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
+			Snapshotter types.Snapshotter
 			// Voter is the voter argument value.
-			Voter interface {
-				InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
-			}
+			Voter types.InitPoller
 		}
 		// SubmitPubKeys holds details about calls to the SubmitPubKeys method.
 		SubmitPubKeys []struct {
@@ -2092,6 +2231,7 @@ type TSSKeeperMock struct {
 	lockDeleteMultisigKeygen             sync.RWMutex
 	lockDeleteMultisigSign               sync.RWMutex
 	lockDeleteParticipantsInKeygen       sync.RWMutex
+	lockDeleteScheduledKeyRotation       sync.RWMutex
 	lockDeleteSnapshotCounterForKeyID    sync.RWMutex
 	lockDoesValidatorParticipateInKeygen sync.RWMutex
 	lockDoesValidatorParticipateInSign   sync.RWMutex
@@ -2106,6 +2246,10 @@ type TSSKeeperMock struct {
 	lockGetKey                           sync.RWMutex
 	lockGetKeyForSigID                   sync.RWMutex
 	lockGetKeyRequirement                sync.RWMutex
+	lockGetKeyRotationAbort              sync.RWMutex
+	lockGetKeyRotationCooldown           sync.RWMutex
+	lockGetKeyRotationPolicies           sync.RWMutex
+	lockGetKeyRotationRouter             sync.RWMutex
 	lockGetKeyType                       sync.RWMutex
 	lockGetMaxSimultaneousSignShares     sync.RWMutex
 	lockGetMultisigKeygenInfo            sync.RWMutex
@@ -2118,11 +2262,14 @@ type TSSKeeperMock struct {
 	lockGetParticipantsInKeygen          sync.RWMutex
 	lockGetPrivateRecoveryInfo           sync.RWMutex
 	lockGetRouter                        sync.RWMutex
+	lockGetScheduledKeyRotation          sync.RWMutex
 	lockGetSig                           sync.RWMutex
 	lockGetSignParticipants              sync.RWMutex
 	lockGetSignParticipantsAsJSON        sync.RWMutex
 	lockGetSignParticipantsSharesAsJSON  sync.RWMutex
+	lockGetSignQueueSchedule             sync.RWMutex
 	lockGetSnapshotCounterForKeyID       sync.RWMutex
+	lockGetSnapshotDrift                 sync.RWMutex
 	lockGetTssSuspendedUntil             sync.RWMutex
 	lockHasKeygenStarted                 sync.RWMutex
 	lockHasPrivateRecoveryInfos          sync.RWMutex
@@ -2130,6 +2277,7 @@ type TSSKeeperMock struct {
 	lockLogger                           sync.RWMutex
 	lockPenalizeCriminal                 sync.RWMutex
 	lockRotateKey                        sync.RWMutex
+	lockScheduleSigns                    sync.RWMutex
 	lockSelectSignParticipants           sync.RWMutex
 	lockSetAvailableOperator             sync.RWMutex
 	lockSetExternalKeyIDs                sync.RWMutex
@@ -2137,8 +2285,10 @@ type TSSKeeperMock struct {
 	lockSetInfoForSig                    sync.RWMutex
 	lockSetKey                           sync.RWMutex
 	lockSetKeyInfo                       sync.RWMutex
+	lockSetKeyRotationAbort              sync.RWMutex
 	lockSetParams                        sync.RWMutex
 	lockSetPrivateRecoveryInfo           sync.RWMutex
+	lockSetScheduledKeyRotation          sync.RWMutex
 	lockSetSig                           sync.RWMutex
 	lockSetSigStatus                     sync.RWMutex
 	lockStartKeygen                      sync.RWMutex
@@ -2447,6 +2597,45 @@ func (mock *TSSKeeperMock) DeleteParticipantsInKeygenCalls() []struct {
 	return calls
 }
 
+// DeleteScheduledKeyRotation calls DeleteScheduledKeyRotationFunc.
+func (mock *TSSKeeperMock) DeleteScheduledKeyRotation(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) {
+	if mock.DeleteScheduledKeyRotationFunc == nil {
+		panic("TSSKeeperMock.DeleteScheduledKeyRotationFunc: method is nil but TSSKeeper.DeleteScheduledKeyRotation was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockDeleteScheduledKeyRotation.Lock()
+	mock.calls.DeleteScheduledKeyRotation = append(mock.calls.DeleteScheduledKeyRotation, callInfo)
+	mock.lockDeleteScheduledKeyRotation.Unlock()
+	mock.DeleteScheduledKeyRotationFunc(ctx, chain, keyRole)
+}
+
+// DeleteScheduledKeyRotationCalls gets all the calls that were made to DeleteScheduledKeyRotation.
+// Check the length with:
+//     len(mockedTSSKeeper.DeleteScheduledKeyRotationCalls())
+func (mock *TSSKeeperMock) DeleteScheduledKeyRotationCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Chain   nexus.Chain
+	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}
+	mock.lockDeleteScheduledKeyRotation.RLock()
+	calls = mock.calls.DeleteScheduledKeyRotation
+	mock.lockDeleteScheduledKeyRotation.RUnlock()
+	return calls
+}
+
 // DeleteSnapshotCounterForKeyID calls DeleteSnapshotCounterForKeyIDFunc.
 func (mock *TSSKeeperMock) DeleteSnapshotCounterForKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) {
 	if mock.DeleteSnapshotCounterForKeyIDFunc == nil {
@@ -2949,6 +3138,133 @@ func (mock *TSSKeeperMock) GetKeyRequirementCalls() []struct {
 	return calls
 }
 
+// GetKeyRotationAbort calls GetKeyRotationAbortFunc.
+func (mock *TSSKeeperMock) GetKeyRotationAbort(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (types.KeyRotationAbort, bool) {
+	if mock.GetKeyRotationAbortFunc == nil {
+		panic("TSSKeeperMock.GetKeyRotationAbortFunc: method is nil but TSSKeeper.GetKeyRotationAbort was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockGetKeyRotationAbort.Lock()
+	mock.calls.GetKeyRotationAbort = append(mock.calls.GetKeyRotationAbort, callInfo)
+	mock.lockGetKeyRotationAbort.Unlock()
+	return mock.GetKeyRotationAbortFunc(ctx, chain, keyRole)
+}
+
+// GetKeyRotationAbortCalls gets all the calls that were made to GetKeyRotationAbort.
+// Check the length with:
+//     len(mockedTSSKeeper.GetKeyRotationAbortCalls())
+func (mock *TSSKeeperMock) GetKeyRotationAbortCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Chain   nexus.Chain
+	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}
+	mock.lockGetKeyRotationAbort.RLock()
+	calls = mock.calls.GetKeyRotationAbort
+	mock.lockGetKeyRotationAbort.RUnlock()
+	return calls
+}
+
+// GetKeyRotationCooldown calls GetKeyRotationCooldownFunc.
+func (mock *TSSKeeperMock) GetKeyRotationCooldown(ctx github_com_cosmos_cosmos_sdk_types.Context) int64 {
+	if mock.GetKeyRotationCooldownFunc == nil {
+		panic("TSSKeeperMock.GetKeyRotationCooldownFunc: method is nil but TSSKeeper.GetKeyRotationCooldown was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetKeyRotationCooldown.Lock()
+	mock.calls.GetKeyRotationCooldown = append(mock.calls.GetKeyRotationCooldown, callInfo)
+	mock.lockGetKeyRotationCooldown.Unlock()
+	return mock.GetKeyRotationCooldownFunc(ctx)
+}
+
+// GetKeyRotationCooldownCalls gets all the calls that were made to GetKeyRotationCooldown.
+// Check the length with:
+//     len(mockedTSSKeeper.GetKeyRotationCooldownCalls())
+func (mock *TSSKeeperMock) GetKeyRotationCooldownCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetKeyRotationCooldown.RLock()
+	calls = mock.calls.GetKeyRotationCooldown
+	mock.lockGetKeyRotationCooldown.RUnlock()
+	return calls
+}
+
+// GetKeyRotationPolicies calls GetKeyRotationPoliciesFunc.
+func (mock *TSSKeeperMock) GetKeyRotationPolicies(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.KeyRotationPolicy {
+	if mock.GetKeyRotationPoliciesFunc == nil {
+		panic("TSSKeeperMock.GetKeyRotationPoliciesFunc: method is nil but TSSKeeper.GetKeyRotationPolicies was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetKeyRotationPolicies.Lock()
+	mock.calls.GetKeyRotationPolicies = append(mock.calls.GetKeyRotationPolicies, callInfo)
+	mock.lockGetKeyRotationPolicies.Unlock()
+	return mock.GetKeyRotationPoliciesFunc(ctx)
+}
+
+// GetKeyRotationPoliciesCalls gets all the calls that were made to GetKeyRotationPolicies.
+// Check the length with:
+//     len(mockedTSSKeeper.GetKeyRotationPoliciesCalls())
+func (mock *TSSKeeperMock) GetKeyRotationPoliciesCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+	}
+	mock.lockGetKeyRotationPolicies.RLock()
+	calls = mock.calls.GetKeyRotationPolicies
+	mock.lockGetKeyRotationPolicies.RUnlock()
+	return calls
+}
+
+// GetKeyRotationRouter calls GetKeyRotationRouterFunc.
+func (mock *TSSKeeperMock) GetKeyRotationRouter() types.KeyRotationRouter {
+	if mock.GetKeyRotationRouterFunc == nil {
+		panic("TSSKeeperMock.GetKeyRotationRouterFunc: method is nil but TSSKeeper.GetKeyRotationRouter was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetKeyRotationRouter.Lock()
+	mock.calls.GetKeyRotationRouter = append(mock.calls.GetKeyRotationRouter, callInfo)
+	mock.lockGetKeyRotationRouter.Unlock()
+	return mock.GetKeyRotationRouterFunc()
+}
+
+// GetKeyRotationRouterCalls gets all the calls that were made to GetKeyRotationRouter.
+// Check the length with:
+//     len(mockedTSSKeeper.GetKeyRotationRouterCalls())
+func (mock *TSSKeeperMock) GetKeyRotationRouterCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetKeyRotationRouter.RLock()
+	calls = mock.calls.GetKeyRotationRouter
+	mock.lockGetKeyRotationRouter.RUnlock()
+	return calls
+}
+
 // GetKeyType calls GetKeyTypeFunc.
 func (mock *TSSKeeperMock) GetKeyType(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType {
 	if mock.GetKeyTypeFunc == nil {
@@ -3372,6 +3688,45 @@ func (mock *TSSKeeperMock) GetRouterCalls() []struct {
 	return calls
 }
 
+// GetScheduledKeyRotation calls GetScheduledKeyRotationFunc.
+func (mock *TSSKeeperMock) GetScheduledKeyRotation(ctx github_com_cosmos_cosmos_sdk_types.Context, chain nexus.Chain, keyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole) (types.ScheduledKeyRotation, bool) {
+	if mock.GetScheduledKeyRotationFunc == nil {
+		panic("TSSKeeperMock.GetScheduledKeyRotationFunc: method is nil but TSSKeeper.GetScheduledKeyRotation was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}{
+		Ctx:     ctx,
+		Chain:   chain,
+		KeyRole: keyRole,
+	}
+	mock.lockGetScheduledKeyRotation.Lock()
+	mock.calls.GetScheduledKeyRotation = append(mock.calls.GetScheduledKeyRotation, callInfo)
+	mock.lockGetScheduledKeyRotation.Unlock()
+	return mock.GetScheduledKeyRotationFunc(ctx, chain, keyRole)
+}
+
+// GetScheduledKeyRotationCalls gets all the calls that were made to GetScheduledKeyRotation.
+// Check the length with:
+//     len(mockedTSSKeeper.GetScheduledKeyRotationCalls())
+func (mock *TSSKeeperMock) GetScheduledKeyRotationCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	Chain   nexus.Chain
+	KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		Chain   nexus.Chain
+		KeyRole github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole
+	}
+	mock.lockGetScheduledKeyRotation.RLock()
+	calls = mock.calls.GetScheduledKeyRotation
+	mock.lockGetScheduledKeyRotation.RUnlock()
+	return calls
+}
+
 // GetSig calls GetSigFunc.
 func (mock *TSSKeeperMock) GetSig(ctx github_com_cosmos_cosmos_sdk_types.Context, sigID string) (github_com_axelarnetwork_axelar_core_x_tss_exported.Signature, github_com_axelarnetwork_axelar_core_x_tss_exported.SigStatus) {
 	if mock.GetSigFunc == nil {
@@ -3512,6 +3867,41 @@ func (mock *TSSKeeperMock) GetSignParticipantsSharesAsJSONCalls() []struct {
 	return calls
 }
 
+// GetSignQueueSchedule calls GetSignQueueScheduleFunc.
+func (mock *TSSKeeperMock) GetSignQueueSchedule(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter) (int64, []types.QuerySignQueueResponse_Entry) {
+	if mock.GetSignQueueScheduleFunc == nil {
+		panic("TSSKeeperMock.GetSignQueueScheduleFunc: method is nil but TSSKeeper.GetSignQueueSchedule was just called")
+	}
+	callInfo := struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Snapshotter types.Snapshotter
+	}{
+		Ctx:         ctx,
+		Snapshotter: snapshotter,
+	}
+	mock.lockGetSignQueueSchedule.Lock()
+	mock.calls.GetSignQueueSchedule = append(mock.calls.GetSignQueueSchedule, callInfo)
+	mock.lockGetSignQueueSchedule.Unlock()
+	return mock.GetSignQueueScheduleFunc(ctx, snapshotter)
+}
+
+// GetSignQueueScheduleCalls gets all the calls that were made to GetSignQueueSchedule.
+// Check the length with:
+//     len(mockedTSSKeeper.GetSignQueueScheduleCalls())
+func (mock *TSSKeeperMock) GetSignQueueScheduleCalls() []struct {
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Snapshotter types.Snapshotter
+} {
+	var calls []struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Snapshotter types.Snapshotter
+	}
+	mock.lockGetSignQueueSchedule.RLock()
+	calls = mock.calls.GetSignQueueSchedule
+	mock.lockGetSignQueueSchedule.RUnlock()
+	return calls
+}
+
 // GetSnapshotCounterForKeyID calls GetSnapshotCounterForKeyIDFunc.
 func (mock *TSSKeeperMock) GetSnapshotCounterForKeyID(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (int64, bool) {
 	if mock.GetSnapshotCounterForKeyIDFunc == nil {
@@ -3547,6 +3937,45 @@ func (mock *TSSKeeperMock) GetSnapshotCounterForKeyIDCalls() []struct {
 	return calls
 }
 
+// GetSnapshotDrift calls GetSnapshotDriftFunc.
+func (mock *TSSKeeperMock) GetSnapshotDrift(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, keyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID) (types.SnapshotDrift, error) {
+	if mock.GetSnapshotDriftFunc == nil {
+		panic("TSSKeeperMock.GetSnapshotDriftFunc: method is nil but TSSKeeper.GetSnapshotDrift was just called")
+	}
+	callInfo := struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Snapshotter types.Snapshotter
+		KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+	}{
+		Ctx:         ctx,
+		Snapshotter: snapshotter,
+		KeyID:       keyID,
+	}
+	mock.lockGetSnapshotDrift.Lock()
+	mock.calls.GetSnapshotDrift = append(mock.calls.GetSnapshotDrift, callInfo)
+	mock.lockGetSnapshotDrift.Unlock()
+	return mock.GetSnapshotDriftFunc(ctx, snapshotter, keyID)
+}
+
+// GetSnapshotDriftCalls gets all the calls that were made to GetSnapshotDrift.
+// Check the length with:
+//     len(mockedTSSKeeper.GetSnapshotDriftCalls())
+func (mock *TSSKeeperMock) GetSnapshotDriftCalls() []struct {
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Snapshotter types.Snapshotter
+	KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+} {
+	var calls []struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Snapshotter types.Snapshotter
+		KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID
+	}
+	mock.lockGetSnapshotDrift.RLock()
+	calls = mock.calls.GetSnapshotDrift
+	mock.lockGetSnapshotDrift.RUnlock()
+	return calls
+}
+
 // GetTssSuspendedUntil calls GetTssSuspendedUntilFunc.
 func (mock *TSSKeeperMock) GetTssSuspendedUntil(ctx github_com_cosmos_cosmos_sdk_types.Context, validator github_com_cosmos_cosmos_sdk_types.ValAddress) int64 {
 	if mock.GetTssSuspendedUntilFunc == nil {
@@ -3800,14 +4229,49 @@ func (mock *TSSKeeperMock) RotateKeyCalls() []struct {
 	return calls
 }
 
+// ScheduleSigns calls ScheduleSignsFunc.
+func (mock *TSSKeeperMock) ScheduleSigns(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter) []github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo {
+	if mock.ScheduleSignsFunc == nil {
+		panic("TSSKeeperMock.ScheduleSignsFunc: method is nil but TSSKeeper.ScheduleSigns was just called")
+	}
+	callInfo := struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Snapshotter types.Snapshotter
+	}{
+		Ctx:         ctx,
+		Snapshotter: snapshotter,
+	}
+	mock.lockScheduleSigns.Lock()
+	mock.calls.ScheduleSigns = append(mock.calls.ScheduleSigns, callInfo)
+	mock.lockScheduleSigns.Unlock()
+	return mock.ScheduleSignsFunc(ctx, snapshotter)
+}
+
+// ScheduleSignsCalls gets all the calls that were made to ScheduleSigns.
+// Check the length with:
+//     len(mockedTSSKeeper.ScheduleSignsCalls())
+func (mock *TSSKeeperMock) ScheduleSignsCalls() []struct {
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Snapshotter types.Snapshotter
+} {
+	var calls []struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Snapshotter types.Snapshotter
+	}
+	mock.lockScheduleSigns.RLock()
+	calls = mock.calls.ScheduleSigns
+	mock.lockScheduleSigns.RUnlock()
+	return calls
+}
+
 // SelectSignParticipants calls SelectSignParticipantsFunc.
-func (mock *TSSKeeperMock) SelectSignParticipants(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snap github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) ([]github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, []github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, error) {
+func (mock *TSSKeeperMock) SelectSignParticipants(ctx github_com_cosmos_cosmos_sdk_types.Context, snapshotter types.Snapshotter, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snap github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot, keyType github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType) ([]github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, []github_com_axelarnetwork_axelar_core_x_snapshot_exported.Validator, error) {
 	if mock.SelectSignParticipantsFunc == nil {
 		panic("TSSKeeperMock.SelectSignParticipantsFunc: method is nil but TSSKeeper.SelectSignParticipants was just called")
 	}
 	callInfo := struct {
 		Ctx         github_com_cosmos_cosmos_sdk_types.Context
-		Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
+		Snapshotter types.Snapshotter
 		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 		Snap        github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot
 		KeyType     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType
@@ -3829,14 +4293,14 @@ func (mock *TSSKeeperMock) SelectSignParticipants(ctx github_com_cosmos_cosmos_s
 //     len(mockedTSSKeeper.SelectSignParticipantsCalls())
 func (mock *TSSKeeperMock) SelectSignParticipantsCalls() []struct {
 	Ctx         github_com_cosmos_cosmos_sdk_types.Context
-	Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
+	Snapshotter types.Snapshotter
 	Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 	Snap        github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot
 	KeyType     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType
 } {
 	var calls []struct {
 		Ctx         github_com_cosmos_cosmos_sdk_types.Context
-		Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
+		Snapshotter types.Snapshotter
 		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
 		Snap        github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshot
 		KeyType     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyType
@@ -4073,6 +4537,41 @@ func (mock *TSSKeeperMock) SetKeyInfoCalls() []struct {
 	return calls
 }
 
+// SetKeyRotationAbort calls SetKeyRotationAbortFunc.
+func (mock *TSSKeeperMock) SetKeyRotationAbort(ctx github_com_cosmos_cosmos_sdk_types.Context, abort types.KeyRotationAbort) {
+	if mock.SetKeyRotationAbortFunc == nil {
+		panic("TSSKeeperMock.SetKeyRotationAbortFunc: method is nil but TSSKeeper.SetKeyRotationAbort was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Abort types.KeyRotationAbort
+	}{
+		Ctx:   ctx,
+		Abort: abort,
+	}
+	mock.lockSetKeyRotationAbort.Lock()
+	mock.calls.SetKeyRotationAbort = append(mock.calls.SetKeyRotationAbort, callInfo)
+	mock.lockSetKeyRotationAbort.Unlock()
+	mock.SetKeyRotationAbortFunc(ctx, abort)
+}
+
+// SetKeyRotationAbortCalls gets all the calls that were made to SetKeyRotationAbort.
+// Check the length with:
+//     len(mockedTSSKeeper.SetKeyRotationAbortCalls())
+func (mock *TSSKeeperMock) SetKeyRotationAbortCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	Abort types.KeyRotationAbort
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		Abort types.KeyRotationAbort
+	}
+	mock.lockSetKeyRotationAbort.RLock()
+	calls = mock.calls.SetKeyRotationAbort
+	mock.lockSetKeyRotationAbort.RUnlock()
+	return calls
+}
+
 // SetParams calls SetParamsFunc.
 func (mock *TSSKeeperMock) SetParams(ctx github_com_cosmos_cosmos_sdk_types.Context, p types.Params) {
 	if mock.SetParamsFunc == nil {
@@ -4151,6 +4650,41 @@ func (mock *TSSKeeperMock) SetPrivateRecoveryInfoCalls() []struct {
 	return calls
 }
 
+// SetScheduledKeyRotation calls SetScheduledKeyRotationFunc.
+func (mock *TSSKeeperMock) SetScheduledKeyRotation(ctx github_com_cosmos_cosmos_sdk_types.Context, rotation types.ScheduledKeyRotation) {
+	if mock.SetScheduledKeyRotationFunc == nil {
+		panic("TSSKeeperMock.SetScheduledKeyRotationFunc: method is nil but TSSKeeper.SetScheduledKeyRotation was just called")
+	}
+	callInfo := struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Rotation types.ScheduledKeyRotation
+	}{
+		Ctx:      ctx,
+		Rotation: rotation,
+	}
+	mock.lockSetScheduledKeyRotation.Lock()
+	mock.calls.SetScheduledKeyRotation = append(mock.calls.SetScheduledKeyRotation, callInfo)
+	mock.lockSetScheduledKeyRotation.Unlock()
+	mock.SetScheduledKeyRotationFunc(ctx, rotation)
+}
+
+// SetScheduledKeyRotationCalls gets all the calls that were made to SetScheduledKeyRotation.
+// Check the length with:
+//     len(mockedTSSKeeper.SetScheduledKeyRotationCalls())
+func (mock *TSSKeeperMock) SetScheduledKeyRotationCalls() []struct {
+	Ctx      github_com_cosmos_cosmos_sdk_types.Context
+	Rotation types.ScheduledKeyRotation
+} {
+	var calls []struct {
+		Ctx      github_com_cosmos_cosmos_sdk_types.Context
+		Rotation types.ScheduledKeyRotation
+	}
+	mock.lockSetScheduledKeyRotation.RLock()
+	calls = mock.calls.SetScheduledKeyRotation
+	mock.lockSetScheduledKeyRotation.RUnlock()
+	return calls
+}
+
 // SetSig calls SetSigFunc.
 func (mock *TSSKeeperMock) SetSig(ctx github_com_cosmos_cosmos_sdk_types.Context, signature github_com_axelarnetwork_axelar_core_x_tss_exported.Signature) {
 	if mock.SetSigFunc == nil {
@@ -4269,19 +4803,15 @@ func (mock *TSSKeeperMock) StartKeygenCalls() []struct {
 }
 
 // StartSign calls StartSignFunc.
-func (mock *TSSKeeperMock) StartSign(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter, voter interface {
-	InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
-}) error {
+func (mock *TSSKeeperMock) StartSign(ctx github_com_cosmos_cosmos_sdk_types.Context, info github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo, snapshotter types.Snapshotter, voter types.InitPoller) error {
 	if mock.StartSignFunc == nil {
 		panic("TSSKeeperMock.StartSignFunc: method is nil but TSSKeeper.StartSign was just called")
 	}
 	callInfo := struct {
 		Ctx         github_com_cosmos_cosmos_sdk_types.Context
 		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
-		Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
-		Voter       interface {
-			InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
-		}
+		Snapshotter types.Snapshotter
+		Voter       types.InitPoller
 	}{
 		Ctx:         ctx,
 		Info:        info,
@@ -4300,18 +4830,14 @@ func (mock *TSSKeeperMock) StartSign(ctx github_com_cosmos_cosmos_sdk_types.Cont
 func (mock *TSSKeeperMock) StartSignCalls() []struct {
 	Ctx         github_com_cosmos_cosmos_sdk_types.Context
 	Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
-	Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
-	Voter       interface {
-		InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
-	}
+	Snapshotter types.Snapshotter
+	Voter       types.InitPoller
 } {
 	var calls []struct {
 		Ctx         github_com_cosmos_cosmos_sdk_types.Context
 		Info        github_com_axelarnetwork_axelar_core_x_tss_exported.SignInfo
-		Snapshotter github_com_axelarnetwork_axelar_core_x_snapshot_exported.Snapshotter
-		Voter       interface {
-			InitializePollWithSnapshot(ctx github_com_cosmos_cosmos_sdk_types.Context, key exported1.PollKey, snapshotSeqNo int64, pollProperties ...exported1.PollProperty) error
-		}
+		Snapshotter types.Snapshotter
+		Voter       types.InitPoller
 	}
 	mock.lockStartSign.RLock()
 	calls = mock.calls.StartSign
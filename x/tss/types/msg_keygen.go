@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
@@ -57,6 +58,11 @@ func (m StartKeygenRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
 
+// GetRequiredRole implements permission.RestrictedMsg
+func (m StartKeygenRequest) GetRequiredRole() permission.Role {
+	return permission.KeyManagement
+}
+
 // Route implements the sdk.Msg interface.
 func (m ProcessKeygenTrafficRequest) Route() string { return RouterKey }
 
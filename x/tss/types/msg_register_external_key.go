@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
@@ -80,3 +81,8 @@ func (m RegisterExternalKeysRequest) GetSignBytes() []byte {
 func (m RegisterExternalKeysRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// GetRequiredRole implements permission.RestrictedMsg
+func (m RegisterExternalKeysRequest) GetRequiredRole() permission.Role {
+	return permission.KeyManagement
+}
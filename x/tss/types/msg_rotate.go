@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
 
@@ -58,3 +59,8 @@ func (m RotateKeyRequest) GetSignBytes() []byte {
 func (m RotateKeyRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// GetRequiredRole implements permission.RestrictedMsg
+func (m RotateKeyRequest) GetRequiredRole() permission.Role {
+	return permission.KeyManagement
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -24,8 +25,16 @@ var (
 	KeyExternalMultisigThreshold        = []byte("externalMultisigThreshold")
 	KeyMaxSignQueueSize                 = []byte("MaxSignQueueSize")
 	MaxSimultaneousSignShares           = []byte("MaxSimultaneousSignShares")
+	KeyKeyRotationPolicies              = []byte("KeyRotationPolicies")
+	KeySnapshotDriftWarningMargin       = []byte("SnapshotDriftWarningMargin")
+	KeyBlockSignBelowThreshold          = []byte("BlockSignBelowThreshold")
+	KeySignQueueWeights                 = []byte("SignQueueWeights")
+	KeyKeyRotationCooldown              = []byte("KeyRotationCooldown")
 )
 
+// DefaultSignQueueWeight is the weight of sign queue lane classes that have no weight configured
+const DefaultSignQueueWeight int64 = 1
+
 // KeyTable returns a subspace.KeyTable that has registered all parameter types in this module's parameter set
 func KeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
@@ -95,6 +104,15 @@ func DefaultParams() Params {
 		ExternalMultisigThreshold:        utils.Threshold{Numerator: 3, Denominator: 6},
 		MaxSignQueueSize:                 50,
 		MaxSimultaneousSignShares:        26,
+		KeyRotationPolicies:              []KeyRotationPolicy{},
+		SnapshotDriftWarningMargin:       utils.Threshold{Numerator: 1, Denominator: 10},
+		BlockSignBelowThreshold:          false,
+		SignQueueWeights: []SignQueueWeight{
+			{LaneClass: exported.KeyManagementLaneClass, Weight: 4},
+			{LaneClass: "bitcoin", Weight: 2},
+			{LaneClass: "evm", Weight: 1},
+		},
+		KeyRotationCooldown: 500,
 	}
 }
 
@@ -116,6 +134,11 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyExternalMultisigThreshold, &m.ExternalMultisigThreshold, validateExternalMultisigThreshold),
 		params.NewParamSetPair(KeyMaxSignQueueSize, &m.MaxSignQueueSize, validatePosInt64("MaxSignQueueSize")),
 		params.NewParamSetPair(MaxSimultaneousSignShares, &m.MaxSimultaneousSignShares, validatePosInt64("MaxSimultaneousSignShares")),
+		params.NewParamSetPair(KeyKeyRotationPolicies, &m.KeyRotationPolicies, validateKeyRotationPolicies),
+		params.NewParamSetPair(KeySnapshotDriftWarningMargin, &m.SnapshotDriftWarningMargin, validateSnapshotDriftWarningMargin),
+		params.NewParamSetPair(KeyBlockSignBelowThreshold, &m.BlockSignBelowThreshold, validateBool("BlockSignBelowThreshold")),
+		params.NewParamSetPair(KeySignQueueWeights, &m.SignQueueWeights, validateSignQueueWeights),
+		params.NewParamSetPair(KeyKeyRotationCooldown, &m.KeyRotationCooldown, validatePosInt64("KeyRotationCooldown")),
 	}
 }
 
@@ -153,6 +176,22 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateKeyRotationPolicies(m.KeyRotationPolicies); err != nil {
+		return err
+	}
+
+	if err := validateSnapshotDriftWarningMargin(m.SnapshotDriftWarningMargin); err != nil {
+		return err
+	}
+
+	if err := validateSignQueueWeights(m.SignQueueWeights); err != nil {
+		return err
+	}
+
+	if err := validatePosInt64("KeyRotationCooldown")(m.KeyRotationCooldown); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -248,3 +287,119 @@ func validateExternalMultisigThreshold(externalMultisigThreshold interface{}) er
 
 	return nil
 }
+
+func validateBool(field string) func(value interface{}) error {
+	return func(value interface{}) error {
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("invalid parameter type for %s: %T", field, value)
+		}
+
+		return nil
+	}
+}
+
+func validateSnapshotDriftWarningMargin(margin interface{}) error {
+	t, ok := margin.(utils.Threshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for SnapshotDriftWarningMargin: %T", margin)
+	}
+
+	if t.Numerator < 0 {
+		return fmt.Errorf("numerator must be >=0 for SnapshotDriftWarningMargin")
+	}
+
+	if t.Denominator <= 0 {
+		return fmt.Errorf("denominator must be a positive integer for SnapshotDriftWarningMargin")
+	}
+
+	if t.Numerator > t.Denominator {
+		return fmt.Errorf("threshold must be <=1 for SnapshotDriftWarningMargin")
+	}
+
+	return nil
+}
+
+func validateKeyRotationPolicies(keyRotationPolicies interface{}) error {
+	val, ok := keyRotationPolicies.([]KeyRotationPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for KeyRotationPolicies: %T", keyRotationPolicies)
+	}
+
+	seen := map[string]bool{}
+	for _, policy := range val {
+		key := fmt.Sprintf("%s_%s", strings.ToLower(policy.Chain), policy.KeyRole.SimpleString())
+		if seen[key] {
+			return fmt.Errorf("duplicate chain and key role found in KeyRotationPolicies")
+		}
+
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func validateSignQueueWeights(signQueueWeights interface{}) error {
+	val, ok := signQueueWeights.([]SignQueueWeight)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for SignQueueWeights: %T", signQueueWeights)
+	}
+
+	seen := map[string]bool{}
+	for _, weight := range val {
+		if weight.LaneClass == "" {
+			return fmt.Errorf("missing lane class for sign queue weight")
+		}
+
+		if seen[weight.LaneClass] {
+			return fmt.Errorf("duplicate lane class %s found in SignQueueWeights", weight.LaneClass)
+		}
+
+		if weight.Weight <= 0 {
+			return fmt.Errorf("weight of lane class %s must be >0", weight.LaneClass)
+		}
+
+		seen[weight.LaneClass] = true
+	}
+
+	return nil
+}
+
+// Validate returns an error if the key rotation policy is invalid
+func (m KeyRotationPolicy) Validate() error {
+	if m.Chain == "" {
+		return fmt.Errorf("missing chain name for key rotation policy")
+	}
+
+	switch m.KeyRole {
+	case exported.MasterKey, exported.SecondaryKey:
+	default:
+		return fmt.Errorf("key role %s cannot be rotated automatically", m.KeyRole.SimpleString())
+	}
+
+	if m.Period < 0 {
+		return fmt.Errorf("period must be >=0 for key rotation policy of chain %s", m.Chain)
+	}
+
+	if m.MaxSnapshotDrift.Numerator < 0 {
+		return fmt.Errorf("snapshot drift numerator must be >=0 for key rotation policy of chain %s", m.Chain)
+	}
+
+	if m.MaxSnapshotDrift.Denominator <= 0 {
+		return fmt.Errorf("snapshot drift denominator must be a positive integer for key rotation policy of chain %s", m.Chain)
+	}
+
+	if m.MaxSnapshotDrift.Numerator > m.MaxSnapshotDrift.Denominator {
+		return fmt.Errorf("snapshot drift must be <=1 for key rotation policy of chain %s", m.Chain)
+	}
+
+	return nil
+}
+
+// IsDisabled returns true if none of the triggers of the key rotation policy are enabled
+func (m KeyRotationPolicy) IsDisabled() bool {
+	return m.Period == 0 && m.MaxSnapshotDrift.Numerator == 0 && !m.RotateOnUnbonding
+}
@@ -41,6 +41,23 @@ type Params struct {
 	ExternalMultisigThreshold        utils.Threshold `protobuf:"bytes,7,opt,name=external_multisig_threshold,json=externalMultisigThreshold,proto3" json:"external_multisig_threshold"`
 	MaxSignQueueSize                 int64           `protobuf:"varint,8,opt,name=max_sign_queue_size,json=maxSignQueueSize,proto3" json:"max_sign_queue_size,omitempty"`
 	MaxSimultaneousSignShares        int64           `protobuf:"varint,9,opt,name=max_simultaneous_sign_shares,json=maxSimultaneousSignShares,proto3" json:"max_simultaneous_sign_shares,omitempty"`
+	// KeyRotationPolicies defines for which chains and key roles new keys are
+	// generated and rotated in automatically
+	KeyRotationPolicies []KeyRotationPolicy `protobuf:"bytes,10,rep,name=key_rotation_policies,json=keyRotationPolicies,proto3" json:"key_rotation_policies"`
+	// SnapshotDriftWarningMargin defines the fraction of a key's total share
+	// count above its signing threshold at which warnings about the key's
+	// snapshot drift are emitted
+	SnapshotDriftWarningMargin utils.Threshold `protobuf:"bytes,11,opt,name=snapshot_drift_warning_margin,json=snapshotDriftWarningMargin,proto3" json:"snapshot_drift_warning_margin"`
+	// BlockSignBelowThreshold rejects new sign requests for keys whose eligible
+	// share count no longer exceeds the corruption threshold
+	BlockSignBelowThreshold bool `protobuf:"varint,12,opt,name=block_sign_below_threshold,json=blockSignBelowThreshold,proto3" json:"block_sign_below_threshold,omitempty"`
+	// SignQueueWeights defines how many sign requests of each lane class are
+	// started relative to the other classes when the sign queue is congested
+	SignQueueWeights []SignQueueWeight `protobuf:"bytes,13,rep,name=sign_queue_weights,json=signQueueWeights,proto3" json:"sign_queue_weights"`
+	// KeyRotationCooldown defines the number of blocks to wait after an
+	// automatic key rotation has been aborted before the next one is attempted
+	// for the same chain and key role
+	KeyRotationCooldown int64 `protobuf:"varint,14,opt,name=key_rotation_cooldown,json=keyRotationCooldown,proto3" json:"key_rotation_cooldown,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -76,46 +93,157 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// KeyRotationPolicy defines when the key of the given role on the given chain
+// is rotated automatically. The key is rotated as soon as any of the enabled
+// triggers fires
+type KeyRotationPolicy struct {
+	Chain   string           `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyRole exported.KeyRole `protobuf:"varint,2,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+	// Period defines the number of blocks after the snapshot of the current key
+	// was taken at which a new key is generated, zero disables this trigger
+	Period int64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// MaxSnapshotDrift defines the maximum fraction of the current key's shares
+	// that may be held by validators that are no longer bonded or eligible
+	// before a new key is generated, a zero numerator disables this trigger
+	MaxSnapshotDrift utils.Threshold `protobuf:"bytes,4,opt,name=max_snapshot_drift,json=maxSnapshotDrift,proto3" json:"max_snapshot_drift"`
+	// RotateOnUnbonding triggers a new key as soon as any participant of the
+	// current key is no longer bonded
+	RotateOnUnbonding bool `protobuf:"varint,5,opt,name=rotate_on_unbonding,json=rotateOnUnbonding,proto3" json:"rotate_on_unbonding,omitempty"`
+}
+
+func (m *KeyRotationPolicy) Reset()         { *m = KeyRotationPolicy{} }
+func (m *KeyRotationPolicy) String() string { return proto.CompactTextString(m) }
+func (*KeyRotationPolicy) ProtoMessage()    {}
+func (*KeyRotationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67c9a42e8b26dfec, []int{1}
+}
+func (m *KeyRotationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRotationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotationPolicy.Merge(m, src)
+}
+func (m *KeyRotationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotationPolicy proto.InternalMessageInfo
+
+// SignQueueWeight defines the weight of a class of sign queue lanes in the
+// weighted round robin scheduling of sign requests. Every lane of the class
+// (e.g. every EVM chain) is scheduled with this weight
+type SignQueueWeight struct {
+	LaneClass string `protobuf:"bytes,1,opt,name=lane_class,json=laneClass,proto3" json:"lane_class,omitempty"`
+	Weight    int64  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *SignQueueWeight) Reset()         { *m = SignQueueWeight{} }
+func (m *SignQueueWeight) String() string { return proto.CompactTextString(m) }
+func (*SignQueueWeight) ProtoMessage()    {}
+func (*SignQueueWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67c9a42e8b26dfec, []int{2}
+}
+func (m *SignQueueWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignQueueWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignQueueWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignQueueWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignQueueWeight.Merge(m, src)
+}
+func (m *SignQueueWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignQueueWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignQueueWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignQueueWeight proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "tss.v1beta1.Params")
+	proto.RegisterType((*KeyRotationPolicy)(nil), "tss.v1beta1.KeyRotationPolicy")
+	proto.RegisterType((*SignQueueWeight)(nil), "tss.v1beta1.SignQueueWeight")
 }
 
 func init() { proto.RegisterFile("tss/v1beta1/params.proto", fileDescriptor_67c9a42e8b26dfec) }
 
 var fileDescriptor_67c9a42e8b26dfec = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x1c, 0xc5, 0x5b, 0x5a, 0x0a, 0xa4, 0x07, 0xa6, 0x80, 0x44, 0x56, 0x20, 0x54, 0x68, 0x87, 0x5e,
-	0x96, 0xb0, 0x71, 0xe4, 0x80, 0x54, 0xb8, 0x20, 0xd8, 0x54, 0x5a, 0x10, 0x12, 0x87, 0x59, 0x4e,
-	0xf3, 0x57, 0x62, 0x35, 0xb1, 0x33, 0xff, 0x6d, 0x9a, 0xee, 0x53, 0xf0, 0xb1, 0x7a, 0xdc, 0x11,
-	0x2e, 0x08, 0xda, 0x2f, 0x82, 0xec, 0xa4, 0x29, 0xdc, 0x76, 0x4a, 0xe2, 0xff, 0xef, 0x3d, 0x3f,
-	0xbf, 0xd8, 0xf1, 0x14, 0x62, 0xf8, 0xed, 0x24, 0x02, 0x45, 0x4f, 0xc2, 0x82, 0x4a, 0x9a, 0x63,
-	0x50, 0x48, 0xa1, 0x84, 0xdb, 0x57, 0x88, 0x41, 0x3d, 0x19, 0x3c, 0x4c, 0x44, 0x22, 0xec, 0x7a,
-	0x68, 0xde, 0x2a, 0x64, 0xf0, 0x54, 0x2b, 0x96, 0xed, 0xe5, 0x2a, 0x95, 0x80, 0xa9, 0xc8, 0xe2,
-	0x7a, 0x3c, 0x34, 0xde, 0x50, 0x16, 0x42, 0x2a, 0x88, 0xf7, 0xd4, 0xaa, 0x80, 0x7a, 0x8f, 0xe7,
-	0x3f, 0xbb, 0x4e, 0x6f, 0x62, 0x37, 0x75, 0x3f, 0x3b, 0x07, 0x0b, 0x58, 0x11, 0x09, 0x97, 0x9a,
-	0x49, 0xc8, 0x81, 0x2b, 0xf4, 0x6e, 0x0d, 0x3b, 0xa3, 0xfe, 0xe9, 0x51, 0x60, 0x92, 0xec, 0x7c,
-	0x76, 0x91, 0x82, 0xf7, 0xb0, 0x9a, 0xee, 0xe1, 0x71, 0x77, 0xfd, 0xeb, 0x59, 0x6b, 0x7a, 0x7f,
-	0xf1, 0xdf, 0x2a, 0xba, 0xaf, 0x9c, 0x01, 0x6a, 0x2c, 0x80, 0xc7, 0x24, 0xd6, 0x92, 0x2a, 0x26,
-	0x38, 0x61, 0x9c, 0x44, 0x99, 0x98, 0x2f, 0xd0, 0xeb, 0x0c, 0xdb, 0xa3, 0xce, 0xf4, 0x51, 0x4d,
-	0xbc, 0xad, 0x81, 0x77, 0x7c, 0x6c, 0xc7, 0x46, 0x9c, 0x02, 0x95, 0x2a, 0x02, 0xaa, 0x48, 0x01,
-	0x92, 0x89, 0xf8, 0x1f, 0x71, 0xb7, 0x12, 0x37, 0xc4, 0xc4, 0x02, 0x8d, 0xf8, 0xc2, 0x79, 0x92,
-	0xd3, 0x92, 0xe4, 0x0c, 0x11, 0xe2, 0x5a, 0x63, 0x4c, 0xc8, 0x92, 0xf1, 0x58, 0x2c, 0xbd, 0xdb,
-	0xc3, 0xf6, 0xa8, 0x7f, 0xea, 0x05, 0xb6, 0xc3, 0xe6, 0x54, 0x9f, 0x76, 0x1d, 0xd6, 0x07, 0xf2,
-	0x72, 0x5a, 0x9e, 0x59, 0x8b, 0xca, 0x76, 0x02, 0xf2, 0x8b, 0xd5, 0xbb, 0xe7, 0xce, 0x91, 0xe6,
-	0x91, 0xe0, 0x31, 0xe3, 0x09, 0x31, 0x33, 0xf3, 0xb4, 0x15, 0x0a, 0x55, 0x9d, 0x73, 0x2e, 0x34,
-	0x57, 0x5e, 0xcf, 0xc6, 0x1c, 0x36, 0xec, 0x87, 0x0a, 0x35, 0xf5, 0xd5, 0xe0, 0x1b, 0xc3, 0xb9,
-	0x17, 0xce, 0x63, 0x28, 0x15, 0x48, 0x4e, 0x33, 0x92, 0xeb, 0x4c, 0x31, 0x64, 0x09, 0x69, 0x7e,
-	0xa9, 0x77, 0xe7, 0x46, 0x71, 0x0f, 0x77, 0x16, 0x67, 0xb5, 0x43, 0x03, 0xb8, 0xc7, 0xce, 0x03,
-	0xd3, 0x07, 0xb2, 0x84, 0x93, 0x4b, 0x0d, 0x1a, 0x08, 0xb2, 0x2b, 0xf0, 0xee, 0xda, 0x78, 0x07,
-	0x39, 0x2d, 0x67, 0x2c, 0xe1, 0x1f, 0xcd, 0x60, 0xc6, 0xae, 0xc0, 0x7d, 0x5d, 0xd5, 0x87, 0xcc,
-	0x64, 0xa1, 0x1c, 0x84, 0xc6, 0x4a, 0x8b, 0x29, 0x95, 0x80, 0xde, 0x3d, 0xab, 0x3b, 0xb4, 0xba,
-	0x3d, 0x62, 0x3c, 0x66, 0x16, 0x18, 0x9f, 0xaf, 0xff, 0xf8, 0xad, 0xf5, 0xc6, 0x6f, 0x5f, 0x6f,
-	0xfc, 0xf6, 0xef, 0x8d, 0xdf, 0xfe, 0xbe, 0xf5, 0x5b, 0xd7, 0x5b, 0xbf, 0xf5, 0x63, 0xeb, 0xb7,
-	0xbe, 0xbe, 0x48, 0x98, 0x4a, 0x75, 0x14, 0xcc, 0x45, 0x1e, 0xd2, 0x12, 0x32, 0x2a, 0x39, 0xa8,
-	0xa5, 0x90, 0x8b, 0xfa, 0xeb, 0x78, 0x2e, 0x24, 0x84, 0x65, 0x68, 0xae, 0xb0, 0xbd, 0xb1, 0x51,
-	0xcf, 0x5e, 0xd9, 0x97, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xf5, 0x5a, 0x13, 0x32, 0x03,
-	0x00, 0x00,
+	// 778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0xdb, 0x36,
+	0x14, 0xb6, 0x9a, 0x34, 0x3f, 0x98, 0xad, 0x4d, 0x99, 0x6e, 0x65, 0xb3, 0x46, 0x13, 0x82, 0x1e,
+	0x7c, 0xa9, 0xbc, 0x66, 0x97, 0x01, 0x3b, 0x0c, 0x70, 0x7a, 0xd8, 0xb0, 0xa6, 0xf3, 0x94, 0x15,
+	0x19, 0x76, 0x28, 0x41, 0x4b, 0x6f, 0x12, 0x61, 0x89, 0x54, 0x49, 0x6a, 0xb6, 0xfb, 0x57, 0xec,
+	0xcf, 0xca, 0xb1, 0xc7, 0xed, 0x32, 0x6c, 0xc9, 0x5f, 0xb1, 0xdb, 0x40, 0x8a, 0x96, 0xed, 0x0c,
+	0x05, 0x72, 0xb2, 0xc9, 0xf7, 0xbd, 0x4f, 0xef, 0xfb, 0xde, 0x7b, 0x44, 0xc4, 0x68, 0x3d, 0xf8,
+	0xed, 0xf9, 0x18, 0x0c, 0x7b, 0x3e, 0xa8, 0x99, 0x62, 0x95, 0x8e, 0x6b, 0x25, 0x8d, 0xc4, 0x7b,
+	0x46, 0xeb, 0xd8, 0x47, 0x0e, 0x1f, 0xe6, 0x32, 0x97, 0xee, 0x7e, 0x60, 0xff, 0xb5, 0x90, 0xc3,
+	0xa3, 0xc6, 0xf0, 0x72, 0x99, 0x6e, 0x0a, 0x05, 0xba, 0x90, 0x65, 0xe6, 0xc3, 0x91, 0xe5, 0x86,
+	0x59, 0x2d, 0x95, 0x81, 0x6c, 0x89, 0x9a, 0xd7, 0xe0, 0xbf, 0x71, 0xfc, 0xe7, 0x36, 0xda, 0x1a,
+	0xb9, 0x8f, 0xe2, 0xd7, 0x68, 0x7f, 0x02, 0x73, 0xaa, 0xe0, 0x6d, 0xc3, 0x15, 0x54, 0x20, 0x8c,
+	0x26, 0x77, 0xa2, 0x8d, 0xfe, 0xde, 0xc9, 0xd3, 0xd8, 0x56, 0xb2, 0xe0, 0x59, 0x94, 0x14, 0x7f,
+	0x0f, 0xf3, 0x64, 0x09, 0x1e, 0x6e, 0x5e, 0xfe, 0xf5, 0x79, 0x2f, 0xb9, 0x3f, 0x59, 0xbb, 0xd5,
+	0xf8, 0x6b, 0x74, 0xa8, 0x1b, 0x5d, 0x83, 0xc8, 0x68, 0xd6, 0x28, 0x66, 0xb8, 0x14, 0x94, 0x0b,
+	0x3a, 0x2e, 0x65, 0x3a, 0xd1, 0x64, 0x23, 0x0a, 0xfa, 0x1b, 0xc9, 0x23, 0x8f, 0x78, 0xe1, 0x01,
+	0xdf, 0x89, 0xa1, 0x0b, 0xdb, 0xe4, 0x02, 0x98, 0x32, 0x63, 0x60, 0x86, 0xd6, 0xa0, 0xb8, 0xcc,
+	0x56, 0x92, 0x37, 0xdb, 0xe4, 0x0e, 0x31, 0x72, 0x80, 0x2e, 0xf9, 0x0d, 0x7a, 0x52, 0xb1, 0x19,
+	0xad, 0xb8, 0xd6, 0x90, 0xf9, 0x1c, 0x4b, 0x42, 0xa7, 0x5c, 0x64, 0x72, 0x4a, 0xee, 0x46, 0x41,
+	0x7f, 0xef, 0x84, 0xc4, 0xce, 0xc3, 0x4e, 0xd5, 0x4f, 0x0b, 0x0f, 0xbd, 0x20, 0x52, 0xb1, 0xd9,
+	0x99, 0xa3, 0x68, 0x69, 0x47, 0xa0, 0x2e, 0x5c, 0x3e, 0x7e, 0x85, 0x9e, 0x36, 0x62, 0x2c, 0x45,
+	0xc6, 0x45, 0x4e, 0x6d, 0xcc, 0xfe, 0x3a, 0x0b, 0xa5, 0x69, 0x75, 0xa6, 0xb2, 0x11, 0x86, 0x6c,
+	0xb9, 0x32, 0xa3, 0x0e, 0xfb, 0xb2, 0x85, 0x5a, 0xfb, 0x3c, 0xf0, 0xd4, 0xe2, 0xf0, 0x1b, 0xf4,
+	0x19, 0xcc, 0x0c, 0x28, 0xc1, 0x4a, 0x5a, 0x35, 0xa5, 0xe1, 0x9a, 0xe7, 0xb4, 0x6b, 0x29, 0xd9,
+	0xbe, 0x55, 0xb9, 0x8f, 0x17, 0x14, 0x67, 0x9e, 0xa1, 0x03, 0xe0, 0x67, 0xe8, 0xc0, 0xfa, 0xa1,
+	0x79, 0x2e, 0xe8, 0xdb, 0x06, 0x1a, 0xa0, 0x9a, 0xbf, 0x03, 0xb2, 0xe3, 0xca, 0xdb, 0xaf, 0xd8,
+	0xec, 0x9c, 0xe7, 0xe2, 0x47, 0x1b, 0x38, 0xe7, 0xef, 0x00, 0x7f, 0xd3, 0xda, 0xa7, 0xb9, 0xad,
+	0x85, 0x09, 0x90, 0x8d, 0x6e, 0x73, 0x75, 0xc1, 0x14, 0x68, 0xb2, 0xeb, 0xf2, 0x1e, 0xbb, 0xbc,
+	0x25, 0xc4, 0x72, 0x9c, 0x3b, 0x00, 0xfe, 0x19, 0x7d, 0xb2, 0xe6, 0x46, 0x2d, 0x4b, 0x9e, 0x72,
+	0xd0, 0x04, 0xb9, 0xa9, 0x0a, 0xe3, 0x95, 0xf9, 0x8e, 0x57, 0xdc, 0x18, 0x59, 0xdc, 0xdc, 0xeb,
+	0x39, 0x98, 0xdc, 0x08, 0x70, 0xd0, 0x98, 0xa1, 0x23, 0x2d, 0x58, 0xad, 0x0b, 0x69, 0x68, 0xa6,
+	0xf8, 0xaf, 0x86, 0x4e, 0x99, 0x12, 0xd6, 0xfe, 0x8a, 0xa9, 0x9c, 0x0b, 0xb2, 0x77, 0x2b, 0xaf,
+	0x0e, 0x17, 0x24, 0x2f, 0x2c, 0xc7, 0x45, 0x4b, 0x71, 0xe6, 0x18, 0xec, 0xe4, 0xb9, 0x89, 0x69,
+	0x25, 0x8f, 0xa1, 0x94, 0xd3, 0x95, 0x5e, 0x7c, 0x14, 0x05, 0xfd, 0x9d, 0xe4, 0x91, 0x43, 0x58,
+	0xc5, 0x43, 0x1b, 0x5f, 0x3a, 0x3d, 0x42, 0x78, 0xc5, 0xe5, 0x29, 0xf0, 0xbc, 0x30, 0x9a, 0x7c,
+	0xec, 0x64, 0x3f, 0x59, 0x93, 0xdd, 0x59, 0x7e, 0xe1, 0x40, 0xbe, 0xb0, 0x7d, 0xbd, 0x7e, 0xad,
+	0xf1, 0xc9, 0x0d, 0x2f, 0x53, 0x29, 0xcb, 0x4c, 0x4e, 0x05, 0xb9, 0xe7, 0xba, 0xb0, 0xea, 0xd2,
+	0xa9, 0x0f, 0x1d, 0xff, 0x1b, 0xa0, 0x07, 0xff, 0xb3, 0x15, 0x3f, 0x44, 0x77, 0xd3, 0x82, 0x71,
+	0x41, 0x82, 0x28, 0xe8, 0xef, 0x26, 0xed, 0x01, 0x7f, 0x85, 0x76, 0x5a, 0xfe, 0x12, 0xc8, 0x9d,
+	0x28, 0xe8, 0xdf, 0x3b, 0x39, 0xfa, 0xf0, 0xd2, 0xcb, 0x12, 0x92, 0xed, 0x49, 0xfb, 0x07, 0x7f,
+	0x8a, 0xb6, 0xda, 0xc5, 0xf4, 0xbb, 0xec, 0x4f, 0xf8, 0x25, 0xc2, 0x6e, 0x7c, 0xd6, 0xfa, 0x44,
+	0x36, 0x6f, 0xd5, 0x18, 0x37, 0x8c, 0xab, 0xbd, 0xc1, 0x31, 0x3a, 0x70, 0xda, 0x81, 0x4a, 0x41,
+	0xbb, 0x4d, 0x72, 0x2b, 0xbc, 0x93, 0x3c, 0x68, 0x43, 0x3f, 0x88, 0xd7, 0x8b, 0xc0, 0xf1, 0xb7,
+	0xe8, 0xfe, 0x0d, 0x6b, 0xf1, 0x11, 0x42, 0x25, 0x13, 0x40, 0xd3, 0x92, 0x69, 0xed, 0xd5, 0xef,
+	0xda, 0x9b, 0x53, 0x7b, 0x61, 0x75, 0xb4, 0x8d, 0x72, 0xfa, 0x37, 0x12, 0x7f, 0x1a, 0xbe, 0xba,
+	0xfc, 0x27, 0xec, 0x5d, 0x5e, 0x85, 0xc1, 0xfb, 0xab, 0x30, 0xf8, 0xfb, 0x2a, 0x0c, 0x7e, 0xbf,
+	0x0e, 0x7b, 0xef, 0xaf, 0xc3, 0xde, 0x1f, 0xd7, 0x61, 0xef, 0x97, 0x2f, 0x72, 0x6e, 0x8a, 0x66,
+	0x1c, 0xa7, 0xb2, 0x1a, 0xb0, 0x19, 0x94, 0x4c, 0x09, 0x30, 0x53, 0xa9, 0x26, 0xfe, 0xf4, 0x2c,
+	0x95, 0x0a, 0x06, 0xb3, 0x81, 0x7d, 0x88, 0xdd, 0xbb, 0x3b, 0xde, 0x72, 0x0f, 0xef, 0x97, 0xff,
+	0x0d, 0x00, 0xb2, 0x7b, 0xf4, 0xf9, 0xf8, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +266,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyRotationCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyRotationCooldown))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.SignQueueWeights) > 0 {
+		for iNdEx := len(m.SignQueueWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignQueueWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.BlockSignBelowThreshold {
+		i--
+		if m.BlockSignBelowThreshold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.SnapshotDriftWarningMargin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.KeyRotationPolicies) > 0 {
+		for iNdEx := len(m.KeyRotationPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotationPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.MaxSimultaneousSignShares != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxSimultaneousSignShares))
 		i--
@@ -200,6 +381,101 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeyRotationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RotateOnUnbonding {
+		i--
+		if m.RotateOnUnbonding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.MaxSnapshotDrift.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Period != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignQueueWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignQueueWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignQueueWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LaneClass) > 0 {
+		i -= len(m.LaneClass)
+		copy(dAtA[i:], m.LaneClass)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.LaneClass)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -242,6 +518,66 @@ func (m *Params) Size() (n int) {
 	if m.MaxSimultaneousSignShares != 0 {
 		n += 1 + sovParams(uint64(m.MaxSimultaneousSignShares))
 	}
+	if len(m.KeyRotationPolicies) > 0 {
+		for _, e := range m.KeyRotationPolicies {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.SnapshotDriftWarningMargin.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BlockSignBelowThreshold {
+		n += 2
+	}
+	if len(m.SignQueueWeights) > 0 {
+		for _, e := range m.SignQueueWeights {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.KeyRotationCooldown != 0 {
+		n += 1 + sovParams(uint64(m.KeyRotationCooldown))
+	}
+	return n
+}
+
+func (m *KeyRotationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovParams(uint64(m.KeyRole))
+	}
+	if m.Period != 0 {
+		n += 1 + sovParams(uint64(m.Period))
+	}
+	l = m.MaxSnapshotDrift.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RotateOnUnbonding {
+		n += 2
+	}
+	return n
+}
+
+func (m *SignQueueWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LaneClass)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovParams(uint64(m.Weight))
+	}
 	return n
 }
 
@@ -475,6 +811,420 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotationPolicies = append(m.KeyRotationPolicies, KeyRotationPolicy{})
+			if err := m.KeyRotationPolicies[len(m.KeyRotationPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotDriftWarningMargin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SnapshotDriftWarningMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSignBelowThreshold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockSignBelowThreshold = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignQueueWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignQueueWeights = append(m.SignQueueWeights, SignQueueWeight{})
+			if err := m.SignQueueWeights[len(m.SignQueueWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationCooldown", wireType)
+			}
+			m.KeyRotationCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRotationCooldown |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRotationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRotationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRotationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotDrift", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSnapshotDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotateOnUnbonding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RotateOnUnbonding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignQueueWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignQueueWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignQueueWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaneClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LaneClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/types"
 )
 
@@ -13,3 +15,93 @@ func TestDefaultParams(t *testing.T) {
 
 	assert.NoError(t, params.Validate())
 }
+
+func TestValidateKeyRotationPolicies(t *testing.T) {
+	policy := func() types.KeyRotationPolicy {
+		return types.KeyRotationPolicy{
+			Chain:             "Ethereum",
+			KeyRole:           exported.SecondaryKey,
+			Period:            1000,
+			MaxSnapshotDrift:  utils.NewThreshold(1, 3),
+			RotateOnUnbonding: true,
+		}
+	}
+
+	t.Run("valid policies", func(t *testing.T) {
+		params := types.DefaultParams()
+		master := policy()
+		master.KeyRole = exported.MasterKey
+		params.KeyRotationPolicies = []types.KeyRotationPolicy{policy(), master}
+
+		assert.NoError(t, params.Validate())
+	})
+
+	t.Run("disabled triggers", func(t *testing.T) {
+		p := policy()
+		p.Period = 0
+		p.MaxSnapshotDrift = utils.NewThreshold(0, 1)
+		p.RotateOnUnbonding = false
+
+		assert.NoError(t, p.Validate())
+		assert.True(t, p.IsDisabled())
+	})
+
+	t.Run("invalid policies", func(t *testing.T) {
+		invalid := []func(p *types.KeyRotationPolicy){
+			func(p *types.KeyRotationPolicy) { p.Chain = "" },
+			func(p *types.KeyRotationPolicy) { p.KeyRole = exported.ExternalKey },
+			func(p *types.KeyRotationPolicy) { p.Period = -1 },
+			func(p *types.KeyRotationPolicy) { p.MaxSnapshotDrift = utils.NewThreshold(-1, 3) },
+			func(p *types.KeyRotationPolicy) { p.MaxSnapshotDrift = utils.NewThreshold(1, 0) },
+			func(p *types.KeyRotationPolicy) { p.MaxSnapshotDrift = utils.NewThreshold(4, 3) },
+		}
+
+		for _, modify := range invalid {
+			params := types.DefaultParams()
+			p := policy()
+			modify(&p)
+			params.KeyRotationPolicies = []types.KeyRotationPolicy{p}
+
+			assert.Error(t, params.Validate())
+		}
+	})
+
+	t.Run("duplicate chain and key role", func(t *testing.T) {
+		params := types.DefaultParams()
+		duplicate := policy()
+		duplicate.Chain = "ethereum"
+		params.KeyRotationPolicies = []types.KeyRotationPolicy{policy(), duplicate}
+
+		assert.Error(t, params.Validate())
+	})
+}
+
+func TestValidateSnapshotDriftWarningMargin(t *testing.T) {
+	invalid := []utils.Threshold{
+		utils.NewThreshold(-1, 10),
+		utils.NewThreshold(1, 0),
+		utils.NewThreshold(11, 10),
+	}
+
+	for _, margin := range invalid {
+		params := types.DefaultParams()
+		params.SnapshotDriftWarningMargin = margin
+
+		assert.Error(t, params.Validate())
+	}
+}
+
+func TestValidateSignQueueWeights(t *testing.T) {
+	invalid := [][]types.SignQueueWeight{
+		{{LaneClass: "", Weight: 1}},
+		{{LaneClass: "evm", Weight: 0}},
+		{{LaneClass: "evm", Weight: 1}, {LaneClass: "evm", Weight: 2}},
+	}
+
+	for _, weights := range invalid {
+		params := types.DefaultParams()
+		params.SignQueueWeights = weights
+
+		assert.Error(t, params.Validate())
+	}
+}
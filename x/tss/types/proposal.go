@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+)
+
+// proposal types
+const (
+	ProposalTypeRotateKey            = "RotateKey"
+	ProposalTypeUpdateKeyRequirement = "UpdateKeyRequirement"
+)
+
+var (
+	_ govtypes.Content = &RotateKeyProposal{}
+	_ govtypes.Content = &UpdateKeyRequirementProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRotateKey)
+	govtypes.RegisterProposalTypeCodec(&RotateKeyProposal{}, "tss/RotateKeyProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateKeyRequirement)
+	govtypes.RegisterProposalTypeCodec(&UpdateKeyRequirementProposal{}, "tss/UpdateKeyRequirementProposal")
+}
+
+// NewRotateKeyProposal is the constructor for RotateKeyProposal
+func NewRotateKeyProposal(title, description, chain string, keyRole exported.KeyRole, keyID exported.KeyID) *RotateKeyProposal {
+	return &RotateKeyProposal{
+		Title:       title,
+		Description: description,
+		Chain:       chain,
+		KeyRole:     keyRole,
+		KeyID:       keyID,
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (m RotateKeyProposal) GetTitle() string { return m.Title }
+
+// GetDescription implements govtypes.Content
+func (m RotateKeyProposal) GetDescription() string { return m.Description }
+
+// ProposalRoute implements govtypes.Content
+func (m RotateKeyProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (m RotateKeyProposal) ProposalType() string { return ProposalTypeRotateKey }
+
+// ValidateBasic implements govtypes.Content
+func (m RotateKeyProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	return m.ToRequest().ValidateBasic()
+}
+
+// String implements govtypes.Content
+func (m RotateKeyProposal) String() string {
+	return fmt.Sprintf(`Rotate Key Proposal:
+  Title:       %s
+  Description: %s
+  Chain:       %s
+  Key Role:    %s
+  Key ID:      %s
+`, m.Title, m.Description, m.Chain, m.KeyRole.SimpleString(), m.KeyID)
+}
+
+// ToRequest returns the RotateKeyRequest that is executed on behalf of the gov module when the proposal passes
+func (m RotateKeyProposal) ToRequest() *RotateKeyRequest {
+	return NewRotateKeyRequest(authtypes.NewModuleAddress(govtypes.ModuleName), m.Chain, m.KeyRole, string(m.KeyID))
+}
+
+// NewUpdateKeyRequirementProposal is the constructor for UpdateKeyRequirementProposal
+func NewUpdateKeyRequirementProposal(title, description string, keyRequirement exported.KeyRequirement) *UpdateKeyRequirementProposal {
+	return &UpdateKeyRequirementProposal{
+		Title:          title,
+		Description:    description,
+		KeyRequirement: keyRequirement,
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (m UpdateKeyRequirementProposal) GetTitle() string { return m.Title }
+
+// GetDescription implements govtypes.Content
+func (m UpdateKeyRequirementProposal) GetDescription() string { return m.Description }
+
+// ProposalRoute implements govtypes.Content
+func (m UpdateKeyRequirementProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (m UpdateKeyRequirementProposal) ProposalType() string {
+	return ProposalTypeUpdateKeyRequirement
+}
+
+// ValidateBasic implements govtypes.Content
+func (m UpdateKeyRequirementProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	return m.KeyRequirement.Validate()
+}
+
+// String implements govtypes.Content
+func (m UpdateKeyRequirementProposal) String() string {
+	return fmt.Sprintf(`Update Key Requirement Proposal:
+  Title:           %s
+  Description:     %s
+  Key Requirement: %s
+`, m.Title, m.Description, m.KeyRequirement.String())
+}
+
+// UpdateKeyRequirement returns a copy of the given params with the proposal's key requirement
+// replacing the one for the same key role and key type, or added if there was none
+func (m UpdateKeyRequirementProposal) UpdateKeyRequirement(params Params) Params {
+	keyRequirements := make([]exported.KeyRequirement, 0, len(params.KeyRequirements)+1)
+	replaced := false
+	for _, keyRequirement := range params.KeyRequirements {
+		if keyRequirement.KeyRole == m.KeyRequirement.KeyRole && keyRequirement.KeyType == m.KeyRequirement.KeyType {
+			keyRequirement = m.KeyRequirement
+			replaced = true
+		}
+
+		keyRequirements = append(keyRequirements, keyRequirement)
+	}
+
+	if !replaced {
+		keyRequirements = append(keyRequirements, m.KeyRequirement)
+	}
+
+	params.KeyRequirements = keyRequirements
+	return params
+}
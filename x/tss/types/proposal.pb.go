@@ -0,0 +1,739 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tss/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RotateKeyProposal is a gov proposal to rotate a chain's key of the given role
+// to the given key
+type RotateKeyProposal struct {
+	Title       string                                                    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Chain       string                                                    `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyRole     exported.KeyRole                                          `protobuf:"varint,4,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+	KeyID       github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,5,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+}
+
+func (m *RotateKeyProposal) Reset()      { *m = RotateKeyProposal{} }
+func (*RotateKeyProposal) ProtoMessage() {}
+func (*RotateKeyProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8699aaa9925130e2, []int{0}
+}
+func (m *RotateKeyProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateKeyProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateKeyProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateKeyProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKeyProposal.Merge(m, src)
+}
+func (m *RotateKeyProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateKeyProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKeyProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateKeyProposal proto.InternalMessageInfo
+
+// UpdateKeyRequirementProposal is a gov proposal to add or replace the key
+// requirement for a key role and key type
+type UpdateKeyRequirementProposal struct {
+	Title          string                  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	KeyRequirement exported.KeyRequirement `protobuf:"bytes,3,opt,name=key_requirement,json=keyRequirement,proto3" json:"key_requirement"`
+}
+
+func (m *UpdateKeyRequirementProposal) Reset()      { *m = UpdateKeyRequirementProposal{} }
+func (*UpdateKeyRequirementProposal) ProtoMessage() {}
+func (*UpdateKeyRequirementProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8699aaa9925130e2, []int{1}
+}
+func (m *UpdateKeyRequirementProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateKeyRequirementProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateKeyRequirementProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateKeyRequirementProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateKeyRequirementProposal.Merge(m, src)
+}
+func (m *UpdateKeyRequirementProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateKeyRequirementProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateKeyRequirementProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateKeyRequirementProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*RotateKeyProposal)(nil), "tss.v1beta1.RotateKeyProposal")
+	proto.RegisterType((*UpdateKeyRequirementProposal)(nil), "tss.v1beta1.UpdateKeyRequirementProposal")
+}
+
+func init() { proto.RegisterFile("tss/v1beta1/proposal.proto", fileDescriptor_8699aaa9925130e2) }
+
+var fileDescriptor_8699aaa9925130e2 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x3d, 0x4f, 0xf2, 0x50,
+	0x18, 0x6d, 0x79, 0x29, 0xef, 0xfb, 0x5e, 0x12, 0x8c, 0x0d, 0x43, 0x43, 0xb4, 0x6d, 0x88, 0x03,
+	0x8b, 0xad, 0xe0, 0xa2, 0x8e, 0xc4, 0x98, 0x10, 0x12, 0x63, 0x6a, 0x5c, 0x4c, 0x8c, 0x29, 0xed,
+	0x13, 0x68, 0x5a, 0x7a, 0xeb, 0xed, 0x83, 0xd2, 0xcd, 0x9f, 0xe0, 0xe8, 0xe8, 0x5f, 0xf0, 0x5f,
+	0x30, 0x32, 0x3a, 0x11, 0x2d, 0xff, 0xc2, 0xc9, 0xf4, 0x16, 0x04, 0x07, 0x07, 0xe3, 0x76, 0x9f,
+	0x8f, 0x73, 0xee, 0x39, 0x27, 0x0f, 0xa9, 0x61, 0x1c, 0x9b, 0xb7, 0xcd, 0x1e, 0xa0, 0xdd, 0x34,
+	0x23, 0x46, 0x23, 0x1a, 0xdb, 0x81, 0x11, 0x31, 0x8a, 0x54, 0x2e, 0x63, 0x1c, 0x1b, 0x8b, 0x59,
+	0xad, 0xda, 0xa7, 0x7d, 0xca, 0xfb, 0x66, 0xf6, 0xca, 0x57, 0x6a, 0x7a, 0x06, 0x87, 0x71, 0x44,
+	0x19, 0x82, 0xfb, 0xc9, 0x83, 0x49, 0x04, 0x71, 0xbe, 0x51, 0xbf, 0x2f, 0x90, 0x4d, 0x8b, 0xa2,
+	0x8d, 0xd0, 0x85, 0xe4, 0x6c, 0xf1, 0x81, 0x5c, 0x25, 0x12, 0x7a, 0x18, 0x80, 0x22, 0xea, 0x62,
+	0xe3, 0xbf, 0x95, 0x17, 0xb2, 0x4e, 0xca, 0x2e, 0xc4, 0x0e, 0xf3, 0x22, 0xf4, 0x68, 0xa8, 0x14,
+	0xf8, 0x6c, 0xbd, 0x95, 0xe1, 0x9c, 0x81, 0xed, 0x85, 0xca, 0x9f, 0x1c, 0xc7, 0x0b, 0xf9, 0x80,
+	0xfc, 0xf3, 0x21, 0xb9, 0x66, 0x34, 0x00, 0xa5, 0xa8, 0x8b, 0x8d, 0x4a, 0x6b, 0xdb, 0xc8, 0xb4,
+	0x2f, 0x85, 0x2d, 0x4d, 0x18, 0x5d, 0x48, 0x2c, 0x1a, 0x80, 0xf5, 0xd7, 0xcf, 0x1f, 0xf2, 0x15,
+	0x29, 0x65, 0x48, 0xcf, 0x55, 0xa4, 0x8c, 0xb0, 0x7d, 0x92, 0xce, 0x34, 0xa9, 0x0b, 0x49, 0xe7,
+	0xf8, 0x7d, 0xa6, 0x1d, 0xf6, 0x3d, 0x1c, 0x8c, 0x7a, 0x86, 0x43, 0x87, 0xa6, 0x3d, 0x86, 0xc0,
+	0x66, 0x21, 0xe0, 0x1d, 0x65, 0xfe, 0xa2, 0xda, 0x75, 0x28, 0x03, 0x73, 0x6c, 0xae, 0x67, 0x60,
+	0x70, 0xb0, 0x25, 0xf9, 0x90, 0x74, 0xdc, 0xa3, 0xe2, 0xe3, 0x93, 0x26, 0xd4, 0x9f, 0x45, 0xb2,
+	0x75, 0x11, 0xb9, 0x79, 0x04, 0x16, 0xdc, 0x8c, 0x3c, 0x06, 0x43, 0x08, 0xf1, 0xd7, 0x69, 0x9c,
+	0x93, 0x0d, 0xee, 0x7b, 0x45, 0xc9, 0x73, 0x29, 0xb7, 0x76, 0xbe, 0xb7, 0xbf, 0xda, 0x6d, 0x17,
+	0x27, 0x33, 0x4d, 0xb0, 0x2a, 0xfe, 0x97, 0x6e, 0xae, 0xb9, 0x7d, 0x3a, 0x79, 0x53, 0x85, 0x49,
+	0xaa, 0x8a, 0xd3, 0x54, 0x15, 0x5f, 0x53, 0x55, 0x7c, 0x98, 0xab, 0xc2, 0x74, 0xae, 0x0a, 0x2f,
+	0x73, 0x55, 0xb8, 0xdc, 0xfb, 0x41, 0x32, 0xfc, 0x18, 0x7a, 0x25, 0x7e, 0x0d, 0xfb, 0x1f, 0x03,
+	0x00, 0x7d, 0x23, 0xfc, 0x71, 0x70, 0x02, 0x00, 0x00,
+}
+
+func (m *RotateKeyProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateKeyProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateKeyProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateKeyRequirementProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateKeyRequirementProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateKeyRequirementProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.KeyRequirement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RotateKeyProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovProposal(uint64(m.KeyRole))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateKeyRequirementProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.KeyRequirement.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RotateKeyProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKeyProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKeyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateKeyRequirementProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateKeyRequirementProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateKeyRequirementProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRequirement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
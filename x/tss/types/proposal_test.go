@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestUpdateKeyRequirementProposal_UpdateKeyRequirement(t *testing.T) {
+	t.Run("should replace the key requirement with the same key role and key type", func(t *testing.T) {
+		params := types.DefaultParams()
+		keyRequirement := params.KeyRequirements[0]
+		keyRequirement.KeygenTimeout++
+		keyRequirement.SignTimeout++
+
+		proposal := types.NewUpdateKeyRequirementProposal("title", "description", keyRequirement)
+		assert.NoError(t, proposal.ValidateBasic())
+
+		updated := proposal.UpdateKeyRequirement(params)
+		assert.NoError(t, updated.Validate())
+		assert.Len(t, updated.KeyRequirements, len(params.KeyRequirements))
+		assert.Equal(t, keyRequirement, updated.KeyRequirements[0])
+		assert.Equal(t, params.KeyRequirements[1:], updated.KeyRequirements[1:])
+		assert.NotEqual(t, keyRequirement, params.KeyRequirements[0])
+	})
+
+	t.Run("should add a key requirement for a new key role and key type", func(t *testing.T) {
+		params := types.DefaultParams()
+		var keyRequirements []exported.KeyRequirement
+		for _, keyRequirement := range params.KeyRequirements {
+			if keyRequirement.KeyRole != exported.MasterKey {
+				keyRequirements = append(keyRequirements, keyRequirement)
+			}
+		}
+		removed := len(params.KeyRequirements) - len(keyRequirements)
+		assert.Greater(t, removed, 0)
+
+		var masterKeyRequirement exported.KeyRequirement
+		for _, keyRequirement := range params.KeyRequirements {
+			if keyRequirement.KeyRole == exported.MasterKey {
+				masterKeyRequirement = keyRequirement
+				break
+			}
+		}
+		params.KeyRequirements = keyRequirements
+
+		updated := types.NewUpdateKeyRequirementProposal("title", "description", masterKeyRequirement).UpdateKeyRequirement(params)
+		assert.NoError(t, updated.Validate())
+		assert.Len(t, updated.KeyRequirements, len(keyRequirements)+1)
+		assert.Equal(t, masterKeyRequirement, updated.KeyRequirements[len(keyRequirements)])
+	})
+
+	t.Run("should reject invalid key requirements", func(t *testing.T) {
+		keyRequirement := types.DefaultParams().KeyRequirements[0]
+		keyRequirement.KeyRole = exported.KeyRole(-1)
+
+		assert.Error(t, types.NewUpdateKeyRequirementProposal("title", "description", keyRequirement).ValidateBasic())
+	})
+}
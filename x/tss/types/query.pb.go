@@ -8,6 +8,7 @@ import (
 	exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	github_com_axelarnetwork_axelar_core_x_tss_exported "github.com/axelarnetwork/axelar-core/x/tss/exported"
 	tofnd "github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -509,6 +510,7 @@ var xxx_messageInfo_QueryRecoveryResponse proto.InternalMessageInfo
 
 type QueryKeyShareResponse struct {
 	ShareInfos []QueryKeyShareResponse_ShareInfo `protobuf:"bytes,1,rep,name=share_infos,json=shareInfos,proto3" json:"share_infos"`
+	Pagination *query.PageResponse               `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryKeyShareResponse) Reset()         { *m = QueryKeyShareResponse{} }
@@ -588,7 +590,8 @@ func (m *QueryKeyShareResponse_ShareInfo) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryKeyShareResponse_ShareInfo proto.InternalMessageInfo
 
 type QueryDeactivatedOperatorsResponse struct {
-	OperatorAddresses []string `protobuf:"bytes,1,rep,name=operator_addresses,json=operatorAddresses,proto3" json:"operator_addresses,omitempty"`
+	OperatorAddresses []string            `protobuf:"bytes,1,rep,name=operator_addresses,json=operatorAddresses,proto3" json:"operator_addresses,omitempty"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryDeactivatedOperatorsResponse) Reset()         { *m = QueryDeactivatedOperatorsResponse{} }
@@ -625,7 +628,8 @@ func (m *QueryDeactivatedOperatorsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryDeactivatedOperatorsResponse proto.InternalMessageInfo
 
 type QueryActiveOldKeysValidatorResponse struct {
-	KeysInfo []QueryActiveOldKeysValidatorResponse_KeyInfo `protobuf:"bytes,1,rep,name=keys_info,json=keysInfo,proto3" json:"keys_info"`
+	KeysInfo   []QueryActiveOldKeysValidatorResponse_KeyInfo `protobuf:"bytes,1,rep,name=keys_info,json=keysInfo,proto3" json:"keys_info"`
+	Pagination *query.PageResponse                           `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryActiveOldKeysValidatorResponse) Reset()         { *m = QueryActiveOldKeysValidatorResponse{} }
@@ -705,7 +709,8 @@ func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryActiveOldKeysValidatorResponse_KeyInfo proto.InternalMessageInfo
 
 type QueryActiveOldKeysResponse struct {
-	KeyIDs []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,rep,name=key_ids,json=keyIds,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_ids,omitempty"`
+	KeyIDs     []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,rep,name=key_ids,json=keyIds,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_ids,omitempty"`
+	Pagination *query.PageResponse                                         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryActiveOldKeysResponse) Reset()         { *m = QueryActiveOldKeysResponse{} }
@@ -742,7 +747,8 @@ func (m *QueryActiveOldKeysResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryActiveOldKeysResponse proto.InternalMessageInfo
 
 type QueryExternalKeyIDResponse struct {
-	KeyIDs []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,rep,name=key_ids,json=keyIds,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_ids,omitempty"`
+	KeyIDs     []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,rep,name=key_ids,json=keyIds,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_ids,omitempty"`
+	Pagination *query.PageResponse                                         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryExternalKeyIDResponse) Reset()         { *m = QueryExternalKeyIDResponse{} }
@@ -853,515 +859,774 @@ func (m *QueryNextKeyIDResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryNextKeyIDResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("tss.v1beta1.VoteStatus", VoteStatus_name, VoteStatus_value)
-	proto.RegisterType((*QuerySignatureResponse)(nil), "tss.v1beta1.QuerySignatureResponse")
-	proto.RegisterType((*QuerySignatureResponse_Signature)(nil), "tss.v1beta1.QuerySignatureResponse.Signature")
-	proto.RegisterType((*QuerySignatureResponse_ThresholdSignature)(nil), "tss.v1beta1.QuerySignatureResponse.ThresholdSignature")
-	proto.RegisterType((*QuerySignatureResponse_MultisigSignature)(nil), "tss.v1beta1.QuerySignatureResponse.MultisigSignature")
-	proto.RegisterType((*QueryKeyResponse)(nil), "tss.v1beta1.QueryKeyResponse")
-	proto.RegisterType((*QueryKeyResponse_Key)(nil), "tss.v1beta1.QueryKeyResponse.Key")
-	proto.RegisterType((*QueryKeyResponse_ECDSAKey)(nil), "tss.v1beta1.QueryKeyResponse.ECDSAKey")
-	proto.RegisterType((*QueryKeyResponse_MultisigKey)(nil), "tss.v1beta1.QueryKeyResponse.MultisigKey")
-	proto.RegisterType((*QueryRecoveryResponse)(nil), "tss.v1beta1.QueryRecoveryResponse")
-	proto.RegisterType((*QueryKeyShareResponse)(nil), "tss.v1beta1.QueryKeyShareResponse")
-	proto.RegisterType((*QueryKeyShareResponse_ShareInfo)(nil), "tss.v1beta1.QueryKeyShareResponse.ShareInfo")
-	proto.RegisterType((*QueryDeactivatedOperatorsResponse)(nil), "tss.v1beta1.QueryDeactivatedOperatorsResponse")
-	proto.RegisterType((*QueryActiveOldKeysValidatorResponse)(nil), "tss.v1beta1.QueryActiveOldKeysValidatorResponse")
-	proto.RegisterType((*QueryActiveOldKeysValidatorResponse_KeyInfo)(nil), "tss.v1beta1.QueryActiveOldKeysValidatorResponse.KeyInfo")
-	proto.RegisterType((*QueryActiveOldKeysResponse)(nil), "tss.v1beta1.QueryActiveOldKeysResponse")
-	proto.RegisterType((*QueryExternalKeyIDResponse)(nil), "tss.v1beta1.QueryExternalKeyIDResponse")
-	proto.RegisterType((*QueryNextKeyIDRequest)(nil), "tss.v1beta1.QueryNextKeyIDRequest")
-	proto.RegisterType((*QueryNextKeyIDResponse)(nil), "tss.v1beta1.QueryNextKeyIDResponse")
+type QuerySignatureRequest struct {
+	SigID string `protobuf:"bytes,1,opt,name=sig_id,json=sigId,proto3" json:"sig_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("tss/v1beta1/query.proto", fileDescriptor_b9e98857940a4a89) }
-
-var fileDescriptor_b9e98857940a4a89 = []byte{
-	// 1289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x93, 0x13, 0x55,
-	0x14, 0x4e, 0x27, 0xf3, 0xea, 0x93, 0x80, 0x99, 0x0b, 0xc8, 0xd8, 0x4a, 0x32, 0x44, 0x4b, 0x50,
-	0x21, 0x81, 0xb1, 0xb4, 0xa0, 0xac, 0xd2, 0x9a, 0x3c, 0x06, 0x52, 0x29, 0x33, 0xd8, 0xc9, 0xb0,
-	0xd0, 0xb2, 0xda, 0x4e, 0xfa, 0xa6, 0xa7, 0x2b, 0x9d, 0xbe, 0xa1, 0xef, 0xed, 0x21, 0xbd, 0xd1,
-	0xad, 0xc5, 0x8a, 0xbd, 0xc5, 0x4a, 0x17, 0xfc, 0x05, 0xff, 0xc1, 0xb0, 0xa1, 0x58, 0xba, 0x0a,
-	0x1a, 0xfc, 0x15, 0xac, 0xac, 0x7b, 0xfb, 0x09, 0x43, 0x51, 0x20, 0xa5, 0xab, 0xe9, 0xf3, 0x3e,
-	0xe7, 0x3b, 0xdf, 0xe9, 0xce, 0xc0, 0x69, 0x46, 0x69, 0xed, 0xe0, 0xf2, 0x00, 0x33, 0xfd, 0x72,
-	0xed, 0x96, 0x87, 0x5d, 0xbf, 0x3a, 0x75, 0x09, 0x23, 0x28, 0xcf, 0x28, 0xad, 0x86, 0x06, 0xe5,
-	0xa4, 0x49, 0x4c, 0x22, 0xf4, 0x35, 0xfe, 0x14, 0xb8, 0x28, 0x65, 0x93, 0x10, 0xd3, 0xc6, 0x35,
-	0x21, 0x0d, 0xbc, 0x51, 0x8d, 0x59, 0x13, 0x4c, 0x99, 0x3e, 0x99, 0x86, 0x0e, 0x9b, 0x3c, 0x39,
-	0x9e, 0x4d, 0x89, 0xcb, 0xb0, 0x11, 0x57, 0x61, 0xfe, 0x14, 0xd3, 0xd0, 0xe3, 0x0c, 0xf7, 0x60,
-	0x64, 0xe4, 0xa4, 0xcc, 0x5c, 0x0a, 0xcc, 0x95, 0xbf, 0x97, 0xe0, 0xed, 0x6f, 0x78, 0x53, 0x3d,
-	0xcb, 0x74, 0x74, 0xe6, 0xb9, 0x58, 0xc5, 0x74, 0x4a, 0x1c, 0x8a, 0x91, 0x05, 0x27, 0xd8, 0xbe,
-	0x8b, 0xe9, 0x3e, 0xb1, 0x0d, 0x8d, 0x46, 0xe6, 0x0d, 0x69, 0x53, 0x3a, 0x9f, 0xdf, 0xfa, 0xbc,
-	0x9a, 0xea, 0xbe, 0xfa, 0xe2, 0x0c, 0xd5, 0x7e, 0x14, 0x1e, 0x9b, 0xae, 0x67, 0x54, 0xc4, 0x8e,
-	0x68, 0xd1, 0x08, 0xd0, 0xc4, 0xb3, 0x99, 0x45, 0x2d, 0x33, 0x55, 0x29, 0x2b, 0x2a, 0x7d, 0xf6,
-	0x2a, 0x95, 0xbe, 0x0e, 0xa3, 0xd3, 0x85, 0xd6, 0x27, 0xcf, 0x2b, 0x95, 0x73, 0x20, 0x27, 0x45,
-	0x0b, 0x20, 0xb9, 0x62, 0x1a, 0x59, 0x95, 0x5c, 0x2e, 0x51, 0x51, 0x51, 0x56, 0x25, 0xaa, 0xfc,
-	0x22, 0x01, 0x3a, 0xda, 0x3d, 0xba, 0x02, 0xf9, 0x03, 0xc2, 0xb0, 0x46, 0x99, 0xce, 0x3c, 0x2a,
-	0x82, 0x8f, 0x6f, 0x9d, 0x7e, 0xa6, 0xc1, 0x9b, 0x84, 0xe1, 0x9e, 0x30, 0xab, 0x70, 0x10, 0x3f,
-	0xa3, 0x0e, 0xc8, 0xcf, 0x0f, 0x76, 0xf1, 0x55, 0x06, 0x4b, 0x34, 0x49, 0xbc, 0x72, 0x5f, 0x82,
-	0xf5, 0x23, 0x13, 0xa3, 0x2f, 0x01, 0x04, 0x7e, 0xe9, 0xde, 0xca, 0xa2, 0x46, 0x44, 0x90, 0xb8,
-	0x58, 0xcf, 0x32, 0xc3, 0x1e, 0x79, 0xd6, 0xb0, 0xc5, 0x9e, 0x88, 0x0f, 0x92, 0x71, 0x28, 0x72,
-	0xaf, 0xdd, 0x63, 0x7d, 0xe9, 0x70, 0x5e, 0xce, 0xa8, 0xa9, 0x34, 0xf5, 0x65, 0xc8, 0x51, 0xcb,
-	0xac, 0x3c, 0x58, 0x82, 0xa2, 0x88, 0xee, 0x60, 0x3f, 0x26, 0x58, 0x0f, 0x64, 0x3c, 0x34, 0xa8,
-	0xae, 0x8d, 0xb1, 0x1f, 0xd2, 0xea, 0xc3, 0xa3, 0xf5, 0x52, 0x11, 0xd5, 0x56, 0xa3, 0xd9, 0xdb,
-	0xee, 0x60, 0xbf, 0x5e, 0x58, 0xcc, 0xcb, 0x6b, 0x91, 0x74, 0x3d, 0xa3, 0xae, 0x89, 0x44, 0x1d,
-	0xec, 0xa3, 0x2e, 0x14, 0x62, 0x2a, 0xf1, 0xbc, 0x01, 0xd6, 0x1f, 0xbd, 0x3c, 0x6f, 0x04, 0x66,
-	0x90, 0x2c, 0x3f, 0x49, 0x44, 0x74, 0x19, 0x96, 0x5c, 0x62, 0xe3, 0x8d, 0x9c, 0xc0, 0xf3, 0xcc,
-	0x8b, 0xf1, 0xe4, 0xb9, 0x88, 0x8d, 0x55, 0xe1, 0x8a, 0x1a, 0x00, 0x2e, 0x61, 0x3a, 0xc3, 0x86,
-	0xa6, 0xb3, 0x8d, 0x25, 0xd1, 0x80, 0x52, 0x0d, 0x4e, 0xb9, 0x1a, 0x9d, 0x72, 0xb5, 0x1f, 0x9d,
-	0x72, 0x7d, 0xed, 0x70, 0x5e, 0x96, 0xee, 0x3e, 0x2e, 0x4b, 0xaa, 0x1c, 0xc6, 0x6d, 0x33, 0xe5,
-	0x2c, 0xe4, 0x78, 0xf9, 0x02, 0x48, 0xb3, 0x88, 0xa4, 0x33, 0x2e, 0xf9, 0x11, 0x49, 0x7d, 0xe5,
-	0x27, 0x88, 0x21, 0x78, 0x03, 0x66, 0x5e, 0x85, 0x5c, 0x82, 0xd3, 0xd9, 0x97, 0xe3, 0xc4, 0xa1,
-	0x0f, 0x76, 0xcc, 0x63, 0x94, 0x11, 0xe4, 0x53, 0xc8, 0xa1, 0xf7, 0x40, 0x8e, 0x6f, 0x5b, 0x74,
-	0x90, 0x53, 0x13, 0x45, 0x52, 0x27, 0xf7, 0xba, 0x75, 0xea, 0x05, 0x80, 0xa9, 0x37, 0xb0, 0xad,
-	0x21, 0xdf, 0x68, 0xe5, 0x50, 0x82, 0x53, 0x22, 0x42, 0xc5, 0x43, 0x72, 0x20, 0xfe, 0x86, 0x84,
-	0x3a, 0x03, 0x30, 0xd5, 0x5d, 0xe6, 0x6b, 0x9e, 0x65, 0x70, 0x0c, 0x72, 0xe7, 0x65, 0x55, 0x16,
-	0x9a, 0x3d, 0xcb, 0xa0, 0xe8, 0x02, 0xa0, 0xc0, 0x4c, 0xf7, 0x75, 0x17, 0x6b, 0x43, 0xe2, 0x39,
-	0x2c, 0x20, 0xfa, 0x31, 0xb5, 0x28, 0x2c, 0x3d, 0x6e, 0x68, 0x08, 0xfd, 0xb3, 0xd3, 0xf0, 0xed,
-	0x1f, 0x4b, 0x4f, 0xd3, 0x84, 0x63, 0x63, 0xec, 0x9b, 0xd8, 0xd1, 0x88, 0xc7, 0xa6, 0x5e, 0xb4,
-	0xe6, 0xe0, 0xde, 0x82, 0x17, 0x6c, 0x8a, 0x1c, 0x26, 0x76, 0x76, 0x85, 0x9b, 0x5a, 0x18, 0xa7,
-	0xa4, 0xca, 0xc3, 0x5c, 0x38, 0x4a, 0x07, 0x07, 0xb5, 0x53, 0xb7, 0x91, 0x0f, 0xba, 0xb4, 0x9c,
-	0x11, 0x09, 0x66, 0xc9, 0x6f, 0x5d, 0x78, 0x21, 0x6a, 0xcf, 0x04, 0x56, 0x85, 0xd4, 0x76, 0x46,
-	0x24, 0x3e, 0xc6, 0x48, 0x41, 0x95, 0xc7, 0x59, 0x90, 0x63, 0x3b, 0xfa, 0x1e, 0x56, 0xc6, 0xd8,
-	0xd7, 0xac, 0x60, 0x57, 0x72, 0x7d, 0x67, 0x31, 0x2f, 0x2f, 0x77, 0xb0, 0xdf, 0x6e, 0x3e, 0x9d,
-	0x97, 0xaf, 0x9a, 0x16, 0xdb, 0xf7, 0x06, 0xd5, 0x21, 0x99, 0xd4, 0xf4, 0x19, 0xb6, 0x75, 0xd7,
-	0xc1, 0xec, 0x36, 0x71, 0xc7, 0xa1, 0x74, 0x71, 0x48, 0x5c, 0x5c, 0x9b, 0xd5, 0xd2, 0xdf, 0x9f,
-	0xaa, 0x08, 0x56, 0x97, 0xc7, 0xd8, 0x6f, 0x1b, 0xe8, 0x5d, 0x90, 0x79, 0xfa, 0xe1, 0xbe, 0x6e,
-	0x39, 0x21, 0x67, 0xd7, 0xc6, 0xd8, 0x6f, 0x70, 0x19, 0xbd, 0x03, 0xfc, 0x59, 0x8b, 0x2f, 0x4b,
-	0x56, 0x57, 0xc7, 0xc1, 0x0d, 0xa1, 0x2d, 0x38, 0x45, 0x1d, 0x7d, 0x4a, 0xf7, 0x09, 0xd3, 0x06,
-	0x36, 0x19, 0x8e, 0x35, 0xc7, 0x9b, 0x0c, 0xb0, 0x2b, 0x10, 0xce, 0xa9, 0x27, 0x22, 0x63, 0x9d,
-	0xdb, 0xba, 0xc2, 0x84, 0x3e, 0x81, 0xf5, 0x03, 0xdd, 0xb6, 0x0c, 0x9d, 0x11, 0x57, 0xd3, 0x0d,
-	0xc3, 0xc5, 0x94, 0x6e, 0x2c, 0x8b, 0xbc, 0xc5, 0xd8, 0xb0, 0x1d, 0xe8, 0xd1, 0x25, 0x38, 0xe9,
-	0x78, 0x13, 0x2d, 0x09, 0x10, 0x08, 0xd1, 0x8d, 0x15, 0x91, 0x1f, 0x39, 0xde, 0xe4, 0x66, 0x64,
-	0x12, 0x60, 0x51, 0x74, 0x1e, 0x8a, 0x3c, 0x82, 0x11, 0xa6, 0xdb, 0x91, 0xf7, 0xaa, 0xf0, 0x3e,
-	0xee, 0x78, 0x93, 0x3e, 0x57, 0x07, 0x9e, 0x15, 0x15, 0xce, 0x8a, 0xb5, 0x34, 0xb1, 0x3e, 0x64,
-	0xd6, 0x01, 0xbf, 0xe5, 0xdd, 0x29, 0x76, 0x79, 0x2e, 0x1a, 0xef, 0xf6, 0x22, 0x20, 0x12, 0x2a,
-	0xa3, 0x66, 0x71, 0x44, 0xd7, 0xf5, 0xc8, 0xb2, 0x1d, 0x19, 0x2a, 0x0f, 0xb3, 0xf0, 0xbe, 0x48,
-	0xba, 0xcd, 0x53, 0xe2, 0x5d, 0xdb, 0xe8, 0x60, 0x9f, 0xc6, 0x3d, 0xc6, 0x69, 0xbf, 0x13, 0x80,
-	0x53, 0xc1, 0x98, 0x90, 0x30, 0x57, 0x8e, 0x12, 0xe6, 0xe5, 0x49, 0xc4, 0x0a, 0x13, 0xf2, 0xf0,
-	0x25, 0x51, 0x2e, 0x2b, 0x0f, 0x24, 0x58, 0x0d, 0x6d, 0xa8, 0x07, 0xd9, 0x98, 0x34, 0x8d, 0xc5,
-	0xbc, 0x9c, 0x7d, 0x53, 0xc6, 0x64, 0x2d, 0x03, 0x9d, 0x84, 0xe5, 0x34, 0x55, 0x02, 0x01, 0xf5,
-	0x52, 0x6f, 0xdf, 0xe5, 0xfa, 0x57, 0x4f, 0xe7, 0xe5, 0x2f, 0xfe, 0x65, 0x99, 0xe4, 0xfd, 0x5c,
-	0xf9, 0x11, 0x94, 0xa3, 0x50, 0xc4, 0x30, 0xfe, 0x00, 0xab, 0xc1, 0x59, 0x84, 0x2b, 0xa9, 0x5f,
-	0x5b, 0xcc, 0xcb, 0x2b, 0xa2, 0x51, 0xfa, 0x66, 0x63, 0xae, 0x88, 0xc3, 0xa0, 0x71, 0xfd, 0xd6,
-	0x8c, 0x61, 0xd7, 0xd1, 0xed, 0xc0, 0xfa, 0xff, 0xd5, 0x37, 0xc3, 0x97, 0x4e, 0x17, 0xcf, 0x58,
-	0x58, 0xfb, 0x96, 0x87, 0x29, 0x4b, 0x76, 0x20, 0xa5, 0x77, 0x70, 0x25, 0x75, 0xab, 0xd9, 0x57,
-	0xf9, 0x0a, 0x46, 0xa7, 0x5c, 0xb9, 0x1d, 0xfe, 0xb6, 0x4c, 0x15, 0x0a, 0x87, 0xfc, 0x6f, 0xdf,
-	0x3d, 0x1f, 0xff, 0x2e, 0x01, 0x24, 0x9f, 0x3b, 0x74, 0x01, 0x4e, 0xdf, 0xdc, 0xed, 0xb7, 0xb4,
-	0x5e, 0x7f, 0xbb, 0xbf, 0xd7, 0xd3, 0xf6, 0xba, 0xbd, 0x1b, 0xad, 0x46, 0x7b, 0xa7, 0xdd, 0x6a,
-	0x16, 0x33, 0xca, 0x5b, 0x77, 0xee, 0x6d, 0xe6, 0xf7, 0x1c, 0x3a, 0xc5, 0x43, 0x6b, 0x64, 0x61,
-	0x03, 0x9d, 0x83, 0x53, 0x69, 0xef, 0xee, 0x6e, 0x5f, 0xdb, 0xd9, 0xdd, 0xeb, 0x36, 0x8b, 0x92,
-	0x52, 0xb8, 0x73, 0x6f, 0x73, 0xad, 0x4b, 0xd8, 0x0e, 0xf1, 0x1c, 0x03, 0x7d, 0x00, 0x27, 0xd2,
-	0x8e, 0x37, 0x5a, 0xdd, 0x66, 0xbb, 0x7b, 0xad, 0x98, 0x55, 0xf2, 0x77, 0xee, 0x6d, 0xae, 0xde,
-	0xc0, 0x8e, 0x61, 0x39, 0xe6, 0xf3, 0x5e, 0xcd, 0x56, 0xa3, 0xdd, 0x6c, 0x35, 0x8b, 0xb9, 0xc0,
-	0xab, 0x89, 0x87, 0x96, 0x81, 0x0d, 0x65, 0xed, 0xe7, 0x5f, 0x4b, 0x99, 0xfb, 0xbf, 0x95, 0xa4,
-	0x7a, 0xf7, 0xf0, 0xaf, 0x52, 0xe6, 0x70, 0x51, 0x92, 0x1e, 0x2d, 0x4a, 0xd2, 0x9f, 0x8b, 0x92,
-	0x74, 0xf7, 0x49, 0x29, 0xf3, 0xe8, 0x49, 0x29, 0xf3, 0xc7, 0x93, 0x52, 0xe6, 0xdb, 0x4b, 0xaf,
-	0x81, 0x8d, 0xf8, 0x37, 0x60, 0xb0, 0x22, 0x7e, 0x71, 0x7c, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xd9, 0x00, 0xea, 0x6a, 0x88, 0x0c, 0x00, 0x00,
+func (m *QuerySignatureRequest) Reset()         { *m = QuerySignatureRequest{} }
+func (m *QuerySignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignatureRequest) ProtoMessage()    {}
+func (*QuerySignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{10}
 }
-
-func (m *QuerySignatureResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySignatureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignatureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignatureRequest.Merge(m, src)
+}
+func (m *QuerySignatureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignatureRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySignatureResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySignatureRequest proto.InternalMessageInfo
+
+type QueryKeyRequest struct {
+	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 }
 
-func (m *QuerySignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sig != nil {
-		{
-			size := m.Sig.Size()
-			i -= size
-			if _, err := m.Sig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *QueryKeyRequest) Reset()         { *m = QueryKeyRequest{} }
+func (m *QueryKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyRequest) ProtoMessage()    {}
+func (*QueryKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{11}
+}
+func (m *QueryKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyRequest.Merge(m, src)
+}
+func (m *QueryKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySignatureResponse_ThresholdSignature_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryKeyRequest proto.InternalMessageInfo
+
+type QueryRecoveryRequest struct {
+	Validator string                                                    `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	KeyID     github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 }
 
-func (m *QuerySignatureResponse_ThresholdSignature_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ThresholdSignature != nil {
-		{
-			size, err := m.ThresholdSignature.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRecoveryRequest) Reset()         { *m = QueryRecoveryRequest{} }
+func (m *QueryRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryRequest) ProtoMessage()    {}
+func (*QueryRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{12}
+}
+func (m *QueryRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *QuerySignatureResponse_MultisigSignature_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryRequest.Merge(m, src)
+}
+func (m *QueryRecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySignatureResponse_MultisigSignature_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MultisigSignature != nil {
-		{
-			size, err := m.MultisigSignature.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryRecoveryRequest proto.InternalMessageInfo
+
+type QueryKeyIDRequest struct {
+	Chain   string           `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyRole exported.KeyRole `protobuf:"varint,2,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+}
+
+func (m *QueryKeyIDRequest) Reset()         { *m = QueryKeyIDRequest{} }
+func (m *QueryKeyIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyIDRequest) ProtoMessage()    {}
+func (*QueryKeyIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{13}
+}
+func (m *QueryKeyIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *QuerySignatureResponse_Signature) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryKeyIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyIDRequest.Merge(m, src)
 }
-
-func (m *QuerySignatureResponse_Signature) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryKeyIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyIDRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySignatureResponse_Signature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.R)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryKeyIDRequest proto.InternalMessageInfo
+
+type QueryKeyIDResponse struct {
+	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 }
 
-func (m *QuerySignatureResponse_ThresholdSignature) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryKeyIDResponse) Reset()         { *m = QueryKeyIDResponse{} }
+func (m *QueryKeyIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyIDResponse) ProtoMessage()    {}
+func (*QueryKeyIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{14}
+}
+func (m *QueryKeyIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryKeyIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyIDResponse.Merge(m, src)
+}
+func (m *QueryKeyIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyIDResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySignatureResponse_ThresholdSignature) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryKeyIDResponse proto.InternalMessageInfo
+
+type QueryKeySharesByKeyIDRequest struct {
+	KeyID      github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+	Pagination *query.PageRequest                                        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySignatureResponse_ThresholdSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Signature != nil {
-		{
-			size, err := m.Signature.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryKeySharesByKeyIDRequest) Reset()         { *m = QueryKeySharesByKeyIDRequest{} }
+func (m *QueryKeySharesByKeyIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeySharesByKeyIDRequest) ProtoMessage()    {}
+func (*QueryKeySharesByKeyIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{15}
+}
+func (m *QueryKeySharesByKeyIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeySharesByKeyIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeySharesByKeyIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.VoteStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VoteStatus))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySignatureResponse_MultisigSignature) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryKeySharesByKeyIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeySharesByKeyIDRequest.Merge(m, src)
+}
+func (m *QueryKeySharesByKeyIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeySharesByKeyIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeySharesByKeyIDRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySignatureResponse_MultisigSignature) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryKeySharesByKeyIDRequest proto.InternalMessageInfo
+
+type QueryKeySharesByValidatorRequest struct {
+	Validator  string             `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySignatureResponse_MultisigSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryKeySharesByValidatorRequest) Reset()         { *m = QueryKeySharesByValidatorRequest{} }
+func (m *QueryKeySharesByValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeySharesByValidatorRequest) ProtoMessage()    {}
+func (*QueryKeySharesByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{16}
+}
+func (m *QueryKeySharesByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeySharesByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeySharesByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SigStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SigStatus))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryKeySharesByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeySharesByValidatorRequest.Merge(m, src)
+}
+func (m *QueryKeySharesByValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeySharesByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeySharesByValidatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryKeySharesByValidatorRequest proto.InternalMessageInfo
+
+type QueryActiveOldKeysRequest struct {
+	Chain      string             `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyRole    exported.KeyRole   `protobuf:"varint,2,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RotatedAt != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RotatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotatedAt):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintQuery(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Role != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PublicKey != nil {
-		{
-			size := m.PublicKey.Size()
-			i -= size
-			if _, err := m.PublicKey.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *QueryActiveOldKeysRequest) Reset()         { *m = QueryActiveOldKeysRequest{} }
+func (m *QueryActiveOldKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveOldKeysRequest) ProtoMessage()    {}
+func (*QueryActiveOldKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{17}
+}
+func (m *QueryActiveOldKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveOldKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveOldKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryActiveOldKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveOldKeysRequest.Merge(m, src)
+}
+func (m *QueryActiveOldKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveOldKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveOldKeysRequest.DiscardUnknown(m)
 }
 
-func (m *QueryKeyResponse_ECDSAKey_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryActiveOldKeysRequest proto.InternalMessageInfo
+
+type QueryActiveOldKeysByValidatorRequest struct {
+	Validator  string             `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryKeyResponse_ECDSAKey_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ECDSAKey != nil {
-		{
-			size, err := m.ECDSAKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryActiveOldKeysByValidatorRequest) Reset()         { *m = QueryActiveOldKeysByValidatorRequest{} }
+func (m *QueryActiveOldKeysByValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveOldKeysByValidatorRequest) ProtoMessage()    {}
+func (*QueryActiveOldKeysByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{18}
+}
+func (m *QueryActiveOldKeysByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveOldKeysByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveOldKeysByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *QueryKeyResponse_MultisigKey_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryActiveOldKeysByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveOldKeysByValidatorRequest.Merge(m, src)
+}
+func (m *QueryActiveOldKeysByValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveOldKeysByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveOldKeysByValidatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryKeyResponse_MultisigKey_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MultisigKey != nil {
-		{
-			size, err := m.MultisigKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryActiveOldKeysByValidatorRequest proto.InternalMessageInfo
+
+type QueryDeactivatedOperatorsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeactivatedOperatorsRequest) Reset()         { *m = QueryDeactivatedOperatorsRequest{} }
+func (m *QueryDeactivatedOperatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeactivatedOperatorsRequest) ProtoMessage()    {}
+func (*QueryDeactivatedOperatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{19}
+}
+func (m *QueryDeactivatedOperatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeactivatedOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeactivatedOperatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *QueryKeyResponse_Key) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDeactivatedOperatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeactivatedOperatorsRequest.Merge(m, src)
 }
-
-func (m *QueryKeyResponse_Key) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDeactivatedOperatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeactivatedOperatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeactivatedOperatorsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryKeyResponse_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Y) > 0 {
-		i -= len(m.Y)
-		copy(dAtA[i:], m.Y)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Y)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.X) > 0 {
-		i -= len(m.X)
-		copy(dAtA[i:], m.X)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.X)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryDeactivatedOperatorsRequest proto.InternalMessageInfo
+
+type QueryExternalKeyIDRequest struct {
+	Chain      string             `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryKeyResponse_ECDSAKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryExternalKeyIDRequest) Reset()         { *m = QueryExternalKeyIDRequest{} }
+func (m *QueryExternalKeyIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalKeyIDRequest) ProtoMessage()    {}
+func (*QueryExternalKeyIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{20}
+}
+func (m *QueryExternalKeyIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalKeyIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalKeyIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryExternalKeyIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalKeyIDRequest.Merge(m, src)
+}
+func (m *QueryExternalKeyIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalKeyIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalKeyIDRequest.DiscardUnknown(m)
 }
 
-func (m *QueryKeyResponse_ECDSAKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryExternalKeyIDRequest proto.InternalMessageInfo
+
+type QuerySnapshotDriftRequest struct {
+	KeyID github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
 }
 
-func (m *QueryKeyResponse_ECDSAKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySnapshotDriftRequest) Reset()         { *m = QuerySnapshotDriftRequest{} }
+func (m *QuerySnapshotDriftRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySnapshotDriftRequest) ProtoMessage()    {}
+func (*QuerySnapshotDriftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{21}
+}
+func (m *QuerySnapshotDriftRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnapshotDriftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnapshotDriftRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.VoteStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VoteStatus))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryKeyResponse_MultisigKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySnapshotDriftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnapshotDriftRequest.Merge(m, src)
+}
+func (m *QuerySnapshotDriftRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnapshotDriftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnapshotDriftRequest.DiscardUnknown(m)
 }
 
-func (m *QueryKeyResponse_MultisigKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySnapshotDriftRequest proto.InternalMessageInfo
+
+type QuerySnapshotDriftResponse struct {
+	SnapshotDrift SnapshotDrift `protobuf:"bytes,1,opt,name=snapshot_drift,json=snapshotDrift,proto3" json:"snapshot_drift"`
 }
 
-func (m *QueryKeyResponse_MultisigKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		for iNdEx := len(m.Key) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Key[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QuerySnapshotDriftResponse) Reset()         { *m = QuerySnapshotDriftResponse{} }
+func (m *QuerySnapshotDriftResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySnapshotDriftResponse) ProtoMessage()    {}
+func (*QuerySnapshotDriftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{22}
+}
+func (m *QuerySnapshotDriftResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnapshotDriftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnapshotDriftResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Threshold != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QuerySnapshotDriftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnapshotDriftResponse.Merge(m, src)
+}
+func (m *QuerySnapshotDriftResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnapshotDriftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnapshotDriftResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySnapshotDriftResponse proto.InternalMessageInfo
+
+type QuerySignQueueRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignQueueRequest) Reset()         { *m = QuerySignQueueRequest{} }
+func (m *QuerySignQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignQueueRequest) ProtoMessage()    {}
+func (*QuerySignQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{23}
+}
+func (m *QuerySignQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySignQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignQueueRequest.Merge(m, src)
+}
+func (m *QuerySignQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignQueueRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRecoveryResponse) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_QuerySignQueueRequest proto.InternalMessageInfo
+
+type QuerySignQueueResponse struct {
+	MaxSimultaneousSignShares int64                          `protobuf:"varint,1,opt,name=max_simultaneous_sign_shares,json=maxSimultaneousSignShares,proto3" json:"max_simultaneous_sign_shares,omitempty"`
+	SigningShareCount         int64                          `protobuf:"varint,2,opt,name=signing_share_count,json=signingShareCount,proto3" json:"signing_share_count,omitempty"`
+	Entries                   []QuerySignQueueResponse_Entry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+	Pagination                *query.PageResponse            `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySignQueueResponse) Reset()         { *m = QuerySignQueueResponse{} }
+func (m *QuerySignQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignQueueResponse) ProtoMessage()    {}
+func (*QuerySignQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{24}
+}
+func (m *QuerySignQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignQueueResponse.Merge(m, src)
+}
+func (m *QuerySignQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignQueueResponse proto.InternalMessageInfo
+
+type QuerySignQueueResponse_Entry struct {
+	SigID                string                                                    `protobuf:"bytes,1,opt,name=sig_id,json=sigId,proto3" json:"sig_id,omitempty"`
+	KeyID                github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+	RequestModule        string                                                    `protobuf:"bytes,3,opt,name=request_module,json=requestModule,proto3" json:"request_module,omitempty"`
+	Lane                 string                                                    `protobuf:"bytes,4,opt,name=lane,proto3" json:"lane,omitempty"`
+	Priority             exported.SignPriority                                     `protobuf:"varint,5,opt,name=priority,proto3,enum=tss.exported.v1beta1.SignPriority" json:"priority,omitempty"`
+	ShareCount           int64                                                     `protobuf:"varint,6,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+	Position             int64                                                     `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	EstimatedStartHeight int64                                                     `protobuf:"varint,8,opt,name=estimated_start_height,json=estimatedStartHeight,proto3" json:"estimated_start_height,omitempty"`
+}
+
+func (m *QuerySignQueueResponse_Entry) Reset()         { *m = QuerySignQueueResponse_Entry{} }
+func (m *QuerySignQueueResponse_Entry) String() string { return proto.CompactTextString(m) }
+func (*QuerySignQueueResponse_Entry) ProtoMessage()    {}
+func (*QuerySignQueueResponse_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e98857940a4a89, []int{24, 0}
+}
+func (m *QuerySignQueueResponse_Entry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignQueueResponse_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignQueueResponse_Entry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignQueueResponse_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignQueueResponse_Entry.Merge(m, src)
+}
+func (m *QuerySignQueueResponse_Entry) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignQueueResponse_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignQueueResponse_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignQueueResponse_Entry proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("tss.v1beta1.VoteStatus", VoteStatus_name, VoteStatus_value)
+	proto.RegisterType((*QuerySignatureResponse)(nil), "tss.v1beta1.QuerySignatureResponse")
+	proto.RegisterType((*QuerySignatureResponse_Signature)(nil), "tss.v1beta1.QuerySignatureResponse.Signature")
+	proto.RegisterType((*QuerySignatureResponse_ThresholdSignature)(nil), "tss.v1beta1.QuerySignatureResponse.ThresholdSignature")
+	proto.RegisterType((*QuerySignatureResponse_MultisigSignature)(nil), "tss.v1beta1.QuerySignatureResponse.MultisigSignature")
+	proto.RegisterType((*QueryKeyResponse)(nil), "tss.v1beta1.QueryKeyResponse")
+	proto.RegisterType((*QueryKeyResponse_Key)(nil), "tss.v1beta1.QueryKeyResponse.Key")
+	proto.RegisterType((*QueryKeyResponse_ECDSAKey)(nil), "tss.v1beta1.QueryKeyResponse.ECDSAKey")
+	proto.RegisterType((*QueryKeyResponse_MultisigKey)(nil), "tss.v1beta1.QueryKeyResponse.MultisigKey")
+	proto.RegisterType((*QueryRecoveryResponse)(nil), "tss.v1beta1.QueryRecoveryResponse")
+	proto.RegisterType((*QueryKeyShareResponse)(nil), "tss.v1beta1.QueryKeyShareResponse")
+	proto.RegisterType((*QueryKeyShareResponse_ShareInfo)(nil), "tss.v1beta1.QueryKeyShareResponse.ShareInfo")
+	proto.RegisterType((*QueryDeactivatedOperatorsResponse)(nil), "tss.v1beta1.QueryDeactivatedOperatorsResponse")
+	proto.RegisterType((*QueryActiveOldKeysValidatorResponse)(nil), "tss.v1beta1.QueryActiveOldKeysValidatorResponse")
+	proto.RegisterType((*QueryActiveOldKeysValidatorResponse_KeyInfo)(nil), "tss.v1beta1.QueryActiveOldKeysValidatorResponse.KeyInfo")
+	proto.RegisterType((*QueryActiveOldKeysResponse)(nil), "tss.v1beta1.QueryActiveOldKeysResponse")
+	proto.RegisterType((*QueryExternalKeyIDResponse)(nil), "tss.v1beta1.QueryExternalKeyIDResponse")
+	proto.RegisterType((*QueryNextKeyIDRequest)(nil), "tss.v1beta1.QueryNextKeyIDRequest")
+	proto.RegisterType((*QueryNextKeyIDResponse)(nil), "tss.v1beta1.QueryNextKeyIDResponse")
+	proto.RegisterType((*QuerySignatureRequest)(nil), "tss.v1beta1.QuerySignatureRequest")
+	proto.RegisterType((*QueryKeyRequest)(nil), "tss.v1beta1.QueryKeyRequest")
+	proto.RegisterType((*QueryRecoveryRequest)(nil), "tss.v1beta1.QueryRecoveryRequest")
+	proto.RegisterType((*QueryKeyIDRequest)(nil), "tss.v1beta1.QueryKeyIDRequest")
+	proto.RegisterType((*QueryKeyIDResponse)(nil), "tss.v1beta1.QueryKeyIDResponse")
+	proto.RegisterType((*QueryKeySharesByKeyIDRequest)(nil), "tss.v1beta1.QueryKeySharesByKeyIDRequest")
+	proto.RegisterType((*QueryKeySharesByValidatorRequest)(nil), "tss.v1beta1.QueryKeySharesByValidatorRequest")
+	proto.RegisterType((*QueryActiveOldKeysRequest)(nil), "tss.v1beta1.QueryActiveOldKeysRequest")
+	proto.RegisterType((*QueryActiveOldKeysByValidatorRequest)(nil), "tss.v1beta1.QueryActiveOldKeysByValidatorRequest")
+	proto.RegisterType((*QueryDeactivatedOperatorsRequest)(nil), "tss.v1beta1.QueryDeactivatedOperatorsRequest")
+	proto.RegisterType((*QueryExternalKeyIDRequest)(nil), "tss.v1beta1.QueryExternalKeyIDRequest")
+	proto.RegisterType((*QuerySnapshotDriftRequest)(nil), "tss.v1beta1.QuerySnapshotDriftRequest")
+	proto.RegisterType((*QuerySnapshotDriftResponse)(nil), "tss.v1beta1.QuerySnapshotDriftResponse")
+	proto.RegisterType((*QuerySignQueueRequest)(nil), "tss.v1beta1.QuerySignQueueRequest")
+	proto.RegisterType((*QuerySignQueueResponse)(nil), "tss.v1beta1.QuerySignQueueResponse")
+	proto.RegisterType((*QuerySignQueueResponse_Entry)(nil), "tss.v1beta1.QuerySignQueueResponse.Entry")
+}
+
+func init() { proto.RegisterFile("tss/v1beta1/query.proto", fileDescriptor_b9e98857940a4a89) }
+
+var fileDescriptor_b9e98857940a4a89 = []byte{
+	// 1796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xe7, 0x92, 0x7a, 0x90, 0x1f, 0x65, 0x45, 0x1a, 0xcb, 0xb6, 0xbc, 0xb5, 0x49, 0x9a, 0x4d,
+	0x63, 0x37, 0xb5, 0x97, 0xb1, 0xfa, 0x80, 0x8d, 0x02, 0x09, 0x44, 0x91, 0xb2, 0x09, 0x21, 0x94,
+	0xb2, 0x94, 0x7c, 0x68, 0x51, 0x6c, 0x57, 0xdc, 0xd1, 0x6a, 0x4a, 0x72, 0x87, 0xd9, 0x99, 0x55,
+	0xb8, 0xbd, 0xf4, 0x1a, 0x18, 0x3d, 0x04, 0x28, 0xd0, 0x1c, 0x02, 0x9f, 0xda, 0x43, 0xee, 0x3d,
+	0xf5, 0x0f, 0x28, 0xaa, 0xdc, 0x72, 0xec, 0x49, 0x69, 0xe5, 0xfe, 0x0d, 0x3d, 0xe4, 0x54, 0xcc,
+	0xec, 0x93, 0x94, 0xaa, 0xf8, 0x21, 0xa5, 0xc8, 0x6d, 0x67, 0xbe, 0xc7, 0xef, 0x7b, 0xfc, 0xe6,
+	0xb5, 0x70, 0x8d, 0x33, 0x56, 0x3b, 0xb8, 0xbf, 0x8b, 0xb9, 0x79, 0xbf, 0xf6, 0xa1, 0x87, 0x5d,
+	0x5f, 0x1b, 0xba, 0x94, 0x53, 0x54, 0xe4, 0x8c, 0x69, 0xa1, 0x40, 0x5d, 0xb2, 0xa9, 0x4d, 0xe5,
+	0x7c, 0x4d, 0x7c, 0x05, 0x2a, 0xea, 0xdb, 0x5d, 0xca, 0x06, 0x94, 0xd5, 0x76, 0x4d, 0x86, 0x03,
+	0xdb, 0xd8, 0xd3, 0xd0, 0xb4, 0x89, 0x63, 0x72, 0x42, 0x9d, 0x50, 0xb7, 0x6c, 0x53, 0x6a, 0xf7,
+	0x71, 0x4d, 0x8e, 0x76, 0xbd, 0xbd, 0x1a, 0x27, 0x03, 0xcc, 0xb8, 0x39, 0x18, 0x86, 0x0a, 0x15,
+	0x11, 0x08, 0x1e, 0x0d, 0xa9, 0xcb, 0xb1, 0x15, 0xfb, 0xe1, 0xfe, 0x10, 0xb3, 0x50, 0xe3, 0xa6,
+	0xd0, 0xe0, 0x74, 0xcf, 0x49, 0x89, 0xc5, 0x28, 0x14, 0x8f, 0x65, 0x92, 0xb2, 0xab, 0xfe, 0x7b,
+	0x0a, 0xae, 0x7e, 0x20, 0xa2, 0xeb, 0x10, 0xdb, 0x31, 0xb9, 0xe7, 0x62, 0x1d, 0xb3, 0x21, 0x75,
+	0x18, 0x46, 0x04, 0x2e, 0xf3, 0x7d, 0x17, 0xb3, 0x7d, 0xda, 0xb7, 0x0c, 0x16, 0x89, 0x97, 0x95,
+	0x8a, 0x72, 0xa7, 0xb8, 0xf2, 0x33, 0x2d, 0x55, 0x02, 0xed, 0x74, 0x0f, 0xda, 0x76, 0x64, 0x1e,
+	0x8b, 0x1e, 0x67, 0x74, 0xc4, 0x4f, 0xcc, 0xa2, 0x3d, 0x40, 0x03, 0xaf, 0xcf, 0x09, 0x23, 0x76,
+	0x0a, 0x29, 0x2b, 0x91, 0x7e, 0xfa, 0x22, 0x48, 0xef, 0x87, 0xd6, 0x69, 0xa0, 0xc5, 0xc1, 0xe4,
+	0xa4, 0x7a, 0x1b, 0x0a, 0x09, 0xe8, 0x1c, 0x28, 0xae, 0xcc, 0xa6, 0xa0, 0x2b, 0xae, 0x18, 0x31,
+	0x89, 0x58, 0xd0, 0x15, 0xa6, 0x7e, 0xa6, 0x00, 0x3a, 0x19, 0x3d, 0x7a, 0x00, 0xc5, 0x03, 0xca,
+	0xb1, 0xc1, 0xb8, 0xc9, 0x3d, 0x26, 0x8d, 0xe7, 0x57, 0xae, 0x8d, 0x05, 0xf8, 0x84, 0x72, 0xdc,
+	0x91, 0x62, 0x1d, 0x0e, 0xe2, 0x6f, 0xb4, 0x01, 0x85, 0xc9, 0xc4, 0xee, 0xbd, 0x48, 0x62, 0xc9,
+	0x4c, 0x62, 0xaf, 0x7e, 0xae, 0xc0, 0xe2, 0x89, 0x8c, 0xd1, 0xbb, 0x00, 0xb2, 0x7e, 0xe9, 0xd8,
+	0xca, 0x12, 0x23, 0x62, 0x4e, 0x0c, 0xd6, 0x21, 0x76, 0x18, 0x63, 0x81, 0x45, 0x9f, 0xa8, 0x23,
+	0xed, 0x03, 0x67, 0xa2, 0x14, 0xb9, 0x97, 0x8e, 0xb1, 0x3e, 0x75, 0x78, 0x54, 0xce, 0xe8, 0x29,
+	0x37, 0xf5, 0x69, 0xc8, 0x31, 0x62, 0x57, 0xbf, 0x98, 0x82, 0x05, 0x69, 0xbd, 0x81, 0xfd, 0x98,
+	0x60, 0x1d, 0x28, 0xe0, 0xae, 0xc5, 0x4c, 0xa3, 0x87, 0xfd, 0x90, 0x56, 0x6f, 0x9d, 0xc4, 0x4b,
+	0x59, 0x68, 0xcd, 0xb5, 0x46, 0x67, 0x75, 0x03, 0xfb, 0xf5, 0xb9, 0xe3, 0xa3, 0x72, 0x3e, 0x1a,
+	0x3d, 0xce, 0xe8, 0x79, 0xe9, 0x68, 0x03, 0xfb, 0xa8, 0x0d, 0x73, 0x31, 0x95, 0x84, 0xdf, 0xa0,
+	0xd6, 0x3f, 0x3c, 0xdb, 0x6f, 0x54, 0xcc, 0xc0, 0x59, 0x71, 0x90, 0x0c, 0xd1, 0x7d, 0x98, 0x72,
+	0x69, 0x1f, 0x2f, 0xe7, 0x64, 0x3d, 0x6f, 0x9e, 0x5e, 0x4f, 0xe1, 0x8b, 0xf6, 0xb1, 0x2e, 0x55,
+	0xd1, 0x1a, 0x80, 0x4b, 0xb9, 0xc9, 0xb1, 0x65, 0x98, 0x7c, 0x79, 0x4a, 0x06, 0xa0, 0x6a, 0xc1,
+	0x1a, 0xd7, 0xa2, 0x35, 0xae, 0x6d, 0x47, 0x6b, 0xbc, 0x9e, 0x3f, 0x3c, 0x2a, 0x2b, 0x9f, 0x7c,
+	0x55, 0x56, 0xf4, 0x42, 0x68, 0xb7, 0xca, 0xd5, 0x5b, 0x90, 0x13, 0xf0, 0x73, 0xa0, 0x8c, 0x22,
+	0x92, 0x8e, 0xc4, 0xc8, 0x8f, 0x48, 0xea, 0xab, 0xbf, 0x83, 0xb8, 0x04, 0xaf, 0xc1, 0xcc, 0x87,
+	0x90, 0x4b, 0xea, 0x74, 0xeb, 0xec, 0x3a, 0x89, 0xd2, 0x07, 0x3d, 0x16, 0x36, 0xea, 0x1e, 0x14,
+	0x53, 0x95, 0x43, 0x37, 0xa0, 0x10, 0xaf, 0x6d, 0x19, 0x41, 0x4e, 0x4f, 0x26, 0x12, 0x9c, 0xdc,
+	0xcb, 0xe2, 0xd4, 0xe7, 0x00, 0x86, 0xde, 0x6e, 0x9f, 0x74, 0x45, 0x47, 0xab, 0x87, 0x0a, 0x5c,
+	0x91, 0x16, 0x3a, 0xee, 0xd2, 0x03, 0xec, 0xc6, 0x66, 0xe8, 0x26, 0xc0, 0xd0, 0x74, 0xb9, 0x6f,
+	0x78, 0xc4, 0x12, 0x35, 0xc8, 0xdd, 0x29, 0xe8, 0x05, 0x39, 0xb3, 0x43, 0x2c, 0x86, 0xee, 0x02,
+	0x0a, 0xc4, 0x6c, 0xdf, 0x74, 0xb1, 0xd1, 0xa5, 0x9e, 0xc3, 0x03, 0xa2, 0x5f, 0xd2, 0x17, 0xa4,
+	0xa4, 0x23, 0x04, 0x6b, 0x72, 0x7e, 0x3c, 0x1b, 0xd1, 0xfd, 0x4b, 0xe9, 0x6c, 0x1a, 0x70, 0xa9,
+	0x87, 0x7d, 0x1b, 0x3b, 0x06, 0xf5, 0xf8, 0xd0, 0x8b, 0xda, 0x1c, 0xac, 0xb7, 0x60, 0xe7, 0x4d,
+	0x91, 0xc3, 0xc6, 0xce, 0xa6, 0x54, 0xd3, 0xe7, 0x7a, 0xa9, 0x51, 0xf5, 0xd3, 0xa9, 0x30, 0x95,
+	0x0d, 0x1c, 0x60, 0xa7, 0xd6, 0x46, 0x31, 0x88, 0x92, 0x38, 0x7b, 0x34, 0xc8, 0xa5, 0xb8, 0x72,
+	0xf7, 0xd4, 0xaa, 0x8d, 0x19, 0x6a, 0x72, 0xd4, 0x72, 0xf6, 0x68, 0xbc, 0x18, 0xa3, 0x09, 0x86,
+	0x1e, 0x01, 0x24, 0x67, 0x4f, 0xd8, 0xf1, 0xdb, 0x5a, 0x70, 0x50, 0x69, 0xe2, 0xa0, 0xd2, 0x82,
+	0x43, 0x2e, 0x42, 0xd8, 0x32, 0xed, 0xd8, 0xb1, 0x9e, 0x32, 0x55, 0xbf, 0xca, 0x42, 0x21, 0x06,
+	0x42, 0xbf, 0x82, 0x99, 0x1e, 0xf6, 0x0d, 0x12, 0x34, 0xbd, 0x50, 0x5f, 0x3f, 0x3e, 0x2a, 0x4f,
+	0x6f, 0x60, 0xbf, 0xd5, 0xf8, 0xfa, 0xa8, 0xfc, 0xd0, 0x26, 0x7c, 0xdf, 0xdb, 0xd5, 0xba, 0x74,
+	0x50, 0x33, 0x47, 0xb8, 0x6f, 0xba, 0x0e, 0xe6, 0x1f, 0x51, 0xb7, 0x17, 0x8e, 0xee, 0x75, 0xa9,
+	0x8b, 0x6b, 0xa3, 0x5a, 0xfa, 0x84, 0xd3, 0xa4, 0xb1, 0x3e, 0xdd, 0xc3, 0x7e, 0xcb, 0x42, 0xdf,
+	0x83, 0x82, 0x70, 0xdf, 0xdd, 0x37, 0x89, 0x13, 0x92, 0x3f, 0xdf, 0xc3, 0xfe, 0x9a, 0x18, 0xa3,
+	0xeb, 0x20, 0xbe, 0x8d, 0x78, 0x89, 0x16, 0xf4, 0xd9, 0x5e, 0xb0, 0x18, 0xd1, 0x0a, 0x5c, 0x61,
+	0x8e, 0x39, 0x64, 0xfb, 0x94, 0x1b, 0xbb, 0x7d, 0xda, 0xed, 0x19, 0x8e, 0x37, 0xd8, 0xc5, 0xae,
+	0x6c, 0x55, 0x4e, 0xbf, 0x1c, 0x09, 0xeb, 0x42, 0xd6, 0x96, 0x22, 0xf4, 0x23, 0x58, 0x3c, 0x30,
+	0xfb, 0xc4, 0x32, 0x39, 0x75, 0x0d, 0xd3, 0xb2, 0x5c, 0xcc, 0xd8, 0xf2, 0xb4, 0xf4, 0xbb, 0x10,
+	0x0b, 0x56, 0x83, 0x79, 0xf4, 0x0e, 0x2c, 0x39, 0xde, 0xc0, 0x48, 0x0c, 0x64, 0xa9, 0xd9, 0xf2,
+	0x8c, 0xf4, 0x8f, 0x1c, 0x6f, 0xf0, 0x24, 0x12, 0xc9, 0x62, 0x31, 0x74, 0x07, 0x16, 0x84, 0x05,
+	0xa7, 0xdc, 0xec, 0x47, 0xda, 0xb3, 0x52, 0x7b, 0xde, 0xf1, 0x06, 0xdb, 0x62, 0x3a, 0xd0, 0xac,
+	0x7e, 0xa6, 0xc0, 0x2d, 0xd9, 0xe0, 0x06, 0x36, 0xbb, 0x9c, 0x1c, 0x88, 0x5d, 0x61, 0x73, 0x88,
+	0x5d, 0xe1, 0x8c, 0xc5, 0x2c, 0xb9, 0x07, 0x88, 0x86, 0x93, 0x51, 0xb4, 0x38, 0x22, 0xfe, 0x62,
+	0x24, 0x59, 0x8d, 0x04, 0xe7, 0xd6, 0xff, 0xea, 0xa7, 0x39, 0xf8, 0xbe, 0x8c, 0x6e, 0x55, 0xc4,
+	0x86, 0x37, 0xfb, 0xd6, 0x06, 0xf6, 0x59, 0x9c, 0x6d, 0x1c, 0xdf, 0x2f, 0x65, 0xeb, 0x98, 0x24,
+	0x71, 0xc8, 0xe1, 0x07, 0x27, 0x39, 0x7c, 0xb6, 0x13, 0x49, 0x86, 0x84, 0xcf, 0xa2, 0xdd, 0x4c,
+	0xd2, 0xee, 0xdc, 0xd8, 0xfc, 0x85, 0x02, 0xb3, 0x21, 0x08, 0xea, 0x40, 0x36, 0xe6, 0xf1, 0xda,
+	0xf1, 0x51, 0x39, 0xfb, 0xba, 0x24, 0xce, 0x12, 0x0b, 0x2d, 0xc1, 0x74, 0x9a, 0xbd, 0xc1, 0x00,
+	0x75, 0x52, 0x27, 0xcb, 0x74, 0xfd, 0xbd, 0xaf, 0x8f, 0xca, 0x3f, 0x7f, 0x45, 0x98, 0xe4, 0xec,
+	0xa9, 0xfe, 0x5d, 0x01, 0xf5, 0x64, 0x51, 0xe3, 0x86, 0xfc, 0x1a, 0x66, 0x83, 0xa5, 0x1a, 0xb2,
+	0xa4, 0xfe, 0xe8, 0xf8, 0xa8, 0x3c, 0x23, 0x23, 0x65, 0xaf, 0x97, 0xe7, 0x8c, 0x5c, 0xac, 0xe7,
+	0xc8, 0xb1, 0x38, 0x93, 0xe6, 0x88, 0x63, 0xd7, 0x31, 0xfb, 0x01, 0xce, 0x77, 0x30, 0x13, 0x3b,
+	0xdc, 0xe4, 0xdb, 0x78, 0xc4, 0xc3, 0x24, 0x3e, 0xf4, 0x30, 0xe3, 0x09, 0x2f, 0x94, 0x34, 0x2f,
+	0x1e, 0xa4, 0xb6, 0xb4, 0xec, 0x8b, 0xdc, 0x3a, 0xa2, 0x1d, 0xaf, 0xfa, 0x11, 0x5c, 0x9d, 0x04,
+	0x0a, 0xab, 0x75, 0xb1, 0x5b, 0x74, 0xf5, 0x61, 0x98, 0x61, 0xea, 0x6e, 0x18, 0x64, 0x58, 0x81,
+	0x19, 0x71, 0x11, 0x8b, 0x71, 0x0b, 0x02, 0xb7, 0x43, 0x6c, 0x61, 0xca, 0x88, 0xdd, 0xb2, 0xaa,
+	0x43, 0x78, 0x23, 0x39, 0xfe, 0x03, 0xa3, 0x0b, 0x0e, 0xf6, 0x0f, 0x0a, 0x2c, 0x4d, 0xdc, 0x1f,
+	0x02, 0xdc, 0x1b, 0x50, 0x88, 0xf7, 0xf2, 0xb0, 0x25, 0xc9, 0x44, 0x2a, 0xaa, 0xec, 0x45, 0x44,
+	0xd5, 0x85, 0xc5, 0xa8, 0x0e, 0x17, 0x47, 0x10, 0x06, 0x28, 0x0d, 0xf2, 0xed, 0x90, 0xe3, 0x6f,
+	0x0a, 0xdc, 0x18, 0xbb, 0xab, 0xb0, 0xfa, 0x78, 0x96, 0x17, 0x7c, 0x7f, 0x58, 0x3f, 0x65, 0x1d,
+	0xbf, 0xf5, 0x8d, 0xeb, 0x58, 0x86, 0x36, 0xb6, 0x8c, 0x3f, 0x56, 0xa0, 0x32, 0x99, 0x47, 0xea,
+	0xb4, 0x7a, 0x11, 0x0e, 0x9d, 0x57, 0x28, 0x7f, 0x51, 0xe0, 0xfa, 0x69, 0xbb, 0xfc, 0x85, 0xb0,
+	0x66, 0x22, 0xea, 0xdc, 0x2b, 0x47, 0xfd, 0x7b, 0x05, 0xde, 0x3c, 0x19, 0xf5, 0xff, 0xad, 0x88,
+	0xbf, 0x81, 0xca, 0x19, 0x37, 0xac, 0x20, 0x92, 0x71, 0x2c, 0xe5, 0x95, 0xb1, 0x7c, 0xb8, 0x7e,
+	0xda, 0x59, 0x76, 0x56, 0xbf, 0xce, 0x2b, 0xcd, 0xdf, 0x86, 0xd0, 0x9d, 0xf0, 0xba, 0xdb, 0x70,
+	0xc9, 0x1e, 0xff, 0x96, 0xb6, 0x5a, 0x0c, 0xea, 0x69, 0xd8, 0xe1, 0xbe, 0xf3, 0x08, 0xe6, 0xe3,
+	0x0b, 0xba, 0x25, 0x24, 0x61, 0x81, 0xd5, 0xb1, 0x2b, 0xe2, 0x98, 0x6d, 0x78, 0x09, 0xbc, 0xc4,
+	0xd2, 0x93, 0x55, 0x23, 0x75, 0xfc, 0x7c, 0xe0, 0x61, 0x0f, 0x9f, 0x77, 0xfb, 0xfe, 0x38, 0x0d,
+	0x57, 0x27, 0x11, 0xc2, 0x24, 0xde, 0x83, 0x1b, 0x03, 0x73, 0x64, 0x30, 0x22, 0x7e, 0x1a, 0x98,
+	0x0e, 0xa6, 0x1e, 0x93, 0xbf, 0xb0, 0xa2, 0xeb, 0x7d, 0xf0, 0x0e, 0xbe, 0x3e, 0x30, 0x47, 0x9d,
+	0x94, 0x8a, 0xf0, 0x13, 0xbe, 0x09, 0x34, 0xb8, 0x2c, 0xf4, 0x89, 0x63, 0xa7, 0xdf, 0xa5, 0xb2,
+	0xe1, 0x39, 0x7d, 0x31, 0x14, 0x25, 0x0f, 0x53, 0xd4, 0x82, 0x59, 0xec, 0x70, 0x97, 0x60, 0xb6,
+	0x9c, 0xab, 0xe4, 0x4e, 0xff, 0xb7, 0x71, 0x22, 0x4c, 0xad, 0xe9, 0x70, 0x37, 0x7a, 0x53, 0x47,
+	0xf6, 0x13, 0x37, 0x9c, 0xa9, 0x57, 0xbf, 0x41, 0xff, 0x27, 0x0b, 0xd3, 0x12, 0xe1, 0x9b, 0x0f,
+	0xfc, 0x0b, 0x3e, 0x47, 0xd1, 0x0f, 0x60, 0xde, 0x0d, 0x3a, 0x68, 0x0c, 0xa8, 0xe5, 0xc5, 0xcf,
+	0xc2, 0x4b, 0xe1, 0xec, 0xfb, 0x72, 0x12, 0x21, 0x98, 0xea, 0x9b, 0x0e, 0x96, 0x49, 0x17, 0x74,
+	0xf9, 0x8d, 0xde, 0x85, 0xfc, 0xd0, 0x25, 0xd4, 0x25, 0xdc, 0x97, 0x6f, 0xbe, 0xf9, 0x95, 0xea,
+	0xff, 0xfc, 0x7d, 0xe6, 0x6c, 0x85, 0x9a, 0x7a, 0x6c, 0x83, 0xca, 0x50, 0x4c, 0x77, 0x30, 0x78,
+	0x06, 0x02, 0x4b, 0x5a, 0xa7, 0x42, 0x7e, 0x48, 0x19, 0x91, 0xd5, 0x0e, 0x9e, 0x7d, 0xf1, 0x18,
+	0xfd, 0x04, 0xae, 0x62, 0xc6, 0xc9, 0x40, 0xfe, 0x36, 0x62, 0xdc, 0x74, 0xb9, 0xb1, 0x8f, 0x89,
+	0xbd, 0xcf, 0x97, 0xf3, 0x52, 0x73, 0x29, 0x96, 0x76, 0x84, 0xf0, 0xb1, 0x94, 0xbd, 0xfd, 0x57,
+	0x05, 0x20, 0xf9, 0xaf, 0x83, 0xee, 0xc2, 0xb5, 0x27, 0x9b, 0xdb, 0x4d, 0xa3, 0xb3, 0xbd, 0xba,
+	0xbd, 0xd3, 0x31, 0x76, 0xda, 0x9d, 0xad, 0xe6, 0x5a, 0x6b, 0xbd, 0xd5, 0x6c, 0x2c, 0x64, 0xd4,
+	0x37, 0x9e, 0x3e, 0xab, 0x14, 0x77, 0x1c, 0x36, 0xc4, 0x5d, 0xb2, 0x47, 0xb0, 0x85, 0x6e, 0xc3,
+	0x95, 0xb4, 0x76, 0x7b, 0x73, 0xdb, 0x58, 0xdf, 0xdc, 0x69, 0x37, 0x16, 0x14, 0x75, 0xee, 0xe9,
+	0xb3, 0x4a, 0xbe, 0x4d, 0xf9, 0x3a, 0xf5, 0x1c, 0x0b, 0xbd, 0x09, 0x97, 0xd3, 0x8a, 0x5b, 0xcd,
+	0x76, 0xa3, 0xd5, 0x7e, 0xb4, 0x90, 0x55, 0x8b, 0x4f, 0x9f, 0x55, 0x66, 0xb7, 0xb0, 0x63, 0x11,
+	0xc7, 0x9e, 0xd4, 0x6a, 0x34, 0xd7, 0x5a, 0x8d, 0x66, 0x63, 0x21, 0x17, 0x68, 0x35, 0x70, 0x97,
+	0x58, 0xd8, 0x52, 0xf3, 0x1f, 0xff, 0xa9, 0x94, 0xf9, 0xfc, 0xcf, 0x25, 0xa5, 0xde, 0x3e, 0xfc,
+	0x57, 0x29, 0x73, 0x78, 0x5c, 0x52, 0xbe, 0x3c, 0x2e, 0x29, 0xff, 0x3c, 0x2e, 0x29, 0x9f, 0x3c,
+	0x2f, 0x65, 0xbe, 0x7c, 0x5e, 0xca, 0xfc, 0xe3, 0x79, 0x29, 0xf3, 0x8b, 0x77, 0x5e, 0x82, 0x09,
+	0xf2, 0x87, 0xf6, 0xee, 0x8c, 0xfc, 0xb5, 0xf6, 0xe3, 0xff, 0x0e, 0x00, 0x1a, 0xb1, 0xc9, 0x7d,
+	0xb6, 0x17, 0x00, 0x00,
+}
+
+func (m *QuerySignatureResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1371,19 +1636,38 @@ func (m *QueryRecoveryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySignatureResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.KeygenOutput != nil {
+	if m.Sig != nil {
 		{
-			size, err := m.KeygenOutput.MarshalToSizedBuffer(dAtA[:i])
+			size := m.Sig.Size()
+			i -= size
+			if _, err := m.Sig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignatureResponse_ThresholdSignature_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignatureResponse_ThresholdSignature_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ThresholdSignature != nil {
+		{
+			size, err := m.ThresholdSignature.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1391,44 +1675,32 @@ func (m *QueryRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.Threshold != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
 	}
-	if len(m.PartyShareCounts) > 0 {
-		dAtA10 := make([]byte, len(m.PartyShareCounts)*10)
-		var j9 int
-		for _, num := range m.PartyShareCounts {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
+	return len(dAtA) - i, nil
+}
+func (m *QuerySignatureResponse_MultisigSignature_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignatureResponse_MultisigSignature_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MultisigSignature != nil {
+		{
+			size, err := m.MultisigSignature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintQuery(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.PartyUids) > 0 {
-		for iNdEx := len(m.PartyUids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PartyUids[iNdEx])
-			copy(dAtA[i:], m.PartyUids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.PartyUids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
-
-func (m *QueryKeyShareResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySignatureResponse_Signature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1438,34 +1710,34 @@ func (m *QueryKeyShareResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySignatureResponse_Signature) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySignatureResponse_Signature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ShareInfos) > 0 {
-		for iNdEx := len(m.ShareInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ShareInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryKeyShareResponse_ShareInfo) Marshal() (dAtA []byte, err error) {
+func (m *QuerySignatureResponse_ThresholdSignature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1475,95 +1747,37 @@ func (m *QueryKeyShareResponse_ShareInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryKeyShareResponse_ShareInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySignatureResponse_ThresholdSignature) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryKeyShareResponse_ShareInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySignatureResponse_ThresholdSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NumTotalShares != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumTotalShares))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.NumValidatorShares != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumValidatorShares))
+	if m.Signature != nil {
+		{
+			size, err := m.Signature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x12
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if m.VoteStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteStatus))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SnapshotBlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotBlockNumber))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.KeyRole) > 0 {
-		i -= len(m.KeyRole)
-		copy(dAtA[i:], m.KeyRole)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyRole)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.KeyChain) > 0 {
-		i -= len(m.KeyChain)
-		copy(dAtA[i:], m.KeyChain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyChain)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.KeyID) > 0 {
-		i -= len(m.KeyID)
-		copy(dAtA[i:], m.KeyID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryDeactivatedOperatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDeactivatedOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDeactivatedOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddresses) > 0 {
-		for iNdEx := len(m.OperatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OperatorAddresses[iNdEx])
-			copy(dAtA[i:], m.OperatorAddresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveOldKeysValidatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySignatureResponse_MultisigSignature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1573,20 +1787,20 @@ func (m *QueryActiveOldKeysValidatorResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryActiveOldKeysValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySignatureResponse_MultisigSignature) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActiveOldKeysValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySignatureResponse_MultisigSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.KeysInfo) > 0 {
-		for iNdEx := len(m.KeysInfo) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.KeysInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1594,13 +1808,18 @@ func (m *QueryActiveOldKeysValidatorResponse) MarshalToSizedBuffer(dAtA []byte)
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if m.SigStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SigStatus))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1610,39 +1829,86 @@ func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.RotatedAt != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RotatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotatedAt):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintQuery(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Role != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
-		i--
-		dAtA[i] = 0x12
+	if m.PublicKey != nil {
+		{
+			size := m.PublicKey.Size()
+			i -= size
+			if _, err := m.PublicKey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ID)))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyResponse_ECDSAKey_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyResponse_ECDSAKey_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ECDSAKey != nil {
+		{
+			size, err := m.ECDSAKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
+func (m *QueryKeyResponse_MultisigKey_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *QueryActiveOldKeysResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeyResponse_MultisigKey_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MultisigKey != nil {
+		{
+			size, err := m.MultisigKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueryKeyResponse_Key) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1652,29 +1918,34 @@ func (m *QueryActiveOldKeysResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActiveOldKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeyResponse_Key) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActiveOldKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeyResponse_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.KeyIDs) > 0 {
-		for iNdEx := len(m.KeyIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KeyIDs[iNdEx])
-			copy(dAtA[i:], m.KeyIDs[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyIDs[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Y) > 0 {
+		i -= len(m.Y)
+		copy(dAtA[i:], m.Y)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Y)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.X) > 0 {
+		i -= len(m.X)
+		copy(dAtA[i:], m.X)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.X)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExternalKeyIDResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeyResponse_ECDSAKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1684,29 +1955,35 @@ func (m *QueryExternalKeyIDResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExternalKeyIDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeyResponse_ECDSAKey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExternalKeyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeyResponse_ECDSAKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.KeyIDs) > 0 {
-		for iNdEx := len(m.KeyIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KeyIDs[iNdEx])
-			copy(dAtA[i:], m.KeyIDs[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyIDs[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.VoteStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteStatus))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextKeyIDRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryKeyResponse_MultisigKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1716,32 +1993,39 @@ func (m *QueryNextKeyIDRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNextKeyIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryKeyResponse_MultisigKey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNextKeyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryKeyResponse_MultisigKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.KeyRole != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.KeyRole))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Key) > 0 {
+		for iNdEx := len(m.Key) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Key[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextKeyIDResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRecoveryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1751,410 +2035,3286 @@ func (m *QueryNextKeyIDResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNextKeyIDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNextKeyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.KeyID) > 0 {
-		i -= len(m.KeyID)
-		copy(dAtA[i:], m.KeyID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+	if m.KeygenOutput != nil {
+		{
+			size, err := m.KeygenOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QuerySignatureResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PartyShareCounts) > 0 {
+		dAtA10 := make([]byte, len(m.PartyShareCounts)*10)
+		var j9 int
+		for _, num := range m.PartyShareCounts {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Sig != nil {
-		n += m.Sig.Size()
+	if len(m.PartyUids) > 0 {
+		for iNdEx := len(m.PartyUids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartyUids[iNdEx])
+			copy(dAtA[i:], m.PartyUids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PartyUids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignatureResponse_ThresholdSignature_) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ThresholdSignature != nil {
-		l = m.ThresholdSignature.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ShareInfos) > 0 {
+		for iNdEx := len(m.ShareInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
-func (m *QuerySignatureResponse_MultisigSignature_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryKeyShareResponse_ShareInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyShareResponse_ShareInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyShareResponse_ShareInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MultisigSignature != nil {
-		l = m.MultisigSignature.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.NumTotalShares != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumTotalShares))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-func (m *QuerySignatureResponse_Signature) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.NumValidatorShares != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumValidatorShares))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SnapshotBlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotBlockNumber))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *QuerySignatureResponse_ThresholdSignature) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.KeyRole) > 0 {
+		i -= len(m.KeyRole)
+		copy(dAtA[i:], m.KeyRole)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyRole)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.VoteStatus != 0 {
-		n += 1 + sovQuery(uint64(m.VoteStatus))
+	if len(m.KeyChain) > 0 {
+		i -= len(m.KeyChain)
+		copy(dAtA[i:], m.KeyChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyChain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Signature != nil {
-		l = m.Signature.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySignatureResponse_MultisigSignature) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDeactivatedOperatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeactivatedOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeactivatedOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SigStatus != 0 {
-		n += 1 + sovQuery(uint64(m.SigStatus))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.OperatorAddresses) > 0 {
+		for iNdEx := len(m.OperatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OperatorAddresses[iNdEx])
+			copy(dAtA[i:], m.OperatorAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PublicKey != nil {
-		n += m.PublicKey.Size()
-	}
-	if m.Role != 0 {
-		n += 1 + sovQuery(uint64(m.Role))
-	}
-	if m.RotatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotatedAt)
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryActiveOldKeysValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryKeyResponse_ECDSAKey_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ECDSAKey != nil {
-		l = m.ECDSAKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryActiveOldKeysValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryKeyResponse_MultisigKey_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryActiveOldKeysValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MultisigKey != nil {
-		l = m.MultisigKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.KeysInfo) > 0 {
+		for iNdEx := len(m.KeysInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeysInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
-func (m *QueryKeyResponse_Key) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.X)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Y)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryKeyResponse_ECDSAKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VoteStatus != 0 {
-		n += 1 + sovQuery(uint64(m.VoteStatus))
+func (m *QueryActiveOldKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Key.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryKeyResponse_MultisigKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActiveOldKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveOldKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Threshold != 0 {
-		n += 1 + sovQuery(uint64(m.Threshold))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		for _, e := range m.Key {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.KeyIDs) > 0 {
+		for iNdEx := len(m.KeyIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyIDs[iNdEx])
+			copy(dAtA[i:], m.KeyIDs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecoveryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExternalKeyIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalKeyIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalKeyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PartyUids) > 0 {
-		for _, s := range m.PartyUids {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.PartyShareCounts) > 0 {
-		l = 0
-		for _, e := range m.PartyShareCounts {
-			l += sovQuery(uint64(e))
+	if len(m.KeyIDs) > 0 {
+		for iNdEx := len(m.KeyIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyIDs[iNdEx])
+			copy(dAtA[i:], m.KeyIDs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		n += 1 + sovQuery(uint64(l)) + l
-	}
-	if m.Threshold != 0 {
-		n += 1 + sovQuery(uint64(m.Threshold))
-	}
-	if m.KeygenOutput != nil {
-		l = m.KeygenOutput.Size()
-		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ShareInfos) > 0 {
-		for _, e := range m.ShareInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryNextKeyIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryKeyShareResponse_ShareInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNextKeyIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextKeyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.KeyChain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.KeyRole)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.KeyRole != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SnapshotBlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.SnapshotBlockNumber))
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.NumValidatorShares != 0 {
-		n += 1 + sovQuery(uint64(m.NumValidatorShares))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextKeyIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.NumTotalShares != 0 {
-		n += 1 + sovQuery(uint64(m.NumTotalShares))
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextKeyIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextKeyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDeactivatedOperatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySignatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OperatorAddresses) > 0 {
-		for _, s := range m.OperatorAddresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.SigID) > 0 {
+		i -= len(m.SigID)
+		copy(dAtA[i:], m.SigID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveOldKeysValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.KeysInfo) > 0 {
-		for _, e := range m.KeysInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Role != 0 {
-		n += 1 + sovQuery(uint64(m.Role))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveOldKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryKeyIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.KeyIDs) > 0 {
-		for _, s := range m.KeyIDs {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.KeyRole != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExternalKeyIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryKeyIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.KeyIDs) > 0 {
-		for _, s := range m.KeyIDs {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextKeyIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryKeySharesByKeyIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeySharesByKeyIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeySharesByKeyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.KeyRole != 0 {
-		n += 1 + sovQuery(uint64(m.KeyRole))
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNextKeyIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryKeySharesByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeySharesByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeySharesByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveOldKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveOldKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveOldKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveOldKeysByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveOldKeysByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveOldKeysByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeactivatedOperatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeactivatedOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeactivatedOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalKeyIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalKeyIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalKeyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySnapshotDriftRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnapshotDriftRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnapshotDriftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySnapshotDriftResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnapshotDriftResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnapshotDriftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SnapshotDrift.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SigningShareCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SigningShareCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxSimultaneousSignShares != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSimultaneousSignShares))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignQueueResponse_Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignQueueResponse_Entry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignQueueResponse_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedStartHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ShareCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Priority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RequestModule) > 0 {
+		i -= len(m.RequestModule)
+		copy(dAtA[i:], m.RequestModule)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestModule)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SigID) > 0 {
+		i -= len(m.SigID)
+		copy(dAtA[i:], m.SigID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sig != nil {
+		n += m.Sig.Size()
+	}
+	return n
+}
+
+func (m *QuerySignatureResponse_ThresholdSignature_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdSignature != nil {
+		l = m.ThresholdSignature.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QuerySignatureResponse_MultisigSignature_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigSignature != nil {
+		l = m.MultisigSignature.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QuerySignatureResponse_Signature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignatureResponse_ThresholdSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteStatus != 0 {
+		n += 1 + sovQuery(uint64(m.VoteStatus))
+	}
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignatureResponse_MultisigSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigStatus != 0 {
+		n += 1 + sovQuery(uint64(m.SigStatus))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PublicKey != nil {
+		n += m.PublicKey.Size()
+	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	if m.RotatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotatedAt)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyResponse_ECDSAKey_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ECDSAKey != nil {
+		l = m.ECDSAKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QueryKeyResponse_MultisigKey_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigKey != nil {
+		l = m.MultisigKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QueryKeyResponse_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.X)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Y)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyResponse_ECDSAKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteStatus != 0 {
+		n += 1 + sovQuery(uint64(m.VoteStatus))
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryKeyResponse_MultisigKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if len(m.Key) > 0 {
+		for _, e := range m.Key {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartyUids) > 0 {
+		for _, s := range m.PartyUids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PartyShareCounts) > 0 {
+		l = 0
+		for _, e := range m.PartyShareCounts {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if m.KeygenOutput != nil {
+		l = m.KeygenOutput.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShareInfos) > 0 {
+		for _, e := range m.ShareInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyShareResponse_ShareInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyRole)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SnapshotBlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotBlockNumber))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumValidatorShares != 0 {
+		n += 1 + sovQuery(uint64(m.NumValidatorShares))
+	}
+	if m.NumTotalShares != 0 {
+		n += 1 + sovQuery(uint64(m.NumTotalShares))
+	}
+	return n
+}
+
+func (m *QueryDeactivatedOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorAddresses) > 0 {
+		for _, s := range m.OperatorAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveOldKeysValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeysInfo) > 0 {
+		for _, e := range m.KeysInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *QueryActiveOldKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyIDs) > 0 {
+		for _, s := range m.KeyIDs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalKeyIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyIDs) > 0 {
+		for _, s := range m.KeyIDs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextKeyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovQuery(uint64(m.KeyRole))
+	}
+	return n
+}
+
+func (m *QueryNextKeyIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SigID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovQuery(uint64(m.KeyRole))
+	}
+	return n
+}
+
+func (m *QueryKeyIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeySharesByKeyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeySharesByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveOldKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovQuery(uint64(m.KeyRole))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveOldKeysByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeactivatedOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalKeyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySnapshotDriftRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySnapshotDriftResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SnapshotDrift.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySignQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSimultaneousSignShares != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSimultaneousSignShares))
+	}
+	if m.SigningShareCount != 0 {
+		n += 1 + sovQuery(uint64(m.SigningShareCount))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignQueueResponse_Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SigID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RequestModule)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQuery(uint64(m.Priority))
+	}
+	if m.ShareCount != 0 {
+		n += 1 + sovQuery(uint64(m.ShareCount))
+	}
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	if m.EstimatedStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedStartHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySignatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QuerySignatureResponse_ThresholdSignature{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sig = &QuerySignatureResponse_ThresholdSignature_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigSignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QuerySignatureResponse_MultisigSignature{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sig = &QuerySignatureResponse_MultisigSignature_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignatureResponse_Signature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignatureResponse_ThresholdSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteStatus", wireType)
+			}
+			m.VoteStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteStatus |= VoteStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signature == nil {
+				m.Signature = &QuerySignatureResponse_Signature{}
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignatureResponse_MultisigSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigStatus", wireType)
+			}
+			m.SigStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigStatus |= exported.SigStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, QuerySignatureResponse_Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ECDSAKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryKeyResponse_ECDSAKey{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.PublicKey = &QueryKeyResponse_ECDSAKey_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryKeyResponse_MultisigKey{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.PublicKey = &QueryKeyResponse_MultisigKey_{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RotatedAt == nil {
+				m.RotatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RotatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyResponse_Key) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Key: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Key: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.X = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Y", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Y = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyResponse_ECDSAKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ECDSAKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ECDSAKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteStatus", wireType)
+			}
+			m.VoteStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteStatus |= VoteStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyResponse_MultisigKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key, QueryKeyResponse_Key{})
+			if err := m.Key[len(m.Key)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyUids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyUids = append(m.PartyUids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PartyShareCounts = append(m.PartyShareCounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PartyShareCounts) == 0 {
+					m.PartyShareCounts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PartyShareCounts = append(m.PartyShareCounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyShareCounts", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeygenOutput == nil {
+				m.KeygenOutput = &tofnd.KeygenOutput{}
+			}
+			if err := m.KeygenOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareInfos = append(m.ShareInfos, QueryKeyShareResponse_ShareInfo{})
+			if err := m.ShareInfos[len(m.ShareInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRole = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotBlockNumber", wireType)
+			}
+			m.SnapshotBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotBlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidatorShares", wireType)
+			}
+			m.NumValidatorShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidatorShares |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTotalShares", wireType)
+			}
+			m.NumTotalShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTotalShares |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySignatureResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeactivatedOperatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2177,17 +5337,17 @@ func (m *QuerySignatureResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySignatureResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeactivatedOperatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeactivatedOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2197,30 +5357,27 @@ func (m *QuerySignatureResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &QuerySignatureResponse_ThresholdSignature{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Sig = &QuerySignatureResponse_ThresholdSignature_{v}
+			m.OperatorAddresses = append(m.OperatorAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2247,11 +5404,12 @@ func (m *QuerySignatureResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &QuerySignatureResponse_MultisigSignature{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sig = &QuerySignatureResponse_MultisigSignature_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2274,7 +5432,7 @@ func (m *QuerySignatureResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignatureResponse_Signature) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveOldKeysValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2297,17 +5455,17 @@ func (m *QuerySignatureResponse_Signature) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Signature: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveOldKeysValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveOldKeysValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2317,29 +5475,31 @@ func (m *QuerySignatureResponse_Signature) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.R = string(dAtA[iNdEx:postIndex])
+			m.KeysInfo = append(m.KeysInfo, QueryActiveOldKeysValidatorResponse_KeyInfo{})
+			if err := m.KeysInfo[len(m.KeysInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2349,23 +5509,27 @@ func (m *QuerySignatureResponse_Signature) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2388,7 +5552,7 @@ func (m *QuerySignatureResponse_Signature) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySignatureResponse_ThresholdSignature) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2411,17 +5575,17 @@ func (m *QuerySignatureResponse_ThresholdSignature) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ThresholdSignature: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThresholdSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteStatus", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.VoteStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2431,16 +5595,29 @@ func (m *QuerySignatureResponse_ThresholdSignature) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VoteStatus |= VoteStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2450,28 +5627,43 @@ func (m *QuerySignatureResponse_ThresholdSignature) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signature == nil {
-				m.Signature = &QuerySignatureResponse_Signature{}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2493,7 +5685,7 @@ func (m *QuerySignatureResponse_ThresholdSignature) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QuerySignatureResponse_MultisigSignature) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveOldKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2516,17 +5708,17 @@ func (m *QuerySignatureResponse_MultisigSignature) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultisigSignature: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveOldKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultisigSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveOldKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigStatus", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
 			}
-			m.SigStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2536,14 +5728,27 @@ func (m *QuerySignatureResponse_MultisigSignature) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigStatus |= exported.SigStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyIDs = append(m.KeyIDs, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2570,8 +5775,10 @@ func (m *QuerySignatureResponse_MultisigSignature) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, QuerySignatureResponse_Signature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2596,7 +5803,7 @@ func (m *QuerySignatureResponse_MultisigSignature) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalKeyIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2619,52 +5826,17 @@ func (m *QueryKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExternalKeyIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExternalKeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ECDSAKey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &QueryKeyResponse_ECDSAKey{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.PublicKey = &QueryKeyResponse_ECDSAKey_{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2674,49 +5846,27 @@ func (m *QueryKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &QueryKeyResponse_MultisigKey{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.PublicKey = &QueryKeyResponse_MultisigKey_{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
-			}
-			m.Role = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Role |= exported.KeyRole(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			m.KeyIDs = append(m.KeyIDs, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RotatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2743,10 +5893,10 @@ func (m *QueryKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RotatedAt == nil {
-				m.RotatedAt = new(time.Time)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RotatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2771,7 +5921,7 @@ func (m *QueryKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyResponse_Key) Unmarshal(dAtA []byte) error {
+func (m *QueryNextKeyIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2794,15 +5944,15 @@ func (m *QueryKeyResponse_Key) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Key: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextKeyIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Key: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextKeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2830,13 +5980,13 @@ func (m *QueryKeyResponse_Key) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.X = string(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Y", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
 			}
-			var stringLen uint64
+			m.KeyRole = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2846,24 +5996,11 @@ func (m *QueryKeyResponse_Key) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Y = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2885,7 +6022,7 @@ func (m *QueryKeyResponse_Key) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyResponse_ECDSAKey) Unmarshal(dAtA []byte) error {
+func (m *QueryNextKeyIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2908,36 +6045,17 @@ func (m *QueryKeyResponse_ECDSAKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ECDSAKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextKeyIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ECDSAKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextKeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteStatus", wireType)
-			}
-			m.VoteStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteStatus |= VoteStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2947,24 +6065,23 @@ func (m *QueryKeyResponse_ECDSAKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2987,7 +6104,7 @@ func (m *QueryKeyResponse_ECDSAKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyResponse_MultisigKey) Unmarshal(dAtA []byte) error {
+func (m *QuerySignatureRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3010,36 +6127,17 @@ func (m *QueryKeyResponse_MultisigKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultisigKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignatureRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultisigKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-			}
-			m.Threshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Threshold |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3049,25 +6147,23 @@ func (m *QueryKeyResponse_MultisigKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key, QueryKeyResponse_Key{})
-			if err := m.Key[len(m.Key)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SigID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3090,7 +6186,7 @@ func (m *QueryKeyResponse_MultisigKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecoveryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3113,15 +6209,15 @@ func (m *QueryRecoveryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecoveryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartyUids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3149,89 +6245,95 @@ func (m *QueryRecoveryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartyUids = append(m.PartyUids, string(dAtA[iNdEx:postIndex]))
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PartyShareCounts = append(m.PartyShareCounts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PartyShareCounts) == 0 {
-					m.PartyShareCounts = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PartyShareCounts = append(m.PartyShareCounts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartyShareCounts", wireType)
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Threshold = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3241,16 +6343,79 @@ func (m *QueryRecoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3260,28 +6425,43 @@ func (m *QueryRecoveryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KeygenOutput == nil {
-				m.KeygenOutput = &tofnd.KeygenOutput{}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
 			}
-			if err := m.KeygenOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3303,7 +6483,7 @@ func (m *QueryRecoveryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3326,17 +6506,17 @@ func (m *QueryKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3346,25 +6526,23 @@ func (m *QueryKeyShareResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShareInfos = append(m.ShareInfos, QueryKeyShareResponse_ShareInfo{})
-			if err := m.ShareInfos[len(m.ShareInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3387,7 +6565,7 @@ func (m *QueryKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryKeySharesByKeyIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3410,10 +6588,10 @@ func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShareInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeySharesByKeyIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeySharesByKeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3450,9 +6628,9 @@ func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3462,27 +6640,81 @@ func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyChain = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeySharesByValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeySharesByValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeySharesByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3510,13 +6742,13 @@ func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyRole = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotBlockNumber", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.SnapshotBlockNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3526,14 +6758,81 @@ func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotBlockNumber |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveOldKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveOldKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveOldKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3561,13 +6860,13 @@ func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumValidatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
 			}
-			m.NumValidatorShares = 0
+			m.KeyRole = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3577,16 +6876,16 @@ func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumValidatorShares |= int64(b&0x7F) << shift
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumTotalShares", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.NumTotalShares = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3596,11 +6895,28 @@ func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumTotalShares |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3622,7 +6938,7 @@ func (m *QueryKeyShareResponse_ShareInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeactivatedOperatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveOldKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3645,15 +6961,15 @@ func (m *QueryDeactivatedOperatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeactivatedOperatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveOldKeysByValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeactivatedOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveOldKeysByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3681,7 +6997,43 @@ func (m *QueryDeactivatedOperatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddresses = append(m.OperatorAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3704,7 +7056,7 @@ func (m *QueryDeactivatedOperatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveOldKeysValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeactivatedOperatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3727,15 +7079,15 @@ func (m *QueryActiveOldKeysValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveOldKeysValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeactivatedOperatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveOldKeysValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeactivatedOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeysInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3762,8 +7114,10 @@ func (m *QueryActiveOldKeysValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeysInfo = append(m.KeysInfo, QueryActiveOldKeysValidatorResponse_KeyInfo{})
-			if err := m.KeysInfo[len(m.KeysInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3788,7 +7142,7 @@ func (m *QueryActiveOldKeysValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalKeyIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3811,15 +7165,15 @@ func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExternalKeyIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExternalKeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3847,11 +7201,97 @@ func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySnapshotDriftRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySnapshotDriftRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySnapshotDriftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3879,13 +7319,63 @@ func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Role = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySnapshotDriftResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySnapshotDriftResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySnapshotDriftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotDrift", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3895,11 +7385,25 @@ func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= github_com_axelarnetwork_axelar_core_x_tss_exported.KeyRole(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SnapshotDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3921,7 +7425,7 @@ func (m *QueryActiveOldKeysValidatorResponse_KeyInfo) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryActiveOldKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3944,17 +7448,17 @@ func (m *QueryActiveOldKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveOldKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveOldKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3964,23 +7468,27 @@ func (m *QueryActiveOldKeysResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyIDs = append(m.KeyIDs, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4003,7 +7511,7 @@ func (m *QueryActiveOldKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExternalKeyIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4026,17 +7534,55 @@ func (m *QueryExternalKeyIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExternalKeyIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExternalKeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSimultaneousSignShares", wireType)
+			}
+			m.MaxSimultaneousSignShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSimultaneousSignShares |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningShareCount", wireType)
+			}
+			m.SigningShareCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningShareCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4046,23 +7592,61 @@ func (m *QueryExternalKeyIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyIDs = append(m.KeyIDs, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			m.Entries = append(m.Entries, QuerySignQueueResponse_Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4085,7 +7669,7 @@ func (m *QueryExternalKeyIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextKeyIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignQueueResponse_Entry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4108,15 +7692,15 @@ func (m *QueryNextKeyIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextKeyIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextKeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4144,13 +7728,13 @@ func (m *QueryNextKeyIDRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.SigID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			m.KeyRole = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4160,64 +7744,59 @@ func (m *QueryNextKeyIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNextKeyIDResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestModule", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextKeyIDResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextKeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4245,8 +7824,84 @@ func (m *QueryNextKeyIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			m.Lane = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= exported.SignPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCount", wireType)
+			}
+			m.ShareCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedStartHeight", wireType)
+			}
+			m.EstimatedStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
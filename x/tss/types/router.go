@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 )
@@ -65,3 +66,70 @@ func (r *router) GetRoute(module string) exported.Handler {
 
 	return r.routes[module]
 }
+
+// KeyRotationRouter implements a router for the handlers of automatic key rotations
+type KeyRotationRouter interface {
+	AddRoute(module string, handler exported.KeyRotationHandler) KeyRotationRouter
+	HasRoute(module string) bool
+	GetRoutes() []exported.KeyRotationHandler
+	Seal()
+}
+
+var _ KeyRotationRouter = (*keyRotationRouter)(nil)
+
+type keyRotationRouter struct {
+	routes map[string]exported.KeyRotationHandler
+	sealed bool
+}
+
+// NewKeyRotationRouter creates a new KeyRotationRouter interface instance
+func NewKeyRotationRouter() KeyRotationRouter {
+	return &keyRotationRouter{
+		routes: make(map[string]exported.KeyRotationHandler),
+	}
+}
+
+// Seal prevents additional route handlers from being added to the router.
+func (r *keyRotationRouter) Seal() {
+	r.sealed = true
+}
+
+// AddRoute registers a key rotation handler for a given module and returns the router.
+// Panics if the router is sealed, module is an empty string, or if the module has been registered already.
+func (r *keyRotationRouter) AddRoute(module string, handler exported.KeyRotationHandler) KeyRotationRouter {
+	if r.sealed {
+		panic("cannot add handler (router sealed)")
+	}
+
+	if module == "" {
+		panic("module name cannot be an empty string")
+	}
+
+	if r.HasRoute(module) {
+		panic(fmt.Sprintf("key rotation handler for module %s has already been registered", module))
+	}
+
+	r.routes[module] = handler
+	return r
+}
+
+// HasRoute returns true if the router has a key rotation handler registered for the given module
+func (r *keyRotationRouter) HasRoute(module string) bool {
+	return r.routes[module] != nil
+}
+
+// GetRoutes returns all registered key rotation handlers, ordered by module name
+func (r *keyRotationRouter) GetRoutes() []exported.KeyRotationHandler {
+	modules := make([]string, 0, len(r.routes))
+	for module := range r.routes {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	handlers := make([]exported.KeyRotationHandler, 0, len(modules))
+	for _, module := range modules {
+		handlers = append(handlers, r.routes[module])
+	}
+
+	return handlers
+}
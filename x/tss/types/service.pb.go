@@ -34,42 +34,69 @@ func init() { proto.RegisterFile("tss/v1beta1/service.proto", fileDescriptor_604
 func init() { golang_proto.RegisterFile("tss/v1beta1/service.proto", fileDescriptor_604dc337414bd075) }
 
 var fileDescriptor_604dc337414bd075 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x0e, 0x45, 0xdd, 0xde, 0xac, 0x54, 0xd0, 0x10, 0x9c, 0x62, 0x89, 0x02, 0x55,
-	0x13, 0x53, 0xb8, 0x71, 0xac, 0x84, 0x84, 0x14, 0x8a, 0xaa, 0x18, 0x38, 0x70, 0x5b, 0x5b, 0xd3,
-	0xcd, 0xaa, 0x89, 0x37, 0xdd, 0x19, 0x87, 0x44, 0x08, 0x21, 0x78, 0x02, 0x24, 0x4e, 0xbc, 0x0d,
-	0x47, 0x8e, 0x95, 0xb8, 0x70, 0x44, 0x09, 0x0f, 0x82, 0xec, 0xac, 0x13, 0xdb, 0x38, 0x90, 0x9b,
-	0x3d, 0xff, 0xbf, 0xf3, 0xfd, 0x33, 0x5e, 0x99, 0xed, 0x11, 0xa2, 0x37, 0x3e, 0x0e, 0x80, 0xf8,
-	0xb1, 0x87, 0xa0, 0xc7, 0x32, 0x84, 0xce, 0x48, 0x2b, 0x52, 0xf6, 0x0e, 0x21, 0x76, 0x8c, 0xd4,
-	0xa8, 0x0b, 0x25, 0x54, 0x5a, 0xf7, 0x92, 0xa7, 0x85, 0xa5, 0xd1, 0x14, 0x4a, 0x89, 0x01, 0x78,
-	0x7c, 0x24, 0x3d, 0x1e, 0x45, 0x8a, 0x38, 0x49, 0x15, 0xa1, 0x51, 0xf7, 0x30, 0xe2, 0x23, 0xec,
-	0x2b, 0x5a, 0x02, 0x68, 0x62, 0xa4, 0x7a, 0x1e, 0x9b, 0x55, 0x1f, 0x7d, 0xdc, 0x66, 0xec, 0x14,
-	0x85, 0xbf, 0x88, 0x61, 0x7f, 0xb5, 0x58, 0xbd, 0x07, 0x42, 0x22, 0x81, 0x7e, 0x3a, 0x21, 0xd0,
-	0x11, 0x1f, 0x74, 0x61, 0x8a, 0xf6, 0xfd, 0x4e, 0x2e, 0x5a, 0xa7, 0xca, 0xd2, 0x83, 0xcb, 0x18,
-	0x90, 0x1a, 0x0f, 0x36, 0x70, 0xe2, 0x48, 0x45, 0x08, 0xee, 0xd1, 0xa7, 0x1f, 0xbf, 0xbf, 0x5c,
-	0x3b, 0x70, 0xef, 0x78, 0x7c, 0x02, 0x03, 0xae, 0xbd, 0x24, 0xa2, 0x36, 0x27, 0xda, 0x60, 0x8e,
-	0xb4, 0x2f, 0x60, 0xfa, 0xc4, 0x3a, 0xb4, 0x07, 0x6c, 0xfb, 0x19, 0x70, 0x4d, 0x27, 0xc0, 0xc9,
-	0xbe, 0x5d, 0xa0, 0x2c, 0xeb, 0x59, 0x08, 0x67, 0x9d, 0x6c, 0xc8, 0xfb, 0x29, 0xb9, 0xe1, 0xee,
-	0xe6, 0xc9, 0xfd, 0xc4, 0x16, 0x00, 0xa7, 0x84, 0x46, 0x6c, 0xc7, 0x27, 0xae, 0xa9, 0x0b, 0x53,
-	0x01, 0x91, 0xdd, 0x2a, 0x34, 0xcc, 0x29, 0x19, 0x71, 0x7f, 0xbd, 0xc1, 0x30, 0xdd, 0x94, 0xd9,
-	0x74, 0x6f, 0xe4, 0x99, 0xb8, 0x32, 0x26, 0x54, 0x64, 0xf5, 0x33, 0xad, 0x42, 0x40, 0x5c, 0xd4,
-	0x5e, 0x6a, 0x7e, 0x7e, 0x2e, 0xc3, 0xd2, 0xfa, 0xab, 0x2c, 0xd5, 0xeb, 0xaf, 0x76, 0x9a, 0x40,
-	0x5b, 0x69, 0xa0, 0x9a, 0x7d, 0xc9, 0xb6, 0x7b, 0xc9, 0x3d, 0x82, 0x2e, 0x4c, 0x4b, 0x8b, 0x5d,
-	0xd6, 0xab, 0x17, 0x9b, 0x93, 0x4d, 0xcf, 0xbb, 0x69, 0xcf, 0x96, 0xdb, 0xc8, 0x0f, 0xc9, 0x11,
-	0xa5, 0x88, 0xbc, 0x77, 0x61, 0x9f, 0xcb, 0xe8, 0x7d, 0x32, 0xe7, 0x2b, 0xc6, 0x5e, 0x2b, 0x82,
-	0xb3, 0x38, 0x48, 0x98, 0xc5, 0xa6, 0x2b, 0x21, 0x83, 0xb6, 0xd6, 0xea, 0xa5, 0x49, 0x86, 0xcc,
-	0x36, 0x13, 0xfb, 0x52, 0x2c, 0x97, 0x77, 0x50, 0xb5, 0x92, 0x9c, 0x21, 0xc3, 0xdc, 0xfb, 0xaf,
-	0xaf, 0x84, 0x7b, 0xce, 0xae, 0x27, 0x61, 0x7c, 0x29, 0xec, 0x5b, 0x7f, 0x45, 0xf4, 0xa5, 0xc8,
-	0x1a, 0x37, 0xab, 0xc5, 0x52, 0xb7, 0x31, 0xdb, 0xf5, 0xe3, 0x60, 0x28, 0xe9, 0x34, 0x1e, 0x90,
-	0x44, 0x29, 0x16, 0x43, 0xa2, 0x5d, 0xfc, 0xa4, 0x95, 0x9e, 0x8c, 0x74, 0xb8, 0x89, 0xb5, 0xc4,
-	0xfd, 0xc0, 0x6e, 0x16, 0x8d, 0xc9, 0xc8, 0x9c, 0x62, 0x0d, 0x68, 0x1f, 0xfd, 0xa3, 0xdf, 0xca,
-	0x96, 0xd1, 0xdb, 0x1b, 0xba, 0x8b, 0x01, 0x4e, 0x5e, 0x7c, 0x9f, 0x39, 0xd6, 0xd5, 0xcc, 0xb1,
-	0x7e, 0xcd, 0x1c, 0xeb, 0xf3, 0xdc, 0xa9, 0x7d, 0x9b, 0x3b, 0xd6, 0xd5, 0xdc, 0xa9, 0xfd, 0x9c,
-	0x3b, 0xb5, 0x37, 0x0f, 0x85, 0xa4, 0x7e, 0x1c, 0x74, 0x42, 0x35, 0x34, 0x77, 0x2a, 0x02, 0x7a,
-	0xab, 0xf4, 0x85, 0x79, 0x6b, 0x87, 0x4a, 0x83, 0x37, 0x49, 0x2f, 0x1a, 0x4d, 0x47, 0x80, 0xc1,
-	0x56, 0xfa, 0x6b, 0x7b, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x46, 0xd8, 0x6a, 0x95, 0x69, 0x05,
-	0x00, 0x00,
+	// 986 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x20, 0x0a, 0x9e, 0x12, 0x01, 0x23, 0x97, 0xa4, 0x26, 0xd9, 0x24, 0x8e, 0x9a,
+	0x36, 0x25, 0xeb, 0x6d, 0x5a, 0x2e, 0x70, 0x23, 0x0a, 0x12, 0x95, 0xe9, 0xaf, 0x18, 0x7a, 0x80,
+	0xd3, 0xd8, 0x7e, 0x59, 0x8f, 0xe2, 0xec, 0x38, 0x33, 0xb3, 0xc6, 0xab, 0x28, 0x42, 0x45, 0x5c,
+	0x91, 0x90, 0x38, 0x71, 0x82, 0xbf, 0x83, 0x13, 0x47, 0x8e, 0x95, 0xb8, 0x70, 0x44, 0x09, 0x57,
+	0xfe, 0x07, 0xb4, 0xb3, 0xb3, 0xeb, 0xd9, 0xf5, 0x78, 0x93, 0xde, 0xec, 0x79, 0xdf, 0x79, 0x9f,
+	0xef, 0xbc, 0xb7, 0xf3, 0x03, 0xdd, 0x94, 0x42, 0xf8, 0xe3, 0xdd, 0x2e, 0x48, 0xb2, 0xeb, 0x0b,
+	0xe0, 0x63, 0xda, 0x83, 0xd6, 0x88, 0x33, 0xc9, 0xf0, 0x75, 0x29, 0x44, 0x4b, 0x87, 0x1a, 0xf5,
+	0x80, 0x05, 0x4c, 0x8d, 0xfb, 0xc9, 0xaf, 0x54, 0xd2, 0x58, 0x09, 0x18, 0x0b, 0x86, 0xe0, 0x93,
+	0x11, 0xf5, 0x49, 0x18, 0x32, 0x49, 0x24, 0x65, 0xa1, 0xd0, 0xd1, 0x9b, 0x22, 0x24, 0x23, 0x31,
+	0x60, 0x32, 0x07, 0xc8, 0x89, 0x0e, 0xd5, 0x4d, 0x6c, 0x3e, 0xba, 0x64, 0x8e, 0x9e, 0x44, 0xc0,
+	0xe3, 0x34, 0x70, 0xff, 0x45, 0x0d, 0xa1, 0x47, 0x22, 0xe8, 0xa4, 0xfe, 0xf0, 0x2f, 0x0e, 0xaa,
+	0x1f, 0x40, 0x40, 0x85, 0x04, 0xfe, 0xd9, 0x44, 0x02, 0x0f, 0xc9, 0xb0, 0x0d, 0xb1, 0xc0, 0x77,
+	0x5a, 0x86, 0xe7, 0x96, 0x4d, 0x72, 0x00, 0x27, 0x11, 0x08, 0xd9, 0xd8, 0xbe, 0x82, 0x52, 0x8c,
+	0x58, 0x28, 0xa0, 0xb9, 0xf3, 0xfd, 0x5f, 0xff, 0xfe, 0xfc, 0xda, 0x56, 0x73, 0xc3, 0x27, 0x13,
+	0x18, 0x12, 0xee, 0x27, 0x2e, 0xb9, 0x9e, 0xe1, 0x81, 0x9e, 0xe2, 0x1d, 0x41, 0xfc, 0x89, 0x73,
+	0x17, 0x0f, 0x51, 0xed, 0x73, 0x20, 0x5c, 0xee, 0x01, 0x91, 0x78, 0xb5, 0x40, 0xc9, 0xc7, 0x33,
+	0x13, 0xee, 0xbc, 0xb0, 0x26, 0xaf, 0x2b, 0x72, 0xa3, 0x79, 0xc3, 0x24, 0x0f, 0x12, 0x59, 0x17,
+	0x88, 0x4c, 0x68, 0x12, 0x5d, 0xef, 0x48, 0xc2, 0x65, 0x1b, 0xe2, 0x00, 0x42, 0xbc, 0x56, 0x48,
+	0x68, 0x44, 0x32, 0xe2, 0xfa, 0x7c, 0x81, 0x66, 0x36, 0x15, 0x73, 0xa5, 0xb9, 0x64, 0x32, 0xc5,
+	0x54, 0x98, 0x50, 0x05, 0xaa, 0x3f, 0xe5, 0xac, 0x07, 0x42, 0xa4, 0x63, 0x5f, 0x72, 0x72, 0x78,
+	0x48, 0x7b, 0xa5, 0xf2, 0xdb, 0x24, 0xf6, 0xf2, 0xdb, 0x95, 0xda, 0xd0, 0x35, 0x65, 0x68, 0x01,
+	0x9f, 0xa0, 0xda, 0x41, 0xf2, 0x81, 0x41, 0x1b, 0xe2, 0x52, 0x61, 0xf3, 0x71, 0x7b, 0x61, 0x8d,
+	0xb0, 0xce, 0x79, 0x4b, 0xe5, 0x5c, 0x6b, 0x36, 0xcc, 0x45, 0x12, 0x21, 0x68, 0x10, 0xfa, 0xa7,
+	0xbd, 0x01, 0xa1, 0xe1, 0x59, 0xb2, 0xce, 0xaf, 0x10, 0x7a, 0xce, 0x24, 0x3c, 0x8d, 0xba, 0x09,
+	0xb3, 0x98, 0x74, 0x1a, 0xc8, 0xa0, 0x6b, 0x73, 0xe3, 0xa5, 0x95, 0x1c, 0x23, 0xac, 0x57, 0xdc,
+	0xa1, 0x41, 0x5e, 0xbc, 0x2d, 0x5b, 0x49, 0x0c, 0x41, 0x86, 0xb9, 0x7d, 0xa9, 0xae, 0x84, 0xfb,
+	0x02, 0xbd, 0x99, 0x98, 0xe9, 0xd0, 0x00, 0x7f, 0x30, 0x63, 0xb1, 0x43, 0x83, 0x2c, 0xf1, 0x8a,
+	0x3d, 0x58, 0xca, 0x36, 0x46, 0x37, 0x3a, 0x51, 0xf7, 0x98, 0xca, 0x47, 0xd1, 0x50, 0x52, 0x41,
+	0x83, 0x74, 0x91, 0x02, 0x17, 0x5b, 0x6a, 0xd5, 0x64, 0xa4, 0xbb, 0x57, 0x91, 0x96, 0xb8, 0xdf,
+	0xa1, 0xe5, 0xa2, 0x30, 0x59, 0x32, 0x91, 0x11, 0x07, 0x81, 0x77, 0x2a, 0xf2, 0x4d, 0x65, 0x19,
+	0xdd, 0xbb, 0xa2, 0xba, 0x68, 0xe0, 0xfe, 0x7f, 0x8b, 0xe8, 0xed, 0x67, 0xc9, 0x99, 0x94, 0x9d,
+	0x42, 0x02, 0xd5, 0x72, 0x39, 0x6e, 0x16, 0x92, 0xa6, 0xba, 0x2c, 0x98, 0x81, 0x37, 0x2b, 0x35,
+	0x1a, 0xb7, 0xaa, 0x70, 0x4b, 0xb8, 0xb0, 0xe7, 0x45, 0xce, 0xf9, 0x06, 0xbd, 0x9e, 0x7c, 0x8b,
+	0x2b, 0xb3, 0xa9, 0x8c, 0x2f, 0x71, 0x75, 0x4e, 0x54, 0x23, 0x96, 0x14, 0xe2, 0x3d, 0xfc, 0x8e,
+	0x89, 0x38, 0x82, 0x18, 0x9f, 0xa2, 0xb7, 0x0e, 0xa0, 0xc7, 0xc6, 0xc0, 0x63, 0xbc, 0x31, 0x9b,
+	0x23, 0x8b, 0x65, 0x98, 0x66, 0x95, 0x44, 0xb3, 0xee, 0x28, 0x56, 0x13, 0xaf, 0x17, 0x0f, 0xcf,
+	0x54, 0xe5, 0x9f, 0x8e, 0xc9, 0x90, 0xf6, 0x89, 0x64, 0xfc, 0x0c, 0x0f, 0xd1, 0x1b, 0x6d, 0x88,
+	0x1f, 0xee, 0x63, 0x77, 0x36, 0xad, 0x0a, 0xd8, 0xf7, 0x99, 0x19, 0x2f, 0x1e, 0x61, 0xb8, 0x51,
+	0x5a, 0x9f, 0x47, 0xfb, 0xd9, 0xee, 0xc6, 0x67, 0xa8, 0xf6, 0x18, 0x26, 0x32, 0x25, 0x5a, 0x16,
+	0x92, 0x07, 0x2b, 0x9a, 0x67, 0x68, 0x34, 0xf9, 0xb6, 0x22, 0x6f, 0xe0, 0x35, 0x93, 0x1c, 0xc2,
+	0x44, 0x7a, 0x25, 0xfc, 0x0b, 0x07, 0xbd, 0xdb, 0x86, 0xb8, 0x33, 0x20, 0x1c, 0xc4, 0x5e, 0xea,
+	0x1f, 0x6f, 0xcf, 0x22, 0xca, 0x9a, 0x8a, 0xd2, 0x67, 0xd2, 0xdc, 0x8c, 0xab, 0xcc, 0x2c, 0xe3,
+	0xf7, 0xcb, 0x65, 0x10, 0x2a, 0x23, 0xfe, 0xd5, 0x41, 0x75, 0x23, 0xff, 0xf3, 0xac, 0x15, 0xd8,
+	0xab, 0xf4, 0x91, 0xeb, 0x5e, 0xc5, 0xcb, 0x47, 0xca, 0x4b, 0x0b, 0xef, 0x98, 0x5e, 0xf2, 0xe6,
+	0x7b, 0x53, 0x57, 0x85, 0x4f, 0xe2, 0x47, 0x07, 0x2d, 0x7e, 0xda, 0x93, 0x74, 0x0c, 0x4f, 0x86,
+	0x7d, 0x75, 0xc8, 0x6c, 0xcd, 0xb2, 0x0a, 0x02, 0xfb, 0x21, 0x69, 0xd3, 0x69, 0x63, 0x1f, 0x2a,
+	0x63, 0xb7, 0xf0, 0x66, 0xe1, 0x26, 0x50, 0x52, 0x8f, 0x0d, 0xfb, 0x89, 0x33, 0x91, 0x77, 0xed,
+	0x77, 0x07, 0x2d, 0x17, 0xd2, 0x98, 0x55, 0xdb, 0xbd, 0x04, 0x69, 0xa9, 0xdc, 0xbd, 0x4b, 0xa6,
+	0x18, 0x13, 0xb4, 0xdd, 0x8f, 0x95, 0xdd, 0x07, 0x78, 0xd7, 0x5e, 0xc7, 0x19, 0xe3, 0x46, 0x31,
+	0x7f, 0x73, 0x50, 0x7d, 0x1f, 0x94, 0x82, 0x48, 0xe8, 0x3f, 0x19, 0x01, 0x4f, 0x02, 0xc2, 0xd6,
+	0x6e, 0x9b, 0x2e, 0x33, 0xdd, 0xba, 0xaa, 0x5c, 0x5b, 0xde, 0x56, 0x96, 0x37, 0x71, 0xe1, 0xf9,
+	0xd4, 0x9f, 0xce, 0xf0, 0x58, 0xee, 0x24, 0xe9, 0xb7, 0xf1, 0x04, 0x7b, 0xb8, 0x6f, 0xeb, 0x77,
+	0x41, 0x50, 0xd1, 0xef, 0x92, 0xae, 0xaa, 0xdf, 0xe6, 0x1b, 0xce, 0xdc, 0xa5, 0x3f, 0x38, 0x68,
+	0xb1, 0xa3, 0xdf, 0xb0, 0xfb, 0x9c, 0x1e, 0x4a, 0x9b, 0x9f, 0x82, 0xa0, 0xc2, 0x4f, 0x49, 0x57,
+	0x75, 0x56, 0x65, 0xef, 0x66, 0xaf, 0xaf, 0xa0, 0x32, 0xbd, 0x68, 0x9e, 0x45, 0x10, 0xcd, 0xbd,
+	0x68, 0x54, 0xf0, 0x92, 0x8b, 0x46, 0x6b, 0xaa, 0x8e, 0x87, 0xe4, 0xa2, 0xf1, 0x4e, 0x12, 0xdd,
+	0xde, 0xe3, 0x3f, 0xcf, 0x5d, 0xe7, 0xe5, 0xb9, 0xeb, 0xfc, 0x73, 0xee, 0x3a, 0x3f, 0x5d, 0xb8,
+	0x0b, 0x7f, 0x5c, 0xb8, 0xce, 0xcb, 0x0b, 0x77, 0xe1, 0xef, 0x0b, 0x77, 0xe1, 0xeb, 0x7b, 0x01,
+	0x95, 0x83, 0xa8, 0xdb, 0xea, 0xb1, 0x63, 0x3d, 0x3f, 0x04, 0xf9, 0x2d, 0xe3, 0x47, 0xfa, 0x9f,
+	0xd7, 0x63, 0x1c, 0xfc, 0x89, 0x4a, 0x2a, 0xe3, 0x11, 0x88, 0xee, 0x35, 0xf5, 0x94, 0x7f, 0xf0,
+	0xff, 0x00, 0xfb, 0x7b, 0xfd, 0x6e, 0x72, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -475,3 +502,507 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tss/v1beta1/service.proto",
 }
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	Signature(ctx context.Context, in *QuerySignatureRequest, opts ...grpc.CallOption) (*QuerySignatureResponse, error)
+	Key(ctx context.Context, in *QueryKeyRequest, opts ...grpc.CallOption) (*QueryKeyResponse, error)
+	Recovery(ctx context.Context, in *QueryRecoveryRequest, opts ...grpc.CallOption) (*QueryRecoveryResponse, error)
+	KeyID(ctx context.Context, in *QueryKeyIDRequest, opts ...grpc.CallOption) (*QueryKeyIDResponse, error)
+	NextKeyID(ctx context.Context, in *QueryNextKeyIDRequest, opts ...grpc.CallOption) (*QueryNextKeyIDResponse, error)
+	KeySharesByKeyID(ctx context.Context, in *QueryKeySharesByKeyIDRequest, opts ...grpc.CallOption) (*QueryKeyShareResponse, error)
+	KeySharesByValidator(ctx context.Context, in *QueryKeySharesByValidatorRequest, opts ...grpc.CallOption) (*QueryKeyShareResponse, error)
+	ActiveOldKeys(ctx context.Context, in *QueryActiveOldKeysRequest, opts ...grpc.CallOption) (*QueryActiveOldKeysResponse, error)
+	ActiveOldKeysByValidator(ctx context.Context, in *QueryActiveOldKeysByValidatorRequest, opts ...grpc.CallOption) (*QueryActiveOldKeysValidatorResponse, error)
+	DeactivatedOperators(ctx context.Context, in *QueryDeactivatedOperatorsRequest, opts ...grpc.CallOption) (*QueryDeactivatedOperatorsResponse, error)
+	ExternalKeyID(ctx context.Context, in *QueryExternalKeyIDRequest, opts ...grpc.CallOption) (*QueryExternalKeyIDResponse, error)
+	SnapshotDrift(ctx context.Context, in *QuerySnapshotDriftRequest, opts ...grpc.CallOption) (*QuerySnapshotDriftResponse, error)
+	SignQueue(ctx context.Context, in *QuerySignQueueRequest, opts ...grpc.CallOption) (*QuerySignQueueResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) Signature(ctx context.Context, in *QuerySignatureRequest, opts ...grpc.CallOption) (*QuerySignatureResponse, error) {
+	out := new(QuerySignatureResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/Signature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Key(ctx context.Context, in *QueryKeyRequest, opts ...grpc.CallOption) (*QueryKeyResponse, error) {
+	out := new(QueryKeyResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/Key", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Recovery(ctx context.Context, in *QueryRecoveryRequest, opts ...grpc.CallOption) (*QueryRecoveryResponse, error) {
+	out := new(QueryRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/Recovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) KeyID(ctx context.Context, in *QueryKeyIDRequest, opts ...grpc.CallOption) (*QueryKeyIDResponse, error) {
+	out := new(QueryKeyIDResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/KeyID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) NextKeyID(ctx context.Context, in *QueryNextKeyIDRequest, opts ...grpc.CallOption) (*QueryNextKeyIDResponse, error) {
+	out := new(QueryNextKeyIDResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/NextKeyID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) KeySharesByKeyID(ctx context.Context, in *QueryKeySharesByKeyIDRequest, opts ...grpc.CallOption) (*QueryKeyShareResponse, error) {
+	out := new(QueryKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/KeySharesByKeyID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) KeySharesByValidator(ctx context.Context, in *QueryKeySharesByValidatorRequest, opts ...grpc.CallOption) (*QueryKeyShareResponse, error) {
+	out := new(QueryKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/KeySharesByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ActiveOldKeys(ctx context.Context, in *QueryActiveOldKeysRequest, opts ...grpc.CallOption) (*QueryActiveOldKeysResponse, error) {
+	out := new(QueryActiveOldKeysResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/ActiveOldKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ActiveOldKeysByValidator(ctx context.Context, in *QueryActiveOldKeysByValidatorRequest, opts ...grpc.CallOption) (*QueryActiveOldKeysValidatorResponse, error) {
+	out := new(QueryActiveOldKeysValidatorResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/ActiveOldKeysByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) DeactivatedOperators(ctx context.Context, in *QueryDeactivatedOperatorsRequest, opts ...grpc.CallOption) (*QueryDeactivatedOperatorsResponse, error) {
+	out := new(QueryDeactivatedOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/DeactivatedOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ExternalKeyID(ctx context.Context, in *QueryExternalKeyIDRequest, opts ...grpc.CallOption) (*QueryExternalKeyIDResponse, error) {
+	out := new(QueryExternalKeyIDResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/ExternalKeyID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) SnapshotDrift(ctx context.Context, in *QuerySnapshotDriftRequest, opts ...grpc.CallOption) (*QuerySnapshotDriftResponse, error) {
+	out := new(QuerySnapshotDriftResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/SnapshotDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) SignQueue(ctx context.Context, in *QuerySignQueueRequest, opts ...grpc.CallOption) (*QuerySignQueueResponse, error) {
+	out := new(QuerySignQueueResponse)
+	err := c.cc.Invoke(ctx, "/tss.v1beta1.QueryService/SignQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	Signature(context.Context, *QuerySignatureRequest) (*QuerySignatureResponse, error)
+	Key(context.Context, *QueryKeyRequest) (*QueryKeyResponse, error)
+	Recovery(context.Context, *QueryRecoveryRequest) (*QueryRecoveryResponse, error)
+	KeyID(context.Context, *QueryKeyIDRequest) (*QueryKeyIDResponse, error)
+	NextKeyID(context.Context, *QueryNextKeyIDRequest) (*QueryNextKeyIDResponse, error)
+	KeySharesByKeyID(context.Context, *QueryKeySharesByKeyIDRequest) (*QueryKeyShareResponse, error)
+	KeySharesByValidator(context.Context, *QueryKeySharesByValidatorRequest) (*QueryKeyShareResponse, error)
+	ActiveOldKeys(context.Context, *QueryActiveOldKeysRequest) (*QueryActiveOldKeysResponse, error)
+	ActiveOldKeysByValidator(context.Context, *QueryActiveOldKeysByValidatorRequest) (*QueryActiveOldKeysValidatorResponse, error)
+	DeactivatedOperators(context.Context, *QueryDeactivatedOperatorsRequest) (*QueryDeactivatedOperatorsResponse, error)
+	ExternalKeyID(context.Context, *QueryExternalKeyIDRequest) (*QueryExternalKeyIDResponse, error)
+	SnapshotDrift(context.Context, *QuerySnapshotDriftRequest) (*QuerySnapshotDriftResponse, error)
+	SignQueue(context.Context, *QuerySignQueueRequest) (*QuerySignQueueResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) Signature(ctx context.Context, req *QuerySignatureRequest) (*QuerySignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signature not implemented")
+}
+func (*UnimplementedQueryServiceServer) Key(ctx context.Context, req *QueryKeyRequest) (*QueryKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Key not implemented")
+}
+func (*UnimplementedQueryServiceServer) Recovery(ctx context.Context, req *QueryRecoveryRequest) (*QueryRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recovery not implemented")
+}
+func (*UnimplementedQueryServiceServer) KeyID(ctx context.Context, req *QueryKeyIDRequest) (*QueryKeyIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyID not implemented")
+}
+func (*UnimplementedQueryServiceServer) NextKeyID(ctx context.Context, req *QueryNextKeyIDRequest) (*QueryNextKeyIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextKeyID not implemented")
+}
+func (*UnimplementedQueryServiceServer) KeySharesByKeyID(ctx context.Context, req *QueryKeySharesByKeyIDRequest) (*QueryKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeySharesByKeyID not implemented")
+}
+func (*UnimplementedQueryServiceServer) KeySharesByValidator(ctx context.Context, req *QueryKeySharesByValidatorRequest) (*QueryKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeySharesByValidator not implemented")
+}
+func (*UnimplementedQueryServiceServer) ActiveOldKeys(ctx context.Context, req *QueryActiveOldKeysRequest) (*QueryActiveOldKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveOldKeys not implemented")
+}
+func (*UnimplementedQueryServiceServer) ActiveOldKeysByValidator(ctx context.Context, req *QueryActiveOldKeysByValidatorRequest) (*QueryActiveOldKeysValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveOldKeysByValidator not implemented")
+}
+func (*UnimplementedQueryServiceServer) DeactivatedOperators(ctx context.Context, req *QueryDeactivatedOperatorsRequest) (*QueryDeactivatedOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatedOperators not implemented")
+}
+func (*UnimplementedQueryServiceServer) ExternalKeyID(ctx context.Context, req *QueryExternalKeyIDRequest) (*QueryExternalKeyIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalKeyID not implemented")
+}
+func (*UnimplementedQueryServiceServer) SnapshotDrift(ctx context.Context, req *QuerySnapshotDriftRequest) (*QuerySnapshotDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotDrift not implemented")
+}
+func (*UnimplementedQueryServiceServer) SignQueue(ctx context.Context, req *QuerySignQueueRequest) (*QuerySignQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignQueue not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_Signature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Signature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/Signature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Signature(ctx, req.(*QuerySignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Key_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Key(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/Key",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Key(ctx, req.(*QueryKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Recovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Recovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/Recovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Recovery(ctx, req.(*QueryRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_KeyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).KeyID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/KeyID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).KeyID(ctx, req.(*QueryKeyIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_NextKeyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextKeyIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).NextKeyID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/NextKeyID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).NextKeyID(ctx, req.(*QueryNextKeyIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_KeySharesByKeyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeySharesByKeyIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).KeySharesByKeyID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/KeySharesByKeyID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).KeySharesByKeyID(ctx, req.(*QueryKeySharesByKeyIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_KeySharesByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeySharesByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).KeySharesByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/KeySharesByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).KeySharesByValidator(ctx, req.(*QueryKeySharesByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ActiveOldKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveOldKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ActiveOldKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/ActiveOldKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ActiveOldKeys(ctx, req.(*QueryActiveOldKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ActiveOldKeysByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveOldKeysByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ActiveOldKeysByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/ActiveOldKeysByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ActiveOldKeysByValidator(ctx, req.(*QueryActiveOldKeysByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_DeactivatedOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeactivatedOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).DeactivatedOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/DeactivatedOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).DeactivatedOperators(ctx, req.(*QueryDeactivatedOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ExternalKeyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExternalKeyIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ExternalKeyID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/ExternalKeyID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ExternalKeyID(ctx, req.(*QueryExternalKeyIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_SnapshotDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySnapshotDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).SnapshotDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/SnapshotDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).SnapshotDrift(ctx, req.(*QuerySnapshotDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_SignQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).SignQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tss.v1beta1.QueryService/SignQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).SignQueue(ctx, req.(*QuerySignQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tss.v1beta1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Signature",
+			Handler:    _QueryService_Signature_Handler,
+		},
+		{
+			MethodName: "Key",
+			Handler:    _QueryService_Key_Handler,
+		},
+		{
+			MethodName: "Recovery",
+			Handler:    _QueryService_Recovery_Handler,
+		},
+		{
+			MethodName: "KeyID",
+			Handler:    _QueryService_KeyID_Handler,
+		},
+		{
+			MethodName: "NextKeyID",
+			Handler:    _QueryService_NextKeyID_Handler,
+		},
+		{
+			MethodName: "KeySharesByKeyID",
+			Handler:    _QueryService_KeySharesByKeyID_Handler,
+		},
+		{
+			MethodName: "KeySharesByValidator",
+			Handler:    _QueryService_KeySharesByValidator_Handler,
+		},
+		{
+			MethodName: "ActiveOldKeys",
+			Handler:    _QueryService_ActiveOldKeys_Handler,
+		},
+		{
+			MethodName: "ActiveOldKeysByValidator",
+			Handler:    _QueryService_ActiveOldKeysByValidator_Handler,
+		},
+		{
+			MethodName: "DeactivatedOperators",
+			Handler:    _QueryService_DeactivatedOperators_Handler,
+		},
+		{
+			MethodName: "ExternalKeyID",
+			Handler:    _QueryService_ExternalKeyID_Handler,
+		},
+		{
+			MethodName: "SnapshotDrift",
+			Handler:    _QueryService_SnapshotDrift_Handler,
+		},
+		{
+			MethodName: "SignQueue",
+			Handler:    _QueryService_SignQueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tss/v1beta1/service.proto",
+}
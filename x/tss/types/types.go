@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 
+	"github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 	"github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -159,3 +160,51 @@ func (m MultisigInfo) GetSigKeyPairs() []exported.SigKeyPair {
 
 	return pairs
 }
+
+// SimpleString returns a human-readable string
+func (x KeyRotationTrigger) SimpleString() string {
+	switch x {
+	case KeyRotationTrigger_Period:
+		return "period"
+	case KeyRotationTrigger_SnapshotDrift:
+		return "snapshot_drift"
+	case KeyRotationTrigger_Unbonding:
+		return "unbonding"
+	default:
+		return "unspecified"
+	}
+}
+
+// CanSign returns true if the eligible share count of the key exceeds its corruption threshold
+func (m SnapshotDrift) CanSign() bool {
+	return m.EligibleShareCount > m.CorruptionThreshold
+}
+
+// ComputeStatus returns the status of the snapshot drift. Warnings start once the share count
+// exceeding the signing threshold falls below the given margin of the total share count
+func (m SnapshotDrift) ComputeStatus(warningMargin utils.Threshold) SnapshotDriftStatus {
+	if !m.CanSign() {
+		return SnapshotDriftStatus_Insufficient
+	}
+
+	excess := sdk.NewInt(m.EligibleShareCount - (m.CorruptionThreshold + 1))
+	if excess.MulRaw(warningMargin.Denominator).LT(sdk.NewInt(m.TotalShareCount).MulRaw(warningMargin.Numerator)) {
+		return SnapshotDriftStatus_Warning
+	}
+
+	return SnapshotDriftStatus_Healthy
+}
+
+// SimpleString returns a human-readable string
+func (x SnapshotDriftStatus) SimpleString() string {
+	switch x {
+	case SnapshotDriftStatus_Healthy:
+		return "healthy"
+	case SnapshotDriftStatus_Warning:
+		return "warning"
+	case SnapshotDriftStatus_Insufficient:
+		return "insufficient"
+	default:
+		return "unspecified"
+	}
+}
@@ -26,6 +26,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type KeyRotationTrigger int32
+
+const (
+	KeyRotationTrigger_Unspecified   KeyRotationTrigger = 0
+	KeyRotationTrigger_Period        KeyRotationTrigger = 1
+	KeyRotationTrigger_SnapshotDrift KeyRotationTrigger = 2
+	KeyRotationTrigger_Unbonding     KeyRotationTrigger = 3
+)
+
+var KeyRotationTrigger_name = map[int32]string{
+	0: "KEY_ROTATION_TRIGGER_UNSPECIFIED",
+	1: "KEY_ROTATION_TRIGGER_PERIOD",
+	2: "KEY_ROTATION_TRIGGER_SNAPSHOT_DRIFT",
+	3: "KEY_ROTATION_TRIGGER_UNBONDING",
+}
+
+var KeyRotationTrigger_value = map[string]int32{
+	"KEY_ROTATION_TRIGGER_UNSPECIFIED":    0,
+	"KEY_ROTATION_TRIGGER_PERIOD":         1,
+	"KEY_ROTATION_TRIGGER_SNAPSHOT_DRIFT": 2,
+	"KEY_ROTATION_TRIGGER_UNBONDING":      3,
+}
+
+func (x KeyRotationTrigger) String() string {
+	return proto.EnumName(KeyRotationTrigger_name, int32(x))
+}
+
+func (KeyRotationTrigger) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{0}
+}
+
+type SnapshotDriftStatus int32
+
+const (
+	SnapshotDriftStatus_Unspecified  SnapshotDriftStatus = 0
+	SnapshotDriftStatus_Healthy      SnapshotDriftStatus = 1
+	SnapshotDriftStatus_Warning      SnapshotDriftStatus = 2
+	SnapshotDriftStatus_Insufficient SnapshotDriftStatus = 3
+)
+
+var SnapshotDriftStatus_name = map[int32]string{
+	0: "SNAPSHOT_DRIFT_STATUS_UNSPECIFIED",
+	1: "SNAPSHOT_DRIFT_STATUS_HEALTHY",
+	2: "SNAPSHOT_DRIFT_STATUS_WARNING",
+	3: "SNAPSHOT_DRIFT_STATUS_INSUFFICIENT",
+}
+
+var SnapshotDriftStatus_value = map[string]int32{
+	"SNAPSHOT_DRIFT_STATUS_UNSPECIFIED":  0,
+	"SNAPSHOT_DRIFT_STATUS_HEALTHY":      1,
+	"SNAPSHOT_DRIFT_STATUS_WARNING":      2,
+	"SNAPSHOT_DRIFT_STATUS_INSUFFICIENT": 3,
+}
+
+func (x SnapshotDriftStatus) String() string {
+	return proto.EnumName(SnapshotDriftStatus_name, int32(x))
+}
+
+func (SnapshotDriftStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{1}
+}
+
 type KeygenVoteData struct {
 	PubKey            []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	GroupRecoveryInfo []byte `protobuf:"bytes,2,opt,name=group_recovery_info,json=groupRecoveryInfo,proto3" json:"group_recovery_info,omitempty"`
@@ -259,317 +321,3545 @@ func (m *MultisigInfo_Info) GetData() [][]byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*KeygenVoteData)(nil), "tss.v1beta1.KeygenVoteData")
-	proto.RegisterType((*KeyInfo)(nil), "tss.v1beta1.KeyInfo")
-	proto.RegisterType((*MultisigInfo)(nil), "tss.v1beta1.MultisigInfo")
-	proto.RegisterType((*MultisigInfo_Info)(nil), "tss.v1beta1.MultisigInfo.Info")
+// KeyRecord holds the complete state of a key, including the state of an
+// ongoing keygen
+type KeyRecord struct {
+	KeyInfo KeyInfo `protobuf:"bytes,1,opt,name=key_info,json=keyInfo,proto3" json:"key_info"`
+	// key is only set once keygen has completed
+	Key                *exported.Key                                   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	KeygenStarted      bool                                            `protobuf:"varint,3,opt,name=keygen_started,json=keygenStarted,proto3" json:"keygen_started,omitempty"`
+	KeygenParticipants []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,4,rep,name=keygen_participants,json=keygenParticipants,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"keygen_participants,omitempty"`
+	// snapshot_counter is only valid if has_snapshot_counter is true
+	HasSnapshotCounter bool  `protobuf:"varint,5,opt,name=has_snapshot_counter,json=hasSnapshotCounter,proto3" json:"has_snapshot_counter,omitempty"`
+	SnapshotCounter    int64 `protobuf:"varint,6,opt,name=snapshot_counter,json=snapshotCounter,proto3" json:"snapshot_counter,omitempty"`
+	// rotation_count is 0 if the key has never been assigned to a chain
+	RotationCount int64 `protobuf:"varint,7,opt,name=rotation_count,json=rotationCount,proto3" json:"rotation_count,omitempty"`
+	// rotated_at is the unix timestamp in seconds of the key rotation, 0 if the
+	// key has never been rotated to
+	RotatedAt            int64                           `protobuf:"varint,8,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	GroupRecoveryInfo    []byte                          `protobuf:"bytes,9,opt,name=group_recovery_info,json=groupRecoveryInfo,proto3" json:"group_recovery_info,omitempty"`
+	PrivateRecoveryInfos []KeyRecord_PrivateRecoveryInfo `protobuf:"bytes,10,rep,name=private_recovery_infos,json=privateRecoveryInfos,proto3" json:"private_recovery_infos"`
+	MultisigKeygenInfo   *MultisigInfo                   `protobuf:"bytes,11,opt,name=multisig_keygen_info,json=multisigKeygenInfo,proto3" json:"multisig_keygen_info,omitempty"`
 }
 
-func init() { proto.RegisterFile("tss/v1beta1/types.proto", fileDescriptor_757d526ec8821445) }
+func (m *KeyRecord) Reset()         { *m = KeyRecord{} }
+func (m *KeyRecord) String() string { return proto.CompactTextString(m) }
+func (*KeyRecord) ProtoMessage()    {}
+func (*KeyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{3}
+}
+func (m *KeyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRecord.Merge(m, src)
+}
+func (m *KeyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRecord.DiscardUnknown(m)
+}
 
-var fileDescriptor_757d526ec8821445 = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0x6e, 0xd2, 0x5f, 0xee, 0xb4, 0x2c, 0x38, 0x8a, 0x1b, 0x0a, 0x9b, 0x96, 0x9e, 0x7a, 0x69,
-	0x62, 0x57, 0x0f, 0x7a, 0xb4, 0x14, 0xa1, 0x16, 0x3d, 0x8c, 0xb2, 0xa0, 0x20, 0x65, 0x9a, 0xbc,
-	0x8d, 0x21, 0x3f, 0x26, 0xcc, 0x4c, 0xd6, 0xe6, 0x1f, 0xf0, 0xec, 0x9f, 0xe5, 0x71, 0x8f, 0x1e,
-	0xa4, 0x48, 0xfa, 0x5f, 0xec, 0x49, 0x66, 0x92, 0x5d, 0x72, 0x10, 0xc4, 0x4b, 0xf2, 0xcd, 0xbc,
-	0xf7, 0xbd, 0xf7, 0xbe, 0xef, 0x0d, 0x3a, 0x93, 0x42, 0xb8, 0xd7, 0x8b, 0x1d, 0x48, 0xba, 0x70,
-	0x65, 0x91, 0x81, 0x70, 0x32, 0xce, 0x24, 0xc3, 0x03, 0x29, 0x84, 0x53, 0x07, 0x46, 0x8f, 0x03,
-	0x16, 0x30, 0x7d, 0xef, 0x2a, 0x54, 0xa5, 0x8c, 0x26, 0x8a, 0x0b, 0xfb, 0x8c, 0x71, 0x09, 0xfe,
-	0xdf, 0x8a, 0x4c, 0x3f, 0xa2, 0xd3, 0x0d, 0x14, 0x01, 0xa4, 0x97, 0x4c, 0xc2, 0x8a, 0x4a, 0x8a,
-	0xcf, 0x50, 0x3f, 0xcb, 0x77, 0xdb, 0x08, 0x0a, 0xcb, 0x98, 0x18, 0xb3, 0x21, 0xe9, 0x65, 0xf9,
-	0x6e, 0x03, 0x05, 0x76, 0xd0, 0xa3, 0x80, 0xb3, 0x3c, 0xdb, 0x72, 0xf0, 0xd8, 0x35, 0xf0, 0x62,
-	0x1b, 0xa6, 0x57, 0xcc, 0x32, 0x75, 0xd2, 0x43, 0x1d, 0x22, 0x75, 0x64, 0x9d, 0x5e, 0xb1, 0xe9,
-	0x2f, 0x03, 0xf5, 0x37, 0xa0, 0x31, 0xfe, 0x8c, 0x7a, 0x11, 0x14, 0xdb, 0xd0, 0xd7, 0x35, 0x4f,
-	0x96, 0xaf, 0xcb, 0xc3, 0xb8, 0xab, 0x82, 0xab, 0xdb, 0xc3, 0xf8, 0x65, 0x10, 0xca, 0x2f, 0xf9,
-	0xce, 0xf1, 0x58, 0xe2, 0xd2, 0x3d, 0xc4, 0x94, 0xa7, 0x20, 0xbf, 0x32, 0x1e, 0xd5, 0xa7, 0xb9,
-	0xc7, 0x38, 0xb8, 0x7b, 0xb7, 0x29, 0xc6, 0xd1, 0x64, 0xd2, 0x8d, 0xa0, 0x58, 0xfb, 0xf8, 0x05,
-	0x7a, 0xa0, 0xca, 0x73, 0x16, 0x83, 0x9e, 0xe7, 0xf4, 0xe2, 0xdc, 0x51, 0xee, 0xdc, 0x67, 0xd7,
-	0xd2, 0x15, 0x8b, 0xb0, 0x18, 0x48, 0x3f, 0xaa, 0xc0, 0x1d, 0x53, 0x59, 0x62, 0xb5, 0xff, 0xc1,
-	0xfc, 0x50, 0x64, 0x15, 0x53, 0x81, 0xe9, 0x37, 0x13, 0x0d, 0xdf, 0xe6, 0xb1, 0x0c, 0x45, 0x18,
-	0x68, 0x8d, 0x4f, 0x90, 0x79, 0xaf, 0xaf, 0x57, 0x1e, 0xc6, 0xe6, 0x7a, 0x45, 0xcc, 0xd0, 0xc7,
-	0x16, 0xea, 0xcb, 0x30, 0x01, 0x96, 0x4b, 0x3d, 0x5b, 0x9b, 0xdc, 0x1d, 0xf1, 0x39, 0x42, 0x92,
-	0xf2, 0x00, 0xe4, 0x36, 0xcd, 0x13, 0xdd, 0xbe, 0x4d, 0x4e, 0xaa, 0x9b, 0x77, 0x79, 0x82, 0x9f,
-	0xa3, 0xae, 0x72, 0x58, 0x58, 0x9d, 0x49, 0x7b, 0x36, 0xb8, 0xb0, 0x9d, 0xc6, 0xc2, 0x9d, 0x66,
-	0x6b, 0x47, 0x7d, 0x48, 0x95, 0x3c, 0x62, 0xa8, 0xa3, 0xc7, 0x79, 0x8f, 0x06, 0x19, 0xe5, 0x32,
-	0xf4, 0xc2, 0x8c, 0xa6, 0xb2, 0xda, 0xe5, 0x72, 0x71, 0x7b, 0x18, 0xcf, 0x1b, 0x76, 0x7b, 0x4c,
-	0x24, 0x4c, 0xd4, 0xbf, 0xb9, 0xf0, 0xa3, 0xfa, 0x71, 0x5c, 0xd2, 0xf8, 0x95, 0xef, 0x73, 0x10,
-	0x82, 0x34, 0xab, 0x60, 0x8c, 0x3a, 0x3e, 0x95, 0xd4, 0x32, 0x27, 0xed, 0xd9, 0x90, 0x68, 0xbc,
-	0x7c, 0xf3, 0xa3, 0xb4, 0x8d, 0x9b, 0xd2, 0x36, 0x7e, 0x97, 0xb6, 0xf1, 0xfd, 0x68, 0xb7, 0x6e,
-	0x8e, 0x76, 0xeb, 0xe7, 0xd1, 0x6e, 0x7d, 0x7a, 0xfa, 0x1f, 0x8b, 0xd5, 0x7d, 0x77, 0x3d, 0xfd,
-	0x2a, 0x9f, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x44, 0x38, 0x4a, 0xec, 0xf5, 0x02, 0x00, 0x00,
+var xxx_messageInfo_KeyRecord proto.InternalMessageInfo
+
+func (m *KeyRecord) GetKeyInfo() KeyInfo {
+	if m != nil {
+		return m.KeyInfo
+	}
+	return KeyInfo{}
 }
 
-func (m *KeygenVoteData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyRecord) GetKey() *exported.Key {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *KeygenVoteData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyRecord) GetKeygenStarted() bool {
+	if m != nil {
+		return m.KeygenStarted
+	}
+	return false
 }
 
-func (m *KeygenVoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GroupRecoveryInfo) > 0 {
-		i -= len(m.GroupRecoveryInfo)
-		copy(dAtA[i:], m.GroupRecoveryInfo)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupRecoveryInfo)))
-		i--
-		dAtA[i] = 0x12
+func (m *KeyRecord) GetKeygenParticipants() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.KeygenParticipants
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *KeyRecord) GetHasSnapshotCounter() bool {
+	if m != nil {
+		return m.HasSnapshotCounter
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *KeyInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyRecord) GetSnapshotCounter() int64 {
+	if m != nil {
+		return m.SnapshotCounter
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *KeyInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyRecord) GetRotationCount() int64 {
+	if m != nil {
+		return m.RotationCount
+	}
+	return 0
 }
 
-func (m *KeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.KeyType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.KeyType))
-		i--
-		dAtA[i] = 0x18
+func (m *KeyRecord) GetRotatedAt() int64 {
+	if m != nil {
+		return m.RotatedAt
 	}
-	if m.KeyRole != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.KeyRole))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *KeyRecord) GetGroupRecoveryInfo() []byte {
+	if m != nil {
+		return m.GroupRecoveryInfo
 	}
-	if len(m.KeyID) > 0 {
-		i -= len(m.KeyID)
-		copy(dAtA[i:], m.KeyID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyID)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *KeyRecord) GetPrivateRecoveryInfos() []KeyRecord_PrivateRecoveryInfo {
+	if m != nil {
+		return m.PrivateRecoveryInfos
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MultisigInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyRecord) GetMultisigKeygenInfo() *MultisigInfo {
+	if m != nil {
+		return m.MultisigKeygenInfo
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MultisigInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type KeyRecord_PrivateRecoveryInfo struct {
+	Validator    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	RecoveryInfo []byte                                        `protobuf:"bytes,2,opt,name=recovery_info,json=recoveryInfo,proto3" json:"recovery_info,omitempty"`
 }
 
-func (m *MultisigInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Infos) > 0 {
-		for iNdEx := len(m.Infos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Infos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *KeyRecord_PrivateRecoveryInfo) Reset()         { *m = KeyRecord_PrivateRecoveryInfo{} }
+func (m *KeyRecord_PrivateRecoveryInfo) String() string { return proto.CompactTextString(m) }
+func (*KeyRecord_PrivateRecoveryInfo) ProtoMessage()    {}
+func (*KeyRecord_PrivateRecoveryInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{3, 0}
+}
+func (m *KeyRecord_PrivateRecoveryInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRecord_PrivateRecoveryInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRecord_PrivateRecoveryInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TargetNum != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TargetNum))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Timeout != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *KeyRecord_PrivateRecoveryInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRecord_PrivateRecoveryInfo.Merge(m, src)
+}
+func (m *KeyRecord_PrivateRecoveryInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRecord_PrivateRecoveryInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRecord_PrivateRecoveryInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRecord_PrivateRecoveryInfo proto.InternalMessageInfo
+
+func (m *KeyRecord_PrivateRecoveryInfo) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MultisigInfo_Info) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyRecord_PrivateRecoveryInfo) GetRecoveryInfo() []byte {
+	if m != nil {
+		return m.RecoveryInfo
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MultisigInfo_Info) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SignRecord holds the complete state of a signature, including the state of
+// an ongoing signing
+type SignRecord struct {
+	SigID            string                   `protobuf:"bytes,1,opt,name=sig_id,json=sigId,proto3" json:"sig_id,omitempty"`
+	SigStatus        exported.SigStatus       `protobuf:"varint,2,opt,name=sig_status,json=sigStatus,proto3,enum=tss.exported.v1beta1.SigStatus" json:"sig_status,omitempty"`
+	Signature        *exported.Signature      `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignInfo         *exported.SignInfo       `protobuf:"bytes,4,opt,name=sign_info,json=signInfo,proto3" json:"sign_info,omitempty"`
+	Participants     []SignRecord_Participant `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants"`
+	MultisigSignInfo *MultisigInfo            `protobuf:"bytes,6,opt,name=multisig_sign_info,json=multisigSignInfo,proto3" json:"multisig_sign_info,omitempty"`
 }
 
-func (m *MultisigInfo_Info) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Data[iNdEx])
-			copy(dAtA[i:], m.Data[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Data[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *SignRecord) Reset()         { *m = SignRecord{} }
+func (m *SignRecord) String() string { return proto.CompactTextString(m) }
+func (*SignRecord) ProtoMessage()    {}
+func (*SignRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{4}
+}
+func (m *SignRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Participant)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *SignRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRecord.Merge(m, src)
+}
+func (m *SignRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRecord.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_SignRecord proto.InternalMessageInfo
+
+func (m *SignRecord) GetSigID() string {
+	if m != nil {
+		return m.SigID
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *KeygenVoteData) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SignRecord) GetSigStatus() exported.SigStatus {
+	if m != nil {
+		return m.SigStatus
 	}
-	var l int
-	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return exported.SigStatus_Unspecified
+}
+
+func (m *SignRecord) GetSignature() *exported.Signature {
+	if m != nil {
+		return m.Signature
 	}
-	l = len(m.GroupRecoveryInfo)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *SignRecord) GetSignInfo() *exported.SignInfo {
+	if m != nil {
+		return m.SignInfo
 	}
-	return n
+	return nil
 }
 
-func (m *KeyInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignRecord) GetParticipants() []SignRecord_Participant {
+	if m != nil {
+		return m.Participants
 	}
-	var l int
-	_ = l
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *SignRecord) GetMultisigSignInfo() *MultisigInfo {
+	if m != nil {
+		return m.MultisigSignInfo
 	}
-	if m.KeyRole != 0 {
-		n += 1 + sovTypes(uint64(m.KeyRole))
+	return nil
+}
+
+type SignRecord_Participant struct {
+	Validator  github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	ShareCount int64                                         `protobuf:"varint,2,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+}
+
+func (m *SignRecord_Participant) Reset()         { *m = SignRecord_Participant{} }
+func (m *SignRecord_Participant) String() string { return proto.CompactTextString(m) }
+func (*SignRecord_Participant) ProtoMessage()    {}
+func (*SignRecord_Participant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{4, 0}
+}
+func (m *SignRecord_Participant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignRecord_Participant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignRecord_Participant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.KeyType != 0 {
-		n += 1 + sovTypes(uint64(m.KeyType))
+}
+func (m *SignRecord_Participant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRecord_Participant.Merge(m, src)
+}
+func (m *SignRecord_Participant) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignRecord_Participant) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRecord_Participant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRecord_Participant proto.InternalMessageInfo
+
+func (m *SignRecord_Participant) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
 	}
-	return n
+	return nil
 }
 
-func (m *MultisigInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignRecord_Participant) GetShareCount() int64 {
+	if m != nil {
+		return m.ShareCount
 	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+// KeyRotations holds the rotation history of a key role on a chain. The key
+// ID at index i is assigned to rotation count i+1, so there may be one more
+// key ID than the rotation count if the next key has already been assigned
+type KeyRotations struct {
+	Chain         string                                                      `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyRole       exported.KeyRole                                            `protobuf:"varint,2,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+	RotationCount int64                                                       `protobuf:"varint,3,opt,name=rotation_count,json=rotationCount,proto3" json:"rotation_count,omitempty"`
+	KeyIDs        []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,4,rep,name=key_ids,json=keyIds,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_ids,omitempty"`
+}
+
+func (m *KeyRotations) Reset()         { *m = KeyRotations{} }
+func (m *KeyRotations) String() string { return proto.CompactTextString(m) }
+func (*KeyRotations) ProtoMessage()    {}
+func (*KeyRotations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{5}
+}
+func (m *KeyRotations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovTypes(uint64(m.Timeout))
+}
+func (m *KeyRotations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotations.Merge(m, src)
+}
+func (m *KeyRotations) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotations) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotations proto.InternalMessageInfo
+
+func (m *KeyRotations) GetChain() string {
+	if m != nil {
+		return m.Chain
 	}
-	if m.TargetNum != 0 {
-		n += 1 + sovTypes(uint64(m.TargetNum))
+	return ""
+}
+
+func (m *KeyRotations) GetKeyRole() exported.KeyRole {
+	if m != nil {
+		return m.KeyRole
 	}
-	if len(m.Infos) > 0 {
-		for _, e := range m.Infos {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return exported.Unknown
+}
+
+func (m *KeyRotations) GetRotationCount() int64 {
+	if m != nil {
+		return m.RotationCount
+	}
+	return 0
+}
+
+func (m *KeyRotations) GetKeyIDs() []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID {
+	if m != nil {
+		return m.KeyIDs
+	}
+	return nil
+}
+
+// ExternalKeys holds the IDs of the external keys registered for a chain
+type ExternalKeys struct {
+	Chain  string                                                      `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyIDs []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,2,rep,name=key_ids,json=keyIds,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_ids,omitempty"`
+}
+
+func (m *ExternalKeys) Reset()         { *m = ExternalKeys{} }
+func (m *ExternalKeys) String() string { return proto.CompactTextString(m) }
+func (*ExternalKeys) ProtoMessage()    {}
+func (*ExternalKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{6}
+}
+func (m *ExternalKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalKeys.Merge(m, src)
+}
+func (m *ExternalKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalKeys proto.InternalMessageInfo
+
+func (m *ExternalKeys) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ExternalKeys) GetKeyIDs() []github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID {
+	if m != nil {
+		return m.KeyIDs
+	}
+	return nil
+}
+
+// ScheduledKeyRotation holds the key that has been generated automatically
+// for the given chain and key role and is waiting to be rotated in
+type ScheduledKeyRotation struct {
+	Chain           string                                                    `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyRole         exported.KeyRole                                          `protobuf:"varint,2,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+	KeyID           github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+	Trigger         KeyRotationTrigger                                        `protobuf:"varint,4,opt,name=trigger,proto3,enum=tss.v1beta1.KeyRotationTrigger" json:"trigger,omitempty"`
+	StartedAt       int64                                                     `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FailedHandovers int64                                                     `protobuf:"varint,6,opt,name=failed_handovers,json=failedHandovers,proto3" json:"failed_handovers,omitempty"`
+}
+
+func (m *ScheduledKeyRotation) Reset()         { *m = ScheduledKeyRotation{} }
+func (m *ScheduledKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ScheduledKeyRotation) ProtoMessage()    {}
+func (*ScheduledKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{7}
+}
+func (m *ScheduledKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledKeyRotation.Merge(m, src)
+}
+func (m *ScheduledKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledKeyRotation proto.InternalMessageInfo
+
+func (m *ScheduledKeyRotation) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ScheduledKeyRotation) GetKeyRole() exported.KeyRole {
+	if m != nil {
+		return m.KeyRole
+	}
+	return exported.Unknown
+}
+
+func (m *ScheduledKeyRotation) GetKeyID() github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+func (m *ScheduledKeyRotation) GetTrigger() KeyRotationTrigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return KeyRotationTrigger_Unspecified
+}
+
+func (m *ScheduledKeyRotation) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *ScheduledKeyRotation) GetFailedHandovers() int64 {
+	if m != nil {
+		return m.FailedHandovers
+	}
+	return 0
+}
+
+// KeyRotationAbort holds the height at which the latest automatic key rotation
+// for the given chain and key role has been aborted
+type KeyRotationAbort struct {
+	Chain     string           `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	KeyRole   exported.KeyRole `protobuf:"varint,2,opt,name=key_role,json=keyRole,proto3,enum=tss.exported.v1beta1.KeyRole" json:"key_role,omitempty"`
+	AbortedAt int64            `protobuf:"varint,3,opt,name=aborted_at,json=abortedAt,proto3" json:"aborted_at,omitempty"`
+}
+
+func (m *KeyRotationAbort) Reset()         { *m = KeyRotationAbort{} }
+func (m *KeyRotationAbort) String() string { return proto.CompactTextString(m) }
+func (*KeyRotationAbort) ProtoMessage()    {}
+func (*KeyRotationAbort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{8}
+}
+func (m *KeyRotationAbort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotationAbort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotationAbort.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRotationAbort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotationAbort.Merge(m, src)
+}
+func (m *KeyRotationAbort) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotationAbort) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotationAbort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotationAbort proto.InternalMessageInfo
+
+func (m *KeyRotationAbort) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *KeyRotationAbort) GetKeyRole() exported.KeyRole {
+	if m != nil {
+		return m.KeyRole
+	}
+	return exported.Unknown
+}
+
+func (m *KeyRotationAbort) GetAbortedAt() int64 {
+	if m != nil {
+		return m.AbortedAt
+	}
+	return 0
+}
+
+// SnapshotDrift describes how much of a key's share count is still held by
+// validators that are eligible and available to sign with it
+type SnapshotDrift struct {
+	KeyID                github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/tss/exported.KeyID" json:"key_id,omitempty"`
+	SnapshotCounter      int64                                                     `protobuf:"varint,2,opt,name=snapshot_counter,json=snapshotCounter,proto3" json:"snapshot_counter,omitempty"`
+	TotalShareCount      int64                                                     `protobuf:"varint,3,opt,name=total_share_count,json=totalShareCount,proto3" json:"total_share_count,omitempty"`
+	EligibleShareCount   int64                                                     `protobuf:"varint,4,opt,name=eligible_share_count,json=eligibleShareCount,proto3" json:"eligible_share_count,omitempty"`
+	CorruptionThreshold  int64                                                     `protobuf:"varint,5,opt,name=corruption_threshold,json=corruptionThreshold,proto3" json:"corruption_threshold,omitempty"`
+	IneligibleValidators []string                                                  `protobuf:"bytes,6,rep,name=ineligible_validators,json=ineligibleValidators,proto3" json:"ineligible_validators,omitempty"`
+	Status               SnapshotDriftStatus                                       `protobuf:"varint,7,opt,name=status,proto3,enum=tss.v1beta1.SnapshotDriftStatus" json:"status,omitempty"`
+}
+
+func (m *SnapshotDrift) Reset()         { *m = SnapshotDrift{} }
+func (m *SnapshotDrift) String() string { return proto.CompactTextString(m) }
+func (*SnapshotDrift) ProtoMessage()    {}
+func (*SnapshotDrift) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{9}
+}
+func (m *SnapshotDrift) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotDrift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotDrift.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotDrift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotDrift.Merge(m, src)
+}
+func (m *SnapshotDrift) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotDrift) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotDrift.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotDrift proto.InternalMessageInfo
+
+func (m *SnapshotDrift) GetKeyID() github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+func (m *SnapshotDrift) GetSnapshotCounter() int64 {
+	if m != nil {
+		return m.SnapshotCounter
+	}
+	return 0
+}
+
+func (m *SnapshotDrift) GetTotalShareCount() int64 {
+	if m != nil {
+		return m.TotalShareCount
+	}
+	return 0
+}
+
+func (m *SnapshotDrift) GetEligibleShareCount() int64 {
+	if m != nil {
+		return m.EligibleShareCount
+	}
+	return 0
+}
+
+func (m *SnapshotDrift) GetCorruptionThreshold() int64 {
+	if m != nil {
+		return m.CorruptionThreshold
+	}
+	return 0
+}
+
+func (m *SnapshotDrift) GetIneligibleValidators() []string {
+	if m != nil {
+		return m.IneligibleValidators
+	}
+	return nil
+}
+
+func (m *SnapshotDrift) GetStatus() SnapshotDriftStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SnapshotDriftStatus_Unspecified
+}
+
+// SuspendedValidator holds the block height until which a validator is
+// suspended from participating in tss
+type SuspendedValidator struct {
+	Validator      github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	SuspendedUntil int64                                         `protobuf:"varint,2,opt,name=suspended_until,json=suspendedUntil,proto3" json:"suspended_until,omitempty"`
+}
+
+func (m *SuspendedValidator) Reset()         { *m = SuspendedValidator{} }
+func (m *SuspendedValidator) String() string { return proto.CompactTextString(m) }
+func (*SuspendedValidator) ProtoMessage()    {}
+func (*SuspendedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_757d526ec8821445, []int{10}
+}
+func (m *SuspendedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuspendedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuspendedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuspendedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendedValidator.Merge(m, src)
+}
+func (m *SuspendedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuspendedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendedValidator proto.InternalMessageInfo
+
+func (m *SuspendedValidator) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *SuspendedValidator) GetSuspendedUntil() int64 {
+	if m != nil {
+		return m.SuspendedUntil
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("tss.v1beta1.KeyRotationTrigger", KeyRotationTrigger_name, KeyRotationTrigger_value)
+	proto.RegisterEnum("tss.v1beta1.SnapshotDriftStatus", SnapshotDriftStatus_name, SnapshotDriftStatus_value)
+	proto.RegisterType((*KeygenVoteData)(nil), "tss.v1beta1.KeygenVoteData")
+	proto.RegisterType((*KeyInfo)(nil), "tss.v1beta1.KeyInfo")
+	proto.RegisterType((*MultisigInfo)(nil), "tss.v1beta1.MultisigInfo")
+	proto.RegisterType((*MultisigInfo_Info)(nil), "tss.v1beta1.MultisigInfo.Info")
+	proto.RegisterType((*KeyRecord)(nil), "tss.v1beta1.KeyRecord")
+	proto.RegisterType((*KeyRecord_PrivateRecoveryInfo)(nil), "tss.v1beta1.KeyRecord.PrivateRecoveryInfo")
+	proto.RegisterType((*SignRecord)(nil), "tss.v1beta1.SignRecord")
+	proto.RegisterType((*SignRecord_Participant)(nil), "tss.v1beta1.SignRecord.Participant")
+	proto.RegisterType((*KeyRotations)(nil), "tss.v1beta1.KeyRotations")
+	proto.RegisterType((*ExternalKeys)(nil), "tss.v1beta1.ExternalKeys")
+	proto.RegisterType((*ScheduledKeyRotation)(nil), "tss.v1beta1.ScheduledKeyRotation")
+	proto.RegisterType((*KeyRotationAbort)(nil), "tss.v1beta1.KeyRotationAbort")
+	proto.RegisterType((*SnapshotDrift)(nil), "tss.v1beta1.SnapshotDrift")
+	proto.RegisterType((*SuspendedValidator)(nil), "tss.v1beta1.SuspendedValidator")
+}
+
+func init() { proto.RegisterFile("tss/v1beta1/types.proto", fileDescriptor_757d526ec8821445) }
+
+var fileDescriptor_757d526ec8821445 = []byte{
+	// 1482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1a, 0x49,
+	0x16, 0x77, 0x03, 0x06, 0xf3, 0xc0, 0x36, 0x29, 0xb3, 0x09, 0xcb, 0xca, 0xc0, 0x12, 0x45, 0xeb,
+	0x4d, 0x14, 0x1c, 0x27, 0x9b, 0x55, 0xb2, 0xab, 0x5d, 0x09, 0x07, 0x6c, 0xb3, 0xde, 0x60, 0xab,
+	0x1b, 0x27, 0xca, 0x4a, 0xab, 0xde, 0x82, 0x2e, 0xa0, 0x65, 0xdc, 0x85, 0xba, 0xaa, 0xbd, 0xe6,
+	0x34, 0x1a, 0x8d, 0x14, 0x8d, 0x3c, 0xd2, 0x28, 0xd7, 0x39, 0xf8, 0x34, 0x73, 0x98, 0x8f, 0x12,
+	0xcd, 0x29, 0xc7, 0x39, 0x8c, 0xac, 0x91, 0x73, 0x98, 0xf3, 0x5c, 0x73, 0x1a, 0x55, 0x55, 0x03,
+	0x4d, 0x8c, 0x27, 0x9a, 0xc4, 0xb9, 0xd8, 0xd5, 0xef, 0xfd, 0xde, 0xab, 0xf7, 0xff, 0x15, 0x70,
+	0x8d, 0x33, 0xb6, 0x7a, 0xb8, 0xd6, 0x24, 0x1c, 0xaf, 0xad, 0xf2, 0x41, 0x9f, 0xb0, 0x52, 0xdf,
+	0xa5, 0x9c, 0xa2, 0x04, 0x67, 0xac, 0xe4, 0x33, 0xb2, 0xe9, 0x0e, 0xed, 0x50, 0x49, 0x5f, 0x15,
+	0x27, 0x05, 0xc9, 0x16, 0x84, 0x2c, 0x39, 0xea, 0x53, 0x97, 0x13, 0x6b, 0x9a, 0x92, 0xe2, 0x33,
+	0x58, 0xd8, 0x26, 0x83, 0x0e, 0x71, 0x9e, 0x50, 0x4e, 0x2a, 0x98, 0x63, 0x74, 0x0d, 0x62, 0x7d,
+	0xaf, 0x69, 0xee, 0x93, 0x41, 0x46, 0x2b, 0x68, 0x2b, 0x49, 0x3d, 0xda, 0xf7, 0x9a, 0xdb, 0x64,
+	0x80, 0x4a, 0xb0, 0xd4, 0x71, 0xa9, 0xd7, 0x37, 0x5d, 0xd2, 0xa2, 0x87, 0xc4, 0x1d, 0x98, 0xb6,
+	0xd3, 0xa6, 0x99, 0x90, 0x04, 0x5d, 0x91, 0x2c, 0xdd, 0xe7, 0xd4, 0x9c, 0x36, 0x2d, 0xfe, 0xa0,
+	0x41, 0x6c, 0x9b, 0xc8, 0x33, 0xfa, 0x2f, 0x44, 0xf7, 0xc9, 0xc0, 0xb4, 0x2d, 0xa9, 0x33, 0xbe,
+	0xbe, 0x71, 0x76, 0x9a, 0x9f, 0x15, 0xcc, 0xca, 0x9b, 0xd3, 0xfc, 0xc3, 0x8e, 0xcd, 0xbb, 0x5e,
+	0xb3, 0xd4, 0xa2, 0x07, 0xab, 0xf8, 0x88, 0xf4, 0xb0, 0xeb, 0x10, 0xfe, 0x7f, 0xea, 0xee, 0xfb,
+	0x5f, 0xb7, 0x5b, 0xd4, 0x25, 0xab, 0x47, 0xab, 0x41, 0x67, 0x4a, 0x52, 0x58, 0x9f, 0xdd, 0x27,
+	0x83, 0x9a, 0x85, 0x1e, 0xc0, 0x9c, 0x50, 0xef, 0xd2, 0x1e, 0x91, 0xf6, 0x2c, 0xdc, 0x5d, 0x2e,
+	0x89, 0xe8, 0x8c, 0xd0, 0xbe, 0xeb, 0x42, 0x4a, 0xa7, 0x3d, 0xa2, 0xc7, 0xf6, 0xd5, 0x61, 0x28,
+	0x29, 0x42, 0x92, 0x09, 0xbf, 0x43, 0xb2, 0x31, 0xe8, 0x2b, 0x49, 0x71, 0x28, 0x3e, 0x0f, 0x41,
+	0xf2, 0xb1, 0xd7, 0xe3, 0x36, 0xb3, 0x3b, 0xd2, 0xc7, 0xab, 0x10, 0x1a, 0xf9, 0x17, 0x3d, 0x3b,
+	0xcd, 0x87, 0x6a, 0x15, 0x3d, 0x64, 0x5b, 0x28, 0x03, 0x31, 0x6e, 0x1f, 0x10, 0xea, 0x71, 0x69,
+	0x5b, 0x58, 0x1f, 0x7e, 0xa2, 0x65, 0x00, 0x8e, 0xdd, 0x0e, 0xe1, 0xa6, 0xe3, 0x1d, 0xc8, 0xeb,
+	0xc3, 0x7a, 0x5c, 0x51, 0xea, 0xde, 0x01, 0xfa, 0x0b, 0xcc, 0x8a, 0x08, 0xb3, 0x4c, 0xa4, 0x10,
+	0x5e, 0x49, 0xdc, 0xcd, 0x95, 0x02, 0x09, 0x2f, 0x05, 0xaf, 0x2e, 0x89, 0x3f, 0xba, 0x02, 0x67,
+	0x29, 0x44, 0xa4, 0x39, 0x06, 0x24, 0xfa, 0xd8, 0xe5, 0x76, 0xcb, 0xee, 0x63, 0x87, 0xab, 0x5c,
+	0xae, 0xaf, 0xbd, 0x39, 0xcd, 0xdf, 0x0e, 0x84, 0xbb, 0x45, 0xd9, 0x01, 0x65, 0xfe, 0xbf, 0xdb,
+	0xcc, 0xda, 0xf7, 0x8b, 0xe3, 0x09, 0xee, 0x95, 0x2d, 0xcb, 0x25, 0x8c, 0xe9, 0x41, 0x2d, 0x08,
+	0x41, 0xc4, 0xc2, 0x1c, 0x67, 0x42, 0x85, 0xf0, 0x4a, 0x52, 0x97, 0xe7, 0xe2, 0x8b, 0x28, 0xc4,
+	0x45, 0x5c, 0x49, 0x8b, 0xba, 0x16, 0xba, 0xaf, 0x02, 0x2a, 0x4b, 0x43, 0xdc, 0x99, 0xb8, 0x9b,
+	0x9e, 0xb0, 0xdb, 0xaf, 0x88, 0xf5, 0xc8, 0xcb, 0xd3, 0xfc, 0x8c, 0x8c, 0xa6, 0xb4, 0xf6, 0x16,
+	0x84, 0x45, 0xc5, 0x85, 0xa4, 0xc4, 0xef, 0x2f, 0x4e, 0x9e, 0x40, 0xa1, 0x1b, 0xb0, 0xb0, 0x2f,
+	0x8b, 0xd6, 0x64, 0x1c, 0x0b, 0x88, 0x8c, 0xdd, 0x9c, 0x3e, 0xaf, 0xa8, 0x86, 0x22, 0xa2, 0x26,
+	0x2c, 0xf9, 0xb0, 0x80, 0x0b, 0x2a, 0x9a, 0xef, 0x15, 0x09, 0xa4, 0xb4, 0xed, 0x06, 0x94, 0xa1,
+	0x3b, 0x90, 0xee, 0x62, 0x66, 0x32, 0x07, 0xf7, 0x59, 0x97, 0x72, 0xb3, 0x45, 0x3d, 0x87, 0x13,
+	0x37, 0x33, 0x2b, 0x0d, 0x42, 0x5d, 0xcc, 0x0c, 0x9f, 0xf5, 0x48, 0x71, 0xd0, 0x9f, 0x21, 0x75,
+	0x0e, 0x1d, 0x95, 0xa9, 0x5f, 0x64, 0x6f, 0x41, 0x6f, 0xc0, 0x82, 0x4b, 0x39, 0xe6, 0x36, 0x75,
+	0x14, 0x34, 0x13, 0x93, 0xc0, 0xf9, 0x21, 0x55, 0x02, 0x45, 0x19, 0x49, 0x02, 0xb1, 0x4c, 0xcc,
+	0x33, 0x73, 0xaa, 0x8c, 0x7c, 0x4a, 0x99, 0x5f, 0xd4, 0xb7, 0xf1, 0x0b, 0xfa, 0x16, 0xb5, 0xe1,
+	0x6a, 0xdf, 0xb5, 0x0f, 0x31, 0x27, 0x93, 0x12, 0x2c, 0x03, 0xb2, 0x0e, 0x6f, 0xbe, 0x9d, 0x4f,
+	0x95, 0xf9, 0xd2, 0xae, 0x12, 0x0a, 0xea, 0xf2, 0xb3, 0x9c, 0xee, 0x9f, 0x67, 0x31, 0xb4, 0x0d,
+	0xe9, 0x03, 0xbf, 0x88, 0x4d, 0x3f, 0x4f, 0xd2, 0xb0, 0x44, 0xa0, 0x06, 0xa6, 0x55, 0xbb, 0x8e,
+	0x86, 0x62, 0x6a, 0x72, 0x09, 0x5a, 0xf6, 0x0b, 0x0d, 0x96, 0xa6, 0x18, 0x80, 0x76, 0x20, 0x7e,
+	0x88, 0x7b, 0xb6, 0x85, 0x39, 0x75, 0xdf, 0xbf, 0x07, 0xc6, 0x3a, 0xd0, 0x75, 0x98, 0x9f, 0x36,
+	0xff, 0x92, 0x6e, 0x70, 0xf4, 0x7d, 0x16, 0x01, 0x30, 0xec, 0x8e, 0xe3, 0xf7, 0x44, 0x01, 0xa2,
+	0xc2, 0xc9, 0xd1, 0x74, 0x88, 0x8b, 0xe9, 0x67, 0xd8, 0x1d, 0x31, 0xc0, 0x84, 0x53, 0x16, 0xfa,
+	0x27, 0x80, 0x40, 0x30, 0x8e, 0xb9, 0xc7, 0xfc, 0x11, 0x96, 0x9f, 0xde, 0x05, 0x86, 0xdd, 0x31,
+	0x24, 0x4c, 0x8f, 0xb3, 0xe1, 0x11, 0xfd, 0x03, 0xc4, 0x87, 0x83, 0xb9, 0xe7, 0xaa, 0x39, 0x96,
+	0xf8, 0x15, 0x71, 0x05, 0xd3, 0xc7, 0x12, 0xe8, 0xef, 0x4a, 0x5c, 0x39, 0x14, 0x29, 0x68, 0xa3,
+	0x69, 0x33, 0x55, 0x5c, 0x26, 0x61, 0x8e, 0xf9, 0x27, 0xf4, 0x18, 0x92, 0x13, 0xfd, 0x35, 0x2b,
+	0xab, 0xe4, 0xfa, 0x44, 0xfe, 0xc6, 0xc1, 0x28, 0x05, 0xda, 0xc7, 0x2f, 0x8f, 0x09, 0x71, 0xb4,
+	0x09, 0xa3, 0xfc, 0x9a, 0x63, 0xa3, 0xa2, 0xef, 0x2a, 0x8a, 0xd4, 0x50, 0x68, 0x68, 0x61, 0xf6,
+	0x13, 0x48, 0x04, 0xee, 0xba, 0xfc, 0x4a, 0xc8, 0x43, 0x82, 0x75, 0xb1, 0x4b, 0xfc, 0xd6, 0x54,
+	0xb3, 0x1d, 0x24, 0x49, 0xf6, 0x65, 0xf1, 0x27, 0x0d, 0x92, 0x72, 0xe1, 0xa8, 0x66, 0x65, 0x28,
+	0x0d, 0xb3, 0xad, 0x2e, 0xb6, 0x1d, 0x55, 0x06, 0xba, 0xfa, 0xf8, 0x80, 0xe5, 0x75, 0x7e, 0x3e,
+	0x84, 0xa7, 0xcd, 0x87, 0xff, 0x41, 0x4c, 0x2d, 0x5f, 0x35, 0xfb, 0xe2, 0xeb, 0x9b, 0x67, 0xa7,
+	0xf9, 0xa8, 0x5c, 0xa0, 0xec, 0xc3, 0xd6, 0x6f, 0x54, 0xae, 0x5f, 0x56, 0x7c, 0xae, 0x41, 0xb2,
+	0x7a, 0xc4, 0x89, 0xeb, 0xe0, 0xde, 0x36, 0x19, 0x5c, 0xe4, 0x69, 0xc0, 0x90, 0xd0, 0xc7, 0x31,
+	0xe4, 0xbb, 0x10, 0xa4, 0x8d, 0x56, 0x97, 0x58, 0x5e, 0x8f, 0x58, 0x81, 0xd8, 0x5f, 0x7a, 0xe8,
+	0xc7, 0x0f, 0x9a, 0xf0, 0xc7, 0x78, 0xd0, 0x3c, 0x84, 0x18, 0x77, 0xed, 0x4e, 0x87, 0xb8, 0x99,
+	0x48, 0x60, 0x18, 0x4c, 0x98, 0xa3, 0x3c, 0x6b, 0x28, 0x98, 0x3e, 0xc4, 0x8b, 0x6d, 0xe0, 0x6f,
+	0x45, 0xb1, 0x0d, 0x66, 0xd5, 0x36, 0xf0, 0x29, 0x65, 0x2e, 0xd6, 0x4f, 0x1b, 0xdb, 0x3d, 0x62,
+	0x99, 0x5d, 0xec, 0x58, 0x62, 0x64, 0xb1, 0xe1, 0xfa, 0x51, 0xf4, 0xad, 0x21, 0xb9, 0xf8, 0xa9,
+	0x06, 0xa9, 0xc0, 0x4d, 0xe5, 0x26, 0x75, 0xf9, 0xa5, 0x07, 0x72, 0x19, 0x00, 0x37, 0xe9, 0xd0,
+	0x5c, 0xff, 0x0d, 0xe4, 0x53, 0xca, 0xbc, 0xf8, 0x55, 0x18, 0xe6, 0x87, 0x1b, 0xb4, 0xe2, 0xda,
+	0x6d, 0xfe, 0xb1, 0x9f, 0x92, 0xd3, 0xd6, 0x73, 0x68, 0xfa, 0x7a, 0xbe, 0x09, 0x57, 0x38, 0xe5,
+	0xb8, 0x67, 0x06, 0xc7, 0x80, 0xf2, 0x60, 0x51, 0x32, 0x8c, 0xd1, 0x2c, 0x10, 0xef, 0x04, 0xd2,
+	0xb3, 0x3b, 0x76, 0xb3, 0x47, 0x26, 0xe0, 0x11, 0x09, 0x47, 0x43, 0x5e, 0x40, 0x62, 0x0d, 0xd2,
+	0x2d, 0xea, 0xba, 0x5e, 0x5f, 0xb6, 0x37, 0xef, 0xba, 0x84, 0x75, 0x69, 0xcf, 0xf2, 0x33, 0xba,
+	0x34, 0xe6, 0x35, 0x86, 0x2c, 0x74, 0x0f, 0x7e, 0x67, 0x3b, 0xa3, 0x6b, 0x46, 0x93, 0x4a, 0x24,
+	0x38, 0xbc, 0x12, 0xd7, 0xd3, 0x63, 0xe6, 0x93, 0x11, 0x0f, 0x3d, 0x80, 0xa8, 0xbf, 0x76, 0x62,
+	0x32, 0x71, 0x85, 0xc9, 0xc1, 0x1d, 0x8c, 0xbd, 0xbf, 0x77, 0x7c, 0x7c, 0xf1, 0x4b, 0x0d, 0x90,
+	0xe1, 0xb1, 0x3e, 0x71, 0x2c, 0x62, 0x8d, 0x34, 0x5e, 0xfe, 0xa0, 0xfd, 0x13, 0x2c, 0xb2, 0xe1,
+	0x35, 0xa6, 0xe7, 0x70, 0xbb, 0xe7, 0x67, 0x64, 0x61, 0x44, 0xde, 0x13, 0xd4, 0x9b, 0x3f, 0x6b,
+	0x80, 0xce, 0xb7, 0x06, 0xba, 0x0f, 0x85, 0xed, 0xea, 0x33, 0x53, 0xdf, 0x69, 0x94, 0x1b, 0xb5,
+	0x9d, 0xba, 0xd9, 0xd0, 0x6b, 0x9b, 0x9b, 0x55, 0xdd, 0xdc, 0xab, 0x1b, 0xbb, 0xd5, 0x47, 0xb5,
+	0x8d, 0x5a, 0xb5, 0x92, 0x9a, 0xc9, 0x2e, 0x1e, 0x9f, 0x14, 0x12, 0x7b, 0x0e, 0xeb, 0x93, 0x96,
+	0xdd, 0xb6, 0x89, 0x85, 0x6e, 0xc1, 0x1f, 0xa6, 0x8a, 0xed, 0x56, 0xf5, 0xda, 0x4e, 0x25, 0xa5,
+	0x65, 0xe1, 0xf8, 0xa4, 0x10, 0xdd, 0x25, 0xae, 0x4d, 0x2d, 0xf4, 0x37, 0xb8, 0x3e, 0x15, 0x6c,
+	0xd4, 0xcb, 0xbb, 0xc6, 0xd6, 0x4e, 0xc3, 0xac, 0xe8, 0xb5, 0x8d, 0x46, 0x2a, 0x94, 0xbd, 0x72,
+	0x7c, 0x52, 0x78, 0xab, 0xa2, 0xd7, 0x20, 0x77, 0x81, 0x7d, 0xeb, 0x3b, 0xf5, 0x4a, 0xad, 0xbe,
+	0x99, 0x0a, 0x67, 0xe7, 0x8f, 0x4f, 0x0a, 0xf1, 0x3d, 0xa7, 0x49, 0x1d, 0xcb, 0x76, 0x3a, 0xd9,
+	0xb9, 0xcf, 0xbf, 0xce, 0x69, 0xdf, 0x7e, 0x93, 0xd3, 0x84, 0xcf, 0x4b, 0x53, 0x92, 0x84, 0xfe,
+	0x0a, 0x7f, 0x9c, 0xbc, 0xdb, 0x34, 0x1a, 0xe5, 0xc6, 0x9e, 0xf1, 0x2e, 0xaf, 0x4b, 0xb0, 0x3c,
+	0x5d, 0x6e, 0xab, 0x5a, 0xfe, 0x77, 0x63, 0xeb, 0x59, 0x4a, 0xcb, 0x26, 0x8e, 0x4f, 0x0a, 0xb1,
+	0x2d, 0x82, 0x7b, 0xbc, 0x3b, 0xb8, 0x18, 0xff, 0xb4, 0xac, 0xd7, 0x85, 0xed, 0x21, 0x85, 0x7f,
+	0x8a, 0x5d, 0xc7, 0x76, 0x3a, 0xe8, 0x01, 0x14, 0xa7, 0xe3, 0x6b, 0x75, 0x63, 0x6f, 0x63, 0xa3,
+	0xf6, 0xa8, 0x56, 0xad, 0x37, 0x52, 0xe1, 0x6c, 0xea, 0xf8, 0xa4, 0x90, 0xac, 0x39, 0xcc, 0x6b,
+	0xb7, 0xed, 0x96, 0x4d, 0x1c, 0x3e, 0xf6, 0x79, 0xfd, 0x5f, 0x2f, 0xcf, 0x72, 0xda, 0xab, 0xb3,
+	0x9c, 0xf6, 0xe3, 0x59, 0x4e, 0x7b, 0xf1, 0x3a, 0x37, 0xf3, 0xea, 0x75, 0x6e, 0xe6, 0xfb, 0xd7,
+	0xb9, 0x99, 0xff, 0xdc, 0xf9, 0x0d, 0xfd, 0x2f, 0x4b, 0xae, 0x19, 0x95, 0xbf, 0x83, 0xef, 0xfd,
+	0x32, 0x00, 0xbb, 0x48, 0x54, 0x54, 0x67, 0x0f, 0x00, 0x00,
+}
+
+func (m *KeygenVoteData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeygenVoteData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeygenVoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupRecoveryInfo) > 0 {
+		i -= len(m.GroupRecoveryInfo)
+		copy(dAtA[i:], m.GroupRecoveryInfo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupRecoveryInfo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Infos) > 0 {
+		for iNdEx := len(m.Infos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TargetNum != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigInfo_Info) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigInfo_Info) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigInfo_Info) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Data[iNdEx])
+			copy(dAtA[i:], m.Data[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Data[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MultisigKeygenInfo != nil {
+		{
+			size, err := m.MultisigKeygenInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PrivateRecoveryInfos) > 0 {
+		for iNdEx := len(m.PrivateRecoveryInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrivateRecoveryInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.GroupRecoveryInfo) > 0 {
+		i -= len(m.GroupRecoveryInfo)
+		copy(dAtA[i:], m.GroupRecoveryInfo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupRecoveryInfo)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RotatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RotatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RotationCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RotationCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SnapshotCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SnapshotCounter))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HasSnapshotCounter {
+		i--
+		if m.HasSnapshotCounter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.KeygenParticipants) > 0 {
+		for iNdEx := len(m.KeygenParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeygenParticipants[iNdEx])
+			copy(dAtA[i:], m.KeygenParticipants[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.KeygenParticipants[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.KeygenStarted {
+		i--
+		if m.KeygenStarted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.KeyInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRecord_PrivateRecoveryInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRecord_PrivateRecoveryInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRecord_PrivateRecoveryInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveryInfo) > 0 {
+		i -= len(m.RecoveryInfo)
+		copy(dAtA[i:], m.RecoveryInfo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RecoveryInfo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MultisigSignInfo != nil {
+		{
+			size, err := m.MultisigSignInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SignInfo != nil {
+		{
+			size, err := m.SignInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Signature != nil {
+		{
+			size, err := m.Signature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SigStatus != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SigID) > 0 {
+		i -= len(m.SigID)
+		copy(dAtA[i:], m.SigID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SigID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignRecord_Participant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignRecord_Participant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignRecord_Participant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShareCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ShareCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRotations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyIDs) > 0 {
+		for iNdEx := len(m.KeyIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyIDs[iNdEx])
+			copy(dAtA[i:], m.KeyIDs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RotationCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RotationCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyIDs) > 0 {
+		for iNdEx := len(m.KeyIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyIDs[iNdEx])
+			copy(dAtA[i:], m.KeyIDs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedHandovers != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailedHandovers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Trigger != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Trigger))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRotationAbort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotationAbort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotationAbort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AbortedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AbortedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeyRole != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyRole))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotDrift) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotDrift) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotDrift) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IneligibleValidators) > 0 {
+		for iNdEx := len(m.IneligibleValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IneligibleValidators[iNdEx])
+			copy(dAtA[i:], m.IneligibleValidators[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.IneligibleValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CorruptionThreshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CorruptionThreshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EligibleShareCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EligibleShareCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalShareCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalShareCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SnapshotCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SnapshotCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuspendedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspendedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspendedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuspendedUntil != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SuspendedUntil))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *KeygenVoteData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupRecoveryInfo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovTypes(uint64(m.KeyRole))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTypes(uint64(m.KeyType))
+	}
+	return n
+}
+
+func (m *MultisigInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovTypes(uint64(m.Timeout))
+	}
+	if m.TargetNum != 0 {
+		n += 1 + sovTypes(uint64(m.TargetNum))
+	}
+	if len(m.Infos) > 0 {
+		for _, e := range m.Infos {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultisigInfo_Info) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KeyInfo.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.KeygenStarted {
+		n += 2
+	}
+	if len(m.KeygenParticipants) > 0 {
+		for _, b := range m.KeygenParticipants {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.HasSnapshotCounter {
+		n += 2
+	}
+	if m.SnapshotCounter != 0 {
+		n += 1 + sovTypes(uint64(m.SnapshotCounter))
+	}
+	if m.RotationCount != 0 {
+		n += 1 + sovTypes(uint64(m.RotationCount))
+	}
+	if m.RotatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.RotatedAt))
+	}
+	l = len(m.GroupRecoveryInfo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.PrivateRecoveryInfos) > 0 {
+		for _, e := range m.PrivateRecoveryInfos {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MultisigKeygenInfo != nil {
+		l = m.MultisigKeygenInfo.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyRecord_PrivateRecoveryInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RecoveryInfo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SignRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SigID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SigStatus != 0 {
+		n += 1 + sovTypes(uint64(m.SigStatus))
+	}
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SignInfo != nil {
+		l = m.SignInfo.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MultisigSignInfo != nil {
+		l = m.MultisigSignInfo.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SignRecord_Participant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ShareCount != 0 {
+		n += 1 + sovTypes(uint64(m.ShareCount))
+	}
+	return n
+}
+
+func (m *KeyRotations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovTypes(uint64(m.KeyRole))
+	}
+	if m.RotationCount != 0 {
+		n += 1 + sovTypes(uint64(m.RotationCount))
+	}
+	if len(m.KeyIDs) > 0 {
+		for _, s := range m.KeyIDs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExternalKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.KeyIDs) > 0 {
+		for _, s := range m.KeyIDs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovTypes(uint64(m.KeyRole))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Trigger != 0 {
+		n += 1 + sovTypes(uint64(m.Trigger))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovTypes(uint64(m.StartedAt))
+	}
+	if m.FailedHandovers != 0 {
+		n += 1 + sovTypes(uint64(m.FailedHandovers))
+	}
+	return n
+}
+
+func (m *KeyRotationAbort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.KeyRole != 0 {
+		n += 1 + sovTypes(uint64(m.KeyRole))
+	}
+	if m.AbortedAt != 0 {
+		n += 1 + sovTypes(uint64(m.AbortedAt))
+	}
+	return n
+}
+
+func (m *SnapshotDrift) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SnapshotCounter != 0 {
+		n += 1 + sovTypes(uint64(m.SnapshotCounter))
+	}
+	if m.TotalShareCount != 0 {
+		n += 1 + sovTypes(uint64(m.TotalShareCount))
+	}
+	if m.EligibleShareCount != 0 {
+		n += 1 + sovTypes(uint64(m.EligibleShareCount))
+	}
+	if m.CorruptionThreshold != 0 {
+		n += 1 + sovTypes(uint64(m.CorruptionThreshold))
+	}
+	if len(m.IneligibleValidators) > 0 {
+		for _, s := range m.IneligibleValidators {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *SuspendedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SuspendedUntil != 0 {
+		n += 1 + sovTypes(uint64(m.SuspendedUntil))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KeygenVoteData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeygenVoteData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeygenVoteData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupRecoveryInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupRecoveryInfo = append(m.GroupRecoveryInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupRecoveryInfo == nil {
+				m.GroupRecoveryInfo = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= exported.KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNum", wireType)
+			}
+			m.TargetNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetNum |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infos = append(m.Infos, &MultisigInfo_Info{})
+			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = append(m.Participant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Participant == nil {
+				m.Participant = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &exported.Key{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenStarted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeygenStarted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenParticipants", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenParticipants = append(m.KeygenParticipants, make([]byte, postIndex-iNdEx))
+			copy(m.KeygenParticipants[len(m.KeygenParticipants)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasSnapshotCounter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasSnapshotCounter = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotCounter", wireType)
+			}
+			m.SnapshotCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationCount", wireType)
+			}
+			m.RotationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedAt", wireType)
+			}
+			m.RotatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupRecoveryInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupRecoveryInfo = append(m.GroupRecoveryInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupRecoveryInfo == nil {
+				m.GroupRecoveryInfo = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateRecoveryInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivateRecoveryInfos = append(m.PrivateRecoveryInfos, KeyRecord_PrivateRecoveryInfo{})
+			if err := m.PrivateRecoveryInfos[len(m.PrivateRecoveryInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigKeygenInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultisigKeygenInfo == nil {
+				m.MultisigKeygenInfo = &MultisigInfo{}
+			}
+			if err := m.MultisigKeygenInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRecord_PrivateRecoveryInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrivateRecoveryInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrivateRecoveryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryInfo = append(m.RecoveryInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.RecoveryInfo == nil {
+				m.RecoveryInfo = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigStatus", wireType)
+			}
+			m.SigStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigStatus |= exported.SigStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signature == nil {
+				m.Signature = &exported.Signature{}
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignInfo == nil {
+				m.SignInfo = &exported.SignInfo{}
+			}
+			if err := m.SignInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, SignRecord_Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigSignInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultisigSignInfo == nil {
+				m.MultisigSignInfo = &MultisigInfo{}
+			}
+			if err := m.MultisigSignInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignRecord_Participant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Participant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Participant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCount", wireType)
+			}
+			m.ShareCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRotations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRotations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRotations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationCount", wireType)
+			}
+			m.RotationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyIDs = append(m.KeyIDs, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyIDs = append(m.KeyIDs, github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MultisigInfo_Info) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Data) > 0 {
-		for _, b := range m.Data {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *KeygenVoteData) Unmarshal(dAtA []byte) error {
+func (m *ScheduledKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -592,17 +3882,17 @@ func (m *KeygenVoteData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeygenVoteData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeygenVoteData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -612,31 +3902,48 @@ func (m *KeygenVoteData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
-			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRole", wireType)
+			}
+			m.KeyRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRole |= exported.KeyRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupRecoveryInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -646,26 +3953,81 @@ func (m *KeygenVoteData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupRecoveryInfo = append(m.GroupRecoveryInfo[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupRecoveryInfo == nil {
-				m.GroupRecoveryInfo = []byte{}
-			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			m.Trigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Trigger |= KeyRotationTrigger(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedHandovers", wireType)
+			}
+			m.FailedHandovers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedHandovers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -687,7 +4049,7 @@ func (m *KeygenVoteData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyInfo) Unmarshal(dAtA []byte) error {
+func (m *KeyRotationAbort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -710,15 +4072,15 @@ func (m *KeyInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRotationAbort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRotationAbort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -746,7 +4108,7 @@ func (m *KeyInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -769,9 +4131,9 @@ func (m *KeyInfo) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortedAt", wireType)
 			}
-			m.KeyType = 0
+			m.AbortedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -781,7 +4143,7 @@ func (m *KeyInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeyType |= exported.KeyType(b&0x7F) << shift
+				m.AbortedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -807,7 +4169,7 @@ func (m *KeyInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
+func (m *SnapshotDrift) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,15 +4192,15 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultisigInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotDrift: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultisigInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotDrift: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -866,13 +4228,13 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_tss_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotCounter", wireType)
 			}
-			m.Timeout = 0
+			m.SnapshotCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -882,16 +4244,16 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= int64(b&0x7F) << shift
+				m.SnapshotCounter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShareCount", wireType)
 			}
-			m.TargetNum = 0
+			m.TotalShareCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -901,16 +4263,54 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetNum |= int64(b&0x7F) << shift
+				m.TotalShareCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleShareCount", wireType)
+			}
+			m.EligibleShareCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibleShareCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorruptionThreshold", wireType)
+			}
+			m.CorruptionThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorruptionThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IneligibleValidators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -920,26 +4320,43 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Infos = append(m.Infos, &MultisigInfo_Info{})
-			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IneligibleValidators = append(m.IneligibleValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SnapshotDriftStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -961,7 +4378,7 @@ func (m *MultisigInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
+func (m *SuspendedValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -984,15 +4401,15 @@ func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuspendedValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuspendedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1019,16 +4436,16 @@ func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = append(m.Participant[:0], dAtA[iNdEx:postIndex]...)
-			if m.Participant == nil {
-				m.Participant = []byte{}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
 			}
-			var byteLen int
+			m.SuspendedUntil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1038,24 +4455,11 @@ func (m *MultisigInfo_Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SuspendedUntil |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
-			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -13,18 +13,14 @@ import (
 // InitGenesis initialize default parameters
 // from the genesis state
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, state types.GenesisState) {
-	k.SetDefaultVotingThreshold(ctx, state.VotingThreshold)
+	k.InitGenesis(ctx, &state)
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	state := &types.GenesisState{
-		VotingThreshold: k.GetDefaultVotingThreshold(ctx),
-	}
-
-	return state
+	return k.ExportGenesis(ctx)
 }
 
 // GetGenesisStateFromAppState returns x/vote GenesisState given raw application
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/axelar-core/x/vote/types"
+)
+
+// InitGenesis initializes the vote module's state from a given genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetDefaultVotingThreshold(ctx, genState.VotingThreshold)
+
+	for _, poll := range genState.Polls {
+		store := k.newPollStore(ctx, poll.Metadata.Key)
+		store.SetMetadata(poll.Metadata)
+
+		for _, vote := range poll.Votes {
+			for _, voter := range vote.Voters {
+				store.SetVote(voter, vote)
+			}
+		}
+	}
+}
+
+// ExportGenesis returns the vote module's genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	polls := []types.PollRecord{}
+
+	iter := k.getKVStore(ctx).Iterator(pollPrefix.AppendStr(""))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var metadata exported.PollMetadata
+		iter.UnmarshalValue(&metadata)
+
+		polls = append(polls, types.PollRecord{
+			Metadata: metadata,
+			Votes:    k.getTalliedVotes(ctx, metadata.Key),
+		})
+	}
+
+	return types.NewGenesisState(k.GetDefaultVotingThreshold(ctx), polls)
+}
+
+// getTalliedVotes returns only the votes of the given poll, even if the key of another poll shares the same prefix
+func (k Keeper) getTalliedVotes(ctx sdk.Context, pollKey exported.PollKey) []types.TalliedVote {
+	votes := []types.TalliedVote{}
+
+	prefix := votesPrefix.AppendStr(pollKey.String()).AppendStr("")
+	iter := k.getKVStore(ctx).Iterator(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var vote types.TalliedVote
+		iter.UnmarshalValue(&vote)
+
+		if !votesPrefix.AppendStr(pollKey.String()).AppendStr(vote.Hash()).Equals(iter.GetKey()) {
+			continue
+		}
+
+		votes = append(votes, vote)
+	}
+
+	return votes
+}
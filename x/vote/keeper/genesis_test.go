@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoprototypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/axelar-core/x/vote/keeper"
+	"github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/axelar-core/x/vote/types/mock"
+)
+
+func randPollRecord() types.PollRecord {
+	voterCount := rand.I64Between(1, 20)
+	voters := make([]exported.Voter, voterCount)
+	totalVotingPower := sdk.ZeroInt()
+	for i := range voters {
+		voters[i] = exported.Voter{Validator: rand.ValAddr(), VotingPower: rand.I64Between(1, 100)}
+		totalVotingPower = totalVotingPower.AddRaw(voters[i].VotingPower)
+	}
+
+	key := exported.NewPollKey(rand.StrBetween(5, 20), rand.StrBetween(5, 20))
+	metadata := types.NewPollMetaData(key, types.DefaultGenesisState().VotingThreshold, voters, totalVotingPower)
+
+	votes := []types.TalliedVote{}
+	for _, voter := range voters[:rand.I64Between(0, voterCount+1)] {
+		data := &gogoprototypes.StringValue{Value: rand.StrBetween(1, 3)}
+		vote := types.NewTalliedVote(voter.Validator, voter.VotingPower, data)
+
+		found := false
+		for i := range votes {
+			if votes[i].Hash() == vote.Hash() {
+				votes[i].Tally = votes[i].Tally.Add(vote.Tally)
+				votes[i].Voters = append(votes[i].Voters, voter.Validator)
+				found = true
+			}
+		}
+
+		if !found {
+			votes = append(votes, vote)
+		}
+	}
+
+	// votes are stored by their hash
+	sort.Slice(votes, func(i, j int) bool { return votes[i].Hash() < votes[j].Hash() })
+
+	return types.PollRecord{Metadata: metadata, Votes: votes}
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	encCfg.InterfaceRegistry.RegisterImplementations((*codec.ProtoMarshaler)(nil), &gogoprototypes.StringValue{})
+
+	t.Run("should restore all polls and votes", testutils.Func(func(t *testing.T) {
+		storeKey := sdk.NewKVStoreKey(types.StoreKey)
+		k := keeper.NewKeeper(encCfg.Marshaler, storeKey, &mock.SnapshotterMock{}, &mock.StakingKeeperMock{}, &mock.RewarderMock{})
+
+		polls := make([]types.PollRecord, rand.I64Between(0, 20))
+		for i := range polls {
+			polls[i] = randPollRecord()
+		}
+		// polls are stored by their key
+		sort.Slice(polls, func(i, j int) bool { return polls[i].Metadata.Key.String() < polls[j].Metadata.Key.String() })
+
+		expected := types.NewGenesisState(types.DefaultGenesisState().VotingThreshold, polls)
+		assert.NoError(t, expected.Validate())
+
+		ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		k.InitGenesis(ctx, expected)
+
+		actual := k.ExportGenesis(ctx)
+		assert.Equal(t, encCfg.Marshaler.MustMarshalJSON(expected), encCfg.Marshaler.MustMarshalJSON(actual))
+
+		for _, poll := range expected.Polls {
+			assert.True(t, k.GetPoll(ctx, poll.Metadata.Key).Is(exported.Pending))
+		}
+
+		bz := encCfg.Marshaler.MustMarshalJSON(actual)
+		var unmarshaled types.GenesisState
+		encCfg.Marshaler.MustUnmarshalJSON(bz, &unmarshaled)
+		assert.NoError(t, unmarshaled.Validate())
+
+		newCtx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		k.InitGenesis(newCtx, &unmarshaled)
+		assert.Equal(t, encCfg.Marshaler.MustMarshalJSON(expected), encCfg.Marshaler.MustMarshalJSON(k.ExportGenesis(newCtx)))
+	}).Repeat(20))
+
+	t.Run("should reject votes from ineligible voters", testutils.Func(func(t *testing.T) {
+		poll := randPollRecord()
+		poll.Votes = append(poll.Votes, types.NewTalliedVote(rand.ValAddr(), rand.PosI64(), &gogoprototypes.StringValue{Value: rand.Str(5)}))
+
+		genState := types.NewGenesisState(types.DefaultGenesisState().VotingThreshold, []types.PollRecord{poll})
+		assert.Error(t, genState.Validate())
+	}).Repeat(20))
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/axelar-core/x/vote/types"
+)
+
+var _ types.QueryServiceServer = Querier{}
+
+// Querier implements the grpc querier
+type Querier struct {
+	keeper Keeper
+}
+
+// NewGRPCQuerier creates a new vote Querier
+func NewGRPCQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// Poll returns the poll with the given key together with its tallied votes
+func (q Querier) Poll(c context.Context, req *types.QueryPollRequest) (*types.QueryPollResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pollKey := exported.NewPollKey(req.Module, req.ID)
+	if err := pollKey.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrVote, err.Error())
+	}
+
+	metadata, ok := q.keeper.getPollMetadata(ctx, pollKey)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrVote, fmt.Sprintf("poll %s not found", pollKey.String()))
+	}
+
+	return &types.QueryPollResponse{
+		Poll: types.PollRecord{
+			Metadata: metadata,
+			Votes:    q.keeper.getTalliedVotes(ctx, pollKey),
+		},
+	}, nil
+}
+
+// Polls returns a page of all polls
+func (q Querier) Polls(c context.Context, req *types.QueryPollsRequest) (*types.QueryPollsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	polls := make([]exported.PollMetadata, 0)
+	store := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), pollPrefix.AppendStr("").AsKey())
+
+	pageResponse, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var metadata exported.PollMetadata
+		if err := q.keeper.cdc.UnmarshalLengthPrefixed(value, &metadata); err != nil {
+			return err
+		}
+
+		polls = append(polls, metadata)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrVote, err.Error())
+	}
+
+	return &types.QueryPollsResponse{Polls: polls, Pagination: pageResponse}, nil
+}
+
+// DefaultVotingThreshold returns the default voting threshold of new polls
+func (q Querier) DefaultVotingThreshold(c context.Context, _ *types.QueryDefaultVotingThresholdRequest) (*types.QueryDefaultVotingThresholdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDefaultVotingThresholdResponse{Threshold: q.keeper.GetDefaultVotingThreshold(ctx)}, nil
+}
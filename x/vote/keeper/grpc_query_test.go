@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogoprototypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/axelar-core/x/vote/keeper"
+	"github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/axelar-core/x/vote/types/mock"
+)
+
+func TestQuerier(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	encCfg.InterfaceRegistry.RegisterImplementations((*codec.ProtoMarshaler)(nil), &gogoprototypes.StringValue{})
+
+	var (
+		ctx     sdk.Context
+		querier keeper.Querier
+		polls   []types.PollRecord
+	)
+	setup := func() {
+		k := keeper.NewKeeper(encCfg.Marshaler, sdk.NewKVStoreKey(types.StoreKey), &mock.SnapshotterMock{}, &mock.StakingKeeperMock{}, &mock.RewarderMock{})
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+
+		polls = make([]types.PollRecord, rand.I64Between(0, 30))
+		for i := range polls {
+			polls[i] = randPollRecord()
+		}
+		// polls are stored by their key
+		sort.Slice(polls, func(i, j int) bool { return polls[i].Metadata.Key.String() < polls[j].Metadata.Key.String() })
+
+		k.InitGenesis(ctx, types.NewGenesisState(types.DefaultGenesisState().VotingThreshold, polls))
+		querier = keeper.NewGRPCQuerier(k)
+	}
+
+	t.Run("should return all polls page by page", testutils.Func(func(t *testing.T) {
+		setup()
+
+		limit := uint64(rand.I64Between(1, 10))
+		var actual []exported.PollMetadata
+		var nextKey []byte
+		for {
+			res, err := querier.Polls(sdk.WrapSDKContext(ctx), &types.QueryPollsRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: limit}})
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, uint64(len(res.Polls)), limit)
+
+			actual = append(actual, res.Polls...)
+			nextKey = res.Pagination.NextKey
+			if nextKey == nil {
+				break
+			}
+		}
+
+		assert.Len(t, actual, len(polls))
+		for i, poll := range polls {
+			assert.Equal(t, poll.Metadata, actual[i])
+		}
+
+		res, err := querier.Polls(sdk.WrapSDKContext(ctx), &types.QueryPollsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(len(polls)), res.Pagination.Total)
+	}).Repeat(20))
+
+	t.Run("should return the poll with its tallied votes", testutils.Func(func(t *testing.T) {
+		setup()
+
+		for _, poll := range polls {
+			res, err := querier.Poll(sdk.WrapSDKContext(ctx), &types.QueryPollRequest{Module: poll.Metadata.Key.Module, ID: poll.Metadata.Key.ID})
+			assert.NoError(t, err)
+			assert.Equal(t, poll.Metadata, res.Poll.Metadata)
+			assert.Len(t, res.Poll.Votes, len(poll.Votes))
+		}
+
+		_, err := querier.Poll(sdk.WrapSDKContext(ctx), &types.QueryPollRequest{Module: rand.StrBetween(5, 20), ID: rand.StrBetween(5, 20)})
+		assert.Error(t, err)
+
+		_, err = querier.Poll(sdk.WrapSDKContext(ctx), &types.QueryPollRequest{})
+		assert.Error(t, err)
+	}).Repeat(20))
+
+	t.Run("should return the default voting threshold", func(t *testing.T) {
+		setup()
+
+		res, err := querier.DefaultVotingThreshold(sdk.WrapSDKContext(ctx), &types.QueryDefaultVotingThresholdRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, types.DefaultGenesisState().VotingThreshold, res.Threshold)
+	})
+}
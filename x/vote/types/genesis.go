@@ -1,20 +1,36 @@
 package types
 
 import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
 
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState is the constructor for GenesisState
+func NewGenesisState(votingThreshold utils.Threshold, polls []PollRecord) *GenesisState {
+	return &GenesisState{
+		VotingThreshold: votingThreshold,
+		Polls:           polls,
+	}
+}
+
 // DefaultGenesisState represents the default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{
-		VotingThreshold: utils.Threshold{
+	return NewGenesisState(
+		utils.Threshold{
 			Numerator:   2,
 			Denominator: 3,
 		},
-	}
+		[]PollRecord{},
+	)
 }
 
 // Validate validates the genesis state
@@ -27,5 +43,93 @@ func (m GenesisState) Validate() error {
 		return sdkerrors.Wrap(types.ErrInvalidGenesis, "voting threshold must be lesser than or equal to 1")
 	}
 
+	pollKeys := make(map[string]bool)
+	for _, poll := range m.Polls {
+		if err := poll.Validate(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidGenesis, err.Error())
+		}
+
+		if pollKeys[poll.Metadata.Key.String()] {
+			return sdkerrors.Wrapf(types.ErrInvalidGenesis, "duplicate poll %s", poll.Metadata.Key.String())
+		}
+		pollKeys[poll.Metadata.Key.String()] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (m GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, poll := range m.Polls {
+		if err := poll.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = PollRecord{}
+
+// Validate performs a stateless validity check on the poll and its votes
+func (m PollRecord) Validate() error {
+	if err := m.Metadata.Key.Validate(); err != nil {
+		return err
+	}
+
+	if m.Metadata.State == exported.NonExistent {
+		return fmt.Errorf("poll %s must exist", m.Metadata.Key.String())
+	}
+
+	if m.Metadata.TotalVotingPower.IsNil() || m.Metadata.TotalVotingPower.IsNegative() {
+		return fmt.Errorf("total voting power of poll %s must not be negative", m.Metadata.Key.String())
+	}
+
+	eligibleVoters := make(map[string]bool)
+	for _, voter := range m.Metadata.Voters {
+		eligibleVoters[voter.Validator.String()] = true
+	}
+
+	voted := make(map[string]bool)
+	for _, vote := range m.Votes {
+		if vote.Data == nil {
+			return fmt.Errorf("vote for poll %s is missing data", m.Metadata.Key.String())
+		}
+
+		if vote.Tally.IsNil() || vote.Tally.IsNegative() {
+			return fmt.Errorf("tally of vote for poll %s must not be negative", m.Metadata.Key.String())
+		}
+
+		for _, voter := range vote.Voters {
+			if err := sdk.VerifyAddressFormat(voter); err != nil {
+				return sdkerrors.Wrapf(err, "invalid voter for poll %s", m.Metadata.Key.String())
+			}
+
+			if !eligibleVoters[voter.String()] {
+				return fmt.Errorf("%s is not an eligible voter for poll %s", voter.String(), m.Metadata.Key.String())
+			}
+
+			if voted[voter.String()] {
+				return fmt.Errorf("%s voted more than once for poll %s", voter.String(), m.Metadata.Key.String())
+			}
+			voted[voter.String()] = true
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (m PollRecord) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if err := m.Metadata.UnpackInterfaces(unpacker); err != nil {
+		return err
+	}
+
+	for _, vote := range m.Votes {
+		if err := vote.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
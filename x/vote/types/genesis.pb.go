@@ -26,6 +26,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
 	VotingThreshold utils.Threshold `protobuf:"bytes,2,opt,name=voting_threshold,json=votingThreshold,proto3" json:"voting_threshold"`
+	Polls           []PollRecord    `protobuf:"bytes,3,rep,name=polls,proto3" json:"polls"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,22 +69,24 @@ func init() {
 func init() { proto.RegisterFile("vote/v1beta1/genesis.proto", fileDescriptor_30ba2e51f460db61) }
 
 var fileDescriptor_30ba2e51f460db61 = []byte{
-	// 225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0xcb, 0x2f, 0x49,
-	0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0xc9, 0xe9, 0x41, 0xe5, 0xa4, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x12, 0xfa, 0x20, 0x16, 0x44, 0x8d, 0x94, 0x6c, 0x69, 0x49, 0x66, 0x4e,
-	0x31, 0xdc, 0x80, 0x92, 0x8c, 0xa2, 0xd4, 0xe2, 0x8c, 0xfc, 0x9c, 0x14, 0x88, 0xb4, 0x52, 0x24,
-	0x17, 0x8f, 0x3b, 0xc4, 0xcc, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x4f, 0x2e, 0x81, 0xb2, 0xfc,
-	0x92, 0xcc, 0xbc, 0xf4, 0x78, 0xb8, 0x4a, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x09, 0x3d,
-	0xb0, 0x49, 0x30, 0xeb, 0xf4, 0x42, 0x60, 0xf2, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0xf1,
-	0x43, 0xf4, 0x21, 0x84, 0xfd, 0x4f, 0x3c, 0x94, 0x63, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
-	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6,
-	0x63, 0x39, 0x86, 0x28, 0xc3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd,
-	0xc4, 0x8a, 0xd4, 0x9c, 0xc4, 0xa2, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x28, 0x4f, 0x37,
-	0x39, 0xbf, 0x28, 0x55, 0xbf, 0x42, 0x1f, 0xec, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0x93, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x28, 0x05, 0x16, 0x1f, 0x13, 0x01, 0x00,
-	0x00,
+	// 265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x13, 0x0a, 0x0c, 0xa1, 0x12, 0x28, 0x62, 0x88, 0x22, 0x61, 0x2a, 0x26, 0x16, 0x6c,
+	0x05, 0x78, 0x82, 0x2e, 0x88, 0x09, 0x54, 0x98, 0x58, 0x50, 0x12, 0x4e, 0x4e, 0x84, 0xc9, 0x45,
+	0xf6, 0x35, 0x94, 0xa7, 0x80, 0xc7, 0xca, 0xd8, 0x91, 0x09, 0x41, 0xf2, 0x22, 0xa8, 0xce, 0x1f,
+	0xc1, 0x66, 0xfb, 0x77, 0xdf, 0x77, 0xfe, 0x79, 0x61, 0x85, 0x04, 0xa2, 0x8a, 0x12, 0xa0, 0x38,
+	0x12, 0x12, 0x0a, 0x30, 0xb9, 0xe1, 0xa5, 0x46, 0x42, 0x7f, 0xba, 0x61, 0xbc, 0x67, 0xe1, 0xa1,
+	0x44, 0x89, 0x16, 0x88, 0xcd, 0xa9, 0x9b, 0x09, 0x8f, 0x96, 0x94, 0x2b, 0x33, 0x16, 0x50, 0xa6,
+	0xc1, 0x64, 0xa8, 0x9e, 0x7a, 0x1c, 0xfc, 0xab, 0xa7, 0xb7, 0x12, 0xfa, 0xf2, 0x93, 0x77, 0xd7,
+	0x9b, 0x5e, 0x75, 0xeb, 0xee, 0x28, 0x26, 0xf0, 0xaf, 0xbd, 0x83, 0x0a, 0x29, 0x2f, 0xe4, 0xe3,
+	0x58, 0x12, 0x6c, 0xcd, 0xdc, 0xd3, 0xbd, 0xf3, 0x80, 0xdb, 0x25, 0xc3, 0x4f, 0xf8, 0xfd, 0xc0,
+	0xe7, 0xdb, 0xf5, 0xd7, 0xb1, 0xb3, 0xd8, 0xef, 0x72, 0xe3, 0xb3, 0x7f, 0xe9, 0xed, 0x94, 0xa8,
+	0x94, 0x09, 0x26, 0xb3, 0x89, 0xcd, 0xff, 0x15, 0xe1, 0xb7, 0xa8, 0xd4, 0x02, 0x52, 0xd4, 0x43,
+	0xbe, 0x1b, 0x9e, 0xdf, 0xd4, 0x3f, 0xcc, 0xa9, 0x1b, 0xe6, 0xae, 0x1b, 0xe6, 0x7e, 0x37, 0xcc,
+	0xfd, 0x68, 0x99, 0xb3, 0x6e, 0x99, 0xf3, 0xd9, 0x32, 0xe7, 0x21, 0x92, 0x39, 0x65, 0xcb, 0x84,
+	0xa7, 0xf8, 0x22, 0xe2, 0x15, 0xa8, 0x58, 0x17, 0x40, 0xaf, 0xa8, 0x9f, 0xfb, 0xdb, 0x59, 0x8a,
+	0x1a, 0xc4, 0x4a, 0x58, 0x61, 0x2b, 0x9a, 0xec, 0x5a, 0xd3, 0x8b, 0xdf, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0xb2, 0xf5, 0xbb, 0xfe, 0x64, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -106,6 +109,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Polls) > 0 {
+		for iNdEx := len(m.Polls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Polls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.VotingThreshold.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -138,6 +155,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.VotingThreshold.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Polls) > 0 {
+		for _, e := range m.Polls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -209,6 +232,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Polls = append(m.Polls, PollRecord{})
+			if err := m.Polls[len(m.Polls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: vote/v1beta1/service.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("vote/v1beta1/service.proto", fileDescriptor_cee403020b6bfc48) }
+func init() { golang_proto.RegisterFile("vote/v1beta1/service.proto", fileDescriptor_cee403020b6bfc48) }
+
+var fileDescriptor_cee403020b6bfc48 = []byte{
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x3f, 0x4b, 0xfb, 0x40,
+	0x18, 0xc7, 0x9b, 0xdf, 0x4f, 0x1d, 0x42, 0x17, 0xcf, 0x22, 0x1a, 0xe4, 0x14, 0x41, 0x9c, 0x92,
+	0x33, 0xfa, 0x0e, 0xc4, 0xdd, 0xbf, 0x38, 0xb8, 0x5d, 0xda, 0xa7, 0x69, 0x30, 0xe6, 0x49, 0xef,
+	0x9e, 0xc4, 0x76, 0x75, 0x73, 0x13, 0x7c, 0x1b, 0xbe, 0x08, 0x47, 0xc7, 0x82, 0x8b, 0xa3, 0x34,
+	0xbe, 0x10, 0xc9, 0x25, 0x05, 0x8b, 0xcd, 0xe0, 0x76, 0x0f, 0xdf, 0x3f, 0x9f, 0x2f, 0x9c, 0xed,
+	0xe4, 0x48, 0x20, 0x72, 0x3f, 0x00, 0x92, 0xbe, 0xd0, 0xa0, 0xf2, 0xa8, 0x0b, 0x5e, 0xaa, 0x90,
+	0x90, 0xb5, 0x4b, 0xcd, 0xab, 0x35, 0xa7, 0x13, 0x62, 0x88, 0x46, 0x10, 0xe5, 0xab, 0xf2, 0x38,
+	0x5b, 0x21, 0x62, 0x18, 0x83, 0x90, 0x69, 0x24, 0x64, 0x92, 0x20, 0x49, 0x8a, 0x30, 0xd1, 0xb5,
+	0xba, 0x31, 0xd7, 0x3e, 0xcc, 0x40, 0x8d, 0x2b, 0xe5, 0xf0, 0xf1, 0xbf, 0xdd, 0x3e, 0x2f, 0xef,
+	0xcb, 0x0a, 0xc9, 0x02, 0x7b, 0xe9, 0x0c, 0xe3, 0x98, 0x71, 0xef, 0x27, 0xd5, 0x33, 0x9e, 0x52,
+	0xb8, 0x80, 0x61, 0x06, 0x9a, 0x9c, 0xed, 0x46, 0x5d, 0xa7, 0x98, 0x68, 0xd8, 0xdd, 0x7c, 0x78,
+	0xff, 0x7a, 0xfe, 0xb7, 0xc6, 0x56, 0x85, 0x1c, 0x41, 0x2c, 0x95, 0x30, 0x1b, 0xd2, 0xb2, 0xbb,
+	0x6f, 0x2f, 0x97, 0x56, 0xcd, 0x9a, 0x4a, 0xf4, 0x8c, 0xb2, 0xd3, 0x6c, 0xa8, 0x31, 0x8e, 0xc1,
+	0x74, 0x18, 0xfb, 0x85, 0xd1, 0xec, 0xc5, 0xb2, 0xd7, 0x4f, 0xa0, 0x2f, 0xb3, 0x98, 0xae, 0x91,
+	0xa2, 0x24, 0xbc, 0x1a, 0x28, 0xd0, 0x03, 0x8c, 0x7b, 0xec, 0x60, 0x41, 0xf1, 0x62, 0xeb, 0x6c,
+	0x8a, 0xff, 0x87, 0x44, 0xbd, 0xcd, 0x35, 0xdb, 0xf6, 0xd9, 0xde, 0xdc, 0xb6, 0x5e, 0x15, 0x72,
+	0x73, 0x93, 0x72, 0x69, 0x16, 0x3b, 0x3e, 0x7d, 0x9b, 0x72, 0x6b, 0x32, 0xe5, 0xd6, 0xe7, 0x94,
+	0x5b, 0x4f, 0x05, 0x6f, 0xbd, 0x16, 0xdc, 0x9a, 0x14, 0xbc, 0xf5, 0x51, 0xf0, 0xd6, 0x8d, 0x1f,
+	0x46, 0x34, 0xc8, 0x02, 0xaf, 0x8b, 0x77, 0x75, 0x5d, 0x02, 0x74, 0x8f, 0xea, 0xb6, 0xbe, 0xdc,
+	0x2e, 0x2a, 0x10, 0xa3, 0x8a, 0x41, 0xe3, 0x14, 0x74, 0xb0, 0x62, 0xfe, 0xf8, 0xe8, 0x3b, 0x00,
+	0x00, 0xff, 0xff, 0x74, 0x1f, 0x65, 0x58, 0x5d, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	Poll(ctx context.Context, in *QueryPollRequest, opts ...grpc.CallOption) (*QueryPollResponse, error)
+	Polls(ctx context.Context, in *QueryPollsRequest, opts ...grpc.CallOption) (*QueryPollsResponse, error)
+	DefaultVotingThreshold(ctx context.Context, in *QueryDefaultVotingThresholdRequest, opts ...grpc.CallOption) (*QueryDefaultVotingThresholdResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) Poll(ctx context.Context, in *QueryPollRequest, opts ...grpc.CallOption) (*QueryPollResponse, error) {
+	out := new(QueryPollResponse)
+	err := c.cc.Invoke(ctx, "/vote.v1beta1.QueryService/Poll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Polls(ctx context.Context, in *QueryPollsRequest, opts ...grpc.CallOption) (*QueryPollsResponse, error) {
+	out := new(QueryPollsResponse)
+	err := c.cc.Invoke(ctx, "/vote.v1beta1.QueryService/Polls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) DefaultVotingThreshold(ctx context.Context, in *QueryDefaultVotingThresholdRequest, opts ...grpc.CallOption) (*QueryDefaultVotingThresholdResponse, error) {
+	out := new(QueryDefaultVotingThresholdResponse)
+	err := c.cc.Invoke(ctx, "/vote.v1beta1.QueryService/DefaultVotingThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	Poll(context.Context, *QueryPollRequest) (*QueryPollResponse, error)
+	Polls(context.Context, *QueryPollsRequest) (*QueryPollsResponse, error)
+	DefaultVotingThreshold(context.Context, *QueryDefaultVotingThresholdRequest) (*QueryDefaultVotingThresholdResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) Poll(ctx context.Context, req *QueryPollRequest) (*QueryPollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Poll not implemented")
+}
+func (*UnimplementedQueryServiceServer) Polls(ctx context.Context, req *QueryPollsRequest) (*QueryPollsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Polls not implemented")
+}
+func (*UnimplementedQueryServiceServer) DefaultVotingThreshold(ctx context.Context, req *QueryDefaultVotingThresholdRequest) (*QueryDefaultVotingThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefaultVotingThreshold not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_Poll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Poll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vote.v1beta1.QueryService/Poll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Poll(ctx, req.(*QueryPollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Polls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPollsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Polls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vote.v1beta1.QueryService/Polls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Polls(ctx, req.(*QueryPollsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_DefaultVotingThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDefaultVotingThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).DefaultVotingThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vote.v1beta1.QueryService/DefaultVotingThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).DefaultVotingThreshold(ctx, req.(*QueryDefaultVotingThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "vote.v1beta1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Poll",
+			Handler:    _QueryService_Poll_Handler,
+		},
+		{
+			MethodName: "Polls",
+			Handler:    _QueryService_Polls_Handler,
+		},
+		{
+			MethodName: "DefaultVotingThreshold",
+			Handler:    _QueryService_DefaultVotingThreshold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vote/v1beta1/service.proto",
+}
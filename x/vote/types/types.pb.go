@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -67,34 +68,80 @@ func (m *TalliedVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TalliedVote proto.InternalMessageInfo
 
+// PollRecord represents a poll together with all votes that have been tallied
+// for it
+type PollRecord struct {
+	Metadata exported.PollMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	Votes    []TalliedVote         `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *PollRecord) Reset()         { *m = PollRecord{} }
+func (m *PollRecord) String() string { return proto.CompactTextString(m) }
+func (*PollRecord) ProtoMessage()    {}
+func (*PollRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c503af20eb7347, []int{1}
+}
+func (m *PollRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PollRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PollRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PollRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollRecord.Merge(m, src)
+}
+func (m *PollRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PollRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*TalliedVote)(nil), "vote.v1beta1.TalliedVote")
+	proto.RegisterType((*PollRecord)(nil), "vote.v1beta1.PollRecord")
 }
 
 func init() { proto.RegisterFile("vote/v1beta1/types.proto", fileDescriptor_f9c503af20eb7347) }
 
 var fileDescriptor_f9c503af20eb7347 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0xcb, 0x2f, 0x49,
-	0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0xc9, 0xe8, 0x41, 0x65, 0xa4, 0x24, 0xd3, 0xf3, 0xf3,
-	0xd3, 0x73, 0x52, 0xf5, 0xc1, 0x72, 0x49, 0xa5, 0x69, 0xfa, 0x89, 0x79, 0x95, 0x10, 0x85, 0x52,
-	0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0x8e, 0x87, 0x48, 0x40, 0x38, 0x10, 0x29, 0xa5, 0xb3, 0x8c, 0x5c, 0xdc, 0x21, 0x89,
-	0x39, 0x39, 0x99, 0xa9, 0x29, 0x61, 0xf9, 0x25, 0xa9, 0x42, 0x2e, 0x5c, 0xac, 0x25, 0x89, 0x39,
-	0x39, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x3c, 0x4e, 0x7a, 0x27, 0xee, 0xc9, 0x33, 0xdc, 0xba,
-	0x27, 0xaf, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x0b, 0xd5, 0x0f, 0xa5,
-	0x74, 0x8b, 0x53, 0xb2, 0xa1, 0x4e, 0xf5, 0xcc, 0x2b, 0x09, 0x82, 0x68, 0x16, 0x52, 0xe2, 0x62,
-	0x03, 0xb9, 0xb8, 0xa8, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0xc7, 0x89, 0x6b, 0xd5, 0x7d, 0x79,
-	0xb6, 0x30, 0xb0, 0x48, 0x10, 0x54, 0x46, 0x28, 0x84, 0x8b, 0x25, 0x25, 0xb1, 0x24, 0x51, 0x82,
-	0x59, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x44, 0x0f, 0xe2, 0x29, 0x3d, 0x98, 0xa7, 0xf4, 0x1c, 0xf3,
-	0x2a, 0x9d, 0xb4, 0x4e, 0x6d, 0xd1, 0xc5, 0x6a, 0x75, 0x4a, 0x6a, 0xb2, 0x7e, 0x00, 0x48, 0xa5,
-	0x6f, 0x62, 0x51, 0x71, 0x46, 0x62, 0x4e, 0x6a, 0x51, 0x10, 0xd8, 0x34, 0x27, 0xff, 0x13, 0x0f,
-	0xe5, 0x18, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x10, 0xc9, 0xac,
-	0xc4, 0x8a, 0xd4, 0x9c, 0xc4, 0xa2, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x28, 0x4f, 0x37,
-	0x39, 0xbf, 0x28, 0x55, 0xbf, 0x42, 0x1f, 0x1c, 0x11, 0x60, 0x5f, 0x25, 0xb1, 0x81, 0x1d, 0x64,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xfd, 0xe0, 0x12, 0x9d, 0x01, 0x00, 0x00,
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xcd, 0x8a, 0x1a, 0x41,
+	0x18, 0x9c, 0x8e, 0x3f, 0x84, 0xd6, 0xd3, 0xe0, 0x61, 0xf4, 0x30, 0x1a, 0x03, 0x41, 0x02, 0x76,
+	0xa3, 0x21, 0x0f, 0x90, 0x21, 0x39, 0xe4, 0x20, 0x91, 0x41, 0x3c, 0xe4, 0x12, 0x7a, 0x66, 0x3a,
+	0xa3, 0xd8, 0xce, 0x27, 0x3d, 0xad, 0x71, 0x5e, 0x21, 0xa7, 0x3c, 0x47, 0xce, 0xfb, 0x10, 0xb2,
+	0xb0, 0xe0, 0x71, 0xd9, 0x83, 0xbb, 0xab, 0x2f, 0xb2, 0x4c, 0x4f, 0xbb, 0x78, 0xf0, 0xd4, 0x3f,
+	0x55, 0xf5, 0x15, 0x55, 0x1f, 0x76, 0x36, 0xa0, 0x38, 0xdd, 0x0c, 0x02, 0xae, 0xd8, 0x80, 0xaa,
+	0x6c, 0xc5, 0x53, 0xb2, 0x92, 0xa0, 0xc0, 0xae, 0xe7, 0x08, 0x31, 0x48, 0xab, 0x19, 0x03, 0xc4,
+	0x82, 0x53, 0x8d, 0x05, 0xeb, 0xdf, 0x94, 0x25, 0x59, 0x41, 0x6c, 0x35, 0x62, 0x88, 0x41, 0x5f,
+	0x69, 0x7e, 0x33, 0xbf, 0xcd, 0x10, 0xd2, 0x25, 0xa4, 0xbf, 0x0a, 0xa0, 0x78, 0x18, 0xe8, 0x9d,
+	0xf6, 0xe4, 0xdb, 0x15, 0x48, 0xc5, 0xa3, 0x6b, 0xe6, 0xdd, 0x3b, 0x84, 0x6b, 0x13, 0x26, 0xc4,
+	0x9c, 0x47, 0x53, 0x50, 0xdc, 0xfe, 0x8a, 0x2b, 0x8a, 0x09, 0x91, 0x39, 0xa8, 0x83, 0x7a, 0x75,
+	0x8f, 0xec, 0x0e, 0x6d, 0xeb, 0xe1, 0xd0, 0xfe, 0x10, 0xcf, 0xd5, 0x6c, 0x1d, 0x90, 0x10, 0x96,
+	0xc6, 0xc2, 0x1c, 0xfd, 0x34, 0x5a, 0x98, 0x81, 0xdf, 0x13, 0xe5, 0x17, 0x62, 0xbb, 0x8b, 0xab,
+	0xb9, 0xb5, 0x4c, 0x9d, 0x37, 0x9d, 0x52, 0xaf, 0xee, 0xe1, 0xff, 0x8f, 0xed, 0xea, 0x54, 0xff,
+	0xf8, 0x06, 0xb1, 0x27, 0xb8, 0x1c, 0x31, 0xc5, 0x9c, 0x52, 0x07, 0xf5, 0x6a, 0xc3, 0x06, 0x29,
+	0x72, 0x93, 0x73, 0x6e, 0xf2, 0x25, 0xc9, 0xbc, 0x8f, 0xb7, 0x37, 0xfd, 0xab, 0xd6, 0x11, 0x0f,
+	0xe9, 0x38, 0x67, 0x8e, 0x98, 0x4c, 0x67, 0x4c, 0x70, 0xe9, 0xeb, 0x69, 0xdd, 0xbf, 0x08, 0xe3,
+	0x31, 0x08, 0xe1, 0xf3, 0x10, 0x64, 0x64, 0x7f, 0xc3, 0x6f, 0x97, 0x5c, 0x31, 0x6d, 0x84, 0xb4,
+	0xd1, 0x7b, 0xa2, 0xeb, 0x3e, 0x97, 0x72, 0xee, 0x9d, 0xe4, 0xa2, 0x91, 0xa1, 0x7a, 0xe5, 0x3c,
+	0xb6, 0xff, 0x2a, 0xb5, 0x3f, 0xe3, 0x4a, 0xae, 0x2a, 0xe2, 0xd4, 0x86, 0x4d, 0x72, 0xb9, 0x32,
+	0x72, 0xd1, 0x9f, 0x51, 0x16, 0x6c, 0xef, 0xc7, 0xee, 0xd9, 0xb5, 0x76, 0x47, 0x17, 0xed, 0x8f,
+	0x2e, 0x7a, 0x3a, 0xba, 0xe8, 0xdf, 0xc9, 0xb5, 0xf6, 0x27, 0xd7, 0xba, 0x3f, 0xb9, 0xd6, 0xcf,
+	0xc1, 0x45, 0x30, 0xb6, 0xe5, 0x82, 0xc9, 0x84, 0xab, 0x3f, 0x20, 0x17, 0xe6, 0xd5, 0x0f, 0x41,
+	0x72, 0xba, 0xa5, 0x7a, 0x89, 0xba, 0xe2, 0xa0, 0xaa, 0xdb, 0xf9, 0xf4, 0x12, 0x00, 0x00, 0xff,
+	0xff, 0xb3, 0x91, 0xb6, 0xf5, 0x4d, 0x02, 0x00, 0x00,
 }
 
 func (m *TalliedVote) Marshal() (dAtA []byte, err error) {
@@ -151,6 +198,53 @@ func (m *TalliedVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PollRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -183,6 +277,23 @@ func (m *TalliedVote) Size() (n int) {
 	return n
 }
 
+func (m *PollRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -340,6 +451,123 @@ func (m *TalliedVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PollRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, TalliedVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0